@@ -28,7 +28,7 @@ vtctld \
  -cell $cell \
  -workflow_manager_init \
  -workflow_manager_use_election \
- -service_map 'grpc-vtctl' \
+ -service_map 'grpc-vtctl,grpc-vtctld' \
  -backup_storage_implementation file \
  -file_backup_storage_root $VTDATAROOT/backups \
  -log_dir $VTDATAROOT/tmp \
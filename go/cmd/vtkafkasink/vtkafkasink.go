@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/kafkasink"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+/*
+
+  Vtkafkasink streams the row changes of a keyspace from vtgate with
+  VStream, and produces them to Kafka, with one topic per table named
+  <topic_prefix><keyspace>.<table>.
+
+  vtkafkasink \
+        -server vtgate-host.my.domain:15991 \
+        -keyspace commerce \
+        -kafka_brokers kafka1:9092,kafka2:9092 \
+        -format avro \
+        -schema_registry_url http://schema-registry:8081 \
+        -position_file /var/lib/vtkafkasink/commerce.pos
+
+*/
+
+var (
+	server            = flag.String("server", "", "vtgate server to connect to")
+	keyspace          = flag.String("keyspace", "", "keyspace to stream the row changes of")
+	tabletType        = flag.String("tablet_type", "replica", "tablet type to stream from")
+	kafkaBrokers      = flag.String("kafka_brokers", "", "comma separated list of the Kafka brokers")
+	kafkaBatchSize    = flag.Int("kafka_batch_size", 100, "maximum number of messages written at once to a partition")
+	kafkaBatchTimeout = flag.Duration("kafka_batch_timeout", 10*time.Millisecond, "maximum time to wait for a batch to fill before it's written, every transaction waits for it unless it fills its batches")
+	topicPrefix       = flag.String("topic_prefix", "", "prefix of the topics, which are named <prefix><keyspace>.<table>")
+	format            = flag.String("format", kafkasink.FormatJSON, "format of the messages, json or avro")
+	schemaRegistryURL = flag.String("schema_registry_url", "", "URL of the schema registry, required by the avro format")
+	positionFile      = flag.String("position_file", "", "if set, the position of the last produced transaction is saved in this file, and the stream resumes from it")
+)
+
+func main() {
+	logger := logutil.NewConsoleLogger()
+	flag.CommandLine.SetOutput(logutil.NewLoggerWriter(logger))
+
+	defer exit.Recover()
+
+	flag.Parse()
+	defer logutil.Flush()
+
+	if *server == "" || *kafkaBrokers == "" {
+		log.Exitf("vtkafkasink requires -server and -kafka_brokers")
+	}
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		log.Exitf("invalid tablet_type %v: %v", *tabletType, err)
+	}
+
+	producer := kafkasink.NewKafkaProducer(strings.Split(*kafkaBrokers, ","), *kafkaBatchSize, *kafkaBatchTimeout)
+	defer producer.Close()
+	sink, err := kafkasink.NewSink(kafkasink.Config{
+		Keyspace:          *keyspace,
+		TopicPrefix:       *topicPrefix,
+		Format:            *format,
+		SchemaRegistryURL: *schemaRegistryURL,
+		PositionFile:      *positionFile,
+	}, producer)
+	if err != nil {
+		log.Exitf("%v", err)
+	}
+	vgtid, err := sink.StartPosition()
+	if err != nil {
+		log.Exitf("%v", err)
+	}
+
+	// Catch SIGTERM and SIGINT so the last transaction is produced.
+	ctx, cancel := context.WithCancel(context.Background())
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigChan
+		log.Infof("Cancelling due to signal: %v", sig)
+		cancel()
+	}()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Errorf("cannot connect to %v: %v", *server, err)
+		exit.Return(1)
+	}
+	defer conn.Close()
+	reader, err := conn.VStream(ctx, tt, vgtid, sink.Filter(), nil)
+	if err != nil {
+		log.Errorf("cannot start the VStream: %v", err)
+		exit.Return(1)
+	}
+	log.Infof("Streaming %v from %v", *keyspace, vgtid)
+	if err := sink.Run(ctx, reader); err != nil && ctx.Err() == nil {
+		log.Errorf("vtkafkasink failed at %v: %v", sink.Position(), err)
+		exit.Return(1)
+	}
+	log.Infof("vtkafkasink stopped at %v", sink.Position())
+}
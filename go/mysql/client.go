@@ -239,6 +239,7 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if !params.DisableClientDeprecateEOF {
 		c.Capabilities = capabilities & (CapabilityClientDeprecateEOF)
 	}
+	c.Capabilities |= capabilities & CapabilityClientSessionTrack
 
 	// Handle switch to SSL if necessary.
 	if params.Flags&CapabilityClientSSL > 0 {
@@ -523,6 +524,9 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// If the server supports CapabilityClientSessionTrack,
+		// we read the GTIDs it sends after an OK packet.
+		c.Capabilities&CapabilityClientSessionTrack |
 		// Pass-through ClientFoundRows flag.
 		CapabilityClientFoundRows&uint32(params.Flags)
 
@@ -572,6 +576,9 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// If the server supports CapabilityClientSessionTrack,
+		// we read the GTIDs it sends after an OK packet.
+		c.Capabilities&CapabilityClientSessionTrack |
 		// Pass-through ClientFoundRows flag.
 		CapabilityClientFoundRows&uint32(params.Flags)
 
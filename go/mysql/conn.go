@@ -172,6 +172,11 @@ type Conn struct {
 
 	// PrepareData is the map to use a prepared statement.
 	PrepareData map[uint32]*PrepareData
+
+	// activeCursor is the cursor whose handler may still be running.
+	// It's buffered before any other command is handled, so the
+	// handler is never called concurrently for the same connection.
+	activeCursor *cursor
 }
 
 // PrepareData is a buffer used for store prepare statement meta data
@@ -182,6 +187,59 @@ type PrepareData struct {
 	ParamsType  []int32
 	ColumnNames []string
 	BindVars    map[string]*querypb.BindVariable
+
+	// cursor is set while the statement has a read-only cursor
+	// with rows that have not been fetched yet.
+	cursor *cursor
+}
+
+// errCursorClosed is returned to the handler when the cursor is
+// closed before all its rows were fetched.
+var errCursorClosed = errors.New("cursor closed")
+
+// cursor streams the result of a statement executed with a read-only
+// cursor. The handler runs in its own goroutine, and its callback
+// blocks until the client fetches the rows it received.
+type cursor struct {
+	fields []*querypb.Field
+	// rows are the rows received from the handler
+	// which have not been fetched yet.
+	rows [][]sqltypes.Value
+
+	// results receives the results of the handler. It's closed
+	// once the handler returned, after err is set.
+	results chan *sqltypes.Result
+	err     error
+	// done is closed to make the callback of the handler fail.
+	done chan struct{}
+}
+
+// fill makes sure rows isn't empty, by waiting for the next results
+// of the handler. It returns false once all the rows were fetched.
+func (cur *cursor) fill() bool {
+	for len(cur.rows) == 0 {
+		qr, ok := <-cur.results
+		if !ok {
+			return false
+		}
+		cur.rows = qr.Rows
+	}
+	return true
+}
+
+// buffer waits for the handler to return and keeps all its rows.
+func (cur *cursor) buffer() {
+	for qr := range cur.results {
+		cur.rows = append(cur.rows, qr.Rows...)
+	}
+}
+
+// close stops the handler and discards the rows.
+func (cur *cursor) close() {
+	close(cur.done)
+	for range cur.results {
+	}
+	cur.rows = nil
 }
 
 // bufPool is used to allocate and free buffers in an efficient way.
@@ -771,6 +829,13 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		return err
 	}
 
+	// Only the fetches can be handled while the handler of a cursor
+	// is still running, and the statements can always be closed.
+	if c.activeCursor != nil && data[0] != ComStmtFetch && data[0] != ComStmtClose && data[0] != ComStmtReset {
+		c.activeCursor.buffer()
+		c.activeCursor = nil
+	}
+
 	switch data[0] {
 	case ComQuit:
 		c.recycleReadPacket()
@@ -908,6 +973,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				log.Errorf("Conn %v: Error writing prepared statement error: %v", c, werr)
 				return werr
 			}
+			return nil
 		}
 
 		paramsCount := uint16(0)
@@ -953,7 +1019,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				}
 			}()
 			queryStart := time.Now()
-			stmtID, _, err := c.parseComStmtExecute(c.PrepareData, data)
+			stmtID, cursorType, err := c.parseComStmtExecute(c.PrepareData, data)
 			c.recycleReadPacket()
 
 			if stmtID != uint32(0) {
@@ -973,10 +1039,18 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				return nil
 			}
 
+			prepare := c.PrepareData[stmtID]
+			// A new execution discards any rows left over from a previous cursor.
+			c.closeCursor(prepare)
+			if cursorType&CursorTypeReadOnly != 0 {
+				err := c.execWithCursor(handler, prepare)
+				timings.Record(queryTimingKey, queryStart)
+				return err
+			}
+
 			fieldSent := false
 			// sendFinished is set if the response should just be an OK packet.
 			sendFinished := false
-			prepare := c.PrepareData[stmtID]
 			err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
 				if sendFinished {
 					// Failsafe: Unreachable if server is well-behaved.
@@ -1068,10 +1142,27 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		} else {
 			prepare.BindVars[key] = sqltypes.BytesBindVariable(chunk)
 		}
+	case ComStmtFetch:
+		stmtID, numRows, ok := c.parseComStmtFetch(data)
+		c.recycleReadPacket()
+		if !ok {
+			log.Errorf("Got unhandled packet from client %v, returning error: %v", c.ConnectionID, data)
+			if err := c.writeErrorPacket(ERUnknownComError, SSUnknownComError, "error handling packet: %v", data); err != nil {
+				log.Errorf("Error writing error packet to client: %v", err)
+				return err
+			}
+			return nil
+		}
+		if err := c.fetchFromCursor(stmtID, numRows); err != nil {
+			return err
+		}
 	case ComStmtClose:
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
 		if ok {
+			if prepare, ok := c.PrepareData[stmtID]; ok {
+				c.closeCursor(prepare)
+			}
 			delete(c.PrepareData, stmtID)
 		}
 	case ComStmtReset:
@@ -1083,6 +1174,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				log.Error("Error writing error packet to client: %v", err)
 				return err
 			}
+			return nil
 		}
 
 		prepare, ok := c.PrepareData[stmtID]
@@ -1092,13 +1184,14 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				log.Error("Error writing error packet to client: %v", err)
 				return err
 			}
+			return nil
 		}
 
+		// Discard any long data that was sent and close the cursor.
 		if prepare.BindVars != nil {
-			for k := range prepare.BindVars {
-				prepare.BindVars[k] = nil
-			}
+			prepare.BindVars = make(map[string]*querypb.BindVariable, prepare.ParamsCount)
 		}
+		c.closeCursor(prepare)
 
 		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
 			log.Error("Error writing ComStmtReset OK packet to client %v: %v", c.ConnectionID, err)
@@ -1108,6 +1201,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	case ComResetConnection:
 		// Clean up and reset the connection
 		c.recycleReadPacket()
+		c.closeCursors()
 		handler.ComResetConnection(c)
 		// Reset prepared statements
 		c.PrepareData = make(map[uint32]*PrepareData)
@@ -1198,6 +1292,136 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) error {
 	return nil
 }
 
+// execWithCursor executes a prepared statement for which the client
+// requested a read-only cursor. Only the column definitions are sent
+// back, and the handler keeps running: the rows are then returned in
+// batches by COM_STMT_FETCH, as the client asks for them.
+func (c *Conn) execWithCursor(handler Handler, prepare *PrepareData) error {
+	cur := &cursor{
+		results: make(chan *sqltypes.Result),
+		done:    make(chan struct{}),
+	}
+	// The bind variables of the statement are reset once this returns,
+	// so the handler gets its own copy of the statement.
+	stmt := *prepare
+	go func() {
+		defer close(cur.results)
+		cur.err = handler.ComStmtExecute(c, &stmt, func(qr *sqltypes.Result) error {
+			select {
+			case cur.results <- qr:
+				return nil
+			case <-cur.done:
+				return errCursorClosed
+			}
+		})
+	}()
+
+	qr, ok := <-cur.results
+	if !ok || len(qr.Fields) == 0 {
+		// Statements that return no rows don't open a cursor.
+		cur.buffer()
+		if cur.err != nil {
+			if werr := c.writeErrorPacketFromError(cur.err); werr != nil {
+				// If we can't even write the error, we're done.
+				log.Errorf("Error writing query error to %s: %v", c, werr)
+				return werr
+			}
+			return nil
+		}
+		if qr == nil {
+			qr = &sqltypes.Result{}
+		}
+		return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, handler.WarningCount(c))
+	}
+
+	cur.fields = qr.Fields
+	cur.rows = qr.Rows
+	prepare.cursor = cur
+	c.activeCursor = cur
+	if err := c.sendColumnCount(uint64(len(cur.fields))); err != nil {
+		return err
+	}
+	for _, field := range cur.fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			return err
+		}
+	}
+	// Like mysqld, the metadata is terminated by an EOF packet carrying
+	// the cursor flag even with CapabilityClientDeprecateEOF, so the
+	// client knows it has to fetch the rows.
+	return c.writeEOFPacket(c.StatusFlags|ServerStatusCursorExists, 0)
+}
+
+// fetchFromCursor sends up to numRows rows from the open cursor of
+// the statement, followed by an EOF packet. The cursor is closed once
+// the last row has been sent.
+func (c *Conn) fetchFromCursor(stmtID, numRows uint32) error {
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok || prepare.cursor == nil {
+		log.Errorf("Conn %v: fetch for statement %v without an open cursor", c, stmtID)
+		if err := c.writeErrorPacket(CRCommandsOutOfSync, SSUnknownSQLState, "statement %v has no open cursor", stmtID); err != nil {
+			log.Errorf("Error writing error packet to client: %v", err)
+			return err
+		}
+		return nil
+	}
+	cur := prepare.cursor
+	if c.activeCursor != nil && c.activeCursor != cur {
+		c.activeCursor.buffer()
+		c.activeCursor = nil
+	}
+
+	c.startWriterBuffering()
+	defer func() {
+		if err := c.endWriterBuffering(); err != nil {
+			log.Errorf("conn %v: flush() failed: %v", c.ID(), err)
+		}
+	}()
+
+	for sent := uint32(0); sent < numRows && cur.fill(); sent++ {
+		if err := c.writeBinaryRow(cur.fields, cur.rows[0]); err != nil {
+			return err
+		}
+		cur.rows = cur.rows[1:]
+	}
+
+	flags := c.StatusFlags | ServerStatusCursorExists
+	if !cur.fill() {
+		prepare.cursor = nil
+		if c.activeCursor == cur {
+			c.activeCursor = nil
+		}
+		if cur.err != nil {
+			if werr := c.writeErrorPacketFromError(cur.err); werr != nil {
+				log.Errorf("Error writing query error to %s: %v", c, werr)
+				return werr
+			}
+			return nil
+		}
+		flags |= ServerStatusLastRowSent
+	}
+	return c.writeEndResultWithFlags(flags, 0, 0, 0)
+}
+
+// closeCursor closes the cursor of the statement, if any.
+func (c *Conn) closeCursor(prepare *PrepareData) {
+	if prepare.cursor == nil {
+		return
+	}
+	if c.activeCursor == prepare.cursor {
+		c.activeCursor = nil
+	}
+	prepare.cursor.close()
+	prepare.cursor = nil
+}
+
+// closeCursors closes all the cursors of the connection.
+func (c *Conn) closeCursors() {
+	for _, prepare := range c.PrepareData {
+		c.closeCursor(prepare)
+	}
+}
+
 //
 // Packet parsing methods, for generic packets.
 //
@@ -1270,6 +1494,53 @@ func parseOKPacket(data []byte) (uint64, uint64, uint16, uint16, error) {
 	return affectedRows, lastInsertID, statusFlags, warnings, nil
 }
 
+// parseOKPacketGTIDs returns the GTIDs of the session state changes
+// of an OK packet, sent by servers which track them if the
+// CapabilityClientSessionTrack capability was negotiated.
+func parseOKPacketGTIDs(data []byte) (string, error) {
+	// Skip the affected rows, last insert ID, status flags and warnings.
+	_, pos, ok := readLenEncInt(data, 1)
+	if ok {
+		_, pos, ok = readLenEncInt(data, pos)
+	}
+	if !ok || pos+4 > len(data) {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet: %v", data)
+	}
+	pos += 4
+
+	// Human readable info.
+	if _, pos, ok = readLenEncStringAsBytes(data, pos); !ok {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet info: %v", data)
+	}
+
+	// Session state changes.
+	changes, _, ok := readLenEncStringAsBytes(data, pos)
+	if !ok {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet session state changes: %v", data)
+	}
+	for pos = 0; pos < len(changes); {
+		var changeType byte
+		var change []byte
+		if changeType, pos, ok = readByte(changes, pos); ok {
+			change, pos, ok = readLenEncStringAsBytes(changes, pos)
+		}
+		if !ok {
+			return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet session state change: %v", data)
+		}
+		if changeType != SessionTrackGtids {
+			continue
+		}
+		// The change is the encoding specification, which is
+		// always 0 for now, and the GTIDs.
+		gtids, _, ok := readLenEncString(change, 1)
+		if !ok {
+			return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet GTIDs: %v", data)
+		}
+		return gtids, nil
+	}
+	return "", nil
+}
+
 // isErrorPacket determines whether or not the packet is an error packet. Mostly here for
 // consistency with isEOFPacket
 func isErrorPacket(data []byte) bool {
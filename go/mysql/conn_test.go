@@ -267,6 +267,35 @@ func TestBasicPackets(t *testing.T) {
 }
 
 // Mostly a sanity check.
+func TestOKPacketGTIDs(t *testing.T) {
+	gtids := "3e11fa47-71ca-11e1-9e33-c80aa9429562:23"
+	gtidsChange := append([]byte{0, byte(len(gtids))}, gtids...)
+	changes := []byte{
+		// A schema change, which is skipped.
+		0x01, 5, 4, 't', 'e', 's', 't',
+		SessionTrackGtids, byte(len(gtidsChange)),
+	}
+	changes = append(changes, gtidsChange...)
+	data := []byte{
+		OKPacket,
+		1,          // affected rows
+		0,          // last insert ID
+		0x02, 0x40, // status flags
+		0, 0, // warnings
+		0, // info
+		byte(len(changes)),
+	}
+	data = append(data, changes...)
+
+	got, err := parseOKPacketGTIDs(data)
+	if err != nil || got != gtids {
+		t.Errorf("parseOKPacketGTIDs() = %v, %v, want %v", got, err, gtids)
+	}
+	if _, err := parseOKPacketGTIDs(data[:len(data)-1]); err == nil {
+		t.Errorf("parseOKPacketGTIDs(truncated) should have failed")
+	}
+}
+
 func TestEOFOrLengthEncodedIntFuzz(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		bytes := make([]byte, rand.Intn(16)+1)
@@ -125,10 +125,10 @@ const (
 	// Announces support for expired password extension.
 	// Not yet supported.
 
-	// CLIENT_SESSION_TRACK 1 << 23
+	// CapabilityClientSessionTrack is CLIENT_SESSION_TRACK
 	// Can set SERVER_SESSION_STATE_CHANGED in the Status Flags
 	// and send session-state change data after a OK packet.
-	// Not yet supported.
+	CapabilityClientSessionTrack = 1 << 23
 
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
@@ -500,6 +500,33 @@ const (
 
 	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS
 	ServerMoreResultsExists = 0x0008
+
+	// ServerStatusCursorExists is SERVER_STATUS_CURSOR_EXISTS
+	ServerStatusCursorExists = 0x0040
+
+	// ServerStatusLastRowSent is SERVER_STATUS_LAST_ROW_SENT
+	ServerStatusLastRowSent = 0x0080
+
+	// ServerSessionStateChanged is SERVER_SESSION_STATE_CHANGED
+	ServerSessionStateChanged = 0x4000
+)
+
+// Cursor type flags sent by the client in COM_STMT_EXECUTE.
+// See https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+const (
+	// CursorTypeNoCursor is CURSOR_TYPE_NO_CURSOR.
+	CursorTypeNoCursor = 0x00
+
+	// CursorTypeReadOnly is CURSOR_TYPE_READ_ONLY.
+	CursorTypeReadOnly = 0x01
+)
+
+// Session state change types, sent after an OK packet if
+// ServerSessionStateChanged is set.
+// See https://dev.mysql.com/doc/internals/en/packet-OK_Packet.html
+const (
+	// SessionTrackGtids is SESSION_TRACK_GTIDS.
+	SessionTrackGtids = 0x03
 )
 
 // A few interesting character set values.
@@ -123,6 +123,16 @@ func parseMysql56GTIDSet(s string) (GTIDSet, error) {
 	return set, nil
 }
 
+// ParseMysql56GTIDSet parses a MySQL 5.6 GTID set, in the format of
+// gtid_executed.
+func ParseMysql56GTIDSet(s string) (Mysql56GTIDSet, error) {
+	set, err := parseMysql56GTIDSet(s)
+	if err != nil {
+		return nil, err
+	}
+	return set.(Mysql56GTIDSet), nil
+}
+
 // Mysql56GTIDSet implements GTIDSet for MySQL 5.6.
 type Mysql56GTIDSet map[SID][]interval
 
@@ -334,6 +344,35 @@ func (set Mysql56GTIDSet) AddGTID(gtid GTID) GTIDSet {
 	return newSet
 }
 
+// Union returns the set of the GTIDs which are in either set.
+// Like AddGTID, it does not modify the original sets.
+func (set Mysql56GTIDSet) Union(other Mysql56GTIDSet) Mysql56GTIDSet {
+	newSet := make(Mysql56GTIDSet, len(set))
+	for sid, intervals := range set {
+		newSet[sid] = intervals
+	}
+	for sid, otherIntervals := range other {
+		intervals := make([]interval, 0, len(newSet[sid])+len(otherIntervals))
+		intervals = append(intervals, newSet[sid]...)
+		intervals = append(intervals, otherIntervals...)
+		sort.Sort(intervalList(intervals))
+
+		// Merge the intervals which overlap or are adjacent.
+		merged := intervals[:0]
+		for _, iv := range intervals {
+			if last := len(merged) - 1; last >= 0 && iv.start <= merged[last].end+1 {
+				if iv.end > merged[last].end {
+					merged[last].end = iv.end
+				}
+				continue
+			}
+			merged = append(merged, iv)
+		}
+		newSet[sid] = merged
+	}
+	return newSet
+}
+
 // SIDBlock returns the binary encoding of a MySQL 5.6 GTID set as expected
 // by internal commands that refer to an "SID block".
 //
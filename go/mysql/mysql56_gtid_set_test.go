@@ -417,6 +417,38 @@ func TestMysql56GTIDSetAddGTID(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetUnion(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+	sid3 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{20, 30}, {35, 40}, {42, 45}},
+		sid2: []interval{{1, 5}, {50, 50}, {60, 70}},
+	}
+	other := Mysql56GTIDSet{
+		sid1: []interval{{25, 36}, {46, 46}},
+		sid2: []interval{{8, 8}},
+		sid3: []interval{{1, 1}},
+	}
+	want := Mysql56GTIDSet{
+		sid1: []interval{{20, 40}, {42, 46}},
+		sid2: []interval{{1, 5}, {8, 8}, {50, 50}, {60, 70}},
+		sid3: []interval{{1, 1}},
+	}
+	if got := set.Union(other); !got.Equal(want) {
+		t.Errorf("Union(%#v) = %#v, want %#v", other, got, want)
+	}
+	if got := other.Union(set); !got.Equal(want) {
+		t.Errorf("Union(%#v) = %#v, want %#v", set, got, want)
+	}
+
+	// The original sets are not modified.
+	if set[sid1][0] != (interval{20, 30}) || len(other[sid1]) != 2 {
+		t.Errorf("Union modified its sets: %#v, %#v", set, other)
+	}
+}
+
 func TestMysql56GTIDSetSIDBlock(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
@@ -22,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -343,7 +345,7 @@ func (c *Conn) ExecuteFetchWithWarningCount(query string, maxrows int, wantfield
 // ReadQueryResult gets the result from the last written query.
 func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.Result, more bool, warnings uint16, err error) {
 	// Get the result.
-	affectedRows, lastInsertID, colNumber, more, warnings, err := c.readComQueryResponse()
+	affectedRows, lastInsertID, colNumber, more, warnings, gtids, err := c.readComQueryResponse()
 	if err != nil {
 		return nil, false, 0, err
 	}
@@ -351,8 +353,9 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 	if colNumber == 0 {
 		// OK packet, means no results. Just use the numbers.
 		return &sqltypes.Result{
-			RowsAffected: affectedRows,
-			InsertID:     lastInsertID,
+			RowsAffected:        affectedRows,
+			InsertID:            lastInsertID,
+			SessionStateChanges: gtids,
 		}, more, warnings, nil
 	}
 
@@ -470,35 +473,47 @@ func (c *Conn) drainResults() error {
 	}
 }
 
-func (c *Conn) readComQueryResponse() (affectedRows uint64, lastInsertID uint64, status int, more bool, warnings uint16, err error) {
+// readComQueryResponse reads the response to a COM_QUERY. If the
+// response is an OK packet, gtids is the set of GTIDs of the
+// transaction committed by the query, if the server tracks them with
+// session_track_gtids.
+func (c *Conn) readComQueryResponse() (affectedRows uint64, lastInsertID uint64, status int, more bool, warnings uint16, gtids string, err error) {
 	data, err := c.readEphemeralPacket()
 	if err != nil {
-		return 0, 0, 0, false, 0, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		return 0, 0, 0, false, 0, "", NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 	}
 	defer c.recycleReadPacket()
 	if len(data) == 0 {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
+		return 0, 0, 0, false, 0, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
 	}
 
 	switch data[0] {
 	case OKPacket:
 		affectedRows, lastInsertID, status, warnings, err := parseOKPacket(data)
-		return affectedRows, lastInsertID, 0, (status & ServerMoreResultsExists) != 0, warnings, err
+		if err != nil {
+			return 0, 0, 0, false, 0, "", err
+		}
+		if c.Capabilities&CapabilityClientSessionTrack != 0 && status&ServerSessionStateChanged != 0 {
+			if gtids, err = parseOKPacketGTIDs(data); err != nil {
+				return 0, 0, 0, false, 0, "", err
+			}
+		}
+		return affectedRows, lastInsertID, 0, (status & ServerMoreResultsExists) != 0, warnings, gtids, nil
 	case ErrPacket:
 		// Error
-		return 0, 0, 0, false, 0, ParseErrorPacket(data)
+		return 0, 0, 0, false, 0, "", ParseErrorPacket(data)
 	case 0xfb:
 		// Local infile
-		return 0, 0, 0, false, 0, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "not implemented")
+		return 0, 0, 0, false, 0, "", vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "not implemented")
 	}
 	n, pos, ok := readLenEncInt(data, 0)
 	if !ok {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
+		return 0, 0, 0, false, 0, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
 	}
 	if pos != len(data) {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
+		return 0, 0, 0, false, 0, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
 	}
-	return 0, 0, int(n), false, 0, nil
+	return 0, 0, int(n), false, 0, "", nil
 }
 
 //
@@ -845,6 +860,15 @@ func (c *Conn) parseComStmtSendLongData(data []byte) (uint32, uint16, []byte, bo
 	return statementID, paramID, data[pos:], true
 }
 
+func (c *Conn) parseComStmtFetch(data []byte) (uint32, uint32, bool) {
+	stmtID, pos, ok := readUint32(data, 1)
+	if !ok {
+		return 0, 0, false
+	}
+	numRows, _, ok := readUint32(data, pos)
+	return stmtID, numRows, ok
+}
+
 func (c *Conn) parseComStmtClose(data []byte) (uint32, bool) {
 	val, _, ok := readUint32(data, 1)
 	return val, ok
@@ -987,6 +1011,12 @@ func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warn
 	if more {
 		flags |= ServerMoreResultsExists
 	}
+	return c.writeEndResultWithFlags(flags, affectedRows, lastInsertID, warnings)
+}
+
+// writeEndResultWithFlags concludes the sending of a Result
+// using the given status flags.
+func (c *Conn) writeEndResultWithFlags(flags uint16, affectedRows, lastInsertID uint64, warnings uint16) error {
 	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
 		if err := c.writeEOFPacket(flags, warnings); err != nil {
 			return err
@@ -1002,6 +1032,16 @@ func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warn
 }
 
 // writePrepare writes a prepare query response to the wire.
+// paramDefinition describes the parameters of a prepared statement.
+// Their types are only known once the client binds them, so mysqld
+// describes them all as nullable binary strings, and so do we.
+var paramDefinition = &querypb.Field{
+	Name:    "?",
+	Type:    sqltypes.VarBinary,
+	Charset: CharacterSetBinary,
+	Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
+}
+
 func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 	paramsCount := prepare.ParamsCount
 	columnCount := 0
@@ -1028,10 +1068,7 @@ func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 
 	if paramsCount > 0 {
 		for i := uint16(0); i < paramsCount; i++ {
-			if err := c.writeColumnDefinition(&querypb.Field{
-				Name:    "?",
-				Type:    sqltypes.VarBinary,
-				Charset: 63}); err != nil {
+			if err := c.writeColumnDefinition(paramDefinition); err != nil {
 				return err
 			}
 		}
@@ -1046,7 +1083,12 @@ func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 	}
 
 	for i, field := range fld {
-		field.Name = strings.Replace(field.Name, "'?'", "?", -1)
+		// The fields can be shared with the plan cache of the
+		// handler, so they are renamed on a copy.
+		if name := strings.Replace(field.Name, "'?'", "?", -1); name != field.Name {
+			field = proto.Clone(field).(*querypb.Field)
+			field.Name = name
+		}
 		prepare.ColumnNames[i] = field.Name
 		if err := c.writeColumnDefinition(field); err != nil {
 			return err
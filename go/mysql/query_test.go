@@ -21,10 +21,14 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
+
+	"golang.org/x/net/context"
 
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -222,6 +226,239 @@ func TestComStmtExecute(t *testing.T) {
 	}
 }
 
+func TestComStmtFetch(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	// COM_STMT_FETCH for statement 18, 2 rows.
+	stmtID, numRows, ok := sConn.parseComStmtFetch([]byte{ComStmtFetch, 18, 0, 0, 0, 2, 0, 0, 0})
+	if !ok || stmtID != 18 || numRows != 2 {
+		t.Fatalf("parseComStmtFetch returned %v, %v, %v", stmtID, numRows, ok)
+	}
+	if _, _, ok := sConn.parseComStmtFetch([]byte{ComStmtFetch, 18, 0}); ok {
+		t.Fatalf("parseComStmtFetch succeeded on a truncated packet")
+	}
+
+	prepare, result := MockPrepareData(t)
+	result.Rows = append(result.Rows,
+		[]sqltypes.Value{sqltypes.MakeTrusted(querypb.Type_INT32, []byte("2"))},
+		[]sqltypes.Value{sqltypes.MakeTrusted(querypb.Type_INT32, []byte("3"))},
+	)
+	results := make(chan *sqltypes.Result)
+	close(results)
+	prepare.cursor = &cursor{
+		fields:  result.Fields,
+		rows:    result.Rows,
+		results: results,
+		done:    make(chan struct{}),
+	}
+	sConn.PrepareData = map[uint32]*PrepareData{prepare.StatementID: prepare}
+
+	readEndFlags := func(wantRows int) uint16 {
+		t.Helper()
+		for i := 0; i < wantRows; i++ {
+			data, err := cConn.ReadPacket()
+			if err != nil || len(data) == 0 || data[0] != 0x00 {
+				t.Fatalf("reading binary row %d failed: %v %v", i, data, err)
+			}
+		}
+		data, err := cConn.ReadPacket()
+		if err != nil || !isEOFPacket(data) {
+			t.Fatalf("expected EOF packet, got %v %v", data, err)
+		}
+		flags, _, _ := readUint16(data, 3)
+		return flags
+	}
+
+	if err := sConn.fetchFromCursor(prepare.StatementID, 2); err != nil {
+		t.Fatalf("fetchFromCursor failed: %v", err)
+	}
+	if flags := readEndFlags(2); flags&ServerStatusCursorExists == 0 || flags&ServerStatusLastRowSent != 0 {
+		t.Errorf("first fetch flags: %x", flags)
+	}
+	if err := sConn.fetchFromCursor(prepare.StatementID, 2); err != nil {
+		t.Fatalf("fetchFromCursor failed: %v", err)
+	}
+	if flags := readEndFlags(1); flags&ServerStatusLastRowSent == 0 {
+		t.Errorf("last fetch flags: %x", flags)
+	}
+	if prepare.cursor != nil {
+		t.Errorf("cursor was not closed after the last row")
+	}
+
+	// Fetching again must fail since the cursor is closed.
+	if err := sConn.fetchFromCursor(prepare.StatementID, 2); err != nil {
+		t.Fatalf("fetchFromCursor failed: %v", err)
+	}
+	data, err := cConn.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != ErrPacket {
+		t.Fatalf("expected error packet, got %v %v", data, err)
+	}
+}
+
+// cursorHandler streams batches of two rows to the cursors, and
+// counts the batches it produced.
+type cursorHandler struct {
+	testHandler
+	batches  int
+	produced sync2.AtomicInt32
+	done     chan error
+}
+
+func (ch *cursorHandler) ComPrepare(c *Conn, query string) ([]*querypb.Field, error) {
+	return []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}}, nil
+}
+
+func (ch *cursorHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	fields := []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}}
+	for i := 0; i < ch.batches; i++ {
+		ch.produced.Add(1)
+		err := callback(&sqltypes.Result{
+			Fields: fields,
+			Rows: [][]sqltypes.Value{
+				{sqltypes.NewInt64(int64(2 * i))},
+				{sqltypes.NewInt64(int64(2*i + 1))},
+			},
+		})
+		if err != nil {
+			ch.done <- err
+			return err
+		}
+	}
+	ch.done <- nil
+	return nil
+}
+
+func TestCursor(t *testing.T) {
+	ch := &cursorHandler{
+		batches: 4,
+		done:    make(chan error, 10),
+	}
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, ch, 0, 0, false)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	client, err := Connect(context.Background(), &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	})
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer client.Close()
+
+	writeCommand := func(data ...byte) {
+		t.Helper()
+		client.sequence = 0
+		if err := client.writePacket(data); err != nil {
+			t.Fatalf("writePacket failed: %v", err)
+		}
+	}
+	readPacket := func() []byte {
+		t.Helper()
+		data, err := client.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket failed: %v", err)
+		}
+		if isErrorPacket(data) {
+			t.Fatalf("unexpected error: %v", ParseErrorPacket(data))
+		}
+		return data
+	}
+	deprecateEOF := client.Capabilities&CapabilityClientDeprecateEOF != 0
+	// readRows reads the rows of a fetch, and returns the status flags.
+	readRows := func(wantRows int) uint16 {
+		t.Helper()
+		for i := 0; i < wantRows; i++ {
+			if data := readPacket(); data[0] != 0x00 {
+				t.Fatalf("reading binary row %d failed: %v", i, data)
+			}
+		}
+		data := readPacket()
+		if data[0] != EOFPacket {
+			t.Fatalf("expected end of rows, got %v", data)
+		}
+		if deprecateEOF {
+			_, _, flags, _, _ := parseOKPacket(data)
+			return flags
+		}
+		flags, _, _ := readUint16(data, 3)
+		return flags
+	}
+	// Prepare the statement: a column definition, followed
+	// by an EOF packet without CapabilityClientDeprecateEOF.
+	writeCommand(append([]byte{ComPrepare}, "select id from t"...)...)
+	if data := readPacket(); data[0] != OKPacket {
+		t.Fatalf("expected prepare OK, got %v", data)
+	}
+	readPacket()
+	if !deprecateEOF {
+		readPacket()
+	}
+
+	// Execute it with a cursor: only the metadata is returned,
+	// terminated by an EOF packet with the cursor flag.
+	writeCommand(ComStmtExecute, 1, 0, 0, 0, CursorTypeReadOnly, 1, 0, 0, 0)
+	if data := readPacket(); data[0] != 1 {
+		t.Fatalf("expected a column count of 1, got %v", data)
+	}
+	readPacket()
+	data := readPacket()
+	if !isEOFPacket(data) {
+		t.Fatalf("expected EOF packet, got %v", data)
+	}
+	if flags, _, _ := readUint16(data, 3); flags&ServerStatusCursorExists == 0 {
+		t.Errorf("metadata flags: %x", flags)
+	}
+
+	// The handler is only asked for the rows the client fetches.
+	writeCommand(ComStmtFetch, 1, 0, 0, 0, 3, 0, 0, 0)
+	if flags := readRows(3); flags&ServerStatusLastRowSent != 0 {
+		t.Errorf("first fetch flags: %x", flags)
+	}
+	// The third batch can be waiting for the next fetch.
+	if n := ch.produced.Get(); n > 3 {
+		t.Errorf("handler produced %v batches for the first fetch, want at most 3", n)
+	}
+	writeCommand(ComStmtFetch, 1, 0, 0, 0, 10, 0, 0, 0)
+	if flags := readRows(5); flags&ServerStatusLastRowSent == 0 {
+		t.Errorf("last fetch flags: %x", flags)
+	}
+	if err := <-ch.done; err != nil {
+		t.Errorf("handler failed: %v", err)
+	}
+
+	// Closing the statement before all the rows are fetched stops the handler.
+	writeCommand(ComStmtExecute, 1, 0, 0, 0, CursorTypeReadOnly, 1, 0, 0, 0)
+	readPacket()
+	readPacket()
+	readPacket()
+	writeCommand(ComStmtClose, 1, 0, 0, 0)
+	select {
+	case err := <-ch.done:
+		if err != errCursorClosed {
+			t.Errorf("handler returned %v, want %v", err, errCursorClosed)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("the handler was not stopped when the statement was closed")
+	}
+}
+
 func TestComStmtClose(t *testing.T) {
 	listener, sConn, cConn := createSocketPair(t)
 	defer func() {
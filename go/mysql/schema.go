@@ -17,6 +17,8 @@ limitations under the License.
 package mysql
 
 import (
+	"bytes"
+
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -35,8 +37,29 @@ const (
 
 	// BaseShowPrimary is the base query for fetching primary key info.
 	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
+
+	// BaseShowForeignKeys is the base query for fetching foreign key info.
+	// It returns one row per column of each foreign key.
+	BaseShowForeignKeys = "SELECT kcu.table_name, kcu.constraint_name, kcu.column_name, kcu.referenced_table_name, kcu.referenced_column_name, rc.update_rule, rc.delete_rule FROM information_schema.key_column_usage kcu JOIN information_schema.referential_constraints rc ON rc.constraint_schema=kcu.constraint_schema AND rc.table_name=kcu.table_name AND rc.constraint_name=kcu.constraint_name WHERE kcu.table_schema=database() AND kcu.referenced_table_name IS NOT NULL ORDER BY kcu.table_name, kcu.constraint_name, kcu.ordinal_position"
+
+	// BaseShowGeneratedColumns is the base query for fetching the
+	// generated columns. It fails on versions without them.
+	BaseShowGeneratedColumns = "SELECT table_name, column_name, generation_expression, extra FROM information_schema.columns WHERE table_schema=database() AND extra IN ('VIRTUAL GENERATED', 'STORED GENERATED') ORDER BY table_name, ordinal_position"
+
+	// BaseShowPartitions is the base query for fetching the partitions
+	// of the partitioned tables. The rows of the subpartitions are
+	// summed in their partition.
+	BaseShowPartitions = "SELECT table_name, partition_name, partition_method, partition_expression, partition_description, CAST(SUM(table_rows) AS UNSIGNED) AS table_rows FROM information_schema.partitions WHERE table_schema=database() AND partition_name IS NOT NULL GROUP BY table_name, partition_ordinal_position, partition_name, partition_method, partition_expression, partition_description ORDER BY table_name, partition_ordinal_position"
 )
 
+// BaseShowTablesForTable specializes BaseShowTables for a single table.
+func BaseShowTablesForTable(table string) string {
+	buf := bytes.NewBufferString(BaseShowTables)
+	buf.WriteString(" AND table_name = ")
+	sqltypes.NewVarChar(table).EncodeSQL(buf)
+	return buf.String()
+}
+
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
 // They are validated by the
 // testBaseShowTables test.
@@ -114,3 +137,102 @@ func ShowPrimaryRow(tableName, colName string) []sqltypes.Value {
 		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
 	}
 }
+
+// ShowForeignKeysFields contains the fields for a BaseShowForeignKeys.
+var ShowForeignKeysFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "constraint_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "column_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "referenced_table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "referenced_column_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "update_rule",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "delete_rule",
+	Type: sqltypes.VarChar,
+}}
+
+// ShowForeignKeysRow returns a row for a column of a foreign key.
+func ShowForeignKeysRow(tableName, constraintName, colName, referencedTableName, referencedColName, updateRule, deleteRule string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(constraintName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(referencedTableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(referencedColName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(updateRule)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(deleteRule)),
+	}
+}
+
+// ShowGeneratedColumnsFields contains the fields for a BaseShowGeneratedColumns.
+var ShowGeneratedColumnsFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "column_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "generation_expression",
+	Type: sqltypes.Text,
+}, {
+	Name: "extra",
+	Type: sqltypes.VarChar,
+}}
+
+// ShowGeneratedColumnsRow returns a row for a generated column.
+func ShowGeneratedColumnsRow(tableName, colName, expression string, stored bool) []sqltypes.Value {
+	extra := "VIRTUAL GENERATED"
+	if stored {
+		extra = "STORED GENERATED"
+	}
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(expression)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(extra)),
+	}
+}
+
+// ShowPartitionsFields contains the fields for a BaseShowPartitions.
+var ShowPartitionsFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_method",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_expression",
+	Type: sqltypes.Text,
+}, {
+	Name: "partition_description",
+	Type: sqltypes.Text,
+}, {
+	Name: "table_rows",
+	Type: sqltypes.Uint64,
+}}
+
+// ShowPartitionsRow returns a row for a partition.
+func ShowPartitionsRow(tableName, partitionName, method, expression, description string, rows uint64) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(partitionName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(method)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(expression)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(description)),
+		sqltypes.NewUint64(rows),
+	}
+}
@@ -289,6 +289,8 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// Tell the handler about the connection coming and going.
 	l.handler.NewConnection(c)
 	defer l.handler.ConnectionClosed(c)
+	// The handlers of the open cursors must return first.
+	defer c.closeCursors()
 
 	// Adjust the count of open connections
 	defer connCount.Add(-1)
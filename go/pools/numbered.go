@@ -172,13 +172,13 @@ func (nu *Numbered) GetOutdated(age time.Duration, purpose string) (vals []inter
 
 // GetIdle returns a list of resurces that have been idle for longer
 // than timeout, and locks them. It does not return any resources that
-// are already locked.
+// are already locked, or that were registered without enforceTimeout.
 func (nu *Numbered) GetIdle(timeout time.Duration, purpose string) (vals []interface{}) {
 	nu.mu.Lock()
 	defer nu.mu.Unlock()
 	now := time.Now()
 	for _, nw := range nu.resources {
-		if nw.inUse {
+		if nw.inUse || !nw.enforceTimeout {
 			continue
 		}
 		if nw.timeUsed.Add(timeout).Sub(now) <= 0 {
@@ -29,6 +29,11 @@ type Result struct {
 	RowsAffected uint64           `json:"rows_affected"`
 	InsertID     uint64           `json:"insert_id"`
 	Rows         [][]Value        `json:"rows"`
+
+	// SessionStateChanges is the set of GTIDs of the transaction
+	// committed by the query, if MySQL tracks them with
+	// session_track_gtids.
+	SessionStateChanges string `json:"session_state_changes,omitempty"`
 }
 
 // ResultStream is an interface for receiving Result. It is used for
@@ -55,8 +60,9 @@ func (result *Result) Repair(fields []*querypb.Field) {
 // Copy creates a deep copy of Result.
 func (result *Result) Copy() *Result {
 	out := &Result{
-		InsertID:     result.InsertID,
-		RowsAffected: result.RowsAffected,
+		InsertID:            result.InsertID,
+		RowsAffected:        result.RowsAffected,
+		SessionStateChanges: result.SessionStateChanges,
 	}
 	if result.Fields != nil {
 		fieldsp := make([]*querypb.Field, len(result.Fields))
@@ -65,6 +65,10 @@ type Rates struct {
 	// totalRate is the rate of total counts per second seen in the latest
 	// sampling interval e.g. 100 queries / 5 seconds sampling interval = 20 QPS.
 	totalRate float64
+
+	// stop is closed by Stop.
+	stop     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewRates reports rolling rate information for countTracker. samples specifies
@@ -83,6 +87,7 @@ func NewRates(name string, countTracker CountTracker, samples int, interval time
 		samples:               samples + 1,
 		interval:              interval,
 		timestampLastSampling: timeNow(),
+		stop:                  make(chan struct{}),
 	}
 	if name != "" {
 		publish(name, rt)
@@ -96,10 +101,23 @@ func NewRates(name string, countTracker CountTracker, samples int, interval time
 func (rt *Rates) track() {
 	for {
 		rt.snapshot()
-		<-time.After(rt.interval)
+		select {
+		case <-rt.stop:
+			return
+		case <-time.After(rt.interval):
+		}
 	}
 }
 
+// Stop stops the sampling. Get keeps returning the last rates.
+// Rates which are created for a limited time, and not published, must
+// be stopped when they are not needed anymore.
+func (rt *Rates) Stop() {
+	rt.stopOnce.Do(func() {
+		close(rt.stop)
+	})
+}
+
 func (rt *Rates) snapshot() {
 	rt.mu.Lock()
 	defer rt.mu.Unlock()
@@ -18,6 +18,7 @@ package stats
 
 import (
 	"expvar"
+	"sync"
 	"testing"
 	"time"
 )
@@ -140,3 +141,33 @@ func TestRatesHook(t *testing.T) {
 		t.Errorf("want %#v, got %#v", v, gotv)
 	}
 }
+
+type countingTracker struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (ct *countingTracker) Counts() map[string]int64 {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.calls++
+	return nil
+}
+
+func TestRatesStop(t *testing.T) {
+	timeNow = time.Now
+	ct := &countingTracker{}
+	r := NewRates("", ct, 2, interval)
+	r.Stop()
+	// Stop can be called more than once.
+	r.Stop()
+
+	// The first snapshot is taken when the sampling starts, and none
+	// after it's stopped.
+	time.Sleep(interval + epsilon)
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.calls != 1 {
+		t.Errorf("snapshots after Stop: got %d, want 1", ct.calls)
+	}
+}
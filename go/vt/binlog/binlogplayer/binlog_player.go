@@ -53,6 +53,11 @@ var (
 	// BlplTransaction is the key for the stats map.
 	BlplTransaction = "Transaction"
 
+	// ThroughputRows is the key of the rows in the throughput stats.
+	ThroughputRows = "Rows"
+	// ThroughputBytes is the key of the bytes in the throughput stats.
+	ThroughputBytes = "Bytes"
+
 	// VReplicationInit is for the Init state.
 	VReplicationInit = "Init"
 	// VReplicationCopying is for the Copying state.
@@ -80,6 +85,26 @@ type Stats struct {
 	SecondsBehindMaster sync2.AtomicInt64
 	History             *history.History
 
+	// lagSamples are the recent values of SecondsBehindMaster,
+	// used to estimate when the player catches up.
+	lagMutex   sync.Mutex
+	lagSamples []lagSample
+
+	// Throughput counts the rows and bytes applied, with the keys
+	// ThroughputRows and ThroughputBytes.
+	Throughput      *stats.CountersWithSingleLabel
+	ThroughputRates *stats.Rates
+
+	// ThrottledTime is the total time the player was throttled.
+	ThrottledTime sync2.AtomicDuration
+
+	// ParallelApplyRowChanges counts the row changes applied
+	// by each parallel apply worker.
+	ParallelApplyRowChanges *stats.CountersWithSingleLabel
+	// ParallelApplyFallbacks counts the transactions which
+	// could not be applied in parallel, and were applied serially.
+	ParallelApplyFallbacks sync2.AtomicInt64
+
 	State sync2.AtomicString
 }
 
@@ -97,6 +122,97 @@ func (bps *Stats) LastPosition() mysql.Position {
 	return bps.lastPosition
 }
 
+// lagSample is a value of SecondsBehindMaster at a point in time.
+type lagSample struct {
+	at  time.Time
+	lag int64
+}
+
+const (
+	// lagSampleInterval is the minimum interval between two lag samples.
+	lagSampleInterval = time.Second
+	// lagTrendWindow is how far back the lag samples are kept.
+	lagTrendWindow = time.Minute
+	// minLagTrend is the minimum time covered by the lag samples
+	// to estimate the catch-up time.
+	minLagTrend = 5 * time.Second
+)
+
+// SetSecondsBehindMaster sets SecondsBehindMaster, and records it to
+// estimate the catch-up time. An unknown lag, math.MaxInt64, clears
+// the recorded values.
+func (bps *Stats) SetSecondsBehindMaster(sbm int64) {
+	bps.SecondsBehindMaster.Set(sbm)
+	bps.recordLag(time.Now(), sbm)
+}
+
+func (bps *Stats) recordLag(now time.Time, sbm int64) {
+	bps.lagMutex.Lock()
+	defer bps.lagMutex.Unlock()
+	if sbm == math.MaxInt64 {
+		bps.lagSamples = nil
+		return
+	}
+	if n := len(bps.lagSamples); n > 0 && now.Sub(bps.lagSamples[n-1].at) < lagSampleInterval {
+		bps.lagSamples[n-1].lag = sbm
+		return
+	}
+	bps.lagSamples = append(bps.lagSamples, lagSample{at: now, lag: sbm})
+	i := 0
+	for i < len(bps.lagSamples)-1 && now.Sub(bps.lagSamples[i].at) > lagTrendWindow {
+		i++
+	}
+	bps.lagSamples = bps.lagSamples[i:]
+}
+
+// CatchupETA estimates the seconds until the player catches up, from
+// how fast SecondsBehindMaster decreased over the last minute.
+// It returns 0 if the player is caught up, and -1 if the lag is
+// unknown or doesn't decrease.
+func (bps *Stats) CatchupETA() int64 {
+	bps.lagMutex.Lock()
+	defer bps.lagMutex.Unlock()
+	if len(bps.lagSamples) == 0 {
+		return -1
+	}
+	first, last := bps.lagSamples[0], bps.lagSamples[len(bps.lagSamples)-1]
+	if last.lag <= 0 {
+		return 0
+	}
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed < minLagTrend.Seconds() || first.lag <= last.lag {
+		return -1
+	}
+	catchupRate := float64(first.lag-last.lag) / elapsed
+	return int64(float64(last.lag) / catchupRate)
+}
+
+// AddThroughput adds applied rows and their bytes to Throughput.
+func (bps *Stats) AddThroughput(rows, bytes int64) {
+	bps.Throughput.Add(ThroughputRows, rows)
+	bps.Throughput.Add(ThroughputBytes, bytes)
+}
+
+// ThroughputPerSecond returns the rows and bytes applied per second
+// over the last sampling interval of ThroughputRates.
+func (bps *Stats) ThroughputPerSecond() (rows, bytes float64) {
+	rates := bps.ThroughputRates.Get()
+	latest := func(key string) float64 {
+		if values := rates[key]; len(values) > 0 {
+			return values[len(values)-1]
+		}
+		return 0
+	}
+	return latest(ThroughputRows), latest(ThroughputBytes)
+}
+
+// Stop stops sampling the rates. The Stats must not be used after
+// Stop.
+func (bps *Stats) Stop() {
+	bps.Rates.Stop()
+	bps.ThroughputRates.Stop()
+}
+
 // MessageHistory gets all the messages, we store 3 at a time
 func (bps *Stats) MessageHistory() []string {
 	strs := make([]string, 0, 3)
@@ -115,6 +231,9 @@ func NewStats() *Stats {
 	bps.Timings = stats.NewTimings("", "", "")
 	bps.Rates = stats.NewRates("", bps.Timings, 15*60/5, 5*time.Second)
 	bps.History = history.New(3)
+	bps.ParallelApplyRowChanges = stats.NewCountersWithSingleLabel("", "", "worker")
+	bps.Throughput = stats.NewCountersWithSingleLabel("", "", "type")
+	bps.ThroughputRates = stats.NewRates("", bps.Throughput, 12, 5*time.Second)
 	bps.SecondsBehindMaster.Set(math.MaxInt64)
 	return bps
 }
@@ -455,7 +574,7 @@ func (blp *BinlogPlayer) writeRecoveryPosition(tx *binlogdatapb.BinlogTransactio
 	blp.position = position
 	blp.blplStats.SetLastPosition(blp.position)
 	if tx.EventToken.Timestamp != 0 {
-		blp.blplStats.SecondsBehindMaster.Set(now - tx.EventToken.Timestamp)
+		blp.blplStats.SetSecondsBehindMaster(now - tx.EventToken.Timestamp)
 	}
 	return nil
 }
@@ -613,6 +732,14 @@ func StartVReplicationUntil(uid uint32, pos string) string {
 		BlpRunning, encodeString(pos), uid)
 }
 
+// ResumeVReplication returns a statement to restart the replication
+// without changing its stop position.
+func ResumeVReplication(uid uint32) string {
+	return fmt.Sprintf(
+		"update _vt.vreplication set state='%v', message='' where id=%v",
+		BlpRunning, uid)
+}
+
 // StopVReplication returns a statement to stop the replication.
 func StopVReplication(uid uint32, message string) string {
 	return fmt.Sprintf(
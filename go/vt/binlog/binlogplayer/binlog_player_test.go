@@ -18,6 +18,7 @@ package binlogplayer
 
 import (
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -393,3 +394,39 @@ func TestReadVReplicationStatus(t *testing.T) {
 		t.Errorf("ReadVReplicationStatus(482821) = %#v, want %#v", got, want)
 	}
 }
+
+func TestCatchupETA(t *testing.T) {
+	bps := NewStats()
+	if got := bps.CatchupETA(); got != -1 {
+		t.Errorf("CatchupETA without lag: %v, want -1", got)
+	}
+
+	now := time.Now()
+	bps.recordLag(now, 100)
+	bps.recordLag(now.Add(2*time.Second), 96)
+	if got := bps.CatchupETA(); got != -1 {
+		t.Errorf("CatchupETA with a short trend: %v, want -1", got)
+	}
+
+	// The lag decreases by 2 seconds per second.
+	bps.recordLag(now.Add(10*time.Second), 80)
+	if got := bps.CatchupETA(); got != 40 {
+		t.Errorf("CatchupETA: %v, want 40", got)
+	}
+
+	// Samples older than a minute are discarded.
+	bps.recordLag(now.Add(70*time.Second), 80)
+	if got := bps.CatchupETA(); got != -1 {
+		t.Errorf("CatchupETA with a constant lag: %v, want -1", got)
+	}
+
+	bps.recordLag(now.Add(71*time.Second), 0)
+	if got := bps.CatchupETA(); got != 0 {
+		t.Errorf("CatchupETA when caught up: %v, want 0", got)
+	}
+
+	bps.recordLag(now.Add(72*time.Second), math.MaxInt64)
+	if got := bps.CatchupETA(); got != -1 {
+		t.Errorf("CatchupETA with an unknown lag: %v, want -1", got)
+	}
+}
@@ -18,8 +18,10 @@ package discovery
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"hash/crc32"
+	"path"
 	"sort"
 	"strings"
 	"sync"
@@ -43,13 +45,16 @@ const (
 	topologyWatcherOpAddTablet     = "AddTablet"
 	topologyWatcherOpRemoveTablet  = "RemoveTablet"
 	topologyWatcherOpReplaceTablet = "ReplaceTablet"
+	topologyWatcherOpWatch         = "Watch"
 )
 
 var (
 	topologyWatcherOperations = stats.NewCountersWithSingleLabel("TopologyWatcherOperations", "Topology watcher operation counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet, topologyWatcherOpWatch)
 	topologyWatcherErrors = stats.NewCountersWithSingleLabel("TopologyWatcherErrors", "Topology watcher error counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpWatch)
+
+	watchTabletTopology = flag.Bool("tablet_topology_watch", false, "watch the records of the known tablets and the ShardReplication records of their shards, so the topology watchers see the changes right away instead of at the next refresh. This opens one topology watch per tablet")
 )
 
 // TabletRecorder is the part of the HealthCheck interface that can
@@ -100,13 +105,16 @@ func NewShardReplicationWatcher(ctx context.Context, topoServer *topo.Server, tr
 
 // tabletInfo is used internally by the TopologyWatcher class
 type tabletInfo struct {
-	alias  string
-	key    string
-	tablet *topodatapb.Tablet
+	alias   string
+	key     string
+	tablet  *topodatapb.Tablet
+	version topo.Version
 }
 
-// TopologyWatcher polls tablet from a configurable set of tablets
-// periodically. When tablets are added / removed, it calls
+// TopologyWatcher reads tablets from a configurable set of tablets
+// every time the records of the known tablets or the ShardReplication
+// records of their shards change, and periodically to find the tablets
+// of new shards. When tablets are added / removed, it calls
 // the TabletRecorder AddTablet / RemoveTablet interface appropriately.
 type TopologyWatcher struct {
 	// set at construction time
@@ -119,6 +127,9 @@ type TopologyWatcher struct {
 	sem                 chan int
 	ctx                 context.Context
 	cancelFunc          context.CancelFunc
+	// refreshChan is signaled by the watches when the tablets
+	// need to be loaded again.
+	refreshChan chan struct{}
 	// wg keeps track of all launched Go routines.
 	wg sync.WaitGroup
 
@@ -126,6 +137,9 @@ type TopologyWatcher struct {
 	mu sync.Mutex
 	// tablets contains a map of alias -> tabletInfo for all known tablets
 	tablets map[string]*tabletInfo
+	// watches contains a map of file path -> cancel function for
+	// the topology records that are watched.
+	watches map[string]*fileWatch
 	// topoChecksum stores a crc32 of the tablets map and is exported as a metric
 	topoChecksum uint32
 	// lastRefresh records the timestamp of the last topo refresh
@@ -147,7 +161,9 @@ func NewTopologyWatcher(ctx context.Context, topoServer *topo.Server, tr TabletR
 		refreshKnownTablets: refreshKnownTablets,
 		getTablets:          getTablets,
 		sem:                 make(chan int, topoReadConcurrency),
+		refreshChan:         make(chan struct{}, 1),
 		tablets:             make(map[string]*tabletInfo),
+		watches:             make(map[string]*fileWatch),
 	}
 	tw.firstLoadChan = make(chan struct{})
 
@@ -159,7 +175,9 @@ func NewTopologyWatcher(ctx context.Context, topoServer *topo.Server, tr TabletR
 	return tw
 }
 
-// watch polls all tablets and notifies TabletRecorder by adding/removing tablets.
+// watch loads all tablets every time the watched records change, and
+// every refreshInterval, and notifies TabletRecorder by adding/removing
+// tablets.
 func (tw *TopologyWatcher) watch() {
 	defer tw.wg.Done()
 	ticker := time.NewTicker(tw.refreshInterval)
@@ -170,10 +188,127 @@ func (tw *TopologyWatcher) watch() {
 		case <-tw.ctx.Done():
 			return
 		case <-ticker.C:
+		case <-tw.refreshChan:
+		}
+	}
+}
+
+// fileWatch is a watch on a topology record started by updateWatches.
+type fileWatch struct {
+	cancel context.CancelFunc
+	// version is the version of the record that was loaded, if known.
+	version topo.Version
+}
+
+// updateWatches watches the records of the known tablets, if they are
+// refreshed, and the ShardReplication records of their shards, and
+// stops watching the other records. tw.mu must be held.
+func (tw *TopologyWatcher) updateWatches() {
+	if !*watchTabletTopology {
+		return
+	}
+	// The versions of the records that were loaded, nil if unknown.
+	paths := make(map[string]topo.Version)
+	for alias, ti := range tw.tablets {
+		if tw.refreshKnownTablets {
+			paths[path.Join(topo.TabletsPath, alias, topo.TabletFile)] = ti.version
+		}
+		paths[path.Join(topo.KeyspacesPath, ti.tablet.Keyspace, topo.ShardsPath, ti.tablet.Shard, topo.ShardReplicationFile)] = nil
+	}
+	for filePath, fw := range tw.watches {
+		if _, ok := paths[filePath]; !ok {
+			fw.cancel()
+			delete(tw.watches, filePath)
+		}
+	}
+	for filePath, version := range paths {
+		if _, ok := tw.watches[filePath]; ok {
+			continue
+		}
+		ctx, cancel := context.WithCancel(tw.ctx)
+		fw := &fileWatch{cancel: cancel, version: version}
+		tw.watches[filePath] = fw
+		tw.wg.Add(1)
+		go tw.watchFile(ctx, filePath, fw)
+	}
+}
+
+// watchFile watches a topology record until ctx is canceled, and asks
+// for the tablets to be loaded again every time it changes. If the
+// watch fails, the record is watched again after the next load.
+func (tw *TopologyWatcher) watchFile(ctx context.Context, filePath string, fw *fileWatch) {
+	defer tw.wg.Done()
+	defer tw.dropWatch(filePath, fw)
+
+	topologyWatcherOperations.Add(topologyWatcherOpWatch, 1)
+	conn, err := tw.topoServer.ConnForCell(ctx, tw.cell)
+	if err != nil {
+		topologyWatcherErrors.Add(topologyWatcherOpWatch, 1)
+		return
+	}
+	current, changes, cancel := conn.Watch(ctx, filePath)
+	if current.Err != nil {
+		// The record may have been deleted since we loaded the
+		// tablets, so we don't count this as an error.
+		if !topo.IsErrType(current.Err, topo.NoNode) && ctx.Err() == nil {
+			topologyWatcherErrors.Add(topologyWatcherOpWatch, 1)
+			log.Warningf("cannot watch %v in cell %v: %v", filePath, tw.cell, current.Err)
+		}
+		return
+	}
+	if fw.version == nil || current.Version.String() != fw.version.String() {
+		// The record may have changed after it was loaded, and
+		// before the watch started.
+		tw.triggerRefresh()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			for range changes {
+			}
+			return
+		case c, ok := <-changes:
+			if !ok {
+				return
+			}
+			if c.Err != nil {
+				// The record was deleted, or the watch was interrupted,
+				// for instance by a restart of the topology server.
+				// Loading the tablets again starts a new watch.
+				if !topo.IsErrType(c.Err, topo.NoNode) {
+					topologyWatcherErrors.Add(topologyWatcherOpWatch, 1)
+					log.Warningf("watch on %v in cell %v failed: %v", filePath, tw.cell, c.Err)
+				}
+				tw.dropWatch(filePath, fw)
+			}
+			tw.triggerRefresh()
+			if c.Err != nil {
+				return
+			}
 		}
 	}
 }
 
+// dropWatch forgets the watch on filePath, if it's still fw.
+func (tw *TopologyWatcher) dropWatch(filePath string, fw *fileWatch) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.watches[filePath] == fw {
+		fw.cancel()
+		delete(tw.watches, filePath)
+	}
+}
+
+// triggerRefresh asks the watch loop to load the tablets again.
+func (tw *TopologyWatcher) triggerRefresh() {
+	select {
+	case tw.refreshChan <- struct{}{}:
+	default:
+		// A refresh is already pending.
+	}
+}
+
 // loadTablets reads all tablets from topology, and updates TabletRecorder.
 func (tw *TopologyWatcher) loadTablets() {
 	var wg sync.WaitGroup
@@ -229,9 +364,10 @@ func (tw *TopologyWatcher) loadTablets() {
 			tw.mu.Lock()
 			aliasStr := topoproto.TabletAliasString(alias)
 			newTablets[aliasStr] = &tabletInfo{
-				alias:  aliasStr,
-				key:    TabletToMapKey(tablet.Tablet),
-				tablet: tablet.Tablet,
+				alias:   aliasStr,
+				key:     TabletToMapKey(tablet.Tablet),
+				tablet:  tablet.Tablet,
+				version: tablet.Version(),
 			}
 			tw.mu.Unlock()
 		}(tAlias)
@@ -296,6 +432,7 @@ func (tw *TopologyWatcher) loadTablets() {
 	}
 	tw.topoChecksum = crc32.ChecksumIEEE(buf.Bytes())
 	tw.lastRefresh = time.Now()
+	tw.updateWatches()
 
 	tw.mu.Unlock()
 }
@@ -327,6 +464,14 @@ func (tw *TopologyWatcher) RefreshLag() time.Duration {
 	return time.Since(tw.lastRefresh)
 }
 
+// Watches returns the number of topology records that are watched.
+func (tw *TopologyWatcher) Watches() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	return len(tw.watches)
+}
+
 // TopoChecksum returns the checksum of the current state of the topo
 func (tw *TopologyWatcher) TopoChecksum() uint32 {
 	tw.mu.Lock()
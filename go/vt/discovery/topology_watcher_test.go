@@ -334,6 +334,78 @@ func checkWatcher(t *testing.T, cellTablets, refreshKnownTablets bool) {
 	tw.Stop()
 }
 
+func TestTopologyWatcherWatches(t *testing.T) {
+	*watchTabletTopology = true
+	defer func() { *watchTabletTopology = false }()
+
+	ts := memorytopo.NewServer("aa")
+	fhc := NewFakeHealthCheck()
+	ctx := context.Background()
+
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+		Hostname: "host1",
+		PortMap:  map[string]int32{"vt": 123},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+
+	// The refresh interval is too long for the test, so all
+	// the changes below are seen through the watches.
+	tw := NewShardReplicationWatcher(ctx, ts, fhc, "aa", "keyspace", "shard", 10*time.Minute, 5)
+	defer tw.Stop()
+	if err := tw.WaitForInitialTopology(); err != nil {
+		t.Fatalf("initial WaitForInitialTopology failed")
+	}
+	waitFor := func(what string, cond func() bool) {
+		t.Helper()
+		for start := time.Now(); !cond(); time.Sleep(10 * time.Millisecond) {
+			if time.Since(start) > 10*time.Second {
+				t.Fatalf("timed out waiting for %v, tablets: %+v", what, fhc.GetAllTablets())
+			}
+		}
+	}
+	// The tablet record and the ShardReplication record.
+	waitFor("the watches to start", func() bool { return tw.Watches() == 2 })
+
+	// A new tablet in the shard.
+	tablet2 := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "aa", Uid: 2},
+		Hostname: "host2",
+		PortMap:  map[string]int32{"vt": 789},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(ctx, tablet2); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	waitFor("the new tablet", func() bool { return len(fhc.GetAllTablets()) == 2 })
+	waitFor("the watch on the new tablet", func() bool { return tw.Watches() == 3 })
+
+	// A tablet moves to a new port.
+	if _, err := ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.PortMap["vt"] = 456
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	tablet.PortMap["vt"] = 456
+	waitFor("the new port", func() bool {
+		_, ok := fhc.GetAllTablets()[TabletToMapKey(tablet)]
+		return ok
+	})
+
+	// A tablet goes away.
+	if err := ts.DeleteTablet(ctx, tablet2.Alias); err != nil {
+		t.Fatalf("DeleteTablet failed: %v", err)
+	}
+	waitFor("the tablet to be removed", func() bool { return len(fhc.GetAllTablets()) == 1 })
+	waitFor("the watch on the tablet to stop", func() bool { return tw.Watches() == 2 })
+}
+
 func TestFilterByShard(t *testing.T) {
 	testcases := []struct {
 		filters  []string
@@ -26,7 +26,7 @@ import (
 )
 
 var (
-	compression = flag.String("grpc_compression", "", "how to compress gRPC, default: nothing, supported: snappy")
+	compression = flag.String("grpc_compression", "", "how to compress gRPC, default: nothing, supported: snappy, zstd")
 )
 
 // SnappyCompressor is a gRPC compressor using the Snappy algorithm.
@@ -48,8 +48,8 @@ func (s SnappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
 }
 
 func appendCompression(opts []grpc.DialOption) ([]grpc.DialOption, error) {
-	if *compression == "snappy" {
-		compression := grpc.WithDefaultCallOptions(grpc.UseCompressor("snappy"))
+	if *compression == "snappy" || *compression == "zstd" {
+		compression := grpc.WithDefaultCallOptions(grpc.UseCompressor(*compression))
 		opts = append(opts, compression)
 	}
 
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// ZstdCompressor is a gRPC compressor using the Zstandard algorithm.
+// gRPC gives it whole messages, so a single encoder and a single decoder
+// are shared by all the streams, with EncodeAll and DecodeAll which can
+// run concurrently. They are never closed, and there is nothing to pool.
+type ZstdCompressor struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewZstdCompressor creates a ZstdCompressor.
+func NewZstdCompressor() (*ZstdCompressor, error) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ZstdCompressor{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+// Name is "zstd"
+func (z *ZstdCompressor) Name() string {
+	return "zstd"
+}
+
+// Compress returns a writer which compresses the message to w when it's
+// closed.
+func (z *ZstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &zstdWriter{encoder: z.encoder, w: w}, nil
+}
+
+// Decompress reads and decompresses the whole message.
+func (z *ZstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	compressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := z.decoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(msg), nil
+}
+
+// zstdWriter buffers the message, and writes it compressed when it's
+// closed.
+type zstdWriter struct {
+	bytes.Buffer
+	encoder *zstd.Encoder
+	w       io.Writer
+}
+
+func (w *zstdWriter) Close() error {
+	_, err := w.w.Write(w.encoder.EncodeAll(w.Bytes(), nil))
+	return err
+}
+
+type compressionKey struct{}
+
+// WithCompression returns a context which makes the streaming calls that
+// support it compress their messages with the named compressor. The server
+// compresses its responses with the same compressor.
+func WithCompression(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, compressionKey{}, name)
+}
+
+// CompressionCallOptions returns the call options which set the compressor
+// of the context, if any.
+func CompressionCallOptions(ctx context.Context) []grpc.CallOption {
+	name, _ := ctx.Value(compressionKey{}).(string)
+	if name == "" {
+		return nil
+	}
+	return []grpc.CallOption{grpc.UseCompressor(name)}
+}
+
+// ValidateCompression returns an error if the compressor is not registered.
+func ValidateCompression(name string) error {
+	if name != "" && encoding.GetCompressor(name) == nil {
+		return fmt.Errorf("unsupported compression: %v", name)
+	}
+	return nil
+}
+
+func init() {
+	compressor, err := NewZstdCompressor()
+	if err != nil {
+		panic(fmt.Sprintf("cannot create the zstd compressor: %v", err))
+	}
+	encoding.RegisterCompressor(compressor)
+}
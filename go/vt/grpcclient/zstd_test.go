@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestZstdCompressor(t *testing.T) {
+	compressor := encoding.GetCompressor("zstd")
+	require.NotNil(t, compressor)
+
+	// The encoder and the decoder are shared by the messages.
+	for _, msg := range []string{strings.Repeat("vreplication ", 1000), "binlog"} {
+		var buf bytes.Buffer
+		w, err := compressor.Compress(&buf)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(msg))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := compressor.Decompress(&buf)
+		require.NoError(t, err)
+		got, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, msg, string(got))
+	}
+}
+
+func TestCompression(t *testing.T) {
+	assert.NoError(t, ValidateCompression(""))
+	assert.NoError(t, ValidateCompression("zstd"))
+	assert.NoError(t, ValidateCompression("snappy"))
+	assert.EqualError(t, ValidateCompression("lzma"), "unsupported compression: lzma")
+
+	assert.Nil(t, CompressionCallOptions(context.Background()))
+	assert.Len(t, CompressionCallOptions(WithCompression(context.Background(), "zstd")), 1)
+}
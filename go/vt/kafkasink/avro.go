@@ -0,0 +1,267 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The Avro types of the columns. All the columns are nullable.
+const (
+	avroLong   = "long"
+	avroDouble = "double"
+	avroString = "string"
+	avroBytes  = "bytes"
+)
+
+// avroMagicByte starts the messages in the wire format of the
+// Confluent schema registry, followed by the id of the schema.
+const avroMagicByte = 0
+
+// avroSchema is the registered schema of the envelopes of a table.
+type avroSchema struct {
+	table *table
+	// types are the Avro types of the columns.
+	types []string
+	id    int32
+}
+
+// avroEncoder encodes the row changes in Avro. The schema of the
+// envelopes of a topic is registered with the subject <topic>-value
+// every time the fields of its table change.
+type avroEncoder struct {
+	registry *SchemaRegistry
+
+	mu      sync.Mutex
+	schemas map[string]*avroSchema
+}
+
+func newAvroEncoder(registry *SchemaRegistry) *avroEncoder {
+	return &avroEncoder{
+		registry: registry,
+		schemas:  make(map[string]*avroSchema),
+	}
+}
+
+func (ae *avroEncoder) encode(ctx context.Context, topic string, rc *rowChange) ([]byte, error) {
+	schema, err := ae.schema(ctx, topic, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteByte(avroMagicByte)
+	var id [4]byte
+	binary.BigEndian.PutUint32(id[:], uint32(schema.id))
+	buf.Write(id[:])
+
+	writeAvroString(buf, rc.op())
+	writeAvroString(buf, rc.keyspace)
+	writeAvroString(buf, rc.table.name)
+	writeAvroLong(buf, rc.timestamp)
+	for _, row := range [][]sqltypes.Value{rc.before, rc.after} {
+		if row == nil {
+			writeAvroLong(buf, 0)
+			continue
+		}
+		writeAvroLong(buf, 1)
+		for i, value := range row {
+			if err := writeAvroValue(buf, schema.types[i], value); err != nil {
+				return nil, fmt.Errorf("cannot encode column %v of table %v: %v", rc.table.fields[i].Name, rc.table.name, err)
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (ae *avroEncoder) schema(ctx context.Context, topic string, rc *rowChange) (*avroSchema, error) {
+	ae.mu.Lock()
+	defer ae.mu.Unlock()
+	if schema, ok := ae.schemas[topic]; ok && schema.table == rc.table {
+		return schema, nil
+	}
+	types := make([]string, 0, len(rc.table.fields))
+	for _, field := range rc.table.fields {
+		types = append(types, avroType(field.Type))
+	}
+	definition, err := avroEnvelopeSchema(rc.keyspace, rc.table, types)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ae.registry.Register(ctx, topic+"-value", definition)
+	if err != nil {
+		return nil, err
+	}
+	schema := &avroSchema{
+		table: rc.table,
+		types: types,
+		id:    id,
+	}
+	ae.schemas[topic] = schema
+	return schema, nil
+}
+
+// avroType returns the Avro type of the values of a MySQL type.
+// The unsigned 64 bit integers don't fit in a long, and the decimals
+// would lose their precision in a double, so they are strings.
+func avroType(typ querypb.Type) string {
+	switch {
+	case typ == sqltypes.Uint64:
+		return avroString
+	case sqltypes.IsIntegral(typ):
+		return avroLong
+	case sqltypes.IsFloat(typ):
+		return avroDouble
+	case sqltypes.IsBinary(typ):
+		return avroBytes
+	default:
+		return avroString
+	}
+}
+
+type avroField struct {
+	Name    string      `json:"name"`
+	Type    interface{} `json:"type"`
+	Default interface{} `json:"default,omitempty"`
+}
+
+type avroRecord struct {
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	Fields    []*avroField `json:"fields"`
+}
+
+// nullDefault is marshaled as the null default of the nullable fields.
+var nullDefault = json.RawMessage("null")
+
+// avroEnvelopeSchema returns the schema of the envelopes of a table.
+// The before and after images share the record of the rows.
+func avroEnvelopeSchema(keyspace string, t *table, types []string) (string, error) {
+	rowName := avroName(t.name)
+	row := &avroRecord{
+		Type: "record",
+		Name: rowName,
+	}
+	for i, field := range t.fields {
+		row.Fields = append(row.Fields, &avroField{
+			Name:    avroName(field.Name),
+			Type:    []string{"null", types[i]},
+			Default: nullDefault,
+		})
+	}
+	envelope := &avroRecord{
+		Type:      "record",
+		Name:      rowName + "_envelope",
+		Namespace: avroName(keyspace),
+		Fields: []*avroField{
+			{Name: "op", Type: avroString},
+			{Name: "keyspace", Type: avroString},
+			{Name: "table", Type: avroString},
+			{Name: "timestamp", Type: avroLong},
+			{Name: "before", Type: []interface{}{"null", row}, Default: nullDefault},
+			{Name: "after", Type: []interface{}{"null", rowName}, Default: nullDefault},
+		},
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// avroName replaces the characters which are not allowed in the
+// Avro names with underscores.
+func avroName(name string) string {
+	result := []byte(name)
+	for i, c := range result {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			result[i] = '_'
+		}
+	}
+	return string(result)
+}
+
+func writeAvroValue(buf *bytes.Buffer, typ string, value sqltypes.Value) error {
+	if value.IsNull() {
+		writeAvroLong(buf, 0)
+		return nil
+	}
+	writeAvroLong(buf, 1)
+	switch typ {
+	case avroLong:
+		var v int64
+		if value.IsSigned() {
+			n, err := strconv.ParseInt(value.ToString(), 10, 64)
+			if err != nil {
+				return err
+			}
+			v = n
+		} else {
+			n, err := strconv.ParseUint(value.ToString(), 10, 64)
+			if err != nil {
+				return err
+			}
+			v = int64(n)
+		}
+		writeAvroLong(buf, v)
+	case avroDouble:
+		v, err := strconv.ParseFloat(value.ToString(), 64)
+		if err != nil {
+			return err
+		}
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		buf.Write(b[:])
+	default:
+		writeAvroBytes(buf, value.Raw())
+	}
+	return nil
+}
+
+// writeAvroLong writes the zig-zag varint encoding of v, which is
+// also the encoding of binary.PutVarint.
+func writeAvroLong(buf *bytes.Buffer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	buf.Write(b[:n])
+}
+
+func writeAvroBytes(buf *bytes.Buffer, b []byte) {
+	writeAvroLong(buf, int64(len(b)))
+	buf.Write(b)
+}
+
+func writeAvroString(buf *bytes.Buffer, s string) {
+	writeAvroLong(buf, int64(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// fakeRegistry is a schema registry which assigns the ids in
+// the order of the registrations.
+type fakeRegistry struct {
+	subjects []string
+	schemas  []string
+}
+
+func (fr *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != schemaRegistryContentType {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	if r.URL.Path == "/subjects/invalid-value/versions" {
+		http.Error(w, `{"error_code":42201,"message":"Invalid schema"}`, http.StatusUnprocessableEntity)
+		return
+	}
+	data, _ := ioutil.ReadAll(r.Body)
+	var body struct {
+		Schema string `json:"schema"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fr.subjects = append(fr.subjects, r.URL.Path)
+	fr.schemas = append(fr.schemas, body.Schema)
+	json.NewEncoder(w).Encode(map[string]int{"id": len(fr.schemas)})
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	registry := &fakeRegistry{}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	sr := NewSchemaRegistry(server.URL + "/")
+	ctx := context.Background()
+
+	id, err := sr.Register(ctx, "t1-value", `"string"`)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	// The second registration is cached.
+	id, err = sr.Register(ctx, "t1-value", `"string"`)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	id, err = sr.Register(ctx, "t1-value", `"long"`)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, id)
+	assert.Equal(t, []string{"/subjects/t1-value/versions", "/subjects/t1-value/versions"}, registry.subjects)
+
+	_, err = sr.Register(ctx, "invalid-value", `"string"`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot register the schema of invalid-value: 422 Unprocessable Entity")
+}
+
+func TestAvroEnvelopeSchema(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|my-col|amount|price|data|big", "int64|varchar|decimal|float64|blob|uint64")
+	types := make([]string, 0, len(fields))
+	for _, field := range fields {
+		types = append(types, avroType(field.Type))
+	}
+	assert.Equal(t, []string{avroLong, avroString, avroString, avroDouble, avroBytes, avroString}, types)
+
+	schema, err := avroEnvelopeSchema("commerce", newTable("1order", fields), types)
+	require.NoError(t, err)
+	want := `{"type":"record","name":"_order_envelope","namespace":"commerce","fields":[` +
+		`{"name":"op","type":"string"},{"name":"keyspace","type":"string"},{"name":"table","type":"string"},{"name":"timestamp","type":"long"},` +
+		`{"name":"before","type":["null",{"type":"record","name":"_order","fields":[` +
+		`{"name":"id","type":["null","long"],"default":null},` +
+		`{"name":"my_col","type":["null","string"],"default":null},` +
+		`{"name":"amount","type":["null","string"],"default":null},` +
+		`{"name":"price","type":["null","double"],"default":null},` +
+		`{"name":"data","type":["null","bytes"],"default":null},` +
+		`{"name":"big","type":["null","string"],"default":null}]}],"default":null},` +
+		`{"name":"after","type":["null","_order"],"default":null}]}`
+	assert.Equal(t, want, schema)
+}
+
+func TestAvroEncoder(t *testing.T) {
+	registry := &fakeRegistry{}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	enc := newAvroEncoder(NewSchemaRegistry(server.URL))
+	ctx := context.Background()
+
+	t1 := newTable("t1", sqltypes.MakeTestFields("id|name|price", "int64|varchar|float64"))
+	rc := &rowChange{
+		keyspace:  "ks",
+		table:     t1,
+		timestamp: 3,
+		after:     []sqltypes.Value{sqltypes.NewInt64(-2), sqltypes.NewVarChar("ab"), sqltypes.NULL},
+	}
+	got, err := enc.encode(ctx, "ks.t1", rc)
+	require.NoError(t, err)
+
+	want := &bytes.Buffer{}
+	// Magic byte and schema id.
+	want.Write([]byte{0, 0, 0, 0, 1})
+	// op, keyspace, table and timestamp.
+	want.Write([]byte{12})
+	want.WriteString("insert")
+	want.Write([]byte{4})
+	want.WriteString("ks")
+	want.Write([]byte{4})
+	want.WriteString("t1")
+	want.Write([]byte{6})
+	// before is null, after is set.
+	want.Write([]byte{0, 2})
+	// id is -2, name is "ab" and price is null.
+	want.Write([]byte{2, 3})
+	want.Write([]byte{2, 4})
+	want.WriteString("ab")
+	want.Write([]byte{0})
+	assert.Equal(t, want.Bytes(), got)
+
+	rc.after[2] = sqltypes.NewFloat64(1.5)
+	got, err = enc.encode(ctx, "ks.t1", rc)
+	require.NoError(t, err)
+	var price [8]byte
+	binary.LittleEndian.PutUint64(price[:], math.Float64bits(1.5))
+	assert.Equal(t, append([]byte{2}, price[:]...), got[len(got)-9:])
+
+	// The schema is registered again only if the fields change.
+	assert.Len(t, registry.schemas, 1)
+	rc.table = newTable("t1", sqltypes.MakeTestFields("id|name|price", "int64|varchar|float64"))
+	_, err = enc.encode(ctx, "ks.t1", rc)
+	require.NoError(t, err)
+	assert.Len(t, registry.schemas, 1)
+	rc.table = newTable("t1", sqltypes.MakeTestFields("id|name|price|qty", "int64|varchar|float64|int32"))
+	rc.after = append(rc.after, sqltypes.NewInt32(4))
+	got, err = enc.encode(ctx, "ks.t1", rc)
+	require.NoError(t, err)
+	assert.Len(t, registry.schemas, 2)
+	assert.Equal(t, []byte{0, 0, 0, 0, 2}, got[:5])
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The operations of the row changes.
+const (
+	opInsert = "insert"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// table holds the fields of the last FIELD event of a table.
+// A new table is created for every FIELD event, so the encoders
+// can detect the schema changes by comparing the pointers.
+type table struct {
+	name   string
+	fields []*querypb.Field
+	// pkColumns are the indexes of the primary key columns.
+	pkColumns []int
+}
+
+func newTable(name string, fields []*querypb.Field) *table {
+	t := &table{
+		name:   name,
+		fields: fields,
+	}
+	for i, field := range fields {
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			t.pkColumns = append(t.pkColumns, i)
+		}
+	}
+	return t
+}
+
+// rowChange is a changed row of a table. Before is nil for the
+// inserted rows, and After is nil for the deleted rows.
+type rowChange struct {
+	keyspace  string
+	table     *table
+	timestamp int64
+	before    []sqltypes.Value
+	after     []sqltypes.Value
+}
+
+func (rc *rowChange) op() string {
+	switch {
+	case rc.before == nil:
+		return opInsert
+	case rc.after == nil:
+		return opDelete
+	default:
+		return opUpdate
+	}
+}
+
+// key returns the key of the message of the change, which is a JSON
+// object of the primary key columns, whatever the format of the values.
+// It returns nil if the table has no primary key.
+func (rc *rowChange) key() ([]byte, error) {
+	if len(rc.table.pkColumns) == 0 {
+		return nil, nil
+	}
+	row := rc.after
+	if row == nil {
+		row = rc.before
+	}
+	key := make(map[string]interface{}, len(rc.table.pkColumns))
+	for _, i := range rc.table.pkColumns {
+		key[rc.table.fields[i].Name] = jsonValue(row[i])
+	}
+	return json.Marshal(key)
+}
+
+// encoder encodes the row changes into the values of the messages.
+type encoder interface {
+	encode(ctx context.Context, topic string, rc *rowChange) ([]byte, error)
+}
+
+func newEncoder(format, schemaRegistryURL string) (encoder, error) {
+	switch format {
+	case "", FormatJSON:
+		return jsonEncoder{}, nil
+	case FormatAvro:
+		if schemaRegistryURL == "" {
+			return nil, fmt.Errorf("the avro format requires a schema registry")
+		}
+		return newAvroEncoder(NewSchemaRegistry(schemaRegistryURL)), nil
+	}
+	return nil, fmt.Errorf("unknown format %v, the supported formats are %v and %v", format, FormatJSON, FormatAvro)
+}
+
+// jsonEnvelope is the JSON encoding of a row change.
+type jsonEnvelope struct {
+	Op        string                 `json:"op"`
+	Keyspace  string                 `json:"keyspace"`
+	Table     string                 `json:"table"`
+	Timestamp int64                  `json:"timestamp"`
+	Before    map[string]interface{} `json:"before"`
+	After     map[string]interface{} `json:"after"`
+}
+
+// jsonEncoder encodes the row changes in JSON. The rows are encoded
+// like the rows of the VStream API of vtgate.
+type jsonEncoder struct{}
+
+func (jsonEncoder) encode(ctx context.Context, topic string, rc *rowChange) ([]byte, error) {
+	return json.Marshal(&jsonEnvelope{
+		Op:        rc.op(),
+		Keyspace:  rc.keyspace,
+		Table:     rc.table.name,
+		Timestamp: rc.timestamp,
+		Before:    jsonRow(rc.table.fields, rc.before),
+		After:     jsonRow(rc.table.fields, rc.after),
+	})
+}
+
+// jsonRow returns the values of the row keyed by the column names.
+func jsonRow(fields []*querypb.Field, row []sqltypes.Value) map[string]interface{} {
+	if row == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(row))
+	for i, value := range row {
+		result[fields[i].Name] = jsonValue(value)
+	}
+	return result
+}
+
+// jsonValue encodes the numbers as JSON numbers, the binary values
+// in base64, and the other values as strings.
+func jsonValue(value sqltypes.Value) interface{} {
+	switch {
+	case value.IsNull():
+		return nil
+	case value.IsIntegral() || value.IsFloat() || value.Type() == sqltypes.Decimal:
+		return json.Number(value.ToString())
+	case value.IsBinary():
+		return value.ToBytes()
+	default:
+		return value.ToString()
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafkasink produces the row changes of a VStream to Kafka,
+// with one topic per table. The messages are keyed by the primary key
+// of the rows, and their values are envelopes with the before and
+// after images of the rows, in JSON or in Avro with the schemas
+// registered in a schema registry.
+package kafkasink
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The formats of the values of the messages.
+const (
+	FormatJSON = "json"
+	FormatAvro = "avro"
+)
+
+// Config is the configuration of a Sink.
+type Config struct {
+	// Keyspace is the keyspace of the VStream.
+	Keyspace string
+	// TopicPrefix is prepended to the topics, which are named
+	// <prefix><keyspace>.<table>.
+	TopicPrefix string
+	// Format is the format of the values, json or avro.
+	Format string
+	// SchemaRegistryURL is the schema registry of the avro format.
+	SchemaRegistryURL string
+	// PositionFile saves the VGTID of the last produced transaction,
+	// to resume from it after a restart. It's optional.
+	PositionFile string
+}
+
+// Sink consumes a VStream and produces its row changes to Kafka.
+// The changes of a transaction are produced before its VGTID is
+// saved, so they are produced at least once.
+type Sink struct {
+	config   Config
+	producer Producer
+	encoder  encoder
+
+	// tables maps the tables to their last FIELD event.
+	tables map[string]*table
+	// position is the VGTID of the last produced transaction.
+	position *binlogdatapb.VGtid
+}
+
+// NewSink returns a Sink which produces with the producer.
+func NewSink(config Config, producer Producer) (*Sink, error) {
+	if config.Keyspace == "" {
+		return nil, fmt.Errorf("the keyspace of the sink must be specified")
+	}
+	enc, err := newEncoder(config.Format, config.SchemaRegistryURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Sink{
+		config:   config,
+		producer: producer,
+		encoder:  enc,
+		tables:   make(map[string]*table),
+	}, nil
+}
+
+// StartPosition returns the VGTID to start the VStream from: the saved
+// position if there is one, or the current position of all the shards
+// of the keyspace.
+func (s *Sink) StartPosition() (*binlogdatapb.VGtid, error) {
+	if s.config.PositionFile != "" {
+		data, err := ioutil.ReadFile(s.config.PositionFile)
+		switch {
+		case err == nil:
+			vgtid := &binlogdatapb.VGtid{}
+			if err := proto.UnmarshalText(string(data), vgtid); err != nil {
+				return nil, fmt.Errorf("cannot parse the position file %v: %v", s.config.PositionFile, err)
+			}
+			return vgtid, nil
+		case !os.IsNotExist(err):
+			return nil, err
+		}
+	}
+	return &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: s.config.Keyspace,
+			Gtid:     "current",
+		}},
+	}, nil
+}
+
+// Filter returns the filter of the VStream, which matches all the
+// tables of the keyspace.
+func (s *Sink) Filter() *binlogdatapb.Filter {
+	return &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+}
+
+// Position returns the VGTID of the last produced transaction.
+func (s *Sink) Position() *binlogdatapb.VGtid {
+	return s.position
+}
+
+// Run produces the events of the reader until the stream ends or fails.
+// The events returned by a Recv are produced before the next one.
+func (s *Sink) Run(ctx context.Context, reader vtgateconn.VStreamReader) error {
+	for {
+		events, err := reader.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.process(ctx, events); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Sink) process(ctx context.Context, events []*binlogdatapb.VEvent) error {
+	// The messages are grouped by topic, in the order of the events.
+	var topics []string
+	messages := make(map[string][]Message)
+	var vgtid *binlogdatapb.VGtid
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_FIELD:
+			s.tables[event.FieldEvent.TableName] = newTable(event.FieldEvent.TableName, event.FieldEvent.Fields)
+		case binlogdatapb.VEventType_ROW:
+			t, ok := s.tables[event.RowEvent.TableName]
+			if !ok {
+				return fmt.Errorf("no field event received for table %v", event.RowEvent.TableName)
+			}
+			topic := s.topic(t.name)
+			for _, change := range event.RowEvent.RowChanges {
+				rc := &rowChange{
+					keyspace:  s.config.Keyspace,
+					table:     t,
+					timestamp: event.Timestamp,
+				}
+				if change.Before != nil {
+					rc.before = sqltypes.MakeRowTrusted(t.fields, change.Before)
+				}
+				if change.After != nil {
+					rc.after = sqltypes.MakeRowTrusted(t.fields, change.After)
+				}
+				key, err := rc.key()
+				if err != nil {
+					return err
+				}
+				value, err := s.encoder.encode(ctx, topic, rc)
+				if err != nil {
+					return err
+				}
+				if _, ok := messages[topic]; !ok {
+					topics = append(topics, topic)
+				}
+				messages[topic] = append(messages[topic], Message{Key: key, Value: value})
+			}
+		case binlogdatapb.VEventType_VGTID:
+			vgtid = event.Vgtid
+		}
+	}
+
+	for _, topic := range topics {
+		if err := s.producer.Produce(ctx, topic, messages[topic]); err != nil {
+			return fmt.Errorf("cannot produce to %v: %v", topic, err)
+		}
+	}
+	if vgtid == nil {
+		return nil
+	}
+	s.position = vgtid
+	return s.savePosition()
+}
+
+func (s *Sink) topic(tableName string) string {
+	return fmt.Sprintf("%s%s.%s", s.config.TopicPrefix, s.config.Keyspace, tableName)
+}
+
+// savePosition writes the position to a temporary file which is
+// renamed, so the position file is never partially written.
+func (s *Sink) savePosition() error {
+	if s.config.PositionFile == "" {
+		return nil
+	}
+	tmp := s.config.PositionFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(proto.MarshalTextString(s.position)), 0644); err != nil {
+		return fmt.Errorf("cannot save the position: %v", err)
+	}
+	if err := os.Rename(tmp, s.config.PositionFile); err != nil {
+		return fmt.Errorf("cannot save the position: %v", err)
+	}
+	log.V(2).Infof("Saved the position %v", s.position)
+	return nil
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type fakeReader struct {
+	batches [][]*binlogdatapb.VEvent
+}
+
+func (fr *fakeReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(fr.batches) == 0 {
+		return nil, io.EOF
+	}
+	events := fr.batches[0]
+	fr.batches = fr.batches[1:]
+	return events, nil
+}
+
+type fakeProducer struct {
+	topics   []string
+	messages map[string][]Message
+	err      error
+}
+
+func newFakeProducer() *fakeProducer {
+	return &fakeProducer{messages: make(map[string][]Message)}
+}
+
+func (fp *fakeProducer) Produce(ctx context.Context, topic string, messages []Message) error {
+	if fp.err != nil {
+		return fp.err
+	}
+	fp.topics = append(fp.topics, topic)
+	fp.messages[topic] = append(fp.messages[topic], messages...)
+	return nil
+}
+
+func (fp *fakeProducer) Close() error {
+	return nil
+}
+
+func testFields() []*querypb.Field {
+	fields := sqltypes.MakeTestFields("id|name|price|data", "int64|varchar|float64|varbinary")
+	fields[0].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	return fields
+}
+
+func fieldEvent(tableName string, fields []*querypb.Field) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: tableName,
+			Fields:    fields,
+		},
+	}
+}
+
+func rowEvent(tableName string, changes ...*binlogdatapb.RowChange) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 1000,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  tableName,
+			RowChanges: changes,
+		},
+	}
+}
+
+func testRow(values ...sqltypes.Value) *querypb.Row {
+	return sqltypes.RowToProto3(values)
+}
+
+func vgtidEvent(gtid string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "ks",
+				Shard:    "0",
+				Gtid:     gtid,
+			}},
+		},
+	}
+}
+
+func TestSinkJSON(t *testing.T) {
+	row1 := testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewFloat64(1.5), sqltypes.NewVarBinary("\x01"))
+	row2 := testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("b"), sqltypes.NULL, sqltypes.NewVarBinary("\x02"))
+	reader := &fakeReader{batches: [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		fieldEvent("t1", testFields()),
+		rowEvent("t1", &binlogdatapb.RowChange{After: row1}),
+		fieldEvent("t2", sqltypes.MakeTestFields("c1", "int64")),
+		rowEvent("t2", &binlogdatapb.RowChange{After: testRow(sqltypes.NewInt64(3))}),
+		vgtidEvent("pos1"),
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, {
+		{Type: binlogdatapb.VEventType_BEGIN},
+		rowEvent("t1", &binlogdatapb.RowChange{Before: row1, After: row2}, &binlogdatapb.RowChange{Before: row2}),
+		vgtidEvent("pos2"),
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}}
+	producer := newFakeProducer()
+	sink, err := NewSink(Config{Keyspace: "ks", TopicPrefix: "vt."}, producer)
+	require.NoError(t, err)
+	require.NoError(t, sink.Run(context.Background(), reader))
+
+	assert.Equal(t, []string{"vt.ks.t1", "vt.ks.t2", "vt.ks.t1"}, producer.topics)
+	var got []string
+	for _, message := range producer.messages["vt.ks.t1"] {
+		got = append(got, fmt.Sprintf("%s %s", message.Key, message.Value))
+	}
+	want := []string{
+		`{"id":1} {"op":"insert","keyspace":"ks","table":"t1","timestamp":1000,"before":null,"after":{"data":"AQ==","id":1,"name":"a","price":1.5}}`,
+		`{"id":1} {"op":"update","keyspace":"ks","table":"t1","timestamp":1000,"before":{"data":"AQ==","id":1,"name":"a","price":1.5},"after":{"data":"Ag==","id":1,"name":"b","price":null}}`,
+		`{"id":1} {"op":"delete","keyspace":"ks","table":"t1","timestamp":1000,"before":{"data":"Ag==","id":1,"name":"b","price":null},"after":null}`,
+	}
+	assert.Equal(t, want, got)
+
+	// A table without primary key has no message key.
+	require.Len(t, producer.messages["vt.ks.t2"], 1)
+	assert.Nil(t, producer.messages["vt.ks.t2"][0].Key)
+
+	assert.Equal(t, "pos2", sink.Position().ShardGtids[0].Gtid)
+}
+
+func TestSinkPositionFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kafkasink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := Config{
+		Keyspace:     "ks",
+		PositionFile: path.Join(dir, "position"),
+	}
+
+	sink, err := NewSink(config, newFakeProducer())
+	require.NoError(t, err)
+	start, err := sink.StartPosition()
+	require.NoError(t, err)
+	want := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Gtid:     "current",
+		}},
+	}
+	assert.True(t, proto.Equal(want, start), "got %v, want %v", start, want)
+
+	reader := &fakeReader{batches: [][]*binlogdatapb.VEvent{{
+		fieldEvent("t1", testFields()),
+		vgtidEvent("pos1"),
+	}}}
+	require.NoError(t, sink.Run(context.Background(), reader))
+
+	// A new sink resumes from the saved position.
+	sink, err = NewSink(config, newFakeProducer())
+	require.NoError(t, err)
+	start, err = sink.StartPosition()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(vgtidEvent("pos1").Vgtid, start), "got %v", start)
+
+	// The position doesn't move if the messages can't be produced.
+	producer := newFakeProducer()
+	producer.err = fmt.Errorf("broker down")
+	sink, err = NewSink(config, producer)
+	require.NoError(t, err)
+	reader = &fakeReader{batches: [][]*binlogdatapb.VEvent{{
+		fieldEvent("t1", testFields()),
+		rowEvent("t1", &binlogdatapb.RowChange{After: testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NULL, sqltypes.NULL)}),
+		vgtidEvent("pos2"),
+	}}}
+	err = sink.Run(context.Background(), reader)
+	assert.EqualError(t, err, "cannot produce to ks.t1: broker down")
+	start, err = sink.StartPosition()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(vgtidEvent("pos1").Vgtid, start), "got %v", start)
+}
+
+func TestSinkErrors(t *testing.T) {
+	_, err := NewSink(Config{}, newFakeProducer())
+	assert.EqualError(t, err, "the keyspace of the sink must be specified")
+
+	_, err = NewSink(Config{Keyspace: "ks", Format: "xml"}, newFakeProducer())
+	assert.EqualError(t, err, "unknown format xml, the supported formats are json and avro")
+
+	_, err = NewSink(Config{Keyspace: "ks", Format: FormatAvro}, newFakeProducer())
+	assert.EqualError(t, err, "the avro format requires a schema registry")
+
+	sink, err := NewSink(Config{Keyspace: "ks"}, newFakeProducer())
+	require.NoError(t, err)
+	reader := &fakeReader{batches: [][]*binlogdatapb.VEvent{{
+		rowEvent("t1", &binlogdatapb.RowChange{After: testRow(sqltypes.NewInt64(1))}),
+	}}}
+	err = sink.Run(context.Background(), reader)
+	assert.EqualError(t, err, "no field event received for table t1")
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"golang.org/x/net/context"
+)
+
+// Message is a message to produce to a Kafka topic.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// Producer produces the messages of the sink to Kafka.
+type Producer interface {
+	// Produce writes the messages to the topic. It returns once
+	// the messages are acknowledged by the brokers.
+	Produce(ctx context.Context, topic string, messages []Message) error
+	// Close flushes and closes the connections to the brokers.
+	Close() error
+}
+
+// kafkaProducer produces to the brokers with one writer per topic.
+// The messages are partitioned by the hash of their keys, so the
+// changes of a row are kept in order.
+type kafkaProducer struct {
+	brokers      []string
+	batchSize    int
+	batchTimeout time.Duration
+
+	mu      sync.Mutex
+	writers map[string]*kafka.Writer
+}
+
+// NewKafkaProducer returns a Producer which writes to the brokers.
+// A write is sent to the brokers once batchSize messages are queued for
+// a partition, or after batchTimeout. Produce waits for the acks of all
+// its messages, so a transaction which doesn't fill the batches of its
+// partitions takes at least batchTimeout: it must be short, as the sink
+// produces one transaction at a time.
+func NewKafkaProducer(brokers []string, batchSize int, batchTimeout time.Duration) Producer {
+	return &kafkaProducer{
+		brokers:      brokers,
+		batchSize:    batchSize,
+		batchTimeout: batchTimeout,
+		writers:      make(map[string]*kafka.Writer),
+	}
+}
+
+func (kp *kafkaProducer) Produce(ctx context.Context, topic string, messages []Message) error {
+	kmessages := make([]kafka.Message, 0, len(messages))
+	for _, message := range messages {
+		kmessages = append(kmessages, kafka.Message{Key: message.Key, Value: message.Value})
+	}
+	return kp.writer(topic).WriteMessages(ctx, kmessages...)
+}
+
+func (kp *kafkaProducer) writer(topic string) *kafka.Writer {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	writer, ok := kp.writers[topic]
+	if !ok {
+		writer = kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      kp.brokers,
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			BatchSize:    kp.batchSize,
+			BatchTimeout: kp.batchTimeout,
+			// The position is saved once the messages are written,
+			// so they must be acknowledged by all the replicas.
+			RequiredAcks: -1,
+		})
+		kp.writers[topic] = writer
+	}
+	return writer
+}
+
+func (kp *kafkaProducer) Close() error {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	var firstErr error
+	for topic, writer := range kp.writers {
+		if err := writer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(kp.writers, topic)
+	}
+	return firstErr
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// SchemaRegistry is a client of a Confluent compatible schema registry.
+// The ids of the registered schemas are cached.
+type SchemaRegistry struct {
+	url    string
+	client *http.Client
+
+	mu  sync.Mutex
+	ids map[string]int32
+}
+
+// NewSchemaRegistry returns a client of the schema registry at url.
+func NewSchemaRegistry(url string) *SchemaRegistry {
+	return &SchemaRegistry{
+		url:    strings.TrimSuffix(url, "/"),
+		client: http.DefaultClient,
+		ids:    make(map[string]int32),
+	}
+}
+
+// Register registers the schema under the subject, and returns its id.
+// Registering a schema which is already registered returns the
+// existing id.
+func (sr *SchemaRegistry) Register(ctx context.Context, subject, schema string) (int32, error) {
+	key := subject + "\n" + schema
+	sr.mu.Lock()
+	id, ok := sr.ids[key]
+	sr.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := ctxhttp.Post(ctx, sr.client, fmt.Sprintf("%s/subjects/%s/versions", sr.url, subject), schemaRegistryContentType, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("cannot register the schema of %v: %v", subject, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("cannot register the schema of %v: %v", subject, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("cannot register the schema of %v: %v: %s", subject, resp.Status, data)
+	}
+	var result struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return 0, fmt.Errorf("cannot parse the response of the schema registry for %v: %v", subject, err)
+	}
+
+	sr.mu.Lock()
+	sr.ids[key] = result.ID
+	sr.mu.Unlock()
+	return result.ID, nil
+}
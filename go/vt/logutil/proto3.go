@@ -48,6 +48,25 @@ func TimeToProto(t time.Time) *vttimepb.Time {
 	}
 }
 
+// ProtoToDuration converts a vttimepb.Duration to a time.Duration.
+//
+// A nil pointer is like the zero duration.
+func ProtoToDuration(d *vttimepb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos)
+}
+
+// DurationToProto converts the time.Duration to a vttimepb.Duration.
+func DurationToProto(d time.Duration) *vttimepb.Duration {
+	seconds := int64(d / time.Second)
+	return &vttimepb.Duration{
+		Seconds: seconds,
+		Nanos:   int32(d - time.Duration(seconds)*time.Second),
+	}
+}
+
 // EventStream is an interface used by RPC clients when the streaming
 // RPC returns a stream of log events.
 type EventStream interface {
@@ -96,3 +96,26 @@ func TestTimeToProto(t *testing.T) {
 		}
 	}
 }
+
+func TestDurationProto(t *testing.T) {
+	durationTests := []struct {
+		pd *vttime.Duration
+		d  time.Duration
+	}{
+		{pd: &vttime.Duration{}, d: 0},
+		{pd: &vttime.Duration{Seconds: 5}, d: 5 * time.Second},
+		{pd: &vttime.Duration{Seconds: 1, Nanos: 500000000}, d: 1500 * time.Millisecond},
+		{pd: &vttime.Duration{Seconds: -1, Nanos: -500000000}, d: -1500 * time.Millisecond},
+	}
+	for i, s := range durationTests {
+		if got := ProtoToDuration(s.pd); got != s.d {
+			t.Errorf("ProtoToDuration[%v](%v) = %v, want %v", i, s.pd, got, s.d)
+		}
+		if got := DurationToProto(s.d); !proto.Equal(got, s.pd) {
+			t.Errorf("DurationToProto[%v](%v) = %v, want %v", i, s.d, got, s.pd)
+		}
+	}
+	if got := ProtoToDuration(nil); got != 0 {
+		t.Errorf("ProtoToDuration(nil) = %v, want 0", got)
+	}
+}
@@ -41,6 +41,9 @@ const (
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	// backupBinlogDir is the base of the binary logs of the
+	// incremental backups
+	backupBinlogDir = "BinLog"
 
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
@@ -107,14 +110,39 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "StartBackup failed")
 	}
 
-	be, err := GetBackupEngine()
+	be, err := GetBackupEngineFor(params.Incremental)
 	if err != nil {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
+	hooks, err := newBackupHooks(params, bh)
+	if err != nil {
+		return err
+	}
+	logger := params.Logger
+
+	// Run the preflight hook, which may abort the backup.
+	if err := hooks.run(preflightBackupHook, ""); err != nil {
+		logger.Errorf2(err, "%v hook failed, aborting the backup", preflightBackupHook)
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			logger.Errorf2(abortErr, "failed to abort backup")
+		}
+		return err
+	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
+	// The postflight hook is run before, and may still abort it.
 	usable, err := be.ExecuteBackup(ctx, params, bh)
-	logger := params.Logger
+	if usable {
+		if hookErr := hooks.run(postflightBackupHook, "complete"); hookErr != nil {
+			logger.Errorf2(hookErr, "%v hook failed", postflightBackupHook)
+			usable = false
+			err = hookErr
+		} else if annotateErr := hooks.annotate(ctx, bh); annotateErr != nil {
+			logger.Errorf2(annotateErr, "failed to record the hook errors")
+		}
+	} else if hookErr := hooks.run(postflightBackupHook, "failed"); hookErr != nil {
+		logger.Errorf2(hookErr, "%v hook failed", postflightBackupHook)
+	}
 	var finishErr error
 	if usable {
 		finishErr = bh.EndBackup(ctx)
@@ -221,6 +249,8 @@ func removeExistingFiles(cnf *Mycnf) error {
 // Restore is the main entry point for backup restore.  If there is no
 // appropriate backup on the BackupStorage, Restore logs an error
 // and returns ErrNoBackup. Any other error is returned.
+// The incremental backups taken after the restored backup are
+// replayed on top of it.
 func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error) {
 
 	if !params.DeleteBeforeRestore {
@@ -262,6 +292,9 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "ListBackups failed")
 	}
 
+	if len(bhs) == 0 && params.BackupName != "" {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backup %v in %v", params.BackupName, backupDir)
+	}
 	if len(bhs) == 0 {
 		// There are no backups (not even broken/incomplete ones).
 		params.Logger.Errorf("no backup to restore on BackupStorage for directory %v. Starting up empty.", backupDir)
@@ -341,6 +374,33 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	// Replay the incremental backups taken after the restored backup.
+	// mysqld runs with its grant tables, since the binary logs may
+	// contain grants.
+	incrementals, err := FindIncrementalBackupsToRestore(ctx, params, bhs, manifest.Position)
+	if err != nil {
+		return nil, err
+	}
+	for i, ibh := range incrementals {
+		params.Logger.Infof("Restore: replaying incremental backup %v/%v: %v", i+1, len(incrementals), ibh.Name())
+		re, err := GetRestoreEngine(ctx, ibh)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "Failed to find restore engine")
+		}
+		manifest, err = re.ExecuteRestore(ctx, params, ibh)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(incrementals) > 0 {
+		params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
+		params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
+		params.Logger.Infof("Restore: populating local_metadata after the incremental backups")
+		if err := PopulateMetadataTables(params.Mysqld, params.LocalMetadata, params.DbName); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
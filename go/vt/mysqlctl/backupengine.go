@@ -38,7 +38,7 @@ import (
 
 var (
 	// BackupEngineImplementation is the implementation to use for BackupEngine
-	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin or xtrabackup). Restores will always be done with whichever engine created a given backup.")
+	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin, xtrabackup or binlog). Restores will always be done with whichever engine created a given backup.")
 )
 
 // BackupEngine is the interface to take a backup with a given engine.
@@ -66,6 +66,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// Incremental takes an incremental backup with the binlog engine,
+	// instead of a backup with the configured engine.
+	Incremental bool
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -92,6 +95,22 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToTimestamp: if non-zero, restore the last backup taken at or before
+	// this time, and replay the incremental backups up to this time
+	RestoreToTimestamp time.Time
+	// RestoreToPos: if non-zero, restore the last backup at or before this position,
+	// and replay the incremental backups up to this position
+	RestoreToPos mysql.Position
+	// BackupName: if set, restore this full backup instead of the most
+	// recent one, and don't replay the incremental backups after it,
+	// unless restoring to a point in time
+	BackupName string
+}
+
+// PointInTime returns true if the restore stops at a timestamp or a position,
+// instead of the end of the last backup.
+func (params RestoreParams) PointInTime() bool {
+	return !params.RestoreToTimestamp.IsZero() || !params.RestoreToPos.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -126,6 +145,15 @@ func GetBackupEngine() (BackupEngine, error) {
 	return be, nil
 }
 
+// GetBackupEngineFor returns the BackupEngine of a backup: the binlog engine
+// for an incremental backup, the configured one otherwise.
+func GetBackupEngineFor(incremental bool) (BackupEngine, error) {
+	if incremental {
+		return BackupRestoreEngineMap[binlogBackupEngineName], nil
+	}
+	return GetBackupEngine()
+}
+
 // GetRestoreEngine returns the RestoreEngine implementation to restore a given backup.
 // It reads the MANIFEST file from the backup to check which engine was used to create it.
 func GetRestoreEngine(ctx context.Context, backup backupstorage.BackupHandle) (RestoreEngine, error) {
@@ -188,26 +216,53 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is set if the backup only contains the changes since
+	// FromPosition. It's restored on top of a full backup, and of the
+	// incremental backups which cover the positions before it.
+	Incremental bool
+
+	// FromPosition is the position of the previous backup, for the
+	// incremental backups.
+	FromPosition mysql.Position
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
 // It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// MANIFEST file, or the backup named in params if any.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
 	// if a StartTime is provided in params, then find a backup that was taken at or before that time
-	checkBackupTime := !params.StartTime.IsZero()
+	startTime := params.StartTime
+	if !params.RestoreToTimestamp.IsZero() {
+		startTime = params.RestoreToTimestamp
+	}
+	checkBackupTime := !startTime.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
 	for index = len(bhs) - 1; index >= 0; index-- {
 		bh = bhs[index]
+		if params.BackupName != "" && bh.Name() != params.BackupName {
+			continue
+		}
 		// Check that the backup MANIFEST exists and can be successfully decoded.
 		bm, err := GetBackupManifest(ctx, bh)
 		if err != nil {
 			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
 			continue
 		}
+		// The incremental backups are restored on top of a full backup.
+		if bm.Incremental {
+			if params.BackupName != "" {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v/%v is incremental, it can't be restored on its own", backupDir, bh.Name())
+			}
+			continue
+		}
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v at %v, after the position to restore to", backupDir, bh.Name(), bm.Position)
+			continue
+		}
 
 		var backupTime time.Time
 		if checkBackupTime {
@@ -217,14 +272,17 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
-		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
+		if !checkBackupTime /* not snapshot */ || backupTime.Equal(startTime) || backupTime.Before(startTime) {
 			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
 			break
 		}
 	}
 	if index < 0 {
+		if params.BackupName != "" {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no complete backup %v in %v", params.BackupName, backupDir)
+		}
 		if checkBackupTime {
-			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
+			params.Logger.Errorf("No valid backup found before time %v", startTime.Format(BackupTimestampFormat))
 		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
@@ -235,6 +293,66 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	return bh, nil
 }
 
+// FindIncrementalBackupsToRestore returns the incremental backups to restore
+// on top of a backup restored at the given position, in the order in which
+// they must be restored. Each backup starts at or before the position of the
+// previous one, and ends after it. If a StartTime is provided in params, the
+// backups taken after it are ignored, and if a BackupName is provided, no
+// backup is returned. For a point in time restore, the
+// backups stop with the first one which ends after the point in time, and
+// an error is returned if the backups don't reach it.
+func FindIncrementalBackupsToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle, pos mysql.Position) ([]backupstorage.BackupHandle, error) {
+	var incrementals []backupstorage.BackupHandle
+	if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+		return nil, nil
+	}
+	if params.BackupName != "" && !params.PointInTime() {
+		return nil, nil
+	}
+	checkBackupTime := !params.StartTime.IsZero() && params.RestoreToTimestamp.IsZero()
+	for _, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil || !bm.Incremental {
+			continue
+		}
+		var backupTime time.Time
+		if checkBackupTime || !params.RestoreToTimestamp.IsZero() {
+			backupTime, err = time.Parse(time.RFC3339, bm.BackupTime)
+			if err != nil {
+				params.Logger.Warningf("Restore: skipping incremental backup %v/%v with invalid time %v: %v", bh.Directory(), bh.Name(), bm.BackupTime, err)
+				continue
+			}
+		}
+		if checkBackupTime && backupTime.After(params.StartTime) {
+			continue
+		}
+		// The backups which don't start at or before the current
+		// position would leave a gap, and the backups which don't
+		// end after it have nothing to apply.
+		if !pos.AtLeast(bm.FromPosition) || pos.AtLeast(bm.Position) {
+			continue
+		}
+		params.Logger.Infof("Restore: found incremental backup %v %v from %v to %v", bh.Directory(), bh.Name(), bm.FromPosition, bm.Position)
+		incrementals = append(incrementals, bh)
+		pos = bm.Position
+
+		// The backup taken after the point in time contains it.
+		if !params.RestoreToTimestamp.IsZero() && backupTime.After(params.RestoreToTimestamp) {
+			return incrementals, nil
+		}
+		if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+			return incrementals, nil
+		}
+	}
+	switch {
+	case !params.RestoreToTimestamp.IsZero():
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no incremental backup was taken after %v, the backups end at %v", params.RestoreToTimestamp.Format(time.RFC3339), pos)
+	case !params.RestoreToPos.IsZero():
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the incremental backups end at %v, before %v", pos, params.RestoreToPos)
+	}
+	return incrementals, nil
+}
+
 func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logger logutil.Logger) error {
 	// shutdown mysqld if it is running
 	logger.Infof("Restore: shutdown mysqld")
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// preflightBackupHook is run before taking a backup, e.g. to flush
+	// an application cache.
+	preflightBackupHook = "preflight_backup"
+
+	// postflightBackupHook is run after taking a backup. If the backup
+	// succeeded, it is run before the backup is marked as complete,
+	// e.g. to snapshot a volume or notify a scheduler.
+	postflightBackupHook = "postflight_backup"
+
+	// backupHookErrorsFileName is the file of a backup where the
+	// failures of its hooks are recorded, when they don't abort it.
+	backupHookErrorsFileName = "HOOK_ERRORS"
+
+	backupHookFailureAbort    = "abort"
+	backupHookFailureAnnotate = "annotate"
+)
+
+var (
+	// backupHookFailure tells what to do when a backup hook fails.
+	backupHookFailure = flag.String("backup_hook_failure", backupHookFailureAbort, "what to do when the preflight_backup or postflight_backup hook fails: abort the backup, or annotate it with the errors in its HOOK_ERRORS file")
+)
+
+// backupHooks runs the hooks of one backup, and records their failures.
+type backupHooks struct {
+	env    map[string]string
+	errors []string
+}
+
+func newBackupHooks(params BackupParams, bh backupstorage.BackupHandle) (*backupHooks, error) {
+	switch *backupHookFailure {
+	case backupHookFailureAbort, backupHookFailureAnnotate:
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown backup_hook_failure %v, expected %v or %v", *backupHookFailure, backupHookFailureAbort, backupHookFailureAnnotate)
+	}
+	env := make(map[string]string)
+	for k, v := range params.HookExtraEnv {
+		env[k] = v
+	}
+	env["BACKUP_DIR"] = bh.Directory()
+	env["BACKUP_NAME"] = bh.Name()
+	env["BACKUP_ENGINE"] = *backupEngineImplementation
+	return &backupHooks{env: env}, nil
+}
+
+// run runs the hook, if it exists. It returns an error if the hook
+// failed and the backup must be aborted, and otherwise records it.
+func (bhs *backupHooks) run(name string, status string) error {
+	h := hook.NewSimpleHook(name)
+	h.ExtraEnv = make(map[string]string)
+	for k, v := range bhs.env {
+		h.ExtraEnv[k] = v
+	}
+	if status != "" {
+		h.ExtraEnv["BACKUP_STATUS"] = status
+	}
+	err := h.ExecuteOptional()
+	if err == nil {
+		return nil
+	}
+	if *backupHookFailure == backupHookFailureAbort {
+		return err
+	}
+	bhs.errors = append(bhs.errors, err.Error())
+	return nil
+}
+
+// annotate writes the recorded hook failures in the backup, if any.
+func (bhs *backupHooks) annotate(ctx context.Context, bh backupstorage.BackupHandle) (finalErr error) {
+	if len(bhs.errors) == 0 {
+		return nil
+	}
+	wc, err := bh.AddFile(ctx, backupHookErrorsFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupHookErrorsFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+	if _, err := fmt.Fprintln(wc, strings.Join(bhs.errors, "\n")); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupHookErrorsFileName)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+// writeBackupHook writes a hook script in the vthook directory of root.
+func writeBackupHook(t *testing.T, root, name, script string) {
+	t.Helper()
+	if err := os.MkdirAll(path.Join(root, "vthook"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(root, "vthook", name), []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// annotatedBackupHandle records the files added to the backup.
+type annotatedBackupHandle struct {
+	memBackupHandle
+	added map[string]*bytes.Buffer
+}
+
+func (abh *annotatedBackupHandle) Directory() string {
+	return "ks/0"
+}
+
+func (abh *annotatedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	buf := &bytes.Buffer{}
+	abh.added[filename] = buf
+	return nopWriteCloser{buf}, nil
+}
+
+func TestBackupHooks(t *testing.T) {
+	root, err := ioutil.TempDir("", "backup_hooks_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	defer os.Setenv("VTROOT", os.Getenv("VTROOT"))
+	os.Setenv("VTROOT", root)
+	defer func(failure string) { *backupHookFailure = failure }(*backupHookFailure)
+
+	// The hook sees the backup in its environment.
+	out := path.Join(root, "out")
+	writeBackupHook(t, root, preflightBackupHook, `echo "$BACKUP_NAME $BACKUP_STATUS $TABLET_ALIAS" > `+out)
+	writeBackupHook(t, root, postflightBackupHook, `echo "$BACKUP_STATUS failure" >&2; exit 1`)
+
+	bh := &annotatedBackupHandle{
+		memBackupHandle: memBackupHandle{files: make(map[string][]byte)},
+		added:           make(map[string]*bytes.Buffer),
+	}
+	params := BackupParams{HookExtraEnv: map[string]string{"TABLET_ALIAS": "cell-0000000100"}}
+
+	*backupHookFailure = backupHookFailureAbort
+	hooks, err := newBackupHooks(params, bh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hooks.run(preflightBackupHook, ""); err != nil {
+		t.Errorf("run(%v) failed: %v", preflightBackupHook, err)
+	}
+	data, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "mem  cell-0000000100\n"; got != want {
+		t.Errorf("%v hook environment: %q, want %q", preflightBackupHook, got, want)
+	}
+	if err := hooks.run(postflightBackupHook, "complete"); err == nil || !strings.Contains(err.Error(), "complete failure") {
+		t.Errorf("run(%v) returned %v, want the hook failure", postflightBackupHook, err)
+	}
+
+	// The failures are recorded in the backup instead.
+	*backupHookFailure = backupHookFailureAnnotate
+	hooks, err = newBackupHooks(params, bh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hooks.run(postflightBackupHook, "complete"); err != nil {
+		t.Errorf("run(%v) returned %v, want the failure to be recorded", postflightBackupHook, err)
+	}
+	if err := hooks.annotate(context.Background(), bh); err != nil {
+		t.Fatal(err)
+	}
+	if got := bh.added[backupHookErrorsFileName]; got == nil || !strings.Contains(got.String(), "complete failure") {
+		t.Errorf("%v: %v, want the hook failure", backupHookErrorsFileName, got)
+	}
+
+	// A missing hook is not a failure.
+	os.Remove(path.Join(root, "vthook", postflightBackupHook))
+	*backupHookFailure = backupHookFailureAbort
+	if err := hooks.run(postflightBackupHook, "complete"); err != nil {
+		t.Errorf("run(%v) of a missing hook failed: %v", postflightBackupHook, err)
+	}
+
+	*backupHookFailure = "ignore"
+	if _, err := newBackupHooks(params, bh); err == nil {
+		t.Errorf("newBackupHooks with backup_hook_failure=ignore succeeded, want an error")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"time"
+)
+
+// RetentionPolicy tells which backups of a shard to keep, in tiers: the
+// most recent backups, then the last backup of each of the most recent
+// days, and the last backup of each of the most recent weeks. A backup
+// retained by any tier is kept.
+type RetentionPolicy struct {
+	// Latest is the number of most recent backups to keep.
+	Latest int
+	// Dailies is the number of days whose last backup is kept.
+	Dailies int
+	// Weeklies is the number of weeks whose last backup is kept.
+	Weeklies int
+}
+
+// IsZero returns true if the policy doesn't retain any backup.
+func (p RetentionPolicy) IsZero() bool {
+	return p.Latest <= 0 && p.Dailies <= 0 && p.Weeklies <= 0
+}
+
+// Retained returns, for each of the backups taken at times, sorted
+// oldest first like ListBackups returns them, whether the policy
+// retains it. The days and weeks are in UTC, and only the days and
+// weeks with backups count.
+func (p RetentionPolicy) Retained(times []time.Time) []bool {
+	retained := make([]bool, len(times))
+	latest := 0
+	days := make(map[string]bool)
+	weeks := make(map[[2]int]bool)
+	for i := len(times) - 1; i >= 0; i-- {
+		t := times[i].UTC()
+		if latest < p.Latest {
+			latest++
+			retained[i] = true
+		}
+		// The first backup seen for a day or week is its last one.
+		day := t.Format("2006-01-02")
+		if !days[day] && len(days) < p.Dailies {
+			days[day] = true
+			retained[i] = true
+		}
+		year, week := t.ISOWeek()
+		if !weeks[[2]int{year, week}] && len(weeks) < p.Weeklies {
+			weeks[[2]int{year, week}] = true
+			retained[i] = true
+		}
+	}
+	return retained
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRetentionPolicy(t *testing.T) {
+	// Two backups a day, from Wednesday 2020-01-01 to Tuesday 2020-01-14.
+	var times []time.Time
+	start := time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC)
+	for i := 0; i < 28; i++ {
+		times = append(times, start.Add(time.Duration(i)*12*time.Hour))
+	}
+	retainedTimes := func(p RetentionPolicy) []string {
+		var result []string
+		for i, retained := range p.Retained(times) {
+			if retained {
+				result = append(result, times[i].Format("01-02T15"))
+			}
+		}
+		return result
+	}
+
+	testcases := []struct {
+		policy RetentionPolicy
+		want   []string
+	}{{
+		policy: RetentionPolicy{},
+		want:   nil,
+	}, {
+		policy: RetentionPolicy{Latest: 3},
+		want:   []string{"01-13T18", "01-14T06", "01-14T18"},
+	}, {
+		policy: RetentionPolicy{Dailies: 3},
+		want:   []string{"01-12T18", "01-13T18", "01-14T18"},
+	}, {
+		// The weeks start on Monday: 01-06 and 01-13.
+		policy: RetentionPolicy{Weeklies: 3},
+		want:   []string{"01-05T18", "01-12T18", "01-14T18"},
+	}, {
+		policy: RetentionPolicy{Latest: 2, Dailies: 2, Weeklies: 3},
+		want:   []string{"01-05T18", "01-12T18", "01-13T18", "01-14T06", "01-14T18"},
+	}, {
+		// More weeks than there are.
+		policy: RetentionPolicy{Weeklies: 10},
+		want:   []string{"01-05T18", "01-12T18", "01-14T18"},
+	}}
+	for _, tcase := range testcases {
+		if got := retainedTimes(tcase.policy); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("%+v: retained %v, want %v", tcase.policy, got, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	binlogBackupEngineName = "binlog"
+	// previousGTIDsEventType is the type of the event which starts the
+	// binary logs with the GTIDs executed before them.
+	previousGTIDsEventType = "Previous_gtids"
+)
+
+// BinlogBackupEngine takes incremental backups. It copies the binary logs
+// which contain the transactions executed since the last backup, full or
+// incremental, of the shard. It's restored by replaying the binary logs
+// on top of a restored full backup, and of the previous incremental
+// backups. It requires MySQL GTIDs.
+type BinlogBackupEngine struct {
+}
+
+// binlogBackupManifest represents an incremental backup. It lists the
+// binary logs, which contain the transactions between FromPosition and
+// Position.
+type binlogBackupManifest struct {
+	// BackupManifest is an anonymous embedding of the base manifest struct.
+	BackupManifest
+
+	// FileEntries contains the binary logs, in order.
+	FileEntries []FileEntry
+
+	// TransformHook that was used on the files, if any.
+	TransformHook string
+
+	// SkipCompress is true if the backup files were NOT compressed.
+	SkipCompress bool
+
+	// CompressionCodec is the codec the backup files were compressed with.
+	CompressionCodec string
+}
+
+// binlogFile is a binary log of mysqld.
+type binlogFile struct {
+	name string
+	// previousGTIDs is the position of mysqld when the file was started.
+	previousGTIDs mysql.Position
+}
+
+// ExecuteBackup returns a boolean that indicates if the backup is usable,
+// and an overall error.
+func (be *BinlogBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	fromPosition, err := lastBackupPosition(ctx, params)
+	if err != nil {
+		return false, err
+	}
+	if fromPosition.IsZero() {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the last backup has no replication position")
+	}
+	params.Logger.Infof("taking an incremental backup from %v", fromPosition)
+
+	// Rotate the binary logs, so the files to backup aren't written anymore.
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't flush the binary logs")
+	}
+	binlogs, err := readBinlogFiles(ctx, params.Mysqld, fromPosition.GTIDSet.Flavor())
+	if err != nil {
+		return false, err
+	}
+	names, position, err := binlogFilesToBackup(fromPosition, binlogs)
+	if err != nil {
+		return false, err
+	}
+	if position.Equal(fromPosition) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no transaction was executed since the last backup at %v", fromPosition)
+	}
+	params.Logger.Infof("found %v binary logs to backup, up to %v", len(names), position)
+
+	compression, err := backupCompression()
+	if err != nil {
+		return false, err
+	}
+	if err := be.backupFiles(ctx, params, bh, fromPosition, position, names, compression); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (be *BinlogBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fromPosition, position mysql.Position, names []string, compression string) (finalErr error) {
+	// The binary logs are copied like the files of the builtin engine.
+	builtin := &BuiltinBackupEngine{}
+	ts := newTransferStats(transferBackup)
+	fes := make([]FileEntry, len(names))
+	for i, name := range names {
+		fes[i] = FileEntry{
+			Base: backupBinlogDir,
+			Name: name,
+		}
+		if err := builtin.backupFile(ctx, params, bh, &fes[i], compression, ts, fmt.Sprintf("%v", i)); err != nil {
+			return err
+		}
+	}
+	params.Logger.Infof("Backup: copied %v", ts)
+
+	// open the MANIFEST
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+
+	// JSON-encode and write the MANIFEST
+	bm := &binlogBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: binlogBackupEngineName,
+			Position:     position,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Incremental:  true,
+			FromPosition: fromPosition,
+		},
+		FileEntries:      fes,
+		TransformHook:    *backupStorageHook,
+		SkipCompress:     compression == compressionNone,
+		CompressionCodec: compression,
+	}
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write([]byte(data)); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return nil
+}
+
+// lastBackupPosition returns the position of the last complete backup
+// of the shard. There must be a full backup to restore the incremental
+// backups on.
+func lastBackupPosition(ctx context.Context, params BackupParams) (mysql.Position, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	var position mysql.Position
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			continue
+		}
+		if position.IsZero() {
+			position = bm.Position
+		}
+		if !bm.Incremental {
+			return position, nil
+		}
+	}
+	return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "an incremental backup requires a full backup in %v", backupDir)
+}
+
+// readBinlogFiles returns the binary logs of mysqld, with the positions
+// at which they were started.
+func readBinlogFiles(ctx context.Context, mysqld MysqlDaemon, flavor string) ([]binlogFile, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list the binary logs")
+	}
+	binlogs := make([]binlogFile, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		// The Previous_gtids event follows the format description
+		// event at the start of the file.
+		events, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN '%s' LIMIT 2", name))
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't read the binary log %v", name)
+		}
+		var previousGTIDs *mysql.Position
+		for _, event := range events.Rows {
+			if len(event) < 6 || event[2].ToString() != previousGTIDsEventType {
+				continue
+			}
+			info := strings.Replace(event[5].ToString(), "\n", "", -1)
+			pos, err := mysql.ParsePosition(flavor, info)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "can't parse the previous GTIDs of the binary log %v", name)
+			}
+			previousGTIDs = &pos
+		}
+		if previousGTIDs == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the binary log %v doesn't start with the previous GTIDs, incremental backups require MySQL GTIDs", name)
+		}
+		binlogs = append(binlogs, binlogFile{
+			name:          name,
+			previousGTIDs: *previousGTIDs,
+		})
+	}
+	return binlogs, nil
+}
+
+// binlogFilesToBackup returns the binary logs which contain the transactions
+// executed after fromPosition, and the position at the end of those files.
+// The last binary log is being written, so it isn't backed up, and it was
+// started at the position of the end of the previous files.
+func binlogFilesToBackup(fromPosition mysql.Position, binlogs []binlogFile) ([]string, mysql.Position, error) {
+	if len(binlogs) == 0 {
+		return nil, mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "mysqld has no binary logs")
+	}
+	if !fromPosition.AtLeast(binlogs[0].previousGTIDs) {
+		return nil, mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the binary logs after %v were purged, the first binary log %v starts at %v", fromPosition, binlogs[0].name, binlogs[0].previousGTIDs)
+	}
+	// Start with the last file which was started before fromPosition,
+	// which contains the first transaction after it.
+	first := 0
+	for i := 1; i < len(binlogs)-1; i++ {
+		if fromPosition.AtLeast(binlogs[i].previousGTIDs) {
+			first = i
+		}
+	}
+	last := len(binlogs) - 1
+	var names []string
+	for _, binlog := range binlogs[first:last] {
+		names = append(names, binlog.name)
+	}
+	return names, binlogs[last].previousGTIDs, nil
+}
+
+// ExecuteRestore restores the binary logs of an incremental backup, and
+// replays them, up to the point in time of params if any. mysqld must be
+// running, with the backups which come before this one restored.
+func (be *BinlogBackupEngine) ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	var bm binlogBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+
+	// The binary logs are copied to a temporary directory, so they
+	// don't get mixed up with the binary logs of mysqld.
+	dir, err := ioutil.TempDir(params.Cnf.TmpDir, "restore_binlogs")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't create the directory of the binary logs")
+	}
+	defer os.RemoveAll(dir)
+	cnf := *params.Cnf
+	cnf.BinLogPath = path.Join(dir, "binlog")
+	restoreParams := params
+	restoreParams.Cnf = &cnf
+
+	params.Logger.Infof("Restore: copying %v binary logs from %v to %v", len(bm.FileEntries), bm.FromPosition, bm.Position)
+	builtin := &BuiltinBackupEngine{}
+	ts := newTransferStats(transferRestore)
+	files := make([]string, 0, len(bm.FileEntries))
+	for i := range bm.FileEntries {
+		fe := &bm.FileEntries[i]
+		name := fmt.Sprintf("%v", i)
+		if err := builtin.restoreFile(ctx, restoreParams, bh, fe, bm.TransformHook, manifestCompression(bm.CompressionCodec, bm.SkipCompress), ts, name); err != nil {
+			return nil, vterrors.Wrapf(err, "can't restore file %v to %v", name, fe.Name)
+		}
+		files = append(files, path.Join(dir, fe.Name))
+	}
+	params.Logger.Infof("Restore: copied %v", ts)
+
+	params.Logger.Infof("Restore: applying the binary logs of %v", bh.Name())
+	if err := params.Mysqld.ApplyBinlogFiles(ctx, files, params.RestoreToTimestamp, params.RestoreToPos); err != nil {
+		return nil, vterrors.Wrap(err, "can't apply the binary logs")
+	}
+	manifest := bm.BackupManifest
+	if params.PointInTime() {
+		// The binary logs were only applied up to the point in time.
+		pos, err := params.Mysqld.MasterPosition()
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't get the position after applying the binary logs")
+		}
+		manifest.Position = pos
+	}
+	params.Logger.Infof("Restore: returning replication position %v", manifest.Position)
+	return &manifest, nil
+}
+
+// ExecuteVerify downloads the binary logs of a backup, and checks them
+// against the hashes of the MANIFEST.
+func (be *BinlogBackupEngine) ExecuteVerify(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle) error {
+	var bm binlogBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return err
+	}
+	params.Logger.Infof("Verify: checking %v binary logs from %v to %v", len(bm.FileEntries), bm.FromPosition, bm.Position)
+	return verifyFiles(ctx, params, bh, bm.FileEntries, bm.TransformHook, manifestCompression(bm.CompressionCodec, bm.SkipCompress))
+}
+
+// ShouldDrainForBackup satisfies the BackupEngine interface.
+// The binary logs are copied while mysqld is running.
+func (be *BinlogBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+func init() {
+	BackupRestoreEngineMap[binlogBackupEngineName] = &BinlogBackupEngine{}
+}
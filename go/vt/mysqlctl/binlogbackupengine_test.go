@@ -0,0 +1,373 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+const testSID = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+
+func testPosition(t *testing.T, interval string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.ParsePosition("MySQL56", testSID+":"+interval)
+	if err != nil {
+		t.Fatalf("ParsePosition failed: %v", err)
+	}
+	return pos
+}
+
+func TestBinlogFilesToBackup(t *testing.T) {
+	binlogs := []binlogFile{
+		{name: "bin.000001", previousGTIDs: testPosition(t, "1-10")},
+		{name: "bin.000002", previousGTIDs: testPosition(t, "1-20")},
+		{name: "bin.000003", previousGTIDs: testPosition(t, "1-30")},
+		{name: "bin.000004", previousGTIDs: testPosition(t, "1-40")},
+	}
+	testcases := []struct {
+		from     string
+		names    []string
+		position string
+		err      string
+	}{{
+		from:     "1-10",
+		names:    []string{"bin.000001", "bin.000002", "bin.000003"},
+		position: "1-40",
+	}, {
+		from:     "1-25",
+		names:    []string{"bin.000002", "bin.000003"},
+		position: "1-40",
+	}, {
+		from:     "1-30",
+		names:    []string{"bin.000003"},
+		position: "1-40",
+	}, {
+		from:     "1-40",
+		names:    []string{"bin.000003"},
+		position: "1-40",
+	}, {
+		from: "1-5",
+		err:  "the binary logs after " + testSID + ":1-5 were purged, the first binary log bin.000001 starts at " + testSID + ":1-10",
+	}}
+	for _, tc := range testcases {
+		names, position, err := binlogFilesToBackup(testPosition(t, tc.from), binlogs)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("binlogFilesToBackup(%v): %v, want %v", tc.from, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("binlogFilesToBackup(%v) failed: %v", tc.from, err)
+			continue
+		}
+		if !reflect.DeepEqual(names, tc.names) {
+			t.Errorf("binlogFilesToBackup(%v): %v, want %v", tc.from, names, tc.names)
+		}
+		if want := testPosition(t, tc.position); !position.Equal(want) {
+			t.Errorf("binlogFilesToBackup(%v): %v, want %v", tc.from, position, want)
+		}
+	}
+}
+
+// fakeBackupHandle is a read-only backup with a MANIFEST.
+type fakeBackupHandle struct {
+	backupstorage.BackupHandle
+	name     string
+	manifest string
+}
+
+func (fbh *fakeBackupHandle) Directory() string {
+	return "ks/0"
+}
+
+func (fbh *fakeBackupHandle) Name() string {
+	return fbh.name
+}
+
+func (fbh *fakeBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	if filename != backupManifestFileName || fbh.manifest == "" {
+		return nil, fmt.Errorf("no file %v", filename)
+	}
+	return ioutil.NopCloser(strings.NewReader(fbh.manifest)), nil
+}
+
+func newFakeBackupHandle(name string, incremental bool, from, to, backupTime string) *fakeBackupHandle {
+	manifest := fmt.Sprintf(`{"BackupMethod": "builtin", "Position": "MySQL56/%s:%s", "BackupTime": "%s"}`, testSID, to, backupTime)
+	if incremental {
+		manifest = fmt.Sprintf(`{"BackupMethod": "binlog", "Incremental": true, "FromPosition": "MySQL56/%s:%s", "Position": "MySQL56/%s:%s", "BackupTime": "%s"}`, testSID, from, testSID, to, backupTime)
+	}
+	return &fakeBackupHandle{
+		name:     name,
+		manifest: manifest,
+	}
+}
+
+func TestFindIncrementalBackupsToRestore(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T00:00:00Z"),
+		newFakeBackupHandle("inc1", true, "1-5", "1-8", "2020-01-01T01:00:00Z"),
+		newFakeBackupHandle("inc2", true, "1-10", "1-20", "2020-01-01T02:00:00Z"),
+		// Incomplete backup.
+		&fakeBackupHandle{name: "inc3"},
+		newFakeBackupHandle("inc4", true, "1-15", "1-30", "2020-01-01T04:00:00Z"),
+		newFakeBackupHandle("full2", false, "", "1-25", "2020-01-01T05:00:00Z"),
+		// There is a gap between 1-30 and 1-35.
+		newFakeBackupHandle("inc5", true, "1-35", "1-40", "2020-01-01T06:00:00Z"),
+	}
+	params := RestoreParams{
+		Logger: logutil.NewMemoryLogger(),
+	}
+	find := func(params RestoreParams, pos string) []string {
+		t.Helper()
+		bhs, err := FindIncrementalBackupsToRestore(context.Background(), params, bhs, testPosition(t, pos))
+		if err != nil {
+			t.Fatalf("FindIncrementalBackupsToRestore failed: %v", err)
+		}
+		var result []string
+		for _, bh := range bhs {
+			result = append(result, bh.Name())
+		}
+		return result
+	}
+
+	if got, want := find(params, "1-10"), []string{"inc2", "inc4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want %v", got, want)
+	}
+	if got, want := find(params, "1-25"), []string{"inc4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want %v", got, want)
+	}
+
+	// The backups taken after the start time are ignored.
+	params.StartTime = time.Date(2020, 1, 1, 3, 0, 0, 0, time.UTC)
+	if got, want := find(params, "1-10"), []string{"inc2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want %v", got, want)
+	}
+
+	// A named backup is restored on its own.
+	params = RestoreParams{
+		Logger:     logutil.NewMemoryLogger(),
+		BackupName: "full1",
+	}
+	if got := find(params, "1-10"); len(got) != 0 {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want none", got)
+	}
+}
+
+func TestFindBackupToRestore(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T00:00:00Z"),
+		newFakeBackupHandle("inc1", true, "1-10", "1-20", "2020-01-01T01:00:00Z"),
+		newFakeBackupHandle("full2", false, "", "1-30", "2020-01-01T02:00:00Z"),
+		// Incomplete backup.
+		&fakeBackupHandle{name: "full3"},
+	}
+	ctx := context.Background()
+	testcases := []struct {
+		backupName string
+		startTime  time.Time
+		want       string
+		err        string
+	}{{
+		want: "full2",
+	}, {
+		startTime: time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC),
+		want:      "full1",
+	}, {
+		startTime: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		err:       ErrNoCompleteBackup.Error(),
+	}, {
+		backupName: "full1",
+		want:       "full1",
+	}, {
+		backupName: "full2",
+		startTime:  time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC),
+		err:        "no complete backup full2 in ks/0",
+	}, {
+		backupName: "inc1",
+		err:        "backup ks/0/inc1 is incremental, it can't be restored on its own",
+	}, {
+		backupName: "full3",
+		err:        "no complete backup full3 in ks/0",
+	}, {
+		backupName: "full4",
+		err:        "no complete backup full4 in ks/0",
+	}}
+	for _, tc := range testcases {
+		params := RestoreParams{
+			Logger:     logutil.NewMemoryLogger(),
+			Keyspace:   "ks",
+			Shard:      "0",
+			BackupName: tc.backupName,
+			StartTime:  tc.startTime,
+		}
+		bh, err := FindBackupToRestore(ctx, params, bhs)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("FindBackupToRestore(%q, %v): %v, want %v", tc.backupName, tc.startTime, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("FindBackupToRestore(%q, %v) failed: %v", tc.backupName, tc.startTime, err)
+			continue
+		}
+		if bh.Name() != tc.want {
+			t.Errorf("FindBackupToRestore(%q, %v): %v, want %v", tc.backupName, tc.startTime, bh.Name(), tc.want)
+		}
+	}
+}
+
+func TestFindIncrementalBackupsToRestorePointInTime(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T00:00:00Z"),
+		newFakeBackupHandle("inc1", true, "1-10", "1-20", "2020-01-01T01:00:00Z"),
+		newFakeBackupHandle("inc2", true, "1-20", "1-30", "2020-01-01T02:00:00Z"),
+		newFakeBackupHandle("inc3", true, "1-30", "1-40", "2020-01-01T03:00:00Z"),
+	}
+	ctx := context.Background()
+	names := func(bhs []backupstorage.BackupHandle) []string {
+		var result []string
+		for _, bh := range bhs {
+			result = append(result, bh.Name())
+		}
+		return result
+	}
+
+	// The backup taken after the timestamp contains it.
+	params := RestoreParams{
+		Logger:             logutil.NewMemoryLogger(),
+		RestoreToTimestamp: time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC),
+	}
+	got, err := FindIncrementalBackupsToRestore(ctx, params, bhs, testPosition(t, "1-10"))
+	if err != nil {
+		t.Fatalf("FindIncrementalBackupsToRestore failed: %v", err)
+	}
+	if want := []string{"inc1", "inc2"}; !reflect.DeepEqual(names(got), want) {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want %v", names(got), want)
+	}
+
+	// No backup was taken after the timestamp.
+	params.RestoreToTimestamp = time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC)
+	_, err = FindIncrementalBackupsToRestore(ctx, params, bhs, testPosition(t, "1-10"))
+	if err == nil || !strings.Contains(err.Error(), "no incremental backup was taken after 2020-01-01T04:00:00Z, the backups end at "+testSID+":1-40") {
+		t.Errorf("FindIncrementalBackupsToRestore: %v", err)
+	}
+
+	// The backups stop with the first one which contains the position.
+	params = RestoreParams{
+		Logger:       logutil.NewMemoryLogger(),
+		RestoreToPos: testPosition(t, "1-25"),
+	}
+	got, err = FindIncrementalBackupsToRestore(ctx, params, bhs, testPosition(t, "1-10"))
+	if err != nil {
+		t.Fatalf("FindIncrementalBackupsToRestore failed: %v", err)
+	}
+	if want := []string{"inc1", "inc2"}; !reflect.DeepEqual(names(got), want) {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, want %v", names(got), want)
+	}
+	// The restored backup already contains the position.
+	got, err = FindIncrementalBackupsToRestore(ctx, params, bhs, testPosition(t, "1-30"))
+	if err != nil || len(got) != 0 {
+		t.Errorf("FindIncrementalBackupsToRestore: %v, %v", names(got), err)
+	}
+
+	params.RestoreToPos = testPosition(t, "1-50")
+	_, err = FindIncrementalBackupsToRestore(ctx, params, bhs, testPosition(t, "1-10"))
+	if err == nil || !strings.Contains(err.Error(), "the incremental backups end at "+testSID+":1-40, before "+testSID+":1-50") {
+		t.Errorf("FindIncrementalBackupsToRestore: %v", err)
+	}
+}
+
+func TestFindBackupToRestorePointInTime(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T00:00:00Z"),
+		newFakeBackupHandle("full2", false, "", "1-30", "2020-01-01T02:00:00Z"),
+	}
+	params := RestoreParams{
+		Logger:       logutil.NewMemoryLogger(),
+		Keyspace:     "ks",
+		Shard:        "0",
+		RestoreToPos: testPosition(t, "1-20"),
+	}
+	bh, err := FindBackupToRestore(context.Background(), params, bhs)
+	if err != nil {
+		t.Fatalf("FindBackupToRestore failed: %v", err)
+	}
+	if bh.Name() != "full1" {
+		t.Errorf("FindBackupToRestore: %v, want full1", bh.Name())
+	}
+
+	params.RestoreToPos = mysql.Position{}
+	params.RestoreToTimestamp = time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	bh, err = FindBackupToRestore(context.Background(), params, bhs)
+	if err != nil {
+		t.Fatalf("FindBackupToRestore failed: %v", err)
+	}
+	if bh.Name() != "full1" {
+		t.Errorf("FindBackupToRestore: %v, want full1", bh.Name())
+	}
+}
+
+func TestFindBackupToRestoreSkipsIncrementals(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T00:00:00Z"),
+		newFakeBackupHandle("inc1", true, "1-10", "1-20", "2020-01-01T01:00:00Z"),
+	}
+	params := RestoreParams{
+		Logger:   logutil.NewMemoryLogger(),
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	bh, err := FindBackupToRestore(context.Background(), params, bhs)
+	if err != nil {
+		t.Fatalf("FindBackupToRestore failed: %v", err)
+	}
+	if bh.Name() != "full1" {
+		t.Errorf("FindBackupToRestore: %v, want full1", bh.Name())
+	}
+}
+
+func TestGetBackupEngineFor(t *testing.T) {
+	// An incremental backup uses the binlog engine, whatever the
+	// configured engine.
+	be, err := GetBackupEngineFor(true)
+	if err != nil {
+		t.Fatalf("GetBackupEngineFor(true) failed: %v", err)
+	}
+	if _, ok := be.(*BinlogBackupEngine); !ok {
+		t.Errorf("GetBackupEngineFor(true) = %T, want *BinlogBackupEngine", be)
+	}
+	be, err = GetBackupEngineFor(false)
+	if err != nil {
+		t.Fatalf("GetBackupEngineFor(false) failed: %v", err)
+	}
+	if _, ok := be.(*BuiltinBackupEngine); !ok {
+		t.Errorf("GetBackupEngineFor(false) = %T, want *BuiltinBackupEngine", be)
+	}
+}
@@ -27,7 +27,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -72,6 +71,11 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionCodec is the codec the backup files were compressed
+	// with. It is empty for the backups taken before the field existed,
+	// which were compressed with pgzip unless SkipCompress is set.
+	CompressionCodec string
 }
 
 // FileEntry is one file to backup
@@ -80,6 +84,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for files that go into the directory of Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -100,6 +105,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -128,7 +135,11 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	compression, err := backupCompression()
+	if err != nil {
+		return false, err
+	}
+	params.Logger.Infof("Hook: %v, Compression: %v", *backupStorageHook, compression)
 
 	// Save initial state so we can restore.
 	slaveStartRequired := false
@@ -139,7 +150,8 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 
 	// See if we need to restart replication after backup.
 	params.Logger.Infof("getting current replication status")
-	slaveStatus, err := params.Mysqld.SlaveStatus()
+	var slaveStatus mysql.SlaveStatus
+	slaveStatus, err = params.Mysqld.SlaveStatus()
 	switch err {
 	case nil:
 		slaveStartRequired = slaveStatus.SlaveRunning()
@@ -188,7 +200,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 
 	// Backup everything, capture the error.
-	backupErr := be.backupFiles(ctx, params, bh, replicationPosition)
+	backupErr := be.backupFiles(ctx, params, bh, replicationPosition, compression)
 	usable := backupErr == nil
 
 	// Try to restart mysqld, use background context in case we timed out the original context
@@ -264,7 +276,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position, compression string) (finalErr error) {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -272,9 +284,10 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	if err != nil {
 		return vterrors.Wrap(err, "can't find files to backup")
 	}
-	params.Logger.Infof("found %v files to backup", len(fes))
+	params.Logger.Infof("found %v files to backup, copying %v at once", len(fes), params.Concurrency)
 
 	// Backup with the provided concurrency.
+	ts := newTransferStats(transferBackup)
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -293,7 +306,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], compression, ts, name))
 		}(i)
 	}
 
@@ -301,6 +314,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	if rec.HasErrors() {
 		return rec.Error()
 	}
+	params.Logger.Infof("Backup: copied %v", ts)
 
 	// open the MANIFEST
 	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
@@ -324,9 +338,10 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		},
 
 		// Builtin-specific fields
-		FileEntries:   fes,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
+		FileEntries:      fes,
+		TransformHook:    *backupStorageHook,
+		SkipCompress:     compression == compressionNone,
+		CompressionCodec: compression,
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -340,7 +355,13 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 }
 
 // backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+// The file is compressed with the compression codec, and its copy is
+// accounted in ts.
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, compression string, ts *transferStats, name string) (finalErr error) {
+	// The file is accounted once all the deferred closes are done.
+	fileDone := ts.startFile()
+	defer func() { fileDone(finalErr) }()
+
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -369,7 +390,7 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 			}
 		}
 	}(name, fe.Name)
-	dst := bufio.NewWriterSize(wc, writerBufferSize)
+	dst := bufio.NewWriterSize(throttleWriter(ctx, wc, ts.limiter), writerBufferSize)
 
 	// Create the hasher and the tee on top.
 	hasher := newHasher()
@@ -388,28 +409,27 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pgzip.Writer
-	if *backupStorageCompress {
-		gzip, err = pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
+	if compression != compressionNone {
+		compressor, err = newCompressor(compression, writer)
 		if err != nil {
-			return vterrors.Wrap(err, "cannot create gziper")
+			return err
 		}
-		gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-		writer = gzip
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compressor,
 	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	_, err = io.Copy(writer, ts.reader(source))
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrapf(err, "cannot close %v compressor", compression)
 		}
 	}
 
@@ -457,7 +477,7 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 		return nil, err
 	}
 
-	params.Logger.Infof("Restore: copying %v files", len(bm.FileEntries))
+	params.Logger.Infof("Restore: copying %v files, %v at once", len(bm.FileEntries), params.Concurrency)
 
 	if err := be.restoreFiles(context.Background(), params, bh, bm); err != nil {
 		// don't delete the file here because that is how we detect an interrupted restore
@@ -472,6 +492,7 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+	ts := newTransferStats(transferRestore)
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -491,18 +512,27 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, manifestCompression(bm.CompressionCodec, bm.SkipCompress), ts, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
 		}(i)
 	}
 	wg.Wait()
-	return rec.Error()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+	params.Logger.Infof("Restore: copied %v", ts)
+	return nil
 }
 
-// restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+// restoreFile restores an individual file, which was compressed with the
+// compression codec. Its copy is accounted in ts.
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compression string, ts *transferStats, name string) (finalErr error) {
+	// The file is accounted once all the deferred closes are done.
+	fileDone := ts.startFile()
+	defer func() { fileDone(finalErr) }()
+
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -533,8 +563,8 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
-	reader := io.TeeReader(source, hasher)
+	// and into the decompressor.
+	reader := io.TeeReader(throttleReader(ctx, source, ts.limiter), hasher)
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
@@ -548,26 +578,26 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if compression != compressionNone {
+		decompressor, err := newDecompressor(compression, reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return err
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close %v decompressor %v: %v", compression, name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrapf(cerr, "failed to close %v decompressor", compression)
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
-	if _, err = io.Copy(dst, reader); err != nil {
+	if _, err = io.Copy(dst, ts.reader(reader)); err != nil {
 		return vterrors.Wrap(err, "failed to copy file contents")
 	}
 
@@ -625,6 +655,17 @@ func getMasterPosition(ctx context.Context, tmc tmclient.TabletManagerClient, ts
 	return pos, nil
 }
 
+// ExecuteVerify downloads the files of a backup, and checks them against
+// the hashes of the MANIFEST.
+func (be *BuiltinBackupEngine) ExecuteVerify(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle) error {
+	var bm builtinBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return err
+	}
+	params.Logger.Infof("Verify: checking %v files", len(bm.FileEntries))
+	return verifyFiles(ctx, params, bh, bm.FileEntries, bm.TransformHook, manifestCompression(bm.CompressionCodec, bm.SkipCompress))
+}
+
 func init() {
 	BackupRestoreEngineMap["builtin"] = &BuiltinBackupEngine{}
 }
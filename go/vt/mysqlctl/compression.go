@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"flag"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// compressionPgzip compresses the backup files with parallel gzip.
+	// It is what the backups used before the codec was recorded in the
+	// MANIFEST.
+	compressionPgzip = "pgzip"
+
+	// compressionZstd compresses the backup files with zstd.
+	compressionZstd = "zstd"
+
+	// compressionLz4 compresses the backup files with lz4.
+	compressionLz4 = "lz4"
+
+	// compressionNone leaves the backup files uncompressed.
+	compressionNone = "none"
+)
+
+var (
+	// backupCompressionCodec is the codec used to compress new backups.
+	// The codec is recorded in the MANIFEST, so backups taken with any
+	// codec can be restored.
+	backupCompressionCodec = flag.String("backup_storage_compression_codec", compressionPgzip, "if backup_storage_compress is true, the codec used to compress the backup files: pgzip, zstd, lz4 or none")
+
+	// backupCompressionLevel is the compression level of the codec. 0
+	// selects the fastest level of each codec.
+	backupCompressionLevel = flag.Int("backup_storage_compression_level", 0, "if backup_storage_compress is true, the compression level of backup_storage_compression_codec. 0 selects the fastest level of the codec (default is 0).")
+)
+
+// backupCompression returns the codec to use for a new backup, as
+// specified by the flags.
+func backupCompression() (string, error) {
+	if !*backupStorageCompress {
+		return compressionNone, nil
+	}
+	switch *backupCompressionCodec {
+	case compressionPgzip, compressionZstd, compressionLz4, compressionNone:
+		return *backupCompressionCodec, nil
+	}
+	return "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown backup compression codec %q, expected pgzip, zstd, lz4 or none", *backupCompressionCodec)
+}
+
+// manifestCompression returns the codec a backup was compressed with,
+// from the fields of its MANIFEST. Backups taken before the codec was
+// recorded were compressed with pgzip, unless skipCompress is set.
+func manifestCompression(codec string, skipCompress bool) string {
+	if skipCompress {
+		return compressionNone
+	}
+	if codec == "" {
+		return compressionPgzip
+	}
+	return codec
+}
+
+// compressionExtension returns the file name extension of the files
+// compressed with codec.
+func compressionExtension(codec string) string {
+	switch codec {
+	case compressionPgzip:
+		return ".gz"
+	case compressionZstd:
+		return ".zst"
+	case compressionLz4:
+		return ".lz4"
+	}
+	return ""
+}
+
+// newCompressor returns a writer compressing into w with codec. The
+// returned writer must be closed to flush the compressed data.
+func newCompressor(codec string, w io.Writer) (io.WriteCloser, error) {
+	switch codec {
+	case compressionPgzip:
+		level := *backupCompressionLevel
+		if level == 0 {
+			level = pgzip.BestSpeed
+		}
+		gzip, err := pgzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create gzip compressor")
+		}
+		if err := gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+			return nil, vterrors.Wrap(err, "cannot set gzip compressor concurrency")
+		}
+		return gzip, nil
+	case compressionZstd:
+		level := zstd.SpeedFastest
+		if *backupCompressionLevel != 0 {
+			level = zstd.EncoderLevelFromZstd(*backupCompressionLevel)
+		}
+		enc, err := zstd.NewWriter(w, zstd.WithEncoderLevel(level), zstd.WithEncoderConcurrency(*backupCompressBlocks))
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create zstd compressor")
+		}
+		return enc, nil
+	case compressionLz4:
+		lw := lz4.NewWriter(w)
+		lw.Header.CompressionLevel = *backupCompressionLevel
+		return lw.WithConcurrency(*backupCompressBlocks), nil
+	case compressionNone:
+		return nopWriteCloser{w}, nil
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression codec %q", codec)
+}
+
+// newDecompressor returns a reader decompressing r with codec.
+func newDecompressor(codec string, r io.Reader) (io.ReadCloser, error) {
+	switch codec {
+	case compressionPgzip:
+		gz, err := pgzip.NewReader(r)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+		}
+		return gz, nil
+	case compressionZstd:
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open zstd decompressor")
+		}
+		return dec.IOReadCloser(), nil
+	case compressionLz4:
+		return ioutil.NopCloser(lz4.NewReader(r)), nil
+	case compressionNone:
+		return ioutil.NopCloser(r), nil
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression codec %q", codec)
+}
+
+// nopWriteCloser is an io.WriteCloser whose Close does nothing.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := []byte(strings.Repeat("vitess backup compression ", 10000))
+	for _, codec := range []string{compressionPgzip, compressionZstd, compressionLz4, compressionNone} {
+		for _, level := range []int{0, 3} {
+			*backupCompressionLevel = level
+
+			buf := &bytes.Buffer{}
+			compressor, err := newCompressor(codec, buf)
+			if err != nil {
+				t.Fatalf("newCompressor(%v) failed: %v", codec, err)
+			}
+			if _, err := compressor.Write(data); err != nil {
+				t.Fatalf("%v: Write failed: %v", codec, err)
+			}
+			if err := compressor.Close(); err != nil {
+				t.Fatalf("%v: Close failed: %v", codec, err)
+			}
+			if codec != compressionNone && buf.Len() >= len(data) {
+				t.Errorf("%v level %v: compressed to %v bytes, expected less than %v", codec, level, buf.Len(), len(data))
+			}
+
+			decompressor, err := newDecompressor(codec, buf)
+			if err != nil {
+				t.Fatalf("newDecompressor(%v) failed: %v", codec, err)
+			}
+			got, err := ioutil.ReadAll(decompressor)
+			if err != nil {
+				t.Fatalf("%v: ReadAll failed: %v", codec, err)
+			}
+			if err := decompressor.Close(); err != nil {
+				t.Fatalf("%v: Close failed: %v", codec, err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("%v level %v: got %v bytes after the round trip, expected the %v original bytes", codec, level, len(got), len(data))
+			}
+		}
+	}
+	*backupCompressionLevel = 0
+}
+
+func TestBackupCompression(t *testing.T) {
+	defer func(codec string, compress bool) {
+		*backupCompressionCodec = codec
+		*backupStorageCompress = compress
+	}(*backupCompressionCodec, *backupStorageCompress)
+
+	testcases := []struct {
+		codec    string
+		compress bool
+		want     string
+		wantErr  string
+	}{{
+		codec:    compressionPgzip,
+		compress: true,
+		want:     compressionPgzip,
+	}, {
+		codec:    compressionZstd,
+		compress: true,
+		want:     compressionZstd,
+	}, {
+		codec:    compressionZstd,
+		compress: false,
+		want:     compressionNone,
+	}, {
+		codec:    "snappy",
+		compress: true,
+		wantErr:  `unknown backup compression codec "snappy"`,
+	}}
+	for _, tcase := range testcases {
+		*backupCompressionCodec = tcase.codec
+		*backupStorageCompress = tcase.compress
+		got, err := backupCompression()
+		if tcase.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.wantErr) {
+				t.Errorf("backupCompression(%v, %v): %v, want %v", tcase.codec, tcase.compress, err, tcase.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("backupCompression(%v, %v) failed: %v", tcase.codec, tcase.compress, err)
+			continue
+		}
+		if got != tcase.want {
+			t.Errorf("backupCompression(%v, %v): %v, want %v", tcase.codec, tcase.compress, got, tcase.want)
+		}
+	}
+}
+
+func TestManifestCompression(t *testing.T) {
+	testcases := []struct {
+		codec        string
+		skipCompress bool
+		want         string
+	}{{
+		// Backups taken before the codec was recorded.
+		codec: "",
+		want:  compressionPgzip,
+	}, {
+		codec:        "",
+		skipCompress: true,
+		want:         compressionNone,
+	}, {
+		codec: compressionLz4,
+		want:  compressionLz4,
+	}}
+	for _, tcase := range testcases {
+		if got := manifestCompression(tcase.codec, tcase.skipCompress); got != tcase.want {
+			t.Errorf("manifestCompression(%q, %v): %v, want %v", tcase.codec, tcase.skipCompress, got, tcase.want)
+		}
+	}
+}
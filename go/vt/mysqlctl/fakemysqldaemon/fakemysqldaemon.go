@@ -18,6 +18,7 @@ package fakemysqldaemon
 
 import (
 	"fmt"
+	"path"
 	"reflect"
 	"strings"
 	"time"
@@ -412,6 +413,15 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFiles is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFiles(ctx context.Context, files []string, restoreToTimestamp time.Time, restoreToPos mysql.Position) error {
+	var queries []string
+	for _, file := range files {
+		queries = append(queries, "FAKE APPLY BINLOG "+path.Base(file))
+	}
+	return fmd.ExecuteSuperQueryList(ctx, queries)
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
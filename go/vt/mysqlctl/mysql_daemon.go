@@ -17,6 +17,8 @@ limitations under the License.
 package mysqlctl
 
 import (
+	"time"
+
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
@@ -95,6 +97,11 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFiles replays the binary logs, to restore the
+	// incremental backups. If they are set, the transactions after
+	// restoreToTimestamp, or not in restoreToPos, are skipped.
+	ApplyBinlogFiles(ctx context.Context, files []string, restoreToTimestamp time.Time, restoreToPos mysql.Position) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
@@ -1045,6 +1045,61 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFiles replays the binary logs with mysqlbinlog, piped into
+// the mysql client with the dba credentials. With GTIDs, the transactions
+// which were already executed are skipped. The point in time is passed to
+// mysqlbinlog as --stop-datetime, in the local time of the server, and as
+// --include-gtids.
+func (mysqld *Mysqld) ApplyBinlogFiles(ctx context.Context, files []string, restoreToTimestamp time.Time, restoreToPos mysql.Position) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	name, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.Dba().MysqlParams()
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	if !restoreToTimestamp.IsZero() {
+		args = append(args, "--stop-datetime="+restoreToTimestamp.Local().Format("2006-01-02 15:04:05"))
+	}
+	if !restoreToPos.IsZero() {
+		args = append(args, "--include-gtids="+restoreToPos.GTIDSet.String())
+	}
+	args = append(args, files...)
+	log.Infof("ApplyBinlogFiles: %v %v", name, args)
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = env
+	cmd.Dir = dir
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := mysqld.executeMysqlScript(params, stdout); err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%v: %v, output: %v", name, err, stderr.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
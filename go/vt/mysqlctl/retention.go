@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// PruneBackups removes the backups of dir which are not retained by
+// policy, and returns their names. With dryRun, the backups are only
+// listed.
+//
+// The policy selects among the full backups. The incremental backups are
+// restored on top of a full backup, each one from the position of the
+// previous one: the chains of incremental backups which a restore would
+// apply on top of a retained full backup are kept whole, and the other
+// incremental backups are removed. The backups without a valid MANIFEST
+// may be in progress, and are kept.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, dir string, policy backupstorage.RetentionPolicy, dryRun bool, logger logutil.Logger) ([]string, error) {
+	if policy.IsZero() {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "the retention policy must retain at least one backup")
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list backups")
+	}
+
+	var fulls []backupstorage.BackupHandle
+	var fullTimes []time.Time
+	var fullPositions []mysql.Position
+	var incrementals []string
+	for _, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			logger.Warningf("Keeping possibly incomplete backup %v/%v: can't read MANIFEST: %v", dir, bh.Name(), err)
+			continue
+		}
+		if bm.Incremental {
+			incrementals = append(incrementals, bh.Name())
+			continue
+		}
+		backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+		if err != nil {
+			logger.Warningf("Keeping backup %v/%v with invalid time %v: %v", dir, bh.Name(), bm.BackupTime, err)
+			continue
+		}
+		fulls = append(fulls, bh)
+		fullTimes = append(fullTimes, backupTime)
+		fullPositions = append(fullPositions, bm.Position)
+	}
+	if len(fulls) == 0 {
+		logger.Infof("No full backup in %v, nothing to prune", dir)
+		return nil, nil
+	}
+
+	retainedFulls := policy.Retained(fullTimes)
+	removed := make(map[string]bool)
+	chained := make(map[string]bool)
+	// The chains are found as a restore does, without logging it.
+	chainParams := RestoreParams{Logger: logutil.NewMemoryLogger()}
+	for i, bh := range fulls {
+		if !retainedFulls[i] {
+			removed[bh.Name()] = true
+			continue
+		}
+		chain, err := FindIncrementalBackupsToRestore(ctx, chainParams, bhs, fullPositions[i])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't find the incremental backups of %v/%v", dir, bh.Name())
+		}
+		for _, inc := range chain {
+			chained[inc.Name()] = true
+		}
+	}
+	for _, name := range incrementals {
+		if !chained[name] {
+			removed[name] = true
+		}
+	}
+
+	// Remove the backups oldest first, so an interrupted prune doesn't
+	// leave incremental backups without the backups before them.
+	var names []string
+	for _, bh := range bhs {
+		if !removed[bh.Name()] {
+			logger.Infof("Keeping backup %v/%v", dir, bh.Name())
+			continue
+		}
+		names = append(names, bh.Name())
+		if dryRun {
+			logger.Infof("Would remove backup %v/%v", dir, bh.Name())
+			continue
+		}
+		logger.Infof("Removing backup %v/%v", dir, bh.Name())
+		if err := bs.RemoveBackup(ctx, dir, bh.Name()); err != nil {
+			return names, vterrors.Wrapf(err, "can't remove backup %v/%v", dir, bh.Name())
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// fakeBackupStorage lists its backups, and records the removed ones.
+type fakeBackupStorage struct {
+	backupstorage.BackupStorage
+	bhs     []backupstorage.BackupHandle
+	removed []string
+}
+
+func (fbs *fakeBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
+	return fbs.bhs, nil
+}
+
+func (fbs *fakeBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	fbs.removed = append(fbs.removed, name)
+	return nil
+}
+
+func TestPruneBackups(t *testing.T) {
+	newStorage := func() *fakeBackupStorage {
+		return &fakeBackupStorage{
+			bhs: []backupstorage.BackupHandle{
+				newFakeBackupHandle("full1", false, "", "1-10", "2020-01-01T01:00:00Z"),
+				newFakeBackupHandle("inc1", true, "1-10", "1-15", "2020-01-01T02:00:00Z"),
+				newFakeBackupHandle("full2", false, "", "1-20", "2020-01-02T01:00:00Z"),
+				newFakeBackupHandle("inc2", true, "1-20", "1-25", "2020-01-02T02:00:00Z"),
+				// In progress.
+				&fakeBackupHandle{name: "full3"},
+				newFakeBackupHandle("full4", false, "", "1-30", "2020-01-02T03:00:00Z"),
+				newFakeBackupHandle("inc3", true, "1-30", "1-35", "2020-01-02T04:00:00Z"),
+				newFakeBackupHandle("inc4", true, "1-35", "1-40", "2020-01-02T05:00:00Z"),
+				// It doesn't follow any backup.
+				newFakeBackupHandle("inc5", true, "1-45", "1-50", "2020-01-02T06:00:00Z"),
+			},
+		}
+	}
+	logger := logutil.NewMemoryLogger()
+
+	// full1 is the last backup of 01-01, and full4 of 01-02.
+	fbs := newStorage()
+	policy := backupstorage.RetentionPolicy{Dailies: 1}
+	names, err := PruneBackups(context.Background(), fbs, "ks/0", policy, false, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"full1", "inc1", "full2", "inc2", "inc5"}
+	if !reflect.DeepEqual(names, want) || !reflect.DeepEqual(fbs.removed, want) {
+		t.Errorf("PruneBackups(%+v): %v, removed %v, want %v", policy, names, fbs.removed, want)
+	}
+
+	// The chains of incremental backups of full1 and full4 are kept,
+	// inc2 was taken after full1 but follows full2.
+	fbs = newStorage()
+	policy = backupstorage.RetentionPolicy{Latest: 1, Dailies: 2}
+	names, err = PruneBackups(context.Background(), fbs, "ks/0", policy, false, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"full2", "inc2", "inc5"}
+	if !reflect.DeepEqual(names, want) || !reflect.DeepEqual(fbs.removed, want) {
+		t.Errorf("PruneBackups(%+v): %v, removed %v, want %v", policy, names, fbs.removed, want)
+	}
+
+	// Dry run.
+	fbs = newStorage()
+	policy = backupstorage.RetentionPolicy{Latest: 1}
+	names, err = PruneBackups(context.Background(), fbs, "ks/0", policy, true, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"full1", "inc1", "full2", "inc2", "inc5"}
+	if !reflect.DeepEqual(names, want) || len(fbs.removed) != 0 {
+		t.Errorf("PruneBackups(%+v, dry run): %v, removed %v, want %v and nothing removed", policy, names, fbs.removed, want)
+	}
+
+	// A policy must retain a backup.
+	if _, err := PruneBackups(context.Background(), newStorage(), "ks/0", backupstorage.RetentionPolicy{}, false, logger); err == nil {
+		t.Errorf("PruneBackups with an empty policy succeeded, want an error")
+	}
+}
@@ -24,10 +24,13 @@ limitations under the License.
 package s3backupstorage
 
 import (
+	"crypto/md5"
 	"crypto/tls"
+	"encoding/base64"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"net/http"
 	"sort"
@@ -42,6 +45,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 )
@@ -71,12 +75,25 @@ var (
 	requiredLogLevel = flag.String("s3_backup_log_level", "LogOff", "determine the S3 loglevel to use from LogOff, LogDebug, LogDebugWithSigning, LogDebugWithHTTPBody, LogDebugWithRequestRetries, LogDebugWithRequestErrors")
 
 	// sse is the server-side encryption algorithm used when storing this object in S3
-	sse = flag.String("s3_backup_server_side_encryption", "", "server-side encryption algorithm (e.g., AES256, aws:kms)")
+	sse = flag.String("s3_backup_server_side_encryption", "", "server-side encryption algorithm (e.g., AES256, aws:kms, sse_c:/path/to/key/file)")
+
+	// sseKMSKeyID is the KMS key used by the aws:kms encryption
+	sseKMSKeyID = flag.String("s3_backup_sse_kms_key_id", "", "KMS key id used by the aws:kms server-side encryption, the default key of the account is used if empty")
+
+	// sseKMSKeyIDs are the KMS keys of the buckets, which override sseKMSKeyID
+	sseKMSKeyIDs flagutil.StringMapValue
 
 	// path component delimiter
 	delimiter = "/"
 )
 
+// sseCustomerKeyPrefix prefixes the file of the key of the SSE-C encryption,
+// which is an AES256 key of 32 bytes.
+const (
+	sseCustomerKeyPrefix = "sse_c:"
+	sseCustomerKeySize   = 32
+)
+
 type logNameToLogLevel map[string]aws.LogLevelType
 
 var logNameMap logNameToLogLevel
@@ -84,6 +101,7 @@ var logNameMap logNameToLogLevel
 // S3BackupHandle implements the backupstorage.BackupHandle interface.
 type S3BackupHandle struct {
 	client    *s3.S3
+	sse       *sseParams
 	bs        *S3BackupStorage
 	dir       string
 	name      string
@@ -129,15 +147,14 @@ func (bh *S3BackupHandle) AddFile(ctx context.Context, filename string, filesize
 		})
 		object := objName(bh.dir, bh.name, filename)
 
-		var sseOption *string
-		if *sse != "" {
-			sseOption = sse
-		}
 		_, err := uploader.Upload(&s3manager.UploadInput{
 			Bucket:               bucket,
 			Key:                  object,
 			Body:                 reader,
-			ServerSideEncryption: sseOption,
+			ServerSideEncryption: bh.sse.algorithm,
+			SSEKMSKeyId:          bh.sse.kmsKeyID,
+			SSECustomerAlgorithm: bh.sse.customerAlgorithm,
+			SSECustomerKey:       bh.sse.customerKey,
 		})
 		if err != nil {
 			reader.CloseWithError(err)
@@ -172,12 +189,18 @@ func (bh *S3BackupHandle) ReadFile(ctx context.Context, filename string) (io.Rea
 	}
 	object := objName(bh.dir, bh.name, filename)
 	out, err := bh.client.GetObject(&s3.GetObjectInput{
-		Bucket: bucket,
-		Key:    object,
+		Bucket:               bucket,
+		Key:                  object,
+		SSECustomerAlgorithm: bh.sse.customerAlgorithm,
+		SSECustomerKey:       bh.sse.customerKey,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := bh.sse.verify(*object, out); err != nil {
+		out.Body.Close()
+		return nil, err
+	}
 	return out.Body, nil
 }
 
@@ -186,13 +209,14 @@ var _ backupstorage.BackupHandle = (*S3BackupHandle)(nil)
 // S3BackupStorage implements the backupstorage.BackupStorage interface.
 type S3BackupStorage struct {
 	_client *s3.S3
+	_sse    *sseParams
 	mu      sync.Mutex
 }
 
 // ListBackups is part of the backupstorage.BackupStorage interface.
 func (bs *S3BackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
 	log.Infof("ListBackups: [s3] dir: %v, bucket: %v", dir, *bucket)
-	c, err := bs.client()
+	c, sseParams, err := bs.client()
 	if err != nil {
 		return nil, err
 	}
@@ -229,6 +253,7 @@ func (bs *S3BackupStorage) ListBackups(ctx context.Context, dir string) ([]backu
 	for _, subdir := range subdirs {
 		result = append(result, &S3BackupHandle{
 			client:   c,
+			sse:      sseParams,
 			bs:       bs,
 			dir:      dir,
 			name:     subdir,
@@ -241,13 +266,14 @@ func (bs *S3BackupStorage) ListBackups(ctx context.Context, dir string) ([]backu
 // StartBackup is part of the backupstorage.BackupStorage interface.
 func (bs *S3BackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
 	log.Infof("StartBackup: [s3] dir: %v, name: %v, bucket: %v", dir, name, *bucket)
-	c, err := bs.client()
+	c, sseParams, err := bs.client()
 	if err != nil {
 		return nil, err
 	}
 
 	return &S3BackupHandle{
 		client:   c,
+		sse:      sseParams,
 		bs:       bs,
 		dir:      dir,
 		name:     name,
@@ -259,7 +285,7 @@ func (bs *S3BackupStorage) StartBackup(ctx context.Context, dir, name string) (b
 func (bs *S3BackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
 	log.Infof("RemoveBackup: [s3] dir: %v, name: %v, bucket: %v", dir, name, *bucket)
 
-	c, err := bs.client()
+	c, _, err := bs.client()
 	if err != nil {
 		return err
 	}
@@ -314,6 +340,7 @@ func (bs *S3BackupStorage) Close() error {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 	bs._client = nil
+	bs._sse = nil
 	return nil
 }
 
@@ -329,7 +356,7 @@ func getLogLevel() *aws.LogLevelType {
 	return l
 }
 
-func (bs *S3BackupStorage) client() (*s3.S3, error) {
+func (bs *S3BackupStorage) client() (*s3.S3, *sseParams, error) {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 	if bs._client == nil {
@@ -341,7 +368,7 @@ func (bs *S3BackupStorage) client() (*s3.S3, error) {
 
 		session, err := session.NewSession()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		awsConfig := aws.Config{
@@ -356,17 +383,102 @@ func (bs *S3BackupStorage) client() (*s3.S3, error) {
 			awsConfig.WithMaxRetries(*retryCount)
 		}
 
-		bs._client = s3.New(session, &awsConfig)
-
 		if len(*bucket) == 0 {
-			return nil, fmt.Errorf("-s3_backup_storage_bucket required")
+			return nil, nil, fmt.Errorf("-s3_backup_storage_bucket required")
 		}
 
-		if _, err := bs._client.HeadBucket(&s3.HeadBucketInput{Bucket: bucket}); err != nil {
-			return nil, err
+		sseParams, err := newSSEParams(*sse, *bucket)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		client := s3.New(session, &awsConfig)
+		if _, err := client.HeadBucket(&s3.HeadBucketInput{Bucket: bucket}); err != nil {
+			return nil, nil, err
 		}
+		bs._client = client
+		bs._sse = sseParams
+	}
+	return bs._client, bs._sse, nil
+}
+
+// sseParams are the server-side encryption parameters of the objects.
+// The nil values are left unset in the requests.
+type sseParams struct {
+	// algorithm is the encryption with the keys managed by S3 or KMS.
+	algorithm *string
+	// kmsKeyID is the KMS key of the aws:kms encryption.
+	kmsKeyID *string
+	// customerAlgorithm and customerKey are set for the SSE-C encryption,
+	// with a key provided in every request.
+	customerAlgorithm *string
+	customerKey       *string
+}
+
+// newSSEParams returns the encryption parameters of the objects of
+// the bucket, for the -s3_backup_server_side_encryption value.
+func newSSEParams(algorithm, bucketName string) (*sseParams, error) {
+	params := &sseParams{}
+	switch {
+	case algorithm == "":
+	case algorithm == s3.ServerSideEncryptionAes256:
+		params.algorithm = aws.String(algorithm)
+	case algorithm == s3.ServerSideEncryptionAwsKms:
+		params.algorithm = aws.String(algorithm)
+		keyID := *sseKMSKeyID
+		if bucketKeyID, ok := sseKMSKeyIDs[bucketName]; ok {
+			keyID = bucketKeyID
+		}
+		if keyID != "" {
+			params.kmsKeyID = aws.String(keyID)
+		}
+	case strings.HasPrefix(algorithm, sseCustomerKeyPrefix):
+		keyFile := strings.TrimPrefix(algorithm, sseCustomerKeyPrefix)
+		key, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the SSE-C key: %v", err)
+		}
+		if len(key) != sseCustomerKeySize {
+			return nil, fmt.Errorf("the SSE-C key in %v must be %v bytes long, not %v", keyFile, sseCustomerKeySize, len(key))
+		}
+		params.customerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+		params.customerKey = aws.String(string(key))
+	default:
+		return nil, fmt.Errorf("unknown server-side encryption %v, it must be one of %v, %v or %v<key file>", algorithm, s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms, sseCustomerKeyPrefix)
+	}
+	return params, nil
+}
+
+// verify checks that a restored object was encrypted with the parameters.
+// S3 only returns the objects encrypted with SSE-C if the key is provided,
+// so the MD5 of the key tells if the object was encrypted with it.
+func (params *sseParams) verify(object string, out *s3.GetObjectOutput) error {
+	if params.algorithm != nil && aws.StringValue(out.ServerSideEncryption) != *params.algorithm {
+		return fmt.Errorf("object %v is encrypted with %q, not %v", object, aws.StringValue(out.ServerSideEncryption), *params.algorithm)
+	}
+	if params.kmsKeyID != nil && !kmsKeyMatches(*params.kmsKeyID, aws.StringValue(out.SSEKMSKeyId)) {
+		return fmt.Errorf("object %v is encrypted with the KMS key %q, not %v", object, aws.StringValue(out.SSEKMSKeyId), *params.kmsKeyID)
+	}
+	if params.customerKey != nil {
+		sum := md5.Sum([]byte(*params.customerKey))
+		if aws.StringValue(out.SSECustomerKeyMD5) != base64.StdEncoding.EncodeToString(sum[:]) {
+			return fmt.Errorf("object %v is not encrypted with the SSE-C key", object)
+		}
+	}
+	return nil
+}
+
+// kmsKeyMatches returns true if the KMS key ARN returned by S3 is the key
+// configured by its id or ARN. The aliases can't be checked.
+func kmsKeyMatches(keyID, keyARN string) bool {
+	switch {
+	case strings.HasPrefix(keyID, "alias/") || strings.Contains(keyID, ":alias/"):
+		return true
+	case strings.HasPrefix(keyID, "arn:"):
+		return keyID == keyARN
+	default:
+		return strings.HasSuffix(keyARN, ":key/"+keyID)
 	}
-	return bs._client, nil
 }
 
 func objName(parts ...string) *string {
@@ -379,6 +491,8 @@ func objName(parts ...string) *string {
 }
 
 func init() {
+	flag.Var(&sseKMSKeyIDs, "s3_backup_sse_kms_key_ids", "KMS key ids of the buckets for the aws:kms server-side encryption, as a comma separated list of bucket:key_id, overriding -s3_backup_sse_kms_key_id")
+
 	backupstorage.BackupStorageMap["s3"] = &S3BackupStorage{}
 
 	logNameMap = logNameToLogLevel{
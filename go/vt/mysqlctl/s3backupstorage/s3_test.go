@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3backupstorage
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const testKeyARN = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+func TestSSEParamsKMS(t *testing.T) {
+	*sseKMSKeyID = "default-key"
+	sseKMSKeyIDs = map[string]string{"compliance": testKeyARN}
+	defer func() {
+		*sseKMSKeyID = ""
+		sseKMSKeyIDs = nil
+	}()
+
+	params, err := newSSEParams("aws:kms", "backups")
+	if err != nil {
+		t.Fatalf("newSSEParams failed: %v", err)
+	}
+	if aws.StringValue(params.algorithm) != "aws:kms" || aws.StringValue(params.kmsKeyID) != "default-key" {
+		t.Errorf("newSSEParams: %v %v, want aws:kms default-key", aws.StringValue(params.algorithm), aws.StringValue(params.kmsKeyID))
+	}
+
+	// The key of the bucket overrides the default key.
+	params, err = newSSEParams("aws:kms", "compliance")
+	if err != nil {
+		t.Fatalf("newSSEParams failed: %v", err)
+	}
+	if aws.StringValue(params.kmsKeyID) != testKeyARN {
+		t.Errorf("newSSEParams: %v, want %v", aws.StringValue(params.kmsKeyID), testKeyARN)
+	}
+	if err := params.verify("obj", &s3.GetObjectOutput{ServerSideEncryption: aws.String("aws:kms"), SSEKMSKeyId: aws.String(testKeyARN)}); err != nil {
+		t.Errorf("verify failed: %v", err)
+	}
+	err = params.verify("obj", &s3.GetObjectOutput{ServerSideEncryption: aws.String("AES256")})
+	if err == nil || !strings.Contains(err.Error(), `object obj is encrypted with "AES256", not aws:kms`) {
+		t.Errorf("verify: %v", err)
+	}
+	err = params.verify("obj", &s3.GetObjectOutput{ServerSideEncryption: aws.String("aws:kms"), SSEKMSKeyId: aws.String("arn:aws:kms:us-east-1:123456789012:key/other")})
+	if err == nil || !strings.Contains(err.Error(), "is encrypted with the KMS key") {
+		t.Errorf("verify: %v", err)
+	}
+}
+
+func TestKMSKeyMatches(t *testing.T) {
+	testcases := []struct {
+		keyID string
+		match bool
+	}{
+		{keyID: testKeyARN, match: true},
+		{keyID: "1234abcd-12ab-34cd-56ef-1234567890ab", match: true},
+		{keyID: "alias/backups", match: true},
+		{keyID: "arn:aws:kms:us-east-1:123456789012:alias/backups", match: true},
+		{keyID: "34cd-56ef-1234567890ab", match: false},
+		{keyID: "arn:aws:kms:us-east-1:123456789012:key/other", match: false},
+	}
+	for _, tc := range testcases {
+		if got := kmsKeyMatches(tc.keyID, testKeyARN); got != tc.match {
+			t.Errorf("kmsKeyMatches(%v): %v, want %v", tc.keyID, got, tc.match)
+		}
+	}
+}
+
+func TestSSEParamsCustomerKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "s3backupstorage")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	keyFile := path.Join(dir, "key")
+	key := strings.Repeat("k", sseCustomerKeySize)
+	if err := ioutil.WriteFile(keyFile, []byte(key), 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	params, err := newSSEParams("sse_c:"+keyFile, "backups")
+	if err != nil {
+		t.Fatalf("newSSEParams failed: %v", err)
+	}
+	if params.algorithm != nil || aws.StringValue(params.customerAlgorithm) != "AES256" || aws.StringValue(params.customerKey) != key {
+		t.Errorf("newSSEParams: %+v", params)
+	}
+	sum := md5.Sum([]byte(key))
+	if err := params.verify("obj", &s3.GetObjectOutput{SSECustomerKeyMD5: aws.String(base64.StdEncoding.EncodeToString(sum[:]))}); err != nil {
+		t.Errorf("verify failed: %v", err)
+	}
+	if err := params.verify("obj", &s3.GetObjectOutput{}); err == nil || err.Error() != "object obj is not encrypted with the SSE-C key" {
+		t.Errorf("verify: %v", err)
+	}
+
+	if err := ioutil.WriteFile(keyFile, []byte("short"), 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	if _, err := newSSEParams("sse_c:"+keyFile, "backups"); err == nil || !strings.Contains(err.Error(), "must be 32 bytes long, not 5") {
+		t.Errorf("newSSEParams: %v", err)
+	}
+}
+
+func TestSSEParamsInvalid(t *testing.T) {
+	params, err := newSSEParams("", "backups")
+	if err != nil || params.algorithm != nil || params.customerKey != nil {
+		t.Errorf("newSSEParams(\"\"): %+v, %v", params, err)
+	}
+	if err := params.verify("obj", &s3.GetObjectOutput{}); err != nil {
+		t.Errorf("verify failed: %v", err)
+	}
+	if _, err := newSSEParams("rot13", "backups"); err == nil || !strings.HasPrefix(err.Error(), "unknown server-side encryption rot13") {
+		t.Errorf("newSSEParams: %v", err)
+	}
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package mysqlctl
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
@@ -130,6 +132,14 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 		if err != nil {
 			return nil, err
 		}
+		td.GeneratedColumns, err = mysqld.getGeneratedColumns(dbName, tableName)
+		if err != nil {
+			return nil, err
+		}
+		td.Partitioning, err = mysqld.getPartitioning(dbName, tableName)
+		if err != nil {
+			return nil, err
+		}
 		td.Type = tableType
 		td.DataLength = dataLength
 		td.RowCount = rowCount
@@ -177,6 +187,74 @@ func (mysqld *Mysqld) GetColumns(dbName, table string) ([]*querypb.Field, []stri
 
 }
 
+// getGeneratedColumns returns the generated columns of table.
+// MySQL versions without generated columns return none.
+func (mysqld *Mysqld) getGeneratedColumns(dbName, table string) ([]*tabletmanagerdatapb.GeneratedColumn, error) {
+	conn, err := getPoolReconnect(context.TODO(), mysqld.dbaPool)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	buf := &bytes.Buffer{}
+	buf.WriteString("SELECT column_name, generation_expression, extra FROM information_schema.columns WHERE table_schema = ")
+	sqltypes.NewVarChar(dbName).EncodeSQL(buf)
+	buf.WriteString(" AND table_name = ")
+	sqltypes.NewVarChar(table).EncodeSQL(buf)
+	buf.WriteString(" AND extra IN ('VIRTUAL GENERATED', 'STORED GENERATED') ORDER BY ordinal_position")
+	qr, err := conn.ExecuteFetch(buf.String(), 10000, false)
+	if err != nil {
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERBadFieldError {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var columns []*tabletmanagerdatapb.GeneratedColumn
+	for _, row := range qr.Rows {
+		columns = append(columns, &tabletmanagerdatapb.GeneratedColumn{
+			Name:       row[0].ToString(),
+			Expression: row[1].ToString(),
+			Stored:     row[2].ToString() == "STORED GENERATED",
+		})
+	}
+	return columns, nil
+}
+
+// getPartitioning returns the partitioning of table,
+// or nil if it isn't partitioned.
+func (mysqld *Mysqld) getPartitioning(dbName, table string) (*tabletmanagerdatapb.Partitioning, error) {
+	conn, err := getPoolReconnect(context.TODO(), mysqld.dbaPool)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	buf := &bytes.Buffer{}
+	buf.WriteString("SELECT partition_name, partition_method, partition_expression, partition_description, CAST(SUM(table_rows) AS UNSIGNED) FROM information_schema.partitions WHERE table_schema = ")
+	sqltypes.NewVarChar(dbName).EncodeSQL(buf)
+	buf.WriteString(" AND table_name = ")
+	sqltypes.NewVarChar(table).EncodeSQL(buf)
+	buf.WriteString(" AND partition_name IS NOT NULL GROUP BY partition_ordinal_position, partition_name, partition_method, partition_expression, partition_description ORDER BY partition_ordinal_position")
+	qr, err := conn.ExecuteFetch(buf.String(), 10000, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	partitioning := &tabletmanagerdatapb.Partitioning{
+		Method:     qr.Rows[0][1].ToString(),
+		Expression: qr.Rows[0][2].ToString(),
+	}
+	for _, row := range qr.Rows {
+		rowCount, _ := sqltypes.ToUint64(row[4])
+		partitioning.Partitions = append(partitioning.Partitions, &tabletmanagerdatapb.Partition{
+			Name:        row[0].ToString(),
+			Description: row[3].ToString(),
+			RowCount:    rowCount,
+		})
+	}
+	return partitioning, nil
+}
+
 // GetPrimaryKeyColumns returns the primary key columns of table.
 func (mysqld *Mysqld) GetPrimaryKeyColumns(dbName, table string) ([]string, error) {
 	conn, err := getPoolReconnect(context.TODO(), mysqld.dbaPool)
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// maxTransferBurst is the largest number of bytes transferred at once
+// when the transfers are rate limited.
+const maxTransferBurst = 1024 * 1024
+
+var (
+	// backupUploadRateLimit limits the rate of the uploads to the backup
+	// storage, so backups taken on serving tablets don't saturate the
+	// network.
+	backupUploadRateLimit = flag.Int64("backup_storage_upload_rate_limit", 0, "if set, the maximum rate in bytes per second of the uploads of a backup to the backup storage, across all the files uploaded concurrently (default is 0, no limit).")
+
+	// backupDownloadRateLimit limits the rate of the downloads from the
+	// backup storage during a restore.
+	backupDownloadRateLimit = flag.Int64("backup_storage_download_rate_limit", 0, "if set, the maximum rate in bytes per second of the downloads of a restore from the backup storage, across all the files downloaded concurrently (default is 0, no limit).")
+)
+
+// newTransferLimiter returns the limiter for a transfer of bytesPerSec,
+// or nil if bytesPerSec is not positive and the transfer is unlimited.
+func newTransferLimiter(bytesPerSec int64) *rate.Limiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	burst := maxTransferBurst
+	if bytesPerSec < int64(burst) {
+		burst = int(bytesPerSec)
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSec), burst)
+}
+
+// throttleWriter returns a writer to w, limited by limiter. It returns
+// w if limiter is nil.
+func throttleWriter(ctx context.Context, w io.Writer, limiter *rate.Limiter) io.Writer {
+	if limiter == nil {
+		return w
+	}
+	return &throttledWriter{ctx: ctx, w: w, limiter: limiter}
+}
+
+// throttleReader returns a reader from r, limited by limiter. It returns
+// r if limiter is nil.
+func throttleReader(ctx context.Context, r io.Reader, limiter *rate.Limiter) io.Reader {
+	if limiter == nil {
+		return r
+	}
+	return &throttledReader{ctx: ctx, r: r, limiter: limiter}
+}
+
+type throttledWriter struct {
+	ctx     context.Context
+	w       io.Writer
+	limiter *rate.Limiter
+}
+
+func (tw *throttledWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > tw.limiter.Burst() {
+			chunk = chunk[:tw.limiter.Burst()]
+		}
+		if err := tw.limiter.WaitN(tw.ctx, len(chunk)); err != nil {
+			return written, err
+		}
+		n, err := tw.w.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+type throttledReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *rate.Limiter
+}
+
+func (tr *throttledReader) Read(p []byte) (int, error) {
+	if len(p) > tr.limiter.Burst() {
+		p = p[:tr.limiter.Burst()]
+	}
+	n, err := tr.r.Read(p)
+	if n > 0 {
+		if werr := tr.limiter.WaitN(tr.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestThrottleUnlimited(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if w := throttleWriter(context.Background(), buf, newTransferLimiter(0)); w != buf {
+		t.Errorf("throttleWriter without limit returned %T, want the writer itself", w)
+	}
+	r := bytes.NewReader(nil)
+	if got := throttleReader(context.Background(), r, newTransferLimiter(-1)); got != r {
+		t.Errorf("throttleReader without limit returned %T, want the reader itself", got)
+	}
+}
+
+func TestThrottleWriter(t *testing.T) {
+	// The first 200000 bytes are the burst, the next 100000 take 500ms.
+	data := bytes.Repeat([]byte("x"), 300000)
+	buf := &bytes.Buffer{}
+	w := throttleWriter(context.Background(), buf, newTransferLimiter(200000))
+
+	start := time.Now()
+	n, err := w.Write(data)
+	if err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("Write took %v, want at least 400ms", elapsed)
+	}
+	if n != len(data) || !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("Write wrote %v bytes, want %v", n, len(data))
+	}
+}
+
+func TestThrottleReader(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 300000)
+	r := throttleReader(context.Background(), bytes.NewReader(data), newTransferLimiter(200000))
+
+	start := time.Now()
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("ReadAll took %v, want at least 400ms", elapsed)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("ReadAll read %v bytes, want %v", len(got), len(data))
+	}
+}
+
+func TestThrottleCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := throttleWriter(ctx, &bytes.Buffer{}, newTransferLimiter(1000))
+	if _, err := w.Write(make([]byte, 10)); err == nil {
+		t.Errorf("Write with a canceled context succeeded, want an error")
+	}
+}
@@ -283,6 +283,32 @@ func DiffSchemaToArray(leftName string, left *tabletmanagerdatapb.SchemaDefiniti
 	return nil
 }
 
+// The following are the DDL strategies of a keyspace. They define how
+// the tablets apply the schema changes of ApplySchema.
+const (
+	// DDLStrategyDirect runs the statements. It's the strategy of the
+	// keyspaces which don't set one.
+	DDLStrategyDirect = "direct"
+	// DDLStrategyOnline runs each ALTER TABLE as an online DDL
+	// migration, in the background.
+	DDLStrategyOnline = "online"
+	// DDLStrategyDeclarative diffs the CREATE TABLE statements against
+	// the schema, and runs the statements which make the changes.
+	DDLStrategyDeclarative = "declarative"
+)
+
+// ParseDDLStrategy returns the DDL strategy of a keyspace from the value
+// of its ddl_strategy field.
+func ParseDDLStrategy(value string) (string, error) {
+	switch value {
+	case "":
+		return DDLStrategyDirect, nil
+	case DDLStrategyDirect, DDLStrategyOnline, DDLStrategyDeclarative:
+		return value, nil
+	}
+	return "", fmt.Errorf("unknown DDL strategy: %v", value)
+}
+
 // SchemaChange contains all necessary information to apply a schema change.
 // It should not be sent over the wire, it's just a set of parameters.
 type SchemaChange struct {
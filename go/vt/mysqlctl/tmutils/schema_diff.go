@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file computes the statements which turn a schema into the one
+// described by a list of CREATE TABLE statements.
+
+// integerTypes are the types whose display width is ignored.
+var integerTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"integer":   true,
+	"bigint":    true,
+}
+
+// DeclarativeSchemaChanges returns the statements which change the
+// tables of the live schema into the tables created by the CREATE TABLE
+// statements of desired. New tables are created, and the columns and
+// indexes of existing tables are altered. Tables which are not in
+// desired are only dropped if allowDrop is set. Views, table options
+// and the order of the columns are ignored.
+func DeclarativeSchemaChanges(live *tabletmanagerdatapb.SchemaDefinition, desired []string, allowDrop bool) ([]string, error) {
+	desiredTables := make(map[string]*sqlparser.DDL)
+	var desiredNames []string
+	for _, sql := range desired {
+		ddl, err := parseCreateTable(sql)
+		if err != nil {
+			return nil, err
+		}
+		name := ddl.Table.Name.String()
+		if _, ok := desiredTables[name]; ok {
+			return nil, fmt.Errorf("table %v is created more than once", name)
+		}
+		desiredTables[name] = ddl
+		desiredNames = append(desiredNames, name)
+	}
+	sort.Strings(desiredNames)
+
+	liveTables := make(map[string]*sqlparser.DDL)
+	var liveNames []string
+	for _, td := range live.TableDefinitions {
+		if td.Type == TableView {
+			continue
+		}
+		ddl, err := parseCreateTable(td.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the schema of table %v: %v", td.Name, err)
+		}
+		liveTables[td.Name] = ddl
+		liveNames = append(liveNames, td.Name)
+	}
+	sort.Strings(liveNames)
+
+	var changes []string
+	for _, name := range desiredNames {
+		ddl := desiredTables[name]
+		liveDDL, ok := liveTables[name]
+		if !ok {
+			changes = append(changes, sqlparser.String(ddl))
+			continue
+		}
+		if alter := alterTable(liveDDL, ddl); alter != "" {
+			changes = append(changes, alter)
+		}
+	}
+	if allowDrop {
+		for _, name := range liveNames {
+			if _, ok := desiredTables[name]; !ok {
+				changes = append(changes, "drop table "+sqlparser.String(liveTables[name].Table))
+			}
+		}
+	}
+	return changes, nil
+}
+
+// parseCreateTable parses a CREATE TABLE statement, and normalizes
+// it into the table MySQL creates for it.
+func parseCreateTable(sql string) (*sqlparser.DDL, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.CreateStr || ddl.TableSpec == nil {
+		return nil, fmt.Errorf("not a CREATE TABLE statement: %v", sql)
+	}
+	normalizeTable(ddl.TableSpec)
+	return ddl, nil
+}
+
+// normalizeTable changes the columns and indexes of spec the way MySQL
+// does when it creates the table, so that a table compares equal to
+// the output of SHOW CREATE TABLE for it:
+// the key options of the columns become indexes, the indexes without a
+// name are named after their first column, the index types are spelled
+// the same way, and the columns of the primary key are NOT NULL.
+func normalizeTable(spec *sqlparser.TableSpec) {
+	spec.MoveColumnKeys()
+
+	names := make(map[string]bool)
+	for _, index := range spec.Indexes {
+		names[index.Info.Name.Lowered()] = true
+	}
+	primary := make(map[string]bool)
+	for _, index := range spec.Indexes {
+		info := index.Info
+		if info.Name.IsEmpty() && len(index.Columns) > 0 {
+			// MySQL adds a suffix to the name of the column
+			// when an index already has it.
+			name := index.Columns[0].Column.String()
+			for i := 2; names[strings.ToLower(name)]; i++ {
+				name = fmt.Sprintf("%s_%d", index.Columns[0].Column.String(), i)
+			}
+			info.Name = sqlparser.NewColIdent(name)
+			names[info.Name.Lowered()] = true
+		}
+		switch {
+		case info.Primary:
+			info.Type = "primary key"
+			for _, col := range index.Columns {
+				primary[col.Column.Lowered()] = true
+			}
+		case info.Spatial:
+			info.Type = "spatial key"
+		case info.Unique:
+			info.Type = "unique key"
+		default:
+			info.Type = "key"
+		}
+	}
+	for _, col := range spec.Columns {
+		if primary[col.Name.Lowered()] {
+			col.Type.NotNull = true
+		}
+	}
+}
+
+// alterTable returns the ALTER TABLE which changes the columns and
+// indexes of live into the ones of desired, or "" if they're the same.
+func alterTable(live, desired *sqlparser.DDL) string {
+	var drops, modifies, adds []string
+
+	liveIndexes := make(map[string]*sqlparser.IndexDefinition)
+	for _, index := range live.TableSpec.Indexes {
+		liveIndexes[index.Info.Name.Lowered()] = index
+	}
+	desiredIndexes := make(map[string]*sqlparser.IndexDefinition)
+	for _, index := range desired.TableSpec.Indexes {
+		desiredIndexes[index.Info.Name.Lowered()] = index
+	}
+	for _, index := range live.TableSpec.Indexes {
+		want, ok := desiredIndexes[index.Info.Name.Lowered()]
+		if ok && sqlparser.String(want) == sqlparser.String(index) {
+			continue
+		}
+		if index.Info.Primary {
+			drops = append(drops, "drop primary key")
+		} else {
+			drops = append(drops, "drop index "+sqlparser.String(index.Info.Name))
+		}
+	}
+
+	liveColumns := make(map[string]*sqlparser.ColumnDefinition)
+	for _, col := range live.TableSpec.Columns {
+		liveColumns[col.Name.Lowered()] = col
+	}
+	desiredColumns := make(map[string]*sqlparser.ColumnDefinition)
+	for _, col := range desired.TableSpec.Columns {
+		desiredColumns[col.Name.Lowered()] = col
+	}
+	for _, col := range live.TableSpec.Columns {
+		if _, ok := desiredColumns[col.Name.Lowered()]; !ok {
+			drops = append(drops, "drop column "+sqlparser.String(col.Name))
+		}
+	}
+	for _, col := range desired.TableSpec.Columns {
+		liveCol, ok := liveColumns[col.Name.Lowered()]
+		switch {
+		case !ok:
+			adds = append(adds, "add column "+sqlparser.String(col))
+		case columnString(liveCol, col) != columnString(col, col):
+			modifies = append(modifies, "modify column "+sqlparser.String(col))
+		}
+	}
+
+	for _, index := range desired.TableSpec.Indexes {
+		have, ok := liveIndexes[index.Info.Name.Lowered()]
+		if ok && sqlparser.String(have) == sqlparser.String(index) {
+			continue
+		}
+		adds = append(adds, "add "+sqlparser.String(index))
+	}
+
+	clauses := append(append(drops, modifies...), adds...)
+	if len(clauses) == 0 {
+		return ""
+	}
+	return "alter table " + sqlparser.String(desired.Table) + " " + strings.Join(clauses, ", ")
+}
+
+// columnString returns the definition of col as it's compared with
+// the desired column. The attributes which desired leaves to MySQL,
+// and the ones MySQL only adds for display, are ignored.
+func columnString(col, desired *sqlparser.ColumnDefinition) string {
+	normalized := *col
+	typ := &normalized.Type
+	typ.Type = strings.ToLower(typ.Type)
+	if typ.Type == "integer" {
+		typ.Type = "int"
+	}
+	if integerTypes[typ.Type] {
+		typ.Length = nil
+	}
+	if _, ok := typ.Default.(*sqlparser.NullVal); ok && !bool(typ.NotNull) {
+		typ.Default = nil
+	}
+	// MySQL displays all the defaults as strings.
+	if val, ok := typ.Default.(*sqlparser.SQLVal); ok && val.Type != sqlparser.StrVal {
+		typ.Default = sqlparser.NewStrVal(val.Val)
+	}
+	if desired.Type.Charset == "" {
+		typ.Charset = ""
+	}
+	if desired.Type.Collate == "" {
+		typ.Collate = ""
+	}
+	return sqlparser.String(&normalized)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var liveSchema = &tabletmanagerdatapb.SchemaDefinition{
+	TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+		Name: "t1",
+		Schema: "CREATE TABLE `t1` (\n" +
+			"  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n" +
+			"  `name` varchar(64) COLLATE utf8_bin DEFAULT NULL,\n" +
+			"  `c` int(11) DEFAULT '0',\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  UNIQUE KEY `name_idx` (`name`),\n" +
+			"  KEY `c_idx` (`c`)\n" +
+			") ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8 COLLATE=utf8_bin",
+		Type: TableBaseTable,
+	}, {
+		Name:   "t2",
+		Schema: "CREATE TABLE `t2` (\n  `id` int(11) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   TableBaseTable,
+	}, {
+		Name:   "v1",
+		Schema: "CREATE ALGORITHM=UNDEFINED VIEW `v1` AS select 1 AS `1`",
+		Type:   TableView,
+	}},
+}
+
+func TestDeclarativeSchemaChangesUnchanged(t *testing.T) {
+	desired := []string{
+		"create table t1 (id bigint not null auto_increment, name varchar(64), c int default 0, primary key(id), unique index name_idx(name), index c_idx(c))",
+		"create table t2 (id int not null, primary key (id))",
+	}
+	changes, err := DeclarativeSchemaChanges(liveSchema, desired, true)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDeclarativeSchemaChanges(t *testing.T) {
+	desired := []string{
+		"create table t1 (id bigint not null auto_increment, name varchar(128), d datetime, primary key(id), unique index name_idx(name), index c_idx(d))",
+		"create table t3 (id int not null, primary key (id))",
+	}
+	changes, err := DeclarativeSchemaChanges(liveSchema, desired, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"alter table t1 drop index c_idx, drop column c, modify column name varchar(128), add column d datetime, add key c_idx (d)",
+		"create table t3 (\n\tid int not null,\n\tprimary key (id)\n)",
+	}, changes)
+
+	changes, err = DeclarativeSchemaChanges(liveSchema, desired, true)
+	require.NoError(t, err)
+	assert.Equal(t, "drop table t2", changes[len(changes)-1])
+}
+
+func TestDeclarativeSchemaChangesIdempotent(t *testing.T) {
+	// The desired tables, and SHOW CREATE TABLE once MySQL created them.
+	desired := []string{
+		"create table t1 (id bigint auto_increment key, name varchar(64) unique, c int, index (c), key (c, id))",
+		"create table t2 (a int, b int, primary key (a, b), unique index (b))",
+		"create table t3 (id int primary key, v varchar(10) not null default 'x')",
+	}
+	live := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name: "t1",
+			Schema: "CREATE TABLE `t1` (\n" +
+				"  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n" +
+				"  `name` varchar(64) DEFAULT NULL,\n" +
+				"  `c` int(11) DEFAULT NULL,\n" +
+				"  PRIMARY KEY (`id`),\n" +
+				"  UNIQUE KEY `name` (`name`),\n" +
+				"  KEY `c` (`c`),\n" +
+				"  KEY `c_2` (`c`,`id`)\n" +
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type: TableBaseTable,
+		}, {
+			Name: "t2",
+			Schema: "CREATE TABLE `t2` (\n" +
+				"  `a` int(11) NOT NULL,\n" +
+				"  `b` int(11) NOT NULL,\n" +
+				"  PRIMARY KEY (`a`,`b`),\n" +
+				"  UNIQUE KEY `b` (`b`)\n" +
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type: TableBaseTable,
+		}, {
+			Name: "t3",
+			Schema: "CREATE TABLE `t3` (\n" +
+				"  `id` int(11) NOT NULL,\n" +
+				"  `v` varchar(10) NOT NULL DEFAULT 'x',\n" +
+				"  PRIMARY KEY (`id`)\n" +
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type: TableBaseTable,
+		}},
+	}
+	changes, err := DeclarativeSchemaChanges(live, desired, true)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// The desired tables are also unchanged against themselves.
+	self := &tabletmanagerdatapb.SchemaDefinition{}
+	for i, sql := range desired {
+		self.TableDefinitions = append(self.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+			Name:   live.TableDefinitions[i].Name,
+			Schema: sql,
+			Type:   TableBaseTable,
+		})
+	}
+	changes, err = DeclarativeSchemaChanges(self, desired, true)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// A changed column keeps its index, which is not part of the
+	// modify clause.
+	changes, err = DeclarativeSchemaChanges(live, []string{
+		"create table t1 (id bigint auto_increment key, name varchar(128) unique, c int, index (c), key (c, id))",
+		desired[1],
+		desired[2],
+	}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alter table t1 modify column name varchar(128)"}, changes)
+}
+
+func TestDeclarativeSchemaChangesErrors(t *testing.T) {
+	_, err := DeclarativeSchemaChanges(liveSchema, []string{"alter table t1 add column d int"}, false)
+	assert.EqualError(t, err, "not a CREATE TABLE statement: alter table t1 add column d int")
+
+	_, err = DeclarativeSchemaChanges(liveSchema, []string{"create table t1 (id int)", "create table t1 (id bigint)"}, false)
+	assert.EqualError(t, err, "table t1 is created more than once")
+}
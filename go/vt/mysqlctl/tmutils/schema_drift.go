@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"sort"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// TableDrift describes a table whose definition differs across the
+// shards of a keyspace.
+type TableDrift struct {
+	Table string `json:"table"`
+	// Variants lists the distinct definitions of the table, with the
+	// shards which have each of them. The most common definition is
+	// first. The definition is empty for the shards without the table.
+	Variants []*TableVariant `json:"variants"`
+}
+
+// TableVariant is one of the definitions of a drifted table.
+type TableVariant struct {
+	Schema string   `json:"schema"`
+	Shards []string `json:"shards"`
+}
+
+// DriftedShards returns the number of shards whose definition of the
+// table differs from the most common one.
+func (td *TableDrift) DriftedShards() int {
+	drifted := 0
+	for _, variant := range td.Variants[1:] {
+		drifted += len(variant.Shards)
+	}
+	return drifted
+}
+
+// SchemaDrift compares the definitions of the tables in the schemas of
+// the shards, and returns the tables which differ, sorted by name.
+func SchemaDrift(schemas map[string]*tabletmanagerdatapb.SchemaDefinition) []*TableDrift {
+	shards := make([]string, 0, len(schemas))
+	for shard := range schemas {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+
+	// definitions maps the tables to the definition of every shard.
+	definitions := make(map[string]map[string]string)
+	for _, shard := range shards {
+		for _, td := range schemas[shard].TableDefinitions {
+			if definitions[td.Name] == nil {
+				definitions[td.Name] = make(map[string]string)
+			}
+			definitions[td.Name][shard] = td.Schema
+		}
+	}
+	tables := make([]string, 0, len(definitions))
+	for table := range definitions {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var result []*TableDrift
+	for _, table := range tables {
+		var variants []*TableVariant
+		for _, shard := range shards {
+			schema := definitions[table][shard]
+			found := false
+			for _, variant := range variants {
+				if variant.Schema == schema {
+					variant.Shards = append(variant.Shards, shard)
+					found = true
+					break
+				}
+			}
+			if !found {
+				variants = append(variants, &TableVariant{Schema: schema, Shards: []string{shard}})
+			}
+		}
+		if len(variants) == 1 {
+			continue
+		}
+		// The variants are in the order of their first shard, which
+		// breaks the ties.
+		sort.SliceStable(variants, func(i, j int) bool {
+			return len(variants[i].Shards) > len(variants[j].Shards)
+		})
+		result = append(result, &TableDrift{Table: table, Variants: variants})
+	}
+	return result
+}
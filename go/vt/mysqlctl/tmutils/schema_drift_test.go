@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func schemaWithTables(tables ...string) *tabletmanagerdatapb.SchemaDefinition {
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for i := 0; i < len(tables); i += 2 {
+		sd.TableDefinitions = append(sd.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+			Name:   tables[i],
+			Schema: tables[i+1],
+			Type:   TableBaseTable,
+		})
+	}
+	return sd
+}
+
+func TestSchemaDrift(t *testing.T) {
+	t1 := "create table t1 (id int)"
+	t1Altered := "create table t1 (id int, val int)"
+	t2 := "create table t2 (id int)"
+	t3 := "create table t3 (id int)"
+	schemas := map[string]*tabletmanagerdatapb.SchemaDefinition{
+		"-40":   schemaWithTables("t1", t1, "t2", t2, "t3", t3),
+		"40-80": schemaWithTables("t1", t1Altered, "t2", t2, "t3", t3),
+		"80-c0": schemaWithTables("t1", t1, "t2", t2, "t3", t3),
+		"c0-":   schemaWithTables("t1", t1Altered, "t3", t3),
+	}
+	drift := SchemaDrift(schemas)
+	assert.Equal(t, []*TableDrift{{
+		Table: "t1",
+		Variants: []*TableVariant{{
+			Schema: t1,
+			Shards: []string{"-40", "80-c0"},
+		}, {
+			Schema: t1Altered,
+			Shards: []string{"40-80", "c0-"},
+		}},
+	}, {
+		Table: "t2",
+		Variants: []*TableVariant{{
+			Schema: t2,
+			Shards: []string{"-40", "40-80", "80-c0"},
+		}, {
+			Schema: "",
+			Shards: []string{"c0-"},
+		}},
+	}}, drift)
+	assert.Equal(t, 2, drift[0].DriftedShards())
+	assert.Equal(t, 1, drift[1].DriftedShards())
+
+	assert.Empty(t, SchemaDrift(map[string]*tabletmanagerdatapb.SchemaDefinition{
+		"-80": schemaWithTables("t1", t1),
+		"80-": schemaWithTables("t1", t1),
+	}))
+}
@@ -446,3 +446,38 @@ func TestFilterTables(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDDLStrategy(t *testing.T) {
+	testcases := []struct {
+		value    string
+		strategy string
+		err      string
+	}{{
+		value:    "",
+		strategy: DDLStrategyDirect,
+	}, {
+		value:    "direct",
+		strategy: DDLStrategyDirect,
+	}, {
+		value:    "online",
+		strategy: DDLStrategyOnline,
+	}, {
+		value:    "declarative",
+		strategy: DDLStrategyDeclarative,
+	}, {
+		value: "gh-ost",
+		err:   "unknown DDL strategy: gh-ost",
+	}}
+	for _, tcase := range testcases {
+		strategy, err := ParseDDLStrategy(tcase.value)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseDDLStrategy(%q): %v, want %v", tcase.value, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil || strategy != tcase.strategy {
+			t.Errorf("ParseDDLStrategy(%q): %v, %v, want %v", tcase.value, strategy, err, tcase.strategy)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+)
+
+const (
+	transferBackup  = "backup"
+	transferRestore = "restore"
+)
+
+var (
+	// transferBytes counts the bytes of the files copied to and from
+	// the backup storage, before compression.
+	transferBytes = stats.NewCountersWithSingleLabel("BackupTransferBytes", "Bytes of the files copied to or from the backup storage, before compression", "operation", transferBackup, transferRestore)
+
+	// transferFiles counts the files copied to and from the backup
+	// storage.
+	transferFiles = stats.NewCountersWithSingleLabel("BackupTransferFiles", "Number of files copied to or from the backup storage", "operation", transferBackup, transferRestore)
+
+	// transferInProgressFiles is the number of files being copied at
+	// the moment, which is at most the concurrency of the backup or
+	// restore.
+	transferInProgressFiles = stats.NewGaugesWithSingleLabel("BackupTransferInProgressFiles", "Number of files being copied to or from the backup storage", "operation", transferBackup, transferRestore)
+
+	// transferRates is the aggregate bandwidth of the copies, in bytes
+	// per second, over the last 15 minutes.
+	transferRates = stats.NewRates("BackupTransferBytesRates", transferBytes, 15*60/5, 5*time.Second)
+)
+
+// transferStats aggregates the files copied to or from the backup
+// storage by one backup or restore. The files are copied concurrently,
+// so it is safe for concurrent use.
+type transferStats struct {
+	operation string
+	start     time.Time
+	files     sync2.AtomicInt64
+	bytes     sync2.AtomicInt64
+
+	// limiter limits the rate of all the files transferred, if set.
+	limiter *rate.Limiter
+}
+
+func newTransferStats(operation string) *transferStats {
+	bytesPerSec := *backupUploadRateLimit
+	if operation == transferRestore {
+		bytesPerSec = *backupDownloadRateLimit
+	}
+	return &transferStats{
+		operation: operation,
+		start:     time.Now(),
+		limiter:   newTransferLimiter(bytesPerSec),
+	}
+}
+
+// startFile is called before copying a file. The returned function is
+// called with the result of the copy once it's over: only the files
+// which were copied successfully are counted.
+func (ts *transferStats) startFile() func(err error) {
+	transferInProgressFiles.Add(ts.operation, 1)
+	return func(err error) {
+		transferInProgressFiles.Add(ts.operation, -1)
+		if err != nil {
+			return
+		}
+		transferFiles.Add(ts.operation, 1)
+		ts.files.Add(1)
+	}
+}
+
+// reader returns a reader counting the bytes read from r.
+func (ts *transferStats) reader(r io.Reader) io.Reader {
+	return &transferReader{Reader: r, ts: ts}
+}
+
+func (ts *transferStats) addBytes(n int64) {
+	transferBytes.Add(ts.operation, n)
+	ts.bytes.Add(n)
+}
+
+// String summarizes the copies, with their aggregate bandwidth.
+func (ts *transferStats) String() string {
+	elapsed := time.Since(ts.start)
+	bytes := ts.bytes.Get()
+	var rate float64
+	if elapsed > 0 {
+		rate = float64(bytes) / elapsed.Seconds() / (1024 * 1024)
+	}
+	return fmt.Sprintf("%v files, %v bytes in %v (%.2f MiB/s)", ts.files.Get(), bytes, elapsed.Round(time.Millisecond), rate)
+}
+
+// transferReader counts the bytes read into its transferStats.
+type transferReader struct {
+	io.Reader
+	ts *transferStats
+}
+
+func (tr *transferReader) Read(p []byte) (int, error) {
+	n, err := tr.Reader.Read(p)
+	tr.ts.addBytes(int64(n))
+	return n, err
+}
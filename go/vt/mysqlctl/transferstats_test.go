@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTransferStats(t *testing.T) {
+	startBytes := transferBytes.Counts()[transferRestore]
+	startFiles := transferFiles.Counts()[transferRestore]
+
+	ts := newTransferStats(transferRestore)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			done := ts.startFile()
+			if i == 4 {
+				// A failed copy is not counted.
+				done(errors.New("copy failed"))
+				return
+			}
+			_, err := ioutil.ReadAll(ts.reader(strings.NewReader("0123456789")))
+			if err != nil {
+				t.Errorf("ReadAll failed: %v", err)
+			}
+			done(err)
+		}(i)
+	}
+	wg.Wait()
+
+	if got, want := ts.files.Get(), int64(4); got != want {
+		t.Errorf("files: %v, want %v", got, want)
+	}
+	if got, want := ts.bytes.Get(), int64(40); got != want {
+		t.Errorf("bytes: %v, want %v", got, want)
+	}
+	if got, want := transferFiles.Counts()[transferRestore]-startFiles, int64(4); got != want {
+		t.Errorf("BackupTransferFiles: %v, want %v", got, want)
+	}
+	if got, want := transferBytes.Counts()[transferRestore]-startBytes, int64(40); got != want {
+		t.Errorf("BackupTransferBytes: %v, want %v", got, want)
+	}
+	if got := transferInProgressFiles.Counts()[transferRestore]; got != 0 {
+		t.Errorf("BackupTransferInProgressFiles: %v, want 0", got)
+	}
+	if got, want := ts.String(), "4 files, 40 bytes in "; !strings.HasPrefix(got, want) {
+		t.Errorf("String(): %v, want prefix %v", got, want)
+	}
+}
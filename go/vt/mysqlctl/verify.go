@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// VerifyParams is the struct that holds all params passed to ExecuteVerify
+type VerifyParams struct {
+	Logger logutil.Logger
+	// Concurrency determines how many files are verified in parallel
+	Concurrency int
+}
+
+// VerifyEngine is the interface to verify a backup with a given engine,
+// without restoring it.
+type VerifyEngine interface {
+	ExecuteVerify(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle) error
+}
+
+// VerifyBackup downloads a backup and checks its files against its
+// MANIFEST. It returns the MANIFEST of the backup if it is valid.
+func VerifyBackup(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	manifest, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't get backup MANIFEST")
+	}
+	re, err := GetRestoreEngine(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	ve, ok := re.(VerifyEngine)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "backups created with %q engine can't be verified", manifest.BackupMethod)
+	}
+
+	params.Logger.Infof("Verifying backup %v at position %v", bh.Name(), manifest.Position)
+	if err := ve.ExecuteVerify(ctx, params, bh); err != nil {
+		return nil, vterrors.Wrapf(err, "backup %v is not valid", bh.Name())
+	}
+	params.Logger.Infof("Backup %v is valid", bh.Name())
+	return manifest, nil
+}
+
+// verifyFiles downloads the files of a backup and checks their hashes.
+// Unless they went through a transform hook, the files are also
+// decompressed to check they can be.
+func verifyFiles(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle, fes []FileEntry, transformHook string, compression string) error {
+	sema := sync2.NewSemaphore(params.Concurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i := range fes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Wait until we are ready to go, skip if we already
+			// encountered an error.
+			sema.Acquire()
+			defer sema.Release()
+			if rec.HasErrors() {
+				return
+			}
+
+			name := fmt.Sprintf("%v", i)
+			params.Logger.Infof("Verifying file %v: %v", name, fes[i].Name)
+			if err := verifyFile(ctx, bh, &fes[i], transformHook, compression, name); err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "can't verify file %v of %v", name, fes[i].Name))
+			}
+		}(i)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// verifyFile downloads an individual file and checks its hash.
+func verifyFile(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compression string, name string) error {
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open source file for reading")
+	}
+	defer source.Close()
+
+	hasher := newHasher()
+	reader := io.TeeReader(source, hasher)
+
+	// The hook may be needed to decompress the file, so it is only
+	// checked when no hook was used.
+	if transformHook == "" && compression != compressionNone {
+		decompressor, err := newDecompressor(compression, reader)
+		if err != nil {
+			return err
+		}
+		defer decompressor.Close()
+		if _, err := io.Copy(ioutil.Discard, decompressor); err != nil {
+			return vterrors.Wrapf(err, "can't decompress the file with %v", compression)
+		}
+	}
+
+	// Read what the decompressor left, so it is all hashed.
+	if _, err := io.Copy(ioutil.Discard, reader); err != nil {
+		return vterrors.Wrap(err, "failed to read file contents")
+	}
+
+	hash := hasher.HashString()
+	if hash != fe.Hash {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "hash mismatch for %v, got %v expected %v", fe.Name, hash, fe.Hash)
+	}
+	return nil
+}
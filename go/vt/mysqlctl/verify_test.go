@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// memBackupHandle is a read-only backup with its files in memory.
+type memBackupHandle struct {
+	backupstorage.BackupHandle
+	files map[string][]byte
+}
+
+func (mbh *memBackupHandle) Name() string {
+	return "mem"
+}
+
+func (mbh *memBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	data, ok := mbh.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("no file %v", filename)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// newMemBuiltinBackup returns a builtin backup of contents, compressed
+// with compression.
+func newMemBuiltinBackup(t *testing.T, compression string, contents ...string) *memBackupHandle {
+	t.Helper()
+	mbh := &memBackupHandle{files: make(map[string][]byte)}
+	bm := builtinBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: builtinBackupEngineName,
+		},
+		SkipCompress:     compression == compressionNone,
+		CompressionCodec: compression,
+	}
+	for i, content := range contents {
+		buf := &bytes.Buffer{}
+		compressor, err := newCompressor(compression, buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := compressor.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		if err := compressor.Close(); err != nil {
+			t.Fatal(err)
+		}
+		hasher := newHasher()
+		hasher.Write(buf.Bytes())
+		name := fmt.Sprintf("%v", i)
+		mbh.files[name] = buf.Bytes()
+		bm.FileEntries = append(bm.FileEntries, FileEntry{
+			Base: backupData,
+			Name: fmt.Sprintf("file%v", i),
+			Hash: hasher.HashString(),
+		})
+	}
+	data, err := json.Marshal(bm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mbh.files[backupManifestFileName] = data
+	return mbh
+}
+
+func TestVerifyBackup(t *testing.T) {
+	params := VerifyParams{
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 2,
+	}
+	for _, compression := range []string{compressionPgzip, compressionZstd, compressionNone} {
+		mbh := newMemBuiltinBackup(t, compression, "first file", "second file", strings.Repeat("third file", 1000))
+		if _, err := VerifyBackup(context.Background(), params, mbh); err != nil {
+			t.Errorf("%v: VerifyBackup failed: %v", compression, err)
+		}
+
+		// Corrupt the last byte of a file.
+		data := mbh.files["1"]
+		data[len(data)-1]++
+		_, err := VerifyBackup(context.Background(), params, mbh)
+		if err == nil {
+			t.Errorf("%v: VerifyBackup of a corrupted backup succeeded", compression)
+		} else if compression == compressionNone && !strings.Contains(err.Error(), "hash mismatch for file1") {
+			t.Errorf("%v: VerifyBackup of a corrupted backup returned %v, want a hash mismatch", compression, err)
+		}
+	}
+}
+
+func TestVerifyBackupMissingFile(t *testing.T) {
+	mbh := newMemBuiltinBackup(t, compressionPgzip, "first file", "second file")
+	delete(mbh.files, "0")
+	params := VerifyParams{
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+	}
+	if _, err := VerifyBackup(context.Background(), params, mbh); err == nil || !strings.Contains(err.Error(), "no file 0") {
+		t.Errorf("VerifyBackup of a backup without file 0 returned %v, want an error", err)
+	}
+}
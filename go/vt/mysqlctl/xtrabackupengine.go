@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
@@ -31,7 +32,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -56,7 +56,7 @@ var (
 	// flags to pass through to extract phase of restore
 	xbstreamRestoreFlags = flag.String("xbstream_restore_flags", "", "flags to pass to xbstream command during restore. These should be space separated and will be added to the end of the command. These need to match the ones used for backup e.g. --compress / --decompress, --encrypt / --decrypt")
 	// streaming mode
-	xtrabackupStreamMode = flag.String("xtrabackup_stream_mode", "tar", "which mode to use if streaming, valid values are tar and xbstream")
+	xtrabackupStreamMode = flag.String("xtrabackup_stream_mode", "tar", "format of the stream the backup is sent in, directly to the backup storage without staging it on the local disk. Valid values are tar and xbstream")
 	xtrabackupUser       = flag.String("xtrabackup_user", "", "User that xtrabackup will use to connect to the database server. This user must have all necessary privileges. For details, please refer to xtrabackup documentation.")
 	// striping mode
 	xtrabackupStripes         = flag.Uint("xtrabackup_stripes", 0, "If greater than 0, use data striping across this many destination files to parallelize data transfer and decompression")
@@ -75,7 +75,7 @@ const (
 
 // xtraBackupManifest represents a backup.
 // It stores the name of the backup file, the replication position,
-// whether the backup is compressed using gzip, and any extra
+// the codec the backup is compressed with, and any extra
 // command line parameters used while invoking it.
 type xtraBackupManifest struct {
 	// BackupManifest is an anonymous embedding of the base manifest struct.
@@ -97,17 +97,33 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionCodec is the codec the backup file was compressed with.
+	// It is empty for the backups taken before the field existed, which
+	// were compressed with pgzip unless SkipCompress is set.
+	CompressionCodec string
+}
+
+// checkStreamMode checks the backup can be streamed in mode. Without a
+// stream mode, xtrabackup would write the backup to the local disk
+// instead of its output, which is uploaded to the backup storage.
+func checkStreamMode(mode string) error {
+	switch mode {
+	case streamModeTar, xbstream:
+		return nil
+	case "":
+		return vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackup_stream_mode must be specified, the backup is streamed to the backup storage")
+	}
+	return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown xtrabackup_stream_mode %v, valid values are %v and %v", mode, streamModeTar, xbstream)
 }
 
-func (be *XtrabackupEngine) backupFileName() string {
+func (be *XtrabackupEngine) backupFileName(compression string) string {
 	fileName := "backup"
 	if *xtrabackupStreamMode != "" {
 		fileName += "."
 		fileName += *xtrabackupStreamMode
 	}
-	if *backupStorageCompress {
-		fileName += ".gz"
-	}
+	fileName += compressionExtension(compression)
 	return fileName
 }
 
@@ -128,6 +144,9 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	if *xtrabackupUser == "" {
 		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackupUser must be specified.")
 	}
+	if err := checkStreamMode(*xtrabackupStreamMode); err != nil {
+		return false, err
+	}
 	// use a mysql connection to detect flavor at runtime
 	conn, err := params.Mysqld.GetDbaConnection()
 	if conn != nil && err == nil {
@@ -144,7 +163,11 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	flavor := pos.GTIDSet.Flavor()
 	params.Logger.Infof("Detected MySQL flavor: %v", flavor)
 
-	backupFileName := be.backupFileName()
+	compression, err := backupCompression()
+	if err != nil {
+		return false, err
+	}
+	backupFileName := be.backupFileName(compression)
 	numStripes := int(*xtrabackupStripes)
 
 	// Perform backups in a separate function, so deferred calls to Close() are
@@ -152,8 +175,8 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	// do not write the MANIFEST unless all files were closed successfully,
 	// maintaining the contract that a MANIFEST file should only exist if the
 	// backup was created successfully.
-	params.Logger.Infof("Starting backup with %v stripe(s)", numStripes)
-	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor)
+	params.Logger.Infof("Starting backup with %v stripe(s), streaming the %v output to %v", numStripes, *xtrabackupStreamMode, backupFileName)
+	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor, compression)
 	if err != nil {
 		return false, err
 	}
@@ -177,12 +200,13 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		},
 
 		// XtraBackup-specific fields
-		FileName:        backupFileName,
-		StreamMode:      *xtrabackupStreamMode,
-		SkipCompress:    !*backupStorageCompress,
-		Params:          *xtrabackupBackupFlags,
-		NumStripes:      int32(numStripes),
-		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
+		FileName:         backupFileName,
+		StreamMode:       *xtrabackupStreamMode,
+		SkipCompress:     compression == compressionNone,
+		CompressionCodec: compression,
+		Params:           *xtrabackupBackupFlags,
+		NumStripes:       int32(numStripes),
+		StripeBlockSize:  int32(*xtrabackupStripeBlockSize),
 	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
@@ -197,7 +221,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	return true, nil
 }
 
-func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string) (replicationPosition mysql.Position, finalErr error) {
+func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string, compression string) (replicationPosition mysql.Position, finalErr error) {
 
 	backupProgram := path.Join(*xtrabackupEnginePath, xtrabackupBinaryName)
 	flagsToExec := []string{"--defaults-file=" + params.Cnf.path,
@@ -206,9 +230,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		"--slave-info",
 		"--user=" + *xtrabackupUser,
 		"--target-dir=" + params.Cnf.TmpDir,
-	}
-	if *xtrabackupStreamMode != "" {
-		flagsToExec = append(flagsToExec, "--stream="+*xtrabackupStreamMode)
+		"--stream=" + *xtrabackupStreamMode,
 	}
 	if *xtrabackupBackupFlags != "" {
 		flagsToExec = append(flagsToExec, strings.Fields(*xtrabackupBackupFlags)...)
@@ -269,19 +291,19 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
-	destCompressors := []*pgzip.Writer{}
+	destCompressors := []io.WriteCloser{}
+	limiter := newTransferLimiter(*backupUploadRateLimit)
 	for _, file := range destFiles {
-		buffer := bufio.NewWriterSize(file, writerBufferSize)
+		buffer := bufio.NewWriterSize(throttleWriter(ctx, file, limiter), writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
-		if *backupStorageCompress {
-			compressor, err := pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+		// Create the compression pipe, if necessary.
+		if compression != compressionNone {
+			compressor, err := newCompressor(compression, writer)
 			if err != nil {
-				return replicationPosition, vterrors.Wrap(err, "cannot create gzip compressor")
+				return replicationPosition, err
 			}
-			compressor.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -341,7 +363,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrapf(err, "cannot close %v compressor", compression)
 		}
 	}
 
@@ -497,14 +519,14 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	// Pull details from the MANIFEST where available, so we can still restore
 	// backups taken with different flags. Some fields were not always present,
 	// so if necessary we default to the flag values.
-	compressed := !bm.SkipCompress
+	compression := manifestCompression(bm.CompressionCodec, bm.SkipCompress)
 	streamMode := bm.StreamMode
 	if streamMode == "" {
 		streamMode = *xtrabackupStreamMode
 	}
 	baseFileName := bm.FileName
 	if baseFileName == "" {
-		baseFileName = be.backupFileName()
+		baseFileName = be.backupFileName(compression)
 	}
 
 	// Open the source files for reading.
@@ -519,15 +541,16 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	}()
 
 	srcReaders := []io.Reader{}
-	srcDecompressors := []*pgzip.Reader{}
+	srcDecompressors := []io.ReadCloser{}
+	limiter := newTransferLimiter(*backupDownloadRateLimit)
 	for _, file := range srcFiles {
-		reader := io.Reader(file)
+		reader := throttleReader(ctx, file, limiter)
 
 		// Create the decompressor if needed.
-		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+		if compression != compressionNone {
+			decompressor, err := newDecompressor(compression, reader)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return err
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -538,7 +561,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close %v decompressor: %v", compression, cerr)
 			}
 		}
 	}()
@@ -666,7 +689,7 @@ func addStripeFiles(ctx context.Context, params BackupParams, backupHandle backu
 	// Compute total size of all files we will backup.
 	// We delegate the actual backing up to xtrabackup which streams
 	// the files as a single archive (tar / xbstream), which might
-	// further be compressed using gzip.
+	// further be compressed.
 	// This approximate total size is passed in to AddFile so that
 	// storage plugins can make appropriate choices for parameters
 	// like partSize in multi-part uploads
@@ -815,6 +838,47 @@ func stripeReader(readers []io.Reader, blockSize int64) io.Reader {
 	return reader
 }
 
+// ExecuteVerify downloads the backup file, or its stripes. The MANIFEST
+// has no hashes of the files, so they are only checked to decompress.
+func (be *XtrabackupEngine) ExecuteVerify(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle) error {
+	var bm xtraBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return err
+	}
+	compression := manifestCompression(bm.CompressionCodec, bm.SkipCompress)
+	baseFileName := bm.FileName
+	if baseFileName == "" {
+		baseFileName = be.backupFileName(compression)
+	}
+
+	srcFiles, err := readStripeFiles(ctx, bh, baseFileName, int(bm.NumStripes), params.Logger)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot open backup file %v", baseFileName)
+	}
+	defer func() {
+		for _, file := range srcFiles {
+			file.Close()
+		}
+	}()
+
+	params.Logger.Infof("Verify: reading %v with %v stripe(s)", baseFileName, len(srcFiles))
+	for i, file := range srcFiles {
+		reader := io.Reader(file)
+		if compression != compressionNone {
+			decompressor, err := newDecompressor(compression, reader)
+			if err != nil {
+				return err
+			}
+			defer decompressor.Close()
+			reader = decompressor
+		}
+		if _, err := io.Copy(ioutil.Discard, reader); err != nil {
+			return vterrors.Wrapf(err, "can't read stripe %v of %v", i, baseFileName)
+		}
+	}
+	return nil
+}
+
 // ShouldDrainForBackup satisfies the BackupEngine interface
 // xtrabackup can run while tablet is serving, hence false
 func (be *XtrabackupEngine) ShouldDrainForBackup() bool {
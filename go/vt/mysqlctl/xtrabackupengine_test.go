@@ -115,3 +115,16 @@ func TestStripeRoundTrip(t *testing.T) {
 	// Test block size and stripe count that don't evenly divide data size.
 	test(6000, 7)
 }
+
+func TestCheckStreamMode(t *testing.T) {
+	for _, mode := range []string{streamModeTar, xbstream} {
+		if err := checkStreamMode(mode); err != nil {
+			t.Errorf("checkStreamMode(%v) failed: %v", mode, err)
+		}
+	}
+	for _, mode := range []string{"", "cpio"} {
+		if err := checkStreamMode(mode); err == nil {
+			t.Errorf("checkStreamMode(%q) succeeded, want an error", mode)
+		}
+	}
+}
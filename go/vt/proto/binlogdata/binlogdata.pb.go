@@ -746,12 +746,27 @@ type BinlogSource struct {
 	Filter *Filter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
 	// OnDdl specifies the action to be taken when a DDL is encountered.
 	OnDdl OnDDLAction `protobuf:"varint,7,opt,name=on_ddl,json=onDdl,proto3,enum=binlogdata.OnDDLAction" json:"on_ddl,omitempty"`
-	// Source is an external mysql. This attribute should be set to the username
-	// to use in the connection
+	// Source is an external mysql. This attribute is the name of the external
+	// mysql in the vreplication_external_mysql_config file of the tablet. If the
+	// file is not configured, the erepl db config is used for the connection.
 	ExternalMysql string `protobuf:"bytes,8,opt,name=external_mysql,json=externalMysql,proto3" json:"external_mysql,omitempty"`
 	// StopAfterCopy specifies if vreplication should be stopped
 	// after copying is done.
-	StopAfterCopy        bool     `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
+	StopAfterCopy bool `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
+	// MaxSourceThreadsRunning throttles vreplication while the
+	// Threads_running status of the source is higher. 0 disables it.
+	MaxSourceThreadsRunning int64 `protobuf:"varint,10,opt,name=max_source_threads_running,json=maxSourceThreadsRunning,proto3" json:"max_source_threads_running,omitempty"`
+	// Compression is the gRPC compressor of the events streamed
+	// from the source, like "zstd". Empty means no compression.
+	Compression string `protobuf:"bytes,11,opt,name=compression,proto3" json:"compression,omitempty"`
+	// ParallelApplyWorkers is the number of connections which apply
+	// the row changes in parallel. 1 applies them serially, 0 uses
+	// the vreplication_parallel_apply_workers flag of the tablet.
+	ParallelApplyWorkers int64 `protobuf:"varint,12,opt,name=parallel_apply_workers,json=parallelApplyWorkers,proto3" json:"parallel_apply_workers,omitempty"`
+	// ParallelApplyMode is how the row changes are distributed to the
+	// parallel apply workers, "pk" or "writeset". Empty uses the
+	// vreplication_parallel_apply_mode flag of the tablet.
+	ParallelApplyMode    string   `protobuf:"bytes,13,opt,name=parallel_apply_mode,json=parallelApplyMode,proto3" json:"parallel_apply_mode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -845,6 +860,34 @@ func (m *BinlogSource) GetStopAfterCopy() bool {
 	return false
 }
 
+func (m *BinlogSource) GetMaxSourceThreadsRunning() int64 {
+	if m != nil {
+		return m.MaxSourceThreadsRunning
+	}
+	return 0
+}
+
+func (m *BinlogSource) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *BinlogSource) GetParallelApplyWorkers() int64 {
+	if m != nil {
+		return m.ParallelApplyWorkers
+	}
+	return 0
+}
+
+func (m *BinlogSource) GetParallelApplyMode() string {
+	if m != nil {
+		return m.ParallelApplyMode
+	}
+	return ""
+}
+
 // RowChange represents one row change.
 // If Before is set and not After, it's a delete.
 // If After is set and not Before, it's an insert.
@@ -1165,7 +1208,12 @@ type Journal struct {
 	// were migrated to the target. If a migration fails after a Journal
 	// is committed, this information is used to start the target streams
 	// that were created prior to the creation of the journal.
-	SourceWorkflows      []string `protobuf:"bytes,7,rep,name=source_workflows,json=sourceWorkflows,proto3" json:"source_workflows,omitempty"`
+	SourceWorkflows []string `protobuf:"bytes,7,rep,name=source_workflows,json=sourceWorkflows,proto3" json:"source_workflows,omitempty"`
+	// SourceKeyspaces is the list of source keyspaces of a TABLES migration
+	// whose writes were switched together. Each of them has a journal with
+	// the same id and ShardGtids, which are the consistent cutover point,
+	// for its own tables and participants.
+	SourceKeyspaces      []string `protobuf:"bytes,8,rep,name=source_keyspaces,json=sourceKeyspaces,proto3" json:"source_keyspaces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1245,6 +1293,13 @@ func (m *Journal) GetSourceWorkflows() []string {
 	return nil
 }
 
+func (m *Journal) GetSourceKeyspaces() []string {
+	if m != nil {
+		return m.SourceKeyspaces
+	}
+	return nil
+}
+
 // VEvent represents a vstream event.
 // A FieldEvent is sent once for every table, just before
 // the first event for that table. The client is expected
@@ -1789,112 +1844,119 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0x23, 0x49,
-	0x11, 0x9e, 0xd6, 0x5b, 0xd9, 0xb6, 0xdc, 0x2e, 0x3f, 0x10, 0x13, 0x2c, 0xe1, 0xed, 0x60, 0x76,
-	0xbc, 0x8e, 0x40, 0x06, 0x01, 0xc3, 0x69, 0x59, 0xf4, 0x68, 0x7b, 0x34, 0xd3, 0x92, 0x3c, 0xa5,
-	0x1e, 0x0f, 0xb1, 0x97, 0x8e, 0xb6, 0x54, 0xf6, 0x34, 0xee, 0xd7, 0x74, 0x97, 0xec, 0xd5, 0x0f,
-	0x20, 0xf8, 0x01, 0xfc, 0x0a, 0xce, 0x5c, 0xe1, 0xca, 0x9d, 0x3b, 0x57, 0x4e, 0x9c, 0xf8, 0x07,
-	0x44, 0x3d, 0xba, 0xd5, 0xad, 0x59, 0x76, 0x3c, 0x1b, 0xc1, 0x01, 0x2e, 0x8a, 0xac, 0xec, 0xcc,
-	0xac, 0xcc, 0x2f, 0x1f, 0x55, 0x25, 0xd0, 0xae, 0xdc, 0xc0, 0x0b, 0x6f, 0x16, 0x0e, 0x75, 0x3a,
-	0x51, 0x1c, 0xd2, 0x10, 0xc1, 0x9a, 0xf3, 0x58, 0xbd, 0xa3, 0x71, 0x34, 0x17, 0x1f, 0x1e, 0xab,
-	0xef, 0x96, 0x24, 0x5e, 0xc9, 0x45, 0x8b, 0x86, 0x51, 0xb8, 0xd6, 0xd2, 0xc7, 0x50, 0x1f, 0xbc,
-	0x75, 0xe2, 0x84, 0x50, 0x74, 0x08, 0xb5, 0xb9, 0xe7, 0x92, 0x80, 0xb6, 0x95, 0x23, 0xe5, 0xb8,
-	0x8a, 0xe5, 0x0a, 0x21, 0xa8, 0xcc, 0xc3, 0x20, 0x68, 0x97, 0x38, 0x97, 0xd3, 0x4c, 0x36, 0x21,
-	0xf1, 0x1d, 0x89, 0xdb, 0x65, 0x21, 0x2b, 0x56, 0xfa, 0x3f, 0xca, 0xb0, 0xdb, 0xe7, 0x7e, 0x58,
-	0xb1, 0x13, 0x24, 0xce, 0x9c, 0xba, 0x61, 0x80, 0xce, 0x01, 0x12, 0xea, 0x50, 0xe2, 0x93, 0x80,
-	0x26, 0x6d, 0xe5, 0xa8, 0x7c, 0xac, 0x76, 0x9f, 0x76, 0x72, 0x11, 0xbc, 0xa7, 0xd2, 0x99, 0xa5,
-	0xf2, 0x38, 0xa7, 0x8a, 0xba, 0xa0, 0x92, 0x3b, 0x12, 0x50, 0x9b, 0x86, 0xb7, 0x24, 0x68, 0x57,
-	0x8e, 0x94, 0x63, 0xb5, 0xbb, 0xdb, 0x11, 0x01, 0x1a, 0xec, 0x8b, 0xc5, 0x3e, 0x60, 0x20, 0x19,
-	0xfd, 0xf8, 0xaf, 0x25, 0x68, 0x66, 0xd6, 0x90, 0x09, 0x8d, 0xb9, 0x43, 0xc9, 0x4d, 0x18, 0xaf,
-	0x78, 0x98, 0xad, 0xee, 0x4f, 0x1e, 0xe8, 0x48, 0x67, 0x20, 0xf5, 0x70, 0x66, 0x01, 0xfd, 0x18,
-	0xea, 0x73, 0x81, 0x1e, 0x47, 0x47, 0xed, 0xee, 0xe5, 0x8d, 0x49, 0x60, 0x71, 0x2a, 0x83, 0x34,
-	0x28, 0x27, 0xef, 0x3c, 0x0e, 0xd9, 0x16, 0x66, 0xa4, 0xfe, 0x47, 0x05, 0x1a, 0xa9, 0x5d, 0xb4,
-	0x07, 0x3b, 0x7d, 0xd3, 0x7e, 0x3d, 0xc1, 0xc6, 0x60, 0x7a, 0x3e, 0x19, 0x7d, 0x65, 0x0c, 0xb5,
-	0x47, 0x68, 0x0b, 0x1a, 0x7d, 0xd3, 0xee, 0x1b, 0xe7, 0xa3, 0x89, 0xa6, 0xa0, 0x6d, 0x68, 0xf6,
-	0x4d, 0x7b, 0x30, 0x1d, 0x8f, 0x47, 0x96, 0x56, 0x42, 0x3b, 0xa0, 0xf6, 0x4d, 0x1b, 0x4f, 0x4d,
-	0xb3, 0xdf, 0x1b, 0xbc, 0xd4, 0xca, 0xe8, 0x00, 0x76, 0xfb, 0xa6, 0x3d, 0x1c, 0x9b, 0xf6, 0xd0,
-	0xb8, 0xc0, 0xc6, 0xa0, 0x67, 0x19, 0x43, 0xad, 0x82, 0x00, 0x6a, 0x8c, 0x3d, 0x34, 0xb5, 0xaa,
-	0xa4, 0x67, 0x86, 0xa5, 0xd5, 0xa4, 0xb9, 0xd1, 0x64, 0x66, 0x60, 0x4b, 0xab, 0xcb, 0xe5, 0xeb,
-	0x8b, 0x61, 0xcf, 0x32, 0xb4, 0x86, 0x5c, 0x0e, 0x0d, 0xd3, 0xb0, 0x0c, 0xad, 0xf9, 0xa2, 0xd2,
-	0x28, 0x69, 0xe5, 0x17, 0x95, 0x46, 0x59, 0xab, 0xe8, 0x7f, 0x50, 0xe0, 0x60, 0x46, 0x63, 0xe2,
-	0xf8, 0x2f, 0xc9, 0x0a, 0x3b, 0xc1, 0x0d, 0xc1, 0xe4, 0xdd, 0x92, 0x24, 0x14, 0x3d, 0x86, 0x46,
-	0x14, 0x26, 0x2e, 0xc3, 0x8e, 0x03, 0xdc, 0xc4, 0xd9, 0x1a, 0x9d, 0x42, 0xf3, 0x96, 0xac, 0xec,
-	0x98, 0xc9, 0x4b, 0xc0, 0x50, 0x27, 0x2b, 0xc8, 0xcc, 0x52, 0xe3, 0x56, 0x52, 0x79, 0x7c, 0xcb,
-	0x1f, 0xc6, 0x57, 0xbf, 0x86, 0xc3, 0x4d, 0xa7, 0x92, 0x28, 0x0c, 0x12, 0x82, 0x4c, 0x40, 0x42,
-	0xd1, 0xa6, 0xeb, 0xdc, 0x72, 0xff, 0xd4, 0xee, 0x27, 0xdf, 0x5a, 0x00, 0x78, 0xf7, 0x6a, 0x93,
-	0xa5, 0x7f, 0x0d, 0x7b, 0x62, 0x1f, 0xcb, 0xb9, 0xf2, 0x48, 0xf2, 0x90, 0xd0, 0x0f, 0xa1, 0x46,
-	0xb9, 0x70, 0xbb, 0x74, 0x54, 0x3e, 0x6e, 0x62, 0xb9, 0xfa, 0xd8, 0x08, 0x17, 0xb0, 0x5f, 0xdc,
-	0xf9, 0xbf, 0x12, 0xdf, 0xcf, 0xa1, 0x82, 0x97, 0x1e, 0x41, 0xfb, 0x50, 0xf5, 0x1d, 0x3a, 0x7f,
-	0x2b, 0xa3, 0x11, 0x0b, 0x16, 0xca, 0xb5, 0xeb, 0x51, 0x12, 0xf3, 0x14, 0x36, 0xb1, 0x5c, 0xe9,
-	0x7f, 0x52, 0xa0, 0x76, 0xc6, 0x49, 0xf4, 0x19, 0x54, 0xe3, 0x25, 0x0b, 0x56, 0xf4, 0xba, 0x96,
-	0xf7, 0x80, 0x59, 0xc6, 0xe2, 0x33, 0x1a, 0x41, 0xeb, 0xda, 0x25, 0xde, 0x82, 0xb7, 0xee, 0x38,
-	0x5c, 0x88, 0xaa, 0x68, 0x75, 0x3f, 0xcd, 0x2b, 0x08, 0x9b, 0x9d, 0xb3, 0x82, 0x20, 0xde, 0x50,
-	0xd4, 0x9f, 0x41, 0xab, 0x28, 0xc1, 0xda, 0xc9, 0xc0, 0xd8, 0x9e, 0x4e, 0xec, 0xf1, 0x68, 0x36,
-	0xee, 0x59, 0x83, 0xe7, 0xda, 0x23, 0xde, 0x31, 0xc6, 0xcc, 0xb2, 0x8d, 0xb3, 0xb3, 0x29, 0xb6,
-	0x34, 0x45, 0xff, 0x67, 0x09, 0xb6, 0x04, 0x28, 0xb3, 0x70, 0x19, 0xcf, 0x09, 0xcb, 0xe2, 0x2d,
-	0x59, 0x25, 0x91, 0x33, 0x27, 0x69, 0x16, 0xd3, 0x35, 0x03, 0x24, 0x79, 0xeb, 0xc4, 0x0b, 0x19,
-	0xb9, 0x58, 0xa0, 0x5f, 0x80, 0xca, 0xb3, 0x49, 0x6d, 0xba, 0x8a, 0x08, 0xcf, 0x63, 0xab, 0xbb,
-	0xbf, 0x2e, 0x6c, 0x9e, 0x2b, 0x6a, 0xad, 0x22, 0x82, 0x81, 0x66, 0x74, 0xb1, 0x1b, 0x2a, 0x0f,
-	0xe8, 0x86, 0x75, 0x0d, 0x55, 0x0b, 0x35, 0x74, 0x92, 0x25, 0xa4, 0x26, 0xad, 0xbc, 0x87, 0x5e,
-	0x9a, 0x24, 0xd4, 0x81, 0x5a, 0x18, 0xd8, 0x8b, 0x85, 0xd7, 0xae, 0x73, 0x37, 0xbf, 0x97, 0x97,
-	0x9d, 0x06, 0xc3, 0xa1, 0xd9, 0x13, 0x65, 0x51, 0x0d, 0x83, 0xe1, 0xc2, 0x43, 0x4f, 0xa0, 0x45,
-	0xbe, 0xa6, 0x24, 0x0e, 0x1c, 0xcf, 0xf6, 0x57, 0x6c, 0x7a, 0x35, 0x78, 0xe8, 0xdb, 0x29, 0x77,
-	0xcc, 0x98, 0xe8, 0x33, 0xd8, 0x49, 0x68, 0x18, 0xd9, 0xce, 0x35, 0x25, 0xb1, 0x3d, 0x0f, 0xa3,
-	0x55, 0xbb, 0x79, 0xa4, 0x1c, 0x37, 0xf0, 0x36, 0x63, 0xf7, 0x18, 0x77, 0x10, 0x46, 0x2b, 0xfd,
-	0x15, 0x34, 0x71, 0x78, 0x3f, 0x78, 0xcb, 0xe3, 0xd1, 0xa1, 0x76, 0x45, 0xae, 0xc3, 0x98, 0xc8,
-	0x42, 0x05, 0x39, 0xc8, 0x71, 0x78, 0x8f, 0xe5, 0x17, 0x74, 0x04, 0x55, 0x6e, 0x53, 0x8e, 0x8b,
-	0xbc, 0x88, 0xf8, 0xa0, 0x3b, 0xd0, 0xc0, 0xe1, 0x3d, 0x4f, 0x3b, 0xfa, 0x04, 0x04, 0xc0, 0x76,
-	0xe0, 0xf8, 0x69, 0xf6, 0x9a, 0x9c, 0x33, 0x71, 0x7c, 0x82, 0x9e, 0x81, 0x1a, 0x87, 0xf7, 0xf6,
-	0x9c, 0x6f, 0x2f, 0x3a, 0x51, 0xed, 0x1e, 0x14, 0x8a, 0x33, 0x75, 0x0e, 0x43, 0x9c, 0x92, 0x89,
-	0xfe, 0x0a, 0x60, 0x5d, 0x5b, 0x1f, 0xda, 0xe4, 0x47, 0x2c, 0x1b, 0xc4, 0x5b, 0xa4, 0xf6, 0xb7,
-	0xa4, 0xcb, 0xdc, 0x02, 0x96, 0xdf, 0x18, 0x10, 0x33, 0x56, 0x3c, 0xe7, 0xd4, 0x5d, 0x7c, 0x87,
-	0x92, 0x43, 0x50, 0xb9, 0xa1, 0xee, 0x82, 0xd7, 0x5a, 0x13, 0x73, 0x5a, 0xff, 0x12, 0xaa, 0x97,
-	0xdc, 0xdc, 0x33, 0x50, 0xb9, 0x94, 0xcd, 0xd8, 0x69, 0x0f, 0x16, 0xc2, 0xcc, 0xb6, 0xc6, 0x90,
-	0xa4, 0x64, 0xa2, 0xf7, 0x60, 0xfb, 0xa5, 0xdc, 0x96, 0x0b, 0x7c, 0xbc, 0x5f, 0xfa, 0x9f, 0x4b,
-	0x50, 0x7f, 0x11, 0x2e, 0x59, 0x61, 0xa0, 0x16, 0x94, 0xdc, 0x05, 0xd7, 0x2b, 0xe3, 0x92, 0xbb,
-	0x40, 0xbf, 0x86, 0x96, 0xef, 0xde, 0xc4, 0x0e, 0x2b, 0x2f, 0xd1, 0x29, 0xa2, 0xd9, 0xbf, 0x9f,
-	0xf7, 0x6c, 0x9c, 0x4a, 0xf0, 0x76, 0xd9, 0xf6, 0xf3, 0xcb, 0x5c, 0x03, 0x94, 0x0b, 0x0d, 0xf0,
-	0x04, 0x5a, 0x5e, 0x38, 0x77, 0x3c, 0x3b, 0x1b, 0xbf, 0x15, 0x51, 0xa4, 0x9c, 0x7b, 0x91, 0xce,
-	0xe0, 0x0d, 0x5c, 0xaa, 0x0f, 0xc4, 0x05, 0x7d, 0x01, 0x5b, 0x91, 0x13, 0x53, 0x77, 0xee, 0x46,
-	0x0e, 0xbb, 0xc0, 0xd4, 0xb8, 0x62, 0xc1, 0xed, 0x02, 0x6e, 0xb8, 0x20, 0x8e, 0x3e, 0x07, 0x2d,
-	0xe1, 0xa3, 0xc5, 0xbe, 0x0f, 0xe3, 0xdb, 0x6b, 0x2f, 0xbc, 0x4f, 0xda, 0x75, 0xee, 0xff, 0x8e,
-	0xe0, 0xbf, 0x49, 0xd9, 0xfa, 0xbf, 0x4a, 0x50, 0xbb, 0x14, 0x55, 0x76, 0x02, 0x15, 0x8e, 0x91,
-	0xb8, 0xa4, 0x1c, 0xe6, 0x37, 0x13, 0x12, 0x1c, 0x20, 0x2e, 0x83, 0x7e, 0x00, 0x4d, 0xea, 0xfa,
-	0x24, 0xa1, 0x8e, 0x1f, 0x71, 0x50, 0xcb, 0x78, 0xcd, 0xf8, 0xa6, 0x5a, 0x61, 0x37, 0x11, 0x36,
-	0x03, 0x04, 0x4c, 0x8c, 0x44, 0x3f, 0x85, 0x26, 0xeb, 0x0d, 0x7e, 0x71, 0x6a, 0x57, 0x79, 0xb3,
-	0xed, 0x6f, 0x74, 0x06, 0xdf, 0x16, 0x37, 0xe2, 0xb4, 0xdb, 0x7e, 0x09, 0x2a, 0xaf, 0x66, 0xa9,
-	0x24, 0x86, 0xcf, 0x61, 0x71, 0xf8, 0xa4, 0x5d, 0x83, 0x61, 0x3d, 0xaf, 0xd1, 0x53, 0xa8, 0xde,
-	0x71, 0x97, 0xea, 0xf2, 0x02, 0x97, 0x0f, 0x8e, 0xc3, 0x2f, 0xbe, 0xb3, 0xd3, 0xf1, 0xb7, 0xa2,
-	0x9a, 0xf8, 0xd8, 0xd9, 0x38, 0x1d, 0x65, 0xa1, 0xe1, 0x54, 0x86, 0x47, 0xe5, 0x7b, 0x7c, 0xf2,
-	0xb0, 0xa8, 0x7c, 0x0f, 0x7d, 0x0a, 0x5b, 0xf3, 0x65, 0x1c, 0xf3, 0x2b, 0xa3, 0xeb, 0x93, 0xf6,
-	0x3e, 0x07, 0x47, 0x95, 0x3c, 0xcb, 0xf5, 0x89, 0xfe, 0xfb, 0x12, 0xb4, 0x2e, 0xc5, 0xa1, 0x9a,
-	0x1e, 0xe4, 0x5f, 0xc2, 0x1e, 0xb9, 0xbe, 0x26, 0x73, 0xea, 0xde, 0x11, 0x7b, 0xee, 0x78, 0x1e,
-	0x89, 0x6d, 0x59, 0xca, 0x6a, 0x77, 0xa7, 0x23, 0x2e, 0xd7, 0x03, 0xce, 0x1f, 0x0d, 0xf1, 0x6e,
-	0x26, 0x2b, 0x59, 0x0b, 0x64, 0xc0, 0x9e, 0xeb, 0xfb, 0x64, 0xe1, 0x3a, 0x34, 0x6f, 0x40, 0xcc,
-	0xb0, 0x03, 0x39, 0x10, 0x2e, 0xad, 0x73, 0x87, 0x92, 0xb5, 0x99, 0x4c, 0x23, 0x33, 0xf3, 0x84,
-	0xd5, 0x7b, 0x7c, 0x93, 0xdd, 0x0d, 0xb6, 0xa5, 0xa6, 0xc5, 0x99, 0x58, 0x7e, 0x2c, 0xdc, 0x3b,
-	0x2a, 0x1b, 0xf7, 0x8e, 0xf5, 0xd9, 0x50, 0xfd, 0xd0, 0xd9, 0xa0, 0x7f, 0x01, 0x3b, 0x19, 0x10,
-	0xf2, 0x5e, 0x71, 0x02, 0x35, 0x9e, 0xdc, 0x74, 0x8a, 0xa0, 0xf7, 0xeb, 0x10, 0x4b, 0x09, 0xfd,
-	0x77, 0x25, 0x40, 0xa9, 0x7e, 0x78, 0x9f, 0xfc, 0x8f, 0x82, 0xb9, 0x0f, 0x55, 0xce, 0x97, 0x48,
-	0x8a, 0x05, 0xc3, 0xc1, 0x73, 0x12, 0x1a, 0xdd, 0x66, 0x30, 0x0a, 0xe5, 0x57, 0xec, 0x17, 0x93,
-	0x64, 0xe9, 0x51, 0x2c, 0x25, 0xf4, 0xbf, 0x28, 0xb0, 0x57, 0xc0, 0x41, 0x62, 0xb9, 0x3e, 0x18,
-	0x94, 0xff, 0x7c, 0x30, 0xa0, 0x63, 0x68, 0x44, 0xb7, 0xdf, 0x72, 0x80, 0x64, 0x5f, 0xbf, 0xb1,
-	0xaf, 0x7f, 0x08, 0x95, 0x98, 0xcd, 0x97, 0x0a, 0xd7, 0xcc, 0x9f, 0x96, 0x9c, 0xcf, 0x8e, 0xdc,
-	0x42, 0x1c, 0x85, 0x23, 0x57, 0xfa, 0xff, 0x77, 0x05, 0x0e, 0xd6, 0x75, 0xb0, 0xf4, 0xe8, 0xff,
-	0x55, 0x2a, 0xf5, 0x18, 0x0e, 0x37, 0xa3, 0xfb, 0xa8, 0x04, 0x7d, 0x07, 0xd8, 0x4f, 0x7e, 0x05,
-	0x6a, 0xee, 0x6e, 0xc5, 0x9e, 0x60, 0xa3, 0xf3, 0xc9, 0x14, 0x1b, 0xda, 0x23, 0xd4, 0x80, 0xca,
-	0xcc, 0x9a, 0x5e, 0x68, 0x0a, 0xa3, 0x8c, 0xdf, 0x18, 0x03, 0xf1, 0xac, 0x63, 0x94, 0x2d, 0x85,
-	0xca, 0x27, 0x7f, 0x53, 0x00, 0xd6, 0x53, 0x1f, 0xa9, 0x50, 0x7f, 0x3d, 0x79, 0x39, 0x99, 0xbe,
-	0x99, 0x08, 0x03, 0xe7, 0xd6, 0x68, 0xa8, 0x29, 0xa8, 0x09, 0x55, 0xf1, 0x4e, 0x2c, 0xb1, 0x1d,
-	0xe4, 0x23, 0xb1, 0xcc, 0x5e, 0x90, 0xd9, 0x0b, 0xb1, 0x82, 0xea, 0x50, 0xce, 0xde, 0x81, 0xf2,
-	0xe1, 0x57, 0x63, 0x06, 0xb1, 0x71, 0x61, 0xf6, 0x06, 0x86, 0x56, 0x67, 0x1f, 0xb2, 0x27, 0x20,
-	0x40, 0x2d, 0x7d, 0xff, 0x31, 0x4d, 0xf6, 0x6a, 0x04, 0xb6, 0xcf, 0xd4, 0x7a, 0x6e, 0x60, 0x4d,
-	0x65, 0x3c, 0x3c, 0x7d, 0xa3, 0x6d, 0x31, 0xde, 0xd9, 0xc8, 0x30, 0x87, 0xda, 0x36, 0x7b, 0x36,
-	0x3e, 0x37, 0x7a, 0xd8, 0xea, 0x1b, 0x3d, 0x4b, 0x6b, 0xb1, 0x2f, 0x97, 0xdc, 0xc1, 0x1d, 0xb6,
-	0xcd, 0x8b, 0xe9, 0x6b, 0x3c, 0xe9, 0x99, 0x9a, 0x76, 0xf2, 0x14, 0xb6, 0x0b, 0x87, 0x3d, 0xdb,
-	0xcb, 0xea, 0xf5, 0x4d, 0x63, 0xa6, 0x3d, 0x62, 0xf4, 0xec, 0x79, 0x0f, 0x0f, 0x67, 0x9a, 0xd2,
-	0xff, 0xfc, 0xab, 0xa7, 0x77, 0x2e, 0x25, 0x49, 0xd2, 0x71, 0xc3, 0x53, 0x41, 0x9d, 0xde, 0x84,
-	0xa7, 0x77, 0xf4, 0x94, 0xff, 0x85, 0x71, 0xba, 0x9e, 0x48, 0x57, 0x35, 0xce, 0xf9, 0xd9, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x33, 0x5b, 0xba, 0x1e, 0x11, 0x00, 0x00,
+	// 1813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x49, 0x73, 0x23, 0x49,
+	0x15, 0xee, 0xd2, 0xae, 0x57, 0x96, 0x5c, 0x4e, 0x2f, 0x23, 0x1c, 0x0c, 0xa1, 0xa9, 0xa0, 0xa7,
+	0x3d, 0x8e, 0x40, 0x06, 0x31, 0x34, 0x07, 0x62, 0x18, 0xb4, 0x94, 0xdd, 0xea, 0xd6, 0xe2, 0x4e,
+	0x55, 0xbb, 0x89, 0xb9, 0x54, 0x94, 0xa5, 0xb4, 0x5d, 0xb8, 0xb6, 0xae, 0x4a, 0xd9, 0xad, 0x1f,
+	0x40, 0xf0, 0x03, 0xf8, 0x15, 0xdc, 0x88, 0xe0, 0xcc, 0x95, 0x3b, 0x77, 0xae, 0x04, 0x67, 0xfe,
+	0x01, 0x91, 0x4b, 0x95, 0x54, 0xf2, 0x30, 0xbd, 0x44, 0x70, 0x60, 0x2e, 0x8a, 0x97, 0x6f, 0xcb,
+	0xf7, 0xbe, 0x7c, 0x2f, 0xeb, 0xa5, 0x40, 0xbb, 0x74, 0x7c, 0x37, 0xb8, 0x9e, 0xdb, 0xd4, 0x6e,
+	0x85, 0x51, 0x40, 0x03, 0x04, 0x2b, 0xce, 0xa1, 0x7a, 0x47, 0xa3, 0x70, 0x26, 0x04, 0x87, 0xea,
+	0x9b, 0x05, 0x89, 0x96, 0x72, 0x51, 0xa7, 0x41, 0x18, 0xac, 0xac, 0xf4, 0x11, 0x94, 0x7b, 0x37,
+	0x76, 0x14, 0x13, 0x8a, 0x0e, 0xa0, 0x34, 0x73, 0x1d, 0xe2, 0xd3, 0x86, 0xd2, 0x54, 0x8e, 0x8a,
+	0x58, 0xae, 0x10, 0x82, 0xc2, 0x2c, 0xf0, 0xfd, 0x46, 0x8e, 0x73, 0x39, 0xcd, 0x74, 0x63, 0x12,
+	0xdd, 0x91, 0xa8, 0x91, 0x17, 0xba, 0x62, 0xa5, 0xff, 0x33, 0x0f, 0x3b, 0x5d, 0x1e, 0x87, 0x19,
+	0xd9, 0x7e, 0x6c, 0xcf, 0xa8, 0x13, 0xf8, 0xe8, 0x0c, 0x20, 0xa6, 0x36, 0x25, 0x1e, 0xf1, 0x69,
+	0xdc, 0x50, 0x9a, 0xf9, 0x23, 0xb5, 0xfd, 0xa4, 0xb5, 0x96, 0xc1, 0x03, 0x93, 0xd6, 0x34, 0xd1,
+	0xc7, 0x6b, 0xa6, 0xa8, 0x0d, 0x2a, 0xb9, 0x23, 0x3e, 0xb5, 0x68, 0x70, 0x4b, 0xfc, 0x46, 0xa1,
+	0xa9, 0x1c, 0xa9, 0xed, 0x9d, 0x96, 0x48, 0xd0, 0x60, 0x12, 0x93, 0x09, 0x30, 0x90, 0x94, 0x3e,
+	0xfc, 0x5b, 0x0e, 0xaa, 0xa9, 0x37, 0x34, 0x84, 0xca, 0xcc, 0xa6, 0xe4, 0x3a, 0x88, 0x96, 0x3c,
+	0xcd, 0x7a, 0xfb, 0xa7, 0xef, 0x19, 0x48, 0xab, 0x27, 0xed, 0x70, 0xea, 0x01, 0xfd, 0x04, 0xca,
+	0x33, 0x81, 0x1e, 0x47, 0x47, 0x6d, 0xef, 0xae, 0x3b, 0x93, 0xc0, 0xe2, 0x44, 0x07, 0x69, 0x90,
+	0x8f, 0xdf, 0xb8, 0x1c, 0xb2, 0x2d, 0xcc, 0x48, 0xfd, 0x4f, 0x0a, 0x54, 0x12, 0xbf, 0x68, 0x17,
+	0xb6, 0xbb, 0x43, 0xeb, 0xd5, 0x18, 0x1b, 0xbd, 0xc9, 0xd9, 0x78, 0xf0, 0x8d, 0xd1, 0xd7, 0x1e,
+	0xa1, 0x2d, 0xa8, 0x74, 0x87, 0x56, 0xd7, 0x38, 0x1b, 0x8c, 0x35, 0x05, 0xd5, 0xa0, 0xda, 0x1d,
+	0x5a, 0xbd, 0xc9, 0x68, 0x34, 0x30, 0xb5, 0x1c, 0xda, 0x06, 0xb5, 0x3b, 0xb4, 0xf0, 0x64, 0x38,
+	0xec, 0x76, 0x7a, 0x2f, 0xb4, 0x3c, 0xda, 0x87, 0x9d, 0xee, 0xd0, 0xea, 0x8f, 0x86, 0x56, 0xdf,
+	0x38, 0xc7, 0x46, 0xaf, 0x63, 0x1a, 0x7d, 0xad, 0x80, 0x00, 0x4a, 0x8c, 0xdd, 0x1f, 0x6a, 0x45,
+	0x49, 0x4f, 0x0d, 0x53, 0x2b, 0x49, 0x77, 0x83, 0xf1, 0xd4, 0xc0, 0xa6, 0x56, 0x96, 0xcb, 0x57,
+	0xe7, 0xfd, 0x8e, 0x69, 0x68, 0x15, 0xb9, 0xec, 0x1b, 0x43, 0xc3, 0x34, 0xb4, 0xea, 0xf3, 0x42,
+	0x25, 0xa7, 0xe5, 0x9f, 0x17, 0x2a, 0x79, 0xad, 0xa0, 0xff, 0x51, 0x81, 0xfd, 0x29, 0x8d, 0x88,
+	0xed, 0xbd, 0x20, 0x4b, 0x6c, 0xfb, 0xd7, 0x04, 0x93, 0x37, 0x0b, 0x12, 0x53, 0x74, 0x08, 0x95,
+	0x30, 0x88, 0x1d, 0x86, 0x1d, 0x07, 0xb8, 0x8a, 0xd3, 0x35, 0x3a, 0x81, 0xea, 0x2d, 0x59, 0x5a,
+	0x11, 0xd3, 0x97, 0x80, 0xa1, 0x56, 0x5a, 0x90, 0xa9, 0xa7, 0xca, 0xad, 0xa4, 0xd6, 0xf1, 0xcd,
+	0xbf, 0x1b, 0x5f, 0xfd, 0x0a, 0x0e, 0x36, 0x83, 0x8a, 0xc3, 0xc0, 0x8f, 0x09, 0x1a, 0x02, 0x12,
+	0x86, 0x16, 0x5d, 0x9d, 0x2d, 0x8f, 0x4f, 0x6d, 0x7f, 0xfa, 0x9d, 0x05, 0x80, 0x77, 0x2e, 0x37,
+	0x59, 0xfa, 0x5b, 0xd8, 0x15, 0xfb, 0x98, 0xf6, 0xa5, 0x4b, 0xe2, 0xf7, 0x49, 0xfd, 0x00, 0x4a,
+	0x94, 0x2b, 0x37, 0x72, 0xcd, 0xfc, 0x51, 0x15, 0xcb, 0xd5, 0x87, 0x66, 0x38, 0x87, 0xbd, 0xec,
+	0xce, 0xff, 0x93, 0xfc, 0xbe, 0x84, 0x02, 0x5e, 0xb8, 0x04, 0xed, 0x41, 0xd1, 0xb3, 0xe9, 0xec,
+	0x46, 0x66, 0x23, 0x16, 0x2c, 0x95, 0x2b, 0xc7, 0xa5, 0x24, 0xe2, 0x47, 0x58, 0xc5, 0x72, 0xa5,
+	0xff, 0x45, 0x81, 0xd2, 0x29, 0x27, 0xd1, 0xe7, 0x50, 0x8c, 0x16, 0x2e, 0x49, 0x7a, 0x5d, 0x5b,
+	0x8f, 0x80, 0x79, 0xc6, 0x42, 0x8c, 0x06, 0x50, 0xbf, 0x72, 0x88, 0x3b, 0xe7, 0xad, 0x3b, 0x0a,
+	0xe6, 0xa2, 0x2a, 0xea, 0xed, 0xcf, 0xd6, 0x0d, 0x84, 0xcf, 0xd6, 0x69, 0x46, 0x11, 0x6f, 0x18,
+	0xea, 0x4f, 0xa1, 0x9e, 0xd5, 0x60, 0xed, 0x64, 0x60, 0x6c, 0x4d, 0xc6, 0xd6, 0x68, 0x30, 0x1d,
+	0x75, 0xcc, 0xde, 0x33, 0xed, 0x11, 0xef, 0x18, 0x63, 0x6a, 0x5a, 0xc6, 0xe9, 0xe9, 0x04, 0x9b,
+	0x9a, 0xa2, 0xff, 0xb9, 0x00, 0x5b, 0x02, 0x94, 0x69, 0xb0, 0x88, 0x66, 0x84, 0x9d, 0xe2, 0x2d,
+	0x59, 0xc6, 0xa1, 0x3d, 0x23, 0xc9, 0x29, 0x26, 0x6b, 0x06, 0x48, 0x7c, 0x63, 0x47, 0x73, 0x99,
+	0xb9, 0x58, 0xa0, 0x5f, 0x80, 0xca, 0x4f, 0x93, 0x5a, 0x74, 0x19, 0x12, 0x7e, 0x8e, 0xf5, 0xf6,
+	0xde, 0xaa, 0xb0, 0xf9, 0x59, 0x51, 0x73, 0x19, 0x12, 0x0c, 0x34, 0xa5, 0xb3, 0xdd, 0x50, 0x78,
+	0x8f, 0x6e, 0x58, 0xd5, 0x50, 0x31, 0x53, 0x43, 0xc7, 0xe9, 0x81, 0x94, 0xa4, 0x97, 0x07, 0xe8,
+	0x25, 0x87, 0x84, 0x5a, 0x50, 0x0a, 0x7c, 0x6b, 0x3e, 0x77, 0x1b, 0x65, 0x1e, 0xe6, 0x27, 0xeb,
+	0xba, 0x13, 0xbf, 0xdf, 0x1f, 0x76, 0x44, 0x59, 0x14, 0x03, 0xbf, 0x3f, 0x77, 0xd1, 0x63, 0xa8,
+	0x93, 0xb7, 0x94, 0x44, 0xbe, 0xed, 0x5a, 0xde, 0x92, 0xdd, 0x5e, 0x15, 0x9e, 0x7a, 0x2d, 0xe1,
+	0x8e, 0x18, 0x13, 0x7d, 0x0e, 0xdb, 0x31, 0x0d, 0x42, 0xcb, 0xbe, 0xa2, 0x24, 0xb2, 0x66, 0x41,
+	0xb8, 0x6c, 0x54, 0x9b, 0xca, 0x51, 0x05, 0xd7, 0x18, 0xbb, 0xc3, 0xb8, 0xbd, 0x20, 0x5c, 0xa2,
+	0x5f, 0xc1, 0xa1, 0x67, 0xbf, 0xb5, 0x62, 0x0e, 0xb5, 0x45, 0x6f, 0x22, 0x62, 0xcf, 0x63, 0x2b,
+	0x5a, 0xf8, 0xbe, 0xe3, 0x5f, 0x37, 0xa0, 0xa9, 0x1c, 0xe5, 0xf1, 0x27, 0x9e, 0xfd, 0x56, 0x9c,
+	0x85, 0x29, 0xe4, 0x58, 0x88, 0x51, 0x13, 0xd4, 0x59, 0xe0, 0x85, 0x11, 0x89, 0x63, 0x56, 0xdd,
+	0x2a, 0x0f, 0x64, 0x9d, 0x85, 0xbe, 0x84, 0x83, 0xd0, 0x8e, 0x6c, 0xd7, 0x25, 0xae, 0x65, 0x87,
+	0xa1, 0xbb, 0xb4, 0xee, 0x83, 0xe8, 0x96, 0x44, 0x71, 0x63, 0x8b, 0xbb, 0xde, 0x4b, 0xa4, 0x1d,
+	0x26, 0x7c, 0x2d, 0x64, 0xa8, 0x05, 0xbb, 0x1b, 0x56, 0x1e, 0x2b, 0xc5, 0x1a, 0xf7, 0xbf, 0x93,
+	0x31, 0xe1, 0xa5, 0xf6, 0x12, 0xaa, 0x38, 0xb8, 0xef, 0xdd, 0xf0, 0x43, 0xd1, 0xa1, 0x74, 0x49,
+	0xae, 0x82, 0x88, 0xc8, 0x6e, 0x03, 0xf9, 0x35, 0xc2, 0xc1, 0x3d, 0x96, 0x12, 0xd4, 0x84, 0x22,
+	0x07, 0xa6, 0x91, 0x7b, 0xa0, 0x22, 0x04, 0xba, 0x0d, 0x15, 0x1c, 0xdc, 0xf3, 0xda, 0x45, 0x9f,
+	0x82, 0xa8, 0x12, 0xcb, 0xb7, 0xbd, 0xa4, 0x04, 0xab, 0x9c, 0x33, 0xb6, 0x3d, 0x82, 0x9e, 0x82,
+	0x1a, 0x05, 0xf7, 0xd6, 0x8c, 0x6f, 0x2f, 0xae, 0x13, 0xb5, 0xbd, 0x9f, 0xe9, 0xb0, 0x24, 0x38,
+	0x0c, 0x51, 0x42, 0xc6, 0xfa, 0x4b, 0x80, 0x55, 0x83, 0xbc, 0x6b, 0x93, 0x1f, 0xb3, 0x92, 0x22,
+	0xee, 0x3c, 0xf1, 0xbf, 0x25, 0x43, 0xe6, 0x1e, 0xb0, 0x94, 0x31, 0x20, 0xa6, 0xac, 0x03, 0xce,
+	0xa8, 0x33, 0xff, 0x88, 0xbe, 0x41, 0x50, 0xb8, 0xa6, 0xce, 0x9c, 0x37, 0x4c, 0x15, 0x73, 0x5a,
+	0xff, 0x1a, 0x8a, 0x17, 0xdc, 0xdd, 0x53, 0x50, 0xb9, 0x96, 0xc5, 0xd8, 0xc9, 0x45, 0x92, 0x49,
+	0x33, 0xdd, 0x1a, 0x43, 0x9c, 0x90, 0xb1, 0xde, 0x81, 0xda, 0x0b, 0xb9, 0x2d, 0x57, 0xf8, 0xf0,
+	0xb8, 0xf4, 0x7f, 0xe5, 0xa0, 0xfc, 0x3c, 0x58, 0xb0, 0xea, 0x46, 0x75, 0xc8, 0x39, 0x73, 0x6e,
+	0x97, 0xc7, 0x39, 0x67, 0x8e, 0x7e, 0x03, 0x75, 0xcf, 0xb9, 0x8e, 0x6c, 0xd6, 0x23, 0xa2, 0xdd,
+	0xc5, 0x8d, 0xf5, 0x83, 0xf5, 0xc8, 0x46, 0x89, 0x06, 0xef, 0xf9, 0x9a, 0xb7, 0xbe, 0x5c, 0xeb,
+	0xe2, 0x7c, 0xa6, 0x8b, 0x1f, 0x43, 0xdd, 0x0d, 0x66, 0xb6, 0x6b, 0xa5, 0xdf, 0x90, 0x82, 0xe8,
+	0x34, 0xce, 0x3d, 0x97, 0xcc, 0x4d, 0x5c, 0x8a, 0xef, 0x89, 0x0b, 0xfa, 0x0a, 0xb6, 0x42, 0x3b,
+	0xa2, 0xce, 0xcc, 0x09, 0x6d, 0x36, 0x85, 0x95, 0xb8, 0x61, 0x26, 0xec, 0x0c, 0x6e, 0x38, 0xa3,
+	0x8e, 0xbe, 0x00, 0x4d, 0x36, 0x2d, 0xeb, 0xa8, 0x2b, 0x37, 0xb8, 0x8f, 0x1b, 0x65, 0x1e, 0xff,
+	0xb6, 0xe0, 0xbf, 0x4e, 0xd8, 0x6b, 0xaa, 0x09, 0xce, 0x71, 0xa3, 0xb2, 0xae, 0x9a, 0xec, 0x13,
+	0xeb, 0xff, 0xce, 0x41, 0xe9, 0x42, 0x14, 0xe4, 0x31, 0x14, 0x38, 0x9c, 0x62, 0x28, 0x3b, 0x58,
+	0x8f, 0x4b, 0x68, 0x70, 0x2c, 0xb9, 0x0e, 0xfa, 0x21, 0x54, 0xa9, 0xe3, 0x91, 0x98, 0xda, 0x5e,
+	0xc8, 0xf1, 0xcf, 0xe3, 0x15, 0xe3, 0xdb, 0xca, 0x8a, 0x4d, 0x5e, 0xec, 0xce, 0x13, 0x88, 0x32,
+	0x12, 0xfd, 0x0c, 0xaa, 0xac, 0x8d, 0xf8, 0xa0, 0xd8, 0x28, 0xf2, 0xbe, 0xdc, 0xdb, 0x68, 0x22,
+	0xbe, 0x2d, 0xae, 0x44, 0x92, 0x42, 0xbf, 0x04, 0x95, 0x17, 0xbe, 0x34, 0x12, 0x97, 0xed, 0x41,
+	0xf6, 0xb2, 0x4d, 0x1a, 0x0c, 0xc3, 0xea, 0xfb, 0x84, 0x9e, 0x40, 0xf1, 0x8e, 0x87, 0x54, 0x96,
+	0x03, 0xeb, 0x7a, 0x72, 0xfc, 0xa4, 0x84, 0x9c, 0x4d, 0x03, 0xbf, 0x13, 0x85, 0xd7, 0xa8, 0x3c,
+	0x9c, 0x06, 0x64, 0x4d, 0xe2, 0x44, 0x87, 0x67, 0xe5, 0xb9, 0x8d, 0xaa, 0xcc, 0xca, 0x73, 0xd1,
+	0x67, 0xb0, 0x35, 0x5b, 0x44, 0x11, 0x1f, 0x91, 0x1d, 0x8f, 0x34, 0xf6, 0x38, 0x38, 0xaa, 0xe4,
+	0x99, 0x8e, 0x47, 0xf4, 0x3f, 0xe4, 0xa0, 0x7e, 0x21, 0x86, 0x88, 0x64, 0x70, 0xf9, 0x1a, 0x76,
+	0xc9, 0xd5, 0x15, 0x99, 0x51, 0xe7, 0x8e, 0x58, 0x33, 0x76, 0xdb, 0x45, 0x96, 0xac, 0x7a, 0xb5,
+	0xbd, 0xdd, 0x12, 0x8f, 0x89, 0x1e, 0xe7, 0x0f, 0xfa, 0x78, 0x27, 0xd5, 0x95, 0xac, 0x39, 0x32,
+	0x60, 0xd7, 0xf1, 0x3c, 0x32, 0x77, 0x6c, 0xba, 0xee, 0x40, 0x5c, 0x77, 0xfb, 0xf2, 0xee, 0xb8,
+	0x30, 0xcf, 0x6c, 0x4a, 0x56, 0x6e, 0x52, 0x8b, 0xd4, 0xcd, 0x63, 0xd6, 0x1a, 0xd1, 0x75, 0x3a,
+	0x0b, 0xd5, 0xa4, 0xa5, 0xc9, 0x99, 0x58, 0x0a, 0x33, 0x73, 0x56, 0x61, 0x63, 0xce, 0x5a, 0x7d,
+	0x0b, 0x8b, 0xef, 0xfa, 0x16, 0xea, 0x5f, 0xc1, 0x76, 0x0a, 0x84, 0x9c, 0xa3, 0x8e, 0xa1, 0xc4,
+	0x0f, 0x37, 0xb9, 0x70, 0xd0, 0xc3, 0x3a, 0xc4, 0x52, 0x43, 0xff, 0x7d, 0x0e, 0x50, 0x62, 0x1f,
+	0xdc, 0xc7, 0xff, 0xa7, 0x60, 0xee, 0x41, 0x91, 0xf3, 0x25, 0x92, 0x62, 0xc1, 0x70, 0x70, 0xed,
+	0x98, 0x86, 0xb7, 0x29, 0x8c, 0xc2, 0xf8, 0x25, 0xfb, 0xc5, 0x24, 0x5e, 0xb8, 0x14, 0x4b, 0x0d,
+	0xfd, 0xaf, 0x0a, 0xec, 0x66, 0x70, 0x90, 0x58, 0xae, 0xbe, 0x21, 0xca, 0x7f, 0xff, 0x86, 0xa0,
+	0x23, 0xa8, 0x84, 0xb7, 0xdf, 0xf1, 0xad, 0x49, 0xa5, 0xdf, 0xda, 0xd7, 0x3f, 0x82, 0x42, 0xc4,
+	0xae, 0xa2, 0x42, 0x33, 0xbf, 0xf1, 0x61, 0xe5, 0x7c, 0xf6, 0x75, 0xce, 0xe4, 0x91, 0xf9, 0x3a,
+	0xcb, 0xf8, 0xff, 0xa1, 0xc0, 0xfe, 0xaa, 0x0e, 0x16, 0x2e, 0xfd, 0x5e, 0x1d, 0xa5, 0x1e, 0xc1,
+	0xc1, 0x66, 0x76, 0x1f, 0x74, 0x40, 0x1f, 0x01, 0xfb, 0xf1, 0xaf, 0x41, 0x5d, 0x9b, 0x25, 0xd9,
+	0x93, 0x73, 0x70, 0x36, 0x9e, 0x60, 0x43, 0x7b, 0x84, 0x2a, 0x50, 0x98, 0x9a, 0x93, 0x73, 0x4d,
+	0x61, 0x94, 0xf1, 0x5b, 0xa3, 0x27, 0x9e, 0xb1, 0x8c, 0xb2, 0xa4, 0x52, 0xfe, 0xf8, 0xef, 0x0a,
+	0xc0, 0xea, 0xd6, 0x47, 0x2a, 0x94, 0x5f, 0x8d, 0x5f, 0x8c, 0x27, 0xaf, 0xc7, 0xc2, 0xc1, 0x99,
+	0x39, 0xe8, 0x6b, 0x0a, 0xaa, 0x42, 0x51, 0xbc, 0x8b, 0x73, 0x6c, 0x07, 0xf9, 0x28, 0xce, 0xb3,
+	0x17, 0x73, 0xfa, 0x22, 0x2e, 0xa0, 0x32, 0xe4, 0xd3, 0x77, 0xaf, 0x7c, 0xe8, 0x96, 0x98, 0x43,
+	0x6c, 0x9c, 0x0f, 0x3b, 0x3d, 0x43, 0x2b, 0x33, 0x41, 0xfa, 0xe4, 0x05, 0x28, 0x25, 0xef, 0x5d,
+	0x66, 0xc9, 0x5e, 0xc9, 0xc0, 0xf6, 0x99, 0x98, 0xcf, 0x0c, 0xac, 0xa9, 0x8c, 0x87, 0x27, 0xaf,
+	0xb5, 0x2d, 0xc6, 0x3b, 0x1d, 0x18, 0xc3, 0xbe, 0x56, 0x63, 0xcf, 0xe4, 0x67, 0x46, 0x07, 0x9b,
+	0x5d, 0xa3, 0x63, 0x6a, 0x75, 0x26, 0xb9, 0xe0, 0x01, 0x6e, 0xb3, 0x6d, 0x9e, 0x4f, 0x5e, 0xe1,
+	0x71, 0x67, 0xa8, 0x69, 0xc7, 0x4f, 0xa0, 0x96, 0x99, 0x0b, 0xd8, 0x5e, 0x66, 0xa7, 0x3b, 0x34,
+	0xa6, 0xda, 0x23, 0x46, 0x4f, 0x9f, 0x75, 0x70, 0x7f, 0xaa, 0x29, 0xdd, 0x2f, 0xbe, 0x79, 0x72,
+	0xe7, 0x50, 0x12, 0xc7, 0x2d, 0x27, 0x38, 0x11, 0xd4, 0xc9, 0x75, 0x70, 0x72, 0x47, 0x4f, 0xf8,
+	0x5f, 0x36, 0x27, 0xab, 0x1b, 0xe9, 0xb2, 0xc4, 0x39, 0x3f, 0xff, 0xcf, 0x00, 0x3e, 0x41, 0xf6,
+	0x04, 0x0e, 0x12, 0x00, 0x00,
 }
@@ -491,6 +491,35 @@ func (StreamEvent_Statement_Category) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{11, 0, 0}
 }
 
+// ChangeType is the type of the change.
+type SchemaTableChange_ChangeType int32
+
+const (
+	SchemaTableChange_ADDED   SchemaTableChange_ChangeType = 0
+	SchemaTableChange_CHANGED SchemaTableChange_ChangeType = 1
+	SchemaTableChange_DROPPED SchemaTableChange_ChangeType = 2
+)
+
+var SchemaTableChange_ChangeType_name = map[int32]string{
+	0: "ADDED",
+	1: "CHANGED",
+	2: "DROPPED",
+}
+
+var SchemaTableChange_ChangeType_value = map[string]int32{
+	"ADDED":   0,
+	"CHANGED": 1,
+	"DROPPED": 2,
+}
+
+func (x SchemaTableChange_ChangeType) String() string {
+	return proto.EnumName(SchemaTableChange_ChangeType_name, int32(x))
+}
+
+func (SchemaTableChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{56, 0}
+}
+
 // Target describes what the client expects the tablet is.
 // If the tablet does not match, an error is returned.
 type Target struct {
@@ -857,7 +886,13 @@ type ExecuteOptions struct {
 	TransactionIsolation ExecuteOptions_TransactionIsolation `protobuf:"varint,9,opt,name=transaction_isolation,json=transactionIsolation,proto3,enum=query.ExecuteOptions_TransactionIsolation" json:"transaction_isolation,omitempty"`
 	// skip_query_plan_cache specifies if the query plan should be cached by vitess.
 	// By default all query plans are cached.
-	SkipQueryPlanCache   bool     `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	// read_after_write_gtid is the GTID set a replica must have executed
+	// before it serves a read. It is set by vtgate for sessions that must
+	// read their own writes. Replicas whose replication position, as of
+	// the last health check, does not contain it reject the read with
+	// FAILED_PRECONDITION so that it can be retried elsewhere.
+	ReadAfterWriteGtid   string   `protobuf:"bytes,11,opt,name=read_after_write_gtid,json=readAfterWriteGtid,proto3" json:"read_after_write_gtid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -930,6 +965,13 @@ func (m *ExecuteOptions) GetSkipQueryPlanCache() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetReadAfterWriteGtid() string {
+	if m != nil {
+		return m.ReadAfterWriteGtid
+	}
+	return ""
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -1114,10 +1156,14 @@ func (m *Row) GetValues() []byte {
 // len(QueryResult[0].fields) is always equal to len(row) (for each
 // row in rows for each QueryResult in QueryResult[1:]).
 type QueryResult struct {
-	Fields               []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	RowsAffected         uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
-	InsertId             uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
-	Rows                 []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Fields       []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	RowsAffected uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	InsertId     uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
+	Rows         []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// session_state_changes is the set of GTIDs of the transaction
+	// committed by the query, if MySQL tracks them with
+	// session_track_gtids.
+	SessionStateChanges  string   `protobuf:"bytes,6,opt,name=session_state_changes,json=sessionStateChanges,proto3" json:"session_state_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1176,6 +1222,13 @@ func (m *QueryResult) GetRows() []*Row {
 	return nil
 }
 
+func (m *QueryResult) GetSessionStateChanges() string {
+	if m != nil {
+		return m.SessionStateChanges
+	}
+	return ""
+}
+
 // QueryWarning is used to convey out of band query execution warnings
 // by storing in the vtgate.Session
 type QueryWarning struct {
@@ -1942,6 +1995,9 @@ func (m *CommitRequest) GetTransactionId() int64 {
 
 // CommitResponse is the returned value from Commit
 type CommitResponse struct {
+	// session_state_changes is the set of GTIDs of the committed
+	// transaction, if MySQL tracks them with session_track_gtids.
+	SessionStateChanges  string   `protobuf:"bytes,1,opt,name=session_state_changes,json=sessionStateChanges,proto3" json:"session_state_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1972,6 +2028,13 @@ func (m *CommitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
 
+func (m *CommitResponse) GetSessionStateChanges() string {
+	if m != nil {
+		return m.SessionStateChanges
+	}
+	return ""
+}
+
 // RollbackRequest is the payload to Rollback
 type RollbackRequest struct {
 	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
@@ -3162,7 +3225,14 @@ type MessageStreamRequest struct {
 	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
 	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	// name is the message table name.
-	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// resume_token makes the stream resumable if it's not empty. The
+	// rows of a resumable stream have an additional resume_token column,
+	// which lists the messages sent to the consumer and not acked yet.
+	// The consumer keeps the last token it received, and passes it when
+	// it reconnects: the messages of the token that were not acked or
+	// resent since are resent right away, instead of after their ack wait.
+	ResumeToken          string   `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3221,6 +3291,13 @@ func (m *MessageStreamRequest) GetName() string {
 	return ""
 }
 
+func (m *MessageStreamRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
 // MessageStreamResponse is a response for MessageStream.
 type MessageStreamResponse struct {
 	Result               *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -3267,11 +3344,14 @@ type MessageAckRequest struct {
 	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
 	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	// name is the message table name.
-	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Ids                  []*Value `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Ids  []*Value `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	// updates contains new values for user-defined columns,
+	// which are set on the acked messages.
+	Updates              map[string]*Value `protobuf:"bytes,6,rep,name=updates,proto3" json:"updates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MessageAckRequest) Reset()         { *m = MessageAckRequest{} }
@@ -3334,6 +3414,13 @@ func (m *MessageAckRequest) GetIds() []*Value {
 	return nil
 }
 
+func (m *MessageAckRequest) GetUpdates() map[string]*Value {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 // MessageAckResponse is the response for MessageAck.
 type MessageAckResponse struct {
 	// result contains the result of the ack operation.
@@ -3377,6 +3464,132 @@ func (m *MessageAckResponse) GetResult() *QueryResult {
 	return nil
 }
 
+// MessagePostponeRequest is the request payload for MessagePostpone.
+type MessagePostponeRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// name is the message table name.
+	Name string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Ids  []*Value `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	// updates contains new values for user-defined columns,
+	// which are set on the postponed messages.
+	Updates              map[string]*Value `protobuf:"bytes,6,rep,name=updates,proto3" json:"updates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MessagePostponeRequest) Reset()         { *m = MessagePostponeRequest{} }
+func (m *MessagePostponeRequest) String() string { return proto.CompactTextString(m) }
+func (*MessagePostponeRequest) ProtoMessage()    {}
+func (*MessagePostponeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{49}
+}
+
+func (m *MessagePostponeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessagePostponeRequest.Unmarshal(m, b)
+}
+func (m *MessagePostponeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessagePostponeRequest.Marshal(b, m, deterministic)
+}
+func (m *MessagePostponeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePostponeRequest.Merge(m, src)
+}
+func (m *MessagePostponeRequest) XXX_Size() int {
+	return xxx_messageInfo_MessagePostponeRequest.Size(m)
+}
+func (m *MessagePostponeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePostponeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePostponeRequest proto.InternalMessageInfo
+
+func (m *MessagePostponeRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *MessagePostponeRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *MessagePostponeRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *MessagePostponeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MessagePostponeRequest) GetIds() []*Value {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *MessagePostponeRequest) GetUpdates() map[string]*Value {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+// MessagePostponeResponse is the response for MessagePostpone.
+type MessagePostponeResponse struct {
+	// result contains the result of the postpone operation.
+	// Since this acts like a DML, only
+	// RowsAffected is returned in the result.
+	Result               *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MessagePostponeResponse) Reset()         { *m = MessagePostponeResponse{} }
+func (m *MessagePostponeResponse) String() string { return proto.CompactTextString(m) }
+func (*MessagePostponeResponse) ProtoMessage()    {}
+func (*MessagePostponeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{50}
+}
+
+func (m *MessagePostponeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessagePostponeResponse.Unmarshal(m, b)
+}
+func (m *MessagePostponeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessagePostponeResponse.Marshal(b, m, deterministic)
+}
+func (m *MessagePostponeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePostponeResponse.Merge(m, src)
+}
+func (m *MessagePostponeResponse) XXX_Size() int {
+	return xxx_messageInfo_MessagePostponeResponse.Size(m)
+}
+func (m *MessagePostponeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePostponeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePostponeResponse proto.InternalMessageInfo
+
+func (m *MessagePostponeResponse) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 // StreamHealthRequest is the payload for StreamHealth
 type StreamHealthRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3388,7 +3601,7 @@ func (m *StreamHealthRequest) Reset()         { *m = StreamHealthRequest{} }
 func (m *StreamHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamHealthRequest) ProtoMessage()    {}
 func (*StreamHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{49}
+	return fileDescriptor_5c6ac9b241082464, []int{51}
 }
 
 func (m *StreamHealthRequest) XXX_Unmarshal(b []byte) error {
@@ -3438,7 +3651,11 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// replication_position is populated for slaves only. It is the
+	// replication position of the slave as of the last health check.
+	// NOTE: This field must not be evaluated if "health_error" is not empty.
+	ReplicationPosition  string   `protobuf:"bytes,7,opt,name=replication_position,json=replicationPosition,proto3" json:"replication_position,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3448,7 +3665,7 @@ func (m *RealtimeStats) Reset()         { *m = RealtimeStats{} }
 func (m *RealtimeStats) String() string { return proto.CompactTextString(m) }
 func (*RealtimeStats) ProtoMessage()    {}
 func (*RealtimeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{50}
+	return fileDescriptor_5c6ac9b241082464, []int{52}
 }
 
 func (m *RealtimeStats) XXX_Unmarshal(b []byte) error {
@@ -3511,6 +3728,13 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetReplicationPosition() string {
+	if m != nil {
+		return m.ReplicationPosition
+	}
+	return ""
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -3537,7 +3761,7 @@ func (m *AggregateStats) Reset()         { *m = AggregateStats{} }
 func (m *AggregateStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateStats) ProtoMessage()    {}
 func (*AggregateStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{51}
+	return fileDescriptor_5c6ac9b241082464, []int{53}
 }
 
 func (m *AggregateStats) XXX_Unmarshal(b []byte) error {
@@ -3643,7 +3867,7 @@ func (m *StreamHealthResponse) Reset()         { *m = StreamHealthResponse{} }
 func (m *StreamHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamHealthResponse) ProtoMessage()    {}
 func (*StreamHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{52}
+	return fileDescriptor_5c6ac9b241082464, []int{54}
 }
 
 func (m *StreamHealthResponse) XXX_Unmarshal(b []byte) error {
@@ -3699,6 +3923,366 @@ func (m *StreamHealthResponse) GetTabletAlias() *topodata.TabletAlias {
 	return nil
 }
 
+// StreamSchemaChangesRequest is the payload for StreamSchemaChanges.
+type StreamSchemaChangesRequest struct {
+	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId    *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target               *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StreamSchemaChangesRequest) Reset()         { *m = StreamSchemaChangesRequest{} }
+func (m *StreamSchemaChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamSchemaChangesRequest) ProtoMessage()    {}
+func (*StreamSchemaChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{55}
+}
+
+func (m *StreamSchemaChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamSchemaChangesRequest.Unmarshal(m, b)
+}
+func (m *StreamSchemaChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamSchemaChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamSchemaChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamSchemaChangesRequest.Merge(m, src)
+}
+func (m *StreamSchemaChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamSchemaChangesRequest.Size(m)
+}
+func (m *StreamSchemaChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamSchemaChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamSchemaChangesRequest proto.InternalMessageInfo
+
+func (m *StreamSchemaChangesRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *StreamSchemaChangesRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *StreamSchemaChangesRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+// SchemaTableChange describes a change to a table of the schema.
+type SchemaTableChange struct {
+	// table_name is the name of the table.
+	TableName  string                       `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ChangeType SchemaTableChange_ChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=query.SchemaTableChange_ChangeType" json:"change_type,omitempty"`
+	// fields is the new list of columns of the table.
+	// It's empty for dropped tables.
+	Fields []*Field `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// pk_columns is the new list of primary key columns of the table.
+	// It's empty for dropped tables.
+	PkColumns []string `protobuf:"bytes,4,rep,name=pk_columns,json=pkColumns,proto3" json:"pk_columns,omitempty"`
+	// create_statement is the new definition of the table, as given by
+	// SHOW CREATE TABLE. It's empty for dropped tables.
+	CreateStatement      string   `protobuf:"bytes,5,opt,name=create_statement,json=createStatement,proto3" json:"create_statement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaTableChange) Reset()         { *m = SchemaTableChange{} }
+func (m *SchemaTableChange) String() string { return proto.CompactTextString(m) }
+func (*SchemaTableChange) ProtoMessage()    {}
+func (*SchemaTableChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{56}
+}
+
+func (m *SchemaTableChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaTableChange.Unmarshal(m, b)
+}
+func (m *SchemaTableChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaTableChange.Marshal(b, m, deterministic)
+}
+func (m *SchemaTableChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaTableChange.Merge(m, src)
+}
+func (m *SchemaTableChange) XXX_Size() int {
+	return xxx_messageInfo_SchemaTableChange.Size(m)
+}
+func (m *SchemaTableChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaTableChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaTableChange proto.InternalMessageInfo
+
+func (m *SchemaTableChange) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *SchemaTableChange) GetChangeType() SchemaTableChange_ChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return SchemaTableChange_ADDED
+}
+
+func (m *SchemaTableChange) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SchemaTableChange) GetPkColumns() []string {
+	if m != nil {
+		return m.PkColumns
+	}
+	return nil
+}
+
+func (m *SchemaTableChange) GetCreateStatement() string {
+	if m != nil {
+		return m.CreateStatement
+	}
+	return ""
+}
+
+// StreamSchemaChangesResponse is a response for StreamSchemaChanges.
+type StreamSchemaChangesResponse struct {
+	// changes lists the tables that changed. The first response of a
+	// stream lists all the tables of the schema as added.
+	Changes              []*SchemaTableChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StreamSchemaChangesResponse) Reset()         { *m = StreamSchemaChangesResponse{} }
+func (m *StreamSchemaChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamSchemaChangesResponse) ProtoMessage()    {}
+func (*StreamSchemaChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{57}
+}
+
+func (m *StreamSchemaChangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamSchemaChangesResponse.Unmarshal(m, b)
+}
+func (m *StreamSchemaChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamSchemaChangesResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamSchemaChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamSchemaChangesResponse.Merge(m, src)
+}
+func (m *StreamSchemaChangesResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamSchemaChangesResponse.Size(m)
+}
+func (m *StreamSchemaChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamSchemaChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamSchemaChangesResponse proto.InternalMessageInfo
+
+func (m *StreamSchemaChangesResponse) GetChanges() []*SchemaTableChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// GetSchemaVersionRequest is the payload for GetSchemaVersion.
+type GetSchemaVersionRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	TableName         string          `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// time is the unix timestamp at which the version of the table
+	// is requested. 0 requests the latest version.
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaVersionRequest) Reset()         { *m = GetSchemaVersionRequest{} }
+func (m *GetSchemaVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaVersionRequest) ProtoMessage()    {}
+func (*GetSchemaVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{58}
+}
+
+func (m *GetSchemaVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaVersionRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaVersionRequest.Merge(m, src)
+}
+func (m *GetSchemaVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaVersionRequest.Size(m)
+}
+func (m *GetSchemaVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaVersionRequest proto.InternalMessageInfo
+
+func (m *GetSchemaVersionRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *GetSchemaVersionRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *GetSchemaVersionRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *GetSchemaVersionRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *GetSchemaVersionRequest) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// SchemaVersion is a version of a table recorded by the master tablet.
+type SchemaVersion struct {
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// ddl is the CREATE TABLE statement of the version.
+	// It's empty if the table was dropped.
+	Ddl string `protobuf:"bytes,2,opt,name=ddl,proto3" json:"ddl,omitempty"`
+	// time_updated is the unix timestamp at which the version was detected.
+	TimeUpdated int64 `protobuf:"varint,3,opt,name=time_updated,json=timeUpdated,proto3" json:"time_updated,omitempty"`
+	// position is the replication position of the master when the
+	// version was detected.
+	Position             string   `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaVersion) Reset()         { *m = SchemaVersion{} }
+func (m *SchemaVersion) String() string { return proto.CompactTextString(m) }
+func (*SchemaVersion) ProtoMessage()    {}
+func (*SchemaVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{59}
+}
+
+func (m *SchemaVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaVersion.Unmarshal(m, b)
+}
+func (m *SchemaVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaVersion.Marshal(b, m, deterministic)
+}
+func (m *SchemaVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaVersion.Merge(m, src)
+}
+func (m *SchemaVersion) XXX_Size() int {
+	return xxx_messageInfo_SchemaVersion.Size(m)
+}
+func (m *SchemaVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaVersion proto.InternalMessageInfo
+
+func (m *SchemaVersion) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetDdl() string {
+	if m != nil {
+		return m.Ddl
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetTimeUpdated() int64 {
+	if m != nil {
+		return m.TimeUpdated
+	}
+	return 0
+}
+
+func (m *SchemaVersion) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+// GetSchemaVersionResponse is the response for GetSchemaVersion.
+type GetSchemaVersionResponse struct {
+	Version              *SchemaVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetSchemaVersionResponse) Reset()         { *m = GetSchemaVersionResponse{} }
+func (m *GetSchemaVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaVersionResponse) ProtoMessage()    {}
+func (*GetSchemaVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{60}
+}
+
+func (m *GetSchemaVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaVersionResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaVersionResponse.Merge(m, src)
+}
+func (m *GetSchemaVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaVersionResponse.Size(m)
+}
+func (m *GetSchemaVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaVersionResponse proto.InternalMessageInfo
+
+func (m *GetSchemaVersionResponse) GetVersion() *SchemaVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
 // TransactionMetadata contains the metadata for a distributed transaction.
 type TransactionMetadata struct {
 	Dtid                 string           `protobuf:"bytes,1,opt,name=dtid,proto3" json:"dtid,omitempty"`
@@ -3714,7 +4298,7 @@ func (m *TransactionMetadata) Reset()         { *m = TransactionMetadata{} }
 func (m *TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*TransactionMetadata) ProtoMessage()    {}
 func (*TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{53}
+	return fileDescriptor_5c6ac9b241082464, []int{61}
 }
 
 func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
@@ -3772,6 +4356,7 @@ func init() {
 	proto.RegisterEnum("query.ExecuteOptions_Workload", ExecuteOptions_Workload_name, ExecuteOptions_Workload_value)
 	proto.RegisterEnum("query.ExecuteOptions_TransactionIsolation", ExecuteOptions_TransactionIsolation_name, ExecuteOptions_TransactionIsolation_value)
 	proto.RegisterEnum("query.StreamEvent_Statement_Category", StreamEvent_Statement_Category_name, StreamEvent_Statement_Category_value)
+	proto.RegisterEnum("query.SchemaTableChange_ChangeType", SchemaTableChange_ChangeType_name, SchemaTableChange_ChangeType_value)
 	proto.RegisterType((*Target)(nil), "query.Target")
 	proto.RegisterType((*VTGateCallerID)(nil), "query.VTGateCallerID")
 	proto.RegisterType((*EventToken)(nil), "query.EventToken")
@@ -3822,203 +4407,238 @@ func init() {
 	proto.RegisterType((*MessageStreamRequest)(nil), "query.MessageStreamRequest")
 	proto.RegisterType((*MessageStreamResponse)(nil), "query.MessageStreamResponse")
 	proto.RegisterType((*MessageAckRequest)(nil), "query.MessageAckRequest")
+	proto.RegisterMapType((map[string]*Value)(nil), "query.MessageAckRequest.UpdatesEntry")
 	proto.RegisterType((*MessageAckResponse)(nil), "query.MessageAckResponse")
+	proto.RegisterType((*MessagePostponeRequest)(nil), "query.MessagePostponeRequest")
+	proto.RegisterMapType((map[string]*Value)(nil), "query.MessagePostponeRequest.UpdatesEntry")
+	proto.RegisterType((*MessagePostponeResponse)(nil), "query.MessagePostponeResponse")
 	proto.RegisterType((*StreamHealthRequest)(nil), "query.StreamHealthRequest")
 	proto.RegisterType((*RealtimeStats)(nil), "query.RealtimeStats")
 	proto.RegisterType((*AggregateStats)(nil), "query.AggregateStats")
 	proto.RegisterType((*StreamHealthResponse)(nil), "query.StreamHealthResponse")
+	proto.RegisterType((*StreamSchemaChangesRequest)(nil), "query.StreamSchemaChangesRequest")
+	proto.RegisterType((*SchemaTableChange)(nil), "query.SchemaTableChange")
+	proto.RegisterType((*StreamSchemaChangesResponse)(nil), "query.StreamSchemaChangesResponse")
+	proto.RegisterType((*GetSchemaVersionRequest)(nil), "query.GetSchemaVersionRequest")
+	proto.RegisterType((*SchemaVersion)(nil), "query.SchemaVersion")
+	proto.RegisterType((*GetSchemaVersionResponse)(nil), "query.GetSchemaVersionResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
 }
 
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 2983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x70, 0xdb, 0xd6,
-	0xb9, 0x36, 0xf8, 0x12, 0xf9, 0x53, 0xa4, 0x8e, 0x8e, 0x24, 0x9b, 0x96, 0xf3, 0x50, 0x90, 0x38,
-	0xd1, 0xd5, 0xbd, 0x57, 0x76, 0x64, 0xc7, 0xd7, 0x37, 0x49, 0x5b, 0x43, 0x14, 0xe4, 0xd0, 0x26,
-	0x41, 0xfa, 0x10, 0xb4, 0x63, 0x4f, 0x67, 0x30, 0x10, 0x79, 0x4c, 0x61, 0x04, 0x02, 0x34, 0x00,
-	0xc9, 0xd6, 0xce, 0x6d, 0x9a, 0xbe, 0x1f, 0xe9, 0x33, 0x4d, 0x33, 0xcd, 0x74, 0xa6, 0x8b, 0x4e,
-	0x37, 0x5d, 0x77, 0xd9, 0xe9, 0xa2, 0xcb, 0xee, 0xdb, 0x2e, 0xba, 0xea, 0x74, 0x97, 0xe9, 0xaa,
-	0x8b, 0x2e, 0x3a, 0x9d, 0xf3, 0x00, 0x48, 0x59, 0x8c, 0xed, 0xb8, 0xdd, 0xd8, 0xc9, 0xee, 0xfc,
-	0x8f, 0xf3, 0xf8, 0xbe, 0xf3, 0xe3, 0x3f, 0x3f, 0x80, 0x03, 0xc5, 0x5b, 0xbb, 0x34, 0xd8, 0x5f,
-	0x1d, 0x06, 0x7e, 0xe4, 0xe3, 0x2c, 0x17, 0x16, 0xcb, 0x91, 0x3f, 0xf4, 0x7b, 0x76, 0x64, 0x0b,
-	0xf5, 0x62, 0x71, 0x2f, 0x0a, 0x86, 0x5d, 0x21, 0xa8, 0x6f, 0x2b, 0x90, 0x33, 0xed, 0xa0, 0x4f,
-	0x23, 0xbc, 0x08, 0xf9, 0x1d, 0xba, 0x1f, 0x0e, 0xed, 0x2e, 0xad, 0x28, 0x4b, 0xca, 0x72, 0x81,
-	0x24, 0x32, 0x9e, 0x87, 0x6c, 0xb8, 0x6d, 0x07, 0xbd, 0x4a, 0x8a, 0x1b, 0x84, 0x80, 0x5f, 0x81,
-	0x62, 0x64, 0x6f, 0xb9, 0x34, 0xb2, 0xa2, 0xfd, 0x21, 0xad, 0xa4, 0x97, 0x94, 0xe5, 0xf2, 0xda,
-	0xfc, 0x6a, 0x32, 0x9f, 0xc9, 0x8d, 0xe6, 0xfe, 0x90, 0x12, 0x88, 0x92, 0x36, 0xc6, 0x90, 0xe9,
-	0x52, 0xd7, 0xad, 0x64, 0xf8, 0x58, 0xbc, 0xad, 0x6e, 0x40, 0xf9, 0xaa, 0x79, 0xd1, 0x8e, 0x68,
-	0xd5, 0x76, 0x5d, 0x1a, 0xd4, 0x36, 0xd8, 0x72, 0x76, 0x43, 0x1a, 0x78, 0xf6, 0x20, 0x59, 0x4e,
-	0x2c, 0xe3, 0xa3, 0x90, 0xeb, 0x07, 0xfe, 0xee, 0x30, 0xac, 0xa4, 0x96, 0xd2, 0xcb, 0x05, 0x22,
-	0x25, 0xf5, 0xf3, 0x00, 0xfa, 0x1e, 0xf5, 0x22, 0xd3, 0xdf, 0xa1, 0x1e, 0x7e, 0x0a, 0x0a, 0x91,
-	0x33, 0xa0, 0x61, 0x64, 0x0f, 0x86, 0x7c, 0x88, 0x34, 0x19, 0x29, 0x3e, 0x02, 0xd2, 0x22, 0xe4,
-	0x87, 0x7e, 0xe8, 0x44, 0x8e, 0xef, 0x71, 0x3c, 0x05, 0x92, 0xc8, 0xea, 0x67, 0x21, 0x7b, 0xd5,
-	0x76, 0x77, 0x29, 0x7e, 0x16, 0x32, 0x1c, 0xb0, 0xc2, 0x01, 0x17, 0x57, 0x05, 0xe9, 0x1c, 0x27,
-	0x37, 0xb0, 0xb1, 0xf7, 0x98, 0x27, 0x1f, 0x7b, 0x9a, 0x08, 0x41, 0xdd, 0x81, 0xe9, 0x75, 0xc7,
-	0xeb, 0x5d, 0xb5, 0x03, 0x87, 0x91, 0xf1, 0x88, 0xc3, 0xe0, 0x17, 0x20, 0xc7, 0x1b, 0x61, 0x25,
-	0xbd, 0x94, 0x5e, 0x2e, 0xae, 0x4d, 0xcb, 0x8e, 0x7c, 0x6d, 0x44, 0xda, 0xd4, 0xdf, 0x2a, 0x00,
-	0xeb, 0xfe, 0xae, 0xd7, 0xbb, 0xc2, 0x8c, 0x18, 0x41, 0x3a, 0xbc, 0xe5, 0x4a, 0x22, 0x59, 0x13,
-	0x5f, 0x86, 0xf2, 0x96, 0xe3, 0xf5, 0xac, 0x3d, 0xb9, 0x1c, 0xc1, 0x65, 0x71, 0xed, 0x05, 0x39,
-	0xdc, 0xa8, 0xf3, 0xea, 0xf8, 0xaa, 0x43, 0xdd, 0x8b, 0x82, 0x7d, 0x52, 0xda, 0x1a, 0xd7, 0x2d,
-	0x76, 0x00, 0x1f, 0x76, 0x62, 0x93, 0xee, 0xd0, 0xfd, 0x78, 0xd2, 0x1d, 0xba, 0x8f, 0xff, 0x6b,
-	0x1c, 0x51, 0x71, 0x6d, 0x2e, 0x9e, 0x6b, 0xac, 0xaf, 0x84, 0xf9, 0x6a, 0xea, 0xbc, 0xa2, 0xfe,
-	0x3a, 0x0b, 0x65, 0xfd, 0x0e, 0xed, 0xee, 0x46, 0xb4, 0x39, 0x64, 0x7b, 0x10, 0xe2, 0x06, 0xcc,
-	0x38, 0x5e, 0xd7, 0xdd, 0xed, 0xd1, 0x9e, 0x75, 0xd3, 0xa1, 0x6e, 0x2f, 0xe4, 0x71, 0x54, 0x4e,
-	0xd6, 0x7d, 0xd0, 0x7f, 0xb5, 0x26, 0x9d, 0x37, 0xb9, 0x2f, 0x29, 0x3b, 0x07, 0x64, 0xbc, 0x02,
-	0xb3, 0x5d, 0xd7, 0xa1, 0x5e, 0x64, 0xdd, 0x64, 0x78, 0xad, 0xc0, 0xbf, 0x1d, 0x56, 0xb2, 0x4b,
-	0xca, 0x72, 0x9e, 0xcc, 0x08, 0xc3, 0x26, 0xd3, 0x13, 0xff, 0x76, 0x88, 0x5f, 0x85, 0xfc, 0x6d,
-	0x3f, 0xd8, 0x71, 0x7d, 0xbb, 0x57, 0xc9, 0xf1, 0x39, 0x9f, 0x99, 0x3c, 0xe7, 0x35, 0xe9, 0x45,
-	0x12, 0x7f, 0xbc, 0x0c, 0x28, 0xbc, 0xe5, 0x5a, 0x21, 0x75, 0x69, 0x37, 0xb2, 0x5c, 0x67, 0xe0,
-	0x44, 0x95, 0x3c, 0x0f, 0xc9, 0x72, 0x78, 0xcb, 0x6d, 0x73, 0x75, 0x9d, 0x69, 0xb1, 0x05, 0x0b,
-	0x51, 0x60, 0x7b, 0xa1, 0xdd, 0x65, 0x83, 0x59, 0x4e, 0xe8, 0xbb, 0x36, 0x0f, 0xc7, 0x02, 0x9f,
-	0x72, 0x65, 0xf2, 0x94, 0xe6, 0xa8, 0x4b, 0x2d, 0xee, 0x41, 0xe6, 0xa3, 0x09, 0x5a, 0xfc, 0x32,
-	0x2c, 0x84, 0x3b, 0xce, 0xd0, 0xe2, 0xe3, 0x58, 0x43, 0xd7, 0xf6, 0xac, 0xae, 0xdd, 0xdd, 0xa6,
-	0x15, 0xe0, 0xb0, 0x31, 0x33, 0xf2, 0x7d, 0x6f, 0xb9, 0xb6, 0x57, 0x65, 0x16, 0xf5, 0x35, 0x28,
-	0x1f, 0xe4, 0x11, 0xcf, 0x42, 0xc9, 0xbc, 0xde, 0xd2, 0x2d, 0xcd, 0xd8, 0xb0, 0x0c, 0xad, 0xa1,
-	0xa3, 0x23, 0xb8, 0x04, 0x05, 0xae, 0x6a, 0x1a, 0xf5, 0xeb, 0x48, 0xc1, 0x53, 0x90, 0xd6, 0xea,
-	0x75, 0x94, 0x52, 0xcf, 0x43, 0x3e, 0x26, 0x04, 0xcf, 0x40, 0xb1, 0x63, 0xb4, 0x5b, 0x7a, 0xb5,
-	0xb6, 0x59, 0xd3, 0x37, 0xd0, 0x11, 0x9c, 0x87, 0x4c, 0xb3, 0x6e, 0xb6, 0x90, 0x22, 0x5a, 0x5a,
-	0x0b, 0xa5, 0x58, 0xcf, 0x8d, 0x75, 0x0d, 0xa5, 0xd5, 0x5f, 0x28, 0x30, 0x3f, 0x09, 0x18, 0x2e,
-	0xc2, 0xd4, 0x86, 0xbe, 0xa9, 0x75, 0xea, 0x26, 0x3a, 0x82, 0xe7, 0x60, 0x86, 0xe8, 0x2d, 0x5d,
-	0x33, 0xb5, 0xf5, 0xba, 0x6e, 0x11, 0x5d, 0xdb, 0x40, 0x0a, 0xc6, 0x50, 0x66, 0x2d, 0xab, 0xda,
-	0x6c, 0x34, 0x6a, 0xa6, 0xa9, 0x6f, 0xa0, 0x14, 0x9e, 0x07, 0xc4, 0x75, 0x1d, 0x63, 0xa4, 0x4d,
-	0x63, 0x04, 0xd3, 0x6d, 0x9d, 0xd4, 0xb4, 0x7a, 0xed, 0x06, 0x1b, 0x00, 0x65, 0xf0, 0x73, 0xf0,
-	0x74, 0xb5, 0x69, 0xb4, 0x6b, 0x6d, 0x53, 0x37, 0x4c, 0xab, 0x6d, 0x68, 0xad, 0xf6, 0x1b, 0x4d,
-	0x93, 0x8f, 0x2c, 0xc0, 0x65, 0x71, 0x19, 0x40, 0xeb, 0x98, 0x4d, 0x31, 0x0e, 0xca, 0x5d, 0xca,
-	0xe4, 0x15, 0x94, 0xba, 0x94, 0xc9, 0xa7, 0x50, 0xfa, 0x52, 0x26, 0x9f, 0x46, 0x19, 0xf5, 0xdd,
-	0x14, 0x64, 0x39, 0x57, 0x2c, 0xdd, 0x8d, 0x25, 0x31, 0xde, 0x4e, 0x1e, 0xfd, 0xd4, 0x7d, 0x1e,
-	0x7d, 0x9e, 0x31, 0x65, 0x12, 0x12, 0x02, 0x3e, 0x01, 0x05, 0x3f, 0xe8, 0x5b, 0xc2, 0x22, 0xd2,
-	0x67, 0xde, 0x0f, 0xfa, 0x3c, 0xcf, 0xb2, 0xd4, 0xc5, 0xb2, 0xee, 0x96, 0x1d, 0x52, 0x1e, 0xc1,
-	0x05, 0x92, 0xc8, 0xf8, 0x38, 0x30, 0x3f, 0x8b, 0xaf, 0x23, 0xc7, 0x6d, 0x53, 0x7e, 0xd0, 0x37,
-	0xd8, 0x52, 0x9e, 0x87, 0x52, 0xd7, 0x77, 0x77, 0x07, 0x9e, 0xe5, 0x52, 0xaf, 0x1f, 0x6d, 0x57,
-	0xa6, 0x96, 0x94, 0xe5, 0x12, 0x99, 0x16, 0xca, 0x3a, 0xd7, 0xe1, 0x0a, 0x4c, 0x75, 0xb7, 0xed,
-	0x20, 0xa4, 0x22, 0x6a, 0x4b, 0x24, 0x16, 0xf9, 0xac, 0xb4, 0xeb, 0x0c, 0x6c, 0x37, 0xe4, 0x11,
-	0x5a, 0x22, 0x89, 0xcc, 0x40, 0xdc, 0x74, 0xed, 0x7e, 0xc8, 0x23, 0xab, 0x44, 0x84, 0xa0, 0xfe,
-	0x1f, 0xa4, 0x89, 0x7f, 0x9b, 0x0d, 0x29, 0x26, 0x0c, 0x2b, 0xca, 0x52, 0x7a, 0x19, 0x93, 0x58,
-	0x64, 0xd9, 0x5d, 0x26, 0x38, 0x91, 0xf7, 0xe2, 0x94, 0xf6, 0xbe, 0x02, 0x45, 0x1e, 0x98, 0x84,
-	0x86, 0xbb, 0x6e, 0xc4, 0x12, 0xa1, 0xcc, 0x00, 0xca, 0x81, 0x44, 0xc8, 0x69, 0x27, 0xd2, 0xc6,
-	0xf0, 0xb1, 0x87, 0xda, 0xb2, 0x6f, 0xde, 0xa4, 0xdd, 0x88, 0x8a, 0x7c, 0x9f, 0x21, 0xd3, 0x4c,
-	0xa9, 0x49, 0x1d, 0x23, 0xd6, 0xf1, 0x42, 0x1a, 0x44, 0x96, 0xd3, 0xe3, 0x94, 0x67, 0x48, 0x5e,
-	0x28, 0x6a, 0x3d, 0xfc, 0x0c, 0x64, 0x78, 0x5a, 0xc8, 0xf0, 0x59, 0x40, 0xce, 0x42, 0xfc, 0xdb,
-	0x84, 0xeb, 0x2f, 0x65, 0xf2, 0x59, 0x94, 0x53, 0x5f, 0x87, 0x69, 0xbe, 0xb8, 0x6b, 0x76, 0xe0,
-	0x39, 0x5e, 0x9f, 0x9f, 0x72, 0x7e, 0x4f, 0x6c, 0x7b, 0x89, 0xf0, 0x36, 0xc3, 0x3c, 0xa0, 0x61,
-	0x68, 0xf7, 0xa9, 0x3c, 0x75, 0x62, 0x51, 0xfd, 0x59, 0x1a, 0x8a, 0xed, 0x28, 0xa0, 0xf6, 0x80,
-	0x1f, 0x60, 0xf8, 0x75, 0x80, 0x30, 0xb2, 0x23, 0x3a, 0xa0, 0x5e, 0x14, 0xe3, 0x7b, 0x4a, 0xce,
-	0x3c, 0xe6, 0xb7, 0xda, 0x8e, 0x9d, 0xc8, 0x98, 0x3f, 0x5e, 0x83, 0x22, 0x65, 0x66, 0x2b, 0x62,
-	0x07, 0xa1, 0x4c, 0xb6, 0xb3, 0x71, 0xe6, 0x48, 0x4e, 0x48, 0x02, 0x34, 0x69, 0x2f, 0x7e, 0x90,
-	0x82, 0x42, 0x32, 0x1a, 0xd6, 0x20, 0xdf, 0xb5, 0x23, 0xda, 0xf7, 0x83, 0x7d, 0x79, 0x3e, 0x9d,
-	0xbc, 0xdf, 0xec, 0xab, 0x55, 0xe9, 0x4c, 0x92, 0x6e, 0xf8, 0x69, 0x10, 0x87, 0xbe, 0x88, 0x3a,
-	0x81, 0xb7, 0xc0, 0x35, 0x3c, 0xee, 0x5e, 0x05, 0x3c, 0x0c, 0x9c, 0x81, 0x1d, 0xec, 0x5b, 0x3b,
-	0x74, 0x3f, 0xce, 0xe5, 0xe9, 0x09, 0x3b, 0x89, 0xa4, 0xdf, 0x65, 0xba, 0x2f, 0xb3, 0xcf, 0xf9,
-	0x83, 0x7d, 0x65, 0xb4, 0x1c, 0xde, 0x9f, 0xb1, 0x9e, 0xfc, 0x74, 0x0c, 0xe3, 0x73, 0x30, 0xcb,
-	0x03, 0x8b, 0x35, 0xd5, 0x97, 0x20, 0x1f, 0x2f, 0x1e, 0x17, 0x20, 0xab, 0x07, 0x81, 0x1f, 0xa0,
-	0x23, 0x3c, 0x09, 0x35, 0xea, 0x22, 0x8f, 0x6d, 0x6c, 0xb0, 0x3c, 0xf6, 0x9b, 0x54, 0x72, 0x18,
-	0x11, 0x7a, 0x6b, 0x97, 0x86, 0x11, 0xfe, 0x1c, 0xcc, 0x51, 0x1e, 0x42, 0xce, 0x1e, 0xb5, 0xba,
-	0xbc, 0x72, 0x61, 0x01, 0xa4, 0x70, 0xbe, 0x67, 0x56, 0x45, 0xa1, 0x15, 0x57, 0x34, 0x64, 0x36,
-	0xf1, 0x95, 0xaa, 0x1e, 0xd6, 0x61, 0xce, 0x19, 0x0c, 0x68, 0xcf, 0xb1, 0xa3, 0xf1, 0x01, 0xc4,
-	0x86, 0x2d, 0xc4, 0x07, 0xfb, 0x81, 0xc2, 0x88, 0xcc, 0x26, 0x3d, 0x92, 0x61, 0x4e, 0x42, 0x2e,
-	0xe2, 0x45, 0x1c, 0x8f, 0xdd, 0xe2, 0x5a, 0x29, 0x4e, 0x28, 0x5c, 0x49, 0xa4, 0x11, 0xbf, 0x04,
-	0xa2, 0x24, 0xe4, 0xa9, 0x63, 0x14, 0x10, 0xa3, 0x93, 0x9e, 0x08, 0x3b, 0x3e, 0x09, 0xe5, 0x03,
-	0x67, 0x50, 0x8f, 0x13, 0x96, 0x26, 0xa5, 0xf1, 0x03, 0xa5, 0x87, 0x4f, 0xc1, 0x94, 0x2f, 0xce,
-	0x1f, 0x9e, 0x54, 0x46, 0x2b, 0x3e, 0x78, 0x38, 0x91, 0xd8, 0x4b, 0xfd, 0x0c, 0xcc, 0x24, 0x0c,
-	0x86, 0x43, 0xdf, 0x0b, 0x29, 0x5e, 0x81, 0x5c, 0xc0, 0x1f, 0x67, 0xc9, 0x1a, 0x96, 0x43, 0x8c,
-	0x3d, 0xe8, 0x44, 0x7a, 0xa8, 0x3d, 0x98, 0x11, 0x9a, 0x6b, 0x4e, 0xb4, 0xcd, 0x37, 0x0a, 0x9f,
-	0x84, 0x2c, 0x65, 0x8d, 0x7b, 0x38, 0x27, 0xad, 0x2a, 0xb7, 0x13, 0x61, 0x1d, 0x9b, 0x25, 0xf5,
-	0xc0, 0x59, 0xfe, 0x96, 0x82, 0x39, 0xb9, 0xca, 0x75, 0x3b, 0xea, 0x6e, 0x3f, 0xa6, 0x9b, 0xfd,
-	0xdf, 0x30, 0xc5, 0xf4, 0x4e, 0xf2, 0x60, 0x4c, 0xd8, 0xee, 0xd8, 0x83, 0x6d, 0xb8, 0x1d, 0x5a,
-	0x63, 0xbb, 0x2b, 0x6b, 0xa0, 0x92, 0x1d, 0x8e, 0x1d, 0xc0, 0x13, 0xe2, 0x22, 0xf7, 0x80, 0xb8,
-	0x98, 0x7a, 0xa8, 0xb8, 0xd8, 0x80, 0xf9, 0x83, 0x8c, 0xcb, 0xe0, 0xf8, 0x1f, 0x98, 0x12, 0x9b,
-	0x12, 0xa7, 0xc0, 0x49, 0xfb, 0x16, 0xbb, 0xa8, 0xbf, 0x4b, 0xc1, 0xbc, 0xcc, 0x4e, 0x9f, 0x8c,
-	0xc7, 0x74, 0x8c, 0xe7, 0xec, 0xc3, 0xf0, 0xfc, 0x90, 0xfb, 0xa7, 0x56, 0x61, 0xe1, 0x1e, 0x1e,
-	0x1f, 0xe1, 0x61, 0xfd, 0x50, 0x81, 0xe9, 0x75, 0xda, 0x77, 0xbc, 0xc7, 0x74, 0x17, 0xc6, 0xc8,
-	0xcd, 0x3c, 0x54, 0x10, 0x9f, 0x83, 0x92, 0xc4, 0x2b, 0xd9, 0x3a, 0xcc, 0xb6, 0x32, 0x89, 0xed,
-	0xbf, 0x28, 0x50, 0xaa, 0xfa, 0x83, 0x81, 0x13, 0x3d, 0xa6, 0x4c, 0x1d, 0xc6, 0x99, 0x99, 0x84,
-	0x13, 0x41, 0x39, 0x86, 0x29, 0x08, 0x52, 0xff, 0xaa, 0xc0, 0x0c, 0xf1, 0x5d, 0x77, 0xcb, 0xee,
-	0xee, 0x3c, 0xd9, 0xd8, 0x31, 0xa0, 0x11, 0x50, 0x89, 0xfe, 0x1f, 0x0a, 0x94, 0x5b, 0x01, 0x1d,
-	0xda, 0x01, 0x7d, 0xa2, 0xc1, 0xb3, 0x4a, 0xb8, 0x17, 0xc9, 0x1a, 0xa2, 0x40, 0x78, 0x5b, 0x9d,
-	0x85, 0x99, 0x04, 0xbb, 0xe4, 0xe3, 0x8f, 0x0a, 0x2c, 0x88, 0x00, 0x91, 0x96, 0xde, 0x63, 0x4a,
-	0x4b, 0x8c, 0x37, 0x33, 0x86, 0xb7, 0x02, 0x47, 0xef, 0xc5, 0x26, 0x61, 0xbf, 0x95, 0x82, 0x63,
-	0x71, 0x6c, 0x3c, 0xe6, 0xc0, 0xff, 0x8d, 0x78, 0x58, 0x84, 0xca, 0x61, 0x12, 0x24, 0x43, 0xef,
-	0xa4, 0xa0, 0x52, 0x0d, 0xa8, 0x1d, 0xd1, 0xb1, 0x5a, 0xe4, 0xc9, 0x89, 0x0d, 0xfc, 0x32, 0x4c,
-	0x0f, 0xed, 0x20, 0x72, 0xba, 0xce, 0xd0, 0x66, 0x6f, 0x7b, 0x59, 0x5e, 0xea, 0xdc, 0x33, 0xc0,
-	0x01, 0x17, 0xf5, 0x04, 0x1c, 0x9f, 0xc0, 0x88, 0xe4, 0xeb, 0x9f, 0x0a, 0xe0, 0x76, 0x64, 0x07,
-	0xd1, 0x27, 0xe0, 0x54, 0x99, 0x18, 0x4c, 0x0b, 0x30, 0x77, 0x00, 0xff, 0x38, 0x2f, 0x34, 0xfa,
-	0x44, 0x9c, 0x38, 0x1f, 0xc9, 0xcb, 0x38, 0x7e, 0xc9, 0xcb, 0x9f, 0x15, 0x58, 0xac, 0xfa, 0xe2,
-	0xfb, 0xde, 0x13, 0xf9, 0x84, 0xa9, 0x4f, 0xc3, 0x89, 0x89, 0x00, 0x25, 0x01, 0x7f, 0x52, 0xe0,
-	0x28, 0xa1, 0x76, 0xef, 0xc9, 0x04, 0x7f, 0x05, 0x8e, 0x1d, 0x02, 0x27, 0x2b, 0xd4, 0x73, 0x90,
-	0x1f, 0xd0, 0xc8, 0xee, 0xd9, 0x91, 0x2d, 0x21, 0x2d, 0xc6, 0xe3, 0x8e, 0xbc, 0x1b, 0xd2, 0x83,
-	0x24, 0xbe, 0xea, 0x07, 0x29, 0x98, 0xe3, 0xb5, 0xee, 0xa7, 0x2f, 0x5a, 0x93, 0xdf, 0x05, 0xde,
-	0x51, 0x60, 0xfe, 0x20, 0x41, 0xc9, 0x3b, 0xc1, 0x7f, 0xfa, 0x7b, 0xc5, 0x84, 0x84, 0x90, 0x9e,
-	0x54, 0x82, 0xfe, 0x3e, 0x05, 0x95, 0xf1, 0x25, 0x7d, 0xfa, 0x6d, 0xe3, 0xe0, 0xb7, 0x8d, 0x8f,
-	0xfd, 0x31, 0xeb, 0x5d, 0x05, 0x8e, 0x4f, 0x20, 0xf4, 0xe3, 0x6d, 0xf4, 0xd8, 0x17, 0x8e, 0xd4,
-	0x03, 0xbf, 0x70, 0x3c, 0xec, 0x56, 0xff, 0x41, 0x81, 0xf9, 0x86, 0xf8, 0xb0, 0x2c, 0xde, 0xe3,
-	0x1f, 0xdf, 0x6c, 0xc6, 0xbf, 0x1d, 0x67, 0x46, 0x7f, 0x4e, 0xd4, 0x2a, 0x2c, 0xdc, 0x03, 0xed,
-	0x11, 0xbe, 0x4d, 0xfc, 0x5d, 0x81, 0x59, 0x39, 0x8a, 0xf6, 0xd8, 0x16, 0x02, 0x13, 0xd8, 0xc1,
-	0xcf, 0x40, 0xda, 0xe9, 0xc5, 0x15, 0xe4, 0xc1, 0x1f, 0xc3, 0xcc, 0xa0, 0x5e, 0x00, 0x3c, 0x8e,
-	0xfb, 0x11, 0xa8, 0xe3, 0xb5, 0x15, 0x23, 0xfe, 0x0d, 0x6a, 0xbb, 0x51, 0x9c, 0x40, 0xd4, 0x9f,
-	0xa7, 0xa0, 0x44, 0x98, 0xc6, 0x19, 0xd0, 0x76, 0x64, 0x47, 0x21, 0x7e, 0x0e, 0xa6, 0xb7, 0xb9,
-	0x8b, 0x35, 0x7a, 0x0e, 0x0a, 0xa4, 0x28, 0x74, 0xe2, 0xe3, 0xed, 0x1a, 0x2c, 0x84, 0xb4, 0xeb,
-	0x7b, 0xbd, 0xd0, 0xda, 0xa2, 0xdb, 0x8e, 0xd7, 0xb3, 0x06, 0x76, 0x18, 0xd1, 0x80, 0x33, 0x56,
-	0x22, 0x73, 0xd2, 0xb8, 0xce, 0x6d, 0x0d, 0x6e, 0xc2, 0xa7, 0x61, 0x7e, 0xcb, 0xf1, 0x5c, 0xbf,
-	0x6f, 0x0d, 0x5d, 0x7b, 0x9f, 0x06, 0xa1, 0xd5, 0xf5, 0x77, 0x3d, 0x41, 0x55, 0x96, 0x60, 0x61,
-	0x6b, 0x09, 0x53, 0x95, 0x59, 0xf0, 0x0d, 0x58, 0x99, 0x38, 0x8b, 0x75, 0xd3, 0x71, 0x23, 0x1a,
-	0xd0, 0x9e, 0x15, 0xd0, 0xa1, 0xeb, 0x74, 0xc5, 0x5f, 0x56, 0x51, 0x4c, 0xbd, 0x38, 0x61, 0xea,
-	0x4d, 0xe9, 0x4e, 0x46, 0xde, 0xf8, 0x04, 0x14, 0xba, 0xc3, 0x5d, 0x6b, 0x97, 0xff, 0xd2, 0x61,
-	0x69, 0x45, 0x21, 0xf9, 0xee, 0x70, 0xb7, 0xc3, 0x64, 0x8c, 0x20, 0x7d, 0x6b, 0x28, 0xb2, 0x89,
-	0x42, 0x58, 0x53, 0xfd, 0x50, 0x81, 0xb2, 0xd6, 0xef, 0x07, 0xb4, 0x6f, 0x47, 0x92, 0xa6, 0xd3,
-	0x30, 0x2f, 0x28, 0xd9, 0xb7, 0xe4, 0x5d, 0x0a, 0x81, 0x47, 0x11, 0x78, 0xa4, 0x4d, 0xdc, 0xa4,
-	0x10, 0x78, 0xce, 0xc2, 0xd1, 0x5d, 0x6f, 0x62, 0x9f, 0x14, 0xef, 0x33, 0x9f, 0x58, 0xc7, 0x7b,
-	0xfd, 0x3f, 0x1c, 0x9f, 0xcc, 0xc2, 0xc0, 0x11, 0x37, 0x1d, 0x4a, 0xe4, 0xe8, 0x04, 0xd0, 0x0d,
-	0xc7, 0xbb, 0x4f, 0x57, 0xfb, 0x0e, 0xe7, 0xeb, 0x23, 0xba, 0xda, 0x77, 0xd4, 0x5f, 0x26, 0x9f,
-	0x64, 0xe3, 0x70, 0x49, 0xd2, 0x63, 0x1c, 0xe3, 0xca, 0xfd, 0x62, 0xbc, 0x02, 0x53, 0x21, 0x0d,
-	0xf6, 0x1c, 0xaf, 0xcf, 0xc1, 0xe5, 0x49, 0x2c, 0xe2, 0x36, 0xbc, 0x28, 0xb1, 0xd3, 0x3b, 0x11,
-	0x0d, 0x3c, 0xdb, 0x75, 0xf7, 0x2d, 0xf1, 0xe6, 0xe8, 0x45, 0xb4, 0x67, 0x8d, 0x6e, 0x7e, 0x88,
-	0x14, 0xf9, 0xbc, 0xf0, 0xd6, 0x13, 0x67, 0x92, 0xf8, 0x9a, 0xc9, 0x9d, 0x90, 0xd7, 0xa0, 0x1c,
-	0xc8, 0x20, 0xb6, 0x42, 0xb6, 0x3d, 0xb2, 0x32, 0x98, 0x8f, 0xff, 0x29, 0x8d, 0x47, 0x38, 0x29,
-	0x05, 0x07, 0x02, 0xfe, 0x3c, 0x4c, 0xcb, 0x15, 0xd9, 0xae, 0x63, 0x8f, 0x2a, 0x85, 0x7b, 0xae,
-	0xc3, 0x68, 0xcc, 0x48, 0xe4, 0xc5, 0x19, 0x2e, 0x5c, 0xca, 0xe4, 0x73, 0x68, 0x4a, 0xfd, 0x95,
-	0x02, 0x73, 0x13, 0xca, 0xae, 0xa4, 0xa6, 0x53, 0xc6, 0x5e, 0x19, 0xff, 0x17, 0xb2, 0xfc, 0x77,
-	0x9f, 0xfc, 0x81, 0x7c, 0xec, 0x70, 0xd5, 0xc6, 0x7f, 0xcd, 0x11, 0xe1, 0xc5, 0x9e, 0x45, 0x8e,
-	0xa9, 0xcb, 0xdf, 0x19, 0xe3, 0x53, 0xa3, 0xc8, 0x74, 0xe2, 0x35, 0xf2, 0xf0, 0x4b, 0x68, 0xe6,
-	0x81, 0x2f, 0xa1, 0x2b, 0xdf, 0x4b, 0x43, 0xa1, 0xb1, 0xdf, 0xbe, 0xe5, 0x6e, 0xba, 0x76, 0x9f,
-	0xff, 0x3b, 0x6b, 0xb4, 0xcc, 0xeb, 0xe8, 0x08, 0x9e, 0x85, 0x92, 0xd1, 0x34, 0x2d, 0xa3, 0x53,
-	0xaf, 0x5b, 0x9b, 0x75, 0xed, 0x22, 0x52, 0x30, 0x82, 0xe9, 0x16, 0xa9, 0x59, 0x97, 0xf5, 0xeb,
-	0x42, 0x93, 0xc2, 0x73, 0x30, 0xd3, 0x31, 0x6a, 0x57, 0x3a, 0xfa, 0x48, 0x99, 0xc1, 0x0b, 0x30,
-	0xdb, 0xe8, 0xd4, 0xcd, 0x5a, 0xab, 0x3e, 0xa6, 0xce, 0xe3, 0x12, 0x14, 0xd6, 0xeb, 0xcd, 0x75,
-	0x21, 0x22, 0x36, 0x7e, 0xc7, 0x68, 0xd7, 0x2e, 0x1a, 0xfa, 0x86, 0x50, 0x2d, 0x31, 0xd5, 0x0d,
-	0x9d, 0x34, 0x37, 0x6b, 0xf1, 0x94, 0x17, 0x30, 0x82, 0xe2, 0x7a, 0xcd, 0xd0, 0x88, 0x1c, 0xe5,
-	0xae, 0x82, 0xcb, 0x50, 0xd0, 0x8d, 0x4e, 0x43, 0xca, 0x29, 0x5c, 0x81, 0x39, 0xad, 0x63, 0x36,
-	0xad, 0x9a, 0x51, 0x25, 0x7a, 0x43, 0x37, 0x4c, 0x69, 0xc9, 0xe0, 0x39, 0x28, 0x9b, 0xb5, 0x86,
-	0xde, 0x36, 0xb5, 0x46, 0x4b, 0x2a, 0xd9, 0x2a, 0xf2, 0x6d, 0x3d, 0xf6, 0x41, 0x78, 0x11, 0x16,
-	0x8c, 0xa6, 0x25, 0xef, 0x21, 0x58, 0x57, 0xb5, 0x7a, 0x47, 0x97, 0xb6, 0x25, 0x7c, 0x0c, 0x70,
-	0xd3, 0xb0, 0x3a, 0xad, 0x0d, 0xcd, 0xd4, 0x2d, 0xa3, 0x79, 0x4d, 0x1a, 0x2e, 0xe0, 0x32, 0xe4,
-	0x47, 0x2b, 0xb8, 0xcb, 0x58, 0x28, 0xb5, 0x34, 0x62, 0x8e, 0xc0, 0xde, 0xbd, 0xcb, 0xc8, 0x82,
-	0x8b, 0xa4, 0xd9, 0x69, 0x8d, 0xdc, 0x66, 0xa1, 0x28, 0xc9, 0x92, 0xaa, 0x0c, 0x53, 0xad, 0xd7,
-	0x8c, 0x6a, 0xb2, 0xbe, 0xbb, 0xf9, 0xc5, 0x14, 0x52, 0x56, 0x76, 0x20, 0xc3, 0xb7, 0x23, 0x0f,
-	0x19, 0xa3, 0x69, 0xe8, 0xe8, 0x08, 0x9e, 0x01, 0xa8, 0xb5, 0x6b, 0x86, 0xa9, 0x5f, 0x24, 0x5a,
-	0x9d, 0xc1, 0xe6, 0x8a, 0x98, 0x40, 0x86, 0x76, 0x1a, 0xa6, 0x6a, 0xed, 0xcd, 0x7a, 0x53, 0x33,
-	0x25, 0xcc, 0x5a, 0xfb, 0x4a, 0xa7, 0x69, 0x32, 0x23, 0xc2, 0x45, 0xc8, 0xd5, 0xda, 0xa6, 0xfe,
-	0xa6, 0xc9, 0x70, 0x71, 0x9b, 0x60, 0x15, 0xdd, 0xbd, 0xb0, 0xf2, 0x5e, 0x1a, 0x32, 0xfc, 0x4a,
-	0x57, 0x09, 0x0a, 0x7c, 0xb7, 0xcd, 0xeb, 0x2d, 0x36, 0x65, 0x01, 0x32, 0x35, 0xc3, 0x3c, 0x8f,
-	0xbe, 0x90, 0xc2, 0x00, 0xd9, 0x0e, 0x6f, 0x7f, 0x31, 0xc7, 0xda, 0x35, 0xc3, 0x7c, 0xf9, 0x1c,
-	0x7a, 0x2b, 0xc5, 0x86, 0xed, 0x08, 0xe1, 0x4b, 0xb1, 0x61, 0xed, 0x2c, 0x7a, 0x3b, 0x31, 0xac,
-	0x9d, 0x45, 0x5f, 0x8e, 0x0d, 0x67, 0xd6, 0xd0, 0x57, 0x12, 0xc3, 0x99, 0x35, 0xf4, 0xd5, 0xd8,
-	0x70, 0xee, 0x2c, 0xfa, 0x5a, 0x62, 0x38, 0x77, 0x16, 0x7d, 0x3d, 0xc7, 0xb0, 0x70, 0x24, 0x67,
-	0xd6, 0xd0, 0x37, 0xf2, 0x89, 0x74, 0xee, 0x2c, 0xfa, 0x66, 0x9e, 0xed, 0x7f, 0xb2, 0xab, 0xe8,
-	0x5b, 0x88, 0x2d, 0x93, 0x6d, 0x10, 0xfa, 0x36, 0x6f, 0x32, 0x13, 0xfa, 0x0e, 0x62, 0x18, 0x99,
-	0x96, 0x8b, 0xef, 0x70, 0xcb, 0x75, 0x5d, 0x23, 0xe8, 0xbb, 0x39, 0x71, 0xed, 0xa4, 0x5a, 0x6b,
-	0x68, 0x75, 0x84, 0x79, 0x0f, 0xc6, 0xca, 0xf7, 0x4f, 0xb3, 0x26, 0x0b, 0x4f, 0xf4, 0x83, 0x16,
-	0x9b, 0xf0, 0xaa, 0x46, 0xaa, 0x6f, 0x68, 0x04, 0xfd, 0xf0, 0x34, 0x9b, 0xf0, 0xaa, 0x46, 0x24,
-	0x5f, 0x3f, 0x6a, 0x31, 0x47, 0x6e, 0x7a, 0xf7, 0x34, 0x5b, 0xb4, 0xd4, 0xff, 0xb8, 0x85, 0xf3,
-	0x90, 0x5e, 0xaf, 0x99, 0xe8, 0x3d, 0x3e, 0x1b, 0x0b, 0x51, 0xf4, 0x13, 0xc4, 0x94, 0x6d, 0xdd,
-	0x44, 0xef, 0x33, 0x65, 0xd6, 0xec, 0xb4, 0xea, 0x3a, 0x7a, 0x8a, 0x2d, 0xee, 0xa2, 0xde, 0x6c,
-	0xe8, 0x26, 0xb9, 0x8e, 0x7e, 0xca, 0xdd, 0x2f, 0xb5, 0x9b, 0x06, 0xfa, 0x00, 0xe1, 0x32, 0x80,
-	0xfe, 0x66, 0x8b, 0xe8, 0xed, 0x76, 0xad, 0x69, 0xa0, 0x67, 0x57, 0x36, 0x01, 0xdd, 0x9b, 0x0e,
-	0x18, 0x80, 0x8e, 0x71, 0xd9, 0x68, 0x5e, 0x33, 0xd0, 0x11, 0x26, 0xb4, 0x88, 0xde, 0xd2, 0x88,
-	0x8e, 0x14, 0x0c, 0x90, 0x93, 0x97, 0x59, 0x52, 0x78, 0x1a, 0xf2, 0xa4, 0x59, 0xaf, 0xaf, 0x6b,
-	0xd5, 0xcb, 0x28, 0xbd, 0xfe, 0x0a, 0xcc, 0x38, 0xfe, 0xea, 0x9e, 0x13, 0xd1, 0x30, 0x14, 0x97,
-	0x06, 0x6f, 0xa8, 0x52, 0x72, 0xfc, 0x53, 0xa2, 0x75, 0xaa, 0xef, 0x9f, 0xda, 0x8b, 0x4e, 0x71,
-	0xeb, 0x29, 0x9e, 0x31, 0xb6, 0x72, 0x5c, 0x38, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69,
-	0x36, 0x21, 0xf2, 0x92, 0x28, 0x00, 0x00,
+	// 3388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x73, 0x1c, 0x49,
+	0x56, 0x77, 0xf5, 0x77, 0xbf, 0x56, 0xb7, 0x52, 0x29, 0xc9, 0xee, 0xd1, 0x7c, 0xac, 0xb6, 0x66,
+	0x67, 0x57, 0x2b, 0x40, 0xb6, 0x35, 0x5e, 0x63, 0x66, 0x17, 0x76, 0x4a, 0xdd, 0x25, 0xb9, 0xed,
+	0xfe, 0x72, 0x76, 0xb5, 0xbd, 0x9e, 0x20, 0xa2, 0xa2, 0xd4, 0x9d, 0x6e, 0x55, 0xa8, 0xba, 0xaa,
+	0x5d, 0x55, 0x2d, 0x8f, 0x4e, 0x78, 0x59, 0x96, 0xaf, 0xe5, 0x63, 0xf8, 0x1c, 0x96, 0x0d, 0x26,
+	0xb8, 0x11, 0x5c, 0xf8, 0x1b, 0x08, 0x0e, 0x5c, 0x88, 0x80, 0x1b, 0x07, 0x38, 0x70, 0x22, 0x38,
+	0x10, 0x41, 0x70, 0x06, 0x82, 0x20, 0xf2, 0xa3, 0xaa, 0xab, 0xa5, 0xf6, 0xc7, 0x1a, 0x38, 0xc8,
+	0x33, 0xa7, 0xce, 0x7c, 0xef, 0xe5, 0xc7, 0xfb, 0xbd, 0x57, 0x2f, 0xb3, 0x5f, 0x3e, 0x28, 0x3d,
+	0x9e, 0x52, 0xff, 0x74, 0x67, 0xe2, 0x7b, 0xa1, 0x87, 0xb3, 0xbc, 0xb3, 0x51, 0x09, 0xbd, 0x89,
+	0x37, 0xb4, 0x42, 0x4b, 0x90, 0x37, 0x4a, 0x27, 0xa1, 0x3f, 0x19, 0x88, 0x8e, 0xfa, 0x7d, 0x05,
+	0x72, 0x86, 0xe5, 0x8f, 0x68, 0x88, 0x37, 0xa0, 0x70, 0x4c, 0x4f, 0x83, 0x89, 0x35, 0xa0, 0x55,
+	0x65, 0x53, 0xd9, 0x2a, 0x92, 0xb8, 0x8f, 0xd7, 0x20, 0x1b, 0x1c, 0x59, 0xfe, 0xb0, 0x9a, 0xe2,
+	0x0c, 0xd1, 0xc1, 0xdf, 0x80, 0x52, 0x68, 0x1d, 0x3a, 0x34, 0x34, 0xc3, 0xd3, 0x09, 0xad, 0xa6,
+	0x37, 0x95, 0xad, 0xca, 0xee, 0xda, 0x4e, 0xbc, 0x9e, 0xc1, 0x99, 0xc6, 0xe9, 0x84, 0x12, 0x08,
+	0xe3, 0x36, 0xc6, 0x90, 0x19, 0x50, 0xc7, 0xa9, 0x66, 0xf8, 0x5c, 0xbc, 0xad, 0xd6, 0xa1, 0x72,
+	0xdf, 0x38, 0xb0, 0x42, 0x5a, 0xb3, 0x1c, 0x87, 0xfa, 0x8d, 0x3a, 0xdb, 0xce, 0x34, 0xa0, 0xbe,
+	0x6b, 0x8d, 0xe3, 0xed, 0x44, 0x7d, 0x7c, 0x19, 0x72, 0x23, 0xdf, 0x9b, 0x4e, 0x82, 0x6a, 0x6a,
+	0x33, 0xbd, 0x55, 0x24, 0xb2, 0xa7, 0xfe, 0x3c, 0x80, 0x7e, 0x42, 0xdd, 0xd0, 0xf0, 0x8e, 0xa9,
+	0x8b, 0xdf, 0x82, 0x62, 0x68, 0x8f, 0x69, 0x10, 0x5a, 0xe3, 0x09, 0x9f, 0x22, 0x4d, 0x66, 0x84,
+	0x67, 0xa8, 0xb4, 0x01, 0x85, 0x89, 0x17, 0xd8, 0xa1, 0xed, 0xb9, 0x5c, 0x9f, 0x22, 0x89, 0xfb,
+	0xea, 0xcf, 0x41, 0xf6, 0xbe, 0xe5, 0x4c, 0x29, 0xfe, 0x12, 0x64, 0xb8, 0xc2, 0x0a, 0x57, 0xb8,
+	0xb4, 0x23, 0x40, 0xe7, 0x7a, 0x72, 0x06, 0x9b, 0xfb, 0x84, 0x49, 0xf2, 0xb9, 0x97, 0x88, 0xe8,
+	0xa8, 0xc7, 0xb0, 0xb4, 0x67, 0xbb, 0xc3, 0xfb, 0x96, 0x6f, 0x33, 0x30, 0x5e, 0x71, 0x1a, 0xfc,
+	0x15, 0xc8, 0xf1, 0x46, 0x50, 0x4d, 0x6f, 0xa6, 0xb7, 0x4a, 0xbb, 0x4b, 0x72, 0x20, 0xdf, 0x1b,
+	0x91, 0x3c, 0xf5, 0xaf, 0x14, 0x80, 0x3d, 0x6f, 0xea, 0x0e, 0xef, 0x31, 0x26, 0x46, 0x90, 0x0e,
+	0x1e, 0x3b, 0x12, 0x48, 0xd6, 0xc4, 0x77, 0xa1, 0x72, 0x68, 0xbb, 0x43, 0xf3, 0x44, 0x6e, 0x47,
+	0x60, 0x59, 0xda, 0xfd, 0x8a, 0x9c, 0x6e, 0x36, 0x78, 0x27, 0xb9, 0xeb, 0x40, 0x77, 0x43, 0xff,
+	0x94, 0x94, 0x0f, 0x93, 0xb4, 0x8d, 0x3e, 0xe0, 0xf3, 0x42, 0x6c, 0xd1, 0x63, 0x7a, 0x1a, 0x2d,
+	0x7a, 0x4c, 0x4f, 0xf1, 0xd7, 0x93, 0x1a, 0x95, 0x76, 0x57, 0xa3, 0xb5, 0x12, 0x63, 0xa5, 0x9a,
+	0x1f, 0xa4, 0x6e, 0x29, 0xea, 0xbf, 0x66, 0xa1, 0xa2, 0x7f, 0x4c, 0x07, 0xd3, 0x90, 0x76, 0x26,
+	0xcc, 0x06, 0x01, 0x6e, 0xc1, 0xb2, 0xed, 0x0e, 0x9c, 0xe9, 0x90, 0x0e, 0xcd, 0x47, 0x36, 0x75,
+	0x86, 0x01, 0xf7, 0xa3, 0x4a, 0xbc, 0xef, 0x79, 0xf9, 0x9d, 0x86, 0x14, 0xde, 0xe7, 0xb2, 0xa4,
+	0x62, 0xcf, 0xf5, 0xf1, 0x36, 0xac, 0x0c, 0x1c, 0x9b, 0xba, 0xa1, 0xf9, 0x88, 0xe9, 0x6b, 0xfa,
+	0xde, 0x93, 0xa0, 0x9a, 0xdd, 0x54, 0xb6, 0x0a, 0x64, 0x59, 0x30, 0xf6, 0x19, 0x9d, 0x78, 0x4f,
+	0x02, 0xfc, 0x01, 0x14, 0x9e, 0x78, 0xfe, 0xb1, 0xe3, 0x59, 0xc3, 0x6a, 0x8e, 0xaf, 0xf9, 0xce,
+	0xe2, 0x35, 0x1f, 0x48, 0x29, 0x12, 0xcb, 0xe3, 0x2d, 0x40, 0xc1, 0x63, 0xc7, 0x0c, 0xa8, 0x43,
+	0x07, 0xa1, 0xe9, 0xd8, 0x63, 0x3b, 0xac, 0x16, 0xb8, 0x4b, 0x56, 0x82, 0xc7, 0x4e, 0x8f, 0x93,
+	0x9b, 0x8c, 0x8a, 0x4d, 0x58, 0x0f, 0x7d, 0xcb, 0x0d, 0xac, 0x01, 0x9b, 0xcc, 0xb4, 0x03, 0xcf,
+	0xb1, 0x58, 0xab, 0x5a, 0xe4, 0x4b, 0x6e, 0x2f, 0x5e, 0xd2, 0x98, 0x0d, 0x69, 0x44, 0x23, 0xc8,
+	0x5a, 0xb8, 0x80, 0x8a, 0xaf, 0xc3, 0x7a, 0x70, 0x6c, 0x4f, 0x4c, 0x3e, 0x8f, 0x39, 0x71, 0x2c,
+	0xd7, 0x1c, 0x58, 0x83, 0x23, 0x5a, 0x05, 0xae, 0x36, 0x66, 0x4c, 0x6e, 0xf7, 0xae, 0x63, 0xb9,
+	0x35, 0xc6, 0x61, 0x43, 0x7c, 0x6a, 0x0d, 0x4d, 0xeb, 0x51, 0x48, 0x7d, 0xf3, 0x89, 0x6f, 0x87,
+	0xd4, 0x1c, 0x85, 0xf6, 0xb0, 0x5a, 0xe2, 0xa6, 0xc5, 0x8c, 0xa9, 0x31, 0xde, 0x03, 0xc6, 0x3a,
+	0x08, 0xed, 0xa1, 0xfa, 0x4d, 0xa8, 0xcc, 0x43, 0x8f, 0x57, 0xa0, 0x6c, 0x3c, 0xec, 0xea, 0xa6,
+	0xd6, 0xae, 0x9b, 0x6d, 0xad, 0xa5, 0xa3, 0x4b, 0xb8, 0x0c, 0x45, 0x4e, 0xea, 0xb4, 0x9b, 0x0f,
+	0x91, 0x82, 0xf3, 0x90, 0xd6, 0x9a, 0x4d, 0x94, 0x52, 0x6f, 0x41, 0x21, 0xc2, 0x10, 0x2f, 0x43,
+	0xa9, 0xdf, 0xee, 0x75, 0xf5, 0x5a, 0x63, 0xbf, 0xa1, 0xd7, 0xd1, 0x25, 0x5c, 0x80, 0x4c, 0xa7,
+	0x69, 0x74, 0x91, 0x22, 0x5a, 0x5a, 0x17, 0xa5, 0xd8, 0xc8, 0xfa, 0x9e, 0x86, 0xd2, 0xea, 0x9f,
+	0x29, 0xb0, 0xb6, 0x08, 0x0b, 0x5c, 0x82, 0x7c, 0x5d, 0xdf, 0xd7, 0xfa, 0x4d, 0x03, 0x5d, 0xc2,
+	0xab, 0xb0, 0x4c, 0xf4, 0xae, 0xae, 0x19, 0xda, 0x5e, 0x53, 0x37, 0x89, 0xae, 0xd5, 0x91, 0x82,
+	0x31, 0x54, 0x58, 0xcb, 0xac, 0x75, 0x5a, 0xad, 0x86, 0x61, 0xe8, 0x75, 0x94, 0xc2, 0x6b, 0x80,
+	0x38, 0xad, 0xdf, 0x9e, 0x51, 0xd3, 0x18, 0xc1, 0x52, 0x4f, 0x27, 0x0d, 0xad, 0xd9, 0xf8, 0x88,
+	0x4d, 0x80, 0x32, 0xf8, 0xcb, 0xf0, 0x76, 0xad, 0xd3, 0xee, 0x35, 0x7a, 0x86, 0xde, 0x36, 0xcc,
+	0x5e, 0x5b, 0xeb, 0xf6, 0x6e, 0x77, 0x0c, 0x3e, 0xb3, 0x50, 0x2e, 0x8b, 0x2b, 0x00, 0x5a, 0xdf,
+	0xe8, 0x88, 0x79, 0x50, 0xee, 0x4e, 0xa6, 0xa0, 0xa0, 0xd4, 0x9d, 0x4c, 0x21, 0x85, 0xd2, 0x77,
+	0x32, 0x85, 0x34, 0xca, 0xa8, 0x9f, 0xa6, 0x20, 0xcb, 0xb1, 0x62, 0x11, 0x32, 0x11, 0xf7, 0x78,
+	0x3b, 0x8e, 0x16, 0xa9, 0xe7, 0x44, 0x0b, 0x1e, 0x64, 0x65, 0xdc, 0x12, 0x1d, 0xfc, 0x26, 0x14,
+	0x3d, 0x7f, 0x64, 0x0a, 0x8e, 0x88, 0xb8, 0x05, 0xcf, 0x1f, 0xf1, 0xd0, 0xcc, 0xa2, 0x1d, 0x0b,
+	0xd4, 0x87, 0x56, 0x40, 0xb9, 0xd3, 0x17, 0x49, 0xdc, 0xc7, 0x6f, 0x00, 0x93, 0x33, 0xf9, 0x3e,
+	0x72, 0x9c, 0x97, 0xf7, 0xfc, 0x51, 0x9b, 0x6d, 0xe5, 0x5d, 0x28, 0x0f, 0x3c, 0x67, 0x3a, 0x76,
+	0x4d, 0x87, 0xba, 0xa3, 0xf0, 0xa8, 0x9a, 0xdf, 0x54, 0xb6, 0xca, 0x64, 0x49, 0x10, 0x9b, 0x9c,
+	0x86, 0xab, 0x90, 0x1f, 0x1c, 0x59, 0x7e, 0x40, 0x85, 0xa3, 0x97, 0x49, 0xd4, 0xe5, 0xab, 0xd2,
+	0x81, 0x3d, 0xb6, 0x9c, 0x80, 0x3b, 0x75, 0x99, 0xc4, 0x7d, 0xa6, 0xc4, 0x23, 0xc7, 0x1a, 0x05,
+	0xdc, 0x19, 0xcb, 0x44, 0x74, 0xd4, 0x9f, 0x86, 0x34, 0xf1, 0x9e, 0xb0, 0x29, 0xc5, 0x82, 0x41,
+	0x55, 0xd9, 0x4c, 0x6f, 0x61, 0x12, 0x75, 0xd9, 0x81, 0x20, 0x63, 0xa2, 0x08, 0x95, 0xb2, 0xa7,
+	0xfe, 0x9d, 0x02, 0x25, 0xee, 0xcb, 0x84, 0x06, 0x53, 0x27, 0x64, 0xb1, 0x53, 0x06, 0x0d, 0x65,
+	0x2e, 0x76, 0x72, 0xd8, 0x89, 0xe4, 0x31, 0xfd, 0x58, 0x1c, 0x30, 0xad, 0x47, 0x8f, 0xe8, 0x20,
+	0xa4, 0xe2, 0x88, 0xc8, 0x90, 0x25, 0x46, 0xd4, 0x24, 0x8d, 0x01, 0x6b, 0xbb, 0x01, 0xf5, 0x43,
+	0xd3, 0x1e, 0x72, 0xc8, 0x33, 0xa4, 0x20, 0x08, 0x8d, 0x21, 0x7e, 0x07, 0x32, 0x3c, 0x92, 0x64,
+	0xf8, 0x2a, 0x20, 0x57, 0x21, 0xde, 0x13, 0xc2, 0xe9, 0x78, 0x17, 0xd6, 0x03, 0x1a, 0x04, 0xec,
+	0x03, 0x0f, 0x42, 0x2b, 0xa4, 0xe6, 0xe0, 0xc8, 0x72, 0x47, 0x34, 0x90, 0x48, 0xaf, 0x4a, 0x66,
+	0x8f, 0xf1, 0x6a, 0x82, 0x75, 0x27, 0x53, 0xc8, 0xa2, 0x9c, 0xfa, 0x2d, 0x58, 0xe2, 0x0a, 0x3d,
+	0xb0, 0x7c, 0xd7, 0x76, 0x47, 0xfc, 0x30, 0xf5, 0x86, 0xc2, 0x55, 0xca, 0x84, 0xb7, 0x19, 0x4e,
+	0x63, 0x1a, 0x04, 0xd6, 0x88, 0xca, 0xc3, 0x2d, 0xea, 0xaa, 0x7f, 0x9a, 0x86, 0x52, 0x2f, 0xf4,
+	0xa9, 0x35, 0xe6, 0xe7, 0x24, 0xfe, 0x16, 0x00, 0x5f, 0x7f, 0x4c, 0xdd, 0x30, 0xc2, 0xe4, 0x2d,
+	0xb9, 0xdb, 0x84, 0xdc, 0x4e, 0x2f, 0x12, 0x22, 0x09, 0x79, 0xbc, 0x0b, 0x25, 0xca, 0xd8, 0x66,
+	0xc8, 0xce, 0x5b, 0x19, 0xd3, 0x57, 0xa2, 0x00, 0x15, 0x1f, 0xc4, 0x04, 0x68, 0xdc, 0xde, 0xf8,
+	0x2c, 0x05, 0xc5, 0x78, 0x36, 0xac, 0x41, 0x61, 0x60, 0x85, 0x74, 0xe4, 0xf9, 0xa7, 0xf2, 0x18,
+	0x7c, 0xef, 0x79, 0xab, 0xef, 0xd4, 0xa4, 0x30, 0x89, 0x87, 0xe1, 0xb7, 0x41, 0xdc, 0x2d, 0x84,
+	0xa7, 0x0a, 0x7d, 0x8b, 0x9c, 0xc2, 0x7d, 0xf5, 0x03, 0xc0, 0x13, 0xdf, 0x1e, 0x5b, 0xfe, 0xa9,
+	0x79, 0x4c, 0x4f, 0xa3, 0x23, 0x23, 0xbd, 0xc0, 0xfa, 0x48, 0xca, 0xdd, 0xa5, 0xa7, 0x32, 0x62,
+	0xdd, 0x9a, 0x1f, 0x2b, 0x3d, 0xec, 0xbc, 0x4d, 0x13, 0x23, 0xf9, 0x21, 0x1c, 0x44, 0xc7, 0x6d,
+	0x96, 0x3b, 0x23, 0x6b, 0xaa, 0x5f, 0x83, 0x42, 0xb4, 0x79, 0x5c, 0x84, 0xac, 0xee, 0xfb, 0x9e,
+	0x8f, 0x2e, 0xf1, 0xc0, 0xd5, 0x6a, 0x8a, 0xd8, 0x57, 0xaf, 0xb3, 0xd8, 0xf7, 0x97, 0xa9, 0xf8,
+	0xcc, 0x23, 0xf4, 0xf1, 0x94, 0x06, 0x21, 0xfe, 0x36, 0xac, 0x52, 0xee, 0x76, 0xf6, 0x09, 0x35,
+	0x07, 0xfc, 0x82, 0xc4, 0x9c, 0x4e, 0xe1, 0x78, 0x2f, 0xef, 0x88, 0xfb, 0x5c, 0x74, 0x71, 0x22,
+	0x2b, 0xb1, 0xac, 0x24, 0x0d, 0xb1, 0x0e, 0xab, 0xf6, 0x78, 0x4c, 0x87, 0x36, 0x77, 0xb5, 0x78,
+	0x02, 0x61, 0xb0, 0xf5, 0xe8, 0xfe, 0x30, 0x77, 0xff, 0x22, 0x2b, 0xf1, 0x88, 0x78, 0x9a, 0xf7,
+	0x20, 0x17, 0xf2, 0xbb, 0x22, 0xf7, 0xf7, 0xd2, 0x6e, 0x39, 0x0a, 0x42, 0x9c, 0x48, 0x24, 0x13,
+	0x7f, 0x0d, 0xc4, 0xcd, 0x93, 0x87, 0x9b, 0x99, 0x43, 0xcc, 0x2e, 0x14, 0x44, 0xf0, 0xf1, 0x7b,
+	0x50, 0x99, 0x3b, 0xea, 0x86, 0x1c, 0xb0, 0x34, 0x29, 0x27, 0xa8, 0x8d, 0x21, 0xbe, 0x0a, 0x79,
+	0x4f, 0x1c, 0x73, 0xd5, 0xdc, 0xdc, 0x8e, 0xe7, 0xcf, 0x40, 0x12, 0x49, 0xa9, 0x3f, 0x0b, 0xcb,
+	0x31, 0x82, 0xc1, 0xc4, 0x73, 0x03, 0x8a, 0xb7, 0x21, 0xe7, 0xf3, 0x10, 0x20, 0x51, 0xc3, 0x72,
+	0x8a, 0x44, 0x70, 0x20, 0x52, 0x42, 0x1d, 0xc2, 0xb2, 0xa0, 0x3c, 0xb0, 0xc3, 0x23, 0x6e, 0x28,
+	0xfc, 0x1e, 0x64, 0x29, 0x6b, 0x9c, 0xc1, 0x9c, 0x74, 0x6b, 0x9c, 0x4f, 0x04, 0x37, 0xb1, 0x4a,
+	0xea, 0x85, 0xab, 0xfc, 0x7b, 0x0a, 0x56, 0xe5, 0x2e, 0xf7, 0xac, 0x70, 0x70, 0x74, 0x41, 0x8d,
+	0xfd, 0x13, 0x90, 0x67, 0x74, 0x3b, 0xfe, 0x30, 0x16, 0x98, 0x3b, 0x92, 0x60, 0x06, 0xb7, 0x02,
+	0x33, 0x61, 0x5d, 0x79, 0xd5, 0x2a, 0x5b, 0x41, 0xe2, 0xd0, 0x5e, 0xe0, 0x17, 0xb9, 0x17, 0xf8,
+	0x45, 0xfe, 0xa5, 0xfc, 0xa2, 0x0e, 0x6b, 0xf3, 0x88, 0x4b, 0xe7, 0xf8, 0x49, 0xc8, 0x0b, 0xa3,
+	0x44, 0x21, 0x70, 0x91, 0xdd, 0x22, 0x11, 0xf5, 0xaf, 0x53, 0xb0, 0x26, 0xa3, 0xd3, 0xe7, 0xe3,
+	0x33, 0x4d, 0xe0, 0x9c, 0x7d, 0x19, 0x9c, 0x5f, 0xd2, 0x7e, 0x6a, 0x0d, 0xd6, 0xcf, 0xe0, 0xf8,
+	0x0a, 0x1f, 0xeb, 0xbf, 0x29, 0xb0, 0xb4, 0x47, 0x47, 0xb6, 0x7b, 0x41, 0xad, 0x90, 0x00, 0x37,
+	0xf3, 0x52, 0x4e, 0x7c, 0x13, 0xca, 0x52, 0x5f, 0x89, 0xd6, 0x79, 0xb4, 0x95, 0x45, 0x68, 0xff,
+	0xb3, 0x02, 0xe5, 0x9a, 0x37, 0x1e, 0xdb, 0xe1, 0x05, 0x45, 0xea, 0xbc, 0x9e, 0x99, 0x45, 0x7a,
+	0xd6, 0xa1, 0x12, 0xa9, 0x29, 0x01, 0x7a, 0xe6, 0x65, 0x4b, 0x79, 0xe6, 0x65, 0x4b, 0xfd, 0x17,
+	0x05, 0x96, 0x89, 0xe7, 0x38, 0x87, 0xd6, 0xe0, 0xf8, 0xf5, 0xc6, 0x0b, 0x03, 0x9a, 0x29, 0x2a,
+	0x10, 0x53, 0xff, 0x43, 0x81, 0x4a, 0xd7, 0xa7, 0x13, 0xcb, 0xa7, 0xaf, 0xb5, 0xf2, 0xec, 0xf6,
+	0x3c, 0x0c, 0xe5, 0xbd, 0xa3, 0x48, 0x78, 0x5b, 0x5d, 0x81, 0xe5, 0x58, 0x77, 0x89, 0xc7, 0x3f,
+	0x28, 0xb0, 0x2e, 0x9c, 0x4a, 0x72, 0x86, 0x17, 0x14, 0x96, 0x48, 0xdf, 0x4c, 0x42, 0xdf, 0x2a,
+	0x5c, 0x3e, 0xab, 0x9b, 0x54, 0xfb, 0x7b, 0x29, 0xb8, 0x12, 0xf9, 0xc6, 0x05, 0x57, 0xfc, 0x7f,
+	0xe1, 0x0f, 0x1b, 0x50, 0x3d, 0x0f, 0x82, 0x44, 0xe8, 0x93, 0x14, 0x54, 0x6b, 0x3e, 0xb5, 0x42,
+	0x9a, 0xb8, 0xbf, 0xbc, 0x3e, 0xbe, 0x81, 0xaf, 0xc3, 0xd2, 0xc4, 0xf2, 0x43, 0x7b, 0x60, 0x4f,
+	0x2c, 0xf6, 0x0f, 0x31, 0xbb, 0x99, 0x3e, 0x3f, 0xc1, 0x9c, 0x88, 0xfa, 0x26, 0xbc, 0xb1, 0x00,
+	0x11, 0x89, 0xd7, 0x7f, 0x2b, 0x80, 0x7b, 0xa1, 0xe5, 0x87, 0x9f, 0x83, 0x93, 0x68, 0xa1, 0x33,
+	0xad, 0xc3, 0xea, 0x9c, 0xfe, 0x49, 0x5c, 0x68, 0xf8, 0xb9, 0x38, 0x71, 0x9e, 0x89, 0x4b, 0x52,
+	0x7f, 0x89, 0xcb, 0x3f, 0x29, 0xb0, 0x51, 0xf3, 0x44, 0x1e, 0xf1, 0xb5, 0xfc, 0xc2, 0xd4, 0xb7,
+	0xe1, 0xcd, 0x85, 0x0a, 0x4a, 0x00, 0xfe, 0x51, 0x81, 0xcb, 0x84, 0x5a, 0xc3, 0xd7, 0x53, 0xf9,
+	0x7b, 0x70, 0xe5, 0x9c, 0x72, 0xf2, 0xd2, 0x76, 0x13, 0x0a, 0x63, 0x1a, 0x5a, 0x43, 0x2b, 0xb4,
+	0xa4, 0x4a, 0x1b, 0xd1, 0xbc, 0x33, 0xe9, 0x96, 0x94, 0x20, 0xb1, 0xac, 0xfa, 0x59, 0x0a, 0x56,
+	0xf9, 0xfd, 0xf8, 0x8b, 0x3f, 0x67, 0x8b, 0xff, 0x3f, 0x7c, 0xa2, 0xc0, 0xda, 0x3c, 0x40, 0xf1,
+	0xff, 0x88, 0xff, 0xeb, 0x1c, 0xc7, 0x82, 0x80, 0x90, 0x5e, 0x74, 0x05, 0xfd, 0xdb, 0x14, 0x54,
+	0x93, 0x5b, 0xfa, 0x22, 0x1f, 0x32, 0x9f, 0x0f, 0xf9, 0xb1, 0x13, 0x60, 0x9f, 0x2a, 0xf0, 0xc6,
+	0x02, 0x40, 0x7f, 0x3c, 0x43, 0x27, 0xb2, 0x22, 0xa9, 0x17, 0x66, 0x45, 0x5e, 0xd6, 0xd4, 0xff,
+	0xa5, 0xc0, 0x5a, 0x4b, 0x24, 0xa3, 0xc5, 0x7f, 0xff, 0x8b, 0x1b, 0xcd, 0x78, 0xbe, 0x39, 0x93,
+	0x78, 0xa1, 0xf9, 0x32, 0x2c, 0x31, 0x34, 0xc6, 0x54, 0xe6, 0xc3, 0xc5, 0xf9, 0x56, 0x12, 0x34,
+	0x9e, 0xfd, 0x66, 0x29, 0x8f, 0x33, 0xda, 0xbf, 0x42, 0xca, 0xe3, 0xbb, 0x69, 0x58, 0x91, 0xb3,
+	0x68, 0x83, 0xe3, 0xd7, 0x08, 0xc0, 0x77, 0x20, 0x6d, 0x0f, 0xa3, 0x4b, 0xe6, 0xfc, 0xb3, 0x36,
+	0x63, 0xe0, 0x6f, 0x43, 0x7e, 0x3a, 0x19, 0x5a, 0x21, 0x7f, 0x27, 0x61, 0x32, 0xd1, 0x63, 0xc1,
+	0x39, 0x34, 0x76, 0xfa, 0x42, 0x4e, 0x3c, 0x56, 0x47, 0xa3, 0x36, 0x6e, 0xc3, 0x52, 0x92, 0xb1,
+	0xe0, 0x81, 0x5a, 0x9d, 0x7f, 0xa0, 0x9e, 0xdf, 0x44, 0xe2, 0x65, 0xfa, 0x43, 0xc0, 0xc9, 0x45,
+	0x5f, 0xc1, 0x8a, 0x3f, 0x48, 0xc3, 0x65, 0x39, 0x45, 0xd7, 0x0b, 0xc2, 0x89, 0xe7, 0xd2, 0xcf,
+	0x91, 0x29, 0xeb, 0x67, 0x4d, 0xb9, 0x3d, 0x6f, 0xca, 0x33, 0x90, 0xfc, 0xbf, 0xdb, 0x53, 0x87,
+	0x2b, 0xe7, 0x56, 0x7e, 0x05, 0xa3, 0xf2, 0xeb, 0x3d, 0xfb, 0xb0, 0x6f, 0x53, 0xcb, 0x09, 0xa3,
+	0x33, 0x4c, 0xfd, 0xfb, 0x14, 0x94, 0x09, 0xa3, 0xd8, 0x63, 0xca, 0xd2, 0x4c, 0x01, 0x8b, 0x15,
+	0x47, 0x5c, 0xc4, 0x9c, 0x85, 0xe2, 0x22, 0x29, 0x09, 0x9a, 0x78, 0x73, 0xe0, 0x69, 0xab, 0x81,
+	0xe7, 0x0e, 0x03, 0xf3, 0x90, 0x1e, 0xb1, 0x52, 0x8d, 0xb1, 0x15, 0x84, 0xd4, 0xe7, 0xaa, 0x94,
+	0xc9, 0xaa, 0x64, 0xee, 0x71, 0x5e, 0x8b, 0xb3, 0xf0, 0x35, 0x58, 0x3b, 0xb4, 0x5d, 0xc7, 0x1b,
+	0xb1, 0x77, 0xfd, 0x53, 0xea, 0x07, 0xe6, 0xc0, 0x9b, 0xba, 0xc2, 0x7e, 0x59, 0x82, 0x05, 0xaf,
+	0x2b, 0x58, 0x35, 0xc6, 0xc1, 0x1f, 0xc1, 0xf6, 0xc2, 0x55, 0xcc, 0x47, 0xb6, 0x13, 0x52, 0x9f,
+	0x0e, 0x4d, 0x9f, 0x4e, 0x1c, 0x7b, 0x20, 0x6a, 0x10, 0xc4, 0x7d, 0xfe, 0xab, 0x0b, 0x96, 0xde,
+	0x97, 0xe2, 0x64, 0x26, 0xcd, 0x9e, 0x48, 0x07, 0x93, 0xa9, 0x39, 0xe5, 0x2f, 0x91, 0x2c, 0x1a,
+	0x2a, 0xa4, 0x30, 0x98, 0x4c, 0xfb, 0xac, 0xcf, 0x6c, 0xf5, 0x78, 0x22, 0x0e, 0x34, 0x85, 0xb0,
+	0x26, 0xbe, 0x0e, 0x6b, 0x89, 0xb5, 0xcc, 0xb8, 0x0e, 0x27, 0x2f, 0xd2, 0x74, 0x09, 0x5e, 0x57,
+	0xb2, 0x58, 0xf6, 0xb7, 0xa2, 0x8d, 0x46, 0x3e, 0x1d, 0x59, 0xa1, 0x44, 0xf6, 0x1a, 0xac, 0x09,
+	0x14, 0x4f, 0x4d, 0x59, 0x9c, 0x24, 0x20, 0x50, 0x04, 0x04, 0x92, 0x27, 0x4a, 0x93, 0x04, 0x04,
+	0x37, 0xe0, 0xf2, 0xd4, 0x5d, 0x38, 0x26, 0xc5, 0xc7, 0xac, 0x4d, 0xdd, 0x05, 0xa3, 0x7e, 0x06,
+	0xde, 0x58, 0x0c, 0xdc, 0xd8, 0x16, 0xa5, 0x43, 0x65, 0x72, 0x79, 0x01, 0x4e, 0x2d, 0xdb, 0x7d,
+	0xce, 0x50, 0xeb, 0xe3, 0x6a, 0xe6, 0xd9, 0x43, 0xad, 0x8f, 0xd5, 0x3f, 0x8f, 0x1f, 0x1f, 0x22,
+	0x0f, 0x8b, 0x0f, 0xf5, 0xe8, 0x5b, 0x55, 0x9e, 0xf7, 0xad, 0x56, 0x21, 0x1f, 0x50, 0xff, 0xc4,
+	0x76, 0x47, 0x5c, 0xb9, 0x02, 0x89, 0xba, 0xb8, 0x07, 0x5f, 0x95, 0xba, 0xd3, 0x8f, 0x43, 0xea,
+	0xbb, 0x96, 0xe3, 0x9c, 0x9a, 0x22, 0xdf, 0xe1, 0x86, 0x74, 0x68, 0xce, 0x4a, 0xa9, 0xc4, 0xc1,
+	0xfe, 0xae, 0x90, 0xd6, 0x63, 0x61, 0x12, 0xcb, 0x1a, 0x91, 0x28, 0xfe, 0x26, 0x54, 0x7c, 0xe9,
+	0xf7, 0x3c, 0xf7, 0x1a, 0x25, 0xb9, 0xd7, 0xe4, 0xee, 0xe6, 0x3e, 0x0a, 0x52, 0xf6, 0x93, 0x5d,
+	0x7c, 0x0b, 0x96, 0xe4, 0x8e, 0x2c, 0xc7, 0xb6, 0x66, 0xf7, 0xdb, 0x33, 0xf5, 0x65, 0x1a, 0x63,
+	0x92, 0x52, 0x38, 0xeb, 0xdc, 0xc9, 0x14, 0x72, 0x28, 0xaf, 0xfe, 0x8d, 0x02, 0x1b, 0x02, 0xab,
+	0xde, 0xe0, 0x88, 0x8e, 0x2d, 0x99, 0xdb, 0xbd, 0x98, 0x51, 0x56, 0xfd, 0x51, 0x0a, 0x56, 0x84,
+	0x1e, 0x5c, 0x6d, 0xa1, 0xcc, 0x99, 0xd7, 0x6f, 0xe5, 0xec, 0xeb, 0x77, 0x1d, 0x4a, 0x22, 0xd9,
+	0x6d, 0x26, 0x6a, 0x47, 0xde, 0x8d, 0x9e, 0xd8, 0xcf, 0xce, 0xb6, 0x23, 0x7e, 0x44, 0xc1, 0xde,
+	0x20, 0x6e, 0x27, 0xaa, 0x26, 0xd2, 0xcf, 0xa9, 0x9a, 0x78, 0x1b, 0x60, 0x72, 0x6c, 0x8a, 0x1a,
+	0x10, 0x71, 0xf9, 0x2d, 0x92, 0xe2, 0xe4, 0xb8, 0x26, 0x08, 0xf8, 0xeb, 0x80, 0x06, 0x3c, 0x2f,
+	0x64, 0xc6, 0x15, 0x04, 0xf2, 0x86, 0xb4, 0x2c, 0xe8, 0xf1, 0x2b, 0xbf, 0x7a, 0x1d, 0x60, 0xb6,
+	0x13, 0xf6, 0x5a, 0xae, 0xd5, 0xeb, 0xbc, 0xf4, 0xa7, 0x04, 0xf9, 0xda, 0x6d, 0xad, 0x7d, 0xa0,
+	0xb3, 0x7a, 0x1d, 0x56, 0xd1, 0x43, 0x3a, 0xdd, 0x2e, 0x2b, 0xd4, 0x51, 0xef, 0xc1, 0x9b, 0x0b,
+	0x4d, 0x1d, 0x3f, 0x01, 0xe4, 0x67, 0x49, 0x7f, 0xa6, 0x42, 0xf5, 0x59, 0x18, 0x90, 0x48, 0x50,
+	0xfd, 0x4f, 0x05, 0xae, 0x1c, 0xd0, 0x50, 0x48, 0xdc, 0xa7, 0x7e, 0x70, 0x71, 0xff, 0x7b, 0xcf,
+	0x7b, 0x49, 0xe6, 0xac, 0x97, 0x60, 0xc8, 0xb0, 0xaf, 0x4e, 0xbe, 0xbe, 0xf3, 0xb6, 0xfa, 0x0b,
+	0x50, 0x9e, 0xd3, 0xfc, 0x45, 0x9e, 0x86, 0x20, 0x3d, 0x1c, 0x3a, 0xb2, 0xfe, 0x82, 0x35, 0xd9,
+	0x11, 0xc7, 0xbf, 0x7b, 0x71, 0x58, 0x47, 0xff, 0x07, 0x4a, 0x8c, 0x26, 0x0e, 0xed, 0xf9, 0x6a,
+	0xcb, 0xcc, 0x99, 0x6a, 0xcb, 0x3b, 0x50, 0x3d, 0x8f, 0xbe, 0x34, 0xe7, 0x0e, 0xe4, 0x4f, 0x04,
+	0xa9, 0xaa, 0xcc, 0xc5, 0x93, 0x79, 0xf1, 0x48, 0x48, 0xfd, 0x0b, 0x05, 0x56, 0x17, 0xa4, 0x0d,
+	0xe2, 0x9c, 0x84, 0x92, 0x48, 0x79, 0xfe, 0x14, 0x64, 0xb9, 0x83, 0xca, 0x8f, 0xe5, 0xca, 0xf9,
+	0xac, 0x03, 0x77, 0x54, 0x22, 0xa4, 0x62, 0x2d, 0x85, 0x0f, 0xcf, 0x69, 0x29, 0xd2, 0xa0, 0xe7,
+	0x93, 0xa8, 0x99, 0x17, 0x26, 0x51, 0xb7, 0x7f, 0x37, 0x0d, 0xc5, 0xd6, 0x69, 0xef, 0xb1, 0xb3,
+	0xef, 0x58, 0x23, 0x5e, 0x2f, 0xd2, 0xea, 0x1a, 0x0f, 0xd1, 0x25, 0x56, 0x44, 0xd7, 0xee, 0x18,
+	0x66, 0xbb, 0xdf, 0x6c, 0x9a, 0xfb, 0x4d, 0xed, 0x00, 0x29, 0xac, 0x1a, 0xad, 0x4b, 0x1a, 0xe6,
+	0x5d, 0xfd, 0xa1, 0xa0, 0xa4, 0x58, 0x79, 0x5b, 0xbf, 0xdd, 0xb8, 0xd7, 0xd7, 0x67, 0xc4, 0x0c,
+	0x5e, 0x87, 0x95, 0x56, 0xbf, 0x69, 0x34, 0xba, 0xcd, 0x04, 0xb9, 0xc0, 0x4a, 0xf0, 0xf6, 0x9a,
+	0x9d, 0x3d, 0xd1, 0x45, 0x6c, 0xfe, 0x7e, 0xbb, 0xd7, 0x38, 0x68, 0xeb, 0x75, 0x41, 0xda, 0x64,
+	0xa4, 0x8f, 0x74, 0xd2, 0xd9, 0x6f, 0x44, 0x4b, 0x7e, 0x88, 0x11, 0x94, 0xf6, 0x1a, 0x6d, 0x8d,
+	0xc8, 0x59, 0x9e, 0x2a, 0xb8, 0x02, 0x45, 0xbd, 0xdd, 0x6f, 0xc9, 0x7e, 0x0a, 0x57, 0x61, 0x95,
+	0x55, 0xbb, 0x99, 0x8d, 0x76, 0x8d, 0xe8, 0x2d, 0x56, 0x14, 0x27, 0x38, 0x19, 0xbc, 0x0a, 0x15,
+	0xa3, 0xd1, 0xd2, 0x7b, 0x86, 0xd6, 0xea, 0x4a, 0x22, 0xdb, 0x45, 0xa1, 0xa7, 0x47, 0x32, 0x08,
+	0x6f, 0xc0, 0x7a, 0xbb, 0x63, 0xca, 0x7a, 0x3d, 0xf3, 0xbe, 0xd6, 0xec, 0xeb, 0x92, 0xb7, 0x89,
+	0xaf, 0x00, 0xee, 0xb4, 0xcd, 0x7e, 0xb7, 0xae, 0x19, 0xba, 0xd9, 0xee, 0x3c, 0x90, 0x8c, 0x0f,
+	0x71, 0x05, 0x0a, 0xb3, 0x1d, 0x3c, 0x65, 0x28, 0x94, 0xbb, 0x1a, 0x31, 0x66, 0xca, 0x3e, 0x7d,
+	0xca, 0xc0, 0x82, 0x03, 0xd2, 0xe9, 0x77, 0x67, 0x62, 0x2b, 0x50, 0x92, 0x60, 0x49, 0x52, 0x86,
+	0x91, 0xf6, 0x1a, 0xed, 0x5a, 0xbc, 0xbf, 0xa7, 0x85, 0x8d, 0x14, 0x52, 0xb6, 0x8f, 0x21, 0xc3,
+	0xcd, 0x51, 0x80, 0x4c, 0xbb, 0xd3, 0x66, 0xf5, 0x8b, 0xcb, 0x00, 0x8d, 0x5e, 0xa3, 0x6d, 0xe8,
+	0x07, 0x44, 0x6b, 0x32, 0xb5, 0x39, 0x21, 0x02, 0x90, 0x69, 0xbb, 0x04, 0xf9, 0x46, 0x6f, 0xbf,
+	0xd9, 0xd1, 0x0c, 0xa9, 0x66, 0xa3, 0x77, 0xaf, 0xdf, 0x61, 0x65, 0x84, 0x4f, 0x11, 0x2e, 0x41,
+	0x8e, 0x55, 0x0c, 0x7e, 0xc7, 0x60, 0x7a, 0x71, 0x9e, 0x40, 0x15, 0x3d, 0xfd, 0x70, 0xfb, 0x87,
+	0x69, 0xc8, 0xf0, 0xf0, 0x57, 0x86, 0x22, 0xb7, 0x36, 0x2b, 0x94, 0x44, 0x97, 0x70, 0x11, 0x32,
+	0x8d, 0xb6, 0x71, 0x0b, 0x7d, 0x37, 0x85, 0x01, 0xb2, 0x7d, 0xde, 0xfe, 0xc5, 0x1c, 0x6b, 0x37,
+	0xda, 0xc6, 0xf5, 0x9b, 0xe8, 0x7b, 0x29, 0x36, 0x6d, 0x5f, 0x74, 0x7e, 0x29, 0x62, 0xec, 0xde,
+	0x40, 0xdf, 0x8f, 0x19, 0xbb, 0x37, 0xd0, 0x2f, 0x47, 0x8c, 0xf7, 0x77, 0xd1, 0xaf, 0xc4, 0x8c,
+	0xf7, 0x77, 0xd1, 0xaf, 0x46, 0x8c, 0x9b, 0x37, 0xd0, 0xaf, 0xc5, 0x8c, 0x9b, 0x37, 0xd0, 0xaf,
+	0xe7, 0x98, 0x2e, 0x5c, 0x93, 0xf7, 0x77, 0xd1, 0x0f, 0x0a, 0x71, 0xef, 0xe6, 0x0d, 0xf4, 0x1b,
+	0x05, 0x66, 0xff, 0xd8, 0xaa, 0xe8, 0x37, 0x11, 0xdb, 0x26, 0x33, 0x10, 0xfa, 0x2d, 0xde, 0x64,
+	0x2c, 0xf4, 0xdb, 0x88, 0xe9, 0xc8, 0xa8, 0xbc, 0xfb, 0x09, 0xe7, 0x3c, 0xd4, 0x35, 0x82, 0x7e,
+	0x27, 0x27, 0xca, 0x33, 0x6b, 0x8d, 0x96, 0xd6, 0x44, 0x98, 0x8f, 0x60, 0xa8, 0xfc, 0xde, 0x35,
+	0xd6, 0x64, 0xee, 0x89, 0x7e, 0xbf, 0xcb, 0x16, 0xbc, 0xaf, 0x91, 0xda, 0x6d, 0x8d, 0xa0, 0x3f,
+	0xb8, 0xc6, 0x16, 0xbc, 0xaf, 0x11, 0x89, 0xd7, 0x1f, 0x76, 0x99, 0x20, 0x67, 0x7d, 0x7a, 0x8d,
+	0x6d, 0x5a, 0xd2, 0xff, 0xa8, 0x8b, 0x0b, 0x90, 0xde, 0x6b, 0x18, 0xe8, 0x87, 0x7c, 0x35, 0xe6,
+	0xa2, 0xe8, 0x8f, 0x11, 0x23, 0xf6, 0x74, 0x03, 0xfd, 0x88, 0x11, 0xb3, 0x46, 0xbf, 0xdb, 0xd4,
+	0xd1, 0x5b, 0x6c, 0x73, 0x07, 0x7a, 0xa7, 0xa5, 0x1b, 0xe4, 0x21, 0xfa, 0x13, 0x2e, 0x7e, 0xa7,
+	0xd7, 0x69, 0xa3, 0xcf, 0x10, 0x2b, 0xdd, 0xd4, 0xbf, 0xd3, 0x25, 0x7a, 0xaf, 0xd7, 0xe8, 0xb4,
+	0xd1, 0x97, 0xb6, 0xf7, 0x01, 0x9d, 0x0d, 0x07, 0x4c, 0x81, 0x7e, 0xfb, 0x6e, 0xbb, 0xf3, 0xa0,
+	0x2d, 0xce, 0xa9, 0x2e, 0xd1, 0xbb, 0x1a, 0xd1, 0x91, 0x82, 0x01, 0x72, 0xb2, 0xe8, 0x33, 0x85,
+	0x97, 0xa0, 0x40, 0x3a, 0xcd, 0xe6, 0x9e, 0x56, 0xbb, 0x8b, 0xd2, 0x7b, 0xdf, 0x80, 0x65, 0xdb,
+	0xdb, 0x39, 0xb1, 0x43, 0x1a, 0x04, 0xa2, 0x1e, 0xff, 0x23, 0x55, 0xf6, 0x6c, 0xef, 0xaa, 0x68,
+	0x5d, 0x1d, 0x79, 0x57, 0x4f, 0xc2, 0xab, 0x9c, 0x7b, 0x95, 0x47, 0x8c, 0xc3, 0x1c, 0xef, 0xbc,
+	0xff, 0x3f, 0x03, 0x00, 0xda, 0x63, 0x56, 0x52, 0xed, 0x2f, 0x00, 0x00,
 }
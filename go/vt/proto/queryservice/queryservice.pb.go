@@ -30,42 +30,46 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x86, 0xe1, 0xd0, 0x06, 0x4d, 0xd2, 0x52, 0xb6, 0x14, 0xa8, 0x53, 0xd2, 0x8f, 0x1b, 0x42,
-	0x4a, 0x10, 0x20, 0x21, 0x55, 0xe2, 0xd0, 0x44, 0x54, 0x20, 0xc4, 0x57, 0x0a, 0x15, 0x02, 0x09,
-	0x69, 0xe3, 0x8c, 0x82, 0x55, 0xc7, 0x9b, 0x7a, 0xd7, 0x29, 0xfc, 0x09, 0x7e, 0x33, 0xaa, 0xed,
-	0x19, 0xef, 0x6e, 0xec, 0xde, 0xb2, 0xef, 0x3b, 0xf3, 0x64, 0xbc, 0xe3, 0x19, 0x83, 0xb8, 0xcc,
-	0x30, 0xfd, 0xab, 0x31, 0x5d, 0x46, 0x21, 0xf6, 0x17, 0xa9, 0x32, 0x4a, 0x74, 0x6c, 0x2d, 0x68,
-	0xe7, 0xa7, 0xc2, 0x0a, 0xb6, 0x26, 0x51, 0x12, 0xab, 0xd9, 0x54, 0x1a, 0x59, 0x28, 0xcf, 0xff,
-	0x6d, 0xc0, 0xda, 0x97, 0xeb, 0x08, 0x71, 0x0c, 0xad, 0x37, 0x7f, 0x30, 0xcc, 0x0c, 0x8a, 0x9d,
-	0x7e, 0x91, 0x54, 0x9e, 0xc7, 0x78, 0x99, 0xa1, 0x36, 0xc1, 0x03, 0x5f, 0xd6, 0x0b, 0x95, 0x68,
-	0x3c, 0xba, 0x25, 0xde, 0x41, 0xa7, 0x14, 0x87, 0xd2, 0x84, 0xbf, 0x45, 0xe0, 0x46, 0xe6, 0x22,
-	0x51, 0xba, 0xb5, 0x1e, 0xa3, 0x3e, 0xc2, 0xc6, 0x99, 0x49, 0x51, 0xce, 0xa9, 0x18, 0x8a, 0x77,
-	0x54, 0x82, 0xed, 0xd5, 0x9b, 0x44, 0x7b, 0x76, 0x5b, 0xbc, 0x84, 0xb5, 0x21, 0xce, 0xa2, 0x44,
-	0x6c, 0x97, 0xa1, 0xf9, 0x89, 0xf2, 0xef, 0xbb, 0x22, 0x57, 0xf1, 0x0a, 0xd6, 0x47, 0x6a, 0x3e,
-	0x8f, 0x8c, 0xa0, 0x88, 0xe2, 0x48, 0x79, 0x3b, 0x9e, 0xca, 0x89, 0xaf, 0xe1, 0xce, 0x58, 0xc5,
-	0xf1, 0x44, 0x86, 0x17, 0x82, 0xee, 0x8b, 0x04, 0x4a, 0x7e, 0xb8, 0xa2, 0x73, 0xfa, 0x31, 0xb4,
-	0x3e, 0xa7, 0xb8, 0x90, 0x69, 0xd5, 0x84, 0xf2, 0xec, 0x37, 0x81, 0x65, 0xce, 0xfd, 0x04, 0x9b,
-	0x45, 0x39, 0xa5, 0x35, 0x15, 0x7b, 0x4e, 0x95, 0x24, 0x13, 0xe9, 0x71, 0x83, 0xcb, 0xc0, 0x6f,
-	0xb0, 0x45, 0x25, 0x32, 0xb2, 0xe7, 0xd5, 0xee, 0x43, 0xf7, 0x1b, 0x7d, 0xc6, 0x7e, 0x87, 0x7b,
-	0xa3, 0x14, 0xa5, 0xc1, 0xaf, 0xa9, 0x4c, 0xb4, 0x0c, 0x4d, 0xa4, 0x12, 0x41, 0x79, 0x2b, 0x0e,
-	0x81, 0x0f, 0x9a, 0x03, 0x98, 0x7c, 0x0a, 0xed, 0x33, 0x23, 0x53, 0x53, 0xb6, 0x6e, 0x97, 0x5f,
-	0x0e, 0xd6, 0x88, 0x16, 0xd4, 0x59, 0x0e, 0x07, 0x0d, 0xf7, 0x91, 0x39, 0x95, 0xb6, 0xc2, 0xb1,
-	0x2d, 0xe6, 0xfc, 0x82, 0xed, 0x91, 0x4a, 0xc2, 0x38, 0x9b, 0x3a, 0xcf, 0x7a, 0xc8, 0x17, 0xbf,
-	0xe2, 0x11, 0xf7, 0xe8, 0xa6, 0x10, 0xe6, 0x8f, 0xe1, 0xee, 0x18, 0xe5, 0xd4, 0x66, 0x53, 0x53,
-	0x3d, 0x9d, 0xb8, 0xbd, 0x26, 0xdb, 0x1e, 0xe5, 0x7c, 0x18, 0x68, 0xfc, 0x02, 0x7b, 0x42, 0xbc,
-	0xe9, 0xeb, 0xd6, 0x7a, 0x76, 0xa3, 0x6d, 0xa7, 0x58, 0x0d, 0xfb, 0x35, 0x39, 0xce, 0x7e, 0x38,
-	0x68, 0x0e, 0xb0, 0x97, 0xc4, 0x07, 0xd4, 0x5a, 0xce, 0xb0, 0x18, 0x7c, 0x5e, 0x12, 0x8e, 0xea,
-	0x2f, 0x09, 0xcf, 0xb4, 0x96, 0xc4, 0x08, 0xa0, 0x34, 0x4f, 0xc2, 0x0b, 0xf1, 0xc8, 0x8d, 0x3f,
-	0xa9, 0xda, 0xbd, 0x5b, 0xe3, 0x70, 0x51, 0xef, 0xa1, 0x53, 0xa0, 0xdf, 0xa2, 0x8c, 0x4d, 0xb5,
-	0x04, 0x6d, 0xd1, 0xbf, 0x39, 0xd7, 0xb3, 0x2a, 0x3a, 0x85, 0xd6, 0x79, 0xf9, 0x6c, 0x41, 0xdf,
-	0xda, 0xda, 0xe7, 0xee, 0xa3, 0x75, 0x6b, 0x3d, 0x8b, 0x33, 0x86, 0x36, 0xc9, 0xea, 0x4a, 0x8b,
-	0x5e, 0x5d, 0xbc, 0xba, 0xd2, 0xd5, 0xf8, 0x36, 0xf9, 0x16, 0xf3, 0x27, 0x6c, 0x56, 0x7f, 0x95,
-	0xc5, 0x46, 0x8b, 0xc3, 0xfa, 0x32, 0xae, 0xbd, 0xea, 0x8d, 0xbe, 0x21, 0xa4, 0x82, 0x0f, 0x9f,
-	0xfe, 0x78, 0xb2, 0x8c, 0x0c, 0x6a, 0xdd, 0x8f, 0xd4, 0xa0, 0xf8, 0x35, 0x98, 0xa9, 0xc1, 0xd2,
-	0x0c, 0xf2, 0x0f, 0xd6, 0xc0, 0xfe, 0xb8, 0x4d, 0xd6, 0x73, 0xed, 0xc5, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x16, 0x79, 0x9a, 0xc3, 0x07, 0x07, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc6, 0xdf, 0xf7, 0xd0, 0x06, 0x4d, 0x42, 0x1b, 0x36, 0x14, 0xa8, 0x53, 0xd2, 0x3f, 0x37,
+	0x84, 0x94, 0x20, 0x40, 0x42, 0xaa, 0xc4, 0xa1, 0x89, 0x28, 0x20, 0x04, 0x94, 0x84, 0x46, 0x08,
+	0x24, 0xc4, 0xc6, 0x19, 0x25, 0x56, 0x1d, 0x6f, 0xea, 0xdd, 0xa4, 0xf0, 0x59, 0xf8, 0xb2, 0x28,
+	0xb6, 0x67, 0xbd, 0x5e, 0xaf, 0x7b, 0xcb, 0x3e, 0xbf, 0x99, 0xc7, 0xe3, 0x9d, 0xcc, 0x18, 0xd8,
+	0xf5, 0x0a, 0xe3, 0x3f, 0x12, 0xe3, 0x75, 0xe0, 0x63, 0x77, 0x19, 0x0b, 0x25, 0x58, 0xc3, 0xd4,
+	0xbc, 0x7a, 0x72, 0x4a, 0x91, 0xd7, 0x9c, 0x04, 0x51, 0x28, 0x66, 0x53, 0xae, 0x78, 0xaa, 0x3c,
+	0xff, 0xbb, 0x0b, 0x5b, 0x5f, 0x36, 0x11, 0xec, 0x14, 0x6a, 0x6f, 0x7e, 0xa3, 0xbf, 0x52, 0xc8,
+	0xf6, 0xba, 0x69, 0x52, 0x76, 0x1e, 0xe2, 0xf5, 0x0a, 0xa5, 0xf2, 0x1e, 0xd8, 0xb2, 0x5c, 0x8a,
+	0x48, 0xe2, 0xc9, 0x7f, 0xec, 0x3d, 0x34, 0x32, 0xb1, 0xcf, 0x95, 0x3f, 0x67, 0x5e, 0x31, 0x32,
+	0x11, 0xc9, 0xa5, 0xed, 0x64, 0xda, 0xea, 0x13, 0xdc, 0x1d, 0xa9, 0x18, 0xf9, 0x82, 0x8a, 0xa1,
+	0xf8, 0x82, 0x4a, 0x66, 0x07, 0x6e, 0x48, 0x6e, 0xcf, 0xfe, 0x67, 0x2f, 0x61, 0xab, 0x8f, 0xb3,
+	0x20, 0x62, 0xad, 0x2c, 0x34, 0x39, 0x51, 0xfe, 0xfd, 0xa2, 0xa8, 0xab, 0x78, 0x05, 0xdb, 0x03,
+	0xb1, 0x58, 0x04, 0x8a, 0x51, 0x44, 0x7a, 0xa4, 0xbc, 0x3d, 0x4b, 0xd5, 0x89, 0xaf, 0xe1, 0xce,
+	0x50, 0x84, 0xe1, 0x84, 0xfb, 0x57, 0x8c, 0xee, 0x8b, 0x04, 0x4a, 0x7e, 0x58, 0xd2, 0x75, 0xfa,
+	0x29, 0xd4, 0x2e, 0x62, 0x5c, 0xf2, 0x38, 0x6f, 0x42, 0x76, 0xb6, 0x9b, 0xa0, 0x65, 0x9d, 0xfb,
+	0x19, 0x76, 0xd2, 0x72, 0x32, 0x34, 0x65, 0x07, 0x85, 0x2a, 0x49, 0x26, 0xa7, 0xc7, 0x15, 0x54,
+	0x1b, 0x5e, 0x42, 0x93, 0x4a, 0xd4, 0x96, 0x1d, 0xab, 0x76, 0xdb, 0xf4, 0xb0, 0x92, 0x6b, 0xdb,
+	0x6f, 0x70, 0x6f, 0x10, 0x23, 0x57, 0xf8, 0x35, 0xe6, 0x91, 0xe4, 0xbe, 0x0a, 0x44, 0xc4, 0x28,
+	0xaf, 0x44, 0xc8, 0xf8, 0xa8, 0x3a, 0x40, 0x3b, 0x9f, 0x43, 0x7d, 0xa4, 0x78, 0xac, 0xb2, 0xd6,
+	0xed, 0xeb, 0x3f, 0x87, 0xd6, 0xc8, 0xcd, 0x73, 0xa1, 0x82, 0x0f, 0x2a, 0xdd, 0x47, 0xed, 0x93,
+	0x6b, 0x25, 0x1f, 0x13, 0x69, 0x9f, 0x9f, 0xd0, 0x1a, 0x88, 0xc8, 0x0f, 0x57, 0xd3, 0xc2, 0xbb,
+	0x1e, 0xeb, 0x8b, 0x2f, 0x31, 0xf2, 0x3d, 0xb9, 0x2d, 0x44, 0xfb, 0x0f, 0x61, 0x77, 0x88, 0x7c,
+	0x6a, 0x7a, 0x53, 0x53, 0x2d, 0x9d, 0x7c, 0x3b, 0x55, 0xd8, 0x1c, 0xe5, 0x64, 0x18, 0x68, 0xfc,
+	0x3c, 0x73, 0x42, 0xac, 0xe9, 0x6b, 0x3b, 0x99, 0xd9, 0x68, 0x93, 0xa4, 0xab, 0xe1, 0xd0, 0x91,
+	0x53, 0xd8, 0x0f, 0x47, 0xd5, 0x01, 0xe6, 0x92, 0xf8, 0x88, 0x52, 0xf2, 0x19, 0xa6, 0x83, 0xaf,
+	0x97, 0x44, 0x41, 0xb5, 0x97, 0x84, 0x05, 0x8d, 0x25, 0x31, 0x00, 0xc8, 0xe0, 0x99, 0x7f, 0xc5,
+	0x1e, 0x15, 0xe3, 0xcf, 0xf2, 0x76, 0xef, 0x3b, 0x88, 0xd9, 0x8d, 0x4c, 0xbf, 0x10, 0x52, 0x2d,
+	0x45, 0x84, 0xba, 0x1b, 0x96, 0x6e, 0x77, 0xa3, 0x84, 0xb5, 0xe7, 0x07, 0x68, 0xa4, 0xe5, 0xbe,
+	0x43, 0x1e, 0xaa, 0x7c, 0xb1, 0x9a, 0xa2, 0xdd, 0x8d, 0x22, 0x33, 0xde, 0xf2, 0x1c, 0x6a, 0xe3,
+	0xec, 0xbe, 0xbc, 0xae, 0xf1, 0x25, 0x18, 0x17, 0xaf, 0xab, 0xed, 0x64, 0x86, 0xcf, 0x10, 0xea,
+	0x24, 0x8b, 0x1b, 0xc9, 0x3a, 0xae, 0x78, 0x71, 0x23, 0xf3, 0x95, 0x50, 0xc5, 0x0d, 0xcf, 0x1f,
+	0xb0, 0x93, 0x3f, 0x6a, 0x15, 0x2a, 0xc9, 0x8e, 0xdd, 0x65, 0x6c, 0x58, 0x3e, 0x25, 0xb7, 0x84,
+	0x18, 0xe6, 0xbf, 0xa0, 0x95, 0xc2, 0x91, 0x3f, 0xc7, 0x05, 0x1f, 0xcc, 0x79, 0x34, 0x43, 0xa9,
+	0xe7, 0xd0, 0xc1, 0xec, 0x39, 0x74, 0x86, 0x18, 0x4f, 0xb8, 0x84, 0xe6, 0x5b, 0x54, 0x29, 0x1f,
+	0x63, 0x2c, 0x37, 0xa3, 0x48, 0xdd, 0xb5, 0x81, 0xbd, 0x2a, 0xcb, 0x9c, 0x8c, 0xfb, 0x4f, 0xbf,
+	0x3f, 0x59, 0x07, 0x0a, 0xa5, 0xec, 0x06, 0xa2, 0x97, 0xfe, 0xea, 0xcd, 0x44, 0x6f, 0xad, 0x7a,
+	0xc9, 0xd7, 0xbb, 0x67, 0x7e, 0xe9, 0x27, 0xdb, 0x89, 0xf6, 0xe2, 0xdf, 0x00, 0x79, 0x44, 0xab,
+	0x59, 0x14, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -121,6 +125,8 @@ type QueryClient interface {
 	MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error)
 	// MessageAck acks messages for a table.
 	MessageAck(ctx context.Context, in *query.MessageAckRequest, opts ...grpc.CallOption) (*query.MessageAckResponse, error)
+	// MessagePostpone postpones the redelivery of messages for a table.
+	MessagePostpone(ctx context.Context, in *query.MessagePostponeRequest, opts ...grpc.CallOption) (*query.MessagePostponeResponse, error)
 	// StreamHealth runs a streaming RPC to the tablet, that returns the
 	// current health of the tablet on a regular basis.
 	StreamHealth(ctx context.Context, in *query.StreamHealthRequest, opts ...grpc.CallOption) (Query_StreamHealthClient, error)
@@ -130,6 +136,11 @@ type QueryClient interface {
 	VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error)
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error)
+	// StreamSchemaChanges streams the tables that are added, changed or
+	// dropped from the schema of the tablet.
+	StreamSchemaChanges(ctx context.Context, in *query.StreamSchemaChangesRequest, opts ...grpc.CallOption) (Query_StreamSchemaChangesClient, error)
+	// GetSchemaVersion returns the version of a table at a point in time.
+	GetSchemaVersion(ctx context.Context, in *query.GetSchemaVersionRequest, opts ...grpc.CallOption) (*query.GetSchemaVersionResponse, error)
 }
 
 type queryClient struct {
@@ -348,6 +359,15 @@ func (c *queryClient) MessageAck(ctx context.Context, in *query.MessageAckReques
 	return out, nil
 }
 
+func (c *queryClient) MessagePostpone(ctx context.Context, in *query.MessagePostponeRequest, opts ...grpc.CallOption) (*query.MessagePostponeResponse, error) {
+	out := new(query.MessagePostponeResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/MessagePostpone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) StreamHealth(ctx context.Context, in *query.StreamHealthRequest, opts ...grpc.CallOption) (Query_StreamHealthClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/queryservice.Query/StreamHealth", opts...)
 	if err != nil {
@@ -476,6 +496,47 @@ func (x *queryVStreamResultsClient) Recv() (*binlogdata.VStreamResultsResponse,
 	return m, nil
 }
 
+func (c *queryClient) StreamSchemaChanges(ctx context.Context, in *query.StreamSchemaChangesRequest, opts ...grpc.CallOption) (Query_StreamSchemaChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[6], "/queryservice.Query/StreamSchemaChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamSchemaChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamSchemaChangesClient interface {
+	Recv() (*query.StreamSchemaChangesResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamSchemaChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamSchemaChangesClient) Recv() (*query.StreamSchemaChangesResponse, error) {
+	m := new(query.StreamSchemaChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) GetSchemaVersion(ctx context.Context, in *query.GetSchemaVersionRequest, opts ...grpc.CallOption) (*query.GetSchemaVersionResponse, error) {
+	out := new(query.GetSchemaVersionResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/GetSchemaVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Execute executes the specified SQL query (might be in a
@@ -519,6 +580,8 @@ type QueryServer interface {
 	MessageStream(*query.MessageStreamRequest, Query_MessageStreamServer) error
 	// MessageAck acks messages for a table.
 	MessageAck(context.Context, *query.MessageAckRequest) (*query.MessageAckResponse, error)
+	// MessagePostpone postpones the redelivery of messages for a table.
+	MessagePostpone(context.Context, *query.MessagePostponeRequest) (*query.MessagePostponeResponse, error)
 	// StreamHealth runs a streaming RPC to the tablet, that returns the
 	// current health of the tablet on a regular basis.
 	StreamHealth(*query.StreamHealthRequest, Query_StreamHealthServer) error
@@ -528,6 +591,11 @@ type QueryServer interface {
 	VStreamRows(*binlogdata.VStreamRowsRequest, Query_VStreamRowsServer) error
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error
+	// StreamSchemaChanges streams the tables that are added, changed or
+	// dropped from the schema of the tablet.
+	StreamSchemaChanges(*query.StreamSchemaChangesRequest, Query_StreamSchemaChangesServer) error
+	// GetSchemaVersion returns the version of a table at a point in time.
+	GetSchemaVersion(context.Context, *query.GetSchemaVersionRequest) (*query.GetSchemaVersionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -588,6 +656,9 @@ func (*UnimplementedQueryServer) MessageStream(req *query.MessageStreamRequest,
 func (*UnimplementedQueryServer) MessageAck(ctx context.Context, req *query.MessageAckRequest) (*query.MessageAckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageAck not implemented")
 }
+func (*UnimplementedQueryServer) MessagePostpone(ctx context.Context, req *query.MessagePostponeRequest) (*query.MessagePostponeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessagePostpone not implemented")
+}
 func (*UnimplementedQueryServer) StreamHealth(req *query.StreamHealthRequest, srv Query_StreamHealthServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamHealth not implemented")
 }
@@ -600,6 +671,12 @@ func (*UnimplementedQueryServer) VStreamRows(req *binlogdata.VStreamRowsRequest,
 func (*UnimplementedQueryServer) VStreamResults(req *binlogdata.VStreamResultsRequest, srv Query_VStreamResultsServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStreamResults not implemented")
 }
+func (*UnimplementedQueryServer) StreamSchemaChanges(req *query.StreamSchemaChangesRequest, srv Query_StreamSchemaChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSchemaChanges not implemented")
+}
+func (*UnimplementedQueryServer) GetSchemaVersion(ctx context.Context, req *query.GetSchemaVersionRequest) (*query.GetSchemaVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaVersion not implemented")
+}
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -935,6 +1012,24 @@ func _Query_MessageAck_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessagePostpone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.MessagePostponeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessagePostpone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/MessagePostpone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessagePostpone(ctx, req.(*query.MessagePostponeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_StreamHealth_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(query.StreamHealthRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1019,6 +1114,45 @@ func (x *queryVStreamResultsServer) Send(m *binlogdata.VStreamResultsResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_StreamSchemaChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(query.StreamSchemaChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamSchemaChanges(m, &queryStreamSchemaChangesServer{stream})
+}
+
+type Query_StreamSchemaChangesServer interface {
+	Send(*query.StreamSchemaChangesResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamSchemaChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamSchemaChangesServer) Send(m *query.StreamSchemaChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_GetSchemaVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.GetSchemaVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSchemaVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/GetSchemaVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSchemaVersion(ctx, req.(*query.GetSchemaVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queryservice.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1087,6 +1221,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageAck",
 			Handler:    _Query_MessageAck_Handler,
 		},
+		{
+			MethodName: "MessagePostpone",
+			Handler:    _Query_MessagePostpone_Handler,
+		},
+		{
+			MethodName: "GetSchemaVersion",
+			Handler:    _Query_GetSchemaVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1119,6 +1261,11 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_VStreamResults_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamSchemaChanges",
+			Handler:       _Query_StreamSchemaChanges_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "queryservice.proto",
 }
@@ -12,6 +12,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -42,10 +43,14 @@ type TableDefinition struct {
 	RowCount uint64 `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
 	// column names along with their types.
 	// NOTE: this is a superset of columns.
-	Fields               []*query.Field `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Fields []*query.Field `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	// the generated columns, in the order of fields.
+	GeneratedColumns []*GeneratedColumn `protobuf:"bytes,9,rep,name=generated_columns,json=generatedColumns,proto3" json:"generated_columns,omitempty"`
+	// the partitioning of the table, if it's partitioned.
+	Partitioning         *Partitioning `protobuf:"bytes,10,opt,name=partitioning,proto3" json:"partitioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *TableDefinition) Reset()         { *m = TableDefinition{} }
@@ -129,6 +134,196 @@ func (m *TableDefinition) GetFields() []*query.Field {
 	return nil
 }
 
+func (m *TableDefinition) GetGeneratedColumns() []*GeneratedColumn {
+	if m != nil {
+		return m.GeneratedColumns
+	}
+	return nil
+}
+
+func (m *TableDefinition) GetPartitioning() *Partitioning {
+	if m != nil {
+		return m.Partitioning
+	}
+	return nil
+}
+
+// GeneratedColumn describes a column whose value is computed by MySQL.
+type GeneratedColumn struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the expression which computes the value.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// stored is true if the value is stored in the rows,
+	// and false if the column is virtual.
+	Stored               bool     `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedColumn) Reset()         { *m = GeneratedColumn{} }
+func (m *GeneratedColumn) String() string { return proto.CompactTextString(m) }
+func (*GeneratedColumn) ProtoMessage()    {}
+func (*GeneratedColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{1}
+}
+
+func (m *GeneratedColumn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeneratedColumn.Unmarshal(m, b)
+}
+func (m *GeneratedColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeneratedColumn.Marshal(b, m, deterministic)
+}
+func (m *GeneratedColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedColumn.Merge(m, src)
+}
+func (m *GeneratedColumn) XXX_Size() int {
+	return xxx_messageInfo_GeneratedColumn.Size(m)
+}
+func (m *GeneratedColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedColumn proto.InternalMessageInfo
+
+func (m *GeneratedColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GeneratedColumn) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *GeneratedColumn) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
+// Partitioning describes how the rows of a table are split into partitions.
+type Partitioning struct {
+	// the partitioning method, like RANGE or HASH.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// the expression or the columns which select the partition of a row.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// the partitions, in their order.
+	Partitions           []*Partition `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Partitioning) Reset()         { *m = Partitioning{} }
+func (m *Partitioning) String() string { return proto.CompactTextString(m) }
+func (*Partitioning) ProtoMessage()    {}
+func (*Partitioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{2}
+}
+
+func (m *Partitioning) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Partitioning.Unmarshal(m, b)
+}
+func (m *Partitioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Partitioning.Marshal(b, m, deterministic)
+}
+func (m *Partitioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Partitioning.Merge(m, src)
+}
+func (m *Partitioning) XXX_Size() int {
+	return xxx_messageInfo_Partitioning.Size(m)
+}
+func (m *Partitioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_Partitioning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Partitioning proto.InternalMessageInfo
+
+func (m *Partitioning) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Partitioning) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *Partitioning) GetPartitions() []*Partition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+// Partition is a partition of a table.
+type Partition struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the values of the partition, for the RANGE and LIST methods.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// approximate number of rows in the partition.
+	RowCount             uint64   `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Partition) Reset()         { *m = Partition{} }
+func (m *Partition) String() string { return proto.CompactTextString(m) }
+func (*Partition) ProtoMessage()    {}
+func (*Partition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{3}
+}
+
+func (m *Partition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Partition.Unmarshal(m, b)
+}
+func (m *Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Partition.Marshal(b, m, deterministic)
+}
+func (m *Partition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Partition.Merge(m, src)
+}
+func (m *Partition) XXX_Size() int {
+	return xxx_messageInfo_Partition.Size(m)
+}
+func (m *Partition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Partition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Partition proto.InternalMessageInfo
+
+func (m *Partition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Partition) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Partition) GetRowCount() uint64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
 type SchemaDefinition struct {
 	DatabaseSchema       string             `protobuf:"bytes,1,opt,name=database_schema,json=databaseSchema,proto3" json:"database_schema,omitempty"`
 	TableDefinitions     []*TableDefinition `protobuf:"bytes,2,rep,name=table_definitions,json=tableDefinitions,proto3" json:"table_definitions,omitempty"`
@@ -142,7 +337,7 @@ func (m *SchemaDefinition) Reset()         { *m = SchemaDefinition{} }
 func (m *SchemaDefinition) String() string { return proto.CompactTextString(m) }
 func (*SchemaDefinition) ProtoMessage()    {}
 func (*SchemaDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{1}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{4}
 }
 
 func (m *SchemaDefinition) XXX_Unmarshal(b []byte) error {
@@ -198,7 +393,7 @@ func (m *SchemaChangeResult) Reset()         { *m = SchemaChangeResult{} }
 func (m *SchemaChangeResult) String() string { return proto.CompactTextString(m) }
 func (*SchemaChangeResult) ProtoMessage()    {}
 func (*SchemaChangeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{2}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{5}
 }
 
 func (m *SchemaChangeResult) XXX_Unmarshal(b []byte) error {
@@ -250,7 +445,7 @@ func (m *UserPermission) Reset()         { *m = UserPermission{} }
 func (m *UserPermission) String() string { return proto.CompactTextString(m) }
 func (*UserPermission) ProtoMessage()    {}
 func (*UserPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{3}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{6}
 }
 
 func (m *UserPermission) XXX_Unmarshal(b []byte) error {
@@ -315,7 +510,7 @@ func (m *DbPermission) Reset()         { *m = DbPermission{} }
 func (m *DbPermission) String() string { return proto.CompactTextString(m) }
 func (*DbPermission) ProtoMessage()    {}
 func (*DbPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{4}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{7}
 }
 
 func (m *DbPermission) XXX_Unmarshal(b []byte) error {
@@ -378,7 +573,7 @@ func (m *Permissions) Reset()         { *m = Permissions{} }
 func (m *Permissions) String() string { return proto.CompactTextString(m) }
 func (*Permissions) ProtoMessage()    {}
 func (*Permissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{5}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{8}
 }
 
 func (m *Permissions) XXX_Unmarshal(b []byte) error {
@@ -424,7 +619,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{6}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{9}
 }
 
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
@@ -463,7 +658,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{7}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{10}
 }
 
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
@@ -503,7 +698,7 @@ func (m *SleepRequest) Reset()         { *m = SleepRequest{} }
 func (m *SleepRequest) String() string { return proto.CompactTextString(m) }
 func (*SleepRequest) ProtoMessage()    {}
 func (*SleepRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{8}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{11}
 }
 
 func (m *SleepRequest) XXX_Unmarshal(b []byte) error {
@@ -541,7 +736,7 @@ func (m *SleepResponse) Reset()         { *m = SleepResponse{} }
 func (m *SleepResponse) String() string { return proto.CompactTextString(m) }
 func (*SleepResponse) ProtoMessage()    {}
 func (*SleepResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{9}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{12}
 }
 
 func (m *SleepResponse) XXX_Unmarshal(b []byte) error {
@@ -575,7 +770,7 @@ func (m *ExecuteHookRequest) Reset()         { *m = ExecuteHookRequest{} }
 func (m *ExecuteHookRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteHookRequest) ProtoMessage()    {}
 func (*ExecuteHookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{10}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{13}
 }
 
 func (m *ExecuteHookRequest) XXX_Unmarshal(b []byte) error {
@@ -630,7 +825,7 @@ func (m *ExecuteHookResponse) Reset()         { *m = ExecuteHookResponse{} }
 func (m *ExecuteHookResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteHookResponse) ProtoMessage()    {}
 func (*ExecuteHookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{11}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{14}
 }
 
 func (m *ExecuteHookResponse) XXX_Unmarshal(b []byte) error {
@@ -685,7 +880,7 @@ func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()    {}
 func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{12}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{15}
 }
 
 func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -738,7 +933,7 @@ func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()    {}
 func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{13}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
 }
 
 func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -776,7 +971,7 @@ func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
 func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsRequest) ProtoMessage()    {}
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{14}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
 }
 
 func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
@@ -808,7 +1003,7 @@ func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{}
 func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsResponse) ProtoMessage()    {}
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{15}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
 }
 
 func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
@@ -846,7 +1041,7 @@ func (m *SetReadOnlyRequest) Reset()         { *m = SetReadOnlyRequest{} }
 func (m *SetReadOnlyRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyRequest) ProtoMessage()    {}
 func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
 }
 
 func (m *SetReadOnlyRequest) XXX_Unmarshal(b []byte) error {
@@ -877,7 +1072,7 @@ func (m *SetReadOnlyResponse) Reset()         { *m = SetReadOnlyResponse{} }
 func (m *SetReadOnlyResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyResponse) ProtoMessage()    {}
 func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
 }
 
 func (m *SetReadOnlyResponse) XXX_Unmarshal(b []byte) error {
@@ -908,7 +1103,7 @@ func (m *SetReadWriteRequest) Reset()         { *m = SetReadWriteRequest{} }
 func (m *SetReadWriteRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteRequest) ProtoMessage()    {}
 func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
 }
 
 func (m *SetReadWriteRequest) XXX_Unmarshal(b []byte) error {
@@ -939,7 +1134,7 @@ func (m *SetReadWriteResponse) Reset()         { *m = SetReadWriteResponse{} }
 func (m *SetReadWriteResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteResponse) ProtoMessage()    {}
 func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
 }
 
 func (m *SetReadWriteResponse) XXX_Unmarshal(b []byte) error {
@@ -971,7 +1166,7 @@ func (m *ChangeTypeRequest) Reset()         { *m = ChangeTypeRequest{} }
 func (m *ChangeTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeRequest) ProtoMessage()    {}
 func (*ChangeTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
 }
 
 func (m *ChangeTypeRequest) XXX_Unmarshal(b []byte) error {
@@ -1009,7 +1204,7 @@ func (m *ChangeTypeResponse) Reset()         { *m = ChangeTypeResponse{} }
 func (m *ChangeTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeResponse) ProtoMessage()    {}
 func (*ChangeTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
 }
 
 func (m *ChangeTypeResponse) XXX_Unmarshal(b []byte) error {
@@ -1040,7 +1235,7 @@ func (m *RefreshStateRequest) Reset()         { *m = RefreshStateRequest{} }
 func (m *RefreshStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateRequest) ProtoMessage()    {}
 func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
 }
 
 func (m *RefreshStateRequest) XXX_Unmarshal(b []byte) error {
@@ -1071,7 +1266,7 @@ func (m *RefreshStateResponse) Reset()         { *m = RefreshStateResponse{} }
 func (m *RefreshStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateResponse) ProtoMessage()    {}
 func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
 }
 
 func (m *RefreshStateResponse) XXX_Unmarshal(b []byte) error {
@@ -1102,7 +1297,7 @@ func (m *RunHealthCheckRequest) Reset()         { *m = RunHealthCheckRequest{} }
 func (m *RunHealthCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckRequest) ProtoMessage()    {}
 func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
 }
 
 func (m *RunHealthCheckRequest) XXX_Unmarshal(b []byte) error {
@@ -1133,7 +1328,7 @@ func (m *RunHealthCheckResponse) Reset()         { *m = RunHealthCheckResponse{}
 func (m *RunHealthCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckResponse) ProtoMessage()    {}
 func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
 }
 
 func (m *RunHealthCheckResponse) XXX_Unmarshal(b []byte) error {
@@ -1165,7 +1360,7 @@ func (m *IgnoreHealthErrorRequest) Reset()         { *m = IgnoreHealthErrorReque
 func (m *IgnoreHealthErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorRequest) ProtoMessage()    {}
 func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
 }
 
 func (m *IgnoreHealthErrorRequest) XXX_Unmarshal(b []byte) error {
@@ -1203,7 +1398,7 @@ func (m *IgnoreHealthErrorResponse) Reset()         { *m = IgnoreHealthErrorResp
 func (m *IgnoreHealthErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorResponse) ProtoMessage()    {}
 func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
 }
 
 func (m *IgnoreHealthErrorResponse) XXX_Unmarshal(b []byte) error {
@@ -1224,6 +1419,76 @@ func (m *IgnoreHealthErrorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IgnoreHealthErrorResponse proto.InternalMessageInfo
 
+type ResizeTxPoolRequest struct {
+	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizeTxPoolRequest) Reset()         { *m = ResizeTxPoolRequest{} }
+func (m *ResizeTxPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeTxPoolRequest) ProtoMessage()    {}
+func (*ResizeTxPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
+}
+
+func (m *ResizeTxPoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeTxPoolRequest.Unmarshal(m, b)
+}
+func (m *ResizeTxPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeTxPoolRequest.Marshal(b, m, deterministic)
+}
+func (m *ResizeTxPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeTxPoolRequest.Merge(m, src)
+}
+func (m *ResizeTxPoolRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizeTxPoolRequest.Size(m)
+}
+func (m *ResizeTxPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeTxPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeTxPoolRequest proto.InternalMessageInfo
+
+func (m *ResizeTxPoolRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type ResizeTxPoolResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizeTxPoolResponse) Reset()         { *m = ResizeTxPoolResponse{} }
+func (m *ResizeTxPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*ResizeTxPoolResponse) ProtoMessage()    {}
+func (*ResizeTxPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
+}
+
+func (m *ResizeTxPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeTxPoolResponse.Unmarshal(m, b)
+}
+func (m *ResizeTxPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeTxPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *ResizeTxPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeTxPoolResponse.Merge(m, src)
+}
+func (m *ResizeTxPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_ResizeTxPoolResponse.Size(m)
+}
+func (m *ResizeTxPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeTxPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeTxPoolResponse proto.InternalMessageInfo
+
 type ReloadSchemaRequest struct {
 	// wait_position allows scheduling a schema reload to occur after a
 	// given DDL has replicated to this slave, by specifying a replication
@@ -1238,7 +1503,7 @@ func (m *ReloadSchemaRequest) Reset()         { *m = ReloadSchemaRequest{} }
 func (m *ReloadSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaRequest) ProtoMessage()    {}
 func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
 }
 
 func (m *ReloadSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1276,7 +1541,7 @@ func (m *ReloadSchemaResponse) Reset()         { *m = ReloadSchemaResponse{} }
 func (m *ReloadSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaResponse) ProtoMessage()    {}
 func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
 }
 
 func (m *ReloadSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1308,7 +1573,7 @@ func (m *PreflightSchemaRequest) Reset()         { *m = PreflightSchemaRequest{}
 func (m *PreflightSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaRequest) ProtoMessage()    {}
 func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
 }
 
 func (m *PreflightSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1349,7 +1614,7 @@ func (m *PreflightSchemaResponse) Reset()         { *m = PreflightSchemaResponse
 func (m *PreflightSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaResponse) ProtoMessage()    {}
 func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
 }
 
 func (m *PreflightSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1392,7 +1657,7 @@ func (m *ApplySchemaRequest) Reset()         { *m = ApplySchemaRequest{} }
 func (m *ApplySchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaRequest) ProtoMessage()    {}
 func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
 }
 
 func (m *ApplySchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1460,7 +1725,7 @@ func (m *ApplySchemaResponse) Reset()         { *m = ApplySchemaResponse{} }
 func (m *ApplySchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaResponse) ProtoMessage()    {}
 func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
 }
 
 func (m *ApplySchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1495,57 +1760,156 @@ func (m *ApplySchemaResponse) GetAfterSchema() *SchemaDefinition {
 	return nil
 }
 
-type LockTablesRequest struct {
+type ApplyDeclarativeSchemaRequest struct {
+	// sql lists the CREATE TABLE statements of the desired schema.
+	Sql []string `protobuf:"bytes,1,rep,name=sql,proto3" json:"sql,omitempty"`
+	// allow_drop drops the tables which are not created by sql.
+	AllowDrop bool `protobuf:"varint,2,opt,name=allow_drop,json=allowDrop,proto3" json:"allow_drop,omitempty"`
+	// dry_run only computes the changes, without applying them.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LockTablesRequest) Reset()         { *m = LockTablesRequest{} }
-func (m *LockTablesRequest) String() string { return proto.CompactTextString(m) }
-func (*LockTablesRequest) ProtoMessage()    {}
-func (*LockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
+func (m *ApplyDeclarativeSchemaRequest) Reset()         { *m = ApplyDeclarativeSchemaRequest{} }
+func (m *ApplyDeclarativeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyDeclarativeSchemaRequest) ProtoMessage()    {}
+func (*ApplyDeclarativeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
 }
 
-func (m *LockTablesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LockTablesRequest.Unmarshal(m, b)
+func (m *ApplyDeclarativeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Unmarshal(m, b)
 }
-func (m *LockTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LockTablesRequest.Marshal(b, m, deterministic)
+func (m *ApplyDeclarativeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Marshal(b, m, deterministic)
 }
-func (m *LockTablesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockTablesRequest.Merge(m, src)
+func (m *ApplyDeclarativeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyDeclarativeSchemaRequest.Merge(m, src)
 }
-func (m *LockTablesRequest) XXX_Size() int {
-	return xxx_messageInfo_LockTablesRequest.Size(m)
+func (m *ApplyDeclarativeSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Size(m)
 }
-func (m *LockTablesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockTablesRequest.DiscardUnknown(m)
+func (m *ApplyDeclarativeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyDeclarativeSchemaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockTablesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplyDeclarativeSchemaRequest proto.InternalMessageInfo
 
-type LockTablesResponse struct {
+func (m *ApplyDeclarativeSchemaRequest) GetSql() []string {
+	if m != nil {
+		return m.Sql
+	}
+	return nil
+}
+
+func (m *ApplyDeclarativeSchemaRequest) GetAllowDrop() bool {
+	if m != nil {
+		return m.AllowDrop
+	}
+	return false
+}
+
+func (m *ApplyDeclarativeSchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ApplyDeclarativeSchemaResponse struct {
+	// changes lists the statements which change the schema of the
+	// tablet into the desired one.
+	Changes              []string `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LockTablesResponse) Reset()         { *m = LockTablesResponse{} }
-func (m *LockTablesResponse) String() string { return proto.CompactTextString(m) }
-func (*LockTablesResponse) ProtoMessage()    {}
-func (*LockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
+func (m *ApplyDeclarativeSchemaResponse) Reset()         { *m = ApplyDeclarativeSchemaResponse{} }
+func (m *ApplyDeclarativeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyDeclarativeSchemaResponse) ProtoMessage()    {}
+func (*ApplyDeclarativeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
 }
 
-func (m *LockTablesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LockTablesResponse.Unmarshal(m, b)
+func (m *ApplyDeclarativeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Unmarshal(m, b)
 }
-func (m *LockTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LockTablesResponse.Marshal(b, m, deterministic)
+func (m *ApplyDeclarativeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Marshal(b, m, deterministic)
 }
-func (m *LockTablesResponse) XXX_Merge(src proto.Message) {
+func (m *ApplyDeclarativeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyDeclarativeSchemaResponse.Merge(m, src)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Size(m)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyDeclarativeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyDeclarativeSchemaResponse proto.InternalMessageInfo
+
+func (m *ApplyDeclarativeSchemaResponse) GetChanges() []string {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type LockTablesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockTablesRequest) Reset()         { *m = LockTablesRequest{} }
+func (m *LockTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*LockTablesRequest) ProtoMessage()    {}
+func (*LockTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
+}
+
+func (m *LockTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockTablesRequest.Unmarshal(m, b)
+}
+func (m *LockTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockTablesRequest.Marshal(b, m, deterministic)
+}
+func (m *LockTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockTablesRequest.Merge(m, src)
+}
+func (m *LockTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_LockTablesRequest.Size(m)
+}
+func (m *LockTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockTablesRequest proto.InternalMessageInfo
+
+type LockTablesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockTablesResponse) Reset()         { *m = LockTablesResponse{} }
+func (m *LockTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*LockTablesResponse) ProtoMessage()    {}
+func (*LockTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
+}
+
+func (m *LockTablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockTablesResponse.Unmarshal(m, b)
+}
+func (m *LockTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockTablesResponse.Marshal(b, m, deterministic)
+}
+func (m *LockTablesResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_LockTablesResponse.Merge(m, src)
 }
 func (m *LockTablesResponse) XXX_Size() int {
@@ -1567,7 +1931,7 @@ func (m *UnlockTablesRequest) Reset()         { *m = UnlockTablesRequest{} }
 func (m *UnlockTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesRequest) ProtoMessage()    {}
 func (*UnlockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
 }
 
 func (m *UnlockTablesRequest) XXX_Unmarshal(b []byte) error {
@@ -1598,7 +1962,7 @@ func (m *UnlockTablesResponse) Reset()         { *m = UnlockTablesResponse{} }
 func (m *UnlockTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesResponse) ProtoMessage()    {}
 func (*UnlockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
 }
 
 func (m *UnlockTablesResponse) XXX_Unmarshal(b []byte) error {
@@ -1619,6 +1983,441 @@ func (m *UnlockTablesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UnlockTablesResponse proto.InternalMessageInfo
 
+// OnlineDDLMigration describes an ALTER TABLE run by gh-ost or
+// pt-online-schema-change.
+type OnlineDDLMigration struct {
+	Uuid  string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Sql   string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	// strategy is the tool: "gh-ost" or "pt-osc".
+	Strategy string `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// status is one of "queued", "running", "complete", "failed"
+	// or "cancelled".
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// progress is the percentage of rows copied so far.
+	Progress float64 `protobuf:"fixed64,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	// message is the error of a failed migration, or the last
+	// line of output of the tool.
+	Message  string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Attempts int32  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// started_at and completed_at are in seconds since the epoch.
+	StartedAt   int64 `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt int64 `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// schema is the qualifier of the table in sql, if any.
+	Schema               string   `protobuf:"bytes,11,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnlineDDLMigration) Reset()         { *m = OnlineDDLMigration{} }
+func (m *OnlineDDLMigration) String() string { return proto.CompactTextString(m) }
+func (*OnlineDDLMigration) ProtoMessage()    {}
+func (*OnlineDDLMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
+}
+
+func (m *OnlineDDLMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnlineDDLMigration.Unmarshal(m, b)
+}
+func (m *OnlineDDLMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnlineDDLMigration.Marshal(b, m, deterministic)
+}
+func (m *OnlineDDLMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnlineDDLMigration.Merge(m, src)
+}
+func (m *OnlineDDLMigration) XXX_Size() int {
+	return xxx_messageInfo_OnlineDDLMigration.Size(m)
+}
+func (m *OnlineDDLMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnlineDDLMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnlineDDLMigration proto.InternalMessageInfo
+
+func (m *OnlineDDLMigration) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *OnlineDDLMigration) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *OnlineDDLMigration) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *OnlineDDLMigration) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *OnlineDDLMigration) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *OnlineDDLMigration) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+type SubmitOnlineDDLRequest struct {
+	Sql                  string   `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Strategy             string   `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitOnlineDDLRequest) Reset()         { *m = SubmitOnlineDDLRequest{} }
+func (m *SubmitOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitOnlineDDLRequest) ProtoMessage()    {}
+func (*SubmitOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
+}
+
+func (m *SubmitOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOnlineDDLRequest.Merge(m, src)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Size(m)
+}
+func (m *SubmitOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *SubmitOnlineDDLRequest) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *SubmitOnlineDDLRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+type SubmitOnlineDDLResponse struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitOnlineDDLResponse) Reset()         { *m = SubmitOnlineDDLResponse{} }
+func (m *SubmitOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitOnlineDDLResponse) ProtoMessage()    {}
+func (*SubmitOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
+}
+
+func (m *SubmitOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOnlineDDLResponse.Merge(m, src)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Size(m)
+}
+func (m *SubmitOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOnlineDDLResponse proto.InternalMessageInfo
+
+func (m *SubmitOnlineDDLResponse) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetOnlineDDLMigrationsRequest struct {
+	// uuid selects a single migration. All the migrations
+	// are returned if it's empty.
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOnlineDDLMigrationsRequest) Reset()         { *m = GetOnlineDDLMigrationsRequest{} }
+func (m *GetOnlineDDLMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOnlineDDLMigrationsRequest) ProtoMessage()    {}
+func (*GetOnlineDDLMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
+}
+
+func (m *GetOnlineDDLMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Unmarshal(m, b)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOnlineDDLMigrationsRequest.Merge(m, src)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Size(m)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOnlineDDLMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOnlineDDLMigrationsRequest proto.InternalMessageInfo
+
+func (m *GetOnlineDDLMigrationsRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetOnlineDDLMigrationsResponse struct {
+	Migrations           []*OnlineDDLMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetOnlineDDLMigrationsResponse) Reset()         { *m = GetOnlineDDLMigrationsResponse{} }
+func (m *GetOnlineDDLMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOnlineDDLMigrationsResponse) ProtoMessage()    {}
+func (*GetOnlineDDLMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
+}
+
+func (m *GetOnlineDDLMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Unmarshal(m, b)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOnlineDDLMigrationsResponse.Merge(m, src)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Size(m)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOnlineDDLMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOnlineDDLMigrationsResponse proto.InternalMessageInfo
+
+func (m *GetOnlineDDLMigrationsResponse) GetMigrations() []*OnlineDDLMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+type CancelOnlineDDLRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOnlineDDLRequest) Reset()         { *m = CancelOnlineDDLRequest{} }
+func (m *CancelOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOnlineDDLRequest) ProtoMessage()    {}
+func (*CancelOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
+}
+
+func (m *CancelOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *CancelOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOnlineDDLRequest.Merge(m, src)
+}
+func (m *CancelOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Size(m)
+}
+func (m *CancelOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *CancelOnlineDDLRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type CancelOnlineDDLResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOnlineDDLResponse) Reset()         { *m = CancelOnlineDDLResponse{} }
+func (m *CancelOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOnlineDDLResponse) ProtoMessage()    {}
+func (*CancelOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
+}
+
+func (m *CancelOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *CancelOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOnlineDDLResponse.Merge(m, src)
+}
+func (m *CancelOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Size(m)
+}
+func (m *CancelOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOnlineDDLResponse proto.InternalMessageInfo
+
+type RetryOnlineDDLRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOnlineDDLRequest) Reset()         { *m = RetryOnlineDDLRequest{} }
+func (m *RetryOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryOnlineDDLRequest) ProtoMessage()    {}
+func (*RetryOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
+}
+
+func (m *RetryOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *RetryOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *RetryOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOnlineDDLRequest.Merge(m, src)
+}
+func (m *RetryOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Size(m)
+}
+func (m *RetryOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *RetryOnlineDDLRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type RetryOnlineDDLResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOnlineDDLResponse) Reset()         { *m = RetryOnlineDDLResponse{} }
+func (m *RetryOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryOnlineDDLResponse) ProtoMessage()    {}
+func (*RetryOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
+}
+
+func (m *RetryOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *RetryOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *RetryOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOnlineDDLResponse.Merge(m, src)
+}
+func (m *RetryOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Size(m)
+}
+func (m *RetryOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOnlineDDLResponse proto.InternalMessageInfo
+
 type ExecuteFetchAsDbaRequest struct {
 	Query                []byte   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -1634,7 +2433,7 @@ func (m *ExecuteFetchAsDbaRequest) Reset()         { *m = ExecuteFetchAsDbaReque
 func (m *ExecuteFetchAsDbaRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
 }
 
 func (m *ExecuteFetchAsDbaRequest) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +2500,7 @@ func (m *ExecuteFetchAsDbaResponse) Reset()         { *m = ExecuteFetchAsDbaResp
 func (m *ExecuteFetchAsDbaResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
 }
 
 func (m *ExecuteFetchAsDbaResponse) XXX_Unmarshal(b []byte) error {
@@ -1743,7 +2542,7 @@ func (m *ExecuteFetchAsAllPrivsRequest) Reset()         { *m = ExecuteFetchAsAll
 func (m *ExecuteFetchAsAllPrivsRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
 }
 
 func (m *ExecuteFetchAsAllPrivsRequest) XXX_Unmarshal(b []byte) error {
@@ -1803,7 +2602,7 @@ func (m *ExecuteFetchAsAllPrivsResponse) Reset()         { *m = ExecuteFetchAsAl
 func (m *ExecuteFetchAsAllPrivsResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
 }
 
 func (m *ExecuteFetchAsAllPrivsResponse) XXX_Unmarshal(b []byte) error {
@@ -1843,7 +2642,7 @@ func (m *ExecuteFetchAsAppRequest) Reset()         { *m = ExecuteFetchAsAppReque
 func (m *ExecuteFetchAsAppRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
 }
 
 func (m *ExecuteFetchAsAppRequest) XXX_Unmarshal(b []byte) error {
@@ -1889,7 +2688,7 @@ func (m *ExecuteFetchAsAppResponse) Reset()         { *m = ExecuteFetchAsAppResp
 func (m *ExecuteFetchAsAppResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
 }
 
 func (m *ExecuteFetchAsAppResponse) XXX_Unmarshal(b []byte) error {
@@ -1927,7 +2726,7 @@ func (m *SlaveStatusRequest) Reset()         { *m = SlaveStatusRequest{} }
 func (m *SlaveStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveStatusRequest) ProtoMessage()    {}
 func (*SlaveStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
 }
 
 func (m *SlaveStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -1959,7 +2758,7 @@ func (m *SlaveStatusResponse) Reset()         { *m = SlaveStatusResponse{} }
 func (m *SlaveStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveStatusResponse) ProtoMessage()    {}
 func (*SlaveStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
 }
 
 func (m *SlaveStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -1997,7 +2796,7 @@ func (m *MasterPositionRequest) Reset()         { *m = MasterPositionRequest{} }
 func (m *MasterPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionRequest) ProtoMessage()    {}
 func (*MasterPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
 }
 
 func (m *MasterPositionRequest) XXX_Unmarshal(b []byte) error {
@@ -2029,7 +2828,7 @@ func (m *MasterPositionResponse) Reset()         { *m = MasterPositionResponse{}
 func (m *MasterPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionResponse) ProtoMessage()    {}
 func (*MasterPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
 }
 
 func (m *MasterPositionResponse) XXX_Unmarshal(b []byte) error {
@@ -2068,7 +2867,7 @@ func (m *WaitForPositionRequest) Reset()         { *m = WaitForPositionRequest{}
 func (m *WaitForPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionRequest) ProtoMessage()    {}
 func (*WaitForPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
 }
 
 func (m *WaitForPositionRequest) XXX_Unmarshal(b []byte) error {
@@ -2106,7 +2905,7 @@ func (m *WaitForPositionResponse) Reset()         { *m = WaitForPositionResponse
 func (m *WaitForPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionResponse) ProtoMessage()    {}
 func (*WaitForPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
 }
 
 func (m *WaitForPositionResponse) XXX_Unmarshal(b []byte) error {
@@ -2137,7 +2936,7 @@ func (m *StopSlaveRequest) Reset()         { *m = StopSlaveRequest{} }
 func (m *StopSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveRequest) ProtoMessage()    {}
 func (*StopSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
 }
 
 func (m *StopSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -2168,7 +2967,7 @@ func (m *StopSlaveResponse) Reset()         { *m = StopSlaveResponse{} }
 func (m *StopSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveResponse) ProtoMessage()    {}
 func (*StopSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
 }
 
 func (m *StopSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -2201,7 +3000,7 @@ func (m *StopSlaveMinimumRequest) Reset()         { *m = StopSlaveMinimumRequest
 func (m *StopSlaveMinimumRequest) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumRequest) ProtoMessage()    {}
 func (*StopSlaveMinimumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
 }
 
 func (m *StopSlaveMinimumRequest) XXX_Unmarshal(b []byte) error {
@@ -2247,7 +3046,7 @@ func (m *StopSlaveMinimumResponse) Reset()         { *m = StopSlaveMinimumRespon
 func (m *StopSlaveMinimumResponse) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumResponse) ProtoMessage()    {}
 func (*StopSlaveMinimumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
 }
 
 func (m *StopSlaveMinimumResponse) XXX_Unmarshal(b []byte) error {
@@ -2285,7 +3084,7 @@ func (m *StartSlaveRequest) Reset()         { *m = StartSlaveRequest{} }
 func (m *StartSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveRequest) ProtoMessage()    {}
 func (*StartSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
 }
 
 func (m *StartSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -2316,7 +3115,7 @@ func (m *StartSlaveResponse) Reset()         { *m = StartSlaveResponse{} }
 func (m *StartSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveResponse) ProtoMessage()    {}
 func (*StartSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
 }
 
 func (m *StartSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -2349,7 +3148,7 @@ func (m *StartSlaveUntilAfterRequest) Reset()         { *m = StartSlaveUntilAfte
 func (m *StartSlaveUntilAfterRequest) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveUntilAfterRequest) ProtoMessage()    {}
 func (*StartSlaveUntilAfterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
 }
 
 func (m *StartSlaveUntilAfterRequest) XXX_Unmarshal(b []byte) error {
@@ -2394,7 +3193,7 @@ func (m *StartSlaveUntilAfterResponse) Reset()         { *m = StartSlaveUntilAft
 func (m *StartSlaveUntilAfterResponse) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveUntilAfterResponse) ProtoMessage()    {}
 func (*StartSlaveUntilAfterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
 }
 
 func (m *StartSlaveUntilAfterResponse) XXX_Unmarshal(b []byte) error {
@@ -2429,7 +3228,7 @@ func (m *TabletExternallyReparentedRequest) Reset()         { *m = TabletExterna
 func (m *TabletExternallyReparentedRequest) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedRequest) ProtoMessage()    {}
 func (*TabletExternallyReparentedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
 }
 
 func (m *TabletExternallyReparentedRequest) XXX_Unmarshal(b []byte) error {
@@ -2467,7 +3266,7 @@ func (m *TabletExternallyReparentedResponse) Reset()         { *m = TabletExtern
 func (m *TabletExternallyReparentedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedResponse) ProtoMessage()    {}
 func (*TabletExternallyReparentedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
 }
 
 func (m *TabletExternallyReparentedResponse) XXX_Unmarshal(b []byte) error {
@@ -2498,7 +3297,7 @@ func (m *TabletExternallyElectedRequest) Reset()         { *m = TabletExternally
 func (m *TabletExternallyElectedRequest) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyElectedRequest) ProtoMessage()    {}
 func (*TabletExternallyElectedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
 }
 
 func (m *TabletExternallyElectedRequest) XXX_Unmarshal(b []byte) error {
@@ -2529,7 +3328,7 @@ func (m *TabletExternallyElectedResponse) Reset()         { *m = TabletExternall
 func (m *TabletExternallyElectedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyElectedResponse) ProtoMessage()    {}
 func (*TabletExternallyElectedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
 }
 
 func (m *TabletExternallyElectedResponse) XXX_Unmarshal(b []byte) error {
@@ -2560,7 +3359,7 @@ func (m *GetSlavesRequest) Reset()         { *m = GetSlavesRequest{} }
 func (m *GetSlavesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSlavesRequest) ProtoMessage()    {}
 func (*GetSlavesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
 }
 
 func (m *GetSlavesRequest) XXX_Unmarshal(b []byte) error {
@@ -2592,7 +3391,7 @@ func (m *GetSlavesResponse) Reset()         { *m = GetSlavesResponse{} }
 func (m *GetSlavesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSlavesResponse) ProtoMessage()    {}
 func (*GetSlavesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
 }
 
 func (m *GetSlavesResponse) XXX_Unmarshal(b []byte) error {
@@ -2630,7 +3429,7 @@ func (m *ResetReplicationRequest) Reset()         { *m = ResetReplicationRequest
 func (m *ResetReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationRequest) ProtoMessage()    {}
 func (*ResetReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
 }
 
 func (m *ResetReplicationRequest) XXX_Unmarshal(b []byte) error {
@@ -2661,7 +3460,7 @@ func (m *ResetReplicationResponse) Reset()         { *m = ResetReplicationRespon
 func (m *ResetReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationResponse) ProtoMessage()    {}
 func (*ResetReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
 }
 
 func (m *ResetReplicationResponse) XXX_Unmarshal(b []byte) error {
@@ -2693,7 +3492,7 @@ func (m *VReplicationExecRequest) Reset()         { *m = VReplicationExecRequest
 func (m *VReplicationExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecRequest) ProtoMessage()    {}
 func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
 }
 
 func (m *VReplicationExecRequest) XXX_Unmarshal(b []byte) error {
@@ -2732,7 +3531,7 @@ func (m *VReplicationExecResponse) Reset()         { *m = VReplicationExecRespon
 func (m *VReplicationExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecResponse) ProtoMessage()    {}
 func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
 }
 
 func (m *VReplicationExecResponse) XXX_Unmarshal(b []byte) error {
@@ -2772,7 +3571,7 @@ func (m *VReplicationWaitForPosRequest) Reset()         { *m = VReplicationWaitF
 func (m *VReplicationWaitForPosRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosRequest) ProtoMessage()    {}
 func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
 }
 
 func (m *VReplicationWaitForPosRequest) XXX_Unmarshal(b []byte) error {
@@ -2817,7 +3616,7 @@ func (m *VReplicationWaitForPosResponse) Reset()         { *m = VReplicationWait
 func (m *VReplicationWaitForPosResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosResponse) ProtoMessage()    {}
 func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
 }
 
 func (m *VReplicationWaitForPosResponse) XXX_Unmarshal(b []byte) error {
@@ -2838,6 +3637,370 @@ func (m *VReplicationWaitForPosResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VReplicationWaitForPosResponse proto.InternalMessageInfo
 
+type VReplicationPauseRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VReplicationPauseRequest) Reset()         { *m = VReplicationPauseRequest{} }
+func (m *VReplicationPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*VReplicationPauseRequest) ProtoMessage()    {}
+func (*VReplicationPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
+}
+
+func (m *VReplicationPauseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationPauseRequest.Unmarshal(m, b)
+}
+func (m *VReplicationPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationPauseRequest.Marshal(b, m, deterministic)
+}
+func (m *VReplicationPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationPauseRequest.Merge(m, src)
+}
+func (m *VReplicationPauseRequest) XXX_Size() int {
+	return xxx_messageInfo_VReplicationPauseRequest.Size(m)
+}
+func (m *VReplicationPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationPauseRequest proto.InternalMessageInfo
+
+func (m *VReplicationPauseRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type VReplicationPauseResponse struct {
+	// position is where the stream stopped.
+	Position             string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VReplicationPauseResponse) Reset()         { *m = VReplicationPauseResponse{} }
+func (m *VReplicationPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*VReplicationPauseResponse) ProtoMessage()    {}
+func (*VReplicationPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
+}
+
+func (m *VReplicationPauseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationPauseResponse.Unmarshal(m, b)
+}
+func (m *VReplicationPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationPauseResponse.Marshal(b, m, deterministic)
+}
+func (m *VReplicationPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationPauseResponse.Merge(m, src)
+}
+func (m *VReplicationPauseResponse) XXX_Size() int {
+	return xxx_messageInfo_VReplicationPauseResponse.Size(m)
+}
+func (m *VReplicationPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationPauseResponse proto.InternalMessageInfo
+
+func (m *VReplicationPauseResponse) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+type VReplicationResumeRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VReplicationResumeRequest) Reset()         { *m = VReplicationResumeRequest{} }
+func (m *VReplicationResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*VReplicationResumeRequest) ProtoMessage()    {}
+func (*VReplicationResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
+}
+
+func (m *VReplicationResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationResumeRequest.Unmarshal(m, b)
+}
+func (m *VReplicationResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationResumeRequest.Marshal(b, m, deterministic)
+}
+func (m *VReplicationResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationResumeRequest.Merge(m, src)
+}
+func (m *VReplicationResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_VReplicationResumeRequest.Size(m)
+}
+func (m *VReplicationResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationResumeRequest proto.InternalMessageInfo
+
+func (m *VReplicationResumeRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type VReplicationResumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VReplicationResumeResponse) Reset()         { *m = VReplicationResumeResponse{} }
+func (m *VReplicationResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*VReplicationResumeResponse) ProtoMessage()    {}
+func (*VReplicationResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
+}
+
+func (m *VReplicationResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationResumeResponse.Unmarshal(m, b)
+}
+func (m *VReplicationResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationResumeResponse.Marshal(b, m, deterministic)
+}
+func (m *VReplicationResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationResumeResponse.Merge(m, src)
+}
+func (m *VReplicationResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_VReplicationResumeResponse.Size(m)
+}
+func (m *VReplicationResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationResumeResponse proto.InternalMessageInfo
+
+type VReplicationCopyProgressRequest struct {
+	// workflow restricts the progress to the streams of this workflow.
+	// All the streams are returned if it's empty.
+	Workflow             string   `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VReplicationCopyProgressRequest) Reset()         { *m = VReplicationCopyProgressRequest{} }
+func (m *VReplicationCopyProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*VReplicationCopyProgressRequest) ProtoMessage()    {}
+func (*VReplicationCopyProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
+}
+
+func (m *VReplicationCopyProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationCopyProgressRequest.Unmarshal(m, b)
+}
+func (m *VReplicationCopyProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationCopyProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *VReplicationCopyProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationCopyProgressRequest.Merge(m, src)
+}
+func (m *VReplicationCopyProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_VReplicationCopyProgressRequest.Size(m)
+}
+func (m *VReplicationCopyProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationCopyProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationCopyProgressRequest proto.InternalMessageInfo
+
+func (m *VReplicationCopyProgressRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+// TableCopyProgress is the progress of the copy of a table by a
+// vreplication stream. The counts are estimates if the copy was
+// resumed after a restart of the stream.
+type TableCopyProgress struct {
+	Table      string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	RowsCopied int64  `protobuf:"varint,2,opt,name=rows_copied,json=rowsCopied,proto3" json:"rows_copied,omitempty"`
+	// rows_total is the estimated number of rows of the source table,
+	// or 0 if it's unknown.
+	RowsTotal int64 `protobuf:"varint,3,opt,name=rows_total,json=rowsTotal,proto3" json:"rows_total,omitempty"`
+	// rows_per_second is the throughput since the copy of the table
+	// was started or resumed.
+	RowsPerSecond float64 `protobuf:"fixed64,4,opt,name=rows_per_second,json=rowsPerSecond,proto3" json:"rows_per_second,omitempty"`
+	// eta_seconds is the estimated time left, or -1 if it's unknown.
+	EtaSeconds           int64    `protobuf:"varint,5,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableCopyProgress) Reset()         { *m = TableCopyProgress{} }
+func (m *TableCopyProgress) String() string { return proto.CompactTextString(m) }
+func (*TableCopyProgress) ProtoMessage()    {}
+func (*TableCopyProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
+}
+
+func (m *TableCopyProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableCopyProgress.Unmarshal(m, b)
+}
+func (m *TableCopyProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableCopyProgress.Marshal(b, m, deterministic)
+}
+func (m *TableCopyProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableCopyProgress.Merge(m, src)
+}
+func (m *TableCopyProgress) XXX_Size() int {
+	return xxx_messageInfo_TableCopyProgress.Size(m)
+}
+func (m *TableCopyProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableCopyProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableCopyProgress proto.InternalMessageInfo
+
+func (m *TableCopyProgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *TableCopyProgress) GetRowsCopied() int64 {
+	if m != nil {
+		return m.RowsCopied
+	}
+	return 0
+}
+
+func (m *TableCopyProgress) GetRowsTotal() int64 {
+	if m != nil {
+		return m.RowsTotal
+	}
+	return 0
+}
+
+func (m *TableCopyProgress) GetRowsPerSecond() float64 {
+	if m != nil {
+		return m.RowsPerSecond
+	}
+	return 0
+}
+
+func (m *TableCopyProgress) GetEtaSeconds() int64 {
+	if m != nil {
+		return m.EtaSeconds
+	}
+	return 0
+}
+
+type VReplicationStreamCopyProgress struct {
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// tables are the tables which remain to be copied.
+	Tables               []*TableCopyProgress `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VReplicationStreamCopyProgress) Reset()         { *m = VReplicationStreamCopyProgress{} }
+func (m *VReplicationStreamCopyProgress) String() string { return proto.CompactTextString(m) }
+func (*VReplicationStreamCopyProgress) ProtoMessage()    {}
+func (*VReplicationStreamCopyProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
+}
+
+func (m *VReplicationStreamCopyProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationStreamCopyProgress.Unmarshal(m, b)
+}
+func (m *VReplicationStreamCopyProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationStreamCopyProgress.Marshal(b, m, deterministic)
+}
+func (m *VReplicationStreamCopyProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationStreamCopyProgress.Merge(m, src)
+}
+func (m *VReplicationStreamCopyProgress) XXX_Size() int {
+	return xxx_messageInfo_VReplicationStreamCopyProgress.Size(m)
+}
+func (m *VReplicationStreamCopyProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationStreamCopyProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationStreamCopyProgress proto.InternalMessageInfo
+
+func (m *VReplicationStreamCopyProgress) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VReplicationStreamCopyProgress) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *VReplicationStreamCopyProgress) GetTables() []*TableCopyProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type VReplicationCopyProgressResponse struct {
+	Streams              []*VReplicationStreamCopyProgress `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *VReplicationCopyProgressResponse) Reset()         { *m = VReplicationCopyProgressResponse{} }
+func (m *VReplicationCopyProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*VReplicationCopyProgressResponse) ProtoMessage()    {}
+func (*VReplicationCopyProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
+}
+
+func (m *VReplicationCopyProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationCopyProgressResponse.Unmarshal(m, b)
+}
+func (m *VReplicationCopyProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationCopyProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *VReplicationCopyProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationCopyProgressResponse.Merge(m, src)
+}
+func (m *VReplicationCopyProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_VReplicationCopyProgressResponse.Size(m)
+}
+func (m *VReplicationCopyProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationCopyProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationCopyProgressResponse proto.InternalMessageInfo
+
+func (m *VReplicationCopyProgressResponse) GetStreams() []*VReplicationStreamCopyProgress {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
 type InitMasterRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2848,7 +4011,7 @@ func (m *InitMasterRequest) Reset()         { *m = InitMasterRequest{} }
 func (m *InitMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*InitMasterRequest) ProtoMessage()    {}
 func (*InitMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
 }
 
 func (m *InitMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -2880,7 +4043,7 @@ func (m *InitMasterResponse) Reset()         { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()    {}
 func (*InitMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
 }
 
 func (m *InitMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -2922,7 +4085,7 @@ func (m *PopulateReparentJournalRequest) Reset()         { *m = PopulateReparent
 func (m *PopulateReparentJournalRequest) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalRequest) ProtoMessage()    {}
 func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
 }
 
 func (m *PopulateReparentJournalRequest) XXX_Unmarshal(b []byte) error {
@@ -2981,7 +4144,7 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
 }
 
 func (m *PopulateReparentJournalResponse) XXX_Unmarshal(b []byte) error {
@@ -3015,7 +4178,7 @@ func (m *InitSlaveRequest) Reset()         { *m = InitSlaveRequest{} }
 func (m *InitSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveRequest) ProtoMessage()    {}
 func (*InitSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
 }
 
 func (m *InitSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3067,7 +4230,7 @@ func (m *InitSlaveResponse) Reset()         { *m = InitSlaveResponse{} }
 func (m *InitSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveResponse) ProtoMessage()    {}
 func (*InitSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
 }
 
 func (m *InitSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -3098,7 +4261,7 @@ func (m *DemoteMasterRequest) Reset()         { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()    {}
 func (*DemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
 }
 
 func (m *DemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3130,7 +4293,7 @@ func (m *DemoteMasterResponse) Reset()         { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()    {}
 func (*DemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
 }
 
 func (m *DemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3168,7 +4331,7 @@ func (m *UndoDemoteMasterRequest) Reset()         { *m = UndoDemoteMasterRequest
 func (m *UndoDemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterRequest) ProtoMessage()    {}
 func (*UndoDemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
 }
 
 func (m *UndoDemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3199,7 +4362,7 @@ func (m *UndoDemoteMasterResponse) Reset()         { *m = UndoDemoteMasterRespon
 func (m *UndoDemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterResponse) ProtoMessage()    {}
 func (*UndoDemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
 }
 
 func (m *UndoDemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3231,7 +4394,7 @@ func (m *PromoteSlaveWhenCaughtUpRequest) Reset()         { *m = PromoteSlaveWhe
 func (m *PromoteSlaveWhenCaughtUpRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpRequest) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
 }
 
 func (m *PromoteSlaveWhenCaughtUpRequest) XXX_Unmarshal(b []byte) error {
@@ -3270,7 +4433,7 @@ func (m *PromoteSlaveWhenCaughtUpResponse) Reset()         { *m = PromoteSlaveWh
 func (m *PromoteSlaveWhenCaughtUpResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpResponse) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
 }
 
 func (m *PromoteSlaveWhenCaughtUpResponse) XXX_Unmarshal(b []byte) error {
@@ -3308,7 +4471,7 @@ func (m *SlaveWasPromotedRequest) Reset()         { *m = SlaveWasPromotedRequest
 func (m *SlaveWasPromotedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedRequest) ProtoMessage()    {}
 func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{106}
 }
 
 func (m *SlaveWasPromotedRequest) XXX_Unmarshal(b []byte) error {
@@ -3339,7 +4502,7 @@ func (m *SlaveWasPromotedResponse) Reset()         { *m = SlaveWasPromotedRespon
 func (m *SlaveWasPromotedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedResponse) ProtoMessage()    {}
 func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{107}
 }
 
 func (m *SlaveWasPromotedResponse) XXX_Unmarshal(b []byte) error {
@@ -3374,7 +4537,7 @@ func (m *SetMasterRequest) Reset()         { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()    {}
 func (*SetMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{108}
 }
 
 func (m *SetMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3433,7 +4596,7 @@ func (m *SetMasterResponse) Reset()         { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()    {}
 func (*SetMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{109}
 }
 
 func (m *SetMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3466,7 +4629,7 @@ func (m *SlaveWasRestartedRequest) Reset()         { *m = SlaveWasRestartedReque
 func (m *SlaveWasRestartedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedRequest) ProtoMessage()    {}
 func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{110}
 }
 
 func (m *SlaveWasRestartedRequest) XXX_Unmarshal(b []byte) error {
@@ -3504,7 +4667,7 @@ func (m *SlaveWasRestartedResponse) Reset()         { *m = SlaveWasRestartedResp
 func (m *SlaveWasRestartedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedResponse) ProtoMessage()    {}
 func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{111}
 }
 
 func (m *SlaveWasRestartedResponse) XXX_Unmarshal(b []byte) error {
@@ -3535,7 +4698,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{112}
 }
 
 func (m *StopReplicationAndGetStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3567,7 +4730,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{113}
 }
 
 func (m *StopReplicationAndGetStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3605,7 +4768,7 @@ func (m *PromoteSlaveRequest) Reset()         { *m = PromoteSlaveRequest{} }
 func (m *PromoteSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveRequest) ProtoMessage()    {}
 func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{114}
 }
 
 func (m *PromoteSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3637,7 +4800,7 @@ func (m *PromoteSlaveResponse) Reset()         { *m = PromoteSlaveResponse{} }
 func (m *PromoteSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveResponse) ProtoMessage()    {}
 func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{115}
 }
 
 func (m *PromoteSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -3666,8 +4829,11 @@ func (m *PromoteSlaveResponse) GetPosition() string {
 }
 
 type BackupRequest struct {
-	Concurrency          int64    `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	AllowMaster          bool     `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowMaster bool  `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	// incremental takes an incremental backup of the binary logs,
+	// whatever the backup engine of the tablet.
+	Incremental          bool     `protobuf:"varint,3,opt,name=incremental,proto3" json:"incremental,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3677,7 +4843,7 @@ func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
 func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()    {}
 func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{116}
 }
 
 func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3712,6 +4878,13 @@ func (m *BackupRequest) GetAllowMaster() bool {
 	return false
 }
 
+func (m *BackupRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
 type BackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3723,7 +4896,7 @@ func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
 func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()    {}
 func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{117}
 }
 
 func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3752,16 +4925,28 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// restore_to_timestamp is set to restore the last backup before it,
+	// and replay the incremental backups up to it.
+	RestoreToTimestamp *vttime.Time `protobuf:"bytes,1,opt,name=restore_to_timestamp,json=restoreToTimestamp,proto3" json:"restore_to_timestamp,omitempty"`
+	// restore_to_pos is set to restore the last backup before it, and
+	// replay the incremental backups up to it.
+	RestoreToPos string `protobuf:"bytes,2,opt,name=restore_to_pos,json=restoreToPos,proto3" json:"restore_to_pos,omitempty"`
+	// backup_name is set to restore this full backup of the shard,
+	// instead of the latest one. The incremental backups are not replayed.
+	BackupName string `protobuf:"bytes,3,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	// backup_timestamp is set to restore the latest backup taken at or
+	// before it, and the incremental backups taken before it.
+	BackupTimestamp      *vttime.Time `protobuf:"bytes,4,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupRequest{} }
 func (m *RestoreFromBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()    {}
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{118}
 }
 
 func (m *RestoreFromBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3782,6 +4967,34 @@ func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
 
+func (m *RestoreFromBackupRequest) GetRestoreToTimestamp() *vttime.Time {
+	if m != nil {
+		return m.RestoreToTimestamp
+	}
+	return nil
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetBackupTimestamp() *vttime.Time {
+	if m != nil {
+		return m.BackupTimestamp
+	}
+	return nil
+}
+
 type RestoreFromBackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3793,7 +5006,7 @@ func (m *RestoreFromBackupResponse) Reset()         { *m = RestoreFromBackupResp
 func (m *RestoreFromBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()    {}
 func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{119}
 }
 
 func (m *RestoreFromBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3823,6 +5036,9 @@ func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
+	proto.RegisterType((*GeneratedColumn)(nil), "tabletmanagerdata.GeneratedColumn")
+	proto.RegisterType((*Partitioning)(nil), "tabletmanagerdata.Partitioning")
+	proto.RegisterType((*Partition)(nil), "tabletmanagerdata.Partition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
 	proto.RegisterType((*SchemaChangeResult)(nil), "tabletmanagerdata.SchemaChangeResult")
 	proto.RegisterType((*UserPermission)(nil), "tabletmanagerdata.UserPermission")
@@ -3853,16 +5069,29 @@ func init() {
 	proto.RegisterType((*RunHealthCheckResponse)(nil), "tabletmanagerdata.RunHealthCheckResponse")
 	proto.RegisterType((*IgnoreHealthErrorRequest)(nil), "tabletmanagerdata.IgnoreHealthErrorRequest")
 	proto.RegisterType((*IgnoreHealthErrorResponse)(nil), "tabletmanagerdata.IgnoreHealthErrorResponse")
+	proto.RegisterType((*ResizeTxPoolRequest)(nil), "tabletmanagerdata.ResizeTxPoolRequest")
+	proto.RegisterType((*ResizeTxPoolResponse)(nil), "tabletmanagerdata.ResizeTxPoolResponse")
 	proto.RegisterType((*ReloadSchemaRequest)(nil), "tabletmanagerdata.ReloadSchemaRequest")
 	proto.RegisterType((*ReloadSchemaResponse)(nil), "tabletmanagerdata.ReloadSchemaResponse")
 	proto.RegisterType((*PreflightSchemaRequest)(nil), "tabletmanagerdata.PreflightSchemaRequest")
 	proto.RegisterType((*PreflightSchemaResponse)(nil), "tabletmanagerdata.PreflightSchemaResponse")
 	proto.RegisterType((*ApplySchemaRequest)(nil), "tabletmanagerdata.ApplySchemaRequest")
 	proto.RegisterType((*ApplySchemaResponse)(nil), "tabletmanagerdata.ApplySchemaResponse")
+	proto.RegisterType((*ApplyDeclarativeSchemaRequest)(nil), "tabletmanagerdata.ApplyDeclarativeSchemaRequest")
+	proto.RegisterType((*ApplyDeclarativeSchemaResponse)(nil), "tabletmanagerdata.ApplyDeclarativeSchemaResponse")
 	proto.RegisterType((*LockTablesRequest)(nil), "tabletmanagerdata.LockTablesRequest")
 	proto.RegisterType((*LockTablesResponse)(nil), "tabletmanagerdata.LockTablesResponse")
 	proto.RegisterType((*UnlockTablesRequest)(nil), "tabletmanagerdata.UnlockTablesRequest")
 	proto.RegisterType((*UnlockTablesResponse)(nil), "tabletmanagerdata.UnlockTablesResponse")
+	proto.RegisterType((*OnlineDDLMigration)(nil), "tabletmanagerdata.OnlineDDLMigration")
+	proto.RegisterType((*SubmitOnlineDDLRequest)(nil), "tabletmanagerdata.SubmitOnlineDDLRequest")
+	proto.RegisterType((*SubmitOnlineDDLResponse)(nil), "tabletmanagerdata.SubmitOnlineDDLResponse")
+	proto.RegisterType((*GetOnlineDDLMigrationsRequest)(nil), "tabletmanagerdata.GetOnlineDDLMigrationsRequest")
+	proto.RegisterType((*GetOnlineDDLMigrationsResponse)(nil), "tabletmanagerdata.GetOnlineDDLMigrationsResponse")
+	proto.RegisterType((*CancelOnlineDDLRequest)(nil), "tabletmanagerdata.CancelOnlineDDLRequest")
+	proto.RegisterType((*CancelOnlineDDLResponse)(nil), "tabletmanagerdata.CancelOnlineDDLResponse")
+	proto.RegisterType((*RetryOnlineDDLRequest)(nil), "tabletmanagerdata.RetryOnlineDDLRequest")
+	proto.RegisterType((*RetryOnlineDDLResponse)(nil), "tabletmanagerdata.RetryOnlineDDLResponse")
 	proto.RegisterType((*ExecuteFetchAsDbaRequest)(nil), "tabletmanagerdata.ExecuteFetchAsDbaRequest")
 	proto.RegisterType((*ExecuteFetchAsDbaResponse)(nil), "tabletmanagerdata.ExecuteFetchAsDbaResponse")
 	proto.RegisterType((*ExecuteFetchAsAllPrivsRequest)(nil), "tabletmanagerdata.ExecuteFetchAsAllPrivsRequest")
@@ -3895,6 +5124,14 @@ func init() {
 	proto.RegisterType((*VReplicationExecResponse)(nil), "tabletmanagerdata.VReplicationExecResponse")
 	proto.RegisterType((*VReplicationWaitForPosRequest)(nil), "tabletmanagerdata.VReplicationWaitForPosRequest")
 	proto.RegisterType((*VReplicationWaitForPosResponse)(nil), "tabletmanagerdata.VReplicationWaitForPosResponse")
+	proto.RegisterType((*VReplicationPauseRequest)(nil), "tabletmanagerdata.VReplicationPauseRequest")
+	proto.RegisterType((*VReplicationPauseResponse)(nil), "tabletmanagerdata.VReplicationPauseResponse")
+	proto.RegisterType((*VReplicationResumeRequest)(nil), "tabletmanagerdata.VReplicationResumeRequest")
+	proto.RegisterType((*VReplicationResumeResponse)(nil), "tabletmanagerdata.VReplicationResumeResponse")
+	proto.RegisterType((*VReplicationCopyProgressRequest)(nil), "tabletmanagerdata.VReplicationCopyProgressRequest")
+	proto.RegisterType((*TableCopyProgress)(nil), "tabletmanagerdata.TableCopyProgress")
+	proto.RegisterType((*VReplicationStreamCopyProgress)(nil), "tabletmanagerdata.VReplicationStreamCopyProgress")
+	proto.RegisterType((*VReplicationCopyProgressResponse)(nil), "tabletmanagerdata.VReplicationCopyProgressResponse")
 	proto.RegisterType((*InitMasterRequest)(nil), "tabletmanagerdata.InitMasterRequest")
 	proto.RegisterType((*InitMasterResponse)(nil), "tabletmanagerdata.InitMasterResponse")
 	proto.RegisterType((*PopulateReparentJournalRequest)(nil), "tabletmanagerdata.PopulateReparentJournalRequest")
@@ -3926,137 +5163,184 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2108 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x06, 0x49, 0x49, 0xa6, 0x0e, 0x2f, 0x22, 0x97, 0x94, 0x48, 0xc9, 0x8d, 0x2e, 0x6b, 0xa7,
-	0x51, 0x5d, 0x94, 0x4a, 0x94, 0x34, 0x08, 0x52, 0xa4, 0xa8, 0xac, 0x8b, 0xed, 0x44, 0x89, 0x95,
-	0x95, 0x65, 0x17, 0x41, 0x81, 0xc5, 0x90, 0x3b, 0x22, 0x17, 0x5a, 0xee, 0xac, 0x67, 0x66, 0x29,
-	0xf1, 0x4f, 0xf4, 0x17, 0xf4, 0xad, 0x40, 0xfb, 0xde, 0xc7, 0xfe, 0x10, 0xf7, 0xa7, 0xf4, 0xa1,
-	0x0f, 0x2d, 0xe6, 0xb2, 0xe4, 0x2c, 0x49, 0xc9, 0x92, 0xe0, 0x02, 0x79, 0x11, 0x38, 0xdf, 0x39,
-	0x73, 0x6e, 0x73, 0x6e, 0x0b, 0x41, 0x83, 0xa3, 0x76, 0x80, 0x79, 0x1f, 0x85, 0xa8, 0x8b, 0xa9,
-	0x87, 0x38, 0x6a, 0x45, 0x94, 0x70, 0x62, 0x55, 0xa7, 0x08, 0x6b, 0x85, 0xb7, 0x31, 0xa6, 0x43,
-	0x45, 0x5f, 0x2b, 0x73, 0x12, 0x91, 0x31, 0xff, 0xda, 0x32, 0xc5, 0x51, 0xe0, 0x77, 0x10, 0xf7,
-	0x49, 0x68, 0xc0, 0xa5, 0x80, 0x74, 0x63, 0xee, 0x07, 0xea, 0x68, 0xff, 0x37, 0x03, 0x4b, 0xaf,
-	0x84, 0xe0, 0x03, 0x7c, 0xee, 0x87, 0xbe, 0x60, 0xb6, 0x2c, 0x98, 0x0b, 0x51, 0x1f, 0x37, 0x33,
-	0x9b, 0x99, 0xed, 0x45, 0x47, 0xfe, 0xb6, 0x56, 0x60, 0x81, 0x75, 0x7a, 0xb8, 0x8f, 0x9a, 0x59,
-	0x89, 0xea, 0x93, 0xd5, 0x84, 0x07, 0x1d, 0x12, 0xc4, 0xfd, 0x90, 0x35, 0x73, 0x9b, 0xb9, 0xed,
-	0x45, 0x27, 0x39, 0x5a, 0x2d, 0xa8, 0x45, 0xd4, 0xef, 0x23, 0x3a, 0x74, 0x2f, 0xf0, 0xd0, 0x4d,
-	0xb8, 0xe6, 0x24, 0x57, 0x55, 0x93, 0xbe, 0xc3, 0xc3, 0x7d, 0xcd, 0x6f, 0xc1, 0x1c, 0x1f, 0x46,
-	0xb8, 0x39, 0xaf, 0xb4, 0x8a, 0xdf, 0xd6, 0x06, 0x14, 0x84, 0xe9, 0x6e, 0x80, 0xc3, 0x2e, 0xef,
-	0x35, 0x17, 0x36, 0x33, 0xdb, 0x73, 0x0e, 0x08, 0xe8, 0x58, 0x22, 0xd6, 0x43, 0x58, 0xa4, 0xe4,
-	0xd2, 0xed, 0x90, 0x38, 0xe4, 0xcd, 0x07, 0x92, 0x9c, 0xa7, 0xe4, 0x72, 0x5f, 0x9c, 0xad, 0xc7,
-	0xb0, 0x70, 0xee, 0xe3, 0xc0, 0x63, 0xcd, 0xfc, 0x66, 0x6e, 0xbb, 0xb0, 0x5b, 0x6c, 0xa9, 0x78,
-	0x1d, 0x09, 0xd0, 0xd1, 0x34, 0xfb, 0x6f, 0x19, 0xa8, 0x9c, 0x4a, 0x67, 0x8c, 0x10, 0x7c, 0x02,
-	0x4b, 0x42, 0x4b, 0x1b, 0x31, 0xec, 0x6a, 0xbf, 0x55, 0x34, 0xca, 0x09, 0xac, 0xae, 0x58, 0x2f,
-	0x41, 0xbd, 0x8b, 0xeb, 0x8d, 0x2e, 0xb3, 0x66, 0x56, 0xaa, 0xb3, 0x5b, 0xd3, 0x4f, 0x39, 0x11,
-	0x6a, 0xa7, 0xc2, 0xd3, 0x00, 0x13, 0x01, 0x1d, 0x60, 0xca, 0x7c, 0x12, 0x36, 0x73, 0x52, 0x63,
-	0x72, 0x14, 0x86, 0x5a, 0x4a, 0xeb, 0x7e, 0x0f, 0x85, 0x5d, 0xec, 0x60, 0x16, 0x07, 0xdc, 0x7a,
-	0x0e, 0xa5, 0x36, 0x3e, 0x27, 0x34, 0x65, 0x68, 0x61, 0xf7, 0xd1, 0x0c, 0xed, 0x93, 0x6e, 0x3a,
-	0x45, 0x75, 0x53, 0xfb, 0x72, 0x04, 0x45, 0x74, 0xce, 0x31, 0x75, 0x8d, 0x97, 0xbe, 0xa5, 0xa0,
-	0x82, 0xbc, 0xa8, 0x60, 0xfb, 0xdf, 0x19, 0x28, 0x9f, 0x31, 0x4c, 0x4f, 0x30, 0xed, 0xfb, 0x8c,
-	0xe9, 0x94, 0xea, 0x11, 0xc6, 0x93, 0x94, 0x12, 0xbf, 0x05, 0x16, 0x33, 0x4c, 0x75, 0x42, 0xc9,
-	0xdf, 0xd6, 0xaf, 0xa1, 0x1a, 0x21, 0xc6, 0x2e, 0x09, 0xf5, 0xdc, 0x4e, 0x0f, 0x77, 0x2e, 0x58,
-	0xdc, 0x97, 0x71, 0x98, 0x73, 0x2a, 0x09, 0x61, 0x5f, 0xe3, 0xd6, 0x8f, 0x00, 0x11, 0xf5, 0x07,
-	0x7e, 0x80, 0xbb, 0x58, 0x25, 0x56, 0x61, 0xf7, 0xb3, 0x19, 0xd6, 0xa6, 0x6d, 0x69, 0x9d, 0x8c,
-	0xee, 0x1c, 0x86, 0x9c, 0x0e, 0x1d, 0x43, 0xc8, 0xda, 0x37, 0xb0, 0x34, 0x41, 0xb6, 0x2a, 0x90,
-	0xbb, 0xc0, 0x43, 0x6d, 0xb9, 0xf8, 0x69, 0xd5, 0x61, 0x7e, 0x80, 0x82, 0x18, 0x6b, 0xcb, 0xd5,
-	0xe1, 0xeb, 0xec, 0x57, 0x19, 0xfb, 0x5d, 0x06, 0x8a, 0x07, 0xed, 0xf7, 0xf8, 0x5d, 0x86, 0xac,
-	0xd7, 0xd6, 0x77, 0xb3, 0x5e, 0x7b, 0x14, 0x87, 0x9c, 0x11, 0x87, 0x97, 0x33, 0x5c, 0xdb, 0x99,
-	0xe1, 0x9a, 0xa9, 0xec, 0xff, 0xe9, 0xd8, 0x5f, 0x33, 0x50, 0x18, 0x6b, 0x62, 0xd6, 0x31, 0x54,
-	0x84, 0x9d, 0x6e, 0x34, 0xc6, 0x9a, 0x19, 0x69, 0xe5, 0xd6, 0x7b, 0x1f, 0xc0, 0x59, 0x8a, 0x53,
-	0x67, 0x66, 0x1d, 0x41, 0xd9, 0x6b, 0xa7, 0x64, 0xa9, 0x0a, 0xda, 0x78, 0x8f, 0xc7, 0x4e, 0xc9,
-	0x33, 0x4e, 0xcc, 0xfe, 0x04, 0x0a, 0x27, 0x7e, 0xd8, 0x75, 0xf0, 0xdb, 0x18, 0x33, 0x2e, 0x4a,
-	0x29, 0x42, 0xc3, 0x80, 0x20, 0x4f, 0x3b, 0x99, 0x1c, 0xed, 0x6d, 0x28, 0x2a, 0x46, 0x16, 0x91,
-	0x90, 0xe1, 0x1b, 0x38, 0x9f, 0x40, 0xf1, 0x34, 0xc0, 0x38, 0x4a, 0x64, 0xae, 0x41, 0xde, 0x8b,
-	0xa9, 0x6c, 0xaa, 0x92, 0x35, 0xe7, 0x8c, 0xce, 0xf6, 0x12, 0x94, 0x34, 0xaf, 0x12, 0x6b, 0xff,
-	0x2b, 0x03, 0xd6, 0xe1, 0x15, 0xee, 0xc4, 0x1c, 0x3f, 0x27, 0xe4, 0x22, 0x91, 0x31, 0xab, 0xbf,
-	0xae, 0x03, 0x44, 0x88, 0xa2, 0x3e, 0xe6, 0x98, 0x2a, 0xf7, 0x17, 0x1d, 0x03, 0xb1, 0x4e, 0x60,
-	0x11, 0x5f, 0x71, 0x8a, 0x5c, 0x1c, 0x0e, 0x64, 0xa7, 0x2d, 0xec, 0x7e, 0x3e, 0x23, 0x3a, 0xd3,
-	0xda, 0x5a, 0x87, 0xe2, 0xda, 0x61, 0x38, 0x50, 0x39, 0x91, 0xc7, 0xfa, 0xb8, 0xf6, 0x3b, 0x28,
-	0xa5, 0x48, 0x77, 0xca, 0x87, 0x73, 0xa8, 0xa5, 0x54, 0xe9, 0x38, 0x6e, 0x40, 0x01, 0x5f, 0xf9,
-	0xdc, 0x65, 0x1c, 0xf1, 0x98, 0xe9, 0x00, 0x81, 0x80, 0x4e, 0x25, 0x22, 0xc7, 0x08, 0xf7, 0x48,
-	0xcc, 0x47, 0x63, 0x44, 0x9e, 0x34, 0x8e, 0x69, 0x52, 0x05, 0xfa, 0x64, 0x0f, 0xa0, 0xf2, 0x0c,
-	0x73, 0xd5, 0x57, 0x92, 0xf0, 0xad, 0xc0, 0x82, 0x74, 0x5c, 0x65, 0xdc, 0xa2, 0xa3, 0x4f, 0xd6,
-	0x23, 0x28, 0xf9, 0x61, 0x27, 0x88, 0x3d, 0xec, 0x0e, 0x7c, 0x7c, 0xc9, 0xa4, 0x8a, 0xbc, 0x53,
-	0xd4, 0xe0, 0x6b, 0x81, 0x59, 0x1f, 0x43, 0x19, 0x5f, 0x29, 0x26, 0x2d, 0x44, 0x8d, 0xad, 0x92,
-	0x46, 0x65, 0x83, 0x66, 0x36, 0x86, 0xaa, 0xa1, 0x57, 0x7b, 0x77, 0x02, 0x55, 0xd5, 0x19, 0x8d,
-	0x66, 0x7f, 0x97, 0x6e, 0x5b, 0x61, 0x13, 0x88, 0xdd, 0x80, 0xe5, 0x67, 0x98, 0x1b, 0x29, 0xac,
-	0x7d, 0xb4, 0x7f, 0x82, 0x95, 0x49, 0x82, 0x36, 0xe2, 0x0f, 0x50, 0x48, 0x17, 0x9d, 0x50, 0xbf,
-	0x3e, 0x43, 0xbd, 0x79, 0xd9, 0xbc, 0x62, 0xd7, 0xc1, 0x3a, 0xc5, 0xdc, 0xc1, 0xc8, 0x7b, 0x19,
-	0x06, 0xc3, 0x44, 0xe3, 0x32, 0xd4, 0x52, 0xa8, 0x4e, 0xe1, 0x31, 0xfc, 0x86, 0xfa, 0x1c, 0x27,
-	0xdc, 0x2b, 0x50, 0x4f, 0xc3, 0x9a, 0xfd, 0x5b, 0xa8, 0xaa, 0xe1, 0xf4, 0x6a, 0x18, 0x25, 0xcc,
-	0xd6, 0x6f, 0xa1, 0xa0, 0xcc, 0x73, 0xe5, 0x80, 0x17, 0x26, 0x97, 0x77, 0xeb, 0xad, 0xd1, 0xbe,
-	0x22, 0x63, 0xce, 0xe5, 0x0d, 0xe0, 0xa3, 0xdf, 0xc2, 0x4e, 0x53, 0xd6, 0xd8, 0x20, 0x07, 0x9f,
-	0x53, 0xcc, 0x7a, 0x22, 0xa5, 0x4c, 0x83, 0xd2, 0xb0, 0x66, 0x6f, 0xc0, 0xb2, 0x13, 0x87, 0xcf,
-	0x31, 0x0a, 0x78, 0x4f, 0x0e, 0x8e, 0xe4, 0x42, 0x13, 0x56, 0x26, 0x09, 0xfa, 0xca, 0x17, 0xd0,
-	0x7c, 0xd1, 0x0d, 0x09, 0xc5, 0x8a, 0x78, 0x48, 0x29, 0xa1, 0xa9, 0x96, 0xc2, 0x39, 0xa6, 0xe1,
-	0xb8, 0x51, 0xc8, 0xa3, 0xfd, 0x10, 0x56, 0x67, 0xdc, 0xd2, 0x22, 0xbf, 0x16, 0x46, 0x8b, 0x7e,
-	0x92, 0xce, 0xe4, 0x47, 0x50, 0xba, 0x44, 0x3e, 0x77, 0x23, 0xc2, 0xc6, 0xc9, 0xb4, 0xe8, 0x14,
-	0x05, 0x78, 0xa2, 0x31, 0xe5, 0x99, 0x79, 0x57, 0xcb, 0xdc, 0x85, 0x95, 0x13, 0x8a, 0xcf, 0x03,
-	0xbf, 0xdb, 0x9b, 0x28, 0x10, 0xb1, 0x93, 0xc9, 0xc0, 0x25, 0x15, 0x92, 0x1c, 0xed, 0x2e, 0x34,
-	0xa6, 0xee, 0xe8, 0xbc, 0x3a, 0x86, 0xb2, 0xe2, 0x72, 0xa9, 0xdc, 0x2b, 0x92, 0x7e, 0xfe, 0xf1,
-	0xb5, 0x99, 0x6d, 0x6e, 0x21, 0x4e, 0xa9, 0x63, 0x9c, 0x98, 0xfd, 0x9f, 0x0c, 0x58, 0x7b, 0x51,
-	0x14, 0x0c, 0xd3, 0x96, 0x55, 0x20, 0xc7, 0xde, 0x06, 0x49, 0x8b, 0x61, 0x6f, 0x03, 0xd1, 0x62,
-	0xce, 0x09, 0xed, 0x60, 0x5d, 0xac, 0xea, 0x20, 0xd6, 0x00, 0x14, 0x04, 0xe4, 0xd2, 0x35, 0x76,
-	0x58, 0xd9, 0x19, 0xf2, 0x4e, 0x45, 0x12, 0x9c, 0x31, 0x3e, 0xbd, 0x00, 0xcd, 0x7d, 0xa8, 0x05,
-	0x68, 0xfe, 0x9e, 0x0b, 0xd0, 0xdf, 0x33, 0x50, 0x4b, 0x79, 0xaf, 0x63, 0xfc, 0xf3, 0x5b, 0xd5,
-	0x6a, 0x50, 0x3d, 0x26, 0x9d, 0x0b, 0xd5, 0xf5, 0x92, 0xd2, 0xa8, 0x83, 0x65, 0x82, 0xe3, 0xc2,
-	0x3b, 0x0b, 0x83, 0x29, 0xe6, 0x15, 0xa8, 0xa7, 0x61, 0xcd, 0xfe, 0x8f, 0x0c, 0x34, 0xf5, 0x88,
-	0x38, 0xc2, 0xbc, 0xd3, 0xdb, 0x63, 0x07, 0xed, 0x51, 0x1e, 0xd4, 0x61, 0x5e, 0xae, 0xe2, 0x32,
-	0x00, 0x45, 0x47, 0x1d, 0xac, 0x06, 0x3c, 0xf0, 0xda, 0xae, 0x1c, 0x8d, 0x7a, 0x3a, 0x78, 0xed,
-	0x1f, 0xc4, 0x70, 0x5c, 0x85, 0x7c, 0x1f, 0x5d, 0xb9, 0x94, 0x5c, 0x32, 0xbd, 0x0c, 0x3e, 0xe8,
-	0xa3, 0x2b, 0x87, 0x5c, 0x32, 0xb9, 0xa8, 0xfb, 0x4c, 0x6e, 0xe0, 0x6d, 0x3f, 0x0c, 0x48, 0x97,
-	0xc9, 0xe7, 0xcf, 0x3b, 0x65, 0x0d, 0x3f, 0x55, 0xa8, 0xa8, 0x35, 0x2a, 0xcb, 0xc8, 0x7c, 0xdc,
-	0xbc, 0x53, 0xa4, 0x46, 0x6d, 0xd9, 0xcf, 0x60, 0x75, 0x86, 0xcd, 0xfa, 0xf5, 0x9e, 0xc0, 0x82,
-	0x2a, 0x0d, 0xfd, 0x6c, 0x96, 0xfe, 0x9c, 0xf8, 0x51, 0xfc, 0xd5, 0x65, 0xa0, 0x39, 0xec, 0x3f,
-	0x67, 0xe0, 0xa3, 0xb4, 0xa4, 0xbd, 0x20, 0x10, 0x0b, 0x18, 0xfb, 0xf0, 0x21, 0x98, 0xf2, 0x6c,
-	0x6e, 0x86, 0x67, 0xc7, 0xb0, 0x7e, 0x9d, 0x3d, 0xf7, 0x70, 0xef, 0xbb, 0xc9, 0xb7, 0xdd, 0x8b,
-	0xa2, 0x9b, 0x1d, 0x33, 0xed, 0xcf, 0xa6, 0xec, 0x9f, 0x0e, 0xba, 0x14, 0x76, 0x0f, 0xab, 0xc4,
-	0x60, 0x0b, 0xd0, 0x00, 0xab, 0x5d, 0x23, 0x49, 0xd0, 0x23, 0xa8, 0xa5, 0x50, 0x2d, 0x78, 0x47,
-	0x6c, 0x1c, 0xa3, 0x2d, 0xa5, 0xb0, 0xdb, 0x68, 0x4d, 0x7e, 0x2f, 0xeb, 0x0b, 0x9a, 0x4d, 0x4c,
-	0x92, 0xef, 0x11, 0xe3, 0x98, 0x26, 0x9d, 0x39, 0x51, 0xf0, 0x05, 0xac, 0x4c, 0x12, 0xb4, 0x8e,
-	0x35, 0xc8, 0x4f, 0xb4, 0xf6, 0xd1, 0x59, 0xdc, 0x7a, 0x83, 0x7c, 0x7e, 0x44, 0x26, 0xe5, 0xdd,
-	0x78, 0x6b, 0x15, 0x1a, 0x53, 0xb7, 0x74, 0xc1, 0x59, 0x50, 0x39, 0xe5, 0x24, 0x92, 0xbe, 0x26,
-	0xa6, 0xd5, 0xa0, 0x6a, 0x60, 0x9a, 0xf1, 0x8f, 0xd0, 0x18, 0x81, 0xdf, 0xfb, 0xa1, 0xdf, 0x8f,
-	0xfb, 0xb7, 0x50, 0x6d, 0x6d, 0x81, 0x9c, 0x4b, 0x2e, 0xf7, 0xfb, 0x38, 0x59, 0xe0, 0x72, 0x4e,
-	0x41, 0x60, 0xaf, 0x14, 0x64, 0x7f, 0x09, 0xcd, 0x69, 0xc9, 0xb7, 0x88, 0x85, 0x34, 0x13, 0x51,
-	0x9e, 0xb2, 0x5d, 0xbc, 0xa6, 0x01, 0x6a, 0xe3, 0xff, 0x04, 0x0f, 0xc7, 0xe8, 0x59, 0xc8, 0xfd,
-	0x60, 0x4f, 0xb4, 0xb3, 0x0f, 0xe4, 0xc0, 0x3a, 0xfc, 0x62, 0xb6, 0x74, 0xad, 0xfd, 0x00, 0xb6,
-	0xd4, 0xb2, 0x72, 0x78, 0x25, 0x86, 0x3e, 0x0a, 0xc4, 0xa6, 0x14, 0x21, 0x8a, 0x43, 0x8e, 0xbd,
-	0xc4, 0x06, 0xb9, 0x04, 0x2b, 0xb2, 0xeb, 0x27, 0x1f, 0x14, 0x90, 0x40, 0x2f, 0x3c, 0xfb, 0x31,
-	0xd8, 0x37, 0x49, 0xd1, 0xba, 0x36, 0x61, 0x7d, 0x92, 0xeb, 0x30, 0xc0, 0x9d, 0xb1, 0x22, 0x7b,
-	0x0b, 0x36, 0xae, 0xe5, 0x18, 0x27, 0x85, 0xd8, 0x63, 0x85, 0x3b, 0xa3, 0x82, 0xf8, 0x95, 0xda,
-	0x6d, 0x35, 0xa6, 0x9f, 0xa7, 0x0e, 0xf3, 0xc8, 0xf3, 0x68, 0xb2, 0x31, 0xa8, 0x83, 0x48, 0x37,
-	0x07, 0x33, 0xb1, 0xe8, 0x8d, 0x4a, 0x23, 0x91, 0xb2, 0x06, 0xcd, 0x69, 0x92, 0xd6, 0xba, 0x03,
-	0x8d, 0xd7, 0x06, 0x2e, 0xaa, 0x7b, 0x66, 0x77, 0x58, 0xd4, 0xdd, 0xc1, 0x3e, 0x82, 0xe6, 0xf4,
-	0x85, 0x7b, 0xf5, 0xa5, 0x8f, 0x4c, 0x39, 0xe3, 0x52, 0x49, 0xd4, 0x97, 0x21, 0xab, 0x9f, 0x24,
-	0xe7, 0x64, 0x7d, 0x2f, 0x95, 0x2f, 0xd9, 0x89, 0xac, 0xdc, 0x84, 0xf5, 0xeb, 0x84, 0x69, 0x3f,
-	0x6b, 0x50, 0x7d, 0x11, 0xfa, 0x5c, 0x55, 0x7f, 0x12, 0x98, 0x4f, 0xc1, 0x32, 0xc1, 0x5b, 0xa4,
-	0xff, 0xbb, 0x0c, 0xac, 0x9f, 0x90, 0x28, 0x0e, 0xe4, 0xe2, 0xaa, 0x12, 0xe1, 0x5b, 0x12, 0x8b,
-	0x17, 0x4d, 0xec, 0xfe, 0x25, 0x2c, 0x89, 0xb4, 0x75, 0x3b, 0x14, 0x23, 0x8e, 0x3d, 0x37, 0x4c,
-	0x3e, 0xae, 0x4a, 0x02, 0xde, 0x57, 0xe8, 0x0f, 0x4c, 0xe4, 0x1e, 0xea, 0x08, 0xa1, 0xe6, 0x0c,
-	0x01, 0x05, 0xc9, 0x39, 0xf2, 0x15, 0x14, 0xfb, 0xd2, 0x32, 0x17, 0x05, 0x3e, 0x52, 0xb3, 0xa4,
-	0xb0, 0xbb, 0x3c, 0xb9, 0x8c, 0xef, 0x09, 0xa2, 0x53, 0x50, 0xac, 0xf2, 0x60, 0x7d, 0x06, 0x75,
-	0xa3, 0x43, 0x8e, 0x77, 0xd6, 0x39, 0xa9, 0xa3, 0x66, 0xd0, 0x46, 0xab, 0xeb, 0x16, 0x6c, 0x5c,
-	0xeb, 0x97, 0x0e, 0xe1, 0x5f, 0x32, 0x50, 0x11, 0xe1, 0x32, 0x4b, 0xdf, 0xfa, 0x0d, 0x2c, 0x28,
-	0x6e, 0xfd, 0xe4, 0xd7, 0x98, 0xa7, 0x99, 0xae, 0xb5, 0x2c, 0x7b, 0xad, 0x65, 0xb3, 0xe2, 0x99,
-	0x9b, 0x11, 0xcf, 0xe4, 0x85, 0xd3, 0x3d, 0x68, 0x19, 0x6a, 0x07, 0xb8, 0x4f, 0x38, 0x4e, 0x3f,
-	0xfc, 0x2e, 0xd4, 0xd3, 0xf0, 0x2d, 0x9e, 0x7e, 0x15, 0x1a, 0x67, 0xa1, 0x47, 0x66, 0x89, 0x5b,
-	0x83, 0xe6, 0x34, 0x49, 0x5b, 0xf0, 0x0d, 0x6c, 0x9c, 0x50, 0x22, 0x08, 0xd2, 0xb2, 0x37, 0x3d,
-	0x1c, 0xee, 0xa3, 0xb8, 0xdb, 0xe3, 0x67, 0xd1, 0x6d, 0xa6, 0xc8, 0xef, 0x61, 0xf3, 0xfa, 0xeb,
-	0xb7, 0xb3, 0x5a, 0x5d, 0x44, 0x4c, 0xcb, 0xf1, 0x0c, 0xab, 0xa7, 0x49, 0xda, 0xea, 0x7f, 0x66,
-	0xa0, 0x72, 0x8a, 0xd3, 0xe5, 0x72, 0xd7, 0xb7, 0x9e, 0xf1, 0x70, 0xd9, 0x59, 0x85, 0x30, 0xf5,
-	0x69, 0x35, 0x37, 0xfd, 0x69, 0x65, 0x3d, 0x81, 0xaa, 0xfc, 0xde, 0x70, 0x99, 0x68, 0xfa, 0x2e,
-	0x13, 0x86, 0xeb, 0xcf, 0x8c, 0x25, 0x49, 0x18, 0x0f, 0x03, 0x39, 0xa3, 0xf0, 0x44, 0x55, 0xdb,
-	0x2f, 0xc6, 0xde, 0x3a, 0x58, 0x0a, 0x19, 0x8f, 0x81, 0xbb, 0x39, 0x26, 0xbe, 0x1f, 0x67, 0x88,
-	0xd2, 0x7a, 0x1e, 0x83, 0x2d, 0x06, 0xab, 0xd1, 0x8d, 0xf6, 0x42, 0x4f, 0x34, 0xf1, 0xd4, 0xa6,
-	0xf3, 0x1a, 0x1e, 0xdd, 0xc8, 0x75, 0xdf, 0xcd, 0x67, 0x19, 0x6a, 0x66, 0xba, 0x18, 0xf9, 0x9e,
-	0x86, 0x6f, 0x91, 0x39, 0xa7, 0x50, 0x7a, 0x8a, 0x3a, 0x17, 0xf1, 0x28, 0x4d, 0x37, 0xa1, 0xd0,
-	0x21, 0x61, 0x27, 0xa6, 0x14, 0x87, 0x9d, 0xa1, 0x6e, 0x6a, 0x26, 0x24, 0x38, 0xe4, 0x27, 0x9f,
-	0x0a, 0xbd, 0xfe, 0x4e, 0x34, 0x21, 0xfb, 0x4b, 0x28, 0x27, 0x42, 0xb5, 0x09, 0x8f, 0x61, 0x1e,
-	0x0f, 0xc6, 0xa1, 0x2f, 0xb7, 0x92, 0x7f, 0x7a, 0x1c, 0x0a, 0xd4, 0x51, 0x44, 0x3d, 0xc2, 0x38,
-	0xa1, 0xf8, 0x88, 0x92, 0x7e, 0xca, 0x2e, 0x7b, 0x0f, 0x56, 0x67, 0xd0, 0xee, 0x22, 0xfe, 0xe9,
-	0xa7, 0x3f, 0xb5, 0x06, 0x3e, 0xc7, 0x8c, 0xb5, 0x7c, 0xb2, 0xa3, 0x7e, 0xed, 0x74, 0xc9, 0xce,
-	0x80, 0xef, 0xc8, 0x7f, 0xbd, 0xec, 0x4c, 0x7d, 0xab, 0xb5, 0x17, 0x24, 0xe1, 0xf3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x80, 0x1f, 0x06, 0x04, 0x1a, 0x00, 0x00,
+	// 2851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x23, 0xc7,
+	0xf1, 0x07, 0x49, 0x49, 0x2b, 0x16, 0xa9, 0xd7, 0xe8, 0x45, 0xc9, 0x5e, 0x49, 0x3b, 0xbb, 0xb6,
+	0xe5, 0xf5, 0xdf, 0x92, 0x2d, 0xfb, 0x6f, 0x1b, 0x8e, 0x6d, 0x44, 0xd6, 0x63, 0xbd, 0xf6, 0xda,
+	0x4b, 0x8f, 0x76, 0xed, 0xc0, 0x48, 0x42, 0x34, 0x39, 0x25, 0x6a, 0xa0, 0xe1, 0xf4, 0x6c, 0x77,
+	0x8f, 0x24, 0xe6, 0x9c, 0x53, 0x0e, 0xb9, 0xe5, 0x96, 0x5b, 0x80, 0xe4, 0x1a, 0xe4, 0x98, 0x0f,
+	0xe2, 0x00, 0xf9, 0x22, 0x39, 0xe4, 0x12, 0xf4, 0x63, 0x86, 0x3d, 0xe4, 0x50, 0xab, 0x5d, 0x18,
+	0x41, 0x2e, 0xc2, 0xd4, 0xaf, 0xeb, 0xd9, 0x5d, 0x5d, 0x5d, 0xdd, 0x22, 0xac, 0x0a, 0xd2, 0x0e,
+	0x51, 0xf4, 0x48, 0x44, 0xba, 0xc8, 0x7c, 0x22, 0xc8, 0x4e, 0xcc, 0xa8, 0xa0, 0xce, 0xc2, 0xc8,
+	0xc0, 0x7a, 0xed, 0x59, 0x82, 0xac, 0xaf, 0xc7, 0xd7, 0x67, 0x05, 0x8d, 0xe9, 0x80, 0x7f, 0x7d,
+	0x99, 0x61, 0x1c, 0x06, 0x1d, 0x22, 0x02, 0x1a, 0x59, 0xf0, 0x4c, 0x48, 0xbb, 0x89, 0x08, 0x42,
+	0x43, 0xd6, 0x2f, 0x84, 0x08, 0x7a, 0xa8, 0x29, 0xf7, 0x0f, 0x15, 0x98, 0x7b, 0x22, 0xcd, 0x1c,
+	0xe2, 0x69, 0x10, 0x05, 0x52, 0xd4, 0x71, 0x60, 0x22, 0x22, 0x3d, 0x6c, 0x94, 0xb6, 0x4a, 0xdb,
+	0x55, 0x4f, 0x7d, 0x3b, 0x2b, 0x30, 0xc5, 0x3b, 0x67, 0xd8, 0x23, 0x8d, 0xb2, 0x42, 0x0d, 0xe5,
+	0x34, 0xe0, 0x56, 0x87, 0x86, 0x49, 0x2f, 0xe2, 0x8d, 0xca, 0x56, 0x65, 0xbb, 0xea, 0xa5, 0xa4,
+	0xb3, 0x03, 0x8b, 0x31, 0x0b, 0x7a, 0x84, 0xf5, 0x5b, 0xe7, 0xd8, 0x6f, 0xa5, 0x5c, 0x13, 0x8a,
+	0x6b, 0xc1, 0x0c, 0x7d, 0x85, 0xfd, 0x03, 0xc3, 0xef, 0xc0, 0x84, 0xe8, 0xc7, 0xd8, 0x98, 0xd4,
+	0x56, 0xe5, 0xb7, 0xb3, 0x09, 0x35, 0x19, 0x48, 0x2b, 0xc4, 0xa8, 0x2b, 0xce, 0x1a, 0x53, 0x5b,
+	0xa5, 0xed, 0x09, 0x0f, 0x24, 0xf4, 0x48, 0x21, 0xce, 0x2b, 0x50, 0x65, 0xf4, 0xb2, 0xd5, 0xa1,
+	0x49, 0x24, 0x1a, 0xb7, 0xd4, 0xf0, 0x34, 0xa3, 0x97, 0x07, 0x92, 0x76, 0xee, 0xc1, 0xd4, 0x69,
+	0x80, 0xa1, 0xcf, 0x1b, 0xd3, 0x5b, 0x95, 0xed, 0xda, 0x5e, 0x7d, 0x47, 0xcf, 0xde, 0xb1, 0x04,
+	0x3d, 0x33, 0xe6, 0x3c, 0x86, 0x85, 0x2e, 0x46, 0xc8, 0x88, 0x40, 0x3f, 0xf3, 0xb2, 0xaa, 0x04,
+	0xdc, 0x9d, 0xd1, 0xa5, 0x79, 0x90, 0xf2, 0x6a, 0xbf, 0xbd, 0xf9, 0x6e, 0x1e, 0xe0, 0xce, 0x01,
+	0xd4, 0x63, 0xc2, 0x84, 0x9a, 0xcb, 0x20, 0xea, 0x36, 0x60, 0xab, 0xb4, 0x5d, 0xdb, 0xdb, 0x2c,
+	0xd0, 0xd5, 0xb4, 0xd8, 0xbc, 0x9c, 0x90, 0xfb, 0x2b, 0x98, 0x1b, 0xb2, 0x54, 0xb8, 0x2c, 0x1b,
+	0x00, 0x78, 0x15, 0x33, 0xe4, 0x3c, 0xa0, 0x91, 0x59, 0x1a, 0x0b, 0x51, 0xcb, 0x26, 0x28, 0x43,
+	0xbf, 0x51, 0xd9, 0x2a, 0x6d, 0x4f, 0x7b, 0x86, 0x72, 0x7f, 0x5b, 0x82, 0xba, 0x6d, 0x5d, 0x32,
+	0xf6, 0x50, 0x9c, 0x51, 0xdf, 0xa8, 0x37, 0xd4, 0x73, 0x0d, 0x7c, 0x02, 0x90, 0xf9, 0xad, 0x53,
+	0xa0, 0xb6, 0xf7, 0xea, 0x75, 0xa1, 0x7a, 0x16, 0xbf, 0xfb, 0x6b, 0xa8, 0x66, 0x03, 0x85, 0xf1,
+	0x6d, 0x41, 0xcd, 0x47, 0xde, 0x61, 0x41, 0x2c, 0x06, 0xf6, 0x6d, 0x28, 0x9f, 0x01, 0x95, 0x7c,
+	0x06, 0xb8, 0x7f, 0x2e, 0xc1, 0xfc, 0x89, 0x4a, 0x54, 0x2b, 0xbd, 0xdf, 0x80, 0x39, 0xe9, 0x52,
+	0x9b, 0x70, 0x6c, 0x99, 0x9c, 0xd6, 0x26, 0x67, 0x53, 0x58, 0x8b, 0xc8, 0xcc, 0x50, 0x81, 0xb4,
+	0xfc, 0x4c, 0x98, 0x37, 0xca, 0x63, 0x33, 0x63, 0x68, 0x1b, 0x79, 0xf3, 0x22, 0x0f, 0x70, 0xb9,
+	0x59, 0x2e, 0x90, 0xa9, 0x99, 0xac, 0x28, 0x8b, 0x29, 0x29, 0x1d, 0x75, 0xb4, 0xd5, 0x83, 0x33,
+	0x12, 0x75, 0xd1, 0x43, 0x9e, 0x84, 0xc2, 0xf9, 0x02, 0x66, 0xda, 0x78, 0x4a, 0x59, 0xce, 0xd1,
+	0xda, 0xde, 0xdd, 0x02, 0xeb, 0xc3, 0x61, 0x7a, 0x75, 0x2d, 0x69, 0x62, 0x39, 0x86, 0x3a, 0x39,
+	0x15, 0xc8, 0x5a, 0xd6, 0x2e, 0xbe, 0xa1, 0xa2, 0x9a, 0x12, 0xd4, 0xb0, 0xfb, 0xaf, 0x12, 0xcc,
+	0x3e, 0xe5, 0xc8, 0x9a, 0xc8, 0x7a, 0x81, 0x4e, 0x01, 0x07, 0x26, 0xce, 0x28, 0x17, 0xe9, 0xba,
+	0xc9, 0x6f, 0x89, 0x25, 0x1c, 0x99, 0x59, 0x30, 0xf5, 0xed, 0xbc, 0x05, 0x0b, 0x31, 0xe1, 0xfc,
+	0x92, 0x32, 0xbf, 0xd5, 0x39, 0xc3, 0xce, 0x39, 0x4f, 0x7a, 0x66, 0xc5, 0xe6, 0xd3, 0x81, 0x03,
+	0x83, 0x3b, 0xdf, 0x02, 0xc4, 0x2c, 0xb8, 0x08, 0x42, 0xec, 0xa2, 0x2e, 0x1a, 0xb5, 0xbd, 0x77,
+	0x0b, 0xbc, 0xcd, 0xfb, 0xb2, 0xd3, 0xcc, 0x64, 0x8e, 0x22, 0xc1, 0xfa, 0x9e, 0xa5, 0x64, 0xfd,
+	0x53, 0x98, 0x1b, 0x1a, 0x76, 0xe6, 0xa1, 0x72, 0x8e, 0x7d, 0xe3, 0xb9, 0xfc, 0x74, 0x96, 0x60,
+	0xf2, 0x82, 0x84, 0x09, 0x1a, 0xcf, 0x35, 0xf1, 0x71, 0xf9, 0xa3, 0x92, 0xfb, 0x63, 0x09, 0xea,
+	0x87, 0xed, 0xe7, 0xc4, 0x3d, 0x0b, 0x65, 0xbf, 0x6d, 0x64, 0xcb, 0x7e, 0x3b, 0x9b, 0x87, 0x8a,
+	0x35, 0x0f, 0x8f, 0x0b, 0x42, 0xdb, 0x2d, 0x08, 0xed, 0xb0, 0xfd, 0xdf, 0x09, 0xec, 0x4f, 0x25,
+	0xa8, 0x0d, 0x2c, 0x71, 0xe7, 0x11, 0xcc, 0x4b, 0x3f, 0x5b, 0xf1, 0x00, 0x6b, 0x94, 0x94, 0x97,
+	0x77, 0x9e, 0xbb, 0x00, 0xde, 0x5c, 0x92, 0xa3, 0xb9, 0x73, 0x0c, 0xb3, 0x7e, 0x3b, 0xa7, 0x4b,
+	0xef, 0xa0, 0xcd, 0xe7, 0x44, 0xec, 0xcd, 0xf8, 0x16, 0xc5, 0xdd, 0x37, 0xa0, 0xd6, 0x94, 0x65,
+	0x12, 0x9f, 0x25, 0xc8, 0x85, 0xdc, 0x4a, 0x31, 0xe9, 0x87, 0x94, 0xa4, 0x05, 0x2b, 0x25, 0xdd,
+	0x6d, 0xa8, 0x6b, 0x46, 0x1e, 0xd3, 0x88, 0xe3, 0x35, 0x9c, 0xf7, 0xa1, 0x7e, 0x12, 0x22, 0xc6,
+	0xa9, 0xce, 0x75, 0x98, 0xf6, 0x13, 0xa6, 0x8e, 0x4f, 0xc5, 0x5a, 0xf1, 0x32, 0xda, 0x9d, 0x83,
+	0x19, 0xc3, 0xab, 0xd5, 0xba, 0xff, 0x28, 0x81, 0x73, 0x74, 0x85, 0x9d, 0x44, 0xe0, 0x17, 0x94,
+	0x9e, 0xa7, 0x3a, 0xc6, 0x14, 0xe9, 0x98, 0x30, 0xd2, 0x43, 0x81, 0x4c, 0x87, 0x5f, 0xf5, 0x2c,
+	0xc4, 0x69, 0x42, 0x15, 0xaf, 0x04, 0x23, 0x2d, 0x8c, 0x2e, 0x4c, 0x09, 0x7d, 0xaf, 0x60, 0x76,
+	0x46, 0xad, 0xed, 0x1c, 0x49, 0xb1, 0xa3, 0xe8, 0x42, 0xe7, 0xc4, 0x34, 0x1a, 0x72, 0xfd, 0x67,
+	0x30, 0x93, 0x1b, 0x7a, 0xa1, 0x7c, 0x38, 0x85, 0xc5, 0x9c, 0x29, 0x33, 0x8f, 0x9b, 0x50, 0xc3,
+	0xab, 0x40, 0xb4, 0xb8, 0x20, 0x22, 0xe1, 0x66, 0x82, 0x40, 0x42, 0x27, 0x0a, 0xd1, 0x67, 0x8d,
+	0x4f, 0x13, 0x91, 0xb5, 0x08, 0x8a, 0x32, 0x38, 0xb2, 0x74, 0x17, 0x18, 0xca, 0xbd, 0x80, 0xf9,
+	0x07, 0x28, 0x74, 0x5d, 0x49, 0xa7, 0x6f, 0x05, 0xa6, 0x54, 0xe0, 0x3a, 0xe3, 0xaa, 0x9e, 0xa1,
+	0x9c, 0xbb, 0x30, 0x13, 0x44, 0x9d, 0x30, 0xf1, 0xb1, 0x75, 0x11, 0xe0, 0x25, 0x57, 0x26, 0xa6,
+	0xbd, 0xba, 0x01, 0xbf, 0x93, 0x98, 0xf3, 0x1a, 0xcc, 0xe2, 0x95, 0x66, 0x32, 0x4a, 0x74, 0x4b,
+	0x32, 0x63, 0x50, 0x55, 0xa0, 0xb9, 0x8b, 0xb0, 0x60, 0xd9, 0x35, 0xd1, 0x35, 0x61, 0x41, 0x57,
+	0x46, 0xab, 0xd8, 0xbf, 0x48, 0xb5, 0x9d, 0xe7, 0x43, 0x88, 0xbb, 0x0a, 0xcb, 0x0f, 0x50, 0x58,
+	0x29, 0x6c, 0x62, 0x74, 0x7f, 0x80, 0x95, 0xe1, 0x01, 0xe3, 0xc4, 0xcf, 0xa1, 0x96, 0xdf, 0x74,
+	0xd2, 0xfc, 0x46, 0xd1, 0x69, 0x6a, 0x09, 0xdb, 0x22, 0xee, 0x12, 0x38, 0x27, 0x28, 0x3c, 0x24,
+	0xfe, 0xe3, 0x28, 0xec, 0xa7, 0x16, 0x97, 0x61, 0x31, 0x87, 0x9a, 0x14, 0x1e, 0xc0, 0xdf, 0xb3,
+	0x40, 0x60, 0xca, 0xbd, 0x02, 0x4b, 0x79, 0xd8, 0xb0, 0x7f, 0x09, 0x0b, 0xfa, 0x70, 0x7a, 0xd2,
+	0x8f, 0x53, 0x66, 0xe7, 0xff, 0xa1, 0xa6, 0xdd, 0x6b, 0xa9, 0xe6, 0x4d, 0xba, 0x3c, 0xbb, 0xb7,
+	0xb4, 0x93, 0x75, 0xa6, 0x6a, 0xce, 0x85, 0x92, 0x00, 0x91, 0x7d, 0x4b, 0x3f, 0x6d, 0x5d, 0x03,
+	0x87, 0x3c, 0x3c, 0x65, 0xc8, 0xcf, 0x64, 0x4a, 0xd9, 0x0e, 0xe5, 0x61, 0xc3, 0xbe, 0x0a, 0xcb,
+	0x5e, 0x12, 0x7d, 0x81, 0x24, 0x14, 0x67, 0xea, 0xe0, 0x48, 0x05, 0x1a, 0xb0, 0x32, 0x3c, 0x60,
+	0x44, 0xde, 0x87, 0xc6, 0xc3, 0x6e, 0x44, 0x19, 0xea, 0xc1, 0x23, 0xc6, 0x28, 0xcb, 0x95, 0x14,
+	0x21, 0x90, 0x45, 0x83, 0x42, 0xa1, 0x48, 0xf7, 0x15, 0x58, 0x2b, 0x90, 0x32, 0x2a, 0xdf, 0x94,
+	0x4e, 0xf3, 0xe0, 0x37, 0xf8, 0xe4, 0xaa, 0x49, 0x69, 0x68, 0x15, 0x02, 0x09, 0x9a, 0x7d, 0xa2,
+	0xbe, 0x75, 0x20, 0x36, 0xab, 0x51, 0xf1, 0xb1, 0x54, 0x21, 0x4b, 0x52, 0x7e, 0x33, 0xdc, 0x85,
+	0x99, 0x4b, 0x12, 0x88, 0x56, 0x4c, 0xf9, 0x20, 0x1f, 0xab, 0x5e, 0x5d, 0x82, 0x4d, 0x83, 0x69,
+	0x9d, 0xb6, 0xac, 0xd1, 0xb9, 0x07, 0x2b, 0x4d, 0x86, 0xa7, 0x61, 0xd0, 0x3d, 0x1b, 0xda, 0x63,
+	0xb2, 0x65, 0x57, 0x73, 0x9f, 0x6e, 0xb2, 0x94, 0x74, 0xbb, 0xb0, 0x3a, 0x22, 0x63, 0x52, 0xf3,
+	0x11, 0xcc, 0x6a, 0xae, 0x16, 0x53, 0xad, 0x49, 0x7a, 0x24, 0xbc, 0x36, 0x76, 0x73, 0xd8, 0x8d,
+	0x8c, 0x37, 0xd3, 0xb1, 0x28, 0xee, 0xfe, 0xbb, 0x04, 0xce, 0x7e, 0x1c, 0x87, 0xfd, 0xbc, 0x67,
+	0xf3, 0x50, 0xe1, 0xcf, 0xc2, 0xb4, 0x4a, 0xf1, 0x67, 0xa1, 0xac, 0x52, 0xa7, 0x94, 0x75, 0xd0,
+	0xec, 0x77, 0x4d, 0xc8, 0x4e, 0x82, 0x84, 0x21, 0xbd, 0x6c, 0x59, 0x17, 0x1e, 0xd3, 0xe0, 0xce,
+	0xab, 0x01, 0x6f, 0x80, 0x8f, 0xf6, 0x50, 0x13, 0x3f, 0x55, 0x0f, 0x35, 0xf9, 0x92, 0x3d, 0xd4,
+	0x5f, 0x4a, 0xb0, 0x98, 0x8b, 0xde, 0xcc, 0xf1, 0xff, 0x5e, 0xb7, 0x17, 0xc0, 0x6d, 0xe5, 0xe8,
+	0x21, 0x76, 0x42, 0x22, 0x4f, 0xc2, 0x0b, 0x1c, 0xb3, 0x62, 0x95, 0x74, 0xc5, 0x6e, 0x03, 0xe8,
+	0xb5, 0xf1, 0x19, 0x8d, 0xcd, 0xb2, 0x55, 0x15, 0x72, 0xc8, 0x68, 0xec, 0xac, 0xc2, 0x2d, 0x9f,
+	0xf5, 0x5b, 0x2c, 0x49, 0x17, 0x6c, 0xca, 0x67, 0x7d, 0x2f, 0x89, 0xdc, 0x8f, 0x61, 0x63, 0x9c,
+	0xa9, 0xc1, 0x41, 0x3e, 0x26, 0x6f, 0x17, 0x61, 0xe1, 0x11, 0xed, 0x9c, 0xeb, 0xfa, 0x9e, 0x16,
+	0x81, 0x25, 0x70, 0x6c, 0x70, 0x50, 0x62, 0x9e, 0x46, 0xe1, 0x08, 0xf3, 0x0a, 0x2c, 0xe5, 0x61,
+	0xc3, 0xfe, 0xd7, 0x32, 0x38, 0x8f, 0xa3, 0x30, 0x88, 0xf0, 0xf0, 0xf0, 0xd1, 0xd7, 0x41, 0x57,
+	0x77, 0x03, 0xaa, 0xad, 0x4b, 0x82, 0xb4, 0xa1, 0x50, 0xdf, 0x32, 0x55, 0xd5, 0xf4, 0xa6, 0x07,
+	0xaa, 0x22, 0xd2, 0x09, 0xaa, 0x0c, 0x52, 0x7a, 0x1d, 0xa6, 0xb9, 0x60, 0x44, 0x60, 0xb7, 0xaf,
+	0x52, 0xb1, 0xea, 0x65, 0xb4, 0x3e, 0x2a, 0xd5, 0xf1, 0x3a, 0x99, 0x1e, 0x95, 0x92, 0x92, 0x32,
+	0x31, 0xa3, 0x5d, 0x86, 0x9c, 0xab, 0x4b, 0x70, 0xc9, 0xcb, 0x68, 0x39, 0x2d, 0x3d, 0xe4, 0x9c,
+	0x74, 0x51, 0x5d, 0x80, 0xab, 0x5e, 0x4a, 0x4a, 0x29, 0x22, 0x04, 0xf6, 0x62, 0x21, 0x6f, 0xc0,
+	0xa5, 0xed, 0x49, 0x2f, 0xa3, 0xe5, 0x32, 0x71, 0x41, 0x98, 0xbc, 0xf3, 0x12, 0xd1, 0xa8, 0xaa,
+	0x22, 0x55, 0x35, 0xc8, 0xbe, 0x70, 0xee, 0x40, 0xbd, 0x43, 0x7b, 0x71, 0x88, 0x86, 0x01, 0x14,
+	0x43, 0x2d, 0xc3, 0xf6, 0x85, 0xf5, 0x22, 0x50, 0xb3, 0x5f, 0x04, 0xdc, 0x63, 0x58, 0x39, 0x49,
+	0xda, 0xbd, 0x40, 0x64, 0xf3, 0x36, 0x7e, 0x7b, 0xdb, 0x73, 0x51, 0xce, 0xcf, 0x85, 0xfb, 0x36,
+	0xac, 0x8e, 0xe8, 0x31, 0x99, 0x50, 0x30, 0xfd, 0xee, 0x7b, 0x70, 0xfb, 0x01, 0x8a, 0xd1, 0xb5,
+	0xe2, 0x56, 0x41, 0x1e, 0x11, 0xea, 0xc2, 0xc6, 0x38, 0x21, 0x63, 0xea, 0x08, 0xa0, 0x97, 0xa1,
+	0xd7, 0xd4, 0xbc, 0x51, 0x1d, 0x9e, 0x25, 0xe8, 0xfe, 0x1f, 0xac, 0x1c, 0x90, 0xa8, 0x83, 0xe1,
+	0xc8, 0xa4, 0x14, 0xb9, 0xb5, 0x06, 0xab, 0x23, 0xdc, 0x26, 0x21, 0xdf, 0x82, 0x65, 0x0f, 0x05,
+	0xeb, 0xdf, 0x48, 0x8f, 0x3c, 0x07, 0x87, 0x98, 0x8d, 0x9a, 0xbf, 0x95, 0xa0, 0x61, 0x9a, 0xbc,
+	0x63, 0x14, 0x9d, 0xb3, 0x7d, 0x7e, 0xd8, 0xce, 0x36, 0xf5, 0x12, 0x4c, 0xaa, 0x87, 0x12, 0xa5,
+	0xab, 0xee, 0x69, 0x42, 0xed, 0xdc, 0x76, 0x4b, 0x35, 0xb7, 0xa6, 0xbf, 0xf3, 0xdb, 0xdf, 0xc8,
+	0xf6, 0x76, 0x0d, 0xa6, 0x7b, 0xe4, 0xaa, 0xc5, 0xe8, 0x25, 0x37, 0xd7, 0xb9, 0x5b, 0x3d, 0x72,
+	0xe5, 0xd1, 0x4b, 0xae, 0xae, 0xda, 0x01, 0x57, 0x77, 0xe8, 0x76, 0x10, 0x85, 0xb4, 0xcb, 0x55,
+	0xca, 0x4f, 0x7b, 0xb3, 0x06, 0xfe, 0x5c, 0xa3, 0xf2, 0xa8, 0x63, 0xea, 0x14, 0xb3, 0x6b, 0xeb,
+	0xb4, 0x57, 0x67, 0xd6, 0xd1, 0xe6, 0x3e, 0x80, 0xb5, 0x02, 0x9f, 0xcd, 0x42, 0xdd, 0x87, 0x29,
+	0x7d, 0x32, 0x99, 0xaa, 0xe9, 0x98, 0xc7, 0x9e, 0x6f, 0xe5, 0x5f, 0x73, 0x0a, 0x19, 0x0e, 0xf7,
+	0xf7, 0x25, 0xb8, 0x9d, 0xd7, 0xb4, 0x1f, 0x86, 0xf2, 0x0a, 0xc5, 0x7f, 0xfa, 0x29, 0x18, 0x89,
+	0x6c, 0xa2, 0x20, 0xb2, 0x47, 0xb0, 0x31, 0xce, 0x9f, 0x97, 0x08, 0xef, 0xab, 0xe1, 0xb5, 0xdd,
+	0x8f, 0xe3, 0xeb, 0x03, 0xb3, 0xfd, 0x2f, 0xe7, 0xfc, 0x1f, 0x9d, 0x74, 0xa5, 0xec, 0x25, 0xbc,
+	0x92, 0xad, 0x69, 0x48, 0x2e, 0x50, 0xdf, 0x16, 0xd2, 0xc2, 0x7b, 0x0c, 0x8b, 0x39, 0xd4, 0x28,
+	0xde, 0xcd, 0x0a, 0xa1, 0x56, 0xbc, 0xba, 0x33, 0xfc, 0xb6, 0x69, 0x04, 0x0c, 0x9b, 0xec, 0x05,
+	0xbf, 0x26, 0x5c, 0x20, 0x4b, 0x1b, 0xa3, 0xd4, 0xc0, 0xfb, 0xb0, 0x32, 0x3c, 0x60, 0x6c, 0xc8,
+	0xa2, 0x9a, 0xef, 0xac, 0x32, 0x5a, 0x4a, 0x7d, 0x4f, 0x02, 0x71, 0x4c, 0x87, 0xf5, 0x5d, 0x2b,
+	0xb5, 0x06, 0xab, 0x23, 0x52, 0x66, 0xc3, 0x39, 0x30, 0x7f, 0x22, 0x68, 0xac, 0x62, 0x4d, 0x5d,
+	0x5b, 0x84, 0x05, 0x0b, 0x33, 0x8c, 0xbf, 0x80, 0xd5, 0x0c, 0xfc, 0x3a, 0x88, 0x82, 0x5e, 0xd2,
+	0xbb, 0x81, 0x69, 0x59, 0xb0, 0x55, 0xaf, 0x28, 0x82, 0x1e, 0xa6, 0x57, 0xb0, 0x8a, 0x57, 0x93,
+	0xd8, 0x13, 0x0d, 0xb9, 0x1f, 0x40, 0x63, 0x54, 0xf3, 0x0d, 0xe6, 0x42, 0xb9, 0x49, 0x98, 0xc8,
+	0xf9, 0x2e, 0x57, 0xd3, 0x02, 0x8d, 0xf3, 0xbf, 0x84, 0x57, 0x06, 0xe8, 0xd3, 0x48, 0x04, 0xe1,
+	0xbe, 0xec, 0x26, 0x7e, 0xa2, 0x00, 0x36, 0xe0, 0xd5, 0x62, 0xed, 0xc6, 0xfa, 0x21, 0xdc, 0xd1,
+	0xd7, 0x8d, 0xa3, 0x2b, 0x81, 0x2c, 0x22, 0xa1, 0xbc, 0xeb, 0xc4, 0x84, 0x61, 0x24, 0xd0, 0x4f,
+	0x7d, 0x50, 0xd7, 0x58, 0x3d, 0xdc, 0xca, 0xca, 0x25, 0xa4, 0xd0, 0x43, 0xdf, 0xbd, 0x07, 0xee,
+	0x75, 0x5a, 0x8c, 0xad, 0x2d, 0xd8, 0x18, 0xe6, 0x3a, 0x0a, 0xb1, 0x33, 0x30, 0xe4, 0xde, 0x81,
+	0xcd, 0xb1, 0x1c, 0x83, 0xa4, 0x78, 0x80, 0x3a, 0x9c, 0x6c, 0x43, 0xbc, 0x09, 0x0b, 0x16, 0x66,
+	0x96, 0x67, 0x09, 0x26, 0x89, 0xef, 0xb3, 0xb4, 0xf1, 0xd1, 0x84, 0x4c, 0x37, 0x0f, 0x39, 0x0a,
+	0xab, 0xdb, 0x4d, 0xb5, 0xac, 0x43, 0x63, 0x74, 0xc8, 0x58, 0xdd, 0x85, 0xd5, 0xef, 0x2c, 0x5c,
+	0xee, 0xee, 0xc2, 0xea, 0x50, 0x35, 0xd5, 0xc1, 0x3d, 0x86, 0xc6, 0xa8, 0xc0, 0x4b, 0xd5, 0xa5,
+	0xdb, 0xb6, 0x9e, 0xc1, 0x56, 0x49, 0xcd, 0xcf, 0x42, 0xd9, 0x2c, 0x49, 0xc5, 0x2b, 0x07, 0x7e,
+	0x2e, 0x5f, 0xca, 0x43, 0x59, 0xb9, 0x05, 0x1b, 0xe3, 0x94, 0x99, 0x38, 0xef, 0xe7, 0xdd, 0x6e,
+	0x92, 0x84, 0xe3, 0x18, 0x4b, 0xee, 0x87, 0xb0, 0x56, 0xc0, 0x7b, 0x83, 0xcd, 0xf1, 0x56, 0x5e,
+	0x50, 0x46, 0xdc, 0x1b, 0x6b, 0xe5, 0x55, 0x58, 0x2f, 0x62, 0x36, 0xfe, 0x7e, 0x0a, 0x9b, 0xf6,
+	0xe8, 0x01, 0x8d, 0xfb, 0x4d, 0xd3, 0xe4, 0x59, 0x1b, 0xe8, 0x92, 0xb2, 0xf3, 0xd3, 0x90, 0x5e,
+	0xa6, 0x9e, 0xa4, 0xb4, 0x3c, 0xd2, 0x17, 0x54, 0xc2, 0xd9, 0x82, 0x83, 0xae, 0xb4, 0x64, 0x77,
+	0xa5, 0x9b, 0x50, 0x93, 0xb5, 0xbe, 0xd5, 0xa1, 0x71, 0x80, 0xbe, 0xd9, 0x6b, 0x20, 0xa1, 0x03,
+	0x85, 0xc8, 0xf6, 0x50, 0x31, 0x08, 0x2a, 0x88, 0xee, 0x5e, 0x2b, 0x9e, 0x7c, 0x67, 0xe7, 0x4f,
+	0x24, 0xe0, 0xbc, 0x0e, 0x73, 0x6a, 0x38, 0x96, 0x57, 0x0c, 0xec, 0xd0, 0xc8, 0x57, 0xc7, 0x5a,
+	0xc9, 0x9b, 0x91, 0x70, 0x13, 0xd9, 0x89, 0x02, 0xa5, 0x1d, 0x14, 0xc4, 0xb0, 0xe8, 0xa6, 0x56,
+	0xbe, 0x19, 0x09, 0xa2, 0xc7, 0xb9, 0xfb, 0xbb, 0x52, 0x7e, 0x19, 0x4f, 0x04, 0x43, 0xd2, 0xcb,
+	0x45, 0x50, 0x90, 0x14, 0xd9, 0x1c, 0x94, 0xf3, 0x73, 0xe0, 0x7c, 0x02, 0x53, 0xd6, 0xcb, 0x4f,
+	0x6d, 0xef, 0xde, 0xb8, 0x67, 0xfa, 0xdc, 0xe4, 0x1a, 0x19, 0x97, 0xc2, 0xd6, 0xf8, 0x05, 0x30,
+	0xb9, 0xf0, 0x15, 0xdc, 0xe2, 0xca, 0xc7, 0xb4, 0x19, 0x2c, 0x7a, 0x94, 0xbe, 0x3e, 0x22, 0x2f,
+	0xd5, 0x20, 0x2b, 0xeb, 0xc3, 0x28, 0x10, 0xfa, 0x7c, 0x4a, 0xb7, 0xee, 0x3b, 0xe0, 0xd8, 0xe0,
+	0x0d, 0x72, 0xf0, 0xc7, 0x12, 0x6c, 0x34, 0x69, 0x9c, 0x84, 0xea, 0x71, 0x44, 0x97, 0xaa, 0x2f,
+	0x69, 0x22, 0x6b, 0x4e, 0x9a, 0x38, 0xaf, 0xc3, 0x9c, 0x2c, 0xac, 0xad, 0x0e, 0x43, 0xf5, 0x7f,
+	0xae, 0x28, 0x7d, 0xc0, 0x9b, 0x91, 0xf0, 0x81, 0x46, 0xbf, 0xe1, 0x72, 0xc1, 0x48, 0x47, 0x2a,
+	0xb5, 0xbb, 0x1c, 0xd0, 0x90, 0xea, 0x74, 0x3e, 0x82, 0x7a, 0x4f, 0x79, 0xd6, 0x22, 0x61, 0x40,
+	0x74, 0xb7, 0x53, 0xdb, 0x5b, 0x1e, 0x7e, 0xf0, 0xd9, 0x97, 0x83, 0x5e, 0x4d, 0xb3, 0x2a, 0xc2,
+	0x79, 0x17, 0x96, 0xac, 0x33, 0x7c, 0xf0, 0xa8, 0xa1, 0xef, 0x40, 0x8b, 0xd6, 0x58, 0xf6, 0xb6,
+	0x71, 0x07, 0x36, 0xc7, 0xc6, 0x65, 0x36, 0xcd, 0x1f, 0x4b, 0x30, 0x2f, 0xa7, 0xcb, 0x3e, 0x9c,
+	0x9c, 0xb7, 0x61, 0x4a, 0x73, 0x37, 0x4a, 0xd7, 0xb9, 0x67, 0x98, 0xc6, 0x7a, 0x56, 0x1e, 0xeb,
+	0x59, 0xd1, 0x7c, 0x56, 0x0a, 0xe6, 0x33, 0x5d, 0xe1, 0xfc, 0x29, 0xb9, 0x0c, 0x8b, 0x87, 0xd8,
+	0xa3, 0x02, 0xf3, 0x0b, 0xbf, 0x07, 0x4b, 0x79, 0xf8, 0x06, 0x4b, 0xbf, 0x06, 0xab, 0x4f, 0x23,
+	0x9f, 0x16, 0xa9, 0x5b, 0x87, 0xc6, 0xe8, 0xd0, 0xa0, 0xd4, 0x34, 0x19, 0x95, 0x03, 0xca, 0xb3,
+	0xef, 0xcf, 0x30, 0x3a, 0x20, 0x49, 0xf7, 0x4c, 0x3c, 0x8d, 0x6f, 0xd2, 0xe7, 0x7c, 0x06, 0x5b,
+	0xe3, 0xc5, 0x6f, 0xe6, 0xb5, 0x16, 0x24, 0xdc, 0xe8, 0xf1, 0x2d, 0xaf, 0x47, 0x87, 0x8c, 0xd7,
+	0x7f, 0x97, 0xff, 0xcd, 0xc3, 0xfc, 0x76, 0x79, 0xd1, 0xb5, 0x2e, 0x58, 0xb8, 0x72, 0xd1, 0x46,
+	0x18, 0x79, 0x7b, 0x9b, 0x18, 0x7d, 0x7b, 0x73, 0xee, 0xc3, 0x82, 0x7a, 0x90, 0x6a, 0xa9, 0x8b,
+	0x73, 0x8b, 0x4b, 0xc7, 0xcd, 0xb3, 0xc6, 0x9c, 0x1a, 0x18, 0xb4, 0x2b, 0xaa, 0x8b, 0xc2, 0xa1,
+	0x5d, 0xed, 0x3e, 0x1c, 0x44, 0xeb, 0xa1, 0xb9, 0x7d, 0xbf, 0x5c, 0x60, 0xf2, 0x8d, 0xb2, 0x40,
+	0x95, 0xb1, 0x73, 0x0f, 0x5c, 0xd9, 0xfa, 0x59, 0x65, 0x69, 0x3f, 0xf2, 0x65, 0x9b, 0x91, 0xeb,
+	0xc5, 0xbf, 0x83, 0xbb, 0xd7, 0x72, 0xbd, 0x6c, 0x6f, 0xbe, 0x0c, 0x8b, 0x76, 0xba, 0x58, 0xf9,
+	0x9e, 0x87, 0x6f, 0x90, 0x39, 0x09, 0xcc, 0x7c, 0x4e, 0x3a, 0xe7, 0x49, 0x96, 0xa6, 0x5b, 0x50,
+	0xeb, 0xd0, 0xa8, 0x93, 0x30, 0x86, 0x51, 0xa7, 0x6f, 0x8a, 0x9a, 0x0d, 0x49, 0x0e, 0xf5, 0xfc,
+	0xa4, 0xa7, 0xde, 0xbc, 0x48, 0xd9, 0x90, 0xe4, 0x08, 0xa2, 0x0e, 0xc3, 0x1e, 0x46, 0xe9, 0x69,
+	0x37, 0xed, 0xd9, 0x90, 0xfb, 0x01, 0xcc, 0xa6, 0x66, 0x8d, 0x93, 0xf7, 0x60, 0x12, 0x2f, 0x06,
+	0x8b, 0x33, 0xbb, 0x93, 0xfe, 0xc8, 0xe2, 0x48, 0xa2, 0x9e, 0x1e, 0x74, 0xff, 0x59, 0x52, 0x7d,
+	0x98, 0xa0, 0x0c, 0x8f, 0x19, 0xed, 0xe5, 0x5d, 0xff, 0x4c, 0x96, 0x1d, 0x35, 0xd6, 0x12, 0x54,
+	0xf5, 0xbd, 0x5c, 0x90, 0x5e, 0x6c, 0x34, 0xd6, 0x77, 0xcc, 0xef, 0x34, 0x64, 0xf7, 0xeb, 0x39,
+	0x86, 0xf3, 0x09, 0x7d, 0x92, 0xf2, 0x39, 0xf7, 0x60, 0xd6, 0x92, 0x8f, 0x29, 0x37, 0x05, 0xab,
+	0x9e, 0xf1, 0x36, 0xa9, 0xaa, 0xe8, 0x6d, 0x65, 0x56, 0x57, 0x74, 0xfd, 0x10, 0x05, 0x1a, 0x52,
+	0x15, 0xfd, 0x43, 0x98, 0x37, 0x0c, 0x03, 0x17, 0x26, 0x0a, 0x5c, 0x98, 0xd3, 0x5c, 0x99, 0x7d,
+	0x77, 0x1f, 0xd6, 0x0a, 0x62, 0x7b, 0x91, 0xf9, 0xf9, 0xfc, 0x9d, 0x1f, 0x76, 0x2e, 0x02, 0x81,
+	0x9c, 0xef, 0x04, 0x74, 0x57, 0x7f, 0xed, 0x76, 0xe9, 0xee, 0x85, 0xd8, 0x55, 0xbf, 0x4e, 0xd9,
+	0x1d, 0x39, 0x5a, 0xdb, 0x53, 0x6a, 0xe0, 0xbd, 0xff, 0x0c, 0x00, 0x42, 0xc4, 0x0a, 0x00, 0x35,
+	0x23, 0x00, 0x00,
 }
@@ -29,73 +29,83 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0xb1, 0x04, 0x95, 0x58, 0x1e, 0xbb, 0xaa, 0x28, 0x0a, 0x12, 0x4f, 0x6d, 0x78, 0x48,
-	0x51, 0xdc, 0x34, 0x94, 0xf7, 0x6e, 0x9a, 0xb4, 0x41, 0x8d, 0x30, 0x76, 0x43, 0x10, 0x48, 0x48,
-	0x1b, 0x7b, 0x62, 0x1f, 0x39, 0xef, 0x1e, 0xbb, 0x7b, 0x56, 0xf3, 0x0a, 0x09, 0x89, 0x57, 0x48,
-	0x7c, 0x11, 0xbe, 0x24, 0xba, 0x87, 0xdd, 0x9b, 0x3d, 0xcf, 0xad, 0xed, 0x77, 0x91, 0xff, 0xbf,
-	0x99, 0xd9, 0x87, 0x99, 0xd9, 0xc9, 0xb1, 0x1d, 0x2b, 0x2e, 0x53, 0xb0, 0x0b, 0x21, 0xc5, 0x0c,
-	0xb4, 0x01, 0xbd, 0x4c, 0x26, 0xb0, 0x9f, 0x69, 0x65, 0x15, 0xbf, 0x43, 0x69, 0x3b, 0x77, 0x83,
-	0x5f, 0xa7, 0xc2, 0x8a, 0x0a, 0x7f, 0xf4, 0xdf, 0x2e, 0x7b, 0xe7, 0x65, 0xa9, 0x9d, 0x55, 0x1a,
-	0x3f, 0x65, 0xaf, 0x0f, 0x13, 0x39, 0xe3, 0x1f, 0xef, 0xaf, 0xda, 0x14, 0xc2, 0x08, 0xfe, 0xc8,
-	0xc1, 0xd8, 0x9d, 0x4f, 0x3a, 0x75, 0x93, 0x29, 0x69, 0xe0, 0xf3, 0xd7, 0xf8, 0x0b, 0xf6, 0xc6,
-	0x38, 0x05, 0xc8, 0x38, 0xc5, 0x96, 0x8a, 0x73, 0xf6, 0x69, 0x37, 0xe0, 0xbd, 0xfd, 0xc6, 0xde,
-	0x3a, 0x7e, 0x05, 0x93, 0xdc, 0xc2, 0x73, 0xa5, 0xae, 0xf9, 0x2e, 0x61, 0x82, 0x74, 0xe7, 0xf9,
-	0x8b, 0x75, 0x98, 0xf7, 0xff, 0x33, 0x7b, 0xf3, 0x19, 0xd8, 0xf1, 0x64, 0x0e, 0x0b, 0xc1, 0xef,
-	0x11, 0x66, 0x5e, 0x75, 0xbe, 0xef, 0xc7, 0x21, 0xef, 0x79, 0xc6, 0xde, 0x7d, 0x06, 0x76, 0x08,
-	0x7a, 0x91, 0x18, 0x93, 0x28, 0x69, 0xf8, 0x57, 0xb4, 0x25, 0x42, 0x5c, 0x8c, 0xaf, 0x37, 0x20,
-	0xf1, 0x11, 0x8d, 0xc1, 0x8e, 0x40, 0x4c, 0x7f, 0x90, 0xe9, 0x0d, 0x79, 0x44, 0x48, 0x8f, 0x1d,
-	0x51, 0x80, 0x79, 0xff, 0x82, 0xbd, 0x5d, 0x0b, 0x17, 0x3a, 0xb1, 0xc0, 0x23, 0x96, 0x25, 0xe0,
-	0x22, 0x7c, 0xb9, 0x96, 0xf3, 0x21, 0x7e, 0x65, 0xec, 0x68, 0x2e, 0xe4, 0x0c, 0x5e, 0xde, 0x64,
-	0xc0, 0xa9, 0x13, 0x6e, 0x64, 0xe7, 0x7e, 0x77, 0x0d, 0x85, 0xd7, 0x3f, 0x82, 0x2b, 0x0d, 0x66,
-	0x3e, 0xb6, 0xa2, 0x63, 0xfd, 0x18, 0x88, 0xad, 0x3f, 0xe4, 0xf0, 0x5d, 0x8f, 0x72, 0xf9, 0x1c,
-	0x44, 0x6a, 0xe7, 0x47, 0x73, 0x98, 0x5c, 0x93, 0x77, 0x1d, 0x22, 0xb1, 0xbb, 0x6e, 0x93, 0x3e,
-	0x50, 0xc6, 0x6e, 0x9f, 0xce, 0xa4, 0xd2, 0x50, 0xc9, 0xc7, 0x5a, 0x2b, 0xcd, 0x1f, 0x10, 0x1e,
-	0x56, 0x28, 0x17, 0xee, 0x9b, 0xcd, 0xe0, 0xf0, 0xf4, 0x52, 0x25, 0xa6, 0x75, 0x8d, 0xd0, 0xa7,
-	0xd7, 0x00, 0xf1, 0xd3, 0xc3, 0x9c, 0x0f, 0xf1, 0x3b, 0x7b, 0x6f, 0xa8, 0xe1, 0x2a, 0x4d, 0x66,
-	0x73, 0x57, 0x89, 0xd4, 0xa1, 0xb4, 0x18, 0x17, 0x68, 0x6f, 0x13, 0x14, 0x17, 0xcb, 0x20, 0xcb,
-	0xd2, 0x9b, 0x3a, 0x0e, 0x95, 0x44, 0x48, 0x8f, 0x15, 0x4b, 0x80, 0xe1, 0x4c, 0x7e, 0xa1, 0x26,
-	0xd7, 0x65, 0x77, 0x35, 0x64, 0x26, 0x37, 0x72, 0x2c, 0x93, 0x31, 0x85, 0xef, 0xe2, 0x5c, 0xa6,
-	0x8d, 0x7b, 0x6a, 0x59, 0x18, 0x88, 0xdd, 0x45, 0xc8, 0xe1, 0x04, 0xab, 0x1b, 0xe5, 0x09, 0xd8,
-	0xc9, 0x7c, 0x60, 0x9e, 0x5e, 0x0a, 0x32, 0xc1, 0x56, 0xa8, 0x58, 0x82, 0x11, 0xb0, 0x8f, 0xf8,
-	0x27, 0xfb, 0x20, 0x94, 0x07, 0x69, 0x3a, 0xd4, 0xc9, 0xd2, 0xf0, 0x87, 0x6b, 0x3d, 0x39, 0xd4,
-	0xc5, 0x3e, 0xd8, 0xc2, 0xa2, 0x7b, 0xcb, 0x83, 0x2c, 0xdb, 0x60, 0xcb, 0x83, 0x2c, 0xdb, 0x7c,
-	0xcb, 0x25, 0x1c, 0x74, 0xec, 0x54, 0x2c, 0xa1, 0x68, 0x23, 0xb9, 0xa1, 0x3b, 0x76, 0xa3, 0x47,
-	0x3b, 0x36, 0xc6, 0x70, 0x3b, 0x3a, 0x13, 0xc6, 0x82, 0x1e, 0x2a, 0x93, 0xd8, 0x44, 0x49, 0xb2,
-	0x1d, 0x85, 0x48, 0xac, 0x1d, 0xb5, 0x49, 0x5c, 0xb9, 0x17, 0x22, 0xb1, 0x27, 0xaa, 0x89, 0x44,
-	0xd9, 0xb7, 0x98, 0x58, 0xe5, 0xae, 0xa0, 0xf8, 0xa5, 0x1e, 0x5b, 0x95, 0x95, 0x3b, 0x26, 0x5f,
-	0x6a, 0xaf, 0xc6, 0x5e, 0x6a, 0x04, 0x79, 0xcf, 0x0b, 0xf6, 0xbe, 0xff, 0xf9, 0x2c, 0x91, 0xc9,
-	0x22, 0x5f, 0xf0, 0xbd, 0x98, 0x6d, 0x0d, 0xb9, 0x38, 0x0f, 0x36, 0x62, 0x71, 0x8b, 0x18, 0x5b,
-	0xa1, 0x6d, 0xb5, 0x13, 0x7a, 0x91, 0x4e, 0x8e, 0xb5, 0x08, 0x4c, 0x79, 0xe7, 0x37, 0xec, 0x4e,
-	0xf3, 0xfb, 0xb9, 0xb4, 0x49, 0x3a, 0xb8, 0xb2, 0xa0, 0xf9, 0x7e, 0xd4, 0x41, 0x03, 0xba, 0x80,
-	0xfd, 0x8d, 0x79, 0x1f, 0xfa, 0x9f, 0x1e, 0xdb, 0xa9, 0xa6, 0xca, 0xe3, 0x57, 0x16, 0xb4, 0x14,
-	0x69, 0x31, 0x46, 0x64, 0x42, 0x83, 0xb4, 0x30, 0xe5, 0xdf, 0x12, 0x1e, 0xbb, 0x71, 0xb7, 0x8e,
-	0xc7, 0x5b, 0x5a, 0xf9, 0xd5, 0xfc, 0xd5, 0x63, 0x77, 0xdb, 0xe0, 0x71, 0x0a, 0x93, 0x62, 0x29,
-	0x07, 0x1b, 0x38, 0xad, 0x59, 0xb7, 0x8e, 0x47, 0xdb, 0x98, 0xb4, 0xa7, 0xcb, 0xe2, 0xc8, 0x4c,
-	0xe7, 0x74, 0x59, 0xaa, 0xeb, 0xa6, 0xcb, 0x1a, 0xc2, 0x39, 0xfb, 0xd3, 0x08, 0xb2, 0x34, 0x99,
-	0x88, 0xa2, 0x4e, 0x8a, 0x6e, 0x43, 0xe6, 0x6c, 0x1b, 0x8a, 0xe5, 0xec, 0x2a, 0x8b, 0x9b, 0x34,
-	0x56, 0x9b, 0x2a, 0x25, 0x9b, 0x34, 0x8d, 0xc6, 0x9a, 0x74, 0x97, 0x05, 0xde, 0xef, 0x08, 0x4c,
-	0x31, 0x3d, 0x7a, 0x8e, 0xdc, 0x6f, 0x1b, 0x8a, 0xed, 0x77, 0x95, 0xc5, 0x35, 0x7a, 0x2a, 0x13,
-	0x5b, 0x35, 0x3e, 0xb2, 0x46, 0x1b, 0x39, 0x56, 0xa3, 0x98, 0x0a, 0x52, 0x73, 0xa8, 0xb2, 0x3c,
-	0x2d, 0x87, 0xc8, 0x2a, 0x77, 0xbf, 0x57, 0x79, 0x91, 0x44, 0x64, 0x6a, 0x76, 0xb0, 0xb1, 0xd4,
-	0xec, 0x34, 0xc1, 0xa9, 0x59, 0x2c, 0xae, 0xbb, 0x9d, 0x7a, 0x35, 0x96, 0x9a, 0x08, 0xc2, 0x53,
-	0xca, 0x53, 0x58, 0x28, 0x0b, 0xf5, 0xe9, 0x51, 0xef, 0x16, 0x06, 0x62, 0x53, 0x4a, 0xc8, 0xe1,
-	0x6c, 0x38, 0x97, 0x53, 0x15, 0x84, 0xd9, 0x23, 0x87, 0x9c, 0x10, 0x8a, 0x65, 0xc3, 0x2a, 0xeb,
-	0xc3, 0xfd, 0xdd, 0x63, 0x1f, 0x0e, 0xb5, 0x2a, 0xb4, 0x72, 0xb3, 0x17, 0x73, 0x90, 0x47, 0x22,
-	0x9f, 0xcd, 0xed, 0x79, 0xc6, 0xc9, 0xe3, 0xef, 0x80, 0x5d, 0xfc, 0xc3, 0xad, 0x6c, 0x82, 0x87,
-	0xaa, 0x94, 0x85, 0xa9, 0xe9, 0x29, 0xfd, 0x50, 0xb5, 0xa0, 0xe8, 0x43, 0xb5, 0xc2, 0x06, 0x2f,
-	0x2e, 0xb8, 0x1a, 0xb8, 0x47, 0xff, 0x37, 0x17, 0x9e, 0xeb, 0xfd, 0x38, 0x84, 0x47, 0x2e, 0x17,
-	0x77, 0x04, 0xa6, 0x78, 0x56, 0x60, 0xca, 0x63, 0xab, 0xf3, 0x54, 0x6c, 0xe4, 0x22, 0x60, 0x1f,
-	0xf1, 0xdf, 0x1e, 0xfb, 0xa8, 0x78, 0x93, 0x51, 0xb9, 0x0f, 0xe4, 0xb4, 0xe8, 0xac, 0xd5, 0x0c,
-	0xf6, 0xb8, 0xe3, 0x0d, 0xef, 0xe0, 0xdd, 0x32, 0xbe, 0xdb, 0xd6, 0x0c, 0x57, 0x09, 0xbe, 0x71,
-	0xb2, 0x4a, 0x30, 0x10, 0xab, 0x92, 0x90, 0xf3, 0x21, 0x7e, 0x64, 0xb7, 0x9e, 0x88, 0xc9, 0x75,
-	0x9e, 0x71, 0xea, 0x4b, 0x4b, 0x25, 0x39, 0xb7, 0x9f, 0x45, 0x08, 0xe7, 0xf0, 0x61, 0x8f, 0x6b,
-	0x76, 0xbb, 0x38, 0x5d, 0xa5, 0xe1, 0x44, 0xab, 0x45, 0xed, 0xbd, 0xa3, 0xb7, 0x86, 0x54, 0xec,
-	0xe2, 0x08, 0xb8, 0x89, 0xf9, 0xe4, 0xf0, 0x97, 0x83, 0x65, 0x62, 0xc1, 0x98, 0xfd, 0x44, 0xf5,
-	0xab, 0xbf, 0xfa, 0x33, 0xd5, 0x5f, 0xda, 0x7e, 0xf9, 0x35, 0xab, 0x4f, 0x7d, 0xfb, 0xba, 0xbc,
-	0x55, 0x6a, 0x87, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xa2, 0x81, 0x89, 0x36, 0x13, 0x00,
-	0x00,
+	// 1207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xd9, 0x6f, 0x23, 0xc5,
+	0x13, 0xc7, 0x7f, 0x91, 0x7e, 0xac, 0x44, 0x73, 0xee, 0x68, 0xc5, 0xa2, 0x20, 0x71, 0xee, 0x72,
+	0x24, 0x6c, 0x9c, 0x83, 0xe5, 0xdd, 0x9b, 0x6b, 0x83, 0x12, 0xad, 0xb1, 0x13, 0x82, 0x40, 0x42,
+	0xea, 0x8c, 0x2b, 0x76, 0x93, 0xf1, 0xf4, 0xd0, 0xdd, 0x63, 0xc5, 0xbc, 0x20, 0x21, 0xf1, 0x84,
+	0xc4, 0xdf, 0xc4, 0x9f, 0x86, 0x66, 0x3c, 0xdd, 0x53, 0x3d, 0xae, 0x69, 0xdb, 0x6f, 0x51, 0xbe,
+	0x9f, 0xaa, 0xea, 0xa3, 0xaa, 0xba, 0xc6, 0x6c, 0xd3, 0xf0, 0x9b, 0x04, 0xcc, 0x84, 0xa7, 0x7c,
+	0x04, 0x4a, 0x83, 0x9a, 0x8a, 0x18, 0x76, 0x32, 0x25, 0x8d, 0x8c, 0x1e, 0x51, 0xda, 0xe6, 0x63,
+	0xef, 0xbf, 0x43, 0x6e, 0xf8, 0x1c, 0xdf, 0xff, 0xf7, 0x19, 0x7b, 0xeb, 0xb2, 0xd4, 0x2e, 0xe6,
+	0x5a, 0x74, 0xc6, 0xfe, 0xdf, 0x13, 0xe9, 0x28, 0xfa, 0x70, 0x67, 0xd1, 0xa6, 0x10, 0xfa, 0xf0,
+	0x5b, 0x0e, 0xda, 0x6c, 0x7e, 0xd4, 0xaa, 0xeb, 0x4c, 0xa6, 0x1a, 0x3e, 0xfd, 0x5f, 0x74, 0xce,
+	0x5e, 0x1b, 0x24, 0x00, 0x59, 0x44, 0xb1, 0xa5, 0x62, 0x9d, 0x7d, 0xdc, 0x0e, 0x38, 0x6f, 0xbf,
+	0xb0, 0x37, 0x8e, 0xef, 0x21, 0xce, 0x0d, 0xbc, 0x94, 0xf2, 0x2e, 0x7a, 0x4a, 0x98, 0x20, 0xdd,
+	0x7a, 0xfe, 0x7c, 0x19, 0xe6, 0xfc, 0xff, 0xc8, 0x5e, 0x3f, 0x05, 0x33, 0x88, 0xc7, 0x30, 0xe1,
+	0xd1, 0x67, 0x84, 0x99, 0x53, 0xad, 0xef, 0x27, 0x61, 0xc8, 0x79, 0x1e, 0xb1, 0xb7, 0x4f, 0xc1,
+	0xf4, 0x40, 0x4d, 0x84, 0xd6, 0x42, 0xa6, 0x3a, 0xfa, 0x92, 0xb6, 0x44, 0x88, 0x8d, 0xf1, 0xd5,
+	0x0a, 0x24, 0x3e, 0xa2, 0x01, 0x98, 0x3e, 0xf0, 0xe1, 0xab, 0x34, 0x99, 0x91, 0x47, 0x84, 0xf4,
+	0xd0, 0x11, 0x79, 0x98, 0xf3, 0xcf, 0xd9, 0x9b, 0x95, 0x70, 0xad, 0x84, 0x81, 0x28, 0x60, 0x59,
+	0x02, 0x36, 0xc2, 0x17, 0x4b, 0x39, 0x17, 0xe2, 0x67, 0xc6, 0x0e, 0xc7, 0x3c, 0x1d, 0xc1, 0xe5,
+	0x2c, 0x83, 0x88, 0x3a, 0xe1, 0x5a, 0xb6, 0xee, 0x9f, 0x2e, 0xa1, 0xf0, 0xfa, 0xfb, 0x70, 0xab,
+	0x40, 0x8f, 0x07, 0x86, 0xb7, 0xac, 0x1f, 0x03, 0xa1, 0xf5, 0xfb, 0x1c, 0xbe, 0xeb, 0x7e, 0x9e,
+	0xbe, 0x04, 0x9e, 0x98, 0xf1, 0xe1, 0x18, 0xe2, 0x3b, 0xf2, 0xae, 0x7d, 0x24, 0x74, 0xd7, 0x4d,
+	0xd2, 0x05, 0xca, 0xd8, 0xc3, 0xb3, 0x51, 0x2a, 0x15, 0xcc, 0xe5, 0x63, 0xa5, 0xa4, 0x8a, 0xb6,
+	0x09, 0x0f, 0x0b, 0x94, 0x0d, 0xf7, 0xf5, 0x6a, 0xb0, 0x7f, 0x7a, 0x5a, 0xfc, 0x0e, 0x97, 0xf7,
+	0x3d, 0x29, 0x93, 0x96, 0xd3, 0xab, 0x81, 0xf0, 0xe9, 0x61, 0xce, 0x0f, 0x91, 0x48, 0x3e, 0xac,
+	0xca, 0x90, 0x0e, 0x51, 0x03, 0xe1, 0x10, 0x98, 0x73, 0x21, 0x7e, 0x65, 0xef, 0xf4, 0x14, 0xdc,
+	0x26, 0x62, 0x34, 0xb6, 0xc5, 0x4e, 0x9d, 0x7b, 0x83, 0xb1, 0x81, 0xb6, 0x56, 0x41, 0x71, 0x3d,
+	0x76, 0xb3, 0x2c, 0x99, 0x55, 0x71, 0xa8, 0x3c, 0x45, 0x7a, 0xa8, 0x1e, 0x3d, 0xcc, 0xf9, 0xff,
+	0x83, 0xbd, 0x57, 0x0a, 0x47, 0x10, 0x27, 0x5c, 0x71, 0x23, 0xa6, 0x50, 0x85, 0xda, 0x6d, 0xf3,
+	0xb1, 0x80, 0xda, 0xa8, 0x7b, 0x6b, 0x58, 0xe0, 0x6a, 0x3d, 0x97, 0xf1, 0x5d, 0xf9, 0x82, 0x68,
+	0xb2, 0x5a, 0x6b, 0x39, 0x54, 0xad, 0x98, 0xc2, 0xc9, 0x70, 0x95, 0x26, 0xb5, 0x7b, 0xea, 0x5c,
+	0x30, 0x10, 0x4a, 0x06, 0x9f, 0xc3, 0xc9, 0x30, 0xc8, 0x6f, 0x26, 0xc2, 0xbc, 0x4a, 0x13, 0x91,
+	0xc2, 0xd1, 0xd1, 0x39, 0x99, 0x0c, 0x0d, 0x26, 0x94, 0x0c, 0x0b, 0x28, 0xbe, 0xac, 0x53, 0xa8,
+	0x95, 0x0b, 0x31, 0x2a, 0x0e, 0xb5, 0x78, 0x0d, 0x76, 0xe9, 0x1e, 0x4f, 0xa0, 0xa1, 0xcb, 0x6a,
+	0xb3, 0xc0, 0x9b, 0x3d, 0xe4, 0x69, 0x0c, 0x49, 0x78, 0xb3, 0x0d, 0x26, 0xb4, 0xd9, 0x05, 0xd4,
+	0x6b, 0x83, 0x60, 0xd4, 0xac, 0x0e, 0x45, 0xb6, 0x41, 0x0f, 0x09, 0xb6, 0xc1, 0x06, 0x89, 0xdb,
+	0x60, 0xf5, 0x9c, 0x9f, 0x80, 0x89, 0xc7, 0x5d, 0x7d, 0x74, 0xc3, 0xc9, 0x36, 0xb8, 0x40, 0x85,
+	0xda, 0x20, 0x01, 0xe3, 0x7b, 0xf4, 0xe5, 0x6e, 0x92, 0xf4, 0x94, 0x98, 0xd2, 0xf7, 0x48, 0xa3,
+	0xa1, 0x7b, 0x6c, 0xb3, 0x68, 0xdf, 0x72, 0x37, 0xcb, 0x56, 0xd8, 0x72, 0x37, 0xcb, 0x56, 0xdf,
+	0x72, 0x09, 0x7b, 0x73, 0x45, 0xc2, 0xa7, 0x30, 0x30, 0xdc, 0xe4, 0x9a, 0x9e, 0x2b, 0x6a, 0x3d,
+	0x38, 0x57, 0x60, 0x0c, 0x67, 0xcb, 0x05, 0xd7, 0x06, 0x54, 0x4f, 0x6a, 0x51, 0xa4, 0x2d, 0x99,
+	0x2d, 0x3e, 0x12, 0xca, 0x96, 0x26, 0x89, 0x4b, 0xe0, 0x9a, 0x0b, 0x73, 0x22, 0xeb, 0x48, 0x94,
+	0x7d, 0x83, 0x09, 0x95, 0xc0, 0x02, 0x8a, 0xe7, 0xc9, 0x81, 0x91, 0x59, 0xb9, 0x63, 0x72, 0x9e,
+	0x74, 0x6a, 0x68, 0x9e, 0x44, 0x90, 0xf3, 0x3c, 0x61, 0xef, 0xba, 0x7f, 0x5f, 0x88, 0x54, 0x4c,
+	0xf2, 0x49, 0xb4, 0x15, 0xb2, 0xad, 0x20, 0x1b, 0x67, 0x7b, 0x25, 0x16, 0x37, 0xf9, 0x81, 0xe1,
+	0xca, 0xcc, 0x77, 0x42, 0x2f, 0xd2, 0xca, 0xa1, 0x26, 0x8f, 0x29, 0xe7, 0x7c, 0xc6, 0x1e, 0xd5,
+	0xff, 0xbf, 0x4a, 0x8d, 0x48, 0xba, 0xb7, 0x06, 0x54, 0xb4, 0x13, 0x74, 0x50, 0x83, 0x36, 0x60,
+	0x67, 0x65, 0xde, 0x85, 0xfe, 0x7b, 0x83, 0x6d, 0xce, 0xbf, 0x7d, 0x8e, 0xef, 0x0d, 0xa8, 0x94,
+	0x27, 0xc5, 0xb0, 0x9b, 0x71, 0x05, 0xa9, 0x81, 0x61, 0xf4, 0x0d, 0xe1, 0xb1, 0x1d, 0xb7, 0xeb,
+	0x78, 0xbe, 0xa6, 0x95, 0x5b, 0xcd, 0x9f, 0x1b, 0xec, 0x71, 0x13, 0x3c, 0x4e, 0x20, 0x2e, 0x96,
+	0xb2, 0xb7, 0x82, 0xd3, 0x8a, 0xb5, 0xeb, 0xd8, 0x5f, 0xc7, 0xa4, 0xf9, 0x0d, 0x54, 0x1c, 0x99,
+	0x6e, 0xfd, 0x06, 0x2a, 0xd5, 0x65, 0xdf, 0x40, 0x15, 0x84, 0x73, 0xf6, 0x87, 0x3e, 0x64, 0x89,
+	0x88, 0xcb, 0x77, 0xa9, 0xe8, 0x36, 0x64, 0xce, 0x36, 0xa1, 0x50, 0xce, 0x2e, 0xb2, 0xb8, 0x49,
+	0x63, 0xb5, 0xae, 0x52, 0xb2, 0x49, 0xd3, 0x68, 0xa8, 0x49, 0xb7, 0x59, 0xe0, 0x26, 0x8d, 0x99,
+	0x1e, 0xcf, 0x35, 0x44, 0xcb, 0x36, 0x51, 0x52, 0xa1, 0x26, 0x4d, 0xc0, 0x2e, 0xa2, 0x66, 0x11,
+	0x96, 0xfb, 0xa0, 0xf3, 0x09, 0x44, 0xcb, 0xbc, 0xcc, 0x31, 0x1b, 0xf3, 0xd9, 0x8a, 0xb4, 0x0b,
+	0xfa, 0xd7, 0x06, 0x7b, 0x1f, 0x03, 0x87, 0x32, 0x9b, 0xf5, 0x94, 0x1c, 0x29, 0xd0, 0x3a, 0xda,
+	0x5f, 0xe2, 0x0d, 0xc3, 0x76, 0x05, 0x07, 0x6b, 0xd9, 0xe0, 0xf4, 0xea, 0x83, 0x06, 0x83, 0x48,
+	0x32, 0xbd, 0x9a, 0x50, 0x28, 0xbd, 0x16, 0x59, 0xdc, 0x12, 0xcf, 0x52, 0x61, 0xe6, 0xef, 0x0c,
+	0xd9, 0x12, 0x6b, 0x39, 0xd4, 0x12, 0x31, 0xe5, 0x75, 0x82, 0x9e, 0xcc, 0xf2, 0x84, 0x1b, 0xb0,
+	0xad, 0xe2, 0x3b, 0x99, 0x17, 0x35, 0x4b, 0x76, 0x82, 0x16, 0x36, 0xd4, 0x09, 0x5a, 0x4d, 0x70,
+	0x27, 0x28, 0x16, 0xd7, 0xfe, 0x7a, 0x39, 0x35, 0xd4, 0x09, 0x10, 0x84, 0xc7, 0xfa, 0x23, 0x98,
+	0x48, 0x03, 0xd5, 0xe9, 0x51, 0x63, 0x02, 0x06, 0x42, 0x63, 0xbd, 0xcf, 0xe1, 0x6c, 0xb8, 0x4a,
+	0x87, 0xd2, 0x0b, 0xb3, 0x45, 0x7e, 0x15, 0x0c, 0x25, 0x15, 0x6a, 0x7b, 0x25, 0xd6, 0x2b, 0x82,
+	0x9e, 0x92, 0x85, 0x56, 0x6e, 0xf6, 0x7a, 0x0c, 0xe9, 0x21, 0xcf, 0x47, 0x63, 0x73, 0x95, 0x91,
+	0x45, 0xd0, 0x06, 0x87, 0x8a, 0xa0, 0xdd, 0xc6, 0x9b, 0x0b, 0x4a, 0x99, 0xeb, 0x8a, 0x1e, 0xd2,
+	0x73, 0x41, 0x03, 0x0a, 0xce, 0x05, 0x0b, 0xac, 0x37, 0xe0, 0x80, 0xad, 0x01, 0x72, 0xc0, 0x81,
+	0x46, 0x09, 0x3c, 0x09, 0x43, 0xb8, 0x79, 0xda, 0xb8, 0x7d, 0xd0, 0x86, 0xab, 0x62, 0x27, 0xa1,
+	0xd5, 0x39, 0x2a, 0xd4, 0x3c, 0x09, 0xd8, 0x45, 0xfc, 0x67, 0x83, 0x7d, 0x50, 0x8c, 0x40, 0xa8,
+	0xdc, 0xbb, 0xe9, 0xb0, 0x78, 0xc8, 0xe6, 0x23, 0xef, 0xf3, 0x96, 0x91, 0xa9, 0x85, 0xb7, 0xcb,
+	0xf8, 0x76, 0x5d, 0x33, 0x5c, 0x25, 0xf8, 0xc6, 0xc9, 0x2a, 0xc1, 0x40, 0xa8, 0x4a, 0x7c, 0xce,
+	0x85, 0xf8, 0x9e, 0x3d, 0x78, 0xc1, 0xe3, 0xbb, 0x3c, 0x8b, 0xa8, 0x9f, 0x5f, 0xe7, 0x92, 0x75,
+	0xfb, 0x49, 0x80, 0xb0, 0x0e, 0x77, 0x37, 0x22, 0xc5, 0x1e, 0x16, 0xa7, 0x2b, 0x15, 0x9c, 0x28,
+	0x39, 0xa9, 0xbc, 0xb7, 0xf4, 0x56, 0x9f, 0x0a, 0x5d, 0x1c, 0x01, 0xd7, 0x31, 0x5f, 0x1c, 0xfc,
+	0xb4, 0x37, 0x15, 0x06, 0xb4, 0xde, 0x11, 0xb2, 0x33, 0xff, 0xab, 0x33, 0x92, 0x9d, 0xa9, 0xe9,
+	0x94, 0x3f, 0x71, 0x77, 0xa8, 0x1f, 0xc4, 0x6f, 0x1e, 0x94, 0xda, 0xc1, 0x7f, 0x03, 0x00, 0xdd,
+	0xf5, 0xee, 0x65, 0x4b, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -127,11 +137,26 @@ type TabletManagerClient interface {
 	RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error)
 	RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
+	// ResizeTxPool changes the size of the transaction pool at runtime.
+	ResizeTxPool(ctx context.Context, in *tabletmanagerdata.ResizeTxPoolRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResizeTxPoolResponse, error)
 	ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error)
 	PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(ctx context.Context, in *tabletmanagerdata.ApplySchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplySchemaResponse, error)
+	// ApplyDeclarativeSchema changes the schema of the tablet into the
+	// one created by a list of CREATE TABLE statements.
+	ApplyDeclarativeSchema(ctx context.Context, in *tabletmanagerdata.ApplyDeclarativeSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyDeclarativeSchemaResponse, error)
 	LockTables(ctx context.Context, in *tabletmanagerdata.LockTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.LockTablesResponse, error)
 	UnlockTables(ctx context.Context, in *tabletmanagerdata.UnlockTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.UnlockTablesResponse, error)
+	// SubmitOnlineDDL queues an ALTER TABLE to be run by gh-ost or
+	// pt-online-schema-change on the master.
+	SubmitOnlineDDL(ctx context.Context, in *tabletmanagerdata.SubmitOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SubmitOnlineDDLResponse, error)
+	// GetOnlineDDLMigrations returns the state and progress of the
+	// online DDL migrations.
+	GetOnlineDDLMigrations(ctx context.Context, in *tabletmanagerdata.GetOnlineDDLMigrationsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetOnlineDDLMigrationsResponse, error)
+	// CancelOnlineDDL cancels a queued or running online DDL migration.
+	CancelOnlineDDL(ctx context.Context, in *tabletmanagerdata.CancelOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CancelOnlineDDLResponse, error)
+	// RetryOnlineDDL queues a failed or cancelled online DDL migration again.
+	RetryOnlineDDL(ctx context.Context, in *tabletmanagerdata.RetryOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RetryOnlineDDLResponse, error)
 	ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
@@ -182,6 +207,13 @@ type TabletManagerClient interface {
 	// VReplication API
 	VReplicationExec(ctx context.Context, in *tabletmanagerdata.VReplicationExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(ctx context.Context, in *tabletmanagerdata.VReplicationWaitForPosRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VReplicationPause stops a stream between two transactions and
+	// returns its position. VReplicationResume restarts it.
+	VReplicationPause(ctx context.Context, in *tabletmanagerdata.VReplicationPauseRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationPauseResponse, error)
+	VReplicationResume(ctx context.Context, in *tabletmanagerdata.VReplicationResumeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationResumeResponse, error)
+	// VReplicationCopyProgress returns the progress of the copy phase
+	// of the vreplication streams.
+	VReplicationCopyProgress(ctx context.Context, in *tabletmanagerdata.VReplicationCopyProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationCopyProgressResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -321,6 +353,15 @@ func (c *tabletManagerClient) IgnoreHealthError(ctx context.Context, in *tabletm
 	return out, nil
 }
 
+func (c *tabletManagerClient) ResizeTxPool(ctx context.Context, in *tabletmanagerdata.ResizeTxPoolRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResizeTxPoolResponse, error) {
+	out := new(tabletmanagerdata.ResizeTxPoolResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResizeTxPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error) {
 	out := new(tabletmanagerdata.ReloadSchemaResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReloadSchema", in, out, opts...)
@@ -348,6 +389,15 @@ func (c *tabletManagerClient) ApplySchema(ctx context.Context, in *tabletmanager
 	return out, nil
 }
 
+func (c *tabletManagerClient) ApplyDeclarativeSchema(ctx context.Context, in *tabletmanagerdata.ApplyDeclarativeSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyDeclarativeSchemaResponse, error) {
+	out := new(tabletmanagerdata.ApplyDeclarativeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplyDeclarativeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) LockTables(ctx context.Context, in *tabletmanagerdata.LockTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.LockTablesResponse, error) {
 	out := new(tabletmanagerdata.LockTablesResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/LockTables", in, out, opts...)
@@ -366,6 +416,42 @@ func (c *tabletManagerClient) UnlockTables(ctx context.Context, in *tabletmanage
 	return out, nil
 }
 
+func (c *tabletManagerClient) SubmitOnlineDDL(ctx context.Context, in *tabletmanagerdata.SubmitOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SubmitOnlineDDLResponse, error) {
+	out := new(tabletmanagerdata.SubmitOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SubmitOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetOnlineDDLMigrations(ctx context.Context, in *tabletmanagerdata.GetOnlineDDLMigrationsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetOnlineDDLMigrationsResponse, error) {
+	out := new(tabletmanagerdata.GetOnlineDDLMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetOnlineDDLMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) CancelOnlineDDL(ctx context.Context, in *tabletmanagerdata.CancelOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CancelOnlineDDLResponse, error) {
+	out := new(tabletmanagerdata.CancelOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CancelOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RetryOnlineDDL(ctx context.Context, in *tabletmanagerdata.RetryOnlineDDLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RetryOnlineDDLResponse, error) {
+	out := new(tabletmanagerdata.RetryOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RetryOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error) {
 	out := new(tabletmanagerdata.ExecuteFetchAsDbaResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsDba", in, out, opts...)
@@ -501,6 +587,33 @@ func (c *tabletManagerClient) VReplicationWaitForPos(ctx context.Context, in *ta
 	return out, nil
 }
 
+func (c *tabletManagerClient) VReplicationPause(ctx context.Context, in *tabletmanagerdata.VReplicationPauseRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationPauseResponse, error) {
+	out := new(tabletmanagerdata.VReplicationPauseResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VReplicationPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) VReplicationResume(ctx context.Context, in *tabletmanagerdata.VReplicationResumeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationResumeResponse, error) {
+	out := new(tabletmanagerdata.VReplicationResumeResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VReplicationResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) VReplicationCopyProgress(ctx context.Context, in *tabletmanagerdata.VReplicationCopyProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationCopyProgressResponse, error) {
+	out := new(tabletmanagerdata.VReplicationCopyProgressResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VReplicationCopyProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	out := new(tabletmanagerdata.ResetReplicationResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetReplication", in, out, opts...)
@@ -692,11 +805,26 @@ type TabletManagerServer interface {
 	RefreshState(context.Context, *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error)
 	RunHealthCheck(context.Context, *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(context.Context, *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
+	// ResizeTxPool changes the size of the transaction pool at runtime.
+	ResizeTxPool(context.Context, *tabletmanagerdata.ResizeTxPoolRequest) (*tabletmanagerdata.ResizeTxPoolResponse, error)
 	ReloadSchema(context.Context, *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error)
 	PreflightSchema(context.Context, *tabletmanagerdata.PreflightSchemaRequest) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(context.Context, *tabletmanagerdata.ApplySchemaRequest) (*tabletmanagerdata.ApplySchemaResponse, error)
+	// ApplyDeclarativeSchema changes the schema of the tablet into the
+	// one created by a list of CREATE TABLE statements.
+	ApplyDeclarativeSchema(context.Context, *tabletmanagerdata.ApplyDeclarativeSchemaRequest) (*tabletmanagerdata.ApplyDeclarativeSchemaResponse, error)
 	LockTables(context.Context, *tabletmanagerdata.LockTablesRequest) (*tabletmanagerdata.LockTablesResponse, error)
 	UnlockTables(context.Context, *tabletmanagerdata.UnlockTablesRequest) (*tabletmanagerdata.UnlockTablesResponse, error)
+	// SubmitOnlineDDL queues an ALTER TABLE to be run by gh-ost or
+	// pt-online-schema-change on the master.
+	SubmitOnlineDDL(context.Context, *tabletmanagerdata.SubmitOnlineDDLRequest) (*tabletmanagerdata.SubmitOnlineDDLResponse, error)
+	// GetOnlineDDLMigrations returns the state and progress of the
+	// online DDL migrations.
+	GetOnlineDDLMigrations(context.Context, *tabletmanagerdata.GetOnlineDDLMigrationsRequest) (*tabletmanagerdata.GetOnlineDDLMigrationsResponse, error)
+	// CancelOnlineDDL cancels a queued or running online DDL migration.
+	CancelOnlineDDL(context.Context, *tabletmanagerdata.CancelOnlineDDLRequest) (*tabletmanagerdata.CancelOnlineDDLResponse, error)
+	// RetryOnlineDDL queues a failed or cancelled online DDL migration again.
+	RetryOnlineDDL(context.Context, *tabletmanagerdata.RetryOnlineDDLRequest) (*tabletmanagerdata.RetryOnlineDDLResponse, error)
 	ExecuteFetchAsDba(context.Context, *tabletmanagerdata.ExecuteFetchAsDbaRequest) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(context.Context, *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
@@ -747,6 +875,13 @@ type TabletManagerServer interface {
 	// VReplication API
 	VReplicationExec(context.Context, *tabletmanagerdata.VReplicationExecRequest) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(context.Context, *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VReplicationPause stops a stream between two transactions and
+	// returns its position. VReplicationResume restarts it.
+	VReplicationPause(context.Context, *tabletmanagerdata.VReplicationPauseRequest) (*tabletmanagerdata.VReplicationPauseResponse, error)
+	VReplicationResume(context.Context, *tabletmanagerdata.VReplicationResumeRequest) (*tabletmanagerdata.VReplicationResumeResponse, error)
+	// VReplicationCopyProgress returns the progress of the copy phase
+	// of the vreplication streams.
+	VReplicationCopyProgress(context.Context, *tabletmanagerdata.VReplicationCopyProgressRequest) (*tabletmanagerdata.VReplicationCopyProgressResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -816,6 +951,9 @@ func (*UnimplementedTabletManagerServer) RunHealthCheck(ctx context.Context, req
 func (*UnimplementedTabletManagerServer) IgnoreHealthError(ctx context.Context, req *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IgnoreHealthError not implemented")
 }
+func (*UnimplementedTabletManagerServer) ResizeTxPool(ctx context.Context, req *tabletmanagerdata.ResizeTxPoolRequest) (*tabletmanagerdata.ResizeTxPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeTxPool not implemented")
+}
 func (*UnimplementedTabletManagerServer) ReloadSchema(ctx context.Context, req *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadSchema not implemented")
 }
@@ -825,12 +963,27 @@ func (*UnimplementedTabletManagerServer) PreflightSchema(ctx context.Context, re
 func (*UnimplementedTabletManagerServer) ApplySchema(ctx context.Context, req *tabletmanagerdata.ApplySchemaRequest) (*tabletmanagerdata.ApplySchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplySchema not implemented")
 }
+func (*UnimplementedTabletManagerServer) ApplyDeclarativeSchema(ctx context.Context, req *tabletmanagerdata.ApplyDeclarativeSchemaRequest) (*tabletmanagerdata.ApplyDeclarativeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDeclarativeSchema not implemented")
+}
 func (*UnimplementedTabletManagerServer) LockTables(ctx context.Context, req *tabletmanagerdata.LockTablesRequest) (*tabletmanagerdata.LockTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockTables not implemented")
 }
 func (*UnimplementedTabletManagerServer) UnlockTables(ctx context.Context, req *tabletmanagerdata.UnlockTablesRequest) (*tabletmanagerdata.UnlockTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockTables not implemented")
 }
+func (*UnimplementedTabletManagerServer) SubmitOnlineDDL(ctx context.Context, req *tabletmanagerdata.SubmitOnlineDDLRequest) (*tabletmanagerdata.SubmitOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOnlineDDL not implemented")
+}
+func (*UnimplementedTabletManagerServer) GetOnlineDDLMigrations(ctx context.Context, req *tabletmanagerdata.GetOnlineDDLMigrationsRequest) (*tabletmanagerdata.GetOnlineDDLMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineDDLMigrations not implemented")
+}
+func (*UnimplementedTabletManagerServer) CancelOnlineDDL(ctx context.Context, req *tabletmanagerdata.CancelOnlineDDLRequest) (*tabletmanagerdata.CancelOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOnlineDDL not implemented")
+}
+func (*UnimplementedTabletManagerServer) RetryOnlineDDL(ctx context.Context, req *tabletmanagerdata.RetryOnlineDDLRequest) (*tabletmanagerdata.RetryOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOnlineDDL not implemented")
+}
 func (*UnimplementedTabletManagerServer) ExecuteFetchAsDba(ctx context.Context, req *tabletmanagerdata.ExecuteFetchAsDbaRequest) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteFetchAsDba not implemented")
 }
@@ -876,6 +1029,15 @@ func (*UnimplementedTabletManagerServer) VReplicationExec(ctx context.Context, r
 func (*UnimplementedTabletManagerServer) VReplicationWaitForPos(ctx context.Context, req *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VReplicationWaitForPos not implemented")
 }
+func (*UnimplementedTabletManagerServer) VReplicationPause(ctx context.Context, req *tabletmanagerdata.VReplicationPauseRequest) (*tabletmanagerdata.VReplicationPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VReplicationPause not implemented")
+}
+func (*UnimplementedTabletManagerServer) VReplicationResume(ctx context.Context, req *tabletmanagerdata.VReplicationResumeRequest) (*tabletmanagerdata.VReplicationResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VReplicationResume not implemented")
+}
+func (*UnimplementedTabletManagerServer) VReplicationCopyProgress(ctx context.Context, req *tabletmanagerdata.VReplicationCopyProgressRequest) (*tabletmanagerdata.VReplicationCopyProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VReplicationCopyProgress not implemented")
+}
 func (*UnimplementedTabletManagerServer) ResetReplication(ctx context.Context, req *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetReplication not implemented")
 }
@@ -1121,6 +1283,24 @@ func _TabletManager_IgnoreHealthError_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ResizeTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ResizeTxPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ResizeTxPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ResizeTxPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ResizeTxPool(ctx, req.(*tabletmanagerdata.ResizeTxPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ReloadSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ReloadSchemaRequest)
 	if err := dec(in); err != nil {
@@ -1175,6 +1355,24 @@ func _TabletManager_ApplySchema_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ApplyDeclarativeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ApplyDeclarativeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ApplyDeclarativeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ApplyDeclarativeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ApplyDeclarativeSchema(ctx, req.(*tabletmanagerdata.ApplyDeclarativeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_LockTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.LockTablesRequest)
 	if err := dec(in); err != nil {
@@ -1211,6 +1409,78 @@ func _TabletManager_UnlockTables_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_SubmitOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SubmitOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SubmitOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SubmitOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SubmitOnlineDDL(ctx, req.(*tabletmanagerdata.SubmitOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetOnlineDDLMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetOnlineDDLMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetOnlineDDLMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetOnlineDDLMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetOnlineDDLMigrations(ctx, req.(*tabletmanagerdata.GetOnlineDDLMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_CancelOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CancelOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CancelOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CancelOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CancelOnlineDDL(ctx, req.(*tabletmanagerdata.CancelOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RetryOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RetryOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RetryOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RetryOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RetryOnlineDDL(ctx, req.(*tabletmanagerdata.RetryOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ExecuteFetchAsDba_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ExecuteFetchAsDbaRequest)
 	if err := dec(in); err != nil {
@@ -1481,6 +1751,60 @@ func _TabletManager_VReplicationWaitForPos_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_VReplicationPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VReplicationPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VReplicationPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VReplicationPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VReplicationPause(ctx, req.(*tabletmanagerdata.VReplicationPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_VReplicationResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VReplicationResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VReplicationResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VReplicationResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VReplicationResume(ctx, req.(*tabletmanagerdata.VReplicationResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_VReplicationCopyProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VReplicationCopyProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VReplicationCopyProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VReplicationCopyProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VReplicationCopyProgress(ctx, req.(*tabletmanagerdata.VReplicationCopyProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ResetReplicationRequest)
 	if err := dec(in); err != nil {
@@ -1787,6 +2111,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IgnoreHealthError",
 			Handler:    _TabletManager_IgnoreHealthError_Handler,
 		},
+		{
+			MethodName: "ResizeTxPool",
+			Handler:    _TabletManager_ResizeTxPool_Handler,
+		},
 		{
 			MethodName: "ReloadSchema",
 			Handler:    _TabletManager_ReloadSchema_Handler,
@@ -1799,6 +2127,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplySchema",
 			Handler:    _TabletManager_ApplySchema_Handler,
 		},
+		{
+			MethodName: "ApplyDeclarativeSchema",
+			Handler:    _TabletManager_ApplyDeclarativeSchema_Handler,
+		},
 		{
 			MethodName: "LockTables",
 			Handler:    _TabletManager_LockTables_Handler,
@@ -1807,6 +2139,22 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockTables",
 			Handler:    _TabletManager_UnlockTables_Handler,
 		},
+		{
+			MethodName: "SubmitOnlineDDL",
+			Handler:    _TabletManager_SubmitOnlineDDL_Handler,
+		},
+		{
+			MethodName: "GetOnlineDDLMigrations",
+			Handler:    _TabletManager_GetOnlineDDLMigrations_Handler,
+		},
+		{
+			MethodName: "CancelOnlineDDL",
+			Handler:    _TabletManager_CancelOnlineDDL_Handler,
+		},
+		{
+			MethodName: "RetryOnlineDDL",
+			Handler:    _TabletManager_RetryOnlineDDL_Handler,
+		},
 		{
 			MethodName: "ExecuteFetchAsDba",
 			Handler:    _TabletManager_ExecuteFetchAsDba_Handler,
@@ -1867,6 +2215,18 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VReplicationWaitForPos",
 			Handler:    _TabletManager_VReplicationWaitForPos_Handler,
 		},
+		{
+			MethodName: "VReplicationPause",
+			Handler:    _TabletManager_VReplicationPause_Handler,
+		},
+		{
+			MethodName: "VReplicationResume",
+			Handler:    _TabletManager_VReplicationResume_Handler,
+		},
+		{
+			MethodName: "VReplicationCopyProgress",
+			Handler:    _TabletManager_VReplicationCopyProgress_Handler,
+		},
 		{
 			MethodName: "ResetReplication",
 			Handler:    _TabletManager_ResetReplication_Handler,
@@ -156,6 +156,49 @@ func (TabletType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_52c350cb619f972e, []int{2}
 }
 
+// State is the last completed step of the workflow.
+type ReshardWorkflow_State int32
+
+const (
+	// CREATED: the workflow is saved, the target shards
+	// may not exist or have a master yet.
+	ReshardWorkflow_CREATED ReshardWorkflow_State = 0
+	// COPYING: the vreplication streams are started on the target shards.
+	ReshardWorkflow_COPYING ReshardWorkflow_State = 1
+	// COPIED: the copy phase of the streams is done.
+	ReshardWorkflow_COPIED ReshardWorkflow_State = 2
+	// VERIFIED: VDiff found no difference between the source and
+	// target shards.
+	ReshardWorkflow_VERIFIED ReshardWorkflow_State = 3
+	// READS_SWITCHED: the rdonly and replica traffic is served by
+	// the target shards.
+	ReshardWorkflow_READS_SWITCHED ReshardWorkflow_State = 4
+)
+
+var ReshardWorkflow_State_name = map[int32]string{
+	0: "CREATED",
+	1: "COPYING",
+	2: "COPIED",
+	3: "VERIFIED",
+	4: "READS_SWITCHED",
+}
+
+var ReshardWorkflow_State_value = map[string]int32{
+	"CREATED":        0,
+	"COPYING":        1,
+	"COPIED":         2,
+	"VERIFIED":       3,
+	"READS_SWITCHED": 4,
+}
+
+func (x ReshardWorkflow_State) String() string {
+	return proto.EnumName(ReshardWorkflow_State_name, int32(x))
+}
+
+func (ReshardWorkflow_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{5, 0}
+}
+
 // KeyRange describes a range of sharding keys, when range-based
 // sharding is used.
 type KeyRange struct {
@@ -752,10 +795,14 @@ type Keyspace struct {
 	// snapshot_time (in UTC) is a property of snapshot
 	// keyspaces which tells us what point in time
 	// the snapshot is of
-	SnapshotTime         *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SnapshotTime *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	// ddl_strategy is how the tablets apply the schema changes of
+	// ApplySchema: "direct", "online" or "declarative".
+	// Empty means "direct".
+	DdlStrategy          string   `protobuf:"bytes,8,opt,name=ddl_strategy,json=ddlStrategy,proto3" json:"ddl_strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -825,6 +872,13 @@ func (m *Keyspace) GetSnapshotTime() *vttime.Time {
 	return nil
 }
 
+func (m *Keyspace) GetDdlStrategy() string {
+	if m != nil {
+		return m.DdlStrategy
+	}
+	return ""
+}
+
 // ServedFrom indicates a relationship between a TabletType and the
 // keyspace name that's serving it.
 type Keyspace_ServedFrom struct {
@@ -885,6 +939,199 @@ func (m *Keyspace_ServedFrom) GetKeyspace() string {
 	return ""
 }
 
+// ReshardWorkflow is the state of a resharding driven by the Reshard
+// command, so it can be resumed from its last completed step.
+// It is stored in the global cell, under the target keyspace.
+type ReshardWorkflow struct {
+	SourceShards   []string `protobuf:"bytes,1,rep,name=source_shards,json=sourceShards,proto3" json:"source_shards,omitempty"`
+	TargetShards   []string `protobuf:"bytes,2,rep,name=target_shards,json=targetShards,proto3" json:"target_shards,omitempty"`
+	SkipSchemaCopy bool     `protobuf:"varint,3,opt,name=skip_schema_copy,json=skipSchemaCopy,proto3" json:"skip_schema_copy,omitempty"`
+	Compression    string   `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	// skip_vdiff allows switching the traffic without running VDiff.
+	SkipVdiff bool                  `protobuf:"varint,5,opt,name=skip_vdiff,json=skipVdiff,proto3" json:"skip_vdiff,omitempty"`
+	State     ReshardWorkflow_State `protobuf:"varint,6,opt,name=state,proto3,enum=topodata.ReshardWorkflow_State" json:"state,omitempty"`
+	// last_error is the error which stopped the last step, if any.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// validation is the result of the last VDiff of the workflow, by
+	// table. SwitchWrites is refused while a table has differences.
+	Validation []*ReshardWorkflow_TableValidation `protobuf:"bytes,8,rep,name=validation,proto3" json:"validation,omitempty"`
+	// reads_switched lists the tablet types whose traffic is already
+	// switched to the target shards, while the reads are being switched.
+	ReadsSwitched        []TabletType `protobuf:"varint,9,rep,packed,name=reads_switched,json=readsSwitched,proto3,enum=topodata.TabletType" json:"reads_switched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReshardWorkflow) Reset()         { *m = ReshardWorkflow{} }
+func (m *ReshardWorkflow) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflow) ProtoMessage()    {}
+func (*ReshardWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{5}
+}
+
+func (m *ReshardWorkflow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflow.Unmarshal(m, b)
+}
+func (m *ReshardWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflow.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflow.Merge(m, src)
+}
+func (m *ReshardWorkflow) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflow.Size(m)
+}
+func (m *ReshardWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflow proto.InternalMessageInfo
+
+func (m *ReshardWorkflow) GetSourceShards() []string {
+	if m != nil {
+		return m.SourceShards
+	}
+	return nil
+}
+
+func (m *ReshardWorkflow) GetTargetShards() []string {
+	if m != nil {
+		return m.TargetShards
+	}
+	return nil
+}
+
+func (m *ReshardWorkflow) GetSkipSchemaCopy() bool {
+	if m != nil {
+		return m.SkipSchemaCopy
+	}
+	return false
+}
+
+func (m *ReshardWorkflow) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *ReshardWorkflow) GetSkipVdiff() bool {
+	if m != nil {
+		return m.SkipVdiff
+	}
+	return false
+}
+
+func (m *ReshardWorkflow) GetState() ReshardWorkflow_State {
+	if m != nil {
+		return m.State
+	}
+	return ReshardWorkflow_CREATED
+}
+
+func (m *ReshardWorkflow) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReshardWorkflow) GetValidation() []*ReshardWorkflow_TableValidation {
+	if m != nil {
+		return m.Validation
+	}
+	return nil
+}
+
+func (m *ReshardWorkflow) GetReadsSwitched() []TabletType {
+	if m != nil {
+		return m.ReadsSwitched
+	}
+	return nil
+}
+
+// TableValidation is the comparison of the rows of a table between
+// the source and target shards.
+type ReshardWorkflow_TableValidation struct {
+	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	ProcessedRows        int64    `protobuf:"varint,2,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	MatchingRows         int64    `protobuf:"varint,3,opt,name=matching_rows,json=matchingRows,proto3" json:"matching_rows,omitempty"`
+	MismatchedRows       int64    `protobuf:"varint,4,opt,name=mismatched_rows,json=mismatchedRows,proto3" json:"mismatched_rows,omitempty"`
+	ExtraRowsSource      int64    `protobuf:"varint,5,opt,name=extra_rows_source,json=extraRowsSource,proto3" json:"extra_rows_source,omitempty"`
+	ExtraRowsTarget      int64    `protobuf:"varint,6,opt,name=extra_rows_target,json=extraRowsTarget,proto3" json:"extra_rows_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReshardWorkflow_TableValidation) Reset()         { *m = ReshardWorkflow_TableValidation{} }
+func (m *ReshardWorkflow_TableValidation) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflow_TableValidation) ProtoMessage()    {}
+func (*ReshardWorkflow_TableValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{5, 0}
+}
+
+func (m *ReshardWorkflow_TableValidation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflow_TableValidation.Unmarshal(m, b)
+}
+func (m *ReshardWorkflow_TableValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflow_TableValidation.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflow_TableValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflow_TableValidation.Merge(m, src)
+}
+func (m *ReshardWorkflow_TableValidation) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflow_TableValidation.Size(m)
+}
+func (m *ReshardWorkflow_TableValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflow_TableValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflow_TableValidation proto.InternalMessageInfo
+
+func (m *ReshardWorkflow_TableValidation) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *ReshardWorkflow_TableValidation) GetProcessedRows() int64 {
+	if m != nil {
+		return m.ProcessedRows
+	}
+	return 0
+}
+
+func (m *ReshardWorkflow_TableValidation) GetMatchingRows() int64 {
+	if m != nil {
+		return m.MatchingRows
+	}
+	return 0
+}
+
+func (m *ReshardWorkflow_TableValidation) GetMismatchedRows() int64 {
+	if m != nil {
+		return m.MismatchedRows
+	}
+	return 0
+}
+
+func (m *ReshardWorkflow_TableValidation) GetExtraRowsSource() int64 {
+	if m != nil {
+		return m.ExtraRowsSource
+	}
+	return 0
+}
+
+func (m *ReshardWorkflow_TableValidation) GetExtraRowsTarget() int64 {
+	if m != nil {
+		return m.ExtraRowsTarget
+	}
+	return 0
+}
+
 // ShardReplication describes the MySQL replication relationships
 // whithin a cell.
 type ShardReplication struct {
@@ -900,7 +1147,7 @@ func (m *ShardReplication) Reset()         { *m = ShardReplication{} }
 func (m *ShardReplication) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication) ProtoMessage()    {}
 func (*ShardReplication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5}
+	return fileDescriptor_52c350cb619f972e, []int{6}
 }
 
 func (m *ShardReplication) XXX_Unmarshal(b []byte) error {
@@ -940,7 +1187,7 @@ func (m *ShardReplication_Node) Reset()         { *m = ShardReplication_Node{} }
 func (m *ShardReplication_Node) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication_Node) ProtoMessage()    {}
 func (*ShardReplication_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5, 0}
+	return fileDescriptor_52c350cb619f972e, []int{6, 0}
 }
 
 func (m *ShardReplication_Node) XXX_Unmarshal(b []byte) error {
@@ -982,7 +1229,7 @@ func (m *ShardReference) Reset()         { *m = ShardReference{} }
 func (m *ShardReference) String() string { return proto.CompactTextString(m) }
 func (*ShardReference) ProtoMessage()    {}
 func (*ShardReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{6}
+	return fileDescriptor_52c350cb619f972e, []int{7}
 }
 
 func (m *ShardReference) XXX_Unmarshal(b []byte) error {
@@ -1033,7 +1280,7 @@ func (m *ShardTabletControl) Reset()         { *m = ShardTabletControl{} }
 func (m *ShardTabletControl) String() string { return proto.CompactTextString(m) }
 func (*ShardTabletControl) ProtoMessage()    {}
 func (*ShardTabletControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{7}
+	return fileDescriptor_52c350cb619f972e, []int{8}
 }
 
 func (m *ShardTabletControl) XXX_Unmarshal(b []byte) error {
@@ -1092,7 +1339,7 @@ func (m *SrvKeyspace) Reset()         { *m = SrvKeyspace{} }
 func (m *SrvKeyspace) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace) ProtoMessage()    {}
 func (*SrvKeyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8}
+	return fileDescriptor_52c350cb619f972e, []int{9}
 }
 
 func (m *SrvKeyspace) XXX_Unmarshal(b []byte) error {
@@ -1157,7 +1404,7 @@ func (m *SrvKeyspace_KeyspacePartition) Reset()         { *m = SrvKeyspace_Keysp
 func (m *SrvKeyspace_KeyspacePartition) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_KeyspacePartition) ProtoMessage()    {}
 func (*SrvKeyspace_KeyspacePartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 0}
+	return fileDescriptor_52c350cb619f972e, []int{9, 0}
 }
 
 func (m *SrvKeyspace_KeyspacePartition) XXX_Unmarshal(b []byte) error {
@@ -1215,7 +1462,7 @@ func (m *SrvKeyspace_ServedFrom) Reset()         { *m = SrvKeyspace_ServedFrom{}
 func (m *SrvKeyspace_ServedFrom) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_ServedFrom) ProtoMessage()    {}
 func (*SrvKeyspace_ServedFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 1}
+	return fileDescriptor_52c350cb619f972e, []int{9, 1}
 }
 
 func (m *SrvKeyspace_ServedFrom) XXX_Unmarshal(b []byte) error {
@@ -1271,7 +1518,7 @@ func (m *CellInfo) Reset()         { *m = CellInfo{} }
 func (m *CellInfo) String() string { return proto.CompactTextString(m) }
 func (*CellInfo) ProtoMessage()    {}
 func (*CellInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{9}
+	return fileDescriptor_52c350cb619f972e, []int{10}
 }
 
 func (m *CellInfo) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1566,7 @@ func (m *CellsAlias) Reset()         { *m = CellsAlias{} }
 func (m *CellsAlias) String() string { return proto.CompactTextString(m) }
 func (*CellsAlias) ProtoMessage()    {}
 func (*CellsAlias) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{10}
+	return fileDescriptor_52c350cb619f972e, []int{11}
 }
 
 func (m *CellsAlias) XXX_Unmarshal(b []byte) error {
@@ -1351,6 +1598,7 @@ func init() {
 	proto.RegisterEnum("topodata.KeyspaceType", KeyspaceType_name, KeyspaceType_value)
 	proto.RegisterEnum("topodata.KeyspaceIdType", KeyspaceIdType_name, KeyspaceIdType_value)
 	proto.RegisterEnum("topodata.TabletType", TabletType_name, TabletType_value)
+	proto.RegisterEnum("topodata.ReshardWorkflow_State", ReshardWorkflow_State_name, ReshardWorkflow_State_value)
 	proto.RegisterType((*KeyRange)(nil), "topodata.KeyRange")
 	proto.RegisterType((*TabletAlias)(nil), "topodata.TabletAlias")
 	proto.RegisterType((*Tablet)(nil), "topodata.Tablet")
@@ -1362,6 +1610,8 @@ func init() {
 	proto.RegisterType((*Shard_TabletControl)(nil), "topodata.Shard.TabletControl")
 	proto.RegisterType((*Keyspace)(nil), "topodata.Keyspace")
 	proto.RegisterType((*Keyspace_ServedFrom)(nil), "topodata.Keyspace.ServedFrom")
+	proto.RegisterType((*ReshardWorkflow)(nil), "topodata.ReshardWorkflow")
+	proto.RegisterType((*ReshardWorkflow_TableValidation)(nil), "topodata.ReshardWorkflow.TableValidation")
 	proto.RegisterType((*ShardReplication)(nil), "topodata.ShardReplication")
 	proto.RegisterType((*ShardReplication_Node)(nil), "topodata.ShardReplication.Node")
 	proto.RegisterType((*ShardReference)(nil), "topodata.ShardReference")
@@ -1376,90 +1626,112 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
-	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0xaa, 0x35, 0x95, 0xd2, 0x1f, 0x33, 0x6d, 0xaf, 0xed, 0x82, 0x79, 0xc8, 0x66, 0xd4, 0x0b, 0x2e,
-	0x18, 0xd9, 0x80, 0xbc, 0x90, 0x1e, 0x97, 0x8e, 0xb1, 0x65, 0xd4, 0x2d, 0xaa, 0x15, 0x62, 0x43,
-	0x96, 0x05, 0x43, 0x27, 0x83, 0x36, 0x25, 0xd6, 0x1e, 0x41, 0xa9, 0xef, 0x9d, 0x8d, 0x98, 0x6c,
-	0x8e, 0x7c, 0x4f, 0x10, 0x02, 0xb9, 0x01, 0x1b, 0x8d, 0x30, 0xaa, 0x48, 0x51, 0x56, 0x41, 0x57,
-	0xbe, 0x0e, 0x2a, 0x53, 0x25, 0xd6, 0xfe, 0xce, 0x41, 0x41, 0x47, 0x91, 0x4f, 0x21, 0xef, 0xa9,
-	0x48, 0x8c, 0x28, 0xed, 0xde, 0x6f, 0x24, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
-	0x09, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x9a, 0xe8, 0x64, 0x0f, 0xcc, 0x49, 0xc8, 0xa5,
-	0x3b, 0xf6, 0x26, 0x4e, 0x6e, 0x2b, 0x5b, 0x2f, 0xed, 0x3e, 0x58, 0xce, 0xd5, 0xe8, 0x86, 0x5c,
-	0x1e, 0x7b, 0x93, 0x76, 0x20, 0xf9, 0x8c, 0xae, 0x4d, 0xb4, 0xa6, 0xb2, 0x5e, 0xb2, 0x99, 0x98,
-	0x78, 0x03, 0xe6, 0xe4, 0x75, 0xd6, 0x58, 0x47, 0x18, 0xde, 0x78, 0x7c, 0xe8, 0x14, 0xf0, 0x40,
-	0x2b, 0x64, 0x07, 0x8a, 0x97, 0x6c, 0xe6, 0x72, 0x85, 0x94, 0xb3, 0x86, 0x85, 0x93, 0xf9, 0xc7,
-	0x62, 0x0c, 0x31, 0x8d, 0x46, 0xb3, 0x0e, 0x39, 0x39, 0x9b, 0x30, 0xc7, 0xdc, 0x32, 0xea, 0x95,
-	0xdd, 0x8d, 0xe5, 0xc2, 0xfa, 0xb3, 0x09, 0xa3, 0xe8, 0x41, 0xea, 0x60, 0x0f, 0xcf, 0x5c, 0xd5,
-	0x91, 0x1b, 0x4e, 0x19, 0xe7, 0xfe, 0x90, 0x39, 0x45, 0xfc, 0x76, 0x65, 0x78, 0xd6, 0xf1, 0xc6,
-	0xec, 0x24, 0xb2, 0x92, 0x06, 0xe4, 0xa4, 0x77, 0x21, 0x1c, 0xc0, 0x66, 0xab, 0x2b, 0xcd, 0xf6,
-	0xbd, 0x0b, 0xa1, 0x3b, 0x45, 0x3f, 0xf2, 0x10, 0x2a, 0xe3, 0x99, 0x78, 0x3b, 0x72, 0x13, 0x08,
-	0x2d, 0xcc, 0x5b, 0x46, 0xeb, 0xcb, 0x18, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0xc1, 0xe3, 0x94, 0xb7,
-	0x8c, 0x7a, 0x9e, 0x16, 0xd1, 0xa2, 0xd0, 0x23, 0x4d, 0xd8, 0x1c, 0x7b, 0x42, 0x32, 0xee, 0x4a,
-	0xc6, 0xc7, 0x2e, 0xd2, 0xc2, 0x55, 0x1c, 0x72, 0x2a, 0x88, 0x83, 0xd5, 0x88, 0x28, 0xd5, 0xf7,
-	0xc7, 0x8c, 0xde, 0xd3, 0xbe, 0x7d, 0xc6, 0xc7, 0x3d, 0xe5, 0xa9, 0x8c, 0xd5, 0xa7, 0x60, 0xa5,
-	0x2f, 0x42, 0xf1, 0xe3, 0x92, 0xcd, 0x22, 0xca, 0x28, 0x51, 0xa1, 0x3e, 0xf5, 0x46, 0x57, 0xfa,
-	0x92, 0xf3, 0x54, 0x2b, 0x4f, 0x33, 0x7b, 0x46, 0xf5, 0x2b, 0x28, 0x26, 0x7d, 0xfd, 0x5b, 0x60,
-	0x31, 0x15, 0xf8, 0x2a, 0x67, 0x66, 0xed, 0xdc, 0xab, 0x9c, 0x59, 0xb2, 0xad, 0xda, 0xef, 0x05,
-	0xc8, 0xf7, 0xf0, 0x22, 0xf7, 0xc0, 0x8a, 0xba, 0xb9, 0x01, 0x09, 0x4b, 0xda, 0x55, 0x13, 0xfd,
-	0x7a, 0x1c, 0xcc, 0x1b, 0xe2, 0xb0, 0xc8, 0xa2, 0xcc, 0x0d, 0x58, 0xf4, 0x0d, 0x58, 0x82, 0xf1,
-	0x29, 0x1b, 0xba, 0x8a, 0x2a, 0xc2, 0xc9, 0x2e, 0xdf, 0x3c, 0x36, 0xd5, 0xe8, 0xa1, 0x0f, 0x72,
-	0xaa, 0x24, 0x12, 0x59, 0x90, 0x67, 0x50, 0x16, 0xe1, 0x15, 0x1f, 0x30, 0x17, 0x59, 0x2c, 0xa2,
-	0x31, 0xf9, 0xdf, 0x4a, 0x3c, 0x3a, 0xa1, 0x4c, 0x2d, 0x31, 0x57, 0x04, 0x79, 0x01, 0xeb, 0x12,
-	0x01, 0x71, 0x07, 0x61, 0x20, 0x79, 0x38, 0x12, 0x4e, 0x61, 0x79, 0xd4, 0x74, 0x0e, 0x8d, 0x5b,
-	0x4b, 0x7b, 0xd1, 0x8a, 0x4c, 0xab, 0x82, 0x6c, 0xc3, 0x5d, 0x5f, 0xb8, 0x11, 0x7e, 0xaa, 0x44,
-	0x3f, 0xb8, 0xc0, 0x39, 0x32, 0xe9, 0xba, 0x2f, 0x8e, 0xd1, 0xde, 0xd3, 0xe6, 0xea, 0x6b, 0x80,
-	0x79, 0x43, 0xe4, 0x09, 0x94, 0xa2, 0x0a, 0x70, 0x9e, 0x8c, 0xf7, 0xcc, 0x13, 0xc8, 0x44, 0x56,
-	0xbc, 0x50, 0xab, 0x48, 0x38, 0x99, 0xad, 0xac, 0xe2, 0x05, 0x2a, 0xd5, 0x5f, 0x0d, 0x28, 0xa5,
-	0x9a, 0x8d, 0x17, 0x95, 0x91, 0x2c, 0xaa, 0x85, 0xd5, 0x90, 0xb9, 0x6e, 0x35, 0x64, 0xaf, 0x5d,
-	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x48, 0xab, 0xfe,
-	0x66, 0x40, 0x79, 0x01, 0xc5, 0x5b, 0xed, 0x9d, 0x7c, 0x06, 0xe4, 0x6c, 0xe4, 0x0d, 0x2e, 0x47,
-	0xbe, 0x90, 0x8a, 0x50, 0xba, 0x84, 0x1c, 0xba, 0xdc, 0x4d, 0x9d, 0x60, 0x52, 0xa1, 0xaa, 0x3c,
-	0xe7, 0xe1, 0x8f, 0x2c, 0xc0, 0x0d, 0x69, 0xd2, 0x48, 0x4b, 0xc6, 0x2a, 0x6f, 0x17, 0x6a, 0x7f,
-	0x64, 0xf1, 0xfd, 0xd0, 0xe8, 0x7c, 0x0e, 0x1b, 0x08, 0x88, 0x1f, 0x5c, 0xb8, 0x83, 0x70, 0x74,
-	0x35, 0x0e, 0x70, 0xa9, 0x45, 0xc3, 0x4a, 0xe2, 0xb3, 0x16, 0x1e, 0xa9, 0xbd, 0x46, 0x5e, 0xad,
-	0x46, 0x60, 0x9f, 0x19, 0xec, 0xd3, 0x59, 0x00, 0x11, 0xbf, 0x71, 0xa0, 0x39, 0xbe, 0x94, 0x0b,
-	0x7b, 0x7e, 0x96, 0x4c, 0xca, 0x39, 0x0f, 0xc7, 0x62, 0xf5, 0x41, 0x88, 0x73, 0x44, 0xc3, 0xf2,
-	0x82, 0x87, 0xe3, 0x78, 0x58, 0x94, 0x2c, 0xc8, 0xd7, 0x50, 0x8e, 0x6f, 0x5a, 0x97, 0x91, 0xc7,
-	0x32, 0x36, 0x57, 0x53, 0x60, 0x11, 0xd6, 0x65, 0x4a, 0x23, 0x1f, 0x43, 0xf9, 0xcc, 0x13, 0xcc,
-	0x4d, 0xb8, 0xa3, 0x5f, 0x0f, 0x4b, 0x19, 0x13, 0x84, 0xbe, 0x80, 0xb2, 0x08, 0xbc, 0x89, 0x78,
-	0x13, 0x46, 0x8b, 0x63, 0xed, 0x1d, 0x8b, 0xc3, 0x8a, 0x5d, 0x70, 0x73, 0x5e, 0xc5, 0xb3, 0xa0,
-	0x6a, 0xbc, 0x5d, 0x3e, 0xa4, 0x99, 0x9e, 0x5d, 0x64, 0xba, 0xbe, 0xe4, 0xda, 0x4f, 0x06, 0xd8,
-	0x7a, 0x29, 0xb0, 0xc9, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
-	0x6a, 0x73, 0x2a, 0x84, 0x3f, 0x5a, 0xda, 0x03, 0x29, 0xd7, 0x46, 0x27, 0x1c, 0x32, 0xaa, 0xbd,
-	0xab, 0xcf, 0x20, 0xa7, 0x54, 0xb5, 0x7f, 0xa3, 0x16, 0x6e, 0xb2, 0x7f, 0xe5, 0x5c, 0xa9, 0x9d,
-	0x42, 0x25, 0xfa, 0xc2, 0x39, 0xe3, 0x2c, 0x18, 0x30, 0xf5, 0xd3, 0x23, 0xc5, 0x30, 0x94, 0x3f,
-	0x78, 0xc5, 0xd6, 0x7e, 0x36, 0x80, 0x60, 0xde, 0xc5, 0xd1, 0xbb, 0x8d, 0xdc, 0xe4, 0x31, 0x6c,
-	0xbe, 0xbd, 0x62, 0x7c, 0xa6, 0x37, 0xde, 0x80, 0xb9, 0x43, 0x5f, 0xa8, 0xaf, 0xe8, 0x0d, 0x62,
-	0xd2, 0x0d, 0x3c, 0xed, 0xe9, 0xc3, 0xfd, 0xe8, 0xac, 0xf6, 0x57, 0x0e, 0x4a, 0x3d, 0x3e, 0x4d,
-	0x68, 0xf3, 0x2d, 0xc0, 0xc4, 0xe3, 0xd2, 0x57, 0x98, 0xc6, 0xb0, 0x7f, 0x92, 0x82, 0x7d, 0xee,
-	0x9a, 0x30, 0xb4, 0x1b, 0xfb, 0xd3, 0x54, 0xe8, 0xb5, 0x13, 0x9a, 0xf9, 0xe0, 0x09, 0xcd, 0xfe,
-	0x87, 0x09, 0x6d, 0x42, 0x29, 0x35, 0xa1, 0xd1, 0x80, 0x6e, 0xbd, 0xbb, 0x8f, 0xd4, 0x8c, 0xc2,
-	0x7c, 0x46, 0xab, 0x7f, 0x1a, 0x70, 0x77, 0xa5, 0x45, 0x35, 0x15, 0xa9, 0x47, 0xf2, 0xfd, 0x53,
-	0x31, 0x7f, 0x1d, 0x49, 0x0b, 0x6c, 0xac, 0xd2, 0xe5, 0x31, 0xa1, 0xf4, 0x80, 0x94, 0xd2, 0x7d,
-	0x2d, 0x32, 0x8e, 0xae, 0x8b, 0x05, 0x5d, 0x90, 0x2e, 0xdc, 0xd7, 0x49, 0x96, 0x5f, 0x49, 0xfd,
-	0x52, 0xff, 0x7f, 0x29, 0xd3, 0xe2, 0x23, 0x79, 0x4f, 0xac, 0xd8, 0x44, 0xd5, 0xbd, 0x8d, 0x89,
-	0x7f, 0xcf, 0x2b, 0x16, 0xad, 0xee, 0x43, 0x30, 0x5b, 0x6c, 0x34, 0x3a, 0x08, 0xce, 0x43, 0xf5,
-	0x3b, 0x11, 0x71, 0xe1, 0xae, 0x37, 0x1c, 0x72, 0x26, 0x44, 0xc4, 0xfa, 0xb2, 0xb6, 0x36, 0xb5,
-	0x51, 0x8d, 0x04, 0x0f, 0x43, 0x19, 0x25, 0x44, 0x39, 0x5a, 0x14, 0x35, 0x00, 0x95, 0x4c, 0xe8,
-	0x1f, 0x4a, 0xef, 0x5c, 0x37, 0xdb, 0x75, 0xb0, 0xd2, 0xfb, 0x93, 0x00, 0x14, 0x3a, 0x27, 0xf4,
-	0xb8, 0x79, 0x64, 0xdf, 0x21, 0x16, 0x98, 0xbd, 0x4e, 0xb3, 0xdb, 0x7b, 0x79, 0xd2, 0xb7, 0x8d,
-	0xed, 0x5d, 0xa8, 0x2c, 0xd2, 0x89, 0x14, 0x21, 0x7f, 0xda, 0xe9, 0xb5, 0xfb, 0xf6, 0x1d, 0x15,
-	0x76, 0x7a, 0xd0, 0xe9, 0x7f, 0xf9, 0xd8, 0x36, 0x94, 0xf9, 0xf9, 0xeb, 0x7e, 0xbb, 0x67, 0x67,
-	0xb6, 0x7f, 0x31, 0x00, 0xe6, 0x58, 0x90, 0x12, 0xac, 0x9d, 0x76, 0x0e, 0x3b, 0x27, 0xdf, 0x75,
-	0x74, 0xc8, 0x71, 0xb3, 0xd7, 0x6f, 0x53, 0xdb, 0x50, 0x07, 0xb4, 0xdd, 0x3d, 0x3a, 0x68, 0x35,
-	0xed, 0x8c, 0x3a, 0xa0, 0xfb, 0x27, 0x9d, 0xa3, 0xd7, 0x76, 0x16, 0x73, 0x35, 0xfb, 0xad, 0x97,
-	0x5a, 0xec, 0x75, 0x9b, 0xb4, 0x6d, 0xe7, 0x88, 0x0d, 0x56, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x1c,
-	0xb7, 0x3b, 0xfd, 0xe6, 0x91, 0x9d, 0x57, 0x31, 0xcf, 0x9b, 0xad, 0xc3, 0xd3, 0xae, 0x5d, 0xd0,
-	0xc9, 0x7a, 0xfd, 0x13, 0xda, 0xb6, 0xd7, 0x94, 0xb2, 0x4f, 0x9b, 0x07, 0x9d, 0xf6, 0xbe, 0x6d,
-	0x56, 0x33, 0xb6, 0xf1, 0x7c, 0x0f, 0xd6, 0xfd, 0xb0, 0x31, 0xf5, 0x25, 0x13, 0x42, 0xff, 0xdd,
-	0xfa, 0xe1, 0x61, 0xa4, 0xf9, 0xe1, 0x8e, 0x96, 0x76, 0x2e, 0xc2, 0x9d, 0xa9, 0xdc, 0xc1, 0xd3,
-	0x9d, 0xf8, 0x52, 0xcf, 0x0a, 0xa8, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0x2b,
-	0xc1, 0xc6, 0x0d, 0x00, 0x00,
+	// 1711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0xdb, 0xc8,
+	0xf5, 0x0f, 0xf5, 0x65, 0xe9, 0x88, 0x92, 0x99, 0xd9, 0x6c, 0x40, 0xe8, 0xff, 0x5f, 0xd4, 0xd5,
+	0x62, 0xb1, 0xae, 0x8b, 0xca, 0xad, 0x77, 0xd3, 0x06, 0xbb, 0x28, 0x10, 0x45, 0x66, 0x36, 0x4a,
+	0x62, 0x49, 0x18, 0xca, 0x49, 0xd3, 0x1b, 0x82, 0x16, 0xc7, 0x36, 0x61, 0x4a, 0xa3, 0x9d, 0x19,
+	0x2b, 0x55, 0x5f, 0xa1, 0x17, 0xed, 0x75, 0xdf, 0xa0, 0xef, 0xd3, 0x17, 0x68, 0xaf, 0x7b, 0xd9,
+	0xcb, 0x02, 0x2d, 0xe6, 0x0c, 0x29, 0x51, 0x72, 0x9c, 0x7a, 0x8b, 0xdc, 0xcd, 0x39, 0xf3, 0x3b,
+	0x87, 0x73, 0xbe, 0x8f, 0x04, 0x4d, 0xc5, 0xe7, 0x3c, 0x0a, 0x55, 0xd8, 0x99, 0x0b, 0xae, 0x38,
+	0xa9, 0x66, 0x74, 0xcb, 0x5e, 0x28, 0x15, 0x4f, 0x99, 0xe1, 0xb7, 0x8f, 0xa0, 0xfa, 0x92, 0x2d,
+	0x69, 0x38, 0xbb, 0x60, 0xe4, 0x01, 0x94, 0xa5, 0x0a, 0x85, 0x72, 0xad, 0x3d, 0x6b, 0xdf, 0xa6,
+	0x86, 0x20, 0x0e, 0x14, 0xd9, 0x2c, 0x72, 0x0b, 0xc8, 0xd3, 0xc7, 0xf6, 0x57, 0x50, 0x1f, 0x87,
+	0x67, 0x09, 0x53, 0xdd, 0x24, 0x0e, 0x25, 0x21, 0x50, 0x9a, 0xb0, 0x24, 0x41, 0xa9, 0x1a, 0xc5,
+	0xb3, 0x16, 0xba, 0x8e, 0x8d, 0x50, 0x83, 0xea, 0x63, 0xfb, 0x5f, 0x25, 0xa8, 0x18, 0x29, 0xf2,
+	0x53, 0x28, 0x87, 0x5a, 0x12, 0x25, 0xea, 0x47, 0x9f, 0x76, 0x56, 0x6f, 0xcd, 0xa9, 0xa5, 0x06,
+	0x43, 0x5a, 0x50, 0xbd, 0xe4, 0x52, 0xcd, 0xc2, 0x29, 0x43, 0x75, 0x35, 0xba, 0xa2, 0xc9, 0x63,
+	0xa8, 0xce, 0xb9, 0x50, 0xc1, 0x34, 0x9c, 0xbb, 0xa5, 0xbd, 0xe2, 0x7e, 0xfd, 0xe8, 0xb3, 0x6d,
+	0x5d, 0x9d, 0x11, 0x17, 0xea, 0x24, 0x9c, 0x7b, 0x33, 0x25, 0x96, 0x74, 0x67, 0x6e, 0x28, 0xad,
+	0xf5, 0x8a, 0x2d, 0xe5, 0x3c, 0x9c, 0x30, 0xb7, 0x6c, 0xb4, 0x66, 0x34, 0xba, 0xe1, 0x32, 0x14,
+	0x91, 0x5b, 0xc1, 0x0b, 0x43, 0x90, 0x43, 0xa8, 0x5d, 0xb1, 0x65, 0x20, 0xb4, 0xa7, 0xdc, 0x1d,
+	0x7c, 0x38, 0x59, 0x7f, 0x2c, 0xf3, 0x21, 0xaa, 0xc1, 0x13, 0xd9, 0x87, 0x92, 0x5a, 0xce, 0x99,
+	0x5b, 0xdd, 0xb3, 0xf6, 0x9b, 0x47, 0x0f, 0xb6, 0x1f, 0x36, 0x5e, 0xce, 0x19, 0x45, 0x04, 0xd9,
+	0x07, 0x27, 0x3a, 0x0b, 0xb4, 0x45, 0x01, 0x5f, 0x30, 0x21, 0xe2, 0x88, 0xb9, 0x35, 0xfc, 0x76,
+	0x33, 0x3a, 0x1b, 0x84, 0x53, 0x36, 0x4c, 0xb9, 0xa4, 0x03, 0x25, 0x15, 0x5e, 0x48, 0x17, 0xd0,
+	0xd8, 0xd6, 0x0d, 0x63, 0xc7, 0xe1, 0x85, 0x34, 0x96, 0x22, 0x8e, 0x7c, 0x01, 0xcd, 0xe9, 0x52,
+	0x7e, 0x9f, 0x04, 0x2b, 0x17, 0xda, 0xa8, 0xb7, 0x81, 0xdc, 0xe7, 0x99, 0x1f, 0x3f, 0x03, 0x30,
+	0x30, 0xed, 0x1e, 0xb7, 0xb1, 0x67, 0xed, 0x97, 0x69, 0x0d, 0x39, 0xda, 0x7b, 0xa4, 0x0b, 0x0f,
+	0xa7, 0xa1, 0x54, 0x4c, 0x04, 0x8a, 0x89, 0x69, 0x80, 0x69, 0x11, 0xe8, 0x1c, 0x72, 0x9b, 0xe8,
+	0x07, 0xbb, 0x93, 0xa6, 0xd4, 0x38, 0x9e, 0x32, 0xfa, 0x89, 0xc1, 0x8e, 0x99, 0x98, 0xfa, 0x1a,
+	0xa9, 0x99, 0xad, 0x6f, 0xc0, 0xce, 0x07, 0x42, 0xe7, 0xc7, 0x15, 0x5b, 0xa6, 0x29, 0xa3, 0x8f,
+	0xda, 0xeb, 0x8b, 0x30, 0xb9, 0x36, 0x41, 0x2e, 0x53, 0x43, 0x7c, 0x53, 0x78, 0x6c, 0xb5, 0x7e,
+	0x05, 0xb5, 0x95, 0x5d, 0xff, 0x4d, 0xb0, 0x96, 0x13, 0x7c, 0x51, 0xaa, 0x16, 0x9d, 0xd2, 0x8b,
+	0x52, 0xb5, 0xee, 0xd8, 0xed, 0xbf, 0x56, 0xa0, 0xec, 0x63, 0x20, 0x1f, 0x83, 0x9d, 0x5a, 0x73,
+	0x87, 0x24, 0xac, 0x1b, 0x28, 0x12, 0x1f, 0xf0, 0x43, 0xf5, 0x8e, 0x7e, 0xd8, 0xcc, 0xa2, 0xc2,
+	0x1d, 0xb2, 0xe8, 0xd7, 0x60, 0x4b, 0x26, 0x16, 0x2c, 0x0a, 0x74, 0xaa, 0x48, 0xb7, 0xb8, 0x1d,
+	0x79, 0x34, 0xaa, 0xe3, 0x23, 0x06, 0x73, 0xaa, 0x2e, 0x57, 0x67, 0x49, 0x9e, 0x40, 0x43, 0xf2,
+	0x6b, 0x31, 0x61, 0x01, 0x66, 0xb1, 0x4c, 0xcb, 0xe4, 0xff, 0x6e, 0xc8, 0x23, 0x08, 0xcf, 0xd4,
+	0x96, 0x6b, 0x42, 0x92, 0x67, 0xb0, 0xab, 0xd0, 0x21, 0xc1, 0x84, 0xcf, 0x94, 0xe0, 0x89, 0x74,
+	0x2b, 0xdb, 0xa5, 0x66, 0x74, 0x18, 0xbf, 0xf5, 0x0c, 0x8a, 0x36, 0x55, 0x9e, 0x94, 0xe4, 0x00,
+	0xee, 0xc7, 0x32, 0x48, 0xfd, 0xa7, 0x9f, 0x18, 0xcf, 0x2e, 0xb0, 0x8e, 0xaa, 0x74, 0x37, 0x96,
+	0x27, 0xc8, 0xf7, 0x0d, 0xbb, 0xf5, 0x16, 0x60, 0x6d, 0x10, 0x79, 0x04, 0xf5, 0xf4, 0x05, 0x58,
+	0x4f, 0xd6, 0x07, 0xea, 0x09, 0xd4, 0xea, 0xac, 0xf3, 0x42, 0xb7, 0x22, 0xe9, 0x16, 0xf6, 0x8a,
+	0x3a, 0x2f, 0x90, 0x68, 0xfd, 0xd9, 0x82, 0x7a, 0xce, 0xd8, 0xac, 0x51, 0x59, 0xab, 0x46, 0xb5,
+	0xd1, 0x1a, 0x0a, 0xb7, 0xb5, 0x86, 0xe2, 0xad, 0xad, 0xa1, 0x74, 0x87, 0xa0, 0x3e, 0x84, 0x0a,
+	0x3e, 0x54, 0xba, 0x65, 0x7c, 0x5b, 0x4a, 0xb5, 0xfe, 0x62, 0x41, 0x63, 0xc3, 0x8b, 0x1f, 0xd5,
+	0x76, 0xf2, 0x33, 0x20, 0x67, 0x49, 0x38, 0xb9, 0x4a, 0x62, 0xa9, 0x74, 0x42, 0x99, 0x27, 0x94,
+	0x10, 0x72, 0x3f, 0x77, 0x83, 0x4a, 0xa5, 0x7e, 0xe5, 0xb9, 0xe0, 0xbf, 0x67, 0x33, 0xec, 0x90,
+	0x55, 0x9a, 0x52, 0xab, 0xb2, 0x2a, 0x3b, 0x95, 0xf6, 0xbf, 0x8b, 0x38, 0x3f, 0x8c, 0x77, 0x7e,
+	0x0e, 0x0f, 0xd0, 0x21, 0xf1, 0xec, 0x22, 0x98, 0xf0, 0xe4, 0x7a, 0x3a, 0xc3, 0xa6, 0x96, 0x16,
+	0x2b, 0xc9, 0xee, 0x7a, 0x78, 0xa5, 0xfb, 0x1a, 0x79, 0x71, 0x53, 0x02, 0xed, 0x2c, 0xa0, 0x9d,
+	0xee, 0x86, 0x13, 0xf1, 0x1b, 0x7d, 0x93, 0xe3, 0x5b, 0xba, 0xd0, 0xe6, 0x27, 0xab, 0x4a, 0x39,
+	0x17, 0x7c, 0x2a, 0x6f, 0x0e, 0x84, 0x4c, 0x47, 0x5a, 0x2c, 0xcf, 0x04, 0x9f, 0x66, 0xc5, 0xa2,
+	0xcf, 0x92, 0x7c, 0x0b, 0x8d, 0x2c, 0xd2, 0xe6, 0x19, 0x65, 0x7c, 0xc6, 0xc3, 0x9b, 0x2a, 0xf0,
+	0x11, 0xf6, 0x55, 0x8e, 0x22, 0x9f, 0x43, 0xe3, 0x2c, 0x94, 0x2c, 0x58, 0xe5, 0x8e, 0x99, 0x1e,
+	0xb6, 0x66, 0xae, 0x3c, 0xf4, 0x0b, 0x68, 0xc8, 0x59, 0x38, 0x97, 0x97, 0x3c, 0x6d, 0x1c, 0x3b,
+	0xef, 0x69, 0x1c, 0x76, 0x06, 0xd1, 0x14, 0xf9, 0x31, 0xd8, 0x51, 0x94, 0x04, 0x52, 0x89, 0x50,
+	0xb1, 0x8b, 0x25, 0xb6, 0x9a, 0x1a, 0xad, 0x47, 0x51, 0xe2, 0xa7, 0xac, 0xd6, 0x75, 0x56, 0x2e,
+	0xda, 0x8c, 0x8f, 0x9b, 0x32, 0xf9, 0x62, 0x28, 0x6e, 0x16, 0x83, 0xc9, 0x83, 0xf6, 0x3f, 0xca,
+	0xb0, 0x4b, 0x19, 0xc6, 0xe3, 0x0d, 0x17, 0x57, 0xe7, 0x09, 0x7f, 0xa7, 0x7d, 0xb1, 0xd9, 0x75,
+	0x2c, 0xd4, 0xb9, 0xd9, 0x58, 0x3e, 0x87, 0x86, 0x0a, 0xc5, 0x05, 0x53, 0x19, 0xc8, 0x7c, 0xd8,
+	0x36, 0xcc, 0x14, 0xb4, 0x0f, 0x8e, 0xbc, 0x8a, 0xe7, 0x81, 0x9c, 0x5c, 0xb2, 0x69, 0x18, 0x4c,
+	0xf8, 0x7c, 0x89, 0xef, 0xa8, 0xd2, 0xa6, 0xe6, 0xfb, 0xc8, 0xee, 0xf1, 0xf9, 0x92, 0xec, 0x41,
+	0x7d, 0xc2, 0xa7, 0x73, 0xc1, 0xa4, 0x8c, 0xf9, 0x0c, 0xcb, 0xb0, 0x46, 0xf3, 0x2c, 0x3d, 0xe5,
+	0x50, 0xd7, 0x22, 0x8a, 0xcf, 0xcf, 0xd3, 0x9c, 0xae, 0x69, 0xce, 0x6b, 0xcd, 0x20, 0x8f, 0x70,
+	0xfb, 0x51, 0x26, 0x70, 0xcd, 0xa3, 0x1f, 0xad, 0x3d, 0xb6, 0x65, 0x5e, 0xc7, 0xd7, 0x30, 0x6a,
+	0xd0, 0x5a, 0x6b, 0x12, 0x4a, 0x15, 0x30, 0x21, 0xb8, 0xc0, 0x78, 0xd6, 0x68, 0x4d, 0x73, 0x3c,
+	0xcd, 0x20, 0x7d, 0x80, 0x45, 0x98, 0xc4, 0x51, 0xa8, 0xf4, 0xab, 0xaa, 0x98, 0x93, 0x3f, 0xb9,
+	0x5d, 0x35, 0x06, 0xe7, 0xf5, 0x4a, 0x80, 0xe6, 0x84, 0xc9, 0xb7, 0xd0, 0x14, 0x2c, 0x8c, 0x64,
+	0x20, 0xdf, 0xc5, 0x6a, 0x72, 0xc9, 0x22, 0xb7, 0xb6, 0x57, 0xbc, 0x35, 0xb6, 0x0d, 0xc4, 0xfa,
+	0x29, 0xb4, 0xf5, 0x4f, 0x0b, 0x76, 0xb7, 0x94, 0xeb, 0x90, 0x63, 0x02, 0xa4, 0x05, 0x6a, 0x08,
+	0xbd, 0x33, 0xcc, 0x05, 0x9f, 0x30, 0x29, 0x59, 0x14, 0x08, 0xfe, 0x4e, 0x62, 0x35, 0x16, 0x69,
+	0x63, 0xc5, 0xa5, 0xfc, 0x1d, 0x86, 0x6f, 0x1a, 0xaa, 0xc9, 0xa5, 0x2e, 0x5d, 0x44, 0x15, 0x11,
+	0x65, 0x67, 0x4c, 0x04, 0x7d, 0x09, 0xbb, 0xd3, 0x58, 0x22, 0x2b, 0x53, 0x56, 0x42, 0x58, 0x73,
+	0xcd, 0x46, 0xe0, 0x01, 0xdc, 0x67, 0xbf, 0x53, 0x22, 0x44, 0x4c, 0x60, 0xf2, 0x04, 0x43, 0x54,
+	0xa4, 0xbb, 0x78, 0xa1, 0x51, 0xa6, 0x6f, 0x6f, 0x61, 0x4d, 0xba, 0xb8, 0x95, 0x2d, 0xec, 0x18,
+	0xd9, 0xed, 0x21, 0x94, 0x31, 0x5a, 0xa4, 0x0e, 0x3b, 0x3d, 0xea, 0x75, 0xc7, 0xde, 0xb1, 0x73,
+	0x0f, 0x89, 0xe1, 0xe8, 0x6d, 0x7f, 0xf0, 0x9d, 0x63, 0x11, 0x80, 0x4a, 0x6f, 0x38, 0xea, 0x7b,
+	0xc7, 0x4e, 0x81, 0xd8, 0x50, 0x7d, 0xed, 0xd1, 0xfe, 0x33, 0x4d, 0x15, 0x09, 0x81, 0x26, 0xf5,
+	0xba, 0xc7, 0x7e, 0xe0, 0xbf, 0xe9, 0x8f, 0x7b, 0xcf, 0xbd, 0x63, 0xa7, 0xd4, 0xfe, 0x83, 0x05,
+	0x8e, 0x19, 0x93, 0x6c, 0x9e, 0xc4, 0x13, 0xe3, 0xc8, 0x47, 0x50, 0x9e, 0xf1, 0x88, 0x99, 0x3c,
+	0xaf, 0xe7, 0x53, 0x67, 0x1b, 0xda, 0x19, 0xf0, 0x88, 0x51, 0x83, 0x6e, 0x3d, 0x81, 0x92, 0x26,
+	0xf5, 0x46, 0x92, 0x56, 0xec, 0x5d, 0x36, 0x12, 0xb5, 0x26, 0xda, 0xa7, 0xd0, 0x4c, 0xbf, 0x70,
+	0xce, 0x04, 0x9b, 0x4d, 0x98, 0x5e, 0xc6, 0x73, 0x3d, 0x17, 0xcf, 0x3f, 0x78, 0xe9, 0x68, 0xff,
+	0xd1, 0x02, 0x82, 0x7a, 0x37, 0x87, 0xd1, 0xc7, 0xd0, 0x4d, 0xbe, 0x86, 0x87, 0xdf, 0x5f, 0x33,
+	0xb1, 0x34, 0x3b, 0xc0, 0x84, 0x05, 0x51, 0x2c, 0xf5, 0x57, 0xa2, 0xb4, 0xae, 0x1f, 0xe0, 0xad,
+	0x6f, 0x2e, 0x8f, 0xd3, 0xbb, 0xf6, 0xdf, 0x4b, 0x50, 0xf7, 0xc5, 0x62, 0xd5, 0x48, 0xbf, 0x03,
+	0x98, 0x87, 0x42, 0xc5, 0xda, 0xa7, 0x99, 0xdb, 0xbf, 0xcc, 0xb9, 0x7d, 0x0d, 0x5d, 0xf5, 0xec,
+	0x51, 0x86, 0xa7, 0x39, 0xd1, 0x5b, 0x67, 0x56, 0xe1, 0x07, 0xcf, 0xac, 0xe2, 0xff, 0x30, 0xb3,
+	0xba, 0x50, 0xcf, 0xcd, 0xac, 0x74, 0x64, 0xed, 0xbd, 0xdf, 0x8e, 0xdc, 0xd4, 0x82, 0xf5, 0xd4,
+	0x6a, 0xfd, 0xcd, 0x82, 0xfb, 0x37, 0x4c, 0xd4, 0x43, 0x20, 0xb7, 0x36, 0x7e, 0x78, 0x08, 0xac,
+	0xf7, 0x45, 0xd2, 0x03, 0x07, 0x5f, 0x19, 0x88, 0x2c, 0xa1, 0x4c, 0x5b, 0xae, 0xe7, 0xed, 0xda,
+	0xcc, 0x38, 0xba, 0x2b, 0x37, 0x68, 0x49, 0x46, 0xf0, 0xa9, 0x51, 0xb2, 0xbd, 0x37, 0x9a, 0xdd,
+	0xf5, 0xff, 0xb7, 0x34, 0x6d, 0xae, 0x8d, 0x9f, 0xc8, 0x1b, 0x3c, 0xd9, 0x0a, 0x3e, 0xc6, 0x80,
+	0xfb, 0xc0, 0x5e, 0x97, 0x2e, 0x33, 0x2f, 0xa1, 0xda, 0x63, 0x49, 0xd2, 0x9f, 0x9d, 0x73, 0xdd,
+	0x05, 0xd1, 0x2f, 0x22, 0x08, 0xa3, 0x48, 0x4f, 0x90, 0x34, 0xeb, 0x1b, 0x86, 0xdb, 0x35, 0x4c,
+	0x5d, 0x12, 0x82, 0x73, 0x95, 0x2a, 0xc4, 0x73, 0x3a, 0x17, 0xdb, 0x00, 0x5a, 0x99, 0x34, 0x3f,
+	0x1d, 0xde, 0x3b, 0x5d, 0x0f, 0xf6, 0xc1, 0xce, 0x6f, 0x14, 0xba, 0x15, 0x0d, 0x86, 0xf4, 0xa4,
+	0xfb, 0xca, 0xb9, 0xa7, 0x5b, 0x91, 0x3f, 0xe8, 0x8e, 0xfc, 0xe7, 0xc3, 0xb1, 0x63, 0x1d, 0x1c,
+	0x41, 0x73, 0x33, 0x9d, 0x48, 0x0d, 0xca, 0xa7, 0x03, 0xdf, 0x1b, 0x3b, 0xf7, 0xb4, 0xd8, 0x69,
+	0x7f, 0x30, 0xfe, 0xe5, 0xd7, 0x8e, 0xa5, 0xd9, 0x4f, 0xdf, 0x8e, 0x3d, 0xdf, 0x29, 0x1c, 0xfc,
+	0xc9, 0x02, 0x58, 0xfb, 0x42, 0x37, 0xbd, 0xd3, 0xc1, 0xcb, 0xc1, 0xf0, 0xcd, 0xc0, 0x88, 0x9c,
+	0x74, 0xfd, 0xb1, 0x47, 0x1d, 0x4b, 0x5f, 0x50, 0x6f, 0xf4, 0xaa, 0xdf, 0xeb, 0x3a, 0x05, 0x7d,
+	0x41, 0x8f, 0x87, 0x83, 0x57, 0x6f, 0x9d, 0x22, 0xea, 0xea, 0x8e, 0x7b, 0xcf, 0xcd, 0xd1, 0x1f,
+	0x75, 0xa9, 0xe7, 0x94, 0x88, 0x03, 0xb6, 0xf7, 0x9b, 0x91, 0x47, 0xfb, 0x27, 0xde, 0x60, 0xdc,
+	0x7d, 0xe5, 0x94, 0xb5, 0xcc, 0xd3, 0x6e, 0xef, 0xe5, 0xe9, 0xc8, 0xa9, 0x18, 0x65, 0xfe, 0x78,
+	0x48, 0x3d, 0x67, 0x47, 0x13, 0xc7, 0xb4, 0xdb, 0x1f, 0x78, 0xc7, 0x4e, 0xb5, 0x55, 0x70, 0xac,
+	0xa7, 0x8f, 0x61, 0x37, 0xe6, 0x9d, 0x45, 0xac, 0x98, 0x94, 0xe6, 0x0f, 0x88, 0xdf, 0x7e, 0x91,
+	0x52, 0x31, 0x3f, 0x34, 0xa7, 0xc3, 0x0b, 0x7e, 0xb8, 0x50, 0x87, 0x78, 0x7b, 0x98, 0x05, 0xf5,
+	0xac, 0x82, 0xf4, 0x57, 0xff, 0x19, 0x00, 0xe2, 0xec, 0x20, 0x00, 0xd8, 0x10, 0x00, 0x00,
 }
@@ -120,10 +120,13 @@ type Keyspace struct {
 	Vindexes map[string]*Vindex `protobuf:"bytes,2,rep,name=vindexes,proto3" json:"vindexes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Tables   map[string]*Table  `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// If require_explicit_routing is true, vindexes and tables are not added to global routing
-	RequireExplicitRouting bool     `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	RequireExplicitRouting bool `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
+	// vindex_plugins lists the Go plugin files that vtgate opens
+	// to register the custom vindex types used by this keyspace.
+	VindexPlugins        []string `protobuf:"bytes,5,rep,name=vindex_plugins,json=vindexPlugins,proto3" json:"vindex_plugins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -179,6 +182,13 @@ func (m *Keyspace) GetRequireExplicitRouting() bool {
 	return false
 }
 
+func (m *Keyspace) GetVindexPlugins() []string {
+	if m != nil {
+		return m.VindexPlugins
+	}
+	return nil
+}
+
 // Vindex is the vindex info for a Keyspace.
 type Vindex struct {
 	// The type must match one of the predefined
@@ -265,10 +275,14 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// estimated_rows is an estimate of the number of rows of the
+	// table. The planner uses it to choose how to execute a join.
+	// 0 means unknown.
+	EstimatedRows        int64    `protobuf:"varint,7,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -338,6 +352,13 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetEstimatedRows() int64 {
+	if m != nil {
+		return m.EstimatedRows
+	}
+	return 0
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -562,48 +583,50 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdf, 0x4e, 0xdb, 0x3c,
+	0x14, 0x57, 0x1a, 0xfa, 0xef, 0x84, 0x96, 0xef, 0xb3, 0x80, 0x2f, 0x5f, 0x11, 0xa2, 0x8a, 0x60,
+	0xeb, 0x76, 0xd1, 0x4a, 0x45, 0x93, 0x58, 0x27, 0xa6, 0x31, 0xc4, 0x05, 0x1a, 0xd2, 0x50, 0x40,
+	0x5c, 0xec, 0x26, 0x0a, 0xad, 0x07, 0x16, 0x4d, 0x1c, 0x6c, 0x27, 0xd0, 0xd7, 0xd8, 0x23, 0xec,
+	0x49, 0xf6, 0x1e, 0x7b, 0x99, 0x29, 0xb6, 0x13, 0x1c, 0xe8, 0xee, 0x7c, 0xfe, 0xfc, 0x7e, 0xfe,
+	0xf9, 0x9c, 0xe3, 0x03, 0x9d, 0x8c, 0x4f, 0x6f, 0x71, 0x14, 0x0e, 0x13, 0x46, 0x05, 0x45, 0x4d,
+	0x6d, 0xf6, 0x9c, 0xfb, 0x14, 0xb3, 0x85, 0xf2, 0x7a, 0x13, 0x58, 0xf5, 0x69, 0x2a, 0x48, 0x7c,
+	0xe3, 0xa7, 0x73, 0xcc, 0xd1, 0x5b, 0xa8, 0xb3, 0xfc, 0xe0, 0x5a, 0x7d, 0x7b, 0xe0, 0x8c, 0xd7,
+	0x87, 0x05, 0x89, 0x91, 0xe5, 0xab, 0x14, 0xef, 0x14, 0x1c, 0xc3, 0x8b, 0xb6, 0x01, 0xbe, 0x33,
+	0x1a, 0x05, 0x22, 0xbc, 0x9e, 0x63, 0xd7, 0xea, 0x5b, 0x83, 0xb6, 0xdf, 0xce, 0x3d, 0x97, 0xb9,
+	0x03, 0x6d, 0x41, 0x5b, 0x50, 0x15, 0xe4, 0x6e, 0xad, 0x6f, 0x0f, 0xda, 0x7e, 0x4b, 0x50, 0x19,
+	0xe3, 0xde, 0x0f, 0x1b, 0x5a, 0x5f, 0xf0, 0x82, 0x27, 0xe1, 0x14, 0x23, 0x17, 0x9a, 0xfc, 0x36,
+	0x64, 0x33, 0x3c, 0x93, 0x2c, 0x2d, 0xbf, 0x30, 0xd1, 0x07, 0x68, 0x65, 0x24, 0x9e, 0xe1, 0x47,
+	0x4d, 0xe1, 0x8c, 0x77, 0x4a, 0x81, 0x05, 0x7c, 0x78, 0xa5, 0x33, 0x4e, 0x62, 0xc1, 0x16, 0x7e,
+	0x09, 0x40, 0xef, 0xa0, 0xa1, 0x6f, 0xb7, 0x25, 0x74, 0xfb, 0x25, 0x54, 0xa9, 0x51, 0x40, 0x9d,
+	0x8c, 0x0e, 0xc0, 0x65, 0xf8, 0x3e, 0x25, 0x0c, 0x07, 0xf8, 0x31, 0x99, 0x93, 0x29, 0x11, 0x01,
+	0x53, 0xcf, 0x76, 0x57, 0xa4, 0xbc, 0x4d, 0x1d, 0x3f, 0xd1, 0x61, 0x5d, 0x14, 0xb4, 0x07, 0x5d,
+	0x75, 0x79, 0x90, 0xcc, 0xd3, 0x1b, 0x12, 0x73, 0xb7, 0x2e, 0x9f, 0xdd, 0x51, 0xde, 0x73, 0xe5,
+	0xec, 0x9d, 0x41, 0xa7, 0x22, 0x19, 0xfd, 0x03, 0xf6, 0x1d, 0x5e, 0xe8, 0x0a, 0xe6, 0x47, 0xb4,
+	0x07, 0xf5, 0x2c, 0x9c, 0xa7, 0xd8, 0xad, 0xf5, 0xad, 0x81, 0x33, 0x5e, 0x2b, 0x95, 0x2b, 0xa0,
+	0xaf, 0xa2, 0x93, 0xda, 0x81, 0xd5, 0x3b, 0x05, 0xc7, 0x78, 0xc5, 0x12, 0xae, 0xdd, 0x2a, 0x57,
+	0xb7, 0xe4, 0x92, 0x30, 0x83, 0xca, 0xfb, 0x69, 0x41, 0x43, 0x5d, 0x80, 0x10, 0xac, 0x88, 0x45,
+	0x52, 0x74, 0x55, 0x9e, 0xd1, 0x3e, 0x34, 0x92, 0x90, 0x85, 0x51, 0xd1, 0x8a, 0xad, 0x67, 0xaa,
+	0x86, 0xe7, 0x32, 0xaa, 0xab, 0xa9, 0x52, 0xd1, 0x3a, 0xd4, 0xe9, 0x43, 0x8c, 0x99, 0x6b, 0x4b,
+	0x26, 0x65, 0xf4, 0xde, 0x83, 0x63, 0x24, 0x2f, 0x11, 0xbd, 0x6e, 0x8a, 0x6e, 0x9b, 0x22, 0x7f,
+	0xd5, 0xa0, 0xae, 0x06, 0x6c, 0x99, 0xc6, 0x8f, 0xb0, 0x36, 0xa5, 0xf3, 0x34, 0x8a, 0x83, 0x67,
+	0x73, 0xb3, 0x51, 0x8a, 0x3d, 0x96, 0x71, 0x5d, 0xc8, 0xee, 0xd4, 0xb0, 0x30, 0x47, 0x87, 0xd0,
+	0x0d, 0x53, 0x41, 0x03, 0x12, 0x4f, 0x19, 0x8e, 0x70, 0x2c, 0xa4, 0x6e, 0x67, 0xbc, 0x59, 0xc2,
+	0x8f, 0x52, 0x41, 0x4f, 0x8b, 0xa8, 0xdf, 0x09, 0x4d, 0x13, 0xbd, 0x81, 0xa6, 0x22, 0xe4, 0xee,
+	0x4a, 0xdf, 0xae, 0x74, 0x4e, 0x5d, 0xeb, 0x17, 0x71, 0xb4, 0x09, 0x8d, 0x84, 0xc4, 0x31, 0x9e,
+	0xb9, 0x75, 0xa9, 0x5f, 0x5b, 0x68, 0x02, 0xff, 0xeb, 0x17, 0xcc, 0x09, 0x17, 0x41, 0x98, 0x8a,
+	0x5b, 0xca, 0x88, 0x08, 0x05, 0xc9, 0xb0, 0xdb, 0x90, 0xf3, 0xf7, 0x9f, 0x4a, 0x38, 0x23, 0x5c,
+	0x1c, 0x99, 0xe1, 0x7c, 0x00, 0x31, 0x17, 0x24, 0x0a, 0x05, 0x9e, 0x05, 0x8c, 0x3e, 0x70, 0xb7,
+	0xd9, 0xb7, 0x06, 0xb6, 0xdf, 0x29, 0xbd, 0x3e, 0x7d, 0xe0, 0xde, 0x25, 0xac, 0x9a, 0x45, 0xc8,
+	0xa5, 0x28, 0x46, 0x5d, 0x4a, 0x6d, 0xe5, 0x05, 0x8e, 0xc3, 0xa8, 0xe8, 0x81, 0x3c, 0xe7, 0x7f,
+	0xb5, 0x78, 0xa1, 0x2d, 0x87, 0xbb, 0x30, 0xbd, 0x63, 0xe8, 0x54, 0x6a, 0xf3, 0x57, 0xda, 0x1e,
+	0xb4, 0x38, 0xbe, 0x4f, 0x71, 0x3c, 0x2d, 0xa8, 0x4b, 0xdb, 0x3b, 0x84, 0xc6, 0x71, 0xf5, 0x72,
+	0xcb, 0xb8, 0x7c, 0x47, 0x77, 0x3c, 0x47, 0x75, 0xc7, 0xce, 0x50, 0x2d, 0xb6, 0xcb, 0x45, 0x82,
+	0x55, 0xfb, 0xbd, 0xdf, 0x16, 0xc0, 0x05, 0xcb, 0xae, 0x2e, 0x64, 0xcd, 0xd1, 0x27, 0x68, 0xdf,
+	0xe9, 0xaf, 0x5e, 0x2c, 0x38, 0xaf, 0x6c, 0xc8, 0x53, 0x5e, 0xb9, 0x0f, 0xf4, 0xec, 0x3e, 0x81,
+	0xd0, 0x04, 0x3a, 0xfa, 0xef, 0x07, 0x6a, 0x4d, 0xaa, 0x4f, 0xb4, 0xb1, 0x6c, 0x4d, 0x72, 0x7f,
+	0x95, 0x19, 0x56, 0xef, 0x2b, 0x74, 0xab, 0xc4, 0x4b, 0xe6, 0xfc, 0x75, 0xf5, 0x73, 0xfe, 0xfb,
+	0x62, 0x45, 0x19, 0xa3, 0xff, 0xf9, 0xd5, 0xb7, 0xdd, 0x8c, 0x08, 0xcc, 0xf9, 0x90, 0xd0, 0x91,
+	0x3a, 0x8d, 0x6e, 0xe8, 0x28, 0x13, 0x23, 0xb9, 0xdb, 0x47, 0x1a, 0x7b, 0xdd, 0x90, 0xe6, 0xfe,
+	0x9f, 0x01, 0x00, 0x83, 0x6a, 0x11, 0x0a, 0x11, 0x06, 0x00, 0x00,
 }
@@ -9,6 +9,13 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	logutil "vitess.io/vitess/go/vt/proto/logutil"
+	query "vitess.io/vitess/go/vt/proto/query"
+	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdata "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	throttlerdata "vitess.io/vitess/go/vt/proto/throttlerdata"
+	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vschema "vitess.io/vitess/go/vt/proto/vschema"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -181,8 +188,14 @@ type MaterializeSettings struct {
 	StopAfterCopy bool                        `protobuf:"varint,4,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
 	TableSettings []*TableMaterializeSettings `protobuf:"bytes,5,rep,name=table_settings,json=tableSettings,proto3" json:"table_settings,omitempty"`
 	// optional parameters.
-	Cell                 string   `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
-	TabletTypes          string   `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	Cell        string `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes string `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// compression is the gRPC compressor of the vreplication streams.
+	Compression string `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression,omitempty"`
+	// external_mysql is the name of the external MySQL server to stream
+	// from, as configured on the target tablets. If set, source_keyspace
+	// is ignored.
+	ExternalMysql        string   `protobuf:"bytes,9,opt,name=external_mysql,json=externalMysql,proto3" json:"external_mysql,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -262,42 +275,14952 @@ func (m *MaterializeSettings) GetTabletTypes() string {
 	return ""
 }
 
+func (m *MaterializeSettings) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *MaterializeSettings) GetExternalMysql() string {
+	if m != nil {
+		return m.ExternalMysql
+	}
+	return ""
+}
+
+// Keyspace is a keyspace record, with its name.
+type Keyspace struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keyspace             *topodata.Keyspace `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Keyspace) Reset()         { *m = Keyspace{} }
+func (m *Keyspace) String() string { return proto.CompactTextString(m) }
+func (*Keyspace) ProtoMessage()    {}
+func (*Keyspace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{4}
+}
+
+func (m *Keyspace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Keyspace.Unmarshal(m, b)
+}
+func (m *Keyspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Keyspace.Marshal(b, m, deterministic)
+}
+func (m *Keyspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Keyspace.Merge(m, src)
+}
+func (m *Keyspace) XXX_Size() int {
+	return xxx_messageInfo_Keyspace.Size(m)
+}
+func (m *Keyspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_Keyspace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Keyspace proto.InternalMessageInfo
+
+func (m *Keyspace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Keyspace) GetKeyspace() *topodata.Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+// Shard is a shard record, with its keyspace and name.
+type Shard struct {
+	Keyspace             string          `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Shard                *topodata.Shard `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Shard) Reset()         { *m = Shard{} }
+func (m *Shard) String() string { return proto.CompactTextString(m) }
+func (*Shard) ProtoMessage()    {}
+func (*Shard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{5}
+}
+
+func (m *Shard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Shard.Unmarshal(m, b)
+}
+func (m *Shard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Shard.Marshal(b, m, deterministic)
+}
+func (m *Shard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shard.Merge(m, src)
+}
+func (m *Shard) XXX_Size() int {
+	return xxx_messageInfo_Shard.Size(m)
+}
+func (m *Shard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shard proto.InternalMessageInfo
+
+func (m *Shard) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *Shard) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Shard) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type GetKeyspacesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeyspacesRequest) Reset()         { *m = GetKeyspacesRequest{} }
+func (m *GetKeyspacesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspacesRequest) ProtoMessage()    {}
+func (*GetKeyspacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{6}
+}
+
+func (m *GetKeyspacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspacesRequest.Unmarshal(m, b)
+}
+func (m *GetKeyspacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspacesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspacesRequest.Merge(m, src)
+}
+func (m *GetKeyspacesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspacesRequest.Size(m)
+}
+func (m *GetKeyspacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspacesRequest proto.InternalMessageInfo
+
+type GetKeyspacesResponse struct {
+	Keyspaces            []*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetKeyspacesResponse) Reset()         { *m = GetKeyspacesResponse{} }
+func (m *GetKeyspacesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspacesResponse) ProtoMessage()    {}
+func (*GetKeyspacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{7}
+}
+
+func (m *GetKeyspacesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspacesResponse.Unmarshal(m, b)
+}
+func (m *GetKeyspacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspacesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspacesResponse.Merge(m, src)
+}
+func (m *GetKeyspacesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspacesResponse.Size(m)
+}
+func (m *GetKeyspacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspacesResponse proto.InternalMessageInfo
+
+func (m *GetKeyspacesResponse) GetKeyspaces() []*Keyspace {
+	if m != nil {
+		return m.Keyspaces
+	}
+	return nil
+}
+
+type GetKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeyspaceRequest) Reset()         { *m = GetKeyspaceRequest{} }
+func (m *GetKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspaceRequest) ProtoMessage()    {}
+func (*GetKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{8}
+}
+
+func (m *GetKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *GetKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspaceRequest.Merge(m, src)
+}
+func (m *GetKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspaceRequest.Size(m)
+}
+func (m *GetKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspaceRequest proto.InternalMessageInfo
+
+func (m *GetKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetKeyspaceResponse struct {
+	Keyspace             *Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetKeyspaceResponse) Reset()         { *m = GetKeyspaceResponse{} }
+func (m *GetKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspaceResponse) ProtoMessage()    {}
+func (*GetKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{9}
+}
+
+func (m *GetKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *GetKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspaceResponse.Merge(m, src)
+}
+func (m *GetKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspaceResponse.Size(m)
+}
+func (m *GetKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspaceResponse proto.InternalMessageInfo
+
+func (m *GetKeyspaceResponse) GetKeyspace() *Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type FindAllShardsInKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindAllShardsInKeyspaceRequest) Reset()         { *m = FindAllShardsInKeyspaceRequest{} }
+func (m *FindAllShardsInKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*FindAllShardsInKeyspaceRequest) ProtoMessage()    {}
+func (*FindAllShardsInKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{10}
+}
+
+func (m *FindAllShardsInKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindAllShardsInKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *FindAllShardsInKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindAllShardsInKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *FindAllShardsInKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindAllShardsInKeyspaceRequest.Merge(m, src)
+}
+func (m *FindAllShardsInKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_FindAllShardsInKeyspaceRequest.Size(m)
+}
+func (m *FindAllShardsInKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindAllShardsInKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindAllShardsInKeyspaceRequest proto.InternalMessageInfo
+
+func (m *FindAllShardsInKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type FindAllShardsInKeyspaceResponse struct {
+	// shards are sorted by name.
+	Shards               []*Shard `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindAllShardsInKeyspaceResponse) Reset()         { *m = FindAllShardsInKeyspaceResponse{} }
+func (m *FindAllShardsInKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*FindAllShardsInKeyspaceResponse) ProtoMessage()    {}
+func (*FindAllShardsInKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{11}
+}
+
+func (m *FindAllShardsInKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindAllShardsInKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *FindAllShardsInKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindAllShardsInKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *FindAllShardsInKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindAllShardsInKeyspaceResponse.Merge(m, src)
+}
+func (m *FindAllShardsInKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_FindAllShardsInKeyspaceResponse.Size(m)
+}
+func (m *FindAllShardsInKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindAllShardsInKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindAllShardsInKeyspaceResponse proto.InternalMessageInfo
+
+func (m *FindAllShardsInKeyspaceResponse) GetShards() []*Shard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type GetShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ShardName            string   `protobuf:"bytes,2,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardRequest) Reset()         { *m = GetShardRequest{} }
+func (m *GetShardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardRequest) ProtoMessage()    {}
+func (*GetShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{12}
+}
+
+func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardRequest.Unmarshal(m, b)
+}
+func (m *GetShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardRequest.Merge(m, src)
+}
+func (m *GetShardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardRequest.Size(m)
+}
+func (m *GetShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardRequest proto.InternalMessageInfo
+
+func (m *GetShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetShardRequest) GetShardName() string {
+	if m != nil {
+		return m.ShardName
+	}
+	return ""
+}
+
+type GetShardResponse struct {
+	Shard                *Shard   `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardResponse) Reset()         { *m = GetShardResponse{} }
+func (m *GetShardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardResponse) ProtoMessage()    {}
+func (*GetShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{13}
+}
+
+func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardResponse.Unmarshal(m, b)
+}
+func (m *GetShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardResponse.Merge(m, src)
+}
+func (m *GetShardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardResponse.Size(m)
+}
+func (m *GetShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardResponse proto.InternalMessageInfo
+
+func (m *GetShardResponse) GetShard() *Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type GetTabletRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTabletRequest) Reset()         { *m = GetTabletRequest{} }
+func (m *GetTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTabletRequest) ProtoMessage()    {}
+func (*GetTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{14}
+}
+
+func (m *GetTabletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletRequest.Unmarshal(m, b)
+}
+func (m *GetTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletRequest.Merge(m, src)
+}
+func (m *GetTabletRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTabletRequest.Size(m)
+}
+func (m *GetTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletRequest proto.InternalMessageInfo
+
+func (m *GetTabletRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type GetTabletResponse struct {
+	Tablet               *topodata.Tablet `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTabletResponse) Reset()         { *m = GetTabletResponse{} }
+func (m *GetTabletResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTabletResponse) ProtoMessage()    {}
+func (*GetTabletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{15}
+}
+
+func (m *GetTabletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletResponse.Unmarshal(m, b)
+}
+func (m *GetTabletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTabletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletResponse.Merge(m, src)
+}
+func (m *GetTabletResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTabletResponse.Size(m)
+}
+func (m *GetTabletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletResponse proto.InternalMessageInfo
+
+func (m *GetTabletResponse) GetTablet() *topodata.Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+// GetTabletsRequest returns the tablets of a keyspace or shard, or of
+// all keyspaces, in the given cells or in all cells.
+type GetTabletsRequest struct {
+	// keyspace is optional, all keyspaces are considered if empty.
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// shard is optional, and requires keyspace.
+	Shard string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// cells is optional, all cells are considered if empty.
+	Cells                []string `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTabletsRequest) Reset()         { *m = GetTabletsRequest{} }
+func (m *GetTabletsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTabletsRequest) ProtoMessage()    {}
+func (*GetTabletsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{16}
+}
+
+func (m *GetTabletsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletsRequest.Unmarshal(m, b)
+}
+func (m *GetTabletsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTabletsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletsRequest.Merge(m, src)
+}
+func (m *GetTabletsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTabletsRequest.Size(m)
+}
+func (m *GetTabletsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletsRequest proto.InternalMessageInfo
+
+func (m *GetTabletsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetTabletsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *GetTabletsRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type GetTabletsResponse struct {
+	Tablets              []*topodata.Tablet `protobuf:"bytes,1,rep,name=tablets,proto3" json:"tablets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTabletsResponse) Reset()         { *m = GetTabletsResponse{} }
+func (m *GetTabletsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTabletsResponse) ProtoMessage()    {}
+func (*GetTabletsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{17}
+}
+
+func (m *GetTabletsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletsResponse.Unmarshal(m, b)
+}
+func (m *GetTabletsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTabletsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletsResponse.Merge(m, src)
+}
+func (m *GetTabletsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTabletsResponse.Size(m)
+}
+func (m *GetTabletsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletsResponse proto.InternalMessageInfo
+
+func (m *GetTabletsResponse) GetTablets() []*topodata.Tablet {
+	if m != nil {
+		return m.Tablets
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// concurrency is the number of files to back up in parallel.
+	Concurrency int64 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// allow_master allows a backup of the master tablet.
+	AllowMaster bool `protobuf:"varint,3,opt,name=allow_master,json=allowMaster,proto3" json:"allow_master,omitempty"`
+	// incremental takes an incremental backup of the binary logs,
+	// whatever the backup engine of the tablet.
+	Incremental          bool     `protobuf:"varint,4,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{18}
+}
+
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupRequest.Unmarshal(m, b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupRequest.Size(m)
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+
+func (m *BackupRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *BackupRequest) GetAllowMaster() bool {
+	if m != nil {
+		return m.AllowMaster
+	}
+	return false
+}
+
+func (m *BackupRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+// BackupResponse is streamed back by Backup, with the log lines of
+// the backup as they happen.
+type BackupResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{19}
+}
+
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupResponse.Unmarshal(m, b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupResponse.Size(m)
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ReshardRequest struct {
+	Keyspace       string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow       string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	SourceShards   []string `protobuf:"bytes,3,rep,name=source_shards,json=sourceShards,proto3" json:"source_shards,omitempty"`
+	TargetShards   []string `protobuf:"bytes,4,rep,name=target_shards,json=targetShards,proto3" json:"target_shards,omitempty"`
+	SkipSchemaCopy bool     `protobuf:"varint,5,opt,name=skip_schema_copy,json=skipSchemaCopy,proto3" json:"skip_schema_copy,omitempty"`
+	// compression is the gRPC compressor of the vreplication streams.
+	Compression string `protobuf:"bytes,6,opt,name=compression,proto3" json:"compression,omitempty"`
+	// wait_for_copy keeps the stream open until the copy phase of the
+	// workflow is done, sending the copy progress periodically.
+	WaitForCopy bool `protobuf:"varint,7,opt,name=wait_for_copy,json=waitForCopy,proto3" json:"wait_for_copy,omitempty"`
+	// progress_interval is the time between two progress updates.
+	// The default is used if it's not set.
+	ProgressInterval     *vttime.Duration `protobuf:"bytes,8,opt,name=progress_interval,json=progressInterval,proto3" json:"progress_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReshardRequest) Reset()         { *m = ReshardRequest{} }
+func (m *ReshardRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshardRequest) ProtoMessage()    {}
+func (*ReshardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{20}
+}
+
+func (m *ReshardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardRequest.Unmarshal(m, b)
+}
+func (m *ReshardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardRequest.Marshal(b, m, deterministic)
+}
+func (m *ReshardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardRequest.Merge(m, src)
+}
+func (m *ReshardRequest) XXX_Size() int {
+	return xxx_messageInfo_ReshardRequest.Size(m)
+}
+func (m *ReshardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardRequest proto.InternalMessageInfo
+
+func (m *ReshardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReshardRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *ReshardRequest) GetSourceShards() []string {
+	if m != nil {
+		return m.SourceShards
+	}
+	return nil
+}
+
+func (m *ReshardRequest) GetTargetShards() []string {
+	if m != nil {
+		return m.TargetShards
+	}
+	return nil
+}
+
+func (m *ReshardRequest) GetSkipSchemaCopy() bool {
+	if m != nil {
+		return m.SkipSchemaCopy
+	}
+	return false
+}
+
+func (m *ReshardRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *ReshardRequest) GetWaitForCopy() bool {
+	if m != nil {
+		return m.WaitForCopy
+	}
+	return false
+}
+
+func (m *ReshardRequest) GetProgressInterval() *vttime.Duration {
+	if m != nil {
+		return m.ProgressInterval
+	}
+	return nil
+}
+
+// ShardCopyProgress is the copy progress of the streams of a workflow
+// on a target shard.
+type ShardCopyProgress struct {
+	Shard                string                                              `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	Streams              []*tabletmanagerdata.VReplicationStreamCopyProgress `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_unrecognized     []byte                                              `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
+}
+
+func (m *ShardCopyProgress) Reset()         { *m = ShardCopyProgress{} }
+func (m *ShardCopyProgress) String() string { return proto.CompactTextString(m) }
+func (*ShardCopyProgress) ProtoMessage()    {}
+func (*ShardCopyProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{21}
+}
+
+func (m *ShardCopyProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardCopyProgress.Unmarshal(m, b)
+}
+func (m *ShardCopyProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardCopyProgress.Marshal(b, m, deterministic)
+}
+func (m *ShardCopyProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardCopyProgress.Merge(m, src)
+}
+func (m *ShardCopyProgress) XXX_Size() int {
+	return xxx_messageInfo_ShardCopyProgress.Size(m)
+}
+func (m *ShardCopyProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardCopyProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardCopyProgress proto.InternalMessageInfo
+
+func (m *ShardCopyProgress) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ShardCopyProgress) GetStreams() []*tabletmanagerdata.VReplicationStreamCopyProgress {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// ReshardResponse is streamed back by Reshard. Each response has either
+// a log line, or the copy progress of all the target shards.
+type ReshardResponse struct {
+	Event                *logutil.Event       `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	CopyProgress         []*ShardCopyProgress `protobuf:"bytes,2,rep,name=copy_progress,json=copyProgress,proto3" json:"copy_progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReshardResponse) Reset()         { *m = ReshardResponse{} }
+func (m *ReshardResponse) String() string { return proto.CompactTextString(m) }
+func (*ReshardResponse) ProtoMessage()    {}
+func (*ReshardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{22}
+}
+
+func (m *ReshardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardResponse.Unmarshal(m, b)
+}
+func (m *ReshardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardResponse.Marshal(b, m, deterministic)
+}
+func (m *ReshardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardResponse.Merge(m, src)
+}
+func (m *ReshardResponse) XXX_Size() int {
+	return xxx_messageInfo_ReshardResponse.Size(m)
+}
+func (m *ReshardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardResponse proto.InternalMessageInfo
+
+func (m *ReshardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ReshardResponse) GetCopyProgress() []*ShardCopyProgress {
+	if m != nil {
+		return m.CopyProgress
+	}
+	return nil
+}
+
+type InitTabletRequest struct {
+	Tablet *topodata.Tablet `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	// allow_master_override allows to create a master tablet when the
+	// shard already has a master.
+	AllowMasterOverride bool `protobuf:"varint,2,opt,name=allow_master_override,json=allowMasterOverride,proto3" json:"allow_master_override,omitempty"`
+	// create_shard_and_keyspace creates the keyspace and the shard of
+	// the tablet if they don't exist.
+	CreateShardAndKeyspace bool `protobuf:"varint,3,opt,name=create_shard_and_keyspace,json=createShardAndKeyspace,proto3" json:"create_shard_and_keyspace,omitempty"`
+	// allow_update overwrites an existing tablet record.
+	AllowUpdate          bool     `protobuf:"varint,4,opt,name=allow_update,json=allowUpdate,proto3" json:"allow_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitTabletRequest) Reset()         { *m = InitTabletRequest{} }
+func (m *InitTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*InitTabletRequest) ProtoMessage()    {}
+func (*InitTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{23}
+}
+
+func (m *InitTabletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitTabletRequest.Unmarshal(m, b)
+}
+func (m *InitTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitTabletRequest.Marshal(b, m, deterministic)
+}
+func (m *InitTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitTabletRequest.Merge(m, src)
+}
+func (m *InitTabletRequest) XXX_Size() int {
+	return xxx_messageInfo_InitTabletRequest.Size(m)
+}
+func (m *InitTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitTabletRequest proto.InternalMessageInfo
+
+func (m *InitTabletRequest) GetTablet() *topodata.Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+func (m *InitTabletRequest) GetAllowMasterOverride() bool {
+	if m != nil {
+		return m.AllowMasterOverride
+	}
+	return false
+}
+
+func (m *InitTabletRequest) GetCreateShardAndKeyspace() bool {
+	if m != nil {
+		return m.CreateShardAndKeyspace
+	}
+	return false
+}
+
+func (m *InitTabletRequest) GetAllowUpdate() bool {
+	if m != nil {
+		return m.AllowUpdate
+	}
+	return false
+}
+
+type InitTabletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitTabletResponse) Reset()         { *m = InitTabletResponse{} }
+func (m *InitTabletResponse) String() string { return proto.CompactTextString(m) }
+func (*InitTabletResponse) ProtoMessage()    {}
+func (*InitTabletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{24}
+}
+
+func (m *InitTabletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitTabletResponse.Unmarshal(m, b)
+}
+func (m *InitTabletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitTabletResponse.Marshal(b, m, deterministic)
+}
+func (m *InitTabletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitTabletResponse.Merge(m, src)
+}
+func (m *InitTabletResponse) XXX_Size() int {
+	return xxx_messageInfo_InitTabletResponse.Size(m)
+}
+func (m *InitTabletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitTabletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitTabletResponse proto.InternalMessageInfo
+
+// UpdateTabletAddrsRequest changes the fields of the tablet record that
+// are set.
+type UpdateTabletAddrsRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Hostname             string                `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	MysqlHostname        string                `protobuf:"bytes,3,opt,name=mysql_hostname,json=mysqlHostname,proto3" json:"mysql_hostname,omitempty"`
+	MysqlPort            int32                 `protobuf:"varint,4,opt,name=mysql_port,json=mysqlPort,proto3" json:"mysql_port,omitempty"`
+	VtPort               int32                 `protobuf:"varint,5,opt,name=vt_port,json=vtPort,proto3" json:"vt_port,omitempty"`
+	GrpcPort             int32                 `protobuf:"varint,6,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateTabletAddrsRequest) Reset()         { *m = UpdateTabletAddrsRequest{} }
+func (m *UpdateTabletAddrsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTabletAddrsRequest) ProtoMessage()    {}
+func (*UpdateTabletAddrsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{25}
+}
+
+func (m *UpdateTabletAddrsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTabletAddrsRequest.Unmarshal(m, b)
+}
+func (m *UpdateTabletAddrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTabletAddrsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTabletAddrsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTabletAddrsRequest.Merge(m, src)
+}
+func (m *UpdateTabletAddrsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTabletAddrsRequest.Size(m)
+}
+func (m *UpdateTabletAddrsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTabletAddrsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTabletAddrsRequest proto.InternalMessageInfo
+
+func (m *UpdateTabletAddrsRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *UpdateTabletAddrsRequest) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *UpdateTabletAddrsRequest) GetMysqlHostname() string {
+	if m != nil {
+		return m.MysqlHostname
+	}
+	return ""
+}
+
+func (m *UpdateTabletAddrsRequest) GetMysqlPort() int32 {
+	if m != nil {
+		return m.MysqlPort
+	}
+	return 0
+}
+
+func (m *UpdateTabletAddrsRequest) GetVtPort() int32 {
+	if m != nil {
+		return m.VtPort
+	}
+	return 0
+}
+
+func (m *UpdateTabletAddrsRequest) GetGrpcPort() int32 {
+	if m != nil {
+		return m.GrpcPort
+	}
+	return 0
+}
+
+type UpdateTabletAddrsResponse struct {
+	Tablet               *topodata.Tablet `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateTabletAddrsResponse) Reset()         { *m = UpdateTabletAddrsResponse{} }
+func (m *UpdateTabletAddrsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTabletAddrsResponse) ProtoMessage()    {}
+func (*UpdateTabletAddrsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{26}
+}
+
+func (m *UpdateTabletAddrsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTabletAddrsResponse.Unmarshal(m, b)
+}
+func (m *UpdateTabletAddrsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTabletAddrsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTabletAddrsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTabletAddrsResponse.Merge(m, src)
+}
+func (m *UpdateTabletAddrsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTabletAddrsResponse.Size(m)
+}
+func (m *UpdateTabletAddrsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTabletAddrsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTabletAddrsResponse proto.InternalMessageInfo
+
+func (m *UpdateTabletAddrsResponse) GetTablet() *topodata.Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+type DeleteTabletsRequest struct {
+	TabletAliases []*topodata.TabletAlias `protobuf:"bytes,1,rep,name=tablet_aliases,json=tabletAliases,proto3" json:"tablet_aliases,omitempty"`
+	// allow_master allows to delete the master tablet of a shard.
+	AllowMaster          bool     `protobuf:"varint,2,opt,name=allow_master,json=allowMaster,proto3" json:"allow_master,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTabletsRequest) Reset()         { *m = DeleteTabletsRequest{} }
+func (m *DeleteTabletsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTabletsRequest) ProtoMessage()    {}
+func (*DeleteTabletsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{27}
+}
+
+func (m *DeleteTabletsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTabletsRequest.Unmarshal(m, b)
+}
+func (m *DeleteTabletsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTabletsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTabletsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTabletsRequest.Merge(m, src)
+}
+func (m *DeleteTabletsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTabletsRequest.Size(m)
+}
+func (m *DeleteTabletsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTabletsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTabletsRequest proto.InternalMessageInfo
+
+func (m *DeleteTabletsRequest) GetTabletAliases() []*topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAliases
+	}
+	return nil
+}
+
+func (m *DeleteTabletsRequest) GetAllowMaster() bool {
+	if m != nil {
+		return m.AllowMaster
+	}
+	return false
+}
+
+type DeleteTabletsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTabletsResponse) Reset()         { *m = DeleteTabletsResponse{} }
+func (m *DeleteTabletsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTabletsResponse) ProtoMessage()    {}
+func (*DeleteTabletsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{28}
+}
+
+func (m *DeleteTabletsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTabletsResponse.Unmarshal(m, b)
+}
+func (m *DeleteTabletsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTabletsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTabletsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTabletsResponse.Merge(m, src)
+}
+func (m *DeleteTabletsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTabletsResponse.Size(m)
+}
+func (m *DeleteTabletsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTabletsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTabletsResponse proto.InternalMessageInfo
+
+type SetReadOnlyRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SetReadOnlyRequest) Reset()         { *m = SetReadOnlyRequest{} }
+func (m *SetReadOnlyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetReadOnlyRequest) ProtoMessage()    {}
+func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{29}
+}
+
+func (m *SetReadOnlyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReadOnlyRequest.Unmarshal(m, b)
+}
+func (m *SetReadOnlyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReadOnlyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetReadOnlyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadOnlyRequest.Merge(m, src)
+}
+func (m *SetReadOnlyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetReadOnlyRequest.Size(m)
+}
+func (m *SetReadOnlyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadOnlyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReadOnlyRequest proto.InternalMessageInfo
+
+func (m *SetReadOnlyRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type SetReadOnlyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetReadOnlyResponse) Reset()         { *m = SetReadOnlyResponse{} }
+func (m *SetReadOnlyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetReadOnlyResponse) ProtoMessage()    {}
+func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{30}
+}
+
+func (m *SetReadOnlyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReadOnlyResponse.Unmarshal(m, b)
+}
+func (m *SetReadOnlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReadOnlyResponse.Marshal(b, m, deterministic)
+}
+func (m *SetReadOnlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadOnlyResponse.Merge(m, src)
+}
+func (m *SetReadOnlyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetReadOnlyResponse.Size(m)
+}
+func (m *SetReadOnlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadOnlyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReadOnlyResponse proto.InternalMessageInfo
+
+type SetReadWriteRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SetReadWriteRequest) Reset()         { *m = SetReadWriteRequest{} }
+func (m *SetReadWriteRequest) String() string { return proto.CompactTextString(m) }
+func (*SetReadWriteRequest) ProtoMessage()    {}
+func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{31}
+}
+
+func (m *SetReadWriteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReadWriteRequest.Unmarshal(m, b)
+}
+func (m *SetReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReadWriteRequest.Marshal(b, m, deterministic)
+}
+func (m *SetReadWriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadWriteRequest.Merge(m, src)
+}
+func (m *SetReadWriteRequest) XXX_Size() int {
+	return xxx_messageInfo_SetReadWriteRequest.Size(m)
+}
+func (m *SetReadWriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadWriteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReadWriteRequest proto.InternalMessageInfo
+
+func (m *SetReadWriteRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type SetReadWriteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetReadWriteResponse) Reset()         { *m = SetReadWriteResponse{} }
+func (m *SetReadWriteResponse) String() string { return proto.CompactTextString(m) }
+func (*SetReadWriteResponse) ProtoMessage()    {}
+func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{32}
+}
+
+func (m *SetReadWriteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReadWriteResponse.Unmarshal(m, b)
+}
+func (m *SetReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReadWriteResponse.Marshal(b, m, deterministic)
+}
+func (m *SetReadWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadWriteResponse.Merge(m, src)
+}
+func (m *SetReadWriteResponse) XXX_Size() int {
+	return xxx_messageInfo_SetReadWriteResponse.Size(m)
+}
+func (m *SetReadWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadWriteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReadWriteResponse proto.InternalMessageInfo
+
+type StartSlaveRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StartSlaveRequest) Reset()         { *m = StartSlaveRequest{} }
+func (m *StartSlaveRequest) String() string { return proto.CompactTextString(m) }
+func (*StartSlaveRequest) ProtoMessage()    {}
+func (*StartSlaveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{33}
+}
+
+func (m *StartSlaveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartSlaveRequest.Unmarshal(m, b)
+}
+func (m *StartSlaveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartSlaveRequest.Marshal(b, m, deterministic)
+}
+func (m *StartSlaveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSlaveRequest.Merge(m, src)
+}
+func (m *StartSlaveRequest) XXX_Size() int {
+	return xxx_messageInfo_StartSlaveRequest.Size(m)
+}
+func (m *StartSlaveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSlaveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSlaveRequest proto.InternalMessageInfo
+
+func (m *StartSlaveRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type StartSlaveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartSlaveResponse) Reset()         { *m = StartSlaveResponse{} }
+func (m *StartSlaveResponse) String() string { return proto.CompactTextString(m) }
+func (*StartSlaveResponse) ProtoMessage()    {}
+func (*StartSlaveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{34}
+}
+
+func (m *StartSlaveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartSlaveResponse.Unmarshal(m, b)
+}
+func (m *StartSlaveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartSlaveResponse.Marshal(b, m, deterministic)
+}
+func (m *StartSlaveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSlaveResponse.Merge(m, src)
+}
+func (m *StartSlaveResponse) XXX_Size() int {
+	return xxx_messageInfo_StartSlaveResponse.Size(m)
+}
+func (m *StartSlaveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSlaveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSlaveResponse proto.InternalMessageInfo
+
+type StopSlaveRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StopSlaveRequest) Reset()         { *m = StopSlaveRequest{} }
+func (m *StopSlaveRequest) String() string { return proto.CompactTextString(m) }
+func (*StopSlaveRequest) ProtoMessage()    {}
+func (*StopSlaveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{35}
+}
+
+func (m *StopSlaveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopSlaveRequest.Unmarshal(m, b)
+}
+func (m *StopSlaveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopSlaveRequest.Marshal(b, m, deterministic)
+}
+func (m *StopSlaveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopSlaveRequest.Merge(m, src)
+}
+func (m *StopSlaveRequest) XXX_Size() int {
+	return xxx_messageInfo_StopSlaveRequest.Size(m)
+}
+func (m *StopSlaveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopSlaveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopSlaveRequest proto.InternalMessageInfo
+
+func (m *StopSlaveRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type StopSlaveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopSlaveResponse) Reset()         { *m = StopSlaveResponse{} }
+func (m *StopSlaveResponse) String() string { return proto.CompactTextString(m) }
+func (*StopSlaveResponse) ProtoMessage()    {}
+func (*StopSlaveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{36}
+}
+
+func (m *StopSlaveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopSlaveResponse.Unmarshal(m, b)
+}
+func (m *StopSlaveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopSlaveResponse.Marshal(b, m, deterministic)
+}
+func (m *StopSlaveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopSlaveResponse.Merge(m, src)
+}
+func (m *StopSlaveResponse) XXX_Size() int {
+	return xxx_messageInfo_StopSlaveResponse.Size(m)
+}
+func (m *StopSlaveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopSlaveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopSlaveResponse proto.InternalMessageInfo
+
+type ChangeSlaveTypeRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	DbType      topodata.TabletType   `protobuf:"varint,2,opt,name=db_type,json=dbType,proto3,enum=topodata.TabletType" json:"db_type,omitempty"`
+	// dry_run only checks that the change is allowed.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeSlaveTypeRequest) Reset()         { *m = ChangeSlaveTypeRequest{} }
+func (m *ChangeSlaveTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeSlaveTypeRequest) ProtoMessage()    {}
+func (*ChangeSlaveTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{37}
+}
+
+func (m *ChangeSlaveTypeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeSlaveTypeRequest.Unmarshal(m, b)
+}
+func (m *ChangeSlaveTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeSlaveTypeRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangeSlaveTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeSlaveTypeRequest.Merge(m, src)
+}
+func (m *ChangeSlaveTypeRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeSlaveTypeRequest.Size(m)
+}
+func (m *ChangeSlaveTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeSlaveTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeSlaveTypeRequest proto.InternalMessageInfo
+
+func (m *ChangeSlaveTypeRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ChangeSlaveTypeRequest) GetDbType() topodata.TabletType {
+	if m != nil {
+		return m.DbType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *ChangeSlaveTypeRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ChangeSlaveTypeResponse struct {
+	BeforeTablet         *topodata.Tablet `protobuf:"bytes,1,opt,name=before_tablet,json=beforeTablet,proto3" json:"before_tablet,omitempty"`
+	AfterTablet          *topodata.Tablet `protobuf:"bytes,2,opt,name=after_tablet,json=afterTablet,proto3" json:"after_tablet,omitempty"`
+	WasDryRun            bool             `protobuf:"varint,3,opt,name=was_dry_run,json=wasDryRun,proto3" json:"was_dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChangeSlaveTypeResponse) Reset()         { *m = ChangeSlaveTypeResponse{} }
+func (m *ChangeSlaveTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeSlaveTypeResponse) ProtoMessage()    {}
+func (*ChangeSlaveTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{38}
+}
+
+func (m *ChangeSlaveTypeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeSlaveTypeResponse.Unmarshal(m, b)
+}
+func (m *ChangeSlaveTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeSlaveTypeResponse.Marshal(b, m, deterministic)
+}
+func (m *ChangeSlaveTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeSlaveTypeResponse.Merge(m, src)
+}
+func (m *ChangeSlaveTypeResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeSlaveTypeResponse.Size(m)
+}
+func (m *ChangeSlaveTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeSlaveTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeSlaveTypeResponse proto.InternalMessageInfo
+
+func (m *ChangeSlaveTypeResponse) GetBeforeTablet() *topodata.Tablet {
+	if m != nil {
+		return m.BeforeTablet
+	}
+	return nil
+}
+
+func (m *ChangeSlaveTypeResponse) GetAfterTablet() *topodata.Tablet {
+	if m != nil {
+		return m.AfterTablet
+	}
+	return nil
+}
+
+func (m *ChangeSlaveTypeResponse) GetWasDryRun() bool {
+	if m != nil {
+		return m.WasDryRun
+	}
+	return false
+}
+
+type PingRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{39}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+func (m *PingRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type PingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{40}
+}
+
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+
+type RefreshStateRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RefreshStateRequest) Reset()         { *m = RefreshStateRequest{} }
+func (m *RefreshStateRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshStateRequest) ProtoMessage()    {}
+func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{41}
+}
+
+func (m *RefreshStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshStateRequest.Unmarshal(m, b)
+}
+func (m *RefreshStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshStateRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshStateRequest.Merge(m, src)
+}
+func (m *RefreshStateRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshStateRequest.Size(m)
+}
+func (m *RefreshStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshStateRequest proto.InternalMessageInfo
+
+func (m *RefreshStateRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type RefreshStateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshStateResponse) Reset()         { *m = RefreshStateResponse{} }
+func (m *RefreshStateResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshStateResponse) ProtoMessage()    {}
+func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{42}
+}
+
+func (m *RefreshStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshStateResponse.Unmarshal(m, b)
+}
+func (m *RefreshStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshStateResponse.Marshal(b, m, deterministic)
+}
+func (m *RefreshStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshStateResponse.Merge(m, src)
+}
+func (m *RefreshStateResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshStateResponse.Size(m)
+}
+func (m *RefreshStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshStateResponse proto.InternalMessageInfo
+
+type RefreshStateByShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// cells is optional, all cells are considered if empty.
+	Cells                []string `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshStateByShardRequest) Reset()         { *m = RefreshStateByShardRequest{} }
+func (m *RefreshStateByShardRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshStateByShardRequest) ProtoMessage()    {}
+func (*RefreshStateByShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{43}
+}
+
+func (m *RefreshStateByShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshStateByShardRequest.Unmarshal(m, b)
+}
+func (m *RefreshStateByShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshStateByShardRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshStateByShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshStateByShardRequest.Merge(m, src)
+}
+func (m *RefreshStateByShardRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshStateByShardRequest.Size(m)
+}
+func (m *RefreshStateByShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshStateByShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshStateByShardRequest proto.InternalMessageInfo
+
+func (m *RefreshStateByShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RefreshStateByShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *RefreshStateByShardRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type RefreshStateByShardResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshStateByShardResponse) Reset()         { *m = RefreshStateByShardResponse{} }
+func (m *RefreshStateByShardResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshStateByShardResponse) ProtoMessage()    {}
+func (*RefreshStateByShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{44}
+}
+
+func (m *RefreshStateByShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshStateByShardResponse.Unmarshal(m, b)
+}
+func (m *RefreshStateByShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshStateByShardResponse.Marshal(b, m, deterministic)
+}
+func (m *RefreshStateByShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshStateByShardResponse.Merge(m, src)
+}
+func (m *RefreshStateByShardResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshStateByShardResponse.Size(m)
+}
+func (m *RefreshStateByShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshStateByShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshStateByShardResponse proto.InternalMessageInfo
+
+type RunHealthCheckRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RunHealthCheckRequest) Reset()         { *m = RunHealthCheckRequest{} }
+func (m *RunHealthCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*RunHealthCheckRequest) ProtoMessage()    {}
+func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{45}
+}
+
+func (m *RunHealthCheckRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunHealthCheckRequest.Unmarshal(m, b)
+}
+func (m *RunHealthCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunHealthCheckRequest.Marshal(b, m, deterministic)
+}
+func (m *RunHealthCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunHealthCheckRequest.Merge(m, src)
+}
+func (m *RunHealthCheckRequest) XXX_Size() int {
+	return xxx_messageInfo_RunHealthCheckRequest.Size(m)
+}
+func (m *RunHealthCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunHealthCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunHealthCheckRequest proto.InternalMessageInfo
+
+func (m *RunHealthCheckRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type RunHealthCheckResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunHealthCheckResponse) Reset()         { *m = RunHealthCheckResponse{} }
+func (m *RunHealthCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*RunHealthCheckResponse) ProtoMessage()    {}
+func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{46}
+}
+
+func (m *RunHealthCheckResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunHealthCheckResponse.Unmarshal(m, b)
+}
+func (m *RunHealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunHealthCheckResponse.Marshal(b, m, deterministic)
+}
+func (m *RunHealthCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunHealthCheckResponse.Merge(m, src)
+}
+func (m *RunHealthCheckResponse) XXX_Size() int {
+	return xxx_messageInfo_RunHealthCheckResponse.Size(m)
+}
+func (m *RunHealthCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunHealthCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunHealthCheckResponse proto.InternalMessageInfo
+
+type IgnoreHealthErrorRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// pattern is a regexp with implicit ^$ anchors. An empty pattern
+	// stops ignoring errors.
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IgnoreHealthErrorRequest) Reset()         { *m = IgnoreHealthErrorRequest{} }
+func (m *IgnoreHealthErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*IgnoreHealthErrorRequest) ProtoMessage()    {}
+func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{47}
+}
+
+func (m *IgnoreHealthErrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IgnoreHealthErrorRequest.Unmarshal(m, b)
+}
+func (m *IgnoreHealthErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IgnoreHealthErrorRequest.Marshal(b, m, deterministic)
+}
+func (m *IgnoreHealthErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IgnoreHealthErrorRequest.Merge(m, src)
+}
+func (m *IgnoreHealthErrorRequest) XXX_Size() int {
+	return xxx_messageInfo_IgnoreHealthErrorRequest.Size(m)
+}
+func (m *IgnoreHealthErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IgnoreHealthErrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IgnoreHealthErrorRequest proto.InternalMessageInfo
+
+func (m *IgnoreHealthErrorRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *IgnoreHealthErrorRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+type IgnoreHealthErrorResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IgnoreHealthErrorResponse) Reset()         { *m = IgnoreHealthErrorResponse{} }
+func (m *IgnoreHealthErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*IgnoreHealthErrorResponse) ProtoMessage()    {}
+func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{48}
+}
+
+func (m *IgnoreHealthErrorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IgnoreHealthErrorResponse.Unmarshal(m, b)
+}
+func (m *IgnoreHealthErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IgnoreHealthErrorResponse.Marshal(b, m, deterministic)
+}
+func (m *IgnoreHealthErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IgnoreHealthErrorResponse.Merge(m, src)
+}
+func (m *IgnoreHealthErrorResponse) XXX_Size() int {
+	return xxx_messageInfo_IgnoreHealthErrorResponse.Size(m)
+}
+func (m *IgnoreHealthErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IgnoreHealthErrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IgnoreHealthErrorResponse proto.InternalMessageInfo
+
+type ResizeTxPoolRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Size                 int64                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ResizeTxPoolRequest) Reset()         { *m = ResizeTxPoolRequest{} }
+func (m *ResizeTxPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeTxPoolRequest) ProtoMessage()    {}
+func (*ResizeTxPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{49}
+}
+
+func (m *ResizeTxPoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeTxPoolRequest.Unmarshal(m, b)
+}
+func (m *ResizeTxPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeTxPoolRequest.Marshal(b, m, deterministic)
+}
+func (m *ResizeTxPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeTxPoolRequest.Merge(m, src)
+}
+func (m *ResizeTxPoolRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizeTxPoolRequest.Size(m)
+}
+func (m *ResizeTxPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeTxPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeTxPoolRequest proto.InternalMessageInfo
+
+func (m *ResizeTxPoolRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ResizeTxPoolRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type ResizeTxPoolResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizeTxPoolResponse) Reset()         { *m = ResizeTxPoolResponse{} }
+func (m *ResizeTxPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*ResizeTxPoolResponse) ProtoMessage()    {}
+func (*ResizeTxPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{50}
+}
+
+func (m *ResizeTxPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeTxPoolResponse.Unmarshal(m, b)
+}
+func (m *ResizeTxPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeTxPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *ResizeTxPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeTxPoolResponse.Merge(m, src)
+}
+func (m *ResizeTxPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_ResizeTxPoolResponse.Size(m)
+}
+func (m *ResizeTxPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeTxPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeTxPoolResponse proto.InternalMessageInfo
+
+type SleepRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Duration             *vttime.Duration      `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SleepRequest) Reset()         { *m = SleepRequest{} }
+func (m *SleepRequest) String() string { return proto.CompactTextString(m) }
+func (*SleepRequest) ProtoMessage()    {}
+func (*SleepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{51}
+}
+
+func (m *SleepRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SleepRequest.Unmarshal(m, b)
+}
+func (m *SleepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SleepRequest.Marshal(b, m, deterministic)
+}
+func (m *SleepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SleepRequest.Merge(m, src)
+}
+func (m *SleepRequest) XXX_Size() int {
+	return xxx_messageInfo_SleepRequest.Size(m)
+}
+func (m *SleepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SleepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SleepRequest proto.InternalMessageInfo
+
+func (m *SleepRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *SleepRequest) GetDuration() *vttime.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type SleepResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SleepResponse) Reset()         { *m = SleepResponse{} }
+func (m *SleepResponse) String() string { return proto.CompactTextString(m) }
+func (*SleepResponse) ProtoMessage()    {}
+func (*SleepResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{52}
+}
+
+func (m *SleepResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SleepResponse.Unmarshal(m, b)
+}
+func (m *SleepResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SleepResponse.Marshal(b, m, deterministic)
+}
+func (m *SleepResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SleepResponse.Merge(m, src)
+}
+func (m *SleepResponse) XXX_Size() int {
+	return xxx_messageInfo_SleepResponse.Size(m)
+}
+func (m *SleepResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SleepResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SleepResponse proto.InternalMessageInfo
+
+type ExecuteHookRequest struct {
+	TabletAlias          *topodata.TabletAlias                 `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	TabletHookRequest    *tabletmanagerdata.ExecuteHookRequest `protobuf:"bytes,2,opt,name=tablet_hook_request,json=tabletHookRequest,proto3" json:"tablet_hook_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ExecuteHookRequest) Reset()         { *m = ExecuteHookRequest{} }
+func (m *ExecuteHookRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteHookRequest) ProtoMessage()    {}
+func (*ExecuteHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{53}
+}
+
+func (m *ExecuteHookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteHookRequest.Unmarshal(m, b)
+}
+func (m *ExecuteHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteHookRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteHookRequest.Merge(m, src)
+}
+func (m *ExecuteHookRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteHookRequest.Size(m)
+}
+func (m *ExecuteHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteHookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteHookRequest proto.InternalMessageInfo
+
+func (m *ExecuteHookRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ExecuteHookRequest) GetTabletHookRequest() *tabletmanagerdata.ExecuteHookRequest {
+	if m != nil {
+		return m.TabletHookRequest
+	}
+	return nil
+}
+
+type ExecuteHookResponse struct {
+	HookResult           *tabletmanagerdata.ExecuteHookResponse `protobuf:"bytes,1,opt,name=hook_result,json=hookResult,proto3" json:"hook_result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *ExecuteHookResponse) Reset()         { *m = ExecuteHookResponse{} }
+func (m *ExecuteHookResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteHookResponse) ProtoMessage()    {}
+func (*ExecuteHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{54}
+}
+
+func (m *ExecuteHookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteHookResponse.Unmarshal(m, b)
+}
+func (m *ExecuteHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteHookResponse.Marshal(b, m, deterministic)
+}
+func (m *ExecuteHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteHookResponse.Merge(m, src)
+}
+func (m *ExecuteHookResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecuteHookResponse.Size(m)
+}
+func (m *ExecuteHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteHookResponse proto.InternalMessageInfo
+
+func (m *ExecuteHookResponse) GetHookResult() *tabletmanagerdata.ExecuteHookResponse {
+	if m != nil {
+		return m.HookResult
+	}
+	return nil
+}
+
+type ExecuteFetchAsAppRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Query       string                `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// max_rows is 10000 if it's not set.
+	MaxRows              int64    `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	UsePool              bool     `protobuf:"varint,4,opt,name=use_pool,json=usePool,proto3" json:"use_pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteFetchAsAppRequest) Reset()         { *m = ExecuteFetchAsAppRequest{} }
+func (m *ExecuteFetchAsAppRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAppRequest) ProtoMessage()    {}
+func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{55}
+}
+
+func (m *ExecuteFetchAsAppRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteFetchAsAppRequest.Unmarshal(m, b)
+}
+func (m *ExecuteFetchAsAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteFetchAsAppRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteFetchAsAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteFetchAsAppRequest.Merge(m, src)
+}
+func (m *ExecuteFetchAsAppRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteFetchAsAppRequest.Size(m)
+}
+func (m *ExecuteFetchAsAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteFetchAsAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteFetchAsAppRequest proto.InternalMessageInfo
+
+func (m *ExecuteFetchAsAppRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ExecuteFetchAsAppRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ExecuteFetchAsAppRequest) GetMaxRows() int64 {
+	if m != nil {
+		return m.MaxRows
+	}
+	return 0
+}
+
+func (m *ExecuteFetchAsAppRequest) GetUsePool() bool {
+	if m != nil {
+		return m.UsePool
+	}
+	return false
+}
+
+type ExecuteFetchAsAppResponse struct {
+	Result               *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExecuteFetchAsAppResponse) Reset()         { *m = ExecuteFetchAsAppResponse{} }
+func (m *ExecuteFetchAsAppResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAppResponse) ProtoMessage()    {}
+func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{56}
+}
+
+func (m *ExecuteFetchAsAppResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteFetchAsAppResponse.Unmarshal(m, b)
+}
+func (m *ExecuteFetchAsAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteFetchAsAppResponse.Marshal(b, m, deterministic)
+}
+func (m *ExecuteFetchAsAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteFetchAsAppResponse.Merge(m, src)
+}
+func (m *ExecuteFetchAsAppResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecuteFetchAsAppResponse.Size(m)
+}
+func (m *ExecuteFetchAsAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteFetchAsAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteFetchAsAppResponse proto.InternalMessageInfo
+
+func (m *ExecuteFetchAsAppResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExecuteFetchAsDbaRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Query       string                `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// max_rows is 10000 if it's not set.
+	MaxRows              int64    `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	DisableBinlogs       bool     `protobuf:"varint,4,opt,name=disable_binlogs,json=disableBinlogs,proto3" json:"disable_binlogs,omitempty"`
+	ReloadSchema         bool     `protobuf:"varint,5,opt,name=reload_schema,json=reloadSchema,proto3" json:"reload_schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteFetchAsDbaRequest) Reset()         { *m = ExecuteFetchAsDbaRequest{} }
+func (m *ExecuteFetchAsDbaRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaRequest) ProtoMessage()    {}
+func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{57}
+}
+
+func (m *ExecuteFetchAsDbaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteFetchAsDbaRequest.Unmarshal(m, b)
+}
+func (m *ExecuteFetchAsDbaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteFetchAsDbaRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteFetchAsDbaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteFetchAsDbaRequest.Merge(m, src)
+}
+func (m *ExecuteFetchAsDbaRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteFetchAsDbaRequest.Size(m)
+}
+func (m *ExecuteFetchAsDbaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteFetchAsDbaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteFetchAsDbaRequest proto.InternalMessageInfo
+
+func (m *ExecuteFetchAsDbaRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ExecuteFetchAsDbaRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ExecuteFetchAsDbaRequest) GetMaxRows() int64 {
+	if m != nil {
+		return m.MaxRows
+	}
+	return 0
+}
+
+func (m *ExecuteFetchAsDbaRequest) GetDisableBinlogs() bool {
+	if m != nil {
+		return m.DisableBinlogs
+	}
+	return false
+}
+
+func (m *ExecuteFetchAsDbaRequest) GetReloadSchema() bool {
+	if m != nil {
+		return m.ReloadSchema
+	}
+	return false
+}
+
+type ExecuteFetchAsDbaResponse struct {
+	Result               *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExecuteFetchAsDbaResponse) Reset()         { *m = ExecuteFetchAsDbaResponse{} }
+func (m *ExecuteFetchAsDbaResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaResponse) ProtoMessage()    {}
+func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{58}
+}
+
+func (m *ExecuteFetchAsDbaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteFetchAsDbaResponse.Unmarshal(m, b)
+}
+func (m *ExecuteFetchAsDbaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteFetchAsDbaResponse.Marshal(b, m, deterministic)
+}
+func (m *ExecuteFetchAsDbaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteFetchAsDbaResponse.Merge(m, src)
+}
+func (m *ExecuteFetchAsDbaResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecuteFetchAsDbaResponse.Size(m)
+}
+func (m *ExecuteFetchAsDbaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteFetchAsDbaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteFetchAsDbaResponse proto.InternalMessageInfo
+
+func (m *ExecuteFetchAsDbaResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type VReplicationExecRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Query                string                `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VReplicationExecRequest) Reset()         { *m = VReplicationExecRequest{} }
+func (m *VReplicationExecRequest) String() string { return proto.CompactTextString(m) }
+func (*VReplicationExecRequest) ProtoMessage()    {}
+func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{59}
+}
+
+func (m *VReplicationExecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationExecRequest.Unmarshal(m, b)
+}
+func (m *VReplicationExecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationExecRequest.Marshal(b, m, deterministic)
+}
+func (m *VReplicationExecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationExecRequest.Merge(m, src)
+}
+func (m *VReplicationExecRequest) XXX_Size() int {
+	return xxx_messageInfo_VReplicationExecRequest.Size(m)
+}
+func (m *VReplicationExecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationExecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationExecRequest proto.InternalMessageInfo
+
+func (m *VReplicationExecRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *VReplicationExecRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type VReplicationExecResponse struct {
+	Result               *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VReplicationExecResponse) Reset()         { *m = VReplicationExecResponse{} }
+func (m *VReplicationExecResponse) String() string { return proto.CompactTextString(m) }
+func (*VReplicationExecResponse) ProtoMessage()    {}
+func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{60}
+}
+
+func (m *VReplicationExecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VReplicationExecResponse.Unmarshal(m, b)
+}
+func (m *VReplicationExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VReplicationExecResponse.Marshal(b, m, deterministic)
+}
+func (m *VReplicationExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationExecResponse.Merge(m, src)
+}
+func (m *VReplicationExecResponse) XXX_Size() int {
+	return xxx_messageInfo_VReplicationExecResponse.Size(m)
+}
+func (m *VReplicationExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationExecResponse proto.InternalMessageInfo
+
+func (m *VReplicationExecResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CreateShardRequest struct {
+	Keyspace  string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ShardName string `protobuf:"bytes,2,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
+	// force doesn't fail if the shard already exists.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// include_parent creates the keyspace if it doesn't exist.
+	IncludeParent        bool     `protobuf:"varint,4,opt,name=include_parent,json=includeParent,proto3" json:"include_parent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShardRequest) Reset()         { *m = CreateShardRequest{} }
+func (m *CreateShardRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateShardRequest) ProtoMessage()    {}
+func (*CreateShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{61}
+}
+
+func (m *CreateShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShardRequest.Unmarshal(m, b)
+}
+func (m *CreateShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShardRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShardRequest.Merge(m, src)
+}
+func (m *CreateShardRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateShardRequest.Size(m)
+}
+func (m *CreateShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShardRequest proto.InternalMessageInfo
+
+func (m *CreateShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CreateShardRequest) GetShardName() string {
+	if m != nil {
+		return m.ShardName
+	}
+	return ""
+}
+
+func (m *CreateShardRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CreateShardRequest) GetIncludeParent() bool {
+	if m != nil {
+		return m.IncludeParent
+	}
+	return false
+}
+
+type CreateShardResponse struct {
+	Shard                *Shard   `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShardResponse) Reset()         { *m = CreateShardResponse{} }
+func (m *CreateShardResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateShardResponse) ProtoMessage()    {}
+func (*CreateShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{62}
+}
+
+func (m *CreateShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShardResponse.Unmarshal(m, b)
+}
+func (m *CreateShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShardResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShardResponse.Merge(m, src)
+}
+func (m *CreateShardResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateShardResponse.Size(m)
+}
+func (m *CreateShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShardResponse proto.InternalMessageInfo
+
+func (m *CreateShardResponse) GetShard() *Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type ValidateShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	PingTablets          bool     `protobuf:"varint,3,opt,name=ping_tablets,json=pingTablets,proto3" json:"ping_tablets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateShardRequest) Reset()         { *m = ValidateShardRequest{} }
+func (m *ValidateShardRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateShardRequest) ProtoMessage()    {}
+func (*ValidateShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{63}
+}
+
+func (m *ValidateShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateShardRequest.Unmarshal(m, b)
+}
+func (m *ValidateShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateShardRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateShardRequest.Merge(m, src)
+}
+func (m *ValidateShardRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateShardRequest.Size(m)
+}
+func (m *ValidateShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateShardRequest proto.InternalMessageInfo
+
+func (m *ValidateShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidateShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ValidateShardRequest) GetPingTablets() bool {
+	if m != nil {
+		return m.PingTablets
+	}
+	return false
+}
+
+// ValidateShardResponse is streamed back by ValidateShard, with the
+// problems found as log lines.
+type ValidateShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateShardResponse) Reset()         { *m = ValidateShardResponse{} }
+func (m *ValidateShardResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateShardResponse) ProtoMessage()    {}
+func (*ValidateShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{64}
+}
+
+func (m *ValidateShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateShardResponse.Unmarshal(m, b)
+}
+func (m *ValidateShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateShardResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateShardResponse.Merge(m, src)
+}
+func (m *ValidateShardResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateShardResponse.Size(m)
+}
+func (m *ValidateShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateShardResponse proto.InternalMessageInfo
+
+func (m *ValidateShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ShardReplicationPositionsRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplicationPositionsRequest) Reset()         { *m = ShardReplicationPositionsRequest{} }
+func (m *ShardReplicationPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationPositionsRequest) ProtoMessage()    {}
+func (*ShardReplicationPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{65}
+}
+
+func (m *ShardReplicationPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationPositionsRequest.Unmarshal(m, b)
+}
+func (m *ShardReplicationPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationPositionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationPositionsRequest.Merge(m, src)
+}
+func (m *ShardReplicationPositionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationPositionsRequest.Size(m)
+}
+func (m *ShardReplicationPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationPositionsRequest proto.InternalMessageInfo
+
+func (m *ShardReplicationPositionsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShardReplicationPositionsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+// ShardReplicationPosition is the replication status of a tablet.
+// status is not set if the tablet couldn't be reached.
+type ShardReplicationPosition struct {
+	Tablet               *topodata.Tablet        `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	Status               *replicationdata.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ShardReplicationPosition) Reset()         { *m = ShardReplicationPosition{} }
+func (m *ShardReplicationPosition) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationPosition) ProtoMessage()    {}
+func (*ShardReplicationPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{66}
+}
+
+func (m *ShardReplicationPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationPosition.Unmarshal(m, b)
+}
+func (m *ShardReplicationPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationPosition.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationPosition.Merge(m, src)
+}
+func (m *ShardReplicationPosition) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationPosition.Size(m)
+}
+func (m *ShardReplicationPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationPosition proto.InternalMessageInfo
+
+func (m *ShardReplicationPosition) GetTablet() *topodata.Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+func (m *ShardReplicationPosition) GetStatus() *replicationdata.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ShardReplicationPositionsResponse struct {
+	Positions            []*ShardReplicationPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ShardReplicationPositionsResponse) Reset()         { *m = ShardReplicationPositionsResponse{} }
+func (m *ShardReplicationPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationPositionsResponse) ProtoMessage()    {}
+func (*ShardReplicationPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{67}
+}
+
+func (m *ShardReplicationPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationPositionsResponse.Unmarshal(m, b)
+}
+func (m *ShardReplicationPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationPositionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationPositionsResponse.Merge(m, src)
+}
+func (m *ShardReplicationPositionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationPositionsResponse.Size(m)
+}
+func (m *ShardReplicationPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationPositionsResponse proto.InternalMessageInfo
+
+func (m *ShardReplicationPositionsResponse) GetPositions() []*ShardReplicationPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type SetShardIsMasterServingRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	IsMasterServing      bool     `protobuf:"varint,3,opt,name=is_master_serving,json=isMasterServing,proto3" json:"is_master_serving,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetShardIsMasterServingRequest) Reset()         { *m = SetShardIsMasterServingRequest{} }
+func (m *SetShardIsMasterServingRequest) String() string { return proto.CompactTextString(m) }
+func (*SetShardIsMasterServingRequest) ProtoMessage()    {}
+func (*SetShardIsMasterServingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{68}
+}
+
+func (m *SetShardIsMasterServingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetShardIsMasterServingRequest.Unmarshal(m, b)
+}
+func (m *SetShardIsMasterServingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetShardIsMasterServingRequest.Marshal(b, m, deterministic)
+}
+func (m *SetShardIsMasterServingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetShardIsMasterServingRequest.Merge(m, src)
+}
+func (m *SetShardIsMasterServingRequest) XXX_Size() int {
+	return xxx_messageInfo_SetShardIsMasterServingRequest.Size(m)
+}
+func (m *SetShardIsMasterServingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetShardIsMasterServingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetShardIsMasterServingRequest proto.InternalMessageInfo
+
+func (m *SetShardIsMasterServingRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SetShardIsMasterServingRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *SetShardIsMasterServingRequest) GetIsMasterServing() bool {
+	if m != nil {
+		return m.IsMasterServing
+	}
+	return false
+}
+
+type SetShardIsMasterServingResponse struct {
+	Shard                *topodata.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetShardIsMasterServingResponse) Reset()         { *m = SetShardIsMasterServingResponse{} }
+func (m *SetShardIsMasterServingResponse) String() string { return proto.CompactTextString(m) }
+func (*SetShardIsMasterServingResponse) ProtoMessage()    {}
+func (*SetShardIsMasterServingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{69}
+}
+
+func (m *SetShardIsMasterServingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetShardIsMasterServingResponse.Unmarshal(m, b)
+}
+func (m *SetShardIsMasterServingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetShardIsMasterServingResponse.Marshal(b, m, deterministic)
+}
+func (m *SetShardIsMasterServingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetShardIsMasterServingResponse.Merge(m, src)
+}
+func (m *SetShardIsMasterServingResponse) XXX_Size() int {
+	return xxx_messageInfo_SetShardIsMasterServingResponse.Size(m)
+}
+func (m *SetShardIsMasterServingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetShardIsMasterServingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetShardIsMasterServingResponse proto.InternalMessageInfo
+
+func (m *SetShardIsMasterServingResponse) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type SetShardTabletControlRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	Cells      []string            `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	// remove removes the cells for vertical splits.
+	Remove            bool     `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
+	BlacklistedTables []string `protobuf:"bytes,6,rep,name=blacklisted_tables,json=blacklistedTables,proto3" json:"blacklisted_tables,omitempty"`
+	// disable_query_service is ignored if remove or blacklisted_tables
+	// is set.
+	DisableQueryService  bool     `protobuf:"varint,7,opt,name=disable_query_service,json=disableQueryService,proto3" json:"disable_query_service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetShardTabletControlRequest) Reset()         { *m = SetShardTabletControlRequest{} }
+func (m *SetShardTabletControlRequest) String() string { return proto.CompactTextString(m) }
+func (*SetShardTabletControlRequest) ProtoMessage()    {}
+func (*SetShardTabletControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{70}
+}
+
+func (m *SetShardTabletControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetShardTabletControlRequest.Unmarshal(m, b)
+}
+func (m *SetShardTabletControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetShardTabletControlRequest.Marshal(b, m, deterministic)
+}
+func (m *SetShardTabletControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetShardTabletControlRequest.Merge(m, src)
+}
+func (m *SetShardTabletControlRequest) XXX_Size() int {
+	return xxx_messageInfo_SetShardTabletControlRequest.Size(m)
+}
+func (m *SetShardTabletControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetShardTabletControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetShardTabletControlRequest proto.InternalMessageInfo
+
+func (m *SetShardTabletControlRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SetShardTabletControlRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *SetShardTabletControlRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *SetShardTabletControlRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *SetShardTabletControlRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+func (m *SetShardTabletControlRequest) GetBlacklistedTables() []string {
+	if m != nil {
+		return m.BlacklistedTables
+	}
+	return nil
+}
+
+func (m *SetShardTabletControlRequest) GetDisableQueryService() bool {
+	if m != nil {
+		return m.DisableQueryService
+	}
+	return false
+}
+
+type SetShardTabletControlResponse struct {
+	Shard                *topodata.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetShardTabletControlResponse) Reset()         { *m = SetShardTabletControlResponse{} }
+func (m *SetShardTabletControlResponse) String() string { return proto.CompactTextString(m) }
+func (*SetShardTabletControlResponse) ProtoMessage()    {}
+func (*SetShardTabletControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{71}
+}
+
+func (m *SetShardTabletControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetShardTabletControlResponse.Unmarshal(m, b)
+}
+func (m *SetShardTabletControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetShardTabletControlResponse.Marshal(b, m, deterministic)
+}
+func (m *SetShardTabletControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetShardTabletControlResponse.Merge(m, src)
+}
+func (m *SetShardTabletControlResponse) XXX_Size() int {
+	return xxx_messageInfo_SetShardTabletControlResponse.Size(m)
+}
+func (m *SetShardTabletControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetShardTabletControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetShardTabletControlResponse proto.InternalMessageInfo
+
+func (m *SetShardTabletControlResponse) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type UpdateSrvKeyspacePartitionRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	Cells      []string            `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	// remove removes the shard from the partition.
+	Remove               bool     `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) Reset()         { *m = UpdateSrvKeyspacePartitionRequest{} }
+func (m *UpdateSrvKeyspacePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSrvKeyspacePartitionRequest) ProtoMessage()    {}
+func (*UpdateSrvKeyspacePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{72}
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionRequest.Unmarshal(m, b)
+}
+func (m *UpdateSrvKeyspacePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateSrvKeyspacePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSrvKeyspacePartitionRequest.Merge(m, src)
+}
+func (m *UpdateSrvKeyspacePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionRequest.Size(m)
+}
+func (m *UpdateSrvKeyspacePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSrvKeyspacePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSrvKeyspacePartitionRequest proto.InternalMessageInfo
+
+func (m *UpdateSrvKeyspacePartitionRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *UpdateSrvKeyspacePartitionRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+type UpdateSrvKeyspacePartitionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSrvKeyspacePartitionResponse) Reset()         { *m = UpdateSrvKeyspacePartitionResponse{} }
+func (m *UpdateSrvKeyspacePartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateSrvKeyspacePartitionResponse) ProtoMessage()    {}
+func (*UpdateSrvKeyspacePartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{73}
+}
+
+func (m *UpdateSrvKeyspacePartitionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionResponse.Unmarshal(m, b)
+}
+func (m *UpdateSrvKeyspacePartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateSrvKeyspacePartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSrvKeyspacePartitionResponse.Merge(m, src)
+}
+func (m *UpdateSrvKeyspacePartitionResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateSrvKeyspacePartitionResponse.Size(m)
+}
+func (m *UpdateSrvKeyspacePartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSrvKeyspacePartitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSrvKeyspacePartitionResponse proto.InternalMessageInfo
+
+type SourceShardDeleteRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Uid                  uint32   `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourceShardDeleteRequest) Reset()         { *m = SourceShardDeleteRequest{} }
+func (m *SourceShardDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*SourceShardDeleteRequest) ProtoMessage()    {}
+func (*SourceShardDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{74}
+}
+
+func (m *SourceShardDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceShardDeleteRequest.Unmarshal(m, b)
+}
+func (m *SourceShardDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceShardDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *SourceShardDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceShardDeleteRequest.Merge(m, src)
+}
+func (m *SourceShardDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_SourceShardDeleteRequest.Size(m)
+}
+func (m *SourceShardDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceShardDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceShardDeleteRequest proto.InternalMessageInfo
+
+func (m *SourceShardDeleteRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SourceShardDeleteRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *SourceShardDeleteRequest) GetUid() uint32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+type SourceShardDeleteResponse struct {
+	Shard                *topodata.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SourceShardDeleteResponse) Reset()         { *m = SourceShardDeleteResponse{} }
+func (m *SourceShardDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*SourceShardDeleteResponse) ProtoMessage()    {}
+func (*SourceShardDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{75}
+}
+
+func (m *SourceShardDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceShardDeleteResponse.Unmarshal(m, b)
+}
+func (m *SourceShardDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceShardDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *SourceShardDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceShardDeleteResponse.Merge(m, src)
+}
+func (m *SourceShardDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_SourceShardDeleteResponse.Size(m)
+}
+func (m *SourceShardDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceShardDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceShardDeleteResponse proto.InternalMessageInfo
+
+func (m *SourceShardDeleteResponse) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type SourceShardAddRequest struct {
+	Keyspace       string             `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard          string             `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Uid            uint32             `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	SourceKeyspace string             `protobuf:"bytes,4,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	SourceShard    string             `protobuf:"bytes,5,opt,name=source_shard,json=sourceShard,proto3" json:"source_shard,omitempty"`
+	KeyRange       *topodata.KeyRange `protobuf:"bytes,6,opt,name=key_range,json=keyRange,proto3" json:"key_range,omitempty"`
+	// tables are the tables to replicate for vertical splits.
+	Tables               []string `protobuf:"bytes,7,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourceShardAddRequest) Reset()         { *m = SourceShardAddRequest{} }
+func (m *SourceShardAddRequest) String() string { return proto.CompactTextString(m) }
+func (*SourceShardAddRequest) ProtoMessage()    {}
+func (*SourceShardAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{76}
+}
+
+func (m *SourceShardAddRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceShardAddRequest.Unmarshal(m, b)
+}
+func (m *SourceShardAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceShardAddRequest.Marshal(b, m, deterministic)
+}
+func (m *SourceShardAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceShardAddRequest.Merge(m, src)
+}
+func (m *SourceShardAddRequest) XXX_Size() int {
+	return xxx_messageInfo_SourceShardAddRequest.Size(m)
+}
+func (m *SourceShardAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceShardAddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceShardAddRequest proto.InternalMessageInfo
+
+func (m *SourceShardAddRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SourceShardAddRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *SourceShardAddRequest) GetUid() uint32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *SourceShardAddRequest) GetSourceKeyspace() string {
+	if m != nil {
+		return m.SourceKeyspace
+	}
+	return ""
+}
+
+func (m *SourceShardAddRequest) GetSourceShard() string {
+	if m != nil {
+		return m.SourceShard
+	}
+	return ""
+}
+
+func (m *SourceShardAddRequest) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+func (m *SourceShardAddRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type SourceShardAddResponse struct {
+	Shard                *topodata.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SourceShardAddResponse) Reset()         { *m = SourceShardAddResponse{} }
+func (m *SourceShardAddResponse) String() string { return proto.CompactTextString(m) }
+func (*SourceShardAddResponse) ProtoMessage()    {}
+func (*SourceShardAddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{77}
+}
+
+func (m *SourceShardAddResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceShardAddResponse.Unmarshal(m, b)
+}
+func (m *SourceShardAddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceShardAddResponse.Marshal(b, m, deterministic)
+}
+func (m *SourceShardAddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceShardAddResponse.Merge(m, src)
+}
+func (m *SourceShardAddResponse) XXX_Size() int {
+	return xxx_messageInfo_SourceShardAddResponse.Size(m)
+}
+func (m *SourceShardAddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceShardAddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceShardAddResponse proto.InternalMessageInfo
+
+func (m *SourceShardAddResponse) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type ShardReplicationAddRequest struct {
+	Keyspace             string                `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string                `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,3,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ShardReplicationAddRequest) Reset()         { *m = ShardReplicationAddRequest{} }
+func (m *ShardReplicationAddRequest) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationAddRequest) ProtoMessage()    {}
+func (*ShardReplicationAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{78}
+}
+
+func (m *ShardReplicationAddRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationAddRequest.Unmarshal(m, b)
+}
+func (m *ShardReplicationAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationAddRequest.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationAddRequest.Merge(m, src)
+}
+func (m *ShardReplicationAddRequest) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationAddRequest.Size(m)
+}
+func (m *ShardReplicationAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationAddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationAddRequest proto.InternalMessageInfo
+
+func (m *ShardReplicationAddRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShardReplicationAddRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ShardReplicationAddRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type ShardReplicationAddResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplicationAddResponse) Reset()         { *m = ShardReplicationAddResponse{} }
+func (m *ShardReplicationAddResponse) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationAddResponse) ProtoMessage()    {}
+func (*ShardReplicationAddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{79}
+}
+
+func (m *ShardReplicationAddResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationAddResponse.Unmarshal(m, b)
+}
+func (m *ShardReplicationAddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationAddResponse.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationAddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationAddResponse.Merge(m, src)
+}
+func (m *ShardReplicationAddResponse) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationAddResponse.Size(m)
+}
+func (m *ShardReplicationAddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationAddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationAddResponse proto.InternalMessageInfo
+
+type ShardReplicationRemoveRequest struct {
+	Keyspace             string                `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string                `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,3,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ShardReplicationRemoveRequest) Reset()         { *m = ShardReplicationRemoveRequest{} }
+func (m *ShardReplicationRemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationRemoveRequest) ProtoMessage()    {}
+func (*ShardReplicationRemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{80}
+}
+
+func (m *ShardReplicationRemoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationRemoveRequest.Unmarshal(m, b)
+}
+func (m *ShardReplicationRemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationRemoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationRemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationRemoveRequest.Merge(m, src)
+}
+func (m *ShardReplicationRemoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationRemoveRequest.Size(m)
+}
+func (m *ShardReplicationRemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationRemoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationRemoveRequest proto.InternalMessageInfo
+
+func (m *ShardReplicationRemoveRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShardReplicationRemoveRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ShardReplicationRemoveRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type ShardReplicationRemoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplicationRemoveResponse) Reset()         { *m = ShardReplicationRemoveResponse{} }
+func (m *ShardReplicationRemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationRemoveResponse) ProtoMessage()    {}
+func (*ShardReplicationRemoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{81}
+}
+
+func (m *ShardReplicationRemoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationRemoveResponse.Unmarshal(m, b)
+}
+func (m *ShardReplicationRemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationRemoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationRemoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationRemoveResponse.Merge(m, src)
+}
+func (m *ShardReplicationRemoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationRemoveResponse.Size(m)
+}
+func (m *ShardReplicationRemoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationRemoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationRemoveResponse proto.InternalMessageInfo
+
+type ShardReplicationFixRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Keyspace             string   `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplicationFixRequest) Reset()         { *m = ShardReplicationFixRequest{} }
+func (m *ShardReplicationFixRequest) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationFixRequest) ProtoMessage()    {}
+func (*ShardReplicationFixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{82}
+}
+
+func (m *ShardReplicationFixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationFixRequest.Unmarshal(m, b)
+}
+func (m *ShardReplicationFixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationFixRequest.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationFixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationFixRequest.Merge(m, src)
+}
+func (m *ShardReplicationFixRequest) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationFixRequest.Size(m)
+}
+func (m *ShardReplicationFixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationFixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationFixRequest proto.InternalMessageInfo
+
+func (m *ShardReplicationFixRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *ShardReplicationFixRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShardReplicationFixRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type ShardReplicationFixResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardReplicationFixResponse) Reset()         { *m = ShardReplicationFixResponse{} }
+func (m *ShardReplicationFixResponse) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationFixResponse) ProtoMessage()    {}
+func (*ShardReplicationFixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{83}
+}
+
+func (m *ShardReplicationFixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplicationFixResponse.Unmarshal(m, b)
+}
+func (m *ShardReplicationFixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplicationFixResponse.Marshal(b, m, deterministic)
+}
+func (m *ShardReplicationFixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationFixResponse.Merge(m, src)
+}
+func (m *ShardReplicationFixResponse) XXX_Size() int {
+	return xxx_messageInfo_ShardReplicationFixResponse.Size(m)
+}
+func (m *ShardReplicationFixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationFixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationFixResponse proto.InternalMessageInfo
+
+type WaitForFilteredReplicationRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// max_delay is the delay under which the wait is over. The default
+	// of the wrangler is used if it's not set.
+	MaxDelay             *vttime.Duration `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WaitForFilteredReplicationRequest) Reset()         { *m = WaitForFilteredReplicationRequest{} }
+func (m *WaitForFilteredReplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitForFilteredReplicationRequest) ProtoMessage()    {}
+func (*WaitForFilteredReplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{84}
+}
+
+func (m *WaitForFilteredReplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForFilteredReplicationRequest.Unmarshal(m, b)
+}
+func (m *WaitForFilteredReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForFilteredReplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitForFilteredReplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForFilteredReplicationRequest.Merge(m, src)
+}
+func (m *WaitForFilteredReplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitForFilteredReplicationRequest.Size(m)
+}
+func (m *WaitForFilteredReplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForFilteredReplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForFilteredReplicationRequest proto.InternalMessageInfo
+
+func (m *WaitForFilteredReplicationRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *WaitForFilteredReplicationRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *WaitForFilteredReplicationRequest) GetMaxDelay() *vttime.Duration {
+	if m != nil {
+		return m.MaxDelay
+	}
+	return nil
+}
+
+// WaitForFilteredReplicationResponse is streamed back by
+// WaitForFilteredReplication, with the delay seen as log lines.
+type WaitForFilteredReplicationResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WaitForFilteredReplicationResponse) Reset()         { *m = WaitForFilteredReplicationResponse{} }
+func (m *WaitForFilteredReplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitForFilteredReplicationResponse) ProtoMessage()    {}
+func (*WaitForFilteredReplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{85}
+}
+
+func (m *WaitForFilteredReplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForFilteredReplicationResponse.Unmarshal(m, b)
+}
+func (m *WaitForFilteredReplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForFilteredReplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *WaitForFilteredReplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForFilteredReplicationResponse.Merge(m, src)
+}
+func (m *WaitForFilteredReplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitForFilteredReplicationResponse.Size(m)
+}
+func (m *WaitForFilteredReplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForFilteredReplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForFilteredReplicationResponse proto.InternalMessageInfo
+
+func (m *WaitForFilteredReplicationResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type RemoveShardCellRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Cell     string `protobuf:"bytes,3,opt,name=cell,proto3" json:"cell,omitempty"`
+	// force proceeds even if the cell's topology server can't be reached.
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// recursive also deletes the tablets of the shard in the cell.
+	Recursive            bool     `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveShardCellRequest) Reset()         { *m = RemoveShardCellRequest{} }
+func (m *RemoveShardCellRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveShardCellRequest) ProtoMessage()    {}
+func (*RemoveShardCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{86}
+}
+
+func (m *RemoveShardCellRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveShardCellRequest.Unmarshal(m, b)
+}
+func (m *RemoveShardCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveShardCellRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveShardCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveShardCellRequest.Merge(m, src)
+}
+func (m *RemoveShardCellRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveShardCellRequest.Size(m)
+}
+func (m *RemoveShardCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveShardCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveShardCellRequest proto.InternalMessageInfo
+
+func (m *RemoveShardCellRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RemoveShardCellRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *RemoveShardCellRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *RemoveShardCellRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *RemoveShardCellRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type RemoveShardCellResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveShardCellResponse) Reset()         { *m = RemoveShardCellResponse{} }
+func (m *RemoveShardCellResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveShardCellResponse) ProtoMessage()    {}
+func (*RemoveShardCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{87}
+}
+
+func (m *RemoveShardCellResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveShardCellResponse.Unmarshal(m, b)
+}
+func (m *RemoveShardCellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveShardCellResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveShardCellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveShardCellResponse.Merge(m, src)
+}
+func (m *RemoveShardCellResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveShardCellResponse.Size(m)
+}
+func (m *RemoveShardCellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveShardCellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveShardCellResponse proto.InternalMessageInfo
+
+type DeleteShardsRequest struct {
+	// shards are identified by keyspace and name. The shards that don't
+	// exist are skipped.
+	Shards []*Shard `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	// recursive also deletes the tablets of the shards.
+	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	EvenIfServing        bool     `protobuf:"varint,3,opt,name=even_if_serving,json=evenIfServing,proto3" json:"even_if_serving,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteShardsRequest) Reset()         { *m = DeleteShardsRequest{} }
+func (m *DeleteShardsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteShardsRequest) ProtoMessage()    {}
+func (*DeleteShardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{88}
+}
+
+func (m *DeleteShardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteShardsRequest.Unmarshal(m, b)
+}
+func (m *DeleteShardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteShardsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteShardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteShardsRequest.Merge(m, src)
+}
+func (m *DeleteShardsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteShardsRequest.Size(m)
+}
+func (m *DeleteShardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteShardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteShardsRequest proto.InternalMessageInfo
+
+func (m *DeleteShardsRequest) GetShards() []*Shard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *DeleteShardsRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *DeleteShardsRequest) GetEvenIfServing() bool {
+	if m != nil {
+		return m.EvenIfServing
+	}
+	return false
+}
+
+type DeleteShardsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteShardsResponse) Reset()         { *m = DeleteShardsResponse{} }
+func (m *DeleteShardsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteShardsResponse) ProtoMessage()    {}
+func (*DeleteShardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{89}
+}
+
+func (m *DeleteShardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteShardsResponse.Unmarshal(m, b)
+}
+func (m *DeleteShardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteShardsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteShardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteShardsResponse.Merge(m, src)
+}
+func (m *DeleteShardsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteShardsResponse.Size(m)
+}
+func (m *DeleteShardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteShardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteShardsResponse proto.InternalMessageInfo
+
+type CreateKeyspaceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// force doesn't fail if the keyspace already exists.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// allow_empty_v_schema doesn't create an empty vschema for the
+	// keyspace.
+	AllowEmptyVSchema  bool                            `protobuf:"varint,3,opt,name=allow_empty_v_schema,json=allowEmptyVSchema,proto3" json:"allow_empty_v_schema,omitempty"`
+	ShardingColumnName string                          `protobuf:"bytes,4,opt,name=sharding_column_name,json=shardingColumnName,proto3" json:"sharding_column_name,omitempty"`
+	ShardingColumnType topodata.KeyspaceIdType         `protobuf:"varint,5,opt,name=sharding_column_type,json=shardingColumnType,proto3,enum=topodata.KeyspaceIdType" json:"sharding_column_type,omitempty"`
+	ServedFroms        []*topodata.Keyspace_ServedFrom `protobuf:"bytes,6,rep,name=served_froms,json=servedFroms,proto3" json:"served_froms,omitempty"`
+	Type               topodata.KeyspaceType           `protobuf:"varint,7,opt,name=type,proto3,enum=topodata.KeyspaceType" json:"type,omitempty"`
+	// base_keyspace and snapshot_time are required for a SNAPSHOT
+	// keyspace.
+	BaseKeyspace         string       `protobuf:"bytes,8,opt,name=base_keyspace,json=baseKeyspace,proto3" json:"base_keyspace,omitempty"`
+	SnapshotTime         *vttime.Time `protobuf:"bytes,9,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateKeyspaceRequest) Reset()         { *m = CreateKeyspaceRequest{} }
+func (m *CreateKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateKeyspaceRequest) ProtoMessage()    {}
+func (*CreateKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{90}
+}
+
+func (m *CreateKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *CreateKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateKeyspaceRequest.Merge(m, src)
+}
+func (m *CreateKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateKeyspaceRequest.Size(m)
+}
+func (m *CreateKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateKeyspaceRequest proto.InternalMessageInfo
+
+func (m *CreateKeyspaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateKeyspaceRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CreateKeyspaceRequest) GetAllowEmptyVSchema() bool {
+	if m != nil {
+		return m.AllowEmptyVSchema
+	}
+	return false
+}
+
+func (m *CreateKeyspaceRequest) GetShardingColumnName() string {
+	if m != nil {
+		return m.ShardingColumnName
+	}
+	return ""
+}
+
+func (m *CreateKeyspaceRequest) GetShardingColumnType() topodata.KeyspaceIdType {
+	if m != nil {
+		return m.ShardingColumnType
+	}
+	return topodata.KeyspaceIdType_UNSET
+}
+
+func (m *CreateKeyspaceRequest) GetServedFroms() []*topodata.Keyspace_ServedFrom {
+	if m != nil {
+		return m.ServedFroms
+	}
+	return nil
+}
+
+func (m *CreateKeyspaceRequest) GetType() topodata.KeyspaceType {
+	if m != nil {
+		return m.Type
+	}
+	return topodata.KeyspaceType_NORMAL
+}
+
+func (m *CreateKeyspaceRequest) GetBaseKeyspace() string {
+	if m != nil {
+		return m.BaseKeyspace
+	}
+	return ""
+}
+
+func (m *CreateKeyspaceRequest) GetSnapshotTime() *vttime.Time {
+	if m != nil {
+		return m.SnapshotTime
+	}
+	return nil
+}
+
+type CreateKeyspaceResponse struct {
+	Keyspace             *Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateKeyspaceResponse) Reset()         { *m = CreateKeyspaceResponse{} }
+func (m *CreateKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateKeyspaceResponse) ProtoMessage()    {}
+func (*CreateKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{91}
+}
+
+func (m *CreateKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *CreateKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateKeyspaceResponse.Merge(m, src)
+}
+func (m *CreateKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateKeyspaceResponse.Size(m)
+}
+func (m *CreateKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateKeyspaceResponse proto.InternalMessageInfo
+
+func (m *CreateKeyspaceResponse) GetKeyspace() *Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type DeleteKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// recursive also deletes the shards of the keyspace.
+	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteKeyspaceRequest) Reset()         { *m = DeleteKeyspaceRequest{} }
+func (m *DeleteKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceRequest) ProtoMessage()    {}
+func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{92}
+}
+
+func (m *DeleteKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *DeleteKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeyspaceRequest.Merge(m, src)
+}
+func (m *DeleteKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Size(m)
+}
+func (m *DeleteKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteKeyspaceRequest proto.InternalMessageInfo
+
+func (m *DeleteKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DeleteKeyspaceRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type DeleteKeyspaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteKeyspaceResponse) Reset()         { *m = DeleteKeyspaceResponse{} }
+func (m *DeleteKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceResponse) ProtoMessage()    {}
+func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{93}
+}
+
+func (m *DeleteKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *DeleteKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeyspaceResponse.Merge(m, src)
+}
+func (m *DeleteKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Size(m)
+}
+func (m *DeleteKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteKeyspaceResponse proto.InternalMessageInfo
+
+type RemoveKeyspaceCellRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Cell     string `protobuf:"bytes,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	// force proceeds even if the cell's topology server can't be reached.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// recursive also deletes the tablets of the keyspace in the cell.
+	Recursive            bool     `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveKeyspaceCellRequest) Reset()         { *m = RemoveKeyspaceCellRequest{} }
+func (m *RemoveKeyspaceCellRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveKeyspaceCellRequest) ProtoMessage()    {}
+func (*RemoveKeyspaceCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{94}
+}
+
+func (m *RemoveKeyspaceCellRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveKeyspaceCellRequest.Unmarshal(m, b)
+}
+func (m *RemoveKeyspaceCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveKeyspaceCellRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveKeyspaceCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveKeyspaceCellRequest.Merge(m, src)
+}
+func (m *RemoveKeyspaceCellRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveKeyspaceCellRequest.Size(m)
+}
+func (m *RemoveKeyspaceCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveKeyspaceCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveKeyspaceCellRequest proto.InternalMessageInfo
+
+func (m *RemoveKeyspaceCellRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RemoveKeyspaceCellRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *RemoveKeyspaceCellRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *RemoveKeyspaceCellRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type RemoveKeyspaceCellResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveKeyspaceCellResponse) Reset()         { *m = RemoveKeyspaceCellResponse{} }
+func (m *RemoveKeyspaceCellResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveKeyspaceCellResponse) ProtoMessage()    {}
+func (*RemoveKeyspaceCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{95}
+}
+
+func (m *RemoveKeyspaceCellResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveKeyspaceCellResponse.Unmarshal(m, b)
+}
+func (m *RemoveKeyspaceCellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveKeyspaceCellResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveKeyspaceCellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveKeyspaceCellResponse.Merge(m, src)
+}
+func (m *RemoveKeyspaceCellResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveKeyspaceCellResponse.Size(m)
+}
+func (m *RemoveKeyspaceCellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveKeyspaceCellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveKeyspaceCellResponse proto.InternalMessageInfo
+
+type SetKeyspaceShardingInfoRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// column_name and column_type must be both set, or both unset.
+	ColumnName string                  `protobuf:"bytes,2,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	ColumnType topodata.KeyspaceIdType `protobuf:"varint,3,opt,name=column_type,json=columnType,proto3,enum=topodata.KeyspaceIdType" json:"column_type,omitempty"`
+	// force updates the fields even if they are already set.
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyspaceShardingInfoRequest) Reset()         { *m = SetKeyspaceShardingInfoRequest{} }
+func (m *SetKeyspaceShardingInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceShardingInfoRequest) ProtoMessage()    {}
+func (*SetKeyspaceShardingInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{96}
+}
+
+func (m *SetKeyspaceShardingInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceShardingInfoRequest.Unmarshal(m, b)
+}
+func (m *SetKeyspaceShardingInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceShardingInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceShardingInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceShardingInfoRequest.Merge(m, src)
+}
+func (m *SetKeyspaceShardingInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceShardingInfoRequest.Size(m)
+}
+func (m *SetKeyspaceShardingInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceShardingInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceShardingInfoRequest proto.InternalMessageInfo
+
+func (m *SetKeyspaceShardingInfoRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SetKeyspaceShardingInfoRequest) GetColumnName() string {
+	if m != nil {
+		return m.ColumnName
+	}
+	return ""
+}
+
+func (m *SetKeyspaceShardingInfoRequest) GetColumnType() topodata.KeyspaceIdType {
+	if m != nil {
+		return m.ColumnType
+	}
+	return topodata.KeyspaceIdType_UNSET
+}
+
+func (m *SetKeyspaceShardingInfoRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type SetKeyspaceShardingInfoResponse struct {
+	Keyspace             *topodata.Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetKeyspaceShardingInfoResponse) Reset()         { *m = SetKeyspaceShardingInfoResponse{} }
+func (m *SetKeyspaceShardingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceShardingInfoResponse) ProtoMessage()    {}
+func (*SetKeyspaceShardingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{97}
+}
+
+func (m *SetKeyspaceShardingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceShardingInfoResponse.Unmarshal(m, b)
+}
+func (m *SetKeyspaceShardingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceShardingInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceShardingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceShardingInfoResponse.Merge(m, src)
+}
+func (m *SetKeyspaceShardingInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceShardingInfoResponse.Size(m)
+}
+func (m *SetKeyspaceShardingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceShardingInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceShardingInfoResponse proto.InternalMessageInfo
+
+func (m *SetKeyspaceShardingInfoResponse) GetKeyspace() *topodata.Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type SetKeyspaceDDLStrategyRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	DdlStrategy          string   `protobuf:"bytes,2,opt,name=ddl_strategy,json=ddlStrategy,proto3" json:"ddl_strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyspaceDDLStrategyRequest) Reset()         { *m = SetKeyspaceDDLStrategyRequest{} }
+func (m *SetKeyspaceDDLStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceDDLStrategyRequest) ProtoMessage()    {}
+func (*SetKeyspaceDDLStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{98}
+}
+
+func (m *SetKeyspaceDDLStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyRequest.Unmarshal(m, b)
+}
+func (m *SetKeyspaceDDLStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceDDLStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceDDLStrategyRequest.Merge(m, src)
+}
+func (m *SetKeyspaceDDLStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyRequest.Size(m)
+}
+func (m *SetKeyspaceDDLStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceDDLStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceDDLStrategyRequest proto.InternalMessageInfo
+
+func (m *SetKeyspaceDDLStrategyRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SetKeyspaceDDLStrategyRequest) GetDdlStrategy() string {
+	if m != nil {
+		return m.DdlStrategy
+	}
+	return ""
+}
+
+type SetKeyspaceDDLStrategyResponse struct {
+	Keyspace             *topodata.Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetKeyspaceDDLStrategyResponse) Reset()         { *m = SetKeyspaceDDLStrategyResponse{} }
+func (m *SetKeyspaceDDLStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceDDLStrategyResponse) ProtoMessage()    {}
+func (*SetKeyspaceDDLStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{99}
+}
+
+func (m *SetKeyspaceDDLStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyResponse.Unmarshal(m, b)
+}
+func (m *SetKeyspaceDDLStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyResponse.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceDDLStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceDDLStrategyResponse.Merge(m, src)
+}
+func (m *SetKeyspaceDDLStrategyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceDDLStrategyResponse.Size(m)
+}
+func (m *SetKeyspaceDDLStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceDDLStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceDDLStrategyResponse proto.InternalMessageInfo
+
+func (m *SetKeyspaceDDLStrategyResponse) GetKeyspace() *topodata.Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type SetKeyspaceServedFromRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,2,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	Cells      []string            `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	// remove removes the served from record instead of adding it.
+	Remove               bool     `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+	SourceKeyspace       string   `protobuf:"bytes,5,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyspaceServedFromRequest) Reset()         { *m = SetKeyspaceServedFromRequest{} }
+func (m *SetKeyspaceServedFromRequest) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceServedFromRequest) ProtoMessage()    {}
+func (*SetKeyspaceServedFromRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{100}
+}
+
+func (m *SetKeyspaceServedFromRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceServedFromRequest.Unmarshal(m, b)
+}
+func (m *SetKeyspaceServedFromRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceServedFromRequest.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceServedFromRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceServedFromRequest.Merge(m, src)
+}
+func (m *SetKeyspaceServedFromRequest) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceServedFromRequest.Size(m)
+}
+func (m *SetKeyspaceServedFromRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceServedFromRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceServedFromRequest proto.InternalMessageInfo
+
+func (m *SetKeyspaceServedFromRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SetKeyspaceServedFromRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *SetKeyspaceServedFromRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *SetKeyspaceServedFromRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+func (m *SetKeyspaceServedFromRequest) GetSourceKeyspace() string {
+	if m != nil {
+		return m.SourceKeyspace
+	}
+	return ""
+}
+
+type SetKeyspaceServedFromResponse struct {
+	Keyspace             *topodata.Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetKeyspaceServedFromResponse) Reset()         { *m = SetKeyspaceServedFromResponse{} }
+func (m *SetKeyspaceServedFromResponse) String() string { return proto.CompactTextString(m) }
+func (*SetKeyspaceServedFromResponse) ProtoMessage()    {}
+func (*SetKeyspaceServedFromResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{101}
+}
+
+func (m *SetKeyspaceServedFromResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyspaceServedFromResponse.Unmarshal(m, b)
+}
+func (m *SetKeyspaceServedFromResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyspaceServedFromResponse.Marshal(b, m, deterministic)
+}
+func (m *SetKeyspaceServedFromResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyspaceServedFromResponse.Merge(m, src)
+}
+func (m *SetKeyspaceServedFromResponse) XXX_Size() int {
+	return xxx_messageInfo_SetKeyspaceServedFromResponse.Size(m)
+}
+func (m *SetKeyspaceServedFromResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyspaceServedFromResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyspaceServedFromResponse proto.InternalMessageInfo
+
+func (m *SetKeyspaceServedFromResponse) GetKeyspace() *topodata.Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type RebuildKeyspaceGraphRequest struct {
+	Keyspaces []string `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty"`
+	// cells is optional, all cells are rebuilt if empty.
+	Cells                []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildKeyspaceGraphRequest) Reset()         { *m = RebuildKeyspaceGraphRequest{} }
+func (m *RebuildKeyspaceGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildKeyspaceGraphRequest) ProtoMessage()    {}
+func (*RebuildKeyspaceGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{102}
+}
+
+func (m *RebuildKeyspaceGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildKeyspaceGraphRequest.Unmarshal(m, b)
+}
+func (m *RebuildKeyspaceGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildKeyspaceGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *RebuildKeyspaceGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildKeyspaceGraphRequest.Merge(m, src)
+}
+func (m *RebuildKeyspaceGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_RebuildKeyspaceGraphRequest.Size(m)
+}
+func (m *RebuildKeyspaceGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildKeyspaceGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildKeyspaceGraphRequest proto.InternalMessageInfo
+
+func (m *RebuildKeyspaceGraphRequest) GetKeyspaces() []string {
+	if m != nil {
+		return m.Keyspaces
+	}
+	return nil
+}
+
+func (m *RebuildKeyspaceGraphRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type RebuildKeyspaceGraphResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildKeyspaceGraphResponse) Reset()         { *m = RebuildKeyspaceGraphResponse{} }
+func (m *RebuildKeyspaceGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildKeyspaceGraphResponse) ProtoMessage()    {}
+func (*RebuildKeyspaceGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{103}
+}
+
+func (m *RebuildKeyspaceGraphResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildKeyspaceGraphResponse.Unmarshal(m, b)
+}
+func (m *RebuildKeyspaceGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildKeyspaceGraphResponse.Marshal(b, m, deterministic)
+}
+func (m *RebuildKeyspaceGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildKeyspaceGraphResponse.Merge(m, src)
+}
+func (m *RebuildKeyspaceGraphResponse) XXX_Size() int {
+	return xxx_messageInfo_RebuildKeyspaceGraphResponse.Size(m)
+}
+func (m *RebuildKeyspaceGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildKeyspaceGraphResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildKeyspaceGraphResponse proto.InternalMessageInfo
+
+type ValidateKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	PingTablets          bool     `protobuf:"varint,2,opt,name=ping_tablets,json=pingTablets,proto3" json:"ping_tablets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateKeyspaceRequest) Reset()         { *m = ValidateKeyspaceRequest{} }
+func (m *ValidateKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateKeyspaceRequest) ProtoMessage()    {}
+func (*ValidateKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{104}
+}
+
+func (m *ValidateKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *ValidateKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateKeyspaceRequest.Merge(m, src)
+}
+func (m *ValidateKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateKeyspaceRequest.Size(m)
+}
+func (m *ValidateKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateKeyspaceRequest proto.InternalMessageInfo
+
+func (m *ValidateKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidateKeyspaceRequest) GetPingTablets() bool {
+	if m != nil {
+		return m.PingTablets
+	}
+	return false
+}
+
+// ValidateKeyspaceResponse is streamed back by ValidateKeyspace, with
+// the problems found as log lines.
+type ValidateKeyspaceResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateKeyspaceResponse) Reset()         { *m = ValidateKeyspaceResponse{} }
+func (m *ValidateKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateKeyspaceResponse) ProtoMessage()    {}
+func (*ValidateKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{105}
+}
+
+func (m *ValidateKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *ValidateKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateKeyspaceResponse.Merge(m, src)
+}
+func (m *ValidateKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateKeyspaceResponse.Size(m)
+}
+func (m *ValidateKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateKeyspaceResponse proto.InternalMessageInfo
+
+func (m *ValidateKeyspaceResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type MoveTablesRequest struct {
+	Workflow       string `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	SourceKeyspace string `protobuf:"bytes,2,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	TargetKeyspace string `protobuf:"bytes,3,opt,name=target_keyspace,json=targetKeyspace,proto3" json:"target_keyspace,omitempty"`
+	// table_specs is either a comma-separated list of tables, or the
+	// JSON of the vschema of the tables.
+	TableSpecs string `protobuf:"bytes,4,opt,name=table_specs,json=tableSpecs,proto3" json:"table_specs,omitempty"`
+	// cell and tablet_types select the source tablets to replicate from.
+	Cell        string `protobuf:"bytes,5,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes string `protobuf:"bytes,6,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// compression is the gRPC compressor of the vreplication streams.
+	Compression          string   `protobuf:"bytes,7,opt,name=compression,proto3" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTablesRequest) Reset()         { *m = MoveTablesRequest{} }
+func (m *MoveTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTablesRequest) ProtoMessage()    {}
+func (*MoveTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{106}
+}
+
+func (m *MoveTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveTablesRequest.Unmarshal(m, b)
+}
+func (m *MoveTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveTablesRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTablesRequest.Merge(m, src)
+}
+func (m *MoveTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveTablesRequest.Size(m)
+}
+func (m *MoveTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTablesRequest proto.InternalMessageInfo
+
+func (m *MoveTablesRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetSourceKeyspace() string {
+	if m != nil {
+		return m.SourceKeyspace
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetTargetKeyspace() string {
+	if m != nil {
+		return m.TargetKeyspace
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetTableSpecs() string {
+	if m != nil {
+		return m.TableSpecs
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *MoveTablesRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+// MoveTablesResponse is streamed back by MoveTables, with its log lines.
+type MoveTablesResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MoveTablesResponse) Reset()         { *m = MoveTablesResponse{} }
+func (m *MoveTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveTablesResponse) ProtoMessage()    {}
+func (*MoveTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{107}
+}
+
+func (m *MoveTablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveTablesResponse.Unmarshal(m, b)
+}
+func (m *MoveTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveTablesResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTablesResponse.Merge(m, src)
+}
+func (m *MoveTablesResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveTablesResponse.Size(m)
+}
+func (m *MoveTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTablesResponse proto.InternalMessageInfo
+
+func (m *MoveTablesResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type CreateLookupVindexRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// spec has the lookup vindex to create, and the tables which use it.
+	Spec                 *vschema.Keyspace `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Cell                 string            `protobuf:"bytes,3,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes          string            `protobuf:"bytes,4,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateLookupVindexRequest) Reset()         { *m = CreateLookupVindexRequest{} }
+func (m *CreateLookupVindexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateLookupVindexRequest) ProtoMessage()    {}
+func (*CreateLookupVindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{108}
+}
+
+func (m *CreateLookupVindexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLookupVindexRequest.Unmarshal(m, b)
+}
+func (m *CreateLookupVindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLookupVindexRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateLookupVindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLookupVindexRequest.Merge(m, src)
+}
+func (m *CreateLookupVindexRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateLookupVindexRequest.Size(m)
+}
+func (m *CreateLookupVindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLookupVindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLookupVindexRequest proto.InternalMessageInfo
+
+func (m *CreateLookupVindexRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CreateLookupVindexRequest) GetSpec() *vschema.Keyspace {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CreateLookupVindexRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *CreateLookupVindexRequest) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+type CreateLookupVindexResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLookupVindexResponse) Reset()         { *m = CreateLookupVindexResponse{} }
+func (m *CreateLookupVindexResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateLookupVindexResponse) ProtoMessage()    {}
+func (*CreateLookupVindexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{109}
+}
+
+func (m *CreateLookupVindexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLookupVindexResponse.Unmarshal(m, b)
+}
+func (m *CreateLookupVindexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLookupVindexResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateLookupVindexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLookupVindexResponse.Merge(m, src)
+}
+func (m *CreateLookupVindexResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateLookupVindexResponse.Size(m)
+}
+func (m *CreateLookupVindexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLookupVindexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLookupVindexResponse proto.InternalMessageInfo
+
+type BackfillLookupVindexRequest struct {
+	Keyspace    string            `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Spec        *vschema.Keyspace `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Cell        string            `protobuf:"bytes,3,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes string            `protobuf:"bytes,4,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// poll_interval is how often the progress of the backfill is checked
+	// and logged. It's 5 seconds if it's not set.
+	PollInterval         *vttime.Duration `protobuf:"bytes,5,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BackfillLookupVindexRequest) Reset()         { *m = BackfillLookupVindexRequest{} }
+func (m *BackfillLookupVindexRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillLookupVindexRequest) ProtoMessage()    {}
+func (*BackfillLookupVindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{110}
+}
+
+func (m *BackfillLookupVindexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillLookupVindexRequest.Unmarshal(m, b)
+}
+func (m *BackfillLookupVindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillLookupVindexRequest.Marshal(b, m, deterministic)
+}
+func (m *BackfillLookupVindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillLookupVindexRequest.Merge(m, src)
+}
+func (m *BackfillLookupVindexRequest) XXX_Size() int {
+	return xxx_messageInfo_BackfillLookupVindexRequest.Size(m)
+}
+func (m *BackfillLookupVindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillLookupVindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillLookupVindexRequest proto.InternalMessageInfo
+
+func (m *BackfillLookupVindexRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *BackfillLookupVindexRequest) GetSpec() *vschema.Keyspace {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *BackfillLookupVindexRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *BackfillLookupVindexRequest) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *BackfillLookupVindexRequest) GetPollInterval() *vttime.Duration {
+	if m != nil {
+		return m.PollInterval
+	}
+	return nil
+}
+
+// BackfillLookupVindexResponse is streamed back by BackfillLookupVindex,
+// with the progress of the backfill as log lines.
+type BackfillLookupVindexResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BackfillLookupVindexResponse) Reset()         { *m = BackfillLookupVindexResponse{} }
+func (m *BackfillLookupVindexResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillLookupVindexResponse) ProtoMessage()    {}
+func (*BackfillLookupVindexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{111}
+}
+
+func (m *BackfillLookupVindexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillLookupVindexResponse.Unmarshal(m, b)
+}
+func (m *BackfillLookupVindexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillLookupVindexResponse.Marshal(b, m, deterministic)
+}
+func (m *BackfillLookupVindexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillLookupVindexResponse.Merge(m, src)
+}
+func (m *BackfillLookupVindexResponse) XXX_Size() int {
+	return xxx_messageInfo_BackfillLookupVindexResponse.Size(m)
+}
+func (m *BackfillLookupVindexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillLookupVindexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillLookupVindexResponse proto.InternalMessageInfo
+
+func (m *BackfillLookupVindexResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ExternalizeVindexRequest struct {
+	// name is the qualified name of the vindex, keyspace.vindex.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalizeVindexRequest) Reset()         { *m = ExternalizeVindexRequest{} }
+func (m *ExternalizeVindexRequest) String() string { return proto.CompactTextString(m) }
+func (*ExternalizeVindexRequest) ProtoMessage()    {}
+func (*ExternalizeVindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{112}
+}
+
+func (m *ExternalizeVindexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalizeVindexRequest.Unmarshal(m, b)
+}
+func (m *ExternalizeVindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalizeVindexRequest.Marshal(b, m, deterministic)
+}
+func (m *ExternalizeVindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalizeVindexRequest.Merge(m, src)
+}
+func (m *ExternalizeVindexRequest) XXX_Size() int {
+	return xxx_messageInfo_ExternalizeVindexRequest.Size(m)
+}
+func (m *ExternalizeVindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalizeVindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalizeVindexRequest proto.InternalMessageInfo
+
+func (m *ExternalizeVindexRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ExternalizeVindexResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalizeVindexResponse) Reset()         { *m = ExternalizeVindexResponse{} }
+func (m *ExternalizeVindexResponse) String() string { return proto.CompactTextString(m) }
+func (*ExternalizeVindexResponse) ProtoMessage()    {}
+func (*ExternalizeVindexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{113}
+}
+
+func (m *ExternalizeVindexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalizeVindexResponse.Unmarshal(m, b)
+}
+func (m *ExternalizeVindexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalizeVindexResponse.Marshal(b, m, deterministic)
+}
+func (m *ExternalizeVindexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalizeVindexResponse.Merge(m, src)
+}
+func (m *ExternalizeVindexResponse) XXX_Size() int {
+	return xxx_messageInfo_ExternalizeVindexResponse.Size(m)
+}
+func (m *ExternalizeVindexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalizeVindexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalizeVindexResponse proto.InternalMessageInfo
+
+type MaterializeRequest struct {
+	Settings             *MaterializeSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MaterializeRequest) Reset()         { *m = MaterializeRequest{} }
+func (m *MaterializeRequest) String() string { return proto.CompactTextString(m) }
+func (*MaterializeRequest) ProtoMessage()    {}
+func (*MaterializeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{114}
+}
+
+func (m *MaterializeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaterializeRequest.Unmarshal(m, b)
+}
+func (m *MaterializeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaterializeRequest.Marshal(b, m, deterministic)
+}
+func (m *MaterializeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializeRequest.Merge(m, src)
+}
+func (m *MaterializeRequest) XXX_Size() int {
+	return xxx_messageInfo_MaterializeRequest.Size(m)
+}
+func (m *MaterializeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializeRequest proto.InternalMessageInfo
+
+func (m *MaterializeRequest) GetSettings() *MaterializeSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+// MaterializeResponse is streamed back by Materialize, with its log
+// lines.
+type MaterializeResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MaterializeResponse) Reset()         { *m = MaterializeResponse{} }
+func (m *MaterializeResponse) String() string { return proto.CompactTextString(m) }
+func (*MaterializeResponse) ProtoMessage()    {}
+func (*MaterializeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{115}
+}
+
+func (m *MaterializeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaterializeResponse.Unmarshal(m, b)
+}
+func (m *MaterializeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaterializeResponse.Marshal(b, m, deterministic)
+}
+func (m *MaterializeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializeResponse.Merge(m, src)
+}
+func (m *MaterializeResponse) XXX_Size() int {
+	return xxx_messageInfo_MaterializeResponse.Size(m)
+}
+func (m *MaterializeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializeResponse proto.InternalMessageInfo
+
+func (m *MaterializeResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SplitCloneRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	FromShards           []string `protobuf:"bytes,2,rep,name=from_shards,json=fromShards,proto3" json:"from_shards,omitempty"`
+	ToShards             []string `protobuf:"bytes,3,rep,name=to_shards,json=toShards,proto3" json:"to_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplitCloneRequest) Reset()         { *m = SplitCloneRequest{} }
+func (m *SplitCloneRequest) String() string { return proto.CompactTextString(m) }
+func (*SplitCloneRequest) ProtoMessage()    {}
+func (*SplitCloneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{116}
+}
+
+func (m *SplitCloneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitCloneRequest.Unmarshal(m, b)
+}
+func (m *SplitCloneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitCloneRequest.Marshal(b, m, deterministic)
+}
+func (m *SplitCloneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitCloneRequest.Merge(m, src)
+}
+func (m *SplitCloneRequest) XXX_Size() int {
+	return xxx_messageInfo_SplitCloneRequest.Size(m)
+}
+func (m *SplitCloneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitCloneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitCloneRequest proto.InternalMessageInfo
+
+func (m *SplitCloneRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SplitCloneRequest) GetFromShards() []string {
+	if m != nil {
+		return m.FromShards
+	}
+	return nil
+}
+
+func (m *SplitCloneRequest) GetToShards() []string {
+	if m != nil {
+		return m.ToShards
+	}
+	return nil
+}
+
+type SplitCloneResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplitCloneResponse) Reset()         { *m = SplitCloneResponse{} }
+func (m *SplitCloneResponse) String() string { return proto.CompactTextString(m) }
+func (*SplitCloneResponse) ProtoMessage()    {}
+func (*SplitCloneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{117}
+}
+
+func (m *SplitCloneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitCloneResponse.Unmarshal(m, b)
+}
+func (m *SplitCloneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitCloneResponse.Marshal(b, m, deterministic)
+}
+func (m *SplitCloneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitCloneResponse.Merge(m, src)
+}
+func (m *SplitCloneResponse) XXX_Size() int {
+	return xxx_messageInfo_SplitCloneResponse.Size(m)
+}
+func (m *SplitCloneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitCloneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitCloneResponse proto.InternalMessageInfo
+
+type VerticalSplitCloneRequest struct {
+	FromKeyspace         string   `protobuf:"bytes,1,opt,name=from_keyspace,json=fromKeyspace,proto3" json:"from_keyspace,omitempty"`
+	ToKeyspace           string   `protobuf:"bytes,2,opt,name=to_keyspace,json=toKeyspace,proto3" json:"to_keyspace,omitempty"`
+	Tables               []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerticalSplitCloneRequest) Reset()         { *m = VerticalSplitCloneRequest{} }
+func (m *VerticalSplitCloneRequest) String() string { return proto.CompactTextString(m) }
+func (*VerticalSplitCloneRequest) ProtoMessage()    {}
+func (*VerticalSplitCloneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{118}
+}
+
+func (m *VerticalSplitCloneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerticalSplitCloneRequest.Unmarshal(m, b)
+}
+func (m *VerticalSplitCloneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerticalSplitCloneRequest.Marshal(b, m, deterministic)
+}
+func (m *VerticalSplitCloneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerticalSplitCloneRequest.Merge(m, src)
+}
+func (m *VerticalSplitCloneRequest) XXX_Size() int {
+	return xxx_messageInfo_VerticalSplitCloneRequest.Size(m)
+}
+func (m *VerticalSplitCloneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerticalSplitCloneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerticalSplitCloneRequest proto.InternalMessageInfo
+
+func (m *VerticalSplitCloneRequest) GetFromKeyspace() string {
+	if m != nil {
+		return m.FromKeyspace
+	}
+	return ""
+}
+
+func (m *VerticalSplitCloneRequest) GetToKeyspace() string {
+	if m != nil {
+		return m.ToKeyspace
+	}
+	return ""
+}
+
+func (m *VerticalSplitCloneRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type VerticalSplitCloneResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerticalSplitCloneResponse) Reset()         { *m = VerticalSplitCloneResponse{} }
+func (m *VerticalSplitCloneResponse) String() string { return proto.CompactTextString(m) }
+func (*VerticalSplitCloneResponse) ProtoMessage()    {}
+func (*VerticalSplitCloneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{119}
+}
+
+func (m *VerticalSplitCloneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerticalSplitCloneResponse.Unmarshal(m, b)
+}
+func (m *VerticalSplitCloneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerticalSplitCloneResponse.Marshal(b, m, deterministic)
+}
+func (m *VerticalSplitCloneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerticalSplitCloneResponse.Merge(m, src)
+}
+func (m *VerticalSplitCloneResponse) XXX_Size() int {
+	return xxx_messageInfo_VerticalSplitCloneResponse.Size(m)
+}
+func (m *VerticalSplitCloneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerticalSplitCloneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerticalSplitCloneResponse proto.InternalMessageInfo
+
+type VDiffRequest struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow   string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	SourceCell string `protobuf:"bytes,3,opt,name=source_cell,json=sourceCell,proto3" json:"source_cell,omitempty"`
+	TargetCell string `protobuf:"bytes,4,opt,name=target_cell,json=targetCell,proto3" json:"target_cell,omitempty"`
+	// tablet_types is a comma-separated list of the tablet types of the
+	// source and target. It's master,replica,rdonly if it's not set.
+	TabletTypes string `protobuf:"bytes,5,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// filtered_replication_wait_time is 30 seconds if it's not set.
+	FilteredReplicationWaitTime *vttime.Duration `protobuf:"bytes,6,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	// chunk_size diffs every table in chunks of at most this many rows
+	// per shard, if it's set.
+	ChunkSize int64 `protobuf:"varint,7,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// checkpoint_file is a file on the vtctld the progress is saved to,
+	// after every chunk. resume resumes the diff saved in it.
+	CheckpointFile       string   `protobuf:"bytes,8,opt,name=checkpoint_file,json=checkpointFile,proto3" json:"checkpoint_file,omitempty"`
+	Resume               bool     `protobuf:"varint,9,opt,name=resume,proto3" json:"resume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VDiffRequest) Reset()         { *m = VDiffRequest{} }
+func (m *VDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*VDiffRequest) ProtoMessage()    {}
+func (*VDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{120}
+}
+
+func (m *VDiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffRequest.Unmarshal(m, b)
+}
+func (m *VDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffRequest.Marshal(b, m, deterministic)
+}
+func (m *VDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffRequest.Merge(m, src)
+}
+func (m *VDiffRequest) XXX_Size() int {
+	return xxx_messageInfo_VDiffRequest.Size(m)
+}
+func (m *VDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffRequest proto.InternalMessageInfo
+
+func (m *VDiffRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetSourceCell() string {
+	if m != nil {
+		return m.SourceCell
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetTargetCell() string {
+	if m != nil {
+		return m.TargetCell
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetFilteredReplicationWaitTime() *vttime.Duration {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return nil
+}
+
+func (m *VDiffRequest) GetChunkSize() int64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *VDiffRequest) GetCheckpointFile() string {
+	if m != nil {
+		return m.CheckpointFile
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+// VDiffTableReport is the result of the diff of a table.
+type VDiffTableReport struct {
+	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	ProcessedRows        int64    `protobuf:"varint,2,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	MatchingRows         int64    `protobuf:"varint,3,opt,name=matching_rows,json=matchingRows,proto3" json:"matching_rows,omitempty"`
+	MismatchedRows       int64    `protobuf:"varint,4,opt,name=mismatched_rows,json=mismatchedRows,proto3" json:"mismatched_rows,omitempty"`
+	ExtraRowsSource      int64    `protobuf:"varint,5,opt,name=extra_rows_source,json=extraRowsSource,proto3" json:"extra_rows_source,omitempty"`
+	ExtraRowsTarget      int64    `protobuf:"varint,6,opt,name=extra_rows_target,json=extraRowsTarget,proto3" json:"extra_rows_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VDiffTableReport) Reset()         { *m = VDiffTableReport{} }
+func (m *VDiffTableReport) String() string { return proto.CompactTextString(m) }
+func (*VDiffTableReport) ProtoMessage()    {}
+func (*VDiffTableReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{121}
+}
+
+func (m *VDiffTableReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffTableReport.Unmarshal(m, b)
+}
+func (m *VDiffTableReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffTableReport.Marshal(b, m, deterministic)
+}
+func (m *VDiffTableReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffTableReport.Merge(m, src)
+}
+func (m *VDiffTableReport) XXX_Size() int {
+	return xxx_messageInfo_VDiffTableReport.Size(m)
+}
+func (m *VDiffTableReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffTableReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffTableReport proto.InternalMessageInfo
+
+func (m *VDiffTableReport) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *VDiffTableReport) GetProcessedRows() int64 {
+	if m != nil {
+		return m.ProcessedRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetMatchingRows() int64 {
+	if m != nil {
+		return m.MatchingRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetMismatchedRows() int64 {
+	if m != nil {
+		return m.MismatchedRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetExtraRowsSource() int64 {
+	if m != nil {
+		return m.ExtraRowsSource
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetExtraRowsTarget() int64 {
+	if m != nil {
+		return m.ExtraRowsTarget
+	}
+	return 0
+}
+
+// VDiffResponse is streamed back by VDiff. Each response has either a
+// log line, or the reports of all the tables once the diff is done.
+type VDiffResponse struct {
+	Event                *logutil.Event      `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Reports              []*VDiffTableReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *VDiffResponse) Reset()         { *m = VDiffResponse{} }
+func (m *VDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*VDiffResponse) ProtoMessage()    {}
+func (*VDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{122}
+}
+
+func (m *VDiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffResponse.Unmarshal(m, b)
+}
+func (m *VDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffResponse.Marshal(b, m, deterministic)
+}
+func (m *VDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffResponse.Merge(m, src)
+}
+func (m *VDiffResponse) XXX_Size() int {
+	return xxx_messageInfo_VDiffResponse.Size(m)
+}
+func (m *VDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffResponse proto.InternalMessageInfo
+
+func (m *VDiffResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *VDiffResponse) GetReports() []*VDiffTableReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type PauseWorkflowRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseWorkflowRequest) Reset()         { *m = PauseWorkflowRequest{} }
+func (m *PauseWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowRequest) ProtoMessage()    {}
+func (*PauseWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{123}
+}
+
+func (m *PauseWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseWorkflowRequest.Unmarshal(m, b)
+}
+func (m *PauseWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseWorkflowRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowRequest.Merge(m, src)
+}
+func (m *PauseWorkflowRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseWorkflowRequest.Size(m)
+}
+func (m *PauseWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PauseWorkflowRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type PauseWorkflowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseWorkflowResponse) Reset()         { *m = PauseWorkflowResponse{} }
+func (m *PauseWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowResponse) ProtoMessage()    {}
+func (*PauseWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{124}
+}
+
+func (m *PauseWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseWorkflowResponse.Unmarshal(m, b)
+}
+func (m *PauseWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseWorkflowResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowResponse.Merge(m, src)
+}
+func (m *PauseWorkflowResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseWorkflowResponse.Size(m)
+}
+func (m *PauseWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowResponse proto.InternalMessageInfo
+
+type ResumeWorkflowRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeWorkflowRequest) Reset()         { *m = ResumeWorkflowRequest{} }
+func (m *ResumeWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeWorkflowRequest) ProtoMessage()    {}
+func (*ResumeWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{125}
+}
+
+func (m *ResumeWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeWorkflowRequest.Unmarshal(m, b)
+}
+func (m *ResumeWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeWorkflowRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeWorkflowRequest.Merge(m, src)
+}
+func (m *ResumeWorkflowRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeWorkflowRequest.Size(m)
+}
+func (m *ResumeWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeWorkflowRequest proto.InternalMessageInfo
+
+func (m *ResumeWorkflowRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ResumeWorkflowRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type ResumeWorkflowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeWorkflowResponse) Reset()         { *m = ResumeWorkflowResponse{} }
+func (m *ResumeWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeWorkflowResponse) ProtoMessage()    {}
+func (*ResumeWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{126}
+}
+
+func (m *ResumeWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeWorkflowResponse.Unmarshal(m, b)
+}
+func (m *ResumeWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeWorkflowResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeWorkflowResponse.Merge(m, src)
+}
+func (m *ResumeWorkflowResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeWorkflowResponse.Size(m)
+}
+func (m *ResumeWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeWorkflowResponse proto.InternalMessageInfo
+
+type CopyProgressRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyProgressRequest) Reset()         { *m = CopyProgressRequest{} }
+func (m *CopyProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*CopyProgressRequest) ProtoMessage()    {}
+func (*CopyProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{127}
+}
+
+func (m *CopyProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyProgressRequest.Unmarshal(m, b)
+}
+func (m *CopyProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *CopyProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyProgressRequest.Merge(m, src)
+}
+func (m *CopyProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_CopyProgressRequest.Size(m)
+}
+func (m *CopyProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyProgressRequest proto.InternalMessageInfo
+
+func (m *CopyProgressRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CopyProgressRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type CopyProgressResponse struct {
+	CopyProgress         []*ShardCopyProgress `protobuf:"bytes,1,rep,name=copy_progress,json=copyProgress,proto3" json:"copy_progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CopyProgressResponse) Reset()         { *m = CopyProgressResponse{} }
+func (m *CopyProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*CopyProgressResponse) ProtoMessage()    {}
+func (*CopyProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{128}
+}
+
+func (m *CopyProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyProgressResponse.Unmarshal(m, b)
+}
+func (m *CopyProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *CopyProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyProgressResponse.Merge(m, src)
+}
+func (m *CopyProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_CopyProgressResponse.Size(m)
+}
+func (m *CopyProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyProgressResponse proto.InternalMessageInfo
+
+func (m *CopyProgressResponse) GetCopyProgress() []*ShardCopyProgress {
+	if m != nil {
+		return m.CopyProgress
+	}
+	return nil
+}
+
+type MigrateServedTypesRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Cells      []string            `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,4,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// reverse moves the served tablet type backward.
+	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// skip_refresh_state doesn't refresh the state of the source tablets.
+	// It can't be set for a master migration.
+	SkipRefreshState bool `protobuf:"varint,6,opt,name=skip_refresh_state,json=skipRefreshState,proto3" json:"skip_refresh_state,omitempty"`
+	// filtered_replication_wait_time is 30 seconds if it's not set.
+	FilteredReplicationWaitTime *vttime.Duration `protobuf:"bytes,7,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	ReverseReplication          bool             `protobuf:"varint,8,opt,name=reverse_replication,json=reverseReplication,proto3" json:"reverse_replication,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}         `json:"-"`
+	XXX_unrecognized            []byte           `json:"-"`
+	XXX_sizecache               int32            `json:"-"`
+}
+
+func (m *MigrateServedTypesRequest) Reset()         { *m = MigrateServedTypesRequest{} }
+func (m *MigrateServedTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateServedTypesRequest) ProtoMessage()    {}
+func (*MigrateServedTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{129}
+}
+
+func (m *MigrateServedTypesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateServedTypesRequest.Unmarshal(m, b)
+}
+func (m *MigrateServedTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateServedTypesRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateServedTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateServedTypesRequest.Merge(m, src)
+}
+func (m *MigrateServedTypesRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateServedTypesRequest.Size(m)
+}
+func (m *MigrateServedTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateServedTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateServedTypesRequest proto.InternalMessageInfo
+
+func (m *MigrateServedTypesRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *MigrateServedTypesRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *MigrateServedTypesRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *MigrateServedTypesRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *MigrateServedTypesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *MigrateServedTypesRequest) GetSkipRefreshState() bool {
+	if m != nil {
+		return m.SkipRefreshState
+	}
+	return false
+}
+
+func (m *MigrateServedTypesRequest) GetFilteredReplicationWaitTime() *vttime.Duration {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return nil
+}
+
+func (m *MigrateServedTypesRequest) GetReverseReplication() bool {
+	if m != nil {
+		return m.ReverseReplication
+	}
+	return false
+}
+
+// MigrateServedTypesResponse is streamed back by MigrateServedTypes,
+// with its log lines.
+type MigrateServedTypesResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MigrateServedTypesResponse) Reset()         { *m = MigrateServedTypesResponse{} }
+func (m *MigrateServedTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateServedTypesResponse) ProtoMessage()    {}
+func (*MigrateServedTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{130}
+}
+
+func (m *MigrateServedTypesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateServedTypesResponse.Unmarshal(m, b)
+}
+func (m *MigrateServedTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateServedTypesResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateServedTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateServedTypesResponse.Merge(m, src)
+}
+func (m *MigrateServedTypesResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateServedTypesResponse.Size(m)
+}
+func (m *MigrateServedTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateServedTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateServedTypesResponse proto.InternalMessageInfo
+
+func (m *MigrateServedTypesResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type MigrateServedFromRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	Cells      []string            `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	Reverse    bool                `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// filtered_replication_wait_time is 30 seconds if it's not set.
+	FilteredReplicationWaitTime *vttime.Duration `protobuf:"bytes,6,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}         `json:"-"`
+	XXX_unrecognized            []byte           `json:"-"`
+	XXX_sizecache               int32            `json:"-"`
+}
+
+func (m *MigrateServedFromRequest) Reset()         { *m = MigrateServedFromRequest{} }
+func (m *MigrateServedFromRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateServedFromRequest) ProtoMessage()    {}
+func (*MigrateServedFromRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{131}
+}
+
+func (m *MigrateServedFromRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateServedFromRequest.Unmarshal(m, b)
+}
+func (m *MigrateServedFromRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateServedFromRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateServedFromRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateServedFromRequest.Merge(m, src)
+}
+func (m *MigrateServedFromRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateServedFromRequest.Size(m)
+}
+func (m *MigrateServedFromRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateServedFromRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateServedFromRequest proto.InternalMessageInfo
+
+func (m *MigrateServedFromRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *MigrateServedFromRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *MigrateServedFromRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *MigrateServedFromRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *MigrateServedFromRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *MigrateServedFromRequest) GetFilteredReplicationWaitTime() *vttime.Duration {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return nil
+}
+
+// MigrateServedFromResponse is streamed back by MigrateServedFrom, with
+// its log lines.
+type MigrateServedFromResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MigrateServedFromResponse) Reset()         { *m = MigrateServedFromResponse{} }
+func (m *MigrateServedFromResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateServedFromResponse) ProtoMessage()    {}
+func (*MigrateServedFromResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{132}
+}
+
+func (m *MigrateServedFromResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateServedFromResponse.Unmarshal(m, b)
+}
+func (m *MigrateServedFromResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateServedFromResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateServedFromResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateServedFromResponse.Merge(m, src)
+}
+func (m *MigrateServedFromResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateServedFromResponse.Size(m)
+}
+func (m *MigrateServedFromResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateServedFromResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateServedFromResponse proto.InternalMessageInfo
+
+func (m *MigrateServedFromResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SwitchReadsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// tablet_type is REPLICA or RDONLY.
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	Cells      []string            `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	// reverse switches the reads back to the source.
+	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchReadsRequest) Reset()         { *m = SwitchReadsRequest{} }
+func (m *SwitchReadsRequest) String() string { return proto.CompactTextString(m) }
+func (*SwitchReadsRequest) ProtoMessage()    {}
+func (*SwitchReadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{133}
+}
+
+func (m *SwitchReadsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchReadsRequest.Unmarshal(m, b)
+}
+func (m *SwitchReadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchReadsRequest.Marshal(b, m, deterministic)
+}
+func (m *SwitchReadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchReadsRequest.Merge(m, src)
+}
+func (m *SwitchReadsRequest) XXX_Size() int {
+	return xxx_messageInfo_SwitchReadsRequest.Size(m)
+}
+func (m *SwitchReadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchReadsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchReadsRequest proto.InternalMessageInfo
+
+func (m *SwitchReadsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SwitchReadsRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *SwitchReadsRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *SwitchReadsRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *SwitchReadsRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+// SwitchReadsResponse is streamed back by SwitchReads, with its log
+// lines.
+type SwitchReadsResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SwitchReadsResponse) Reset()         { *m = SwitchReadsResponse{} }
+func (m *SwitchReadsResponse) String() string { return proto.CompactTextString(m) }
+func (*SwitchReadsResponse) ProtoMessage()    {}
+func (*SwitchReadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{134}
+}
+
+func (m *SwitchReadsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchReadsResponse.Unmarshal(m, b)
+}
+func (m *SwitchReadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchReadsResponse.Marshal(b, m, deterministic)
+}
+func (m *SwitchReadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchReadsResponse.Merge(m, src)
+}
+func (m *SwitchReadsResponse) XXX_Size() int {
+	return xxx_messageInfo_SwitchReadsResponse.Size(m)
+}
+func (m *SwitchReadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchReadsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchReadsResponse proto.InternalMessageInfo
+
+func (m *SwitchReadsResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SwitchWritesRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// filtered_replication_wait_time is 30 seconds if it's not set.
+	FilteredReplicationWaitTime *vttime.Duration `protobuf:"bytes,3,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	// skip_reverse_replication doesn't start the streams which replicate
+	// the writes back to the source.
+	SkipReverseReplication bool `protobuf:"varint,4,opt,name=skip_reverse_replication,json=skipReverseReplication,proto3" json:"skip_reverse_replication,omitempty"`
+	// cancel cancels a failed switch, and serves from the source.
+	Cancel bool `protobuf:"varint,5,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	// max_replication_lag is the largest lag of the streams which allows
+	// switching the writes. It's 30 seconds if it's not set.
+	MaxReplicationLag *vttime.Duration `protobuf:"bytes,6,opt,name=max_replication_lag,json=maxReplicationLag,proto3" json:"max_replication_lag,omitempty"`
+	// vdiff_tablet_types are the tablet types VDiff uses for source and
+	// target. It's master,replica,rdonly if it's not set.
+	VdiffTabletTypes string `protobuf:"bytes,7,opt,name=vdiff_tablet_types,json=vdiffTabletTypes,proto3" json:"vdiff_tablet_types,omitempty"`
+	// skip_prechecks switches the writes without running the checks.
+	SkipPrechecks        bool     `protobuf:"varint,8,opt,name=skip_prechecks,json=skipPrechecks,proto3" json:"skip_prechecks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchWritesRequest) Reset()         { *m = SwitchWritesRequest{} }
+func (m *SwitchWritesRequest) String() string { return proto.CompactTextString(m) }
+func (*SwitchWritesRequest) ProtoMessage()    {}
+func (*SwitchWritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{135}
+}
+
+func (m *SwitchWritesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchWritesRequest.Unmarshal(m, b)
+}
+func (m *SwitchWritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchWritesRequest.Marshal(b, m, deterministic)
+}
+func (m *SwitchWritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchWritesRequest.Merge(m, src)
+}
+func (m *SwitchWritesRequest) XXX_Size() int {
+	return xxx_messageInfo_SwitchWritesRequest.Size(m)
+}
+func (m *SwitchWritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchWritesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchWritesRequest proto.InternalMessageInfo
+
+func (m *SwitchWritesRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SwitchWritesRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *SwitchWritesRequest) GetFilteredReplicationWaitTime() *vttime.Duration {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return nil
+}
+
+func (m *SwitchWritesRequest) GetSkipReverseReplication() bool {
+	if m != nil {
+		return m.SkipReverseReplication
+	}
+	return false
+}
+
+func (m *SwitchWritesRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+func (m *SwitchWritesRequest) GetMaxReplicationLag() *vttime.Duration {
+	if m != nil {
+		return m.MaxReplicationLag
+	}
+	return nil
+}
+
+func (m *SwitchWritesRequest) GetVdiffTabletTypes() string {
+	if m != nil {
+		return m.VdiffTabletTypes
+	}
+	return ""
+}
+
+func (m *SwitchWritesRequest) GetSkipPrechecks() bool {
+	if m != nil {
+		return m.SkipPrechecks
+	}
+	return false
+}
+
+// SwitchWritesPrecheck is the result of one of the checks run before
+// switching the writes.
+type SwitchWritesPrecheck struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed               bool     `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Problems             []string `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchWritesPrecheck) Reset()         { *m = SwitchWritesPrecheck{} }
+func (m *SwitchWritesPrecheck) String() string { return proto.CompactTextString(m) }
+func (*SwitchWritesPrecheck) ProtoMessage()    {}
+func (*SwitchWritesPrecheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{136}
+}
+
+func (m *SwitchWritesPrecheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchWritesPrecheck.Unmarshal(m, b)
+}
+func (m *SwitchWritesPrecheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchWritesPrecheck.Marshal(b, m, deterministic)
+}
+func (m *SwitchWritesPrecheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchWritesPrecheck.Merge(m, src)
+}
+func (m *SwitchWritesPrecheck) XXX_Size() int {
+	return xxx_messageInfo_SwitchWritesPrecheck.Size(m)
+}
+func (m *SwitchWritesPrecheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchWritesPrecheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchWritesPrecheck proto.InternalMessageInfo
+
+func (m *SwitchWritesPrecheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SwitchWritesPrecheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *SwitchWritesPrecheck) GetProblems() []string {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+// SwitchWritesResponse is streamed back by SwitchWrites. Each response
+// has either a log line, the results of the checks, or the ID of the
+// migration journal once the writes are switched.
+type SwitchWritesResponse struct {
+	Event                *logutil.Event          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Prechecks            []*SwitchWritesPrecheck `protobuf:"bytes,2,rep,name=prechecks,proto3" json:"prechecks,omitempty"`
+	JournalId            int64                   `protobuf:"varint,3,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SwitchWritesResponse) Reset()         { *m = SwitchWritesResponse{} }
+func (m *SwitchWritesResponse) String() string { return proto.CompactTextString(m) }
+func (*SwitchWritesResponse) ProtoMessage()    {}
+func (*SwitchWritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{137}
+}
+
+func (m *SwitchWritesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchWritesResponse.Unmarshal(m, b)
+}
+func (m *SwitchWritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchWritesResponse.Marshal(b, m, deterministic)
+}
+func (m *SwitchWritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchWritesResponse.Merge(m, src)
+}
+func (m *SwitchWritesResponse) XXX_Size() int {
+	return xxx_messageInfo_SwitchWritesResponse.Size(m)
+}
+func (m *SwitchWritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchWritesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchWritesResponse proto.InternalMessageInfo
+
+func (m *SwitchWritesResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SwitchWritesResponse) GetPrechecks() []*SwitchWritesPrecheck {
+	if m != nil {
+		return m.Prechecks
+	}
+	return nil
+}
+
+func (m *SwitchWritesResponse) GetJournalId() int64 {
+	if m != nil {
+		return m.JournalId
+	}
+	return 0
+}
+
+type CancelReshardingRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelReshardingRequest) Reset()         { *m = CancelReshardingRequest{} }
+func (m *CancelReshardingRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelReshardingRequest) ProtoMessage()    {}
+func (*CancelReshardingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{138}
+}
+
+func (m *CancelReshardingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelReshardingRequest.Unmarshal(m, b)
+}
+func (m *CancelReshardingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelReshardingRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelReshardingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelReshardingRequest.Merge(m, src)
+}
+func (m *CancelReshardingRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelReshardingRequest.Size(m)
+}
+func (m *CancelReshardingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelReshardingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelReshardingRequest proto.InternalMessageInfo
+
+func (m *CancelReshardingRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CancelReshardingRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type CancelReshardingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelReshardingResponse) Reset()         { *m = CancelReshardingResponse{} }
+func (m *CancelReshardingResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelReshardingResponse) ProtoMessage()    {}
+func (*CancelReshardingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{139}
+}
+
+func (m *CancelReshardingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelReshardingResponse.Unmarshal(m, b)
+}
+func (m *CancelReshardingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelReshardingResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelReshardingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelReshardingResponse.Merge(m, src)
+}
+func (m *CancelReshardingResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelReshardingResponse.Size(m)
+}
+func (m *CancelReshardingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelReshardingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelReshardingResponse proto.InternalMessageInfo
+
+type ShowReshardingRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowReshardingRequest) Reset()         { *m = ShowReshardingRequest{} }
+func (m *ShowReshardingRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowReshardingRequest) ProtoMessage()    {}
+func (*ShowReshardingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{140}
+}
+
+func (m *ShowReshardingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowReshardingRequest.Unmarshal(m, b)
+}
+func (m *ShowReshardingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowReshardingRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowReshardingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowReshardingRequest.Merge(m, src)
+}
+func (m *ShowReshardingRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowReshardingRequest.Size(m)
+}
+func (m *ShowReshardingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowReshardingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowReshardingRequest proto.InternalMessageInfo
+
+func (m *ShowReshardingRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShowReshardingRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+// ShowReshardingResponse is streamed back by ShowResharding, with the
+// resharding metadata of the shard as log lines.
+type ShowReshardingResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ShowReshardingResponse) Reset()         { *m = ShowReshardingResponse{} }
+func (m *ShowReshardingResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowReshardingResponse) ProtoMessage()    {}
+func (*ShowReshardingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{141}
+}
+
+func (m *ShowReshardingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowReshardingResponse.Unmarshal(m, b)
+}
+func (m *ShowReshardingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowReshardingResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowReshardingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowReshardingResponse.Merge(m, src)
+}
+func (m *ShowReshardingResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowReshardingResponse.Size(m)
+}
+func (m *ShowReshardingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowReshardingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowReshardingResponse proto.InternalMessageInfo
+
+func (m *ShowReshardingResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type WaitForDrainRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// cells is optional, all cells are considered if empty.
+	Cells []string `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	// timeout is optional, the wait is only bounded by the RPC
+	// deadline if it's not set.
+	Timeout *vttime.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retry_delay is the time between two checks. It's 1 second if it's
+	// not set.
+	RetryDelay *vttime.Duration `protobuf:"bytes,6,opt,name=retry_delay,json=retryDelay,proto3" json:"retry_delay,omitempty"`
+	// initial_wait is the time to wait for all the tablets to check in.
+	// It's 1 minute if it's not set.
+	InitialWait          *vttime.Duration `protobuf:"bytes,7,opt,name=initial_wait,json=initialWait,proto3" json:"initial_wait,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WaitForDrainRequest) Reset()         { *m = WaitForDrainRequest{} }
+func (m *WaitForDrainRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitForDrainRequest) ProtoMessage()    {}
+func (*WaitForDrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{142}
+}
+
+func (m *WaitForDrainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForDrainRequest.Unmarshal(m, b)
+}
+func (m *WaitForDrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForDrainRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitForDrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForDrainRequest.Merge(m, src)
+}
+func (m *WaitForDrainRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitForDrainRequest.Size(m)
+}
+func (m *WaitForDrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForDrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForDrainRequest proto.InternalMessageInfo
+
+func (m *WaitForDrainRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *WaitForDrainRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *WaitForDrainRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *WaitForDrainRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *WaitForDrainRequest) GetTimeout() *vttime.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *WaitForDrainRequest) GetRetryDelay() *vttime.Duration {
+	if m != nil {
+		return m.RetryDelay
+	}
+	return nil
+}
+
+func (m *WaitForDrainRequest) GetInitialWait() *vttime.Duration {
+	if m != nil {
+		return m.InitialWait
+	}
+	return nil
+}
+
+// WaitForDrainResponse is streamed back by WaitForDrain, with its log
+// lines.
+type WaitForDrainResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WaitForDrainResponse) Reset()         { *m = WaitForDrainResponse{} }
+func (m *WaitForDrainResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitForDrainResponse) ProtoMessage()    {}
+func (*WaitForDrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{143}
+}
+
+func (m *WaitForDrainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForDrainResponse.Unmarshal(m, b)
+}
+func (m *WaitForDrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForDrainResponse.Marshal(b, m, deterministic)
+}
+func (m *WaitForDrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForDrainResponse.Merge(m, src)
+}
+func (m *WaitForDrainResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitForDrainResponse.Size(m)
+}
+func (m *WaitForDrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForDrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForDrainResponse proto.InternalMessageInfo
+
+func (m *WaitForDrainResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// ReshardWorkflowOptions are the options of the steps of a resharding
+// workflow.
+type ReshardWorkflowOptions struct {
+	// copy_check_interval is how often the copy progress is checked and
+	// logged. It's 10 seconds if it's not set.
+	CopyCheckInterval *vttime.Duration `protobuf:"bytes,1,opt,name=copy_check_interval,json=copyCheckInterval,proto3" json:"copy_check_interval,omitempty"`
+	SourceCell        string           `protobuf:"bytes,2,opt,name=source_cell,json=sourceCell,proto3" json:"source_cell,omitempty"`
+	TargetCell        string           `protobuf:"bytes,3,opt,name=target_cell,json=targetCell,proto3" json:"target_cell,omitempty"`
+	// tablet_types are the tablet types VDiff uses for source and target.
+	// It's master,replica,rdonly if it's not set.
+	TabletTypes string `protobuf:"bytes,4,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// filtered_replication_wait_time is 30 seconds if it's not set.
+	FilteredReplicationWaitTime *vttime.Duration `protobuf:"bytes,5,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	// max_replication_lag is the largest lag of the streams which allows
+	// switching the traffic. It's 30 seconds if it's not set.
+	MaxReplicationLag    *vttime.Duration `protobuf:"bytes,6,opt,name=max_replication_lag,json=maxReplicationLag,proto3" json:"max_replication_lag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReshardWorkflowOptions) Reset()         { *m = ReshardWorkflowOptions{} }
+func (m *ReshardWorkflowOptions) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflowOptions) ProtoMessage()    {}
+func (*ReshardWorkflowOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{144}
+}
+
+func (m *ReshardWorkflowOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflowOptions.Unmarshal(m, b)
+}
+func (m *ReshardWorkflowOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflowOptions.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflowOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflowOptions.Merge(m, src)
+}
+func (m *ReshardWorkflowOptions) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflowOptions.Size(m)
+}
+func (m *ReshardWorkflowOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflowOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflowOptions proto.InternalMessageInfo
+
+func (m *ReshardWorkflowOptions) GetCopyCheckInterval() *vttime.Duration {
+	if m != nil {
+		return m.CopyCheckInterval
+	}
+	return nil
+}
+
+func (m *ReshardWorkflowOptions) GetSourceCell() string {
+	if m != nil {
+		return m.SourceCell
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowOptions) GetTargetCell() string {
+	if m != nil {
+		return m.TargetCell
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowOptions) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowOptions) GetFilteredReplicationWaitTime() *vttime.Duration {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return nil
+}
+
+func (m *ReshardWorkflowOptions) GetMaxReplicationLag() *vttime.Duration {
+	if m != nil {
+		return m.MaxReplicationLag
+	}
+	return nil
+}
+
+type StartReshardWorkflowRequest struct {
+	Keyspace       string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow       string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	SourceShards   []string `protobuf:"bytes,3,rep,name=source_shards,json=sourceShards,proto3" json:"source_shards,omitempty"`
+	TargetShards   []string `protobuf:"bytes,4,rep,name=target_shards,json=targetShards,proto3" json:"target_shards,omitempty"`
+	SkipSchemaCopy bool     `protobuf:"varint,5,opt,name=skip_schema_copy,json=skipSchemaCopy,proto3" json:"skip_schema_copy,omitempty"`
+	Compression    string   `protobuf:"bytes,6,opt,name=compression,proto3" json:"compression,omitempty"`
+	// skip_vdiff doesn't run VDiff once the copy is done, and allows
+	// switching the traffic without it.
+	SkipVdiff            bool                    `protobuf:"varint,7,opt,name=skip_vdiff,json=skipVdiff,proto3" json:"skip_vdiff,omitempty"`
+	Options              *ReshardWorkflowOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *StartReshardWorkflowRequest) Reset()         { *m = StartReshardWorkflowRequest{} }
+func (m *StartReshardWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*StartReshardWorkflowRequest) ProtoMessage()    {}
+func (*StartReshardWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{145}
+}
+
+func (m *StartReshardWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartReshardWorkflowRequest.Unmarshal(m, b)
+}
+func (m *StartReshardWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartReshardWorkflowRequest.Marshal(b, m, deterministic)
+}
+func (m *StartReshardWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartReshardWorkflowRequest.Merge(m, src)
+}
+func (m *StartReshardWorkflowRequest) XXX_Size() int {
+	return xxx_messageInfo_StartReshardWorkflowRequest.Size(m)
+}
+func (m *StartReshardWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartReshardWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartReshardWorkflowRequest proto.InternalMessageInfo
+
+func (m *StartReshardWorkflowRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *StartReshardWorkflowRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *StartReshardWorkflowRequest) GetSourceShards() []string {
+	if m != nil {
+		return m.SourceShards
+	}
+	return nil
+}
+
+func (m *StartReshardWorkflowRequest) GetTargetShards() []string {
+	if m != nil {
+		return m.TargetShards
+	}
+	return nil
+}
+
+func (m *StartReshardWorkflowRequest) GetSkipSchemaCopy() bool {
+	if m != nil {
+		return m.SkipSchemaCopy
+	}
+	return false
+}
+
+func (m *StartReshardWorkflowRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *StartReshardWorkflowRequest) GetSkipVdiff() bool {
+	if m != nil {
+		return m.SkipVdiff
+	}
+	return false
+}
+
+func (m *StartReshardWorkflowRequest) GetOptions() *ReshardWorkflowOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// StartReshardWorkflowResponse is streamed back by StartReshardWorkflow,
+// with the progress of the workflow as log lines.
+type StartReshardWorkflowResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StartReshardWorkflowResponse) Reset()         { *m = StartReshardWorkflowResponse{} }
+func (m *StartReshardWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*StartReshardWorkflowResponse) ProtoMessage()    {}
+func (*StartReshardWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{146}
+}
+
+func (m *StartReshardWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartReshardWorkflowResponse.Unmarshal(m, b)
+}
+func (m *StartReshardWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartReshardWorkflowResponse.Marshal(b, m, deterministic)
+}
+func (m *StartReshardWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartReshardWorkflowResponse.Merge(m, src)
+}
+func (m *StartReshardWorkflowResponse) XXX_Size() int {
+	return xxx_messageInfo_StartReshardWorkflowResponse.Size(m)
+}
+func (m *StartReshardWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartReshardWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartReshardWorkflowResponse proto.InternalMessageInfo
+
+func (m *StartReshardWorkflowResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetReshardWorkflowRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReshardWorkflowRequest) Reset()         { *m = GetReshardWorkflowRequest{} }
+func (m *GetReshardWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReshardWorkflowRequest) ProtoMessage()    {}
+func (*GetReshardWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{147}
+}
+
+func (m *GetReshardWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReshardWorkflowRequest.Unmarshal(m, b)
+}
+func (m *GetReshardWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReshardWorkflowRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReshardWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReshardWorkflowRequest.Merge(m, src)
+}
+func (m *GetReshardWorkflowRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReshardWorkflowRequest.Size(m)
+}
+func (m *GetReshardWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReshardWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReshardWorkflowRequest proto.InternalMessageInfo
+
+func (m *GetReshardWorkflowRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetReshardWorkflowRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type GetReshardWorkflowResponse struct {
+	Workflow             *topodata.ReshardWorkflow `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetReshardWorkflowResponse) Reset()         { *m = GetReshardWorkflowResponse{} }
+func (m *GetReshardWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReshardWorkflowResponse) ProtoMessage()    {}
+func (*GetReshardWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{148}
+}
+
+func (m *GetReshardWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReshardWorkflowResponse.Unmarshal(m, b)
+}
+func (m *GetReshardWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReshardWorkflowResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReshardWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReshardWorkflowResponse.Merge(m, src)
+}
+func (m *GetReshardWorkflowResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReshardWorkflowResponse.Size(m)
+}
+func (m *GetReshardWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReshardWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReshardWorkflowResponse proto.InternalMessageInfo
+
+func (m *GetReshardWorkflowResponse) GetWorkflow() *topodata.ReshardWorkflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+type ReshardWorkflowSwitchReadsRequest struct {
+	Keyspace             string                  `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string                  `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Options              *ReshardWorkflowOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ReshardWorkflowSwitchReadsRequest) Reset()         { *m = ReshardWorkflowSwitchReadsRequest{} }
+func (m *ReshardWorkflowSwitchReadsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflowSwitchReadsRequest) ProtoMessage()    {}
+func (*ReshardWorkflowSwitchReadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{149}
+}
+
+func (m *ReshardWorkflowSwitchReadsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsRequest.Unmarshal(m, b)
+}
+func (m *ReshardWorkflowSwitchReadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflowSwitchReadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflowSwitchReadsRequest.Merge(m, src)
+}
+func (m *ReshardWorkflowSwitchReadsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsRequest.Size(m)
+}
+func (m *ReshardWorkflowSwitchReadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflowSwitchReadsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflowSwitchReadsRequest proto.InternalMessageInfo
+
+func (m *ReshardWorkflowSwitchReadsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowSwitchReadsRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowSwitchReadsRequest) GetOptions() *ReshardWorkflowOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// ReshardWorkflowSwitchReadsResponse is streamed back by
+// ReshardWorkflowSwitchReads, with its log lines.
+type ReshardWorkflowSwitchReadsResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReshardWorkflowSwitchReadsResponse) Reset()         { *m = ReshardWorkflowSwitchReadsResponse{} }
+func (m *ReshardWorkflowSwitchReadsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflowSwitchReadsResponse) ProtoMessage()    {}
+func (*ReshardWorkflowSwitchReadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{150}
+}
+
+func (m *ReshardWorkflowSwitchReadsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsResponse.Unmarshal(m, b)
+}
+func (m *ReshardWorkflowSwitchReadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflowSwitchReadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflowSwitchReadsResponse.Merge(m, src)
+}
+func (m *ReshardWorkflowSwitchReadsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflowSwitchReadsResponse.Size(m)
+}
+func (m *ReshardWorkflowSwitchReadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflowSwitchReadsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflowSwitchReadsResponse proto.InternalMessageInfo
+
+func (m *ReshardWorkflowSwitchReadsResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ReshardWorkflowSwitchWritesRequest struct {
+	Keyspace             string                  `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow             string                  `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Options              *ReshardWorkflowOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ReshardWorkflowSwitchWritesRequest) Reset()         { *m = ReshardWorkflowSwitchWritesRequest{} }
+func (m *ReshardWorkflowSwitchWritesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflowSwitchWritesRequest) ProtoMessage()    {}
+func (*ReshardWorkflowSwitchWritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{151}
+}
+
+func (m *ReshardWorkflowSwitchWritesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesRequest.Unmarshal(m, b)
+}
+func (m *ReshardWorkflowSwitchWritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesRequest.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflowSwitchWritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflowSwitchWritesRequest.Merge(m, src)
+}
+func (m *ReshardWorkflowSwitchWritesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesRequest.Size(m)
+}
+func (m *ReshardWorkflowSwitchWritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflowSwitchWritesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflowSwitchWritesRequest proto.InternalMessageInfo
+
+func (m *ReshardWorkflowSwitchWritesRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowSwitchWritesRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *ReshardWorkflowSwitchWritesRequest) GetOptions() *ReshardWorkflowOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// ReshardWorkflowSwitchWritesResponse is streamed back by
+// ReshardWorkflowSwitchWrites, with its log lines.
+type ReshardWorkflowSwitchWritesResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReshardWorkflowSwitchWritesResponse) Reset()         { *m = ReshardWorkflowSwitchWritesResponse{} }
+func (m *ReshardWorkflowSwitchWritesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReshardWorkflowSwitchWritesResponse) ProtoMessage()    {}
+func (*ReshardWorkflowSwitchWritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{152}
+}
+
+func (m *ReshardWorkflowSwitchWritesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesResponse.Unmarshal(m, b)
+}
+func (m *ReshardWorkflowSwitchWritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesResponse.Marshal(b, m, deterministic)
+}
+func (m *ReshardWorkflowSwitchWritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardWorkflowSwitchWritesResponse.Merge(m, src)
+}
+func (m *ReshardWorkflowSwitchWritesResponse) XXX_Size() int {
+	return xxx_messageInfo_ReshardWorkflowSwitchWritesResponse.Size(m)
+}
+func (m *ReshardWorkflowSwitchWritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardWorkflowSwitchWritesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardWorkflowSwitchWritesResponse proto.InternalMessageInfo
+
+func (m *ReshardWorkflowSwitchWritesResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetSchemaRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// tables and exclude_tables are either exact names, or regular
+	// expressions of the form /regexp/.
+	Tables        []string `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	IncludeViews  bool     `protobuf:"varint,4,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	// table_names_only only returns the names of the tables.
+	TableNamesOnly       bool     `protobuf:"varint,5,opt,name=table_names_only,json=tableNamesOnly,proto3" json:"table_names_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{153}
+}
+
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(m, src)
+}
+func (m *GetSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaRequest.Size(m)
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *GetSchemaRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *GetSchemaRequest) GetExcludeTables() []string {
+	if m != nil {
+		return m.ExcludeTables
+	}
+	return nil
+}
+
+func (m *GetSchemaRequest) GetIncludeViews() bool {
+	if m != nil {
+		return m.IncludeViews
+	}
+	return false
+}
+
+func (m *GetSchemaRequest) GetTableNamesOnly() bool {
+	if m != nil {
+		return m.TableNamesOnly
+	}
+	return false
+}
+
+type GetSchemaResponse struct {
+	Schema               *tabletmanagerdata.SchemaDefinition `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{154}
+}
+
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(m, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaResponse.Size(m)
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetSchema() *tabletmanagerdata.SchemaDefinition {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type ReloadSchemaRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReloadSchemaRequest) Reset()         { *m = ReloadSchemaRequest{} }
+func (m *ReloadSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaRequest) ProtoMessage()    {}
+func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{155}
+}
+
+func (m *ReloadSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaRequest.Unmarshal(m, b)
+}
+func (m *ReloadSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaRequest.Merge(m, src)
+}
+func (m *ReloadSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaRequest.Size(m)
+}
+func (m *ReloadSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaRequest proto.InternalMessageInfo
+
+func (m *ReloadSchemaRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type ReloadSchemaResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadSchemaResponse) Reset()         { *m = ReloadSchemaResponse{} }
+func (m *ReloadSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaResponse) ProtoMessage()    {}
+func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{156}
+}
+
+func (m *ReloadSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaResponse.Unmarshal(m, b)
+}
+func (m *ReloadSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaResponse.Merge(m, src)
+}
+func (m *ReloadSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaResponse.Size(m)
+}
+func (m *ReloadSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaResponse proto.InternalMessageInfo
+
+type ReloadSchemaShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// wait_position is the replication position the tablets wait for
+	// before reloading, if it's set.
+	WaitPosition  string `protobuf:"bytes,3,opt,name=wait_position,json=waitPosition,proto3" json:"wait_position,omitempty"`
+	IncludeMaster bool   `protobuf:"varint,4,opt,name=include_master,json=includeMaster,proto3" json:"include_master,omitempty"`
+	// concurrency is the number of tablets to reload in parallel. It's
+	// 10 if it's not set.
+	Concurrency          int64    `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadSchemaShardRequest) Reset()         { *m = ReloadSchemaShardRequest{} }
+func (m *ReloadSchemaShardRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaShardRequest) ProtoMessage()    {}
+func (*ReloadSchemaShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{157}
+}
+
+func (m *ReloadSchemaShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaShardRequest.Unmarshal(m, b)
+}
+func (m *ReloadSchemaShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaShardRequest.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaShardRequest.Merge(m, src)
+}
+func (m *ReloadSchemaShardRequest) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaShardRequest.Size(m)
+}
+func (m *ReloadSchemaShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaShardRequest proto.InternalMessageInfo
+
+func (m *ReloadSchemaShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReloadSchemaShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ReloadSchemaShardRequest) GetWaitPosition() string {
+	if m != nil {
+		return m.WaitPosition
+	}
+	return ""
+}
+
+func (m *ReloadSchemaShardRequest) GetIncludeMaster() bool {
+	if m != nil {
+		return m.IncludeMaster
+	}
+	return false
+}
+
+func (m *ReloadSchemaShardRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+// ReloadSchemaShardResponse is streamed back by ReloadSchemaShard, with
+// the tablets which failed to reload as log lines.
+type ReloadSchemaShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReloadSchemaShardResponse) Reset()         { *m = ReloadSchemaShardResponse{} }
+func (m *ReloadSchemaShardResponse) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaShardResponse) ProtoMessage()    {}
+func (*ReloadSchemaShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{158}
+}
+
+func (m *ReloadSchemaShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaShardResponse.Unmarshal(m, b)
+}
+func (m *ReloadSchemaShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaShardResponse.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaShardResponse.Merge(m, src)
+}
+func (m *ReloadSchemaShardResponse) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaShardResponse.Size(m)
+}
+func (m *ReloadSchemaShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaShardResponse proto.InternalMessageInfo
+
+func (m *ReloadSchemaShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ReloadSchemaKeyspaceRequest struct {
+	Keyspace      string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	IncludeMaster bool   `protobuf:"varint,2,opt,name=include_master,json=includeMaster,proto3" json:"include_master,omitempty"`
+	// concurrency is the number of tablets to reload in parallel. It's
+	// 10 if it's not set.
+	Concurrency          int64    `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadSchemaKeyspaceRequest) Reset()         { *m = ReloadSchemaKeyspaceRequest{} }
+func (m *ReloadSchemaKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaKeyspaceRequest) ProtoMessage()    {}
+func (*ReloadSchemaKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{159}
+}
+
+func (m *ReloadSchemaKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *ReloadSchemaKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaKeyspaceRequest.Merge(m, src)
+}
+func (m *ReloadSchemaKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaKeyspaceRequest.Size(m)
+}
+func (m *ReloadSchemaKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaKeyspaceRequest proto.InternalMessageInfo
+
+func (m *ReloadSchemaKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReloadSchemaKeyspaceRequest) GetIncludeMaster() bool {
+	if m != nil {
+		return m.IncludeMaster
+	}
+	return false
+}
+
+func (m *ReloadSchemaKeyspaceRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+// ReloadSchemaKeyspaceResponse is streamed back by ReloadSchemaKeyspace,
+// with the tablets which failed to reload as log lines.
+type ReloadSchemaKeyspaceResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReloadSchemaKeyspaceResponse) Reset()         { *m = ReloadSchemaKeyspaceResponse{} }
+func (m *ReloadSchemaKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ReloadSchemaKeyspaceResponse) ProtoMessage()    {}
+func (*ReloadSchemaKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{160}
+}
+
+func (m *ReloadSchemaKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadSchemaKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *ReloadSchemaKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadSchemaKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ReloadSchemaKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadSchemaKeyspaceResponse.Merge(m, src)
+}
+func (m *ReloadSchemaKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ReloadSchemaKeyspaceResponse.Size(m)
+}
+func (m *ReloadSchemaKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadSchemaKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadSchemaKeyspaceResponse proto.InternalMessageInfo
+
+func (m *ReloadSchemaKeyspaceResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ValidateSchemaShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	ExcludeTables        []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	IncludeViews         bool     `protobuf:"varint,4,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateSchemaShardRequest) Reset()         { *m = ValidateSchemaShardRequest{} }
+func (m *ValidateSchemaShardRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateSchemaShardRequest) ProtoMessage()    {}
+func (*ValidateSchemaShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{161}
+}
+
+func (m *ValidateSchemaShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSchemaShardRequest.Unmarshal(m, b)
+}
+func (m *ValidateSchemaShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSchemaShardRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateSchemaShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSchemaShardRequest.Merge(m, src)
+}
+func (m *ValidateSchemaShardRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateSchemaShardRequest.Size(m)
+}
+func (m *ValidateSchemaShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSchemaShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSchemaShardRequest proto.InternalMessageInfo
+
+func (m *ValidateSchemaShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidateSchemaShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ValidateSchemaShardRequest) GetExcludeTables() []string {
+	if m != nil {
+		return m.ExcludeTables
+	}
+	return nil
+}
+
+func (m *ValidateSchemaShardRequest) GetIncludeViews() bool {
+	if m != nil {
+		return m.IncludeViews
+	}
+	return false
+}
+
+// ValidateSchemaShardResponse is streamed back by ValidateSchemaShard,
+// with the differences found as log lines.
+type ValidateSchemaShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateSchemaShardResponse) Reset()         { *m = ValidateSchemaShardResponse{} }
+func (m *ValidateSchemaShardResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateSchemaShardResponse) ProtoMessage()    {}
+func (*ValidateSchemaShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{162}
+}
+
+func (m *ValidateSchemaShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSchemaShardResponse.Unmarshal(m, b)
+}
+func (m *ValidateSchemaShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSchemaShardResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateSchemaShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSchemaShardResponse.Merge(m, src)
+}
+func (m *ValidateSchemaShardResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateSchemaShardResponse.Size(m)
+}
+func (m *ValidateSchemaShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSchemaShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSchemaShardResponse proto.InternalMessageInfo
+
+func (m *ValidateSchemaShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ValidateSchemaKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ExcludeTables        []string `protobuf:"bytes,2,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	IncludeViews         bool     `protobuf:"varint,3,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateSchemaKeyspaceRequest) Reset()         { *m = ValidateSchemaKeyspaceRequest{} }
+func (m *ValidateSchemaKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateSchemaKeyspaceRequest) ProtoMessage()    {}
+func (*ValidateSchemaKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{163}
+}
+
+func (m *ValidateSchemaKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSchemaKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *ValidateSchemaKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSchemaKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateSchemaKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSchemaKeyspaceRequest.Merge(m, src)
+}
+func (m *ValidateSchemaKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateSchemaKeyspaceRequest.Size(m)
+}
+func (m *ValidateSchemaKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSchemaKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSchemaKeyspaceRequest proto.InternalMessageInfo
+
+func (m *ValidateSchemaKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidateSchemaKeyspaceRequest) GetExcludeTables() []string {
+	if m != nil {
+		return m.ExcludeTables
+	}
+	return nil
+}
+
+func (m *ValidateSchemaKeyspaceRequest) GetIncludeViews() bool {
+	if m != nil {
+		return m.IncludeViews
+	}
+	return false
+}
+
+// ValidateSchemaKeyspaceResponse is streamed back by
+// ValidateSchemaKeyspace, with the differences found as log lines.
+type ValidateSchemaKeyspaceResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateSchemaKeyspaceResponse) Reset()         { *m = ValidateSchemaKeyspaceResponse{} }
+func (m *ValidateSchemaKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateSchemaKeyspaceResponse) ProtoMessage()    {}
+func (*ValidateSchemaKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{164}
+}
+
+func (m *ValidateSchemaKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSchemaKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *ValidateSchemaKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSchemaKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateSchemaKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSchemaKeyspaceResponse.Merge(m, src)
+}
+func (m *ValidateSchemaKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateSchemaKeyspaceResponse.Size(m)
+}
+func (m *ValidateSchemaKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSchemaKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSchemaKeyspaceResponse proto.InternalMessageInfo
+
+func (m *ValidateSchemaKeyspaceResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetSchemaDriftRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ExcludeTables        []string `protobuf:"bytes,2,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	IncludeViews         bool     `protobuf:"varint,3,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaDriftRequest) Reset()         { *m = GetSchemaDriftRequest{} }
+func (m *GetSchemaDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaDriftRequest) ProtoMessage()    {}
+func (*GetSchemaDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{165}
+}
+
+func (m *GetSchemaDriftRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaDriftRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaDriftRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaDriftRequest.Merge(m, src)
+}
+func (m *GetSchemaDriftRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaDriftRequest.Size(m)
+}
+func (m *GetSchemaDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaDriftRequest proto.InternalMessageInfo
+
+func (m *GetSchemaDriftRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetSchemaDriftRequest) GetExcludeTables() []string {
+	if m != nil {
+		return m.ExcludeTables
+	}
+	return nil
+}
+
+func (m *GetSchemaDriftRequest) GetIncludeViews() bool {
+	if m != nil {
+		return m.IncludeViews
+	}
+	return false
+}
+
+// TableVariant is one of the definitions of a drifted table, with the
+// shards which have it. The definition is empty for the shards without
+// the table.
+type TableVariant struct {
+	Schema               string   `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Shards               []string `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableVariant) Reset()         { *m = TableVariant{} }
+func (m *TableVariant) String() string { return proto.CompactTextString(m) }
+func (*TableVariant) ProtoMessage()    {}
+func (*TableVariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{166}
+}
+
+func (m *TableVariant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableVariant.Unmarshal(m, b)
+}
+func (m *TableVariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableVariant.Marshal(b, m, deterministic)
+}
+func (m *TableVariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableVariant.Merge(m, src)
+}
+func (m *TableVariant) XXX_Size() int {
+	return xxx_messageInfo_TableVariant.Size(m)
+}
+func (m *TableVariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableVariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableVariant proto.InternalMessageInfo
+
+func (m *TableVariant) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *TableVariant) GetShards() []string {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// TableDrift is a table whose definition differs between the shards.
+// The most common definition is the first variant.
+type TableDrift struct {
+	Table                string          `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Variants             []*TableVariant `protobuf:"bytes,2,rep,name=variants,proto3" json:"variants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TableDrift) Reset()         { *m = TableDrift{} }
+func (m *TableDrift) String() string { return proto.CompactTextString(m) }
+func (*TableDrift) ProtoMessage()    {}
+func (*TableDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{167}
+}
+
+func (m *TableDrift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableDrift.Unmarshal(m, b)
+}
+func (m *TableDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableDrift.Marshal(b, m, deterministic)
+}
+func (m *TableDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableDrift.Merge(m, src)
+}
+func (m *TableDrift) XXX_Size() int {
+	return xxx_messageInfo_TableDrift.Size(m)
+}
+func (m *TableDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableDrift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableDrift proto.InternalMessageInfo
+
+func (m *TableDrift) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *TableDrift) GetVariants() []*TableVariant {
+	if m != nil {
+		return m.Variants
+	}
+	return nil
+}
+
+type GetSchemaDriftResponse struct {
+	Drifts               []*TableDrift `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetSchemaDriftResponse) Reset()         { *m = GetSchemaDriftResponse{} }
+func (m *GetSchemaDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaDriftResponse) ProtoMessage()    {}
+func (*GetSchemaDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{168}
+}
+
+func (m *GetSchemaDriftResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaDriftResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaDriftResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaDriftResponse.Merge(m, src)
+}
+func (m *GetSchemaDriftResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaDriftResponse.Size(m)
+}
+func (m *GetSchemaDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaDriftResponse proto.InternalMessageInfo
+
+func (m *GetSchemaDriftResponse) GetDrifts() []*TableDrift {
+	if m != nil {
+		return m.Drifts
+	}
+	return nil
+}
+
+type ApplySchemaRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// sql is a list of semicolon-delimited statements.
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// allow_long_unavailability allows the big schema changes, which
+	// make the database unavailable for longer.
+	AllowLongUnavailability bool `protobuf:"varint,3,opt,name=allow_long_unavailability,json=allowLongUnavailability,proto3" json:"allow_long_unavailability,omitempty"`
+	// dry_run only reports what the statements would do.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// wait_slave_timeout is the time to wait for the slaves to receive
+	// the schema change. It's 10 seconds if it's not set.
+	WaitSlaveTimeout     *vttime.Duration `protobuf:"bytes,5,opt,name=wait_slave_timeout,json=waitSlaveTimeout,proto3" json:"wait_slave_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ApplySchemaRequest) Reset()         { *m = ApplySchemaRequest{} }
+func (m *ApplySchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemaRequest) ProtoMessage()    {}
+func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{169}
+}
+
+func (m *ApplySchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySchemaRequest.Unmarshal(m, b)
+}
+func (m *ApplySchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplySchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemaRequest.Merge(m, src)
+}
+func (m *ApplySchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplySchemaRequest.Size(m)
+}
+func (m *ApplySchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySchemaRequest proto.InternalMessageInfo
+
+func (m *ApplySchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplySchemaRequest) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *ApplySchemaRequest) GetAllowLongUnavailability() bool {
+	if m != nil {
+		return m.AllowLongUnavailability
+	}
+	return false
+}
+
+func (m *ApplySchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ApplySchemaRequest) GetWaitSlaveTimeout() *vttime.Duration {
+	if m != nil {
+		return m.WaitSlaveTimeout
+	}
+	return nil
+}
+
+// ApplySchemaStatementReport describes what applying a statement would
+// do.
+type ApplySchemaStatementReport struct {
+	Sql    string   `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Action string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Tables []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	// estimated_rows is the approximate number of rows copied or deleted
+	// by the statement, summed over all shards.
+	EstimatedRows uint64 `protobuf:"varint,4,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	ChangesSchema bool   `protobuf:"varint,5,opt,name=changes_schema,json=changesSchema,proto3" json:"changes_schema,omitempty"`
+	// big_schema_change explains why the statement is rejected without
+	// allow_long_unavailability, if it is.
+	BigSchemaChange      string   `protobuf:"bytes,6,opt,name=big_schema_change,json=bigSchemaChange,proto3" json:"big_schema_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplySchemaStatementReport) Reset()         { *m = ApplySchemaStatementReport{} }
+func (m *ApplySchemaStatementReport) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemaStatementReport) ProtoMessage()    {}
+func (*ApplySchemaStatementReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{170}
+}
+
+func (m *ApplySchemaStatementReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySchemaStatementReport.Unmarshal(m, b)
+}
+func (m *ApplySchemaStatementReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySchemaStatementReport.Marshal(b, m, deterministic)
+}
+func (m *ApplySchemaStatementReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemaStatementReport.Merge(m, src)
+}
+func (m *ApplySchemaStatementReport) XXX_Size() int {
+	return xxx_messageInfo_ApplySchemaStatementReport.Size(m)
+}
+func (m *ApplySchemaStatementReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemaStatementReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySchemaStatementReport proto.InternalMessageInfo
+
+func (m *ApplySchemaStatementReport) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *ApplySchemaStatementReport) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplySchemaStatementReport) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *ApplySchemaStatementReport) GetEstimatedRows() uint64 {
+	if m != nil {
+		return m.EstimatedRows
+	}
+	return 0
+}
+
+func (m *ApplySchemaStatementReport) GetChangesSchema() bool {
+	if m != nil {
+		return m.ChangesSchema
+	}
+	return false
+}
+
+func (m *ApplySchemaStatementReport) GetBigSchemaChange() string {
+	if m != nil {
+		return m.BigSchemaChange
+	}
+	return ""
+}
+
+// ApplySchemaDryRunReport is the result of an ApplySchema dry run.
+type ApplySchemaDryRunReport struct {
+	Keyspace             string                        `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	DdlStrategy          string                        `protobuf:"bytes,2,opt,name=ddl_strategy,json=ddlStrategy,proto3" json:"ddl_strategy,omitempty"`
+	Statements           []*ApplySchemaStatementReport `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
+	PreflightError       string                        `protobuf:"bytes,4,opt,name=preflight_error,json=preflightError,proto3" json:"preflight_error,omitempty"`
+	Safe                 bool                          `protobuf:"varint,5,opt,name=safe,proto3" json:"safe,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplySchemaDryRunReport) Reset()         { *m = ApplySchemaDryRunReport{} }
+func (m *ApplySchemaDryRunReport) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemaDryRunReport) ProtoMessage()    {}
+func (*ApplySchemaDryRunReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{171}
+}
+
+func (m *ApplySchemaDryRunReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySchemaDryRunReport.Unmarshal(m, b)
+}
+func (m *ApplySchemaDryRunReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySchemaDryRunReport.Marshal(b, m, deterministic)
+}
+func (m *ApplySchemaDryRunReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemaDryRunReport.Merge(m, src)
+}
+func (m *ApplySchemaDryRunReport) XXX_Size() int {
+	return xxx_messageInfo_ApplySchemaDryRunReport.Size(m)
+}
+func (m *ApplySchemaDryRunReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemaDryRunReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySchemaDryRunReport proto.InternalMessageInfo
+
+func (m *ApplySchemaDryRunReport) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplySchemaDryRunReport) GetDdlStrategy() string {
+	if m != nil {
+		return m.DdlStrategy
+	}
+	return ""
+}
+
+func (m *ApplySchemaDryRunReport) GetStatements() []*ApplySchemaStatementReport {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
+}
+
+func (m *ApplySchemaDryRunReport) GetPreflightError() string {
+	if m != nil {
+		return m.PreflightError
+	}
+	return ""
+}
+
+func (m *ApplySchemaDryRunReport) GetSafe() bool {
+	if m != nil {
+		return m.Safe
+	}
+	return false
+}
+
+// ApplySchemaResponse is streamed back by ApplySchema. Each response has
+// either a log line, or the report of a dry run.
+type ApplySchemaResponse struct {
+	Event                *logutil.Event           `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	DryRunReport         *ApplySchemaDryRunReport `protobuf:"bytes,2,opt,name=dry_run_report,json=dryRunReport,proto3" json:"dry_run_report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ApplySchemaResponse) Reset()         { *m = ApplySchemaResponse{} }
+func (m *ApplySchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemaResponse) ProtoMessage()    {}
+func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{172}
+}
+
+func (m *ApplySchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySchemaResponse.Unmarshal(m, b)
+}
+func (m *ApplySchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplySchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemaResponse.Merge(m, src)
+}
+func (m *ApplySchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplySchemaResponse.Size(m)
+}
+func (m *ApplySchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySchemaResponse proto.InternalMessageInfo
+
+func (m *ApplySchemaResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ApplySchemaResponse) GetDryRunReport() *ApplySchemaDryRunReport {
+	if m != nil {
+		return m.DryRunReport
+	}
+	return nil
+}
+
+type ApplyDeclarativeSchemaRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// statements are the CREATE TABLE statements of the schema.
+	Statements []string `protobuf:"bytes,2,rep,name=statements,proto3" json:"statements,omitempty"`
+	// allow_drop drops the tables which are not created by the
+	// statements.
+	AllowDrop bool `protobuf:"varint,3,opt,name=allow_drop,json=allowDrop,proto3" json:"allow_drop,omitempty"`
+	// dry_run only logs the statements which would change the schema.
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyDeclarativeSchemaRequest) Reset()         { *m = ApplyDeclarativeSchemaRequest{} }
+func (m *ApplyDeclarativeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyDeclarativeSchemaRequest) ProtoMessage()    {}
+func (*ApplyDeclarativeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{173}
+}
+
+func (m *ApplyDeclarativeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Unmarshal(m, b)
+}
+func (m *ApplyDeclarativeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyDeclarativeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyDeclarativeSchemaRequest.Merge(m, src)
+}
+func (m *ApplyDeclarativeSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyDeclarativeSchemaRequest.Size(m)
+}
+func (m *ApplyDeclarativeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyDeclarativeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyDeclarativeSchemaRequest proto.InternalMessageInfo
+
+func (m *ApplyDeclarativeSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplyDeclarativeSchemaRequest) GetStatements() []string {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
+}
+
+func (m *ApplyDeclarativeSchemaRequest) GetAllowDrop() bool {
+	if m != nil {
+		return m.AllowDrop
+	}
+	return false
+}
+
+func (m *ApplyDeclarativeSchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// ApplyDeclarativeSchemaResponse is streamed back by
+// ApplyDeclarativeSchema, with the changes of every shard as log lines.
+type ApplyDeclarativeSchemaResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApplyDeclarativeSchemaResponse) Reset()         { *m = ApplyDeclarativeSchemaResponse{} }
+func (m *ApplyDeclarativeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyDeclarativeSchemaResponse) ProtoMessage()    {}
+func (*ApplyDeclarativeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{174}
+}
+
+func (m *ApplyDeclarativeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Unmarshal(m, b)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyDeclarativeSchemaResponse.Merge(m, src)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyDeclarativeSchemaResponse.Size(m)
+}
+func (m *ApplyDeclarativeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyDeclarativeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyDeclarativeSchemaResponse proto.InternalMessageInfo
+
+func (m *ApplyDeclarativeSchemaResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SubmitOnlineDDLRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Sql      string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	// strategy is the tool which runs the migration, gh-ost or pt-osc.
+	// It's gh-ost if it's not set.
+	Strategy             string   `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitOnlineDDLRequest) Reset()         { *m = SubmitOnlineDDLRequest{} }
+func (m *SubmitOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitOnlineDDLRequest) ProtoMessage()    {}
+func (*SubmitOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{175}
+}
+
+func (m *SubmitOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOnlineDDLRequest.Merge(m, src)
+}
+func (m *SubmitOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitOnlineDDLRequest.Size(m)
+}
+func (m *SubmitOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *SubmitOnlineDDLRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SubmitOnlineDDLRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *SubmitOnlineDDLRequest) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *SubmitOnlineDDLRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+type SubmitOnlineDDLResponse struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitOnlineDDLResponse) Reset()         { *m = SubmitOnlineDDLResponse{} }
+func (m *SubmitOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitOnlineDDLResponse) ProtoMessage()    {}
+func (*SubmitOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{176}
+}
+
+func (m *SubmitOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOnlineDDLResponse.Merge(m, src)
+}
+func (m *SubmitOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitOnlineDDLResponse.Size(m)
+}
+func (m *SubmitOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOnlineDDLResponse proto.InternalMessageInfo
+
+func (m *SubmitOnlineDDLResponse) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetOnlineDDLMigrationsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// uuid is optional, all the migrations are returned if it's not set.
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOnlineDDLMigrationsRequest) Reset()         { *m = GetOnlineDDLMigrationsRequest{} }
+func (m *GetOnlineDDLMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOnlineDDLMigrationsRequest) ProtoMessage()    {}
+func (*GetOnlineDDLMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{177}
+}
+
+func (m *GetOnlineDDLMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Unmarshal(m, b)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOnlineDDLMigrationsRequest.Merge(m, src)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOnlineDDLMigrationsRequest.Size(m)
+}
+func (m *GetOnlineDDLMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOnlineDDLMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOnlineDDLMigrationsRequest proto.InternalMessageInfo
+
+func (m *GetOnlineDDLMigrationsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetOnlineDDLMigrationsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *GetOnlineDDLMigrationsRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetOnlineDDLMigrationsResponse struct {
+	Migrations           []*tabletmanagerdata.OnlineDDLMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *GetOnlineDDLMigrationsResponse) Reset()         { *m = GetOnlineDDLMigrationsResponse{} }
+func (m *GetOnlineDDLMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOnlineDDLMigrationsResponse) ProtoMessage()    {}
+func (*GetOnlineDDLMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{178}
+}
+
+func (m *GetOnlineDDLMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Unmarshal(m, b)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOnlineDDLMigrationsResponse.Merge(m, src)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOnlineDDLMigrationsResponse.Size(m)
+}
+func (m *GetOnlineDDLMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOnlineDDLMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOnlineDDLMigrationsResponse proto.InternalMessageInfo
+
+func (m *GetOnlineDDLMigrationsResponse) GetMigrations() []*tabletmanagerdata.OnlineDDLMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+type CancelOnlineDDLRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOnlineDDLRequest) Reset()         { *m = CancelOnlineDDLRequest{} }
+func (m *CancelOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOnlineDDLRequest) ProtoMessage()    {}
+func (*CancelOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{179}
+}
+
+func (m *CancelOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *CancelOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOnlineDDLRequest.Merge(m, src)
+}
+func (m *CancelOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOnlineDDLRequest.Size(m)
+}
+func (m *CancelOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *CancelOnlineDDLRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CancelOnlineDDLRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *CancelOnlineDDLRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type CancelOnlineDDLResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOnlineDDLResponse) Reset()         { *m = CancelOnlineDDLResponse{} }
+func (m *CancelOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOnlineDDLResponse) ProtoMessage()    {}
+func (*CancelOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{180}
+}
+
+func (m *CancelOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *CancelOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOnlineDDLResponse.Merge(m, src)
+}
+func (m *CancelOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelOnlineDDLResponse.Size(m)
+}
+func (m *CancelOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOnlineDDLResponse proto.InternalMessageInfo
+
+type RetryOnlineDDLRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOnlineDDLRequest) Reset()         { *m = RetryOnlineDDLRequest{} }
+func (m *RetryOnlineDDLRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryOnlineDDLRequest) ProtoMessage()    {}
+func (*RetryOnlineDDLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{181}
+}
+
+func (m *RetryOnlineDDLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Unmarshal(m, b)
+}
+func (m *RetryOnlineDDLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Marshal(b, m, deterministic)
+}
+func (m *RetryOnlineDDLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOnlineDDLRequest.Merge(m, src)
+}
+func (m *RetryOnlineDDLRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryOnlineDDLRequest.Size(m)
+}
+func (m *RetryOnlineDDLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOnlineDDLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOnlineDDLRequest proto.InternalMessageInfo
+
+func (m *RetryOnlineDDLRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RetryOnlineDDLRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *RetryOnlineDDLRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type RetryOnlineDDLResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOnlineDDLResponse) Reset()         { *m = RetryOnlineDDLResponse{} }
+func (m *RetryOnlineDDLResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryOnlineDDLResponse) ProtoMessage()    {}
+func (*RetryOnlineDDLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{182}
+}
+
+func (m *RetryOnlineDDLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Unmarshal(m, b)
+}
+func (m *RetryOnlineDDLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Marshal(b, m, deterministic)
+}
+func (m *RetryOnlineDDLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOnlineDDLResponse.Merge(m, src)
+}
+func (m *RetryOnlineDDLResponse) XXX_Size() int {
+	return xxx_messageInfo_RetryOnlineDDLResponse.Size(m)
+}
+func (m *RetryOnlineDDLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOnlineDDLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOnlineDDLResponse proto.InternalMessageInfo
+
+type CopySchemaShardRequest struct {
+	// The schema is copied from source_tablet_alias if it's set, or from
+	// the master of source_keyspace/source_shard.
+	SourceTabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=source_tablet_alias,json=sourceTabletAlias,proto3" json:"source_tablet_alias,omitempty"`
+	SourceKeyspace    string                `protobuf:"bytes,2,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	SourceShard       string                `protobuf:"bytes,3,opt,name=source_shard,json=sourceShard,proto3" json:"source_shard,omitempty"`
+	DestKeyspace      string                `protobuf:"bytes,4,opt,name=dest_keyspace,json=destKeyspace,proto3" json:"dest_keyspace,omitempty"`
+	DestShard         string                `protobuf:"bytes,5,opt,name=dest_shard,json=destShard,proto3" json:"dest_shard,omitempty"`
+	Tables            []string              `protobuf:"bytes,6,rep,name=tables,proto3" json:"tables,omitempty"`
+	ExcludeTables     []string              `protobuf:"bytes,7,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	IncludeViews      bool                  `protobuf:"varint,8,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	// wait_slave_timeout is the time to wait for the slaves to receive
+	// the schema change. It's 10 seconds if it's not set.
+	WaitSlaveTimeout     *vttime.Duration `protobuf:"bytes,9,opt,name=wait_slave_timeout,json=waitSlaveTimeout,proto3" json:"wait_slave_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CopySchemaShardRequest) Reset()         { *m = CopySchemaShardRequest{} }
+func (m *CopySchemaShardRequest) String() string { return proto.CompactTextString(m) }
+func (*CopySchemaShardRequest) ProtoMessage()    {}
+func (*CopySchemaShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{183}
+}
+
+func (m *CopySchemaShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopySchemaShardRequest.Unmarshal(m, b)
+}
+func (m *CopySchemaShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopySchemaShardRequest.Marshal(b, m, deterministic)
+}
+func (m *CopySchemaShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopySchemaShardRequest.Merge(m, src)
+}
+func (m *CopySchemaShardRequest) XXX_Size() int {
+	return xxx_messageInfo_CopySchemaShardRequest.Size(m)
+}
+func (m *CopySchemaShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopySchemaShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopySchemaShardRequest proto.InternalMessageInfo
+
+func (m *CopySchemaShardRequest) GetSourceTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.SourceTabletAlias
+	}
+	return nil
+}
+
+func (m *CopySchemaShardRequest) GetSourceKeyspace() string {
+	if m != nil {
+		return m.SourceKeyspace
+	}
+	return ""
+}
+
+func (m *CopySchemaShardRequest) GetSourceShard() string {
+	if m != nil {
+		return m.SourceShard
+	}
+	return ""
+}
+
+func (m *CopySchemaShardRequest) GetDestKeyspace() string {
+	if m != nil {
+		return m.DestKeyspace
+	}
+	return ""
+}
+
+func (m *CopySchemaShardRequest) GetDestShard() string {
+	if m != nil {
+		return m.DestShard
+	}
+	return ""
+}
+
+func (m *CopySchemaShardRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *CopySchemaShardRequest) GetExcludeTables() []string {
+	if m != nil {
+		return m.ExcludeTables
+	}
+	return nil
+}
+
+func (m *CopySchemaShardRequest) GetIncludeViews() bool {
+	if m != nil {
+		return m.IncludeViews
+	}
+	return false
+}
+
+func (m *CopySchemaShardRequest) GetWaitSlaveTimeout() *vttime.Duration {
+	if m != nil {
+		return m.WaitSlaveTimeout
+	}
+	return nil
+}
+
+// CopySchemaShardResponse is streamed back by CopySchemaShard, with its
+// log lines.
+type CopySchemaShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CopySchemaShardResponse) Reset()         { *m = CopySchemaShardResponse{} }
+func (m *CopySchemaShardResponse) String() string { return proto.CompactTextString(m) }
+func (*CopySchemaShardResponse) ProtoMessage()    {}
+func (*CopySchemaShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{184}
+}
+
+func (m *CopySchemaShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopySchemaShardResponse.Unmarshal(m, b)
+}
+func (m *CopySchemaShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopySchemaShardResponse.Marshal(b, m, deterministic)
+}
+func (m *CopySchemaShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopySchemaShardResponse.Merge(m, src)
+}
+func (m *CopySchemaShardResponse) XXX_Size() int {
+	return xxx_messageInfo_CopySchemaShardResponse.Size(m)
+}
+func (m *CopySchemaShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopySchemaShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopySchemaShardResponse proto.InternalMessageInfo
+
+func (m *CopySchemaShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ValidateVersionShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateVersionShardRequest) Reset()         { *m = ValidateVersionShardRequest{} }
+func (m *ValidateVersionShardRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateVersionShardRequest) ProtoMessage()    {}
+func (*ValidateVersionShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{185}
+}
+
+func (m *ValidateVersionShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateVersionShardRequest.Unmarshal(m, b)
+}
+func (m *ValidateVersionShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateVersionShardRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateVersionShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateVersionShardRequest.Merge(m, src)
+}
+func (m *ValidateVersionShardRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateVersionShardRequest.Size(m)
+}
+func (m *ValidateVersionShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateVersionShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateVersionShardRequest proto.InternalMessageInfo
+
+func (m *ValidateVersionShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidateVersionShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+// ValidateVersionShardResponse is streamed back by ValidateVersionShard,
+// with the differences found as log lines.
+type ValidateVersionShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateVersionShardResponse) Reset()         { *m = ValidateVersionShardResponse{} }
+func (m *ValidateVersionShardResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateVersionShardResponse) ProtoMessage()    {}
+func (*ValidateVersionShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{186}
+}
+
+func (m *ValidateVersionShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateVersionShardResponse.Unmarshal(m, b)
+}
+func (m *ValidateVersionShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateVersionShardResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateVersionShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateVersionShardResponse.Merge(m, src)
+}
+func (m *ValidateVersionShardResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateVersionShardResponse.Size(m)
+}
+func (m *ValidateVersionShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateVersionShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateVersionShardResponse proto.InternalMessageInfo
+
+func (m *ValidateVersionShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ValidateVersionKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateVersionKeyspaceRequest) Reset()         { *m = ValidateVersionKeyspaceRequest{} }
+func (m *ValidateVersionKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateVersionKeyspaceRequest) ProtoMessage()    {}
+func (*ValidateVersionKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{187}
+}
+
+func (m *ValidateVersionKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateVersionKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *ValidateVersionKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateVersionKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateVersionKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateVersionKeyspaceRequest.Merge(m, src)
+}
+func (m *ValidateVersionKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateVersionKeyspaceRequest.Size(m)
+}
+func (m *ValidateVersionKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateVersionKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateVersionKeyspaceRequest proto.InternalMessageInfo
+
+func (m *ValidateVersionKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+// ValidateVersionKeyspaceResponse is streamed back by
+// ValidateVersionKeyspace, with the differences found as log lines.
+type ValidateVersionKeyspaceResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateVersionKeyspaceResponse) Reset()         { *m = ValidateVersionKeyspaceResponse{} }
+func (m *ValidateVersionKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateVersionKeyspaceResponse) ProtoMessage()    {}
+func (*ValidateVersionKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{188}
+}
+
+func (m *ValidateVersionKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateVersionKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *ValidateVersionKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateVersionKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateVersionKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateVersionKeyspaceResponse.Merge(m, src)
+}
+func (m *ValidateVersionKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateVersionKeyspaceResponse.Size(m)
+}
+func (m *ValidateVersionKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateVersionKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateVersionKeyspaceResponse proto.InternalMessageInfo
+
+func (m *ValidateVersionKeyspaceResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetPermissionsRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
+func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsRequest) ProtoMessage()    {}
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{189}
+}
+
+func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPermissionsRequest.Unmarshal(m, b)
+}
+func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsRequest.Merge(m, src)
+}
+func (m *GetPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPermissionsRequest.Size(m)
+}
+func (m *GetPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPermissionsRequest proto.InternalMessageInfo
+
+func (m *GetPermissionsRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type GetPermissionsResponse struct {
+	Permissions          *tabletmanagerdata.Permissions `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{} }
+func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsResponse) ProtoMessage()    {}
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{190}
+}
+
+func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPermissionsResponse.Unmarshal(m, b)
+}
+func (m *GetPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsResponse.Merge(m, src)
+}
+func (m *GetPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPermissionsResponse.Size(m)
+}
+func (m *GetPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPermissionsResponse proto.InternalMessageInfo
+
+func (m *GetPermissionsResponse) GetPermissions() *tabletmanagerdata.Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type ValidatePermissionsShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePermissionsShardRequest) Reset()         { *m = ValidatePermissionsShardRequest{} }
+func (m *ValidatePermissionsShardRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePermissionsShardRequest) ProtoMessage()    {}
+func (*ValidatePermissionsShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{191}
+}
+
+func (m *ValidatePermissionsShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePermissionsShardRequest.Unmarshal(m, b)
+}
+func (m *ValidatePermissionsShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePermissionsShardRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePermissionsShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePermissionsShardRequest.Merge(m, src)
+}
+func (m *ValidatePermissionsShardRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePermissionsShardRequest.Size(m)
+}
+func (m *ValidatePermissionsShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePermissionsShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePermissionsShardRequest proto.InternalMessageInfo
+
+func (m *ValidatePermissionsShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ValidatePermissionsShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+// ValidatePermissionsShardResponse is streamed back by
+// ValidatePermissionsShard, with the differences found as log lines.
+type ValidatePermissionsShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidatePermissionsShardResponse) Reset()         { *m = ValidatePermissionsShardResponse{} }
+func (m *ValidatePermissionsShardResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatePermissionsShardResponse) ProtoMessage()    {}
+func (*ValidatePermissionsShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{192}
+}
+
+func (m *ValidatePermissionsShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePermissionsShardResponse.Unmarshal(m, b)
+}
+func (m *ValidatePermissionsShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePermissionsShardResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatePermissionsShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePermissionsShardResponse.Merge(m, src)
+}
+func (m *ValidatePermissionsShardResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatePermissionsShardResponse.Size(m)
+}
+func (m *ValidatePermissionsShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePermissionsShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePermissionsShardResponse proto.InternalMessageInfo
+
+func (m *ValidatePermissionsShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ValidatePermissionsKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePermissionsKeyspaceRequest) Reset()         { *m = ValidatePermissionsKeyspaceRequest{} }
+func (m *ValidatePermissionsKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePermissionsKeyspaceRequest) ProtoMessage()    {}
+func (*ValidatePermissionsKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{193}
+}
+
+func (m *ValidatePermissionsKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *ValidatePermissionsKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePermissionsKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePermissionsKeyspaceRequest.Merge(m, src)
+}
+func (m *ValidatePermissionsKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceRequest.Size(m)
+}
+func (m *ValidatePermissionsKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePermissionsKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePermissionsKeyspaceRequest proto.InternalMessageInfo
+
+func (m *ValidatePermissionsKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+// ValidatePermissionsKeyspaceResponse is streamed back by
+// ValidatePermissionsKeyspace, with the differences found as log lines.
+type ValidatePermissionsKeyspaceResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidatePermissionsKeyspaceResponse) Reset()         { *m = ValidatePermissionsKeyspaceResponse{} }
+func (m *ValidatePermissionsKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatePermissionsKeyspaceResponse) ProtoMessage()    {}
+func (*ValidatePermissionsKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{194}
+}
+
+func (m *ValidatePermissionsKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *ValidatePermissionsKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatePermissionsKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePermissionsKeyspaceResponse.Merge(m, src)
+}
+func (m *ValidatePermissionsKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatePermissionsKeyspaceResponse.Size(m)
+}
+func (m *ValidatePermissionsKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePermissionsKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePermissionsKeyspaceResponse proto.InternalMessageInfo
+
+func (m *ValidatePermissionsKeyspaceResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetVSchemaRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVSchemaRequest) Reset()         { *m = GetVSchemaRequest{} }
+func (m *GetVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVSchemaRequest) ProtoMessage()    {}
+func (*GetVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{195}
+}
+
+func (m *GetVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVSchemaRequest.Merge(m, src)
+}
+func (m *GetVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVSchemaRequest.Size(m)
+}
+func (m *GetVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVSchemaRequest proto.InternalMessageInfo
+
+func (m *GetVSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetVSchemaResponse struct {
+	Vschema              *vschema.Keyspace `protobuf:"bytes,1,opt,name=vschema,proto3" json:"vschema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetVSchemaResponse) Reset()         { *m = GetVSchemaResponse{} }
+func (m *GetVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVSchemaResponse) ProtoMessage()    {}
+func (*GetVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{196}
+}
+
+func (m *GetVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVSchemaResponse.Merge(m, src)
+}
+func (m *GetVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVSchemaResponse.Size(m)
+}
+func (m *GetVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVSchemaResponse proto.InternalMessageInfo
+
+func (m *GetVSchemaResponse) GetVschema() *vschema.Keyspace {
+	if m != nil {
+		return m.Vschema
+	}
+	return nil
+}
+
+type ApplyVSchemaRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// Exactly one of vschema and sql must be set. vschema replaces the
+	// vschema of the keyspace, sql is a vschema DDL statement applied
+	// to it (e.g. `alter vschema on t add vindex hash(id)`).
+	Vschema *vschema.Keyspace `protobuf:"bytes,2,opt,name=vschema,proto3" json:"vschema,omitempty"`
+	Sql     string            `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	// dry_run only returns the new vschema, without saving it.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// skip_rebuild doesn't rebuild the SrvVSchema objects. The changes
+	// take effect with the next RebuildVSchemaGraph.
+	SkipRebuild bool `protobuf:"varint,5,opt,name=skip_rebuild,json=skipRebuild,proto3" json:"skip_rebuild,omitempty"`
+	// cells limits the rebuild to the cells, all cells are rebuilt if
+	// it's empty.
+	Cells                []string `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyVSchemaRequest) Reset()         { *m = ApplyVSchemaRequest{} }
+func (m *ApplyVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaRequest) ProtoMessage()    {}
+func (*ApplyVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{197}
+}
+
+func (m *ApplyVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyVSchemaRequest.Unmarshal(m, b)
+}
+func (m *ApplyVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaRequest.Merge(m, src)
+}
+func (m *ApplyVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyVSchemaRequest.Size(m)
+}
+func (m *ApplyVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaRequest proto.InternalMessageInfo
+
+func (m *ApplyVSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplyVSchemaRequest) GetVschema() *vschema.Keyspace {
+	if m != nil {
+		return m.Vschema
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaRequest) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *ApplyVSchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetSkipRebuild() bool {
+	if m != nil {
+		return m.SkipRebuild
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type ApplyVSchemaResponse struct {
+	Vschema              *vschema.Keyspace `protobuf:"bytes,1,opt,name=vschema,proto3" json:"vschema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApplyVSchemaResponse) Reset()         { *m = ApplyVSchemaResponse{} }
+func (m *ApplyVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaResponse) ProtoMessage()    {}
+func (*ApplyVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{198}
+}
+
+func (m *ApplyVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyVSchemaResponse.Unmarshal(m, b)
+}
+func (m *ApplyVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaResponse.Merge(m, src)
+}
+func (m *ApplyVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyVSchemaResponse.Size(m)
+}
+func (m *ApplyVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaResponse proto.InternalMessageInfo
+
+func (m *ApplyVSchemaResponse) GetVschema() *vschema.Keyspace {
+	if m != nil {
+		return m.Vschema
+	}
+	return nil
+}
+
+type GetRoutingRulesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRoutingRulesRequest) Reset()         { *m = GetRoutingRulesRequest{} }
+func (m *GetRoutingRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoutingRulesRequest) ProtoMessage()    {}
+func (*GetRoutingRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{199}
+}
+
+func (m *GetRoutingRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoutingRulesRequest.Unmarshal(m, b)
+}
+func (m *GetRoutingRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoutingRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRoutingRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoutingRulesRequest.Merge(m, src)
+}
+func (m *GetRoutingRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRoutingRulesRequest.Size(m)
+}
+func (m *GetRoutingRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoutingRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRoutingRulesRequest proto.InternalMessageInfo
+
+type GetRoutingRulesResponse struct {
+	RoutingRules         *vschema.RoutingRules `protobuf:"bytes,1,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetRoutingRulesResponse) Reset()         { *m = GetRoutingRulesResponse{} }
+func (m *GetRoutingRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoutingRulesResponse) ProtoMessage()    {}
+func (*GetRoutingRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{200}
+}
+
+func (m *GetRoutingRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoutingRulesResponse.Unmarshal(m, b)
+}
+func (m *GetRoutingRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoutingRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRoutingRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoutingRulesResponse.Merge(m, src)
+}
+func (m *GetRoutingRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRoutingRulesResponse.Size(m)
+}
+func (m *GetRoutingRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoutingRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRoutingRulesResponse proto.InternalMessageInfo
+
+func (m *GetRoutingRulesResponse) GetRoutingRules() *vschema.RoutingRules {
+	if m != nil {
+		return m.RoutingRules
+	}
+	return nil
+}
+
+type ApplyRoutingRulesRequest struct {
+	RoutingRules *vschema.RoutingRules `protobuf:"bytes,1,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// skip_rebuild doesn't rebuild the SrvVSchema objects. The changes
+	// take effect with the next RebuildVSchemaGraph.
+	SkipRebuild bool `protobuf:"varint,2,opt,name=skip_rebuild,json=skipRebuild,proto3" json:"skip_rebuild,omitempty"`
+	// cells limits the rebuild to the cells, all cells are rebuilt if
+	// it's empty.
+	Cells                []string `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyRoutingRulesRequest) Reset()         { *m = ApplyRoutingRulesRequest{} }
+func (m *ApplyRoutingRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyRoutingRulesRequest) ProtoMessage()    {}
+func (*ApplyRoutingRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{201}
+}
+
+func (m *ApplyRoutingRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyRoutingRulesRequest.Unmarshal(m, b)
+}
+func (m *ApplyRoutingRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyRoutingRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyRoutingRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyRoutingRulesRequest.Merge(m, src)
+}
+func (m *ApplyRoutingRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyRoutingRulesRequest.Size(m)
+}
+func (m *ApplyRoutingRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyRoutingRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyRoutingRulesRequest proto.InternalMessageInfo
+
+func (m *ApplyRoutingRulesRequest) GetRoutingRules() *vschema.RoutingRules {
+	if m != nil {
+		return m.RoutingRules
+	}
+	return nil
+}
+
+func (m *ApplyRoutingRulesRequest) GetSkipRebuild() bool {
+	if m != nil {
+		return m.SkipRebuild
+	}
+	return false
+}
+
+func (m *ApplyRoutingRulesRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type ApplyRoutingRulesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyRoutingRulesResponse) Reset()         { *m = ApplyRoutingRulesResponse{} }
+func (m *ApplyRoutingRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyRoutingRulesResponse) ProtoMessage()    {}
+func (*ApplyRoutingRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{202}
+}
+
+func (m *ApplyRoutingRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyRoutingRulesResponse.Unmarshal(m, b)
+}
+func (m *ApplyRoutingRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyRoutingRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyRoutingRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyRoutingRulesResponse.Merge(m, src)
+}
+func (m *ApplyRoutingRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyRoutingRulesResponse.Size(m)
+}
+func (m *ApplyRoutingRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyRoutingRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyRoutingRulesResponse proto.InternalMessageInfo
+
+type RebuildVSchemaGraphRequest struct {
+	// cells is optional, all cells are rebuilt if it's empty.
+	Cells                []string `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildVSchemaGraphRequest) Reset()         { *m = RebuildVSchemaGraphRequest{} }
+func (m *RebuildVSchemaGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildVSchemaGraphRequest) ProtoMessage()    {}
+func (*RebuildVSchemaGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{203}
+}
+
+func (m *RebuildVSchemaGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildVSchemaGraphRequest.Unmarshal(m, b)
+}
+func (m *RebuildVSchemaGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildVSchemaGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *RebuildVSchemaGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildVSchemaGraphRequest.Merge(m, src)
+}
+func (m *RebuildVSchemaGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_RebuildVSchemaGraphRequest.Size(m)
+}
+func (m *RebuildVSchemaGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildVSchemaGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildVSchemaGraphRequest proto.InternalMessageInfo
+
+func (m *RebuildVSchemaGraphRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type RebuildVSchemaGraphResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildVSchemaGraphResponse) Reset()         { *m = RebuildVSchemaGraphResponse{} }
+func (m *RebuildVSchemaGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildVSchemaGraphResponse) ProtoMessage()    {}
+func (*RebuildVSchemaGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{204}
+}
+
+func (m *RebuildVSchemaGraphResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildVSchemaGraphResponse.Unmarshal(m, b)
+}
+func (m *RebuildVSchemaGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildVSchemaGraphResponse.Marshal(b, m, deterministic)
+}
+func (m *RebuildVSchemaGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildVSchemaGraphResponse.Merge(m, src)
+}
+func (m *RebuildVSchemaGraphResponse) XXX_Size() int {
+	return xxx_messageInfo_RebuildVSchemaGraphResponse.Size(m)
+}
+func (m *RebuildVSchemaGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildVSchemaGraphResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildVSchemaGraphResponse proto.InternalMessageInfo
+
+type GetSrvKeyspaceNamesRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSrvKeyspaceNamesRequest) Reset()         { *m = GetSrvKeyspaceNamesRequest{} }
+func (m *GetSrvKeyspaceNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSrvKeyspaceNamesRequest) ProtoMessage()    {}
+func (*GetSrvKeyspaceNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{205}
+}
+
+func (m *GetSrvKeyspaceNamesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvKeyspaceNamesRequest.Unmarshal(m, b)
+}
+func (m *GetSrvKeyspaceNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvKeyspaceNamesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSrvKeyspaceNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvKeyspaceNamesRequest.Merge(m, src)
+}
+func (m *GetSrvKeyspaceNamesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSrvKeyspaceNamesRequest.Size(m)
+}
+func (m *GetSrvKeyspaceNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvKeyspaceNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvKeyspaceNamesRequest proto.InternalMessageInfo
+
+func (m *GetSrvKeyspaceNamesRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+type GetSrvKeyspaceNamesResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSrvKeyspaceNamesResponse) Reset()         { *m = GetSrvKeyspaceNamesResponse{} }
+func (m *GetSrvKeyspaceNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSrvKeyspaceNamesResponse) ProtoMessage()    {}
+func (*GetSrvKeyspaceNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{206}
+}
+
+func (m *GetSrvKeyspaceNamesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvKeyspaceNamesResponse.Unmarshal(m, b)
+}
+func (m *GetSrvKeyspaceNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvKeyspaceNamesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSrvKeyspaceNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvKeyspaceNamesResponse.Merge(m, src)
+}
+func (m *GetSrvKeyspaceNamesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSrvKeyspaceNamesResponse.Size(m)
+}
+func (m *GetSrvKeyspaceNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvKeyspaceNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvKeyspaceNamesResponse proto.InternalMessageInfo
+
+func (m *GetSrvKeyspaceNamesResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type GetSrvKeyspaceRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Keyspace             string   `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSrvKeyspaceRequest) Reset()         { *m = GetSrvKeyspaceRequest{} }
+func (m *GetSrvKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSrvKeyspaceRequest) ProtoMessage()    {}
+func (*GetSrvKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{207}
+}
+
+func (m *GetSrvKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *GetSrvKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSrvKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvKeyspaceRequest.Merge(m, src)
+}
+func (m *GetSrvKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSrvKeyspaceRequest.Size(m)
+}
+func (m *GetSrvKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvKeyspaceRequest proto.InternalMessageInfo
+
+func (m *GetSrvKeyspaceRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetSrvKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetSrvKeyspaceResponse struct {
+	SrvKeyspace          *topodata.SrvKeyspace `protobuf:"bytes,1,opt,name=srv_keyspace,json=srvKeyspace,proto3" json:"srv_keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetSrvKeyspaceResponse) Reset()         { *m = GetSrvKeyspaceResponse{} }
+func (m *GetSrvKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSrvKeyspaceResponse) ProtoMessage()    {}
+func (*GetSrvKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{208}
+}
+
+func (m *GetSrvKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *GetSrvKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSrvKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvKeyspaceResponse.Merge(m, src)
+}
+func (m *GetSrvKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSrvKeyspaceResponse.Size(m)
+}
+func (m *GetSrvKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvKeyspaceResponse proto.InternalMessageInfo
+
+func (m *GetSrvKeyspaceResponse) GetSrvKeyspace() *topodata.SrvKeyspace {
+	if m != nil {
+		return m.SrvKeyspace
+	}
+	return nil
+}
+
+type GetSrvVSchemaRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSrvVSchemaRequest) Reset()         { *m = GetSrvVSchemaRequest{} }
+func (m *GetSrvVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSrvVSchemaRequest) ProtoMessage()    {}
+func (*GetSrvVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{209}
+}
+
+func (m *GetSrvVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvVSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetSrvVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSrvVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvVSchemaRequest.Merge(m, src)
+}
+func (m *GetSrvVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSrvVSchemaRequest.Size(m)
+}
+func (m *GetSrvVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvVSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSrvVSchemaRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+type GetSrvVSchemaResponse struct {
+	SrvVschema           *vschema.SrvVSchema `protobuf:"bytes,1,opt,name=srv_vschema,json=srvVschema,proto3" json:"srv_vschema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetSrvVSchemaResponse) Reset()         { *m = GetSrvVSchemaResponse{} }
+func (m *GetSrvVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSrvVSchemaResponse) ProtoMessage()    {}
+func (*GetSrvVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{210}
+}
+
+func (m *GetSrvVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSrvVSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetSrvVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSrvVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSrvVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSrvVSchemaResponse.Merge(m, src)
+}
+func (m *GetSrvVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSrvVSchemaResponse.Size(m)
+}
+func (m *GetSrvVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSrvVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSrvVSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSrvVSchemaResponse) GetSrvVschema() *vschema.SrvVSchema {
+	if m != nil {
+		return m.SrvVschema
+	}
+	return nil
+}
+
+type DeleteSrvVSchemaRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSrvVSchemaRequest) Reset()         { *m = DeleteSrvVSchemaRequest{} }
+func (m *DeleteSrvVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSrvVSchemaRequest) ProtoMessage()    {}
+func (*DeleteSrvVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{211}
+}
+
+func (m *DeleteSrvVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSrvVSchemaRequest.Unmarshal(m, b)
+}
+func (m *DeleteSrvVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSrvVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSrvVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSrvVSchemaRequest.Merge(m, src)
+}
+func (m *DeleteSrvVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSrvVSchemaRequest.Size(m)
+}
+func (m *DeleteSrvVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSrvVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSrvVSchemaRequest proto.InternalMessageInfo
+
+func (m *DeleteSrvVSchemaRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+type DeleteSrvVSchemaResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSrvVSchemaResponse) Reset()         { *m = DeleteSrvVSchemaResponse{} }
+func (m *DeleteSrvVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteSrvVSchemaResponse) ProtoMessage()    {}
+func (*DeleteSrvVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{212}
+}
+
+func (m *DeleteSrvVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSrvVSchemaResponse.Unmarshal(m, b)
+}
+func (m *DeleteSrvVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSrvVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteSrvVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSrvVSchemaResponse.Merge(m, src)
+}
+func (m *DeleteSrvVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteSrvVSchemaResponse.Size(m)
+}
+func (m *DeleteSrvVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSrvVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSrvVSchemaResponse proto.InternalMessageInfo
+
+type GetShardReplicationRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Keyspace             string   `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardReplicationRequest) Reset()         { *m = GetShardReplicationRequest{} }
+func (m *GetShardReplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardReplicationRequest) ProtoMessage()    {}
+func (*GetShardReplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{213}
+}
+
+func (m *GetShardReplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardReplicationRequest.Unmarshal(m, b)
+}
+func (m *GetShardReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardReplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardReplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardReplicationRequest.Merge(m, src)
+}
+func (m *GetShardReplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardReplicationRequest.Size(m)
+}
+func (m *GetShardReplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardReplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardReplicationRequest proto.InternalMessageInfo
+
+func (m *GetShardReplicationRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetShardReplicationRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetShardReplicationRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type GetShardReplicationResponse struct {
+	ShardReplication     *topodata.ShardReplication `protobuf:"bytes,1,opt,name=shard_replication,json=shardReplication,proto3" json:"shard_replication,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetShardReplicationResponse) Reset()         { *m = GetShardReplicationResponse{} }
+func (m *GetShardReplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardReplicationResponse) ProtoMessage()    {}
+func (*GetShardReplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{214}
+}
+
+func (m *GetShardReplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardReplicationResponse.Unmarshal(m, b)
+}
+func (m *GetShardReplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardReplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardReplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardReplicationResponse.Merge(m, src)
+}
+func (m *GetShardReplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardReplicationResponse.Size(m)
+}
+func (m *GetShardReplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardReplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardReplicationResponse proto.InternalMessageInfo
+
+func (m *GetShardReplicationResponse) GetShardReplication() *topodata.ShardReplication {
+	if m != nil {
+		return m.ShardReplication
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	PingTablets bool `protobuf:"varint,1,opt,name=ping_tablets,json=pingTablets,proto3" json:"ping_tablets,omitempty"`
+	// repair fixes the inconsistencies which can be repaired
+	// automatically before validating.
+	Repair bool `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+	// dry_run only logs the changes repair would make.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateRequest) Reset()         { *m = ValidateRequest{} }
+func (m *ValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateRequest) ProtoMessage()    {}
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{215}
+}
+
+func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateRequest.Unmarshal(m, b)
+}
+func (m *ValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateRequest.Merge(m, src)
+}
+func (m *ValidateRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateRequest.Size(m)
+}
+func (m *ValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateRequest proto.InternalMessageInfo
+
+func (m *ValidateRequest) GetPingTablets() bool {
+	if m != nil {
+		return m.PingTablets
+	}
+	return false
+}
+
+func (m *ValidateRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+func (m *ValidateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// ValidateResponse is streamed back by Validate, with the problems found
+// as log lines.
+type ValidateResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidateResponse) Reset()         { *m = ValidateResponse{} }
+func (m *ValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateResponse) ProtoMessage()    {}
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{216}
+}
+
+func (m *ValidateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResponse.Unmarshal(m, b)
+}
+func (m *ValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResponse.Merge(m, src)
+}
+func (m *ValidateResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateResponse.Size(m)
+}
+func (m *ValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResponse proto.InternalMessageInfo
+
+func (m *ValidateResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type InitShardMasterRequest struct {
+	Keyspace               string                `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                  string                `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	MasterElectTabletAlias *topodata.TabletAlias `protobuf:"bytes,3,opt,name=master_elect_tablet_alias,json=masterElectTabletAlias,proto3" json:"master_elect_tablet_alias,omitempty"`
+	// force reparents even if the tablet is not a master or the shard
+	// master.
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// wait_slave_timeout is the time to wait for the slaves to catch up.
+	// It's 30 seconds if it's not set.
+	WaitSlaveTimeout     *vttime.Duration `protobuf:"bytes,5,opt,name=wait_slave_timeout,json=waitSlaveTimeout,proto3" json:"wait_slave_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *InitShardMasterRequest) Reset()         { *m = InitShardMasterRequest{} }
+func (m *InitShardMasterRequest) String() string { return proto.CompactTextString(m) }
+func (*InitShardMasterRequest) ProtoMessage()    {}
+func (*InitShardMasterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{217}
+}
+
+func (m *InitShardMasterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitShardMasterRequest.Unmarshal(m, b)
+}
+func (m *InitShardMasterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitShardMasterRequest.Marshal(b, m, deterministic)
+}
+func (m *InitShardMasterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitShardMasterRequest.Merge(m, src)
+}
+func (m *InitShardMasterRequest) XXX_Size() int {
+	return xxx_messageInfo_InitShardMasterRequest.Size(m)
+}
+func (m *InitShardMasterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitShardMasterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitShardMasterRequest proto.InternalMessageInfo
+
+func (m *InitShardMasterRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *InitShardMasterRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *InitShardMasterRequest) GetMasterElectTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.MasterElectTabletAlias
+	}
+	return nil
+}
+
+func (m *InitShardMasterRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *InitShardMasterRequest) GetWaitSlaveTimeout() *vttime.Duration {
+	if m != nil {
+		return m.WaitSlaveTimeout
+	}
+	return nil
+}
+
+// InitShardMasterResponse is streamed back by InitShardMaster, with its
+// log lines.
+type InitShardMasterResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitShardMasterResponse) Reset()         { *m = InitShardMasterResponse{} }
+func (m *InitShardMasterResponse) String() string { return proto.CompactTextString(m) }
+func (*InitShardMasterResponse) ProtoMessage()    {}
+func (*InitShardMasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{218}
+}
+
+func (m *InitShardMasterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitShardMasterResponse.Unmarshal(m, b)
+}
+func (m *InitShardMasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitShardMasterResponse.Marshal(b, m, deterministic)
+}
+func (m *InitShardMasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitShardMasterResponse.Merge(m, src)
+}
+func (m *InitShardMasterResponse) XXX_Size() int {
+	return xxx_messageInfo_InitShardMasterResponse.Size(m)
+}
+func (m *InitShardMasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitShardMasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitShardMasterResponse proto.InternalMessageInfo
+
+func (m *InitShardMasterResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type PlannedReparentShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// new_master is optional, the new master is chosen among the replicas
+	// if it's not set.
+	NewMaster *topodata.TabletAlias `protobuf:"bytes,3,opt,name=new_master,json=newMaster,proto3" json:"new_master,omitempty"`
+	// avoid_master reparents to any other tablet if it's the master.
+	AvoidMaster *topodata.TabletAlias `protobuf:"bytes,4,opt,name=avoid_master,json=avoidMaster,proto3" json:"avoid_master,omitempty"`
+	// wait_slave_timeout is the time to wait for the replicas to catch up
+	// before and after reparenting. It's the remote operation timeout if
+	// it's not set.
+	WaitSlaveTimeout *vttime.Duration `protobuf:"bytes,5,opt,name=wait_slave_timeout,json=waitSlaveTimeout,proto3" json:"wait_slave_timeout,omitempty"`
+	// dry_run only returns the plan of the reparent, with the problems
+	// which would make it fail.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// precheck reparents only if the dry run finds no problem.
+	Precheck             bool     `protobuf:"varint,7,opt,name=precheck,proto3" json:"precheck,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlannedReparentShardRequest) Reset()         { *m = PlannedReparentShardRequest{} }
+func (m *PlannedReparentShardRequest) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentShardRequest) ProtoMessage()    {}
+func (*PlannedReparentShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{219}
+}
+
+func (m *PlannedReparentShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentShardRequest.Unmarshal(m, b)
+}
+func (m *PlannedReparentShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentShardRequest.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentShardRequest.Merge(m, src)
+}
+func (m *PlannedReparentShardRequest) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentShardRequest.Size(m)
+}
+func (m *PlannedReparentShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentShardRequest proto.InternalMessageInfo
+
+func (m *PlannedReparentShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PlannedReparentShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *PlannedReparentShardRequest) GetNewMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.NewMaster
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardRequest) GetAvoidMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.AvoidMaster
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardRequest) GetWaitSlaveTimeout() *vttime.Duration {
+	if m != nil {
+		return m.WaitSlaveTimeout
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlannedReparentShardRequest) GetPrecheck() bool {
+	if m != nil {
+		return m.Precheck
+	}
+	return false
+}
+
+// PlannedReparentCandidate is the replication state of a tablet of the
+// shard, in a PlannedReparentPlan.
+type PlannedReparentCandidate struct {
+	Tablet      string `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Position    string `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Replicating bool   `protobuf:"varint,4,opt,name=replicating,proto3" json:"replicating,omitempty"`
+	LagSeconds  uint32 `protobuf:"varint,5,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	SemiSync    bool   `protobuf:"varint,6,opt,name=semi_sync,json=semiSync,proto3" json:"semi_sync,omitempty"`
+	// reason is why the tablet cannot be the new master.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// health is why the tablet may not catch up with the master in time,
+	// if it's the new master.
+	Health               string   `protobuf:"bytes,8,opt,name=health,proto3" json:"health,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlannedReparentCandidate) Reset()         { *m = PlannedReparentCandidate{} }
+func (m *PlannedReparentCandidate) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentCandidate) ProtoMessage()    {}
+func (*PlannedReparentCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{220}
+}
+
+func (m *PlannedReparentCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentCandidate.Unmarshal(m, b)
+}
+func (m *PlannedReparentCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentCandidate.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentCandidate.Merge(m, src)
+}
+func (m *PlannedReparentCandidate) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentCandidate.Size(m)
+}
+func (m *PlannedReparentCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentCandidate proto.InternalMessageInfo
+
+func (m *PlannedReparentCandidate) GetTablet() string {
+	if m != nil {
+		return m.Tablet
+	}
+	return ""
+}
+
+func (m *PlannedReparentCandidate) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PlannedReparentCandidate) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+func (m *PlannedReparentCandidate) GetReplicating() bool {
+	if m != nil {
+		return m.Replicating
+	}
+	return false
+}
+
+func (m *PlannedReparentCandidate) GetLagSeconds() uint32 {
+	if m != nil {
+		return m.LagSeconds
+	}
+	return 0
+}
+
+func (m *PlannedReparentCandidate) GetSemiSync() bool {
+	if m != nil {
+		return m.SemiSync
+	}
+	return false
+}
+
+func (m *PlannedReparentCandidate) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PlannedReparentCandidate) GetHealth() string {
+	if m != nil {
+		return m.Health
+	}
+	return ""
+}
+
+// PlannedReparentPlan is what PlannedReparentShard would do.
+type PlannedReparentPlan struct {
+	Keyspace       string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard          string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	CurrentMaster  string `protobuf:"bytes,3,opt,name=current_master,json=currentMaster,proto3" json:"current_master,omitempty"`
+	MasterSemiSync bool   `protobuf:"varint,4,opt,name=master_semi_sync,json=masterSemiSync,proto3" json:"master_semi_sync,omitempty"`
+	// new_master is empty if PlannedReparentShard has nothing to do.
+	NewMaster        string                      `protobuf:"bytes,5,opt,name=new_master,json=newMaster,proto3" json:"new_master,omitempty"`
+	Candidates       []*PlannedReparentCandidate `protobuf:"bytes,6,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	CatchUpTime      string                      `protobuf:"bytes,7,opt,name=catch_up_time,json=catchUpTime,proto3" json:"catch_up_time,omitempty"`
+	ExpectedDowntime string                      `protobuf:"bytes,8,opt,name=expected_downtime,json=expectedDowntime,proto3" json:"expected_downtime,omitempty"`
+	// problems are the reasons why PlannedReparentShard would fail.
+	Problems []string `protobuf:"bytes,9,rep,name=problems,proto3" json:"problems,omitempty"`
+	// warnings are the reasons why PlannedReparentShard may fail.
+	Warnings             []string `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlannedReparentPlan) Reset()         { *m = PlannedReparentPlan{} }
+func (m *PlannedReparentPlan) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentPlan) ProtoMessage()    {}
+func (*PlannedReparentPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{221}
+}
+
+func (m *PlannedReparentPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentPlan.Unmarshal(m, b)
+}
+func (m *PlannedReparentPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentPlan.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentPlan.Merge(m, src)
+}
+func (m *PlannedReparentPlan) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentPlan.Size(m)
+}
+func (m *PlannedReparentPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentPlan proto.InternalMessageInfo
+
+func (m *PlannedReparentPlan) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetCurrentMaster() string {
+	if m != nil {
+		return m.CurrentMaster
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetMasterSemiSync() bool {
+	if m != nil {
+		return m.MasterSemiSync
+	}
+	return false
+}
+
+func (m *PlannedReparentPlan) GetNewMaster() string {
+	if m != nil {
+		return m.NewMaster
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetCandidates() []*PlannedReparentCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *PlannedReparentPlan) GetCatchUpTime() string {
+	if m != nil {
+		return m.CatchUpTime
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetExpectedDowntime() string {
+	if m != nil {
+		return m.ExpectedDowntime
+	}
+	return ""
+}
+
+func (m *PlannedReparentPlan) GetProblems() []string {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+func (m *PlannedReparentPlan) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// PlannedReparentShardResponse is streamed back by PlannedReparentShard.
+// Each response has either a log line, or the plan of the reparent. The
+// plan is sent for a dry run, and when the precheck finds problems.
+type PlannedReparentShardResponse struct {
+	Event                *logutil.Event       `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Plan                 *PlannedReparentPlan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PlannedReparentShardResponse) Reset()         { *m = PlannedReparentShardResponse{} }
+func (m *PlannedReparentShardResponse) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentShardResponse) ProtoMessage()    {}
+func (*PlannedReparentShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{222}
+}
+
+func (m *PlannedReparentShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentShardResponse.Unmarshal(m, b)
+}
+func (m *PlannedReparentShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentShardResponse.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentShardResponse.Merge(m, src)
+}
+func (m *PlannedReparentShardResponse) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentShardResponse.Size(m)
+}
+func (m *PlannedReparentShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentShardResponse proto.InternalMessageInfo
+
+func (m *PlannedReparentShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardResponse) GetPlan() *PlannedReparentPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type EmergencyReparentShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// new_master is optional, the new master is chosen among the replicas
+	// if it's not set.
+	NewMaster *topodata.TabletAlias `protobuf:"bytes,3,opt,name=new_master,json=newMaster,proto3" json:"new_master,omitempty"`
+	// wait_slave_timeout is the time to wait for the replicas to catch
+	// up. It's 30 seconds if it's not set.
+	WaitSlaveTimeout *vttime.Duration `protobuf:"bytes,4,opt,name=wait_slave_timeout,json=waitSlaveTimeout,proto3" json:"wait_slave_timeout,omitempty"`
+	// preferred_cells are the cells whose tablets are chosen first as the
+	// new master, among the most advanced ones.
+	PreferredCells []string `protobuf:"bytes,5,rep,name=preferred_cells,json=preferredCells,proto3" json:"preferred_cells,omitempty"`
+	// forbidden_cells are the cells whose tablets cannot be the new
+	// master.
+	ForbiddenCells []string `protobuf:"bytes,6,rep,name=forbidden_cells,json=forbiddenCells,proto3" json:"forbidden_cells,omitempty"`
+	// tags are the tablet tags the new master must have.
+	Tags map[string]string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// max_data_loss is, if it's set, how far the last heartbeat the new
+	// master applied can be behind the one the most advanced tablet
+	// applied.
+	MaxDataLoss          *vttime.Duration `protobuf:"bytes,8,opt,name=max_data_loss,json=maxDataLoss,proto3" json:"max_data_loss,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EmergencyReparentShardRequest) Reset()         { *m = EmergencyReparentShardRequest{} }
+func (m *EmergencyReparentShardRequest) String() string { return proto.CompactTextString(m) }
+func (*EmergencyReparentShardRequest) ProtoMessage()    {}
+func (*EmergencyReparentShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{223}
+}
+
+func (m *EmergencyReparentShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Unmarshal(m, b)
+}
+func (m *EmergencyReparentShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Marshal(b, m, deterministic)
+}
+func (m *EmergencyReparentShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyReparentShardRequest.Merge(m, src)
+}
+func (m *EmergencyReparentShardRequest) XXX_Size() int {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Size(m)
+}
+func (m *EmergencyReparentShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyReparentShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyReparentShardRequest proto.InternalMessageInfo
+
+func (m *EmergencyReparentShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *EmergencyReparentShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *EmergencyReparentShardRequest) GetNewMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.NewMaster
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetWaitSlaveTimeout() *vttime.Duration {
+	if m != nil {
+		return m.WaitSlaveTimeout
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetPreferredCells() []string {
+	if m != nil {
+		return m.PreferredCells
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetForbiddenCells() []string {
+	if m != nil {
+		return m.ForbiddenCells
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetMaxDataLoss() *vttime.Duration {
+	if m != nil {
+		return m.MaxDataLoss
+	}
+	return nil
+}
+
+// EmergencyReparentShardResponse is streamed back by
+// EmergencyReparentShard, with its log lines.
+type EmergencyReparentShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EmergencyReparentShardResponse) Reset()         { *m = EmergencyReparentShardResponse{} }
+func (m *EmergencyReparentShardResponse) String() string { return proto.CompactTextString(m) }
+func (*EmergencyReparentShardResponse) ProtoMessage()    {}
+func (*EmergencyReparentShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{224}
+}
+
+func (m *EmergencyReparentShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Unmarshal(m, b)
+}
+func (m *EmergencyReparentShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Marshal(b, m, deterministic)
+}
+func (m *EmergencyReparentShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyReparentShardResponse.Merge(m, src)
+}
+func (m *EmergencyReparentShardResponse) XXX_Size() int {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Size(m)
+}
+func (m *EmergencyReparentShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyReparentShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyReparentShardResponse proto.InternalMessageInfo
+
+func (m *EmergencyReparentShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ReparentTabletRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReparentTabletRequest) Reset()         { *m = ReparentTabletRequest{} }
+func (m *ReparentTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*ReparentTabletRequest) ProtoMessage()    {}
+func (*ReparentTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{225}
+}
+
+func (m *ReparentTabletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReparentTabletRequest.Unmarshal(m, b)
+}
+func (m *ReparentTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReparentTabletRequest.Marshal(b, m, deterministic)
+}
+func (m *ReparentTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReparentTabletRequest.Merge(m, src)
+}
+func (m *ReparentTabletRequest) XXX_Size() int {
+	return xxx_messageInfo_ReparentTabletRequest.Size(m)
+}
+func (m *ReparentTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReparentTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReparentTabletRequest proto.InternalMessageInfo
+
+func (m *ReparentTabletRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type ReparentTabletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReparentTabletResponse) Reset()         { *m = ReparentTabletResponse{} }
+func (m *ReparentTabletResponse) String() string { return proto.CompactTextString(m) }
+func (*ReparentTabletResponse) ProtoMessage()    {}
+func (*ReparentTabletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{226}
+}
+
+func (m *ReparentTabletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReparentTabletResponse.Unmarshal(m, b)
+}
+func (m *ReparentTabletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReparentTabletResponse.Marshal(b, m, deterministic)
+}
+func (m *ReparentTabletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReparentTabletResponse.Merge(m, src)
+}
+func (m *ReparentTabletResponse) XXX_Size() int {
+	return xxx_messageInfo_ReparentTabletResponse.Size(m)
+}
+func (m *ReparentTabletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReparentTabletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReparentTabletResponse proto.InternalMessageInfo
+
+type TabletExternallyReparentedRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TabletExternallyReparentedRequest) Reset()         { *m = TabletExternallyReparentedRequest{} }
+func (m *TabletExternallyReparentedRequest) String() string { return proto.CompactTextString(m) }
+func (*TabletExternallyReparentedRequest) ProtoMessage()    {}
+func (*TabletExternallyReparentedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{227}
+}
+
+func (m *TabletExternallyReparentedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TabletExternallyReparentedRequest.Unmarshal(m, b)
+}
+func (m *TabletExternallyReparentedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TabletExternallyReparentedRequest.Marshal(b, m, deterministic)
+}
+func (m *TabletExternallyReparentedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletExternallyReparentedRequest.Merge(m, src)
+}
+func (m *TabletExternallyReparentedRequest) XXX_Size() int {
+	return xxx_messageInfo_TabletExternallyReparentedRequest.Size(m)
+}
+func (m *TabletExternallyReparentedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletExternallyReparentedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TabletExternallyReparentedRequest proto.InternalMessageInfo
+
+func (m *TabletExternallyReparentedRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type TabletExternallyReparentedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TabletExternallyReparentedResponse) Reset()         { *m = TabletExternallyReparentedResponse{} }
+func (m *TabletExternallyReparentedResponse) String() string { return proto.CompactTextString(m) }
+func (*TabletExternallyReparentedResponse) ProtoMessage()    {}
+func (*TabletExternallyReparentedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{228}
+}
+
+func (m *TabletExternallyReparentedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TabletExternallyReparentedResponse.Unmarshal(m, b)
+}
+func (m *TabletExternallyReparentedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TabletExternallyReparentedResponse.Marshal(b, m, deterministic)
+}
+func (m *TabletExternallyReparentedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletExternallyReparentedResponse.Merge(m, src)
+}
+func (m *TabletExternallyReparentedResponse) XXX_Size() int {
+	return xxx_messageInfo_TabletExternallyReparentedResponse.Size(m)
+}
+func (m *TabletExternallyReparentedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletExternallyReparentedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TabletExternallyReparentedResponse proto.InternalMessageInfo
+
+type BackupShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// concurrency is the number of files to back up in parallel.
+	Concurrency int64 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// allow_master backs up the master tablet if no other tablet is
+	// available.
+	AllowMaster bool `protobuf:"varint,4,opt,name=allow_master,json=allowMaster,proto3" json:"allow_master,omitempty"`
+	// incremental takes an incremental backup of the binary logs,
+	// whatever the backup engine of the tablet.
+	Incremental          bool     `protobuf:"varint,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupShardRequest) Reset()         { *m = BackupShardRequest{} }
+func (m *BackupShardRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupShardRequest) ProtoMessage()    {}
+func (*BackupShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{229}
+}
+
+func (m *BackupShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupShardRequest.Unmarshal(m, b)
+}
+func (m *BackupShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupShardRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupShardRequest.Merge(m, src)
+}
+func (m *BackupShardRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupShardRequest.Size(m)
+}
+func (m *BackupShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupShardRequest proto.InternalMessageInfo
+
+func (m *BackupShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *BackupShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *BackupShardRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *BackupShardRequest) GetAllowMaster() bool {
+	if m != nil {
+		return m.AllowMaster
+	}
+	return false
+}
+
+func (m *BackupShardRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+// BackupShardResponse is streamed back by BackupShard, with the log lines
+// of the backup as they happen.
+type BackupShardResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BackupShardResponse) Reset()         { *m = BackupShardResponse{} }
+func (m *BackupShardResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupShardResponse) ProtoMessage()    {}
+func (*BackupShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{230}
+}
+
+func (m *BackupShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupShardResponse.Unmarshal(m, b)
+}
+func (m *BackupShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupShardResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupShardResponse.Merge(m, src)
+}
+func (m *BackupShardResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupShardResponse.Size(m)
+}
+func (m *BackupShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupShardResponse proto.InternalMessageInfo
+
+func (m *BackupShardResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type ListBackupsRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBackupsRequest) Reset()         { *m = ListBackupsRequest{} }
+func (m *ListBackupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsRequest) ProtoMessage()    {}
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{231}
+}
+
+func (m *ListBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsRequest.Unmarshal(m, b)
+}
+func (m *ListBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsRequest.Merge(m, src)
+}
+func (m *ListBackupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsRequest.Size(m)
+}
+func (m *ListBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsRequest proto.InternalMessageInfo
+
+func (m *ListBackupsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ListBackupsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type ListBackupsResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBackupsResponse) Reset()         { *m = ListBackupsResponse{} }
+func (m *ListBackupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsResponse) ProtoMessage()    {}
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{232}
+}
+
+func (m *ListBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsResponse.Unmarshal(m, b)
+}
+func (m *ListBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsResponse.Merge(m, src)
+}
+func (m *ListBackupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsResponse.Size(m)
+}
+func (m *ListBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsResponse proto.InternalMessageInfo
+
+func (m *ListBackupsResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type RemoveBackupRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveBackupRequest) Reset()         { *m = RemoveBackupRequest{} }
+func (m *RemoveBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveBackupRequest) ProtoMessage()    {}
+func (*RemoveBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{233}
+}
+
+func (m *RemoveBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveBackupRequest.Unmarshal(m, b)
+}
+func (m *RemoveBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBackupRequest.Merge(m, src)
+}
+func (m *RemoveBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveBackupRequest.Size(m)
+}
+func (m *RemoveBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBackupRequest proto.InternalMessageInfo
+
+func (m *RemoveBackupRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RemoveBackupRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *RemoveBackupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RemoveBackupResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveBackupResponse) Reset()         { *m = RemoveBackupResponse{} }
+func (m *RemoveBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveBackupResponse) ProtoMessage()    {}
+func (*RemoveBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{234}
+}
+
+func (m *RemoveBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveBackupResponse.Unmarshal(m, b)
+}
+func (m *RemoveBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBackupResponse.Merge(m, src)
+}
+func (m *RemoveBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveBackupResponse.Size(m)
+}
+func (m *RemoveBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBackupResponse proto.InternalMessageInfo
+
+type PruneBackupsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// keep_latest is the number of most recent backups to keep.
+	KeepLatest int64 `protobuf:"varint,3,opt,name=keep_latest,json=keepLatest,proto3" json:"keep_latest,omitempty"`
+	// keep_daily is the number of days whose last backup is kept.
+	KeepDaily int64 `protobuf:"varint,4,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
+	// keep_weekly is the number of weeks whose last backup is kept.
+	KeepWeekly int64 `protobuf:"varint,5,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
+	// dry_run only returns the backups which would be removed.
+	DryRun               bool     `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneBackupsRequest) Reset()         { *m = PruneBackupsRequest{} }
+func (m *PruneBackupsRequest) String() string { return proto.CompactTextString(m) }
+func (*PruneBackupsRequest) ProtoMessage()    {}
+func (*PruneBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{235}
+}
+
+func (m *PruneBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneBackupsRequest.Unmarshal(m, b)
+}
+func (m *PruneBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneBackupsRequest.Marshal(b, m, deterministic)
+}
+func (m *PruneBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneBackupsRequest.Merge(m, src)
+}
+func (m *PruneBackupsRequest) XXX_Size() int {
+	return xxx_messageInfo_PruneBackupsRequest.Size(m)
+}
+func (m *PruneBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneBackupsRequest proto.InternalMessageInfo
+
+func (m *PruneBackupsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PruneBackupsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *PruneBackupsRequest) GetKeepLatest() int64 {
+	if m != nil {
+		return m.KeepLatest
+	}
+	return 0
+}
+
+func (m *PruneBackupsRequest) GetKeepDaily() int64 {
+	if m != nil {
+		return m.KeepDaily
+	}
+	return 0
+}
+
+func (m *PruneBackupsRequest) GetKeepWeekly() int64 {
+	if m != nil {
+		return m.KeepWeekly
+	}
+	return 0
+}
+
+func (m *PruneBackupsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PruneBackupsResponse struct {
+	// names are the backups which were removed.
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneBackupsResponse) Reset()         { *m = PruneBackupsResponse{} }
+func (m *PruneBackupsResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneBackupsResponse) ProtoMessage()    {}
+func (*PruneBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{236}
+}
+
+func (m *PruneBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneBackupsResponse.Unmarshal(m, b)
+}
+func (m *PruneBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneBackupsResponse.Marshal(b, m, deterministic)
+}
+func (m *PruneBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneBackupsResponse.Merge(m, src)
+}
+func (m *PruneBackupsResponse) XXX_Size() int {
+	return xxx_messageInfo_PruneBackupsResponse.Size(m)
+}
+func (m *PruneBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneBackupsResponse proto.InternalMessageInfo
+
+func (m *PruneBackupsResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type VerifyBackupRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// concurrency is the number of files to download and check in
+	// parallel.
+	Concurrency int64 `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// restore_tablet_alias is optional. If it's set, the backup is then
+	// restored on this SPARE or DRAINED tablet of the shard.
+	RestoreTabletAlias   *topodata.TabletAlias `protobuf:"bytes,5,opt,name=restore_tablet_alias,json=restoreTabletAlias,proto3" json:"restore_tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VerifyBackupRequest) Reset()         { *m = VerifyBackupRequest{} }
+func (m *VerifyBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyBackupRequest) ProtoMessage()    {}
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{237}
+}
+
+func (m *VerifyBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyBackupRequest.Unmarshal(m, b)
+}
+func (m *VerifyBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyBackupRequest.Merge(m, src)
+}
+func (m *VerifyBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyBackupRequest.Size(m)
+}
+func (m *VerifyBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyBackupRequest proto.InternalMessageInfo
+
+func (m *VerifyBackupRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VerifyBackupRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *VerifyBackupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VerifyBackupRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *VerifyBackupRequest) GetRestoreTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.RestoreTabletAlias
+	}
+	return nil
+}
+
+// VerifyBackupResponse is streamed back by VerifyBackup, with the log
+// lines of the verification and of the restore as they happen.
+type VerifyBackupResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VerifyBackupResponse) Reset()         { *m = VerifyBackupResponse{} }
+func (m *VerifyBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyBackupResponse) ProtoMessage()    {}
+func (*VerifyBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{238}
+}
+
+func (m *VerifyBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyBackupResponse.Unmarshal(m, b)
+}
+func (m *VerifyBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyBackupResponse.Merge(m, src)
+}
+func (m *VerifyBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyBackupResponse.Size(m)
+}
+func (m *VerifyBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyBackupResponse proto.InternalMessageInfo
+
+func (m *VerifyBackupResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type RestoreFromBackupRequest struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// restore_request selects the backup to restore. The latest one is
+	// restored if it's not set.
+	RestoreRequest       *tabletmanagerdata.RestoreFromBackupRequest `protobuf:"bytes,2,opt,name=restore_request,json=restoreRequest,proto3" json:"restore_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupRequest{} }
+func (m *RestoreFromBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreFromBackupRequest) ProtoMessage()    {}
+func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{239}
+}
+
+func (m *RestoreFromBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreFromBackupRequest.Unmarshal(m, b)
+}
+func (m *RestoreFromBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreFromBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreFromBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreFromBackupRequest.Merge(m, src)
+}
+func (m *RestoreFromBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreFromBackupRequest.Size(m)
+}
+func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreFromBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
+
+func (m *RestoreFromBackupRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreRequest() *tabletmanagerdata.RestoreFromBackupRequest {
+	if m != nil {
+		return m.RestoreRequest
+	}
+	return nil
+}
+
+// RestoreFromBackupResponse is streamed back by RestoreFromBackup, with
+// the log lines of the restore as they happen.
+type RestoreFromBackupResponse struct {
+	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RestoreFromBackupResponse) Reset()         { *m = RestoreFromBackupResponse{} }
+func (m *RestoreFromBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreFromBackupResponse) ProtoMessage()    {}
+func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{240}
+}
+
+func (m *RestoreFromBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreFromBackupResponse.Unmarshal(m, b)
+}
+func (m *RestoreFromBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreFromBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreFromBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreFromBackupResponse.Merge(m, src)
+}
+func (m *RestoreFromBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreFromBackupResponse.Size(m)
+}
+func (m *RestoreFromBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreFromBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreFromBackupResponse proto.InternalMessageInfo
+
+func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type AddCellInfoRequest struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CellInfo             *topodata.CellInfo `protobuf:"bytes,2,opt,name=cell_info,json=cellInfo,proto3" json:"cell_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AddCellInfoRequest) Reset()         { *m = AddCellInfoRequest{} }
+func (m *AddCellInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCellInfoRequest) ProtoMessage()    {}
+func (*AddCellInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{241}
+}
+
+func (m *AddCellInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCellInfoRequest.Unmarshal(m, b)
+}
+func (m *AddCellInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCellInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCellInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCellInfoRequest.Merge(m, src)
+}
+func (m *AddCellInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCellInfoRequest.Size(m)
+}
+func (m *AddCellInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCellInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCellInfoRequest proto.InternalMessageInfo
+
+func (m *AddCellInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddCellInfoRequest) GetCellInfo() *topodata.CellInfo {
+	if m != nil {
+		return m.CellInfo
+	}
+	return nil
+}
+
+type AddCellInfoResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCellInfoResponse) Reset()         { *m = AddCellInfoResponse{} }
+func (m *AddCellInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCellInfoResponse) ProtoMessage()    {}
+func (*AddCellInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{242}
+}
+
+func (m *AddCellInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCellInfoResponse.Unmarshal(m, b)
+}
+func (m *AddCellInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCellInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCellInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCellInfoResponse.Merge(m, src)
+}
+func (m *AddCellInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCellInfoResponse.Size(m)
+}
+func (m *AddCellInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCellInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCellInfoResponse proto.InternalMessageInfo
+
+type UpdateCellInfoRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The fields of cell_info which are set replace those of the cell.
+	CellInfo             *topodata.CellInfo `protobuf:"bytes,2,opt,name=cell_info,json=cellInfo,proto3" json:"cell_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UpdateCellInfoRequest) Reset()         { *m = UpdateCellInfoRequest{} }
+func (m *UpdateCellInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCellInfoRequest) ProtoMessage()    {}
+func (*UpdateCellInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{243}
+}
+
+func (m *UpdateCellInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCellInfoRequest.Unmarshal(m, b)
+}
+func (m *UpdateCellInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCellInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCellInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCellInfoRequest.Merge(m, src)
+}
+func (m *UpdateCellInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCellInfoRequest.Size(m)
+}
+func (m *UpdateCellInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCellInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCellInfoRequest proto.InternalMessageInfo
+
+func (m *UpdateCellInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCellInfoRequest) GetCellInfo() *topodata.CellInfo {
+	if m != nil {
+		return m.CellInfo
+	}
+	return nil
+}
+
+type UpdateCellInfoResponse struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CellInfo             *topodata.CellInfo `protobuf:"bytes,2,opt,name=cell_info,json=cellInfo,proto3" json:"cell_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UpdateCellInfoResponse) Reset()         { *m = UpdateCellInfoResponse{} }
+func (m *UpdateCellInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCellInfoResponse) ProtoMessage()    {}
+func (*UpdateCellInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{244}
+}
+
+func (m *UpdateCellInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCellInfoResponse.Unmarshal(m, b)
+}
+func (m *UpdateCellInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCellInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCellInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCellInfoResponse.Merge(m, src)
+}
+func (m *UpdateCellInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCellInfoResponse.Size(m)
+}
+func (m *UpdateCellInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCellInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCellInfoResponse proto.InternalMessageInfo
+
+func (m *UpdateCellInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCellInfoResponse) GetCellInfo() *topodata.CellInfo {
+	if m != nil {
+		return m.CellInfo
+	}
+	return nil
+}
+
+type DeleteCellInfoRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// force deletes the cell even if its topology server can't be
+	// reached.
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCellInfoRequest) Reset()         { *m = DeleteCellInfoRequest{} }
+func (m *DeleteCellInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCellInfoRequest) ProtoMessage()    {}
+func (*DeleteCellInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{245}
+}
+
+func (m *DeleteCellInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCellInfoRequest.Unmarshal(m, b)
+}
+func (m *DeleteCellInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCellInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCellInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCellInfoRequest.Merge(m, src)
+}
+func (m *DeleteCellInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCellInfoRequest.Size(m)
+}
+func (m *DeleteCellInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCellInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCellInfoRequest proto.InternalMessageInfo
+
+func (m *DeleteCellInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteCellInfoRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DeleteCellInfoResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCellInfoResponse) Reset()         { *m = DeleteCellInfoResponse{} }
+func (m *DeleteCellInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCellInfoResponse) ProtoMessage()    {}
+func (*DeleteCellInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{246}
+}
+
+func (m *DeleteCellInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCellInfoResponse.Unmarshal(m, b)
+}
+func (m *DeleteCellInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCellInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCellInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCellInfoResponse.Merge(m, src)
+}
+func (m *DeleteCellInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCellInfoResponse.Size(m)
+}
+func (m *DeleteCellInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCellInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCellInfoResponse proto.InternalMessageInfo
+
+type GetCellInfoNamesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCellInfoNamesRequest) Reset()         { *m = GetCellInfoNamesRequest{} }
+func (m *GetCellInfoNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCellInfoNamesRequest) ProtoMessage()    {}
+func (*GetCellInfoNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{247}
+}
+
+func (m *GetCellInfoNamesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellInfoNamesRequest.Unmarshal(m, b)
+}
+func (m *GetCellInfoNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellInfoNamesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCellInfoNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellInfoNamesRequest.Merge(m, src)
+}
+func (m *GetCellInfoNamesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCellInfoNamesRequest.Size(m)
+}
+func (m *GetCellInfoNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellInfoNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellInfoNamesRequest proto.InternalMessageInfo
+
+type GetCellInfoNamesResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCellInfoNamesResponse) Reset()         { *m = GetCellInfoNamesResponse{} }
+func (m *GetCellInfoNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCellInfoNamesResponse) ProtoMessage()    {}
+func (*GetCellInfoNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{248}
+}
+
+func (m *GetCellInfoNamesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellInfoNamesResponse.Unmarshal(m, b)
+}
+func (m *GetCellInfoNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellInfoNamesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCellInfoNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellInfoNamesResponse.Merge(m, src)
+}
+func (m *GetCellInfoNamesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCellInfoNamesResponse.Size(m)
+}
+func (m *GetCellInfoNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellInfoNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellInfoNamesResponse proto.InternalMessageInfo
+
+func (m *GetCellInfoNamesResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type GetCellInfoRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCellInfoRequest) Reset()         { *m = GetCellInfoRequest{} }
+func (m *GetCellInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCellInfoRequest) ProtoMessage()    {}
+func (*GetCellInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{249}
+}
+
+func (m *GetCellInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellInfoRequest.Unmarshal(m, b)
+}
+func (m *GetCellInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCellInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellInfoRequest.Merge(m, src)
+}
+func (m *GetCellInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCellInfoRequest.Size(m)
+}
+func (m *GetCellInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellInfoRequest proto.InternalMessageInfo
+
+func (m *GetCellInfoRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+type GetCellInfoResponse struct {
+	CellInfo             *topodata.CellInfo `protobuf:"bytes,1,opt,name=cell_info,json=cellInfo,proto3" json:"cell_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetCellInfoResponse) Reset()         { *m = GetCellInfoResponse{} }
+func (m *GetCellInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCellInfoResponse) ProtoMessage()    {}
+func (*GetCellInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{250}
+}
+
+func (m *GetCellInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellInfoResponse.Unmarshal(m, b)
+}
+func (m *GetCellInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCellInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellInfoResponse.Merge(m, src)
+}
+func (m *GetCellInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCellInfoResponse.Size(m)
+}
+func (m *GetCellInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellInfoResponse proto.InternalMessageInfo
+
+func (m *GetCellInfoResponse) GetCellInfo() *topodata.CellInfo {
+	if m != nil {
+		return m.CellInfo
+	}
+	return nil
+}
+
+type AddCellsAliasRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cells                []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCellsAliasRequest) Reset()         { *m = AddCellsAliasRequest{} }
+func (m *AddCellsAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCellsAliasRequest) ProtoMessage()    {}
+func (*AddCellsAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{251}
+}
+
+func (m *AddCellsAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCellsAliasRequest.Unmarshal(m, b)
+}
+func (m *AddCellsAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCellsAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCellsAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCellsAliasRequest.Merge(m, src)
+}
+func (m *AddCellsAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCellsAliasRequest.Size(m)
+}
+func (m *AddCellsAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCellsAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCellsAliasRequest proto.InternalMessageInfo
+
+func (m *AddCellsAliasRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddCellsAliasRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type AddCellsAliasResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCellsAliasResponse) Reset()         { *m = AddCellsAliasResponse{} }
+func (m *AddCellsAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCellsAliasResponse) ProtoMessage()    {}
+func (*AddCellsAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{252}
+}
+
+func (m *AddCellsAliasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCellsAliasResponse.Unmarshal(m, b)
+}
+func (m *AddCellsAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCellsAliasResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCellsAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCellsAliasResponse.Merge(m, src)
+}
+func (m *AddCellsAliasResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCellsAliasResponse.Size(m)
+}
+func (m *AddCellsAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCellsAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCellsAliasResponse proto.InternalMessageInfo
+
+type UpdateCellsAliasRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cells replaces the cells of the alias, if it's not empty.
+	Cells                []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCellsAliasRequest) Reset()         { *m = UpdateCellsAliasRequest{} }
+func (m *UpdateCellsAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCellsAliasRequest) ProtoMessage()    {}
+func (*UpdateCellsAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{253}
+}
+
+func (m *UpdateCellsAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCellsAliasRequest.Unmarshal(m, b)
+}
+func (m *UpdateCellsAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCellsAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCellsAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCellsAliasRequest.Merge(m, src)
+}
+func (m *UpdateCellsAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCellsAliasRequest.Size(m)
+}
+func (m *UpdateCellsAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCellsAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCellsAliasRequest proto.InternalMessageInfo
+
+func (m *UpdateCellsAliasRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCellsAliasRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type UpdateCellsAliasResponse struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CellsAlias           *topodata.CellsAlias `protobuf:"bytes,2,opt,name=cells_alias,json=cellsAlias,proto3" json:"cells_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateCellsAliasResponse) Reset()         { *m = UpdateCellsAliasResponse{} }
+func (m *UpdateCellsAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCellsAliasResponse) ProtoMessage()    {}
+func (*UpdateCellsAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{254}
+}
+
+func (m *UpdateCellsAliasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCellsAliasResponse.Unmarshal(m, b)
+}
+func (m *UpdateCellsAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCellsAliasResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCellsAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCellsAliasResponse.Merge(m, src)
+}
+func (m *UpdateCellsAliasResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCellsAliasResponse.Size(m)
+}
+func (m *UpdateCellsAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCellsAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCellsAliasResponse proto.InternalMessageInfo
+
+func (m *UpdateCellsAliasResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCellsAliasResponse) GetCellsAlias() *topodata.CellsAlias {
+	if m != nil {
+		return m.CellsAlias
+	}
+	return nil
+}
+
+type DeleteCellsAliasRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCellsAliasRequest) Reset()         { *m = DeleteCellsAliasRequest{} }
+func (m *DeleteCellsAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCellsAliasRequest) ProtoMessage()    {}
+func (*DeleteCellsAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{255}
+}
+
+func (m *DeleteCellsAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCellsAliasRequest.Unmarshal(m, b)
+}
+func (m *DeleteCellsAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCellsAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCellsAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCellsAliasRequest.Merge(m, src)
+}
+func (m *DeleteCellsAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCellsAliasRequest.Size(m)
+}
+func (m *DeleteCellsAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCellsAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCellsAliasRequest proto.InternalMessageInfo
+
+func (m *DeleteCellsAliasRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteCellsAliasResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCellsAliasResponse) Reset()         { *m = DeleteCellsAliasResponse{} }
+func (m *DeleteCellsAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCellsAliasResponse) ProtoMessage()    {}
+func (*DeleteCellsAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{256}
+}
+
+func (m *DeleteCellsAliasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCellsAliasResponse.Unmarshal(m, b)
+}
+func (m *DeleteCellsAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCellsAliasResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCellsAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCellsAliasResponse.Merge(m, src)
+}
+func (m *DeleteCellsAliasResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCellsAliasResponse.Size(m)
+}
+func (m *DeleteCellsAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCellsAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCellsAliasResponse proto.InternalMessageInfo
+
+type GetCellsAliasesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCellsAliasesRequest) Reset()         { *m = GetCellsAliasesRequest{} }
+func (m *GetCellsAliasesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCellsAliasesRequest) ProtoMessage()    {}
+func (*GetCellsAliasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{257}
+}
+
+func (m *GetCellsAliasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellsAliasesRequest.Unmarshal(m, b)
+}
+func (m *GetCellsAliasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellsAliasesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCellsAliasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellsAliasesRequest.Merge(m, src)
+}
+func (m *GetCellsAliasesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCellsAliasesRequest.Size(m)
+}
+func (m *GetCellsAliasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellsAliasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellsAliasesRequest proto.InternalMessageInfo
+
+type GetCellsAliasesResponse struct {
+	Aliases              map[string]*topodata.CellsAlias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GetCellsAliasesResponse) Reset()         { *m = GetCellsAliasesResponse{} }
+func (m *GetCellsAliasesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCellsAliasesResponse) ProtoMessage()    {}
+func (*GetCellsAliasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{258}
+}
+
+func (m *GetCellsAliasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCellsAliasesResponse.Unmarshal(m, b)
+}
+func (m *GetCellsAliasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCellsAliasesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCellsAliasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCellsAliasesResponse.Merge(m, src)
+}
+func (m *GetCellsAliasesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCellsAliasesResponse.Size(m)
+}
+func (m *GetCellsAliasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCellsAliasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCellsAliasesResponse proto.InternalMessageInfo
+
+func (m *GetCellsAliasesResponse) GetAliases() map[string]*topodata.CellsAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+type ValidateCellsAliasesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateCellsAliasesRequest) Reset()         { *m = ValidateCellsAliasesRequest{} }
+func (m *ValidateCellsAliasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateCellsAliasesRequest) ProtoMessage()    {}
+func (*ValidateCellsAliasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{259}
+}
+
+func (m *ValidateCellsAliasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateCellsAliasesRequest.Unmarshal(m, b)
+}
+func (m *ValidateCellsAliasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateCellsAliasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateCellsAliasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateCellsAliasesRequest.Merge(m, src)
+}
+func (m *ValidateCellsAliasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateCellsAliasesRequest.Size(m)
+}
+func (m *ValidateCellsAliasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateCellsAliasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateCellsAliasesRequest proto.InternalMessageInfo
+
+type ValidateCellsAliasesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateCellsAliasesResponse) Reset()         { *m = ValidateCellsAliasesResponse{} }
+func (m *ValidateCellsAliasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateCellsAliasesResponse) ProtoMessage()    {}
+func (*ValidateCellsAliasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{260}
+}
+
+func (m *ValidateCellsAliasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateCellsAliasesResponse.Unmarshal(m, b)
+}
+func (m *ValidateCellsAliasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateCellsAliasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateCellsAliasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateCellsAliasesResponse.Merge(m, src)
+}
+func (m *ValidateCellsAliasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateCellsAliasesResponse.Size(m)
+}
+func (m *ValidateCellsAliasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateCellsAliasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateCellsAliasesResponse proto.InternalMessageInfo
+
+type GetServingCellsRequest struct {
+	Cell                 string              `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletType           topodata.TabletType `protobuf:"varint,2,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetServingCellsRequest) Reset()         { *m = GetServingCellsRequest{} }
+func (m *GetServingCellsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServingCellsRequest) ProtoMessage()    {}
+func (*GetServingCellsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{261}
+}
+
+func (m *GetServingCellsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServingCellsRequest.Unmarshal(m, b)
+}
+func (m *GetServingCellsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServingCellsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetServingCellsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServingCellsRequest.Merge(m, src)
+}
+func (m *GetServingCellsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetServingCellsRequest.Size(m)
+}
+func (m *GetServingCellsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServingCellsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServingCellsRequest proto.InternalMessageInfo
+
+func (m *GetServingCellsRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetServingCellsRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+type GetServingCellsResponse struct {
+	Cells                []string `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServingCellsResponse) Reset()         { *m = GetServingCellsResponse{} }
+func (m *GetServingCellsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServingCellsResponse) ProtoMessage()    {}
+func (*GetServingCellsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{262}
+}
+
+func (m *GetServingCellsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServingCellsResponse.Unmarshal(m, b)
+}
+func (m *GetServingCellsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServingCellsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetServingCellsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServingCellsResponse.Merge(m, src)
+}
+func (m *GetServingCellsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetServingCellsResponse.Size(m)
+}
+func (m *GetServingCellsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServingCellsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServingCellsResponse proto.InternalMessageInfo
+
+func (m *GetServingCellsResponse) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type ThrottlerMaxRatesRequest struct {
+	// server is the address of the vtworker or vttablet running the
+	// throttlers.
+	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottlerMaxRatesRequest) Reset()         { *m = ThrottlerMaxRatesRequest{} }
+func (m *ThrottlerMaxRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerMaxRatesRequest) ProtoMessage()    {}
+func (*ThrottlerMaxRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{263}
+}
+
+func (m *ThrottlerMaxRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerMaxRatesRequest.Unmarshal(m, b)
+}
+func (m *ThrottlerMaxRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerMaxRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerMaxRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerMaxRatesRequest.Merge(m, src)
+}
+func (m *ThrottlerMaxRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerMaxRatesRequest.Size(m)
+}
+func (m *ThrottlerMaxRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerMaxRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerMaxRatesRequest proto.InternalMessageInfo
+
+func (m *ThrottlerMaxRatesRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+type ThrottlerMaxRatesResponse struct {
+	// rates has the max rate of each throttler. A rate of
+	// math.MaxInt64 means unlimited.
+	Rates                map[string]int64 `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ThrottlerMaxRatesResponse) Reset()         { *m = ThrottlerMaxRatesResponse{} }
+func (m *ThrottlerMaxRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerMaxRatesResponse) ProtoMessage()    {}
+func (*ThrottlerMaxRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{264}
+}
+
+func (m *ThrottlerMaxRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerMaxRatesResponse.Unmarshal(m, b)
+}
+func (m *ThrottlerMaxRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerMaxRatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerMaxRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerMaxRatesResponse.Merge(m, src)
+}
+func (m *ThrottlerMaxRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerMaxRatesResponse.Size(m)
+}
+func (m *ThrottlerMaxRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerMaxRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerMaxRatesResponse proto.InternalMessageInfo
+
+func (m *ThrottlerMaxRatesResponse) GetRates() map[string]int64 {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type ThrottlerSetMaxRateRequest struct {
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// rate is the new max rate of all throttlers. math.MaxInt64 means
+	// unlimited.
+	Rate                 int64    `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottlerSetMaxRateRequest) Reset()         { *m = ThrottlerSetMaxRateRequest{} }
+func (m *ThrottlerSetMaxRateRequest) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerSetMaxRateRequest) ProtoMessage()    {}
+func (*ThrottlerSetMaxRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{265}
+}
+
+func (m *ThrottlerSetMaxRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerSetMaxRateRequest.Unmarshal(m, b)
+}
+func (m *ThrottlerSetMaxRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerSetMaxRateRequest.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerSetMaxRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerSetMaxRateRequest.Merge(m, src)
+}
+func (m *ThrottlerSetMaxRateRequest) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerSetMaxRateRequest.Size(m)
+}
+func (m *ThrottlerSetMaxRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerSetMaxRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerSetMaxRateRequest proto.InternalMessageInfo
+
+func (m *ThrottlerSetMaxRateRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ThrottlerSetMaxRateRequest) GetRate() int64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type ThrottlerSetMaxRateResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottlerSetMaxRateResponse) Reset()         { *m = ThrottlerSetMaxRateResponse{} }
+func (m *ThrottlerSetMaxRateResponse) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerSetMaxRateResponse) ProtoMessage()    {}
+func (*ThrottlerSetMaxRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{266}
+}
+
+func (m *ThrottlerSetMaxRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerSetMaxRateResponse.Unmarshal(m, b)
+}
+func (m *ThrottlerSetMaxRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerSetMaxRateResponse.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerSetMaxRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerSetMaxRateResponse.Merge(m, src)
+}
+func (m *ThrottlerSetMaxRateResponse) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerSetMaxRateResponse.Size(m)
+}
+func (m *ThrottlerSetMaxRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerSetMaxRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerSetMaxRateResponse proto.InternalMessageInfo
+
+func (m *ThrottlerSetMaxRateResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type GetThrottlerConfigurationRequest struct {
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// throttler_name selects a single throttler. All throttlers are
+	// returned if it is empty.
+	ThrottlerName        string   `protobuf:"bytes,2,opt,name=throttler_name,json=throttlerName,proto3" json:"throttler_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThrottlerConfigurationRequest) Reset()         { *m = GetThrottlerConfigurationRequest{} }
+func (m *GetThrottlerConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThrottlerConfigurationRequest) ProtoMessage()    {}
+func (*GetThrottlerConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{267}
+}
+
+func (m *GetThrottlerConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThrottlerConfigurationRequest.Unmarshal(m, b)
+}
+func (m *GetThrottlerConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThrottlerConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetThrottlerConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThrottlerConfigurationRequest.Merge(m, src)
+}
+func (m *GetThrottlerConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThrottlerConfigurationRequest.Size(m)
+}
+func (m *GetThrottlerConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThrottlerConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThrottlerConfigurationRequest proto.InternalMessageInfo
+
+func (m *GetThrottlerConfigurationRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *GetThrottlerConfigurationRequest) GetThrottlerName() string {
+	if m != nil {
+		return m.ThrottlerName
+	}
+	return ""
+}
+
+type GetThrottlerConfigurationResponse struct {
+	Configurations       map[string]*throttlerdata.Configuration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *GetThrottlerConfigurationResponse) Reset()         { *m = GetThrottlerConfigurationResponse{} }
+func (m *GetThrottlerConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetThrottlerConfigurationResponse) ProtoMessage()    {}
+func (*GetThrottlerConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{268}
+}
+
+func (m *GetThrottlerConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThrottlerConfigurationResponse.Unmarshal(m, b)
+}
+func (m *GetThrottlerConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThrottlerConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetThrottlerConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThrottlerConfigurationResponse.Merge(m, src)
+}
+func (m *GetThrottlerConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetThrottlerConfigurationResponse.Size(m)
+}
+func (m *GetThrottlerConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThrottlerConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThrottlerConfigurationResponse proto.InternalMessageInfo
+
+func (m *GetThrottlerConfigurationResponse) GetConfigurations() map[string]*throttlerdata.Configuration {
+	if m != nil {
+		return m.Configurations
+	}
+	return nil
+}
+
+type UpdateThrottlerConfigurationRequest struct {
+	Server        string                       `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	ThrottlerName string                       `protobuf:"bytes,2,opt,name=throttler_name,json=throttlerName,proto3" json:"throttler_name,omitempty"`
+	Configuration *throttlerdata.Configuration `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// copy_zero_values also copies the fields of configuration which
+	// have their zero value.
+	CopyZeroValues       bool     `protobuf:"varint,4,opt,name=copy_zero_values,json=copyZeroValues,proto3" json:"copy_zero_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateThrottlerConfigurationRequest) Reset()         { *m = UpdateThrottlerConfigurationRequest{} }
+func (m *UpdateThrottlerConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateThrottlerConfigurationRequest) ProtoMessage()    {}
+func (*UpdateThrottlerConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{269}
+}
+
+func (m *UpdateThrottlerConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateThrottlerConfigurationRequest.Unmarshal(m, b)
+}
+func (m *UpdateThrottlerConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateThrottlerConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateThrottlerConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateThrottlerConfigurationRequest.Merge(m, src)
+}
+func (m *UpdateThrottlerConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateThrottlerConfigurationRequest.Size(m)
+}
+func (m *UpdateThrottlerConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateThrottlerConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateThrottlerConfigurationRequest proto.InternalMessageInfo
+
+func (m *UpdateThrottlerConfigurationRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *UpdateThrottlerConfigurationRequest) GetThrottlerName() string {
+	if m != nil {
+		return m.ThrottlerName
+	}
+	return ""
+}
+
+func (m *UpdateThrottlerConfigurationRequest) GetConfiguration() *throttlerdata.Configuration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+func (m *UpdateThrottlerConfigurationRequest) GetCopyZeroValues() bool {
+	if m != nil {
+		return m.CopyZeroValues
+	}
+	return false
+}
+
+type UpdateThrottlerConfigurationResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateThrottlerConfigurationResponse) Reset()         { *m = UpdateThrottlerConfigurationResponse{} }
+func (m *UpdateThrottlerConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateThrottlerConfigurationResponse) ProtoMessage()    {}
+func (*UpdateThrottlerConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{270}
+}
+
+func (m *UpdateThrottlerConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateThrottlerConfigurationResponse.Unmarshal(m, b)
+}
+func (m *UpdateThrottlerConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateThrottlerConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateThrottlerConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateThrottlerConfigurationResponse.Merge(m, src)
+}
+func (m *UpdateThrottlerConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateThrottlerConfigurationResponse.Size(m)
+}
+func (m *UpdateThrottlerConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateThrottlerConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateThrottlerConfigurationResponse proto.InternalMessageInfo
+
+func (m *UpdateThrottlerConfigurationResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type ResetThrottlerConfigurationRequest struct {
+	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	ThrottlerName        string   `protobuf:"bytes,2,opt,name=throttler_name,json=throttlerName,proto3" json:"throttler_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetThrottlerConfigurationRequest) Reset()         { *m = ResetThrottlerConfigurationRequest{} }
+func (m *ResetThrottlerConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetThrottlerConfigurationRequest) ProtoMessage()    {}
+func (*ResetThrottlerConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{271}
+}
+
+func (m *ResetThrottlerConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetThrottlerConfigurationRequest.Unmarshal(m, b)
+}
+func (m *ResetThrottlerConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetThrottlerConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetThrottlerConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetThrottlerConfigurationRequest.Merge(m, src)
+}
+func (m *ResetThrottlerConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetThrottlerConfigurationRequest.Size(m)
+}
+func (m *ResetThrottlerConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetThrottlerConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetThrottlerConfigurationRequest proto.InternalMessageInfo
+
+func (m *ResetThrottlerConfigurationRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ResetThrottlerConfigurationRequest) GetThrottlerName() string {
+	if m != nil {
+		return m.ThrottlerName
+	}
+	return ""
+}
+
+type ResetThrottlerConfigurationResponse struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetThrottlerConfigurationResponse) Reset()         { *m = ResetThrottlerConfigurationResponse{} }
+func (m *ResetThrottlerConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetThrottlerConfigurationResponse) ProtoMessage()    {}
+func (*ResetThrottlerConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{272}
+}
+
+func (m *ResetThrottlerConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetThrottlerConfigurationResponse.Unmarshal(m, b)
+}
+func (m *ResetThrottlerConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetThrottlerConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetThrottlerConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetThrottlerConfigurationResponse.Merge(m, src)
+}
+func (m *ResetThrottlerConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetThrottlerConfigurationResponse.Size(m)
+}
+func (m *ResetThrottlerConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetThrottlerConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetThrottlerConfigurationResponse proto.InternalMessageInfo
+
+func (m *ResetThrottlerConfigurationResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type TopoCatRequest struct {
+	// cell is the cell of the files. It defaults to the global cell.
+	Cell string `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	// paths can contain wildcards.
+	Paths                []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopoCatRequest) Reset()         { *m = TopoCatRequest{} }
+func (m *TopoCatRequest) String() string { return proto.CompactTextString(m) }
+func (*TopoCatRequest) ProtoMessage()    {}
+func (*TopoCatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{273}
+}
+
+func (m *TopoCatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopoCatRequest.Unmarshal(m, b)
+}
+func (m *TopoCatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopoCatRequest.Marshal(b, m, deterministic)
+}
+func (m *TopoCatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopoCatRequest.Merge(m, src)
+}
+func (m *TopoCatRequest) XXX_Size() int {
+	return xxx_messageInfo_TopoCatRequest.Size(m)
+}
+func (m *TopoCatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopoCatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopoCatRequest proto.InternalMessageInfo
+
+func (m *TopoCatRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *TopoCatRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+// TopoFile is a file of the topology, with its raw contents.
+type TopoFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopoFile) Reset()         { *m = TopoFile{} }
+func (m *TopoFile) String() string { return proto.CompactTextString(m) }
+func (*TopoFile) ProtoMessage()    {}
+func (*TopoFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{274}
+}
+
+func (m *TopoFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopoFile.Unmarshal(m, b)
+}
+func (m *TopoFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopoFile.Marshal(b, m, deterministic)
+}
+func (m *TopoFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopoFile.Merge(m, src)
+}
+func (m *TopoFile) XXX_Size() int {
+	return xxx_messageInfo_TopoFile.Size(m)
+}
+func (m *TopoFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopoFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopoFile proto.InternalMessageInfo
+
+func (m *TopoFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *TopoFile) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TopoFile) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type TopoCatResponse struct {
+	Files                []*TopoFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TopoCatResponse) Reset()         { *m = TopoCatResponse{} }
+func (m *TopoCatResponse) String() string { return proto.CompactTextString(m) }
+func (*TopoCatResponse) ProtoMessage()    {}
+func (*TopoCatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{275}
+}
+
+func (m *TopoCatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopoCatResponse.Unmarshal(m, b)
+}
+func (m *TopoCatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopoCatResponse.Marshal(b, m, deterministic)
+}
+func (m *TopoCatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopoCatResponse.Merge(m, src)
+}
+func (m *TopoCatResponse) XXX_Size() int {
+	return xxx_messageInfo_TopoCatResponse.Size(m)
+}
+func (m *TopoCatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopoCatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopoCatResponse proto.InternalMessageInfo
+
+func (m *TopoCatResponse) GetFiles() []*TopoFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// WriteTopoFileRequest is the typed equivalent of TopoCp -to_topo. The
+// other direction is covered by TopoCat.
+type WriteTopoFileRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WriteTopoFileRequest) Reset()         { *m = WriteTopoFileRequest{} }
+func (m *WriteTopoFileRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteTopoFileRequest) ProtoMessage()    {}
+func (*WriteTopoFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{276}
+}
+
+func (m *WriteTopoFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WriteTopoFileRequest.Unmarshal(m, b)
+}
+func (m *WriteTopoFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WriteTopoFileRequest.Marshal(b, m, deterministic)
+}
+func (m *WriteTopoFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTopoFileRequest.Merge(m, src)
+}
+func (m *WriteTopoFileRequest) XXX_Size() int {
+	return xxx_messageInfo_WriteTopoFileRequest.Size(m)
+}
+func (m *WriteTopoFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTopoFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTopoFileRequest proto.InternalMessageInfo
+
+func (m *WriteTopoFileRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *WriteTopoFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *WriteTopoFileRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type WriteTopoFileResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WriteTopoFileResponse) Reset()         { *m = WriteTopoFileResponse{} }
+func (m *WriteTopoFileResponse) String() string { return proto.CompactTextString(m) }
+func (*WriteTopoFileResponse) ProtoMessage()    {}
+func (*WriteTopoFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{277}
+}
+
+func (m *WriteTopoFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WriteTopoFileResponse.Unmarshal(m, b)
+}
+func (m *WriteTopoFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WriteTopoFileResponse.Marshal(b, m, deterministic)
+}
+func (m *WriteTopoFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTopoFileResponse.Merge(m, src)
+}
+func (m *WriteTopoFileResponse) XXX_Size() int {
+	return xxx_messageInfo_WriteTopoFileResponse.Size(m)
+}
+func (m *WriteTopoFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTopoFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTopoFileResponse proto.InternalMessageInfo
+
+func (m *WriteTopoFileResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type ExportTopoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTopoRequest) Reset()         { *m = ExportTopoRequest{} }
+func (m *ExportTopoRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTopoRequest) ProtoMessage()    {}
+func (*ExportTopoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{278}
+}
+
+func (m *ExportTopoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTopoRequest.Unmarshal(m, b)
+}
+func (m *ExportTopoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTopoRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTopoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTopoRequest.Merge(m, src)
+}
+func (m *ExportTopoRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTopoRequest.Size(m)
+}
+func (m *ExportTopoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTopoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTopoRequest proto.InternalMessageInfo
+
+type ExportTopoResponse struct {
+	// export is the versioned JSON document written by the ExportTopo
+	// vtctl command.
+	Export               []byte   `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTopoResponse) Reset()         { *m = ExportTopoResponse{} }
+func (m *ExportTopoResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTopoResponse) ProtoMessage()    {}
+func (*ExportTopoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{279}
+}
+
+func (m *ExportTopoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTopoResponse.Unmarshal(m, b)
+}
+func (m *ExportTopoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTopoResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTopoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTopoResponse.Merge(m, src)
+}
+func (m *ExportTopoResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTopoResponse.Size(m)
+}
+func (m *ExportTopoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTopoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTopoResponse proto.InternalMessageInfo
+
+func (m *ExportTopoResponse) GetExport() []byte {
+	if m != nil {
+		return m.Export
+	}
+	return nil
+}
+
+type ImportTopoRequest struct {
+	// export is a JSON document in the format of ExportTopoResponse.
+	Export               []byte   `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	SkipRebuild          bool     `protobuf:"varint,2,opt,name=skip_rebuild,json=skipRebuild,proto3" json:"skip_rebuild,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTopoRequest) Reset()         { *m = ImportTopoRequest{} }
+func (m *ImportTopoRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTopoRequest) ProtoMessage()    {}
+func (*ImportTopoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{280}
+}
+
+func (m *ImportTopoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTopoRequest.Unmarshal(m, b)
+}
+func (m *ImportTopoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTopoRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportTopoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTopoRequest.Merge(m, src)
+}
+func (m *ImportTopoRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportTopoRequest.Size(m)
+}
+func (m *ImportTopoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTopoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTopoRequest proto.InternalMessageInfo
+
+func (m *ImportTopoRequest) GetExport() []byte {
+	if m != nil {
+		return m.Export
+	}
+	return nil
+}
+
+func (m *ImportTopoRequest) GetSkipRebuild() bool {
+	if m != nil {
+		return m.SkipRebuild
+	}
+	return false
+}
+
+type ImportTopoResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTopoResponse) Reset()         { *m = ImportTopoResponse{} }
+func (m *ImportTopoResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportTopoResponse) ProtoMessage()    {}
+func (*ImportTopoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{281}
+}
+
+func (m *ImportTopoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTopoResponse.Unmarshal(m, b)
+}
+func (m *ImportTopoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTopoResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportTopoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTopoResponse.Merge(m, src)
+}
+func (m *ImportTopoResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportTopoResponse.Size(m)
+}
+func (m *ImportTopoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTopoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTopoResponse proto.InternalMessageInfo
+
+type GetTopoAuditLogRequest struct {
+	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// before is the name of an entry. Only the entries older than it are
+	// returned, to page through the log.
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// limit is the maximum number of entries. 0 returns all the entries.
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTopoAuditLogRequest) Reset()         { *m = GetTopoAuditLogRequest{} }
+func (m *GetTopoAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTopoAuditLogRequest) ProtoMessage()    {}
+func (*GetTopoAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{282}
+}
+
+func (m *GetTopoAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopoAuditLogRequest.Unmarshal(m, b)
+}
+func (m *GetTopoAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopoAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTopoAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopoAuditLogRequest.Merge(m, src)
+}
+func (m *GetTopoAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTopoAuditLogRequest.Size(m)
+}
+func (m *GetTopoAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopoAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopoAuditLogRequest proto.InternalMessageInfo
+
+func (m *GetTopoAuditLogRequest) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *GetTopoAuditLogRequest) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *GetTopoAuditLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// TopoAuditEntry describes a change made to the topology.
+type TopoAuditEntry struct {
+	Time                 *vttime.Time `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	HostName             string       `protobuf:"bytes,2,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	Process              string       `protobuf:"bytes,3,opt,name=process,proto3" json:"process,omitempty"`
+	UserName             string       `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Principal            string       `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	Actions              []string     `protobuf:"bytes,6,rep,name=actions,proto3" json:"actions,omitempty"`
+	Operation            string       `protobuf:"bytes,7,opt,name=operation,proto3" json:"operation,omitempty"`
+	Cell                 string       `protobuf:"bytes,8,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string       `protobuf:"bytes,9,opt,name=path,proto3" json:"path,omitempty"`
+	Version              string       `protobuf:"bytes,10,opt,name=version,proto3" json:"version,omitempty"`
+	NewVersion           string       `protobuf:"bytes,11,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	OldHash              string       `protobuf:"bytes,12,opt,name=old_hash,json=oldHash,proto3" json:"old_hash,omitempty"`
+	NewHash              string       `protobuf:"bytes,13,opt,name=new_hash,json=newHash,proto3" json:"new_hash,omitempty"`
+	Name                 string       `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TopoAuditEntry) Reset()         { *m = TopoAuditEntry{} }
+func (m *TopoAuditEntry) String() string { return proto.CompactTextString(m) }
+func (*TopoAuditEntry) ProtoMessage()    {}
+func (*TopoAuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{283}
+}
+
+func (m *TopoAuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopoAuditEntry.Unmarshal(m, b)
+}
+func (m *TopoAuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopoAuditEntry.Marshal(b, m, deterministic)
+}
+func (m *TopoAuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopoAuditEntry.Merge(m, src)
+}
+func (m *TopoAuditEntry) XXX_Size() int {
+	return xxx_messageInfo_TopoAuditEntry.Size(m)
+}
+func (m *TopoAuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopoAuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopoAuditEntry proto.InternalMessageInfo
+
+func (m *TopoAuditEntry) GetTime() *vttime.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *TopoAuditEntry) GetHostName() string {
+	if m != nil {
+		return m.HostName
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetProcess() string {
+	if m != nil {
+		return m.Process
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetActions() []string {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *TopoAuditEntry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetNewVersion() string {
+	if m != nil {
+		return m.NewVersion
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetOldHash() string {
+	if m != nil {
+		return m.OldHash
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetNewHash() string {
+	if m != nil {
+		return m.NewHash
+	}
+	return ""
+}
+
+func (m *TopoAuditEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetTopoAuditLogResponse struct {
+	// entries are sorted oldest first.
+	Entries              []*TopoAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTopoAuditLogResponse) Reset()         { *m = GetTopoAuditLogResponse{} }
+func (m *GetTopoAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTopoAuditLogResponse) ProtoMessage()    {}
+func (*GetTopoAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{284}
+}
+
+func (m *GetTopoAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopoAuditLogResponse.Unmarshal(m, b)
+}
+func (m *GetTopoAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopoAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTopoAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopoAuditLogResponse.Merge(m, src)
+}
+func (m *GetTopoAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTopoAuditLogResponse.Size(m)
+}
+func (m *GetTopoAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopoAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopoAuditLogResponse proto.InternalMessageInfo
+
+func (m *GetTopoAuditLogResponse) GetEntries() []*TopoAuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type WorkflowCreateRequest struct {
+	FactoryName string `protobuf:"bytes,1,opt,name=factory_name,json=factoryName,proto3" json:"factory_name,omitempty"`
+	// args are the command line parameters of the workflow factory.
+	Args                 []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	SkipStart            bool     `protobuf:"varint,3,opt,name=skip_start,json=skipStart,proto3" json:"skip_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowCreateRequest) Reset()         { *m = WorkflowCreateRequest{} }
+func (m *WorkflowCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCreateRequest) ProtoMessage()    {}
+func (*WorkflowCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{285}
+}
+
+func (m *WorkflowCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowCreateRequest.Unmarshal(m, b)
+}
+func (m *WorkflowCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCreateRequest.Merge(m, src)
+}
+func (m *WorkflowCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowCreateRequest.Size(m)
+}
+func (m *WorkflowCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCreateRequest proto.InternalMessageInfo
+
+func (m *WorkflowCreateRequest) GetFactoryName() string {
+	if m != nil {
+		return m.FactoryName
+	}
+	return ""
+}
+
+func (m *WorkflowCreateRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *WorkflowCreateRequest) GetSkipStart() bool {
+	if m != nil {
+		return m.SkipStart
+	}
+	return false
+}
+
+type WorkflowCreateResponse struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowCreateResponse) Reset()         { *m = WorkflowCreateResponse{} }
+func (m *WorkflowCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCreateResponse) ProtoMessage()    {}
+func (*WorkflowCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{286}
+}
+
+func (m *WorkflowCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowCreateResponse.Unmarshal(m, b)
+}
+func (m *WorkflowCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowCreateResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCreateResponse.Merge(m, src)
+}
+func (m *WorkflowCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowCreateResponse.Size(m)
+}
+func (m *WorkflowCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCreateResponse proto.InternalMessageInfo
+
+func (m *WorkflowCreateResponse) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type WorkflowStartRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStartRequest) Reset()         { *m = WorkflowStartRequest{} }
+func (m *WorkflowStartRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStartRequest) ProtoMessage()    {}
+func (*WorkflowStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{287}
+}
+
+func (m *WorkflowStartRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowStartRequest.Unmarshal(m, b)
+}
+func (m *WorkflowStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowStartRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStartRequest.Merge(m, src)
+}
+func (m *WorkflowStartRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowStartRequest.Size(m)
+}
+func (m *WorkflowStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStartRequest proto.InternalMessageInfo
+
+func (m *WorkflowStartRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type WorkflowStartResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStartResponse) Reset()         { *m = WorkflowStartResponse{} }
+func (m *WorkflowStartResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStartResponse) ProtoMessage()    {}
+func (*WorkflowStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{288}
+}
+
+func (m *WorkflowStartResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowStartResponse.Unmarshal(m, b)
+}
+func (m *WorkflowStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowStartResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStartResponse.Merge(m, src)
+}
+func (m *WorkflowStartResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowStartResponse.Size(m)
+}
+func (m *WorkflowStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStartResponse proto.InternalMessageInfo
+
+type WorkflowStopRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStopRequest) Reset()         { *m = WorkflowStopRequest{} }
+func (m *WorkflowStopRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStopRequest) ProtoMessage()    {}
+func (*WorkflowStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{289}
+}
+
+func (m *WorkflowStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowStopRequest.Unmarshal(m, b)
+}
+func (m *WorkflowStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowStopRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStopRequest.Merge(m, src)
+}
+func (m *WorkflowStopRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowStopRequest.Size(m)
+}
+func (m *WorkflowStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStopRequest proto.InternalMessageInfo
+
+func (m *WorkflowStopRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type WorkflowStopResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStopResponse) Reset()         { *m = WorkflowStopResponse{} }
+func (m *WorkflowStopResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStopResponse) ProtoMessage()    {}
+func (*WorkflowStopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{290}
+}
+
+func (m *WorkflowStopResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowStopResponse.Unmarshal(m, b)
+}
+func (m *WorkflowStopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowStopResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowStopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStopResponse.Merge(m, src)
+}
+func (m *WorkflowStopResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowStopResponse.Size(m)
+}
+func (m *WorkflowStopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStopResponse proto.InternalMessageInfo
+
+type WorkflowDeleteRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowDeleteRequest) Reset()         { *m = WorkflowDeleteRequest{} }
+func (m *WorkflowDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDeleteRequest) ProtoMessage()    {}
+func (*WorkflowDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{291}
+}
+
+func (m *WorkflowDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowDeleteRequest.Unmarshal(m, b)
+}
+func (m *WorkflowDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDeleteRequest.Merge(m, src)
+}
+func (m *WorkflowDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowDeleteRequest.Size(m)
+}
+func (m *WorkflowDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDeleteRequest proto.InternalMessageInfo
+
+func (m *WorkflowDeleteRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type WorkflowDeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowDeleteResponse) Reset()         { *m = WorkflowDeleteResponse{} }
+func (m *WorkflowDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDeleteResponse) ProtoMessage()    {}
+func (*WorkflowDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{292}
+}
+
+func (m *WorkflowDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowDeleteResponse.Unmarshal(m, b)
+}
+func (m *WorkflowDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDeleteResponse.Merge(m, src)
+}
+func (m *WorkflowDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowDeleteResponse.Size(m)
+}
+func (m *WorkflowDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDeleteResponse proto.InternalMessageInfo
+
+type WorkflowWaitRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowWaitRequest) Reset()         { *m = WorkflowWaitRequest{} }
+func (m *WorkflowWaitRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowWaitRequest) ProtoMessage()    {}
+func (*WorkflowWaitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{293}
+}
+
+func (m *WorkflowWaitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowWaitRequest.Unmarshal(m, b)
+}
+func (m *WorkflowWaitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowWaitRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowWaitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowWaitRequest.Merge(m, src)
+}
+func (m *WorkflowWaitRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowWaitRequest.Size(m)
+}
+func (m *WorkflowWaitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowWaitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowWaitRequest proto.InternalMessageInfo
+
+func (m *WorkflowWaitRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type WorkflowWaitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowWaitResponse) Reset()         { *m = WorkflowWaitResponse{} }
+func (m *WorkflowWaitResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowWaitResponse) ProtoMessage()    {}
+func (*WorkflowWaitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{294}
+}
+
+func (m *WorkflowWaitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowWaitResponse.Unmarshal(m, b)
+}
+func (m *WorkflowWaitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowWaitResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowWaitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowWaitResponse.Merge(m, src)
+}
+func (m *WorkflowWaitResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowWaitResponse.Size(m)
+}
+func (m *WorkflowWaitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowWaitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowWaitResponse proto.InternalMessageInfo
+
+type WorkflowTreeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTreeRequest) Reset()         { *m = WorkflowTreeRequest{} }
+func (m *WorkflowTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTreeRequest) ProtoMessage()    {}
+func (*WorkflowTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{295}
+}
+
+func (m *WorkflowTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowTreeRequest.Unmarshal(m, b)
+}
+func (m *WorkflowTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTreeRequest.Merge(m, src)
+}
+func (m *WorkflowTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowTreeRequest.Size(m)
+}
+func (m *WorkflowTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTreeRequest proto.InternalMessageInfo
+
+type WorkflowTreeResponse struct {
+	// tree is the JSON representation of the workflow tree, as displayed
+	// by the vtctld UI.
+	Tree                 []byte   `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTreeResponse) Reset()         { *m = WorkflowTreeResponse{} }
+func (m *WorkflowTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTreeResponse) ProtoMessage()    {}
+func (*WorkflowTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{296}
+}
+
+func (m *WorkflowTreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowTreeResponse.Unmarshal(m, b)
+}
+func (m *WorkflowTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowTreeResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTreeResponse.Merge(m, src)
+}
+func (m *WorkflowTreeResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowTreeResponse.Size(m)
+}
+func (m *WorkflowTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTreeResponse proto.InternalMessageInfo
+
+func (m *WorkflowTreeResponse) GetTree() []byte {
+	if m != nil {
+		return m.Tree
+	}
+	return nil
+}
+
+type WorkflowActionRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowActionRequest) Reset()         { *m = WorkflowActionRequest{} }
+func (m *WorkflowActionRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowActionRequest) ProtoMessage()    {}
+func (*WorkflowActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{297}
+}
+
+func (m *WorkflowActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowActionRequest.Unmarshal(m, b)
+}
+func (m *WorkflowActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowActionRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowActionRequest.Merge(m, src)
+}
+func (m *WorkflowActionRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowActionRequest.Size(m)
+}
+func (m *WorkflowActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowActionRequest proto.InternalMessageInfo
+
+func (m *WorkflowActionRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *WorkflowActionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type WorkflowActionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowActionResponse) Reset()         { *m = WorkflowActionResponse{} }
+func (m *WorkflowActionResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowActionResponse) ProtoMessage()    {}
+func (*WorkflowActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{298}
+}
+
+func (m *WorkflowActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowActionResponse.Unmarshal(m, b)
+}
+func (m *WorkflowActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowActionResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowActionResponse.Merge(m, src)
+}
+func (m *WorkflowActionResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowActionResponse.Size(m)
+}
+func (m *WorkflowActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowActionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ExecuteVtctlCommandRequest)(nil), "vtctldata.ExecuteVtctlCommandRequest")
 	proto.RegisterType((*ExecuteVtctlCommandResponse)(nil), "vtctldata.ExecuteVtctlCommandResponse")
 	proto.RegisterType((*TableMaterializeSettings)(nil), "vtctldata.TableMaterializeSettings")
 	proto.RegisterType((*MaterializeSettings)(nil), "vtctldata.MaterializeSettings")
+	proto.RegisterType((*Keyspace)(nil), "vtctldata.Keyspace")
+	proto.RegisterType((*Shard)(nil), "vtctldata.Shard")
+	proto.RegisterType((*GetKeyspacesRequest)(nil), "vtctldata.GetKeyspacesRequest")
+	proto.RegisterType((*GetKeyspacesResponse)(nil), "vtctldata.GetKeyspacesResponse")
+	proto.RegisterType((*GetKeyspaceRequest)(nil), "vtctldata.GetKeyspaceRequest")
+	proto.RegisterType((*GetKeyspaceResponse)(nil), "vtctldata.GetKeyspaceResponse")
+	proto.RegisterType((*FindAllShardsInKeyspaceRequest)(nil), "vtctldata.FindAllShardsInKeyspaceRequest")
+	proto.RegisterType((*FindAllShardsInKeyspaceResponse)(nil), "vtctldata.FindAllShardsInKeyspaceResponse")
+	proto.RegisterType((*GetShardRequest)(nil), "vtctldata.GetShardRequest")
+	proto.RegisterType((*GetShardResponse)(nil), "vtctldata.GetShardResponse")
+	proto.RegisterType((*GetTabletRequest)(nil), "vtctldata.GetTabletRequest")
+	proto.RegisterType((*GetTabletResponse)(nil), "vtctldata.GetTabletResponse")
+	proto.RegisterType((*GetTabletsRequest)(nil), "vtctldata.GetTabletsRequest")
+	proto.RegisterType((*GetTabletsResponse)(nil), "vtctldata.GetTabletsResponse")
+	proto.RegisterType((*BackupRequest)(nil), "vtctldata.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "vtctldata.BackupResponse")
+	proto.RegisterType((*ReshardRequest)(nil), "vtctldata.ReshardRequest")
+	proto.RegisterType((*ShardCopyProgress)(nil), "vtctldata.ShardCopyProgress")
+	proto.RegisterType((*ReshardResponse)(nil), "vtctldata.ReshardResponse")
+	proto.RegisterType((*InitTabletRequest)(nil), "vtctldata.InitTabletRequest")
+	proto.RegisterType((*InitTabletResponse)(nil), "vtctldata.InitTabletResponse")
+	proto.RegisterType((*UpdateTabletAddrsRequest)(nil), "vtctldata.UpdateTabletAddrsRequest")
+	proto.RegisterType((*UpdateTabletAddrsResponse)(nil), "vtctldata.UpdateTabletAddrsResponse")
+	proto.RegisterType((*DeleteTabletsRequest)(nil), "vtctldata.DeleteTabletsRequest")
+	proto.RegisterType((*DeleteTabletsResponse)(nil), "vtctldata.DeleteTabletsResponse")
+	proto.RegisterType((*SetReadOnlyRequest)(nil), "vtctldata.SetReadOnlyRequest")
+	proto.RegisterType((*SetReadOnlyResponse)(nil), "vtctldata.SetReadOnlyResponse")
+	proto.RegisterType((*SetReadWriteRequest)(nil), "vtctldata.SetReadWriteRequest")
+	proto.RegisterType((*SetReadWriteResponse)(nil), "vtctldata.SetReadWriteResponse")
+	proto.RegisterType((*StartSlaveRequest)(nil), "vtctldata.StartSlaveRequest")
+	proto.RegisterType((*StartSlaveResponse)(nil), "vtctldata.StartSlaveResponse")
+	proto.RegisterType((*StopSlaveRequest)(nil), "vtctldata.StopSlaveRequest")
+	proto.RegisterType((*StopSlaveResponse)(nil), "vtctldata.StopSlaveResponse")
+	proto.RegisterType((*ChangeSlaveTypeRequest)(nil), "vtctldata.ChangeSlaveTypeRequest")
+	proto.RegisterType((*ChangeSlaveTypeResponse)(nil), "vtctldata.ChangeSlaveTypeResponse")
+	proto.RegisterType((*PingRequest)(nil), "vtctldata.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "vtctldata.PingResponse")
+	proto.RegisterType((*RefreshStateRequest)(nil), "vtctldata.RefreshStateRequest")
+	proto.RegisterType((*RefreshStateResponse)(nil), "vtctldata.RefreshStateResponse")
+	proto.RegisterType((*RefreshStateByShardRequest)(nil), "vtctldata.RefreshStateByShardRequest")
+	proto.RegisterType((*RefreshStateByShardResponse)(nil), "vtctldata.RefreshStateByShardResponse")
+	proto.RegisterType((*RunHealthCheckRequest)(nil), "vtctldata.RunHealthCheckRequest")
+	proto.RegisterType((*RunHealthCheckResponse)(nil), "vtctldata.RunHealthCheckResponse")
+	proto.RegisterType((*IgnoreHealthErrorRequest)(nil), "vtctldata.IgnoreHealthErrorRequest")
+	proto.RegisterType((*IgnoreHealthErrorResponse)(nil), "vtctldata.IgnoreHealthErrorResponse")
+	proto.RegisterType((*ResizeTxPoolRequest)(nil), "vtctldata.ResizeTxPoolRequest")
+	proto.RegisterType((*ResizeTxPoolResponse)(nil), "vtctldata.ResizeTxPoolResponse")
+	proto.RegisterType((*SleepRequest)(nil), "vtctldata.SleepRequest")
+	proto.RegisterType((*SleepResponse)(nil), "vtctldata.SleepResponse")
+	proto.RegisterType((*ExecuteHookRequest)(nil), "vtctldata.ExecuteHookRequest")
+	proto.RegisterType((*ExecuteHookResponse)(nil), "vtctldata.ExecuteHookResponse")
+	proto.RegisterType((*ExecuteFetchAsAppRequest)(nil), "vtctldata.ExecuteFetchAsAppRequest")
+	proto.RegisterType((*ExecuteFetchAsAppResponse)(nil), "vtctldata.ExecuteFetchAsAppResponse")
+	proto.RegisterType((*ExecuteFetchAsDbaRequest)(nil), "vtctldata.ExecuteFetchAsDbaRequest")
+	proto.RegisterType((*ExecuteFetchAsDbaResponse)(nil), "vtctldata.ExecuteFetchAsDbaResponse")
+	proto.RegisterType((*VReplicationExecRequest)(nil), "vtctldata.VReplicationExecRequest")
+	proto.RegisterType((*VReplicationExecResponse)(nil), "vtctldata.VReplicationExecResponse")
+	proto.RegisterType((*CreateShardRequest)(nil), "vtctldata.CreateShardRequest")
+	proto.RegisterType((*CreateShardResponse)(nil), "vtctldata.CreateShardResponse")
+	proto.RegisterType((*ValidateShardRequest)(nil), "vtctldata.ValidateShardRequest")
+	proto.RegisterType((*ValidateShardResponse)(nil), "vtctldata.ValidateShardResponse")
+	proto.RegisterType((*ShardReplicationPositionsRequest)(nil), "vtctldata.ShardReplicationPositionsRequest")
+	proto.RegisterType((*ShardReplicationPosition)(nil), "vtctldata.ShardReplicationPosition")
+	proto.RegisterType((*ShardReplicationPositionsResponse)(nil), "vtctldata.ShardReplicationPositionsResponse")
+	proto.RegisterType((*SetShardIsMasterServingRequest)(nil), "vtctldata.SetShardIsMasterServingRequest")
+	proto.RegisterType((*SetShardIsMasterServingResponse)(nil), "vtctldata.SetShardIsMasterServingResponse")
+	proto.RegisterType((*SetShardTabletControlRequest)(nil), "vtctldata.SetShardTabletControlRequest")
+	proto.RegisterType((*SetShardTabletControlResponse)(nil), "vtctldata.SetShardTabletControlResponse")
+	proto.RegisterType((*UpdateSrvKeyspacePartitionRequest)(nil), "vtctldata.UpdateSrvKeyspacePartitionRequest")
+	proto.RegisterType((*UpdateSrvKeyspacePartitionResponse)(nil), "vtctldata.UpdateSrvKeyspacePartitionResponse")
+	proto.RegisterType((*SourceShardDeleteRequest)(nil), "vtctldata.SourceShardDeleteRequest")
+	proto.RegisterType((*SourceShardDeleteResponse)(nil), "vtctldata.SourceShardDeleteResponse")
+	proto.RegisterType((*SourceShardAddRequest)(nil), "vtctldata.SourceShardAddRequest")
+	proto.RegisterType((*SourceShardAddResponse)(nil), "vtctldata.SourceShardAddResponse")
+	proto.RegisterType((*ShardReplicationAddRequest)(nil), "vtctldata.ShardReplicationAddRequest")
+	proto.RegisterType((*ShardReplicationAddResponse)(nil), "vtctldata.ShardReplicationAddResponse")
+	proto.RegisterType((*ShardReplicationRemoveRequest)(nil), "vtctldata.ShardReplicationRemoveRequest")
+	proto.RegisterType((*ShardReplicationRemoveResponse)(nil), "vtctldata.ShardReplicationRemoveResponse")
+	proto.RegisterType((*ShardReplicationFixRequest)(nil), "vtctldata.ShardReplicationFixRequest")
+	proto.RegisterType((*ShardReplicationFixResponse)(nil), "vtctldata.ShardReplicationFixResponse")
+	proto.RegisterType((*WaitForFilteredReplicationRequest)(nil), "vtctldata.WaitForFilteredReplicationRequest")
+	proto.RegisterType((*WaitForFilteredReplicationResponse)(nil), "vtctldata.WaitForFilteredReplicationResponse")
+	proto.RegisterType((*RemoveShardCellRequest)(nil), "vtctldata.RemoveShardCellRequest")
+	proto.RegisterType((*RemoveShardCellResponse)(nil), "vtctldata.RemoveShardCellResponse")
+	proto.RegisterType((*DeleteShardsRequest)(nil), "vtctldata.DeleteShardsRequest")
+	proto.RegisterType((*DeleteShardsResponse)(nil), "vtctldata.DeleteShardsResponse")
+	proto.RegisterType((*CreateKeyspaceRequest)(nil), "vtctldata.CreateKeyspaceRequest")
+	proto.RegisterType((*CreateKeyspaceResponse)(nil), "vtctldata.CreateKeyspaceResponse")
+	proto.RegisterType((*DeleteKeyspaceRequest)(nil), "vtctldata.DeleteKeyspaceRequest")
+	proto.RegisterType((*DeleteKeyspaceResponse)(nil), "vtctldata.DeleteKeyspaceResponse")
+	proto.RegisterType((*RemoveKeyspaceCellRequest)(nil), "vtctldata.RemoveKeyspaceCellRequest")
+	proto.RegisterType((*RemoveKeyspaceCellResponse)(nil), "vtctldata.RemoveKeyspaceCellResponse")
+	proto.RegisterType((*SetKeyspaceShardingInfoRequest)(nil), "vtctldata.SetKeyspaceShardingInfoRequest")
+	proto.RegisterType((*SetKeyspaceShardingInfoResponse)(nil), "vtctldata.SetKeyspaceShardingInfoResponse")
+	proto.RegisterType((*SetKeyspaceDDLStrategyRequest)(nil), "vtctldata.SetKeyspaceDDLStrategyRequest")
+	proto.RegisterType((*SetKeyspaceDDLStrategyResponse)(nil), "vtctldata.SetKeyspaceDDLStrategyResponse")
+	proto.RegisterType((*SetKeyspaceServedFromRequest)(nil), "vtctldata.SetKeyspaceServedFromRequest")
+	proto.RegisterType((*SetKeyspaceServedFromResponse)(nil), "vtctldata.SetKeyspaceServedFromResponse")
+	proto.RegisterType((*RebuildKeyspaceGraphRequest)(nil), "vtctldata.RebuildKeyspaceGraphRequest")
+	proto.RegisterType((*RebuildKeyspaceGraphResponse)(nil), "vtctldata.RebuildKeyspaceGraphResponse")
+	proto.RegisterType((*ValidateKeyspaceRequest)(nil), "vtctldata.ValidateKeyspaceRequest")
+	proto.RegisterType((*ValidateKeyspaceResponse)(nil), "vtctldata.ValidateKeyspaceResponse")
+	proto.RegisterType((*MoveTablesRequest)(nil), "vtctldata.MoveTablesRequest")
+	proto.RegisterType((*MoveTablesResponse)(nil), "vtctldata.MoveTablesResponse")
+	proto.RegisterType((*CreateLookupVindexRequest)(nil), "vtctldata.CreateLookupVindexRequest")
+	proto.RegisterType((*CreateLookupVindexResponse)(nil), "vtctldata.CreateLookupVindexResponse")
+	proto.RegisterType((*BackfillLookupVindexRequest)(nil), "vtctldata.BackfillLookupVindexRequest")
+	proto.RegisterType((*BackfillLookupVindexResponse)(nil), "vtctldata.BackfillLookupVindexResponse")
+	proto.RegisterType((*ExternalizeVindexRequest)(nil), "vtctldata.ExternalizeVindexRequest")
+	proto.RegisterType((*ExternalizeVindexResponse)(nil), "vtctldata.ExternalizeVindexResponse")
+	proto.RegisterType((*MaterializeRequest)(nil), "vtctldata.MaterializeRequest")
+	proto.RegisterType((*MaterializeResponse)(nil), "vtctldata.MaterializeResponse")
+	proto.RegisterType((*SplitCloneRequest)(nil), "vtctldata.SplitCloneRequest")
+	proto.RegisterType((*SplitCloneResponse)(nil), "vtctldata.SplitCloneResponse")
+	proto.RegisterType((*VerticalSplitCloneRequest)(nil), "vtctldata.VerticalSplitCloneRequest")
+	proto.RegisterType((*VerticalSplitCloneResponse)(nil), "vtctldata.VerticalSplitCloneResponse")
+	proto.RegisterType((*VDiffRequest)(nil), "vtctldata.VDiffRequest")
+	proto.RegisterType((*VDiffTableReport)(nil), "vtctldata.VDiffTableReport")
+	proto.RegisterType((*VDiffResponse)(nil), "vtctldata.VDiffResponse")
+	proto.RegisterType((*PauseWorkflowRequest)(nil), "vtctldata.PauseWorkflowRequest")
+	proto.RegisterType((*PauseWorkflowResponse)(nil), "vtctldata.PauseWorkflowResponse")
+	proto.RegisterType((*ResumeWorkflowRequest)(nil), "vtctldata.ResumeWorkflowRequest")
+	proto.RegisterType((*ResumeWorkflowResponse)(nil), "vtctldata.ResumeWorkflowResponse")
+	proto.RegisterType((*CopyProgressRequest)(nil), "vtctldata.CopyProgressRequest")
+	proto.RegisterType((*CopyProgressResponse)(nil), "vtctldata.CopyProgressResponse")
+	proto.RegisterType((*MigrateServedTypesRequest)(nil), "vtctldata.MigrateServedTypesRequest")
+	proto.RegisterType((*MigrateServedTypesResponse)(nil), "vtctldata.MigrateServedTypesResponse")
+	proto.RegisterType((*MigrateServedFromRequest)(nil), "vtctldata.MigrateServedFromRequest")
+	proto.RegisterType((*MigrateServedFromResponse)(nil), "vtctldata.MigrateServedFromResponse")
+	proto.RegisterType((*SwitchReadsRequest)(nil), "vtctldata.SwitchReadsRequest")
+	proto.RegisterType((*SwitchReadsResponse)(nil), "vtctldata.SwitchReadsResponse")
+	proto.RegisterType((*SwitchWritesRequest)(nil), "vtctldata.SwitchWritesRequest")
+	proto.RegisterType((*SwitchWritesPrecheck)(nil), "vtctldata.SwitchWritesPrecheck")
+	proto.RegisterType((*SwitchWritesResponse)(nil), "vtctldata.SwitchWritesResponse")
+	proto.RegisterType((*CancelReshardingRequest)(nil), "vtctldata.CancelReshardingRequest")
+	proto.RegisterType((*CancelReshardingResponse)(nil), "vtctldata.CancelReshardingResponse")
+	proto.RegisterType((*ShowReshardingRequest)(nil), "vtctldata.ShowReshardingRequest")
+	proto.RegisterType((*ShowReshardingResponse)(nil), "vtctldata.ShowReshardingResponse")
+	proto.RegisterType((*WaitForDrainRequest)(nil), "vtctldata.WaitForDrainRequest")
+	proto.RegisterType((*WaitForDrainResponse)(nil), "vtctldata.WaitForDrainResponse")
+	proto.RegisterType((*ReshardWorkflowOptions)(nil), "vtctldata.ReshardWorkflowOptions")
+	proto.RegisterType((*StartReshardWorkflowRequest)(nil), "vtctldata.StartReshardWorkflowRequest")
+	proto.RegisterType((*StartReshardWorkflowResponse)(nil), "vtctldata.StartReshardWorkflowResponse")
+	proto.RegisterType((*GetReshardWorkflowRequest)(nil), "vtctldata.GetReshardWorkflowRequest")
+	proto.RegisterType((*GetReshardWorkflowResponse)(nil), "vtctldata.GetReshardWorkflowResponse")
+	proto.RegisterType((*ReshardWorkflowSwitchReadsRequest)(nil), "vtctldata.ReshardWorkflowSwitchReadsRequest")
+	proto.RegisterType((*ReshardWorkflowSwitchReadsResponse)(nil), "vtctldata.ReshardWorkflowSwitchReadsResponse")
+	proto.RegisterType((*ReshardWorkflowSwitchWritesRequest)(nil), "vtctldata.ReshardWorkflowSwitchWritesRequest")
+	proto.RegisterType((*ReshardWorkflowSwitchWritesResponse)(nil), "vtctldata.ReshardWorkflowSwitchWritesResponse")
+	proto.RegisterType((*GetSchemaRequest)(nil), "vtctldata.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "vtctldata.GetSchemaResponse")
+	proto.RegisterType((*ReloadSchemaRequest)(nil), "vtctldata.ReloadSchemaRequest")
+	proto.RegisterType((*ReloadSchemaResponse)(nil), "vtctldata.ReloadSchemaResponse")
+	proto.RegisterType((*ReloadSchemaShardRequest)(nil), "vtctldata.ReloadSchemaShardRequest")
+	proto.RegisterType((*ReloadSchemaShardResponse)(nil), "vtctldata.ReloadSchemaShardResponse")
+	proto.RegisterType((*ReloadSchemaKeyspaceRequest)(nil), "vtctldata.ReloadSchemaKeyspaceRequest")
+	proto.RegisterType((*ReloadSchemaKeyspaceResponse)(nil), "vtctldata.ReloadSchemaKeyspaceResponse")
+	proto.RegisterType((*ValidateSchemaShardRequest)(nil), "vtctldata.ValidateSchemaShardRequest")
+	proto.RegisterType((*ValidateSchemaShardResponse)(nil), "vtctldata.ValidateSchemaShardResponse")
+	proto.RegisterType((*ValidateSchemaKeyspaceRequest)(nil), "vtctldata.ValidateSchemaKeyspaceRequest")
+	proto.RegisterType((*ValidateSchemaKeyspaceResponse)(nil), "vtctldata.ValidateSchemaKeyspaceResponse")
+	proto.RegisterType((*GetSchemaDriftRequest)(nil), "vtctldata.GetSchemaDriftRequest")
+	proto.RegisterType((*TableVariant)(nil), "vtctldata.TableVariant")
+	proto.RegisterType((*TableDrift)(nil), "vtctldata.TableDrift")
+	proto.RegisterType((*GetSchemaDriftResponse)(nil), "vtctldata.GetSchemaDriftResponse")
+	proto.RegisterType((*ApplySchemaRequest)(nil), "vtctldata.ApplySchemaRequest")
+	proto.RegisterType((*ApplySchemaStatementReport)(nil), "vtctldata.ApplySchemaStatementReport")
+	proto.RegisterType((*ApplySchemaDryRunReport)(nil), "vtctldata.ApplySchemaDryRunReport")
+	proto.RegisterType((*ApplySchemaResponse)(nil), "vtctldata.ApplySchemaResponse")
+	proto.RegisterType((*ApplyDeclarativeSchemaRequest)(nil), "vtctldata.ApplyDeclarativeSchemaRequest")
+	proto.RegisterType((*ApplyDeclarativeSchemaResponse)(nil), "vtctldata.ApplyDeclarativeSchemaResponse")
+	proto.RegisterType((*SubmitOnlineDDLRequest)(nil), "vtctldata.SubmitOnlineDDLRequest")
+	proto.RegisterType((*SubmitOnlineDDLResponse)(nil), "vtctldata.SubmitOnlineDDLResponse")
+	proto.RegisterType((*GetOnlineDDLMigrationsRequest)(nil), "vtctldata.GetOnlineDDLMigrationsRequest")
+	proto.RegisterType((*GetOnlineDDLMigrationsResponse)(nil), "vtctldata.GetOnlineDDLMigrationsResponse")
+	proto.RegisterType((*CancelOnlineDDLRequest)(nil), "vtctldata.CancelOnlineDDLRequest")
+	proto.RegisterType((*CancelOnlineDDLResponse)(nil), "vtctldata.CancelOnlineDDLResponse")
+	proto.RegisterType((*RetryOnlineDDLRequest)(nil), "vtctldata.RetryOnlineDDLRequest")
+	proto.RegisterType((*RetryOnlineDDLResponse)(nil), "vtctldata.RetryOnlineDDLResponse")
+	proto.RegisterType((*CopySchemaShardRequest)(nil), "vtctldata.CopySchemaShardRequest")
+	proto.RegisterType((*CopySchemaShardResponse)(nil), "vtctldata.CopySchemaShardResponse")
+	proto.RegisterType((*ValidateVersionShardRequest)(nil), "vtctldata.ValidateVersionShardRequest")
+	proto.RegisterType((*ValidateVersionShardResponse)(nil), "vtctldata.ValidateVersionShardResponse")
+	proto.RegisterType((*ValidateVersionKeyspaceRequest)(nil), "vtctldata.ValidateVersionKeyspaceRequest")
+	proto.RegisterType((*ValidateVersionKeyspaceResponse)(nil), "vtctldata.ValidateVersionKeyspaceResponse")
+	proto.RegisterType((*GetPermissionsRequest)(nil), "vtctldata.GetPermissionsRequest")
+	proto.RegisterType((*GetPermissionsResponse)(nil), "vtctldata.GetPermissionsResponse")
+	proto.RegisterType((*ValidatePermissionsShardRequest)(nil), "vtctldata.ValidatePermissionsShardRequest")
+	proto.RegisterType((*ValidatePermissionsShardResponse)(nil), "vtctldata.ValidatePermissionsShardResponse")
+	proto.RegisterType((*ValidatePermissionsKeyspaceRequest)(nil), "vtctldata.ValidatePermissionsKeyspaceRequest")
+	proto.RegisterType((*ValidatePermissionsKeyspaceResponse)(nil), "vtctldata.ValidatePermissionsKeyspaceResponse")
+	proto.RegisterType((*GetVSchemaRequest)(nil), "vtctldata.GetVSchemaRequest")
+	proto.RegisterType((*GetVSchemaResponse)(nil), "vtctldata.GetVSchemaResponse")
+	proto.RegisterType((*ApplyVSchemaRequest)(nil), "vtctldata.ApplyVSchemaRequest")
+	proto.RegisterType((*ApplyVSchemaResponse)(nil), "vtctldata.ApplyVSchemaResponse")
+	proto.RegisterType((*GetRoutingRulesRequest)(nil), "vtctldata.GetRoutingRulesRequest")
+	proto.RegisterType((*GetRoutingRulesResponse)(nil), "vtctldata.GetRoutingRulesResponse")
+	proto.RegisterType((*ApplyRoutingRulesRequest)(nil), "vtctldata.ApplyRoutingRulesRequest")
+	proto.RegisterType((*ApplyRoutingRulesResponse)(nil), "vtctldata.ApplyRoutingRulesResponse")
+	proto.RegisterType((*RebuildVSchemaGraphRequest)(nil), "vtctldata.RebuildVSchemaGraphRequest")
+	proto.RegisterType((*RebuildVSchemaGraphResponse)(nil), "vtctldata.RebuildVSchemaGraphResponse")
+	proto.RegisterType((*GetSrvKeyspaceNamesRequest)(nil), "vtctldata.GetSrvKeyspaceNamesRequest")
+	proto.RegisterType((*GetSrvKeyspaceNamesResponse)(nil), "vtctldata.GetSrvKeyspaceNamesResponse")
+	proto.RegisterType((*GetSrvKeyspaceRequest)(nil), "vtctldata.GetSrvKeyspaceRequest")
+	proto.RegisterType((*GetSrvKeyspaceResponse)(nil), "vtctldata.GetSrvKeyspaceResponse")
+	proto.RegisterType((*GetSrvVSchemaRequest)(nil), "vtctldata.GetSrvVSchemaRequest")
+	proto.RegisterType((*GetSrvVSchemaResponse)(nil), "vtctldata.GetSrvVSchemaResponse")
+	proto.RegisterType((*DeleteSrvVSchemaRequest)(nil), "vtctldata.DeleteSrvVSchemaRequest")
+	proto.RegisterType((*DeleteSrvVSchemaResponse)(nil), "vtctldata.DeleteSrvVSchemaResponse")
+	proto.RegisterType((*GetShardReplicationRequest)(nil), "vtctldata.GetShardReplicationRequest")
+	proto.RegisterType((*GetShardReplicationResponse)(nil), "vtctldata.GetShardReplicationResponse")
+	proto.RegisterType((*ValidateRequest)(nil), "vtctldata.ValidateRequest")
+	proto.RegisterType((*ValidateResponse)(nil), "vtctldata.ValidateResponse")
+	proto.RegisterType((*InitShardMasterRequest)(nil), "vtctldata.InitShardMasterRequest")
+	proto.RegisterType((*InitShardMasterResponse)(nil), "vtctldata.InitShardMasterResponse")
+	proto.RegisterType((*PlannedReparentShardRequest)(nil), "vtctldata.PlannedReparentShardRequest")
+	proto.RegisterType((*PlannedReparentCandidate)(nil), "vtctldata.PlannedReparentCandidate")
+	proto.RegisterType((*PlannedReparentPlan)(nil), "vtctldata.PlannedReparentPlan")
+	proto.RegisterType((*PlannedReparentShardResponse)(nil), "vtctldata.PlannedReparentShardResponse")
+	proto.RegisterType((*EmergencyReparentShardRequest)(nil), "vtctldata.EmergencyReparentShardRequest")
+	proto.RegisterMapType((map[string]string)(nil), "vtctldata.EmergencyReparentShardRequest.TagsEntry")
+	proto.RegisterType((*EmergencyReparentShardResponse)(nil), "vtctldata.EmergencyReparentShardResponse")
+	proto.RegisterType((*ReparentTabletRequest)(nil), "vtctldata.ReparentTabletRequest")
+	proto.RegisterType((*ReparentTabletResponse)(nil), "vtctldata.ReparentTabletResponse")
+	proto.RegisterType((*TabletExternallyReparentedRequest)(nil), "vtctldata.TabletExternallyReparentedRequest")
+	proto.RegisterType((*TabletExternallyReparentedResponse)(nil), "vtctldata.TabletExternallyReparentedResponse")
+	proto.RegisterType((*BackupShardRequest)(nil), "vtctldata.BackupShardRequest")
+	proto.RegisterType((*BackupShardResponse)(nil), "vtctldata.BackupShardResponse")
+	proto.RegisterType((*ListBackupsRequest)(nil), "vtctldata.ListBackupsRequest")
+	proto.RegisterType((*ListBackupsResponse)(nil), "vtctldata.ListBackupsResponse")
+	proto.RegisterType((*RemoveBackupRequest)(nil), "vtctldata.RemoveBackupRequest")
+	proto.RegisterType((*RemoveBackupResponse)(nil), "vtctldata.RemoveBackupResponse")
+	proto.RegisterType((*PruneBackupsRequest)(nil), "vtctldata.PruneBackupsRequest")
+	proto.RegisterType((*PruneBackupsResponse)(nil), "vtctldata.PruneBackupsResponse")
+	proto.RegisterType((*VerifyBackupRequest)(nil), "vtctldata.VerifyBackupRequest")
+	proto.RegisterType((*VerifyBackupResponse)(nil), "vtctldata.VerifyBackupResponse")
+	proto.RegisterType((*RestoreFromBackupRequest)(nil), "vtctldata.RestoreFromBackupRequest")
+	proto.RegisterType((*RestoreFromBackupResponse)(nil), "vtctldata.RestoreFromBackupResponse")
+	proto.RegisterType((*AddCellInfoRequest)(nil), "vtctldata.AddCellInfoRequest")
+	proto.RegisterType((*AddCellInfoResponse)(nil), "vtctldata.AddCellInfoResponse")
+	proto.RegisterType((*UpdateCellInfoRequest)(nil), "vtctldata.UpdateCellInfoRequest")
+	proto.RegisterType((*UpdateCellInfoResponse)(nil), "vtctldata.UpdateCellInfoResponse")
+	proto.RegisterType((*DeleteCellInfoRequest)(nil), "vtctldata.DeleteCellInfoRequest")
+	proto.RegisterType((*DeleteCellInfoResponse)(nil), "vtctldata.DeleteCellInfoResponse")
+	proto.RegisterType((*GetCellInfoNamesRequest)(nil), "vtctldata.GetCellInfoNamesRequest")
+	proto.RegisterType((*GetCellInfoNamesResponse)(nil), "vtctldata.GetCellInfoNamesResponse")
+	proto.RegisterType((*GetCellInfoRequest)(nil), "vtctldata.GetCellInfoRequest")
+	proto.RegisterType((*GetCellInfoResponse)(nil), "vtctldata.GetCellInfoResponse")
+	proto.RegisterType((*AddCellsAliasRequest)(nil), "vtctldata.AddCellsAliasRequest")
+	proto.RegisterType((*AddCellsAliasResponse)(nil), "vtctldata.AddCellsAliasResponse")
+	proto.RegisterType((*UpdateCellsAliasRequest)(nil), "vtctldata.UpdateCellsAliasRequest")
+	proto.RegisterType((*UpdateCellsAliasResponse)(nil), "vtctldata.UpdateCellsAliasResponse")
+	proto.RegisterType((*DeleteCellsAliasRequest)(nil), "vtctldata.DeleteCellsAliasRequest")
+	proto.RegisterType((*DeleteCellsAliasResponse)(nil), "vtctldata.DeleteCellsAliasResponse")
+	proto.RegisterType((*GetCellsAliasesRequest)(nil), "vtctldata.GetCellsAliasesRequest")
+	proto.RegisterType((*GetCellsAliasesResponse)(nil), "vtctldata.GetCellsAliasesResponse")
+	proto.RegisterMapType((map[string]*topodata.CellsAlias)(nil), "vtctldata.GetCellsAliasesResponse.AliasesEntry")
+	proto.RegisterType((*ValidateCellsAliasesRequest)(nil), "vtctldata.ValidateCellsAliasesRequest")
+	proto.RegisterType((*ValidateCellsAliasesResponse)(nil), "vtctldata.ValidateCellsAliasesResponse")
+	proto.RegisterType((*GetServingCellsRequest)(nil), "vtctldata.GetServingCellsRequest")
+	proto.RegisterType((*GetServingCellsResponse)(nil), "vtctldata.GetServingCellsResponse")
+	proto.RegisterType((*ThrottlerMaxRatesRequest)(nil), "vtctldata.ThrottlerMaxRatesRequest")
+	proto.RegisterType((*ThrottlerMaxRatesResponse)(nil), "vtctldata.ThrottlerMaxRatesResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "vtctldata.ThrottlerMaxRatesResponse.RatesEntry")
+	proto.RegisterType((*ThrottlerSetMaxRateRequest)(nil), "vtctldata.ThrottlerSetMaxRateRequest")
+	proto.RegisterType((*ThrottlerSetMaxRateResponse)(nil), "vtctldata.ThrottlerSetMaxRateResponse")
+	proto.RegisterType((*GetThrottlerConfigurationRequest)(nil), "vtctldata.GetThrottlerConfigurationRequest")
+	proto.RegisterType((*GetThrottlerConfigurationResponse)(nil), "vtctldata.GetThrottlerConfigurationResponse")
+	proto.RegisterMapType((map[string]*throttlerdata.Configuration)(nil), "vtctldata.GetThrottlerConfigurationResponse.ConfigurationsEntry")
+	proto.RegisterType((*UpdateThrottlerConfigurationRequest)(nil), "vtctldata.UpdateThrottlerConfigurationRequest")
+	proto.RegisterType((*UpdateThrottlerConfigurationResponse)(nil), "vtctldata.UpdateThrottlerConfigurationResponse")
+	proto.RegisterType((*ResetThrottlerConfigurationRequest)(nil), "vtctldata.ResetThrottlerConfigurationRequest")
+	proto.RegisterType((*ResetThrottlerConfigurationResponse)(nil), "vtctldata.ResetThrottlerConfigurationResponse")
+	proto.RegisterType((*TopoCatRequest)(nil), "vtctldata.TopoCatRequest")
+	proto.RegisterType((*TopoFile)(nil), "vtctldata.TopoFile")
+	proto.RegisterType((*TopoCatResponse)(nil), "vtctldata.TopoCatResponse")
+	proto.RegisterType((*WriteTopoFileRequest)(nil), "vtctldata.WriteTopoFileRequest")
+	proto.RegisterType((*WriteTopoFileResponse)(nil), "vtctldata.WriteTopoFileResponse")
+	proto.RegisterType((*ExportTopoRequest)(nil), "vtctldata.ExportTopoRequest")
+	proto.RegisterType((*ExportTopoResponse)(nil), "vtctldata.ExportTopoResponse")
+	proto.RegisterType((*ImportTopoRequest)(nil), "vtctldata.ImportTopoRequest")
+	proto.RegisterType((*ImportTopoResponse)(nil), "vtctldata.ImportTopoResponse")
+	proto.RegisterType((*GetTopoAuditLogRequest)(nil), "vtctldata.GetTopoAuditLogRequest")
+	proto.RegisterType((*TopoAuditEntry)(nil), "vtctldata.TopoAuditEntry")
+	proto.RegisterType((*GetTopoAuditLogResponse)(nil), "vtctldata.GetTopoAuditLogResponse")
+	proto.RegisterType((*WorkflowCreateRequest)(nil), "vtctldata.WorkflowCreateRequest")
+	proto.RegisterType((*WorkflowCreateResponse)(nil), "vtctldata.WorkflowCreateResponse")
+	proto.RegisterType((*WorkflowStartRequest)(nil), "vtctldata.WorkflowStartRequest")
+	proto.RegisterType((*WorkflowStartResponse)(nil), "vtctldata.WorkflowStartResponse")
+	proto.RegisterType((*WorkflowStopRequest)(nil), "vtctldata.WorkflowStopRequest")
+	proto.RegisterType((*WorkflowStopResponse)(nil), "vtctldata.WorkflowStopResponse")
+	proto.RegisterType((*WorkflowDeleteRequest)(nil), "vtctldata.WorkflowDeleteRequest")
+	proto.RegisterType((*WorkflowDeleteResponse)(nil), "vtctldata.WorkflowDeleteResponse")
+	proto.RegisterType((*WorkflowWaitRequest)(nil), "vtctldata.WorkflowWaitRequest")
+	proto.RegisterType((*WorkflowWaitResponse)(nil), "vtctldata.WorkflowWaitResponse")
+	proto.RegisterType((*WorkflowTreeRequest)(nil), "vtctldata.WorkflowTreeRequest")
+	proto.RegisterType((*WorkflowTreeResponse)(nil), "vtctldata.WorkflowTreeResponse")
+	proto.RegisterType((*WorkflowActionRequest)(nil), "vtctldata.WorkflowActionRequest")
+	proto.RegisterType((*WorkflowActionResponse)(nil), "vtctldata.WorkflowActionResponse")
 }
 
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x95, 0xb5, 0x1b, 0xeb, 0x29, 0x4d, 0xc1, 0xdc, 0x58, 0x45, 0x48, 0xa1, 0xc0, 0x88,
-	0x84, 0xd4, 0x48, 0xe3, 0x09, 0xa0, 0xf4, 0x06, 0xc4, 0x4d, 0xa8, 0x40, 0xe2, 0x26, 0x72, 0x93,
-	0xb3, 0xc8, 0x9a, 0x1b, 0x07, 0xfb, 0xa4, 0x5b, 0x79, 0x03, 0x5e, 0x86, 0x67, 0x44, 0xb6, 0xb3,
-	0x70, 0xb3, 0xdd, 0x1d, 0x7f, 0xe7, 0xb7, 0xfd, 0x9f, 0x5f, 0x07, 0xe6, 0x07, 0x2a, 0x49, 0x55,
-	0x82, 0xc4, 0xaa, 0x35, 0x9a, 0x34, 0x9b, 0x0c, 0x60, 0x31, 0x53, 0xba, 0xee, 0x48, 0xaa, 0xd0,
-	0x59, 0xfe, 0x80, 0xc5, 0xe6, 0x16, 0xcb, 0x8e, 0xf0, 0xbb, 0x93, 0xac, 0xf5, 0x7e, 0x2f, 0x9a,
-	0x2a, 0xc7, 0x5f, 0x1d, 0x5a, 0x62, 0x0c, 0xc6, 0xc2, 0xd4, 0x96, 0x47, 0xc9, 0x28, 0x9d, 0xe4,
-	0xbe, 0x66, 0x6f, 0x20, 0x16, 0x25, 0x49, 0xdd, 0x14, 0x24, 0xf7, 0xa8, 0x3b, 0xe2, 0x27, 0x49,
-	0x94, 0x8e, 0xf2, 0x59, 0xa0, 0xdb, 0x00, 0x97, 0x6b, 0x78, 0x7e, 0xef, 0xc3, 0xb6, 0xd5, 0x8d,
-	0x45, 0xf6, 0x1a, 0x4e, 0xf1, 0x80, 0x0d, 0xf1, 0x28, 0x89, 0xd2, 0xe9, 0x65, 0xbc, 0xba, 0xb3,
-	0xb5, 0x71, 0x34, 0x0f, 0xcd, 0xe5, 0x9f, 0x08, 0xf8, 0x56, 0xec, 0x14, 0x7e, 0x15, 0x84, 0x46,
-	0x0a, 0x25, 0x7f, 0xe3, 0x37, 0x24, 0x92, 0x4d, 0x6d, 0xd9, 0x4b, 0x78, 0x4c, 0xc2, 0xd4, 0x48,
-	0x05, 0x39, 0x89, 0x7f, 0x69, 0x92, 0x4f, 0x03, 0xf3, 0xb7, 0xd8, 0x3b, 0x78, 0x6a, 0x75, 0x67,
-	0x4a, 0x2c, 0xf0, 0xb6, 0x35, 0x68, 0xad, 0xd4, 0x8d, 0xb7, 0x3b, 0xc9, 0x9f, 0x84, 0xc6, 0x66,
-	0xe0, 0xec, 0x05, 0x40, 0x69, 0x50, 0x10, 0x16, 0x55, 0xa5, 0xf8, 0xc8, 0xab, 0x26, 0x81, 0x7c,
-	0xaa, 0xd4, 0xf2, 0xef, 0x09, 0x3c, 0xbb, 0xcf, 0xc6, 0x02, 0xce, 0x6f, 0xb4, 0xb9, 0xbe, 0x52,
-	0xfa, 0xa6, 0xb7, 0x30, 0x9c, 0xd9, 0x5b, 0x98, 0xf7, 0xff, 0x5f, 0xe3, 0xd1, 0xb6, 0xa2, 0xc4,
-	0xfe, 0xf7, 0x38, 0xe0, 0x2f, 0x3d, 0x75, 0xc2, 0x7e, 0x96, 0x41, 0x18, 0x0c, 0xc4, 0x01, 0x0f,
-	0xc2, 0x0b, 0x98, 0x5b, 0xd2, 0x6d, 0x21, 0xae, 0x08, 0x4d, 0x51, 0xea, 0xf6, 0xc8, 0xc7, 0x49,
-	0x94, 0x9e, 0xe7, 0x33, 0x87, 0x3f, 0x38, 0xba, 0xd6, 0xed, 0x91, 0x7d, 0x86, 0xd8, 0xa7, 0x52,
-	0xd8, 0xde, 0x27, 0x3f, 0x4d, 0x46, 0xe9, 0xf4, 0xf2, 0xd5, 0xea, 0xff, 0x6e, 0x3c, 0x94, 0x6c,
-	0x3e, 0xf3, 0x57, 0x87, 0x09, 0x19, 0x8c, 0x4b, 0x54, 0x8a, 0x9f, 0x79, 0x47, 0xbe, 0x0e, 0xe1,
-	0xef, 0x94, 0x0b, 0xff, 0xd8, 0xa2, 0xe5, 0x8f, 0xee, 0xc2, 0x77, 0x6c, 0xeb, 0xd0, 0xc7, 0xf4,
-	0xe7, 0xc5, 0x41, 0x12, 0x5a, 0xbb, 0x92, 0x3a, 0x0b, 0x55, 0x56, 0xeb, 0xec, 0x40, 0x99, 0x5f,
-	0xbd, 0x6c, 0x30, 0xb2, 0x3b, 0xf3, 0xe0, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x37,
-	0xd0, 0x53, 0xb8, 0x02, 0x00, 0x00,
+	// 7150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0xca, 0x2a, 0xff, 0xea, 0x55, 0x95, 0xdb, 0x4e, 0xff, 0xca, 0xee, 0x76, 0x7f, 0xb2, 0xa7,
+	0x67, 0x7b, 0xe7, 0x63, 0xcf, 0x78, 0x76, 0x60, 0x76, 0x3e, 0x3b, 0xed, 0xb1, 0xfb, 0xe3, 0x99,
+	0xee, 0x19, 0x4f, 0x56, 0x8f, 0x87, 0xdd, 0x65, 0x27, 0x95, 0xae, 0x8c, 0x2a, 0x27, 0xce, 0xca,
+	0xac, 0xcd, 0xcc, 0x2a, 0xdb, 0x73, 0x60, 0x25, 0xc4, 0x0a, 0x96, 0x8f, 0x90, 0x58, 0x21, 0x2d,
+	0x2b, 0x0e, 0x5c, 0x56, 0x42, 0x48, 0x20, 0x24, 0xd0, 0x1e, 0xf6, 0xb6, 0x1c, 0x80, 0x13, 0x20,
+	0x38, 0xb0, 0x42, 0x9c, 0x38, 0x22, 0x84, 0x10, 0x12, 0xe2, 0xc0, 0x05, 0xc5, 0x8b, 0x88, 0xcc,
+	0xc8, 0x4f, 0x95, 0xab, 0x5c, 0x9e, 0xd9, 0x15, 0x17, 0x3b, 0xe3, 0xc5, 0x8b, 0x17, 0xef, 0xbd,
+	0xf8, 0xbd, 0x78, 0xf1, 0x22, 0x0a, 0xae, 0xf4, 0xc2, 0x46, 0xe8, 0x58, 0x66, 0x68, 0x6e, 0x74,
+	0x7c, 0x2f, 0xf4, 0xd4, 0x52, 0x04, 0x58, 0xab, 0x3a, 0x5e, 0xab, 0x1b, 0xda, 0x0e, 0xcb, 0x59,
+	0x2b, 0x7f, 0xb3, 0x4b, 0xfc, 0x33, 0x9e, 0x58, 0xf2, 0x49, 0xc7, 0xb1, 0x1b, 0x66, 0x68, 0x7b,
+	0x6e, 0x5c, 0x7a, 0x6d, 0x25, 0x34, 0x0f, 0x1d, 0x12, 0xb6, 0x4d, 0xd7, 0x6c, 0x11, 0x5f, 0xca,
+	0x58, 0x08, 0x8f, 0x7c, 0x2f, 0x0c, 0x9d, 0x04, 0x70, 0x36, 0xf4, 0x3a, 0x9e, 0x94, 0xae, 0xf6,
+	0x82, 0xc6, 0x11, 0x69, 0x8b, 0x64, 0xa5, 0x17, 0x86, 0x76, 0x9b, 0xb0, 0x94, 0xf6, 0x31, 0xac,
+	0xdd, 0x3f, 0x25, 0x8d, 0x6e, 0x48, 0x0e, 0x28, 0x87, 0x3b, 0x5e, 0xbb, 0x6d, 0xba, 0x96, 0x4e,
+	0xbe, 0xd9, 0x25, 0x41, 0xa8, 0xaa, 0x30, 0x61, 0xfa, 0xad, 0xa0, 0xa6, 0xdc, 0x2c, 0xde, 0x2d,
+	0xe9, 0xf8, 0xad, 0xde, 0x81, 0x59, 0xb3, 0x41, 0x19, 0x34, 0x28, 0x19, 0xaf, 0x1b, 0xd6, 0x0a,
+	0x37, 0x95, 0xbb, 0x45, 0xbd, 0xca, 0xa0, 0x4f, 0x19, 0x50, 0xdb, 0x81, 0xab, 0xb9, 0x84, 0x83,
+	0x8e, 0xe7, 0x06, 0x44, 0x7d, 0x06, 0x26, 0x49, 0x8f, 0xb8, 0x61, 0x4d, 0xb9, 0xa9, 0xdc, 0x2d,
+	0x6f, 0xcd, 0x6e, 0x08, 0xad, 0xdc, 0xa7, 0x50, 0x9d, 0x65, 0x6a, 0xdf, 0x51, 0xa0, 0xf6, 0x94,
+	0xca, 0xfe, 0xc4, 0x0c, 0x89, 0x6f, 0x9b, 0x8e, 0xfd, 0x29, 0xa9, 0x93, 0x30, 0xb4, 0xdd, 0x56,
+	0xa0, 0xde, 0x82, 0x4a, 0x68, 0xfa, 0x2d, 0x12, 0x1a, 0xa8, 0x1e, 0xa4, 0x54, 0xd2, 0xcb, 0x0c,
+	0x86, 0xa5, 0xd4, 0xe7, 0x61, 0x3e, 0xf0, 0xba, 0x7e, 0x83, 0x18, 0xe4, 0xb4, 0xe3, 0x93, 0x20,
+	0xb0, 0x3d, 0x17, 0xd9, 0x2d, 0xe9, 0x73, 0x2c, 0xe3, 0x7e, 0x04, 0x57, 0xd7, 0x01, 0x1a, 0x3e,
+	0x31, 0x43, 0x62, 0x58, 0x96, 0x53, 0x2b, 0x22, 0x56, 0x89, 0x41, 0x76, 0x2d, 0x47, 0xfb, 0x9f,
+	0x02, 0x2c, 0xe4, 0xb1, 0xb1, 0x06, 0x33, 0x27, 0x9e, 0x7f, 0xdc, 0x74, 0xbc, 0x13, 0xce, 0x42,
+	0x94, 0x56, 0xbf, 0x00, 0x57, 0x78, 0xfd, 0xc7, 0xe4, 0x2c, 0xe8, 0x98, 0x0d, 0xc2, 0x6b, 0x9f,
+	0x65, 0xe0, 0xf7, 0x38, 0x94, 0x22, 0x72, 0x59, 0x22, 0x44, 0xc6, 0xc0, 0x2c, 0x03, 0x47, 0x88,
+	0xcf, 0xc2, 0x95, 0x20, 0xf4, 0x3a, 0x86, 0xd9, 0x0c, 0x89, 0x6f, 0x34, 0xbc, 0xce, 0x59, 0x6d,
+	0xe2, 0xa6, 0x72, 0x77, 0x46, 0xaf, 0x52, 0xf0, 0x36, 0x85, 0xee, 0x78, 0x9d, 0x33, 0xf5, 0x5d,
+	0x98, 0x45, 0xad, 0x18, 0x01, 0xe7, 0xb3, 0x36, 0x79, 0xb3, 0x78, 0xb7, 0xbc, 0x75, 0x7b, 0x23,
+	0xee, 0x9a, 0xfd, 0x34, 0xab, 0x57, 0xb1, 0x68, 0x24, 0xa1, 0x0a, 0x13, 0x0d, 0xe2, 0x38, 0xb5,
+	0x29, 0xe4, 0x08, 0xbf, 0x99, 0xf2, 0x69, 0xa7, 0x34, 0xc2, 0xb3, 0x0e, 0x09, 0x6a, 0xd3, 0x42,
+	0xf9, 0x14, 0xf6, 0x94, 0x82, 0xd4, 0x9b, 0x50, 0x6e, 0x78, 0xed, 0x48, 0xed, 0x33, 0x0c, 0x43,
+	0x02, 0xd1, 0xae, 0x44, 0x4e, 0x43, 0xe2, 0xbb, 0xa6, 0x63, 0xb4, 0xcf, 0x82, 0x6f, 0x3a, 0xb5,
+	0x12, 0x22, 0x55, 0x05, 0xf4, 0x09, 0x05, 0x6a, 0xef, 0xc3, 0x4c, 0x24, 0xbf, 0x0a, 0x13, 0xae,
+	0xd9, 0x16, 0x8d, 0x8d, 0xdf, 0xea, 0x06, 0xcc, 0x24, 0xd4, 0x5b, 0xde, 0x52, 0x37, 0xa2, 0x31,
+	0x20, 0x4a, 0xea, 0x11, 0x8e, 0xf6, 0x09, 0x4c, 0xd6, 0x8f, 0x4c, 0xdf, 0xa2, 0x4d, 0x17, 0x15,
+	0xe4, 0x4d, 0x77, 0x9c, 0xae, 0xa8, 0x20, 0x55, 0x74, 0x07, 0x26, 0x03, 0x5a, 0x10, 0xdb, 0xa6,
+	0xbc, 0x75, 0x25, 0xae, 0x05, 0xe9, 0xe9, 0x2c, 0x57, 0x5b, 0x82, 0x85, 0x87, 0x71, 0x93, 0x05,
+	0x7c, 0x30, 0x69, 0x7b, 0xb0, 0x98, 0x04, 0xf3, 0xa1, 0xf0, 0x32, 0x94, 0x44, 0xad, 0x6c, 0xa4,
+	0x95, 0xb7, 0x16, 0xa4, 0x56, 0x8a, 0x04, 0x88, 0xb1, 0xb4, 0x97, 0x40, 0x95, 0x48, 0x89, 0xd1,
+	0x3a, 0x40, 0x1c, 0xed, 0x41, 0x82, 0xa7, 0xa8, 0xee, 0xcd, 0x54, 0x91, 0x3e, 0x55, 0xc7, 0x74,
+	0xde, 0x84, 0xeb, 0x0f, 0x6c, 0xd7, 0xda, 0x76, 0x1c, 0x14, 0x39, 0xd8, 0x73, 0x47, 0xe1, 0xe2,
+	0x3d, 0xb8, 0xd1, 0xb7, 0x34, 0xe7, 0xe8, 0x2e, 0x4c, 0xa1, 0x16, 0x85, 0x2a, 0xe6, 0x24, 0x7e,
+	0x98, 0x96, 0x79, 0xbe, 0xf6, 0x18, 0xae, 0x3c, 0x24, 0x21, 0x83, 0x9d, 0x5f, 0x37, 0x1d, 0xde,
+	0x58, 0xd0, 0x90, 0x9a, 0xb5, 0x84, 0x90, 0xf7, 0xcd, 0x36, 0xd1, 0x5e, 0x87, 0xb9, 0x98, 0x1a,
+	0xe7, 0xe5, 0x59, 0xd1, 0xde, 0x4c, 0x35, 0x59, 0x56, 0x78, 0x83, 0x3f, 0xc6, 0xb2, 0x38, 0x9c,
+	0x42, 0xc1, 0xca, 0x6b, 0xd1, 0x00, 0x31, 0x1d, 0xdb, 0x0c, 0x38, 0x89, 0xa5, 0xb8, 0xcb, 0x30,
+	0xf4, 0x6d, 0x9a, 0x29, 0xc6, 0x0d, 0x26, 0xb4, 0xb7, 0x60, 0x5e, 0xa2, 0x16, 0xab, 0x85, 0xe1,
+	0x44, 0xbc, 0xa4, 0x08, 0xe9, 0x3c, 0x5f, 0xfb, 0xba, 0x54, 0x3c, 0x18, 0x46, 0x31, 0x8b, 0x42,
+	0x4a, 0xa6, 0x13, 0x96, 0xa0, 0x50, 0x3a, 0xd0, 0x83, 0x5a, 0x11, 0xe7, 0x7e, 0x96, 0xd0, 0xee,
+	0x81, 0x2a, 0x13, 0xe7, 0xcc, 0x3d, 0x07, 0xd3, 0xac, 0xf2, 0xb8, 0xd1, 0xd2, 0xdc, 0x09, 0x04,
+	0xed, 0xcf, 0x14, 0xa8, 0xbe, 0x63, 0x36, 0x8e, 0xbb, 0x9d, 0xb1, 0x35, 0xc5, 0x66, 0x18, 0xb7,
+	0xd1, 0xf5, 0x7d, 0xe2, 0x36, 0xce, 0xf8, 0x3a, 0x24, 0x83, 0xe8, 0x34, 0x65, 0x3a, 0x8e, 0x77,
+	0x62, 0xb4, 0xcd, 0x20, 0x24, 0x3e, 0x0e, 0xdc, 0x19, 0xbd, 0x8c, 0xb0, 0x27, 0x08, 0xa2, 0x44,
+	0x6c, 0xb7, 0xe1, 0x93, 0x36, 0x71, 0x43, 0xd3, 0xe1, 0xb3, 0xa9, 0x0c, 0xd2, 0x7e, 0x0e, 0x66,
+	0x05, 0xc7, 0x23, 0xad, 0x5e, 0x3f, 0x2e, 0xc0, 0xac, 0x4e, 0x82, 0x61, 0x3b, 0xa8, 0xbc, 0x90,
+	0x14, 0x52, 0x0b, 0xc9, 0x6d, 0xa8, 0xf2, 0x85, 0x84, 0x0f, 0x0e, 0xd6, 0x2a, 0x15, 0x06, 0x64,
+	0x83, 0x89, 0x22, 0xf1, 0x45, 0x84, 0x23, 0x4d, 0x30, 0x24, 0x06, 0xe4, 0x48, 0x77, 0x61, 0x2e,
+	0x38, 0xb6, 0x3b, 0x06, 0xb3, 0x09, 0xd8, 0x0a, 0x32, 0x89, 0x32, 0xcf, 0x52, 0x78, 0x1d, 0xc1,
+	0xb8, 0x84, 0xa4, 0xe6, 0xef, 0xa9, 0xec, 0xfc, 0xad, 0x41, 0xf5, 0xc4, 0xb4, 0x43, 0xa3, 0xe9,
+	0xf1, 0xa5, 0x68, 0x9a, 0x29, 0x8f, 0x02, 0x1f, 0x78, 0x6c, 0x21, 0x7a, 0x0b, 0xe6, 0x3b, 0xbe,
+	0xd7, 0xa2, 0x45, 0x0c, 0xdb, 0x0d, 0x89, 0xdf, 0x33, 0x1d, 0x5c, 0x0b, 0xd8, 0x78, 0x42, 0x53,
+	0x64, 0xb7, 0xeb, 0xa3, 0xc9, 0xa3, 0xcf, 0x09, 0xd4, 0x3d, 0x8e, 0xa9, 0xf5, 0x60, 0x1e, 0x19,
+	0xa7, 0xb4, 0xf6, 0x79, 0x66, 0xdc, 0x63, 0x15, 0xb9, 0xc7, 0xbe, 0x07, 0xd3, 0x41, 0xe8, 0x13,
+	0xb3, 0x1d, 0xd4, 0x0a, 0xd8, 0x0b, 0x5f, 0xde, 0xc8, 0xda, 0x4d, 0x07, 0x7a, 0x6c, 0x61, 0xd5,
+	0x11, 0x5b, 0xa6, 0xac, 0x0b, 0x0a, 0xda, 0xa7, 0x70, 0x25, 0x6a, 0xba, 0x51, 0x1a, 0x5d, 0xdd,
+	0x86, 0x2a, 0x55, 0x85, 0x21, 0x24, 0xe1, 0xbc, 0x5c, 0x4b, 0xcf, 0x1d, 0x89, 0x6a, 0x2b, 0x0d,
+	0x29, 0xa5, 0xfd, 0x9d, 0x02, 0xf3, 0x7b, 0xae, 0x9d, 0x9a, 0x50, 0x86, 0x9e, 0x01, 0xd4, 0x2d,
+	0x58, 0x92, 0x3b, 0xbd, 0xe1, 0xf5, 0x88, 0xef, 0xdb, 0x16, 0x9b, 0xf4, 0x66, 0xf4, 0x05, 0xa9,
+	0xf7, 0x7f, 0xc0, 0xb3, 0xd4, 0x2f, 0xc3, 0x2a, 0x37, 0x7e, 0xd8, 0x24, 0x69, 0xba, 0x56, 0xd2,
+	0x14, 0x99, 0xd1, 0x97, 0x19, 0x02, 0x72, 0xbf, 0xed, 0x5a, 0xd1, 0x92, 0x1c, 0x8d, 0xb1, 0x6e,
+	0xc7, 0x32, 0x43, 0x22, 0x46, 0x10, 0xc2, 0x3e, 0x42, 0x90, 0xb6, 0x08, 0xaa, 0x2c, 0x10, 0x53,
+	0xa8, 0xf6, 0xef, 0x0a, 0xd4, 0x18, 0x02, 0x1f, 0xe1, 0x96, 0xe5, 0x07, 0xe3, 0xcf, 0x0a, 0x6b,
+	0x30, 0x73, 0xe4, 0x05, 0xa1, 0x34, 0xcd, 0x47, 0x69, 0x6a, 0x71, 0xa0, 0xa1, 0x61, 0x44, 0x18,
+	0xcc, 0xcc, 0xaa, 0x22, 0xf4, 0x91, 0x40, 0x5b, 0x07, 0x60, 0x68, 0x1d, 0xcf, 0x0f, 0x51, 0xa0,
+	0x49, 0xbd, 0x84, 0x90, 0x7d, 0xcf, 0x0f, 0xd5, 0x15, 0x98, 0xee, 0x85, 0x2c, 0x6f, 0x12, 0xf3,
+	0xa6, 0x7a, 0x21, 0x66, 0x5c, 0x85, 0x52, 0xcb, 0xef, 0x34, 0x58, 0xd6, 0x14, 0x66, 0xcd, 0x50,
+	0x00, 0xcd, 0xd4, 0xee, 0xc3, 0x6a, 0x8e, 0xb4, 0x23, 0xcf, 0xef, 0x27, 0xb0, 0xb8, 0x4b, 0x1c,
+	0x22, 0xc8, 0x44, 0x0a, 0x7b, 0x13, 0x66, 0x65, 0x85, 0x45, 0xb6, 0x44, 0x1f, 0x95, 0x55, 0x25,
+	0x95, 0x91, 0x20, 0x33, 0x51, 0x16, 0x32, 0x13, 0xa5, 0xb6, 0x02, 0x4b, 0xa9, 0x8a, 0x79, 0x3b,
+	0xbe, 0x0f, 0x6a, 0x9d, 0x36, 0xab, 0x69, 0x7d, 0xe0, 0x3a, 0x67, 0xe3, 0x2f, 0x80, 0x4b, 0xb0,
+	0x90, 0xa0, 0xc7, 0xab, 0xf9, 0x20, 0x02, 0x7f, 0xec, 0xdb, 0x21, 0x19, 0xbf, 0x9e, 0x65, 0x58,
+	0x4c, 0x12, 0xe4, 0x15, 0x3d, 0x81, 0xf9, 0x7a, 0x68, 0xfa, 0x61, 0xdd, 0x31, 0x7b, 0x97, 0x50,
+	0xcd, 0x22, 0xa8, 0x32, 0x39, 0x5e, 0xc9, 0x63, 0x98, 0xab, 0x87, 0x5e, 0xe7, 0x92, 0xea, 0x58,
+	0x80, 0x79, 0x89, 0x1a, 0xaf, 0xe2, 0xfb, 0x0a, 0x2c, 0xef, 0x1c, 0x99, 0x6e, 0x8b, 0x20, 0x9c,
+	0x5a, 0xe5, 0xe3, 0x8f, 0xae, 0x17, 0x61, 0xda, 0x3a, 0x44, 0xa3, 0x1f, 0xfb, 0xc8, 0xec, 0xd6,
+	0x62, 0xba, 0x10, 0xd6, 0x33, 0x65, 0x1d, 0xd2, 0xff, 0x74, 0xa8, 0x58, 0xfe, 0x99, 0xe1, 0x77,
+	0x5d, 0x3e, 0x8b, 0x4c, 0x59, 0xfe, 0x99, 0xde, 0x75, 0xb5, 0x1f, 0x28, 0xb0, 0x92, 0x61, 0x8e,
+	0x0f, 0x86, 0x57, 0xa1, 0x7a, 0x48, 0x9a, 0x9e, 0x4f, 0x8c, 0x73, 0xc6, 0x44, 0x85, 0xa1, 0xb1,
+	0x94, 0xfa, 0x0a, 0x54, 0xd8, 0xb6, 0x88, 0x97, 0x2a, 0xf4, 0x29, 0x55, 0x46, 0x2c, 0x5e, 0xe8,
+	0x3a, 0x94, 0x4f, 0xcc, 0xc0, 0x48, 0x32, 0x59, 0x3a, 0x31, 0x83, 0x5d, 0xc6, 0xe7, 0x43, 0x28,
+	0xef, 0xdb, 0x6e, 0x6b, 0xfc, 0x26, 0x9a, 0x85, 0x0a, 0x23, 0x14, 0x77, 0x67, 0x9d, 0x34, 0x7d,
+	0x12, 0x1c, 0xd5, 0x43, 0xf3, 0x92, 0xba, 0x73, 0x92, 0x20, 0xaf, 0xc8, 0x82, 0x35, 0x19, 0xfe,
+	0xce, 0xd9, 0xd0, 0x26, 0xf3, 0x28, 0x96, 0xe1, 0x3a, 0x5c, 0xcd, 0xad, 0x85, 0x33, 0xf1, 0x21,
+	0x2c, 0xe9, 0x5d, 0xf7, 0x11, 0x31, 0x9d, 0xf0, 0x68, 0xe7, 0x88, 0x34, 0x8e, 0xc7, 0x97, 0xb7,
+	0x06, 0xcb, 0x69, 0x92, 0xbc, 0x32, 0x17, 0x6a, 0x7b, 0x2d, 0xd7, 0xf3, 0x09, 0xcb, 0xbc, 0xef,
+	0xfb, 0x9e, 0x3f, 0x7e, 0xcf, 0xaf, 0xc1, 0x74, 0xc7, 0x0c, 0x43, 0xe2, 0x0b, 0x17, 0x82, 0x48,
+	0x6a, 0x57, 0x61, 0x35, 0xa7, 0x3e, 0xce, 0x4c, 0x83, 0xb6, 0x73, 0x60, 0x7f, 0x4a, 0x9e, 0x9e,
+	0xee, 0x7b, 0x9e, 0x33, 0x3e, 0x1f, 0x2a, 0x4c, 0x50, 0x72, 0xdc, 0xdc, 0xc5, 0x6f, 0xd6, 0xf6,
+	0x72, 0x25, 0xbc, 0xf2, 0x1e, 0x54, 0xea, 0x0e, 0x21, 0x97, 0x60, 0x6b, 0xbf, 0x00, 0x33, 0x16,
+	0x37, 0xd3, 0x6a, 0x85, 0x3e, 0xe6, 0x5b, 0x84, 0xa1, 0x5d, 0x81, 0x2a, 0xaf, 0x97, 0x33, 0xf2,
+	0x03, 0x05, 0x54, 0xee, 0x0f, 0x7a, 0xe4, 0x79, 0xe3, 0xb7, 0xbe, 0xfa, 0x11, 0x2c, 0xf0, 0x92,
+	0x47, 0x9e, 0x77, 0x6c, 0xf8, 0x8c, 0x20, 0x67, 0xed, 0x4e, 0x8e, 0xe5, 0x97, 0xad, 0x5d, 0x9f,
+	0x67, 0x58, 0x12, 0x48, 0xfb, 0x04, 0x16, 0x12, 0x88, 0x7c, 0x46, 0x7a, 0x08, 0x65, 0x5e, 0x4d,
+	0xd0, 0x75, 0xc4, 0x7c, 0xf4, 0xec, 0x79, 0xb5, 0xb0, 0xc2, 0x3a, 0x1c, 0xb1, 0x54, 0xd7, 0x09,
+	0xb5, 0x3f, 0x54, 0xa0, 0xc6, 0x71, 0x1e, 0x90, 0xb0, 0x71, 0xb4, 0x1d, 0x6c, 0x77, 0x2e, 0xa1,
+	0x75, 0x16, 0x61, 0x12, 0xfd, 0x88, 0x62, 0xa4, 0x62, 0x42, 0x5d, 0x85, 0x99, 0xb6, 0x79, 0x6a,
+	0xf8, 0xde, 0x49, 0x80, 0x13, 0x5b, 0x51, 0x9f, 0x6e, 0x9b, 0xa7, 0xba, 0x77, 0x12, 0xd0, 0xac,
+	0x6e, 0x40, 0x8c, 0x8e, 0xe7, 0x89, 0x2d, 0xcf, 0x74, 0x37, 0x20, 0xb4, 0xef, 0x68, 0x0f, 0x61,
+	0x35, 0x87, 0xc3, 0x68, 0xab, 0x37, 0x95, 0xd0, 0x81, 0xba, 0x81, 0x55, 0x6d, 0x7c, 0x48, 0xff,
+	0x32, 0x19, 0x75, 0x8e, 0xa1, 0xfd, 0x63, 0x46, 0xd6, 0xdd, 0x43, 0xf3, 0xa7, 0x20, 0xeb, 0x17,
+	0xe0, 0x8a, 0x65, 0x07, 0xe8, 0x0d, 0x3b, 0xb4, 0x5d, 0xc7, 0x6b, 0x05, 0x5c, 0xe4, 0x59, 0x0e,
+	0x7e, 0x87, 0x41, 0xe9, 0x06, 0xca, 0x27, 0x8e, 0x67, 0x5a, 0x7c, 0x77, 0xc4, 0x37, 0x46, 0x15,
+	0x06, 0x64, 0x5b, 0xa3, 0xac, 0x7a, 0x50, 0xa8, 0x0b, 0xa8, 0xc7, 0x86, 0x15, 0x79, 0x37, 0x42,
+	0x89, 0x7e, 0x46, 0xca, 0xd1, 0x1e, 0x40, 0x2d, 0x5b, 0xd5, 0x05, 0x58, 0xfe, 0x2d, 0x05, 0xd4,
+	0x9d, 0x78, 0x17, 0x30, 0xbe, 0xdb, 0x85, 0xf2, 0xdb, 0xf4, 0xfc, 0x68, 0x8f, 0xc1, 0x12, 0xd4,
+	0x4c, 0xb7, 0xdd, 0x86, 0xd3, 0xb5, 0x88, 0xd1, 0x31, 0x7d, 0xba, 0xe7, 0xe2, 0x4e, 0x4e, 0x0e,
+	0xdd, 0x47, 0xa0, 0xf6, 0x16, 0x2c, 0x24, 0xb8, 0x19, 0xd1, 0x6d, 0x73, 0x0c, 0x8b, 0x07, 0xa6,
+	0x63, 0x5b, 0xa3, 0x88, 0x93, 0xbf, 0x24, 0xde, 0x82, 0x4a, 0xc7, 0x76, 0x5b, 0x86, 0xf0, 0x82,
+	0x70, 0x37, 0x03, 0x85, 0x71, 0x63, 0x59, 0x7b, 0x0b, 0x96, 0x52, 0x95, 0x8d, 0xe4, 0x4b, 0x78,
+	0x0a, 0x37, 0x79, 0xb1, 0xa8, 0x15, 0xf7, 0xbd, 0xc0, 0xa6, 0xff, 0x2f, 0xee, 0xe4, 0xd1, 0xba,
+	0x50, 0xeb, 0x47, 0x75, 0x84, 0xfd, 0xe6, 0x26, 0x4c, 0x05, 0xa1, 0x19, 0x76, 0x03, 0x3e, 0xfb,
+	0xae, 0x6c, 0xa4, 0x8f, 0x31, 0xea, 0x98, 0xad, 0x73, 0x34, 0xad, 0x09, 0xb7, 0x06, 0x08, 0xc3,
+	0xf5, 0xb2, 0x0d, 0xa5, 0x8e, 0x00, 0xd6, 0x94, 0x8c, 0xf3, 0xba, 0x1f, 0x01, 0x3d, 0x2e, 0xa5,
+	0xfd, 0x32, 0x5c, 0xaf, 0x73, 0xc7, 0xc7, 0x5e, 0xc0, 0x76, 0x31, 0x75, 0xe2, 0xf7, 0x24, 0x73,
+	0x6e, 0xf4, 0xa6, 0x7e, 0x0e, 0xe6, 0xed, 0x40, 0xec, 0xac, 0x03, 0x46, 0x8d, 0xb7, 0xf7, 0x15,
+	0x3b, 0x59, 0x89, 0xf6, 0x08, 0x6e, 0xf4, 0xad, 0x9f, 0x4b, 0x79, 0x27, 0xd9, 0x57, 0xfb, 0xb9,
+	0x94, 0xbf, 0x57, 0x80, 0x6b, 0x82, 0x14, 0xd3, 0xfe, 0x8e, 0xe7, 0x86, 0xbe, 0xe7, 0x5c, 0x5c,
+	0x90, 0x57, 0xa1, 0x2c, 0x79, 0xf0, 0x6b, 0xc5, 0x01, 0xc6, 0x3c, 0xc4, 0x6e, 0xfd, 0xd8, 0xfa,
+	0x9b, 0x90, 0xac, 0x3f, 0x75, 0x99, 0x4e, 0x22, 0x6d, 0xaf, 0x47, 0xf8, 0x94, 0xc9, 0x53, 0xea,
+	0x8b, 0xa0, 0x1e, 0x3a, 0x66, 0xe3, 0xd8, 0xb1, 0x83, 0x90, 0x58, 0x6c, 0x7c, 0x04, 0xb5, 0x29,
+	0x2c, 0x3a, 0x2f, 0xe5, 0x60, 0x65, 0x01, 0xf5, 0x5c, 0x88, 0x99, 0x1a, 0x27, 0x21, 0xa6, 0xe0,
+	0x06, 0xe1, 0x8e, 0xa5, 0x05, 0x9e, 0x89, 0x53, 0x53, 0x9d, 0x65, 0x69, 0x0f, 0x60, 0xbd, 0x8f,
+	0x66, 0x46, 0x53, 0xf1, 0x8f, 0x14, 0xb8, 0xc5, 0xf6, 0xe7, 0x75, 0xbf, 0x27, 0x9c, 0x1b, 0xfb,
+	0xa6, 0x1f, 0xb2, 0x6e, 0xf5, 0x33, 0xad, 0x67, 0xed, 0x19, 0xd0, 0x06, 0xf1, 0xce, 0x8d, 0xb0,
+	0x4f, 0xa0, 0x56, 0x8f, 0x1d, 0x86, 0x6c, 0x33, 0x7f, 0x71, 0xc1, 0xe6, 0xa0, 0xd8, 0xb5, 0xd9,
+	0x59, 0x48, 0x55, 0xa7, 0x9f, 0xda, 0x3b, 0xb0, 0x9a, 0x43, 0x7f, 0xb4, 0x66, 0xf8, 0x4f, 0x05,
+	0x96, 0x24, 0x22, 0xdb, 0x96, 0x75, 0x89, 0x1c, 0xe6, 0x1d, 0xc8, 0x4d, 0xe4, 0x1e, 0xc8, 0xdd,
+	0x82, 0x8a, 0xec, 0x70, 0x45, 0x75, 0x97, 0xf4, 0xb2, 0xe4, 0x6f, 0x55, 0x37, 0xf1, 0xdc, 0xc6,
+	0xf0, 0xe9, 0x1e, 0xb6, 0x36, 0xc5, 0xd7, 0x4e, 0xf9, 0xdc, 0x49, 0xa7, 0x39, 0xc8, 0x24, 0x7e,
+	0xd1, 0xc6, 0xe3, 0x03, 0x60, 0x1a, 0xdb, 0x94, 0xa7, 0xb4, 0xb7, 0x61, 0x39, 0x2d, 0xf1, 0x68,
+	0x3a, 0xfb, 0x75, 0x05, 0xd6, 0xd2, 0xf3, 0xe1, 0x58, 0x8a, 0x4b, 0xdb, 0x1f, 0xc5, 0xa1, 0x37,
+	0x65, 0xeb, 0x70, 0x35, 0x97, 0x13, 0xde, 0x03, 0x7f, 0x53, 0x81, 0xf5, 0x74, 0xbe, 0x8e, 0x5d,
+	0xf8, 0xa7, 0xc1, 0xec, 0x4d, 0xb8, 0xde, 0x8f, 0x19, 0xce, 0xef, 0x61, 0x56, 0xb1, 0x0f, 0xec,
+	0x53, 0xe9, 0x78, 0x1c, 0x0f, 0x46, 0x15, 0xe9, 0x60, 0x74, 0x2d, 0x75, 0x18, 0x99, 0xcb, 0x7f,
+	0x51, 0x5e, 0x84, 0x73, 0x54, 0x86, 0x75, 0x70, 0x16, 0x7e, 0x55, 0x81, 0x5b, 0x1f, 0x33, 0x87,
+	0xfa, 0x03, 0xdb, 0x09, 0x89, 0x4f, 0x92, 0xfc, 0x5e, 0x54, 0x6d, 0x2f, 0x42, 0x89, 0x1a, 0xcc,
+	0x16, 0x71, 0xcc, 0xb3, 0x5a, 0x31, 0xb2, 0x94, 0x52, 0x3b, 0xba, 0xb6, 0x79, 0xba, 0x4b, 0x31,
+	0xb4, 0x77, 0x41, 0x1b, 0xc4, 0xc5, 0x48, 0xc6, 0xcc, 0xef, 0x2a, 0xb0, 0xcc, 0x14, 0xcd, 0x5c,
+	0xe1, 0xc4, 0x19, 0x63, 0x1d, 0x13, 0x8d, 0x50, 0x94, 0x1a, 0x21, 0xb2, 0x2a, 0x27, 0x64, 0xab,
+	0xf2, 0x1a, 0x94, 0x7c, 0xd2, 0xe8, 0xfa, 0x81, 0x1d, 0xcd, 0x9f, 0x31, 0x40, 0x5b, 0x85, 0x95,
+	0x0c, 0x4f, 0xbc, 0x09, 0xbe, 0xad, 0xc0, 0x02, 0x9b, 0xcd, 0x30, 0x2f, 0x90, 0x5c, 0xf2, 0xc3,
+	0x9d, 0x55, 0x26, 0xab, 0x2e, 0xa4, 0xaa, 0xa6, 0x87, 0xfa, 0x54, 0x31, 0x86, 0xdd, 0x4c, 0x59,
+	0x14, 0x55, 0x0a, 0xde, 0x6b, 0x0a, 0x7b, 0x62, 0x59, 0xb8, 0x7e, 0x05, 0x1b, 0x9c, 0xbf, 0x1f,
+	0x16, 0x61, 0x89, 0x19, 0xc2, 0xe9, 0xc3, 0xd8, 0xbc, 0xe3, 0xf2, 0x48, 0x39, 0x05, 0x59, 0x39,
+	0x9b, 0xb0, 0xc8, 0x1c, 0xc0, 0xa4, 0xdd, 0x09, 0xcf, 0x8c, 0x9e, 0xd8, 0x02, 0x31, 0x46, 0xe6,
+	0x31, 0xef, 0x3e, 0xcd, 0x3a, 0x60, 0xfb, 0x20, 0xf5, 0x25, 0x58, 0x44, 0xe1, 0xa8, 0xdd, 0xdb,
+	0xf0, 0x9c, 0x6e, 0xdb, 0x65, 0x26, 0x3e, 0x9b, 0x4f, 0x55, 0x91, 0xb7, 0x83, 0x59, 0x68, 0xeb,
+	0xbf, 0x9b, 0x2d, 0x81, 0x4b, 0xe2, 0x24, 0x2e, 0x89, 0xb5, 0xec, 0x99, 0xfd, 0x9e, 0x85, 0xcb,
+	0x62, 0x8a, 0x16, 0x85, 0xa9, 0xf7, 0xa0, 0x42, 0x55, 0x45, 0x2c, 0xa3, 0xe9, 0x7b, 0x6d, 0x66,
+	0x52, 0x94, 0xb7, 0xd6, 0xb3, 0x34, 0x36, 0xea, 0x88, 0xf6, 0xc0, 0xf7, 0xda, 0x7a, 0x39, 0x88,
+	0xbe, 0x03, 0xf5, 0x39, 0x98, 0xc0, 0xda, 0xa7, 0xb1, 0xf6, 0xe5, 0x6c, 0x49, 0xac, 0x1b, 0x71,
+	0xe8, 0xc6, 0xf0, 0xd0, 0x0c, 0xa4, 0x45, 0x83, 0x05, 0x33, 0x54, 0x28, 0x30, 0x5a, 0x32, 0x5e,
+	0x86, 0x6a, 0xe0, 0x9a, 0x9d, 0xe0, 0xc8, 0x0b, 0x31, 0x34, 0x06, 0x83, 0x19, 0xca, 0x5b, 0x15,
+	0x31, 0xa8, 0x68, 0x64, 0x8c, 0x5e, 0x11, 0x28, 0x34, 0xa5, 0xed, 0xc1, 0x72, 0xba, 0xdd, 0x2e,
+	0x7a, 0x30, 0xff, 0xa1, 0xf0, 0xce, 0x8f, 0x70, 0x1e, 0x3f, 0xb8, 0x5b, 0x52, 0x07, 0x5b, 0x9a,
+	0x24, 0xef, 0x70, 0xdf, 0x82, 0x55, 0x36, 0x56, 0x44, 0xce, 0xb0, 0x43, 0x58, 0x0c, 0xd6, 0x42,
+	0xde, 0x60, 0x2d, 0xf6, 0x1d, 0xac, 0x13, 0x69, 0xd6, 0xae, 0xc1, 0x5a, 0x1e, 0x03, 0x9c, 0xbd,
+	0x3f, 0x55, 0xd0, 0xf0, 0x17, 0x79, 0x75, 0xde, 0x7d, 0xf6, 0xdc, 0xa6, 0x37, 0x0c, 0x93, 0x37,
+	0xe8, 0xc1, 0x67, 0xdc, 0xa1, 0x19, 0xaf, 0xd0, 0x88, 0x3b, 0xf2, 0x97, 0x23, 0x04, 0xc9, 0xa4,
+	0xeb, 0xdf, 0x7f, 0x79, 0x51, 0x61, 0xd6, 0x65, 0x67, 0x26, 0xed, 0x43, 0xdc, 0x28, 0xe4, 0xf3,
+	0xcb, 0x3b, 0xc4, 0x46, 0xa6, 0x43, 0x9c, 0x17, 0xe4, 0xb2, 0x2e, 0x91, 0xdc, 0xdd, 0x7d, 0x5c,
+	0x0f, 0x7d, 0x33, 0x24, 0xad, 0xb3, 0x61, 0x34, 0x70, 0x0b, 0x2a, 0x96, 0xe5, 0x18, 0x01, 0x2f,
+	0xc2, 0x55, 0x50, 0xb6, 0x2c, 0x47, 0x50, 0xd1, 0xf6, 0xe1, 0x7a, 0x3f, 0xfa, 0x17, 0xe4, 0xf8,
+	0x6f, 0x14, 0xdc, 0xe3, 0x44, 0x5a, 0x88, 0xc7, 0xed, 0x10, 0x1c, 0xa7, 0xac, 0xec, 0xc2, 0xa8,
+	0x56, 0x76, 0x31, 0xdf, 0xca, 0x9e, 0x48, 0xec, 0x66, 0x72, 0xac, 0xc7, 0xc9, 0x3c, 0xeb, 0x51,
+	0xfb, 0x00, 0xd6, 0xfb, 0x48, 0x72, 0x41, 0xdd, 0x7c, 0x48, 0xbd, 0xeb, 0x87, 0x5d, 0xdb, 0x89,
+	0x8e, 0x5d, 0x1f, 0xfa, 0x66, 0xe7, 0x48, 0x68, 0xe6, 0x5a, 0x3a, 0x84, 0xa8, 0x24, 0x45, 0x0b,
+	0xc5, 0x42, 0x16, 0x64, 0x87, 0xfd, 0x75, 0xb8, 0x96, 0x4f, 0x92, 0x0f, 0xa2, 0x5f, 0x80, 0x15,
+	0xe1, 0xb0, 0x18, 0x65, 0x4a, 0x49, 0xbb, 0x42, 0x0a, 0x59, 0x57, 0xc8, 0x3d, 0xa8, 0x65, 0x29,
+	0x8f, 0x64, 0x40, 0xfc, 0xaf, 0x02, 0xf3, 0x4f, 0xbc, 0x1e, 0x3b, 0xf8, 0x91, 0xfd, 0x1f, 0x9f,
+	0x63, 0x24, 0xde, 0x0d, 0xde, 0xe3, 0x8c, 0xa0, 0x43, 0x1a, 0x01, 0x5f, 0xf6, 0x58, 0xdf, 0xaa,
+	0x53, 0x48, 0x34, 0xd7, 0x4d, 0x0e, 0x08, 0x9b, 0x9b, 0x3a, 0x37, 0x6c, 0x6e, 0x3a, 0x13, 0x76,
+	0xa1, 0xbd, 0x0e, 0xaa, 0x2c, 0xfc, 0x48, 0x9a, 0xfb, 0x3d, 0x05, 0x56, 0xd9, 0x92, 0xf3, 0xd8,
+	0xf3, 0x8e, 0xbb, 0x9d, 0x03, 0xdb, 0xb5, 0xc8, 0xe9, 0x30, 0x0d, 0x7b, 0x07, 0x26, 0xa8, 0xa4,
+	0xdc, 0xc7, 0x33, 0xbf, 0x21, 0xa2, 0x4a, 0xa3, 0x26, 0xc4, 0xec, 0x5c, 0x73, 0x2c, 0x2d, 0xf5,
+	0x44, 0x46, 0x6a, 0x3a, 0xa1, 0xe7, 0xb1, 0xc5, 0xfb, 0xe2, 0xdf, 0x2a, 0x70, 0x95, 0x86, 0xe0,
+	0x34, 0x6d, 0xc7, 0xf9, 0x99, 0xe2, 0x9b, 0x1e, 0x55, 0x76, 0x3c, 0xc7, 0x89, 0x43, 0x5b, 0x26,
+	0xfb, 0x58, 0xd2, 0x15, 0x8a, 0x16, 0x85, 0xb5, 0xec, 0xc2, 0xb5, 0x7c, 0x79, 0x46, 0x6a, 0xcc,
+	0x0d, 0xea, 0x5f, 0x67, 0x91, 0x92, 0xf6, 0xa7, 0x24, 0xa9, 0x92, 0x1c, 0xcb, 0x8f, 0x9e, 0x53,
+	0xe5, 0xe0, 0x73, 0x1d, 0xef, 0x83, 0x2a, 0xc5, 0x82, 0x0a, 0x32, 0xaf, 0xc3, 0x4c, 0x14, 0x41,
+	0xca, 0x78, 0xb9, 0x2e, 0xd9, 0x21, 0x79, 0xc1, 0xa3, 0x11, 0xbe, 0xf6, 0x46, 0x22, 0x60, 0x76,
+	0x44, 0xd9, 0xda, 0x30, 0x5f, 0xef, 0x38, 0x76, 0xb8, 0xe3, 0x78, 0x2e, 0x19, 0x72, 0xd5, 0xa6,
+	0xa6, 0xa0, 0x88, 0x7d, 0x62, 0x73, 0x1d, 0x50, 0x10, 0x8f, 0x7c, 0xba, 0x0a, 0xa5, 0xd0, 0x4b,
+	0xc6, 0x4f, 0xcd, 0x84, 0x1e, 0xcb, 0xc4, 0x43, 0x7a, 0xa9, 0x3a, 0xae, 0x93, 0x33, 0x58, 0x3d,
+	0x20, 0x7e, 0x68, 0x37, 0x4c, 0x27, 0xcb, 0xcc, 0x6d, 0xa8, 0x62, 0x85, 0x29, 0x8e, 0x2a, 0x14,
+	0x98, 0x98, 0x25, 0xbc, 0xf4, 0x9c, 0x03, 0xa1, 0x17, 0x21, 0xc4, 0x4e, 0x81, 0x62, 0xc2, 0x29,
+	0x70, 0x0d, 0xd6, 0xf2, 0xaa, 0xe6, 0x8c, 0xfd, 0x6b, 0x01, 0x2a, 0x07, 0xbb, 0x76, 0xb3, 0x39,
+	0x6e, 0x60, 0xd9, 0x0d, 0xe0, 0x3e, 0x0d, 0x43, 0xea, 0xfd, 0xc0, 0x40, 0xd4, 0xa6, 0x62, 0xd3,
+	0x1c, 0xce, 0x87, 0x88, 0x10, 0x4d, 0x73, 0x14, 0xb4, 0x93, 0x37, 0x48, 0x26, 0xb3, 0x83, 0xe4,
+	0x23, 0xb8, 0xde, 0xe4, 0x9b, 0x46, 0x43, 0x72, 0x0d, 0x1b, 0x18, 0x3c, 0x86, 0xa6, 0xf2, 0x54,
+	0x9f, 0x51, 0x73, 0xb5, 0x99, 0xdd, 0x6c, 0xd2, 0x6d, 0x28, 0xb5, 0x9e, 0x31, 0x60, 0xfb, 0xa8,
+	0xeb, 0x1e, 0x1b, 0x78, 0x1c, 0x3a, 0x8d, 0x87, 0x3e, 0x25, 0x84, 0xd4, 0xed, 0x4f, 0x71, 0x26,
+	0x6f, 0xd0, 0x63, 0xe1, 0x8e, 0x67, 0xbb, 0xa1, 0xd1, 0xb4, 0x1d, 0x61, 0xb6, 0xcf, 0xc6, 0xe0,
+	0x07, 0xb6, 0x43, 0xd8, 0x72, 0x1f, 0x74, 0xb9, 0xc5, 0x3e, 0xa3, 0xf3, 0x94, 0xf6, 0xdf, 0x0a,
+	0xcc, 0xa1, 0x92, 0x71, 0xa6, 0xd5, 0x49, 0x87, 0x86, 0xf8, 0x2c, 0xc2, 0xa4, 0x1c, 0x6e, 0xce,
+	0x12, 0xf4, 0xc0, 0xa2, 0xe3, 0x7b, 0x0d, 0x12, 0x04, 0xc4, 0x62, 0x67, 0x50, 0x3c, 0x28, 0x3e,
+	0x82, 0xe2, 0x49, 0xd4, 0x6d, 0xa8, 0xb6, 0xcd, 0xb0, 0x71, 0x44, 0x17, 0x48, 0xe9, 0xa4, 0xaa,
+	0x22, 0x80, 0xe2, 0xb8, 0xaa, 0x6d, 0x07, 0x08, 0x12, 0xc4, 0x26, 0x10, 0x6d, 0x36, 0x06, 0x23,
+	0xe2, 0x73, 0x30, 0x4f, 0x4e, 0x43, 0xdf, 0x44, 0x1c, 0x83, 0xb5, 0x19, 0xaa, 0xbf, 0xa8, 0x5f,
+	0xc1, 0x0c, 0x8a, 0xc5, 0x3c, 0x4b, 0x29, 0x5c, 0xd6, 0x7c, 0xb5, 0xa9, 0x14, 0xee, 0x53, 0x04,
+	0x6b, 0x0e, 0x54, 0x79, 0xdf, 0x1a, 0x29, 0xf2, 0xed, 0x55, 0x98, 0xf6, 0x51, 0x47, 0x22, 0xe6,
+	0xed, 0xaa, 0x34, 0x53, 0xa4, 0xf5, 0xa8, 0x0b, 0x5c, 0xed, 0x7d, 0x58, 0xdc, 0x37, 0xbb, 0x01,
+	0xf9, 0x98, 0x77, 0xc9, 0x31, 0x7b, 0x34, 0x0d, 0x53, 0x4a, 0xd1, 0x8b, 0x02, 0x2e, 0x96, 0x74,
+	0x6c, 0xd8, 0xcb, 0xaa, 0x89, 0x06, 0x20, 0xa4, 0x08, 0x46, 0x11, 0x44, 0x0b, 0x89, 0xf8, 0xbe,
+	0x31, 0x2b, 0xfa, 0x2a, 0x2c, 0x26, 0xc9, 0x45, 0x47, 0x24, 0xa9, 0x58, 0x43, 0x65, 0xe4, 0x58,
+	0xc3, 0x7f, 0x2b, 0xc0, 0xea, 0x13, 0xbb, 0xe5, 0x9b, 0x21, 0x37, 0x53, 0x71, 0xc4, 0x5e, 0x72,
+	0x70, 0x48, 0xda, 0x3a, 0x9f, 0x18, 0xd2, 0x3a, 0xaf, 0xd1, 0x1e, 0xd5, 0x23, 0x7e, 0x20, 0xdc,
+	0x35, 0x22, 0xa9, 0xbe, 0x00, 0x2a, 0x46, 0xb1, 0xfa, 0x2c, 0xe4, 0xc4, 0x08, 0x42, 0x33, 0x64,
+	0xb3, 0xc8, 0x8c, 0x8e, 0xf1, 0xad, 0x72, 0x2c, 0xca, 0x10, 0xf3, 0xcf, 0xf4, 0x45, 0xe6, 0x9f,
+	0x4d, 0x58, 0xe0, 0xfc, 0xc8, 0x54, 0x71, 0x92, 0x99, 0xd1, 0x55, 0x9e, 0x25, 0x15, 0xd4, 0xde,
+	0x81, 0xb5, 0x3c, 0x5d, 0x8f, 0xb4, 0x2e, 0xfe, 0x46, 0x01, 0x6a, 0x09, 0x22, 0xc3, 0xee, 0x90,
+	0x3e, 0x87, 0xd3, 0x89, 0xfe, 0xed, 0xf5, 0xd9, 0xac, 0x00, 0xda, 0x36, 0xac, 0xe6, 0xe8, 0x62,
+	0x24, 0x7d, 0xfe, 0xb9, 0x02, 0x6a, 0xfd, 0xc4, 0x0e, 0x1b, 0x47, 0x3a, 0x31, 0xad, 0x71, 0x87,
+	0xea, 0xe7, 0xa4, 0x4f, 0x6a, 0x5a, 0x25, 0x98, 0x1e, 0x49, 0xe4, 0xdf, 0x2e, 0x8a, 0xd2, 0x18,
+	0xf7, 0x38, 0xb6, 0xcc, 0xe7, 0x37, 0x6e, 0xf1, 0x22, 0xc3, 0xeb, 0x35, 0xa8, 0xf1, 0x31, 0x9e,
+	0x1d, 0x63, 0x6c, 0x5f, 0xbe, 0xcc, 0x46, 0x7a, 0x7a, 0x9c, 0xd1, 0x05, 0xbd, 0x61, 0xba, 0x0d,
+	0xe2, 0x88, 0x53, 0x32, 0x96, 0x52, 0xef, 0xc1, 0x02, 0xc6, 0x88, 0x48, 0x3c, 0x3a, 0x66, 0xab,
+	0x6f, 0xd7, 0x9b, 0xa7, 0x01, 0x24, 0x31, 0xee, 0x63, 0xb3, 0x45, 0xe7, 0x9d, 0x9e, 0x65, 0x37,
+	0x9b, 0x46, 0xce, 0xe5, 0xa7, 0x39, 0xcc, 0x79, 0x2a, 0xd9, 0x3d, 0x77, 0x00, 0x63, 0xea, 0x8d,
+	0x8e, 0x4f, 0xd0, 0xe4, 0x08, 0xf8, 0xdc, 0x50, 0xa5, 0xd0, 0x7d, 0x01, 0xd4, 0x3e, 0x81, 0x45,
+	0xb9, 0x39, 0x44, 0x46, 0xae, 0xf3, 0x76, 0x19, 0xa6, 0x3a, 0x66, 0x10, 0x10, 0x8b, 0x6f, 0xac,
+	0x79, 0x8a, 0xb6, 0x4f, 0xc7, 0xf7, 0x0e, 0x1d, 0xd2, 0x8e, 0x6c, 0x5b, 0x91, 0xa6, 0x71, 0xa0,
+	0x8b, 0xc9, 0xf6, 0x1e, 0x69, 0x5d, 0x7f, 0x0b, 0x4a, 0xb1, 0x00, 0x6c, 0x65, 0xbf, 0x21, 0xaf,
+	0x30, 0x39, 0xac, 0xeb, 0x71, 0x09, 0x6a, 0xa5, 0xfd, 0x92, 0xd7, 0xc5, 0x3b, 0x5e, 0xfc, 0x2c,
+	0xae, 0xa8, 0x97, 0x38, 0x64, 0xcf, 0xd2, 0xde, 0x83, 0x95, 0x1d, 0x6c, 0x1d, 0x1e, 0x6e, 0x3f,
+	0xce, 0xe1, 0xbc, 0xb6, 0x06, 0xb5, 0x2c, 0x31, 0xbe, 0x26, 0xef, 0xc1, 0x52, 0xfd, 0xc8, 0x3b,
+	0x91, 0x73, 0x2e, 0x5a, 0xcd, 0x57, 0x60, 0x39, 0x4d, 0x6a, 0xa4, 0x01, 0xf8, 0x47, 0x05, 0x58,
+	0xe0, 0x87, 0x29, 0xbb, 0xbe, 0x69, 0xff, 0xac, 0x1c, 0x2e, 0xd3, 0x6b, 0x3c, 0xfc, 0x4a, 0x67,
+	0xbf, 0x5d, 0xac, 0x40, 0x50, 0x5f, 0x86, 0xb2, 0x4f, 0x42, 0xff, 0x8c, 0x9f, 0x1f, 0xf5, 0x1b,
+	0x42, 0x80, 0x48, 0x78, 0x82, 0x44, 0xc3, 0x73, 0x6d, 0xd7, 0x0e, 0x6d, 0xd3, 0xc1, 0x99, 0xa1,
+	0xef, 0x9a, 0x5b, 0xe6, 0x58, 0x54, 0x43, 0xda, 0x9b, 0xb0, 0x98, 0xd4, 0xd4, 0x48, 0x8a, 0xfe,
+	0x49, 0x01, 0x4d, 0x34, 0xaa, 0x2a, 0x61, 0xa3, 0x7d, 0xd0, 0xa1, 0x95, 0x04, 0x74, 0x2e, 0x40,
+	0xdb, 0x09, 0x7b, 0x69, 0xbc, 0x7d, 0x57, 0xfa, 0xcd, 0x05, 0x14, 0x19, 0xa3, 0x4c, 0xc5, 0x1e,
+	0x3e, 0xbd, 0x75, 0x2a, 0x9c, 0xb7, 0x75, 0x2a, 0x9e, 0xbb, 0x75, 0x9a, 0xb8, 0xc8, 0xd6, 0x69,
+	0xf2, 0x22, 0x73, 0xeb, 0xd8, 0x33, 0xa1, 0xf6, 0xd7, 0x05, 0xb8, 0x8a, 0x61, 0xed, 0x29, 0xfd,
+	0xfe, 0xff, 0xbc, 0xe9, 0x44, 0xa3, 0xd8, 0x28, 0x2d, 0x9c, 0xe2, 0x79, 0x34, 0x4a, 0x89, 0x42,
+	0x0e, 0x28, 0x40, 0x7d, 0x03, 0xa6, 0x3d, 0xd6, 0xaf, 0xf8, 0xd5, 0xa6, 0x5b, 0xd2, 0x04, 0x99,
+	0xdf, 0x01, 0x75, 0x51, 0x82, 0xfa, 0x82, 0xf2, 0x15, 0x39, 0x52, 0x57, 0xaf, 0xc3, 0xea, 0x43,
+	0x72, 0xc9, 0x8d, 0xa1, 0xd5, 0x61, 0xed, 0x21, 0xe9, 0xcb, 0xd8, 0xab, 0x29, 0x7f, 0x6b, 0x79,
+	0x6b, 0x35, 0x9e, 0x79, 0xd2, 0x85, 0x62, 0xa2, 0xdf, 0x53, 0xe0, 0x56, 0x2a, 0xf7, 0x12, 0x0d,
+	0x30, 0xa9, 0x29, 0x8a, 0x23, 0x37, 0xc5, 0xbb, 0xa0, 0x0d, 0xe2, 0x6c, 0xa4, 0x06, 0xf9, 0x7d,
+	0xa5, 0x0f, 0xb1, 0xcb, 0x31, 0xba, 0xc6, 0x92, 0xf3, 0x3d, 0xb8, 0x3d, 0x90, 0xb5, 0x91, 0x04,
+	0xfd, 0x67, 0x85, 0x5d, 0x9d, 0xc5, 0xf1, 0x34, 0x7e, 0x04, 0x6b, 0xec, 0x10, 0x2b, 0xc8, 0x0e,
+	0x31, 0x76, 0x59, 0x9c, 0xc5, 0x84, 0x26, 0x1c, 0x66, 0x55, 0x0e, 0xe5, 0x21, 0x64, 0xb7, 0x41,
+	0x04, 0x89, 0x1a, 0x3d, 0x9b, 0x9c, 0x88, 0x50, 0xdf, 0x0a, 0x07, 0x1e, 0x50, 0x18, 0x9d, 0x1a,
+	0x98, 0xef, 0x9e, 0xda, 0x54, 0x81, 0xe1, 0xb9, 0x4e, 0x34, 0x35, 0x20, 0x9c, 0x9e, 0xf2, 0x05,
+	0xf4, 0xf2, 0x91, 0xb6, 0x0f, 0xf3, 0x92, 0x6c, 0x5c, 0x2f, 0x6f, 0xc0, 0x14, 0x3f, 0x1d, 0x67,
+	0x62, 0xdd, 0xce, 0x09, 0x04, 0x67, 0x45, 0x76, 0x49, 0x13, 0x57, 0x33, 0xcf, 0xd5, 0x79, 0x11,
+	0x76, 0xef, 0x23, 0x8e, 0x27, 0xbe, 0xa4, 0x7b, 0x1f, 0x32, 0x41, 0x6e, 0xf0, 0xfc, 0x48, 0x81,
+	0x9a, 0x9c, 0x31, 0x66, 0x8c, 0xeb, 0x6d, 0x7e, 0xd9, 0x53, 0x84, 0x57, 0xf2, 0xf5, 0xac, 0x42,
+	0x81, 0x51, 0xd0, 0xa8, 0x14, 0xb8, 0xcb, 0x2f, 0x92, 0x25, 0x03, 0x77, 0xe3, 0x3b, 0xb7, 0xf2,
+	0xc5, 0xdd, 0xc9, 0xcc, 0xc5, 0x5d, 0xba, 0xb3, 0xcb, 0xe1, 0x7d, 0xa4, 0x7e, 0xf9, 0x2b, 0x0a,
+	0x5c, 0x95, 0x69, 0x8c, 0x72, 0x8a, 0x95, 0x95, 0xa3, 0x30, 0x84, 0x1c, 0xc5, 0xac, 0x1c, 0xbb,
+	0x70, 0x2d, 0x9f, 0x87, 0x91, 0x44, 0xf9, 0xbe, 0x02, 0x6b, 0x51, 0xf4, 0xf0, 0x65, 0x34, 0xe6,
+	0x25, 0x0e, 0x26, 0xfa, 0xd2, 0x47, 0x2e, 0x6f, 0x23, 0x49, 0xf8, 0x6b, 0x0a, 0xac, 0x27, 0xa9,
+	0x8c, 0xd8, 0x5c, 0x29, 0x71, 0x0a, 0x43, 0x89, 0x53, 0xcc, 0x11, 0xe7, 0x01, 0x5c, 0xef, 0xc7,
+	0xc8, 0x48, 0x12, 0x7d, 0x0b, 0x96, 0xa2, 0x99, 0x63, 0xd7, 0xb7, 0x9b, 0xe1, 0xe7, 0x2d, 0xc8,
+	0x57, 0xa0, 0x82, 0xe8, 0x07, 0xa6, 0x6f, 0x9b, 0x6e, 0x48, 0x27, 0x56, 0x69, 0xd6, 0x2a, 0x89,
+	0x09, 0x09, 0xe1, 0xf2, 0x99, 0x09, 0x4f, 0x69, 0x1f, 0x03, 0x60, 0x79, 0x64, 0xbe, 0x8f, 0xdf,
+	0xfb, 0x15, 0x98, 0xe9, 0x31, 0xf2, 0x62, 0x6b, 0xb8, 0x92, 0x7e, 0x60, 0x84, 0x57, 0xaf, 0x47,
+	0x88, 0xda, 0x43, 0x58, 0x4e, 0x6b, 0x86, 0x6b, 0xf6, 0x45, 0x98, 0xb2, 0x28, 0x20, 0xbe, 0xbb,
+	0x9a, 0x22, 0xc6, 0xd0, 0x39, 0x92, 0xf6, 0x4f, 0x0a, 0xa8, 0xdb, 0x9d, 0x8e, 0x73, 0x96, 0x9c,
+	0x4a, 0x07, 0x29, 0x78, 0x0e, 0x8a, 0xf4, 0x9d, 0x11, 0x36, 0x18, 0xe8, 0xa7, 0xfa, 0x3a, 0xac,
+	0xb2, 0xc0, 0x27, 0xc7, 0x73, 0x5b, 0x46, 0xd7, 0x35, 0x7b, 0xa6, 0xed, 0x98, 0x87, 0xb6, 0x63,
+	0x87, 0x67, 0x5c, 0xaf, 0x2b, 0x88, 0xf0, 0xd8, 0x73, 0x5b, 0x1f, 0x25, 0xb2, 0xe5, 0xdb, 0x8d,
+	0x13, 0xf2, 0xed, 0x46, 0xf5, 0x2b, 0xa0, 0xe2, 0x64, 0x19, 0x38, 0x66, 0x8f, 0x18, 0xe7, 0xed,
+	0xaa, 0xe6, 0x28, 0x2e, 0xbb, 0x06, 0xc9, 0x30, 0xb5, 0x9f, 0x28, 0xb0, 0x26, 0x49, 0x86, 0x6e,
+	0xcc, 0x36, 0xed, 0x5c, 0xec, 0x10, 0x82, 0x4b, 0xa1, 0xc4, 0x52, 0x2c, 0xc3, 0x14, 0x7b, 0x7f,
+	0x87, 0x8b, 0xc6, 0x53, 0xfd, 0x8e, 0x97, 0xb0, 0xa3, 0x05, 0xa1, 0xdd, 0x36, 0x43, 0xf9, 0x8c,
+	0x61, 0x42, 0xaf, 0x46, 0x50, 0x3c, 0x62, 0xb8, 0x03, 0xb3, 0x0d, 0xbc, 0xa4, 0x19, 0x24, 0xaf,
+	0xc4, 0x54, 0x39, 0x94, 0xb1, 0x47, 0x4f, 0x17, 0x0e, 0xed, 0x56, 0x64, 0x69, 0x1f, 0x45, 0x21,
+	0xb1, 0x25, 0xfd, 0xca, 0xa1, 0xdd, 0xe2, 0xa6, 0x36, 0x82, 0xb5, 0x7f, 0x51, 0x60, 0x45, 0x12,
+	0x8d, 0x5d, 0xb3, 0xe4, 0x72, 0x8d, 0x17, 0x93, 0xa2, 0xde, 0x07, 0x08, 0x84, 0xa6, 0x98, 0xc0,
+	0xf4, 0x2a, 0x58, 0xdc, 0x85, 0xfa, 0x6b, 0x54, 0x97, 0x0a, 0xd2, 0x03, 0x98, 0x8e, 0x4f, 0x9a,
+	0x8e, 0xdd, 0x3a, 0x0a, 0x0d, 0x42, 0x2f, 0xf3, 0x89, 0x20, 0xe1, 0x08, 0x8c, 0x57, 0xfc, 0xf0,
+	0x26, 0x9e, 0xd9, 0x14, 0xce, 0x39, 0xfc, 0xc6, 0x58, 0xc1, 0x44, 0x9f, 0x1c, 0xc9, 0xd7, 0xf2,
+	0x08, 0x66, 0x79, 0x87, 0x32, 0xd8, 0xf9, 0x08, 0x3f, 0xb6, 0xd6, 0xf2, 0xa5, 0x90, 0x95, 0xa7,
+	0x57, 0x2c, 0x29, 0xa5, 0x7d, 0x57, 0x81, 0x75, 0xc4, 0xdc, 0x25, 0x0d, 0xc7, 0xa4, 0x1d, 0xad,
+	0x47, 0x86, 0x1f, 0x26, 0xd7, 0x13, 0x9a, 0xe4, 0x67, 0xa9, 0x92, 0x8a, 0xd6, 0x01, 0xd8, 0xa0,
+	0xb1, 0x7c, 0xaf, 0x23, 0x2e, 0xcd, 0x22, 0x64, 0xd7, 0xf7, 0x3a, 0x7d, 0xc7, 0x05, 0x9d, 0x5c,
+	0xfb, 0x31, 0x35, 0xd2, 0xe4, 0x7a, 0x0a, 0xcb, 0xf5, 0xee, 0x61, 0xdb, 0x0e, 0x3f, 0x70, 0x1d,
+	0xdb, 0xa5, 0xe1, 0x47, 0x63, 0x45, 0x89, 0xd3, 0xc1, 0x54, 0x8c, 0x07, 0xd3, 0x1a, 0xcc, 0x44,
+	0xdd, 0x8c, 0xb5, 0x7c, 0x94, 0xd6, 0x5e, 0x84, 0x95, 0x4c, 0xcd, 0x9c, 0x75, 0x15, 0x26, 0xba,
+	0x34, 0xde, 0x9c, 0xfb, 0xeb, 0xe8, 0xb7, 0x46, 0x60, 0xfd, 0x21, 0x89, 0x71, 0x99, 0xb7, 0x7a,
+	0xac, 0x4b, 0x3b, 0x51, 0x35, 0x45, 0xa9, 0x9a, 0x16, 0x5c, 0xef, 0x57, 0x0d, 0x67, 0xee, 0x3e,
+	0x40, 0x3b, 0x82, 0xf2, 0xe9, 0x35, 0xef, 0x9a, 0x64, 0x96, 0x86, 0x2e, 0x15, 0xd4, 0x3e, 0x81,
+	0x65, 0xe6, 0x61, 0xbb, 0x04, 0xc5, 0xe7, 0x09, 0xb2, 0x0a, 0x2b, 0x19, 0xfa, 0xdc, 0x9e, 0xfd,
+	0x06, 0x3d, 0xbf, 0x0b, 0xfd, 0xb3, 0xcf, 0xa8, 0x66, 0x3c, 0xcd, 0x4b, 0x92, 0xe7, 0x15, 0x7f,
+	0xb7, 0x08, 0xcb, 0xd4, 0x4f, 0x90, 0x63, 0x79, 0xdd, 0x87, 0x05, 0xee, 0xad, 0x18, 0xde, 0x78,
+	0xe7, 0x4f, 0x92, 0x49, 0xa0, 0xe1, 0xa3, 0x93, 0xd2, 0xd7, 0x12, 0x8a, 0xd9, 0x6b, 0x09, 0xb7,
+	0xa1, 0x6a, 0x91, 0x20, 0x4c, 0x5f, 0x70, 0xa8, 0x50, 0xe0, 0x7b, 0xd2, 0xad, 0x3c, 0x44, 0x92,
+	0x2f, 0x37, 0x94, 0x28, 0x84, 0xd1, 0x88, 0x57, 0x8d, 0xa9, 0x73, 0xf6, 0x60, 0xd3, 0x43, 0x99,
+	0x27, 0x33, 0x39, 0x7b, 0xb0, 0xfc, 0x25, 0xb2, 0x34, 0xf4, 0x12, 0xf9, 0x36, 0xac, 0x64, 0x1a,
+	0x65, 0xa4, 0x39, 0xe4, 0x83, 0xd8, 0x6e, 0x3d, 0x20, 0x3e, 0x75, 0xf3, 0x8c, 0x67, 0x54, 0x53,
+	0x5b, 0x3f, 0x9f, 0xe0, 0x48, 0x6c, 0xbd, 0x09, 0xd7, 0x53, 0x54, 0x46, 0x79, 0x61, 0xeb, 0x21,
+	0xdc, 0xe8, 0x5b, 0x7a, 0x24, 0x36, 0x3e, 0x44, 0xf3, 0x75, 0x9f, 0xf8, 0x6d, 0x3b, 0x08, 0xe4,
+	0x09, 0xeb, 0xe2, 0x1b, 0xd5, 0xaf, 0xc1, 0x72, 0x9a, 0x24, 0x67, 0xe9, 0x1e, 0x94, 0x3b, 0x31,
+	0x38, 0x0a, 0x34, 0xca, 0xce, 0x4e, 0x72, 0x61, 0xb9, 0x88, 0x56, 0x8f, 0xe5, 0x96, 0x70, 0xc6,
+	0x6c, 0xd0, 0x47, 0x70, 0xb3, 0x3f, 0xd1, 0x91, 0xb4, 0x79, 0x0f, 0xb4, 0x1c, 0x4a, 0xa3, 0x3d,
+	0x9d, 0x76, 0x7b, 0x20, 0x85, 0x91, 0xd8, 0xd9, 0x44, 0xaf, 0xc6, 0xc1, 0xd0, 0xf6, 0x80, 0xb6,
+	0x0d, 0xaa, 0x5c, 0x80, 0x57, 0xf6, 0x3c, 0x4c, 0xf7, 0x12, 0x8e, 0x90, 0x9c, 0xe8, 0x3a, 0x81,
+	0xa1, 0xfd, 0xa5, 0x30, 0x8c, 0x86, 0xaf, 0x56, 0xae, 0xa0, 0x70, 0x5e, 0x05, 0x39, 0xeb, 0x78,
+	0x5f, 0xf3, 0x9c, 0x4e, 0xa3, 0xec, 0x68, 0x11, 0x03, 0x60, 0xb9, 0x01, 0x57, 0x0e, 0xf0, 0x38,
+	0x11, 0x41, 0xf1, 0x11, 0xc9, 0x94, 0x1c, 0x34, 0xbb, 0x03, 0x8b, 0x49, 0x19, 0x2e, 0xa2, 0x89,
+	0x1a, 0x8e, 0x03, 0xdd, 0xeb, 0xd2, 0x30, 0x39, 0xbd, 0x1b, 0x47, 0xb0, 0x6a, 0x1f, 0xc1, 0x4a,
+	0x26, 0x87, 0xd7, 0xf0, 0x3a, 0x54, 0x7d, 0x06, 0x37, 0x7c, 0x9a, 0x11, 0x8d, 0x3b, 0x51, 0x4f,
+	0xa2, 0x54, 0xc5, 0x97, 0x52, 0xda, 0xef, 0x28, 0x50, 0x43, 0xb6, 0x73, 0xea, 0x1c, 0x87, 0x70,
+	0x46, 0x8f, 0x85, 0x01, 0x7a, 0x4c, 0xbc, 0x16, 0x72, 0x15, 0x56, 0x73, 0x18, 0xe2, 0xeb, 0xed,
+	0x16, 0xac, 0xf1, 0xd2, 0x5c, 0xcd, 0x89, 0x58, 0xe7, 0x88, 0xa0, 0x92, 0x79, 0x7e, 0x24, 0xa7,
+	0x0c, 0x27, 0xf9, 0x12, 0x3a, 0xb2, 0xa5, 0xcb, 0x91, 0xe8, 0xe1, 0x1b, 0x70, 0x8f, 0x4b, 0x7b,
+	0x05, 0xae, 0xe6, 0x96, 0xe0, 0xcd, 0xb1, 0x08, 0x93, 0xe8, 0x3b, 0x14, 0x5c, 0x60, 0x42, 0x7b,
+	0x08, 0x4b, 0xc9, 0x42, 0x17, 0xbc, 0x29, 0xa6, 0xe9, 0xb0, 0x9c, 0x26, 0xc4, 0x2b, 0x7e, 0x0d,
+	0x2a, 0x81, 0xdf, 0x33, 0x52, 0xd1, 0xe3, 0xd2, 0xf4, 0x2b, 0x17, 0x2a, 0x07, 0x71, 0x42, 0x7b,
+	0x0e, 0xdf, 0x9f, 0xac, 0xfb, 0xbd, 0xd4, 0x00, 0xcc, 0x93, 0xfe, 0x09, 0x2c, 0xa5, 0x70, 0x79,
+	0xf5, 0x5f, 0x02, 0x4a, 0xd3, 0x48, 0x76, 0xf6, 0x85, 0xa8, 0xaf, 0x48, 0x25, 0x20, 0xf0, 0x7b,
+	0x07, 0xbc, 0xc7, 0xbf, 0x08, 0x2b, 0xfc, 0xe2, 0xd2, 0x50, 0xb5, 0xaf, 0x41, 0x2d, 0x8b, 0x1e,
+	0xdf, 0xc8, 0x8b, 0xdf, 0x69, 0xcc, 0x5c, 0x83, 0xbb, 0x9c, 0x1b, 0x79, 0x4d, 0xd6, 0xf6, 0x99,
+	0x3a, 0xa2, 0xc7, 0x40, 0xe6, 0x11, 0x2f, 0x11, 0x91, 0xc0, 0x34, 0xb1, 0x96, 0xbe, 0xa1, 0x29,
+	0x15, 0x9f, 0x0b, 0x52, 0x10, 0x8d, 0xc0, 0x15, 0x31, 0xa7, 0x0b, 0x01, 0xd2, 0xe1, 0xf3, 0x4a,
+	0x26, 0x7c, 0x9e, 0x85, 0x2b, 0x76, 0x4c, 0x5b, 0xf8, 0x24, 0x79, 0xaa, 0xff, 0x53, 0x4b, 0xaf,
+	0xc1, 0x5c, 0x5c, 0xcd, 0x48, 0xeb, 0xc4, 0x7f, 0x29, 0xb0, 0x4c, 0x1f, 0x6e, 0x43, 0x59, 0x98,
+	0xcf, 0xf3, 0xe2, 0x46, 0xf7, 0x3e, 0xac, 0xf2, 0x6b, 0xf3, 0xc4, 0x21, 0x8d, 0xd0, 0x18, 0xfe,
+	0xd2, 0xe6, 0x32, 0x2b, 0x77, 0x9f, 0x16, 0x7b, 0x9a, 0x7c, 0xec, 0x22, 0xe7, 0x9a, 0xdf, 0xb8,
+	0xbe, 0x97, 0xb7, 0x61, 0x25, 0x23, 0xf3, 0x48, 0x5a, 0xfb, 0x8b, 0x02, 0x5c, 0xdd, 0x77, 0x4c,
+	0xd7, 0xc5, 0xb3, 0x57, 0x7c, 0xaa, 0x62, 0x4c, 0x77, 0xed, 0x97, 0x00, 0x5c, 0x92, 0x78, 0xc4,
+	0xb2, 0xaf, 0xae, 0x4a, 0x2e, 0x11, 0x2f, 0x5b, 0xbe, 0x06, 0x15, 0xb3, 0xe7, 0xd9, 0x96, 0xec,
+	0x8a, 0xef, 0x6f, 0xa9, 0x21, 0x2a, 0x2f, 0x39, 0xa6, 0x0a, 0xe5, 0xae, 0x38, 0x95, 0x58, 0x78,
+	0x31, 0x4c, 0x85, 0x45, 0x86, 0xf0, 0x53, 0xd4, 0x28, 0x4d, 0x3b, 0x5b, 0x2d, 0xa5, 0xb6, 0x1d,
+	0xd3, 0xb5, 0xb0, 0xdf, 0x46, 0x3b, 0x92, 0x50, 0x38, 0x2f, 0x59, 0x8a, 0x0e, 0xf8, 0xe8, 0xc2,
+	0x4f, 0x89, 0xdf, 0xd6, 0xa3, 0x95, 0x24, 0x0f, 0x29, 0xa2, 0x34, 0xf5, 0xd8, 0x47, 0xa3, 0xd6,
+	0x6d, 0x89, 0xb7, 0x0a, 0x25, 0x10, 0x3d, 0xb5, 0x77, 0xcc, 0x96, 0x11, 0x90, 0x86, 0xe7, 0x5a,
+	0x2c, 0x9c, 0xb9, 0xaa, 0x83, 0x63, 0xb6, 0xea, 0x0c, 0x42, 0xe3, 0xc8, 0x03, 0xd2, 0xb6, 0x8d,
+	0xe0, 0xcc, 0x6d, 0x70, 0xf1, 0x66, 0x28, 0xa0, 0x7e, 0xe6, 0x36, 0xd8, 0xe0, 0x34, 0x83, 0xe8,
+	0xe2, 0x06, 0x4f, 0x51, 0xf8, 0x11, 0x3e, 0x0e, 0xc5, 0x63, 0x90, 0x79, 0x4a, 0xfb, 0x76, 0x11,
+	0x16, 0x52, 0x42, 0xd3, 0xe4, 0xc5, 0x5c, 0xfa, 0xec, 0xd4, 0x21, 0x94, 0xfb, 0x49, 0x49, 0xaf,
+	0x72, 0x28, 0x6f, 0xda, 0xbb, 0x30, 0x17, 0x3d, 0x5e, 0x21, 0x84, 0xe0, 0xaf, 0xe1, 0x30, 0x78,
+	0x5d, 0x88, 0xb2, 0x9e, 0xe8, 0x74, 0x7c, 0x8f, 0x18, 0xf7, 0xae, 0x1d, 0x80, 0x86, 0x68, 0x1e,
+	0x71, 0xff, 0x52, 0x7e, 0xa0, 0xa3, 0x5f, 0x53, 0xea, 0x52, 0x31, 0xfa, 0x82, 0x68, 0xc3, 0x0c,
+	0x1b, 0x47, 0x46, 0xb7, 0x13, 0x07, 0x62, 0xd2, 0xb3, 0x77, 0x0a, 0xfc, 0xa8, 0x83, 0xb1, 0x0a,
+	0xcf, 0xd3, 0xd0, 0xe5, 0x0e, 0x69, 0x50, 0x4f, 0xa5, 0xe5, 0x9d, 0xb8, 0x88, 0xc7, 0xb4, 0x38,
+	0x27, 0x32, 0x76, 0x39, 0x3c, 0x11, 0x07, 0x55, 0x4a, 0xc6, 0x41, 0xd1, 0xbc, 0x13, 0xd3, 0x77,
+	0xf1, 0x2e, 0x03, 0xb0, 0x3c, 0x91, 0xd6, 0x4e, 0xe1, 0x5a, 0xfe, 0x90, 0x1d, 0xc9, 0x7d, 0xb7,
+	0x05, 0x13, 0x1d, 0xc7, 0x14, 0x0f, 0x64, 0x5d, 0xef, 0xaf, 0x0d, 0x9a, 0xd4, 0x11, 0x57, 0xfb,
+	0xfb, 0x22, 0xac, 0xdf, 0x6f, 0x13, 0xbf, 0x45, 0xcf, 0x8b, 0x7e, 0xaa, 0xf3, 0x45, 0xfe, 0xa8,
+	0x9f, 0x18, 0x7a, 0xd4, 0x73, 0xbf, 0x29, 0xf1, 0x69, 0xb0, 0x0a, 0xb3, 0xd1, 0x26, 0x6f, 0x16,
+	0x85, 0xdf, 0x14, 0xc1, 0x34, 0xec, 0x05, 0xdd, 0x1d, 0x4d, 0xcf, 0x3f, 0xb4, 0x2d, 0x8b, 0xb8,
+	0x86, 0x6c, 0x65, 0xcf, 0x46, 0x60, 0x86, 0xf8, 0x00, 0x26, 0x42, 0xb3, 0xc5, 0xbc, 0x0c, 0xe5,
+	0xad, 0x2d, 0x49, 0x9f, 0x03, 0x35, 0xb6, 0xf1, 0xd4, 0x6c, 0x05, 0xf7, 0xdd, 0xd0, 0x3f, 0xd3,
+	0xb1, 0xbc, 0xfa, 0x25, 0x1a, 0x77, 0x7f, 0x6a, 0xd0, 0x92, 0x86, 0xe3, 0x05, 0x41, 0xdf, 0x07,
+	0x68, 0xcb, 0xf4, 0xbe, 0xbb, 0x19, 0x9a, 0x8f, 0xbd, 0x20, 0x58, 0xfb, 0x79, 0x28, 0x45, 0x84,
+	0xe8, 0xee, 0xe2, 0x98, 0x9c, 0x09, 0x97, 0xfb, 0x31, 0x39, 0xa3, 0xaa, 0xef, 0x99, 0x4e, 0x57,
+	0xcc, 0x3d, 0x2c, 0xf1, 0x7a, 0xe1, 0x35, 0x85, 0x7a, 0x39, 0xfb, 0xf1, 0x37, 0xea, 0x1e, 0x5c,
+	0x14, 0xbf, 0xac, 0xc7, 0xa5, 0xd1, 0xcb, 0x95, 0x24, 0x19, 0xb9, 0xd7, 0x6e, 0x31, 0x88, 0xb8,
+	0x22, 0xe4, 0x44, 0xbc, 0x13, 0x6b, 0xfc, 0x8a, 0x9f, 0x01, 0x6d, 0x10, 0x79, 0xce, 0xc4, 0x9f,
+	0x28, 0xa0, 0xb2, 0xb7, 0x96, 0xc7, 0x1c, 0x01, 0xe7, 0x9e, 0xcc, 0x66, 0x5e, 0x3c, 0x9d, 0x38,
+	0xf7, 0x69, 0xe8, 0xc9, 0xec, 0xd3, 0xd0, 0x6f, 0xc0, 0x42, 0x82, 0xdd, 0x91, 0x9a, 0xf7, 0x01,
+	0xa8, 0x8f, 0xed, 0x20, 0x64, 0x04, 0xc6, 0x78, 0xc5, 0xe9, 0x79, 0x58, 0x48, 0xd0, 0x19, 0xb8,
+	0x45, 0xf9, 0x3a, 0x0d, 0x3f, 0xa0, 0xb7, 0x59, 0x93, 0x8f, 0x70, 0x5f, 0xc8, 0x87, 0x2a, 0x3d,
+	0xa0, 0x8b, 0xdf, 0x2c, 0x14, 0x41, 0x26, 0xce, 0x9b, 0xf5, 0xc7, 0x0a, 0x2c, 0xec, 0xfb, 0x5d,
+	0x97, 0x8c, 0x2b, 0x2b, 0x5d, 0x9d, 0x8f, 0x09, 0xe9, 0x18, 0x8e, 0x19, 0x92, 0x20, 0xe4, 0xed,
+	0x0a, 0x14, 0xf4, 0x18, 0x21, 0x74, 0xd5, 0x42, 0x04, 0xcb, 0xb4, 0x9d, 0x33, 0x7e, 0x71, 0xa6,
+	0x44, 0x21, 0xbb, 0x14, 0x10, 0x95, 0x3f, 0x21, 0xe4, 0xd8, 0x11, 0x91, 0x07, 0x58, 0xe2, 0x63,
+	0x84, 0xf4, 0x35, 0x5d, 0xb4, 0x17, 0x60, 0x31, 0x29, 0xc2, 0x40, 0x35, 0xff, 0x95, 0x02, 0x0b,
+	0x07, 0xc4, 0xb7, 0x9b, 0x67, 0x9f, 0x81, 0x9e, 0xd3, 0xbd, 0x7b, 0x22, 0xdb, 0xbb, 0x1f, 0xc2,
+	0xa2, 0x4f, 0x82, 0x30, 0x7e, 0x43, 0x95, 0x0f, 0xd8, 0xc9, 0x41, 0x03, 0x56, 0xe5, 0x45, 0x24,
+	0x18, 0x0d, 0xc0, 0x4c, 0xca, 0x31, 0x52, 0x17, 0xff, 0x63, 0x8c, 0x41, 0x41, 0xa2, 0x34, 0x36,
+	0xff, 0xb2, 0x1e, 0x7e, 0x7f, 0x0a, 0x57, 0x84, 0x74, 0xc9, 0x87, 0x1f, 0x9f, 0xcf, 0xf1, 0x19,
+	0xf6, 0xab, 0x5f, 0x9f, 0xe5, 0x34, 0x78, 0x9a, 0xc5, 0x9c, 0x64, 0x70, 0x47, 0x92, 0xf7, 0xab,
+	0xa0, 0x6e, 0x5b, 0xb8, 0xca, 0xc9, 0x8f, 0x0d, 0xe4, 0x05, 0x72, 0x6f, 0x42, 0x89, 0xae, 0x7c,
+	0x86, 0xed, 0x36, 0xbd, 0xec, 0xaf, 0x56, 0x44, 0x14, 0x66, 0x1a, 0xfc, 0x8b, 0xbe, 0x8a, 0x9c,
+	0x20, 0xcd, 0x87, 0xd6, 0x2f, 0xc2, 0x12, 0x7b, 0xf9, 0xe9, 0x33, 0xa9, 0xf4, 0x1b, 0xb0, 0x9c,
+	0xa6, 0x1e, 0x1f, 0x76, 0x8d, 0x4f, 0x7e, 0x5b, 0x3c, 0x5a, 0x31, 0x0c, 0xf3, 0xb9, 0xef, 0x96,
+	0xc4, 0x8f, 0x54, 0x64, 0x34, 0xb3, 0x8a, 0xce, 0x34, 0x01, 0x96, 0x1d, 0x3e, 0xda, 0x4b, 0x50,
+	0xcb, 0x66, 0x0d, 0x1c, 0xcf, 0x77, 0x41, 0x95, 0x4a, 0x0c, 0x72, 0x5e, 0xb0, 0x5f, 0xda, 0xc8,
+	0xe8, 0x2b, 0xa1, 0x1b, 0x65, 0x08, 0xdd, 0xdc, 0x83, 0x45, 0xde, 0xde, 0x01, 0x1b, 0x01, 0x83,
+	0x55, 0x93, 0x73, 0xc3, 0x7f, 0x05, 0x96, 0x52, 0x14, 0xb8, 0x66, 0x76, 0x60, 0x25, 0x6e, 0xd5,
+	0x8b, 0x52, 0x27, 0x50, 0xcb, 0x12, 0x19, 0xd0, 0x39, 0x5e, 0x85, 0x32, 0x16, 0xe4, 0x83, 0x9d,
+	0x75, 0x8f, 0xc5, 0xa4, 0x0a, 0x38, 0x19, 0x68, 0x44, 0xdf, 0xb1, 0xeb, 0x68, 0x28, 0x5e, 0x63,
+	0xd7, 0x51, 0x8e, 0xd8, 0xcc, 0xef, 0x1a, 0x67, 0xc4, 0xfd, 0xe1, 0x87, 0x0a, 0xac, 0x64, 0xb2,
+	0xb8, 0x2c, 0x7b, 0x30, 0x9d, 0x7c, 0x50, 0x7d, 0x53, 0x32, 0x43, 0xfb, 0x14, 0xda, 0xe0, 0x69,
+	0x66, 0x83, 0x8a, 0xf2, 0x6b, 0xfb, 0x50, 0x91, 0x33, 0x72, 0x6c, 0xca, 0xe7, 0x64, 0x9b, 0xb2,
+	0x9f, 0x7a, 0x24, 0x4b, 0x73, 0x3d, 0x3e, 0xc3, 0xca, 0x93, 0xeb, 0x3a, 0x5c, 0xcb, 0xcf, 0x8e,
+	0xde, 0x26, 0x46, 0x37, 0x23, 0x7b, 0x5e, 0x08, 0x31, 0x06, 0x39, 0xd2, 0x2e, 0xf6, 0xc6, 0x86,
+	0xb6, 0x09, 0x2b, 0x99, 0x4a, 0xe2, 0xb1, 0x96, 0xe3, 0xcb, 0xdd, 0x82, 0xda, 0x53, 0xf1, 0xbb,
+	0x56, 0x4f, 0xcc, 0x53, 0xdd, 0x94, 0xc2, 0x65, 0x69, 0xb0, 0x12, 0xf1, 0x7b, 0xc4, 0x8f, 0x82,
+	0x98, 0x30, 0xa5, 0xfd, 0x81, 0x02, 0xab, 0x39, 0x85, 0xa2, 0xc3, 0xef, 0x49, 0x9f, 0x02, 0x72,
+	0x5a, 0xb0, 0x6f, 0xa1, 0x0d, 0x4c, 0xb1, 0x16, 0x64, 0xa5, 0xd7, 0x5e, 0x03, 0x88, 0x81, 0xe7,
+	0xed, 0x08, 0x8a, 0x72, 0x3b, 0x3d, 0x82, 0xb5, 0xa8, 0xa2, 0x3a, 0x09, 0x79, 0x5d, 0xe7, 0x08,
+	0x45, 0x1b, 0x81, 0x56, 0xcc, 0xc9, 0xe1, 0x37, 0xf5, 0x4b, 0xe7, 0x52, 0x1a, 0x38, 0x7b, 0x99,
+	0x70, 0x93, 0xfe, 0x6c, 0x8b, 0x28, 0xb7, 0xe3, 0xb9, 0x4d, 0xbb, 0x25, 0xf6, 0x3c, 0xe7, 0x30,
+	0x71, 0x07, 0x66, 0xa3, 0x5f, 0x19, 0x93, 0x1f, 0xc4, 0xa9, 0x46, 0x50, 0xfc, 0xfd, 0x9c, 0xff,
+	0x50, 0xe0, 0xd6, 0x80, 0x3a, 0x38, 0x7b, 0x47, 0x30, 0xdb, 0x90, 0x33, 0x44, 0x8b, 0xdc, 0x4b,
+	0x8e, 0xa9, 0xc1, 0x54, 0x36, 0x12, 0x50, 0xde, 0x44, 0x29, 0xba, 0x6b, 0x06, 0xbd, 0x82, 0x9b,
+	0x41, 0xcb, 0x69, 0xb4, 0xad, 0xe4, 0x90, 0xbb, 0xb6, 0x91, 0xfc, 0x4d, 0xb5, 0x24, 0x07, 0x52,
+	0x93, 0xfe, 0x83, 0x02, 0xb7, 0xf9, 0xef, 0x39, 0x7c, 0x86, 0x7a, 0x55, 0xdf, 0xa1, 0x77, 0x7c,
+	0x25, 0xb2, 0xb5, 0xe2, 0x10, 0x6c, 0x26, 0x8b, 0x50, 0x9f, 0x0f, 0xde, 0x75, 0xf9, 0x94, 0xf8,
+	0x9e, 0x81, 0x12, 0x44, 0x2f, 0x20, 0x53, 0xf8, 0xd7, 0x88, 0xef, 0x1d, 0x20, 0x54, 0x7b, 0x13,
+	0x9e, 0x19, 0x2c, 0xd3, 0xc0, 0x6e, 0xd6, 0xc0, 0x88, 0xf7, 0xcf, 0xb8, 0xa3, 0xbd, 0x01, 0xb7,
+	0x07, 0x56, 0x32, 0x90, 0xc3, 0xd7, 0x61, 0xf6, 0xa9, 0xd7, 0xf1, 0x76, 0xcc, 0x70, 0xd0, 0x44,
+	0xb7, 0x08, 0x93, 0x1d, 0x33, 0x3c, 0x8a, 0x16, 0x3c, 0x4c, 0x68, 0x8f, 0x61, 0x86, 0x96, 0xc5,
+	0x27, 0x03, 0x54, 0x98, 0xa0, 0x40, 0x51, 0x8a, 0x7e, 0x53, 0x18, 0x6d, 0x0a, 0xe4, 0xba, 0xa2,
+	0xe3, 0x37, 0xbd, 0xc1, 0xd9, 0x63, 0xc7, 0xf0, 0xdc, 0x7e, 0x17, 0x49, 0xed, 0x4d, 0xb8, 0x12,
+	0x71, 0xc2, 0x59, 0xfe, 0x22, 0x4c, 0x36, 0x6d, 0x27, 0xf7, 0x47, 0xc0, 0x44, 0xc5, 0x3a, 0xc3,
+	0xd0, 0x74, 0x58, 0xc4, 0x58, 0xfd, 0x08, 0x3e, 0x40, 0x1a, 0xc1, 0x6b, 0x21, 0x87, 0xd7, 0x62,
+	0xcc, 0xab, 0xf6, 0x32, 0x2c, 0xa5, 0x68, 0x72, 0xbe, 0x24, 0x21, 0x94, 0xa4, 0x10, 0x0b, 0x30,
+	0x7f, 0xff, 0xb4, 0xe3, 0xf9, 0x21, 0x2d, 0x23, 0x16, 0x9d, 0x17, 0x40, 0x95, 0x81, 0x9c, 0xc8,
+	0x32, 0x4c, 0x11, 0x84, 0x22, 0x8d, 0x8a, 0xce, 0x53, 0xda, 0xfb, 0x30, 0xbf, 0xd7, 0x4e, 0x91,
+	0xe8, 0x87, 0x3c, 0xc4, 0x79, 0x23, 0xfe, 0xd4, 0x4c, 0x3b, 0x5d, 0xbb, 0xd6, 0xc2, 0x85, 0x8e,
+	0x82, 0xb6, 0xbb, 0x96, 0x1d, 0x3e, 0xf6, 0xa2, 0xdb, 0x7f, 0x37, 0xa0, 0x4c, 0x35, 0x42, 0xef,
+	0x68, 0x36, 0xed, 0x53, 0x2e, 0x20, 0x50, 0xd0, 0x3e, 0x42, 0x28, 0x2f, 0xec, 0x57, 0x26, 0x44,
+	0x64, 0x25, 0x4b, 0xd1, 0x4e, 0xe2, 0xd8, 0x6d, 0x9b, 0xed, 0x41, 0x27, 0x75, 0x96, 0xd0, 0xbe,
+	0x53, 0x84, 0xd9, 0xa8, 0x1a, 0x36, 0xe5, 0xdc, 0x84, 0x09, 0x74, 0x59, 0x2a, 0x39, 0xcf, 0xc1,
+	0x61, 0x0e, 0xf5, 0x28, 0x1f, 0x79, 0x41, 0x68, 0xa4, 0x7f, 0xb2, 0x06, 0x27, 0x00, 0xfa, 0xb3,
+	0x03, 0xec, 0x11, 0x09, 0xd1, 0x85, 0x78, 0x92, 0x16, 0xeb, 0x06, 0x62, 0xac, 0xf0, 0x38, 0x35,
+	0x0a, 0xc0, 0x62, 0xd7, 0xe8, 0xad, 0x4d, 0xdb, 0x6d, 0xd8, 0x1d, 0x53, 0x3c, 0x41, 0x14, 0x03,
+	0x28, 0x51, 0x16, 0x20, 0x2a, 0x3c, 0x6f, 0x22, 0x49, 0xcb, 0x79, 0x1d, 0xc2, 0xe7, 0x1a, 0xe6,
+	0x8d, 0x8d, 0x01, 0x51, 0xff, 0x9a, 0xc9, 0xe9, 0x5f, 0x25, 0xa9, 0x7f, 0x49, 0x5d, 0x06, 0x12,
+	0x5d, 0x86, 0xea, 0x9b, 0xba, 0x26, 0x45, 0x6e, 0x99, 0xe9, 0xdb, 0x25, 0x27, 0x3c, 0x5c, 0x85,
+	0x3e, 0xe4, 0xee, 0x39, 0x96, 0x71, 0x64, 0x06, 0x47, 0xb5, 0x0a, 0x2b, 0xeb, 0x39, 0xd6, 0x23,
+	0x33, 0x38, 0xa2, 0x59, 0xb4, 0x2c, 0x66, 0x55, 0x59, 0x96, 0x4b, 0x4e, 0x30, 0x4b, 0xd8, 0x82,
+	0xb3, 0x92, 0x2d, 0xf8, 0x3e, 0x1a, 0x1e, 0xc9, 0x46, 0xe7, 0xbd, 0xf1, 0x15, 0x98, 0x26, 0x6e,
+	0xe8, 0xdb, 0xd1, 0x60, 0x5b, 0x4d, 0x0d, 0xb6, 0xb8, 0xfd, 0x74, 0x81, 0xa9, 0xb5, 0x61, 0x49,
+	0x5c, 0x97, 0x61, 0x6f, 0x15, 0x49, 0x87, 0x76, 0x4d, 0xb3, 0x11, 0x7a, 0xfe, 0x99, 0x21, 0x19,
+	0xa4, 0x65, 0x0e, 0xc3, 0xe6, 0x10, 0xbf, 0xa4, 0x59, 0x90, 0x7e, 0x49, 0x53, 0x5c, 0x2a, 0x0b,
+	0x42, 0xd3, 0x0f, 0x45, 0x10, 0x25, 0x85, 0xe0, 0x75, 0x30, 0xed, 0x05, 0x58, 0x4e, 0x57, 0x37,
+	0x20, 0xd0, 0xf0, 0x39, 0x58, 0x14, 0xd8, 0x58, 0x5c, 0x9a, 0x11, 0x32, 0xb8, 0x2b, 0xb0, 0x94,
+	0xc2, 0xe5, 0xc3, 0xe4, 0x8b, 0xb0, 0x10, 0x67, 0x78, 0x9d, 0x41, 0x34, 0x96, 0x61, 0x31, 0x89,
+	0xca, 0x49, 0x3c, 0x1f, 0xd3, 0x4e, 0x3e, 0x30, 0x9c, 0x47, 0xa4, 0x06, 0xcb, 0x69, 0xe4, 0x2c,
+	0x27, 0xf4, 0xd2, 0xe2, 0x90, 0x9c, 0x30, 0x54, 0x4e, 0x62, 0x29, 0x26, 0xf1, 0xd4, 0x27, 0xd1,
+	0x2e, 0x5f, 0x52, 0x14, 0x03, 0xc7, 0x4a, 0x0d, 0x7d, 0x42, 0xf8, 0x8c, 0x83, 0xdf, 0xda, 0xdb,
+	0xb1, 0x30, 0xdb, 0x8d, 0xd4, 0x39, 0x73, 0xde, 0xfc, 0x9f, 0xfe, 0xc5, 0x48, 0x59, 0x40, 0x41,
+	0x80, 0x55, 0xf7, 0xce, 0xdd, 0xaf, 0x3d, 0xdb, 0xb3, 0x43, 0x12, 0x04, 0x1b, 0xb6, 0xb7, 0xc9,
+	0xbe, 0x36, 0x5b, 0xde, 0x66, 0x2f, 0xdc, 0xc4, 0x1f, 0x66, 0xdd, 0x8c, 0xba, 0xe3, 0xe1, 0x14,
+	0x02, 0x5e, 0xf9, 0xbf, 0x01, 0x00, 0x36, 0x03, 0x94, 0x8c, 0x55, 0x76, 0x00, 0x00,
 }
@@ -29,17 +29,176 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtctlservice.proto", fileDescriptor_27055cdbb1148d2b) }
 
 var fileDescriptor_27055cdbb1148d2b = []byte{
-	// 146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x2b, 0x49, 0x2e,
-	0xc9, 0x29, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x41, 0x16, 0x93, 0xe2, 0x07, 0xf3, 0x52, 0x12, 0x4b, 0x12, 0x21, 0xd2, 0x46, 0x85, 0x5c, 0xac,
-	0x61, 0x20, 0x21, 0xa1, 0x0c, 0x2e, 0x61, 0xd7, 0x8a, 0xd4, 0xe4, 0xd2, 0x92, 0x54, 0x30, 0xdf,
-	0x39, 0x3f, 0x37, 0x37, 0x31, 0x2f, 0x45, 0x48, 0x55, 0x0f, 0xa1, 0x03, 0x8b, 0x7c, 0x50, 0x6a,
-	0x61, 0x69, 0x6a, 0x71, 0x89, 0x94, 0x1a, 0x21, 0x65, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a,
-	0x0c, 0x06, 0x8c, 0x4e, 0xda, 0x51, 0x9a, 0x65, 0x99, 0x25, 0xa9, 0xc5, 0xc5, 0x7a, 0x99, 0xf9,
-	0xfa, 0x10, 0x96, 0x7e, 0x7a, 0xbe, 0x7e, 0x59, 0x89, 0x3e, 0xd8, 0x49, 0xfa, 0xc8, 0x0e, 0x4e,
-	0x62, 0x03, 0x8b, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xb5, 0x06, 0x92, 0xdb, 0x00,
-	0x00, 0x00,
+	// 2689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5b, 0x6b, 0x6f, 0x1b, 0xb9,
+	0xd5, 0x7e, 0x03, 0xbc, 0xc9, 0x6e, 0x99, 0xfb, 0x24, 0xcd, 0xc5, 0x49, 0x76, 0xe3, 0x5b, 0x2e,
+	0x4d, 0xd6, 0x2e, 0xb6, 0x9f, 0x0a, 0x14, 0x05, 0x14, 0x39, 0x76, 0x8c, 0xc4, 0x59, 0xad, 0xe5,
+	0xca, 0x68, 0xb0, 0x41, 0x4b, 0x6b, 0x28, 0x69, 0xe0, 0xd1, 0x50, 0xe1, 0x50, 0x8e, 0xbd, 0x45,
+	0x8b, 0xf6, 0x2f, 0xf7, 0x17, 0x14, 0x33, 0x1c, 0x72, 0xce, 0x21, 0xcf, 0x8c, 0xf4, 0x69, 0x37,
+	0x7c, 0x1e, 0x3d, 0x67, 0x78, 0x78, 0x39, 0x87, 0x87, 0x34, 0x8b, 0xce, 0xf4, 0x50, 0xa7, 0xb9,
+	0x50, 0x67, 0xc9, 0x50, 0x6c, 0xcd, 0x94, 0xd4, 0x32, 0xba, 0x06, 0xdb, 0x56, 0x6e, 0x96, 0xff,
+	0x8a, 0xb9, 0xe6, 0x06, 0xfe, 0xf1, 0x0b, 0xbb, 0x3c, 0x28, 0x9a, 0xa2, 0x09, 0xbb, 0xf3, 0xf6,
+	0x5c, 0x0c, 0xe7, 0x5a, 0x94, 0xff, 0xee, 0xca, 0xe9, 0x94, 0x67, 0x71, 0xb4, 0xb9, 0x55, 0xff,
+	0x82, 0xc0, 0x0f, 0xc5, 0x97, 0xb9, 0xc8, 0xf5, 0xca, 0xb3, 0x45, 0xb4, 0x7c, 0x26, 0xb3, 0x5c,
+	0xac, 0xfd, 0xdf, 0xef, 0x2f, 0xfd, 0xf8, 0xdf, 0x11, 0xbb, 0x52, 0x82, 0x71, 0xf4, 0x33, 0xbb,
+	0xb6, 0x27, 0xf4, 0x7b, 0x71, 0x91, 0xcf, 0xf8, 0x50, 0xe4, 0xd1, 0x77, 0x40, 0x06, 0x02, 0xd6,
+	0xcc, 0xf7, 0x8d, 0xb8, 0xd5, 0x8f, 0x3e, 0xb2, 0xab, 0x00, 0x89, 0x9e, 0xd0, 0xbf, 0xb0, 0x82,
+	0xdf, 0x35, 0xc1, 0x4e, 0x4f, 0xb1, 0xfb, 0xbb, 0x49, 0x16, 0x77, 0xd2, 0xb4, 0x3f, 0xe1, 0x2a,
+	0xce, 0xf7, 0x33, 0xa7, 0xfd, 0x12, 0xfc, 0xb8, 0x81, 0x63, 0xed, 0xfc, 0x6e, 0x19, 0xaa, 0xb3,
+	0xf9, 0x96, 0x7d, 0xbb, 0x27, 0x74, 0x49, 0x88, 0x56, 0xf0, 0x17, 0x96, 0x8d, 0x56, 0xf5, 0x11,
+	0x89, 0x39, 0x99, 0x77, 0xec, 0x37, 0x7b, 0x42, 0x1f, 0xf1, 0x93, 0x54, 0xe8, 0xc8, 0xe3, 0x9a,
+	0x56, 0x2b, 0xf4, 0x98, 0x06, 0x9d, 0xd2, 0x7b, 0xc6, 0x5c, 0x73, 0x1e, 0x91, 0x6c, 0x37, 0x46,
+	0x4f, 0x1a, 0x50, 0x27, 0xd6, 0x61, 0x57, 0xde, 0xf0, 0xe1, 0xe9, 0x7c, 0x16, 0x3d, 0x00, 0x54,
+	0xd3, 0x64, 0x45, 0x1e, 0x12, 0x48, 0x3d, 0x85, 0xa2, 0x1d, 0xf6, 0xcd, 0xa1, 0xc8, 0x4b, 0xff,
+	0x40, 0x66, 0xd5, 0x66, 0x45, 0x56, 0x28, 0x08, 0xa8, 0xbc, 0x67, 0x6c, 0x3f, 0x4b, 0xac, 0x83,
+	0x60, 0xaf, 0xea, 0x66, 0xaa, 0x57, 0x10, 0x75, 0xbd, 0xfa, 0x3b, 0xbb, 0xfd, 0x97, 0x59, 0xcc,
+	0xb5, 0x30, 0x48, 0x27, 0x8e, 0x55, 0x1e, 0xad, 0x83, 0x5f, 0x05, 0xa8, 0x95, 0xde, 0x68, 0x27,
+	0x39, 0x0b, 0x47, 0xec, 0xfa, 0x8e, 0x48, 0x85, 0x85, 0xf3, 0x08, 0xae, 0x06, 0x84, 0x58, 0xe5,
+	0xa7, 0xcd, 0x04, 0xb8, 0x5e, 0xfa, 0x45, 0x47, 0x78, 0xfc, 0x53, 0x96, 0x5e, 0xa0, 0xf5, 0x02,
+	0xda, 0xa9, 0xf5, 0x82, 0x60, 0xa7, 0xf7, 0x33, 0xbb, 0x56, 0x01, 0xc7, 0x2a, 0xd1, 0x22, 0x22,
+	0x7e, 0x51, 0x02, 0xd4, 0x92, 0xc6, 0x38, 0x9c, 0x7d, 0x7d, 0xcd, 0x95, 0xee, 0xa7, 0xfc, 0x4c,
+	0xa0, 0x71, 0xaa, 0x9b, 0xa9, 0x71, 0x82, 0x28, 0x5c, 0x14, 0x7d, 0x2d, 0x67, 0x46, 0xeb, 0x11,
+	0x62, 0xcb, 0x19, 0x92, 0x7a, 0x4c, 0x83, 0x4e, 0xe9, 0x13, 0xbb, 0xd9, 0x9d, 0xf0, 0x6c, 0x2c,
+	0x4a, 0xe0, 0xe8, 0x62, 0x26, 0xa2, 0x55, 0xf0, 0x13, 0x0f, 0xb3, 0xaa, 0x6b, 0x6d, 0x14, 0xa7,
+	0xfd, 0x47, 0xf6, 0xff, 0xbd, 0x24, 0x1b, 0x47, 0xf7, 0x00, 0xbb, 0x68, 0xb0, 0x2a, 0xf7, 0x83,
+	0x76, 0x38, 0x00, 0x87, 0x62, 0xa4, 0x44, 0x3e, 0xe9, 0x6b, 0xee, 0x0d, 0x00, 0x04, 0xa8, 0x01,
+	0xc0, 0xb8, 0x93, 0x1c, 0xb1, 0x3b, 0x10, 0x79, 0x73, 0x61, 0xb6, 0xa6, 0xcd, 0x86, 0x5f, 0x56,
+	0x38, 0x15, 0x1b, 0x48, 0x9a, 0xb3, 0x73, 0xcc, 0x6e, 0x1c, 0xce, 0xb3, 0x77, 0x82, 0xa7, 0x7a,
+	0xd2, 0x9d, 0x88, 0xe1, 0x69, 0x04, 0x67, 0x30, 0x86, 0xac, 0xfa, 0x6a, 0x0b, 0x03, 0x2e, 0xce,
+	0xfd, 0x71, 0x26, 0x95, 0x30, 0xf0, 0x5b, 0xa5, 0xa4, 0x42, 0x8b, 0x33, 0x40, 0xa9, 0xc5, 0x49,
+	0x90, 0xb0, 0xd7, 0xf3, 0xe4, 0x57, 0x71, 0x74, 0xde, 0x93, 0x32, 0xf5, 0xbc, 0x5e, 0x03, 0xb4,
+	0xd7, 0x21, 0xee, 0x24, 0xff, 0xc4, 0x2e, 0xf7, 0x53, 0x21, 0x66, 0x11, 0x1c, 0xec, 0xb2, 0xc5,
+	0x8a, 0x3c, 0x08, 0x01, 0xb8, 0xae, 0xab, 0x40, 0xfc, 0x4e, 0xca, 0x53, 0xb4, 0xae, 0x41, 0x3b,
+	0xb5, 0xae, 0x11, 0x0c, 0x5d, 0x58, 0x01, 0xbb, 0x42, 0x0f, 0x27, 0x9d, 0xbc, 0x33, 0x9b, 0x21,
+	0x17, 0x06, 0x28, 0xe5, 0x42, 0x82, 0xd4, 0x6c, 0x61, 0xe7, 0x84, 0xb7, 0x58, 0xd8, 0x39, 0xe1,
+	0x8b, 0x2d, 0x94, 0x24, 0x67, 0xe1, 0x33, 0xbb, 0x35, 0x38, 0x14, 0xb3, 0x34, 0x19, 0x72, 0x9d,
+	0xc8, 0xac, 0xa0, 0x46, 0x70, 0x3d, 0xfa, 0xa0, 0xd5, 0x5f, 0x6f, 0xe5, 0x40, 0x97, 0x77, 0x95,
+	0xe0, 0x5a, 0x98, 0xe5, 0x01, 0x5d, 0x0e, 0xda, 0x29, 0x97, 0x23, 0xd8, 0xe9, 0x0d, 0xd8, 0xf5,
+	0x01, 0x4f, 0x93, 0xd8, 0x29, 0xc2, 0x49, 0x83, 0x10, 0x6a, 0xc3, 0xf7, 0x08, 0x20, 0xee, 0x9d,
+	0xb3, 0x87, 0x55, 0xa3, 0xeb, 0x49, 0x4f, 0xe6, 0x49, 0xf1, 0xdf, 0x3c, 0x7a, 0x05, 0xe7, 0x54,
+	0x13, 0xcb, 0xda, 0x7b, 0xbd, 0x1c, 0x19, 0x26, 0x53, 0xfd, 0x2a, 0x4f, 0xd9, 0xcf, 0x0f, 0x78,
+	0xae, 0x85, 0xea, 0x17, 0x99, 0x69, 0x36, 0x46, 0xc9, 0x54, 0x03, 0x87, 0x4a, 0xa6, 0x1a, 0xa9,
+	0xce, 0x66, 0xca, 0x7e, 0x6b, 0x49, 0x26, 0xfa, 0x75, 0x65, 0xa6, 0x95, 0x4c, 0xa3, 0xe7, 0x84,
+	0x0c, 0x62, 0x58, 0x7b, 0x2f, 0x16, 0x13, 0x9d, 0xb5, 0x7f, 0xb0, 0x15, 0x13, 0xc3, 0xfb, 0xea,
+	0xcc, 0x66, 0x76, 0x3d, 0xae, 0x74, 0xe9, 0x8a, 0xe8, 0x75, 0x10, 0xea, 0x29, 0x9a, 0xb5, 0xfb,
+	0xc3, 0x92, 0x6c, 0xb8, 0x82, 0xfa, 0x72, 0xae, 0x86, 0x66, 0xcc, 0x4d, 0xc4, 0x47, 0x2b, 0x28,
+	0x40, 0xa9, 0x15, 0x44, 0x90, 0xe0, 0x0e, 0x0d, 0xe0, 0x4e, 0x1c, 0xa3, 0x1d, 0x1a, 0x43, 0xd4,
+	0x0e, 0xed, 0x33, 0x60, 0x88, 0xf1, 0x27, 0x50, 0x27, 0xc6, 0x21, 0x86, 0xc0, 0xa9, 0x10, 0x43,
+	0xd2, 0x9c, 0x1d, 0xc9, 0xee, 0xf9, 0x84, 0x43, 0x31, 0x95, 0x67, 0x22, 0x7a, 0xd1, 0xa2, 0x61,
+	0x28, 0xd6, 0xda, 0xcb, 0x25, 0x98, 0x6d, 0x1d, 0xdb, 0x4d, 0xce, 0x5b, 0x3b, 0xb6, 0x9b, 0x9c,
+	0x2f, 0xd3, 0xb1, 0x92, 0xe6, 0xec, 0xfc, 0x93, 0xad, 0x1c, 0xf3, 0x44, 0xef, 0x4a, 0xb5, 0x9b,
+	0xa4, 0x5a, 0x28, 0x01, 0xa9, 0x68, 0xe2, 0x35, 0xd3, 0xa8, 0x89, 0xd7, 0xc6, 0x06, 0x7b, 0xca,
+	0x27, 0x76, 0xd3, 0x74, 0xbd, 0xfc, 0xca, 0xae, 0x48, 0x53, 0x94, 0x0c, 0x79, 0x18, 0x95, 0x0c,
+	0x05, 0x14, 0x18, 0x5b, 0xcd, 0x44, 0x2c, 0x41, 0x7c, 0x4a, 0x84, 0x00, 0x15, 0x5b, 0x31, 0x0e,
+	0xe7, 0xb1, 0xd9, 0x73, 0xdd, 0x61, 0xee, 0x69, 0xb0, 0x1d, 0xfb, 0x67, 0xb8, 0xd5, 0x16, 0x06,
+	0x14, 0x36, 0x26, 0x49, 0x61, 0x0c, 0x51, 0xc2, 0x3e, 0xc3, 0x09, 0x0f, 0x59, 0x64, 0x3c, 0x64,
+	0xb1, 0xd2, 0xc7, 0x1b, 0x81, 0x03, 0x21, 0x6c, 0x0d, 0x6c, 0x2e, 0x60, 0x79, 0xfb, 0xb3, 0x05,
+	0x4b, 0xaf, 0x25, 0xd9, 0x78, 0x3f, 0x1b, 0x49, 0x7f, 0x7f, 0xa6, 0x38, 0x0d, 0xfb, 0x33, 0x4d,
+	0x45, 0x2b, 0xb2, 0x26, 0xed, 0xec, 0x7c, 0xe8, 0x6b, 0xc5, 0xb5, 0x18, 0x5f, 0x44, 0x2f, 0x68,
+	0x1d, 0x40, 0x21, 0x57, 0x64, 0x03, 0xd3, 0x0b, 0x08, 0xee, 0xab, 0x84, 0x3a, 0x13, 0xf1, 0xae,
+	0x92, 0x53, 0x3f, 0x20, 0x84, 0x8c, 0x86, 0x80, 0x40, 0x11, 0x9d, 0xb5, 0x84, 0xdd, 0x3d, 0x14,
+	0x27, 0xf3, 0x24, 0x8d, 0x2d, 0x6d, 0x4f, 0xf1, 0xd9, 0x24, 0xc2, 0x59, 0x71, 0x48, 0xb0, 0xb6,
+	0x9e, 0x2f, 0xe4, 0x39, 0x53, 0x7f, 0x63, 0xb7, 0x6c, 0xd0, 0x77, 0xb3, 0x6f, 0x8d, 0xc8, 0x08,
+	0xfc, 0xf9, 0xb7, 0xde, 0xca, 0x01, 0x8b, 0xfc, 0x80, 0xb1, 0x03, 0x79, 0x66, 0x0e, 0x91, 0xb8,
+	0x0c, 0x50, 0x37, 0x53, 0x07, 0x31, 0x88, 0x02, 0xb9, 0x21, 0x8b, 0xcc, 0x3a, 0xfa, 0x20, 0xe5,
+	0xe9, 0x7c, 0x36, 0x48, 0xb2, 0x58, 0x9c, 0xa3, 0x29, 0x1d, 0xc2, 0xd4, 0x94, 0xa6, 0x58, 0xce,
+	0x29, 0xa7, 0xec, 0x6e, 0x51, 0x40, 0x18, 0x25, 0x69, 0x8a, 0xcc, 0x3c, 0xf3, 0x2a, 0x0c, 0x3e,
+	0x81, 0xf2, 0x3f, 0xcd, 0x03, 0x3d, 0x2a, 0x53, 0x58, 0x2d, 0x54, 0xc6, 0xd3, 0xe4, 0x57, 0x51,
+	0x59, 0xc2, 0x29, 0xac, 0x87, 0xd2, 0x29, 0x6c, 0x40, 0x72, 0xdd, 0xe9, 0xb1, 0xab, 0x07, 0x5c,
+	0x0b, 0x95, 0x94, 0x30, 0xca, 0x31, 0x41, 0x3b, 0x95, 0x63, 0x22, 0x18, 0x57, 0x41, 0xfa, 0xb3,
+	0x34, 0xd1, 0xdd, 0x54, 0x66, 0xde, 0xe9, 0xda, 0x35, 0x93, 0xa7, 0x6b, 0x80, 0xc2, 0x5d, 0x6a,
+	0x20, 0x94, 0x4e, 0x86, 0x3c, 0x05, 0xa2, 0xb0, 0x73, 0x21, 0x4c, 0x0d, 0x29, 0xc5, 0x72, 0x46,
+	0xfe, 0xcc, 0x2e, 0x0f, 0x76, 0x92, 0xd1, 0x08, 0x1d, 0x8c, 0xca, 0x16, 0xea, 0x60, 0x54, 0x01,
+	0xa0, 0xc7, 0x47, 0xec, 0x7a, 0x8f, 0xcf, 0x73, 0x71, 0x2c, 0xd5, 0xe9, 0x28, 0x95, 0x5f, 0x51,
+	0x5e, 0x8d, 0x10, 0x2a, 0xaf, 0xf6, 0x08, 0xe8, 0xf0, 0x2a, 0xf2, 0xf9, 0xb4, 0x96, 0x7d, 0x8a,
+	0xcf, 0x78, 0xf3, 0x69, 0xa0, 0xbb, 0xda, 0xc2, 0x80, 0xe1, 0xaf, 0x2b, 0x67, 0x17, 0x3d, 0x25,
+	0xc7, 0x4a, 0xe4, 0x38, 0xfc, 0x41, 0x80, 0x0a, 0x7f, 0x18, 0x77, 0x92, 0x82, 0x45, 0x07, 0xc9,
+	0x58, 0x71, 0x5d, 0xed, 0x59, 0x45, 0xf5, 0x21, 0x47, 0xc3, 0x14, 0xc2, 0xd4, 0x30, 0x51, 0x2c,
+	0xe0, 0xe8, 0x13, 0x76, 0x1b, 0x31, 0xca, 0x5d, 0x76, 0xbd, 0xe9, 0xf7, 0x70, 0x87, 0xdd, 0x68,
+	0x27, 0x01, 0x1b, 0x3d, 0x76, 0xb5, 0xff, 0x35, 0xd1, 0xc3, 0xc9, 0xa1, 0xe0, 0x71, 0x8e, 0xeb,
+	0x57, 0x75, 0x3b, 0x59, 0xbf, 0x82, 0x30, 0x50, 0xec, 0xb3, 0x6b, 0x06, 0x2a, 0xeb, 0x50, 0xd8,
+	0xdf, 0x10, 0x20, 0x2b, 0x58, 0x08, 0x07, 0xa2, 0x9f, 0xd9, 0xad, 0x2e, 0xcf, 0x86, 0x22, 0xad,
+	0x0a, 0x91, 0xc5, 0x91, 0x07, 0x95, 0x82, 0x3c, 0x90, 0xda, 0x9b, 0x43, 0x8e, 0x1b, 0xd0, 0xbf,
+	0xb2, 0x1b, 0xfd, 0x89, 0xfc, 0x5a, 0x63, 0x38, 0x2f, 0x47, 0x10, 0x99, 0x97, 0x7b, 0x0c, 0xec,
+	0x8e, 0x2a, 0x07, 0xdc, 0x51, 0x3c, 0xc9, 0x90, 0x3b, 0x20, 0x40, 0xb9, 0x03, 0xe3, 0x40, 0xf4,
+	0x94, 0xdd, 0x2d, 0xab, 0x73, 0x95, 0x4d, 0xb7, 0x64, 0x9e, 0xf9, 0xe5, 0x3b, 0x8f, 0x40, 0xed,
+	0xca, 0x34, 0x0f, 0xc7, 0x99, 0x3d, 0x11, 0x98, 0xda, 0xc0, 0x75, 0xea, 0x06, 0x43, 0x9b, 0x0b,
+	0x58, 0x30, 0xff, 0xf6, 0x40, 0x38, 0x2d, 0x5f, 0x87, 0xa5, 0x68, 0x82, 0x46, 0xe5, 0xdf, 0x6d,
+	0x6c, 0xd0, 0xc7, 0x7f, 0x5f, 0x62, 0x8f, 0x48, 0x6a, 0x35, 0x89, 0x17, 0x4a, 0xe2, 0x39, 0xbd,
+	0xb5, 0x2c, 0x1d, 0x7c, 0x82, 0xb9, 0x6e, 0xe8, 0x0f, 0x27, 0x62, 0xca, 0xfd, 0xeb, 0x06, 0xd3,
+	0xda, 0x70, 0xdd, 0x60, 0x41, 0x5c, 0x4c, 0x4b, 0x25, 0x8f, 0x2b, 0x31, 0x5c, 0x4c, 0xab, 0x01,
+	0xba, 0x98, 0x06, 0x71, 0x27, 0x79, 0xc2, 0x6e, 0x43, 0xc4, 0xd4, 0x53, 0xd6, 0x1b, 0x7e, 0x87,
+	0x6a, 0x2a, 0x1b, 0xed, 0x24, 0x3c, 0xa9, 0x21, 0xc1, 0xe5, 0x60, 0xcf, 0x1a, 0x14, 0xfc, 0x3c,
+	0xec, 0xf9, 0x42, 0x1e, 0x30, 0x36, 0x61, 0x77, 0x5c, 0x85, 0x07, 0x74, 0x69, 0x93, 0xaa, 0x00,
+	0x85, 0x9d, 0x7a, 0xb6, 0x88, 0x06, 0x2c, 0x7d, 0x61, 0xf7, 0x30, 0xc5, 0x75, 0xec, 0x45, 0xa3,
+	0x8a, 0xdf, 0xb5, 0x97, 0x4b, 0x30, 0x81, 0xc9, 0x63, 0x76, 0xc3, 0xcd, 0x8b, 0x1d, 0x95, 0x8c,
+	0x34, 0xda, 0xce, 0x30, 0x44, 0x6d, 0x67, 0x3e, 0x03, 0xa6, 0x4f, 0x9d, 0xd9, 0x2c, 0xbd, 0xa8,
+	0x26, 0x16, 0x8c, 0x16, 0xa0, 0x9d, 0x8a, 0x16, 0x08, 0xc6, 0xde, 0x29, 0xa1, 0x1d, 0x31, 0x4c,
+	0xb9, 0xe2, 0x3a, 0x39, 0xab, 0x3a, 0x86, 0xbc, 0x43, 0x53, 0x28, 0xef, 0x34, 0x31, 0xf1, 0x59,
+	0xbb, 0x3f, 0x3f, 0x99, 0x26, 0xfa, 0xa7, 0x2c, 0x4d, 0xb2, 0xe2, 0x94, 0x83, 0xce, 0xda, 0x1e,
+	0x46, 0x9d, 0xb5, 0x03, 0x0a, 0x3c, 0x8d, 0xed, 0x89, 0x1a, 0x31, 0xb1, 0xb7, 0x2c, 0x0c, 0xbe,
+	0xc0, 0xfe, 0x25, 0x28, 0x54, 0x77, 0x9a, 0x98, 0xe8, 0x16, 0xa5, 0x8c, 0x6b, 0x74, 0x67, 0x3c,
+	0x8c, 0xbc, 0x45, 0xf1, 0x29, 0x38, 0x25, 0xd3, 0xea, 0xa2, 0x96, 0xc6, 0x29, 0x19, 0x84, 0xe8,
+	0x94, 0x0c, 0x33, 0x9c, 0xf0, 0x2f, 0xec, 0x66, 0x91, 0x59, 0xc1, 0x85, 0xb7, 0xea, 0x65, 0x5d,
+	0xc4, 0xa2, 0x5b, 0x6b, 0xa3, 0xe0, 0x7d, 0xc4, 0xae, 0x91, 0x81, 0x50, 0x79, 0x22, 0x33, 0x63,
+	0x82, 0x5a, 0xb4, 0x90, 0x40, 0xed, 0x23, 0x34, 0x0f, 0x18, 0xd3, 0xec, 0xbe, 0xc7, 0x21, 0xef,
+	0xb7, 0x1b, 0x38, 0xd4, 0x91, 0xbf, 0x91, 0x1a, 0x2c, 0xf0, 0x9e, 0x50, 0xd3, 0x24, 0xcf, 0xcb,
+	0xe9, 0xe5, 0x2d, 0x70, 0x00, 0x35, 0x2c, 0x70, 0xc4, 0x70, 0x23, 0xf3, 0x95, 0x3d, 0xb0, 0xf6,
+	0x01, 0xc1, 0xf8, 0x8f, 0xfa, 0x48, 0x9f, 0x64, 0x8d, 0xbd, 0x5a, 0x8a, 0xeb, 0x05, 0x60, 0x82,
+	0xe8, 0x9c, 0xf9, 0x43, 0xbb, 0xa0, 0xef, 0xd0, 0xad, 0x65, 0xe9, 0xf8, 0x24, 0xb7, 0x27, 0xf4,
+	0xa0, 0xda, 0x7e, 0xbc, 0x20, 0x3b, 0xc0, 0x5b, 0xce, 0x93, 0x06, 0x14, 0xc6, 0xe0, 0x72, 0x2b,
+	0x1a, 0x10, 0x31, 0x18, 0x02, 0x54, 0x0c, 0xc6, 0x38, 0x5c, 0xea, 0x45, 0x0a, 0x25, 0xe7, 0xba,
+	0xc8, 0x31, 0xe7, 0xa9, 0xc8, 0x23, 0x6f, 0x4c, 0x21, 0x46, 0xad, 0x9a, 0x80, 0x02, 0x4b, 0xdf,
+	0xa5, 0x55, 0xa4, 0xbe, 0xee, 0x7f, 0x13, 0xa5, 0xbf, 0xd1, 0x4e, 0xc2, 0x97, 0xa0, 0x65, 0xfd,
+	0xa5, 0xea, 0x99, 0xa9, 0xe3, 0x6c, 0x86, 0xf5, 0x19, 0x88, 0xd3, 0x97, 0xa0, 0x04, 0x0d, 0xda,
+	0x29, 0xc2, 0x57, 0x5d, 0xe9, 0xff, 0xc8, 0xa7, 0x22, 0x8f, 0xbc, 0x44, 0xd4, 0xc7, 0x29, 0x3b,
+	0x24, 0x0d, 0x6e, 0x8e, 0x98, 0x10, 0xc4, 0xd8, 0x1a, 0x6a, 0x8a, 0xb1, 0x90, 0x01, 0xdf, 0x29,
+	0x18, 0xcc, 0x4e, 0x9d, 0xef, 0x83, 0x5f, 0x79, 0x73, 0xe7, 0x69, 0x33, 0x01, 0xde, 0xdd, 0x55,
+	0xb5, 0xdc, 0x5a, 0x78, 0x2d, 0x2c, 0xf4, 0x06, 0xda, 0xeb, 0xad, 0x1c, 0xdf, 0xeb, 0x5e, 0x89,
+	0x3d, 0xf0, 0x7a, 0x50, 0xed, 0xa7, 0xbd, 0x1e, 0xd0, 0x9c, 0x9d, 0x3d, 0xf6, 0xad, 0x5d, 0xce,
+	0xe8, 0x69, 0x8f, 0x6d, 0xa4, 0x9e, 0xf6, 0xd4, 0x18, 0x58, 0xec, 0xbf, 0xb0, 0x9b, 0xc5, 0x3b,
+	0x94, 0xd2, 0x94, 0xb9, 0xfa, 0x42, 0x8b, 0xc9, 0xc3, 0xa8, 0xc5, 0x14, 0x50, 0x70, 0x08, 0xea,
+	0xa5, 0x3c, 0xcb, 0xca, 0x82, 0x3f, 0x57, 0x22, 0xd3, 0x61, 0x08, 0xa2, 0x08, 0x54, 0x08, 0xa2,
+	0x79, 0x38, 0x85, 0x7a, 0x3b, 0x15, 0x6a, 0x2c, 0xb2, 0xe1, 0x05, 0x36, 0x07, 0x73, 0x0e, 0x9a,
+	0x42, 0xe5, 0x1c, 0x4d, 0x4c, 0x1c, 0x7f, 0x2c, 0x58, 0x3d, 0xff, 0xc1, 0x99, 0x01, 0x84, 0xe8,
+	0xcc, 0x00, 0x33, 0xe0, 0xfd, 0x9f, 0x69, 0xb3, 0x45, 0xbc, 0xd4, 0x7d, 0x85, 0x88, 0xd1, 0x31,
+	0xb0, 0x99, 0x46, 0x1d, 0x03, 0xdb, 0xd8, 0x30, 0xbb, 0x35, 0x8f, 0xa5, 0xc2, 0x0b, 0x68, 0xd0,
+	0x4e, 0x65, 0xb7, 0x08, 0x06, 0x7e, 0xfa, 0xc8, 0xae, 0x7e, 0x48, 0x72, 0x6d, 0x60, 0x5c, 0x5d,
+	0x01, 0xed, 0x94, 0x22, 0x82, 0xf1, 0xc9, 0xae, 0xb8, 0x80, 0x30, 0x90, 0x77, 0xb2, 0xab, 0x01,
+	0xfa, 0x64, 0x07, 0x71, 0x28, 0xd9, 0x53, 0xf3, 0x4c, 0xd8, 0x6f, 0x84, 0x92, 0x10, 0xa0, 0x24,
+	0x31, 0xee, 0x24, 0xfb, 0xec, 0xda, 0x40, 0xa8, 0x64, 0x74, 0x41, 0x7c, 0x25, 0x04, 0x28, 0x49,
+	0x8c, 0xe3, 0x62, 0xd8, 0xa1, 0xc8, 0xb5, 0x54, 0xa2, 0xa8, 0x61, 0x55, 0xca, 0xf8, 0x04, 0xea,
+	0xa1, 0xf4, 0x09, 0x34, 0x20, 0xe1, 0xe1, 0xea, 0xc4, 0xe5, 0xf5, 0x59, 0x79, 0x67, 0x83, 0x8e,
+	0x37, 0x75, 0x3b, 0x79, 0xbc, 0x81, 0x30, 0x8c, 0x11, 0xe6, 0xe2, 0xd9, 0x49, 0x3e, 0x0d, 0xee,
+	0xa4, 0x7d, 0xd5, 0xd5, 0x16, 0x46, 0x78, 0x4d, 0x46, 0x0a, 0x63, 0xa8, 0xf9, 0x9a, 0x8c, 0x10,
+	0xfe, 0xcc, 0x6e, 0xed, 0x09, 0x6d, 0x01, 0x13, 0x3a, 0xbd, 0x0c, 0x02, 0x81, 0x54, 0x98, 0x08,
+	0x39, 0xde, 0xeb, 0x52, 0xd2, 0xc1, 0xa0, 0xbd, 0xe1, 0x75, 0x29, 0xf1, 0xb9, 0x47, 0xec, 0x7a,
+	0xe5, 0xf9, 0xbc, 0x93, 0x26, 0x1c, 0xbf, 0xe9, 0x43, 0x08, 0x15, 0x2b, 0x3d, 0x02, 0x74, 0x42,
+	0xed, 0xf9, 0x4a, 0x78, 0x8d, 0x1c, 0x16, 0xac, 0xbd, 0xde, 0xca, 0x09, 0x43, 0x71, 0x83, 0xbc,
+	0x0f, 0x36, 0x87, 0x62, 0x52, 0xde, 0xa4, 0x89, 0x35, 0x14, 0xa6, 0x89, 0x10, 0x6b, 0x48, 0x13,
+	0x31, 0x05, 0xde, 0xc6, 0xd9, 0x68, 0x8a, 0x0c, 0x50, 0x47, 0x2b, 0xca, 0xca, 0xf3, 0x85, 0x3c,
+	0xaf, 0x1b, 0xd5, 0x7b, 0x94, 0x92, 0xe3, 0x77, 0x03, 0x62, 0x0d, 0xdd, 0xc0, 0x14, 0x98, 0xed,
+	0x1e, 0x4d, 0x94, 0xd4, 0x3a, 0x15, 0xea, 0x80, 0x9f, 0x1f, 0x72, 0xed, 0x65, 0xbb, 0x01, 0x4a,
+	0xed, 0x25, 0x04, 0x09, 0xe6, 0x43, 0x0e, 0xee, 0x0b, 0x5d, 0x31, 0x50, 0x3e, 0x44, 0xe0, 0x54,
+	0x3e, 0x44, 0xd2, 0x9c, 0x9d, 0x73, 0xf6, 0xb0, 0x78, 0x24, 0x6c, 0x39, 0x5d, 0x99, 0x8d, 0x92,
+	0xf1, 0xdc, 0x94, 0x09, 0xd0, 0x5b, 0xa4, 0x46, 0x16, 0xf5, 0x16, 0xa9, 0x85, 0xec, 0x2c, 0xff,
+	0xe7, 0x12, 0x7b, 0x5c, 0x3d, 0xb7, 0xa5, 0xad, 0x6f, 0x85, 0xef, 0x72, 0x5b, 0x3f, 0x60, 0x7b,
+	0x69, 0xbe, 0xfb, 0x86, 0x7f, 0x95, 0x45, 0xdb, 0xc6, 0xfe, 0x7b, 0x45, 0xdb, 0x45, 0x1e, 0xd8,
+	0x5a, 0x96, 0xee, 0xec, 0xbf, 0x61, 0xdf, 0x1c, 0xc9, 0x99, 0xec, 0x72, 0x8d, 0xde, 0x51, 0x57,
+	0x6d, 0xd4, 0x3b, 0x6a, 0x07, 0xc1, 0x2d, 0xac, 0x2c, 0x06, 0x17, 0xc8, 0x6e, 0x92, 0x0a, 0xb4,
+	0x85, 0x21, 0x84, 0xda, 0xc2, 0x3c, 0x02, 0x7c, 0xf3, 0xfb, 0xf6, 0x7c, 0x26, 0x95, 0x2e, 0x30,
+	0x74, 0x96, 0xad, 0x9b, 0xa9, 0xb3, 0x2c, 0x44, 0xa1, 0xd8, 0xfe, 0x94, 0x14, 0xdb, 0x9f, 0xb6,
+	0x89, 0xed, 0x4f, 0x09, 0x31, 0xb3, 0xae, 0x8b, 0xc6, 0xce, 0x3c, 0x4e, 0xf4, 0x07, 0x39, 0xf6,
+	0xd7, 0x35, 0xc4, 0x1a, 0xd6, 0x35, 0xa6, 0xc0, 0xb0, 0x68, 0xcb, 0xec, 0xe6, 0x52, 0x1b, 0x85,
+	0x45, 0x0c, 0x51, 0x61, 0xd1, 0x67, 0xa0, 0x41, 0xaa, 0xb0, 0xf2, 0xba, 0x04, 0x0f, 0x12, 0x44,
+	0xc8, 0x41, 0xc2, 0x04, 0x98, 0x7a, 0xd5, 0x90, 0xc4, 0x79, 0x12, 0x04, 0xc8, 0xab, 0x21, 0x84,
+	0x53, 0x1e, 0xa8, 0xde, 0xaf, 0x51, 0x1f, 0x82, 0x1f, 0xaf, 0xad, 0xb6, 0x30, 0xa8, 0x6f, 0x2d,
+	0x6e, 0xa5, 0xc8, 0x6f, 0x2d, 0x80, 0xb6, 0x6f, 0x35, 0x38, 0x25, 0x79, 0xa4, 0x84, 0x20, 0x25,
+	0x0b, 0xa0, 0x4d, 0xd2, 0xe0, 0x54, 0xf7, 0x3b, 0xc3, 0x72, 0x0f, 0xa0, 0xba, 0x6f, 0xa0, 0xb6,
+	0xee, 0x5b, 0x86, 0x15, 0x7e, 0xf3, 0xea, 0xd3, 0xcb, 0xb3, 0x44, 0x8b, 0x3c, 0xdf, 0x4a, 0xe4,
+	0xb6, 0xf9, 0xbf, 0xed, 0xb1, 0xdc, 0x3e, 0xd3, 0xdb, 0xe5, 0xdf, 0x01, 0x6d, 0xc3, 0xbf, 0x12,
+	0x3a, 0xb9, 0x52, 0xb6, 0xfd, 0xe1, 0x7f, 0x03, 0x00, 0xb1, 0x6f, 0xef, 0x99, 0x50, 0x34, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -148,3 +307,6076 @@ var _Vtctl_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "vtctlservice.proto",
 }
+
+// VtctldClient is the client API for Vtctld service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VtctldClient interface {
+	GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error)
+	GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error)
+	FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
+	GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error)
+	GetTablet(ctx context.Context, in *vtctldata.GetTabletRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletResponse, error)
+	GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error)
+	Backup(ctx context.Context, in *vtctldata.BackupRequest, opts ...grpc.CallOption) (Vtctld_BackupClient, error)
+	Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (Vtctld_ReshardClient, error)
+	// Tablets.
+	InitTablet(ctx context.Context, in *vtctldata.InitTabletRequest, opts ...grpc.CallOption) (*vtctldata.InitTabletResponse, error)
+	UpdateTabletAddrs(ctx context.Context, in *vtctldata.UpdateTabletAddrsRequest, opts ...grpc.CallOption) (*vtctldata.UpdateTabletAddrsResponse, error)
+	DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error)
+	SetReadOnly(ctx context.Context, in *vtctldata.SetReadOnlyRequest, opts ...grpc.CallOption) (*vtctldata.SetReadOnlyResponse, error)
+	SetReadWrite(ctx context.Context, in *vtctldata.SetReadWriteRequest, opts ...grpc.CallOption) (*vtctldata.SetReadWriteResponse, error)
+	StartSlave(ctx context.Context, in *vtctldata.StartSlaveRequest, opts ...grpc.CallOption) (*vtctldata.StartSlaveResponse, error)
+	StopSlave(ctx context.Context, in *vtctldata.StopSlaveRequest, opts ...grpc.CallOption) (*vtctldata.StopSlaveResponse, error)
+	ChangeSlaveType(ctx context.Context, in *vtctldata.ChangeSlaveTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeSlaveTypeResponse, error)
+	Ping(ctx context.Context, in *vtctldata.PingRequest, opts ...grpc.CallOption) (*vtctldata.PingResponse, error)
+	RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error)
+	RefreshStateByShard(ctx context.Context, in *vtctldata.RefreshStateByShardRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateByShardResponse, error)
+	RunHealthCheck(ctx context.Context, in *vtctldata.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldata.RunHealthCheckResponse, error)
+	IgnoreHealthError(ctx context.Context, in *vtctldata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*vtctldata.IgnoreHealthErrorResponse, error)
+	ResizeTxPool(ctx context.Context, in *vtctldata.ResizeTxPoolRequest, opts ...grpc.CallOption) (*vtctldata.ResizeTxPoolResponse, error)
+	Sleep(ctx context.Context, in *vtctldata.SleepRequest, opts ...grpc.CallOption) (*vtctldata.SleepResponse, error)
+	ExecuteHook(ctx context.Context, in *vtctldata.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteHookResponse, error)
+	ExecuteFetchAsApp(ctx context.Context, in *vtctldata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteFetchAsAppResponse, error)
+	ExecuteFetchAsDba(ctx context.Context, in *vtctldata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteFetchAsDbaResponse, error)
+	VReplicationExec(ctx context.Context, in *vtctldata.VReplicationExecRequest, opts ...grpc.CallOption) (*vtctldata.VReplicationExecResponse, error)
+	// Shards.
+	CreateShard(ctx context.Context, in *vtctldata.CreateShardRequest, opts ...grpc.CallOption) (*vtctldata.CreateShardResponse, error)
+	ValidateShard(ctx context.Context, in *vtctldata.ValidateShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateShardClient, error)
+	ShardReplicationPositions(ctx context.Context, in *vtctldata.ShardReplicationPositionsRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationPositionsResponse, error)
+	SetShardIsMasterServing(ctx context.Context, in *vtctldata.SetShardIsMasterServingRequest, opts ...grpc.CallOption) (*vtctldata.SetShardIsMasterServingResponse, error)
+	SetShardTabletControl(ctx context.Context, in *vtctldata.SetShardTabletControlRequest, opts ...grpc.CallOption) (*vtctldata.SetShardTabletControlResponse, error)
+	UpdateSrvKeyspacePartition(ctx context.Context, in *vtctldata.UpdateSrvKeyspacePartitionRequest, opts ...grpc.CallOption) (*vtctldata.UpdateSrvKeyspacePartitionResponse, error)
+	SourceShardDelete(ctx context.Context, in *vtctldata.SourceShardDeleteRequest, opts ...grpc.CallOption) (*vtctldata.SourceShardDeleteResponse, error)
+	SourceShardAdd(ctx context.Context, in *vtctldata.SourceShardAddRequest, opts ...grpc.CallOption) (*vtctldata.SourceShardAddResponse, error)
+	ShardReplicationAdd(ctx context.Context, in *vtctldata.ShardReplicationAddRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationAddResponse, error)
+	ShardReplicationRemove(ctx context.Context, in *vtctldata.ShardReplicationRemoveRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationRemoveResponse, error)
+	ShardReplicationFix(ctx context.Context, in *vtctldata.ShardReplicationFixRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationFixResponse, error)
+	WaitForFilteredReplication(ctx context.Context, in *vtctldata.WaitForFilteredReplicationRequest, opts ...grpc.CallOption) (Vtctld_WaitForFilteredReplicationClient, error)
+	RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error)
+	DeleteShards(ctx context.Context, in *vtctldata.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteShardsResponse, error)
+	// Keyspaces.
+	CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error)
+	DeleteKeyspace(ctx context.Context, in *vtctldata.DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error)
+	RemoveKeyspaceCell(ctx context.Context, in *vtctldata.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveKeyspaceCellResponse, error)
+	SetKeyspaceShardingInfo(ctx context.Context, in *vtctldata.SetKeyspaceShardingInfoRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	SetKeyspaceDDLStrategy(ctx context.Context, in *vtctldata.SetKeyspaceDDLStrategyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceDDLStrategyResponse, error)
+	SetKeyspaceServedFrom(ctx context.Context, in *vtctldata.SetKeyspaceServedFromRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceServedFromResponse, error)
+	RebuildKeyspaceGraph(ctx context.Context, in *vtctldata.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildKeyspaceGraphResponse, error)
+	ValidateKeyspace(ctx context.Context, in *vtctldata.ValidateKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateKeyspaceClient, error)
+	// Workflows.
+	MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (Vtctld_MoveTablesClient, error)
+	CreateLookupVindex(ctx context.Context, in *vtctldata.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.CreateLookupVindexResponse, error)
+	BackfillLookupVindex(ctx context.Context, in *vtctldata.BackfillLookupVindexRequest, opts ...grpc.CallOption) (Vtctld_BackfillLookupVindexClient, error)
+	ExternalizeVindex(ctx context.Context, in *vtctldata.ExternalizeVindexRequest, opts ...grpc.CallOption) (*vtctldata.ExternalizeVindexResponse, error)
+	Materialize(ctx context.Context, in *vtctldata.MaterializeRequest, opts ...grpc.CallOption) (Vtctld_MaterializeClient, error)
+	SplitClone(ctx context.Context, in *vtctldata.SplitCloneRequest, opts ...grpc.CallOption) (*vtctldata.SplitCloneResponse, error)
+	VerticalSplitClone(ctx context.Context, in *vtctldata.VerticalSplitCloneRequest, opts ...grpc.CallOption) (*vtctldata.VerticalSplitCloneResponse, error)
+	VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (Vtctld_VDiffClient, error)
+	PauseWorkflow(ctx context.Context, in *vtctldata.PauseWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.PauseWorkflowResponse, error)
+	ResumeWorkflow(ctx context.Context, in *vtctldata.ResumeWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.ResumeWorkflowResponse, error)
+	CopyProgress(ctx context.Context, in *vtctldata.CopyProgressRequest, opts ...grpc.CallOption) (*vtctldata.CopyProgressResponse, error)
+	MigrateServedTypes(ctx context.Context, in *vtctldata.MigrateServedTypesRequest, opts ...grpc.CallOption) (Vtctld_MigrateServedTypesClient, error)
+	MigrateServedFrom(ctx context.Context, in *vtctldata.MigrateServedFromRequest, opts ...grpc.CallOption) (Vtctld_MigrateServedFromClient, error)
+	SwitchReads(ctx context.Context, in *vtctldata.SwitchReadsRequest, opts ...grpc.CallOption) (Vtctld_SwitchReadsClient, error)
+	SwitchWrites(ctx context.Context, in *vtctldata.SwitchWritesRequest, opts ...grpc.CallOption) (Vtctld_SwitchWritesClient, error)
+	CancelResharding(ctx context.Context, in *vtctldata.CancelReshardingRequest, opts ...grpc.CallOption) (*vtctldata.CancelReshardingResponse, error)
+	ShowResharding(ctx context.Context, in *vtctldata.ShowReshardingRequest, opts ...grpc.CallOption) (Vtctld_ShowReshardingClient, error)
+	WaitForDrain(ctx context.Context, in *vtctldata.WaitForDrainRequest, opts ...grpc.CallOption) (Vtctld_WaitForDrainClient, error)
+	StartReshardWorkflow(ctx context.Context, in *vtctldata.StartReshardWorkflowRequest, opts ...grpc.CallOption) (Vtctld_StartReshardWorkflowClient, error)
+	GetReshardWorkflow(ctx context.Context, in *vtctldata.GetReshardWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.GetReshardWorkflowResponse, error)
+	ReshardWorkflowSwitchReads(ctx context.Context, in *vtctldata.ReshardWorkflowSwitchReadsRequest, opts ...grpc.CallOption) (Vtctld_ReshardWorkflowSwitchReadsClient, error)
+	ReshardWorkflowSwitchWrites(ctx context.Context, in *vtctldata.ReshardWorkflowSwitchWritesRequest, opts ...grpc.CallOption) (Vtctld_ReshardWorkflowSwitchWritesClient, error)
+	// Schema, version and permissions.
+	GetSchema(ctx context.Context, in *vtctldata.GetSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaResponse, error)
+	ReloadSchema(ctx context.Context, in *vtctldata.ReloadSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ReloadSchemaResponse, error)
+	ReloadSchemaShard(ctx context.Context, in *vtctldata.ReloadSchemaShardRequest, opts ...grpc.CallOption) (Vtctld_ReloadSchemaShardClient, error)
+	ReloadSchemaKeyspace(ctx context.Context, in *vtctldata.ReloadSchemaKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ReloadSchemaKeyspaceClient, error)
+	ValidateSchemaShard(ctx context.Context, in *vtctldata.ValidateSchemaShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateSchemaShardClient, error)
+	ValidateSchemaKeyspace(ctx context.Context, in *vtctldata.ValidateSchemaKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateSchemaKeyspaceClient, error)
+	GetSchemaDrift(ctx context.Context, in *vtctldata.GetSchemaDriftRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaDriftResponse, error)
+	ApplySchema(ctx context.Context, in *vtctldata.ApplySchemaRequest, opts ...grpc.CallOption) (Vtctld_ApplySchemaClient, error)
+	ApplyDeclarativeSchema(ctx context.Context, in *vtctldata.ApplyDeclarativeSchemaRequest, opts ...grpc.CallOption) (Vtctld_ApplyDeclarativeSchemaClient, error)
+	SubmitOnlineDDL(ctx context.Context, in *vtctldata.SubmitOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.SubmitOnlineDDLResponse, error)
+	GetOnlineDDLMigrations(ctx context.Context, in *vtctldata.GetOnlineDDLMigrationsRequest, opts ...grpc.CallOption) (*vtctldata.GetOnlineDDLMigrationsResponse, error)
+	CancelOnlineDDL(ctx context.Context, in *vtctldata.CancelOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.CancelOnlineDDLResponse, error)
+	RetryOnlineDDL(ctx context.Context, in *vtctldata.RetryOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.RetryOnlineDDLResponse, error)
+	CopySchemaShard(ctx context.Context, in *vtctldata.CopySchemaShardRequest, opts ...grpc.CallOption) (Vtctld_CopySchemaShardClient, error)
+	ValidateVersionShard(ctx context.Context, in *vtctldata.ValidateVersionShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateVersionShardClient, error)
+	ValidateVersionKeyspace(ctx context.Context, in *vtctldata.ValidateVersionKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateVersionKeyspaceClient, error)
+	GetPermissions(ctx context.Context, in *vtctldata.GetPermissionsRequest, opts ...grpc.CallOption) (*vtctldata.GetPermissionsResponse, error)
+	ValidatePermissionsShard(ctx context.Context, in *vtctldata.ValidatePermissionsShardRequest, opts ...grpc.CallOption) (Vtctld_ValidatePermissionsShardClient, error)
+	ValidatePermissionsKeyspace(ctx context.Context, in *vtctldata.ValidatePermissionsKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidatePermissionsKeyspaceClient, error)
+	// VSchema, serving graph and validation.
+	GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error)
+	ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error)
+	GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error)
+	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
+	RebuildVSchemaGraph(ctx context.Context, in *vtctldata.RebuildVSchemaGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildVSchemaGraphResponse, error)
+	GetSrvKeyspaceNames(ctx context.Context, in *vtctldata.GetSrvKeyspaceNamesRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvKeyspaceNamesResponse, error)
+	GetSrvKeyspace(ctx context.Context, in *vtctldata.GetSrvKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvKeyspaceResponse, error)
+	GetSrvVSchema(ctx context.Context, in *vtctldata.GetSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvVSchemaResponse, error)
+	DeleteSrvVSchema(ctx context.Context, in *vtctldata.DeleteSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.DeleteSrvVSchemaResponse, error)
+	GetShardReplication(ctx context.Context, in *vtctldata.GetShardReplicationRequest, opts ...grpc.CallOption) (*vtctldata.GetShardReplicationResponse, error)
+	Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (Vtctld_ValidateClient, error)
+	// Reparenting.
+	InitShardMaster(ctx context.Context, in *vtctldata.InitShardMasterRequest, opts ...grpc.CallOption) (Vtctld_InitShardMasterClient, error)
+	PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (Vtctld_PlannedReparentShardClient, error)
+	EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (Vtctld_EmergencyReparentShardClient, error)
+	ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error)
+	TabletExternallyReparented(ctx context.Context, in *vtctldata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldata.TabletExternallyReparentedResponse, error)
+	// Backups.
+	BackupShard(ctx context.Context, in *vtctldata.BackupShardRequest, opts ...grpc.CallOption) (Vtctld_BackupShardClient, error)
+	ListBackups(ctx context.Context, in *vtctldata.ListBackupsRequest, opts ...grpc.CallOption) (*vtctldata.ListBackupsResponse, error)
+	RemoveBackup(ctx context.Context, in *vtctldata.RemoveBackupRequest, opts ...grpc.CallOption) (*vtctldata.RemoveBackupResponse, error)
+	PruneBackups(ctx context.Context, in *vtctldata.PruneBackupsRequest, opts ...grpc.CallOption) (*vtctldata.PruneBackupsResponse, error)
+	VerifyBackup(ctx context.Context, in *vtctldata.VerifyBackupRequest, opts ...grpc.CallOption) (Vtctld_VerifyBackupClient, error)
+	RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error)
+	// Cells and cells aliases.
+	AddCellInfo(ctx context.Context, in *vtctldata.AddCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.AddCellInfoResponse, error)
+	UpdateCellInfo(ctx context.Context, in *vtctldata.UpdateCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellInfoResponse, error)
+	DeleteCellInfo(ctx context.Context, in *vtctldata.DeleteCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.DeleteCellInfoResponse, error)
+	GetCellInfoNames(ctx context.Context, in *vtctldata.GetCellInfoNamesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellInfoNamesResponse, error)
+	GetCellInfo(ctx context.Context, in *vtctldata.GetCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.GetCellInfoResponse, error)
+	AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error)
+	UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error)
+	DeleteCellsAlias(ctx context.Context, in *vtctldata.DeleteCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.DeleteCellsAliasResponse, error)
+	GetCellsAliases(ctx context.Context, in *vtctldata.GetCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellsAliasesResponse, error)
+	ValidateCellsAliases(ctx context.Context, in *vtctldata.ValidateCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.ValidateCellsAliasesResponse, error)
+	GetServingCells(ctx context.Context, in *vtctldata.GetServingCellsRequest, opts ...grpc.CallOption) (*vtctldata.GetServingCellsResponse, error)
+	// Resharding throttler.
+	ThrottlerMaxRates(ctx context.Context, in *vtctldata.ThrottlerMaxRatesRequest, opts ...grpc.CallOption) (*vtctldata.ThrottlerMaxRatesResponse, error)
+	ThrottlerSetMaxRate(ctx context.Context, in *vtctldata.ThrottlerSetMaxRateRequest, opts ...grpc.CallOption) (*vtctldata.ThrottlerSetMaxRateResponse, error)
+	GetThrottlerConfiguration(ctx context.Context, in *vtctldata.GetThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.GetThrottlerConfigurationResponse, error)
+	UpdateThrottlerConfiguration(ctx context.Context, in *vtctldata.UpdateThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigurationResponse, error)
+	ResetThrottlerConfiguration(ctx context.Context, in *vtctldata.ResetThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.ResetThrottlerConfigurationResponse, error)
+	// Topology files, export and audit log.
+	TopoCat(ctx context.Context, in *vtctldata.TopoCatRequest, opts ...grpc.CallOption) (*vtctldata.TopoCatResponse, error)
+	WriteTopoFile(ctx context.Context, in *vtctldata.WriteTopoFileRequest, opts ...grpc.CallOption) (*vtctldata.WriteTopoFileResponse, error)
+	ExportTopo(ctx context.Context, in *vtctldata.ExportTopoRequest, opts ...grpc.CallOption) (*vtctldata.ExportTopoResponse, error)
+	ImportTopo(ctx context.Context, in *vtctldata.ImportTopoRequest, opts ...grpc.CallOption) (*vtctldata.ImportTopoResponse, error)
+	GetTopoAuditLog(ctx context.Context, in *vtctldata.GetTopoAuditLogRequest, opts ...grpc.CallOption) (*vtctldata.GetTopoAuditLogResponse, error)
+	// Workflow manager of vtctld.
+	WorkflowCreate(ctx context.Context, in *vtctldata.WorkflowCreateRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCreateResponse, error)
+	WorkflowStart(ctx context.Context, in *vtctldata.WorkflowStartRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowStartResponse, error)
+	WorkflowStop(ctx context.Context, in *vtctldata.WorkflowStopRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowStopResponse, error)
+	WorkflowDelete(ctx context.Context, in *vtctldata.WorkflowDeleteRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowDeleteResponse, error)
+	WorkflowWait(ctx context.Context, in *vtctldata.WorkflowWaitRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowWaitResponse, error)
+	WorkflowTree(ctx context.Context, in *vtctldata.WorkflowTreeRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowTreeResponse, error)
+	WorkflowAction(ctx context.Context, in *vtctldata.WorkflowActionRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowActionResponse, error)
+}
+
+type vtctldClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVtctldClient(cc *grpc.ClientConn) VtctldClient {
+	return &vtctldClient{cc}
+}
+
+func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error) {
+	out := new(vtctldata.GetKeyspacesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error) {
+	out := new(vtctldata.GetKeyspaceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
+	out := new(vtctldata.FindAllShardsInKeyspaceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/FindAllShardsInKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error) {
+	out := new(vtctldata.GetShardResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetTablet(ctx context.Context, in *vtctldata.GetTabletRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletResponse, error) {
+	out := new(vtctldata.GetTabletResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTablet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error) {
+	out := new(vtctldata.GetTabletsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTablets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Backup(ctx context.Context, in *vtctldata.BackupRequest, opts ...grpc.CallOption) (Vtctld_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[0], "/vtctlservice.Vtctld/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_BackupClient interface {
+	Recv() (*vtctldata.BackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldBackupClient) Recv() (*vtctldata.BackupResponse, error) {
+	m := new(vtctldata.BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (Vtctld_ReshardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[1], "/vtctlservice.Vtctld/Reshard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldReshardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ReshardClient interface {
+	Recv() (*vtctldata.ReshardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldReshardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldReshardClient) Recv() (*vtctldata.ReshardResponse, error) {
+	m := new(vtctldata.ReshardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) InitTablet(ctx context.Context, in *vtctldata.InitTabletRequest, opts ...grpc.CallOption) (*vtctldata.InitTabletResponse, error) {
+	out := new(vtctldata.InitTabletResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/InitTablet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateTabletAddrs(ctx context.Context, in *vtctldata.UpdateTabletAddrsRequest, opts ...grpc.CallOption) (*vtctldata.UpdateTabletAddrsResponse, error) {
+	out := new(vtctldata.UpdateTabletAddrsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateTabletAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error) {
+	out := new(vtctldata.DeleteTabletsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteTablets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetReadOnly(ctx context.Context, in *vtctldata.SetReadOnlyRequest, opts ...grpc.CallOption) (*vtctldata.SetReadOnlyResponse, error) {
+	out := new(vtctldata.SetReadOnlyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetReadWrite(ctx context.Context, in *vtctldata.SetReadWriteRequest, opts ...grpc.CallOption) (*vtctldata.SetReadWriteResponse, error) {
+	out := new(vtctldata.SetReadWriteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetReadWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) StartSlave(ctx context.Context, in *vtctldata.StartSlaveRequest, opts ...grpc.CallOption) (*vtctldata.StartSlaveResponse, error) {
+	out := new(vtctldata.StartSlaveResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/StartSlave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) StopSlave(ctx context.Context, in *vtctldata.StopSlaveRequest, opts ...grpc.CallOption) (*vtctldata.StopSlaveResponse, error) {
+	out := new(vtctldata.StopSlaveResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/StopSlave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ChangeSlaveType(ctx context.Context, in *vtctldata.ChangeSlaveTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeSlaveTypeResponse, error) {
+	out := new(vtctldata.ChangeSlaveTypeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeSlaveType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Ping(ctx context.Context, in *vtctldata.PingRequest, opts ...grpc.CallOption) (*vtctldata.PingResponse, error) {
+	out := new(vtctldata.PingResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error) {
+	out := new(vtctldata.RefreshStateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RefreshState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RefreshStateByShard(ctx context.Context, in *vtctldata.RefreshStateByShardRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateByShardResponse, error) {
+	out := new(vtctldata.RefreshStateByShardResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RefreshStateByShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RunHealthCheck(ctx context.Context, in *vtctldata.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldata.RunHealthCheckResponse, error) {
+	out := new(vtctldata.RunHealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RunHealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) IgnoreHealthError(ctx context.Context, in *vtctldata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*vtctldata.IgnoreHealthErrorResponse, error) {
+	out := new(vtctldata.IgnoreHealthErrorResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/IgnoreHealthError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ResizeTxPool(ctx context.Context, in *vtctldata.ResizeTxPoolRequest, opts ...grpc.CallOption) (*vtctldata.ResizeTxPoolResponse, error) {
+	out := new(vtctldata.ResizeTxPoolResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ResizeTxPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Sleep(ctx context.Context, in *vtctldata.SleepRequest, opts ...grpc.CallOption) (*vtctldata.SleepResponse, error) {
+	out := new(vtctldata.SleepResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Sleep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ExecuteHook(ctx context.Context, in *vtctldata.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteHookResponse, error) {
+	out := new(vtctldata.ExecuteHookResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExecuteHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ExecuteFetchAsApp(ctx context.Context, in *vtctldata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteFetchAsAppResponse, error) {
+	out := new(vtctldata.ExecuteFetchAsAppResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExecuteFetchAsApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ExecuteFetchAsDba(ctx context.Context, in *vtctldata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteFetchAsDbaResponse, error) {
+	out := new(vtctldata.ExecuteFetchAsDbaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExecuteFetchAsDba", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VReplicationExec(ctx context.Context, in *vtctldata.VReplicationExecRequest, opts ...grpc.CallOption) (*vtctldata.VReplicationExecResponse, error) {
+	out := new(vtctldata.VReplicationExecResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VReplicationExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CreateShard(ctx context.Context, in *vtctldata.CreateShardRequest, opts ...grpc.CallOption) (*vtctldata.CreateShardResponse, error) {
+	out := new(vtctldata.CreateShardResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidateShard(ctx context.Context, in *vtctldata.ValidateShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[2], "/vtctlservice.Vtctld/ValidateShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateShardClient interface {
+	Recv() (*vtctldata.ValidateShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateShardClient) Recv() (*vtctldata.ValidateShardResponse, error) {
+	m := new(vtctldata.ValidateShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ShardReplicationPositions(ctx context.Context, in *vtctldata.ShardReplicationPositionsRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationPositionsResponse, error) {
+	out := new(vtctldata.ShardReplicationPositionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ShardReplicationPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetShardIsMasterServing(ctx context.Context, in *vtctldata.SetShardIsMasterServingRequest, opts ...grpc.CallOption) (*vtctldata.SetShardIsMasterServingResponse, error) {
+	out := new(vtctldata.SetShardIsMasterServingResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetShardIsMasterServing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetShardTabletControl(ctx context.Context, in *vtctldata.SetShardTabletControlRequest, opts ...grpc.CallOption) (*vtctldata.SetShardTabletControlResponse, error) {
+	out := new(vtctldata.SetShardTabletControlResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetShardTabletControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateSrvKeyspacePartition(ctx context.Context, in *vtctldata.UpdateSrvKeyspacePartitionRequest, opts ...grpc.CallOption) (*vtctldata.UpdateSrvKeyspacePartitionResponse, error) {
+	out := new(vtctldata.UpdateSrvKeyspacePartitionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateSrvKeyspacePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SourceShardDelete(ctx context.Context, in *vtctldata.SourceShardDeleteRequest, opts ...grpc.CallOption) (*vtctldata.SourceShardDeleteResponse, error) {
+	out := new(vtctldata.SourceShardDeleteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SourceShardDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SourceShardAdd(ctx context.Context, in *vtctldata.SourceShardAddRequest, opts ...grpc.CallOption) (*vtctldata.SourceShardAddResponse, error) {
+	out := new(vtctldata.SourceShardAddResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SourceShardAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ShardReplicationAdd(ctx context.Context, in *vtctldata.ShardReplicationAddRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationAddResponse, error) {
+	out := new(vtctldata.ShardReplicationAddResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ShardReplicationAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ShardReplicationRemove(ctx context.Context, in *vtctldata.ShardReplicationRemoveRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationRemoveResponse, error) {
+	out := new(vtctldata.ShardReplicationRemoveResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ShardReplicationRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ShardReplicationFix(ctx context.Context, in *vtctldata.ShardReplicationFixRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationFixResponse, error) {
+	out := new(vtctldata.ShardReplicationFixResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ShardReplicationFix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WaitForFilteredReplication(ctx context.Context, in *vtctldata.WaitForFilteredReplicationRequest, opts ...grpc.CallOption) (Vtctld_WaitForFilteredReplicationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[3], "/vtctlservice.Vtctld/WaitForFilteredReplication", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldWaitForFilteredReplicationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_WaitForFilteredReplicationClient interface {
+	Recv() (*vtctldata.WaitForFilteredReplicationResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldWaitForFilteredReplicationClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldWaitForFilteredReplicationClient) Recv() (*vtctldata.WaitForFilteredReplicationResponse, error) {
+	m := new(vtctldata.WaitForFilteredReplicationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error) {
+	out := new(vtctldata.RemoveShardCellResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveShardCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteShards(ctx context.Context, in *vtctldata.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteShardsResponse, error) {
+	out := new(vtctldata.DeleteShardsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error) {
+	out := new(vtctldata.CreateKeyspaceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteKeyspace(ctx context.Context, in *vtctldata.DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error) {
+	out := new(vtctldata.DeleteKeyspaceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldata.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveKeyspaceCellResponse, error) {
+	out := new(vtctldata.RemoveKeyspaceCellResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveKeyspaceCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in *vtctldata.SetKeyspaceShardingInfoRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceShardingInfoResponse, error) {
+	out := new(vtctldata.SetKeyspaceShardingInfoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspaceShardingInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetKeyspaceDDLStrategy(ctx context.Context, in *vtctldata.SetKeyspaceDDLStrategyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceDDLStrategyResponse, error) {
+	out := new(vtctldata.SetKeyspaceDDLStrategyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspaceDDLStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetKeyspaceServedFrom(ctx context.Context, in *vtctldata.SetKeyspaceServedFromRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceServedFromResponse, error) {
+	out := new(vtctldata.SetKeyspaceServedFromResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspaceServedFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldata.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
+	out := new(vtctldata.RebuildKeyspaceGraphResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RebuildKeyspaceGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidateKeyspace(ctx context.Context, in *vtctldata.ValidateKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateKeyspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[4], "/vtctlservice.Vtctld/ValidateKeyspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateKeyspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateKeyspaceClient interface {
+	Recv() (*vtctldata.ValidateKeyspaceResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateKeyspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateKeyspaceClient) Recv() (*vtctldata.ValidateKeyspaceResponse, error) {
+	m := new(vtctldata.ValidateKeyspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (Vtctld_MoveTablesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[5], "/vtctlservice.Vtctld/MoveTables", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldMoveTablesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_MoveTablesClient interface {
+	Recv() (*vtctldata.MoveTablesResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldMoveTablesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldMoveTablesClient) Recv() (*vtctldata.MoveTablesResponse, error) {
+	m := new(vtctldata.MoveTablesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) CreateLookupVindex(ctx context.Context, in *vtctldata.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.CreateLookupVindexResponse, error) {
+	out := new(vtctldata.CreateLookupVindexResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateLookupVindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) BackfillLookupVindex(ctx context.Context, in *vtctldata.BackfillLookupVindexRequest, opts ...grpc.CallOption) (Vtctld_BackfillLookupVindexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[6], "/vtctlservice.Vtctld/BackfillLookupVindex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldBackfillLookupVindexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_BackfillLookupVindexClient interface {
+	Recv() (*vtctldata.BackfillLookupVindexResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldBackfillLookupVindexClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldBackfillLookupVindexClient) Recv() (*vtctldata.BackfillLookupVindexResponse, error) {
+	m := new(vtctldata.BackfillLookupVindexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ExternalizeVindex(ctx context.Context, in *vtctldata.ExternalizeVindexRequest, opts ...grpc.CallOption) (*vtctldata.ExternalizeVindexResponse, error) {
+	out := new(vtctldata.ExternalizeVindexResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExternalizeVindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Materialize(ctx context.Context, in *vtctldata.MaterializeRequest, opts ...grpc.CallOption) (Vtctld_MaterializeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[7], "/vtctlservice.Vtctld/Materialize", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldMaterializeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_MaterializeClient interface {
+	Recv() (*vtctldata.MaterializeResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldMaterializeClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldMaterializeClient) Recv() (*vtctldata.MaterializeResponse, error) {
+	m := new(vtctldata.MaterializeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) SplitClone(ctx context.Context, in *vtctldata.SplitCloneRequest, opts ...grpc.CallOption) (*vtctldata.SplitCloneResponse, error) {
+	out := new(vtctldata.SplitCloneResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SplitClone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VerticalSplitClone(ctx context.Context, in *vtctldata.VerticalSplitCloneRequest, opts ...grpc.CallOption) (*vtctldata.VerticalSplitCloneResponse, error) {
+	out := new(vtctldata.VerticalSplitCloneResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VerticalSplitClone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (Vtctld_VDiffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[8], "/vtctlservice.Vtctld/VDiff", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldVDiffClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_VDiffClient interface {
+	Recv() (*vtctldata.VDiffResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldVDiffClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldVDiffClient) Recv() (*vtctldata.VDiffResponse, error) {
+	m := new(vtctldata.VDiffResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) PauseWorkflow(ctx context.Context, in *vtctldata.PauseWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.PauseWorkflowResponse, error) {
+	out := new(vtctldata.PauseWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PauseWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ResumeWorkflow(ctx context.Context, in *vtctldata.ResumeWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.ResumeWorkflowResponse, error) {
+	out := new(vtctldata.ResumeWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ResumeWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CopyProgress(ctx context.Context, in *vtctldata.CopyProgressRequest, opts ...grpc.CallOption) (*vtctldata.CopyProgressResponse, error) {
+	out := new(vtctldata.CopyProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CopyProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) MigrateServedTypes(ctx context.Context, in *vtctldata.MigrateServedTypesRequest, opts ...grpc.CallOption) (Vtctld_MigrateServedTypesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[9], "/vtctlservice.Vtctld/MigrateServedTypes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldMigrateServedTypesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_MigrateServedTypesClient interface {
+	Recv() (*vtctldata.MigrateServedTypesResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldMigrateServedTypesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldMigrateServedTypesClient) Recv() (*vtctldata.MigrateServedTypesResponse, error) {
+	m := new(vtctldata.MigrateServedTypesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) MigrateServedFrom(ctx context.Context, in *vtctldata.MigrateServedFromRequest, opts ...grpc.CallOption) (Vtctld_MigrateServedFromClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[10], "/vtctlservice.Vtctld/MigrateServedFrom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldMigrateServedFromClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_MigrateServedFromClient interface {
+	Recv() (*vtctldata.MigrateServedFromResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldMigrateServedFromClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldMigrateServedFromClient) Recv() (*vtctldata.MigrateServedFromResponse, error) {
+	m := new(vtctldata.MigrateServedFromResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) SwitchReads(ctx context.Context, in *vtctldata.SwitchReadsRequest, opts ...grpc.CallOption) (Vtctld_SwitchReadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[11], "/vtctlservice.Vtctld/SwitchReads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldSwitchReadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_SwitchReadsClient interface {
+	Recv() (*vtctldata.SwitchReadsResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldSwitchReadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldSwitchReadsClient) Recv() (*vtctldata.SwitchReadsResponse, error) {
+	m := new(vtctldata.SwitchReadsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) SwitchWrites(ctx context.Context, in *vtctldata.SwitchWritesRequest, opts ...grpc.CallOption) (Vtctld_SwitchWritesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[12], "/vtctlservice.Vtctld/SwitchWrites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldSwitchWritesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_SwitchWritesClient interface {
+	Recv() (*vtctldata.SwitchWritesResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldSwitchWritesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldSwitchWritesClient) Recv() (*vtctldata.SwitchWritesResponse, error) {
+	m := new(vtctldata.SwitchWritesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) CancelResharding(ctx context.Context, in *vtctldata.CancelReshardingRequest, opts ...grpc.CallOption) (*vtctldata.CancelReshardingResponse, error) {
+	out := new(vtctldata.CancelReshardingResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CancelResharding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ShowResharding(ctx context.Context, in *vtctldata.ShowReshardingRequest, opts ...grpc.CallOption) (Vtctld_ShowReshardingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[13], "/vtctlservice.Vtctld/ShowResharding", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldShowReshardingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ShowReshardingClient interface {
+	Recv() (*vtctldata.ShowReshardingResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldShowReshardingClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldShowReshardingClient) Recv() (*vtctldata.ShowReshardingResponse, error) {
+	m := new(vtctldata.ShowReshardingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) WaitForDrain(ctx context.Context, in *vtctldata.WaitForDrainRequest, opts ...grpc.CallOption) (Vtctld_WaitForDrainClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[14], "/vtctlservice.Vtctld/WaitForDrain", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldWaitForDrainClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_WaitForDrainClient interface {
+	Recv() (*vtctldata.WaitForDrainResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldWaitForDrainClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldWaitForDrainClient) Recv() (*vtctldata.WaitForDrainResponse, error) {
+	m := new(vtctldata.WaitForDrainResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) StartReshardWorkflow(ctx context.Context, in *vtctldata.StartReshardWorkflowRequest, opts ...grpc.CallOption) (Vtctld_StartReshardWorkflowClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[15], "/vtctlservice.Vtctld/StartReshardWorkflow", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldStartReshardWorkflowClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_StartReshardWorkflowClient interface {
+	Recv() (*vtctldata.StartReshardWorkflowResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldStartReshardWorkflowClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldStartReshardWorkflowClient) Recv() (*vtctldata.StartReshardWorkflowResponse, error) {
+	m := new(vtctldata.StartReshardWorkflowResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) GetReshardWorkflow(ctx context.Context, in *vtctldata.GetReshardWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.GetReshardWorkflowResponse, error) {
+	out := new(vtctldata.GetReshardWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetReshardWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ReshardWorkflowSwitchReads(ctx context.Context, in *vtctldata.ReshardWorkflowSwitchReadsRequest, opts ...grpc.CallOption) (Vtctld_ReshardWorkflowSwitchReadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[16], "/vtctlservice.Vtctld/ReshardWorkflowSwitchReads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldReshardWorkflowSwitchReadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ReshardWorkflowSwitchReadsClient interface {
+	Recv() (*vtctldata.ReshardWorkflowSwitchReadsResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldReshardWorkflowSwitchReadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldReshardWorkflowSwitchReadsClient) Recv() (*vtctldata.ReshardWorkflowSwitchReadsResponse, error) {
+	m := new(vtctldata.ReshardWorkflowSwitchReadsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ReshardWorkflowSwitchWrites(ctx context.Context, in *vtctldata.ReshardWorkflowSwitchWritesRequest, opts ...grpc.CallOption) (Vtctld_ReshardWorkflowSwitchWritesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[17], "/vtctlservice.Vtctld/ReshardWorkflowSwitchWrites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldReshardWorkflowSwitchWritesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ReshardWorkflowSwitchWritesClient interface {
+	Recv() (*vtctldata.ReshardWorkflowSwitchWritesResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldReshardWorkflowSwitchWritesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldReshardWorkflowSwitchWritesClient) Recv() (*vtctldata.ReshardWorkflowSwitchWritesResponse, error) {
+	m := new(vtctldata.ReshardWorkflowSwitchWritesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) GetSchema(ctx context.Context, in *vtctldata.GetSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaResponse, error) {
+	out := new(vtctldata.GetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ReloadSchema(ctx context.Context, in *vtctldata.ReloadSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ReloadSchemaResponse, error) {
+	out := new(vtctldata.ReloadSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReloadSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ReloadSchemaShard(ctx context.Context, in *vtctldata.ReloadSchemaShardRequest, opts ...grpc.CallOption) (Vtctld_ReloadSchemaShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[18], "/vtctlservice.Vtctld/ReloadSchemaShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldReloadSchemaShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ReloadSchemaShardClient interface {
+	Recv() (*vtctldata.ReloadSchemaShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldReloadSchemaShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldReloadSchemaShardClient) Recv() (*vtctldata.ReloadSchemaShardResponse, error) {
+	m := new(vtctldata.ReloadSchemaShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ReloadSchemaKeyspace(ctx context.Context, in *vtctldata.ReloadSchemaKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ReloadSchemaKeyspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[19], "/vtctlservice.Vtctld/ReloadSchemaKeyspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldReloadSchemaKeyspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ReloadSchemaKeyspaceClient interface {
+	Recv() (*vtctldata.ReloadSchemaKeyspaceResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldReloadSchemaKeyspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldReloadSchemaKeyspaceClient) Recv() (*vtctldata.ReloadSchemaKeyspaceResponse, error) {
+	m := new(vtctldata.ReloadSchemaKeyspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ValidateSchemaShard(ctx context.Context, in *vtctldata.ValidateSchemaShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateSchemaShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[20], "/vtctlservice.Vtctld/ValidateSchemaShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateSchemaShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateSchemaShardClient interface {
+	Recv() (*vtctldata.ValidateSchemaShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateSchemaShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateSchemaShardClient) Recv() (*vtctldata.ValidateSchemaShardResponse, error) {
+	m := new(vtctldata.ValidateSchemaShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ValidateSchemaKeyspace(ctx context.Context, in *vtctldata.ValidateSchemaKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateSchemaKeyspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[21], "/vtctlservice.Vtctld/ValidateSchemaKeyspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateSchemaKeyspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateSchemaKeyspaceClient interface {
+	Recv() (*vtctldata.ValidateSchemaKeyspaceResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateSchemaKeyspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateSchemaKeyspaceClient) Recv() (*vtctldata.ValidateSchemaKeyspaceResponse, error) {
+	m := new(vtctldata.ValidateSchemaKeyspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) GetSchemaDrift(ctx context.Context, in *vtctldata.GetSchemaDriftRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaDriftResponse, error) {
+	out := new(vtctldata.GetSchemaDriftResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchemaDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplySchema(ctx context.Context, in *vtctldata.ApplySchemaRequest, opts ...grpc.CallOption) (Vtctld_ApplySchemaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[22], "/vtctlservice.Vtctld/ApplySchema", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldApplySchemaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ApplySchemaClient interface {
+	Recv() (*vtctldata.ApplySchemaResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldApplySchemaClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldApplySchemaClient) Recv() (*vtctldata.ApplySchemaResponse, error) {
+	m := new(vtctldata.ApplySchemaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ApplyDeclarativeSchema(ctx context.Context, in *vtctldata.ApplyDeclarativeSchemaRequest, opts ...grpc.CallOption) (Vtctld_ApplyDeclarativeSchemaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[23], "/vtctlservice.Vtctld/ApplyDeclarativeSchema", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldApplyDeclarativeSchemaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ApplyDeclarativeSchemaClient interface {
+	Recv() (*vtctldata.ApplyDeclarativeSchemaResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldApplyDeclarativeSchemaClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldApplyDeclarativeSchemaClient) Recv() (*vtctldata.ApplyDeclarativeSchemaResponse, error) {
+	m := new(vtctldata.ApplyDeclarativeSchemaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) SubmitOnlineDDL(ctx context.Context, in *vtctldata.SubmitOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.SubmitOnlineDDLResponse, error) {
+	out := new(vtctldata.SubmitOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SubmitOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetOnlineDDLMigrations(ctx context.Context, in *vtctldata.GetOnlineDDLMigrationsRequest, opts ...grpc.CallOption) (*vtctldata.GetOnlineDDLMigrationsResponse, error) {
+	out := new(vtctldata.GetOnlineDDLMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetOnlineDDLMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CancelOnlineDDL(ctx context.Context, in *vtctldata.CancelOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.CancelOnlineDDLResponse, error) {
+	out := new(vtctldata.CancelOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CancelOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RetryOnlineDDL(ctx context.Context, in *vtctldata.RetryOnlineDDLRequest, opts ...grpc.CallOption) (*vtctldata.RetryOnlineDDLResponse, error) {
+	out := new(vtctldata.RetryOnlineDDLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RetryOnlineDDL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CopySchemaShard(ctx context.Context, in *vtctldata.CopySchemaShardRequest, opts ...grpc.CallOption) (Vtctld_CopySchemaShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[24], "/vtctlservice.Vtctld/CopySchemaShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldCopySchemaShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_CopySchemaShardClient interface {
+	Recv() (*vtctldata.CopySchemaShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldCopySchemaShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldCopySchemaShardClient) Recv() (*vtctldata.CopySchemaShardResponse, error) {
+	m := new(vtctldata.CopySchemaShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ValidateVersionShard(ctx context.Context, in *vtctldata.ValidateVersionShardRequest, opts ...grpc.CallOption) (Vtctld_ValidateVersionShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[25], "/vtctlservice.Vtctld/ValidateVersionShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateVersionShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateVersionShardClient interface {
+	Recv() (*vtctldata.ValidateVersionShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateVersionShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateVersionShardClient) Recv() (*vtctldata.ValidateVersionShardResponse, error) {
+	m := new(vtctldata.ValidateVersionShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ValidateVersionKeyspace(ctx context.Context, in *vtctldata.ValidateVersionKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidateVersionKeyspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[26], "/vtctlservice.Vtctld/ValidateVersionKeyspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateVersionKeyspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateVersionKeyspaceClient interface {
+	Recv() (*vtctldata.ValidateVersionKeyspaceResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateVersionKeyspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateVersionKeyspaceClient) Recv() (*vtctldata.ValidateVersionKeyspaceResponse, error) {
+	m := new(vtctldata.ValidateVersionKeyspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) GetPermissions(ctx context.Context, in *vtctldata.GetPermissionsRequest, opts ...grpc.CallOption) (*vtctldata.GetPermissionsResponse, error) {
+	out := new(vtctldata.GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidatePermissionsShard(ctx context.Context, in *vtctldata.ValidatePermissionsShardRequest, opts ...grpc.CallOption) (Vtctld_ValidatePermissionsShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[27], "/vtctlservice.Vtctld/ValidatePermissionsShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidatePermissionsShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidatePermissionsShardClient interface {
+	Recv() (*vtctldata.ValidatePermissionsShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidatePermissionsShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidatePermissionsShardClient) Recv() (*vtctldata.ValidatePermissionsShardResponse, error) {
+	m := new(vtctldata.ValidatePermissionsShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ValidatePermissionsKeyspace(ctx context.Context, in *vtctldata.ValidatePermissionsKeyspaceRequest, opts ...grpc.CallOption) (Vtctld_ValidatePermissionsKeyspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[28], "/vtctlservice.Vtctld/ValidatePermissionsKeyspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidatePermissionsKeyspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidatePermissionsKeyspaceClient interface {
+	Recv() (*vtctldata.ValidatePermissionsKeyspaceResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidatePermissionsKeyspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidatePermissionsKeyspaceClient) Recv() (*vtctldata.ValidatePermissionsKeyspaceResponse, error) {
+	m := new(vtctldata.ValidatePermissionsKeyspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error) {
+	out := new(vtctldata.GetVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error) {
+	out := new(vtctldata.ApplyVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error) {
+	out := new(vtctldata.GetRoutingRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRoutingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error) {
+	out := new(vtctldata.ApplyRoutingRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyRoutingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RebuildVSchemaGraph(ctx context.Context, in *vtctldata.RebuildVSchemaGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildVSchemaGraphResponse, error) {
+	out := new(vtctldata.RebuildVSchemaGraphResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RebuildVSchemaGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSrvKeyspaceNames(ctx context.Context, in *vtctldata.GetSrvKeyspaceNamesRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvKeyspaceNamesResponse, error) {
+	out := new(vtctldata.GetSrvKeyspaceNamesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSrvKeyspaceNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSrvKeyspace(ctx context.Context, in *vtctldata.GetSrvKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvKeyspaceResponse, error) {
+	out := new(vtctldata.GetSrvKeyspaceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSrvKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSrvVSchema(ctx context.Context, in *vtctldata.GetSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvVSchemaResponse, error) {
+	out := new(vtctldata.GetSrvVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSrvVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteSrvVSchema(ctx context.Context, in *vtctldata.DeleteSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.DeleteSrvVSchemaResponse, error) {
+	out := new(vtctldata.DeleteSrvVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteSrvVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetShardReplication(ctx context.Context, in *vtctldata.GetShardReplicationRequest, opts ...grpc.CallOption) (*vtctldata.GetShardReplicationResponse, error) {
+	out := new(vtctldata.GetShardReplicationResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetShardReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (Vtctld_ValidateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[29], "/vtctlservice.Vtctld/Validate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldValidateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_ValidateClient interface {
+	Recv() (*vtctldata.ValidateResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldValidateClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldValidateClient) Recv() (*vtctldata.ValidateResponse, error) {
+	m := new(vtctldata.ValidateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) InitShardMaster(ctx context.Context, in *vtctldata.InitShardMasterRequest, opts ...grpc.CallOption) (Vtctld_InitShardMasterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[30], "/vtctlservice.Vtctld/InitShardMaster", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldInitShardMasterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_InitShardMasterClient interface {
+	Recv() (*vtctldata.InitShardMasterResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldInitShardMasterClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldInitShardMasterClient) Recv() (*vtctldata.InitShardMasterResponse, error) {
+	m := new(vtctldata.InitShardMasterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (Vtctld_PlannedReparentShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[31], "/vtctlservice.Vtctld/PlannedReparentShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldPlannedReparentShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_PlannedReparentShardClient interface {
+	Recv() (*vtctldata.PlannedReparentShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldPlannedReparentShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldPlannedReparentShardClient) Recv() (*vtctldata.PlannedReparentShardResponse, error) {
+	m := new(vtctldata.PlannedReparentShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (Vtctld_EmergencyReparentShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[32], "/vtctlservice.Vtctld/EmergencyReparentShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldEmergencyReparentShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_EmergencyReparentShardClient interface {
+	Recv() (*vtctldata.EmergencyReparentShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldEmergencyReparentShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldEmergencyReparentShardClient) Recv() (*vtctldata.EmergencyReparentShardResponse, error) {
+	m := new(vtctldata.EmergencyReparentShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error) {
+	out := new(vtctldata.ReparentTabletResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReparentTablet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldata.TabletExternallyReparentedResponse, error) {
+	out := new(vtctldata.TabletExternallyReparentedResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/TabletExternallyReparented", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) BackupShard(ctx context.Context, in *vtctldata.BackupShardRequest, opts ...grpc.CallOption) (Vtctld_BackupShardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[33], "/vtctlservice.Vtctld/BackupShard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldBackupShardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_BackupShardClient interface {
+	Recv() (*vtctldata.BackupShardResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldBackupShardClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldBackupShardClient) Recv() (*vtctldata.BackupShardResponse, error) {
+	m := new(vtctldata.BackupShardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ListBackups(ctx context.Context, in *vtctldata.ListBackupsRequest, opts ...grpc.CallOption) (*vtctldata.ListBackupsResponse, error) {
+	out := new(vtctldata.ListBackupsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RemoveBackup(ctx context.Context, in *vtctldata.RemoveBackupRequest, opts ...grpc.CallOption) (*vtctldata.RemoveBackupResponse, error) {
+	out := new(vtctldata.RemoveBackupResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) PruneBackups(ctx context.Context, in *vtctldata.PruneBackupsRequest, opts ...grpc.CallOption) (*vtctldata.PruneBackupsResponse, error) {
+	out := new(vtctldata.PruneBackupsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PruneBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VerifyBackup(ctx context.Context, in *vtctldata.VerifyBackupRequest, opts ...grpc.CallOption) (Vtctld_VerifyBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[34], "/vtctlservice.Vtctld/VerifyBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldVerifyBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_VerifyBackupClient interface {
+	Recv() (*vtctldata.VerifyBackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldVerifyBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldVerifyBackupClient) Recv() (*vtctldata.VerifyBackupResponse, error) {
+	m := new(vtctldata.VerifyBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[35], "/vtctlservice.Vtctld/RestoreFromBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldRestoreFromBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_RestoreFromBackupClient interface {
+	Recv() (*vtctldata.RestoreFromBackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldRestoreFromBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldRestoreFromBackupClient) Recv() (*vtctldata.RestoreFromBackupResponse, error) {
+	m := new(vtctldata.RestoreFromBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) AddCellInfo(ctx context.Context, in *vtctldata.AddCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.AddCellInfoResponse, error) {
+	out := new(vtctldata.AddCellInfoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/AddCellInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateCellInfo(ctx context.Context, in *vtctldata.UpdateCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellInfoResponse, error) {
+	out := new(vtctldata.UpdateCellInfoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateCellInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteCellInfo(ctx context.Context, in *vtctldata.DeleteCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.DeleteCellInfoResponse, error) {
+	out := new(vtctldata.DeleteCellInfoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteCellInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetCellInfoNames(ctx context.Context, in *vtctldata.GetCellInfoNamesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellInfoNamesResponse, error) {
+	out := new(vtctldata.GetCellInfoNamesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetCellInfoNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetCellInfo(ctx context.Context, in *vtctldata.GetCellInfoRequest, opts ...grpc.CallOption) (*vtctldata.GetCellInfoResponse, error) {
+	out := new(vtctldata.GetCellInfoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetCellInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error) {
+	out := new(vtctldata.AddCellsAliasResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/AddCellsAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error) {
+	out := new(vtctldata.UpdateCellsAliasResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateCellsAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteCellsAlias(ctx context.Context, in *vtctldata.DeleteCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.DeleteCellsAliasResponse, error) {
+	out := new(vtctldata.DeleteCellsAliasResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteCellsAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetCellsAliases(ctx context.Context, in *vtctldata.GetCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellsAliasesResponse, error) {
+	out := new(vtctldata.GetCellsAliasesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetCellsAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidateCellsAliases(ctx context.Context, in *vtctldata.ValidateCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.ValidateCellsAliasesResponse, error) {
+	out := new(vtctldata.ValidateCellsAliasesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ValidateCellsAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetServingCells(ctx context.Context, in *vtctldata.GetServingCellsRequest, opts ...grpc.CallOption) (*vtctldata.GetServingCellsResponse, error) {
+	out := new(vtctldata.GetServingCellsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetServingCells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ThrottlerMaxRates(ctx context.Context, in *vtctldata.ThrottlerMaxRatesRequest, opts ...grpc.CallOption) (*vtctldata.ThrottlerMaxRatesResponse, error) {
+	out := new(vtctldata.ThrottlerMaxRatesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ThrottlerMaxRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ThrottlerSetMaxRate(ctx context.Context, in *vtctldata.ThrottlerSetMaxRateRequest, opts ...grpc.CallOption) (*vtctldata.ThrottlerSetMaxRateResponse, error) {
+	out := new(vtctldata.ThrottlerSetMaxRateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ThrottlerSetMaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetThrottlerConfiguration(ctx context.Context, in *vtctldata.GetThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.GetThrottlerConfigurationResponse, error) {
+	out := new(vtctldata.GetThrottlerConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetThrottlerConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateThrottlerConfiguration(ctx context.Context, in *vtctldata.UpdateThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigurationResponse, error) {
+	out := new(vtctldata.UpdateThrottlerConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateThrottlerConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ResetThrottlerConfiguration(ctx context.Context, in *vtctldata.ResetThrottlerConfigurationRequest, opts ...grpc.CallOption) (*vtctldata.ResetThrottlerConfigurationResponse, error) {
+	out := new(vtctldata.ResetThrottlerConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ResetThrottlerConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) TopoCat(ctx context.Context, in *vtctldata.TopoCatRequest, opts ...grpc.CallOption) (*vtctldata.TopoCatResponse, error) {
+	out := new(vtctldata.TopoCatResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/TopoCat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WriteTopoFile(ctx context.Context, in *vtctldata.WriteTopoFileRequest, opts ...grpc.CallOption) (*vtctldata.WriteTopoFileResponse, error) {
+	out := new(vtctldata.WriteTopoFileResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WriteTopoFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ExportTopo(ctx context.Context, in *vtctldata.ExportTopoRequest, opts ...grpc.CallOption) (*vtctldata.ExportTopoResponse, error) {
+	out := new(vtctldata.ExportTopoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExportTopo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ImportTopo(ctx context.Context, in *vtctldata.ImportTopoRequest, opts ...grpc.CallOption) (*vtctldata.ImportTopoResponse, error) {
+	out := new(vtctldata.ImportTopoResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ImportTopo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetTopoAuditLog(ctx context.Context, in *vtctldata.GetTopoAuditLogRequest, opts ...grpc.CallOption) (*vtctldata.GetTopoAuditLogResponse, error) {
+	out := new(vtctldata.GetTopoAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTopoAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowCreate(ctx context.Context, in *vtctldata.WorkflowCreateRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCreateResponse, error) {
+	out := new(vtctldata.WorkflowCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowStart(ctx context.Context, in *vtctldata.WorkflowStartRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowStartResponse, error) {
+	out := new(vtctldata.WorkflowStartResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowStop(ctx context.Context, in *vtctldata.WorkflowStopRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowStopResponse, error) {
+	out := new(vtctldata.WorkflowStopResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowDelete(ctx context.Context, in *vtctldata.WorkflowDeleteRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowDeleteResponse, error) {
+	out := new(vtctldata.WorkflowDeleteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowWait(ctx context.Context, in *vtctldata.WorkflowWaitRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowWaitResponse, error) {
+	out := new(vtctldata.WorkflowWaitResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowWait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowTree(ctx context.Context, in *vtctldata.WorkflowTreeRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowTreeResponse, error) {
+	out := new(vtctldata.WorkflowTreeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowAction(ctx context.Context, in *vtctldata.WorkflowActionRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowActionResponse, error) {
+	out := new(vtctldata.WorkflowActionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VtctldServer is the server API for Vtctld service.
+type VtctldServer interface {
+	GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error)
+	GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error)
+	FindAllShardsInKeyspace(context.Context, *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
+	GetShard(context.Context, *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error)
+	GetTablet(context.Context, *vtctldata.GetTabletRequest) (*vtctldata.GetTabletResponse, error)
+	GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error)
+	Backup(*vtctldata.BackupRequest, Vtctld_BackupServer) error
+	Reshard(*vtctldata.ReshardRequest, Vtctld_ReshardServer) error
+	// Tablets.
+	InitTablet(context.Context, *vtctldata.InitTabletRequest) (*vtctldata.InitTabletResponse, error)
+	UpdateTabletAddrs(context.Context, *vtctldata.UpdateTabletAddrsRequest) (*vtctldata.UpdateTabletAddrsResponse, error)
+	DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error)
+	SetReadOnly(context.Context, *vtctldata.SetReadOnlyRequest) (*vtctldata.SetReadOnlyResponse, error)
+	SetReadWrite(context.Context, *vtctldata.SetReadWriteRequest) (*vtctldata.SetReadWriteResponse, error)
+	StartSlave(context.Context, *vtctldata.StartSlaveRequest) (*vtctldata.StartSlaveResponse, error)
+	StopSlave(context.Context, *vtctldata.StopSlaveRequest) (*vtctldata.StopSlaveResponse, error)
+	ChangeSlaveType(context.Context, *vtctldata.ChangeSlaveTypeRequest) (*vtctldata.ChangeSlaveTypeResponse, error)
+	Ping(context.Context, *vtctldata.PingRequest) (*vtctldata.PingResponse, error)
+	RefreshState(context.Context, *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error)
+	RefreshStateByShard(context.Context, *vtctldata.RefreshStateByShardRequest) (*vtctldata.RefreshStateByShardResponse, error)
+	RunHealthCheck(context.Context, *vtctldata.RunHealthCheckRequest) (*vtctldata.RunHealthCheckResponse, error)
+	IgnoreHealthError(context.Context, *vtctldata.IgnoreHealthErrorRequest) (*vtctldata.IgnoreHealthErrorResponse, error)
+	ResizeTxPool(context.Context, *vtctldata.ResizeTxPoolRequest) (*vtctldata.ResizeTxPoolResponse, error)
+	Sleep(context.Context, *vtctldata.SleepRequest) (*vtctldata.SleepResponse, error)
+	ExecuteHook(context.Context, *vtctldata.ExecuteHookRequest) (*vtctldata.ExecuteHookResponse, error)
+	ExecuteFetchAsApp(context.Context, *vtctldata.ExecuteFetchAsAppRequest) (*vtctldata.ExecuteFetchAsAppResponse, error)
+	ExecuteFetchAsDba(context.Context, *vtctldata.ExecuteFetchAsDbaRequest) (*vtctldata.ExecuteFetchAsDbaResponse, error)
+	VReplicationExec(context.Context, *vtctldata.VReplicationExecRequest) (*vtctldata.VReplicationExecResponse, error)
+	// Shards.
+	CreateShard(context.Context, *vtctldata.CreateShardRequest) (*vtctldata.CreateShardResponse, error)
+	ValidateShard(*vtctldata.ValidateShardRequest, Vtctld_ValidateShardServer) error
+	ShardReplicationPositions(context.Context, *vtctldata.ShardReplicationPositionsRequest) (*vtctldata.ShardReplicationPositionsResponse, error)
+	SetShardIsMasterServing(context.Context, *vtctldata.SetShardIsMasterServingRequest) (*vtctldata.SetShardIsMasterServingResponse, error)
+	SetShardTabletControl(context.Context, *vtctldata.SetShardTabletControlRequest) (*vtctldata.SetShardTabletControlResponse, error)
+	UpdateSrvKeyspacePartition(context.Context, *vtctldata.UpdateSrvKeyspacePartitionRequest) (*vtctldata.UpdateSrvKeyspacePartitionResponse, error)
+	SourceShardDelete(context.Context, *vtctldata.SourceShardDeleteRequest) (*vtctldata.SourceShardDeleteResponse, error)
+	SourceShardAdd(context.Context, *vtctldata.SourceShardAddRequest) (*vtctldata.SourceShardAddResponse, error)
+	ShardReplicationAdd(context.Context, *vtctldata.ShardReplicationAddRequest) (*vtctldata.ShardReplicationAddResponse, error)
+	ShardReplicationRemove(context.Context, *vtctldata.ShardReplicationRemoveRequest) (*vtctldata.ShardReplicationRemoveResponse, error)
+	ShardReplicationFix(context.Context, *vtctldata.ShardReplicationFixRequest) (*vtctldata.ShardReplicationFixResponse, error)
+	WaitForFilteredReplication(*vtctldata.WaitForFilteredReplicationRequest, Vtctld_WaitForFilteredReplicationServer) error
+	RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error)
+	DeleteShards(context.Context, *vtctldata.DeleteShardsRequest) (*vtctldata.DeleteShardsResponse, error)
+	// Keyspaces.
+	CreateKeyspace(context.Context, *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error)
+	DeleteKeyspace(context.Context, *vtctldata.DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error)
+	RemoveKeyspaceCell(context.Context, *vtctldata.RemoveKeyspaceCellRequest) (*vtctldata.RemoveKeyspaceCellResponse, error)
+	SetKeyspaceShardingInfo(context.Context, *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	SetKeyspaceDDLStrategy(context.Context, *vtctldata.SetKeyspaceDDLStrategyRequest) (*vtctldata.SetKeyspaceDDLStrategyResponse, error)
+	SetKeyspaceServedFrom(context.Context, *vtctldata.SetKeyspaceServedFromRequest) (*vtctldata.SetKeyspaceServedFromResponse, error)
+	RebuildKeyspaceGraph(context.Context, *vtctldata.RebuildKeyspaceGraphRequest) (*vtctldata.RebuildKeyspaceGraphResponse, error)
+	ValidateKeyspace(*vtctldata.ValidateKeyspaceRequest, Vtctld_ValidateKeyspaceServer) error
+	// Workflows.
+	MoveTables(*vtctldata.MoveTablesRequest, Vtctld_MoveTablesServer) error
+	CreateLookupVindex(context.Context, *vtctldata.CreateLookupVindexRequest) (*vtctldata.CreateLookupVindexResponse, error)
+	BackfillLookupVindex(*vtctldata.BackfillLookupVindexRequest, Vtctld_BackfillLookupVindexServer) error
+	ExternalizeVindex(context.Context, *vtctldata.ExternalizeVindexRequest) (*vtctldata.ExternalizeVindexResponse, error)
+	Materialize(*vtctldata.MaterializeRequest, Vtctld_MaterializeServer) error
+	SplitClone(context.Context, *vtctldata.SplitCloneRequest) (*vtctldata.SplitCloneResponse, error)
+	VerticalSplitClone(context.Context, *vtctldata.VerticalSplitCloneRequest) (*vtctldata.VerticalSplitCloneResponse, error)
+	VDiff(*vtctldata.VDiffRequest, Vtctld_VDiffServer) error
+	PauseWorkflow(context.Context, *vtctldata.PauseWorkflowRequest) (*vtctldata.PauseWorkflowResponse, error)
+	ResumeWorkflow(context.Context, *vtctldata.ResumeWorkflowRequest) (*vtctldata.ResumeWorkflowResponse, error)
+	CopyProgress(context.Context, *vtctldata.CopyProgressRequest) (*vtctldata.CopyProgressResponse, error)
+	MigrateServedTypes(*vtctldata.MigrateServedTypesRequest, Vtctld_MigrateServedTypesServer) error
+	MigrateServedFrom(*vtctldata.MigrateServedFromRequest, Vtctld_MigrateServedFromServer) error
+	SwitchReads(*vtctldata.SwitchReadsRequest, Vtctld_SwitchReadsServer) error
+	SwitchWrites(*vtctldata.SwitchWritesRequest, Vtctld_SwitchWritesServer) error
+	CancelResharding(context.Context, *vtctldata.CancelReshardingRequest) (*vtctldata.CancelReshardingResponse, error)
+	ShowResharding(*vtctldata.ShowReshardingRequest, Vtctld_ShowReshardingServer) error
+	WaitForDrain(*vtctldata.WaitForDrainRequest, Vtctld_WaitForDrainServer) error
+	StartReshardWorkflow(*vtctldata.StartReshardWorkflowRequest, Vtctld_StartReshardWorkflowServer) error
+	GetReshardWorkflow(context.Context, *vtctldata.GetReshardWorkflowRequest) (*vtctldata.GetReshardWorkflowResponse, error)
+	ReshardWorkflowSwitchReads(*vtctldata.ReshardWorkflowSwitchReadsRequest, Vtctld_ReshardWorkflowSwitchReadsServer) error
+	ReshardWorkflowSwitchWrites(*vtctldata.ReshardWorkflowSwitchWritesRequest, Vtctld_ReshardWorkflowSwitchWritesServer) error
+	// Schema, version and permissions.
+	GetSchema(context.Context, *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error)
+	ReloadSchema(context.Context, *vtctldata.ReloadSchemaRequest) (*vtctldata.ReloadSchemaResponse, error)
+	ReloadSchemaShard(*vtctldata.ReloadSchemaShardRequest, Vtctld_ReloadSchemaShardServer) error
+	ReloadSchemaKeyspace(*vtctldata.ReloadSchemaKeyspaceRequest, Vtctld_ReloadSchemaKeyspaceServer) error
+	ValidateSchemaShard(*vtctldata.ValidateSchemaShardRequest, Vtctld_ValidateSchemaShardServer) error
+	ValidateSchemaKeyspace(*vtctldata.ValidateSchemaKeyspaceRequest, Vtctld_ValidateSchemaKeyspaceServer) error
+	GetSchemaDrift(context.Context, *vtctldata.GetSchemaDriftRequest) (*vtctldata.GetSchemaDriftResponse, error)
+	ApplySchema(*vtctldata.ApplySchemaRequest, Vtctld_ApplySchemaServer) error
+	ApplyDeclarativeSchema(*vtctldata.ApplyDeclarativeSchemaRequest, Vtctld_ApplyDeclarativeSchemaServer) error
+	SubmitOnlineDDL(context.Context, *vtctldata.SubmitOnlineDDLRequest) (*vtctldata.SubmitOnlineDDLResponse, error)
+	GetOnlineDDLMigrations(context.Context, *vtctldata.GetOnlineDDLMigrationsRequest) (*vtctldata.GetOnlineDDLMigrationsResponse, error)
+	CancelOnlineDDL(context.Context, *vtctldata.CancelOnlineDDLRequest) (*vtctldata.CancelOnlineDDLResponse, error)
+	RetryOnlineDDL(context.Context, *vtctldata.RetryOnlineDDLRequest) (*vtctldata.RetryOnlineDDLResponse, error)
+	CopySchemaShard(*vtctldata.CopySchemaShardRequest, Vtctld_CopySchemaShardServer) error
+	ValidateVersionShard(*vtctldata.ValidateVersionShardRequest, Vtctld_ValidateVersionShardServer) error
+	ValidateVersionKeyspace(*vtctldata.ValidateVersionKeyspaceRequest, Vtctld_ValidateVersionKeyspaceServer) error
+	GetPermissions(context.Context, *vtctldata.GetPermissionsRequest) (*vtctldata.GetPermissionsResponse, error)
+	ValidatePermissionsShard(*vtctldata.ValidatePermissionsShardRequest, Vtctld_ValidatePermissionsShardServer) error
+	ValidatePermissionsKeyspace(*vtctldata.ValidatePermissionsKeyspaceRequest, Vtctld_ValidatePermissionsKeyspaceServer) error
+	// VSchema, serving graph and validation.
+	GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error)
+	ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error)
+	GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error)
+	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
+	RebuildVSchemaGraph(context.Context, *vtctldata.RebuildVSchemaGraphRequest) (*vtctldata.RebuildVSchemaGraphResponse, error)
+	GetSrvKeyspaceNames(context.Context, *vtctldata.GetSrvKeyspaceNamesRequest) (*vtctldata.GetSrvKeyspaceNamesResponse, error)
+	GetSrvKeyspace(context.Context, *vtctldata.GetSrvKeyspaceRequest) (*vtctldata.GetSrvKeyspaceResponse, error)
+	GetSrvVSchema(context.Context, *vtctldata.GetSrvVSchemaRequest) (*vtctldata.GetSrvVSchemaResponse, error)
+	DeleteSrvVSchema(context.Context, *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error)
+	GetShardReplication(context.Context, *vtctldata.GetShardReplicationRequest) (*vtctldata.GetShardReplicationResponse, error)
+	Validate(*vtctldata.ValidateRequest, Vtctld_ValidateServer) error
+	// Reparenting.
+	InitShardMaster(*vtctldata.InitShardMasterRequest, Vtctld_InitShardMasterServer) error
+	PlannedReparentShard(*vtctldata.PlannedReparentShardRequest, Vtctld_PlannedReparentShardServer) error
+	EmergencyReparentShard(*vtctldata.EmergencyReparentShardRequest, Vtctld_EmergencyReparentShardServer) error
+	ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error)
+	TabletExternallyReparented(context.Context, *vtctldata.TabletExternallyReparentedRequest) (*vtctldata.TabletExternallyReparentedResponse, error)
+	// Backups.
+	BackupShard(*vtctldata.BackupShardRequest, Vtctld_BackupShardServer) error
+	ListBackups(context.Context, *vtctldata.ListBackupsRequest) (*vtctldata.ListBackupsResponse, error)
+	RemoveBackup(context.Context, *vtctldata.RemoveBackupRequest) (*vtctldata.RemoveBackupResponse, error)
+	PruneBackups(context.Context, *vtctldata.PruneBackupsRequest) (*vtctldata.PruneBackupsResponse, error)
+	VerifyBackup(*vtctldata.VerifyBackupRequest, Vtctld_VerifyBackupServer) error
+	RestoreFromBackup(*vtctldata.RestoreFromBackupRequest, Vtctld_RestoreFromBackupServer) error
+	// Cells and cells aliases.
+	AddCellInfo(context.Context, *vtctldata.AddCellInfoRequest) (*vtctldata.AddCellInfoResponse, error)
+	UpdateCellInfo(context.Context, *vtctldata.UpdateCellInfoRequest) (*vtctldata.UpdateCellInfoResponse, error)
+	DeleteCellInfo(context.Context, *vtctldata.DeleteCellInfoRequest) (*vtctldata.DeleteCellInfoResponse, error)
+	GetCellInfoNames(context.Context, *vtctldata.GetCellInfoNamesRequest) (*vtctldata.GetCellInfoNamesResponse, error)
+	GetCellInfo(context.Context, *vtctldata.GetCellInfoRequest) (*vtctldata.GetCellInfoResponse, error)
+	AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error)
+	UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error)
+	DeleteCellsAlias(context.Context, *vtctldata.DeleteCellsAliasRequest) (*vtctldata.DeleteCellsAliasResponse, error)
+	GetCellsAliases(context.Context, *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error)
+	ValidateCellsAliases(context.Context, *vtctldata.ValidateCellsAliasesRequest) (*vtctldata.ValidateCellsAliasesResponse, error)
+	GetServingCells(context.Context, *vtctldata.GetServingCellsRequest) (*vtctldata.GetServingCellsResponse, error)
+	// Resharding throttler.
+	ThrottlerMaxRates(context.Context, *vtctldata.ThrottlerMaxRatesRequest) (*vtctldata.ThrottlerMaxRatesResponse, error)
+	ThrottlerSetMaxRate(context.Context, *vtctldata.ThrottlerSetMaxRateRequest) (*vtctldata.ThrottlerSetMaxRateResponse, error)
+	GetThrottlerConfiguration(context.Context, *vtctldata.GetThrottlerConfigurationRequest) (*vtctldata.GetThrottlerConfigurationResponse, error)
+	UpdateThrottlerConfiguration(context.Context, *vtctldata.UpdateThrottlerConfigurationRequest) (*vtctldata.UpdateThrottlerConfigurationResponse, error)
+	ResetThrottlerConfiguration(context.Context, *vtctldata.ResetThrottlerConfigurationRequest) (*vtctldata.ResetThrottlerConfigurationResponse, error)
+	// Topology files, export and audit log.
+	TopoCat(context.Context, *vtctldata.TopoCatRequest) (*vtctldata.TopoCatResponse, error)
+	WriteTopoFile(context.Context, *vtctldata.WriteTopoFileRequest) (*vtctldata.WriteTopoFileResponse, error)
+	ExportTopo(context.Context, *vtctldata.ExportTopoRequest) (*vtctldata.ExportTopoResponse, error)
+	ImportTopo(context.Context, *vtctldata.ImportTopoRequest) (*vtctldata.ImportTopoResponse, error)
+	GetTopoAuditLog(context.Context, *vtctldata.GetTopoAuditLogRequest) (*vtctldata.GetTopoAuditLogResponse, error)
+	// Workflow manager of vtctld.
+	WorkflowCreate(context.Context, *vtctldata.WorkflowCreateRequest) (*vtctldata.WorkflowCreateResponse, error)
+	WorkflowStart(context.Context, *vtctldata.WorkflowStartRequest) (*vtctldata.WorkflowStartResponse, error)
+	WorkflowStop(context.Context, *vtctldata.WorkflowStopRequest) (*vtctldata.WorkflowStopResponse, error)
+	WorkflowDelete(context.Context, *vtctldata.WorkflowDeleteRequest) (*vtctldata.WorkflowDeleteResponse, error)
+	WorkflowWait(context.Context, *vtctldata.WorkflowWaitRequest) (*vtctldata.WorkflowWaitResponse, error)
+	WorkflowTree(context.Context, *vtctldata.WorkflowTreeRequest) (*vtctldata.WorkflowTreeResponse, error)
+	WorkflowAction(context.Context, *vtctldata.WorkflowActionRequest) (*vtctldata.WorkflowActionResponse, error)
+}
+
+// UnimplementedVtctldServer can be embedded to have forward compatible implementations.
+type UnimplementedVtctldServer struct {
+}
+
+func (*UnimplementedVtctldServer) GetKeyspaces(ctx context.Context, req *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
+}
+func (*UnimplementedVtctldServer) GetKeyspace(ctx context.Context, req *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllShardsInKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) GetShard(ctx context.Context, req *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
+}
+func (*UnimplementedVtctldServer) GetTablet(ctx context.Context, req *vtctldata.GetTabletRequest) (*vtctldata.GetTabletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTablet not implemented")
+}
+func (*UnimplementedVtctldServer) GetTablets(ctx context.Context, req *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTablets not implemented")
+}
+func (*UnimplementedVtctldServer) Backup(req *vtctldata.BackupRequest, srv Vtctld_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedVtctldServer) Reshard(req *vtctldata.ReshardRequest, srv Vtctld_ReshardServer) error {
+	return status.Errorf(codes.Unimplemented, "method Reshard not implemented")
+}
+func (*UnimplementedVtctldServer) InitTablet(ctx context.Context, req *vtctldata.InitTabletRequest) (*vtctldata.InitTabletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitTablet not implemented")
+}
+func (*UnimplementedVtctldServer) UpdateTabletAddrs(ctx context.Context, req *vtctldata.UpdateTabletAddrsRequest) (*vtctldata.UpdateTabletAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTabletAddrs not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteTablets(ctx context.Context, req *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTablets not implemented")
+}
+func (*UnimplementedVtctldServer) SetReadOnly(ctx context.Context, req *vtctldata.SetReadOnlyRequest) (*vtctldata.SetReadOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
+}
+func (*UnimplementedVtctldServer) SetReadWrite(ctx context.Context, req *vtctldata.SetReadWriteRequest) (*vtctldata.SetReadWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadWrite not implemented")
+}
+func (*UnimplementedVtctldServer) StartSlave(ctx context.Context, req *vtctldata.StartSlaveRequest) (*vtctldata.StartSlaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSlave not implemented")
+}
+func (*UnimplementedVtctldServer) StopSlave(ctx context.Context, req *vtctldata.StopSlaveRequest) (*vtctldata.StopSlaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSlave not implemented")
+}
+func (*UnimplementedVtctldServer) ChangeSlaveType(ctx context.Context, req *vtctldata.ChangeSlaveTypeRequest) (*vtctldata.ChangeSlaveTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSlaveType not implemented")
+}
+func (*UnimplementedVtctldServer) Ping(ctx context.Context, req *vtctldata.PingRequest) (*vtctldata.PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedVtctldServer) RefreshState(ctx context.Context, req *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshState not implemented")
+}
+func (*UnimplementedVtctldServer) RefreshStateByShard(ctx context.Context, req *vtctldata.RefreshStateByShardRequest) (*vtctldata.RefreshStateByShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshStateByShard not implemented")
+}
+func (*UnimplementedVtctldServer) RunHealthCheck(ctx context.Context, req *vtctldata.RunHealthCheckRequest) (*vtctldata.RunHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunHealthCheck not implemented")
+}
+func (*UnimplementedVtctldServer) IgnoreHealthError(ctx context.Context, req *vtctldata.IgnoreHealthErrorRequest) (*vtctldata.IgnoreHealthErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IgnoreHealthError not implemented")
+}
+func (*UnimplementedVtctldServer) ResizeTxPool(ctx context.Context, req *vtctldata.ResizeTxPoolRequest) (*vtctldata.ResizeTxPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeTxPool not implemented")
+}
+func (*UnimplementedVtctldServer) Sleep(ctx context.Context, req *vtctldata.SleepRequest) (*vtctldata.SleepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sleep not implemented")
+}
+func (*UnimplementedVtctldServer) ExecuteHook(ctx context.Context, req *vtctldata.ExecuteHookRequest) (*vtctldata.ExecuteHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteHook not implemented")
+}
+func (*UnimplementedVtctldServer) ExecuteFetchAsApp(ctx context.Context, req *vtctldata.ExecuteFetchAsAppRequest) (*vtctldata.ExecuteFetchAsAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteFetchAsApp not implemented")
+}
+func (*UnimplementedVtctldServer) ExecuteFetchAsDba(ctx context.Context, req *vtctldata.ExecuteFetchAsDbaRequest) (*vtctldata.ExecuteFetchAsDbaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteFetchAsDba not implemented")
+}
+func (*UnimplementedVtctldServer) VReplicationExec(ctx context.Context, req *vtctldata.VReplicationExecRequest) (*vtctldata.VReplicationExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VReplicationExec not implemented")
+}
+func (*UnimplementedVtctldServer) CreateShard(ctx context.Context, req *vtctldata.CreateShardRequest) (*vtctldata.CreateShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShard not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateShard(req *vtctldata.ValidateShardRequest, srv Vtctld_ValidateShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateShard not implemented")
+}
+func (*UnimplementedVtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctldata.ShardReplicationPositionsRequest) (*vtctldata.ShardReplicationPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardReplicationPositions not implemented")
+}
+func (*UnimplementedVtctldServer) SetShardIsMasterServing(ctx context.Context, req *vtctldata.SetShardIsMasterServingRequest) (*vtctldata.SetShardIsMasterServingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShardIsMasterServing not implemented")
+}
+func (*UnimplementedVtctldServer) SetShardTabletControl(ctx context.Context, req *vtctldata.SetShardTabletControlRequest) (*vtctldata.SetShardTabletControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShardTabletControl not implemented")
+}
+func (*UnimplementedVtctldServer) UpdateSrvKeyspacePartition(ctx context.Context, req *vtctldata.UpdateSrvKeyspacePartitionRequest) (*vtctldata.UpdateSrvKeyspacePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSrvKeyspacePartition not implemented")
+}
+func (*UnimplementedVtctldServer) SourceShardDelete(ctx context.Context, req *vtctldata.SourceShardDeleteRequest) (*vtctldata.SourceShardDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceShardDelete not implemented")
+}
+func (*UnimplementedVtctldServer) SourceShardAdd(ctx context.Context, req *vtctldata.SourceShardAddRequest) (*vtctldata.SourceShardAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceShardAdd not implemented")
+}
+func (*UnimplementedVtctldServer) ShardReplicationAdd(ctx context.Context, req *vtctldata.ShardReplicationAddRequest) (*vtctldata.ShardReplicationAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardReplicationAdd not implemented")
+}
+func (*UnimplementedVtctldServer) ShardReplicationRemove(ctx context.Context, req *vtctldata.ShardReplicationRemoveRequest) (*vtctldata.ShardReplicationRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardReplicationRemove not implemented")
+}
+func (*UnimplementedVtctldServer) ShardReplicationFix(ctx context.Context, req *vtctldata.ShardReplicationFixRequest) (*vtctldata.ShardReplicationFixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardReplicationFix not implemented")
+}
+func (*UnimplementedVtctldServer) WaitForFilteredReplication(req *vtctldata.WaitForFilteredReplicationRequest, srv Vtctld_WaitForFilteredReplicationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForFilteredReplication not implemented")
+}
+func (*UnimplementedVtctldServer) RemoveShardCell(ctx context.Context, req *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShardCell not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteShards(ctx context.Context, req *vtctldata.DeleteShardsRequest) (*vtctldata.DeleteShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShards not implemented")
+}
+func (*UnimplementedVtctldServer) CreateKeyspace(ctx context.Context, req *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteKeyspace(ctx context.Context, req *vtctldata.DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldata.RemoveKeyspaceCellRequest) (*vtctldata.RemoveKeyspaceCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeyspaceCell not implemented")
+}
+func (*UnimplementedVtctldServer) SetKeyspaceShardingInfo(ctx context.Context, req *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceShardingInfo not implemented")
+}
+func (*UnimplementedVtctldServer) SetKeyspaceDDLStrategy(ctx context.Context, req *vtctldata.SetKeyspaceDDLStrategyRequest) (*vtctldata.SetKeyspaceDDLStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceDDLStrategy not implemented")
+}
+func (*UnimplementedVtctldServer) SetKeyspaceServedFrom(ctx context.Context, req *vtctldata.SetKeyspaceServedFromRequest) (*vtctldata.SetKeyspaceServedFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceServedFrom not implemented")
+}
+func (*UnimplementedVtctldServer) RebuildKeyspaceGraph(ctx context.Context, req *vtctldata.RebuildKeyspaceGraphRequest) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildKeyspaceGraph not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateKeyspace(req *vtctldata.ValidateKeyspaceRequest, srv Vtctld_ValidateKeyspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) MoveTables(req *vtctldata.MoveTablesRequest, srv Vtctld_MoveTablesServer) error {
+	return status.Errorf(codes.Unimplemented, "method MoveTables not implemented")
+}
+func (*UnimplementedVtctldServer) CreateLookupVindex(ctx context.Context, req *vtctldata.CreateLookupVindexRequest) (*vtctldata.CreateLookupVindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLookupVindex not implemented")
+}
+func (*UnimplementedVtctldServer) BackfillLookupVindex(req *vtctldata.BackfillLookupVindexRequest, srv Vtctld_BackfillLookupVindexServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackfillLookupVindex not implemented")
+}
+func (*UnimplementedVtctldServer) ExternalizeVindex(ctx context.Context, req *vtctldata.ExternalizeVindexRequest) (*vtctldata.ExternalizeVindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalizeVindex not implemented")
+}
+func (*UnimplementedVtctldServer) Materialize(req *vtctldata.MaterializeRequest, srv Vtctld_MaterializeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Materialize not implemented")
+}
+func (*UnimplementedVtctldServer) SplitClone(ctx context.Context, req *vtctldata.SplitCloneRequest) (*vtctldata.SplitCloneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitClone not implemented")
+}
+func (*UnimplementedVtctldServer) VerticalSplitClone(ctx context.Context, req *vtctldata.VerticalSplitCloneRequest) (*vtctldata.VerticalSplitCloneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerticalSplitClone not implemented")
+}
+func (*UnimplementedVtctldServer) VDiff(req *vtctldata.VDiffRequest, srv Vtctld_VDiffServer) error {
+	return status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
+func (*UnimplementedVtctldServer) PauseWorkflow(ctx context.Context, req *vtctldata.PauseWorkflowRequest) (*vtctldata.PauseWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflow not implemented")
+}
+func (*UnimplementedVtctldServer) ResumeWorkflow(ctx context.Context, req *vtctldata.ResumeWorkflowRequest) (*vtctldata.ResumeWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflow not implemented")
+}
+func (*UnimplementedVtctldServer) CopyProgress(ctx context.Context, req *vtctldata.CopyProgressRequest) (*vtctldata.CopyProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyProgress not implemented")
+}
+func (*UnimplementedVtctldServer) MigrateServedTypes(req *vtctldata.MigrateServedTypesRequest, srv Vtctld_MigrateServedTypesServer) error {
+	return status.Errorf(codes.Unimplemented, "method MigrateServedTypes not implemented")
+}
+func (*UnimplementedVtctldServer) MigrateServedFrom(req *vtctldata.MigrateServedFromRequest, srv Vtctld_MigrateServedFromServer) error {
+	return status.Errorf(codes.Unimplemented, "method MigrateServedFrom not implemented")
+}
+func (*UnimplementedVtctldServer) SwitchReads(req *vtctldata.SwitchReadsRequest, srv Vtctld_SwitchReadsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SwitchReads not implemented")
+}
+func (*UnimplementedVtctldServer) SwitchWrites(req *vtctldata.SwitchWritesRequest, srv Vtctld_SwitchWritesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SwitchWrites not implemented")
+}
+func (*UnimplementedVtctldServer) CancelResharding(ctx context.Context, req *vtctldata.CancelReshardingRequest) (*vtctldata.CancelReshardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelResharding not implemented")
+}
+func (*UnimplementedVtctldServer) ShowResharding(req *vtctldata.ShowReshardingRequest, srv Vtctld_ShowReshardingServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowResharding not implemented")
+}
+func (*UnimplementedVtctldServer) WaitForDrain(req *vtctldata.WaitForDrainRequest, srv Vtctld_WaitForDrainServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForDrain not implemented")
+}
+func (*UnimplementedVtctldServer) StartReshardWorkflow(req *vtctldata.StartReshardWorkflowRequest, srv Vtctld_StartReshardWorkflowServer) error {
+	return status.Errorf(codes.Unimplemented, "method StartReshardWorkflow not implemented")
+}
+func (*UnimplementedVtctldServer) GetReshardWorkflow(ctx context.Context, req *vtctldata.GetReshardWorkflowRequest) (*vtctldata.GetReshardWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReshardWorkflow not implemented")
+}
+func (*UnimplementedVtctldServer) ReshardWorkflowSwitchReads(req *vtctldata.ReshardWorkflowSwitchReadsRequest, srv Vtctld_ReshardWorkflowSwitchReadsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReshardWorkflowSwitchReads not implemented")
+}
+func (*UnimplementedVtctldServer) ReshardWorkflowSwitchWrites(req *vtctldata.ReshardWorkflowSwitchWritesRequest, srv Vtctld_ReshardWorkflowSwitchWritesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReshardWorkflowSwitchWrites not implemented")
+}
+func (*UnimplementedVtctldServer) GetSchema(ctx context.Context, req *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (*UnimplementedVtctldServer) ReloadSchema(ctx context.Context, req *vtctldata.ReloadSchemaRequest) (*vtctldata.ReloadSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadSchema not implemented")
+}
+func (*UnimplementedVtctldServer) ReloadSchemaShard(req *vtctldata.ReloadSchemaShardRequest, srv Vtctld_ReloadSchemaShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReloadSchemaShard not implemented")
+}
+func (*UnimplementedVtctldServer) ReloadSchemaKeyspace(req *vtctldata.ReloadSchemaKeyspaceRequest, srv Vtctld_ReloadSchemaKeyspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReloadSchemaKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateSchemaShard(req *vtctldata.ValidateSchemaShardRequest, srv Vtctld_ValidateSchemaShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateSchemaShard not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateSchemaKeyspace(req *vtctldata.ValidateSchemaKeyspaceRequest, srv Vtctld_ValidateSchemaKeyspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateSchemaKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) GetSchemaDrift(ctx context.Context, req *vtctldata.GetSchemaDriftRequest) (*vtctldata.GetSchemaDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaDrift not implemented")
+}
+func (*UnimplementedVtctldServer) ApplySchema(req *vtctldata.ApplySchemaRequest, srv Vtctld_ApplySchemaServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplySchema not implemented")
+}
+func (*UnimplementedVtctldServer) ApplyDeclarativeSchema(req *vtctldata.ApplyDeclarativeSchemaRequest, srv Vtctld_ApplyDeclarativeSchemaServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyDeclarativeSchema not implemented")
+}
+func (*UnimplementedVtctldServer) SubmitOnlineDDL(ctx context.Context, req *vtctldata.SubmitOnlineDDLRequest) (*vtctldata.SubmitOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOnlineDDL not implemented")
+}
+func (*UnimplementedVtctldServer) GetOnlineDDLMigrations(ctx context.Context, req *vtctldata.GetOnlineDDLMigrationsRequest) (*vtctldata.GetOnlineDDLMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineDDLMigrations not implemented")
+}
+func (*UnimplementedVtctldServer) CancelOnlineDDL(ctx context.Context, req *vtctldata.CancelOnlineDDLRequest) (*vtctldata.CancelOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOnlineDDL not implemented")
+}
+func (*UnimplementedVtctldServer) RetryOnlineDDL(ctx context.Context, req *vtctldata.RetryOnlineDDLRequest) (*vtctldata.RetryOnlineDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOnlineDDL not implemented")
+}
+func (*UnimplementedVtctldServer) CopySchemaShard(req *vtctldata.CopySchemaShardRequest, srv Vtctld_CopySchemaShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopySchemaShard not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateVersionShard(req *vtctldata.ValidateVersionShardRequest, srv Vtctld_ValidateVersionShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateVersionShard not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateVersionKeyspace(req *vtctldata.ValidateVersionKeyspaceRequest, srv Vtctld_ValidateVersionKeyspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateVersionKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) GetPermissions(ctx context.Context, req *vtctldata.GetPermissionsRequest) (*vtctldata.GetPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (*UnimplementedVtctldServer) ValidatePermissionsShard(req *vtctldata.ValidatePermissionsShardRequest, srv Vtctld_ValidatePermissionsShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatePermissionsShard not implemented")
+}
+func (*UnimplementedVtctldServer) ValidatePermissionsKeyspace(req *vtctldata.ValidatePermissionsKeyspaceRequest, srv Vtctld_ValidatePermissionsKeyspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatePermissionsKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) GetVSchema(ctx context.Context, req *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVSchema not implemented")
+}
+func (*UnimplementedVtctldServer) ApplyVSchema(ctx context.Context, req *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVSchema not implemented")
+}
+func (*UnimplementedVtctldServer) GetRoutingRules(ctx context.Context, req *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutingRules not implemented")
+}
+func (*UnimplementedVtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRoutingRules not implemented")
+}
+func (*UnimplementedVtctldServer) RebuildVSchemaGraph(ctx context.Context, req *vtctldata.RebuildVSchemaGraphRequest) (*vtctldata.RebuildVSchemaGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildVSchemaGraph not implemented")
+}
+func (*UnimplementedVtctldServer) GetSrvKeyspaceNames(ctx context.Context, req *vtctldata.GetSrvKeyspaceNamesRequest) (*vtctldata.GetSrvKeyspaceNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSrvKeyspaceNames not implemented")
+}
+func (*UnimplementedVtctldServer) GetSrvKeyspace(ctx context.Context, req *vtctldata.GetSrvKeyspaceRequest) (*vtctldata.GetSrvKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSrvKeyspace not implemented")
+}
+func (*UnimplementedVtctldServer) GetSrvVSchema(ctx context.Context, req *vtctldata.GetSrvVSchemaRequest) (*vtctldata.GetSrvVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSrvVSchema not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteSrvVSchema(ctx context.Context, req *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSrvVSchema not implemented")
+}
+func (*UnimplementedVtctldServer) GetShardReplication(ctx context.Context, req *vtctldata.GetShardReplicationRequest) (*vtctldata.GetShardReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardReplication not implemented")
+}
+func (*UnimplementedVtctldServer) Validate(req *vtctldata.ValidateRequest, srv Vtctld_ValidateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (*UnimplementedVtctldServer) InitShardMaster(req *vtctldata.InitShardMasterRequest, srv Vtctld_InitShardMasterServer) error {
+	return status.Errorf(codes.Unimplemented, "method InitShardMaster not implemented")
+}
+func (*UnimplementedVtctldServer) PlannedReparentShard(req *vtctldata.PlannedReparentShardRequest, srv Vtctld_PlannedReparentShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlannedReparentShard not implemented")
+}
+func (*UnimplementedVtctldServer) EmergencyReparentShard(req *vtctldata.EmergencyReparentShardRequest, srv Vtctld_EmergencyReparentShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method EmergencyReparentShard not implemented")
+}
+func (*UnimplementedVtctldServer) ReparentTablet(ctx context.Context, req *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
+}
+func (*UnimplementedVtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldata.TabletExternallyReparentedRequest) (*vtctldata.TabletExternallyReparentedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TabletExternallyReparented not implemented")
+}
+func (*UnimplementedVtctldServer) BackupShard(req *vtctldata.BackupShardRequest, srv Vtctld_BackupShardServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupShard not implemented")
+}
+func (*UnimplementedVtctldServer) ListBackups(ctx context.Context, req *vtctldata.ListBackupsRequest) (*vtctldata.ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (*UnimplementedVtctldServer) RemoveBackup(ctx context.Context, req *vtctldata.RemoveBackupRequest) (*vtctldata.RemoveBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBackup not implemented")
+}
+func (*UnimplementedVtctldServer) PruneBackups(ctx context.Context, req *vtctldata.PruneBackupsRequest) (*vtctldata.PruneBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBackups not implemented")
+}
+func (*UnimplementedVtctldServer) VerifyBackup(req *vtctldata.VerifyBackupRequest, srv Vtctld_VerifyBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (*UnimplementedVtctldServer) RestoreFromBackup(req *vtctldata.RestoreFromBackupRequest, srv Vtctld_RestoreFromBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
+}
+func (*UnimplementedVtctldServer) AddCellInfo(ctx context.Context, req *vtctldata.AddCellInfoRequest) (*vtctldata.AddCellInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCellInfo not implemented")
+}
+func (*UnimplementedVtctldServer) UpdateCellInfo(ctx context.Context, req *vtctldata.UpdateCellInfoRequest) (*vtctldata.UpdateCellInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellInfo not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteCellInfo(ctx context.Context, req *vtctldata.DeleteCellInfoRequest) (*vtctldata.DeleteCellInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCellInfo not implemented")
+}
+func (*UnimplementedVtctldServer) GetCellInfoNames(ctx context.Context, req *vtctldata.GetCellInfoNamesRequest) (*vtctldata.GetCellInfoNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellInfoNames not implemented")
+}
+func (*UnimplementedVtctldServer) GetCellInfo(ctx context.Context, req *vtctldata.GetCellInfoRequest) (*vtctldata.GetCellInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellInfo not implemented")
+}
+func (*UnimplementedVtctldServer) AddCellsAlias(ctx context.Context, req *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCellsAlias not implemented")
+}
+func (*UnimplementedVtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellsAlias not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteCellsAlias(ctx context.Context, req *vtctldata.DeleteCellsAliasRequest) (*vtctldata.DeleteCellsAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCellsAlias not implemented")
+}
+func (*UnimplementedVtctldServer) GetCellsAliases(ctx context.Context, req *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellsAliases not implemented")
+}
+func (*UnimplementedVtctldServer) ValidateCellsAliases(ctx context.Context, req *vtctldata.ValidateCellsAliasesRequest) (*vtctldata.ValidateCellsAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCellsAliases not implemented")
+}
+func (*UnimplementedVtctldServer) GetServingCells(ctx context.Context, req *vtctldata.GetServingCellsRequest) (*vtctldata.GetServingCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServingCells not implemented")
+}
+func (*UnimplementedVtctldServer) ThrottlerMaxRates(ctx context.Context, req *vtctldata.ThrottlerMaxRatesRequest) (*vtctldata.ThrottlerMaxRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThrottlerMaxRates not implemented")
+}
+func (*UnimplementedVtctldServer) ThrottlerSetMaxRate(ctx context.Context, req *vtctldata.ThrottlerSetMaxRateRequest) (*vtctldata.ThrottlerSetMaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThrottlerSetMaxRate not implemented")
+}
+func (*UnimplementedVtctldServer) GetThrottlerConfiguration(ctx context.Context, req *vtctldata.GetThrottlerConfigurationRequest) (*vtctldata.GetThrottlerConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThrottlerConfiguration not implemented")
+}
+func (*UnimplementedVtctldServer) UpdateThrottlerConfiguration(ctx context.Context, req *vtctldata.UpdateThrottlerConfigurationRequest) (*vtctldata.UpdateThrottlerConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThrottlerConfiguration not implemented")
+}
+func (*UnimplementedVtctldServer) ResetThrottlerConfiguration(ctx context.Context, req *vtctldata.ResetThrottlerConfigurationRequest) (*vtctldata.ResetThrottlerConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetThrottlerConfiguration not implemented")
+}
+func (*UnimplementedVtctldServer) TopoCat(ctx context.Context, req *vtctldata.TopoCatRequest) (*vtctldata.TopoCatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopoCat not implemented")
+}
+func (*UnimplementedVtctldServer) WriteTopoFile(ctx context.Context, req *vtctldata.WriteTopoFileRequest) (*vtctldata.WriteTopoFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTopoFile not implemented")
+}
+func (*UnimplementedVtctldServer) ExportTopo(ctx context.Context, req *vtctldata.ExportTopoRequest) (*vtctldata.ExportTopoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTopo not implemented")
+}
+func (*UnimplementedVtctldServer) ImportTopo(ctx context.Context, req *vtctldata.ImportTopoRequest) (*vtctldata.ImportTopoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTopo not implemented")
+}
+func (*UnimplementedVtctldServer) GetTopoAuditLog(ctx context.Context, req *vtctldata.GetTopoAuditLogRequest) (*vtctldata.GetTopoAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopoAuditLog not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowCreate(ctx context.Context, req *vtctldata.WorkflowCreateRequest) (*vtctldata.WorkflowCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowCreate not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowStart(ctx context.Context, req *vtctldata.WorkflowStartRequest) (*vtctldata.WorkflowStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowStart not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowStop(ctx context.Context, req *vtctldata.WorkflowStopRequest) (*vtctldata.WorkflowStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowStop not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowDelete(ctx context.Context, req *vtctldata.WorkflowDeleteRequest) (*vtctldata.WorkflowDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowDelete not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowWait(ctx context.Context, req *vtctldata.WorkflowWaitRequest) (*vtctldata.WorkflowWaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowWait not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowTree(ctx context.Context, req *vtctldata.WorkflowTreeRequest) (*vtctldata.WorkflowTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowTree not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowAction(ctx context.Context, req *vtctldata.WorkflowActionRequest) (*vtctldata.WorkflowActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowAction not implemented")
+}
+
+func RegisterVtctldServer(s *grpc.Server, srv VtctldServer) {
+	s.RegisterService(&_Vtctld_serviceDesc, srv)
+}
+
+func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetKeyspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetKeyspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetKeyspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetKeyspaces(ctx, req.(*vtctldata.GetKeyspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetKeyspace(ctx, req.(*vtctldata.GetKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_FindAllShardsInKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.FindAllShardsInKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).FindAllShardsInKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/FindAllShardsInKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).FindAllShardsInKeyspace(ctx, req.(*vtctldata.FindAllShardsInKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetShard(ctx, req.(*vtctldata.GetShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetTablet(ctx, req.(*vtctldata.GetTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetTablets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetTabletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetTablets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetTablets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetTablets(ctx, req.(*vtctldata.GetTabletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).Backup(m, &vtctldBackupServer{stream})
+}
+
+type Vtctld_BackupServer interface {
+	Send(*vtctldata.BackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldBackupServer) Send(m *vtctldata.BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_Reshard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ReshardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).Reshard(m, &vtctldReshardServer{stream})
+}
+
+type Vtctld_ReshardServer interface {
+	Send(*vtctldata.ReshardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldReshardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldReshardServer) Send(m *vtctldata.ReshardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_InitTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.InitTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).InitTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/InitTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).InitTablet(ctx, req.(*vtctldata.InitTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateTabletAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateTabletAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateTabletAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateTabletAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateTabletAddrs(ctx, req.(*vtctldata.UpdateTabletAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteTabletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteTablets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteTablets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteTablets(ctx, req.(*vtctldata.DeleteTabletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetReadOnly(ctx, req.(*vtctldata.SetReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetReadWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetReadWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetReadWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetReadWrite(ctx, req.(*vtctldata.SetReadWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_StartSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.StartSlaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).StartSlave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/StartSlave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).StartSlave(ctx, req.(*vtctldata.StartSlaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_StopSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.StopSlaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).StopSlave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/StopSlave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).StopSlave(ctx, req.(*vtctldata.StopSlaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ChangeSlaveType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ChangeSlaveTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ChangeSlaveType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ChangeSlaveType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ChangeSlaveType(ctx, req.(*vtctldata.ChangeSlaveTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).Ping(ctx, req.(*vtctldata.PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RefreshStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RefreshState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RefreshState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RefreshState(ctx, req.(*vtctldata.RefreshStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RefreshStateByShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RefreshStateByShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RefreshStateByShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RefreshStateByShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RefreshStateByShard(ctx, req.(*vtctldata.RefreshStateByShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RunHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RunHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RunHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RunHealthCheck(ctx, req.(*vtctldata.RunHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_IgnoreHealthError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.IgnoreHealthErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).IgnoreHealthError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/IgnoreHealthError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).IgnoreHealthError(ctx, req.(*vtctldata.IgnoreHealthErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ResizeTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ResizeTxPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ResizeTxPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ResizeTxPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ResizeTxPool(ctx, req.(*vtctldata.ResizeTxPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Sleep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SleepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).Sleep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/Sleep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).Sleep(ctx, req.(*vtctldata.SleepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ExecuteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExecuteHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExecuteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExecuteHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExecuteHook(ctx, req.(*vtctldata.ExecuteHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ExecuteFetchAsApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExecuteFetchAsAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExecuteFetchAsApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExecuteFetchAsApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExecuteFetchAsApp(ctx, req.(*vtctldata.ExecuteFetchAsAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ExecuteFetchAsDba_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExecuteFetchAsDbaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExecuteFetchAsDba(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExecuteFetchAsDba",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExecuteFetchAsDba(ctx, req.(*vtctldata.ExecuteFetchAsDbaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VReplicationExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VReplicationExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VReplicationExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VReplicationExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VReplicationExec(ctx, req.(*vtctldata.VReplicationExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CreateShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateShard(ctx, req.(*vtctldata.CreateShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidateShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateShard(m, &vtctldValidateShardServer{stream})
+}
+
+type Vtctld_ValidateShardServer interface {
+	Send(*vtctldata.ValidateShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateShardServer) Send(m *vtctldata.ValidateShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ShardReplicationPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ShardReplicationPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ShardReplicationPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ShardReplicationPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ShardReplicationPositions(ctx, req.(*vtctldata.ShardReplicationPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetShardIsMasterServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetShardIsMasterServingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetShardIsMasterServing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetShardIsMasterServing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetShardIsMasterServing(ctx, req.(*vtctldata.SetShardIsMasterServingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetShardTabletControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetShardTabletControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetShardTabletControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetShardTabletControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetShardTabletControl(ctx, req.(*vtctldata.SetShardTabletControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateSrvKeyspacePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateSrvKeyspacePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateSrvKeyspacePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateSrvKeyspacePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateSrvKeyspacePartition(ctx, req.(*vtctldata.UpdateSrvKeyspacePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SourceShardDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SourceShardDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SourceShardDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SourceShardDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SourceShardDelete(ctx, req.(*vtctldata.SourceShardDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SourceShardAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SourceShardAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SourceShardAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SourceShardAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SourceShardAdd(ctx, req.(*vtctldata.SourceShardAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ShardReplicationAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ShardReplicationAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ShardReplicationAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ShardReplicationAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ShardReplicationAdd(ctx, req.(*vtctldata.ShardReplicationAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ShardReplicationRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ShardReplicationRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ShardReplicationRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ShardReplicationRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ShardReplicationRemove(ctx, req.(*vtctldata.ShardReplicationRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ShardReplicationFix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ShardReplicationFixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ShardReplicationFix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ShardReplicationFix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ShardReplicationFix(ctx, req.(*vtctldata.ShardReplicationFixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WaitForFilteredReplication_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.WaitForFilteredReplicationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).WaitForFilteredReplication(m, &vtctldWaitForFilteredReplicationServer{stream})
+}
+
+type Vtctld_WaitForFilteredReplicationServer interface {
+	Send(*vtctldata.WaitForFilteredReplicationResponse) error
+	grpc.ServerStream
+}
+
+type vtctldWaitForFilteredReplicationServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldWaitForFilteredReplicationServer) Send(m *vtctldata.WaitForFilteredReplicationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_RemoveShardCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveShardCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveShardCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveShardCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveShardCell(ctx, req.(*vtctldata.RemoveShardCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteShards(ctx, req.(*vtctldata.DeleteShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateKeyspace(ctx, req.(*vtctldata.CreateKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteKeyspace(ctx, req.(*vtctldata.DeleteKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RemoveKeyspaceCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveKeyspaceCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveKeyspaceCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, req.(*vtctldata.RemoveKeyspaceCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetKeyspaceShardingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspaceShardingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspaceShardingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspaceShardingInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspaceShardingInfo(ctx, req.(*vtctldata.SetKeyspaceShardingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetKeyspaceDDLStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspaceDDLStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspaceDDLStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspaceDDLStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspaceDDLStrategy(ctx, req.(*vtctldata.SetKeyspaceDDLStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetKeyspaceServedFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspaceServedFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspaceServedFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspaceServedFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspaceServedFrom(ctx, req.(*vtctldata.SetKeyspaceServedFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RebuildKeyspaceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RebuildKeyspaceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RebuildKeyspaceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RebuildKeyspaceGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RebuildKeyspaceGraph(ctx, req.(*vtctldata.RebuildKeyspaceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidateKeyspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateKeyspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateKeyspace(m, &vtctldValidateKeyspaceServer{stream})
+}
+
+type Vtctld_ValidateKeyspaceServer interface {
+	Send(*vtctldata.ValidateKeyspaceResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateKeyspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateKeyspaceServer) Send(m *vtctldata.ValidateKeyspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_MoveTables_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.MoveTablesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).MoveTables(m, &vtctldMoveTablesServer{stream})
+}
+
+type Vtctld_MoveTablesServer interface {
+	Send(*vtctldata.MoveTablesResponse) error
+	grpc.ServerStream
+}
+
+type vtctldMoveTablesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldMoveTablesServer) Send(m *vtctldata.MoveTablesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_CreateLookupVindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateLookupVindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateLookupVindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateLookupVindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateLookupVindex(ctx, req.(*vtctldata.CreateLookupVindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_BackfillLookupVindex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.BackfillLookupVindexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).BackfillLookupVindex(m, &vtctldBackfillLookupVindexServer{stream})
+}
+
+type Vtctld_BackfillLookupVindexServer interface {
+	Send(*vtctldata.BackfillLookupVindexResponse) error
+	grpc.ServerStream
+}
+
+type vtctldBackfillLookupVindexServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldBackfillLookupVindexServer) Send(m *vtctldata.BackfillLookupVindexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ExternalizeVindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExternalizeVindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExternalizeVindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExternalizeVindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExternalizeVindex(ctx, req.(*vtctldata.ExternalizeVindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Materialize_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.MaterializeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).Materialize(m, &vtctldMaterializeServer{stream})
+}
+
+type Vtctld_MaterializeServer interface {
+	Send(*vtctldata.MaterializeResponse) error
+	grpc.ServerStream
+}
+
+type vtctldMaterializeServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldMaterializeServer) Send(m *vtctldata.MaterializeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_SplitClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SplitCloneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SplitClone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SplitClone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SplitClone(ctx, req.(*vtctldata.SplitCloneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VerticalSplitClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VerticalSplitCloneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VerticalSplitClone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VerticalSplitClone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VerticalSplitClone(ctx, req.(*vtctldata.VerticalSplitCloneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.VDiffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).VDiff(m, &vtctldVDiffServer{stream})
+}
+
+type Vtctld_VDiffServer interface {
+	Send(*vtctldata.VDiffResponse) error
+	grpc.ServerStream
+}
+
+type vtctldVDiffServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldVDiffServer) Send(m *vtctldata.VDiffResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_PauseWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PauseWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).PauseWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/PauseWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).PauseWorkflow(ctx, req.(*vtctldata.PauseWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ResumeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ResumeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ResumeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ResumeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ResumeWorkflow(ctx, req.(*vtctldata.ResumeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CopyProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CopyProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CopyProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CopyProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CopyProgress(ctx, req.(*vtctldata.CopyProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_MigrateServedTypes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.MigrateServedTypesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).MigrateServedTypes(m, &vtctldMigrateServedTypesServer{stream})
+}
+
+type Vtctld_MigrateServedTypesServer interface {
+	Send(*vtctldata.MigrateServedTypesResponse) error
+	grpc.ServerStream
+}
+
+type vtctldMigrateServedTypesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldMigrateServedTypesServer) Send(m *vtctldata.MigrateServedTypesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_MigrateServedFrom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.MigrateServedFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).MigrateServedFrom(m, &vtctldMigrateServedFromServer{stream})
+}
+
+type Vtctld_MigrateServedFromServer interface {
+	Send(*vtctldata.MigrateServedFromResponse) error
+	grpc.ServerStream
+}
+
+type vtctldMigrateServedFromServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldMigrateServedFromServer) Send(m *vtctldata.MigrateServedFromResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_SwitchReads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.SwitchReadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).SwitchReads(m, &vtctldSwitchReadsServer{stream})
+}
+
+type Vtctld_SwitchReadsServer interface {
+	Send(*vtctldata.SwitchReadsResponse) error
+	grpc.ServerStream
+}
+
+type vtctldSwitchReadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldSwitchReadsServer) Send(m *vtctldata.SwitchReadsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_SwitchWrites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.SwitchWritesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).SwitchWrites(m, &vtctldSwitchWritesServer{stream})
+}
+
+type Vtctld_SwitchWritesServer interface {
+	Send(*vtctldata.SwitchWritesResponse) error
+	grpc.ServerStream
+}
+
+type vtctldSwitchWritesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldSwitchWritesServer) Send(m *vtctldata.SwitchWritesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_CancelResharding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CancelReshardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CancelResharding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CancelResharding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CancelResharding(ctx, req.(*vtctldata.CancelReshardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ShowResharding_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ShowReshardingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ShowResharding(m, &vtctldShowReshardingServer{stream})
+}
+
+type Vtctld_ShowReshardingServer interface {
+	Send(*vtctldata.ShowReshardingResponse) error
+	grpc.ServerStream
+}
+
+type vtctldShowReshardingServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldShowReshardingServer) Send(m *vtctldata.ShowReshardingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_WaitForDrain_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.WaitForDrainRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).WaitForDrain(m, &vtctldWaitForDrainServer{stream})
+}
+
+type Vtctld_WaitForDrainServer interface {
+	Send(*vtctldata.WaitForDrainResponse) error
+	grpc.ServerStream
+}
+
+type vtctldWaitForDrainServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldWaitForDrainServer) Send(m *vtctldata.WaitForDrainResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_StartReshardWorkflow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.StartReshardWorkflowRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).StartReshardWorkflow(m, &vtctldStartReshardWorkflowServer{stream})
+}
+
+type Vtctld_StartReshardWorkflowServer interface {
+	Send(*vtctldata.StartReshardWorkflowResponse) error
+	grpc.ServerStream
+}
+
+type vtctldStartReshardWorkflowServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldStartReshardWorkflowServer) Send(m *vtctldata.StartReshardWorkflowResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_GetReshardWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetReshardWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetReshardWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetReshardWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetReshardWorkflow(ctx, req.(*vtctldata.GetReshardWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ReshardWorkflowSwitchReads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ReshardWorkflowSwitchReadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ReshardWorkflowSwitchReads(m, &vtctldReshardWorkflowSwitchReadsServer{stream})
+}
+
+type Vtctld_ReshardWorkflowSwitchReadsServer interface {
+	Send(*vtctldata.ReshardWorkflowSwitchReadsResponse) error
+	grpc.ServerStream
+}
+
+type vtctldReshardWorkflowSwitchReadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldReshardWorkflowSwitchReadsServer) Send(m *vtctldata.ReshardWorkflowSwitchReadsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ReshardWorkflowSwitchWrites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ReshardWorkflowSwitchWritesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ReshardWorkflowSwitchWrites(m, &vtctldReshardWorkflowSwitchWritesServer{stream})
+}
+
+type Vtctld_ReshardWorkflowSwitchWritesServer interface {
+	Send(*vtctldata.ReshardWorkflowSwitchWritesResponse) error
+	grpc.ServerStream
+}
+
+type vtctldReshardWorkflowSwitchWritesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldReshardWorkflowSwitchWritesServer) Send(m *vtctldata.ReshardWorkflowSwitchWritesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchema(ctx, req.(*vtctldata.GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ReloadSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReloadSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ReloadSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ReloadSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ReloadSchema(ctx, req.(*vtctldata.ReloadSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ReloadSchemaShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ReloadSchemaShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ReloadSchemaShard(m, &vtctldReloadSchemaShardServer{stream})
+}
+
+type Vtctld_ReloadSchemaShardServer interface {
+	Send(*vtctldata.ReloadSchemaShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldReloadSchemaShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldReloadSchemaShardServer) Send(m *vtctldata.ReloadSchemaShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ReloadSchemaKeyspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ReloadSchemaKeyspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ReloadSchemaKeyspace(m, &vtctldReloadSchemaKeyspaceServer{stream})
+}
+
+type Vtctld_ReloadSchemaKeyspaceServer interface {
+	Send(*vtctldata.ReloadSchemaKeyspaceResponse) error
+	grpc.ServerStream
+}
+
+type vtctldReloadSchemaKeyspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldReloadSchemaKeyspaceServer) Send(m *vtctldata.ReloadSchemaKeyspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ValidateSchemaShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateSchemaShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateSchemaShard(m, &vtctldValidateSchemaShardServer{stream})
+}
+
+type Vtctld_ValidateSchemaShardServer interface {
+	Send(*vtctldata.ValidateSchemaShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateSchemaShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateSchemaShardServer) Send(m *vtctldata.ValidateSchemaShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ValidateSchemaKeyspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateSchemaKeyspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateSchemaKeyspace(m, &vtctldValidateSchemaKeyspaceServer{stream})
+}
+
+type Vtctld_ValidateSchemaKeyspaceServer interface {
+	Send(*vtctldata.ValidateSchemaKeyspaceResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateSchemaKeyspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateSchemaKeyspaceServer) Send(m *vtctldata.ValidateSchemaKeyspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_GetSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchemaDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchemaDrift(ctx, req.(*vtctldata.GetSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ApplySchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ApplySchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ApplySchema(m, &vtctldApplySchemaServer{stream})
+}
+
+type Vtctld_ApplySchemaServer interface {
+	Send(*vtctldata.ApplySchemaResponse) error
+	grpc.ServerStream
+}
+
+type vtctldApplySchemaServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldApplySchemaServer) Send(m *vtctldata.ApplySchemaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ApplyDeclarativeSchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ApplyDeclarativeSchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ApplyDeclarativeSchema(m, &vtctldApplyDeclarativeSchemaServer{stream})
+}
+
+type Vtctld_ApplyDeclarativeSchemaServer interface {
+	Send(*vtctldata.ApplyDeclarativeSchemaResponse) error
+	grpc.ServerStream
+}
+
+type vtctldApplyDeclarativeSchemaServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldApplyDeclarativeSchemaServer) Send(m *vtctldata.ApplyDeclarativeSchemaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_SubmitOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SubmitOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SubmitOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SubmitOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SubmitOnlineDDL(ctx, req.(*vtctldata.SubmitOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetOnlineDDLMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetOnlineDDLMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetOnlineDDLMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetOnlineDDLMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetOnlineDDLMigrations(ctx, req.(*vtctldata.GetOnlineDDLMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CancelOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CancelOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CancelOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CancelOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CancelOnlineDDL(ctx, req.(*vtctldata.CancelOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RetryOnlineDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RetryOnlineDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RetryOnlineDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RetryOnlineDDL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RetryOnlineDDL(ctx, req.(*vtctldata.RetryOnlineDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CopySchemaShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.CopySchemaShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).CopySchemaShard(m, &vtctldCopySchemaShardServer{stream})
+}
+
+type Vtctld_CopySchemaShardServer interface {
+	Send(*vtctldata.CopySchemaShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldCopySchemaShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldCopySchemaShardServer) Send(m *vtctldata.CopySchemaShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ValidateVersionShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateVersionShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateVersionShard(m, &vtctldValidateVersionShardServer{stream})
+}
+
+type Vtctld_ValidateVersionShardServer interface {
+	Send(*vtctldata.ValidateVersionShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateVersionShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateVersionShardServer) Send(m *vtctldata.ValidateVersionShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ValidateVersionKeyspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateVersionKeyspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidateVersionKeyspace(m, &vtctldValidateVersionKeyspaceServer{stream})
+}
+
+type Vtctld_ValidateVersionKeyspaceServer interface {
+	Send(*vtctldata.ValidateVersionKeyspaceResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateVersionKeyspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateVersionKeyspaceServer) Send(m *vtctldata.ValidateVersionKeyspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetPermissions(ctx, req.(*vtctldata.GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidatePermissionsShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidatePermissionsShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidatePermissionsShard(m, &vtctldValidatePermissionsShardServer{stream})
+}
+
+type Vtctld_ValidatePermissionsShardServer interface {
+	Send(*vtctldata.ValidatePermissionsShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidatePermissionsShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidatePermissionsShardServer) Send(m *vtctldata.ValidatePermissionsShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ValidatePermissionsKeyspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidatePermissionsKeyspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).ValidatePermissionsKeyspace(m, &vtctldValidatePermissionsKeyspaceServer{stream})
+}
+
+type Vtctld_ValidatePermissionsKeyspaceServer interface {
+	Send(*vtctldata.ValidatePermissionsKeyspaceResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidatePermissionsKeyspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidatePermissionsKeyspaceServer) Send(m *vtctldata.ValidatePermissionsKeyspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetVSchema(ctx, req.(*vtctldata.GetVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplyVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplyVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplyVSchema(ctx, req.(*vtctldata.ApplyVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetRoutingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetRoutingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetRoutingRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetRoutingRules(ctx, req.(*vtctldata.GetRoutingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyRoutingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplyRoutingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplyRoutingRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplyRoutingRules(ctx, req.(*vtctldata.ApplyRoutingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RebuildVSchemaGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RebuildVSchemaGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RebuildVSchemaGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, req.(*vtctldata.RebuildVSchemaGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSrvKeyspaceNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSrvKeyspaceNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSrvKeyspaceNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSrvKeyspaceNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSrvKeyspaceNames(ctx, req.(*vtctldata.GetSrvKeyspaceNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSrvKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSrvKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSrvKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSrvKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSrvKeyspace(ctx, req.(*vtctldata.GetSrvKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSrvVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSrvVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSrvVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSrvVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSrvVSchema(ctx, req.(*vtctldata.GetSrvVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteSrvVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteSrvVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteSrvVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteSrvVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteSrvVSchema(ctx, req.(*vtctldata.DeleteSrvVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetShardReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetShardReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetShardReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetShardReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetShardReplication(ctx, req.(*vtctldata.GetShardReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Validate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.ValidateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).Validate(m, &vtctldValidateServer{stream})
+}
+
+type Vtctld_ValidateServer interface {
+	Send(*vtctldata.ValidateResponse) error
+	grpc.ServerStream
+}
+
+type vtctldValidateServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldValidateServer) Send(m *vtctldata.ValidateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_InitShardMaster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.InitShardMasterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).InitShardMaster(m, &vtctldInitShardMasterServer{stream})
+}
+
+type Vtctld_InitShardMasterServer interface {
+	Send(*vtctldata.InitShardMasterResponse) error
+	grpc.ServerStream
+}
+
+type vtctldInitShardMasterServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldInitShardMasterServer) Send(m *vtctldata.InitShardMasterResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_PlannedReparentShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.PlannedReparentShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).PlannedReparentShard(m, &vtctldPlannedReparentShardServer{stream})
+}
+
+type Vtctld_PlannedReparentShardServer interface {
+	Send(*vtctldata.PlannedReparentShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldPlannedReparentShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldPlannedReparentShardServer) Send(m *vtctldata.PlannedReparentShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.EmergencyReparentShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).EmergencyReparentShard(m, &vtctldEmergencyReparentShardServer{stream})
+}
+
+type Vtctld_EmergencyReparentShardServer interface {
+	Send(*vtctldata.EmergencyReparentShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldEmergencyReparentShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldEmergencyReparentShardServer) Send(m *vtctldata.EmergencyReparentShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReparentTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ReparentTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ReparentTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ReparentTablet(ctx, req.(*vtctldata.ReparentTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.TabletExternallyReparentedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).TabletExternallyReparented(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/TabletExternallyReparented",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).TabletExternallyReparented(ctx, req.(*vtctldata.TabletExternallyReparentedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_BackupShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.BackupShardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).BackupShard(m, &vtctldBackupShardServer{stream})
+}
+
+type Vtctld_BackupShardServer interface {
+	Send(*vtctldata.BackupShardResponse) error
+	grpc.ServerStream
+}
+
+type vtctldBackupShardServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldBackupShardServer) Send(m *vtctldata.BackupShardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ListBackups(ctx, req.(*vtctldata.ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RemoveBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveBackup(ctx, req.(*vtctldata.RemoveBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_PruneBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PruneBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).PruneBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/PruneBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).PruneBackups(ctx, req.(*vtctldata.PruneBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VerifyBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.VerifyBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).VerifyBackup(m, &vtctldVerifyBackupServer{stream})
+}
+
+type Vtctld_VerifyBackupServer interface {
+	Send(*vtctldata.VerifyBackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldVerifyBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldVerifyBackupServer) Send(m *vtctldata.VerifyBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_RestoreFromBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.RestoreFromBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).RestoreFromBackup(m, &vtctldRestoreFromBackupServer{stream})
+}
+
+type Vtctld_RestoreFromBackupServer interface {
+	Send(*vtctldata.RestoreFromBackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldRestoreFromBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldRestoreFromBackupServer) Send(m *vtctldata.RestoreFromBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_AddCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.AddCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).AddCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/AddCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).AddCellInfo(ctx, req.(*vtctldata.AddCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateCellInfo(ctx, req.(*vtctldata.UpdateCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteCellInfo(ctx, req.(*vtctldata.DeleteCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetCellInfoNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetCellInfoNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetCellInfoNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetCellInfoNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetCellInfoNames(ctx, req.(*vtctldata.GetCellInfoNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetCellInfo(ctx, req.(*vtctldata.GetCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_AddCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.AddCellsAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).AddCellsAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/AddCellsAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).AddCellsAlias(ctx, req.(*vtctldata.AddCellsAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateCellsAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateCellsAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateCellsAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateCellsAlias(ctx, req.(*vtctldata.UpdateCellsAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteCellsAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteCellsAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteCellsAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteCellsAlias(ctx, req.(*vtctldata.DeleteCellsAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetCellsAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetCellsAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetCellsAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetCellsAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetCellsAliases(ctx, req.(*vtctldata.GetCellsAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidateCellsAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ValidateCellsAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ValidateCellsAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ValidateCellsAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ValidateCellsAliases(ctx, req.(*vtctldata.ValidateCellsAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetServingCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetServingCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetServingCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetServingCells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetServingCells(ctx, req.(*vtctldata.GetServingCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ThrottlerMaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ThrottlerMaxRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ThrottlerMaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ThrottlerMaxRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ThrottlerMaxRates(ctx, req.(*vtctldata.ThrottlerMaxRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ThrottlerSetMaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ThrottlerSetMaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ThrottlerSetMaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ThrottlerSetMaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ThrottlerSetMaxRate(ctx, req.(*vtctldata.ThrottlerSetMaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetThrottlerConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetThrottlerConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetThrottlerConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetThrottlerConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetThrottlerConfiguration(ctx, req.(*vtctldata.GetThrottlerConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateThrottlerConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateThrottlerConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateThrottlerConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateThrottlerConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateThrottlerConfiguration(ctx, req.(*vtctldata.UpdateThrottlerConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ResetThrottlerConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ResetThrottlerConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ResetThrottlerConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ResetThrottlerConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ResetThrottlerConfiguration(ctx, req.(*vtctldata.ResetThrottlerConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_TopoCat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.TopoCatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).TopoCat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/TopoCat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).TopoCat(ctx, req.(*vtctldata.TopoCatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WriteTopoFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WriteTopoFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WriteTopoFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WriteTopoFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WriteTopoFile(ctx, req.(*vtctldata.WriteTopoFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ExportTopo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExportTopoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExportTopo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExportTopo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExportTopo(ctx, req.(*vtctldata.ExportTopoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ImportTopo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ImportTopoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ImportTopo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ImportTopo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ImportTopo(ctx, req.(*vtctldata.ImportTopoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetTopoAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetTopoAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetTopoAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetTopoAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetTopoAuditLog(ctx, req.(*vtctldata.GetTopoAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowCreate(ctx, req.(*vtctldata.WorkflowCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowStart(ctx, req.(*vtctldata.WorkflowStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowStop(ctx, req.(*vtctldata.WorkflowStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowDelete(ctx, req.(*vtctldata.WorkflowDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowWaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowWait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowWait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowWait(ctx, req.(*vtctldata.WorkflowWaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowTree(ctx, req.(*vtctldata.WorkflowTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowAction(ctx, req.(*vtctldata.WorkflowActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Vtctld_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vtctlservice.Vtctld",
+	HandlerType: (*VtctldServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetKeyspaces",
+			Handler:    _Vtctld_GetKeyspaces_Handler,
+		},
+		{
+			MethodName: "GetKeyspace",
+			Handler:    _Vtctld_GetKeyspace_Handler,
+		},
+		{
+			MethodName: "FindAllShardsInKeyspace",
+			Handler:    _Vtctld_FindAllShardsInKeyspace_Handler,
+		},
+		{
+			MethodName: "GetShard",
+			Handler:    _Vtctld_GetShard_Handler,
+		},
+		{
+			MethodName: "GetTablet",
+			Handler:    _Vtctld_GetTablet_Handler,
+		},
+		{
+			MethodName: "GetTablets",
+			Handler:    _Vtctld_GetTablets_Handler,
+		},
+		{
+			MethodName: "InitTablet",
+			Handler:    _Vtctld_InitTablet_Handler,
+		},
+		{
+			MethodName: "UpdateTabletAddrs",
+			Handler:    _Vtctld_UpdateTabletAddrs_Handler,
+		},
+		{
+			MethodName: "DeleteTablets",
+			Handler:    _Vtctld_DeleteTablets_Handler,
+		},
+		{
+			MethodName: "SetReadOnly",
+			Handler:    _Vtctld_SetReadOnly_Handler,
+		},
+		{
+			MethodName: "SetReadWrite",
+			Handler:    _Vtctld_SetReadWrite_Handler,
+		},
+		{
+			MethodName: "StartSlave",
+			Handler:    _Vtctld_StartSlave_Handler,
+		},
+		{
+			MethodName: "StopSlave",
+			Handler:    _Vtctld_StopSlave_Handler,
+		},
+		{
+			MethodName: "ChangeSlaveType",
+			Handler:    _Vtctld_ChangeSlaveType_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Vtctld_Ping_Handler,
+		},
+		{
+			MethodName: "RefreshState",
+			Handler:    _Vtctld_RefreshState_Handler,
+		},
+		{
+			MethodName: "RefreshStateByShard",
+			Handler:    _Vtctld_RefreshStateByShard_Handler,
+		},
+		{
+			MethodName: "RunHealthCheck",
+			Handler:    _Vtctld_RunHealthCheck_Handler,
+		},
+		{
+			MethodName: "IgnoreHealthError",
+			Handler:    _Vtctld_IgnoreHealthError_Handler,
+		},
+		{
+			MethodName: "ResizeTxPool",
+			Handler:    _Vtctld_ResizeTxPool_Handler,
+		},
+		{
+			MethodName: "Sleep",
+			Handler:    _Vtctld_Sleep_Handler,
+		},
+		{
+			MethodName: "ExecuteHook",
+			Handler:    _Vtctld_ExecuteHook_Handler,
+		},
+		{
+			MethodName: "ExecuteFetchAsApp",
+			Handler:    _Vtctld_ExecuteFetchAsApp_Handler,
+		},
+		{
+			MethodName: "ExecuteFetchAsDba",
+			Handler:    _Vtctld_ExecuteFetchAsDba_Handler,
+		},
+		{
+			MethodName: "VReplicationExec",
+			Handler:    _Vtctld_VReplicationExec_Handler,
+		},
+		{
+			MethodName: "CreateShard",
+			Handler:    _Vtctld_CreateShard_Handler,
+		},
+		{
+			MethodName: "ShardReplicationPositions",
+			Handler:    _Vtctld_ShardReplicationPositions_Handler,
+		},
+		{
+			MethodName: "SetShardIsMasterServing",
+			Handler:    _Vtctld_SetShardIsMasterServing_Handler,
+		},
+		{
+			MethodName: "SetShardTabletControl",
+			Handler:    _Vtctld_SetShardTabletControl_Handler,
+		},
+		{
+			MethodName: "UpdateSrvKeyspacePartition",
+			Handler:    _Vtctld_UpdateSrvKeyspacePartition_Handler,
+		},
+		{
+			MethodName: "SourceShardDelete",
+			Handler:    _Vtctld_SourceShardDelete_Handler,
+		},
+		{
+			MethodName: "SourceShardAdd",
+			Handler:    _Vtctld_SourceShardAdd_Handler,
+		},
+		{
+			MethodName: "ShardReplicationAdd",
+			Handler:    _Vtctld_ShardReplicationAdd_Handler,
+		},
+		{
+			MethodName: "ShardReplicationRemove",
+			Handler:    _Vtctld_ShardReplicationRemove_Handler,
+		},
+		{
+			MethodName: "ShardReplicationFix",
+			Handler:    _Vtctld_ShardReplicationFix_Handler,
+		},
+		{
+			MethodName: "RemoveShardCell",
+			Handler:    _Vtctld_RemoveShardCell_Handler,
+		},
+		{
+			MethodName: "DeleteShards",
+			Handler:    _Vtctld_DeleteShards_Handler,
+		},
+		{
+			MethodName: "CreateKeyspace",
+			Handler:    _Vtctld_CreateKeyspace_Handler,
+		},
+		{
+			MethodName: "DeleteKeyspace",
+			Handler:    _Vtctld_DeleteKeyspace_Handler,
+		},
+		{
+			MethodName: "RemoveKeyspaceCell",
+			Handler:    _Vtctld_RemoveKeyspaceCell_Handler,
+		},
+		{
+			MethodName: "SetKeyspaceShardingInfo",
+			Handler:    _Vtctld_SetKeyspaceShardingInfo_Handler,
+		},
+		{
+			MethodName: "SetKeyspaceDDLStrategy",
+			Handler:    _Vtctld_SetKeyspaceDDLStrategy_Handler,
+		},
+		{
+			MethodName: "SetKeyspaceServedFrom",
+			Handler:    _Vtctld_SetKeyspaceServedFrom_Handler,
+		},
+		{
+			MethodName: "RebuildKeyspaceGraph",
+			Handler:    _Vtctld_RebuildKeyspaceGraph_Handler,
+		},
+		{
+			MethodName: "CreateLookupVindex",
+			Handler:    _Vtctld_CreateLookupVindex_Handler,
+		},
+		{
+			MethodName: "ExternalizeVindex",
+			Handler:    _Vtctld_ExternalizeVindex_Handler,
+		},
+		{
+			MethodName: "SplitClone",
+			Handler:    _Vtctld_SplitClone_Handler,
+		},
+		{
+			MethodName: "VerticalSplitClone",
+			Handler:    _Vtctld_VerticalSplitClone_Handler,
+		},
+		{
+			MethodName: "PauseWorkflow",
+			Handler:    _Vtctld_PauseWorkflow_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflow",
+			Handler:    _Vtctld_ResumeWorkflow_Handler,
+		},
+		{
+			MethodName: "CopyProgress",
+			Handler:    _Vtctld_CopyProgress_Handler,
+		},
+		{
+			MethodName: "CancelResharding",
+			Handler:    _Vtctld_CancelResharding_Handler,
+		},
+		{
+			MethodName: "GetReshardWorkflow",
+			Handler:    _Vtctld_GetReshardWorkflow_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Vtctld_GetSchema_Handler,
+		},
+		{
+			MethodName: "ReloadSchema",
+			Handler:    _Vtctld_ReloadSchema_Handler,
+		},
+		{
+			MethodName: "GetSchemaDrift",
+			Handler:    _Vtctld_GetSchemaDrift_Handler,
+		},
+		{
+			MethodName: "SubmitOnlineDDL",
+			Handler:    _Vtctld_SubmitOnlineDDL_Handler,
+		},
+		{
+			MethodName: "GetOnlineDDLMigrations",
+			Handler:    _Vtctld_GetOnlineDDLMigrations_Handler,
+		},
+		{
+			MethodName: "CancelOnlineDDL",
+			Handler:    _Vtctld_CancelOnlineDDL_Handler,
+		},
+		{
+			MethodName: "RetryOnlineDDL",
+			Handler:    _Vtctld_RetryOnlineDDL_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _Vtctld_GetPermissions_Handler,
+		},
+		{
+			MethodName: "GetVSchema",
+			Handler:    _Vtctld_GetVSchema_Handler,
+		},
+		{
+			MethodName: "ApplyVSchema",
+			Handler:    _Vtctld_ApplyVSchema_Handler,
+		},
+		{
+			MethodName: "GetRoutingRules",
+			Handler:    _Vtctld_GetRoutingRules_Handler,
+		},
+		{
+			MethodName: "ApplyRoutingRules",
+			Handler:    _Vtctld_ApplyRoutingRules_Handler,
+		},
+		{
+			MethodName: "RebuildVSchemaGraph",
+			Handler:    _Vtctld_RebuildVSchemaGraph_Handler,
+		},
+		{
+			MethodName: "GetSrvKeyspaceNames",
+			Handler:    _Vtctld_GetSrvKeyspaceNames_Handler,
+		},
+		{
+			MethodName: "GetSrvKeyspace",
+			Handler:    _Vtctld_GetSrvKeyspace_Handler,
+		},
+		{
+			MethodName: "GetSrvVSchema",
+			Handler:    _Vtctld_GetSrvVSchema_Handler,
+		},
+		{
+			MethodName: "DeleteSrvVSchema",
+			Handler:    _Vtctld_DeleteSrvVSchema_Handler,
+		},
+		{
+			MethodName: "GetShardReplication",
+			Handler:    _Vtctld_GetShardReplication_Handler,
+		},
+		{
+			MethodName: "ReparentTablet",
+			Handler:    _Vtctld_ReparentTablet_Handler,
+		},
+		{
+			MethodName: "TabletExternallyReparented",
+			Handler:    _Vtctld_TabletExternallyReparented_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Vtctld_ListBackups_Handler,
+		},
+		{
+			MethodName: "RemoveBackup",
+			Handler:    _Vtctld_RemoveBackup_Handler,
+		},
+		{
+			MethodName: "PruneBackups",
+			Handler:    _Vtctld_PruneBackups_Handler,
+		},
+		{
+			MethodName: "AddCellInfo",
+			Handler:    _Vtctld_AddCellInfo_Handler,
+		},
+		{
+			MethodName: "UpdateCellInfo",
+			Handler:    _Vtctld_UpdateCellInfo_Handler,
+		},
+		{
+			MethodName: "DeleteCellInfo",
+			Handler:    _Vtctld_DeleteCellInfo_Handler,
+		},
+		{
+			MethodName: "GetCellInfoNames",
+			Handler:    _Vtctld_GetCellInfoNames_Handler,
+		},
+		{
+			MethodName: "GetCellInfo",
+			Handler:    _Vtctld_GetCellInfo_Handler,
+		},
+		{
+			MethodName: "AddCellsAlias",
+			Handler:    _Vtctld_AddCellsAlias_Handler,
+		},
+		{
+			MethodName: "UpdateCellsAlias",
+			Handler:    _Vtctld_UpdateCellsAlias_Handler,
+		},
+		{
+			MethodName: "DeleteCellsAlias",
+			Handler:    _Vtctld_DeleteCellsAlias_Handler,
+		},
+		{
+			MethodName: "GetCellsAliases",
+			Handler:    _Vtctld_GetCellsAliases_Handler,
+		},
+		{
+			MethodName: "ValidateCellsAliases",
+			Handler:    _Vtctld_ValidateCellsAliases_Handler,
+		},
+		{
+			MethodName: "GetServingCells",
+			Handler:    _Vtctld_GetServingCells_Handler,
+		},
+		{
+			MethodName: "ThrottlerMaxRates",
+			Handler:    _Vtctld_ThrottlerMaxRates_Handler,
+		},
+		{
+			MethodName: "ThrottlerSetMaxRate",
+			Handler:    _Vtctld_ThrottlerSetMaxRate_Handler,
+		},
+		{
+			MethodName: "GetThrottlerConfiguration",
+			Handler:    _Vtctld_GetThrottlerConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateThrottlerConfiguration",
+			Handler:    _Vtctld_UpdateThrottlerConfiguration_Handler,
+		},
+		{
+			MethodName: "ResetThrottlerConfiguration",
+			Handler:    _Vtctld_ResetThrottlerConfiguration_Handler,
+		},
+		{
+			MethodName: "TopoCat",
+			Handler:    _Vtctld_TopoCat_Handler,
+		},
+		{
+			MethodName: "WriteTopoFile",
+			Handler:    _Vtctld_WriteTopoFile_Handler,
+		},
+		{
+			MethodName: "ExportTopo",
+			Handler:    _Vtctld_ExportTopo_Handler,
+		},
+		{
+			MethodName: "ImportTopo",
+			Handler:    _Vtctld_ImportTopo_Handler,
+		},
+		{
+			MethodName: "GetTopoAuditLog",
+			Handler:    _Vtctld_GetTopoAuditLog_Handler,
+		},
+		{
+			MethodName: "WorkflowCreate",
+			Handler:    _Vtctld_WorkflowCreate_Handler,
+		},
+		{
+			MethodName: "WorkflowStart",
+			Handler:    _Vtctld_WorkflowStart_Handler,
+		},
+		{
+			MethodName: "WorkflowStop",
+			Handler:    _Vtctld_WorkflowStop_Handler,
+		},
+		{
+			MethodName: "WorkflowDelete",
+			Handler:    _Vtctld_WorkflowDelete_Handler,
+		},
+		{
+			MethodName: "WorkflowWait",
+			Handler:    _Vtctld_WorkflowWait_Handler,
+		},
+		{
+			MethodName: "WorkflowTree",
+			Handler:    _Vtctld_WorkflowTree_Handler,
+		},
+		{
+			MethodName: "WorkflowAction",
+			Handler:    _Vtctld_WorkflowAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Backup",
+			Handler:       _Vtctld_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Reshard",
+			Handler:       _Vtctld_Reshard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateShard",
+			Handler:       _Vtctld_ValidateShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForFilteredReplication",
+			Handler:       _Vtctld_WaitForFilteredReplication_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateKeyspace",
+			Handler:       _Vtctld_ValidateKeyspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MoveTables",
+			Handler:       _Vtctld_MoveTables_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackfillLookupVindex",
+			Handler:       _Vtctld_BackfillLookupVindex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Materialize",
+			Handler:       _Vtctld_Materialize_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VDiff",
+			Handler:       _Vtctld_VDiff_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MigrateServedTypes",
+			Handler:       _Vtctld_MigrateServedTypes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MigrateServedFrom",
+			Handler:       _Vtctld_MigrateServedFrom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SwitchReads",
+			Handler:       _Vtctld_SwitchReads_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SwitchWrites",
+			Handler:       _Vtctld_SwitchWrites_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ShowResharding",
+			Handler:       _Vtctld_ShowResharding_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForDrain",
+			Handler:       _Vtctld_WaitForDrain_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StartReshardWorkflow",
+			Handler:       _Vtctld_StartReshardWorkflow_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReshardWorkflowSwitchReads",
+			Handler:       _Vtctld_ReshardWorkflowSwitchReads_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReshardWorkflowSwitchWrites",
+			Handler:       _Vtctld_ReshardWorkflowSwitchWrites_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReloadSchemaShard",
+			Handler:       _Vtctld_ReloadSchemaShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReloadSchemaKeyspace",
+			Handler:       _Vtctld_ReloadSchemaKeyspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateSchemaShard",
+			Handler:       _Vtctld_ValidateSchemaShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateSchemaKeyspace",
+			Handler:       _Vtctld_ValidateSchemaKeyspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ApplySchema",
+			Handler:       _Vtctld_ApplySchema_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ApplyDeclarativeSchema",
+			Handler:       _Vtctld_ApplyDeclarativeSchema_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CopySchemaShard",
+			Handler:       _Vtctld_CopySchemaShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateVersionShard",
+			Handler:       _Vtctld_ValidateVersionShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateVersionKeyspace",
+			Handler:       _Vtctld_ValidateVersionKeyspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidatePermissionsShard",
+			Handler:       _Vtctld_ValidatePermissionsShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidatePermissionsKeyspace",
+			Handler:       _Vtctld_ValidatePermissionsKeyspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Validate",
+			Handler:       _Vtctld_Validate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InitShardMaster",
+			Handler:       _Vtctld_InitShardMaster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlannedReparentShard",
+			Handler:       _Vtctld_PlannedReparentShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EmergencyReparentShard",
+			Handler:       _Vtctld_EmergencyReparentShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackupShard",
+			Handler:       _Vtctld_BackupShard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VerifyBackup",
+			Handler:       _Vtctld_VerifyBackup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreFromBackup",
+			Handler:       _Vtctld_RestoreFromBackup_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vtctlservice.proto",
+}
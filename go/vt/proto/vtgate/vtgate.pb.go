@@ -142,9 +142,23 @@ type Session struct {
 	FoundRows uint64 `protobuf:"varint,12,opt,name=found_rows,json=foundRows,proto3" json:"found_rows,omitempty"`
 	// user_defined_variables contains all the @variables defined for this session
 	UserDefinedVariables map[string]*query.BindVariable `protobuf:"bytes,13,rep,name=user_defined_variables,json=userDefinedVariables,proto3" json:"user_defined_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	// savepoints keeps track of the savepoints created for nested
+	// BEGIN statements. This is used only if savepoint emulation
+	// is enabled.
+	Savepoints []*Session_Savepoint `protobuf:"bytes,14,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	// read_after_write makes reads from replicas see the writes of the
+	// session. Reads go to replicas which have caught up with the last
+	// write of the session on the shard, or to the master otherwise.
+	ReadAfterWrite bool `protobuf:"varint,15,opt,name=read_after_write,json=readAfterWrite,proto3" json:"read_after_write,omitempty"`
+	// write_gtids keeps track of the GTIDs of the transactions committed
+	// by the session on the masters, keyed by keyspace/shard. An empty
+	// value means that the GTIDs of a transaction are not known. It is
+	// only maintained if read_after_write is set, and requires MySQL to
+	// return the GTIDs of transactions with session_track_gtids.
+	WriteGtids           map[string]string `protobuf:"bytes,16,rep,name=write_gtids,json=writeGtids,proto3" json:"write_gtids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -263,6 +277,27 @@ func (m *Session) GetUserDefinedVariables() map[string]*query.BindVariable {
 	return nil
 }
 
+func (m *Session) GetSavepoints() []*Session_Savepoint {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetReadAfterWrite() bool {
+	if m != nil {
+		return m.ReadAfterWrite
+	}
+	return false
+}
+
+func (m *Session) GetWriteGtids() map[string]string {
+	if m != nil {
+		return m.WriteGtids
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -310,6 +345,63 @@ func (m *Session_ShardSession) GetTransactionId() int64 {
 	return 0
 }
 
+// Savepoint records the number of sessions of each kind that
+// were part of the transaction when the savepoint was created.
+type Session_Savepoint struct {
+	PreSessions          int32    `protobuf:"varint,1,opt,name=pre_sessions,json=preSessions,proto3" json:"pre_sessions,omitempty"`
+	ShardSessions        int32    `protobuf:"varint,2,opt,name=shard_sessions,json=shardSessions,proto3" json:"shard_sessions,omitempty"`
+	PostSessions         int32    `protobuf:"varint,3,opt,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session_Savepoint) Reset()         { *m = Session_Savepoint{} }
+func (m *Session_Savepoint) String() string { return proto.CompactTextString(m) }
+func (*Session_Savepoint) ProtoMessage()    {}
+func (*Session_Savepoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{0, 2}
+}
+
+func (m *Session_Savepoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session_Savepoint.Unmarshal(m, b)
+}
+func (m *Session_Savepoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session_Savepoint.Marshal(b, m, deterministic)
+}
+func (m *Session_Savepoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session_Savepoint.Merge(m, src)
+}
+func (m *Session_Savepoint) XXX_Size() int {
+	return xxx_messageInfo_Session_Savepoint.Size(m)
+}
+func (m *Session_Savepoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session_Savepoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session_Savepoint proto.InternalMessageInfo
+
+func (m *Session_Savepoint) GetPreSessions() int32 {
+	if m != nil {
+		return m.PreSessions
+	}
+	return 0
+}
+
+func (m *Session_Savepoint) GetShardSessions() int32 {
+	if m != nil {
+		return m.ShardSessions
+	}
+	return 0
+}
+
+func (m *Session_Savepoint) GetPostSessions() int32 {
+	if m != nil {
+		return m.PostSessions
+	}
+	return 0
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -835,6 +927,7 @@ type VStreamRequest struct {
 	// position is of the form 'ks1:0@MySQL56/<mysql_pos>|ks2:-80@MySQL56/<mysql_pos>'.
 	Vgtid                *binlogdata.VGtid  `protobuf:"bytes,3,opt,name=vgtid,proto3" json:"vgtid,omitempty"`
 	Filter               *binlogdata.Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Flags                *VStreamFlags      `protobuf:"bytes,5,opt,name=flags,proto3" json:"flags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -893,6 +986,57 @@ func (m *VStreamRequest) GetFilter() *binlogdata.Filter {
 	return nil
 }
 
+func (m *VStreamRequest) GetFlags() *VStreamFlags {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// VStreamFlags are the optional settings of a VStream.
+type VStreamFlags struct {
+	// heartbeat_interval is the interval, in seconds, at which a VGTID
+	// event with the current position and a HEARTBEAT event are sent
+	// if no other event was sent. This lets clients checkpoint and
+	// monitor the lag of idle streams. 0 disables them.
+	HeartbeatInterval    uint32   `protobuf:"varint,1,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VStreamFlags) Reset()         { *m = VStreamFlags{} }
+func (m *VStreamFlags) String() string { return proto.CompactTextString(m) }
+func (*VStreamFlags) ProtoMessage()    {}
+func (*VStreamFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{10}
+}
+
+func (m *VStreamFlags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VStreamFlags.Unmarshal(m, b)
+}
+func (m *VStreamFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VStreamFlags.Marshal(b, m, deterministic)
+}
+func (m *VStreamFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VStreamFlags.Merge(m, src)
+}
+func (m *VStreamFlags) XXX_Size() int {
+	return xxx_messageInfo_VStreamFlags.Size(m)
+}
+func (m *VStreamFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_VStreamFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VStreamFlags proto.InternalMessageInfo
+
+func (m *VStreamFlags) GetHeartbeatInterval() uint32 {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
 // VStreamResponse is streamed by VStream.
 type VStreamResponse struct {
 	Events               []*binlogdata.VEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -905,7 +1049,7 @@ func (m *VStreamResponse) Reset()         { *m = VStreamResponse{} }
 func (m *VStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResponse) ProtoMessage()    {}
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{10}
+	return fileDescriptor_aab96496ceaf1ebb, []int{11}
 }
 
 func (m *VStreamResponse) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1082,9 @@ func init() {
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.WriteGtidsEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
+	proto.RegisterType((*Session_Savepoint)(nil), "vtgate.Session.Savepoint")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
 	proto.RegisterType((*ExecuteBatchRequest)(nil), "vtgate.ExecuteBatchRequest")
@@ -948,78 +1094,89 @@ func init() {
 	proto.RegisterType((*ResolveTransactionRequest)(nil), "vtgate.ResolveTransactionRequest")
 	proto.RegisterType((*ResolveTransactionResponse)(nil), "vtgate.ResolveTransactionResponse")
 	proto.RegisterType((*VStreamRequest)(nil), "vtgate.VStreamRequest")
+	proto.RegisterType((*VStreamFlags)(nil), "vtgate.VStreamFlags")
 	proto.RegisterType((*VStreamResponse)(nil), "vtgate.VStreamResponse")
 }
 
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1058 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x6e, 0x23, 0xb5,
-	0x17, 0xdd, 0xc9, 0xff, 0xdc, 0xfc, 0x9b, 0x9f, 0xb7, 0xbb, 0xbf, 0xd9, 0xb2, 0xa0, 0x28, 0xcb,
-	0x6a, 0xd3, 0x82, 0x12, 0x14, 0x04, 0x42, 0x08, 0x84, 0xda, 0x34, 0xbb, 0x0a, 0x6a, 0x9b, 0xe2,
-	0xa4, 0xad, 0x84, 0x16, 0x8d, 0xa6, 0x19, 0x37, 0xb5, 0x36, 0x1d, 0xcf, 0xda, 0x4e, 0x4a, 0x9e,
-	0x82, 0xef, 0xbc, 0x00, 0xef, 0xc2, 0x37, 0x9e, 0x81, 0x17, 0x41, 0xb6, 0x67, 0x92, 0x69, 0x54,
-	0xd8, 0x6e, 0x57, 0xfd, 0x12, 0xd9, 0xf7, 0x5c, 0xdb, 0xd7, 0xe7, 0xdc, 0xe3, 0x0c, 0x94, 0xe7,
-	0x72, 0xe2, 0x49, 0xd2, 0x0a, 0x39, 0x93, 0x0c, 0xe5, 0xcc, 0x6c, 0xd3, 0x3e, 0xa3, 0xc1, 0x94,
-	0x4d, 0x7c, 0x4f, 0x7a, 0x06, 0xd9, 0x2c, 0xbd, 0x9d, 0x11, 0xbe, 0x88, 0x26, 0x55, 0xc9, 0x42,
-	0x96, 0x04, 0xe7, 0x92, 0x87, 0x63, 0x33, 0x69, 0xfc, 0x9d, 0x83, 0xfc, 0x90, 0x08, 0x41, 0x59,
-	0x80, 0x9e, 0x43, 0x95, 0x06, 0xae, 0xe4, 0x5e, 0x20, 0xbc, 0xb1, 0xa4, 0x2c, 0x70, 0xac, 0xba,
-	0xd5, 0x2c, 0xe0, 0x0a, 0x0d, 0x46, 0xab, 0x20, 0xea, 0x42, 0x55, 0x5c, 0x78, 0xdc, 0x77, 0x85,
-	0x59, 0x27, 0x9c, 0x54, 0x3d, 0xdd, 0x2c, 0x75, 0x9e, 0xb6, 0xa2, 0xea, 0xa2, 0xfd, 0x5a, 0x43,
-	0x95, 0x15, 0x4d, 0x70, 0x45, 0x24, 0x66, 0x02, 0x7d, 0x04, 0x45, 0x41, 0x83, 0xc9, 0x94, 0xb8,
-	0xfe, 0x99, 0x93, 0xd6, 0xc7, 0x14, 0x4c, 0x60, 0xef, 0x0c, 0x7d, 0x02, 0xe0, 0xcd, 0x24, 0x1b,
-	0xb3, 0xcb, 0x4b, 0x2a, 0x9d, 0x8c, 0x46, 0x13, 0x11, 0xf4, 0x0c, 0x2a, 0xd2, 0xe3, 0x13, 0x22,
-	0x5d, 0x21, 0x39, 0x0d, 0x26, 0x4e, 0xb6, 0x6e, 0x35, 0x8b, 0xb8, 0x6c, 0x82, 0x43, 0x1d, 0x43,
-	0x6d, 0xc8, 0xb3, 0x50, 0xea, 0xfa, 0x72, 0x75, 0xab, 0x59, 0xea, 0x3c, 0x6a, 0x19, 0x56, 0x7a,
-	0xbf, 0x92, 0xf1, 0x4c, 0x92, 0x81, 0x01, 0x71, 0x9c, 0x85, 0x76, 0xc1, 0x4e, 0xdc, 0xdd, 0xbd,
-	0x64, 0x3e, 0x71, 0xf2, 0x75, 0xab, 0x59, 0xed, 0xfc, 0x3f, 0xbe, 0x59, 0x82, 0x86, 0x03, 0xe6,
-	0x13, 0x5c, 0x93, 0xd7, 0x03, 0xa8, 0x0d, 0x85, 0x2b, 0x8f, 0x07, 0x34, 0x98, 0x08, 0xa7, 0xa0,
-	0x59, 0x79, 0x18, 0x9d, 0xfa, 0x93, 0xfa, 0x3d, 0x35, 0x18, 0x5e, 0x26, 0xa1, 0x1f, 0xa0, 0x1c,
-	0x72, 0xb2, 0xa2, 0xb2, 0x78, 0x0b, 0x2a, 0x4b, 0x21, 0x27, 0x4b, 0x22, 0x77, 0xa0, 0x12, 0x32,
-	0x21, 0x57, 0x3b, 0xc0, 0x2d, 0x76, 0x28, 0xab, 0x25, 0xcb, 0x2d, 0x3e, 0x85, 0xea, 0xd4, 0x13,
-	0xd2, 0xa5, 0x81, 0x20, 0x5c, 0xba, 0xd4, 0x77, 0x4a, 0x75, 0xab, 0x99, 0xc1, 0x65, 0x15, 0xed,
-	0xeb, 0x60, 0xdf, 0x47, 0x1f, 0x03, 0x9c, 0xb3, 0x59, 0xe0, 0xbb, 0x9c, 0x5d, 0x09, 0xa7, 0xac,
-	0x33, 0x8a, 0x3a, 0x82, 0xd9, 0x95, 0x40, 0x2e, 0x3c, 0x9e, 0x09, 0xc2, 0x5d, 0x9f, 0x9c, 0xd3,
-	0x80, 0xf8, 0xee, 0xdc, 0xe3, 0xd4, 0x3b, 0x9b, 0x12, 0xe1, 0x54, 0x74, 0x41, 0x5b, 0xeb, 0x05,
-	0x1d, 0x0b, 0xc2, 0xf7, 0x4c, 0xf2, 0x49, 0x9c, 0xdb, 0x0b, 0x24, 0x5f, 0xe0, 0x8d, 0xd9, 0x0d,
-	0xd0, 0xe6, 0x6b, 0x28, 0x27, 0xef, 0x80, 0x9e, 0x43, 0xce, 0xe8, 0xad, 0xbb, 0xb4, 0xd4, 0xa9,
-	0x44, 0x44, 0x8f, 0x74, 0x10, 0x47, 0xa0, 0x6a, 0xea, 0xa4, 0xaa, 0xd4, 0x77, 0x52, 0x75, 0xab,
-	0x99, 0xc6, 0x95, 0x44, 0xb4, 0xef, 0x6f, 0xbe, 0x86, 0x27, 0xff, 0x5a, 0x10, 0xb2, 0x21, 0xfd,
-	0x86, 0x2c, 0xf4, 0x39, 0x45, 0xac, 0x86, 0x68, 0x0b, 0xb2, 0x73, 0x6f, 0x3a, 0x23, 0x7a, 0xb3,
-	0x95, 0xc8, 0xbb, 0x34, 0x58, 0xae, 0xc5, 0x26, 0xe3, 0xdb, 0xd4, 0x37, 0x56, 0xe3, 0x8f, 0x14,
-	0x54, 0xa3, 0xb6, 0xc3, 0xe4, 0xed, 0x8c, 0x08, 0x89, 0x3e, 0x87, 0xe2, 0xd8, 0x9b, 0x4e, 0x09,
-	0x57, 0x25, 0x99, 0x1b, 0xd4, 0x5a, 0xc6, 0x99, 0x5d, 0x1d, 0xef, 0xef, 0xe1, 0x82, 0xc9, 0xe8,
-	0xfb, 0x68, 0x0b, 0xf2, 0x91, 0xc0, 0xd1, 0x89, 0xb5, 0x35, 0x3a, 0x71, 0x8c, 0xa3, 0x17, 0x90,
-	0xd5, 0xc5, 0x68, 0x57, 0x95, 0x3a, 0xff, 0x8b, 0x4b, 0x53, 0x4a, 0xe9, 0x26, 0xc4, 0x06, 0x47,
-	0x5f, 0x41, 0x49, 0xaa, 0x42, 0xa5, 0x2b, 0x17, 0x21, 0xd1, 0x36, 0xab, 0x76, 0x36, 0x5a, 0xcb,
-	0xd7, 0x62, 0xa4, 0xc1, 0xd1, 0x22, 0x24, 0x18, 0xe4, 0x72, 0xac, 0x08, 0x7d, 0x43, 0x16, 0x22,
-	0xf4, 0xc6, 0xc4, 0xd5, 0x9e, 0xd6, 0xf6, 0x2a, 0xe2, 0x4a, 0x1c, 0xd5, 0x2a, 0x25, 0xed, 0x97,
-	0xbf, 0x8d, 0xfd, 0x7e, 0xcc, 0x14, 0xb2, 0x76, 0xae, 0xf1, 0x9b, 0x05, 0xb5, 0x25, 0x53, 0x22,
-	0x64, 0x81, 0x50, 0x27, 0x66, 0x09, 0xe7, 0x8c, 0xaf, 0xd1, 0x84, 0x8f, 0xba, 0x3d, 0x15, 0xc6,
-	0x06, 0x7d, 0x1f, 0x8e, 0xb6, 0x21, 0xc7, 0x89, 0x98, 0x4d, 0x65, 0x44, 0x12, 0x4a, 0x9a, 0x14,
-	0x6b, 0x04, 0x47, 0x19, 0x8d, 0xbf, 0x52, 0xf0, 0x30, 0xaa, 0x68, 0xd7, 0x93, 0xe3, 0x8b, 0x7b,
-	0x17, 0xf0, 0x33, 0xc8, 0xab, 0x6a, 0x28, 0x11, 0x4e, 0x5a, 0x5b, 0xe7, 0x06, 0x09, 0xe3, 0x8c,
-	0x0f, 0x10, 0xd1, 0x13, 0xd7, 0x9e, 0xfa, 0xac, 0x79, 0xea, 0x3d, 0x91, 0x7c, 0xea, 0xef, 0x49,
-	0xeb, 0xc6, 0xef, 0x16, 0x6c, 0x5c, 0xe7, 0xf4, 0xde, 0xa4, 0xfe, 0x02, 0xf2, 0x46, 0xc8, 0x98,
-	0xcd, 0xc7, 0x51, 0x6d, 0x46, 0xe6, 0x53, 0x2a, 0x2f, 0xcc, 0xd6, 0x71, 0x9a, 0x32, 0xeb, 0xc6,
-	0x50, 0x72, 0xe2, 0x5d, 0x7e, 0x90, 0x65, 0x97, 0x3e, 0x4c, 0xbd, 0x9f, 0x0f, 0xd3, 0x77, 0xf6,
-	0x61, 0xe6, 0x1d, 0xda, 0x64, 0x6f, 0xf5, 0x37, 0x98, 0xe0, 0x36, 0xf7, 0xdf, 0xdc, 0x36, 0xba,
-	0xf0, 0x68, 0x8d, 0xa8, 0x48, 0xc6, 0x95, 0xbf, 0xac, 0x77, 0xfa, 0xeb, 0x17, 0x78, 0x82, 0x89,
-	0x60, 0xd3, 0x39, 0x49, 0x74, 0xde, 0xdd, 0x28, 0x47, 0x90, 0xf1, 0x65, 0xf4, 0xc2, 0x17, 0xb1,
-	0x1e, 0x37, 0x9e, 0xc2, 0xe6, 0x4d, 0xdb, 0x9b, 0x42, 0x1b, 0x7f, 0x5a, 0x50, 0x3d, 0x31, 0x77,
-	0xb8, 0xdb, 0x91, 0x6b, 0xe2, 0xa5, 0x6e, 0x29, 0xde, 0x0b, 0xc8, 0xce, 0x27, 0xaa, 0xd4, 0xf8,
-	0x91, 0x4e, 0x7c, 0xc2, 0x9d, 0xbc, 0x92, 0xd4, 0xc7, 0x06, 0x57, 0x4c, 0x9e, 0xd3, 0xa9, 0x24,
-	0x5c, 0xab, 0xab, 0x98, 0x4c, 0x64, 0xbe, 0xd4, 0x08, 0x8e, 0x32, 0x1a, 0xdf, 0x43, 0x6d, 0x79,
-	0x97, 0x95, 0x10, 0x64, 0x4e, 0x02, 0x29, 0x1c, 0x4b, 0x37, 0xff, 0xb5, 0xe5, 0x27, 0x3d, 0x05,
-	0xe1, 0x28, 0x63, 0x7b, 0x0f, 0x6a, 0x6b, 0xdf, 0x37, 0xa8, 0x06, 0xa5, 0xe3, 0xc3, 0xe1, 0x51,
-	0xaf, 0xdb, 0x7f, 0xd9, 0xef, 0xed, 0xd9, 0x0f, 0x10, 0x40, 0x6e, 0xd8, 0x3f, 0x7c, 0xb5, 0xdf,
-	0xb3, 0x2d, 0x54, 0x84, 0xec, 0xc1, 0xf1, 0xfe, 0xa8, 0x6f, 0xa7, 0xd4, 0x70, 0x74, 0x3a, 0x38,
-	0xea, 0xda, 0xe9, 0xed, 0xef, 0xa0, 0xd4, 0xd5, 0x5f, 0x69, 0x03, 0xee, 0x13, 0xae, 0x16, 0x1c,
-	0x0e, 0xf0, 0xc1, 0xce, 0xbe, 0xfd, 0x00, 0xe5, 0x21, 0x7d, 0x84, 0xd5, 0xca, 0x02, 0x64, 0x8e,
-	0x06, 0xc3, 0x91, 0x9d, 0x42, 0x55, 0x80, 0x9d, 0xe3, 0xd1, 0xa0, 0x3b, 0x38, 0x38, 0xe8, 0x8f,
-	0xec, 0xf4, 0xee, 0xd7, 0x50, 0xa3, 0xac, 0x35, 0xa7, 0x92, 0x08, 0x61, 0xbe, 0x50, 0x7f, 0x7e,
-	0x16, 0xcd, 0x28, 0x6b, 0x9b, 0x51, 0x7b, 0xc2, 0xda, 0x73, 0xd9, 0xd6, 0x68, 0xdb, 0xb4, 0xe6,
-	0x59, 0x4e, 0xcf, 0xbe, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x19, 0x5b, 0xd5, 0x21, 0x0b,
-	0x00, 0x00,
+	// 1221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xee, 0xfa, 0xdf, 0xc7, 0x7f, 0x5b, 0x35, 0x2d, 0x5b, 0x53, 0xc0, 0xb8, 0x74, 0xea, 0x06,
+	0xb0, 0x99, 0x30, 0x30, 0xc0, 0xd0, 0x81, 0xc4, 0x71, 0x3b, 0x66, 0x9a, 0x3a, 0xc8, 0x4e, 0x32,
+	0xc3, 0x94, 0xd9, 0x51, 0xbc, 0x8a, 0xa3, 0xa9, 0xb3, 0xbb, 0x95, 0x64, 0x87, 0xf0, 0x12, 0xdc,
+	0xf3, 0x02, 0x3c, 0x09, 0x0f, 0xc0, 0xcb, 0x70, 0xcd, 0x48, 0xda, 0xdd, 0x6c, 0x4c, 0xa0, 0x69,
+	0x3b, 0xbd, 0xf1, 0xac, 0xce, 0xf7, 0x49, 0x3a, 0xfa, 0xce, 0x8f, 0x64, 0xa8, 0x2e, 0xe5, 0x8c,
+	0x48, 0xda, 0x0d, 0x79, 0x20, 0x03, 0x54, 0x30, 0xa3, 0xa6, 0x7d, 0xc8, 0xfc, 0x79, 0x30, 0xf3,
+	0x88, 0x24, 0x06, 0x69, 0x56, 0x5e, 0x2c, 0x28, 0x3f, 0x8b, 0x06, 0x75, 0x19, 0x84, 0x41, 0x1a,
+	0x5c, 0x4a, 0x1e, 0x4e, 0xcd, 0xa0, 0xfd, 0x67, 0x19, 0x8a, 0x63, 0x2a, 0x04, 0x0b, 0x7c, 0x74,
+	0x0f, 0xea, 0xcc, 0x77, 0x25, 0x27, 0xbe, 0x20, 0x53, 0xc9, 0x02, 0xdf, 0xb1, 0x5a, 0x56, 0xa7,
+	0x84, 0x6b, 0xcc, 0x9f, 0x9c, 0x1b, 0x51, 0x1f, 0xea, 0xe2, 0x98, 0x70, 0xcf, 0x15, 0x66, 0x9e,
+	0x70, 0x32, 0xad, 0x6c, 0xa7, 0xb2, 0x71, 0xa7, 0x1b, 0x79, 0x17, 0xad, 0xd7, 0x1d, 0x2b, 0x56,
+	0x34, 0xc0, 0x35, 0x91, 0x1a, 0x09, 0xf4, 0x2e, 0x94, 0x05, 0xf3, 0x67, 0x73, 0xea, 0x7a, 0x87,
+	0x4e, 0x56, 0x6f, 0x53, 0x32, 0x86, 0xed, 0x43, 0xf4, 0x3e, 0x00, 0x59, 0xc8, 0x60, 0x1a, 0x9c,
+	0x9c, 0x30, 0xe9, 0xe4, 0x34, 0x9a, 0xb2, 0xa0, 0xbb, 0x50, 0x93, 0x84, 0xcf, 0xa8, 0x74, 0x85,
+	0xe4, 0xcc, 0x9f, 0x39, 0xf9, 0x96, 0xd5, 0x29, 0xe3, 0xaa, 0x31, 0x8e, 0xb5, 0x0d, 0xf5, 0xa0,
+	0x18, 0x84, 0x52, 0xfb, 0x57, 0x68, 0x59, 0x9d, 0xca, 0xc6, 0xcd, 0xae, 0x51, 0x65, 0xf0, 0x0b,
+	0x9d, 0x2e, 0x24, 0x1d, 0x19, 0x10, 0xc7, 0x2c, 0xb4, 0x05, 0x76, 0xea, 0xec, 0xee, 0x49, 0xe0,
+	0x51, 0xa7, 0xd8, 0xb2, 0x3a, 0xf5, 0x8d, 0x77, 0xe2, 0x93, 0xa5, 0x64, 0xd8, 0x09, 0x3c, 0x8a,
+	0x1b, 0xf2, 0xa2, 0x01, 0xf5, 0xa0, 0x74, 0x4a, 0xb8, 0xcf, 0xfc, 0x99, 0x70, 0x4a, 0x5a, 0x95,
+	0x1b, 0xd1, 0xae, 0x3f, 0xaa, 0xdf, 0x03, 0x83, 0xe1, 0x84, 0x84, 0xbe, 0x83, 0x6a, 0xc8, 0xe9,
+	0xb9, 0x94, 0xe5, 0x2b, 0x48, 0x59, 0x09, 0x39, 0x4d, 0x84, 0xdc, 0x84, 0x5a, 0x18, 0x08, 0x79,
+	0xbe, 0x02, 0x5c, 0x61, 0x85, 0xaa, 0x9a, 0x92, 0x2c, 0xf1, 0x11, 0xd4, 0xe7, 0x44, 0x48, 0x97,
+	0xf9, 0x82, 0x72, 0xe9, 0x32, 0xcf, 0xa9, 0xb4, 0xac, 0x4e, 0x0e, 0x57, 0x95, 0x75, 0xa8, 0x8d,
+	0x43, 0x0f, 0xbd, 0x07, 0x70, 0x14, 0x2c, 0x7c, 0xcf, 0xe5, 0xc1, 0xa9, 0x70, 0xaa, 0x9a, 0x51,
+	0xd6, 0x16, 0x1c, 0x9c, 0x0a, 0xe4, 0xc2, 0xad, 0x85, 0xa0, 0xdc, 0xf5, 0xe8, 0x11, 0xf3, 0xa9,
+	0xe7, 0x2e, 0x09, 0x67, 0xe4, 0x70, 0x4e, 0x85, 0x53, 0xd3, 0x0e, 0x3d, 0x58, 0x75, 0x68, 0x4f,
+	0x50, 0xbe, 0x6d, 0xc8, 0xfb, 0x31, 0x77, 0xe0, 0x4b, 0x7e, 0x86, 0xd7, 0x16, 0x97, 0x40, 0xe8,
+	0x6b, 0x00, 0x41, 0x96, 0x34, 0x0c, 0x98, 0x2f, 0x85, 0x53, 0xd7, 0x8b, 0xde, 0xfe, 0xd7, 0x29,
+	0x63, 0x06, 0x4e, 0x91, 0x51, 0x07, 0x6c, 0x4e, 0x89, 0xe7, 0x92, 0x23, 0x49, 0xb9, 0x7b, 0xca,
+	0x99, 0xa4, 0x4e, 0x43, 0x67, 0x55, 0x5d, 0xd9, 0x37, 0x95, 0xf9, 0x40, 0x59, 0xd1, 0xf7, 0x50,
+	0xd1, 0xb0, 0x3b, 0x93, 0xcc, 0x13, 0x8e, 0xad, 0x77, 0xf9, 0x60, 0x75, 0x17, 0xcd, 0x7d, 0xac,
+	0x18, 0xc6, 0x61, 0x38, 0x4d, 0x0c, 0xcd, 0x67, 0x50, 0x4d, 0x4b, 0x8d, 0xee, 0x41, 0xc1, 0xa4,
+	0xa5, 0x2e, 0xa6, 0xca, 0x46, 0x2d, 0xca, 0x87, 0x89, 0x36, 0xe2, 0x08, 0x54, 0xb5, 0x97, 0x4e,
+	0x3e, 0xe6, 0x39, 0x99, 0x96, 0xd5, 0xc9, 0xe2, 0x5a, 0xca, 0x3a, 0xf4, 0x9a, 0xcf, 0xe0, 0xf6,
+	0x7f, 0xea, 0x86, 0x6c, 0xc8, 0x3e, 0xa7, 0x67, 0x7a, 0x9f, 0x32, 0x56, 0x9f, 0xe8, 0x01, 0xe4,
+	0x97, 0x64, 0xbe, 0xa0, 0x7a, 0xb1, 0xf3, 0x5c, 0xdc, 0x62, 0x7e, 0x32, 0x17, 0x1b, 0xc6, 0x37,
+	0x99, 0xaf, 0xac, 0xe6, 0xaf, 0x50, 0x4e, 0x04, 0x44, 0x1f, 0xae, 0x64, 0xa6, 0x5a, 0x36, 0x7f,
+	0x31, 0xf7, 0xee, 0x5d, 0xd2, 0x09, 0x14, 0x69, 0xa5, 0xd6, 0xef, 0xae, 0xa6, 0x68, 0x56, 0xb3,
+	0x2e, 0x24, 0x61, 0xf3, 0x21, 0x34, 0x56, 0x64, 0xbd, 0xe4, 0x3c, 0x6b, 0xe9, 0xf3, 0x94, 0x53,
+	0xae, 0xb7, 0xff, 0xc8, 0x40, 0x3d, 0x2a, 0x6c, 0x4c, 0x5f, 0x2c, 0xa8, 0x90, 0xe8, 0x13, 0x28,
+	0x4f, 0xc9, 0x7c, 0x4e, 0xb9, 0x52, 0xd3, 0x88, 0xdf, 0xe8, 0x9a, 0xde, 0xd7, 0xd7, 0xf6, 0xe1,
+	0x36, 0x2e, 0x19, 0xc6, 0xd0, 0x43, 0x0f, 0xa0, 0x18, 0xf9, 0xe7, 0x64, 0x12, 0x6e, 0x3a, 0xea,
+	0x38, 0xc6, 0xd1, 0x7d, 0xc8, 0x6b, 0x1d, 0xf5, 0x39, 0x2a, 0x1b, 0xd7, 0x63, 0x55, 0x55, 0x2d,
+	0xe8, 0x32, 0xc7, 0x06, 0x47, 0x5f, 0x40, 0x45, 0x2a, 0x8d, 0xa5, 0x2b, 0xcf, 0x42, 0xaa, 0x1b,
+	0x59, 0x7d, 0x63, 0xad, 0x9b, 0xf4, 0xe3, 0x89, 0x06, 0x27, 0x67, 0x21, 0xc5, 0x20, 0x93, 0x6f,
+	0x25, 0xeb, 0x73, 0x7a, 0x26, 0x42, 0x32, 0xa5, 0xae, 0x56, 0x52, 0x37, 0xb0, 0x32, 0xae, 0xc5,
+	0x56, 0x9d, 0x60, 0xe9, 0x06, 0x57, 0xbc, 0x4a, 0x83, 0xfb, 0x21, 0x57, 0xca, 0xdb, 0x85, 0xf6,
+	0x6f, 0x16, 0x34, 0x12, 0xa5, 0x44, 0x18, 0xf8, 0x42, 0xed, 0x98, 0xa7, 0x9c, 0x07, 0x7c, 0x45,
+	0x26, 0xbc, 0xdb, 0x1f, 0x28, 0x33, 0x36, 0xe8, 0xab, 0x68, 0xb4, 0x0e, 0x05, 0x4e, 0xc5, 0x62,
+	0x2e, 0x23, 0x91, 0x50, 0xba, 0x0d, 0x62, 0x8d, 0xe0, 0x88, 0xd1, 0xfe, 0x2b, 0x03, 0x37, 0x22,
+	0x8f, 0xb6, 0x88, 0x9c, 0x1e, 0xbf, 0xf5, 0x00, 0x7e, 0x0c, 0x45, 0xe5, 0x0d, 0xa3, 0x2a, 0x15,
+	0xb3, 0x97, 0x87, 0x30, 0x66, 0xbc, 0x41, 0x10, 0x89, 0xb8, 0x70, 0x99, 0xe6, 0xcd, 0x65, 0x4a,
+	0x44, 0xfa, 0x32, 0x7d, 0x4b, 0xb1, 0x6e, 0xff, 0x6e, 0xc1, 0xda, 0x45, 0x4d, 0xdf, 0x5a, 0xa8,
+	0x3f, 0x83, 0xa2, 0x09, 0x64, 0xac, 0xe6, 0xad, 0xc8, 0x37, 0x13, 0xe6, 0x03, 0x26, 0x8f, 0xcd,
+	0xd2, 0x31, 0x4d, 0x15, 0xeb, 0xda, 0x58, 0x72, 0x4a, 0x4e, 0xde, 0xa8, 0x64, 0x93, 0x3a, 0xcc,
+	0xbc, 0x5a, 0x1d, 0x66, 0x5f, 0xbb, 0x0e, 0x73, 0x2f, 0x89, 0x4d, 0xfe, 0x4a, 0x0f, 0x8d, 0x94,
+	0xb6, 0x85, 0xff, 0xd7, 0xb6, 0xdd, 0x87, 0x9b, 0x2b, 0x42, 0x45, 0x61, 0x3c, 0xaf, 0x2f, 0xeb,
+	0xa5, 0xf5, 0xf5, 0x33, 0xdc, 0xc6, 0x54, 0x04, 0xf3, 0x25, 0x4d, 0x65, 0xde, 0xeb, 0x49, 0x8e,
+	0x20, 0xe7, 0xc9, 0xe8, 0x72, 0x2a, 0x63, 0xfd, 0xdd, 0xbe, 0x03, 0xcd, 0xcb, 0x96, 0x37, 0x8e,
+	0xb6, 0xff, 0xb6, 0xa0, 0xbe, 0x6f, 0xce, 0xf0, 0x7a, 0x5b, 0xae, 0x04, 0x2f, 0x73, 0xc5, 0xe0,
+	0xdd, 0x87, 0xfc, 0x52, 0x5d, 0xe2, 0x49, 0x93, 0x4e, 0x3d, 0x92, 0xf7, 0xd5, 0x25, 0x83, 0x0d,
+	0xae, 0x94, 0x3c, 0x62, 0x73, 0x49, 0xb9, 0x93, 0x8b, 0x94, 0x4c, 0x31, 0x1f, 0x69, 0x04, 0x47,
+	0x0c, 0xb4, 0x0e, 0xf9, 0xa3, 0x39, 0x99, 0xc5, 0x81, 0x5e, 0x8b, 0xe3, 0x16, 0x1d, 0xf0, 0x91,
+	0xc2, 0xb0, 0xa1, 0xb4, 0x1f, 0x42, 0x35, 0x6d, 0x46, 0x9f, 0x02, 0x3a, 0xa6, 0x84, 0xcb, 0x43,
+	0x4a, 0xd4, 0x53, 0x4b, 0x52, 0xbe, 0x24, 0x73, 0x7d, 0xfc, 0x1a, 0xbe, 0x9e, 0x20, 0xc3, 0x08,
+	0x68, 0x3f, 0x84, 0x46, 0x22, 0xdb, 0x79, 0xcc, 0xe9, 0x92, 0xaa, 0xd7, 0x8f, 0xd5, 0xca, 0xae,
+	0x7a, 0xba, 0x3f, 0x50, 0x10, 0x8e, 0x18, 0xeb, 0xdb, 0xd0, 0x58, 0x79, 0xac, 0xa2, 0x06, 0x54,
+	0xf6, 0x9e, 0x8e, 0x77, 0x07, 0xfd, 0xe1, 0xa3, 0xe1, 0x60, 0xdb, 0xbe, 0x86, 0x00, 0x0a, 0xe3,
+	0xe1, 0xd3, 0xc7, 0x4f, 0x06, 0xb6, 0x85, 0xca, 0x90, 0xdf, 0xd9, 0x7b, 0x32, 0x19, 0xda, 0x19,
+	0xf5, 0x39, 0x39, 0x18, 0xed, 0xf6, 0xed, 0xec, 0xfa, 0xb7, 0x50, 0xe9, 0xeb, 0x27, 0xf7, 0x88,
+	0x7b, 0x94, 0xab, 0x09, 0x4f, 0x47, 0x78, 0x67, 0xf3, 0x89, 0x7d, 0x0d, 0x15, 0x21, 0xbb, 0x8b,
+	0xd5, 0xcc, 0x12, 0xe4, 0x76, 0x47, 0xe3, 0x89, 0x9d, 0x41, 0x75, 0x80, 0xcd, 0xbd, 0xc9, 0xa8,
+	0x3f, 0xda, 0xd9, 0x19, 0x4e, 0xec, 0xec, 0xd6, 0x97, 0xd0, 0x60, 0x41, 0x77, 0xc9, 0x24, 0x15,
+	0xc2, 0xfc, 0xdd, 0xf8, 0xe9, 0x6e, 0x34, 0x62, 0x41, 0xcf, 0x7c, 0xf5, 0x66, 0x41, 0x6f, 0x29,
+	0x7b, 0x1a, 0xed, 0x19, 0x35, 0x0f, 0x0b, 0x7a, 0xf4, 0xf9, 0x3f, 0x03, 0x00, 0x5e, 0x9f, 0xe9,
+	0xca, 0xee, 0x0c, 0x00, 0x00,
 }
@@ -92,6 +92,54 @@ type ShardResult struct {
 	Position string
 }
 
+// DryRunReport describes what applying schema changes would do to a
+// keyspace, so an operator can decide whether to apply them.
+type DryRunReport struct {
+	Keyspace    string
+	DDLStrategy string
+	Statements  []*StatementReport
+	// PreflightError is the error returned when the statements are
+	// applied to a scratch copy of the schema of the first shard.
+	PreflightError string
+	// Safe is false if the preflight failed or one of the statements
+	// is a big schema change.
+	Safe bool
+}
+
+// StatementReport describes what applying a statement would do.
+type StatementReport struct {
+	SQL    string
+	Action string
+	Tables []string
+	// EstimatedRows is the approximate number of rows copied or
+	// deleted by the statement, summed over all shards.
+	EstimatedRows uint64
+	// ChangesSchema is set if the preflight shows that the
+	// statement changes the table definitions.
+	ChangesSchema bool
+	// BigSchemaChange explains why the statement is rejected without
+	// -allow_long_unavailability on one of the shards, if it is.
+	BigSchemaChange string
+}
+
+// DryRun reads the schema changes of the controller and reports what
+// they would do on its keyspace, without applying them.
+func DryRun(ctx context.Context, controller Controller, executor *TabletExecutor) (*DryRunReport, error) {
+	if err := controller.Open(ctx); err != nil {
+		return nil, err
+	}
+	defer controller.Close()
+	sqls, err := controller.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := executor.Open(ctx, controller.Keyspace()); err != nil {
+		return nil, err
+	}
+	defer executor.Close()
+	return executor.DryRun(ctx, sqls)
+}
+
 // Run applies schema changes on Vitess through VtGate.
 func Run(ctx context.Context, controller Controller, executor Executor) error {
 	if err := controller.Open(ctx); err != nil {
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -243,8 +244,13 @@ func newFakeTabletManagerClient() *fakeTabletManagerClient {
 type fakeTabletManagerClient struct {
 	tmclient.TabletManagerClient
 	EnableExecuteFetchAsDbaError bool
+	EnablePreflightSchemaError   bool
 	preflightSchemas             map[string]*tabletmanagerdatapb.SchemaChangeResult
 	schemaDefinitions            map[string]*tabletmanagerdatapb.SchemaDefinition
+
+	mu sync.Mutex
+	// appliedSchemaChanges lists the changes sent to ApplySchema.
+	appliedSchemaChanges []string
 }
 
 func (client *fakeTabletManagerClient) AddSchemaChange(sql string, schemaResult *tabletmanagerdatapb.SchemaChangeResult) {
@@ -257,6 +263,9 @@ func (client *fakeTabletManagerClient) AddSchemaDefinition(
 }
 
 func (client *fakeTabletManagerClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
+	if client.EnablePreflightSchemaError {
+		return nil, fmt.Errorf("PreflightSchema occur an unknown error")
+	}
 	var result []*tabletmanagerdatapb.SchemaChangeResult
 	for _, change := range changes {
 		scr, ok := client.preflightSchemas[change]
@@ -284,6 +293,13 @@ func (client *fakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, ta
 	return client.TabletManagerClient.ExecuteFetchAsDba(ctx, tablet, usePool, query, maxRows, disableBinlogs, reloadSchema)
 }
 
+func (client *fakeTabletManagerClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.appliedSchemaChanges = append(client.appliedSchemaChanges, change.SQL)
+	return &tabletmanagerdatapb.SchemaChangeResult{}, nil
+}
+
 // newFakeTopo returns a topo with:
 // - a keyspace named 'test_keyspace'.
 // - 3 shards named '1', '2', '3'.
@@ -24,9 +24,11 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -38,6 +40,10 @@ type TabletExecutor struct {
 	allowBigSchemaChange bool
 	keyspace             string
 	waitSlaveTimeout     time.Duration
+	// ddlStrategy is the DDL strategy of the keyspace. The changes
+	// of the other strategies than direct are sent to the ApplySchema
+	// RPC of the tablets, which apply them with the strategy.
+	ddlStrategy string
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
@@ -68,6 +74,14 @@ func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 		return nil
 	}
 	exec.keyspace = keyspace
+	ki, err := exec.wr.TopoServer().GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return fmt.Errorf("unable to get keyspace: %s, error: %v", keyspace, err)
+	}
+	exec.ddlStrategy, err = tmutils.ParseDDLStrategy(ki.DdlStrategy)
+	if err != nil {
+		return fmt.Errorf("keyspace: %s, error: %v", keyspace, err)
+	}
 	shardNames, err := exec.wr.TopoServer().GetShardNames(ctx, keyspace)
 	if err != nil {
 		return fmt.Errorf("unable to get shard names for keyspace: %s, error: %v", keyspace, err)
@@ -107,6 +121,10 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 	if err != nil {
 		return err
 	}
+	// Online DDL migrations don't make the tables unavailable.
+	if exec.ddlStrategy == tmutils.DDLStrategyOnline {
+		return nil
+	}
 
 	bigSchemaChange, err := exec.detectBigSchemaChanges(ctx, parsedDDLs)
 	if bigSchemaChange && exec.allowBigSchemaChange {
@@ -157,30 +175,109 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 		tableWithCount[tableSchema.Name] = tableSchema.RowCount
 	}
 	for _, ddl := range parsedDDLs {
-		switch ddl.Action {
-		case sqlparser.DropStr, sqlparser.CreateStr, sqlparser.TruncateStr, sqlparser.RenameStr:
-			continue
-		}
 		tableName := ddl.Table.Name.String()
 		if rowCount, ok := tableWithCount[tableName]; ok {
-			if rowCount > 100000 && ddl.Action == sqlparser.AlterStr {
-				return true, fmt.Errorf(
-					"big schema change detected. Disable check with -allow_long_unavailability. ddl: %s alters a table with more than 100 thousand rows", sqlparser.String(ddl))
-			}
-			if rowCount > 2000000 {
+			if reason := bigSchemaChange(ddl, rowCount); reason != "" {
 				return true, fmt.Errorf(
-					"big schema change detected. Disable check with -allow_long_unavailability. ddl: %s changes a table with more than 2 million rows", sqlparser.String(ddl))
+					"big schema change detected. Disable check with -allow_long_unavailability. ddl: %s %s", sqlparser.String(ddl), reason)
 			}
 		}
 	}
 	return false, nil
 }
 
+// bigSchemaChange returns why the ddl is a big schema change for a
+// table with rowCount rows, or an empty string if it isn't.
+func bigSchemaChange(ddl *sqlparser.DDL, rowCount uint64) string {
+	switch ddl.Action {
+	case sqlparser.DropStr, sqlparser.CreateStr, sqlparser.TruncateStr, sqlparser.RenameStr:
+		return ""
+	}
+	if rowCount > 100000 && ddl.Action == sqlparser.AlterStr {
+		return "alters a table with more than 100 thousand rows"
+	}
+	if rowCount > 2000000 {
+		return "changes a table with more than 2 million rows"
+	}
+	return ""
+}
+
 func (exec *TabletExecutor) preflightSchemaChanges(ctx context.Context, sqls []string) error {
 	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
 	return err
 }
 
+// DryRun reports what applying the schema changes would do, without
+// applying them. The changes are applied to a scratch copy of the
+// schema of the first shard, and the affected rows are estimated from
+// the table statistics of every shard.
+func (exec *TabletExecutor) DryRun(ctx context.Context, sqls []string) (*DryRunReport, error) {
+	if exec.isClosed {
+		return nil, fmt.Errorf("executor is closed")
+	}
+	report := &DryRunReport{
+		Keyspace:    exec.keyspace,
+		DDLStrategy: exec.ddlStrategy,
+		Statements:  make([]*StatementReport, len(sqls)),
+	}
+
+	// rowCounts maps the tables to their row count on every shard.
+	rowCounts := make(map[string][]uint64)
+	for _, tablet := range exec.tablets {
+		dbSchema, err := exec.wr.TabletManagerClient().GetSchema(ctx, tablet, nil, nil, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get database schema of shard %s, error: %v", tablet.Shard, err)
+		}
+		for _, td := range dbSchema.TableDefinitions {
+			rowCounts[td.Name] = append(rowCounts[td.Name], td.RowCount)
+		}
+	}
+
+	for i, sql := range sqls {
+		stat, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+		}
+		statement := &StatementReport{SQL: sql}
+		report.Statements[i] = statement
+		ddl, ok := stat.(*sqlparser.DDL)
+		if !ok {
+			continue
+		}
+		statement.Action = ddl.Action
+		for _, table := range ddl.AffectedTables() {
+			tableName := table.Name.String()
+			statement.Tables = append(statement.Tables, tableName)
+			for _, rowCount := range rowCounts[tableName] {
+				switch ddl.Action {
+				case sqlparser.AlterStr, sqlparser.DropStr, sqlparser.TruncateStr:
+					statement.EstimatedRows += rowCount
+				}
+				// Online DDL migrations don't make the tables unavailable.
+				if statement.BigSchemaChange == "" && exec.ddlStrategy != tmutils.DDLStrategyOnline {
+					statement.BigSchemaChange = bigSchemaChange(ddl, rowCount)
+				}
+			}
+		}
+	}
+
+	results, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
+	if err != nil {
+		report.PreflightError = err.Error()
+	}
+	for i, result := range results {
+		report.Statements[i].ChangesSchema = len(tmutils.DiffSchemaToArray("before", result.BeforeSchema, "after", result.AfterSchema)) > 0
+	}
+
+	report.Safe = report.PreflightError == ""
+	for _, statement := range report.Statements {
+		if statement.BigSchemaChange != "" {
+			report.Safe = false
+		}
+	}
+	return report, nil
+}
+
 // Execute applies schema changes
 func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *ExecuteResult {
 	execResult := ExecuteResult{}
@@ -210,9 +307,12 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 	}()
 
 	// Make sure the schema changes introduce a table definition change.
-	if err := exec.preflightSchemaChanges(ctx, sqls); err != nil {
-		execResult.ExecutorErr = err.Error()
-		return &execResult
+	// The tablets check the changes of the other strategies themselves.
+	if exec.ddlStrategy == tmutils.DDLStrategyDirect {
+		if err := exec.preflightSchemaChanges(ctx, sqls); err != nil {
+			execResult.ExecutorErr = err.Error()
+			return &execResult
+		}
 	}
 
 	for index, sql := range sqls {
@@ -275,7 +375,17 @@ func (exec *TabletExecutor) executeOneTablet(
 	sql string,
 	errChan chan ShardWithError,
 	successChan chan ShardResult) {
-	result, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
+	var result *querypb.QueryResult
+	var err error
+	if exec.ddlStrategy == tmutils.DDLStrategyDirect {
+		result, err = exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
+	} else {
+		result = &querypb.QueryResult{}
+		_, err = exec.wr.TabletManagerClient().ApplySchema(ctx, tablet, &tmutils.SchemaChange{
+			SQL:              sql,
+			AllowReplication: true,
+		})
+	}
 	if err != nil {
 		errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
 		return
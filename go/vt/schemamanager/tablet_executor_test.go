@@ -17,6 +17,7 @@ limitations under the License.
 package schemamanager
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -216,3 +217,121 @@ func TestTabletExecutorExecute(t *testing.T) {
 		t.Fatalf("execute should fail, call execute.Open first")
 	}
 }
+
+func TestTabletExecutorExecuteWithDDLStrategy(t *testing.T) {
+	executor := newFakeExecutor(t)
+	ctx := context.Background()
+	if err := executor.wr.SetKeyspaceDDLStrategy(ctx, "test_keyspace", "unknown"); err == nil {
+		t.Fatalf("SetKeyspaceDDLStrategy should fail for an unknown strategy")
+	}
+	if err := executor.wr.SetKeyspaceDDLStrategy(ctx, "test_keyspace", tmutils.DDLStrategyOnline); err != nil {
+		t.Fatalf("SetKeyspaceDDLStrategy failed: %v", err)
+	}
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open failed: %v", err)
+	}
+	defer executor.Close()
+
+	sqls := []string{"ALTER TABLE test_table ADD COLUMN c INT"}
+	result := executor.Execute(ctx, sqls)
+	if result.ExecutorErr != "" || len(result.FailedShards) != 0 {
+		t.Fatalf("execute failed: %v, %v", result.ExecutorErr, result.FailedShards)
+	}
+	if len(result.SuccessShards) != 3 {
+		t.Fatalf("got %d successful shards, want 3", len(result.SuccessShards))
+	}
+	// The changes are applied by the tablets, with the strategy.
+	client := executor.wr.TabletManagerClient().(*fakeTabletManagerClient)
+	if len(client.appliedSchemaChanges) != 3 {
+		t.Fatalf("got %d schema changes, want 3", len(client.appliedSchemaChanges))
+	}
+	for _, sql := range client.appliedSchemaChanges {
+		if sql != sqls[0] {
+			t.Errorf("got schema change %v, want %v", sql, sqls[0])
+		}
+	}
+}
+
+func TestTabletExecutorDryRun(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:     "test_table",
+				Schema:   "table schema",
+				Type:     tmutils.TableBaseTable,
+				RowCount: 10,
+			},
+			{
+				Name:     "test_table_03",
+				Schema:   "table schema",
+				Type:     tmutils.TableBaseTable,
+				RowCount: 200000,
+			},
+		},
+	})
+	fakeTmc.AddSchemaChange("ALTER TABLE test_table ADD COLUMN new_id bigint(20)", &tabletmanagerdatapb.SchemaChangeResult{
+		BeforeSchema: &tabletmanagerdatapb.SchemaDefinition{},
+		AfterSchema: &tabletmanagerdatapb.SchemaDefinition{
+			DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}`",
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	ctx := context.Background()
+
+	sqls := []string{
+		"ALTER TABLE test_table ADD COLUMN new_id bigint(20)",
+		"DROP TABLE test_table_03",
+	}
+	if _, err := executor.DryRun(ctx, sqls); err == nil {
+		t.Fatalf("dry run should fail because executor is closed")
+	}
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open failed: %v", err)
+	}
+	defer executor.Close()
+
+	report, err := executor.DryRun(ctx, sqls)
+	if err != nil {
+		t.Fatalf("executor.DryRun failed: %v", err)
+	}
+	want := &DryRunReport{
+		Keyspace:    "test_keyspace",
+		DDLStrategy: tmutils.DDLStrategyDirect,
+		Statements: []*StatementReport{{
+			SQL:           sqls[0],
+			Action:        "alter",
+			Tables:        []string{"test_table"},
+			EstimatedRows: 30,
+			ChangesSchema: true,
+		}, {
+			SQL:           sqls[1],
+			Action:        "drop",
+			Tables:        []string{"test_table_03"},
+			EstimatedRows: 600000,
+		}},
+		Safe: true,
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("executor.DryRun() = %+v, want %+v", report, want)
+	}
+
+	// alter a table with more than 100,000 rows
+	report, err = executor.DryRun(ctx, []string{"ALTER TABLE test_table_03 ADD COLUMN new_id bigint(20)"})
+	if err != nil {
+		t.Fatalf("executor.DryRun failed: %v", err)
+	}
+	if report.Safe || report.Statements[0].BigSchemaChange == "" {
+		t.Errorf("executor.DryRun() = %+v, want a big schema change", report.Statements[0])
+	}
+
+	fakeTmc.EnablePreflightSchemaError = true
+	report, err = executor.DryRun(ctx, sqls)
+	if err != nil {
+		t.Fatalf("executor.DryRun failed: %v", err)
+	}
+	if report.Safe || report.PreflightError == "" {
+		t.Errorf("executor.DryRun() = %+v, want a preflight error", report)
+	}
+}
@@ -46,6 +46,10 @@ const (
 	StmtBegin
 	StmtCommit
 	StmtRollback
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
+	StmtVExplain
 	StmtSet
 	StmtShow
 	StmtUse
@@ -82,6 +86,14 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtCommit
 	case *Rollback:
 		return StmtRollback
+	case *Savepoint:
+		return StmtSavepoint
+	case *SRollback:
+		return StmtSRollback
+	case *Release:
+		return StmtRelease
+	case *VExplain:
+		return StmtVExplain
 	default:
 		return StmtUnknown
 	}
@@ -138,12 +150,20 @@ func Preview(sql string) StatementType {
 		return StmtBegin
 	case "commit":
 		return StmtCommit
-	case "rollback":
+	case "rollback", "rollback work":
 		return StmtRollback
 	}
 	switch loweredFirstWord {
 	case "create", "alter", "rename", "drop", "truncate", "flush":
 		return StmtDDL
+	case "savepoint":
+		return StmtSavepoint
+	case "release":
+		return StmtRelease
+	case "vexplain":
+		return StmtVExplain
+	case "rollback":
+		return StmtSRollback
 	case "set":
 		return StmtSet
 	case "show":
@@ -180,6 +200,14 @@ func (s StatementType) String() string {
 		return "COMMIT"
 	case StmtRollback:
 		return "ROLLBACK"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
+	case StmtVExplain:
+		return "VEXPLAIN"
 	case StmtSet:
 		return "SET"
 	case StmtShow:
@@ -61,6 +61,12 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback work", StmtRollback},
+		{"ROLLBACK WORK /*...*/", StmtRollback},
+		{"savepoint a", StmtSavepoint},
+		{"rollback to a", StmtSRollback},
+		{"release savepoint a", StmtRelease},
+		{"vexplain select 1 from t", StmtVExplain},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -203,6 +203,27 @@ type (
 	// Rollback represents a Rollback statement.
 	Rollback struct{}
 
+	// SRollback represents a rollback to savepoint statement.
+	SRollback struct {
+		Name ColIdent
+	}
+
+	// Savepoint represents a savepoint statement.
+	Savepoint struct {
+		Name ColIdent
+	}
+
+	// Release represents a release savepoint statement.
+	Release struct {
+		Name ColIdent
+	}
+
+	// VExplain represents a VEXPLAIN statement, which describes
+	// how vtgate would execute the statement.
+	VExplain struct {
+		Statement Statement
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -229,6 +250,10 @@ func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
 func (*Commit) iStatement()            {}
 func (*Rollback) iStatement()          {}
+func (*SRollback) iStatement()         {}
+func (*Savepoint) iStatement()         {}
+func (*Release) iStatement()           {}
+func (*VExplain) iStatement()          {}
 func (*OtherRead) iStatement()         {}
 func (*OtherAdmin) iStatement()        {}
 func (*Select) iSelectStatement()      {}
@@ -626,6 +651,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -765,6 +791,12 @@ type Limit struct {
 	Offset, Rowcount Expr
 }
 
+// OverClause represents the OVER clause of a window function.
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+}
+
 // Values represents a VALUES clause.
 type Values []ValTuple
 
@@ -1240,6 +1272,26 @@ func (node *Rollback) Format(buf *TrackedBuffer) {
 	buf.WriteString("rollback")
 }
 
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *VExplain) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "vexplain %v", node.Statement)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -1553,6 +1605,27 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	buf.WriteString("over (")
+	var sep string
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "partition by %v", node.PartitionBy)
+		sep = " "
+	}
+	for i, order := range node.OrderBy {
+		if i == 0 {
+			buf.astPrintf(node, "%sorder by %v", sep, order)
+			continue
+		}
+		buf.astPrintf(node, ", %v", order)
+	}
+	buf.WriteByte(')')
 }
 
 // Format formats the node
@@ -176,6 +176,28 @@ func (ts *TableSpec) AddConstraint(cd *ConstraintDefinition) {
 	ts.Constraints = append(ts.Constraints, cd)
 }
 
+// MoveColumnKeys replaces the key options of the columns, like
+// "id int primary key", with the indexes MySQL creates for them.
+// KEY alone is a synonym for PRIMARY KEY in a column definition, and
+// the other indexes are named after their column.
+func (ts *TableSpec) MoveColumnKeys() {
+	for _, col := range ts.Columns {
+		var info *IndexInfo
+		switch col.Type.KeyOpt {
+		case colKeyPrimary, colKey:
+			info = &IndexInfo{Type: "primary key", Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		case colKeyUnique, colKeyUniqueKey:
+			info = &IndexInfo{Type: "unique key", Name: col.Name, Unique: true}
+		case colKeySpatialKey:
+			info = &IndexInfo{Type: "spatial key", Name: col.Name, Spatial: true}
+		default:
+			continue
+		}
+		col.Type.KeyOpt = colKeyNone
+		ts.AddIndex(&IndexDefinition{Info: info, Columns: []*IndexColumn{{Column: col.Name}}})
+	}
+}
+
 // DescribeType returns the abbreviated type information as required for
 // describe table
 func (ct *ColumnType) DescribeType() string {
@@ -519,7 +541,12 @@ var Aggregates = map[string]bool{
 
 // IsAggregate returns true if the function is an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function is evaluated over a window.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
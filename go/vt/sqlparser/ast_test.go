@@ -249,6 +249,31 @@ func TestDDL(t *testing.T) {
 	}
 }
 
+func TestMoveColumnKeys(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "create table t (id int primary key, c int unique, d int)",
+		out: "create table t (\n\tid int,\n\tc int,\n\td int,\n\tprimary key (id),\n\tunique key c (c)\n)",
+	}, {
+		in:  "create table t (id int key, c int unique key, key d_idx (d))",
+		out: "create table t (\n\tid int,\n\tc int,\n\tkey d_idx (d),\n\tprimary key (id),\n\tunique key c (c)\n)",
+	}, {
+		in:  "create table t (id int, d int)",
+		out: "create table t (\n\tid int,\n\td int\n)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt.(*DDL).TableSpec.MoveColumnKeys()
+		if got := String(stmt); got != tcase.out {
+			t.Errorf("MoveColumnKeys(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
 func TestSetAutocommitON(t *testing.T) {
 	stmt, err := Parse("SET autocommit=ON")
 	require.NoError(t, err)
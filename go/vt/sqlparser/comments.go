@@ -28,10 +28,28 @@ const (
 	DirectiveMultiShardAutocommit = "MULTI_SHARD_AUTOCOMMIT"
 	// DirectiveSkipQueryPlanCache skips query plan cache when set.
 	DirectiveSkipQueryPlanCache = "SKIP_QUERY_PLAN_CACHE"
-	// DirectiveQueryTimeout sets a query timeout in vtgate. Only supported for SELECTS.
+	// DirectiveQueryTimeout sets a query timeout in vtgate and vttablet.
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
+	// DirectiveMaxRows overrides the maximum number of rows a query
+	// may return or affect.
+	DirectiveMaxRows = "MAX_ROWS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
+	// DirectiveLagSensitive marks a replica read as sensitive (LAG_SENSITIVE=1)
+	// or tolerant (LAG_SENSITIVE=0) to replication lag.
+	DirectiveLagSensitive = "LAG_SENSITIVE"
+	// DirectivePriority sets the scheduling priority of a query in vttablet
+	// (PRIORITY=critical, PRIORITY=normal or PRIORITY=batch).
+	DirectivePriority = "PRIORITY"
+	// DirectiveDeliverAfter delays the delivery of messages inserted
+	// into a message table by the given number of seconds.
+	DirectiveDeliverAfter = "DELIVER_AFTER"
+	// DirectiveResumeToken passes the resume token of a consumer
+	// to the message streams of 'stream * from t'.
+	DirectiveResumeToken = "RESUME_TOKEN"
+	// DirectiveHashJoin allows vtgate to execute the cross-shard joins
+	// of a select as hash joins instead of nested loops.
+	DirectiveHashJoin = "HASH_JOIN"
 )
 
 func isNonSpace(r rune) bool {
@@ -434,6 +434,15 @@ var (
 		input: "select /* function with many params */ 1 from t where a = b(c, d)",
 	}, {
 		input: "select /* function with distinct */ count(distinct a) from t",
+	}, {
+		input: "select /* window function */ row_number() over (partition by a order by b desc) from t",
+	}, {
+		input: "select /* window function */ rank() over (order by b asc, c asc) as r from t",
+	}, {
+		input: "select /* window function */ lag(a, 2, 0) over (partition by b, c) from t",
+	}, {
+		input:  "select /* window function */ count(*) OVER () from t",
+		output: "select /* window function */ count(*) over () from t",
 	}, {
 		input:  "select count(distinctrow(1)) from (select (1) from dual union all select 1 from dual) a",
 		output: "select count(distinct 1) from (select 1 from dual union all select 1 from dual) as a",
@@ -1516,6 +1525,31 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint a",
+	}, {
+		input: "savepoint `@@@;a`",
+	}, {
+		input:  "rollback work to a",
+		output: "rollback to a",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input: "release savepoint a",
+	}, {
+		input: "vexplain select * from t where id = 1",
+	}, {
+		input: "vexplain select a from t union all select b from u order by a asc limit 5",
+	}, {
+		input: "vexplain insert into t(a, b) values (1, 2)",
+	}, {
+		input: "vexplain update t set a = 1 where id = 2",
+	}, {
+		input: "vexplain delete from t where id = 2",
+	}, {
+		input:  "select vexplain from t",
+		output: "select `vexplain` from t",
 	}, {
 		input: "create database test_db",
 	}, {
@@ -300,6 +300,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -459,6 +463,14 @@ func (r *replaceOrderByItems) inc() {
 	*r++
 }
 
+func replaceOverClauseOrderBy(newNode, parent SQLNode) {
+	parent.(*OverClause).OrderBy = newNode.(OrderBy)
+}
+
+func replaceOverClausePartitionBy(newNode, parent SQLNode) {
+	parent.(*OverClause).PartitionBy = newNode.(Exprs)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -511,6 +523,18 @@ func replaceRangeCondTo(newNode, parent SQLNode) {
 	parent.(*RangeCond).To = newNode.(Expr)
 }
 
+func replaceReleaseName(newNode, parent SQLNode) {
+	parent.(*Release).Name = newNode.(ColIdent)
+}
+
+func replaceSRollbackName(newNode, parent SQLNode) {
+	parent.(*SRollback).Name = newNode.(ColIdent)
+}
+
+func replaceSavepointName(newNode, parent SQLNode) {
+	parent.(*Savepoint).Name = newNode.(ColIdent)
+}
+
 func replaceSelectComments(newNode, parent SQLNode) {
 	parent.(*Select).Comments = newNode.(Comments)
 }
@@ -761,6 +785,10 @@ func replaceUseDBName(newNode, parent SQLNode) {
 	parent.(*Use).DBName = newNode.(TableIdent)
 }
 
+func replaceVExplainStatement(newNode, parent SQLNode) {
+	parent.(*VExplain).Statement = newNode.(Statement)
+}
+
 type replaceValTupleItems int
 
 func (r *replaceValTupleItems) replace(newNode, container SQLNode) {
@@ -986,6 +1014,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1086,6 +1115,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.OrderBy, replaceOverClauseOrderBy)
+		a.apply(node, n.PartitionBy, replaceOverClausePartitionBy)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1120,10 +1153,19 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case ReferenceAction:
 
+	case *Release:
+		a.apply(node, n.Name, replaceReleaseName)
+
 	case *Rollback:
 
 	case *SQLVal:
 
+	case *SRollback:
+		a.apply(node, n.Name, replaceSRollbackName)
+
+	case *Savepoint:
+		a.apply(node, n.Name, replaceSavepointName)
+
 	case *Select:
 		a.apply(node, n.Comments, replaceSelectComments)
 		a.apply(node, n.From, replaceSelectFrom)
@@ -1260,6 +1302,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Use:
 		a.apply(node, n.DBName, replaceUseDBName)
 
+	case *VExplain:
+		a.apply(node, n.Statement, replaceVExplainStatement)
+
 	case ValTuple:
 		replacer := replaceValTupleItems(0)
 		replacerRef := &replacer
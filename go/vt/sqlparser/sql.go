@@ -105,6 +105,7 @@ type yySymType struct {
 	vindexParams         []VindexParam
 	showFilter           *ShowFilter
 	optLike              *OptLike
+	overClause           *OverClause
 }
 
 const LEX_ERROR = 57346
@@ -236,208 +237,212 @@ const RESTRICT = 57471
 const SHOW = 57472
 const DESCRIBE = 57473
 const EXPLAIN = 57474
-const DATE = 57475
-const ESCAPE = 57476
-const REPAIR = 57477
-const OPTIMIZE = 57478
-const TRUNCATE = 57479
-const MAXVALUE = 57480
-const PARTITION = 57481
-const REORGANIZE = 57482
-const LESS = 57483
-const THAN = 57484
-const PROCEDURE = 57485
-const TRIGGER = 57486
-const VINDEX = 57487
-const VINDEXES = 57488
-const STATUS = 57489
-const VARIABLES = 57490
-const WARNINGS = 57491
-const SEQUENCE = 57492
-const BEGIN = 57493
-const START = 57494
-const TRANSACTION = 57495
-const COMMIT = 57496
-const ROLLBACK = 57497
-const BIT = 57498
-const TINYINT = 57499
-const SMALLINT = 57500
-const MEDIUMINT = 57501
-const INT = 57502
-const INTEGER = 57503
-const BIGINT = 57504
-const INTNUM = 57505
-const REAL = 57506
-const DOUBLE = 57507
-const FLOAT_TYPE = 57508
-const DECIMAL = 57509
-const NUMERIC = 57510
-const TIME = 57511
-const TIMESTAMP = 57512
-const DATETIME = 57513
-const YEAR = 57514
-const CHAR = 57515
-const VARCHAR = 57516
-const BOOL = 57517
-const CHARACTER = 57518
-const VARBINARY = 57519
-const NCHAR = 57520
-const TEXT = 57521
-const TINYTEXT = 57522
-const MEDIUMTEXT = 57523
-const LONGTEXT = 57524
-const BLOB = 57525
-const TINYBLOB = 57526
-const MEDIUMBLOB = 57527
-const LONGBLOB = 57528
-const JSON = 57529
-const ENUM = 57530
-const GEOMETRY = 57531
-const POINT = 57532
-const LINESTRING = 57533
-const POLYGON = 57534
-const GEOMETRYCOLLECTION = 57535
-const MULTIPOINT = 57536
-const MULTILINESTRING = 57537
-const MULTIPOLYGON = 57538
-const NULLX = 57539
-const AUTO_INCREMENT = 57540
-const APPROXNUM = 57541
-const SIGNED = 57542
-const UNSIGNED = 57543
-const ZEROFILL = 57544
-const COLLATION = 57545
-const DATABASES = 57546
-const TABLES = 57547
-const VITESS_METADATA = 57548
-const VSCHEMA = 57549
-const FULL = 57550
-const PROCESSLIST = 57551
-const COLUMNS = 57552
-const FIELDS = 57553
-const ENGINES = 57554
-const PLUGINS = 57555
-const EXTENDED = 57556
-const NAMES = 57557
-const CHARSET = 57558
-const GLOBAL = 57559
-const SESSION = 57560
-const ISOLATION = 57561
-const LEVEL = 57562
-const READ = 57563
-const WRITE = 57564
-const ONLY = 57565
-const REPEATABLE = 57566
-const COMMITTED = 57567
-const UNCOMMITTED = 57568
-const SERIALIZABLE = 57569
-const CURRENT_TIMESTAMP = 57570
-const DATABASE = 57571
-const CURRENT_DATE = 57572
-const CURRENT_TIME = 57573
-const LOCALTIME = 57574
-const LOCALTIMESTAMP = 57575
-const UTC_DATE = 57576
-const UTC_TIME = 57577
-const UTC_TIMESTAMP = 57578
-const REPLACE = 57579
-const CONVERT = 57580
-const CAST = 57581
-const SUBSTR = 57582
-const SUBSTRING = 57583
-const GROUP_CONCAT = 57584
-const SEPARATOR = 57585
-const TIMESTAMPADD = 57586
-const TIMESTAMPDIFF = 57587
-const MATCH = 57588
-const AGAINST = 57589
-const BOOLEAN = 57590
-const LANGUAGE = 57591
-const WITH = 57592
-const QUERY = 57593
-const EXPANSION = 57594
-const UNUSED = 57595
-const ARRAY = 57596
-const CUME_DIST = 57597
-const DESCRIPTION = 57598
-const DENSE_RANK = 57599
-const EMPTY = 57600
-const EXCEPT = 57601
-const FIRST_VALUE = 57602
-const GROUPING = 57603
-const GROUPS = 57604
-const JSON_TABLE = 57605
-const LAG = 57606
-const LAST_VALUE = 57607
-const LATERAL = 57608
-const LEAD = 57609
-const MEMBER = 57610
-const NTH_VALUE = 57611
-const NTILE = 57612
-const OF = 57613
-const OVER = 57614
-const PERCENT_RANK = 57615
-const RANK = 57616
-const RECURSIVE = 57617
-const ROW_NUMBER = 57618
-const SYSTEM = 57619
-const WINDOW = 57620
-const ACTIVE = 57621
-const ADMIN = 57622
-const BUCKETS = 57623
-const CLONE = 57624
-const COMPONENT = 57625
-const DEFINITION = 57626
-const ENFORCED = 57627
-const EXCLUDE = 57628
-const FOLLOWING = 57629
-const GEOMCOLLECTION = 57630
-const GET_MASTER_PUBLIC_KEY = 57631
-const HISTOGRAM = 57632
-const HISTORY = 57633
-const INACTIVE = 57634
-const INVISIBLE = 57635
-const LOCKED = 57636
-const MASTER_COMPRESSION_ALGORITHMS = 57637
-const MASTER_PUBLIC_KEY_PATH = 57638
-const MASTER_TLS_CIPHERSUITES = 57639
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57640
-const NESTED = 57641
-const NETWORK_NAMESPACE = 57642
-const NOWAIT = 57643
-const NULLS = 57644
-const OJ = 57645
-const OLD = 57646
-const OPTIONAL = 57647
-const ORDINALITY = 57648
-const ORGANIZATION = 57649
-const OTHERS = 57650
-const PATH = 57651
-const PERSIST = 57652
-const PERSIST_ONLY = 57653
-const PRECEDING = 57654
-const PRIVILEGE_CHECKS_USER = 57655
-const PROCESS = 57656
-const RANDOM = 57657
-const REFERENCE = 57658
-const REQUIRE_ROW_FORMAT = 57659
-const RESOURCE = 57660
-const RESPECT = 57661
-const RESTART = 57662
-const RETAIN = 57663
-const REUSE = 57664
-const ROLE = 57665
-const SECONDARY = 57666
-const SECONDARY_ENGINE = 57667
-const SECONDARY_LOAD = 57668
-const SECONDARY_UNLOAD = 57669
-const SKIP = 57670
-const SRID = 57671
-const THREAD_PRIORITY = 57672
-const TIES = 57673
-const UNBOUNDED = 57674
-const VCPU = 57675
-const VISIBLE = 57676
+const VEXPLAIN = 57475
+const DATE = 57476
+const ESCAPE = 57477
+const REPAIR = 57478
+const OPTIMIZE = 57479
+const TRUNCATE = 57480
+const MAXVALUE = 57481
+const PARTITION = 57482
+const REORGANIZE = 57483
+const LESS = 57484
+const THAN = 57485
+const PROCEDURE = 57486
+const TRIGGER = 57487
+const VINDEX = 57488
+const VINDEXES = 57489
+const STATUS = 57490
+const VARIABLES = 57491
+const WARNINGS = 57492
+const SEQUENCE = 57493
+const BEGIN = 57494
+const START = 57495
+const TRANSACTION = 57496
+const COMMIT = 57497
+const ROLLBACK = 57498
+const SAVEPOINT = 57499
+const RELEASE = 57500
+const WORK = 57501
+const BIT = 57502
+const TINYINT = 57503
+const SMALLINT = 57504
+const MEDIUMINT = 57505
+const INT = 57506
+const INTEGER = 57507
+const BIGINT = 57508
+const INTNUM = 57509
+const REAL = 57510
+const DOUBLE = 57511
+const FLOAT_TYPE = 57512
+const DECIMAL = 57513
+const NUMERIC = 57514
+const TIME = 57515
+const TIMESTAMP = 57516
+const DATETIME = 57517
+const YEAR = 57518
+const CHAR = 57519
+const VARCHAR = 57520
+const BOOL = 57521
+const CHARACTER = 57522
+const VARBINARY = 57523
+const NCHAR = 57524
+const TEXT = 57525
+const TINYTEXT = 57526
+const MEDIUMTEXT = 57527
+const LONGTEXT = 57528
+const BLOB = 57529
+const TINYBLOB = 57530
+const MEDIUMBLOB = 57531
+const LONGBLOB = 57532
+const JSON = 57533
+const ENUM = 57534
+const GEOMETRY = 57535
+const POINT = 57536
+const LINESTRING = 57537
+const POLYGON = 57538
+const GEOMETRYCOLLECTION = 57539
+const MULTIPOINT = 57540
+const MULTILINESTRING = 57541
+const MULTIPOLYGON = 57542
+const NULLX = 57543
+const AUTO_INCREMENT = 57544
+const APPROXNUM = 57545
+const SIGNED = 57546
+const UNSIGNED = 57547
+const ZEROFILL = 57548
+const COLLATION = 57549
+const DATABASES = 57550
+const TABLES = 57551
+const VITESS_METADATA = 57552
+const VSCHEMA = 57553
+const FULL = 57554
+const PROCESSLIST = 57555
+const COLUMNS = 57556
+const FIELDS = 57557
+const ENGINES = 57558
+const PLUGINS = 57559
+const EXTENDED = 57560
+const NAMES = 57561
+const CHARSET = 57562
+const GLOBAL = 57563
+const SESSION = 57564
+const ISOLATION = 57565
+const LEVEL = 57566
+const READ = 57567
+const WRITE = 57568
+const ONLY = 57569
+const REPEATABLE = 57570
+const COMMITTED = 57571
+const UNCOMMITTED = 57572
+const SERIALIZABLE = 57573
+const CURRENT_TIMESTAMP = 57574
+const DATABASE = 57575
+const CURRENT_DATE = 57576
+const CURRENT_TIME = 57577
+const LOCALTIME = 57578
+const LOCALTIMESTAMP = 57579
+const UTC_DATE = 57580
+const UTC_TIME = 57581
+const UTC_TIMESTAMP = 57582
+const REPLACE = 57583
+const CONVERT = 57584
+const CAST = 57585
+const SUBSTR = 57586
+const SUBSTRING = 57587
+const GROUP_CONCAT = 57588
+const SEPARATOR = 57589
+const TIMESTAMPADD = 57590
+const TIMESTAMPDIFF = 57591
+const MATCH = 57592
+const AGAINST = 57593
+const BOOLEAN = 57594
+const LANGUAGE = 57595
+const WITH = 57596
+const QUERY = 57597
+const EXPANSION = 57598
+const UNUSED = 57599
+const ARRAY = 57600
+const CUME_DIST = 57601
+const DESCRIPTION = 57602
+const DENSE_RANK = 57603
+const EMPTY = 57604
+const EXCEPT = 57605
+const FIRST_VALUE = 57606
+const GROUPING = 57607
+const GROUPS = 57608
+const JSON_TABLE = 57609
+const LAG = 57610
+const LAST_VALUE = 57611
+const LATERAL = 57612
+const LEAD = 57613
+const MEMBER = 57614
+const NTH_VALUE = 57615
+const NTILE = 57616
+const OF = 57617
+const OVER = 57618
+const PERCENT_RANK = 57619
+const RANK = 57620
+const RECURSIVE = 57621
+const ROW_NUMBER = 57622
+const SYSTEM = 57623
+const WINDOW = 57624
+const ACTIVE = 57625
+const ADMIN = 57626
+const BUCKETS = 57627
+const CLONE = 57628
+const COMPONENT = 57629
+const DEFINITION = 57630
+const ENFORCED = 57631
+const EXCLUDE = 57632
+const FOLLOWING = 57633
+const GEOMCOLLECTION = 57634
+const GET_MASTER_PUBLIC_KEY = 57635
+const HISTOGRAM = 57636
+const HISTORY = 57637
+const INACTIVE = 57638
+const INVISIBLE = 57639
+const LOCKED = 57640
+const MASTER_COMPRESSION_ALGORITHMS = 57641
+const MASTER_PUBLIC_KEY_PATH = 57642
+const MASTER_TLS_CIPHERSUITES = 57643
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57644
+const NESTED = 57645
+const NETWORK_NAMESPACE = 57646
+const NOWAIT = 57647
+const NULLS = 57648
+const OJ = 57649
+const OLD = 57650
+const OPTIONAL = 57651
+const ORDINALITY = 57652
+const ORGANIZATION = 57653
+const OTHERS = 57654
+const PATH = 57655
+const PERSIST = 57656
+const PERSIST_ONLY = 57657
+const PRECEDING = 57658
+const PRIVILEGE_CHECKS_USER = 57659
+const PROCESS = 57660
+const RANDOM = 57661
+const REFERENCE = 57662
+const REQUIRE_ROW_FORMAT = 57663
+const RESOURCE = 57664
+const RESPECT = 57665
+const RESTART = 57666
+const RETAIN = 57667
+const REUSE = 57668
+const ROLE = 57669
+const SECONDARY = 57670
+const SECONDARY_ENGINE = 57671
+const SECONDARY_LOAD = 57672
+const SECONDARY_UNLOAD = 57673
+const SKIP = 57674
+const SRID = 57675
+const THREAD_PRIORITY = 57676
+const TIES = 57677
+const UNBOUNDED = 57678
+const VCPU = 57679
+const VISIBLE = 57680
 
 var yyToknames = [...]string{
 	"$end",
@@ -589,6 +594,7 @@ var yyToknames = [...]string{
 	"SHOW",
 	"DESCRIBE",
 	"EXPLAIN",
+	"VEXPLAIN",
 	"DATE",
 	"ESCAPE",
 	"REPAIR",
@@ -612,6 +618,9 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
+	"WORK",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -805,1721 +814,1761 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 32,
+	5, 35,
 	-2, 4,
-	-1, 37,
-	33, 299,
-	127, 299,
-	139, 299,
-	164, 313,
-	165, 313,
-	-2, 301,
-	-1, 327,
-	115, 657,
-	-2, 653,
-	-1, 328,
-	115, 658,
-	-2, 654,
-	-1, 397,
-	85, 908,
-	-2, 66,
-	-1, 398,
-	85, 825,
-	-2, 67,
-	-1, 403,
-	85, 793,
-	-2, 619,
-	-1, 405,
-	85, 856,
-	-2, 621,
-	-1, 706,
-	1, 366,
-	5, 366,
-	12, 366,
-	13, 366,
-	14, 366,
-	15, 366,
-	17, 366,
-	19, 366,
-	30, 366,
-	31, 366,
-	43, 366,
-	44, 366,
-	45, 366,
-	46, 366,
-	47, 366,
-	49, 366,
-	50, 366,
-	53, 366,
-	54, 366,
-	56, 366,
-	57, 366,
-	352, 366,
-	-2, 384,
-	-1, 709,
-	54, 47,
-	56, 47,
-	-2, 51,
-	-1, 864,
-	115, 660,
-	-2, 656,
-	-1, 1095,
-	5, 33,
-	-2, 452,
-	-1, 1126,
-	5, 32,
-	-2, 593,
-	-1, 1374,
-	5, 33,
-	-2, 594,
-	-1, 1427,
-	5, 32,
-	-2, 596,
-	-1, 1507,
-	5, 33,
-	-2, 597,
+	-1, 40,
+	33, 302,
+	127, 302,
+	139, 302,
+	165, 316,
+	166, 316,
+	-2, 304,
+	-1, 45,
+	129, 326,
+	-2, 324,
+	-1, 316,
+	5, 35,
+	-2, 332,
+	-1, 343,
+	115, 675,
+	-2, 671,
+	-1, 344,
+	115, 676,
+	-2, 672,
+	-1, 413,
+	85, 926,
+	-2, 69,
+	-1, 414,
+	85, 843,
+	-2, 70,
+	-1, 419,
+	85, 811,
+	-2, 637,
+	-1, 421,
+	85, 874,
+	-2, 639,
+	-1, 724,
+	1, 380,
+	5, 380,
+	12, 380,
+	13, 380,
+	14, 380,
+	15, 380,
+	17, 380,
+	19, 380,
+	30, 380,
+	31, 380,
+	43, 380,
+	44, 380,
+	45, 380,
+	46, 380,
+	47, 380,
+	49, 380,
+	50, 380,
+	53, 380,
+	54, 380,
+	56, 380,
+	57, 380,
+	356, 380,
+	-2, 398,
+	-1, 727,
+	54, 50,
+	56, 50,
+	-2, 54,
+	-1, 884,
+	115, 678,
+	-2, 674,
+	-1, 1116,
+	5, 36,
+	-2, 466,
+	-1, 1147,
+	5, 35,
+	-2, 611,
+	-1, 1398,
+	5, 36,
+	-2, 612,
+	-1, 1453,
+	5, 35,
+	-2, 614,
+	-1, 1537,
+	5, 36,
+	-2, 615,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16225
+const yyLast = 16548
 
 var yyAct = [...]int{
 
-	327, 1541, 1335, 1495, 1531, 1129, 661, 979, 1440, 332,
-	1407, 1223, 1147, 358, 1275, 345, 1309, 306, 952, 1276,
-	1022, 1174, 950, 1130, 975, 1272, 57, 988, 1288, 978,
-	557, 1282, 80, 889, 896, 1153, 270, 297, 290, 270,
-	1394, 1247, 808, 900, 827, 1200, 402, 1086, 1191, 992,
-	722, 954, 939, 918, 702, 866, 592, 660, 3, 526,
-	1018, 598, 396, 703, 932, 391, 613, 270, 80, 604,
-	315, 527, 270, 721, 270, 330, 388, 708, 393, 711,
-	675, 56, 298, 299, 300, 301, 1534, 1518, 304, 61,
-	546, 1529, 1505, 1526, 1002, 1336, 1517, 1504, 1041, 676,
-	1264, 1366, 531, 266, 262, 263, 264, 319, 1008, 305,
-	1304, 1305, 1040, 268, 899, 63, 64, 65, 66, 67,
-	370, 1303, 376, 377, 374, 375, 373, 372, 371, 969,
-	568, 82, 83, 84, 970, 971, 378, 379, 723, 1248,
-	724, 82, 83, 84, 390, 258, 586, 303, 256, 528,
-	260, 530, 1039, 1469, 626, 625, 635, 636, 628, 629,
-	630, 631, 632, 633, 634, 627, 1162, 302, 637, 1161,
-	581, 1182, 1163, 1001, 582, 579, 580, 1225, 1250, 1397,
-	82, 83, 84, 1009, 1357, 1355, 296, 797, 574, 575,
-	584, 796, 1227, 794, 1528, 1525, 1496, 1222, 399, 563,
-	933, 565, 1036, 1033, 1034, 585, 1032, 1545, 1488, 993,
-	1549, 571, 1252, 547, 1256, 533, 1251, 260, 1249, 1228,
-	1441, 801, 1449, 1254, 265, 1148, 1150, 795, 798, 995,
-	1226, 785, 1253, 562, 564, 1443, 1298, 1297, 1296, 1043,
-	1046, 82, 83, 84, 259, 1255, 1257, 1219, 529, 536,
-	273, 261, 1053, 1221, 1477, 1052, 1104, 270, 538, 539,
-	1101, 1377, 270, 1233, 548, 995, 257, 1158, 270, 649,
-	650, 1114, 1080, 838, 270, 555, 1038, 717, 561, 80,
-	617, 976, 553, 80, 965, 80, 627, 835, 832, 637,
-	637, 80, 612, 543, 274, 82, 83, 84, 1037, 70,
-	1486, 277, 1458, 1442, 1149, 570, 82, 83, 84, 284,
-	828, 1286, 1503, 611, 610, 1009, 1210, 572, 1543, 560,
-	1268, 1544, 80, 1542, 559, 1470, 1450, 1448, 994, 1180,
-	612, 725, 787, 919, 537, 1266, 600, 71, 1042, 545,
-	588, 589, 919, 282, 1111, 552, 1206, 1207, 1208, 289,
-	1491, 554, 1220, 1044, 1218, 549, 550, 551, 540, 1322,
-	541, 649, 650, 542, 994, 649, 650, 822, 873, 601,
-	630, 631, 632, 633, 634, 627, 275, 610, 637, 611,
-	610, 607, 871, 872, 870, 1509, 270, 270, 270, 1403,
-	82, 83, 84, 612, 829, 80, 612, 995, 841, 842,
-	1402, 80, 602, 286, 278, 558, 287, 288, 294, 701,
-	1195, 1194, 279, 281, 291, 1209, 276, 293, 292, 325,
-	1214, 1211, 1202, 1212, 1205, 525, 1201, 82, 83, 84,
-	1203, 1204, 626, 625, 635, 636, 628, 629, 630, 631,
-	632, 633, 634, 627, 1213, 1183, 637, 54, 1511, 611,
-	610, 823, 678, 680, 682, 684, 686, 688, 689, 869,
-	1487, 998, 1421, 700, 710, 709, 612, 999, 1099, 715,
-	1098, 679, 681, 719, 685, 687, 255, 690, 628, 629,
-	630, 631, 632, 633, 634, 627, 1550, 1087, 637, 611,
-	610, 532, 1400, 1241, 1192, 1063, 994, 856, 858, 859,
-	813, 991, 989, 857, 990, 591, 612, 1077, 1078, 1079,
-	713, 987, 993, 626, 625, 635, 636, 628, 629, 630,
-	631, 632, 633, 634, 627, 399, 270, 637, 1551, 1455,
-	783, 80, 1454, 786, 1318, 788, 270, 270, 80, 80,
-	80, 385, 386, 996, 270, 1446, 1527, 270, 837, 591,
-	270, 806, 807, 714, 270, 716, 80, 22, 1100, 1513,
-	591, 80, 80, 80, 270, 80, 80, 58, 534, 535,
-	82, 83, 84, 1285, 891, 80, 80, 82, 83, 84,
-	54, 1165, 82, 83, 84, 902, 836, 812, 626, 625,
-	635, 636, 628, 629, 630, 631, 632, 633, 634, 627,
-	810, 1372, 637, 733, 80, 611, 610, 1273, 1093, 270,
-	1285, 611, 610, 789, 790, 80, 1457, 310, 843, 1446,
-	1499, 799, 612, 802, 390, 1446, 591, 805, 612, 941,
-	944, 945, 946, 942, 867, 943, 947, 936, 890, 1289,
-	1290, 818, 1224, 1446, 1478, 1446, 1445, 892, 625, 635,
-	636, 628, 629, 630, 631, 632, 633, 634, 627, 80,
-	862, 637, 1392, 1391, 864, 348, 347, 350, 351, 352,
-	353, 1379, 591, 845, 349, 354, 1376, 591, 909, 912,
-	1154, 860, 1328, 1327, 920, 935, 852, 1324, 1325, 1324,
-	1323, 1154, 80, 80, 1093, 591, 936, 591, 24, 713,
-	270, 902, 591, 1326, 328, 732, 731, 1236, 270, 270,
-	1166, 936, 270, 270, 24, 968, 270, 270, 270, 80,
-	24, 904, 1124, 1117, 936, 893, 894, 1125, 1116, 928,
-	929, 1093, 80, 527, 960, 1285, 81, 959, 962, 712,
-	271, 916, 714, 271, 712, 712, 718, 54, 863, 1426,
-	839, 800, 1093, 1519, 1409, 651, 652, 653, 654, 655,
-	656, 657, 658, 54, 810, 1003, 1384, 1023, 1314, 54,
-	1169, 271, 81, 1019, 958, 312, 271, 934, 271, 905,
-	906, 966, 963, 911, 914, 915, 270, 80, 1014, 80,
-	961, 1045, 983, 1013, 967, 270, 270, 270, 270, 270,
-	1410, 270, 270, 1024, 1026, 270, 80, 1536, 927, 1289,
-	1290, 930, 931, 941, 944, 945, 946, 942, 1532, 943,
-	947, 1316, 270, 1295, 54, 1292, 1273, 270, 1196, 270,
-	270, 833, 804, 851, 270, 1294, 1004, 1005, 1006, 1007,
-	1020, 1021, 1141, 1139, 1138, 1137, 1523, 1142, 1140, 399,
-	316, 317, 1015, 1016, 1017, 1010, 1011, 1012, 1060, 1516,
-	1232, 1065, 980, 1027, 1143, 1521, 945, 946, 1075, 1074,
-	605, 1187, 1047, 1048, 1049, 1050, 1051, 605, 1054, 1055,
-	593, 1068, 1056, 606, 867, 864, 603, 730, 556, 1179,
-	606, 1493, 594, 1088, 1492, 1424, 1177, 1171, 1370, 1058,
-	1070, 1069, 1405, 1029, 1059, 803, 949, 313, 314, 307,
-	1463, 1064, 308, 626, 625, 635, 636, 628, 629, 630,
-	631, 632, 633, 634, 627, 1073, 1082, 637, 58, 1462,
-	1412, 1154, 583, 1072, 1538, 1537, 1538, 270, 270, 270,
-	270, 270, 1105, 1102, 826, 1131, 608, 1474, 1398, 270,
-	834, 60, 270, 62, 55, 1, 270, 1530, 1337, 1406,
-	270, 271, 1035, 1494, 1439, 1076, 271, 1308, 986, 863,
-	977, 1110, 271, 69, 524, 68, 1485, 1164, 271, 80,
-	821, 569, 1155, 81, 985, 984, 1167, 81, 1170, 81,
-	1126, 1132, 1175, 1175, 1135, 81, 1156, 1447, 1157, 1396,
-	1144, 1133, 1134, 997, 1136, 1181, 1000, 1152, 1315, 904,
-	1178, 1490, 1091, 1092, 1176, 738, 736, 737, 735, 1159,
-	740, 739, 734, 283, 394, 948, 81, 80, 80, 726,
-	1025, 1108, 609, 1186, 72, 1188, 1189, 1190, 1217, 865,
-	1172, 1173, 874, 875, 876, 877, 878, 879, 880, 881,
-	882, 883, 884, 885, 886, 887, 888, 80, 1216, 1031,
-	831, 280, 577, 1193, 578, 285, 645, 1071, 1160, 1199,
-	400, 1280, 270, 840, 597, 1461, 1411, 1109, 672, 1215,
-	917, 80, 333, 855, 346, 343, 344, 846, 1123, 619,
-	271, 271, 271, 331, 323, 705, 698, 924, 940, 81,
-	890, 938, 937, 1230, 1231, 81, 389, 1291, 1287, 980,
-	704, 1235, 1365, 1184, 1185, 1468, 1239, 850, 26, 59,
-	318, 19, 18, 17, 20, 16, 334, 15, 80, 80,
-	1274, 1240, 14, 1258, 1131, 1265, 544, 1246, 30, 1277,
-	21, 13, 1259, 12, 11, 10, 9, 8, 7, 1234,
-	6, 5, 80, 4, 309, 23, 2, 1068, 0, 0,
-	0, 864, 0, 0, 1293, 1284, 0, 80, 0, 80,
-	80, 0, 0, 1175, 1175, 0, 1307, 0, 0, 0,
-	1300, 0, 0, 1299, 0, 1279, 0, 0, 1321, 0,
-	0, 1302, 0, 0, 1312, 1313, 1306, 270, 1311, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1319,
-	1320, 1238, 0, 0, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 80, 0, 1338, 80, 80, 80, 270,
-	271, 0, 0, 0, 80, 81, 0, 270, 1330, 0,
-	271, 271, 81, 81, 81, 1269, 0, 0, 271, 0,
-	0, 271, 0, 1331, 271, 1333, 0, 0, 271, 1346,
-	81, 1343, 1344, 0, 357, 81, 81, 81, 271, 81,
-	81, 1345, 0, 1353, 1329, 0, 0, 0, 0, 81,
-	81, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1083, 1084, 1085, 1332, 1131, 79, 980, 0, 980,
-	1371, 0, 0, 0, 0, 0, 1342, 80, 81, 1381,
-	0, 1380, 0, 271, 1167, 80, 0, 0, 1390, 81,
-	0, 0, 0, 0, 359, 51, 0, 0, 0, 0,
-	80, 0, 401, 0, 0, 0, 0, 80, 0, 0,
-	0, 0, 0, 1350, 1351, 0, 1352, 0, 0, 1354,
-	0, 1356, 1414, 635, 636, 628, 629, 630, 631, 632,
-	633, 634, 627, 81, 1238, 637, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 51, 80, 80, 0,
-	80, 0, 0, 1420, 311, 80, 1277, 80, 80, 80,
-	270, 0, 1425, 80, 0, 0, 81, 81, 1432, 0,
-	0, 0, 0, 0, 271, 1393, 0, 923, 1438, 1444,
-	80, 270, 271, 271, 1451, 0, 271, 271, 0, 0,
-	271, 271, 271, 81, 1452, 1433, 1453, 1434, 1436, 1437,
-	0, 0, 1399, 1427, 1401, 0, 81, 980, 0, 0,
-	1277, 1475, 1484, 0, 0, 0, 0, 80, 1483, 0,
-	1459, 1482, 0, 0, 0, 0, 647, 0, 80, 80,
-	1413, 0, 0, 0, 0, 0, 1498, 1408, 1497, 0,
-	1501, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	1506, 0, 566, 0, 1131, 0, 1476, 0, 1460, 270,
-	271, 81, 0, 81, 0, 0, 0, 80, 0, 271,
-	271, 271, 271, 271, 0, 271, 271, 1242, 1243, 271,
-	81, 1515, 0, 706, 0, 0, 0, 1520, 1522, 0,
-	80, 1260, 1261, 0, 1262, 1263, 271, 1369, 0, 0,
-	0, 271, 0, 271, 271, 1535, 1270, 1271, 271, 0,
-	1546, 0, 0, 401, 0, 0, 0, 401, 0, 401,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	1524, 0, 0, 0, 0, 0, 1510, 626, 625, 635,
-	636, 628, 629, 630, 631, 632, 633, 634, 627, 1368,
-	0, 637, 0, 0, 0, 0, 615, 0, 1408, 980,
-	1363, 0, 0, 0, 0, 0, 0, 0, 0, 1317,
-	0, 0, 0, 567, 0, 0, 0, 567, 0, 567,
-	0, 0, 0, 0, 0, 567, 0, 0, 0, 626,
-	625, 635, 636, 628, 629, 630, 631, 632, 633, 634,
-	627, 0, 0, 637, 321, 0, 51, 1362, 0, 0,
-	0, 271, 271, 271, 271, 271, 0, 0, 0, 0,
-	0, 646, 0, 271, 648, 0, 271, 1361, 0, 401,
-	271, 1348, 0, 0, 271, 727, 0, 626, 625, 635,
-	636, 628, 629, 630, 631, 632, 633, 634, 627, 0,
-	0, 637, 659, 81, 663, 664, 665, 666, 667, 668,
-	669, 670, 671, 0, 674, 677, 677, 677, 683, 677,
-	677, 683, 677, 691, 692, 693, 694, 695, 696, 697,
-	0, 707, 0, 590, 626, 625, 635, 636, 628, 629,
-	630, 631, 632, 633, 634, 627, 0, 0, 637, 0,
-	1360, 81, 81, 0, 626, 625, 635, 636, 628, 629,
-	630, 631, 632, 633, 634, 627, 0, 868, 637, 626,
-	625, 635, 636, 628, 629, 630, 631, 632, 633, 634,
-	627, 81, 0, 637, 0, 573, 0, 576, 0, 0,
-	0, 0, 0, 587, 0, 0, 271, 0, 0, 0,
-	1415, 1416, 1417, 1418, 1419, 81, 0, 0, 1422, 1423,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 401, 401, 401, 0, 0, 626, 625, 635,
-	636, 628, 629, 630, 631, 632, 633, 634, 627, 0,
-	401, 637, 0, 0, 0, 401, 401, 401, 0, 401,
-	401, 0, 81, 81, 706, 0, 0, 0, 706, 401,
-	401, 0, 706, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 567, 81, 0, 0, 0,
-	0, 0, 567, 567, 567, 0, 0, 0, 847, 0,
-	0, 81, 0, 81, 81, 0, 0, 0, 0, 615,
-	567, 0, 401, 0, 0, 567, 567, 567, 0, 567,
-	567, 0, 0, 0, 0, 0, 0, 0, 0, 567,
-	567, 271, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 271, 0, 895, 0, 0, 0, 81, 0, 0,
-	81, 81, 81, 271, 0, 0, 0, 0, 81, 921,
-	0, 271, 595, 599, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1539, 0, 0, 925, 926, 0, 618,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 51, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 662, 0, 401, 868, 0, 0,
-	0, 0, 0, 673, 0, 0, 0, 0, 0, 844,
-	0, 81, 0, 784, 0, 0, 0, 0, 0, 81,
-	791, 792, 793, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 951, 0, 81, 0, 707, 0, 811, 0,
-	707, 81, 0, 815, 816, 817, 0, 819, 820, 0,
-	0, 401, 0, 401, 0, 0, 0, 824, 825, 0,
-	0, 0, 0, 706, 706, 706, 706, 706, 901, 903,
-	401, 0, 0, 0, 0, 0, 0, 0, 706, 0,
-	0, 81, 81, 0, 81, 0, 706, 0, 0, 81,
-	0, 81, 81, 81, 271, 0, 0, 81, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 567, 0, 567, 81, 271, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	567, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 81, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 81, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 0, 1081, 0,
-	0, 0, 0, 271, 814, 0, 0, 0, 0, 0,
-	921, 81, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 830, 0,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 401, 0, 853, 854, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1127, 1128, 0,
-	0, 707, 707, 707, 707, 707, 0, 0, 0, 1028,
-	0, 1030, 0, 0, 0, 0, 951, 0, 1151, 0,
-	0, 0, 0, 0, 707, 0, 0, 0, 1057, 0,
-	0, 1197, 401, 0, 0, 0, 0, 0, 662, 0,
-	1089, 907, 908, 0, 1090, 0, 0, 0, 0, 0,
-	0, 0, 1095, 1096, 1097, 0, 0, 0, 0, 1103,
-	0, 401, 1106, 1107, 0, 0, 0, 0, 1113, 0,
-	0, 596, 1115, 0, 0, 1118, 1119, 1120, 1121, 1122,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 567, 0, 0, 0, 0, 0, 1146, 0,
-	974, 0, 0, 706, 0, 0, 0, 269, 0, 0,
-	295, 0, 0, 0, 0, 0, 0, 0, 0, 401,
-	0, 567, 0, 0, 0, 0, 0, 0, 0, 921,
-	0, 0, 1281, 1283, 0, 322, 0, 0, 392, 0,
-	0, 0, 0, 269, 0, 269, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 401, 0, 401, 1310, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 24, 25, 52, 27, 28, 0,
-	1278, 0, 51, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 43, 0, 0, 0, 0, 29, 48,
-	49, 1066, 1067, 0, 599, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1334, 0, 38,
-	1339, 1340, 1341, 54, 0, 621, 0, 624, 401, 1244,
-	1245, 0, 0, 638, 639, 640, 641, 642, 643, 644,
-	1198, 622, 623, 620, 626, 625, 635, 636, 628, 629,
-	630, 631, 632, 633, 634, 627, 0, 0, 637, 0,
-	0, 0, 0, 0, 0, 0, 0, 1094, 0, 1229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	921, 0, 0, 0, 1112, 0, 31, 32, 34, 33,
-	36, 707, 50, 0, 0, 0, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 1395,
-	0, 0, 0, 0, 0, 37, 44, 45, 269, 1364,
-	46, 47, 35, 269, 401, 0, 0, 0, 0, 269,
-	0, 401, 0, 0, 0, 269, 39, 40, 0, 41,
-	42, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1386, 1387, 1388, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1429, 1430, 0, 1431, 0, 0, 1347, 0, 1395,
-	0, 1395, 1395, 1395, 567, 1349, 0, 1310, 0, 0,
-	0, 0, 0, 0, 0, 0, 1358, 1359, 0, 0,
-	0, 0, 0, 0, 1395, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1373, 1374, 1375, 0,
-	1378, 0, 53, 0, 0, 0, 0, 1278, 0, 0,
-	1428, 0, 0, 0, 0, 0, 0, 1389, 0, 0,
-	0, 1489, 0, 0, 0, 0, 0, 269, 269, 269,
-	0, 0, 401, 401, 0, 0, 0, 0, 0, 0,
-	1456, 0, 0, 0, 0, 0, 0, 0, 0, 921,
-	0, 0, 1508, 0, 0, 0, 1267, 0, 0, 0,
-	0, 1278, 0, 51, 0, 0, 0, 0, 0, 0,
-	0, 1514, 0, 0, 0, 0, 0, 0, 0, 0,
+	343, 1571, 1561, 1358, 679, 1150, 1244, 1418, 1466, 1523,
+	999, 337, 1168, 1431, 1298, 1332, 972, 361, 63, 1028,
+	1151, 995, 1195, 1008, 1299, 1295, 348, 374, 1042, 1305,
+	916, 998, 322, 678, 3, 86, 1270, 1107, 970, 278,
+	1311, 298, 278, 909, 920, 1174, 845, 86, 1212, 1221,
+	740, 1012, 959, 974, 720, 886, 309, 938, 1038, 610,
+	616, 542, 418, 826, 721, 919, 412, 952, 622, 631,
+	331, 739, 346, 278, 86, 404, 407, 543, 278, 573,
+	278, 409, 729, 693, 62, 1266, 67, 1564, 1548, 316,
+	562, 321, 694, 1559, 1535, 1556, 1061, 1359, 1547, 1534,
+	350, 1287, 1022, 1390, 547, 1326, 310, 311, 312, 313,
+	1060, 1327, 1328, 320, 335, 69, 70, 71, 72, 73,
+	1497, 644, 643, 653, 654, 646, 647, 648, 649, 650,
+	651, 652, 645, 989, 27, 655, 58, 30, 31, 88,
+	89, 90, 88, 89, 90, 602, 88, 89, 90, 386,
+	1059, 392, 393, 390, 391, 389, 388, 387, 315, 274,
+	270, 271, 272, 990, 991, 394, 395, 741, 1183, 742,
+	266, 1182, 597, 264, 1184, 268, 598, 595, 596, 314,
+	1203, 1021, 1421, 60, 1246, 88, 89, 90, 1029, 1381,
+	1379, 306, 853, 308, 304, 590, 591, 600, 814, 282,
+	1056, 1053, 1054, 1248, 1052, 815, 285, 601, 1558, 812,
+	579, 1555, 581, 1524, 292, 644, 643, 653, 654, 646,
+	647, 648, 649, 650, 651, 652, 645, 1438, 1243, 655,
+	953, 1575, 1516, 1013, 1579, 813, 563, 584, 1063, 1066,
+	1247, 1169, 1171, 549, 268, 578, 580, 816, 803, 290,
+	1249, 819, 604, 1321, 1467, 297, 1320, 1319, 545, 552,
+	341, 281, 269, 587, 1505, 278, 554, 555, 1401, 1469,
+	278, 1108, 564, 267, 1475, 1240, 278, 1073, 1058, 1271,
+	1072, 1242, 278, 571, 273, 283, 577, 86, 1125, 1015,
+	1254, 86, 1179, 86, 1122, 265, 1498, 1135, 1015, 86,
+	1057, 667, 668, 559, 582, 985, 1101, 858, 735, 86,
+	635, 415, 294, 286, 569, 295, 296, 302, 1533, 1273,
+	1170, 287, 289, 299, 1029, 284, 301, 300, 88, 89,
+	90, 996, 655, 855, 576, 613, 617, 1468, 86, 76,
+	1062, 1387, 1573, 645, 618, 1574, 655, 1572, 850, 773,
+	575, 630, 636, 1231, 1514, 1064, 1275, 586, 1279, 1484,
+	1274, 619, 1272, 565, 566, 567, 1309, 1277, 556, 588,
+	557, 606, 607, 558, 629, 628, 1276, 77, 1476, 1474,
+	1241, 1291, 1239, 1227, 1228, 1229, 59, 680, 1014, 1278,
+	1280, 630, 743, 667, 668, 1289, 691, 1014, 939, 667,
+	668, 846, 278, 278, 278, 648, 649, 650, 651, 652,
+	645, 86, 939, 655, 1132, 620, 805, 86, 644, 643,
+	653, 654, 646, 647, 648, 649, 650, 651, 652, 645,
+	761, 574, 655, 646, 647, 648, 649, 650, 651, 652,
+	645, 719, 1539, 655, 1201, 1018, 665, 1098, 1099, 1100,
+	893, 1019, 1230, 628, 1519, 625, 1427, 1235, 1232, 1223,
+	1233, 1226, 1426, 1222, 891, 892, 890, 1224, 1225, 630,
+	774, 696, 698, 700, 702, 704, 706, 707, 840, 728,
+	697, 699, 1234, 703, 705, 847, 708, 1216, 733, 861,
+	862, 1121, 737, 263, 1215, 1204, 787, 790, 791, 792,
+	793, 794, 795, 724, 796, 797, 798, 799, 800, 775,
+	776, 777, 778, 759, 760, 788, 1580, 762, 25, 763,
+	764, 765, 766, 767, 768, 769, 770, 771, 772, 779,
+	780, 781, 782, 783, 784, 785, 786, 88, 89, 90,
+	629, 628, 278, 1541, 629, 628, 801, 86, 1515, 804,
+	1447, 806, 278, 278, 86, 86, 86, 630, 1581, 548,
+	278, 630, 841, 278, 401, 402, 278, 824, 825, 1015,
+	278, 857, 86, 88, 89, 90, 1424, 86, 86, 86,
+	278, 86, 86, 1120, 326, 1119, 60, 832, 789, 1213,
+	1345, 86, 86, 629, 628, 589, 1083, 592, 889, 88,
+	89, 90, 831, 603, 629, 628, 1472, 1557, 541, 856,
+	630, 848, 669, 670, 671, 672, 673, 674, 675, 676,
+	609, 630, 86, 88, 89, 90, 1481, 278, 629, 628,
+	1543, 609, 828, 86, 64, 88, 89, 90, 820, 911,
+	873, 874, 550, 551, 1480, 630, 1472, 1527, 415, 1472,
+	609, 863, 830, 876, 878, 879, 910, 1472, 1506, 877,
+	88, 89, 90, 27, 1186, 912, 887, 1341, 1014, 1472,
+	1471, 1416, 1415, 1011, 1009, 1114, 1010, 86, 1403, 609,
+	1175, 1400, 609, 1007, 1013, 364, 363, 366, 367, 368,
+	369, 865, 1452, 680, 365, 370, 927, 928, 884, 882,
+	1351, 1350, 880, 1347, 1348, 1347, 1346, 1114, 609, 1016,
+	86, 86, 60, 929, 932, 924, 956, 609, 278, 940,
+	922, 609, 750, 749, 956, 1175, 278, 278, 344, 1296,
+	278, 278, 1308, 27, 278, 278, 278, 86, 955, 888,
+	913, 914, 1257, 948, 949, 731, 731, 1308, 925, 926,
+	86, 543, 931, 934, 935, 994, 922, 1145, 936, 979,
+	1396, 730, 1146, 87, 956, 1483, 956, 279, 980, 1308,
+	279, 1349, 982, 27, 1187, 87, 988, 947, 1138, 1137,
+	950, 951, 60, 328, 1030, 1031, 1032, 1114, 732, 732,
+	734, 730, 1114, 730, 736, 978, 859, 818, 828, 7,
+	60, 279, 87, 986, 278, 86, 279, 86, 279, 1065,
+	987, 983, 1003, 278, 278, 278, 278, 278, 6, 278,
+	278, 5, 60, 278, 86, 1549, 724, 1433, 1023, 1044,
+	724, 1408, 60, 1043, 724, 961, 964, 965, 966, 962,
+	278, 963, 967, 1337, 1190, 278, 1039, 278, 278, 1312,
+	1313, 802, 278, 86, 1034, 319, 1040, 1041, 809, 810,
+	811, 1033, 1024, 1025, 1026, 1027, 1245, 1434, 1087, 1088,
+	1046, 617, 1566, 883, 318, 1562, 829, 317, 1035, 1036,
+	1037, 833, 834, 835, 1339, 837, 838, 1315, 1296, 1217,
+	851, 822, 1162, 1318, 1160, 842, 843, 1163, 885, 1161,
+	871, 894, 895, 896, 897, 898, 899, 900, 901, 902,
+	903, 904, 905, 906, 907, 908, 1317, 1159, 887, 1158,
+	1090, 884, 1089, 1091, 1164, 1080, 965, 966, 332, 333,
+	1553, 1546, 1253, 1551, 1115, 1086, 1097, 961, 964, 965,
+	966, 962, 1096, 963, 967, 1095, 1103, 1312, 1313, 623,
+	623, 1133, 611, 1208, 748, 572, 944, 278, 278, 278,
+	278, 278, 624, 624, 612, 621, 1200, 1521, 1520, 278,
+	1450, 1198, 278, 1192, 415, 1394, 278, 1429, 1049, 821,
+	278, 969, 1152, 1112, 1113, 1491, 1147, 1000, 329, 330,
+	323, 888, 1094, 279, 1489, 1131, 324, 1185, 279, 86,
+	1093, 64, 1129, 1488, 279, 924, 1436, 1175, 1191, 1188,
+	279, 1153, 1196, 1196, 1156, 87, 599, 1126, 1176, 87,
+	1165, 87, 1154, 1155, 1123, 1157, 1177, 87, 1178, 1173,
+	1568, 1567, 68, 844, 626, 1197, 1568, 87, 1502, 1180,
+	1422, 854, 66, 852, 1205, 1206, 305, 86, 86, 61,
+	1207, 1, 1209, 1210, 1211, 1560, 1360, 724, 724, 724,
+	724, 724, 1193, 1194, 1430, 1055, 87, 1522, 1465, 1331,
+	1006, 997, 724, 75, 540, 74, 1513, 86, 839, 585,
+	724, 1005, 1004, 1214, 1473, 1420, 1017, 1202, 1020, 1338,
+	1199, 1518, 756, 278, 754, 755, 883, 753, 758, 757,
+	752, 291, 86, 1236, 410, 968, 744, 1045, 1220, 1048,
+	627, 1050, 78, 1238, 1237, 1051, 849, 288, 593, 594,
+	293, 910, 663, 1092, 1181, 1251, 1252, 943, 1077, 416,
+	279, 279, 279, 1260, 1303, 860, 615, 1487, 1265, 87,
+	1437, 1435, 1261, 1130, 1290, 87, 690, 937, 1288, 86,
+	86, 1297, 1104, 1105, 1106, 349, 875, 362, 1282, 1269,
+	359, 360, 866, 1144, 1281, 637, 347, 339, 723, 716,
+	960, 958, 1152, 86, 1300, 957, 405, 1314, 1310, 722,
+	1256, 1389, 1302, 1496, 870, 29, 65, 1324, 86, 334,
+	86, 86, 21, 1323, 1196, 1196, 1307, 1316, 884, 1089,
+	1330, 20, 1322, 19, 18, 17, 23, 22, 16, 1344,
+	15, 14, 560, 33, 24, 1334, 1335, 1336, 278, 1329,
+	13, 12, 1325, 11, 10, 9, 8, 1342, 1343, 4,
+	325, 375, 57, 26, 2, 0, 1000, 0, 278, 0,
+	0, 0, 0, 0, 86, 0, 1361, 86, 86, 86,
+	278, 0, 0, 0, 0, 86, 0, 0, 278, 643,
+	653, 654, 646, 647, 648, 649, 650, 651, 652, 645,
+	279, 0, 655, 0, 0, 87, 0, 0, 1353, 0,
+	279, 279, 87, 87, 87, 0, 0, 57, 279, 57,
+	0, 279, 0, 1354, 279, 1356, 1369, 327, 279, 0,
+	87, 0, 1368, 1391, 1377, 87, 87, 87, 279, 87,
+	87, 0, 0, 680, 0, 0, 0, 0, 0, 87,
+	87, 1406, 0, 1395, 1407, 0, 1404, 1409, 0, 1405,
+	86, 1366, 1367, 0, 0, 1152, 0, 0, 86, 1259,
+	1188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	87, 0, 1219, 86, 0, 279, 1414, 0, 724, 0,
+	86, 87, 0, 0, 0, 0, 1423, 0, 1425, 1263,
+	1264, 0, 0, 1292, 1374, 1375, 1440, 1376, 0, 0,
+	1378, 1250, 1380, 1283, 1284, 0, 1285, 1286, 0, 0,
+	0, 0, 0, 0, 0, 1439, 0, 0, 1293, 1294,
+	0, 86, 86, 0, 86, 87, 0, 0, 0, 86,
+	0, 86, 86, 86, 278, 0, 1459, 86, 1460, 1462,
+	1463, 1451, 1446, 0, 1300, 1000, 0, 1000, 0, 0,
+	1464, 0, 1470, 1453, 86, 278, 1417, 1458, 87, 87,
+	1477, 1485, 0, 0, 0, 1478, 279, 1479, 0, 608,
+	0, 0, 0, 0, 279, 279, 1490, 0, 279, 279,
+	0, 1340, 279, 279, 279, 87, 0, 1503, 1512, 0,
+	0, 0, 0, 86, 1511, 0, 0, 1510, 87, 0,
+	1300, 0, 0, 0, 86, 86, 0, 0, 1504, 0,
+	0, 0, 1259, 0, 1529, 1526, 1531, 1525, 0, 0,
+	1528, 680, 0, 680, 0, 0, 86, 0, 1536, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 583, 0,
+	0, 0, 583, 1371, 583, 86, 0, 0, 0, 1152,
+	583, 0, 279, 87, 1545, 87, 0, 0, 0, 0,
+	0, 279, 279, 279, 279, 279, 609, 279, 279, 1552,
+	86, 279, 87, 1550, 0, 0, 0, 1554, 0, 57,
+	0, 0, 0, 1565, 0, 0, 0, 1000, 279, 0,
+	1576, 0, 0, 279, 664, 279, 279, 666, 0, 0,
+	279, 87, 0, 0, 0, 644, 643, 653, 654, 646,
+	647, 648, 649, 650, 651, 652, 645, 1432, 0, 655,
+	0, 0, 0, 0, 0, 677, 0, 681, 682, 683,
+	684, 685, 686, 687, 688, 689, 0, 692, 695, 695,
+	695, 701, 695, 695, 701, 695, 709, 710, 711, 712,
+	713, 714, 715, 0, 725, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1441, 1442, 1443, 1444, 1445,
+	639, 0, 642, 1448, 1449, 0, 0, 1428, 656, 657,
+	658, 659, 660, 661, 662, 0, 640, 641, 638, 644,
+	643, 653, 654, 646, 647, 648, 649, 650, 651, 652,
+	645, 0, 0, 655, 0, 279, 279, 279, 279, 279,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	279, 0, 0, 0, 279, 0, 0, 0, 279, 373,
+	0, 653, 654, 646, 647, 648, 649, 650, 651, 652,
+	645, 1432, 1000, 655, 0, 0, 0, 87, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 27,
+	28, 58, 30, 31, 85, 0, 0, 864, 0, 0,
+	1393, 0, 0, 0, 1386, 0, 307, 0, 48, 0,
+	0, 0, 0, 32, 53, 54, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 87, 0, 583, 0,
+	0, 0, 0, 417, 41, 583, 583, 583, 60, 0,
+	644, 643, 653, 654, 646, 647, 648, 649, 650, 651,
+	652, 645, 0, 583, 655, 87, 921, 923, 583, 583,
+	583, 0, 583, 583, 0, 0, 0, 0, 1392, 0,
+	0, 279, 583, 583, 1569, 0, 0, 0, 0, 0,
+	87, 644, 643, 653, 654, 646, 647, 648, 649, 650,
+	651, 652, 645, 1385, 0, 655, 0, 0, 0, 0,
+	0, 34, 35, 37, 36, 39, 0, 56, 644, 643,
+	653, 654, 646, 647, 648, 649, 650, 651, 652, 645,
+	0, 0, 655, 0, 0, 0, 0, 87, 87, 0,
+	40, 49, 50, 55, 0, 0, 51, 52, 38, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 87, 42, 43, 0, 44, 45, 46, 47, 0,
+	0, 0, 0, 681, 0, 0, 87, 0, 87, 87,
+	644, 643, 653, 654, 646, 647, 648, 649, 650, 651,
+	652, 645, 0, 0, 655, 0, 0, 0, 0, 0,
+	0, 0, 1384, 0, 0, 0, 279, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 971, 0, 0,
+	0, 725, 0, 0, 0, 725, 279, 0, 0, 0,
+	0, 0, 87, 0, 0, 87, 87, 87, 279, 0,
+	0, 0, 0, 87, 0, 0, 279, 0, 0, 0,
+	0, 59, 0, 0, 0, 0, 417, 0, 0, 0,
+	417, 0, 417, 0, 0, 0, 0, 0, 417, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 605, 644,
+	643, 653, 654, 646, 647, 648, 649, 650, 651, 652,
+	645, 0, 0, 655, 0, 0, 583, 0, 583, 0,
+	1110, 0, 0, 0, 1111, 0, 0, 633, 0, 0,
+	0, 0, 1116, 1117, 1118, 583, 0, 0, 87, 1124,
+	0, 0, 1127, 1128, 0, 0, 87, 0, 1134, 0,
+	0, 0, 1136, 726, 0, 1139, 1140, 1141, 1142, 1143,
+	1262, 87, 0, 0, 0, 0, 0, 0, 87, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1167, 0,
+	644, 643, 653, 654, 646, 647, 648, 649, 650, 651,
+	652, 645, 276, 0, 655, 1102, 0, 0, 0, 0,
+	417, 0, 0, 0, 0, 0, 745, 0, 0, 87,
+	87, 0, 87, 0, 0, 0, 0, 87, 0, 87,
+	87, 87, 279, 0, 1109, 87, 406, 0, 0, 0,
+	0, 544, 0, 546, 0, 0, 0, 0, 0, 0,
+	0, 0, 87, 279, 644, 643, 653, 654, 646, 647,
+	648, 649, 650, 651, 652, 645, 0, 0, 655, 0,
+	0, 0, 0, 0, 1148, 1149, 0, 0, 725, 725,
+	725, 725, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 87, 0, 971, 0, 1172, 0, 0, 0, 0,
+	0, 725, 87, 87, 644, 643, 653, 654, 646, 647,
+	648, 649, 650, 651, 652, 645, 0, 0, 655, 0,
+	0, 0, 0, 0, 87, 0, 0, 0, 0, 0,
+	1267, 1268, 0, 0, 0, 279, 0, 0, 0, 0,
+	0, 0, 0, 87, 0, 0, 417, 0, 0, 0,
+	0, 0, 0, 417, 417, 417, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 87, 583,
+	0, 417, 0, 0, 0, 0, 417, 417, 417, 0,
+	417, 417, 0, 0, 0, 0, 0, 0, 0, 0,
+	417, 417, 0, 0, 0, 0, 0, 0, 583, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 867, 0, 0, 0, 0, 0, 0, 553, 0,
+	0, 0, 633, 561, 0, 417, 0, 0, 0, 568,
+	0, 0, 0, 0, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1301, 0,
+	57, 0, 0, 0, 0, 0, 915, 0, 1370, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1373, 0,
+	0, 0, 941, 0, 0, 0, 0, 0, 0, 1382,
+	1383, 0, 0, 0, 0, 0, 0, 0, 0, 945,
+	946, 0, 0, 0, 0, 0, 0, 0, 0, 1397,
+	1398, 1399, 0, 1402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 417, 0, 0, 0,
+	1413, 0, 0, 0, 0, 0, 0, 0, 0, 417,
+	0, 614, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 718, 0, 727, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 725,
+	0, 0, 0, 0, 0, 0, 0, 0, 1372, 0,
+	277, 0, 0, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 417, 0, 417, 0, 0, 1388,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 338, 0, 417, 408, 0, 0, 0, 1461, 277,
+	0, 277, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1410, 1411, 1412, 0, 0, 0, 0, 0, 0,
+	0, 0, 1085, 0, 0, 0, 0, 0, 417, 1492,
+	1493, 1494, 1495, 0, 1499, 0, 1500, 1501, 0, 0,
+	0, 0, 0, 0, 583, 0, 0, 0, 1507, 0,
+	1508, 1509, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 751, 0, 0, 1530, 0,
+	0, 0, 0, 0, 1532, 807, 808, 0, 1301, 0,
+	0, 1454, 1537, 817, 0, 0, 406, 0, 0, 823,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1542, 0, 0, 836, 0, 0, 0, 0, 0, 0,
+	0, 1482, 0, 0, 0, 941, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1301, 0, 57, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1577, 1578, 0,
+	872, 0, 0, 0, 0, 0, 0, 0, 417, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 277, 0, 0,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1218, 417, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1395, 0, 0, 0, 0, 1301,
-	0, 0, 0, 0, 1435, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1464, 1465, 1466, 1467, 0, 1471, 0,
-	1472, 1473, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1479, 0, 1480, 1481, 0, 0, 0, 0,
-	0, 0, 0, 1533, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 269, 0,
-	0, 0, 0, 0, 0, 269, 1502, 0, 269, 0,
-	0, 269, 0, 0, 1507, 809, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 1512, 1367, 0, 0, 0, 0, 0, 0,
-	0, 0, 755, 662, 0, 0, 0, 0, 0, 0,
-	0, 1382, 0, 0, 1383, 0, 0, 1385, 0, 0,
+	0, 0, 0, 0, 0, 0, 417, 0, 0, 0,
+	1563, 954, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 981, 0, 0, 0, 0, 0,
+	0, 417, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 1547, 1548, 809,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 417, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 941, 0, 0, 1304, 1306,
+	0, 0, 0, 277, 277, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1047, 0, 0,
+	0, 0, 1306, 0, 0, 0, 1067, 1068, 1069, 1070,
+	1071, 0, 1074, 1075, 0, 0, 1076, 417, 0, 417,
+	1333, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1078, 0, 0, 0, 0, 1079, 0,
+	0, 0, 0, 0, 0, 1084, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1357, 0, 0, 1362, 1363, 1364, 0,
+	0, 0, 0, 0, 417, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 322, 0, 743, 0, 0, 322, 322, 0, 0,
-	322, 322, 322, 0, 0, 0, 922, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 322, 322, 322, 322, 322,
-	0, 269, 756, 0, 0, 0, 0, 0, 0, 269,
-	956, 0, 0, 269, 269, 0, 0, 269, 964, 809,
-	0, 0, 0, 0, 0, 769, 772, 773, 774, 775,
-	776, 777, 0, 778, 779, 780, 781, 782, 757, 758,
-	759, 760, 741, 742, 770, 0, 744, 0, 745, 746,
-	747, 748, 749, 750, 751, 752, 753, 754, 761, 762,
-	763, 764, 765, 766, 767, 768, 0, 1500, 662, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 269, 269, 269,
-	269, 0, 269, 269, 0, 0, 269, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 771, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 269, 0,
-	1061, 1062, 0, 0, 0, 269, 0, 0, 0, 0,
-	809, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 322,
-	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 322, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 922, 269, 269,
-	269, 269, 269, 0, 0, 0, 0, 0, 0, 0,
-	1145, 0, 0, 269, 0, 0, 0, 956, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 322, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 809, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 922, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 922, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 511, 499, 0, 456,
-	514, 429, 446, 522, 447, 450, 487, 414, 469, 170,
-	444, 956, 433, 409, 440, 410, 431, 458, 114, 462,
-	428, 501, 472, 513, 142, 434, 520, 144, 478, 0,
-	216, 158, 269, 0, 460, 503, 467, 496, 455, 488,
-	419, 477, 515, 445, 485, 516, 0, 0, 0, 82,
-	83, 84, 0, 981, 982, 0, 0, 0, 0, 0,
-	104, 0, 482, 510, 442, 484, 486, 408, 479, 0,
-	412, 415, 521, 506, 437, 438, 1168, 0, 0, 0,
-	0, 0, 0, 459, 468, 493, 453, 0, 0, 0,
-	0, 0, 0, 0, 0, 435, 922, 476, 0, 0,
-	0, 416, 413, 0, 0, 457, 0, 0, 0, 418,
-	269, 436, 494, 0, 406, 123, 498, 505, 454, 272,
-	509, 452, 451, 512, 189, 0, 220, 126, 141, 100,
-	138, 86, 96, 0, 125, 167, 196, 200, 502, 432,
-	441, 108, 439, 198, 177, 236, 475, 179, 197, 145,
-	226, 190, 235, 245, 246, 223, 243, 250, 213, 89,
-	222, 234, 105, 208, 91, 232, 219, 156, 135, 136,
-	90, 0, 194, 113, 121, 110, 169, 229, 230, 109,
-	253, 97, 242, 93, 98, 241, 163, 225, 233, 157,
-	150, 92, 231, 155, 149, 140, 117, 128, 187, 147,
-	188, 129, 160, 159, 161, 0, 411, 0, 217, 239,
-	254, 102, 427, 224, 248, 249, 0, 0, 103, 122,
-	116, 186, 120, 162, 99, 131, 214, 139, 146, 193,
-	252, 176, 199, 106, 238, 215, 423, 426, 421, 422,
-	470, 471, 517, 518, 519, 495, 417, 0, 424, 425,
-	0, 500, 507, 508, 474, 85, 94, 143, 251, 191,
-	119, 240, 407, 420, 112, 430, 0, 0, 443, 448,
-	449, 461, 463, 464, 465, 466, 473, 480, 481, 483,
-	489, 490, 491, 492, 497, 504, 523, 87, 88, 95,
-	101, 107, 111, 115, 118, 124, 127, 130, 132, 133,
-	134, 137, 148, 151, 152, 153, 154, 164, 165, 166,
-	168, 171, 172, 173, 174, 175, 178, 180, 181, 182,
-	183, 184, 185, 192, 195, 201, 202, 203, 204, 205,
-	206, 207, 209, 210, 211, 212, 218, 221, 227, 228,
-	237, 244, 247, 511, 499, 0, 456, 514, 429, 446,
-	522, 447, 450, 487, 414, 469, 170, 444, 0, 433,
-	409, 440, 410, 431, 458, 114, 462, 428, 501, 472,
-	513, 142, 434, 520, 144, 478, 0, 216, 158, 0,
-	0, 460, 503, 467, 496, 455, 488, 419, 477, 515,
-	445, 485, 516, 0, 0, 0, 82, 83, 84, 0,
-	981, 982, 0, 0, 0, 0, 0, 104, 0, 482,
-	510, 442, 484, 486, 408, 479, 0, 412, 415, 521,
-	506, 437, 438, 0, 0, 0, 0, 0, 0, 0,
-	459, 468, 493, 453, 0, 0, 0, 0, 0, 0,
-	0, 0, 435, 0, 476, 0, 0, 0, 416, 413,
-	0, 0, 457, 0, 0, 0, 418, 0, 436, 494,
-	0, 406, 123, 498, 505, 454, 272, 509, 452, 451,
-	512, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 502, 432, 441, 108, 439,
-	198, 177, 236, 475, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 411, 0, 217, 239, 254, 102, 427,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 423, 426, 421, 422, 470, 471, 517,
-	518, 519, 495, 417, 0, 424, 425, 0, 500, 507,
-	508, 474, 85, 94, 143, 251, 191, 119, 240, 407,
-	420, 112, 430, 0, 0, 443, 448, 449, 461, 463,
-	464, 465, 466, 473, 480, 481, 483, 489, 490, 491,
-	492, 497, 504, 523, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	511, 499, 0, 456, 514, 429, 446, 522, 447, 450,
-	487, 414, 469, 170, 444, 0, 433, 409, 440, 410,
-	431, 458, 114, 462, 428, 501, 472, 513, 142, 434,
-	520, 144, 478, 0, 216, 158, 0, 0, 460, 503,
-	467, 496, 455, 488, 419, 477, 515, 445, 485, 516,
-	54, 0, 0, 82, 83, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 104, 0, 482, 510, 442, 484,
-	486, 408, 479, 0, 412, 415, 521, 506, 437, 438,
-	0, 0, 0, 0, 0, 0, 0, 459, 468, 493,
-	453, 0, 0, 0, 0, 0, 0, 0, 0, 435,
-	0, 476, 0, 0, 0, 416, 413, 0, 0, 457,
-	0, 0, 0, 418, 0, 436, 494, 0, 406, 123,
-	498, 505, 454, 272, 509, 452, 451, 512, 189, 0,
-	220, 126, 141, 100, 138, 86, 96, 0, 125, 167,
-	196, 200, 502, 432, 441, 108, 439, 198, 177, 236,
-	475, 179, 197, 145, 226, 190, 235, 245, 246, 223,
-	243, 250, 213, 89, 222, 234, 105, 208, 91, 232,
-	219, 156, 135, 136, 90, 0, 194, 113, 121, 110,
-	169, 229, 230, 109, 253, 97, 242, 93, 98, 241,
-	163, 225, 233, 157, 150, 92, 231, 155, 149, 140,
-	117, 128, 187, 147, 188, 129, 160, 159, 161, 0,
-	411, 0, 217, 239, 254, 102, 427, 224, 248, 249,
-	0, 0, 103, 122, 116, 186, 120, 162, 99, 131,
-	214, 139, 146, 193, 252, 176, 199, 106, 238, 215,
-	423, 426, 421, 422, 470, 471, 517, 518, 519, 495,
-	417, 0, 424, 425, 0, 500, 507, 508, 474, 85,
-	94, 143, 251, 191, 119, 240, 407, 420, 112, 430,
-	0, 0, 443, 448, 449, 461, 463, 464, 465, 466,
-	473, 480, 481, 483, 489, 490, 491, 492, 497, 504,
-	523, 87, 88, 95, 101, 107, 111, 115, 118, 124,
-	127, 130, 132, 133, 134, 137, 148, 151, 152, 153,
-	154, 164, 165, 166, 168, 171, 172, 173, 174, 175,
-	178, 180, 181, 182, 183, 184, 185, 192, 195, 201,
-	202, 203, 204, 205, 206, 207, 209, 210, 211, 212,
-	218, 221, 227, 228, 237, 244, 247, 511, 499, 0,
-	456, 514, 429, 446, 522, 447, 450, 487, 414, 469,
-	170, 444, 0, 433, 409, 440, 410, 431, 458, 114,
-	462, 428, 501, 472, 513, 142, 434, 520, 144, 478,
-	0, 216, 158, 0, 0, 460, 503, 467, 496, 455,
-	488, 419, 477, 515, 445, 485, 516, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 482, 510, 442, 484, 486, 408, 479,
-	0, 412, 415, 521, 506, 437, 438, 0, 0, 0,
-	0, 0, 0, 0, 459, 468, 493, 453, 0, 0,
-	0, 0, 0, 0, 1237, 0, 435, 0, 476, 0,
-	0, 0, 416, 413, 0, 0, 457, 0, 0, 0,
-	418, 0, 436, 494, 0, 406, 123, 498, 505, 454,
-	272, 509, 452, 451, 512, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 502,
-	432, 441, 108, 439, 198, 177, 236, 475, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 411, 0, 217,
-	239, 254, 102, 427, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 423, 426, 421,
-	422, 470, 471, 517, 518, 519, 495, 417, 0, 424,
-	425, 0, 500, 507, 508, 474, 85, 94, 143, 251,
-	191, 119, 240, 407, 420, 112, 430, 0, 0, 443,
-	448, 449, 461, 463, 464, 465, 466, 473, 480, 481,
-	483, 489, 490, 491, 492, 497, 504, 523, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 511, 499, 0, 456, 514, 429,
-	446, 522, 447, 450, 487, 414, 469, 170, 444, 0,
-	433, 409, 440, 410, 431, 458, 114, 462, 428, 501,
-	472, 513, 142, 434, 520, 144, 478, 0, 216, 158,
-	0, 0, 460, 503, 467, 496, 455, 488, 419, 477,
-	515, 445, 485, 516, 0, 0, 0, 82, 83, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 104, 0,
-	482, 510, 442, 484, 486, 408, 479, 0, 412, 415,
-	521, 506, 437, 438, 0, 0, 0, 0, 0, 0,
-	0, 459, 468, 493, 453, 0, 0, 0, 0, 0,
-	0, 965, 0, 435, 0, 476, 0, 0, 0, 416,
-	413, 0, 0, 457, 0, 0, 0, 418, 0, 436,
-	494, 0, 406, 123, 498, 505, 454, 272, 509, 452,
-	451, 512, 189, 0, 220, 126, 141, 100, 138, 86,
-	96, 0, 125, 167, 196, 200, 502, 432, 441, 108,
-	439, 198, 177, 236, 475, 179, 197, 145, 226, 190,
-	235, 245, 246, 223, 243, 250, 213, 89, 222, 234,
-	105, 208, 91, 232, 219, 156, 135, 136, 90, 0,
-	194, 113, 121, 110, 169, 229, 230, 109, 253, 97,
-	242, 93, 98, 241, 163, 225, 233, 157, 150, 92,
-	231, 155, 149, 140, 117, 128, 187, 147, 188, 129,
-	160, 159, 161, 0, 411, 0, 217, 239, 254, 102,
-	427, 224, 248, 249, 0, 0, 103, 122, 116, 186,
-	120, 162, 99, 131, 214, 139, 146, 193, 252, 176,
-	199, 106, 238, 215, 423, 426, 421, 422, 470, 471,
-	517, 518, 519, 495, 417, 0, 424, 425, 0, 500,
-	507, 508, 474, 85, 94, 143, 251, 191, 119, 240,
-	407, 420, 112, 430, 0, 0, 443, 448, 449, 461,
-	463, 464, 465, 466, 473, 480, 481, 483, 489, 490,
-	491, 492, 497, 504, 523, 87, 88, 95, 101, 107,
-	111, 115, 118, 124, 127, 130, 132, 133, 134, 137,
-	148, 151, 152, 153, 154, 164, 165, 166, 168, 171,
-	172, 173, 174, 175, 178, 180, 181, 182, 183, 184,
-	185, 192, 195, 201, 202, 203, 204, 205, 206, 207,
-	209, 210, 211, 212, 218, 221, 227, 228, 237, 244,
-	247, 511, 499, 0, 456, 514, 429, 446, 522, 447,
-	450, 487, 414, 469, 170, 444, 0, 433, 409, 440,
-	410, 431, 458, 114, 462, 428, 501, 472, 513, 142,
-	434, 520, 144, 478, 0, 216, 158, 0, 0, 460,
-	503, 467, 496, 455, 488, 419, 477, 515, 445, 485,
-	516, 0, 0, 0, 82, 83, 84, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 0, 482, 510, 442,
-	484, 486, 408, 479, 0, 412, 415, 521, 506, 437,
-	438, 0, 0, 0, 0, 0, 0, 0, 459, 468,
-	493, 453, 0, 0, 0, 0, 0, 0, 861, 0,
-	435, 0, 476, 0, 0, 0, 416, 413, 0, 0,
-	457, 0, 0, 0, 418, 0, 436, 494, 0, 406,
-	123, 498, 505, 454, 272, 509, 452, 451, 512, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 502, 432, 441, 108, 439, 198, 177,
-	236, 475, 179, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 411, 0, 217, 239, 254, 102, 427, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 423, 426, 421, 422, 470, 471, 517, 518, 519,
-	495, 417, 0, 424, 425, 0, 500, 507, 508, 474,
-	85, 94, 143, 251, 191, 119, 240, 407, 420, 112,
-	430, 0, 0, 443, 448, 449, 461, 463, 464, 465,
-	466, 473, 480, 481, 483, 489, 490, 491, 492, 497,
-	504, 523, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 511, 499,
-	0, 456, 514, 429, 446, 522, 447, 450, 487, 414,
-	469, 170, 444, 0, 433, 409, 440, 410, 431, 458,
-	114, 462, 428, 501, 472, 513, 142, 434, 520, 144,
-	478, 0, 216, 158, 0, 0, 460, 503, 467, 496,
-	455, 488, 419, 477, 515, 445, 485, 516, 0, 0,
-	0, 82, 83, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 482, 510, 442, 484, 486, 408,
-	479, 0, 412, 415, 521, 506, 437, 438, 0, 0,
-	0, 0, 0, 0, 0, 459, 468, 493, 453, 0,
-	0, 0, 0, 0, 0, 0, 0, 435, 0, 476,
-	0, 0, 0, 416, 413, 0, 0, 457, 0, 0,
-	0, 418, 0, 436, 494, 0, 406, 123, 498, 505,
-	454, 272, 509, 452, 451, 512, 189, 0, 220, 126,
-	141, 100, 138, 86, 96, 0, 125, 167, 196, 200,
-	502, 432, 441, 108, 439, 198, 177, 236, 475, 179,
-	197, 145, 226, 190, 235, 245, 246, 223, 243, 250,
-	213, 89, 222, 234, 105, 208, 91, 232, 219, 156,
-	135, 136, 90, 0, 194, 113, 121, 110, 169, 229,
-	230, 109, 253, 97, 242, 93, 98, 241, 163, 225,
-	233, 157, 150, 92, 231, 155, 149, 140, 117, 128,
-	187, 147, 188, 129, 160, 159, 161, 0, 411, 0,
-	217, 239, 254, 102, 427, 224, 248, 249, 0, 0,
-	103, 122, 116, 186, 120, 162, 99, 131, 214, 139,
-	146, 193, 252, 176, 199, 106, 238, 215, 423, 426,
-	421, 422, 470, 471, 517, 518, 519, 495, 417, 0,
-	424, 425, 0, 500, 507, 508, 474, 85, 94, 143,
-	251, 191, 119, 240, 407, 420, 112, 430, 0, 0,
-	443, 448, 449, 461, 463, 464, 465, 466, 473, 480,
-	481, 483, 489, 490, 491, 492, 497, 504, 523, 87,
-	88, 95, 101, 107, 111, 115, 118, 124, 127, 130,
-	132, 133, 134, 137, 148, 151, 152, 153, 154, 164,
-	165, 166, 168, 171, 172, 173, 174, 175, 178, 180,
-	181, 182, 183, 184, 185, 192, 195, 201, 202, 203,
-	204, 205, 206, 207, 209, 210, 211, 212, 218, 221,
-	227, 228, 237, 244, 247, 511, 499, 0, 456, 514,
-	429, 446, 522, 447, 450, 487, 414, 469, 170, 444,
-	0, 433, 409, 440, 410, 431, 458, 114, 462, 428,
-	501, 472, 513, 142, 434, 520, 144, 478, 0, 216,
-	158, 0, 0, 460, 503, 467, 496, 455, 488, 419,
-	477, 515, 445, 485, 516, 0, 0, 0, 82, 83,
-	84, 0, 0, 0, 0, 0, 0, 0, 0, 104,
-	0, 482, 510, 442, 484, 486, 408, 479, 0, 412,
-	415, 521, 506, 437, 438, 0, 0, 0, 0, 0,
-	0, 0, 459, 468, 493, 453, 0, 0, 0, 0,
-	0, 0, 0, 0, 435, 0, 476, 0, 0, 0,
-	416, 413, 0, 0, 457, 0, 0, 0, 418, 0,
-	436, 494, 0, 406, 123, 498, 505, 454, 272, 509,
-	452, 451, 512, 189, 0, 220, 126, 141, 100, 138,
-	86, 96, 0, 125, 167, 196, 200, 502, 432, 441,
-	108, 439, 198, 177, 236, 475, 179, 197, 145, 226,
-	190, 235, 245, 246, 223, 243, 250, 213, 89, 222,
-	234, 105, 208, 91, 232, 219, 156, 135, 136, 90,
-	0, 194, 113, 121, 110, 169, 229, 230, 109, 253,
-	97, 242, 93, 404, 241, 163, 225, 233, 157, 150,
-	92, 231, 155, 149, 140, 117, 128, 187, 147, 188,
-	129, 160, 159, 161, 0, 411, 0, 217, 239, 254,
-	102, 427, 224, 248, 249, 0, 0, 103, 122, 116,
-	186, 120, 405, 403, 131, 214, 139, 146, 193, 252,
-	176, 199, 106, 238, 215, 423, 426, 421, 422, 470,
-	471, 517, 518, 519, 495, 417, 0, 424, 425, 0,
-	500, 507, 508, 474, 85, 94, 143, 251, 191, 119,
-	240, 407, 420, 112, 430, 0, 0, 443, 448, 449,
-	461, 463, 464, 465, 466, 473, 480, 481, 483, 489,
-	490, 491, 492, 497, 504, 523, 87, 88, 95, 101,
-	107, 111, 115, 118, 124, 127, 130, 132, 133, 134,
-	137, 148, 151, 152, 153, 154, 164, 165, 166, 168,
-	171, 172, 173, 174, 175, 178, 180, 181, 182, 183,
-	184, 185, 192, 195, 201, 202, 203, 204, 205, 206,
-	207, 209, 210, 211, 212, 218, 221, 227, 228, 237,
-	244, 247, 511, 499, 0, 456, 514, 429, 446, 522,
-	447, 450, 487, 414, 469, 170, 444, 0, 433, 409,
-	440, 410, 431, 458, 114, 462, 428, 501, 472, 513,
-	142, 434, 520, 144, 478, 0, 216, 158, 0, 0,
-	460, 503, 467, 496, 455, 488, 419, 477, 515, 445,
-	485, 516, 0, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 482, 510,
-	442, 484, 486, 408, 479, 0, 412, 415, 521, 506,
-	437, 438, 0, 0, 0, 0, 0, 0, 0, 459,
-	468, 493, 453, 0, 0, 0, 0, 0, 0, 0,
-	0, 435, 0, 476, 0, 0, 0, 416, 413, 0,
-	0, 457, 0, 0, 0, 418, 0, 436, 494, 0,
-	406, 123, 498, 505, 454, 272, 509, 452, 451, 512,
-	189, 0, 220, 126, 141, 100, 138, 86, 96, 0,
-	125, 167, 196, 200, 502, 432, 441, 108, 439, 198,
-	177, 236, 475, 179, 197, 145, 226, 190, 235, 245,
-	246, 223, 243, 250, 213, 89, 222, 720, 105, 208,
-	91, 232, 219, 156, 135, 136, 90, 0, 194, 113,
-	121, 110, 169, 229, 230, 109, 253, 97, 242, 93,
-	404, 241, 163, 225, 233, 157, 150, 92, 231, 155,
-	149, 140, 117, 128, 187, 147, 188, 129, 160, 159,
-	161, 0, 411, 0, 217, 239, 254, 102, 427, 224,
-	248, 249, 0, 0, 103, 122, 116, 186, 120, 405,
-	403, 131, 214, 139, 146, 193, 252, 176, 199, 106,
-	238, 215, 423, 426, 421, 422, 470, 471, 517, 518,
-	519, 495, 417, 0, 424, 425, 0, 500, 507, 508,
-	474, 85, 94, 143, 251, 191, 119, 240, 407, 420,
-	112, 430, 0, 0, 443, 448, 449, 461, 463, 464,
-	465, 466, 473, 480, 481, 483, 489, 490, 491, 492,
-	497, 504, 523, 87, 88, 95, 101, 107, 111, 115,
-	118, 124, 127, 130, 132, 133, 134, 137, 148, 151,
-	152, 153, 154, 164, 165, 166, 168, 171, 172, 173,
-	174, 175, 178, 180, 181, 182, 183, 184, 185, 192,
-	195, 201, 202, 203, 204, 205, 206, 207, 209, 210,
-	211, 212, 218, 221, 227, 228, 237, 244, 247, 511,
-	499, 0, 456, 514, 429, 446, 522, 447, 450, 487,
-	414, 469, 170, 444, 0, 433, 409, 440, 410, 431,
-	458, 114, 462, 428, 501, 472, 513, 142, 434, 520,
-	144, 478, 0, 216, 158, 0, 0, 460, 503, 467,
-	496, 455, 488, 419, 477, 515, 445, 485, 516, 0,
-	0, 0, 82, 83, 84, 0, 0, 0, 0, 0,
-	0, 0, 0, 104, 0, 482, 510, 442, 484, 486,
-	408, 479, 0, 412, 415, 521, 506, 437, 438, 0,
-	0, 0, 0, 0, 0, 0, 459, 468, 493, 453,
-	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
-	476, 0, 0, 0, 416, 413, 0, 0, 457, 0,
-	0, 0, 418, 0, 436, 494, 0, 406, 123, 498,
-	505, 454, 272, 509, 452, 451, 512, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 502, 432, 441, 108, 439, 198, 177, 236, 475,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 395, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 404, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 411,
-	0, 217, 239, 254, 102, 427, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 405, 403, 398, 397,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 423,
-	426, 421, 422, 470, 471, 517, 518, 519, 495, 417,
-	0, 424, 425, 0, 500, 507, 508, 474, 85, 94,
-	143, 251, 191, 119, 240, 407, 420, 112, 430, 0,
-	0, 443, 448, 449, 461, 463, 464, 465, 466, 473,
-	480, 481, 483, 489, 490, 491, 492, 497, 504, 523,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 170, 0, 0, 897,
-	0, 329, 0, 0, 0, 114, 0, 326, 0, 0,
-	0, 142, 898, 369, 144, 0, 0, 216, 158, 0,
-	0, 0, 0, 360, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 348,
-	347, 350, 351, 352, 353, 0, 0, 104, 349, 354,
-	355, 356, 0, 0, 0, 324, 341, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 338, 339,
-	320, 0, 0, 0, 383, 0, 340, 0, 0, 335,
-	336, 337, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 382, 0, 0, 272, 0, 0, 380,
-	0, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 0, 0, 0, 108, 0,
-	198, 177, 236, 0, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 0, 0, 217, 239, 254, 102, 0,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 370, 381, 376, 377, 374, 375, 373,
-	372, 371, 384, 362, 363, 364, 365, 367, 0, 378,
-	379, 366, 85, 94, 143, 251, 191, 119, 240, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	170, 0, 0, 0, 0, 329, 0, 0, 0, 114,
-	0, 326, 0, 0, 0, 142, 0, 369, 144, 0,
-	0, 216, 158, 0, 0, 0, 0, 360, 361, 0,
-	0, 0, 0, 0, 0, 972, 0, 54, 0, 0,
-	82, 83, 84, 348, 347, 350, 351, 352, 353, 0,
-	0, 104, 349, 354, 355, 356, 973, 0, 0, 324,
-	341, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 338, 339, 0, 0, 0, 0, 383, 0,
-	340, 0, 0, 335, 336, 337, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 382, 0, 0,
-	272, 0, 0, 380, 0, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 0,
-	0, 0, 108, 0, 198, 177, 236, 0, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 0, 0, 217,
-	239, 254, 102, 0, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 370, 381, 376,
-	377, 374, 375, 373, 372, 371, 384, 362, 363, 364,
-	365, 367, 0, 378, 379, 366, 85, 94, 143, 251,
-	191, 119, 240, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 170, 0, 0, 0, 0, 329,
-	0, 0, 0, 114, 0, 326, 0, 0, 0, 142,
-	0, 369, 144, 0, 0, 216, 158, 0, 0, 0,
-	0, 360, 361, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 591, 82, 83, 84, 348, 347, 350,
-	351, 352, 353, 0, 0, 104, 349, 354, 355, 356,
-	0, 0, 0, 324, 341, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 338, 339, 0, 0,
-	0, 0, 383, 0, 340, 0, 0, 335, 336, 337,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 382, 0, 0, 272, 0, 0, 380, 0, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 0, 0, 0, 108, 0, 198, 177,
-	236, 0, 179, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 0, 0, 217, 239, 254, 102, 0, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 370, 381, 376, 377, 374, 375, 373, 372, 371,
-	384, 362, 363, 364, 365, 367, 0, 378, 379, 366,
-	85, 94, 143, 251, 191, 119, 240, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 170, 0,
-	0, 0, 0, 329, 0, 0, 0, 114, 0, 326,
-	0, 0, 0, 142, 0, 369, 144, 0, 0, 216,
-	158, 0, 0, 0, 0, 360, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 82, 83,
-	84, 348, 347, 350, 351, 352, 353, 0, 0, 104,
-	349, 354, 355, 356, 0, 0, 0, 324, 341, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	338, 339, 320, 0, 0, 0, 383, 0, 340, 0,
-	0, 335, 336, 337, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 382, 0, 0, 272, 0,
-	0, 380, 0, 189, 0, 220, 126, 141, 100, 138,
-	86, 96, 0, 125, 167, 196, 200, 0, 0, 0,
-	108, 0, 198, 177, 236, 0, 179, 197, 145, 226,
-	190, 235, 245, 246, 223, 243, 250, 213, 89, 222,
-	234, 105, 208, 91, 232, 219, 156, 135, 136, 90,
-	0, 194, 113, 121, 110, 169, 229, 230, 109, 253,
-	97, 242, 93, 98, 241, 163, 225, 233, 157, 150,
-	92, 231, 155, 149, 140, 117, 128, 187, 147, 188,
-	129, 160, 159, 161, 0, 0, 0, 217, 239, 254,
-	102, 0, 224, 248, 249, 0, 0, 103, 122, 116,
-	186, 120, 162, 99, 131, 214, 139, 146, 193, 252,
-	176, 199, 106, 238, 215, 370, 381, 376, 377, 374,
-	375, 373, 372, 371, 384, 362, 363, 364, 365, 367,
-	0, 378, 379, 366, 85, 94, 143, 251, 191, 119,
-	240, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 124, 127, 130, 132, 133, 134,
-	137, 148, 151, 152, 153, 154, 164, 165, 166, 168,
-	171, 172, 173, 174, 175, 178, 180, 181, 182, 183,
-	184, 185, 192, 195, 201, 202, 203, 204, 205, 206,
-	207, 209, 210, 211, 212, 218, 221, 227, 228, 237,
-	244, 247, 170, 0, 0, 0, 0, 329, 0, 0,
-	0, 114, 0, 326, 0, 0, 0, 142, 0, 369,
-	144, 0, 0, 216, 158, 0, 0, 0, 0, 360,
-	361, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 82, 83, 84, 348, 913, 350, 351, 352,
-	353, 0, 0, 104, 349, 354, 355, 356, 0, 0,
-	0, 324, 341, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 338, 339, 320, 0, 0, 0,
-	383, 0, 340, 0, 0, 335, 336, 337, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 382,
-	0, 0, 272, 0, 0, 380, 0, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 0, 0, 0, 108, 0, 198, 177, 236, 0,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 234, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 98, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 0,
-	0, 217, 239, 254, 102, 0, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 162, 99, 131, 214,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 370,
-	381, 376, 377, 374, 375, 373, 372, 371, 384, 362,
-	363, 364, 365, 367, 0, 378, 379, 366, 85, 94,
-	143, 251, 191, 119, 240, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 170, 0, 0, 0,
-	0, 329, 0, 0, 0, 114, 0, 326, 0, 0,
-	0, 142, 0, 369, 144, 0, 0, 216, 158, 0,
-	0, 0, 0, 360, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 348,
-	910, 350, 351, 352, 353, 0, 0, 104, 349, 354,
-	355, 356, 0, 0, 0, 324, 341, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 338, 339,
-	320, 0, 0, 0, 383, 0, 340, 0, 0, 335,
-	336, 337, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 382, 0, 0, 272, 0, 0, 380,
-	0, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 0, 0, 0, 108, 0,
-	198, 177, 236, 0, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 0, 0, 217, 239, 254, 102, 0,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 370, 381, 376, 377, 374, 375, 373,
-	372, 371, 384, 362, 363, 364, 365, 367, 0, 378,
-	379, 366, 85, 94, 143, 251, 191, 119, 240, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	24, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 0, 0, 0, 0, 329, 0, 0,
-	0, 114, 0, 326, 0, 0, 0, 142, 0, 369,
-	144, 0, 0, 216, 158, 0, 0, 0, 0, 360,
-	361, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 82, 83, 84, 348, 347, 350, 351, 352,
-	353, 0, 0, 104, 349, 354, 355, 356, 0, 0,
-	0, 324, 341, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 338, 339, 0, 0, 0, 0,
-	383, 0, 340, 0, 0, 335, 336, 337, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 382,
-	0, 0, 272, 0, 0, 380, 0, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 0, 0, 0, 108, 0, 198, 177, 236, 0,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 234, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 98, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 0,
-	0, 217, 239, 254, 102, 0, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 162, 99, 131, 214,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 370,
-	381, 376, 377, 374, 375, 373, 372, 371, 384, 362,
-	363, 364, 365, 367, 0, 378, 379, 366, 85, 94,
-	143, 251, 191, 119, 240, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 170, 0, 0, 0,
-	0, 329, 0, 0, 0, 114, 0, 326, 0, 0,
-	0, 142, 0, 369, 144, 0, 0, 216, 158, 0,
-	0, 0, 0, 360, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 348,
-	347, 350, 351, 352, 353, 0, 0, 104, 349, 354,
-	355, 356, 0, 0, 0, 324, 341, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 338, 339,
-	0, 0, 0, 0, 383, 0, 340, 0, 0, 335,
-	336, 337, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 382, 0, 0, 272, 0, 0, 380,
-	0, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 0, 0, 0, 108, 0,
-	198, 177, 236, 0, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 0, 0, 217, 239, 254, 102, 0,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 370, 381, 376, 377, 374, 375, 373,
-	372, 371, 384, 362, 363, 364, 365, 367, 0, 378,
-	379, 366, 85, 94, 143, 251, 191, 119, 240, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	170, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 142, 0, 369, 144, 0,
-	0, 216, 158, 0, 0, 0, 0, 360, 361, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	82, 83, 84, 348, 347, 350, 351, 352, 353, 0,
-	0, 104, 349, 354, 355, 356, 0, 0, 0, 0,
-	341, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 338, 339, 0, 0, 0, 0, 383, 0,
-	340, 0, 0, 335, 336, 337, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 382, 0, 0,
-	272, 0, 0, 380, 0, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 0,
-	0, 0, 108, 0, 198, 177, 236, 1540, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 0, 0, 217,
-	239, 254, 102, 0, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 370, 381, 376,
-	377, 374, 375, 373, 372, 371, 384, 362, 363, 364,
-	365, 367, 0, 378, 379, 366, 85, 94, 143, 251,
-	191, 119, 240, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 170, 0, 0, 0, 0, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 142,
-	0, 369, 144, 0, 0, 216, 158, 0, 0, 0,
-	0, 360, 361, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 591, 82, 83, 84, 348, 347, 350,
-	351, 352, 353, 0, 0, 104, 349, 354, 355, 356,
-	0, 0, 0, 0, 341, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 338, 339, 0, 0,
-	0, 0, 383, 0, 340, 0, 0, 335, 336, 337,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 382, 0, 0, 272, 0, 0, 380, 0, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 0, 0, 0, 108, 0, 198, 177,
-	236, 0, 179, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 0, 0, 217, 239, 254, 102, 0, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 370, 381, 376, 377, 374, 375, 373, 372, 371,
-	384, 362, 363, 364, 365, 367, 0, 378, 379, 366,
-	85, 94, 143, 251, 191, 119, 240, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 170, 0,
-	0, 0, 0, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 142, 0, 369, 144, 0, 0, 216,
-	158, 0, 0, 0, 0, 360, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 82, 83,
-	84, 348, 347, 350, 351, 352, 353, 0, 0, 104,
-	349, 354, 355, 356, 0, 0, 0, 0, 341, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	338, 339, 0, 0, 0, 0, 383, 0, 340, 0,
-	0, 335, 336, 337, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 382, 0, 0, 272, 0,
-	0, 380, 0, 189, 0, 220, 126, 141, 100, 138,
-	86, 96, 0, 125, 167, 196, 200, 0, 0, 0,
-	108, 0, 198, 177, 236, 0, 179, 197, 145, 226,
-	190, 235, 245, 246, 223, 243, 250, 213, 89, 222,
-	234, 105, 208, 91, 232, 219, 156, 135, 136, 90,
-	0, 194, 113, 121, 110, 169, 229, 230, 109, 253,
-	97, 242, 93, 98, 241, 163, 225, 233, 157, 150,
-	92, 231, 155, 149, 140, 117, 128, 187, 147, 188,
-	129, 160, 159, 161, 0, 0, 0, 217, 239, 254,
-	102, 0, 224, 248, 249, 0, 0, 103, 122, 116,
-	186, 120, 162, 99, 131, 214, 139, 146, 193, 252,
-	176, 199, 106, 238, 215, 370, 381, 376, 377, 374,
-	375, 373, 372, 371, 384, 362, 363, 364, 365, 367,
-	0, 378, 379, 366, 85, 94, 143, 251, 191, 119,
-	240, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 124, 127, 130, 132, 133, 134,
-	137, 148, 151, 152, 153, 154, 164, 165, 166, 168,
-	171, 172, 173, 174, 175, 178, 180, 181, 182, 183,
-	184, 185, 192, 195, 201, 202, 203, 204, 205, 206,
-	207, 209, 210, 211, 212, 218, 221, 227, 228, 237,
-	244, 247, 170, 0, 0, 0, 0, 0, 0, 0,
-	0, 114, 0, 0, 0, 0, 0, 142, 0, 0,
-	144, 0, 0, 216, 158, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 0, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	626, 625, 635, 636, 628, 629, 630, 631, 632, 633,
-	634, 627, 0, 0, 637, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 272, 0, 0, 0, 0, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 0, 0, 0, 108, 0, 198, 177, 236, 0,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 234, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 98, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 0,
-	0, 217, 239, 254, 102, 0, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 162, 99, 131, 214,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 94,
-	143, 251, 191, 119, 240, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 170, 0, 0, 0,
-	614, 0, 0, 0, 0, 114, 0, 0, 0, 0,
-	0, 142, 0, 0, 144, 0, 0, 216, 158, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 83, 84, 0,
-	616, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 611, 610, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	612, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 272, 0, 0, 0,
-	0, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 0, 0, 0, 108, 0,
-	198, 177, 236, 0, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 0, 0, 217, 239, 254, 102, 0,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 94, 143, 251, 191, 119, 240, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	170, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 142, 0, 0, 144, 0,
-	0, 216, 158, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 0, 0, 0, 0, 74, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 76, 77, 0,
-	73, 0, 0, 0, 78, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 0,
-	0, 0, 108, 0, 198, 177, 236, 0, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 0, 0, 217,
-	239, 254, 102, 0, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 0, 75, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 143, 251,
-	191, 119, 240, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 170, 0, 0, 0, 955, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 142,
-	0, 0, 144, 0, 0, 216, 158, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 84, 0, 957, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 272, 0, 0, 0, 0, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 0, 0, 0, 108, 0, 198, 177,
-	236, 0, 179, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 0, 0, 217, 239, 254, 102, 0, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 143, 251, 191, 119, 240, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 24, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 142, 0, 0, 144, 0,
-	0, 216, 158, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 0, 0,
-	272, 0, 0, 0, 0, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 0,
-	0, 0, 108, 0, 198, 177, 236, 0, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 0, 0, 217,
-	239, 254, 102, 0, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 143, 251,
-	191, 119, 240, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 170, 0, 0, 0, 955, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 142,
-	0, 0, 144, 0, 0, 216, 158, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 84, 0, 957, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 272, 0, 0, 0, 0, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 0, 0, 0, 108, 0, 198, 177,
-	236, 0, 953, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 0, 0, 217, 239, 254, 102, 0, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 143, 251, 191, 119, 240, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 170, 0,
-	0, 0, 0, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 142, 0, 0, 144, 0, 0, 216,
-	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	84, 0, 0, 848, 0, 0, 849, 0, 0, 104,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 0, 0, 272, 0,
-	0, 0, 0, 189, 0, 220, 126, 141, 100, 138,
-	86, 96, 0, 125, 167, 196, 200, 0, 0, 0,
-	108, 0, 198, 177, 236, 0, 179, 197, 145, 226,
-	190, 235, 245, 246, 223, 243, 250, 213, 89, 222,
-	234, 105, 208, 91, 232, 219, 156, 135, 136, 90,
-	0, 194, 113, 121, 110, 169, 229, 230, 109, 253,
-	97, 242, 93, 98, 241, 163, 225, 233, 157, 150,
-	92, 231, 155, 149, 140, 117, 128, 187, 147, 188,
-	129, 160, 159, 161, 0, 0, 0, 217, 239, 254,
-	102, 0, 224, 248, 249, 0, 0, 103, 122, 116,
-	186, 120, 162, 99, 131, 214, 139, 146, 193, 252,
-	176, 199, 106, 238, 215, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 94, 143, 251, 191, 119,
-	240, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 124, 127, 130, 132, 133, 134,
-	137, 148, 151, 152, 153, 154, 164, 165, 166, 168,
-	171, 172, 173, 174, 175, 178, 180, 181, 182, 183,
-	184, 185, 192, 195, 201, 202, 203, 204, 205, 206,
-	207, 209, 210, 211, 212, 218, 221, 227, 228, 237,
-	244, 247, 170, 0, 0, 0, 0, 0, 0, 0,
-	0, 114, 0, 729, 0, 0, 0, 142, 0, 0,
-	144, 0, 0, 216, 158, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 728, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 272, 0, 0, 0, 0, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 0, 0, 0, 108, 0, 198, 177, 236, 0,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 234, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 98, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 0,
-	0, 217, 239, 254, 102, 0, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 162, 99, 131, 214,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 94,
-	143, 251, 191, 119, 240, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 170, 0, 0, 0,
-	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
-	0, 142, 0, 0, 144, 0, 0, 216, 158, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 591, 82, 83, 84, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 272, 0, 0, 0,
-	0, 189, 0, 220, 126, 141, 100, 138, 86, 96,
-	0, 125, 167, 196, 200, 0, 0, 0, 108, 0,
-	198, 177, 236, 0, 179, 197, 145, 226, 190, 235,
-	245, 246, 223, 243, 250, 213, 89, 222, 234, 105,
-	208, 91, 232, 219, 156, 135, 136, 90, 0, 194,
-	113, 121, 110, 169, 229, 230, 109, 253, 97, 242,
-	93, 98, 241, 163, 225, 233, 157, 150, 92, 231,
-	155, 149, 140, 117, 128, 187, 147, 188, 129, 160,
-	159, 161, 0, 0, 0, 217, 239, 254, 102, 0,
-	224, 248, 249, 0, 0, 103, 122, 116, 186, 120,
-	162, 99, 131, 214, 139, 146, 193, 252, 176, 199,
-	106, 238, 215, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 94, 143, 251, 191, 119, 240, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 124, 127, 130, 132, 133, 134, 137, 148,
-	151, 152, 153, 154, 164, 165, 166, 168, 171, 172,
-	173, 174, 175, 178, 180, 181, 182, 183, 184, 185,
-	192, 195, 201, 202, 203, 204, 205, 206, 207, 209,
-	210, 211, 212, 218, 221, 227, 228, 237, 244, 247,
-	170, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 142, 0, 0, 144, 0,
-	0, 216, 158, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 0, 0,
-	272, 0, 0, 0, 0, 189, 0, 220, 126, 141,
-	100, 138, 86, 96, 0, 125, 167, 196, 200, 0,
-	0, 0, 108, 0, 198, 177, 236, 0, 179, 197,
-	145, 226, 190, 235, 245, 246, 223, 243, 250, 213,
-	89, 222, 234, 105, 208, 91, 232, 219, 156, 135,
-	136, 90, 0, 194, 113, 121, 110, 169, 229, 230,
-	109, 253, 97, 242, 93, 98, 241, 163, 225, 233,
-	157, 150, 92, 231, 155, 149, 140, 117, 128, 187,
-	147, 188, 129, 160, 159, 161, 0, 0, 0, 217,
-	239, 254, 102, 0, 224, 248, 249, 0, 0, 103,
-	122, 116, 186, 120, 162, 99, 131, 214, 139, 146,
-	193, 252, 176, 199, 106, 238, 215, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 143, 251,
-	191, 119, 240, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 124, 127, 130, 132,
-	133, 134, 137, 148, 151, 152, 153, 154, 164, 165,
-	166, 168, 171, 172, 173, 174, 175, 178, 180, 181,
-	182, 183, 184, 185, 192, 195, 201, 202, 203, 204,
-	205, 206, 207, 209, 210, 211, 212, 218, 221, 227,
-	228, 237, 244, 247, 170, 0, 0, 0, 0, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 142,
-	0, 0, 144, 0, 0, 216, 158, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 84, 0, 957, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 272, 0, 0, 0, 0, 189,
-	0, 220, 126, 141, 100, 138, 86, 96, 0, 125,
-	167, 196, 200, 0, 0, 0, 108, 0, 198, 177,
-	236, 0, 179, 197, 145, 226, 190, 235, 245, 246,
-	223, 243, 250, 213, 89, 222, 234, 105, 208, 91,
-	232, 219, 156, 135, 136, 90, 0, 194, 113, 121,
-	110, 169, 229, 230, 109, 253, 97, 242, 93, 98,
-	241, 163, 225, 233, 157, 150, 92, 231, 155, 149,
-	140, 117, 128, 187, 147, 188, 129, 160, 159, 161,
-	0, 0, 0, 217, 239, 254, 102, 0, 224, 248,
-	249, 0, 0, 103, 122, 116, 186, 120, 162, 99,
-	131, 214, 139, 146, 193, 252, 176, 199, 106, 238,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 143, 251, 191, 119, 240, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	124, 127, 130, 132, 133, 134, 137, 148, 151, 152,
-	153, 154, 164, 165, 166, 168, 171, 172, 173, 174,
-	175, 178, 180, 181, 182, 183, 184, 185, 192, 195,
-	201, 202, 203, 204, 205, 206, 207, 209, 210, 211,
-	212, 218, 221, 227, 228, 237, 244, 247, 170, 0,
-	0, 0, 0, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 142, 0, 0, 144, 0, 0, 216,
-	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	84, 0, 616, 0, 0, 0, 0, 0, 0, 104,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 0, 0, 272, 0,
-	0, 0, 0, 189, 0, 220, 126, 141, 100, 138,
-	86, 96, 0, 125, 167, 196, 200, 0, 0, 0,
-	108, 0, 198, 177, 236, 0, 179, 197, 145, 226,
-	190, 235, 245, 246, 223, 243, 250, 213, 89, 222,
-	234, 105, 208, 91, 232, 219, 156, 135, 136, 90,
-	0, 194, 113, 121, 110, 169, 229, 230, 109, 253,
-	97, 242, 93, 98, 241, 163, 225, 233, 157, 150,
-	92, 231, 155, 149, 140, 117, 128, 187, 147, 188,
-	129, 160, 159, 161, 0, 0, 0, 217, 239, 254,
-	102, 0, 224, 248, 249, 0, 0, 103, 122, 116,
-	186, 120, 162, 99, 131, 214, 139, 146, 193, 252,
-	176, 199, 106, 238, 215, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 94, 143, 251, 191, 119,
-	240, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 124, 127, 130, 132, 133, 134,
-	137, 148, 151, 152, 153, 154, 164, 165, 166, 168,
-	171, 172, 173, 174, 175, 178, 180, 181, 182, 183,
-	184, 185, 192, 195, 201, 202, 203, 204, 205, 206,
-	207, 209, 210, 211, 212, 218, 221, 227, 228, 237,
-	244, 247, 170, 0, 0, 0, 0, 0, 0, 0,
-	699, 114, 0, 0, 0, 0, 0, 142, 0, 0,
-	144, 0, 0, 216, 158, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 0, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 272, 0, 0, 0, 0, 189, 0, 220,
-	126, 141, 100, 138, 86, 96, 0, 125, 167, 196,
-	200, 0, 0, 0, 108, 0, 198, 177, 236, 0,
-	179, 197, 145, 226, 190, 235, 245, 246, 223, 243,
-	250, 213, 89, 222, 234, 105, 208, 91, 232, 219,
-	156, 135, 136, 90, 0, 194, 113, 121, 110, 169,
-	229, 230, 109, 253, 97, 242, 93, 98, 241, 163,
-	225, 233, 157, 150, 92, 231, 155, 149, 140, 117,
-	128, 187, 147, 188, 129, 160, 159, 161, 0, 0,
-	0, 217, 239, 254, 102, 0, 224, 248, 249, 0,
-	0, 103, 122, 116, 186, 120, 162, 99, 131, 214,
-	139, 146, 193, 252, 176, 199, 106, 238, 215, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 94,
-	143, 251, 191, 119, 240, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 124, 127,
-	130, 132, 133, 134, 137, 148, 151, 152, 153, 154,
-	164, 165, 166, 168, 171, 172, 173, 174, 175, 178,
-	180, 181, 182, 183, 184, 185, 192, 195, 201, 202,
-	203, 204, 205, 206, 207, 209, 210, 211, 212, 218,
-	221, 227, 228, 237, 244, 247, 387, 0, 0, 0,
-	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
-	0, 0, 114, 0, 0, 0, 0, 0, 142, 0,
-	0, 144, 0, 0, 216, 158, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 0, 0, 272, 0, 0, 0, 0, 189, 0,
-	220, 126, 141, 100, 138, 86, 96, 0, 125, 167,
-	196, 200, 0, 0, 0, 108, 0, 198, 177, 236,
-	0, 179, 197, 145, 226, 190, 235, 245, 246, 223,
-	243, 250, 213, 89, 222, 234, 105, 208, 91, 232,
-	219, 156, 135, 136, 90, 0, 194, 113, 121, 110,
-	169, 229, 230, 109, 253, 97, 242, 93, 98, 241,
-	163, 225, 233, 157, 150, 92, 231, 155, 149, 140,
-	117, 128, 187, 147, 188, 129, 160, 159, 161, 0,
-	0, 0, 217, 239, 254, 102, 0, 224, 248, 249,
-	0, 0, 103, 122, 116, 186, 120, 162, 99, 131,
-	214, 139, 146, 193, 252, 176, 199, 106, 238, 215,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	94, 143, 251, 191, 119, 240, 0, 0, 112, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 88, 95, 101, 107, 111, 115, 118, 124,
-	127, 130, 132, 133, 134, 137, 148, 151, 152, 153,
-	154, 164, 165, 166, 168, 171, 172, 173, 174, 175,
-	178, 180, 181, 182, 183, 184, 185, 192, 195, 201,
-	202, 203, 204, 205, 206, 207, 209, 210, 211, 212,
-	218, 221, 227, 228, 237, 244, 247, 170, 0, 0,
-	0, 0, 0, 0, 0, 0, 114, 0, 0, 0,
-	0, 0, 142, 0, 0, 144, 0, 0, 216, 158,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 104, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 267, 0, 272, 0, 0,
-	0, 0, 189, 0, 220, 126, 141, 100, 138, 86,
-	96, 0, 125, 167, 196, 200, 0, 0, 0, 108,
-	0, 198, 177, 236, 0, 179, 197, 145, 226, 190,
-	235, 245, 246, 223, 243, 250, 213, 89, 222, 234,
-	105, 208, 91, 232, 219, 156, 135, 136, 90, 0,
-	194, 113, 121, 110, 169, 229, 230, 109, 253, 97,
-	242, 93, 98, 241, 163, 225, 233, 157, 150, 92,
-	231, 155, 149, 140, 117, 128, 187, 147, 188, 129,
-	160, 159, 161, 0, 0, 0, 217, 239, 254, 102,
-	0, 224, 248, 249, 0, 0, 103, 122, 116, 186,
-	120, 162, 99, 131, 214, 139, 146, 193, 252, 176,
-	199, 106, 238, 215, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 85, 94, 143, 251, 191, 119, 240,
-	0, 0, 112, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 87, 88, 95, 101, 107,
-	111, 115, 118, 124, 127, 130, 132, 133, 134, 137,
-	148, 151, 152, 153, 154, 164, 165, 166, 168, 171,
-	172, 173, 174, 175, 178, 180, 181, 182, 183, 184,
-	185, 192, 195, 201, 202, 203, 204, 205, 206, 207,
-	209, 210, 211, 212, 218, 221, 227, 228, 237, 244,
-	247, 170, 0, 0, 0, 0, 0, 0, 0, 0,
-	114, 0, 0, 0, 0, 0, 142, 0, 0, 144,
-	0, 0, 216, 158, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
-	0, 272, 0, 0, 0, 0, 189, 0, 220, 126,
-	141, 100, 138, 86, 96, 0, 125, 167, 196, 200,
-	0, 0, 0, 108, 0, 198, 177, 236, 0, 179,
-	197, 145, 226, 190, 235, 245, 246, 223, 243, 250,
-	213, 89, 222, 234, 105, 208, 91, 232, 219, 156,
-	135, 136, 90, 0, 194, 113, 121, 110, 169, 229,
-	230, 109, 253, 97, 242, 93, 98, 241, 163, 225,
-	233, 157, 150, 92, 231, 155, 149, 140, 117, 128,
-	187, 147, 188, 129, 160, 159, 161, 0, 0, 0,
-	217, 239, 254, 102, 0, 224, 248, 249, 0, 0,
-	103, 122, 116, 186, 120, 162, 99, 131, 214, 139,
-	146, 193, 252, 176, 199, 106, 238, 215, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 85, 94, 143,
-	251, 191, 119, 240, 0, 0, 112, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
-	88, 95, 101, 107, 111, 115, 118, 124, 127, 130,
-	132, 133, 134, 137, 148, 151, 152, 153, 154, 164,
-	165, 166, 168, 171, 172, 173, 174, 175, 178, 180,
-	181, 182, 183, 184, 185, 192, 195, 201, 202, 203,
-	204, 205, 206, 207, 209, 210, 211, 212, 218, 221,
-	227, 228, 237, 244, 247,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 277, 0, 0, 0, 941, 0,
+	0, 277, 0, 0, 277, 0, 0, 277, 0, 0,
+	0, 827, 0, 0, 0, 0, 0, 0, 0, 417,
+	0, 277, 0, 0, 0, 0, 0, 1419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 417, 0, 0, 0, 0, 0, 0, 417,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 0, 0, 827, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1455, 1456, 0, 1457, 0, 0, 0, 0, 1419, 0,
+	1419, 1419, 1419, 0, 0, 0, 1333, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 1419, 338, 338, 0, 0, 338, 338,
+	338, 0, 0, 0, 942, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1255, 0, 0, 0,
+	0, 0, 0, 338, 338, 338, 338, 338, 0, 277,
+	0, 0, 1517, 0, 0, 0, 0, 277, 976, 0,
+	0, 277, 277, 417, 417, 277, 984, 827, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 941, 0, 0, 1538, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1544, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1419,
+	0, 0, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 277, 277, 277, 277, 0,
+	277, 277, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 1352, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 0, 0, 277, 0, 1081, 1082,
+	0, 1355, 0, 277, 0, 0, 0, 0, 0, 0,
+	827, 0, 0, 1365, 0, 0, 0, 0, 0, 0,
+	0, 0, 338, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 338,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 338, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 942, 277, 277,
+	277, 277, 277, 0, 0, 0, 0, 0, 0, 0,
+	1166, 0, 0, 277, 0, 0, 0, 976, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1486, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 338, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 338, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1540, 0, 0, 0, 0, 0, 0, 827, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 942, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	942, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 976, 0, 0, 0, 0,
+	0, 527, 515, 0, 472, 530, 445, 462, 538, 463,
+	466, 503, 430, 485, 176, 460, 277, 449, 425, 456,
+	426, 447, 474, 120, 478, 444, 517, 488, 529, 148,
+	450, 536, 150, 494, 0, 222, 164, 0, 0, 476,
+	519, 483, 512, 471, 504, 435, 493, 531, 461, 501,
+	532, 0, 0, 0, 88, 89, 90, 0, 1001, 1002,
+	0, 0, 0, 0, 0, 110, 0, 498, 526, 458,
+	500, 502, 424, 495, 0, 428, 431, 537, 522, 453,
+	454, 1189, 0, 0, 942, 0, 0, 0, 475, 484,
+	509, 469, 0, 0, 0, 0, 0, 0, 277, 0,
+	451, 0, 492, 0, 0, 0, 432, 429, 0, 0,
+	473, 0, 0, 0, 434, 0, 452, 510, 0, 422,
+	129, 514, 521, 470, 280, 525, 468, 467, 528, 195,
+	0, 226, 132, 147, 106, 144, 92, 102, 0, 131,
+	173, 202, 206, 518, 448, 457, 251, 114, 455, 204,
+	183, 242, 491, 185, 203, 151, 232, 196, 241, 252,
+	253, 229, 249, 257, 219, 95, 228, 240, 111, 214,
+	0, 0, 259, 97, 238, 225, 162, 141, 142, 96,
+	0, 200, 119, 127, 116, 175, 235, 236, 115, 261,
+	103, 248, 99, 104, 247, 169, 231, 239, 163, 156,
+	98, 237, 161, 155, 146, 123, 134, 193, 153, 194,
+	135, 166, 165, 167, 0, 427, 0, 223, 245, 262,
+	108, 443, 230, 255, 256, 0, 0, 109, 128, 122,
+	192, 126, 168, 105, 137, 220, 145, 152, 199, 260,
+	182, 205, 112, 244, 221, 439, 442, 437, 438, 486,
+	487, 533, 534, 535, 511, 433, 0, 440, 441, 0,
+	516, 523, 524, 490, 91, 100, 149, 258, 197, 125,
+	246, 423, 436, 118, 446, 0, 0, 459, 464, 465,
+	477, 479, 480, 481, 482, 489, 496, 497, 499, 505,
+	506, 507, 508, 513, 520, 539, 93, 94, 101, 107,
+	113, 117, 121, 124, 130, 133, 136, 138, 139, 140,
+	143, 154, 157, 158, 159, 160, 170, 171, 172, 174,
+	177, 178, 179, 180, 181, 184, 186, 187, 188, 189,
+	190, 191, 198, 201, 207, 208, 209, 210, 211, 212,
+	213, 215, 216, 217, 218, 224, 227, 233, 234, 243,
+	250, 254, 527, 515, 0, 472, 530, 445, 462, 538,
+	463, 466, 503, 430, 485, 176, 460, 0, 449, 425,
+	456, 426, 447, 474, 120, 478, 444, 517, 488, 529,
+	148, 450, 536, 150, 494, 0, 222, 164, 0, 0,
+	476, 519, 483, 512, 471, 504, 435, 493, 531, 461,
+	501, 532, 0, 0, 0, 88, 89, 90, 0, 1001,
+	1002, 0, 0, 0, 0, 0, 110, 0, 498, 526,
+	458, 500, 502, 424, 495, 0, 428, 431, 537, 522,
+	453, 454, 0, 0, 0, 0, 0, 0, 0, 475,
+	484, 509, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 451, 0, 492, 0, 0, 0, 432, 429, 0,
+	0, 473, 0, 0, 0, 434, 0, 452, 510, 0,
+	422, 129, 514, 521, 470, 280, 525, 468, 467, 528,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 518, 448, 457, 251, 114, 455,
+	204, 183, 242, 491, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 427, 0, 223, 245,
+	262, 108, 443, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 439, 442, 437, 438,
+	486, 487, 533, 534, 535, 511, 433, 0, 440, 441,
+	0, 516, 523, 524, 490, 91, 100, 149, 258, 197,
+	125, 246, 423, 436, 118, 446, 0, 0, 459, 464,
+	465, 477, 479, 480, 481, 482, 489, 496, 497, 499,
+	505, 506, 507, 508, 513, 520, 539, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 527, 515, 0, 472, 530, 445, 462,
+	538, 463, 466, 503, 430, 485, 176, 460, 0, 449,
+	425, 456, 426, 447, 474, 120, 478, 444, 517, 488,
+	529, 148, 450, 536, 150, 494, 0, 222, 164, 0,
+	0, 476, 519, 483, 512, 471, 504, 435, 493, 531,
+	461, 501, 532, 60, 0, 0, 88, 89, 90, 0,
+	0, 0, 0, 0, 0, 0, 0, 110, 0, 498,
+	526, 458, 500, 502, 424, 495, 0, 428, 431, 537,
+	522, 453, 454, 0, 0, 0, 0, 0, 0, 0,
+	475, 484, 509, 469, 0, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 492, 0, 0, 0, 432, 429,
+	0, 0, 473, 0, 0, 0, 434, 0, 452, 510,
+	0, 422, 129, 514, 521, 470, 280, 525, 468, 467,
+	528, 195, 0, 226, 132, 147, 106, 144, 92, 102,
+	0, 131, 173, 202, 206, 518, 448, 457, 251, 114,
+	455, 204, 183, 242, 491, 185, 203, 151, 232, 196,
+	241, 252, 253, 229, 249, 257, 219, 95, 228, 240,
+	111, 214, 0, 0, 259, 97, 238, 225, 162, 141,
+	142, 96, 0, 200, 119, 127, 116, 175, 235, 236,
+	115, 261, 103, 248, 99, 104, 247, 169, 231, 239,
+	163, 156, 98, 237, 161, 155, 146, 123, 134, 193,
+	153, 194, 135, 166, 165, 167, 0, 427, 0, 223,
+	245, 262, 108, 443, 230, 255, 256, 0, 0, 109,
+	128, 122, 192, 126, 168, 105, 137, 220, 145, 152,
+	199, 260, 182, 205, 112, 244, 221, 439, 442, 437,
+	438, 486, 487, 533, 534, 535, 511, 433, 0, 440,
+	441, 0, 516, 523, 524, 490, 91, 100, 149, 258,
+	197, 125, 246, 423, 436, 118, 446, 0, 0, 459,
+	464, 465, 477, 479, 480, 481, 482, 489, 496, 497,
+	499, 505, 506, 507, 508, 513, 520, 539, 93, 94,
+	101, 107, 113, 117, 121, 124, 130, 133, 136, 138,
+	139, 140, 143, 154, 157, 158, 159, 160, 170, 171,
+	172, 174, 177, 178, 179, 180, 181, 184, 186, 187,
+	188, 189, 190, 191, 198, 201, 207, 208, 209, 210,
+	211, 212, 213, 215, 216, 217, 218, 224, 227, 233,
+	234, 243, 250, 254, 527, 515, 0, 472, 530, 445,
+	462, 538, 463, 466, 503, 430, 485, 176, 460, 0,
+	449, 425, 456, 426, 447, 474, 120, 478, 444, 517,
+	488, 529, 148, 450, 536, 150, 494, 0, 222, 164,
+	0, 0, 476, 519, 483, 512, 471, 504, 435, 493,
+	531, 461, 501, 532, 0, 0, 0, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 0, 110, 0,
+	498, 526, 458, 500, 502, 424, 495, 0, 428, 431,
+	537, 522, 453, 454, 0, 0, 0, 0, 0, 0,
+	0, 475, 484, 509, 469, 0, 0, 0, 0, 0,
+	0, 1258, 0, 451, 0, 492, 0, 0, 0, 432,
+	429, 0, 0, 473, 0, 0, 0, 434, 0, 452,
+	510, 0, 422, 129, 514, 521, 470, 280, 525, 468,
+	467, 528, 195, 0, 226, 132, 147, 106, 144, 92,
+	102, 0, 131, 173, 202, 206, 518, 448, 457, 251,
+	114, 455, 204, 183, 242, 491, 185, 203, 151, 232,
+	196, 241, 252, 253, 229, 249, 257, 219, 95, 228,
+	240, 111, 214, 0, 0, 259, 97, 238, 225, 162,
+	141, 142, 96, 0, 200, 119, 127, 116, 175, 235,
+	236, 115, 261, 103, 248, 99, 104, 247, 169, 231,
+	239, 163, 156, 98, 237, 161, 155, 146, 123, 134,
+	193, 153, 194, 135, 166, 165, 167, 0, 427, 0,
+	223, 245, 262, 108, 443, 230, 255, 256, 0, 0,
+	109, 128, 122, 192, 126, 168, 105, 137, 220, 145,
+	152, 199, 260, 182, 205, 112, 244, 221, 439, 442,
+	437, 438, 486, 487, 533, 534, 535, 511, 433, 0,
+	440, 441, 0, 516, 523, 524, 490, 91, 100, 149,
+	258, 197, 125, 246, 423, 436, 118, 446, 0, 0,
+	459, 464, 465, 477, 479, 480, 481, 482, 489, 496,
+	497, 499, 505, 506, 507, 508, 513, 520, 539, 93,
+	94, 101, 107, 113, 117, 121, 124, 130, 133, 136,
+	138, 139, 140, 143, 154, 157, 158, 159, 160, 170,
+	171, 172, 174, 177, 178, 179, 180, 181, 184, 186,
+	187, 188, 189, 190, 191, 198, 201, 207, 208, 209,
+	210, 211, 212, 213, 215, 216, 217, 218, 224, 227,
+	233, 234, 243, 250, 254, 527, 515, 0, 472, 530,
+	445, 462, 538, 463, 466, 503, 430, 485, 176, 460,
+	0, 449, 425, 456, 426, 447, 474, 120, 478, 444,
+	517, 488, 529, 148, 450, 536, 150, 494, 0, 222,
+	164, 0, 0, 476, 519, 483, 512, 471, 504, 435,
+	493, 531, 461, 501, 532, 0, 0, 0, 88, 89,
+	90, 0, 0, 0, 0, 0, 0, 0, 0, 110,
+	0, 498, 526, 458, 500, 502, 424, 495, 0, 428,
+	431, 537, 522, 453, 454, 0, 0, 0, 0, 0,
+	0, 0, 475, 484, 509, 469, 0, 0, 0, 0,
+	0, 0, 985, 0, 451, 0, 492, 0, 0, 0,
+	432, 429, 0, 0, 473, 0, 0, 0, 434, 0,
+	452, 510, 0, 422, 129, 514, 521, 470, 280, 525,
+	468, 467, 528, 195, 0, 226, 132, 147, 106, 144,
+	92, 102, 0, 131, 173, 202, 206, 518, 448, 457,
+	251, 114, 455, 204, 183, 242, 491, 185, 203, 151,
+	232, 196, 241, 252, 253, 229, 249, 257, 219, 95,
+	228, 240, 111, 214, 0, 0, 259, 97, 238, 225,
+	162, 141, 142, 96, 0, 200, 119, 127, 116, 175,
+	235, 236, 115, 261, 103, 248, 99, 104, 247, 169,
+	231, 239, 163, 156, 98, 237, 161, 155, 146, 123,
+	134, 193, 153, 194, 135, 166, 165, 167, 0, 427,
+	0, 223, 245, 262, 108, 443, 230, 255, 256, 0,
+	0, 109, 128, 122, 192, 126, 168, 105, 137, 220,
+	145, 152, 199, 260, 182, 205, 112, 244, 221, 439,
+	442, 437, 438, 486, 487, 533, 534, 535, 511, 433,
+	0, 440, 441, 0, 516, 523, 524, 490, 91, 100,
+	149, 258, 197, 125, 246, 423, 436, 118, 446, 0,
+	0, 459, 464, 465, 477, 479, 480, 481, 482, 489,
+	496, 497, 499, 505, 506, 507, 508, 513, 520, 539,
+	93, 94, 101, 107, 113, 117, 121, 124, 130, 133,
+	136, 138, 139, 140, 143, 154, 157, 158, 159, 160,
+	170, 171, 172, 174, 177, 178, 179, 180, 181, 184,
+	186, 187, 188, 189, 190, 191, 198, 201, 207, 208,
+	209, 210, 211, 212, 213, 215, 216, 217, 218, 224,
+	227, 233, 234, 243, 250, 254, 527, 515, 0, 472,
+	530, 445, 462, 538, 463, 466, 503, 430, 485, 176,
+	460, 0, 449, 425, 456, 426, 447, 474, 120, 478,
+	444, 517, 488, 529, 148, 450, 536, 150, 494, 0,
+	222, 164, 0, 0, 476, 519, 483, 512, 471, 504,
+	435, 493, 531, 461, 501, 532, 0, 0, 0, 88,
+	89, 90, 0, 0, 0, 0, 0, 0, 0, 0,
+	110, 0, 498, 526, 458, 500, 502, 424, 495, 0,
+	428, 431, 537, 522, 453, 454, 0, 0, 0, 0,
+	0, 0, 0, 475, 484, 509, 469, 0, 0, 0,
+	0, 0, 0, 881, 0, 451, 0, 492, 0, 0,
+	0, 432, 429, 0, 0, 473, 0, 0, 0, 434,
+	0, 452, 510, 0, 422, 129, 514, 521, 470, 280,
+	525, 468, 467, 528, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 518, 448,
+	457, 251, 114, 455, 204, 183, 242, 491, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	427, 0, 223, 245, 262, 108, 443, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	439, 442, 437, 438, 486, 487, 533, 534, 535, 511,
+	433, 0, 440, 441, 0, 516, 523, 524, 490, 91,
+	100, 149, 258, 197, 125, 246, 423, 436, 118, 446,
+	0, 0, 459, 464, 465, 477, 479, 480, 481, 482,
+	489, 496, 497, 499, 505, 506, 507, 508, 513, 520,
+	539, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 527, 515, 0,
+	472, 530, 445, 462, 538, 463, 466, 503, 430, 485,
+	176, 460, 0, 449, 425, 456, 426, 447, 474, 120,
+	478, 444, 517, 488, 529, 148, 450, 536, 150, 494,
+	0, 222, 164, 0, 0, 476, 519, 483, 512, 471,
+	504, 435, 493, 531, 461, 501, 532, 0, 0, 0,
+	88, 89, 90, 0, 0, 0, 0, 0, 0, 0,
+	0, 110, 0, 498, 526, 458, 500, 502, 424, 495,
+	0, 428, 431, 537, 522, 453, 454, 0, 0, 0,
+	0, 0, 0, 0, 475, 484, 509, 469, 0, 0,
+	0, 0, 0, 0, 0, 0, 451, 0, 492, 0,
+	0, 0, 432, 429, 0, 0, 473, 0, 0, 0,
+	434, 0, 452, 510, 0, 422, 129, 514, 521, 470,
+	280, 525, 468, 467, 528, 195, 0, 226, 132, 147,
+	106, 144, 92, 102, 0, 131, 173, 202, 206, 518,
+	448, 457, 251, 114, 455, 204, 183, 242, 491, 185,
+	203, 151, 232, 196, 241, 252, 253, 229, 249, 257,
+	219, 95, 228, 240, 111, 214, 0, 0, 259, 97,
+	238, 225, 162, 141, 142, 96, 0, 200, 119, 127,
+	116, 175, 235, 236, 115, 261, 103, 248, 99, 104,
+	247, 169, 231, 239, 163, 156, 98, 237, 161, 155,
+	146, 123, 134, 193, 153, 194, 135, 166, 165, 167,
+	0, 427, 0, 223, 245, 262, 108, 443, 230, 255,
+	256, 0, 0, 109, 128, 122, 192, 126, 168, 105,
+	137, 220, 145, 152, 199, 260, 182, 205, 112, 244,
+	221, 439, 442, 437, 438, 486, 487, 533, 534, 535,
+	511, 433, 0, 440, 441, 0, 516, 523, 524, 490,
+	91, 100, 149, 258, 197, 125, 246, 423, 436, 118,
+	446, 0, 0, 459, 464, 465, 477, 479, 480, 481,
+	482, 489, 496, 497, 499, 505, 506, 507, 508, 513,
+	520, 539, 93, 94, 101, 107, 113, 117, 121, 124,
+	130, 133, 136, 138, 139, 140, 143, 154, 157, 158,
+	159, 160, 170, 171, 172, 174, 177, 178, 179, 180,
+	181, 184, 186, 187, 188, 189, 190, 191, 198, 201,
+	207, 208, 209, 210, 211, 212, 213, 215, 216, 217,
+	218, 224, 227, 233, 234, 243, 250, 254, 527, 515,
+	0, 472, 530, 445, 462, 538, 463, 466, 503, 430,
+	485, 176, 460, 0, 449, 425, 456, 426, 447, 474,
+	120, 478, 444, 517, 488, 529, 148, 450, 536, 150,
+	494, 0, 222, 164, 0, 0, 476, 519, 483, 512,
+	471, 504, 435, 493, 531, 461, 501, 532, 0, 0,
+	0, 88, 89, 90, 0, 0, 0, 0, 0, 0,
+	0, 0, 110, 0, 498, 526, 458, 500, 502, 424,
+	495, 0, 428, 431, 537, 522, 453, 454, 0, 0,
+	0, 0, 0, 0, 0, 475, 484, 509, 469, 0,
+	0, 0, 0, 0, 0, 0, 0, 451, 0, 492,
+	0, 0, 0, 432, 429, 0, 0, 473, 0, 0,
+	0, 434, 0, 452, 510, 0, 422, 129, 514, 521,
+	470, 280, 525, 468, 467, 528, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	518, 448, 457, 251, 114, 455, 204, 183, 242, 491,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	420, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 427, 0, 223, 245, 262, 108, 443, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 421,
+	419, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 439, 442, 437, 438, 486, 487, 533, 534,
+	535, 511, 433, 0, 440, 441, 0, 516, 523, 524,
+	490, 91, 100, 149, 258, 197, 125, 246, 423, 436,
+	118, 446, 0, 0, 459, 464, 465, 477, 479, 480,
+	481, 482, 489, 496, 497, 499, 505, 506, 507, 508,
+	513, 520, 539, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 527,
+	515, 0, 472, 530, 445, 462, 538, 463, 466, 503,
+	430, 485, 176, 460, 0, 449, 425, 456, 426, 447,
+	474, 120, 478, 444, 517, 488, 529, 148, 450, 536,
+	150, 494, 0, 222, 164, 0, 0, 476, 519, 483,
+	512, 471, 504, 435, 493, 531, 461, 501, 532, 0,
+	0, 0, 88, 89, 90, 0, 0, 0, 0, 0,
+	0, 0, 0, 110, 0, 498, 526, 458, 500, 502,
+	424, 495, 0, 428, 431, 537, 522, 453, 454, 0,
+	0, 0, 0, 0, 0, 0, 475, 484, 509, 469,
+	0, 0, 0, 0, 0, 0, 0, 0, 451, 0,
+	492, 0, 0, 0, 432, 429, 0, 0, 473, 0,
+	0, 0, 434, 0, 452, 510, 0, 422, 129, 514,
+	521, 470, 280, 525, 468, 467, 528, 195, 0, 226,
+	132, 147, 106, 144, 92, 102, 0, 131, 173, 202,
+	206, 518, 448, 457, 251, 114, 455, 204, 183, 242,
+	491, 185, 203, 151, 232, 196, 241, 252, 253, 229,
+	249, 257, 219, 95, 228, 738, 111, 214, 0, 0,
+	259, 97, 238, 225, 162, 141, 142, 96, 0, 200,
+	119, 127, 116, 175, 235, 236, 115, 261, 103, 248,
+	99, 420, 247, 169, 231, 239, 163, 156, 98, 237,
+	161, 155, 146, 123, 134, 193, 153, 194, 135, 166,
+	165, 167, 0, 427, 0, 223, 245, 262, 108, 443,
+	230, 255, 256, 0, 0, 109, 128, 122, 192, 126,
+	421, 419, 137, 220, 145, 152, 199, 260, 182, 205,
+	112, 244, 221, 439, 442, 437, 438, 486, 487, 533,
+	534, 535, 511, 433, 0, 440, 441, 0, 516, 523,
+	524, 490, 91, 100, 149, 258, 197, 125, 246, 423,
+	436, 118, 446, 0, 0, 459, 464, 465, 477, 479,
+	480, 481, 482, 489, 496, 497, 499, 505, 506, 507,
+	508, 513, 520, 539, 93, 94, 101, 107, 113, 117,
+	121, 124, 130, 133, 136, 138, 139, 140, 143, 154,
+	157, 158, 159, 160, 170, 171, 172, 174, 177, 178,
+	179, 180, 181, 184, 186, 187, 188, 189, 190, 191,
+	198, 201, 207, 208, 209, 210, 211, 212, 213, 215,
+	216, 217, 218, 224, 227, 233, 234, 243, 250, 254,
+	527, 515, 0, 472, 530, 445, 462, 538, 463, 466,
+	503, 430, 485, 176, 460, 0, 449, 425, 456, 426,
+	447, 474, 120, 478, 444, 517, 488, 529, 148, 450,
+	536, 150, 494, 0, 222, 164, 0, 0, 476, 519,
+	483, 512, 471, 504, 435, 493, 531, 461, 501, 532,
+	0, 0, 0, 88, 89, 90, 0, 0, 0, 0,
+	0, 0, 0, 0, 110, 0, 498, 526, 458, 500,
+	502, 424, 495, 0, 428, 431, 537, 522, 453, 454,
+	0, 0, 0, 0, 0, 0, 0, 475, 484, 509,
+	469, 0, 0, 0, 0, 0, 0, 0, 0, 451,
+	0, 492, 0, 0, 0, 432, 429, 0, 0, 473,
+	0, 0, 0, 434, 0, 452, 510, 0, 422, 129,
+	514, 521, 470, 280, 525, 468, 467, 528, 195, 0,
+	226, 132, 147, 106, 144, 92, 102, 0, 131, 173,
+	202, 206, 518, 448, 457, 251, 114, 455, 204, 183,
+	242, 491, 185, 203, 151, 232, 196, 241, 252, 253,
+	229, 249, 257, 219, 95, 228, 411, 111, 214, 0,
+	0, 259, 97, 238, 225, 162, 141, 142, 96, 0,
+	200, 119, 127, 116, 175, 235, 236, 115, 261, 103,
+	248, 99, 420, 247, 169, 231, 239, 163, 156, 98,
+	237, 161, 155, 146, 123, 134, 193, 153, 194, 135,
+	166, 165, 167, 0, 427, 0, 223, 245, 262, 108,
+	443, 230, 255, 256, 0, 0, 109, 128, 122, 192,
+	126, 421, 419, 414, 413, 145, 152, 199, 260, 182,
+	205, 112, 244, 221, 439, 442, 437, 438, 486, 487,
+	533, 534, 535, 511, 433, 0, 440, 441, 0, 516,
+	523, 524, 490, 91, 100, 149, 258, 197, 125, 246,
+	423, 436, 118, 446, 0, 0, 459, 464, 465, 477,
+	479, 480, 481, 482, 489, 496, 497, 499, 505, 506,
+	507, 508, 513, 520, 539, 93, 94, 101, 107, 113,
+	117, 121, 124, 130, 133, 136, 138, 139, 140, 143,
+	154, 157, 158, 159, 160, 170, 171, 172, 174, 177,
+	178, 179, 180, 181, 184, 186, 187, 188, 189, 190,
+	191, 198, 201, 207, 208, 209, 210, 211, 212, 213,
+	215, 216, 217, 218, 224, 227, 233, 234, 243, 250,
+	254, 176, 0, 0, 917, 0, 345, 0, 0, 0,
+	120, 0, 342, 0, 0, 0, 148, 918, 385, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 376, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 0,
+	0, 88, 89, 90, 364, 363, 366, 367, 368, 369,
+	0, 0, 110, 365, 370, 371, 372, 0, 0, 0,
+	340, 357, 0, 384, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 354, 355, 336, 0, 0, 0, 399,
+	0, 356, 0, 0, 351, 352, 353, 358, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 398, 0,
+	0, 280, 0, 0, 396, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 386, 397, 392, 393, 390, 391, 389, 388,
+	387, 400, 378, 379, 380, 381, 383, 0, 394, 395,
+	382, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 176,
+	0, 0, 0, 0, 345, 0, 0, 0, 120, 0,
+	342, 0, 0, 0, 148, 0, 385, 150, 0, 0,
+	222, 164, 0, 0, 0, 0, 376, 377, 0, 0,
+	0, 0, 0, 0, 992, 0, 60, 0, 0, 88,
+	89, 90, 364, 363, 366, 367, 368, 369, 0, 0,
+	110, 365, 370, 371, 372, 993, 0, 0, 340, 357,
+	0, 384, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 354, 355, 0, 0, 0, 0, 399, 0, 356,
+	0, 0, 351, 352, 353, 358, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 398, 0, 0, 280,
+	0, 0, 396, 0, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 0, 0,
+	0, 251, 114, 0, 204, 183, 242, 0, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	0, 0, 223, 245, 262, 108, 0, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	386, 397, 392, 393, 390, 391, 389, 388, 387, 400,
+	378, 379, 380, 381, 383, 0, 394, 395, 382, 91,
+	100, 149, 258, 197, 125, 246, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 176, 0, 0,
+	0, 0, 345, 0, 0, 0, 120, 0, 342, 0,
+	0, 0, 148, 0, 385, 150, 0, 0, 222, 164,
+	0, 0, 0, 0, 376, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 60, 0, 609, 88, 89, 90,
+	364, 363, 366, 367, 368, 369, 0, 0, 110, 365,
+	370, 371, 372, 0, 0, 0, 340, 357, 0, 384,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 354,
+	355, 0, 0, 0, 0, 399, 0, 356, 0, 0,
+	351, 352, 353, 358, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 398, 0, 0, 280, 0, 0,
+	396, 0, 195, 0, 226, 132, 147, 106, 144, 92,
+	102, 0, 131, 173, 202, 206, 0, 0, 0, 251,
+	114, 0, 204, 183, 242, 0, 185, 203, 151, 232,
+	196, 241, 252, 253, 229, 249, 257, 219, 95, 228,
+	240, 111, 214, 0, 0, 259, 97, 238, 225, 162,
+	141, 142, 96, 0, 200, 119, 127, 116, 175, 235,
+	236, 115, 261, 103, 248, 99, 104, 247, 169, 231,
+	239, 163, 156, 98, 237, 161, 155, 146, 123, 134,
+	193, 153, 194, 135, 166, 165, 167, 0, 0, 0,
+	223, 245, 262, 108, 0, 230, 255, 256, 0, 0,
+	109, 128, 122, 192, 126, 168, 105, 137, 220, 145,
+	152, 199, 260, 182, 205, 112, 244, 221, 386, 397,
+	392, 393, 390, 391, 389, 388, 387, 400, 378, 379,
+	380, 381, 383, 0, 394, 395, 382, 91, 100, 149,
+	258, 197, 125, 246, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	94, 101, 107, 113, 117, 121, 124, 130, 133, 136,
+	138, 139, 140, 143, 154, 157, 158, 159, 160, 170,
+	171, 172, 174, 177, 178, 179, 180, 181, 184, 186,
+	187, 188, 189, 190, 191, 198, 201, 207, 208, 209,
+	210, 211, 212, 213, 215, 216, 217, 218, 224, 227,
+	233, 234, 243, 250, 254, 176, 0, 0, 0, 0,
+	345, 0, 0, 0, 120, 0, 342, 0, 0, 0,
+	148, 0, 385, 150, 0, 0, 222, 164, 0, 0,
+	0, 0, 376, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 60, 0, 0, 88, 89, 90, 364, 363,
+	366, 367, 368, 369, 0, 0, 110, 365, 370, 371,
+	372, 0, 0, 0, 340, 357, 0, 384, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 354, 355, 336,
+	0, 0, 0, 399, 0, 356, 0, 0, 351, 352,
+	353, 358, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 398, 0, 0, 280, 0, 0, 396, 0,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 0, 0, 0, 251, 114, 0,
+	204, 183, 242, 0, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 0, 0, 223, 245,
+	262, 108, 0, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 386, 397, 392, 393,
+	390, 391, 389, 388, 387, 400, 378, 379, 380, 381,
+	383, 0, 394, 395, 382, 91, 100, 149, 258, 197,
+	125, 246, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 176, 0, 0, 0, 0, 345, 0,
+	0, 0, 120, 0, 342, 0, 0, 0, 148, 0,
+	385, 150, 0, 0, 222, 164, 0, 0, 0, 0,
+	376, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	60, 0, 0, 88, 89, 90, 364, 933, 366, 367,
+	368, 369, 0, 0, 110, 365, 370, 371, 372, 0,
+	0, 0, 340, 357, 0, 384, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 354, 355, 336, 0, 0,
+	0, 399, 0, 356, 0, 0, 351, 352, 353, 358,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	398, 0, 0, 280, 0, 0, 396, 0, 195, 0,
+	226, 132, 147, 106, 144, 92, 102, 0, 131, 173,
+	202, 206, 0, 0, 0, 251, 114, 0, 204, 183,
+	242, 0, 185, 203, 151, 232, 196, 241, 252, 253,
+	229, 249, 257, 219, 95, 228, 240, 111, 214, 0,
+	0, 259, 97, 238, 225, 162, 141, 142, 96, 0,
+	200, 119, 127, 116, 175, 235, 236, 115, 261, 103,
+	248, 99, 104, 247, 169, 231, 239, 163, 156, 98,
+	237, 161, 155, 146, 123, 134, 193, 153, 194, 135,
+	166, 165, 167, 0, 0, 0, 223, 245, 262, 108,
+	0, 230, 255, 256, 0, 0, 109, 128, 122, 192,
+	126, 168, 105, 137, 220, 145, 152, 199, 260, 182,
+	205, 112, 244, 221, 386, 397, 392, 393, 390, 391,
+	389, 388, 387, 400, 378, 379, 380, 381, 383, 0,
+	394, 395, 382, 91, 100, 149, 258, 197, 125, 246,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 101, 107, 113,
+	117, 121, 124, 130, 133, 136, 138, 139, 140, 143,
+	154, 157, 158, 159, 160, 170, 171, 172, 174, 177,
+	178, 179, 180, 181, 184, 186, 187, 188, 189, 190,
+	191, 198, 201, 207, 208, 209, 210, 211, 212, 213,
+	215, 216, 217, 218, 224, 227, 233, 234, 243, 250,
+	254, 176, 0, 0, 0, 0, 345, 0, 0, 0,
+	120, 0, 342, 0, 0, 0, 148, 0, 385, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 376, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 0,
+	0, 88, 89, 90, 364, 930, 366, 367, 368, 369,
+	0, 0, 110, 365, 370, 371, 372, 0, 0, 0,
+	340, 357, 0, 384, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 354, 355, 336, 0, 0, 0, 399,
+	0, 356, 0, 0, 351, 352, 353, 358, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 398, 0,
+	0, 280, 0, 0, 396, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 386, 397, 392, 393, 390, 391, 389, 388,
+	387, 400, 378, 379, 380, 381, 383, 0, 394, 395,
+	382, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 27,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 0, 0, 0, 345, 0, 0, 0,
+	120, 0, 342, 0, 0, 0, 148, 0, 385, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 376, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 0,
+	0, 88, 89, 90, 364, 363, 366, 367, 368, 369,
+	0, 0, 110, 365, 370, 371, 372, 0, 0, 0,
+	340, 357, 0, 384, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 354, 355, 0, 0, 0, 0, 399,
+	0, 356, 0, 0, 351, 352, 353, 358, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 398, 0,
+	0, 280, 0, 0, 396, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 386, 397, 392, 393, 390, 391, 389, 388,
+	387, 400, 378, 379, 380, 381, 383, 0, 394, 395,
+	382, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 176,
+	0, 0, 0, 0, 345, 0, 0, 0, 120, 0,
+	342, 0, 0, 0, 148, 0, 385, 150, 0, 0,
+	222, 164, 0, 0, 0, 0, 376, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 60, 0, 0, 88,
+	89, 90, 364, 363, 366, 367, 368, 369, 0, 0,
+	110, 365, 370, 371, 372, 0, 0, 0, 340, 357,
+	0, 384, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 354, 355, 0, 0, 0, 0, 399, 0, 356,
+	0, 0, 351, 352, 353, 358, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 398, 0, 0, 280,
+	0, 0, 396, 0, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 0, 0,
+	0, 251, 114, 0, 204, 183, 242, 0, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	0, 0, 223, 245, 262, 108, 0, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	386, 397, 392, 393, 390, 391, 389, 388, 387, 400,
+	378, 379, 380, 381, 383, 0, 394, 395, 382, 91,
+	100, 149, 258, 197, 125, 246, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 0, 148, 0, 385, 150, 0, 0, 222, 164,
+	0, 0, 0, 0, 376, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 60, 0, 0, 88, 89, 90,
+	364, 363, 366, 367, 368, 369, 0, 0, 110, 365,
+	370, 371, 372, 0, 0, 0, 0, 357, 0, 384,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 354,
+	355, 0, 0, 0, 0, 399, 0, 356, 0, 0,
+	351, 352, 353, 358, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 398, 0, 0, 280, 0, 0,
+	396, 0, 195, 0, 226, 132, 147, 106, 144, 92,
+	102, 0, 131, 173, 202, 206, 0, 0, 0, 251,
+	114, 0, 204, 183, 242, 1570, 185, 203, 151, 232,
+	196, 241, 252, 253, 229, 249, 257, 219, 95, 228,
+	240, 111, 214, 0, 0, 259, 97, 238, 225, 162,
+	141, 142, 96, 0, 200, 119, 127, 116, 175, 235,
+	236, 115, 261, 103, 248, 99, 104, 247, 169, 231,
+	239, 163, 156, 98, 237, 161, 155, 146, 123, 134,
+	193, 153, 194, 135, 166, 165, 167, 0, 0, 0,
+	223, 245, 262, 108, 0, 230, 255, 256, 0, 0,
+	109, 128, 122, 192, 126, 168, 105, 137, 220, 145,
+	152, 199, 260, 182, 205, 112, 244, 221, 386, 397,
+	392, 393, 390, 391, 389, 388, 387, 400, 378, 379,
+	380, 381, 383, 0, 394, 395, 382, 91, 100, 149,
+	258, 197, 125, 246, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	94, 101, 107, 113, 117, 121, 124, 130, 133, 136,
+	138, 139, 140, 143, 154, 157, 158, 159, 160, 170,
+	171, 172, 174, 177, 178, 179, 180, 181, 184, 186,
+	187, 188, 189, 190, 191, 198, 201, 207, 208, 209,
+	210, 211, 212, 213, 215, 216, 217, 218, 224, 227,
+	233, 234, 243, 250, 254, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 0, 0, 0,
+	148, 0, 385, 150, 0, 0, 222, 164, 0, 0,
+	0, 0, 376, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 60, 0, 609, 88, 89, 90, 364, 363,
+	366, 367, 368, 369, 0, 0, 110, 365, 370, 371,
+	372, 0, 0, 0, 0, 357, 0, 384, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 354, 355, 0,
+	0, 0, 0, 399, 0, 356, 0, 0, 351, 352,
+	353, 358, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 398, 0, 0, 280, 0, 0, 396, 0,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 0, 0, 0, 251, 114, 0,
+	204, 183, 242, 0, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 0, 0, 223, 245,
+	262, 108, 0, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 386, 397, 392, 393,
+	390, 391, 389, 388, 387, 400, 378, 379, 380, 381,
+	383, 0, 394, 395, 382, 91, 100, 149, 258, 197,
+	125, 246, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 0, 0, 0, 148, 0,
+	385, 150, 0, 0, 222, 164, 0, 0, 0, 0,
+	376, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	60, 0, 0, 88, 89, 90, 364, 363, 366, 367,
+	368, 369, 0, 0, 110, 365, 370, 371, 372, 0,
+	0, 0, 0, 357, 0, 384, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 354, 355, 0, 0, 0,
+	0, 399, 0, 356, 0, 0, 351, 352, 353, 358,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	398, 0, 0, 280, 0, 0, 396, 0, 195, 0,
+	226, 132, 147, 106, 144, 92, 102, 0, 131, 173,
+	202, 206, 0, 0, 0, 251, 114, 0, 204, 183,
+	242, 0, 185, 203, 151, 232, 196, 241, 252, 253,
+	229, 249, 257, 219, 95, 228, 240, 111, 214, 0,
+	0, 259, 97, 238, 225, 162, 141, 142, 96, 0,
+	200, 119, 127, 116, 175, 235, 236, 115, 261, 103,
+	248, 99, 104, 247, 169, 231, 239, 163, 156, 98,
+	237, 161, 155, 146, 123, 134, 193, 153, 194, 135,
+	166, 165, 167, 0, 0, 0, 223, 245, 262, 108,
+	0, 230, 255, 256, 0, 0, 109, 128, 122, 192,
+	126, 168, 105, 137, 220, 145, 152, 199, 260, 182,
+	205, 112, 244, 221, 386, 397, 392, 393, 390, 391,
+	389, 388, 387, 400, 378, 379, 380, 381, 383, 0,
+	394, 395, 382, 91, 100, 149, 258, 197, 125, 246,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 101, 107, 113,
+	117, 121, 124, 130, 133, 136, 138, 139, 140, 143,
+	154, 157, 158, 159, 160, 170, 171, 172, 174, 177,
+	178, 179, 180, 181, 184, 186, 187, 188, 189, 190,
+	191, 198, 201, 207, 208, 209, 210, 211, 212, 213,
+	215, 216, 217, 218, 224, 227, 233, 234, 243, 250,
+	254, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 148, 0, 0, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 90, 0, 0, 0, 0, 0, 0,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 644,
+	643, 653, 654, 646, 647, 648, 649, 650, 651, 652,
+	645, 0, 0, 655, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
+	0, 280, 0, 0, 0, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 176,
+	0, 0, 0, 632, 0, 0, 0, 0, 120, 0,
+	0, 0, 0, 0, 148, 0, 0, 150, 0, 0,
+	222, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 90, 0, 634, 0, 0, 0, 0, 0, 0,
+	110, 0, 0, 0, 0, 0, 629, 628, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 0, 0, 280,
+	0, 0, 0, 0, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 0, 0,
+	0, 251, 114, 0, 204, 183, 242, 0, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	0, 0, 223, 245, 262, 108, 0, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	100, 149, 258, 197, 125, 246, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 0, 148, 0, 0, 150, 0, 0, 222, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 0, 110, 0,
+	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 82, 83, 0, 79, 0, 0,
+	0, 84, 195, 0, 226, 132, 147, 106, 144, 92,
+	102, 0, 131, 173, 202, 206, 0, 0, 0, 251,
+	114, 0, 204, 183, 242, 0, 185, 203, 151, 232,
+	196, 241, 252, 253, 229, 249, 257, 219, 95, 228,
+	240, 111, 214, 0, 0, 259, 97, 238, 225, 162,
+	141, 142, 96, 0, 200, 119, 127, 116, 175, 235,
+	236, 115, 261, 103, 248, 99, 104, 247, 169, 231,
+	239, 163, 156, 98, 237, 161, 155, 146, 123, 134,
+	193, 153, 194, 135, 166, 165, 167, 0, 0, 0,
+	223, 245, 262, 108, 0, 230, 255, 256, 0, 0,
+	109, 128, 122, 192, 126, 168, 105, 137, 220, 145,
+	152, 199, 260, 182, 205, 112, 244, 221, 0, 81,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 100, 149,
+	258, 197, 125, 246, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	94, 101, 107, 113, 117, 121, 124, 130, 133, 136,
+	138, 139, 140, 143, 154, 157, 158, 159, 160, 170,
+	171, 172, 174, 177, 178, 179, 180, 181, 184, 186,
+	187, 188, 189, 190, 191, 198, 201, 207, 208, 209,
+	210, 211, 212, 213, 215, 216, 217, 218, 224, 227,
+	233, 234, 243, 250, 254, 176, 0, 0, 0, 975,
+	0, 0, 0, 0, 120, 0, 0, 0, 0, 0,
+	148, 0, 0, 150, 0, 0, 222, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 89, 90, 0, 977,
+	0, 0, 0, 0, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 0, 0, 280, 0, 0, 0, 0,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 0, 0, 0, 251, 114, 0,
+	204, 183, 242, 0, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 0, 0, 223, 245,
+	262, 108, 0, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 100, 149, 258, 197,
+	125, 246, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 27, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 0, 0, 0,
+	148, 0, 0, 150, 0, 0, 222, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 60, 0, 0, 88, 89, 90, 0, 0,
+	0, 0, 0, 0, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 0, 0, 280, 0, 0, 0, 0,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 0, 0, 0, 251, 114, 0,
+	204, 183, 242, 0, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 0, 0, 223, 245,
+	262, 108, 0, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 100, 149, 258, 197,
+	125, 246, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 176, 0, 0, 0, 975, 0, 0,
+	0, 0, 120, 0, 0, 0, 0, 0, 148, 0,
+	0, 150, 0, 0, 222, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 90, 0, 977, 0, 0,
+	0, 0, 0, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 0, 0, 280, 0, 0, 0, 0, 195, 0,
+	226, 132, 147, 106, 144, 92, 102, 0, 131, 173,
+	202, 206, 0, 0, 0, 251, 114, 0, 204, 183,
+	242, 0, 973, 203, 151, 232, 196, 241, 252, 253,
+	229, 249, 257, 219, 95, 228, 240, 111, 214, 0,
+	0, 259, 97, 238, 225, 162, 141, 142, 96, 0,
+	200, 119, 127, 116, 175, 235, 236, 115, 261, 103,
+	248, 99, 104, 247, 169, 231, 239, 163, 156, 98,
+	237, 161, 155, 146, 123, 134, 193, 153, 194, 135,
+	166, 165, 167, 0, 0, 0, 223, 245, 262, 108,
+	0, 230, 255, 256, 0, 0, 109, 128, 122, 192,
+	126, 168, 105, 137, 220, 145, 152, 199, 260, 182,
+	205, 112, 244, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 100, 149, 258, 197, 125, 246,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 101, 107, 113,
+	117, 121, 124, 130, 133, 136, 138, 139, 140, 143,
+	154, 157, 158, 159, 160, 170, 171, 172, 174, 177,
+	178, 179, 180, 181, 184, 186, 187, 188, 189, 190,
+	191, 198, 201, 207, 208, 209, 210, 211, 212, 213,
+	215, 216, 217, 218, 224, 227, 233, 234, 243, 250,
+	254, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 148, 0, 0, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 90, 0, 0, 868, 0, 0, 869,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
+	0, 280, 0, 0, 0, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 120, 0,
+	747, 0, 0, 0, 148, 0, 0, 150, 0, 0,
+	222, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 90, 0, 746, 0, 0, 0, 0, 0, 0,
+	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 0, 0, 280,
+	0, 0, 0, 0, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 0, 0,
+	0, 251, 114, 0, 204, 183, 242, 0, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	0, 0, 223, 245, 262, 108, 0, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	100, 149, 258, 197, 125, 246, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 0, 148, 0, 0, 150, 0, 0, 222, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 609, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 0, 110, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 0, 0, 280, 0, 0,
+	0, 0, 195, 0, 226, 132, 147, 106, 144, 92,
+	102, 0, 131, 173, 202, 206, 0, 0, 0, 251,
+	114, 0, 204, 183, 242, 0, 185, 203, 151, 232,
+	196, 241, 252, 253, 229, 249, 257, 219, 95, 228,
+	240, 111, 214, 0, 0, 259, 97, 238, 225, 162,
+	141, 142, 96, 0, 200, 119, 127, 116, 175, 235,
+	236, 115, 261, 103, 248, 99, 104, 247, 169, 231,
+	239, 163, 156, 98, 237, 161, 155, 146, 123, 134,
+	193, 153, 194, 135, 166, 165, 167, 0, 0, 0,
+	223, 245, 262, 108, 0, 230, 255, 256, 0, 0,
+	109, 128, 122, 192, 126, 168, 105, 137, 220, 145,
+	152, 199, 260, 182, 205, 112, 244, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 100, 149,
+	258, 197, 125, 246, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	94, 101, 107, 113, 117, 121, 124, 130, 133, 136,
+	138, 139, 140, 143, 154, 157, 158, 159, 160, 170,
+	171, 172, 174, 177, 178, 179, 180, 181, 184, 186,
+	187, 188, 189, 190, 191, 198, 201, 207, 208, 209,
+	210, 211, 212, 213, 215, 216, 217, 218, 224, 227,
+	233, 234, 243, 250, 254, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 120, 0, 0, 0, 0, 0,
+	148, 0, 0, 150, 0, 0, 222, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 60, 0, 0, 88, 89, 90, 0, 0,
+	0, 0, 0, 0, 0, 0, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 0, 0, 280, 0, 0, 0, 0,
+	195, 0, 226, 132, 147, 106, 144, 92, 102, 0,
+	131, 173, 202, 206, 0, 0, 0, 251, 114, 0,
+	204, 183, 242, 0, 185, 203, 151, 232, 196, 241,
+	252, 253, 229, 249, 257, 219, 95, 228, 240, 111,
+	214, 0, 0, 259, 97, 238, 225, 162, 141, 142,
+	96, 0, 200, 119, 127, 116, 175, 235, 236, 115,
+	261, 103, 248, 99, 104, 247, 169, 231, 239, 163,
+	156, 98, 237, 161, 155, 146, 123, 134, 193, 153,
+	194, 135, 166, 165, 167, 0, 0, 0, 223, 245,
+	262, 108, 0, 230, 255, 256, 0, 0, 109, 128,
+	122, 192, 126, 168, 105, 137, 220, 145, 152, 199,
+	260, 182, 205, 112, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 100, 149, 258, 197,
+	125, 246, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 101,
+	107, 113, 117, 121, 124, 130, 133, 136, 138, 139,
+	140, 143, 154, 157, 158, 159, 160, 170, 171, 172,
+	174, 177, 178, 179, 180, 181, 184, 186, 187, 188,
+	189, 190, 191, 198, 201, 207, 208, 209, 210, 211,
+	212, 213, 215, 216, 217, 218, 224, 227, 233, 234,
+	243, 250, 254, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 0, 0, 0, 148, 0,
+	0, 150, 0, 0, 222, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 90, 0, 977, 0, 0,
+	0, 0, 0, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 0, 0, 280, 0, 0, 0, 0, 195, 0,
+	226, 132, 147, 106, 144, 92, 102, 0, 131, 173,
+	202, 206, 0, 0, 0, 251, 114, 0, 204, 183,
+	242, 0, 185, 203, 151, 232, 196, 241, 252, 253,
+	229, 249, 257, 219, 95, 228, 240, 111, 214, 0,
+	0, 259, 97, 238, 225, 162, 141, 142, 96, 0,
+	200, 119, 127, 116, 175, 235, 236, 115, 261, 103,
+	248, 99, 104, 247, 169, 231, 239, 163, 156, 98,
+	237, 161, 155, 146, 123, 134, 193, 153, 194, 135,
+	166, 165, 167, 0, 0, 0, 223, 245, 262, 108,
+	0, 230, 255, 256, 0, 0, 109, 128, 122, 192,
+	126, 168, 105, 137, 220, 145, 152, 199, 260, 182,
+	205, 112, 244, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 100, 149, 258, 197, 125, 246,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 101, 107, 113,
+	117, 121, 124, 130, 133, 136, 138, 139, 140, 143,
+	154, 157, 158, 159, 160, 170, 171, 172, 174, 177,
+	178, 179, 180, 181, 184, 186, 187, 188, 189, 190,
+	191, 198, 201, 207, 208, 209, 210, 211, 212, 213,
+	215, 216, 217, 218, 224, 227, 233, 234, 243, 250,
+	254, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 148, 0, 0, 150,
+	0, 0, 222, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 90, 0, 634, 0, 0, 0, 0,
+	0, 0, 110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
+	0, 280, 0, 0, 0, 0, 195, 0, 226, 132,
+	147, 106, 144, 92, 102, 0, 131, 173, 202, 206,
+	0, 0, 0, 251, 114, 0, 204, 183, 242, 0,
+	185, 203, 151, 232, 196, 241, 252, 253, 229, 249,
+	257, 219, 95, 228, 240, 111, 214, 0, 0, 259,
+	97, 238, 225, 162, 141, 142, 96, 0, 200, 119,
+	127, 116, 175, 235, 236, 115, 261, 103, 248, 99,
+	104, 247, 169, 231, 239, 163, 156, 98, 237, 161,
+	155, 146, 123, 134, 193, 153, 194, 135, 166, 165,
+	167, 0, 0, 0, 223, 245, 262, 108, 0, 230,
+	255, 256, 0, 0, 109, 128, 122, 192, 126, 168,
+	105, 137, 220, 145, 152, 199, 260, 182, 205, 112,
+	244, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 100, 149, 258, 197, 125, 246, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 101, 107, 113, 117, 121,
+	124, 130, 133, 136, 138, 139, 140, 143, 154, 157,
+	158, 159, 160, 170, 171, 172, 174, 177, 178, 179,
+	180, 181, 184, 186, 187, 188, 189, 190, 191, 198,
+	201, 207, 208, 209, 210, 211, 212, 213, 215, 216,
+	217, 218, 224, 227, 233, 234, 243, 250, 254, 176,
+	0, 0, 0, 0, 0, 0, 0, 717, 120, 0,
+	0, 0, 0, 0, 148, 0, 0, 150, 0, 0,
+	222, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 90, 0, 0, 0, 0, 0, 0, 0, 0,
+	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 0, 0, 280,
+	0, 0, 0, 0, 195, 0, 226, 132, 147, 106,
+	144, 92, 102, 0, 131, 173, 202, 206, 0, 0,
+	0, 251, 114, 0, 204, 183, 242, 0, 185, 203,
+	151, 232, 196, 241, 252, 253, 229, 249, 257, 219,
+	95, 228, 240, 111, 214, 0, 0, 259, 97, 238,
+	225, 162, 141, 142, 96, 0, 200, 119, 127, 116,
+	175, 235, 236, 115, 261, 103, 248, 99, 104, 247,
+	169, 231, 239, 163, 156, 98, 237, 161, 155, 146,
+	123, 134, 193, 153, 194, 135, 166, 165, 167, 0,
+	0, 0, 223, 245, 262, 108, 0, 230, 255, 256,
+	0, 0, 109, 128, 122, 192, 126, 168, 105, 137,
+	220, 145, 152, 199, 260, 182, 205, 112, 244, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	100, 149, 258, 197, 125, 246, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 101, 107, 113, 117, 121, 124, 130,
+	133, 136, 138, 139, 140, 143, 154, 157, 158, 159,
+	160, 170, 171, 172, 174, 177, 178, 179, 180, 181,
+	184, 186, 187, 188, 189, 190, 191, 198, 201, 207,
+	208, 209, 210, 211, 212, 213, 215, 216, 217, 218,
+	224, 227, 233, 234, 243, 250, 254, 403, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 0, 0, 0, 148,
+	0, 0, 150, 0, 0, 222, 164, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 90, 0, 0, 0,
+	0, 0, 0, 0, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 280, 0, 0, 0, 0, 195,
+	0, 226, 132, 147, 106, 144, 92, 102, 0, 131,
+	173, 202, 206, 0, 0, 0, 251, 114, 0, 204,
+	183, 242, 0, 185, 203, 151, 232, 196, 241, 252,
+	253, 229, 249, 257, 219, 95, 228, 240, 111, 214,
+	0, 0, 259, 97, 238, 225, 162, 141, 142, 96,
+	0, 200, 119, 127, 116, 175, 235, 236, 115, 261,
+	103, 248, 99, 104, 247, 169, 231, 239, 163, 156,
+	98, 237, 161, 155, 146, 123, 134, 193, 153, 194,
+	135, 166, 165, 167, 0, 0, 0, 223, 245, 262,
+	108, 0, 230, 255, 256, 0, 0, 109, 128, 122,
+	192, 126, 168, 105, 137, 220, 145, 152, 199, 260,
+	182, 205, 112, 244, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 100, 149, 258, 197, 125,
+	246, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 93, 94, 101, 107,
+	113, 117, 121, 124, 130, 133, 136, 138, 139, 140,
+	143, 154, 157, 158, 159, 160, 170, 171, 172, 174,
+	177, 178, 179, 180, 181, 184, 186, 187, 188, 189,
+	190, 191, 198, 201, 207, 208, 209, 210, 211, 212,
+	213, 215, 216, 217, 218, 224, 227, 233, 234, 243,
+	250, 254, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 0, 0, 0, 0, 148, 0, 0,
+	150, 0, 0, 222, 164, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 89, 90, 0, 0, 0, 0, 0,
+	0, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	275, 0, 280, 0, 0, 0, 0, 195, 0, 226,
+	132, 147, 106, 144, 92, 102, 0, 131, 173, 202,
+	206, 0, 0, 0, 251, 114, 0, 204, 183, 242,
+	0, 185, 203, 151, 232, 196, 241, 252, 253, 229,
+	249, 257, 219, 95, 228, 240, 111, 214, 0, 0,
+	259, 97, 238, 225, 162, 141, 142, 96, 0, 200,
+	119, 127, 116, 175, 235, 236, 115, 261, 103, 248,
+	99, 104, 247, 169, 231, 239, 163, 156, 98, 237,
+	161, 155, 146, 123, 134, 193, 153, 194, 135, 166,
+	165, 167, 0, 0, 0, 223, 245, 262, 108, 0,
+	230, 255, 256, 0, 0, 109, 128, 122, 192, 126,
+	168, 105, 137, 220, 145, 152, 199, 260, 182, 205,
+	112, 244, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 100, 149, 258, 197, 125, 246, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 93, 94, 101, 107, 113, 117,
+	121, 124, 130, 133, 136, 138, 139, 140, 143, 154,
+	157, 158, 159, 160, 170, 171, 172, 174, 177, 178,
+	179, 180, 181, 184, 186, 187, 188, 189, 190, 191,
+	198, 201, 207, 208, 209, 210, 211, 212, 213, 215,
+	216, 217, 218, 224, 227, 233, 234, 243, 250, 254,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 0, 0, 148, 0, 0, 150, 0,
+	0, 222, 164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 89, 90, 0, 0, 0, 0, 0, 0, 0,
+	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	280, 0, 0, 0, 0, 195, 0, 226, 132, 147,
+	106, 144, 92, 102, 0, 131, 173, 202, 206, 0,
+	0, 0, 251, 114, 0, 204, 183, 242, 0, 185,
+	203, 151, 232, 196, 241, 252, 253, 229, 249, 257,
+	219, 95, 228, 240, 111, 214, 0, 0, 259, 97,
+	238, 225, 162, 141, 142, 96, 0, 200, 119, 127,
+	116, 175, 235, 236, 115, 261, 103, 248, 99, 104,
+	247, 169, 231, 239, 163, 156, 98, 237, 161, 155,
+	146, 123, 134, 193, 153, 194, 135, 166, 165, 167,
+	0, 0, 0, 223, 245, 262, 108, 0, 230, 255,
+	256, 0, 0, 109, 128, 122, 192, 126, 168, 105,
+	137, 220, 145, 152, 199, 260, 182, 205, 112, 244,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 100, 149, 258, 197, 125, 246, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 94, 101, 107, 113, 117, 121, 124,
+	130, 133, 136, 138, 139, 140, 143, 154, 157, 158,
+	159, 160, 170, 171, 172, 174, 177, 178, 179, 180,
+	181, 184, 186, 187, 188, 189, 190, 191, 198, 201,
+	207, 208, 209, 210, 211, 212, 213, 215, 216, 217,
+	218, 224, 227, 233, 234, 243, 250, 254,
 }
 var yyPact = [...]int{
 
-	2438, -1000, -271, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1733, -1000, -272, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 986, 1037, -1000, -1000, -1000,
+	-1000, -1000, -1000, 284, 11779, 45, 136, 34, 15854, 135,
+	88, 16192, -1000, 23, -1000, 15, 16192, 19, -1000, -1000,
+	-1000, -1000, -1000, -47, -68, 128, -1000, 767, -1000, -1000,
+	-1000, -1000, -1000, 973, 980, 777, 968, 887, -1000, 8387,
+	114, 114, 15516, 7035, -1000, -1000, 515, 16192, 131, 16192,
+	-154, 112, 112, 112, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 913, 946, -1000, -1000, -1000, -1000, -1000, -1000,
-	244, 11512, 20, 125, -22, 15539, 124, 183, 15873, -1000,
-	16, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -55, -75,
-	-1000, 708, -1000, -1000, -1000, -1000, -1000, 892, 896, 769,
-	887, 809, -1000, 8160, 87, 87, 15205, 6824, -1000, -1000,
-	332, 15873, 121, 15873, -152, 84, 84, 84, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2536,24 +2585,23 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 133, 16192, 565, 565, 250, -1000, 16192,
+	105, 565, 105, 105, 105, 16192, -1000, 199, -1000, -1000,
+	-1000, 16192, 565, 925, 338, 84, 4578, -1000, 230, -1000,
+	4578, 30, 4578, -54, 1004, 31, -19, -1000, 4578, -1000,
+	-1000, -1000, -1000, -1000, -1000, 123, -1000, -1000, 16192, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 123, 15873, 524, 524, 240,
-	-1000, 15873, 82, 524, 82, 82, 82, 15873, -1000, 167,
-	-1000, -1000, -1000, 15873, 524, 858, 312, 73, 4395, -1000,
-	178, -1000, 4395, 24, 4395, -52, 920, 25, -17, -1000,
-	4395, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 448, 861, 9508, 9508, 913,
-	-1000, 708, -1000, -1000, -1000, 849, -1000, -1000, 313, 935,
-	-1000, 11178, 165, -1000, 9508, 2418, 525, -1000, -1000, 525,
-	-1000, -1000, 153, -1000, -1000, 10510, 10510, 10510, 10510, 10510,
-	10510, 10510, 10510, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 525, -1000, 9174,
-	525, 525, 525, 525, 525, 525, 525, 525, 9508, 525,
-	525, 525, 525, 525, 525, 525, 525, 525, 525, 525,
-	525, 525, 525, 525, 525, 14864, 13862, 15873, 688, 499,
-	-1000, -1000, 162, 690, 6477, -98, -1000, -1000, -1000, 246,
-	13194, -1000, -1000, -1000, 857, -1000, -1000, -1000, -1000, -1000,
+	-1000, 563, 933, 9751, 9751, 986, -1000, 767, -1000, -1000,
+	-1000, 928, -1000, -1000, 387, 1023, -1000, 11441, 195, -1000,
+	9751, 1573, 745, -1000, -1000, 745, -1000, -1000, 185, -1000,
+	-1000, 10765, 10765, 10765, 10765, 10765, 10765, 10765, 10765, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 745, -1000, 9413, 745, 745, 745, 745,
+	745, 745, 745, 745, 9751, 745, 745, 745, 745, 745,
+	745, 745, 745, 745, 745, 745, 745, 745, 745, 745,
+	745, 15171, 14157, 16192, 735, 734, -1000, -1000, 193, 738,
+	6684, -73, -1000, -1000, -1000, 307, 13481, -1000, -1000, -1000,
+	924, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2564,302 +2612,308 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 649, 15873, -1000, 2882, -1000, 524,
-	4395, 103, 524, 255, 524, 15873, 15873, 4395, 4395, 4395,
-	31, 65, 61, 15873, 695, 92, 15873, 882, 779, 15873,
-	524, 524, -1000, 5783, -1000, 4395, 312, -1000, 438, 9508,
-	4395, 4395, 4395, 15873, 4395, 4395, -1000, -1000, -1000, 356,
-	-1000, -1000, -1000, -1000, 4395, 4395, -1000, 933, 299, -1000,
-	-1000, -1000, -1000, 9508, 195, -1000, 778, -1000, -1000, -1000,
-	-1000, -1000, -1000, 941, 192, 530, 158, 694, -1000, 374,
-	892, 448, 809, 12860, 789, -1000, -1000, -1000, 15873, -1000,
-	9508, 9508, 426, -1000, 14530, -1000, -1000, 5436, 200, 10510,
-	392, 289, 10510, 10510, 10510, 10510, 10510, 10510, 10510, 10510,
-	10510, 10510, 10510, 10510, 10510, 10510, 10510, 512, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 524, -1000, 708, 604,
-	604, 180, 180, 180, 180, 180, 180, 180, 10844, 7158,
-	448, 645, 304, 9174, 8160, 8160, 9508, 9508, 8828, 8494,
-	8160, 856, 252, 304, 15873, -1000, -1000, 10176, -1000, -1000,
-	-1000, -1000, -1000, 448, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 15873, 15873, 8160, 8160, 8160, 8160, 8160, 44, 15873,
-	-1000, 655, 770, -1000, -1000, -1000, 884, 12192, 12526, 44,
-	683, 13862, 15873, -1000, -1000, 13862, 15873, 5089, 6130, 690,
-	-98, 659, -1000, -108, -105, 7492, 171, -1000, -1000, -1000,
-	-1000, 4048, 369, 486, 390, -45, -1000, -1000, -1000, 710,
-	-1000, 710, 710, 710, 710, -10, -10, -10, -10, -1000,
-	-1000, -1000, -1000, -1000, 738, 733, -1000, 710, 710, 710,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 718, 718,
-	718, 712, 712, 750, -1000, 15873, 4395, 880, 4395, -1000,
-	83, -1000, -1000, -1000, 15873, 15873, 15873, 15873, 15873, 132,
-	15873, 15873, 689, -1000, 15873, 4395, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 304, -1000, -1000, -1000, -1000, -1000,
-	-1000, 15873, -1000, -1000, -1000, -1000, 15873, 312, 15873, 15873,
-	304, -1000, 433, 15873, -1000, 822, 9508, 9508, 5783, 9508,
-	-1000, -1000, -1000, 861, -1000, 856, 914, -1000, 834, 833,
-	8160, -1000, -1000, 200, 301, -1000, -1000, 436, -1000, -1000,
-	-1000, -1000, 157, 525, -1000, 1653, -1000, -1000, -1000, -1000,
-	392, 10510, 10510, 10510, 336, 1653, 817, 1255, 551, 180,
-	268, 268, 179, 179, 179, 179, 179, 378, 378, -1000,
-	-1000, -1000, 448, -1000, -1000, -1000, 448, 8160, 8160, 675,
-	-1000, -1000, 9508, -1000, 448, 638, 638, 414, 536, 249,
-	932, 638, 245, 931, 638, 638, 8160, 261, -1000, 9508,
-	448, -1000, 156, -1000, 492, 672, 667, 638, 448, 448,
-	638, 638, 692, 525, -1000, 15873, 13862, 13862, 13862, 13862,
-	13862, -1000, 802, 801, -1000, 800, 799, 821, 15873, -1000,
-	640, 12192, 174, 525, -1000, 14196, -1000, -1000, 919, 13862,
-	668, -1000, 668, -1000, 152, -1000, -1000, 659, -98, -72,
-	-1000, -1000, -1000, -1000, 304, -1000, 519, 654, 3701, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 715, 524, -1000, 869,
-	201, 237, 524, 868, -1000, -1000, -1000, 860, -1000, 258,
-	-48, -1000, -1000, 382, -10, -10, -1000, -1000, 171, 841,
-	171, 171, 171, 432, 432, -1000, -1000, -1000, -1000, 348,
-	-1000, -1000, -1000, 347, -1000, 775, 15873, 4395, -1000, -1000,
-	-1000, -1000, 288, 288, 225, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 41, 588, -1000, -1000,
-	-1000, -1000, 15, 30, 90, -1000, 4395, -1000, 299, 299,
-	-1000, -1000, -1000, -1000, -1000, 820, 304, 304, 148, -1000,
-	-1000, 15873, -1000, -1000, -1000, -1000, 696, -1000, -1000, -1000,
-	4742, 8160, -1000, 336, 1653, 417, -1000, 10510, 10510, -1000,
-	-1000, 638, 638, 8160, 304, -1000, -1000, -1000, 28, 512,
-	28, 10510, 10510, -1000, 10510, 10510, -1000, -164, 552, 251,
-	-1000, 9508, 238, -1000, 5783, -1000, 10510, 10510, -1000, -1000,
-	-1000, -1000, -1000, 773, 15873, 525, -1000, 12192, 15873, 679,
-	-1000, 226, 770, 756, 772, 586, -1000, -1000, -1000, -1000,
-	792, -1000, 780, -1000, -1000, -1000, -1000, -1000, 111, 110,
-	109, 15873, -1000, 913, 9508, 668, -1000, -1000, 182, -1000,
-	-1000, -117, -132, -1000, -1000, -1000, 4048, -1000, 4048, 15873,
-	66, -1000, 524, 524, -1000, -1000, -1000, 713, 768, 10510,
-	-1000, -1000, -1000, 477, 171, 171, -1000, 248, -1000, -1000,
-	-1000, 633, -1000, 631, 647, 626, 15873, -1000, -1000, -1000,
+	666, 16192, -1000, 319, -1000, 565, 4578, 120, 565, 339,
+	565, 16192, 16192, 4578, 4578, 4578, 46, 72, 79, 16192,
+	741, 122, 16192, 956, 838, 16192, 565, 565, -1000, 5982,
+	-1000, 4578, 338, -1000, 540, 9751, 4578, 4578, 4578, 16192,
+	4578, 4578, -1000, -1000, -1000, 467, -1000, -1000, -1000, -1000,
+	4578, 4578, -1000, 1022, 390, -1000, -1000, -1000, -1000, 9751,
+	255, -1000, 837, -1000, 18, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1032, 238, 553, 192, 740, -1000, 465, 973, 563,
+	887, 13143, 856, -1000, -1000, -1000, 16192, -1000, 9751, 9751,
+	582, -1000, 14833, -1000, -1000, 5631, 259, 10765, 531, 371,
+	10765, 10765, 10765, 10765, 10765, 10765, 10765, 10765, 10765, 10765,
+	10765, 10765, 10765, 10765, 10765, 577, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 565, -1000, 767, 624, 624, 222,
+	222, 222, 222, 222, 222, 222, 11103, 7373, 563, 664,
+	518, 9413, 8387, 8387, 9751, 9751, 9063, 8725, 8387, 929,
+	317, 518, 16192, -1000, -1000, 10427, -1000, -1000, -1000, -1000,
+	-1000, 563, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16192,
+	16192, 8387, 8387, 8387, 8387, 8387, 73, 16192, -1000, 708,
+	792, -1000, -1000, -1000, 959, 12467, 12805, 73, 705, 14157,
+	16192, -1000, -1000, 14157, 16192, 5280, 6333, 738, -73, 720,
+	-1000, -108, -80, 7711, 221, -1000, -1000, -1000, -1000, 4227,
+	541, 652, 374, -41, -1000, -1000, -1000, 773, -1000, 773,
+	773, 773, 773, -9, -9, -9, -9, -1000, -1000, -1000,
+	-1000, -1000, 806, 799, -1000, 773, 773, 773, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 15873, -1000, -1000, -1000,
-	-1000, -1000, 15873, -172, 524, 15873, 15873, 15873, 15873, -1000,
-	312, 312, -1000, 5783, -1000, 919, 13862, -1000, -1000, 448,
-	-1000, 10510, 1653, 1653, -1000, -1000, -1000, 448, 710, 710,
-	-1000, 710, 712, -1000, 710, 7, 710, 6, 448, 448,
-	1711, 1638, 1618, 1571, 525, -159, -1000, 304, 9508, -1000,
-	1523, 1471, -1000, 871, 554, 545, -1000, -1000, 7826, 448,
-	620, 146, 615, -1000, 913, 15873, 9508, -1000, -1000, 9508,
-	711, -1000, 9508, -1000, -1000, -1000, 525, 525, 525, 615,
-	892, 304, -1000, -1000, -1000, -1000, 3701, -1000, 606, -1000,
-	710, -1000, -1000, -1000, 15873, -36, 939, 1653, -1000, -1000,
-	-1000, -1000, -1000, -10, 430, -10, 337, -1000, 326, 4395,
-	-1000, -1000, -1000, -1000, 876, -1000, 5783, -1000, -1000, 699,
-	746, -1000, -1000, -1000, -1000, 917, 581, -1000, 1653, -1000,
-	-1000, 122, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	10510, 10510, 10510, 10510, 10510, 892, 400, 304, 10510, 10510,
-	867, -1000, 525, -1000, -1000, 714, 15873, 15873, -1000, 15873,
-	892, -1000, 304, 304, 15873, 304, 13528, 15873, 15873, 11846,
-	-1000, 166, 15873, -1000, 589, -1000, 194, -1000, -125, 171,
-	-1000, 171, 475, 472, -1000, 525, 560, -1000, 217, 15873,
-	15873, 915, 894, -1000, -1000, 492, 492, 492, 492, 58,
-	448, -1000, 492, 492, 938, -1000, 525, -1000, 708, 139,
-	-1000, -1000, -1000, 587, 569, -1000, 569, 569, 174, 166,
-	-1000, 524, 215, 398, -1000, 63, 15873, 281, 866, -1000,
-	863, -1000, -1000, -1000, -1000, -1000, 40, 5783, 4048, 563,
-	-1000, -1000, 9508, 9508, -1000, -1000, -1000, -1000, 448, 47,
-	-176, -1000, -1000, -1000, 15873, 545, 448, 15873, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 322, -1000, -1000, 15873, -1000,
-	-1000, 386, -1000, -1000, 503, -1000, 15873, -1000, -1000, 588,
-	304, 529, -1000, 819, -170, -182, 517, -1000, -1000, -1000,
-	698, -1000, -1000, 40, 830, -172, -1000, 806, -1000, 15873,
-	-1000, 37, -1000, -174, 489, 35, -177, 765, 525, -183,
-	754, -1000, 925, 9842, -1000, -1000, 927, 177, 177, 492,
-	448, -1000, -1000, -1000, 70, 457, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 791, 791, 791, 778,
+	778, 816, -1000, 16192, 4578, 955, 4578, -1000, 81, -1000,
+	-1000, -1000, 16192, 16192, 16192, 16192, 16192, 157, 16192, 16192,
+	737, -1000, 16192, 4578, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 518, -1000, -1000, -1000, -1000, -1000, -1000, 16192,
+	-1000, -1000, -1000, -1000, 16192, 338, 16192, 16192, 518, -1000,
+	534, 16192, 16192, -1000, -1000, 896, 9751, 9751, 5982, 9751,
+	-1000, -1000, -1000, 933, -1000, 929, 981, -1000, 910, 907,
+	8387, -1000, -1000, 259, 377, -1000, -1000, 376, -1000, -1000,
+	-1000, -1000, 191, 745, -1000, 2118, -1000, -1000, -1000, -1000,
+	531, 10765, 10765, 10765, 119, 2118, 2068, 1613, 1162, 222,
+	303, 303, 236, 236, 236, 236, 236, 333, 333, -1000,
+	-1000, -1000, 563, -1000, -1000, -1000, 563, 8387, 8387, 736,
+	-1000, -1000, 9751, -1000, 563, 651, 651, 529, 469, 283,
+	1013, 651, 277, 1006, 651, 651, 8387, 331, -1000, 9751,
+	563, -1000, 182, -1000, 1489, 723, 722, 651, 563, 563,
+	651, 651, 727, 745, -1000, 16192, 14157, 14157, 14157, 14157,
+	14157, -1000, 876, 874, -1000, 851, 849, 881, 16192, -1000,
+	660, 12467, 190, 745, -1000, 14495, -1000, -1000, 995, 14157,
+	668, -1000, 668, -1000, 177, -1000, -1000, 720, -73, -74,
+	-1000, -1000, -1000, -1000, 518, -1000, 602, 718, 3876, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 789, 565, -1000, 945,
+	261, 270, 565, 943, -1000, -1000, -1000, 937, -1000, 373,
+	-43, -1000, -1000, 432, -9, -9, -1000, -1000, 221, 923,
+	221, 221, 221, 527, 527, -1000, -1000, -1000, -1000, 431,
+	-1000, -1000, -1000, 424, -1000, 836, 16192, 4578, -1000, -1000,
+	-1000, -1000, 325, 325, 253, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 71, 812, -1000, -1000,
+	-1000, -1000, 21, 40, 121, -1000, 4578, -1000, 390, 390,
+	-1000, -1000, -1000, -1000, -1000, -1000, 892, 518, 518, 175,
+	-1000, -1000, 16192, -1000, -1000, -1000, -1000, 731, -1000, -1000,
+	-1000, 4929, 8387, -1000, 119, 2118, 2004, -1000, 10765, 10765,
+	-1000, -208, 651, 651, 8387, 518, -1000, -1000, -1000, 168,
+	577, 168, 10765, 10765, -1000, 10765, 10765, -1000, -167, 619,
+	311, -1000, 9751, 299, -1000, 5982, -1000, 10765, 10765, -1000,
+	-1000, -1000, -1000, -1000, 835, 16192, 745, -1000, 12467, 16192,
+	713, -1000, 281, 792, 796, 834, 894, -1000, -1000, -1000,
+	-1000, 873, -1000, 850, -1000, -1000, -1000, -1000, -1000, 130,
+	129, 126, 16192, -1000, 986, 9751, 668, -1000, -1000, 203,
+	-1000, -1000, -137, -135, -1000, -1000, -1000, 4227, -1000, 4227,
+	16192, 90, -1000, 565, 565, -1000, -1000, -1000, 788, 831,
+	10765, -1000, -1000, -1000, 610, 221, 221, -1000, 479, -1000,
+	-1000, -1000, 649, -1000, 647, 715, 644, 16192, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 16192, -1000, -1000,
+	-1000, -1000, -1000, 16192, -174, 565, 16192, 16192, 16192, 16192,
+	-1000, 338, 338, -1000, 5982, -1000, 995, 14157, -1000, -1000,
+	563, -1000, 10765, 2118, 2118, -1000, 745, -1000, -1000, -1000,
+	563, 773, 773, -1000, 773, 778, -1000, 773, 8, 773,
+	7, 563, 563, 1923, 1824, 1735, 322, 745, -161, -1000,
+	518, 9751, -1000, 1762, 1694, -1000, 948, 676, 704, -1000,
+	-1000, 8049, 563, 625, 153, 622, -1000, 986, 16192, 9751,
+	-1000, -1000, 9751, 776, -1000, 9751, -1000, -1000, -1000, 745,
+	745, 745, 622, 973, 518, -1000, -1000, -1000, -1000, 3876,
+	-1000, 615, -1000, 773, -1000, -1000, -1000, 16192, -37, 1031,
+	2118, -1000, -1000, -1000, -1000, -1000, -9, 514, -9, 399,
+	-1000, 393, 4578, -1000, -1000, -1000, -1000, 951, -1000, 5982,
+	-1000, -1000, 772, 813, -1000, -1000, -1000, -1000, 993, 710,
+	-1000, 2118, 70, -1000, -1000, 127, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 10765, 10765, 10765, 10765, 10765, 973,
+	488, 518, 10765, 10765, 942, -1000, 745, -1000, -1000, 657,
+	16192, 16192, -1000, 16192, 973, -1000, 518, 518, 16192, 518,
+	13819, 16192, 16192, 12117, -1000, 200, 16192, -1000, 613, -1000,
+	246, -1000, -100, 221, -1000, 221, 587, 569, -1000, 745,
+	709, -1000, 274, 16192, 16192, 989, 978, 986, 969, -1000,
+	-1000, 1489, 1489, 1489, 1489, 25, 563, -1000, 1489, 1489,
+	1029, -1000, 745, -1000, 767, 149, -1000, -1000, -1000, 601,
+	593, -1000, 593, 593, 190, 200, -1000, 565, 269, 486,
+	-1000, 87, 16192, 385, 940, -1000, 939, -1000, -1000, -1000,
+	-1000, -1000, 56, 5982, 4227, 590, -1000, -1000, 9751, 9751,
+	563, 9751, -1000, -1000, -1000, -1000, 563, 49, -178, -1000,
+	-1000, -1000, 16192, 704, 563, 16192, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 379, -1000, -1000, 16192, -1000, -1000, 481,
+	-1000, -1000, 574, -1000, 16192, -1000, -1000, 812, 518, 700,
+	-1000, 700, -1000, 891, -172, -185, 691, -1000, -1000, -1000,
+	770, -1000, -1000, 56, 898, -174, -1000, 890, -1000, 16192,
+	-1000, 52, -1000, -176, 550, 48, -179, 822, 745, -186,
+	819, -1000, 1021, 10089, -1000, -1000, 1027, 201, 201, 1489,
+	563, -1000, -1000, -1000, 94, 487, -1000, -1000, -1000, -1000,
 	-1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1156, 57, 557, 1155, 1154, 1153, 1151, 1150, 1148,
-	1147, 1146, 1145, 1144, 1143, 1141, 1140, 1138, 1136, 1132,
-	1127, 1125, 1124, 1123, 1122, 1121, 89, 1120, 1119, 1118,
-	69, 1117, 70, 1115, 1112, 47, 114, 34, 43, 1634,
-	1111, 22, 54, 63, 1110, 28, 1108, 1107, 76, 1106,
-	1102, 52, 1101, 1098, 77, 1096, 65, 1095, 12, 35,
-	1094, 1093, 1089, 1088, 75, 419, 1087, 1086, 15, 1085,
-	1084, 99, 1083, 55, 6, 14, 13, 19, 1082, 1126,
-	9, 1080, 53, 1078, 1077, 1076, 1075, 26, 1074, 61,
-	1073, 17, 56, 1071, 40, 64, 31, 25, 5, 78,
-	73, 1070, 23, 62, 50, 1068, 1067, 476, 1066, 1065,
-	44, 1064, 1062, 1061, 30, 1060, 90, 491, 1059, 1058,
-	1038, 1034, 46, 704, 1264, 130, 66, 1032, 1030, 1029,
-	2331, 42, 51, 18, 1025, 37, 1482, 33, 1024, 1023,
-	41, 1022, 1021, 1020, 1018, 1017, 1016, 1015, 94, 1011,
-	1010, 1008, 108, 24, 1006, 1005, 60, 20, 1003, 999,
-	997, 48, 59, 985, 984, 49, 981, 980, 21, 976,
-	975, 974, 973, 970, 29, 7, 968, 16, 967, 8,
-	964, 27, 963, 3, 962, 10, 959, 2, 0, 958,
-	11, 45, 1, 957, 4, 955, 954, 1324, 1407, 79,
-	953, 80,
+	0, 1234, 33, 518, 1233, 1230, 1229, 821, 818, 799,
+	1226, 1225, 1224, 1223, 1221, 1220, 1214, 1213, 1212, 1211,
+	1210, 1208, 1207, 1206, 1205, 1204, 1203, 1201, 1192, 86,
+	1189, 1186, 1185, 68, 1184, 70, 1183, 1181, 37, 65,
+	30, 44, 11, 1180, 38, 54, 64, 1179, 40, 1178,
+	1177, 75, 1176, 1175, 52, 1171, 1170, 2073, 1169, 76,
+	1168, 12, 45, 1167, 1166, 1165, 1163, 72, 260, 1162,
+	1161, 17, 1160, 1157, 92, 1156, 55, 4, 14, 27,
+	24, 1155, 100, 26, 1147, 57, 1146, 1143, 1141, 1140,
+	1138, 1137, 18, 1136, 60, 1135, 32, 59, 1134, 7,
+	67, 29, 25, 5, 81, 71, 1129, 20, 66, 50,
+	1124, 1123, 493, 1122, 1120, 46, 1119, 1118, 1117, 79,
+	1116, 90, 559, 1115, 1114, 1113, 1112, 62, 728, 1709,
+	237, 69, 1110, 1107, 1106, 2461, 63, 53, 16, 1105,
+	56, 304, 43, 1104, 1101, 36, 1100, 1099, 1098, 1097,
+	1095, 1094, 1092, 102, 1091, 1090, 1089, 19, 21, 1088,
+	1087, 58, 28, 1086, 1085, 1084, 48, 61, 1082, 1081,
+	51, 1079, 1078, 22, 1076, 1075, 1074, 1073, 1071, 31,
+	10, 1070, 15, 1069, 8, 1068, 23, 1067, 9, 1065,
+	13, 1064, 3, 0, 1056, 6, 49, 1, 1055, 2,
+	1051, 1049, 1231, 1127, 82, 1046, 1043, 1032, 83,
 }
 var yyR1 = [...]int{
 
-	0, 195, 196, 196, 1, 1, 1, 1, 1, 1,
+	0, 200, 201, 201, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 188, 188, 188, 2, 2, 2,
-	6, 3, 4, 4, 5, 5, 7, 7, 29, 29,
-	8, 9, 9, 9, 9, 199, 199, 48, 48, 49,
-	49, 95, 95, 10, 10, 10, 10, 100, 100, 104,
-	104, 104, 105, 105, 105, 105, 138, 138, 11, 11,
-	11, 11, 11, 11, 11, 190, 190, 189, 187, 187,
-	186, 186, 185, 17, 170, 172, 172, 171, 171, 171,
-	171, 162, 141, 141, 141, 141, 144, 144, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 143, 143, 143,
-	143, 143, 145, 145, 145, 145, 145, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 147, 147, 147, 147, 147, 147, 147, 147,
-	161, 161, 148, 148, 156, 156, 157, 157, 157, 154,
-	154, 155, 155, 158, 158, 158, 150, 150, 151, 151,
-	159, 159, 152, 152, 152, 153, 153, 153, 160, 160,
-	160, 160, 160, 149, 149, 163, 163, 180, 180, 179,
-	179, 179, 169, 169, 176, 176, 176, 176, 176, 166,
-	166, 166, 167, 167, 165, 165, 168, 168, 178, 178,
-	177, 164, 164, 181, 181, 181, 181, 193, 194, 192,
-	192, 192, 192, 192, 173, 173, 173, 174, 174, 174,
-	175, 175, 175, 12, 12, 12, 12, 12, 12, 12,
+	1, 1, 1, 1, 1, 1, 1, 193, 193, 193,
+	2, 2, 2, 6, 3, 4, 4, 5, 5, 7,
+	7, 32, 32, 8, 9, 9, 9, 9, 204, 204,
+	51, 51, 52, 52, 100, 100, 10, 10, 10, 10,
+	105, 105, 109, 109, 109, 110, 110, 110, 110, 143,
+	143, 11, 11, 11, 11, 11, 11, 11, 195, 195,
+	194, 192, 192, 191, 191, 190, 17, 175, 177, 177,
+	176, 176, 176, 176, 167, 146, 146, 146, 146, 149,
+	149, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	148, 148, 148, 148, 148, 150, 150, 150, 150, 150,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 152, 152, 152, 152, 152,
+	152, 152, 152, 166, 166, 153, 153, 161, 161, 162,
+	162, 162, 159, 159, 160, 160, 163, 163, 163, 155,
+	155, 156, 156, 164, 164, 157, 157, 157, 158, 158,
+	158, 165, 165, 165, 165, 165, 154, 154, 168, 168,
+	185, 185, 184, 184, 184, 174, 174, 181, 181, 181,
+	181, 181, 171, 171, 171, 172, 172, 170, 170, 173,
+	173, 183, 183, 182, 169, 169, 186, 186, 186, 186,
+	198, 199, 197, 197, 197, 197, 197, 178, 178, 178,
+	179, 179, 179, 180, 180, 180, 12, 12, 12, 12,
 	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 184, 182, 182, 183, 183, 13,
-	18, 18, 14, 14, 14, 14, 14, 15, 15, 19,
+	12, 12, 12, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 189, 187, 187,
+	188, 188, 13, 18, 18, 14, 14, 14, 14, 14,
+	15, 15, 19, 20, 20, 20, 20, 20, 20, 20,
 	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
 	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 111, 111, 113,
-	113, 109, 109, 112, 112, 110, 110, 110, 114, 114,
-	114, 115, 115, 139, 139, 139, 21, 21, 23, 23,
-	24, 25, 22, 22, 22, 22, 22, 22, 22, 16,
-	200, 26, 27, 27, 28, 28, 28, 32, 32, 32,
-	30, 30, 30, 31, 31, 37, 37, 36, 36, 38,
-	38, 38, 38, 127, 127, 127, 126, 126, 40, 40,
-	41, 41, 42, 42, 43, 43, 43, 43, 57, 57,
-	94, 94, 96, 96, 44, 44, 44, 44, 45, 45,
-	46, 46, 47, 47, 134, 134, 133, 133, 133, 132,
-	132, 50, 50, 50, 52, 51, 51, 51, 51, 53,
-	53, 55, 55, 54, 54, 56, 58, 58, 58, 58,
-	58, 59, 59, 39, 39, 39, 39, 39, 39, 39,
-	108, 108, 61, 61, 60, 60, 60, 60, 60, 60,
-	60, 60, 60, 60, 72, 72, 72, 72, 72, 72,
-	62, 62, 62, 62, 62, 62, 62, 35, 35, 73,
-	73, 73, 79, 74, 74, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 69, 69, 69,
-	69, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 68, 68, 68, 68, 68, 68,
+	116, 116, 118, 118, 114, 114, 117, 117, 115, 115,
+	115, 119, 119, 119, 120, 120, 144, 144, 144, 21,
+	21, 24, 24, 25, 26, 26, 205, 205, 206, 206,
+	27, 28, 23, 23, 23, 23, 22, 22, 22, 22,
+	22, 22, 22, 16, 207, 29, 30, 30, 31, 31,
+	31, 35, 35, 35, 33, 33, 33, 34, 34, 40,
+	40, 39, 39, 41, 41, 41, 41, 132, 132, 132,
+	131, 131, 43, 43, 44, 44, 45, 45, 46, 46,
+	46, 46, 60, 60, 99, 99, 101, 101, 47, 47,
+	47, 47, 48, 48, 49, 49, 50, 50, 139, 139,
+	138, 138, 138, 137, 137, 53, 53, 53, 55, 54,
+	54, 54, 54, 56, 56, 58, 58, 57, 57, 59,
+	61, 61, 61, 61, 61, 62, 62, 42, 42, 42,
+	42, 42, 42, 42, 113, 113, 64, 64, 63, 63,
+	63, 63, 63, 63, 63, 63, 63, 63, 75, 75,
+	75, 75, 75, 75, 65, 65, 65, 65, 65, 65,
+	65, 38, 38, 76, 76, 76, 82, 77, 77, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
 	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	201, 201, 71, 70, 70, 70, 70, 70, 70, 70,
-	33, 33, 33, 33, 33, 137, 137, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
-	83, 83, 34, 34, 81, 81, 82, 84, 84, 80,
-	80, 80, 64, 64, 64, 64, 64, 64, 64, 64,
-	66, 66, 66, 85, 85, 86, 86, 87, 87, 88,
-	88, 89, 90, 90, 90, 91, 91, 91, 91, 92,
-	92, 92, 63, 63, 63, 63, 63, 63, 93, 93,
-	93, 93, 97, 97, 75, 75, 77, 77, 76, 78,
-	98, 98, 102, 99, 99, 103, 103, 103, 103, 101,
-	101, 101, 129, 129, 129, 106, 106, 116, 116, 117,
-	117, 107, 107, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 119, 119, 119, 120, 120, 121, 121,
-	121, 128, 128, 124, 124, 125, 125, 130, 130, 131,
-	131, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	197, 198, 135, 136, 136, 136,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 72, 72, 72, 72, 72, 90, 89, 89, 70,
+	70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
+	70, 70, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 208, 208,
+	74, 73, 73, 73, 73, 73, 73, 73, 36, 36,
+	36, 36, 36, 142, 142, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 86, 86,
+	37, 37, 84, 84, 85, 87, 87, 83, 83, 83,
+	67, 67, 67, 67, 67, 67, 67, 67, 69, 69,
+	69, 88, 88, 91, 91, 92, 92, 93, 93, 94,
+	95, 95, 95, 96, 96, 96, 96, 97, 97, 97,
+	66, 66, 66, 66, 66, 66, 98, 98, 98, 98,
+	102, 102, 78, 78, 80, 80, 79, 81, 103, 103,
+	107, 104, 104, 108, 108, 108, 108, 106, 106, 106,
+	134, 134, 134, 111, 111, 121, 121, 122, 122, 112,
+	112, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 124, 124, 124, 125, 125, 126, 126, 126, 133,
+	133, 129, 129, 130, 130, 135, 135, 136, 136, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	202, 203, 140, 141, 141, 141,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 4, 6, 7,
-	5, 10, 1, 3, 1, 3, 7, 8, 1, 1,
-	9, 8, 7, 6, 6, 1, 1, 1, 3, 1,
-	3, 0, 4, 3, 4, 5, 4, 1, 3, 3,
-	2, 2, 2, 2, 2, 1, 1, 1, 2, 2,
-	8, 4, 6, 5, 5, 0, 2, 1, 0, 2,
-	1, 3, 3, 4, 4, 2, 4, 1, 3, 3,
-	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
-	2, 2, 1, 2, 2, 2, 1, 4, 4, 2,
-	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
-	1, 0, 1, 0, 1, 2, 0, 2, 0, 3,
-	0, 1, 0, 3, 3, 0, 2, 2, 0, 2,
-	1, 2, 1, 0, 2, 5, 4, 1, 2, 2,
-	3, 2, 0, 1, 2, 3, 3, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 3,
-	2, 3, 1, 10, 11, 11, 12, 3, 3, 1,
-	1, 2, 2, 2, 0, 1, 3, 1, 2, 3,
-	1, 1, 1, 6, 7, 7, 7, 7, 4, 5,
-	4, 4, 7, 5, 5, 5, 12, 7, 5, 9,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	4, 6, 7, 5, 10, 1, 3, 1, 3, 7,
+	8, 1, 1, 9, 8, 7, 6, 6, 1, 1,
+	1, 3, 1, 3, 0, 4, 3, 4, 5, 4,
+	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
+	1, 2, 2, 8, 4, 6, 5, 5, 0, 2,
+	1, 0, 2, 1, 3, 3, 4, 4, 2, 4,
+	1, 3, 3, 3, 8, 3, 1, 1, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
-	2, 7, 2, 3, 4, 3, 7, 5, 4, 2,
-	4, 4, 3, 3, 5, 2, 3, 1, 1, 0,
-	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
-	2, 0, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 3, 2,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 1, 0, 1, 0, 1, 1, 3, 1,
-	2, 3, 5, 0, 1, 2, 1, 1, 0, 2,
-	1, 3, 1, 1, 1, 3, 1, 3, 3, 7,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 2, 3, 1, 2,
-	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
-	4, 5, 6, 2, 1, 2, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
-	2, 2, 3, 1, 1, 1, 1, 4, 5, 5,
-	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
-	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
-	0, 2, 3, 4, 4, 4, 4, 4, 4, 4,
-	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
-	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
-	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
-	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
-	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
-	2, 4, 2, 1, 3, 5, 4, 6, 1, 3,
-	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
-	1, 3, 3, 1, 3, 3, 3, 3, 3, 1,
-	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
-	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 1, 0, 1,
-	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 1, 2, 2, 2, 1,
+	4, 4, 2, 2, 3, 3, 3, 3, 1, 1,
+	1, 1, 1, 6, 6, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 0, 3, 0, 5, 0,
+	3, 5, 0, 1, 0, 1, 0, 1, 2, 0,
+	2, 0, 3, 0, 1, 0, 3, 3, 0, 2,
+	2, 0, 2, 1, 2, 1, 0, 2, 5, 4,
+	1, 2, 2, 3, 2, 0, 1, 2, 3, 3,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 3, 2, 3, 1, 10, 11, 11, 12,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 3,
+	1, 2, 3, 1, 1, 1, 6, 7, 7, 7,
+	7, 4, 5, 4, 4, 7, 5, 5, 5, 12,
+	7, 5, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 7, 1, 3,
+	8, 8, 3, 3, 5, 4, 6, 5, 4, 4,
+	3, 2, 3, 4, 4, 3, 4, 4, 4, 4,
+	4, 4, 3, 2, 7, 2, 3, 4, 3, 7,
+	5, 4, 2, 4, 4, 3, 3, 5, 2, 3,
+	1, 1, 0, 1, 0, 1, 1, 1, 0, 2,
+	2, 0, 2, 2, 0, 2, 0, 1, 1, 2,
+	1, 1, 2, 1, 1, 5, 0, 1, 0, 1,
+	2, 3, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 3, 3, 2, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
+	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
+	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
+	1, 3, 3, 7, 1, 3, 1, 3, 4, 4,
+	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
+	3, 2, 3, 2, 2, 2, 1, 1, 3, 3,
+	0, 5, 4, 5, 5, 0, 2, 1, 3, 3,
+	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
+	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 4, 5, 5, 6, 5, 5, 0, 3, 4,
+	4, 6, 6, 6, 8, 8, 8, 8, 9, 8,
+	5, 4, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 8, 8, 0, 2,
+	3, 4, 4, 4, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
+	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
+	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 0, 3, 0, 2, 0, 3, 1, 3, 2,
+	0, 1, 1, 0, 2, 4, 4, 0, 2, 4,
+	2, 1, 3, 5, 4, 6, 1, 3, 3, 5,
+	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
+	3, 1, 3, 3, 3, 3, 3, 1, 2, 1,
+	1, 1, 1, 1, 1, 0, 2, 0, 3, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 1, 1, 0, 1, 1, 0,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2893,321 +2947,327 @@ var yyR2 = [...]int{
 }
 var yyChk = [...]int{
 
-	-1000, -195, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 118, 119, 121, 120, 154, 122, 147, 51, 168,
-	169, 171, 172, 25, 148, 149, 152, 153, 31, 32,
-	124, -197, 8, 254, 55, -196, 352, -87, 15, -28,
-	5, -26, -200, -26, -26, -26, -26, -26, -170, -172,
-	55, 93, -121, 128, 75, 246, 125, 126, 132, -124,
-	-188, -123, 58, 59, 60, 264, 140, 296, 297, 168,
-	179, 173, 200, 192, 265, 298, 141, 190, 193, 233,
-	138, 299, 220, 227, 69, 171, 242, 300, 150, 188,
-	184, 301, 273, 182, 27, 302, 229, 205, 303, 269,
-	231, 183, 228, 124, 304, 143, 136, 305, 206, 210,
-	306, 234, 307, 308, 309, 177, 178, 310, 139, 236,
-	204, 137, 33, 266, 36, 158, 237, 208, 311, 203,
-	199, 312, 313, 314, 315, 202, 176, 198, 40, 212,
-	211, 213, 232, 195, 316, 317, 318, 144, 319, 185,
-	18, 320, 321, 322, 323, 324, 240, 153, 325, 156,
-	326, 327, 328, 329, 330, 331, 230, 207, 209, 133,
-	160, 268, 332, 238, 181, 333, 145, 157, 152, 241,
-	146, 334, 335, 336, 337, 338, 339, 340, 172, 341,
-	342, 343, 344, 167, 235, 244, 39, 217, 345, 175,
-	135, 346, 169, 164, 222, 196, 159, 347, 348, 186,
-	187, 201, 174, 197, 170, 161, 154, 349, 243, 218,
-	270, 194, 191, 165, 350, 162, 163, 351, 223, 224,
-	166, 267, 239, 189, 219, -107, 128, 246, 125, 224,
-	130, 126, 126, 127, 128, 246, 125, 126, -54, -130,
-	-188, -123, 128, 126, 111, 193, 233, 118, 221, 229,
-	-113, 230, 160, -139, 126, -109, 220, 223, 224, 166,
-	-188, 231, 235, 234, 225, -130, 170, -135, -135, -135,
-	-135, -135, 222, 222, -135, -2, -91, 17, 16, -5,
-	-3, -197, 6, 20, 21, -32, 41, 42, -27, -38,
-	102, -39, -130, -60, 77, -65, 29, -188, -123, 23,
-	-64, -61, -80, -78, -79, 111, 112, 113, 100, 101,
-	108, 78, 114, -69, -67, -68, -70, 62, 61, 70,
-	63, 64, 65, 66, 71, 72, 73, -124, -76, -197,
-	45, 46, 255, 256, 257, 258, 263, 259, 80, 35,
-	245, 253, 252, 251, 249, 250, 247, 248, 261, 262,
-	131, 246, 125, 106, 254, -107, -107, 11, -48, -49,
-	-54, -56, -130, -99, -138, 170, -103, 235, 234, -125,
-	-101, -124, -122, 233, 193, 232, 123, 271, 76, 22,
-	24, 215, 79, 111, 16, 80, 110, 255, 118, 49,
-	272, 247, 248, 245, 257, 258, 246, 221, 29, 10,
-	274, 25, 148, 21, 34, 104, 120, 83, 84, 151,
-	23, 149, 73, 277, 19, 52, 11, 13, 278, 279,
-	14, 131, 130, 95, 127, 47, 8, 114, 26, 92,
-	43, 280, 28, 281, 282, 283, 284, 45, 93, 17,
-	249, 250, 31, 285, 263, 155, 106, 50, 37, 77,
-	286, 287, 71, 288, 74, 53, 75, 15, 48, 289,
-	290, 291, 292, 94, 121, 254, 46, 293, 125, 6,
-	260, 30, 147, 44, 294, 126, 82, 261, 262, 129,
-	72, 5, 132, 32, 9, 51, 54, 251, 252, 253,
-	35, 81, 12, 295, -171, 93, -162, -188, -54, 127,
-	-54, 254, -117, 131, -117, -117, 126, -54, -188, -188,
-	118, 120, 123, 53, -18, -54, -116, 131, -188, -116,
-	-116, -116, -54, 115, -54, -188, 30, -114, 93, 12,
-	246, -188, 160, 126, 161, 128, -136, -197, -125, -166,
-	127, 33, 139, -136, 164, 165, -136, -112, -111, 227,
-	228, 222, 226, 12, 165, 222, 163, -136, -135, -135,
-	-198, 57, -92, 19, 31, -39, -130, -88, -89, -39,
-	-87, -2, -26, 37, -30, 21, 34, 68, 11, -127,
-	76, 75, 92, -126, 22, -124, 62, 115, -39, -62,
-	95, 77, 93, 94, 79, 97, 96, 107, 100, 101,
-	102, 103, 104, 105, 106, 98, 99, 110, 85, 86,
-	87, 88, 89, 90, 91, -108, -197, -79, -197, 116,
-	117, -65, -65, -65, -65, -65, -65, -65, -65, -197,
-	-2, -74, -39, -197, -197, -197, -197, -197, -197, -197,
-	-197, -197, -83, -39, -197, -201, -71, -197, -201, -71,
-	-201, -71, -201, -197, -201, -71, -201, -71, -201, -201,
-	-71, -197, -197, -197, -197, -197, -197, -197, -55, 26,
-	-54, -41, -42, -43, -44, -57, -79, -197, -54, -54,
-	-48, -199, 56, 11, 54, -199, 56, 115, 56, -99,
-	170, -100, -104, 236, 238, 85, -129, -124, 62, 29,
-	30, 57, 56, -54, -141, -144, -146, -145, -147, -142,
-	-143, 190, 191, 111, 194, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 30, 150, 186, 187, 188,
-	189, 206, 207, 208, 209, 210, 211, 212, 213, 173,
-	192, 265, 174, 175, 176, 177, 178, 179, 181, 182,
-	183, 184, 185, -188, -136, 128, -188, 77, -188, -54,
-	-54, -136, -136, -136, 162, 162, 126, 126, 167, -54,
-	56, 129, -48, 23, 53, -54, -188, -188, -131, -130,
-	-122, -136, -114, 62, -39, -136, -136, -136, -54, -136,
-	-136, -167, 11, 95, -136, -136, 11, -110, 11, 95,
-	-39, -115, 93, 53, 9, 95, 56, 18, 115, 56,
-	-90, 24, 25, -91, -198, -32, -66, -124, 63, 66,
-	-31, 44, -54, -39, -39, -72, 71, 77, 72, 73,
-	-126, 102, -131, -125, -122, -65, -73, -76, -79, 67,
-	95, 93, 94, 79, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -65, -65, -65, -65, -65, -137,
-	-188, 62, -188, -64, -64, -124, -37, 21, 34, -36,
-	-38, -198, 56, -198, -2, -36, -36, -39, -39, -80,
-	62, -36, -80, 62, -36, -36, -30, -81, -82, 81,
-	-80, -124, -130, -198, -65, -124, -124, -36, -37, -37,
-	-36, -36, -95, 156, -54, 30, 56, -50, -52, -51,
-	-53, 43, 47, 49, 44, 45, 46, 50, -134, 22,
-	-41, -197, -133, 156, -132, 22, -130, 62, -95, 54,
-	-41, -54, -41, -56, -130, 102, -103, -100, 56, 237,
-	239, 240, 53, 74, -39, -153, 110, -173, -174, -175,
-	-125, 62, 63, -162, -163, -164, -176, 142, -181, 133,
-	135, 132, -165, 143, 127, 28, 57, -158, 71, 77,
-	-154, 218, -148, 55, -148, -148, -148, -148, -152, 193,
-	-152, -152, -152, 55, 55, -148, -148, -148, -156, 55,
-	-156, -156, -157, 55, -157, -128, 54, -54, -136, 23,
-	-136, -118, 123, 120, 121, -184, 119, 215, 193, 69,
-	29, 15, 255, 156, 270, -188, 157, -54, -54, -54,
-	-54, -54, 123, 120, -54, -54, -54, -136, -54, -54,
-	-114, -130, -130, 62, -54, 39, -39, -39, -131, -89,
-	-92, -106, 19, 11, 35, 35, -36, 71, 72, 73,
-	115, -197, -73, -65, -65, -65, -35, 151, 76, -198,
-	-198, -36, -36, 56, -39, -198, -198, -198, 56, 54,
-	22, 11, 11, -198, 11, 11, -198, -198, -36, -84,
-	-82, 83, -39, -198, 115, -198, 56, 56, -198, -198,
-	-198, -198, -198, -63, 30, 35, -2, -197, -197, -98,
-	-102, -80, -42, -43, -43, -42, -43, 43, 43, 43,
-	48, 43, 48, 43, -51, -130, -198, -58, 51, 130,
-	52, -197, -132, -59, 12, -41, -59, -59, 115, -104,
-	-105, 241, 238, 244, -188, 62, 56, -175, 85, 55,
-	-188, 28, -165, -165, -168, -188, -168, 28, -150, 29,
-	71, -155, 219, 63, -152, -152, -153, 30, -153, -153,
-	-153, -161, 62, -161, 63, 63, 53, -124, -136, -135,
-	-191, 138, 134, 142, 143, 136, 58, 59, 60, 127,
-	28, 133, 135, 156, 132, -191, -119, -120, 129, 22,
-	127, 28, 156, -190, 54, 162, 215, 162, 129, -136,
-	-110, -110, 40, 115, -54, -40, 11, 102, -125, -37,
-	-35, 76, -65, -65, -198, -198, -38, -140, 111, 190,
-	150, 188, 184, 204, 195, 217, 186, 218, -137, -140,
-	-65, -65, -65, -65, 264, -87, 84, -39, 82, -125,
-	-65, -65, -97, 53, -98, -75, -77, -76, -197, -2,
-	-93, -124, -96, -124, -59, 56, 85, -46, -45, 53,
-	54, -47, 53, -45, 43, 43, 127, 127, 127, -96,
-	-87, -39, -59, 238, 242, 243, -174, -175, -178, -177,
-	-124, -181, -168, -168, 55, -151, 53, -65, 57, -153,
-	-153, -188, 111, 57, 56, 57, 56, 57, 56, -54,
-	-135, -135, -54, -135, -124, -187, 267, -189, -188, -124,
-	-124, -124, -54, -114, -114, -59, -41, -198, -65, -198,
-	-148, -148, -148, -157, -148, 178, -148, 178, -198, -198,
-	19, 19, 19, 19, -197, -34, 260, -39, 56, 56,
-	27, -97, 56, -198, -198, -198, 56, 115, -198, 56,
-	-87, -102, -39, -39, 55, -39, -197, -197, -197, -198,
-	-91, 57, 56, -148, -94, -124, -159, 215, 9, -152,
-	62, -152, 63, 63, -136, 26, -186, -185, -125, 55,
-	54, -85, 13, -152, -188, -65, -65, -65, -65, -65,
-	-91, 62, -65, -65, 28, -77, 35, -2, -197, -124,
-	-124, -124, -91, -94, -94, -198, -94, -94, -133, -180,
-	-179, 54, 137, 69, -177, 57, 56, -160, 133, 28,
-	132, -68, -153, -153, 57, 57, -197, 56, 85, -94,
-	-54, -86, 14, 16, -198, -198, -198, -198, -33, 95,
-	267, -198, -198, -198, 9, -75, -2, 115, 57, -198,
-	-198, -198, -58, -179, -188, -169, 85, 62, 145, -124,
-	-149, 69, 28, 28, -182, -183, 156, -185, -175, 57,
-	-39, -74, -198, 265, 50, 268, -98, -198, -124, 63,
-	-54, 62, -198, 56, -124, -190, 40, 266, 269, 55,
-	-183, 35, -187, 40, -94, 158, 267, 57, 159, 268,
-	-193, -194, 53, -197, 269, -194, 53, 10, 9, -65,
-	155, -192, 146, 141, 144, 30, -192, -198, -198, 140,
+	-1000, -200, -1, -2, -6, -7, -8, -9, -10, -11,
+	-12, -13, -14, -15, -19, -20, -21, -24, -25, -26,
+	-27, -28, -22, -23, -16, -3, -4, 6, 7, -32,
+	9, 10, 30, -17, 118, 119, 121, 120, 155, 122,
+	147, 51, 169, 170, 172, 173, 174, 175, 25, 148,
+	149, 153, 154, 31, 32, 150, 124, -202, 8, 258,
+	55, -201, 356, -92, 15, -31, 5, -29, -207, -29,
+	-29, -29, -29, -29, -175, -177, 55, 93, -126, 128,
+	75, 250, 125, 126, 132, -129, -193, -128, 58, 59,
+	60, 268, 140, 300, 301, 169, 183, 177, 204, 196,
+	269, 302, 141, 194, 197, 237, 138, 303, 224, 231,
+	69, 172, 246, 304, 151, 192, 188, 305, 277, 186,
+	27, 306, 233, 209, 307, 273, 235, 187, 232, 124,
+	308, 143, 136, 309, 210, 214, 310, 238, 311, 312,
+	313, 181, 182, 314, 139, 240, 208, 137, 33, 270,
+	36, 159, 241, 212, 315, 207, 203, 316, 317, 318,
+	319, 206, 180, 202, 40, 216, 215, 217, 236, 199,
+	320, 321, 322, 144, 323, 189, 18, 324, 325, 326,
+	327, 328, 244, 154, 329, 157, 330, 331, 332, 333,
+	334, 335, 234, 211, 213, 133, 161, 272, 336, 242,
+	185, 337, 145, 158, 153, 245, 146, 338, 339, 340,
+	341, 342, 343, 344, 173, 345, 346, 347, 348, 168,
+	239, 248, 39, 221, 349, 179, 135, 350, 170, 165,
+	226, 200, 160, 351, 352, 190, 191, 205, 178, 201,
+	171, 162, 155, 353, 247, 222, 274, 198, 195, 166,
+	354, 150, 163, 164, 355, 227, 228, 167, 271, 176,
+	243, 193, 223, -112, 128, 250, 125, 228, 130, 126,
+	126, 127, 128, 250, 125, 126, -57, -135, -193, -128,
+	128, 126, 111, 197, 237, 118, 225, 233, -118, 234,
+	161, -144, 126, -114, 224, 227, 228, 167, -193, 235,
+	239, 238, 229, -135, 171, -205, 176, -129, 174, -140,
+	-140, -140, -140, -140, 226, 226, -2, -7, -8, -9,
+	-140, -2, -96, 17, 16, -5, -3, -202, 6, 20,
+	21, -35, 41, 42, -30, -41, 102, -42, -135, -63,
+	77, -68, 29, -193, -128, 23, -67, -64, -83, -81,
+	-82, 111, 112, 113, 100, 101, 108, 78, 114, -72,
+	-70, -71, -73, 62, 61, 70, 63, 64, 65, 66,
+	71, 72, 73, -129, -79, -202, 45, 46, 259, 260,
+	261, 262, 267, 263, 80, 35, 249, 257, 256, 255,
+	253, 254, 251, 252, 265, 266, 131, 250, 125, 106,
+	258, -112, -112, 11, -51, -52, -57, -59, -135, -104,
+	-143, 171, -108, 239, 238, -130, -106, -129, -127, 237,
+	197, 236, 123, 275, 76, 22, 24, 219, 79, 111,
+	16, 80, 110, 259, 118, 49, 276, 251, 252, 249,
+	261, 262, 250, 225, 29, 10, 278, 25, 148, 21,
+	34, 104, 120, 83, 84, 152, 23, 149, 73, 281,
+	19, 52, 11, 13, 282, 283, 14, 131, 130, 95,
+	127, 47, 8, 114, 26, 92, 43, 284, 28, 285,
+	286, 287, 288, 45, 93, 17, 253, 254, 31, 289,
+	267, 156, 106, 50, 37, 77, 290, 291, 71, 292,
+	74, 53, 75, 15, 48, 293, 294, 295, 296, 94,
+	121, 258, 46, 297, 125, 6, 264, 30, 147, 44,
+	298, 126, 82, 265, 266, 129, 72, 5, 132, 32,
+	9, 51, 54, 255, 256, 257, 35, 81, 12, 299,
+	-176, 93, -167, -193, -57, 127, -57, 258, -122, 131,
+	-122, -122, 126, -57, -193, -193, 118, 120, 123, 53,
+	-18, -57, -121, 131, -193, -121, -121, -121, -57, 115,
+	-57, -193, 30, -119, 93, 12, 250, -193, 161, 126,
+	162, 128, -141, -202, -130, -171, 127, 33, 139, -141,
+	165, 166, -141, -117, -116, 231, 232, 226, 230, 12,
+	166, 226, 164, -141, 129, -129, -140, -140, -203, 57,
+	-97, 19, 31, -42, -135, -93, -94, -42, -92, -2,
+	-29, 37, -33, 21, 34, 68, 11, -132, 76, 75,
+	92, -131, 22, -129, 62, 115, -42, -65, 95, 77,
+	93, 94, 79, 97, 96, 107, 100, 101, 102, 103,
+	104, 105, 106, 98, 99, 110, 85, 86, 87, 88,
+	89, 90, 91, -113, -202, -82, -202, 116, 117, -68,
+	-68, -68, -68, -68, -68, -68, -68, -202, -2, -77,
+	-42, -202, -202, -202, -202, -202, -202, -202, -202, -202,
+	-86, -42, -202, -208, -74, -202, -208, -74, -208, -74,
+	-208, -202, -208, -74, -208, -74, -208, -208, -74, -202,
+	-202, -202, -202, -202, -202, -202, -58, 26, -57, -44,
+	-45, -46, -47, -60, -82, -202, -57, -57, -51, -204,
+	56, 11, 54, -204, 56, 115, 56, -104, 171, -105,
+	-109, 240, 242, 85, -134, -129, 62, 29, 30, 57,
+	56, -57, -146, -149, -151, -150, -152, -147, -148, 194,
+	195, 111, 198, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 30, 151, 190, 191, 192, 193, 210,
+	211, 212, 213, 214, 215, 216, 217, 177, 196, 269,
+	178, 179, 180, 181, 182, 183, 185, 186, 187, 188,
+	189, -193, -141, 128, -193, 77, -193, -57, -57, -141,
+	-141, -141, 163, 163, 126, 126, 168, -57, 56, 129,
+	-51, 23, 53, -57, -193, -193, -136, -135, -127, -141,
+	-119, 62, -42, -141, -141, -141, -57, -141, -141, -172,
+	11, 95, -141, -141, 11, -115, 11, 95, -42, -120,
+	93, 53, -206, 174, 9, 95, 56, 18, 115, 56,
+	-95, 24, 25, -96, -203, -35, -69, -129, 63, 66,
+	-34, 44, -57, -42, -42, -75, 71, 77, 72, 73,
+	-131, 102, -136, -130, -127, -68, -76, -79, -82, 67,
+	95, 93, 94, 79, -68, -68, -68, -68, -68, -68,
+	-68, -68, -68, -68, -68, -68, -68, -68, -68, -142,
+	-193, 62, -193, -67, -67, -129, -40, 21, 34, -39,
+	-41, -203, 56, -203, -2, -39, -39, -42, -42, -83,
+	62, -39, -83, 62, -39, -39, -33, -84, -85, 81,
+	-83, -129, -135, -203, -68, -129, -129, -39, -40, -40,
+	-39, -39, -100, 157, -57, 30, 56, -53, -55, -54,
+	-56, 43, 47, 49, 44, 45, 46, 50, -139, 22,
+	-44, -202, -138, 157, -137, 22, -135, 62, -100, 54,
+	-44, -57, -44, -59, -135, 102, -108, -105, 56, 241,
+	243, 244, 53, 74, -42, -158, 110, -178, -179, -180,
+	-130, 62, 63, -167, -168, -169, -181, 142, -186, 133,
+	135, 132, -170, 143, 127, 28, 57, -163, 71, 77,
+	-159, 222, -153, 55, -153, -153, -153, -153, -157, 197,
+	-157, -157, -157, 55, 55, -153, -153, -153, -161, 55,
+	-161, -161, -162, 55, -162, -133, 54, -57, -141, 23,
+	-141, -123, 123, 120, 121, -189, 119, 219, 197, 69,
+	29, 15, 259, 157, 274, -193, 158, -57, -57, -57,
+	-57, -57, 123, 120, -57, -57, -57, -141, -57, -57,
+	-119, -135, -135, 62, -57, -129, 39, -42, -42, -136,
+	-94, -97, -111, 19, 11, 35, 35, -39, 71, 72,
+	73, 115, -202, -76, -68, -68, -68, -38, 152, 76,
+	-203, -203, -39, -39, 56, -42, -203, -203, -203, 56,
+	54, 22, 11, 11, -203, 11, 11, -203, -203, -39,
+	-87, -85, 83, -42, -203, 115, -203, 56, 56, -203,
+	-203, -203, -203, -203, -66, 30, 35, -2, -202, -202,
+	-103, -107, -83, -45, -46, -46, -45, -46, 43, 43,
+	43, 48, 43, 48, 43, -54, -135, -203, -61, 51,
+	130, 52, -202, -137, -62, 12, -44, -62, -62, 115,
+	-109, -110, 245, 242, 248, -193, 62, 56, -180, 85,
+	55, -193, 28, -170, -170, -173, -193, -173, 28, -155,
+	29, 71, -160, 223, 63, -157, -157, -158, 30, -158,
+	-158, -158, -166, 62, -166, 63, 63, 53, -129, -141,
+	-140, -196, 138, 134, 142, 143, 136, 58, 59, 60,
+	127, 28, 133, 135, 157, 132, -196, -124, -125, 129,
+	22, 127, 28, 157, -195, 54, 163, 219, 163, 129,
+	-141, -115, -115, 40, 115, -57, -43, 11, 102, -130,
+	-40, -38, 76, -68, -68, -90, 293, -203, -203, -41,
+	-145, 111, 194, 151, 192, 188, 208, 199, 221, 190,
+	222, -142, -145, -68, -68, -68, -68, 268, -92, 84,
+	-42, 82, -130, -68, -68, -102, 53, -103, -78, -80,
+	-79, -202, -2, -98, -129, -101, -129, -62, 56, 85,
+	-49, -48, 53, 54, -50, 53, -48, 43, 43, 127,
+	127, 127, -101, -92, -42, -62, 242, 246, 247, -179,
+	-180, -183, -182, -129, -186, -173, -173, 55, -156, 53,
+	-68, 57, -158, -158, -193, 111, 57, 56, 57, 56,
+	57, 56, -57, -140, -140, -57, -140, -129, -192, 271,
+	-194, -193, -129, -129, -129, -57, -119, -119, -62, -44,
+	-203, -68, -202, -203, -153, -153, -153, -162, -153, 182,
+	-153, 182, -203, -203, 19, 19, 19, 19, -202, -37,
+	264, -42, 56, 56, 27, -102, 56, -203, -203, -203,
+	56, 115, -203, 56, -92, -107, -42, -42, 55, -42,
+	-202, -202, -202, -203, -96, 57, 56, -153, -99, -129,
+	-164, 219, 9, -157, 62, -157, 63, 63, -141, 26,
+	-191, -190, -130, 55, 54, -88, 13, -89, 157, -157,
+	-193, -68, -68, -68, -68, -68, -96, 62, -68, -68,
+	28, -80, 35, -2, -202, -129, -129, -129, -96, -99,
+	-99, -203, -99, -99, -138, -185, -184, 54, 137, 69,
+	-182, 57, 56, -165, 133, 28, 132, -71, -158, -158,
+	57, 57, -202, 56, 85, -99, -57, -91, 14, 16,
+	-92, 16, -203, -203, -203, -203, -36, 95, 271, -203,
+	-203, -203, 9, -78, -2, 115, 57, -203, -203, -203,
+	-61, -184, -193, -174, 85, 62, 145, -129, -154, 69,
+	28, 28, -187, -188, 157, -190, -180, 57, -42, -77,
+	-203, -77, -203, 269, 50, 272, -103, -203, -129, 63,
+	-57, 62, -203, 56, -129, -195, 40, 270, 273, 55,
+	-188, 35, -192, 40, -99, 159, 271, 57, 160, 272,
+	-198, -199, 53, -202, 273, -199, 53, 10, 9, -68,
+	156, -197, 146, 141, 144, 30, -197, -203, -203, 140,
 	29, 71,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	26, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 577, 0, 330, 330, 330, 330, 330, 330,
-	0, 648, 631, 0, 0, 0, 0, -2, 317, 318,
-	0, 320, 321, 952, 952, 952, 952, 952, 0, 0,
-	952, 0, 38, 39, 950, 1, 3, 585, 0, 0,
-	334, 337, 332, 0, 631, 631, 0, 0, 68, 69,
-	0, 0, 0, 939, 0, 629, 629, 629, 649, 650,
-	653, 654, 24, 25, 26, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 823,
-	824, 825, 826, 827, 828, 829, 830, 831, 832, 833,
-	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
-	844, 845, 846, 847, 848, 849, 850, 851, 852, 853,
-	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
-	864, 865, 866, 867, 868, 869, 870, 871, 872, 873,
-	874, 875, 876, 877, 878, 879, 880, 881, 882, 883,
-	884, 885, 886, 887, 888, 889, 890, 891, 892, 893,
-	894, 895, 896, 897, 898, 899, 900, 901, 902, 903,
-	904, 905, 906, 907, 908, 909, 910, 911, 912, 913,
-	914, 915, 916, 917, 918, 919, 920, 921, 922, 923,
-	924, 925, 926, 927, 928, 929, 930, 931, 932, 933,
-	934, 935, 936, 937, 938, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 0, 0, 0, 0, 0,
-	632, 0, 627, 0, 627, 627, 627, 0, 268, 403,
-	657, 658, 939, 0, 0, 0, 308, 0, 953, 280,
-	0, 282, 953, 0, 953, 0, 289, 0, 0, 295,
-	953, 300, 314, 315, 302, 316, 319, 322, 323, 324,
-	325, 326, 952, 952, 329, 32, 589, 0, 0, 577,
-	34, 0, 330, 335, 336, 340, 338, 339, 331, 0,
-	349, 353, 0, 413, 0, 418, 420, -2, -2, 0,
-	455, 456, 457, 458, 459, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 484, 485, 486, 562, 563, 564,
-	565, 566, 567, 568, 569, 422, 423, 559, 609, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 550, 0,
-	520, 520, 520, 520, 520, 520, 520, 520, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	47, 49, 403, 53, 0, 928, 613, -2, -2, 0,
-	0, 655, 656, -2, 792, -2, 661, 662, 663, 664,
-	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 677, 678, 679, 680, 681, 682, 683, 684,
-	685, 686, 687, 688, 689, 690, 691, 692, 693, 694,
-	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
-	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
-	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
-	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 738, 739, 740, 741, 742, 743, 744,
-	745, 746, 747, 748, 749, 750, 751, 752, 753, 754,
-	755, 756, 757, 758, 759, 760, 761, 762, 763, 764,
-	765, 766, 767, 768, 769, 770, 771, 772, 773, 774,
-	775, 776, 777, 778, 0, 0, 87, 0, 85, 0,
-	953, 0, 0, 0, 0, 0, 0, 953, 953, 953,
-	0, 0, 0, 0, 259, 0, 0, 0, 0, 0,
-	0, 0, 267, 0, 269, 953, 308, 272, 0, 0,
-	953, 953, 953, 0, 953, 953, 279, 954, 955, 0,
-	189, 190, 191, 283, 953, 953, 285, 0, 305, 303,
-	304, 297, 298, 0, 311, 292, 293, 296, 327, 328,
-	33, 951, 27, 0, 0, 586, 0, 578, 579, 582,
-	585, 32, 337, 0, 343, 341, 342, 333, 0, 350,
-	0, 0, 0, 354, 0, 356, 357, 0, 416, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 440, 441,
-	442, 443, 444, 445, 446, 419, 0, 433, 0, 0,
-	0, 475, 476, 477, 478, 479, 480, 481, 0, 345,
-	32, 0, 453, 0, 0, 0, 0, 0, 0, 0,
-	0, 340, 0, 551, 0, 504, 512, 0, 505, 513,
-	506, 514, 507, 0, 508, 515, 509, 516, 510, 511,
-	517, 0, 0, 0, 345, 345, 0, 0, 51, 0,
-	402, 0, 360, 362, 363, 364, -2, 0, 386, -2,
-	0, 0, 0, 45, 46, 0, 0, 0, 0, 54,
-	928, 56, 57, 0, 0, 0, 165, 622, 623, 624,
-	620, 214, 0, 0, 153, 149, 93, 94, 95, 142,
-	97, 142, 142, 142, 142, 162, 162, 162, 162, 125,
-	126, 127, 128, 129, 0, 0, 112, 142, 142, 142,
-	116, 132, 133, 134, 135, 136, 137, 138, 139, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 144, 144,
-	144, 146, 146, 651, 71, 0, 953, 0, 953, 83,
-	0, 228, 230, 231, 0, 0, 0, 0, 0, 0,
-	0, 0, 262, 628, 0, 953, 265, 266, 404, 659,
-	660, 270, 271, 309, 310, 273, 274, 275, 276, 277,
-	278, 0, 192, 193, 284, 288, 0, 308, 0, 0,
-	290, 291, 0, 0, 590, 0, 0, 0, 0, 0,
-	581, 583, 584, 589, 35, 340, 0, 570, 0, 0,
-	0, 344, 30, 414, 415, 417, 434, 0, 436, 438,
-	355, 351, 0, 560, -2, 424, 425, 449, 450, 451,
-	0, 0, 0, 0, 447, 429, 0, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 474,
-	535, 536, 0, 472, 473, 482, 0, 0, 0, 346,
-	347, 452, 0, 608, 32, 0, 0, 0, 0, 457,
-	562, 0, 457, 562, 0, 0, 0, 557, 554, 0,
-	0, 559, 0, 521, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 391, 0, 0, 394, 0, 0, 0, 0, 385,
-	0, 0, 406, 873, 387, 0, 389, 390, 411, 0,
-	411, 48, 411, 50, 0, 405, 614, 55, 0, 0,
-	60, 61, 615, 616, 617, 618, 0, 84, 215, 217,
-	220, 221, 222, 88, 89, 90, 0, 0, 202, 0,
-	0, 196, 196, 0, 194, 195, 86, 156, 154, 0,
-	151, 150, 96, 0, 162, 162, 119, 120, 165, 0,
-	165, 165, 165, 0, 0, 113, 114, 115, 107, 0,
-	108, 109, 110, 0, 111, 0, 0, 953, 73, 630,
-	74, 952, 0, 0, 643, 229, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 0, 75, 233, 235,
-	234, 238, 0, 0, 0, 260, 953, 264, 305, 305,
-	287, 306, 307, 312, 294, 0, 587, 588, 0, 580,
-	28, 0, 625, 626, 571, 572, 358, 435, 437, 439,
-	0, 345, 426, 447, 430, 0, 427, 0, 0, 421,
-	487, 0, 0, 0, 454, -2, 491, 492, 0, 0,
-	0, 0, 0, 528, 0, 0, 529, 0, 577, 0,
-	555, 0, 0, 503, 0, 522, 0, 0, 523, 524,
-	525, 526, 527, 602, 0, 0, -2, 0, 0, 411,
-	610, 0, 361, 380, 382, 0, 377, 392, 393, 395,
-	0, 397, 0, 399, 400, 365, 367, 368, 0, 0,
-	0, 0, 388, 577, 0, 411, 43, 44, 0, 58,
-	59, 0, 0, 65, 166, 167, 0, 218, 0, 0,
-	0, 184, 196, 196, 187, 197, 188, 0, 158, 0,
-	155, 92, 152, 0, 165, 165, 121, 0, 122, 123,
-	124, 0, 140, 0, 0, 0, 0, 652, 72, 223,
-	952, 240, 241, 242, 243, 244, 245, 246, 247, 248,
-	249, 250, 251, 252, 253, 952, 0, 952, 644, 645,
-	646, 647, 0, 78, 0, 0, 0, 0, 0, 263,
-	308, 308, 591, 0, 29, 411, 0, 352, 561, 0,
-	428, 0, 448, 431, 488, 489, 348, 0, 142, 142,
-	540, 142, 146, 543, 142, 545, 142, 548, 0, 0,
-	0, 0, 0, 0, 0, 552, 502, 558, 0, 560,
-	0, 0, 36, 0, 602, 592, 604, 606, 0, 32,
-	0, 598, 0, 372, 577, 0, 0, 374, 381, 0,
-	0, 375, 0, 376, 396, 398, 0, 0, 0, 0,
-	585, 412, 42, 62, 63, 64, 216, 219, 0, 198,
-	142, 201, 185, 186, 0, 160, 0, 157, 143, 117,
-	118, 163, 164, 162, 0, 162, 0, 147, 0, 953,
-	224, 225, 226, 227, 0, 232, 0, 76, 77, 0,
-	0, 237, 261, 281, 286, 573, 359, 490, 432, 493,
-	537, 162, 541, 542, 544, 546, 547, 549, 495, 494,
-	0, 0, 0, 0, 0, 585, 0, 556, 0, 0,
-	0, 37, 0, 607, -2, 0, 0, 0, 52, 0,
-	585, 611, 612, 378, 0, 383, 0, 0, 0, 386,
-	41, 176, 0, 200, 0, 370, 168, 161, 0, 165,
-	141, 165, 0, 0, 70, 0, 79, 80, 0, 0,
-	0, 575, 0, 538, 539, 0, 0, 0, 0, 530,
-	0, 553, 0, 0, 0, 605, 0, -2, 0, 600,
-	599, 373, 40, 0, 0, 408, 0, 0, 406, 175,
-	177, 0, 182, 0, 199, 0, 0, 173, 0, 170,
-	172, 159, 130, 131, 145, 148, 0, 0, 0, 0,
-	239, 31, 0, 0, 496, 498, 497, 499, 0, 0,
-	0, 501, 518, 519, 0, 595, 32, 0, 379, 407,
-	409, 410, 369, 178, 179, 0, 183, 181, 0, 371,
-	91, 0, 169, 171, 0, 255, 0, 81, 82, 75,
-	576, 574, 500, 0, 0, 0, 603, -2, 601, 180,
-	0, 174, 254, 0, 0, 78, 531, 0, 534, 0,
-	256, 0, 236, 532, 0, 0, 0, 203, 0, 0,
-	204, 205, 0, 0, 533, 206, 0, 0, 0, 0,
-	0, 207, 209, 210, 0, 0, 208, 257, 258, 211,
-	212, 213,
+	21, 22, 23, 24, 25, 595, 0, 344, 344, 344,
+	344, 344, 344, 0, 666, 649, 0, 0, 0, 0,
+	-2, 320, 321, 0, 323, -2, 0, 0, 972, 972,
+	972, 972, 972, 0, 0, 0, 972, 0, 41, 42,
+	970, 1, 3, 603, 0, 0, 348, 351, 346, 0,
+	649, 649, 0, 0, 71, 72, 0, 0, 0, 958,
+	0, 647, 647, 647, 667, 668, 671, 672, 27, 28,
+	29, 797, 798, 799, 800, 801, 802, 803, 804, 805,
+	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
+	816, 817, 818, 819, 820, 821, 822, 823, 824, 825,
+	826, 827, 828, 829, 830, 831, 832, 833, 834, 835,
+	836, 837, 838, 839, 840, 841, 842, 843, 844, 845,
+	846, 847, 848, 849, 850, 851, 852, 853, 854, 855,
+	856, 857, 858, 859, 860, 861, 862, 863, 864, 865,
+	866, 867, 868, 869, 870, 871, 872, 873, 874, 875,
+	876, 877, 878, 879, 880, 881, 882, 883, 884, 885,
+	886, 887, 888, 889, 890, 891, 892, 893, 894, 895,
+	896, 897, 898, 899, 900, 901, 902, 903, 904, 905,
+	906, 907, 908, 909, 910, 911, 912, 913, 914, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 927, 928, 929, 930, 931, 932, 933, 934, 935,
+	936, 937, 938, 939, 940, 941, 942, 943, 944, 945,
+	946, 947, 948, 949, 950, 951, 952, 953, 954, 955,
+	956, 957, 959, 960, 961, 962, 963, 964, 965, 966,
+	967, 968, 969, 0, 0, 0, 0, 0, 650, 0,
+	645, 0, 645, 645, 645, 0, 271, 417, 675, 676,
+	958, 0, 0, 0, 311, 0, 973, 283, 0, 285,
+	973, 0, 973, 0, 292, 0, 0, 298, 973, 303,
+	317, 318, 305, 319, 322, 0, 327, 330, 0, 336,
+	337, 338, 339, 340, 972, 972, -2, 333, 334, 335,
+	343, 35, 607, 0, 0, 595, 37, 0, 344, 349,
+	350, 354, 352, 353, 345, 0, 363, 367, 0, 427,
+	0, 432, 434, -2, -2, 0, 469, 470, 471, 472,
+	473, 0, 0, 0, 0, 0, 0, 0, 0, 497,
+	498, 499, 500, 580, 581, 582, 583, 584, 585, 586,
+	587, 436, 437, 577, 627, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 568, 0, 538, 538, 538, 538,
+	538, 538, 538, 538, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 50, 52, 417, 56,
+	0, 946, 631, -2, -2, 0, 0, 673, 674, -2,
+	810, -2, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
+	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
+	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
+	727, 728, 729, 730, 731, 732, 733, 734, 735, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	777, 778, 779, 780, 781, 782, 783, 784, 785, 786,
+	787, 788, 789, 790, 791, 792, 793, 794, 795, 796,
+	0, 0, 90, 0, 88, 0, 973, 0, 0, 0,
+	0, 0, 0, 973, 973, 973, 0, 0, 0, 0,
+	262, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	272, 973, 311, 275, 0, 0, 973, 973, 973, 0,
+	973, 973, 282, 974, 975, 0, 192, 193, 194, 286,
+	973, 973, 288, 0, 308, 306, 307, 300, 301, 0,
+	314, 295, 296, 299, 328, 331, 341, 342, 36, 971,
+	30, 0, 0, 604, 0, 596, 597, 600, 603, 35,
+	351, 0, 357, 355, 356, 347, 0, 364, 0, 0,
+	0, 368, 0, 370, 371, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 454, 455, 456, 457,
+	458, 459, 460, 433, 0, 447, 0, 0, 0, 489,
+	490, 491, 492, 493, 494, 495, 0, 359, 35, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 354,
+	0, 569, 0, 522, 530, 0, 523, 531, 524, 532,
+	525, 0, 526, 533, 527, 534, 528, 529, 535, 0,
+	0, 0, 359, 359, 0, 0, 54, 0, 416, 0,
+	374, 376, 377, 378, -2, 0, 400, -2, 0, 0,
+	0, 48, 49, 0, 0, 0, 0, 57, 946, 59,
+	60, 0, 0, 0, 168, 640, 641, 642, 638, 217,
+	0, 0, 156, 152, 96, 97, 98, 145, 100, 145,
+	145, 145, 145, 165, 165, 165, 165, 128, 129, 130,
+	131, 132, 0, 0, 115, 145, 145, 145, 119, 135,
+	136, 137, 138, 139, 140, 141, 142, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 147, 147, 147, 149,
+	149, 669, 74, 0, 973, 0, 973, 86, 0, 231,
+	233, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	265, 646, 0, 973, 268, 269, 418, 677, 678, 273,
+	274, 312, 313, 276, 277, 278, 279, 280, 281, 0,
+	195, 196, 287, 291, 0, 311, 0, 0, 293, 294,
+	0, 0, 0, 329, 608, 0, 0, 0, 0, 0,
+	599, 601, 602, 607, 38, 354, 0, 588, 0, 0,
+	0, 358, 33, 428, 429, 431, 448, 0, 450, 452,
+	369, 365, 0, 578, -2, 438, 439, 463, 464, 465,
+	0, 0, 0, 0, 461, 443, 0, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 488,
+	553, 554, 0, 486, 487, 496, 0, 0, 0, 360,
+	361, 466, 0, 626, 35, 0, 0, 0, 0, 471,
+	580, 0, 471, 580, 0, 0, 0, 575, 572, 0,
+	0, 577, 0, 539, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 415, 0, 0, 0, 0, 0,
+	0, 405, 0, 0, 408, 0, 0, 0, 0, 399,
+	0, 0, 420, 891, 401, 0, 403, 404, 425, 0,
+	425, 51, 425, 53, 0, 419, 632, 58, 0, 0,
+	63, 64, 633, 634, 635, 636, 0, 87, 218, 220,
+	223, 224, 225, 91, 92, 93, 0, 0, 205, 0,
+	0, 199, 199, 0, 197, 198, 89, 159, 157, 0,
+	154, 153, 99, 0, 165, 165, 122, 123, 168, 0,
+	168, 168, 168, 0, 0, 116, 117, 118, 110, 0,
+	111, 112, 113, 0, 114, 0, 0, 973, 76, 648,
+	77, 972, 0, 0, 661, 232, 651, 652, 653, 654,
+	655, 656, 657, 658, 659, 660, 0, 78, 236, 238,
+	237, 241, 0, 0, 0, 263, 973, 267, 308, 308,
+	290, 309, 310, 315, 297, 325, 0, 605, 606, 0,
+	598, 31, 0, 643, 644, 589, 590, 372, 449, 451,
+	453, 0, 359, 440, 461, 444, 0, 441, 0, 0,
+	435, 501, 0, 0, 0, 468, -2, 509, 510, 0,
+	0, 0, 0, 0, 546, 0, 0, 547, 0, 595,
+	0, 573, 0, 0, 521, 0, 540, 0, 0, 541,
+	542, 543, 544, 545, 620, 0, 0, -2, 0, 0,
+	425, 628, 0, 375, 394, 396, 0, 391, 406, 407,
+	409, 0, 411, 0, 413, 414, 379, 381, 382, 0,
+	0, 0, 0, 402, 595, 0, 425, 46, 47, 0,
+	61, 62, 0, 0, 68, 169, 170, 0, 221, 0,
+	0, 0, 187, 199, 199, 190, 200, 191, 0, 161,
+	0, 158, 95, 155, 0, 168, 168, 124, 0, 125,
+	126, 127, 0, 143, 0, 0, 0, 0, 670, 75,
+	226, 972, 243, 244, 245, 246, 247, 248, 249, 250,
+	251, 252, 253, 254, 255, 256, 972, 0, 972, 662,
+	663, 664, 665, 0, 81, 0, 0, 0, 0, 0,
+	266, 311, 311, 609, 0, 32, 425, 0, 366, 579,
+	0, 442, 0, 462, 445, 505, 0, 502, 503, 362,
+	0, 145, 145, 558, 145, 149, 561, 145, 563, 145,
+	566, 0, 0, 0, 0, 0, 0, 0, 570, 520,
+	576, 0, 578, 0, 0, 39, 0, 620, 610, 622,
+	624, 0, 35, 0, 616, 0, 386, 595, 0, 0,
+	388, 395, 0, 0, 389, 0, 390, 410, 412, 0,
+	0, 0, 0, 603, 426, 45, 65, 66, 67, 219,
+	222, 0, 201, 145, 204, 188, 189, 0, 163, 0,
+	160, 146, 120, 121, 166, 167, 165, 0, 165, 0,
+	150, 0, 973, 227, 228, 229, 230, 0, 235, 0,
+	79, 80, 0, 0, 240, 264, 284, 289, 591, 373,
+	504, 446, 507, 511, 555, 165, 559, 560, 562, 564,
+	565, 567, 513, 512, 0, 0, 0, 0, 0, 603,
+	0, 574, 0, 0, 0, 40, 0, 625, -2, 0,
+	0, 0, 55, 0, 603, 629, 630, 392, 0, 397,
+	0, 0, 0, 400, 44, 179, 0, 203, 0, 384,
+	171, 164, 0, 168, 144, 168, 0, 0, 73, 0,
+	82, 83, 0, 0, 0, 593, 0, 595, 0, 556,
+	557, 0, 0, 0, 0, 548, 0, 571, 0, 0,
+	0, 623, 0, -2, 0, 618, 617, 387, 43, 0,
+	0, 422, 0, 0, 420, 178, 180, 0, 185, 0,
+	202, 0, 0, 176, 0, 173, 175, 162, 133, 134,
+	148, 151, 0, 0, 0, 0, 242, 34, 0, 0,
+	0, 0, 514, 516, 515, 517, 0, 0, 0, 519,
+	536, 537, 0, 613, 35, 0, 393, 421, 423, 424,
+	383, 181, 182, 0, 186, 184, 0, 385, 94, 0,
+	172, 174, 0, 258, 0, 84, 85, 78, 594, 592,
+	506, 508, 518, 0, 0, 0, 621, -2, 619, 183,
+	0, 177, 257, 0, 0, 81, 549, 0, 552, 0,
+	259, 0, 239, 550, 0, 0, 0, 206, 0, 0,
+	207, 208, 0, 0, 551, 209, 0, 0, 0, 0,
+	0, 210, 212, 213, 0, 0, 211, 260, 261, 214,
+	215, 216,
 }
 var yyTok1 = [...]int{
 
@@ -3216,7 +3276,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 78, 3, 3, 3, 105, 97, 3,
 	55, 57, 102, 100, 56, 101, 115, 103, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 352,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 356,
 	86, 85, 87, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3270,7 +3330,8 @@ var yyTok3 = [...]int{
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
 	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
-	57675, 350, 57676, 351, 0,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3612,53 +3673,53 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:325
+//line sql.y:327
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:330
+//line sql.y:332
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:331
+//line sql.y:333
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:335
+//line sql.y:337
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:357
+//line sql.y:362
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:363
+//line sql.y:368
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 25:
+	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:367
+//line sql.y:372
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 26:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:371
+//line sql.y:376
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 27:
+	case 30:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:377
+//line sql.y:382
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3666,57 +3727,57 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 28:
+	case 31:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:385
+//line sql.y:390
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 29:
+	case 32:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:389
+//line sql.y:394
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 30:
+	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:395
+//line sql.y:400
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 31:
+	case 34:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:402
+//line sql.y:407
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 32:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:408
+//line sql.y:413
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 33:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:412
+//line sql.y:417
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:418
+//line sql.y:423
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 35:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:422
+//line sql.y:427
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 36:
+	case 39:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:429
+//line sql.y:434
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3728,9 +3789,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 37:
+	case 40:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:441
+//line sql.y:446
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3740,334 +3801,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 38:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:453
+//line sql.y:458
 		{
 			yyVAL.str = InsertStr
 		}
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:457
+//line sql.y:462
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 40:
+	case 43:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:463
+//line sql.y:468
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:469
+//line sql.y:474
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:473
+//line sql.y:478
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:477
+//line sql.y:482
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:481
+//line sql.y:486
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:486
+//line sql.y:491
 		{
 		}
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:487
+//line sql.y:492
 		{
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:491
+//line sql.y:496
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 48:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:495
+//line sql.y:500
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 49:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:506
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:505
+//line sql.y:510
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:510
+//line sql.y:515
 		{
 			yyVAL.partitions = nil
 		}
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:514
+//line sql.y:519
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:520
+//line sql.y:525
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:524
+//line sql.y:529
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:528
+//line sql.y:533
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:532
+//line sql.y:537
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:538
+//line sql.y:543
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:542
+//line sql.y:547
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:553
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:552
+//line sql.y:557
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:556
+//line sql.y:561
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:562
+//line sql.y:567
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:566
+//line sql.y:571
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:570
+//line sql.y:575
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:574
+//line sql.y:579
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:580
+//line sql.y:585
 		{
 			yyVAL.str = SessionStr
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:584
+//line sql.y:589
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:595
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:595
+//line sql.y:600
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:601
+//line sql.y:606
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:606
+//line sql.y:611
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:610
+//line sql.y:615
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:614
+//line sql.y:619
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:618
+//line sql.y:623
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:623
+//line sql.y:628
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:627
+//line sql.y:632
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:633
+//line sql.y:638
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:638
+//line sql.y:643
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:643
+//line sql.y:648
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:649
+//line sql.y:654
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:654
+//line sql.y:659
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:660
+//line sql.y:665
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:666
+//line sql.y:671
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:673
+//line sql.y:678
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:680
+//line sql.y:685
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:684
+//line sql.y:689
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:690
+//line sql.y:695
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:695
+//line sql.y:700
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:699
+//line sql.y:704
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:703
+//line sql.y:708
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:709
+//line sql.y:714
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4077,856 +4138,856 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:720
+//line sql.y:725
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:731
+//line sql.y:736
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:741
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:742
+//line sql.y:747
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:746
+//line sql.y:751
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:750
+//line sql.y:755
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:754
+//line sql.y:759
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:758
+//line sql.y:763
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:762
+//line sql.y:767
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:766
+//line sql.y:771
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:770
+//line sql.y:775
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:774
+//line sql.y:779
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:780
+//line sql.y:785
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:786
+//line sql.y:791
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:792
+//line sql.y:797
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:798
+//line sql.y:803
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:804
+//line sql.y:809
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:812
+//line sql.y:817
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:816
+//line sql.y:821
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:820
+//line sql.y:825
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:824
+//line sql.y:829
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:828
+//line sql.y:833
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:834
+//line sql.y:839
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:838
+//line sql.y:843
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 119:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:842
+//line sql.y:847
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 120:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:846
+//line sql.y:851
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 121:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:850
+//line sql.y:855
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:854
+//line sql.y:859
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 123:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:858
+//line sql.y:863
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:862
+//line sql.y:867
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:866
+//line sql.y:871
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:870
+//line sql.y:875
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:874
+//line sql.y:879
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:878
+//line sql.y:883
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:887
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 133:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:886
+//line sql.y:891
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 131:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:891
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 132:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:897
+//line sql.y:902
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:906
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:910
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:909
+//line sql.y:914
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:913
+//line sql.y:918
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:917
+//line sql.y:922
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:921
+//line sql.y:926
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:930
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:931
+//line sql.y:936
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 141:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:936
+//line sql.y:941
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 142:
+	case 145:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:941
+//line sql.y:946
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 143:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:945
+//line sql.y:950
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 144:
+	case 147:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:950
+//line sql.y:955
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:954
+//line sql.y:959
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:962
+//line sql.y:967
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:966
+//line sql.y:971
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:972
+//line sql.y:977
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:980
+//line sql.y:985
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:984
+//line sql.y:989
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 151:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:989
+//line sql.y:994
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:993
+//line sql.y:998
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:999
+//line sql.y:1004
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1003
+//line sql.y:1008
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 155:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1007
+//line sql.y:1012
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 156:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1012
+//line sql.y:1017
 		{
 			yyVAL.optVal = nil
 		}
-	case 157:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1016
+//line sql.y:1021
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 158:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1021
+//line sql.y:1026
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1025
+//line sql.y:1030
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 160:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1030
+//line sql.y:1035
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 161:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1034
+//line sql.y:1039
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 162:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1039
+//line sql.y:1044
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1043
+//line sql.y:1048
 		{
 			yyVAL.str = string(yyDollar[3].colIdent.String())
 		}
-	case 164:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1047
+//line sql.y:1052
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 165:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1052
+//line sql.y:1057
 		{
 			yyVAL.str = ""
 		}
-	case 166:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1056
+//line sql.y:1061
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 167:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1060
+//line sql.y:1065
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 168:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1065
+//line sql.y:1070
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 169:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1069
+//line sql.y:1074
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 170:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1073
+//line sql.y:1078
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 171:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1077
+//line sql.y:1082
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 172:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1081
+//line sql.y:1086
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 173:
+	case 176:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1086
+//line sql.y:1091
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 174:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1090
+//line sql.y:1095
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 175:
+	case 178:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1096
+//line sql.y:1101
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 176:
+	case 179:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1100
+//line sql.y:1105
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 177:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1106
+//line sql.y:1111
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 178:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1110
+//line sql.y:1115
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 179:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1121
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].colIdent.String())}
 		}
-	case 180:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1120
+//line sql.y:1125
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 181:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1125
+//line sql.y:1130
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 185:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1131
+//line sql.y:1136
 		{
 			yyVAL.str = ""
 		}
-	case 183:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1135
+//line sql.y:1140
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 184:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1141
+//line sql.y:1146
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 185:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1145
+//line sql.y:1150
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 186:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1149
+//line sql.y:1154
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 187:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1153
+//line sql.y:1158
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 188:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1157
+//line sql.y:1162
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 189:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1168
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1172
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 191:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1176
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 192:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+//line sql.y:1183
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 193:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1187
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 194:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1188
+//line sql.y:1193
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 195:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1192
+//line sql.y:1197
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 196:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1197
+//line sql.y:1202
 		{
 			yyVAL.str = ""
 		}
-	case 197:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1201
+//line sql.y:1206
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 198:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1207
+//line sql.y:1212
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 199:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1211
+//line sql.y:1216
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 200:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1217
+//line sql.y:1222
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 201:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1223
+//line sql.y:1228
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 202:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1227
+//line sql.y:1232
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 203:
+	case 206:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1234
+//line sql.y:1239
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 204:
+	case 207:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1238
+//line sql.y:1243
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 205:
+	case 208:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1242
+//line sql.y:1247
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 206:
+	case 209:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1246
+//line sql.y:1251
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 207:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1252
+//line sql.y:1257
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 208:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1258
+//line sql.y:1263
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 209:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1264
+//line sql.y:1269
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 210:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1268
+//line sql.y:1273
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 211:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1272
+//line sql.y:1277
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 212:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1276
+//line sql.y:1281
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 213:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1280
+//line sql.y:1285
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 214:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1285
+//line sql.y:1290
 		{
 			yyVAL.str = ""
 		}
-	case 215:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1289
+//line sql.y:1294
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 216:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1293
+//line sql.y:1298
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 217:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1301
+//line sql.y:1306
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 218:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1305
+//line sql.y:1310
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 219:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1309
+//line sql.y:1314
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 220:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1315
+//line sql.y:1320
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 221:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1319
+//line sql.y:1324
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 222:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1323
+//line sql.y:1328
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 223:
+	case 226:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1329
+//line sql.y:1334
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 224:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1333
+//line sql.y:1338
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 225:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1337
+//line sql.y:1342
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 226:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1341
+//line sql.y:1346
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 227:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1346
+//line sql.y:1351
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 228:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1351
+//line sql.y:1356
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 229:
+	case 232:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1355
+//line sql.y:1360
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 230:
+	case 233:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1359
+//line sql.y:1364
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 231:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1363
+//line sql.y:1368
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 232:
+	case 235:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1367
+//line sql.y:1372
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4938,9 +4999,9 @@ yydefault:
 				},
 			}
 		}
-	case 233:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1379
+//line sql.y:1384
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4950,21 +5011,21 @@ yydefault:
 				},
 			}
 		}
-	case 234:
+	case 237:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1389
+//line sql.y:1394
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 235:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1393
+//line sql.y:1398
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 236:
+	case 239:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1397
+//line sql.y:1402
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4977,9 +5038,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 237:
+	case 240:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1410
+//line sql.y:1415
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4989,15 +5050,15 @@ yydefault:
 				},
 			}
 		}
-	case 238:
+	case 241:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1420
+//line sql.y:1425
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 239:
+	case 242:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1424
+//line sql.y:1429
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5008,59 +5069,59 @@ yydefault:
 				},
 			}
 		}
-	case 254:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1453
+//line sql.y:1458
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 255:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1464
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 256:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1463
+//line sql.y:1468
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 257:
+	case 260:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1469
+//line sql.y:1474
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 258:
+	case 261:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1473
+//line sql.y:1478
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 259:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1479
+//line sql.y:1484
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 260:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1485
+//line sql.y:1490
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 261:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1489
+//line sql.y:1494
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 262:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1497
+//line sql.y:1502
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5068,16 +5129,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 263:
+	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1505
+//line sql.y:1510
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 264:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1510
+//line sql.y:1515
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5085,145 +5146,145 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 265:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1518
+//line sql.y:1523
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 266:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1522
+//line sql.y:1527
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 267:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1528
+//line sql.y:1533
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 268:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1532
+//line sql.y:1537
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 269:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1537
+//line sql.y:1542
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 270:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1543
+//line sql.y:1548
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 271:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1548
+//line sql.y:1553
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 272:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1553
+//line sql.y:1558
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 273:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1558
+//line sql.y:1563
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 274:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1563
+//line sql.y:1568
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 275:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1567
+//line sql.y:1572
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1571
+//line sql.y:1576
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 277:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1575
+//line sql.y:1580
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 278:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1579
+//line sql.y:1584
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 279:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1583
+//line sql.y:1588
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 280:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1587
+//line sql.y:1592
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 284:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1591
+//line sql.y:1596
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 282:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1597
+//line sql.y:1602
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 283:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1601
+//line sql.y:1606
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 284:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1610
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1609
+//line sql.y:1614
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 289:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1613
+//line sql.y:1618
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 287:
+	case 290:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1618
+//line sql.y:1623
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5233,663 +5294,725 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 288:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1628
+//line sql.y:1633
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1632
+//line sql.y:1637
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1636
+//line sql.y:1641
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 291:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1642
+//line sql.y:1647
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 292:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1647
+//line sql.y:1652
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1651
+//line sql.y:1656
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 297:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1655
+//line sql.y:1660
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 295:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1659
+//line sql.y:1664
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 296:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1673
+//line sql.y:1678
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].colIdent.String())}
 		}
-	case 297:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1679
+//line sql.y:1684
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 298:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1683
+//line sql.y:1688
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 299:
+	case 302:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1689
+//line sql.y:1694
 		{
 			yyVAL.str = ""
 		}
-	case 300:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1693
+//line sql.y:1698
 		{
 			yyVAL.str = "extended "
 		}
-	case 301:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1699
+//line sql.y:1704
 		{
 			yyVAL.str = ""
 		}
-	case 302:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1703
+//line sql.y:1708
 		{
 			yyVAL.str = "full "
 		}
-	case 303:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1709
+//line sql.y:1714
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 304:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1713
+//line sql.y:1718
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 305:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1719
+//line sql.y:1724
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1723
+//line sql.y:1728
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 307:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1727
+//line sql.y:1732
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 308:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1733
+//line sql.y:1738
 		{
 			yyVAL.showFilter = nil
 		}
-	case 309:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1737
+//line sql.y:1742
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 310:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:1746
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 311:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1747
+//line sql.y:1752
 		{
 			yyVAL.showFilter = nil
 		}
-	case 312:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1751
+//line sql.y:1756
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 313:
+	case 316:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1757
+//line sql.y:1762
 		{
 			yyVAL.str = ""
 		}
-	case 314:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1761
+//line sql.y:1766
 		{
 			yyVAL.str = SessionStr
 		}
-	case 315:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1765
+//line sql.y:1770
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 316:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1776
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 317:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1775
+//line sql.y:1780
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 318:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1781
+//line sql.y:1786
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 319:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1785
+//line sql.y:1790
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 320:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1791
+//line sql.y:1796
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 321:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1797
+//line sql.y:1802
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 322:
+	case 325:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1806
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
+		}
+	case 326:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1811
+		{
+		}
+	case 327:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1813
+		{
+		}
+	case 328:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1816
+		{
+		}
+	case 329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1818
+		{
+		}
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1803
+//line sql.y:1822
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1828
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 332:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1834
+		{
+			yyVAL.statement = &VExplain{Statement: yyDollar[2].selStmt}
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1838
+		{
+			yyVAL.statement = &VExplain{Statement: yyDollar[2].statement}
+		}
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1842
+		{
+			yyVAL.statement = &VExplain{Statement: yyDollar[2].statement}
+		}
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1846
+		{
+			yyVAL.statement = &VExplain{Statement: yyDollar[2].statement}
+		}
+	case 336:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1852
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 323:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1807
+//line sql.y:1856
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 324:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1811
+//line sql.y:1860
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 325:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:1864
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 326:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1819
+//line sql.y:1868
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 327:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1823
+//line sql.y:1872
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 328:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1827
+//line sql.y:1876
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 329:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1833
+//line sql.y:1882
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 330:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+//line sql.y:1886
 		{
 			setAllowComments(yylex, true)
 		}
-	case 331:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:1890
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 332:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1847
+//line sql.y:1896
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 333:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1851
+//line sql.y:1900
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 334:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1857
+//line sql.y:1906
 		{
 			yyVAL.str = UnionStr
 		}
-	case 335:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1861
+//line sql.y:1910
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 336:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1865
+//line sql.y:1914
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 337:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1870
+//line sql.y:1919
 		{
 			yyVAL.str = ""
 		}
-	case 338:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1874
+//line sql.y:1923
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 339:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1878
+//line sql.y:1927
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 340:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1883
+//line sql.y:1932
 		{
 			yyVAL.str = ""
 		}
-	case 341:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1887
+//line sql.y:1936
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 342:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1891
+//line sql.y:1940
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 343:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1896
+//line sql.y:1945
 		{
 			yyVAL.str = ""
 		}
-	case 344:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1900
+//line sql.y:1949
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 345:
+	case 359:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1905
+//line sql.y:1954
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 346:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1909
+//line sql.y:1958
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 347:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1915
+//line sql.y:1964
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 348:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1919
+//line sql.y:1968
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 349:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1925
+//line sql.y:1974
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 350:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1929
+//line sql.y:1978
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 351:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1933
+//line sql.y:1982
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 352:
+	case 366:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1937
+//line sql.y:1986
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 353:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1942
+//line sql.y:1991
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 354:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1946
+//line sql.y:1995
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 355:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1950
+//line sql.y:1999
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 357:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1957
+//line sql.y:2006
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 358:
+	case 372:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1962
+//line sql.y:2011
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 359:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1966
+//line sql.y:2015
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 360:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1972
+//line sql.y:2021
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 361:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1976
+//line sql.y:2025
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 364:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1986
+//line sql.y:2035
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 365:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2039
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 366:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1994
+//line sql.y:2043
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 367:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2000
+//line sql.y:2049
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 368:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2055
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 369:
+	case 383:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2010
+//line sql.y:2059
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 370:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2016
+//line sql.y:2065
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 371:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2020
+//line sql.y:2069
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 372:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2026
+//line sql.y:2075
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 373:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2030
+//line sql.y:2079
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 374:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2043
+//line sql.y:2092
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 375:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2047
+//line sql.y:2096
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 376:
+	case 390:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2051
+//line sql.y:2100
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 377:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2055
+//line sql.y:2104
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 378:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2061
+//line sql.y:2110
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 379:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2063
+//line sql.y:2112
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 380:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2067
+//line sql.y:2116
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 381:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2069
+//line sql.y:2118
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 382:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2073
+//line sql.y:2122
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 383:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2075
+//line sql.y:2124
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 384:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2078
+//line sql.y:2127
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 385:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2080
+//line sql.y:2129
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 386:
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2083
+//line sql.y:2132
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 387:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2087
+//line sql.y:2136
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 388:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2091
+//line sql.y:2140
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 390:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2098
+//line sql.y:2147
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 391:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2104
+//line sql.y:2153
 		{
 			yyVAL.str = JoinStr
 		}
-	case 392:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2108
+//line sql.y:2157
 		{
 			yyVAL.str = JoinStr
 		}
-	case 393:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2112
+//line sql.y:2161
 		{
 			yyVAL.str = JoinStr
 		}
-	case 394:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2118
+//line sql.y:2167
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 395:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2124
+//line sql.y:2173
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 396:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2128
+//line sql.y:2177
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 397:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2132
+//line sql.y:2181
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 398:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2136
+//line sql.y:2185
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 399:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2142
+//line sql.y:2191
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 400:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2146
+//line sql.y:2195
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5897,471 +6020,471 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 401:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2205
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 402:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2160
+//line sql.y:2209
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 403:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2166
+//line sql.y:2215
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 404:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2219
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 405:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2176
+//line sql.y:2225
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 406:
+	case 420:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2181
+//line sql.y:2230
 		{
 			yyVAL.indexHints = nil
 		}
-	case 407:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2185
+//line sql.y:2234
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 408:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2189
+//line sql.y:2238
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 409:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2193
+//line sql.y:2242
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 410:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2197
+//line sql.y:2246
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 411:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2202
+//line sql.y:2251
 		{
 			yyVAL.expr = nil
 		}
-	case 412:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2206
+//line sql.y:2255
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 413:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2212
+//line sql.y:2261
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 414:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:2265
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2220
+//line sql.y:2269
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 416:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2224
+//line sql.y:2273
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 417:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2228
+//line sql.y:2277
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 418:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2232
+//line sql.y:2281
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 419:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2236
+//line sql.y:2285
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 420:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2242
+//line sql.y:2291
 		{
 			yyVAL.str = ""
 		}
-	case 421:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2246
+//line sql.y:2295
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 422:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2252
+//line sql.y:2301
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 423:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2256
+//line sql.y:2305
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 424:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2311
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 425:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2266
+//line sql.y:2315
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 426:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2270
+//line sql.y:2319
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 427:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2274
+//line sql.y:2323
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 428:
+	case 442:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2278
+//line sql.y:2327
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 429:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2282
+//line sql.y:2331
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 430:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2286
+//line sql.y:2335
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 431:
+	case 445:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2290
+//line sql.y:2339
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 432:
+	case 446:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2294
+//line sql.y:2343
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 433:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2298
+//line sql.y:2347
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 434:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2304
+//line sql.y:2353
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 435:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2308
+//line sql.y:2357
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 436:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2312
+//line sql.y:2361
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 437:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2316
+//line sql.y:2365
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 438:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2320
+//line sql.y:2369
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 439:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2324
+//line sql.y:2373
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 440:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2330
+//line sql.y:2379
 		{
 			yyVAL.str = EqualStr
 		}
-	case 441:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2383
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 442:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2338
+//line sql.y:2387
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 443:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2342
+//line sql.y:2391
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 444:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2346
+//line sql.y:2395
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 445:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2350
+//line sql.y:2399
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 446:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2354
+//line sql.y:2403
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 447:
+	case 461:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2359
+//line sql.y:2408
 		{
 			yyVAL.expr = nil
 		}
-	case 448:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2363
+//line sql.y:2412
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 449:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2369
+//line sql.y:2418
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 450:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2373
+//line sql.y:2422
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 451:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2377
+//line sql.y:2426
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 452:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2383
+//line sql.y:2432
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 453:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2389
+//line sql.y:2438
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 454:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2393
+//line sql.y:2442
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 455:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2399
+//line sql.y:2448
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 456:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2403
+//line sql.y:2452
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 457:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2407
+//line sql.y:2456
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 458:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2411
+//line sql.y:2460
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 459:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2415
+//line sql.y:2464
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 460:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2419
+//line sql.y:2468
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 461:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2423
+//line sql.y:2472
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 462:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2427
+//line sql.y:2476
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2431
+//line sql.y:2480
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 464:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2435
+//line sql.y:2484
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 465:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2439
+//line sql.y:2488
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 466:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2492
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 467:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2447
+//line sql.y:2496
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2451
+//line sql.y:2500
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 469:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2455
+//line sql.y:2504
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 470:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2459
+//line sql.y:2508
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 471:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2463
+//line sql.y:2512
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 472:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2467
+//line sql.y:2516
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 473:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2471
+//line sql.y:2520
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 474:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2475
+//line sql.y:2524
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 475:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2479
+//line sql.y:2528
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 476:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2483
+//line sql.y:2532
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 477:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2487
+//line sql.y:2536
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 478:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2491
+//line sql.y:2540
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6369,9 +6492,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 479:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2499
+//line sql.y:2548
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6385,21 +6508,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 480:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2513
+//line sql.y:2562
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 481:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2517
+//line sql.y:2566
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 482:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2521
+//line sql.y:2570
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6407,497 +6530,521 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 487:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2539
+//line sql.y:2588
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 488:
+	case 502:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2543
+//line sql.y:2592
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 489:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2547
+//line sql.y:2596
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 490:
+	case 504:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2551
+//line sql.y:2600
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 491:
+	case 505:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2604
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
+		}
+	case 506:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2610
+		{
+			yyVAL.overClause = &OverClause{PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy}
+		}
+	case 507:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2615
+		{
+			yyVAL.exprs = nil
+		}
+	case 508:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2619
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2561
+//line sql.y:2629
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 492:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2565
+//line sql.y:2633
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 493:
+	case 511:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2569
+//line sql.y:2637
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 494:
+	case 512:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2573
+//line sql.y:2641
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 495:
+	case 513:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2577
+//line sql.y:2645
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 496:
+	case 514:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2581
+//line sql.y:2649
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 497:
+	case 515:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2585
+//line sql.y:2653
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 498:
+	case 516:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2589
+//line sql.y:2657
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 499:
+	case 517:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2593
+//line sql.y:2661
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 500:
+	case 518:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2597
+//line sql.y:2665
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 501:
+	case 519:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2601
+//line sql.y:2669
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 502:
+	case 520:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2605
+//line sql.y:2673
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 503:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2609
+//line sql.y:2677
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 504:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2619
+//line sql.y:2687
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 505:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2623
+//line sql.y:2691
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 506:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2627
+//line sql.y:2695
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 507:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2632
+//line sql.y:2700
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 508:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2637
+//line sql.y:2705
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 509:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2642
+//line sql.y:2710
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 510:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2648
+//line sql.y:2716
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 511:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2653
+//line sql.y:2721
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 512:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2658
+//line sql.y:2726
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 513:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2662
+//line sql.y:2730
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 514:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2666
+//line sql.y:2734
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 515:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2671
+//line sql.y:2739
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 516:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:2744
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 517:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2681
+//line sql.y:2749
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 518:
+	case 536:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2685
+//line sql.y:2753
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 519:
+	case 537:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2689
+//line sql.y:2757
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 522:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2699
+//line sql.y:2767
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 523:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2709
+//line sql.y:2777
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 524:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2713
+//line sql.y:2781
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 525:
+	case 543:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2717
+//line sql.y:2785
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 526:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2721
+//line sql.y:2789
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 527:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2725
+//line sql.y:2793
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 528:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2729
+//line sql.y:2797
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 529:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2733
+//line sql.y:2801
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 530:
+	case 548:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2739
+//line sql.y:2807
 		{
 			yyVAL.str = ""
 		}
-	case 531:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2743
+//line sql.y:2811
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 532:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2747
+//line sql.y:2815
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 533:
+	case 551:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2751
+//line sql.y:2819
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 534:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2755
+//line sql.y:2823
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 535:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2761
+//line sql.y:2829
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 536:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:2833
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 537:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2771
+//line sql.y:2839
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 538:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2775
+//line sql.y:2843
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 539:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2779
+//line sql.y:2847
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 540:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:2851
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 541:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2787
+//line sql.y:2855
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 542:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2791
+//line sql.y:2859
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 543:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2797
+//line sql.y:2865
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 544:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2801
+//line sql.y:2869
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 545:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2805
+//line sql.y:2873
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 546:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2809
+//line sql.y:2877
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 547:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2813
+//line sql.y:2881
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 548:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2817
+//line sql.y:2885
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 549:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2821
+//line sql.y:2889
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 550:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2826
+//line sql.y:2894
 		{
 			yyVAL.expr = nil
 		}
-	case 551:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2830
+//line sql.y:2898
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 552:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2835
+//line sql.y:2903
 		{
 			yyVAL.str = string("")
 		}
-	case 553:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2839
+//line sql.y:2907
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 554:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2845
+//line sql.y:2913
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 555:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2849
+//line sql.y:2917
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 556:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2855
+//line sql.y:2923
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 557:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2860
+//line sql.y:2928
 		{
 			yyVAL.expr = nil
 		}
-	case 558:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2864
+//line sql.y:2932
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 559:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2870
+//line sql.y:2938
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 560:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2874
+//line sql.y:2942
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 561:
+	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2878
+//line sql.y:2946
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 562:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2884
+//line sql.y:2952
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 563:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2888
+//line sql.y:2956
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 564:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2892
+//line sql.y:2960
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 565:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2896
+//line sql.y:2964
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 566:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2900
+//line sql.y:2968
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 567:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2904
+//line sql.y:2972
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 568:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:2976
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 569:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2912
+//line sql.y:2980
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 570:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2918
+//line sql.y:2986
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6906,239 +7053,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 571:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2927
+//line sql.y:2995
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 572:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2931
+//line sql.y:2999
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 573:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2936
+//line sql.y:3004
 		{
 			yyVAL.exprs = nil
 		}
-	case 574:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2940
+//line sql.y:3008
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 575:
+	case 593:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2945
+//line sql.y:3013
 		{
 			yyVAL.expr = nil
 		}
-	case 576:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2949
+//line sql.y:3017
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 577:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2954
+//line sql.y:3022
 		{
 			yyVAL.orderBy = nil
 		}
-	case 578:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2958
+//line sql.y:3026
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 579:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2964
+//line sql.y:3032
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 580:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2968
+//line sql.y:3036
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 581:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2974
+//line sql.y:3042
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 582:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2979
+//line sql.y:3047
 		{
 			yyVAL.str = AscScr
 		}
-	case 583:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2983
+//line sql.y:3051
 		{
 			yyVAL.str = AscScr
 		}
-	case 584:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2987
+//line sql.y:3055
 		{
 			yyVAL.str = DescScr
 		}
-	case 585:
+	case 603:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2992
+//line sql.y:3060
 		{
 			yyVAL.limit = nil
 		}
-	case 586:
+	case 604:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2996
+//line sql.y:3064
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 587:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3000
+//line sql.y:3068
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 588:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3004
+//line sql.y:3072
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 589:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3009
+//line sql.y:3077
 		{
 			yyVAL.str = ""
 		}
-	case 590:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3013
+//line sql.y:3081
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 591:
+	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3017
+//line sql.y:3085
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 592:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3030
+//line sql.y:3098
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 593:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3034
+//line sql.y:3102
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 594:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3038
+//line sql.y:3106
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 595:
+	case 613:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3043
+//line sql.y:3111
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 596:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3047
+//line sql.y:3115
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 597:
+	case 615:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3051
+//line sql.y:3119
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 598:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3058
+//line sql.y:3126
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 599:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3062
+//line sql.y:3130
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 600:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3066
+//line sql.y:3134
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 601:
+	case 619:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3070
+//line sql.y:3138
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 602:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3075
+//line sql.y:3143
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 603:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3079
+//line sql.y:3147
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 604:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3085
+//line sql.y:3153
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 605:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3089
+//line sql.y:3157
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 606:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3095
+//line sql.y:3163
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 607:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3099
+//line sql.y:3167
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 608:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3105
+//line sql.y:3173
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 609:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3111
+//line sql.y:3179
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -7146,312 +7293,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 610:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3121
+//line sql.y:3189
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 611:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3125
+//line sql.y:3193
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 612:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3131
+//line sql.y:3199
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 613:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3137
+//line sql.y:3205
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 614:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3141
+//line sql.y:3209
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 615:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3147
+//line sql.y:3215
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 616:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3151
+//line sql.y:3219
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 617:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3155
+//line sql.y:3223
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 618:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3159
+//line sql.y:3227
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 620:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3166
+//line sql.y:3234
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 622:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3173
+//line sql.y:3241
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 623:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3177
+//line sql.y:3245
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 624:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3181
+//line sql.y:3249
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 627:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3190
+//line sql.y:3258
 		{
 			yyVAL.byt = 0
 		}
-	case 628:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3192
+//line sql.y:3260
 		{
 			yyVAL.byt = 1
 		}
-	case 629:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3195
+//line sql.y:3263
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3197
+//line sql.y:3265
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 649:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3200
+//line sql.y:3268
 		{
 			yyVAL.str = ""
 		}
-	case 632:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3202
+//line sql.y:3270
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 633:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3206
+//line sql.y:3274
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3208
+//line sql.y:3276
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3278
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 636:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3212
+//line sql.y:3280
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 637:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3214
+//line sql.y:3282
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3216
+//line sql.y:3284
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3218
+//line sql.y:3286
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3220
+//line sql.y:3288
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3222
+//line sql.y:3290
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3224
+//line sql.y:3292
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3227
+//line sql.y:3295
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3229
+//line sql.y:3297
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3299
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3235
+//line sql.y:3303
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3237
+//line sql.y:3305
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 666:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3240
+//line sql.y:3308
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3242
+//line sql.y:3310
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3244
+//line sql.y:3312
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3247
+//line sql.y:3315
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 652:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3249
+//line sql.y:3317
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 653:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3253
+//line sql.y:3321
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 654:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3257
+//line sql.y:3325
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 656:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3264
+//line sql.y:3332
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 657:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3270
+//line sql.y:3338
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 658:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3274
+//line sql.y:3342
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 660:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3281
+//line sql.y:3349
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 950:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3596
+//line sql.y:3666
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 951:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3605
+//line sql.y:3675
 		{
 			decNesting(yylex)
 		}
-	case 952:
+	case 972:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3610
+//line sql.y:3680
 		{
 			skipToEnd(yylex)
 		}
-	case 953:
+	case 973:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3615
+//line sql.y:3685
 		{
 			skipToEnd(yylex)
 		}
-	case 954:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3619
+//line sql.y:3689
 		{
 			skipToEnd(yylex)
 		}
-	case 955:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3623
+//line sql.y:3693
 		{
 			skipToEnd(yylex)
 		}
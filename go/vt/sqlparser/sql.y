@@ -115,6 +115,7 @@ func skipToEnd(yylex interface{}) {
   vindexParams  []VindexParam
   showFilter    *ShowFilter
   optLike       *OptLike
+  overClause    *OverClause
 }
 
 %token LEX_ERROR
@@ -162,14 +163,14 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH
 %token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
-%token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
+%token <bytes> SHOW DESCRIBE EXPLAIN VEXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES WARNINGS
 %token <bytes> SEQUENCE
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -216,8 +217,8 @@ func skipToEnd(yylex interface{}) {
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
-%type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> analyze_statement show_statement use_statement other_statement vexplain_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
@@ -251,7 +252,8 @@ func skipToEnd(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt partition_by_opt
+%type <overClause> over_clause
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
 %type <order> order
@@ -351,7 +353,10 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
 | other_statement
+| vexplain_statement
 | flush_statement
 | /*empty*/
 {
@@ -1797,6 +1802,50 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK work_opt TO savepoint_opt sql_id
+  {
+    $$ = &SRollback{Name: $5}
+  }
+
+work_opt:
+  {}
+| WORK
+  {}
+
+savepoint_opt:
+  {}
+| SAVEPOINT
+  {}
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
+
+vexplain_statement:
+  VEXPLAIN select_statement
+  {
+    $$ = &VExplain{Statement: $2}
+  }
+| VEXPLAIN insert_statement
+  {
+    $$ = &VExplain{Statement: $2}
+  }
+| VEXPLAIN update_statement
+  {
+    $$ = &VExplain{Statement: $2}
+  }
+| VEXPLAIN delete_statement
+  {
+    $$ = &VExplain{Statement: $2}
+  }
 
 other_statement:
   DESC skip_to_end
@@ -2551,6 +2600,25 @@ function_call_generic:
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
+
+over_clause:
+  OVER openb partition_by_opt order_by_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -3579,6 +3647,7 @@ non_reserved_keyword:
 | VARCHAR
 | VARIABLES
 | VCPU
+| VEXPLAIN
 | VIEW
 | VINDEX
 | VINDEXES
@@ -3587,6 +3656,7 @@ non_reserved_keyword:
 | VSCHEMA
 | WARNINGS
 | WITH
+| WORK
 | WRITE
 | YEAR
 | ZEROFILL
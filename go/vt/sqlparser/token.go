@@ -287,6 +287,7 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
 	"point":               POINT,
@@ -303,7 +304,7 @@ var keywords = map[string]int{
 	"real":                REAL,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
-	"release":             UNUSED,
+	"release":             RELEASE,
 	"rename":              RENAME,
 	"reorganize":          REORGANIZE,
 	"repair":              REPAIR,
@@ -318,6 +319,7 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"savepoint":           SAVEPOINT,
 	"schema":              SCHEMA,
 	"second_microsecond":  UNUSED,
 	"select":              SELECT,
@@ -388,6 +390,7 @@ var keywords = map[string]int{
 	"varchar":             VARCHAR,
 	"varcharacter":        UNUSED,
 	"varying":             UNUSED,
+	"vexplain":            VEXPLAIN,
 	"virtual":             UNUSED,
 	"vindex":              VINDEX,
 	"vindexes":            VINDEXES,
@@ -399,6 +402,7 @@ var keywords = map[string]int{
 	"where":               WHERE,
 	"while":               UNUSED,
 	"with":                WITH,
+	"work":                WORK,
 	"write":               WRITE,
 	"xor":                 UNUSED,
 	"year":                YEAR,
@@ -45,10 +45,35 @@ var (
 	//
 	// For entries we watch (like the SrvKeyspace for a given cell), if
 	// setting the watch fails, we will use the last known value until
-	// srv_topo_cache_ttl elapses and we only try to re-establish the watch
+	// srv_topo_stale_ttl elapses and we only try to re-establish the watch
 	// once every srv_topo_cache_refresh interval.
 	srvTopoCacheTTL     = flag.Duration("srv_topo_cache_ttl", 1*time.Second, "how long to use cached entries for topology")
 	srvTopoCacheRefresh = flag.Duration("srv_topo_cache_refresh", 1*time.Second, "how frequently to refresh the topology for cached entries")
+
+	// srvTopoStaleTTL bounds how long the last known value of a watched
+	// entry (SrvKeyspace and SrvVSchema) is served once its watch fails,
+	// so a short topo outage doesn't take down query serving.
+	// It defaults to srv_topo_cache_ttl.
+	srvTopoStaleTTL = flag.Duration("srv_topo_stale_ttl", 0, "how long to keep serving the last known SrvKeyspace and SrvVSchema when the topology is unavailable (defaults to srv_topo_cache_ttl)")
+)
+
+var (
+	// The following stats describe the health of the SrvKeyspace and
+	// SrvVSchema watches. A failing watch is re-established in the
+	// background every srv_topo_cache_refresh, so changes are pushed
+	// again as soon as the topology is back.
+	srvTopoWatchHealthy = stats.NewGaugesWithMultiLabels(
+		"SrvTopoWatchHealthy",
+		"Whether the srvtopo watch is running (1) or failing (0)",
+		[]string{"Type", "Cell", "Keyspace"})
+	srvTopoWatchErrors = stats.NewCountersWithMultiLabels(
+		"SrvTopoWatchErrors",
+		"Number of times a srvtopo watch failed",
+		[]string{"Type", "Cell", "Keyspace"})
+	srvTopoWatchRestarts = stats.NewCountersWithMultiLabels(
+		"SrvTopoWatchRestarts",
+		"Number of times a failed srvtopo watch was re-established in the background",
+		[]string{"Type", "Cell", "Keyspace"})
 )
 
 const (
@@ -120,6 +145,7 @@ type ResilientServer struct {
 	topoServer   *topo.Server
 	cacheTTL     time.Duration
 	cacheRefresh time.Duration
+	staleTTL     time.Duration
 	counts       *stats.CountersWithSingleLabel
 
 	// mutex protects the cache map itself, not the individual
@@ -210,6 +236,13 @@ func NewResilientServer(base *topo.Server, counterPrefix string) *ResilientServe
 	if *srvTopoCacheRefresh > *srvTopoCacheTTL {
 		log.Fatalf("srv_topo_cache_refresh must be less than or equal to srv_topo_cache_ttl")
 	}
+	staleTTL := *srvTopoStaleTTL
+	if staleTTL == 0 {
+		staleTTL = *srvTopoCacheTTL
+	}
+	if staleTTL < *srvTopoCacheTTL {
+		log.Fatalf("srv_topo_stale_ttl must be greater than or equal to srv_topo_cache_ttl")
+	}
 
 	var metric string
 
@@ -223,6 +256,7 @@ func NewResilientServer(base *topo.Server, counterPrefix string) *ResilientServe
 		topoServer:   base,
 		cacheTTL:     *srvTopoCacheTTL,
 		cacheRefresh: *srvTopoCacheRefresh,
+		staleTTL:     staleTTL,
 		counts:       stats.NewCountersWithSingleLabel(metric, "Resilient srvtopo server operations", "type"),
 
 		srvKeyspaceNamesCache: make(map[string]*srvKeyspaceNamesEntry),
@@ -403,7 +437,7 @@ func (server *ResilientServer) GetSrvKeyspace(ctx context.Context, cell, keyspac
 	// In the event that the topo service is slow or unresponsive either
 	// on the initial fetch or if the cache TTL expires, then several
 	// requests could be blocked waiting for the response to come back.
-	cacheValid := entry.value != nil && time.Since(entry.lastValueTime) < server.cacheTTL
+	cacheValid := entry.value != nil && time.Since(entry.lastValueTime) < server.staleTTL
 	if cacheValid {
 		server.counts.Add(cachedCategory, 1)
 		return entry.value, nil
@@ -435,6 +469,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 	// We use a background context, as starting the watch should keep going
 	// even if the current query context is short-lived.
 	newCtx := context.Background()
+	statsKey := []string{"SrvKeyspace", cell, keyspace}
 	current, changes, cancel := server.topoServer.WatchSrvKeyspace(newCtx, cell, keyspace)
 
 	entry.mutex.Lock()
@@ -452,9 +487,11 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		}
 
 		server.counts.Add(errorCategory, 1)
+		srvTopoWatchErrors.Add(statsKey, 1)
+		srvTopoWatchHealthy.Set(statsKey, 0)
 		log.Errorf("Initial WatchSrvKeyspace failed for %v/%v: %v", cell, keyspace, current.Err)
 
-		if time.Since(entry.lastValueTime) > server.cacheTTL {
+		if time.Since(entry.lastValueTime) > server.staleTTL {
 			log.Errorf("WatchSrvKeyspace clearing cached entry for %v/%v", cell, keyspace)
 			entry.value = nil
 		}
@@ -463,10 +500,12 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		close(entry.watchStartingChan)
 		entry.watchStartingChan = nil
 		entry.mutex.Unlock()
+		server.restartSrvKeyspaceWatch(entry, current.Err)
 		return
 	}
 
 	// we are now watching, cache the first notification
+	srvTopoWatchHealthy.Set(statsKey, 1)
 	entry.watchState = watchStateRunning
 	close(entry.watchStartingChan)
 	entry.watchStartingChan = nil
@@ -485,11 +524,13 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 			// Watch errored out.
 			//
 			// Log it and store the error, but do not clear the value
-			// so it can be used until the ttl elapses unless the node
+			// so it can be used until the stale ttl elapses unless the node
 			// was deleted.
 			err := fmt.Errorf("WatchSrvKeyspace failed for %v/%v: %v", cell, keyspace, c.Err)
 			log.Errorf("%v", err)
 			server.counts.Add(errorCategory, 1)
+			srvTopoWatchErrors.Add(statsKey, 1)
+			srvTopoWatchHealthy.Set(statsKey, 0)
 			entry.mutex.Lock()
 			if topo.IsErrType(c.Err, topo.NoNode) {
 				entry.value = nil
@@ -498,13 +539,14 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 
 			// Even though we didn't get a new value, update the lastValueTime
 			// here since the watch was successfully running before and we want
-			// the value to be cached for the full TTL from here onwards.
+			// the value to be cached for the full stale TTL from here onwards.
 			entry.lastValueTime = time.Now()
 
 			entry.lastError = err
 			entry.lastErrorCtx = nil
 			entry.lastErrorTime = time.Now()
 			entry.mutex.Unlock()
+			server.restartSrvKeyspaceWatch(entry, c.Err)
 			return
 		}
 
@@ -518,33 +560,96 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 	}
 }
 
+// restartSrvKeyspaceWatch re-establishes a failed SrvKeyspace watch
+// in the background after srv_topo_cache_refresh, without waiting for
+// the next GetSrvKeyspace call, so the cached value is updated as soon
+// as the topology is back. Watches that failed because the SrvKeyspace
+// doesn't exist are only restarted by GetSrvKeyspace.
+func (server *ResilientServer) restartSrvKeyspaceWatch(entry *srvKeyspaceEntry, err error) {
+	if topo.IsErrType(err, topo.NoNode) {
+		return
+	}
+	time.AfterFunc(server.cacheRefresh, func() {
+		entry.mutex.Lock()
+		if entry.watchState != watchStateIdle || time.Since(entry.lastErrorTime) < server.cacheRefresh {
+			// GetSrvKeyspace already restarted it, and will schedule
+			// the next restart if it failed again.
+			entry.mutex.Unlock()
+			return
+		}
+		entry.watchState = watchStateStarting
+		entry.watchStartingChan = make(chan struct{})
+		entry.mutex.Unlock()
+
+		srvTopoWatchRestarts.Add([]string{"SrvKeyspace", entry.cell, entry.keyspace}, 1)
+		server.watchSrvKeyspace(context.Background(), entry, entry.cell, entry.keyspace)
+	})
+}
+
 var watchSrvVSchemaSleepTime = 5 * time.Second
 
 // WatchSrvVSchema is part of the srvtopo.Server interface.
 func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error)) {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
+	sleepTime := watchSrvVSchemaSleepTime
 
 	go func() {
 		foundFirstValue := false
 
-		for {
+		// lastValue is the last SrvVSchema we got from the topo, and
+		// lastValueTime when it was last known to be valid. While the
+		// watch is failing, lastValue is passed on to the callback along
+		// with the error until the stale TTL elapses.
+		var lastValue *vschemapb.SrvVSchema
+		var lastValueTime time.Time
+		forward := func(v *vschemapb.SrvVSchema, err error) {
+			switch {
+			case err == nil:
+				lastValue = v
+				lastValueTime = time.Now()
+			case topo.IsErrType(err, topo.NoNode):
+				lastValue = nil
+			case lastValue != nil && time.Since(lastValueTime) < server.staleTTL:
+				server.counts.Add(cachedCategory, 1)
+				v = lastValue
+			default:
+				lastValue = nil
+			}
+			callback(v, err)
+		}
+
+		statsKey := []string{"SrvVSchema", cell, ""}
+		for i := 0; ; i++ {
+			if i > 0 {
+				srvTopoWatchRestarts.Add(statsKey, 1)
+			}
 			current, changes, _ := server.topoServer.WatchSrvVSchema(ctx, cell)
-			callback(current.Value, current.Err)
+			forward(current.Value, current.Err)
 			if !foundFirstValue {
 				foundFirstValue = true
 				wg.Done()
 			}
 			if current.Err != nil {
+				srvTopoWatchErrors.Add(statsKey, 1)
+				srvTopoWatchHealthy.Set(statsKey, 0)
 				// Don't log if there is no VSchema to start with.
 				if !topo.IsErrType(current.Err, topo.NoNode) {
 					log.Warningf("Error watching vschema for cell %s (will wait 5s before retrying): %v", cell, current.Err)
 				}
 			} else {
+				srvTopoWatchHealthy.Set(statsKey, 1)
 				for c := range changes {
+					if c.Err != nil {
+						// The watch was running until now, so keep the
+						// last value for the full stale TTL from here on.
+						lastValueTime = time.Now()
+					}
 					// Note we forward topo.ErrNoNode as is.
-					callback(c.Value, c.Err)
+					forward(c.Value, c.Err)
 					if c.Err != nil {
+						srvTopoWatchErrors.Add(statsKey, 1)
+						srvTopoWatchHealthy.Set(statsKey, 0)
 						log.Warningf("Error while watching vschema for cell %s (will wait 5s before retrying): %v", cell, c.Err)
 						break
 					}
@@ -552,7 +657,7 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 			}
 
 			// Sleep a bit before trying again.
-			time.Sleep(watchSrvVSchemaSleepTime)
+			time.Sleep(sleepTime)
 		}
 	}()
 
@@ -680,9 +785,9 @@ func (server *ResilientServer) CacheStatus() *ResilientServerCacheStatus {
 	for _, entry := range server.srvKeyspaceCache {
 		entry.mutex.RLock()
 
-		expirationTime := time.Now().Add(server.cacheTTL)
+		expirationTime := time.Now().Add(server.staleTTL)
 		if entry.watchState != watchStateRunning {
-			expirationTime = entry.lastValueTime.Add(server.cacheTTL)
+			expirationTime = entry.lastValueTime.Add(server.staleTTL)
 		}
 
 		result.SrvKeyspaces = append(result.SrvKeyspaces, &SrvKeyspaceCacheStatus{
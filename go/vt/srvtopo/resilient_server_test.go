@@ -395,6 +395,125 @@ func TestGetSrvKeyspaceCreated(t *testing.T) {
 	}
 }
 
+// TestGetSrvKeyspaceStale will test we keep serving the last known
+// SrvKeyspace while the topo is failing, until the stale TTL elapses.
+func TestGetSrvKeyspaceStale(t *testing.T) {
+	ts, factory := memorytopo.NewServerAndFactory("test_cell")
+	*srvTopoCacheTTL = 100 * time.Millisecond
+	*srvTopoCacheRefresh = 40 * time.Millisecond
+	*srvTopoStaleTTL = 500 * time.Millisecond
+	defer func() {
+		*srvTopoCacheTTL = 1 * time.Second
+		*srvTopoCacheRefresh = 1 * time.Second
+		*srvTopoStaleTTL = 0
+	}()
+	rs := NewResilientServer(ts, "TestGetSrvKeyspaceStale")
+
+	want := &topodatapb.SrvKeyspace{
+		ShardingColumnName: "id",
+		ShardingColumnType: topodatapb.KeyspaceIdType_UINT64,
+	}
+	ts.UpdateSrvKeyspace(context.Background(), "test_cell", "test_ks", want)
+	expiry := time.Now().Add(5 * time.Second)
+	for {
+		got, err := rs.GetSrvKeyspace(context.Background(), "test_cell", "test_ks")
+		if err == nil && proto.Equal(want, got) {
+			break
+		}
+		if time.Now().After(expiry) {
+			t.Fatalf("timeout waiting for keyspace value")
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	// Break the topo: the value should outlive the cache TTL.
+	forceErr := fmt.Errorf("test topo error")
+	factory.SetError(forceErr)
+	errorStart := time.Now()
+	for time.Since(errorStart) < 2**srvTopoCacheTTL {
+		got, err := rs.GetSrvKeyspace(context.Background(), "test_cell", "test_ks")
+		if err != nil || !proto.Equal(want, got) {
+			t.Fatalf("expected keyspace to be served after %v, got error %v", time.Since(errorStart), err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Then the error should be returned once the stale TTL elapses.
+	expiry = time.Now().Add(5 * time.Second)
+	for {
+		_, err := rs.GetSrvKeyspace(context.Background(), "test_cell", "test_ks")
+		if err == forceErr {
+			break
+		}
+		if time.Now().After(expiry) {
+			t.Fatalf("timed out waiting for error, got %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if elapsed := time.Since(errorStart); elapsed < *srvTopoStaleTTL {
+		t.Errorf("value was served for %v, want at least %v", elapsed, *srvTopoStaleTTL)
+	}
+	factory.SetError(nil)
+}
+
+// TestSrvKeyspaceWatchRestart tests a failed SrvKeyspace watch is
+// re-established in the background, without a GetSrvKeyspace call.
+func TestSrvKeyspaceWatchRestart(t *testing.T) {
+	ts, factory := memorytopo.NewServerAndFactory("test_cell")
+	*srvTopoCacheTTL = 100 * time.Millisecond
+	*srvTopoCacheRefresh = 40 * time.Millisecond
+	defer func() {
+		*srvTopoCacheTTL = 1 * time.Second
+		*srvTopoCacheRefresh = 1 * time.Second
+	}()
+	rs := NewResilientServer(ts, "TestSrvKeyspaceWatchRestart")
+	statsKey := "SrvKeyspace.test_cell.restart_ks"
+
+	ts.UpdateSrvKeyspace(context.Background(), "test_cell", "restart_ks", &topodatapb.SrvKeyspace{ShardingColumnName: "id"})
+	if _, err := rs.GetSrvKeyspace(context.Background(), "test_cell", "restart_ks"); err != nil {
+		t.Fatalf("GetSrvKeyspace failed: %v", err)
+	}
+	if got := srvTopoWatchHealthy.Counts()[statsKey]; got != 1 {
+		t.Errorf("SrvTopoWatchHealthy = %v, want 1", got)
+	}
+
+	// Break the topo, and wait for the watch to fail.
+	factory.SetError(fmt.Errorf("test topo error"))
+	expiry := time.Now().Add(5 * time.Second)
+	for srvTopoWatchHealthy.Counts()[statsKey] != 0 {
+		if time.Now().After(expiry) {
+			t.Fatalf("timed out waiting for the watch to fail")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	restarts := srvTopoWatchRestarts.Counts()[statsKey]
+
+	// Fix it, the watch comes back on its own and gets the new value.
+	factory.SetError(nil)
+	want := &topodatapb.SrvKeyspace{ShardingColumnName: "id2"}
+	ts.UpdateSrvKeyspace(context.Background(), "test_cell", "restart_ks", want)
+	entry := rs.getSrvKeyspaceEntry("test_cell", "restart_ks")
+	expiry = time.Now().Add(5 * time.Second)
+	for {
+		entry.mutex.RLock()
+		running, got := entry.watchState == watchStateRunning, entry.value
+		entry.mutex.RUnlock()
+		if running && proto.Equal(want, got) {
+			break
+		}
+		if time.Now().After(expiry) {
+			t.Fatalf("timed out waiting for the watch to be re-established")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if got := srvTopoWatchRestarts.Counts()[statsKey]; got <= restarts {
+		t.Errorf("SrvTopoWatchRestarts = %v, want > %v", got, restarts)
+	}
+	if got := srvTopoWatchHealthy.Counts()[statsKey]; got != 1 {
+		t.Errorf("SrvTopoWatchHealthy = %v, want 1", got)
+	}
+}
+
 func TestWatchSrvVSchema(t *testing.T) {
 	watchSrvVSchemaSleepTime = 10 * time.Millisecond
 	ctx := context.Background()
@@ -479,6 +598,93 @@ func TestWatchSrvVSchema(t *testing.T) {
 	}
 }
 
+func TestWatchSrvVSchemaStale(t *testing.T) {
+	watchSrvVSchemaSleepTime = 10 * time.Millisecond
+	*srvTopoCacheTTL = 100 * time.Millisecond
+	*srvTopoCacheRefresh = 40 * time.Millisecond
+	*srvTopoStaleTTL = 300 * time.Millisecond
+	defer func() {
+		*srvTopoCacheTTL = 1 * time.Second
+		*srvTopoCacheRefresh = 1 * time.Second
+		*srvTopoStaleTTL = 0
+	}()
+	ctx := context.Background()
+	ts, factory := memorytopo.NewServerAndFactory("test_cell")
+	rs := NewResilientServer(ts, "TestWatchSrvVSchemaStale")
+
+	value := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {},
+		},
+	}
+	if err := ts.UpdateSrvVSchema(ctx, "test_cell", value); err != nil {
+		t.Fatalf("UpdateSrvVSchema failed: %v", err)
+	}
+
+	// mu protects watchValue and watchErr.
+	mu := sync.Mutex{}
+	var watchValue *vschemapb.SrvVSchema
+	var watchErr error
+	rs.WatchSrvVSchema(ctx, "test_cell", func(v *vschemapb.SrvVSchema, e error) {
+		mu.Lock()
+		defer mu.Unlock()
+		watchValue = v
+		watchErr = e
+	})
+	get := func() (*vschemapb.SrvVSchema, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return watchValue, watchErr
+	}
+	if v, err := get(); err != nil || !proto.Equal(value, v) {
+		t.Fatalf("WatchSrvVSchema got %v, %v, want %v", v, err, value)
+	}
+
+	// Break the topo: the last value is passed along with the error.
+	forceErr := fmt.Errorf("test topo error")
+	factory.SetError(forceErr)
+	errorStart := time.Now()
+	for {
+		if _, err := get(); err != nil {
+			break
+		}
+		if time.Since(errorStart) > 5*time.Second {
+			t.Fatalf("timed out waiting for watch error")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if v, _ := get(); !proto.Equal(value, v) {
+		t.Errorf("WatchSrvVSchema got %v, want stale value %v", v, value)
+	}
+
+	// Until the stale TTL elapses.
+	for {
+		if v, _ := get(); v == nil {
+			break
+		}
+		if time.Since(errorStart) > 5*time.Second {
+			t.Fatalf("timed out waiting for the stale value to expire")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if elapsed := time.Since(errorStart); elapsed < *srvTopoStaleTTL {
+		t.Errorf("stale value was served for %v, want at least %v", elapsed, *srvTopoStaleTTL)
+	}
+
+	// Fix the topo, the value comes back.
+	factory.SetError(nil)
+	start := time.Now()
+	for {
+		if v, err := get(); err == nil && proto.Equal(value, v) {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("timed out waiting for SrvVschema")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestGetSrvKeyspaceNames(t *testing.T) {
 	ts, factory := memorytopo.NewServerAndFactory("test_cell")
 	*srvTopoCacheTTL = 100 * time.Millisecond
@@ -0,0 +1,450 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the audit log of the topology. When enabled, every
+// Create, Update and Delete a process makes in any cell is recorded as a
+// new file in the AuditPath directory of the global cell. Entries are
+// never modified, so the directory is an append-only log. vtctld prunes
+// the old entries.
+
+var topoAudit = flag.Bool("topo_audit", false, "record every topology change made by this process in the audit log of the global topology")
+
+// AuditPath is the directory of the global cell that contains the
+// audit log entries.
+const AuditPath = "audit"
+
+var _ Conn = (*AuditConn)(nil)
+
+var (
+	auditHost    = "unknown"
+	auditUser    = "unknown"
+	auditProcess = filepath.Base(os.Args[0])
+
+	// auditSequence makes entry names unique within a process.
+	auditSequence int64
+)
+
+// auditReadPageSize is how many entries GetAuditLog reads at once.
+const auditReadPageSize = 50
+
+func init() {
+	if h, err := os.Hostname(); err == nil {
+		auditHost = h
+	}
+	if u, err := user.Current(); err == nil {
+		auditUser = u.Username
+	}
+}
+
+// AuditEntry describes a change made to the topology.
+// It needs to be public as we JSON-serialize it.
+type AuditEntry struct {
+	Time      time.Time
+	HostName  string
+	Process   string
+	UserName  string
+	Principal string   `json:",omitempty"`
+	Actions   []string `json:",omitempty"`
+
+	Operation string
+	Cell      string
+	Path      string
+
+	// Version is the version an Update or a Delete was made against,
+	// and NewVersion is the version of the contents after the change.
+	// They are empty if there was no such version.
+	Version    string `json:",omitempty"`
+	NewVersion string `json:",omitempty"`
+
+	// OldHash and NewHash are the SHA-256 of the contents before and
+	// after the change. OldHash is empty for a Create, or if the
+	// contents could not be read, and NewHash is empty for a Delete.
+	OldHash string `json:",omitempty"`
+	NewHash string `json:",omitempty"`
+
+	// Name is the name of the entry in the audit log. It is set by
+	// GetAuditLog, and can be used as its before parameter to read
+	// the previous entries.
+	Name string `json:",omitempty"`
+}
+
+// AuditConn is a wrapper for a Conn that records the changes made to
+// the topology in the audit log.
+type AuditConn struct {
+	cell string
+	conn Conn
+
+	// auditLog is the connection to the global cell the entries are
+	// written to. It is not an AuditConn, so the entries are not
+	// themselves audited.
+	auditLog Conn
+
+	// mu protects hashes.
+	mu sync.Mutex
+	// hashes has the version and the hash of the contents of the
+	// files this connection last read or wrote. Most changes are
+	// made against the version that was just read, so the contents
+	// they replace don't need to be read again.
+	hashes map[string]auditHash
+}
+
+// auditHash is the hash of the contents of a file at a version.
+type auditHash struct {
+	version string
+	hash    string
+}
+
+// NewAuditConn returns an AuditConn.
+func NewAuditConn(cell string, conn, auditLog Conn) *AuditConn {
+	return &AuditConn{
+		cell:     cell,
+		conn:     conn,
+		auditLog: auditLog,
+		hashes:   make(map[string]auditHash),
+	}
+}
+
+func hashContents(contents []byte) string {
+	if contents == nil {
+		return ""
+	}
+	h := sha256.Sum256(contents)
+	return hex.EncodeToString(h[:])
+}
+
+func versionString(version Version) string {
+	if version == nil {
+		return ""
+	}
+	return version.String()
+}
+
+// remember saves the hash of the contents of filePath at version.
+// A nil version forgets it.
+func (ac *AuditConn) remember(filePath string, version Version, hash string) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if version == nil {
+		delete(ac.hashes, filePath)
+		return
+	}
+	ac.hashes[filePath] = auditHash{version: version.String(), hash: hash}
+}
+
+// oldHash returns the hash of the contents a change made against
+// version will replace. If this connection doesn't know it, the
+// contents are read. Without a version, they may be changed again
+// before our own change is made.
+func (ac *AuditConn) oldHash(ctx context.Context, filePath string, version Version) string {
+	if version != nil {
+		ac.mu.Lock()
+		h, ok := ac.hashes[filePath]
+		ac.mu.Unlock()
+		if ok && h.version == version.String() {
+			return h.hash
+		}
+	}
+	contents, current, err := ac.conn.Get(ctx, filePath)
+	if err != nil || (version != nil && current.String() != version.String()) {
+		// The change will fail.
+		return ""
+	}
+	return hashContents(contents)
+}
+
+// record writes an entry to the audit log. Failures are only logged,
+// as the change itself was already made.
+func (ac *AuditConn) record(ctx context.Context, operation, filePath string, version, newVersion Version, oldHash, newHash string) {
+	entry := &AuditEntry{
+		Time:       time.Now(),
+		HostName:   auditHost,
+		Process:    auditProcess,
+		UserName:   auditUser,
+		Principal:  callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)),
+		Actions:    lockActionsFromContext(ctx),
+		Operation:  operation,
+		Cell:       ac.cell,
+		Path:       filePath,
+		Version:    versionString(version),
+		NewVersion: versionString(newVersion),
+		OldHash:    oldHash,
+		NewHash:    newHash,
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		log.Warningf("cannot JSON-marshal topo audit entry %+v: %v", entry, err)
+		return
+	}
+
+	// Zero-padded so the entries list in chronological order.
+	name := fmt.Sprintf("%019d-%v-%d-%d", entry.Time.UnixNano(), auditHost, os.Getpid(), atomic.AddInt64(&auditSequence, 1))
+	if _, err := ac.auditLog.Create(ctx, path.Join(AuditPath, name), data); err != nil {
+		log.Warningf("cannot record topo audit entry for %v %v in cell %v: %v", operation, filePath, ac.cell, err)
+	}
+}
+
+// auditEntryTime returns the time an entry was recorded at,
+// from its name.
+func auditEntryTime(name string) (time.Time, bool) {
+	parts := strings.SplitN(name, "-", 2)
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// listAuditLog returns the names of the entries of the audit
+// log, oldest first.
+func listAuditLog(ctx context.Context, conn Conn) ([]string, error) {
+	entries, err := conn.ListDir(ctx, AuditPath, false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	names := DirEntriesToStringArray(entries)
+	sort.Strings(names)
+	return names, nil
+}
+
+// pruneAuditLog deletes the entries recorded before cutoff, and
+// returns how many were deleted.
+func pruneAuditLog(ctx context.Context, conn Conn, cutoff time.Time) (int, error) {
+	names, err := listAuditLog(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, name := range names {
+		t, ok := auditEntryTime(name)
+		if !ok {
+			continue
+		}
+		if !t.Before(cutoff) {
+			// The names are sorted, so the next ones are more recent.
+			break
+		}
+		if err := conn.Delete(ctx, path.Join(AuditPath, name), nil); err != nil && !IsErrType(err, NoNode) {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// isAuditPath returns true for the files of the audit log itself.
+func (ac *AuditConn) isAuditPath(filePath string) bool {
+	return ac.cell == GlobalCell && strings.HasPrefix(path.Clean(filePath), AuditPath+"/")
+}
+
+// ListDir is part of the Conn interface
+func (ac *AuditConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	return ac.conn.ListDir(ctx, dirPath, full)
+}
+
+// Create is part of the Conn interface
+func (ac *AuditConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	if ac.isAuditPath(filePath) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the topology audit log cannot be modified")
+	}
+	version, err := ac.conn.Create(ctx, filePath, contents)
+	if err == nil {
+		newHash := hashContents(contents)
+		ac.remember(filePath, version, newHash)
+		ac.record(ctx, "Create", filePath, nil, version, "", newHash)
+	}
+	return version, err
+}
+
+// Update is part of the Conn interface
+func (ac *AuditConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	if ac.isAuditPath(filePath) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the topology audit log cannot be modified")
+	}
+	oldHash := ac.oldHash(ctx, filePath, version)
+	newVersion, err := ac.conn.Update(ctx, filePath, contents, version)
+	if err == nil {
+		newHash := hashContents(contents)
+		ac.remember(filePath, newVersion, newHash)
+		ac.record(ctx, "Update", filePath, version, newVersion, oldHash, newHash)
+	}
+	return newVersion, err
+}
+
+// Get is part of the Conn interface
+func (ac *AuditConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	contents, version, err := ac.conn.Get(ctx, filePath)
+	if err == nil {
+		ac.remember(filePath, version, hashContents(contents))
+	}
+	return contents, version, err
+}
+
+// Delete is part of the Conn interface
+func (ac *AuditConn) Delete(ctx context.Context, filePath string, version Version) error {
+	if ac.isAuditPath(filePath) {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the topology audit log cannot be modified")
+	}
+	oldHash := ac.oldHash(ctx, filePath, version)
+	err := ac.conn.Delete(ctx, filePath, version)
+	if err == nil {
+		ac.remember(filePath, nil, "")
+		ac.record(ctx, "Delete", filePath, version, nil, oldHash, "")
+	}
+	return err
+}
+
+// Lock is part of the Conn interface
+func (ac *AuditConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	return ac.conn.Lock(ctx, dirPath, contents)
+}
+
+// Watch is part of the Conn interface
+func (ac *AuditConn) Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	return ac.conn.Watch(ctx, filePath)
+}
+
+// NewMasterParticipation is part of the Conn interface
+func (ac *AuditConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
+	return ac.conn.NewMasterParticipation(name, id)
+}
+
+// Close is part of the Conn interface
+func (ac *AuditConn) Close() {
+	ac.conn.Close()
+}
+
+// GetAuditLog returns the last limit entries of the audit log whose
+// path starts with pathPrefix, oldest first. A limit of 0 returns all
+// the entries. If before is set, only the entries older than the entry
+// with that name are returned, so the log can be read one page at a
+// time by passing the Name of the oldest entry of the previous page.
+func (ts *Server) GetAuditLog(ctx context.Context, pathPrefix, before string, limit int) ([]*AuditEntry, error) {
+	names, err := listAuditLog(ctx, ts.globalCell)
+	if err != nil {
+		return nil, err
+	}
+	if before != "" {
+		names = names[:sort.SearchStrings(names, before)]
+	}
+
+	// The entries are read one page at a time, most recent first,
+	// until we have enough of them.
+	var result []*AuditEntry
+	for end := len(names); end > 0 && (limit == 0 || len(result) < limit); end -= auditReadPageSize {
+		start := end - auditReadPageSize
+		if start < 0 {
+			start = 0
+		}
+		page, err := ts.readAuditEntries(ctx, names[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for i := len(page) - 1; i >= 0; i-- {
+			if limit > 0 && len(result) == limit {
+				break
+			}
+			if page[i] == nil || !strings.HasPrefix(page[i].Path, pathPrefix) {
+				continue
+			}
+			result = append(result, page[i])
+		}
+	}
+
+	// Reverse to return the oldest first.
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+// readAuditEntries reads the named entries of the audit log in
+// parallel. The entries that were pruned in the meantime are nil.
+func (ts *Server) readAuditEntries(ctx context.Context, names []string) ([]*AuditEntry, error) {
+	entries := make([]*AuditEntry, len(names))
+	wg := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			data, _, err := ts.globalCell.Get(ctx, path.Join(AuditPath, name))
+			switch {
+			case IsErrType(err, NoNode):
+				return
+			case err != nil:
+				rec.RecordError(err)
+				return
+			}
+			entry := &AuditEntry{}
+			if err := json.Unmarshal(data, entry); err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "bad topo audit entry %v", name))
+				return
+			}
+			entry.Name = name
+			entries[i] = entry
+		}(i, name)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	return entries, nil
+}
+
+// PruneAuditLog deletes the entries of the audit log recorded before
+// cutoff, and returns how many were deleted. vtctld calls it
+// periodically with its -topo_audit_retention.
+func (ts *Server) PruneAuditLog(ctx context.Context, cutoff time.Time) (int, error) {
+	conn := ts.auditLog
+	if conn == nil {
+		// This process does not audit its changes, so the
+		// global cell can modify the audit log.
+		conn = ts.globalCell
+	}
+	return pruneAuditLog(ctx, conn, cutoff)
+}
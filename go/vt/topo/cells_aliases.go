@@ -19,6 +19,8 @@ package topo
 import (
 	"fmt"
 	"path"
+	"sort"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
@@ -175,3 +177,87 @@ func validateAlias(currentAliases map[string]*topodatapb.CellsAlias, newAliasNam
 	}
 	return nil
 }
+
+// ValidateCellsAliases checks that all the cells of the CellsAliases
+// exist, and that no cell belongs to more than one alias. Replica and
+// rdonly traffic is only routed across cells of the same alias, so
+// such mistakes change where queries are served from.
+func (ts *Server) ValidateCellsAliases(ctx context.Context) error {
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return err
+	}
+	aliases, err := ts.GetCellsAliases(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	cellToAlias := make(map[string]string)
+	for _, name := range names {
+		if len(aliases[name].Cells) == 0 {
+			problems = append(problems, fmt.Sprintf("cells alias %v has no cells", name))
+		}
+		for _, cell := range aliases[name].Cells {
+			if !InCellList(cell, cells) {
+				problems = append(problems, fmt.Sprintf("cells alias %v has unknown cell %q", name, cell))
+			}
+			if other, ok := cellToAlias[cell]; ok {
+				problems = append(problems, fmt.Sprintf("cell %v is in both cells aliases %v and %v", cell, other, name))
+				continue
+			}
+			cellToAlias[cell] = name
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid cells aliases: %v", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// GetServingCells returns the sorted list of cells whose tablets of
+// the given type can serve queries routed from the given cell: all the
+// cells for masters, and for the other types the cells in the same
+// CellsAlias as the cell, or the cell alone if it is in no alias.
+func (ts *Server) GetServingCells(ctx context.Context, cell string, tabletType topodatapb.TabletType) ([]string, error) {
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !InCellList(cell, cells) {
+		return nil, NewError(NoNode, cell)
+	}
+	if tabletType == topodatapb.TabletType_MASTER {
+		sort.Strings(cells)
+		return cells, nil
+	}
+
+	aliases, err := ts.GetCellsAliases(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		members := make(map[string]bool)
+		for _, c := range alias.Cells {
+			members[c] = true
+		}
+		if !members[cell] {
+			continue
+		}
+		var result []string
+		for _, c := range cells {
+			if members[c] {
+				result = append(result, c)
+			}
+		}
+		sort.Strings(result)
+		return result, nil
+	}
+	return []string{cell}, nil
+}
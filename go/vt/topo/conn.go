@@ -244,6 +244,17 @@ type LockDescriptor interface {
 	Unlock(ctx context.Context) error
 }
 
+// LockLostNotifier is an optional interface a LockDescriptor can
+// implement when the lock is backed by a lease or session that is
+// renewed in the background. LockKeyspace and LockShard use it to
+// cancel the context of the operation holding the lock, and to call
+// the callbacks registered with OnLockLost, as soon as the renewal fails.
+type LockLostNotifier interface {
+	// Lost returns a channel that is closed when the lock is lost.
+	// It is not closed when the lock is released with Unlock.
+	Lost() <-chan struct{}
+}
+
 // CancelFunc is returned by the Watch method.
 type CancelFunc func()
 
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consultopo
+
+import (
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// This file contains the runtime reload of the consul client
+// credentials. The ACL token, namespace and partition of each cell are
+// not set in the consul api.Config, but added to every request by an
+// authTransport, so they can be changed without re-creating the
+// clients, and without restarting the process.
+
+var (
+	consulAuthReloadInterval = flag.Duration("consul_auth_static_file_reload_interval", 0, "if set, consul_auth_static_file is checked for changes at this interval, and the new tokens are used without a restart. The file is also reloaded on SIGHUP.")
+
+	consulNamespace = flag.String("topo_consul_namespace", "", "consul namespace the topology data is stored in, for the cells that don't set one in consul_auth_static_file. Requires Consul Enterprise.")
+	consulPartition = flag.String("topo_consul_partition", "", "consul admin partition the topology data is stored in, for the cells that don't set one in consul_auth_static_file. Requires Consul Enterprise.")
+
+	consulAuthReloads = stats.NewCountersWithSingleLabel(
+		"ConsulAuthReloads",
+		"Reloads of consul_auth_static_file, by result",
+		"Result")
+
+	// authMu protects authTransports and authModTime.
+	authMu sync.Mutex
+	// authTransports has the transports of all the open Servers.
+	authTransports = make(map[*authTransport]bool)
+	// authModTime is the modification time of the last loaded
+	// consul_auth_static_file.
+	authModTime time.Time
+
+	startAuthReloadOnce sync.Once
+)
+
+// authTransport is an http.RoundTripper that adds the current ACL token,
+// namespace and partition of a cell to the consul requests.
+type authTransport struct {
+	cell string
+	base http.RoundTripper
+
+	// mu protects the following fields.
+	mu        sync.RWMutex
+	token     string
+	namespace string
+	partition string
+}
+
+func newAuthTransport(cell string, base http.RoundTripper, creds map[string]*ClientAuthCred) *authTransport {
+	at := &authTransport{
+		cell: cell,
+		base: base,
+	}
+	at.update(creds)
+	return at
+}
+
+// update sets the credentials of the cell from creds.
+func (at *authTransport) update(creds map[string]*ClientAuthCred) {
+	cred := &ClientAuthCred{}
+	if creds != nil {
+		if c, ok := creds[at.cell]; ok && c != nil {
+			cred = c
+		} else {
+			log.Warningf("Client auth not configured for cell: %v", at.cell)
+		}
+	}
+	namespace := cred.Namespace
+	if namespace == "" {
+		namespace = *consulNamespace
+	}
+	partition := cred.Partition
+	if partition == "" {
+		partition = *consulPartition
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.token = cred.ACLToken
+	at.namespace = namespace
+	at.partition = partition
+}
+
+// RoundTrip is part of the http.RoundTripper interface.
+func (at *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	at.mu.RLock()
+	token, namespace, partition := at.token, at.namespace, at.partition
+	at.mu.RUnlock()
+	if token == "" && namespace == "" && partition == "" {
+		return at.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the request, work on a copy.
+	r := new(http.Request)
+	*r = *req
+	u := *req.URL
+	r.URL = &u
+	r.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	if token != "" {
+		r.Header.Set("X-Consul-Token", token)
+	}
+	if namespace != "" || partition != "" {
+		q := u.Query()
+		if namespace != "" {
+			q.Set("ns", namespace)
+		}
+		if partition != "" {
+			q.Set("partition", partition)
+		}
+		u.RawQuery = q.Encode()
+	}
+	return at.base.RoundTrip(r)
+}
+
+// registerAuthTransport adds a transport to the ones updated when
+// consul_auth_static_file changes.
+func registerAuthTransport(at *authTransport) {
+	authMu.Lock()
+	authTransports[at] = true
+	authMu.Unlock()
+
+	if *consulAuthClientStaticFile == "" {
+		return
+	}
+	startAuthReloadOnce.Do(func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGHUP)
+		go func() {
+			for range sigChan {
+				reloadClientCreds()
+			}
+		}()
+
+		if *consulAuthReloadInterval > 0 {
+			go func() {
+				for range time.Tick(*consulAuthReloadInterval) {
+					if authFileChanged() {
+						reloadClientCreds()
+					}
+				}
+			}()
+		}
+	})
+}
+
+// unregisterAuthTransport is called when a Server is closed.
+func unregisterAuthTransport(at *authTransport) {
+	authMu.Lock()
+	delete(authTransports, at)
+	authMu.Unlock()
+}
+
+// authFileChanged returns true if consul_auth_static_file was
+// modified since it was last loaded.
+func authFileChanged() bool {
+	fi, err := os.Stat(*consulAuthClientStaticFile)
+	if err != nil {
+		log.Warningf("Cannot stat consul_auth_static_file: %v", err)
+		return false
+	}
+	authMu.Lock()
+	defer authMu.Unlock()
+	return !fi.ModTime().Equal(authModTime)
+}
+
+// reloadClientCreds reads consul_auth_static_file again, and updates
+// the credentials of all the open Servers. If the file cannot be read,
+// the current credentials are kept.
+func reloadClientCreds() {
+	creds, err := getClientCreds()
+	if err != nil {
+		log.Errorf("Cannot reload consul client credentials, keeping the current ones: %v", err)
+		consulAuthReloads.Add("Error", 1)
+		return
+	}
+
+	authMu.Lock()
+	defer authMu.Unlock()
+	for at := range authTransports {
+		at.update(creds)
+	}
+	log.Infof("Reloaded consul client credentials for %v connection(s)", len(authTransports))
+	consulAuthReloads.Add("Success", 1)
+}
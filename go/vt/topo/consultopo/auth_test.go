@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consultopo
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestConsulAuthReload(t *testing.T) {
+	// A fake consul, that remembers the token, namespace and
+	// partition of the last request.
+	var mu sync.Mutex
+	var token, namespace, partition string
+	fake := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		token = r.Header.Get("X-Consul-Token")
+		namespace = r.URL.Query().Get("ns")
+		partition = r.URL.Query().Get("partition")
+		mu.Unlock()
+		http.NotFound(w, r)
+	}))
+	defer fake.Close()
+	check := func(wantToken, wantNamespace, wantPartition string) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		if token != wantToken || namespace != wantNamespace || partition != wantPartition {
+			t.Errorf("got token/namespace/partition %q/%q/%q, want %q/%q/%q", token, namespace, partition, wantToken, wantNamespace, wantPartition)
+		}
+	}
+
+	tmpFile, err := ioutil.TempFile("", "consul_auth_client_static_file.json")
+	if err != nil {
+		t.Fatalf("couldn't create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	writeCreds := func(creds string) {
+		t.Helper()
+		if err := ioutil.WriteFile(tmpFile.Name(), []byte(creds), 0600); err != nil {
+			t.Fatalf("couldn't write temp file: %v", err)
+		}
+	}
+	*consulAuthClientStaticFile = tmpFile.Name()
+	*consulPartition = "default_partition"
+	defer func() {
+		*consulAuthClientStaticFile = ""
+		*consulPartition = ""
+	}()
+
+	writeCreds(`{"test": {"acl_token": "token1", "namespace": "ns1"}}`)
+	s, err := NewServer("test", strings.TrimPrefix(fake.URL, "http://"), "/root")
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	s.Get(ctx, "file")
+	check("token1", "ns1", "default_partition")
+
+	// A new token is used after a reload.
+	writeCreds(`{"test": {"acl_token": "token2", "namespace": "ns1", "partition": "p1"}}`)
+	if !authFileChanged() {
+		t.Errorf("authFileChanged() = false after the file was rewritten")
+	}
+	reloadClientCreds()
+	if authFileChanged() {
+		t.Errorf("authFileChanged() = true after a reload")
+	}
+	s.Get(ctx, "file")
+	check("token2", "ns1", "p1")
+
+	// A broken file keeps the current credentials.
+	writeCreds(`{"test": `)
+	reloadClientCreds()
+	s.Get(ctx, "file")
+	check("token2", "ns1", "p1")
+}
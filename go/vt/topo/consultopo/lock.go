@@ -17,7 +17,9 @@ limitations under the License.
 package consultopo
 
 import (
+	"flag"
 	"path"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"golang.org/x/net/context"
@@ -28,11 +30,23 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 )
 
-// consulLockDescriptor implements topo.LockDescriptor.
+var (
+	lockSessionTTL     = flag.Duration("topo_consul_lock_session_ttl", 15*time.Second, "TTL of the consul session backing locks. The session is renewed periodically while the lock is held.")
+	lockMonitorRetries = flag.Int("topo_consul_lock_monitor_retries", 3, "number of times the monitoring of a held lock is retried on transient consul errors before the lock is considered lost.")
+)
+
+// consulLockDescriptor implements topo.LockDescriptor and
+// topo.LockLostNotifier.
 type consulLockDescriptor struct {
 	s        *Server
 	lockPath string
-	lost     <-chan struct{}
+
+	// lost is closed if consul reports the lock as lost before
+	// Unlock is called.
+	lost chan struct{}
+	// unlocking is closed when Unlock is called, as releasing the
+	// lock also closes the channel returned by consul.
+	unlocking chan struct{}
 }
 
 // Lock is part of the topo.Conn interface.
@@ -50,9 +64,13 @@ func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockD
 	lockPath := path.Join(s.root, dirPath, locksFilename)
 
 	// Build the lock structure.
+	// The consul client creates a session with SessionTTL and
+	// renews it in the background until the lock is released.
 	l, err := s.client.LockOpts(&api.LockOptions{
-		Key:   lockPath,
-		Value: []byte(contents),
+		Key:            lockPath,
+		Value:          []byte(contents),
+		SessionTTL:     lockSessionTTL.String(),
+		MonitorRetries: *lockMonitorRetries,
 	})
 	if err != nil {
 		return nil, err
@@ -83,7 +101,7 @@ func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockD
 	s.mu.Unlock()
 
 	// We are the only ones trying to lock now.
-	lost, err := l.Lock(ctx.Done())
+	consulLost, err := l.Lock(ctx.Done())
 	if err != nil {
 		// Failed to lock, give up our slot in locks map.
 		// Close the channel to unblock anyone else.
@@ -95,12 +113,27 @@ func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockD
 		return nil, err
 	}
 
-	// We got the lock, we're good.
-	return &consulLockDescriptor{
-		s:        s,
-		lockPath: lockPath,
-		lost:     lost,
-	}, nil
+	// We got the lock, watch it until it is released.
+	ld := &consulLockDescriptor{
+		s:         s,
+		lockPath:  lockPath,
+		lost:      make(chan struct{}),
+		unlocking: make(chan struct{}),
+	}
+	go func() {
+		select {
+		case <-consulLost:
+		case <-ld.unlocking:
+			return
+		}
+		select {
+		case <-ld.unlocking:
+		default:
+			log.Errorf("consul session for lock %v expired or was invalidated, the lock is lost", lockPath)
+			close(ld.lost)
+		}
+	}()
+	return ld, nil
 }
 
 // Check is part of the topo.LockDescriptor interface.
@@ -113,8 +146,18 @@ func (ld *consulLockDescriptor) Check(ctx context.Context) error {
 	return nil
 }
 
+// Lost is part of the topo.LockLostNotifier interface.
+func (ld *consulLockDescriptor) Lost() <-chan struct{} {
+	return ld.lost
+}
+
 // Unlock is part of the topo.LockDescriptor interface.
 func (ld *consulLockDescriptor) Unlock(ctx context.Context) error {
+	select {
+	case <-ld.unlocking:
+	default:
+		close(ld.unlocking)
+	}
 	return ld.s.unlock(ctx, ld.lockPath)
 }
 
@@ -24,6 +24,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"sync"
 
 	"github.com/hashicorp/consul/api"
@@ -34,13 +35,17 @@ import (
 )
 
 var (
-	consulAuthClientStaticFile = flag.String("consul_auth_static_file", "", "JSON File to read the topos/tokens from.")
+	consulAuthClientStaticFile = flag.String("consul_auth_static_file", "", "JSON File to read the topos/tokens from. Send SIGHUP, or set consul_auth_static_file_reload_interval, to use new tokens without a restart.")
 )
 
 // ClientAuthCred credential to use for consul clusters
 type ClientAuthCred struct {
 	// ACLToken when provided, the client will use this token when making requests to the Consul server.
 	ACLToken string `json:"acl_token,omitempty"`
+	// Namespace when provided, the client will use this Consul Enterprise namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Partition when provided, the client will use this Consul Enterprise admin partition.
+	Partition string `json:"partition,omitempty"`
 }
 
 // Factory is the consul topo.Factory implementation.
@@ -65,6 +70,11 @@ func getClientCreds() (creds map[string]*ClientAuthCred, err error) {
 		return nil, nil
 	}
 
+	fi, err := os.Stat(*consulAuthClientStaticFile)
+	if err != nil {
+		err = vterrors.Wrapf(err, "Failed to read consul_auth_static_file file")
+		return creds, err
+	}
 	data, err := ioutil.ReadFile(*consulAuthClientStaticFile)
 	if err != nil {
 		err = vterrors.Wrapf(err, "Failed to read consul_auth_static_file file")
@@ -75,6 +85,10 @@ func getClientCreds() (creds map[string]*ClientAuthCred, err error) {
 		err = vterrors.Wrapf(err, fmt.Sprintf("Error parsing consul_auth_static_file"))
 		return creds, err
 	}
+
+	authMu.Lock()
+	authModTime = fi.ModTime()
+	authMu.Unlock()
 	return creds, nil
 }
 
@@ -87,6 +101,9 @@ type Server struct {
 	// root is the root path for this client.
 	root string
 
+	// auth adds the ACL token, namespace and partition to the requests.
+	auth *authTransport
+
 	// mu protects the following fields.
 	mu sync.Mutex
 	// locks is a map of *lockInstance structures.
@@ -111,23 +128,24 @@ func NewServer(cell, serverAddr, root string) (*Server, error) {
 	}
 	cfg := api.DefaultConfig()
 	cfg.Address = serverAddr
-	if creds != nil {
-		if creds[cell] != nil {
-			cfg.Token = creds[cell].ACLToken
-		} else {
-			log.Warningf("Client auth not configured for cell: %v", cell)
-		}
+	cfg.HttpClient, err = api.NewHttpClient(cfg.Transport, cfg.TLSConfig)
+	if err != nil {
+		return nil, err
 	}
+	auth := newAuthTransport(cell, cfg.HttpClient.Transport, creds)
+	cfg.HttpClient.Transport = auth
 
 	client, err := api.NewClient(cfg)
 	if err != nil {
 		return nil, err
 	}
+	registerAuthTransport(auth)
 
 	return &Server{
 		client: client,
 		kv:     client.KV(),
 		root:   root,
+		auth:   auth,
 		locks:  make(map[string]*lockInstance),
 	}, nil
 }
@@ -136,6 +154,7 @@ func NewServer(cell, serverAddr, root string) (*Server, error) {
 // It will nil out the global and cells fields, so any attempt to
 // re-use this server will panic.
 func (s *Server) Close() {
+	unregisterAuthTransport(s.auth)
 	s.client = nil
 	s.kv = nil
 	s.mu.Lock()
@@ -34,6 +34,7 @@ const (
 	PartialResult
 	NoUpdateNeeded
 	NoImplementation
+	ReadOnly
 )
 
 // Error represents a topo error.
@@ -64,6 +65,8 @@ func NewError(code ErrorCode, node string) error {
 		message = fmt.Sprintf("no update needed: %s", node)
 	case NoImplementation:
 		message = fmt.Sprintf("no such topology implementation %s", node)
+	case ReadOnly:
+		message = fmt.Sprintf("topology is read-only: %s", node)
 	default:
 		message = fmt.Sprintf("unknown code: %s", node)
 	}
@@ -93,6 +93,17 @@ func (mp *etcdMasterParticipation) WaitForMastership() (context.Context, error)
 		return nil, err
 	}
 
+	// If the lease backing the lock can't be renewed, we lose the
+	// mastership: cancel the returned context.
+	go func() {
+		select {
+		case <-ld.(topo.LockLostNotifier).Lost():
+			log.Errorf("lost the lock on electionPath %v, giving up mastership", electionPath)
+			lockCancel()
+		case <-lockCtx.Done():
+		}
+	}()
+
 	// We got the lock. Return the lockContext. If Stop() is called,
 	// it will cancel the lockCtx, and cancel the returned context.
 	return lockCtx, nil
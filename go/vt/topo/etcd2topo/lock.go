@@ -110,10 +110,16 @@ func (s *Server) waitOnLastRev(ctx context.Context, cli *clientv3.Client, nodePa
 	return false, nil
 }
 
-// etcdLockDescriptor implements topo.LockDescriptor.
+// etcdLockDescriptor implements topo.LockDescriptor and
+// topo.LockLostNotifier.
 type etcdLockDescriptor struct {
 	s       *Server
 	leaseID clientv3.LeaseID
+
+	// stopKeepAlive stops the lease KeepAlive.
+	stopKeepAlive context.CancelFunc
+	// lost is closed if the KeepAlive stops before Unlock is called.
+	lost chan struct{}
 }
 
 // Lock is part of the topo.Conn interface.
@@ -135,25 +141,39 @@ func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockD
 func (s *Server) lock(ctx context.Context, nodePath, contents string) (topo.LockDescriptor, error) {
 	nodePath = path.Join(s.root, nodePath, locksPath)
 
-	// Get a lease, set its KeepAlive.
+	// Get a lease, set its KeepAlive. The KeepAlive uses its own
+	// context: ctx is usually bound by a short timeout, and the lease
+	// needs to be renewed until Unlock is called, for as long as the
+	// operation holding the lock runs.
 	lease, err := s.cli.Grant(ctx, int64(*leaseTTL))
 	if err != nil {
 		return nil, convertError(err, nodePath)
 	}
-	leaseKA, err := s.cli.KeepAlive(ctx, lease.ID)
+	kaCtx, kaCancel := context.WithCancel(context.Background())
+	leaseKA, err := s.cli.KeepAlive(kaCtx, lease.ID)
 	if err != nil {
+		kaCancel()
 		return nil, convertError(err, nodePath)
 	}
+	lost := make(chan struct{})
 	go func() {
 		// Drain the lease keepAlive channel, we're not
 		// interested in its contents.
 		for range leaseKA {
 		}
+
+		// The channel is closed when kaCtx is canceled, or when
+		// the lease could not be renewed before it expired.
+		if kaCtx.Err() == nil {
+			log.Errorf("KeepAlive for lease %d on %v stopped, the lock is lost", lease.ID, nodePath)
+			close(lost)
+		}
 	}()
 
 	// Create an ephemeral node in the locks directory.
 	key, revision, err := s.newUniqueEphemeralKV(ctx, s.cli, lease.ID, nodePath, contents)
 	if err != nil {
+		kaCancel()
 		return nil, err
 	}
 
@@ -163,6 +183,7 @@ func (s *Server) lock(ctx context.Context, nodePath, contents string) (topo.Lock
 		if err != nil {
 			// We had an error waiting on the last node.
 			// Revoke our lease, this will delete the file.
+			kaCancel()
 			if _, rerr := s.cli.Revoke(context.Background(), lease.ID); rerr != nil {
 				log.Warningf("Revoke(%d) failed, may have left %v behind: %v", lease.ID, key, rerr)
 			}
@@ -171,8 +192,10 @@ func (s *Server) lock(ctx context.Context, nodePath, contents string) (topo.Lock
 		if done {
 			// No more older nodes, we're it!
 			return &etcdLockDescriptor{
-				s:       s,
-				leaseID: lease.ID,
+				s:             s,
+				leaseID:       lease.ID,
+				stopKeepAlive: kaCancel,
+				lost:          lost,
 			}, nil
 		}
 	}
@@ -188,8 +211,14 @@ func (ld *etcdLockDescriptor) Check(ctx context.Context) error {
 	return nil
 }
 
+// Lost is part of the topo.LockLostNotifier interface.
+func (ld *etcdLockDescriptor) Lost() <-chan struct{} {
+	return ld.lost
+}
+
 // Unlock is part of the topo.LockDescriptor interface.
 func (ld *etcdLockDescriptor) Unlock(ctx context.Context) error {
+	ld.stopKeepAlive()
 	_, err := ld.s.cli.Revoke(ctx, ld.leaseID)
 	if err != nil {
 		return convertError(err, "lease")
@@ -295,4 +295,25 @@ func testKeyspaceLock(t *testing.T, ts *topo.Server) {
 	if err := lockDescriptor.Unlock(ctx); err != nil {
 		t.Fatalf("Unlock failed: %v", err)
 	}
+
+	// Short TTL, and the context used to lock is canceled right
+	// away: the lease must still be kept alive until Unlock.
+	lockCtx, cancel := context.WithCancel(ctx)
+	lockDescriptor, err = conn.Lock(lockCtx, keyspacePath, "canceled context")
+	cancel()
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	time.Sleep(3 * time.Second)
+	if err := lockDescriptor.Check(ctx); err != nil {
+		t.Fatalf("Check failed, lock was lost: %v", err)
+	}
+	select {
+	case <-lockDescriptor.(topo.LockLostNotifier).Lost():
+		t.Fatalf("Lost channel closed while the lock is held")
+	default:
+	}
+	if err := lockDescriptor.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
 }
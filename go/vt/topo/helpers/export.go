@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// TopoExportVersion is the version of the TopoExport format written
+// by ExportTopo. ImportTopo refuses exports with a different version.
+const TopoExportVersion = 1
+
+// TopoExport is the content of the topology, as written by ExportTopo.
+// It is meant to be serialized as JSON.
+type TopoExport struct {
+	Version      int                               `json:"version"`
+	Cells        map[string]*topodatapb.CellInfo   `json:"cells"`
+	CellsAliases map[string]*topodatapb.CellsAlias `json:"cells_aliases,omitempty"`
+	Keyspaces    map[string]*KeyspaceExport        `json:"keyspaces"`
+	RoutingRules *vschemapb.RoutingRules           `json:"routing_rules,omitempty"`
+	Tablets      []*topodatapb.Tablet              `json:"tablets"`
+}
+
+// KeyspaceExport is the content of a keyspace in a TopoExport.
+type KeyspaceExport struct {
+	Keyspace *topodatapb.Keyspace         `json:"keyspace"`
+	VSchema  *vschemapb.Keyspace          `json:"vschema,omitempty"`
+	Shards   map[string]*topodatapb.Shard `json:"shards"`
+}
+
+// ExportTopo reads the cells, cells aliases, keyspaces, shards,
+// vschemas, routing rules and tablets from the topo. The serving graph
+// and the replication graph are not exported: they are rebuilt from
+// the rest of the data.
+func ExportTopo(ctx context.Context, ts *topo.Server) (*TopoExport, error) {
+	export := &TopoExport{
+		Version:   TopoExportVersion,
+		Cells:     make(map[string]*topodatapb.CellInfo),
+		Keyspaces: make(map[string]*KeyspaceExport),
+	}
+
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetCellInfoNames()")
+	}
+	for _, cell := range cells {
+		ci, err := ts.GetCellInfo(ctx, cell, true /*strongRead*/)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetCellInfo(%v)", cell)
+		}
+		export.Cells[cell] = ci
+	}
+
+	if export.CellsAliases, err = ts.GetCellsAliases(ctx, true /*strongRead*/); err != nil {
+		return nil, vterrors.Wrap(err, "GetCellsAliases()")
+	}
+
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetKeyspaces()")
+	}
+	for _, keyspace := range keyspaces {
+		ki, err := ts.GetKeyspace(ctx, keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetKeyspace(%v)", keyspace)
+		}
+		ke := &KeyspaceExport{
+			Keyspace: ki.Keyspace,
+			Shards:   make(map[string]*topodatapb.Shard),
+		}
+
+		ke.VSchema, err = ts.GetVSchema(ctx, keyspace)
+		switch {
+		case err == nil:
+			// Nothing to do.
+		case topo.IsErrType(err, topo.NoNode):
+			// Nothing to do.
+		default:
+			return nil, vterrors.Wrapf(err, "GetVSchema(%v)", keyspace)
+		}
+
+		shards, err := ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShardNames(%v)", keyspace)
+		}
+		for _, shard := range shards {
+			si, err := ts.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "GetShard(%v, %v)", keyspace, shard)
+			}
+			ke.Shards[shard] = si.Shard
+		}
+		export.Keyspaces[keyspace] = ke
+	}
+
+	rr, err := ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetRoutingRules()")
+	}
+	if len(rr.Rules) > 0 {
+		export.RoutingRules = rr
+	}
+
+	for _, cell := range cells {
+		tabletAliases, err := ts.GetTabletsByCell(ctx, cell)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetTabletsByCell(%v)", cell)
+		}
+		for _, tabletAlias := range tabletAliases {
+			ti, err := ts.GetTablet(ctx, tabletAlias)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "GetTablet(%v)", tabletAlias)
+			}
+			export.Tablets = append(export.Tablets, ti.Tablet)
+		}
+	}
+
+	return export, nil
+}
+
+// ImportTopo writes the content of a TopoExport into the topo.
+//
+// Cells that already exist are kept as they are, so the cells of the
+// destination can be created beforehand to point at a different topo
+// implementation. Other objects that already exist are overwritten with
+// the exported values. The caller is expected to rebuild the serving
+// graph afterwards.
+func ImportTopo(ctx context.Context, ts *topo.Server, export *TopoExport) error {
+	if export.Version != TopoExportVersion {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unsupported topo export version %v, want %v", export.Version, TopoExportVersion)
+	}
+
+	for cell, ci := range export.Cells {
+		err := ts.CreateCellInfo(ctx, cell, ci)
+		switch {
+		case err == nil:
+			// Nothing to do.
+		case topo.IsErrType(err, topo.NodeExists):
+			log.Infof("cell %v already exists, keeping it", cell)
+		default:
+			return vterrors.Wrapf(err, "CreateCellInfo(%v)", cell)
+		}
+	}
+
+	for alias, ca := range export.CellsAliases {
+		if err := ts.UpdateCellsAlias(ctx, alias, func(toCA *topodatapb.CellsAlias) error {
+			*toCA = *ca
+			return nil
+		}); err != nil {
+			return vterrors.Wrapf(err, "UpdateCellsAlias(%v)", alias)
+		}
+	}
+
+	for keyspace, ke := range export.Keyspaces {
+		err := ts.CreateKeyspace(ctx, keyspace, ke.Keyspace)
+		if topo.IsErrType(err, topo.NodeExists) {
+			log.Warningf("keyspace %v already exists, updating it", keyspace)
+			err = updateKeyspace(ctx, ts, keyspace, ke.Keyspace)
+		}
+		if err != nil {
+			return vterrors.Wrapf(err, "CreateKeyspace(%v)", keyspace)
+		}
+
+		if ke.VSchema != nil {
+			if err := ts.SaveVSchema(ctx, keyspace, ke.VSchema); err != nil {
+				return vterrors.Wrapf(err, "SaveVSchema(%v)", keyspace)
+			}
+		}
+
+		for shard, s := range ke.Shards {
+			if err := ts.CreateShard(ctx, keyspace, shard); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+				return vterrors.Wrapf(err, "CreateShard(%v, %v)", keyspace, shard)
+			}
+			if _, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+				*si.Shard = *s
+				return nil
+			}); err != nil {
+				return vterrors.Wrapf(err, "UpdateShardFields(%v, %v)", keyspace, shard)
+			}
+		}
+	}
+
+	if export.RoutingRules != nil {
+		if err := ts.SaveRoutingRules(ctx, export.RoutingRules); err != nil {
+			return vterrors.Wrap(err, "SaveRoutingRules()")
+		}
+	}
+
+	for _, tablet := range export.Tablets {
+		err := ts.CreateTablet(ctx, tablet)
+		if topo.IsErrType(err, topo.NodeExists) {
+			_, err = ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+				*t = *tablet
+				return nil
+			})
+		}
+		if err != nil {
+			return vterrors.Wrapf(err, "CreateTablet(%v)", tablet.Alias)
+		}
+	}
+
+	return nil
+}
+
+// updateKeyspace overwrites an existing keyspace record, under the
+// keyspace lock.
+func updateKeyspace(ctx context.Context, ts *topo.Server, keyspace string, ks *topodatapb.Keyspace) (err error) {
+	ctx, unlock, lockErr := ts.LockKeyspace(ctx, keyspace, "ImportTopo")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	*ki.Keyspace = *ks
+	return ts.UpdateKeyspace(ctx, ki)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+	}
+	if err := fromTS.SaveVSchema(ctx, "test_keyspace", vs); err != nil {
+		t.Fatalf("cannot save vschema: %v", err)
+	}
+	ca := &topodatapb.CellsAlias{Cells: []string{"test_cell"}}
+	if err := fromTS.CreateCellsAlias(ctx, "test_alias", ca); err != nil {
+		t.Fatalf("cannot create cells alias: %v", err)
+	}
+
+	export, err := ExportTopo(ctx, fromTS)
+	if err != nil {
+		t.Fatalf("ExportTopo failed: %v", err)
+	}
+	if export.Version != TopoExportVersion {
+		t.Errorf("export.Version: %v, want %v", export.Version, TopoExportVersion)
+	}
+	if len(export.Tablets) != 2 {
+		t.Errorf("export.Tablets: %v, want 2 tablets", export.Tablets)
+	}
+
+	// Go through the JSON encoding, like the vtctl commands do.
+	data, err := json.Marshal(export)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	export = &TopoExport{}
+	if err := json.Unmarshal(data, export); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	// Importing twice overwrites the existing objects.
+	for i := 0; i < 2; i++ {
+		if err := ImportTopo(ctx, toTS, export); err != nil {
+			t.Fatalf("ImportTopo failed: %v", err)
+		}
+	}
+
+	for _, compare := range []func(context.Context, *topo.Server, *topo.Server) error{
+		CompareKeyspaces,
+		CompareShards,
+		CompareTablets,
+		CompareShardReplications,
+		CompareRoutingRules,
+	} {
+		if err := compare(ctx, fromTS, toTS); err != nil {
+			t.Errorf("topos differ after import: %v", err)
+		}
+	}
+	aliases, err := toTS.GetCellsAliases(ctx, true)
+	if err != nil {
+		t.Fatalf("GetCellsAliases failed: %v", err)
+	}
+	if !proto.Equal(aliases["test_alias"], ca) {
+		t.Errorf("cells alias: %v, want %v", aliases["test_alias"], ca)
+	}
+}
+
+func TestImportVersion(t *testing.T) {
+	ctx := context.Background()
+	_, toTS := createSetup(ctx, t)
+
+	err := ImportTopo(ctx, toTS, &TopoExport{Version: TopoExportVersion + 1})
+	if err == nil || !strings.Contains(err.Error(), "unsupported topo export version") {
+		t.Errorf("ImportTopo: %v, want unsupported version error", err)
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package k8stopo
 
 import (
+	"strconv"
+
 	"vitess.io/vitess/go/vt/topo"
 )
 
@@ -36,5 +38,5 @@ func VersionFromInt(version int64) topo.Version {
 	if version == -1 {
 		return nil
 	}
-	return KubernetesVersion(version)
+	return KubernetesVersion(strconv.FormatInt(version, 10))
 }
@@ -18,7 +18,10 @@ package k8stopo
 
 import (
 	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
 
 	"vitess.io/vitess/go/vt/log"
@@ -47,81 +50,128 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 	// Create a context, will be used to cancel the watch.
 	watchCtx, watchCancel := context.WithCancel(context.Background())
 
-	// Create the changes channel
-	changes := make(chan *topo.WatchData, 10)
-
-	// Create a signal channel for non-interrupt shutdowns
-	gracefulShutdown := make(chan struct{})
-
-	resource, err := s.buildFileResource(filePath, []byte{})
-	if err != nil {
-		// Per the topo.Conn interface:
-		// current.Err is set, and 'changes'/'cancel' are nil
-		current.Err = err
-		return current, nil, nil
+	w := &watcher{
+		filePath:    filePath,
+		name:        s.newNodeReference(filePath).id,
+		ctx:         watchCtx,
+		cancel:      watchCancel,
+		changes:     make(chan *topo.WatchData, 10),
+		lastVersion: ver.String(),
 	}
 
-	// Create the informer / indexer to watch the single resource
-	restClient := s.vtKubeClient.TopoV1beta1().RESTClient()
-	listwatch := cache.NewListWatchFromClient(restClient, "vitesstoponodes", s.namespace, fields.OneTermEqualSelector("metadata.name", resource.Name))
-
-	// set up index funcs
-	indexers := cache.Indexers{}
-	indexers["by_parent"] = indexByParent
+	// Create the informer to watch the single resource. The informer
+	// keeps a watch open on the API server, so changes are delivered
+	// as soon as they are committed instead of on the next poll.
+	selector := fields.OneTermEqualSelector("metadata.name", w.name).String()
+	listwatch := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return s.resourceClient.List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return s.resourceClient.Watch(options)
+		},
+	}
 
-	_, memberInformer := cache.NewIndexerInformer(listwatch, &vtv1beta1.VitessTopoNode{}, 0,
+	_, informer := cache.NewInformer(listwatch, &vtv1beta1.VitessTopoNode{}, 0,
 		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				vtn := obj.(*vtv1beta1.VitessTopoNode)
-				out, err := unpackValue([]byte(vtn.Data.Value))
-				if err != nil {
-					changes <- &topo.WatchData{Err: err}
-					close(gracefulShutdown)
-				} else {
-					changes <- &topo.WatchData{
-						Contents: out,
-						Version:  KubernetesVersion(vtn.GetResourceVersion()),
-					}
-				}
-			},
+			AddFunc: w.onChange,
 			UpdateFunc: func(oldObj, newObj interface{}) {
-				vtn := newObj.(*vtv1beta1.VitessTopoNode)
-				out, err := unpackValue([]byte(vtn.Data.Value))
-				if err != nil {
-					changes <- &topo.WatchData{Err: err}
-					close(gracefulShutdown)
-				} else {
-					changes <- &topo.WatchData{
-						Contents: out,
-						Version:  KubernetesVersion(vtn.GetResourceVersion()),
-					}
-				}
-			},
-			DeleteFunc: func(obj interface{}) {
-				vtn := obj.(*vtv1beta1.VitessTopoNode)
-				changes <- &topo.WatchData{Err: topo.NewError(topo.NoNode, vtn.Name)}
-				close(gracefulShutdown)
+				w.onChange(newObj)
 			},
-		}, indexers)
+			DeleteFunc: w.onDelete,
+		})
+
+	go w.run(informer)
+
+	return current, w.changes, topo.CancelFunc(watchCancel)
+}
 
-	// create control chan for informer and start it
-	informerChan := make(chan struct{})
-	go memberInformer.Run(informerChan)
+// watcher forwards the informer events for a single node to
+// the changes channel of a Watch.
+//
+// The informer invokes the event handlers sequentially from the
+// goroutine that runs it, and returns from Run only once the
+// handlers are done, so the fields below need no locking.
+type watcher struct {
+	filePath string
+	name     string
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	changes chan *topo.WatchData
+
+	// lastVersion is the last version sent to the caller. Events
+	// for that version (like the initial listing) are not sent again.
+	lastVersion string
+
+	// stopped is set once a final error has been sent.
+	stopped bool
+}
 
-	// Handle interrupts
-	go closeOnDone(watchCtx, filePath, informerChan, gracefulShutdown, changes)
+// run runs the informer until the watch is cancelled or a final
+// error is sent, and then closes the changes channel.
+func (w *watcher) run(informer cache.Controller) {
+	informer.Run(w.ctx.Done())
 
-	return current, changes, topo.CancelFunc(watchCancel)
+	if !w.stopped {
+		w.changes <- &topo.WatchData{Err: topo.NewError(topo.Interrupted, w.filePath)}
+	}
+	close(w.changes)
 }
 
-func closeOnDone(ctx context.Context, filePath string, informerChan chan struct{}, gracefulShutdown chan struct{}, changes chan *topo.WatchData) {
+func (w *watcher) onChange(obj interface{}) {
+	vtn, ok := obj.(*vtv1beta1.VitessTopoNode)
+	if !ok || vtn.Name != w.name {
+		return
+	}
+	version := vtn.GetResourceVersion()
+	if version == w.lastVersion {
+		return
+	}
+
+	out, err := unpackValue([]byte(vtn.Data.Value))
+	if err != nil {
+		w.stop(err)
+		return
+	}
+	if w.send(&topo.WatchData{
+		Contents: out,
+		Version:  KubernetesVersion(version),
+	}) {
+		w.lastVersion = version
+	}
+}
+
+func (w *watcher) onDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if vtn, ok := obj.(*vtv1beta1.VitessTopoNode); ok && vtn.Name != w.name {
+		return
+	}
+	w.stop(topo.NewError(topo.NoNode, w.filePath))
+}
+
+// stop sends a final error and cancels the watch.
+func (w *watcher) stop(err error) {
+	if w.send(&topo.WatchData{Err: err}) {
+		w.stopped = true
+	}
+	w.cancel()
+}
+
+// send sends wd to the caller, unless the watch was cancelled
+// or already stopped. It returns true if wd was sent.
+func (w *watcher) send(wd *topo.WatchData) bool {
+	if w.stopped {
+		return false
+	}
 	select {
-	case <-ctx.Done():
-		if err := ctx.Err(); err != nil && err == context.Canceled {
-			changes <- &topo.WatchData{Err: topo.NewError(topo.Interrupted, filePath)}
-		}
-	case <-gracefulShutdown:
+	case w.changes <- wd:
+		return true
+	case <-w.ctx.Done():
+		return false
 	}
-	close(informerChan)
-	close(changes)
 }
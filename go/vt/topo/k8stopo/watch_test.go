@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/k8stopo/client/clientset/versioned/fake"
+)
+
+// newFakeServer returns a Server backed by a fake clientset, and a
+// channel that is closed once a watch has been opened on it.
+func newFakeServer() (*Server, chan struct{}) {
+	client := fake.NewSimpleClientset()
+	watching := make(chan struct{})
+	var once sync.Once
+	client.PrependWatchReactor("vitesstoponodes", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := client.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		once.Do(func() { close(watching) })
+		return true, w, err
+	})
+	return &Server{
+		vtKubeClient:   client,
+		resourceClient: client.TopoV1beta1().VitessTopoNodes("default"),
+		namespace:      "default",
+		root:           "/test",
+	}, watching
+}
+
+func putNode(t *testing.T, s *Server, filePath, contents, version string) {
+	t.Helper()
+	resource, err := s.buildFileResource(filePath, []byte(contents))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.ResourceVersion = version
+	if version == "1" {
+		_, err = s.resourceClient.Create(resource)
+	} else {
+		_, err = s.resourceClient.Update(resource)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitForChange(t *testing.T, changes <-chan *topo.WatchData) *topo.WatchData {
+	t.Helper()
+	select {
+	case wd, ok := <-changes:
+		if !ok {
+			t.Fatal("changes channel closed unexpectedly")
+		}
+		return wd
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a change")
+	}
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	s, watching := newFakeServer()
+	ctx := context.Background()
+	putNode(t, s, "/keyspaces/ks/SrvKeyspace", "v1", "1")
+	putNode(t, s, "/keyspaces/other/SrvKeyspace", "other", "1")
+
+	current, changes, cancel := s.Watch(ctx, "/keyspaces/ks/SrvKeyspace")
+	if current.Err != nil {
+		t.Fatalf("Watch failed: %v", current.Err)
+	}
+	if got, want := string(current.Contents), "v1"; got != want {
+		t.Errorf("current.Contents: %q, want %q", got, want)
+	}
+	if got, want := current.Version.String(), "1"; got != want {
+		t.Errorf("current.Version: %q, want %q", got, want)
+	}
+	<-watching
+
+	// Updates to other nodes are not sent, and the initial value
+	// is not sent again.
+	putNode(t, s, "/keyspaces/other/SrvKeyspace", "other2", "2")
+	putNode(t, s, "/keyspaces/ks/SrvKeyspace", "v2", "2")
+	wd := waitForChange(t, changes)
+	if wd.Err != nil {
+		t.Fatalf("unexpected error: %v", wd.Err)
+	}
+	if got, want := string(wd.Contents), "v2"; got != want {
+		t.Errorf("Contents: %q, want %q", got, want)
+	}
+	if got, want := wd.Version.String(), "2"; got != want {
+		t.Errorf("Version: %q, want %q", got, want)
+	}
+
+	cancel()
+	wd = waitForChange(t, changes)
+	if !topo.IsErrType(wd.Err, topo.Interrupted) {
+		t.Errorf("got %v, want Interrupted", wd.Err)
+	}
+	if _, ok := <-changes; ok {
+		t.Error("changes channel was not closed")
+	}
+}
+
+func TestWatchDelete(t *testing.T) {
+	s, watching := newFakeServer()
+	ctx := context.Background()
+	putNode(t, s, "/keyspaces/ks/SrvKeyspace", "v1", "1")
+
+	current, changes, cancel := s.Watch(ctx, "/keyspaces/ks/SrvKeyspace")
+	if current.Err != nil {
+		t.Fatalf("Watch failed: %v", current.Err)
+	}
+	defer cancel()
+	<-watching
+
+	if err := s.resourceClient.Delete(s.newNodeReference("/keyspaces/ks/SrvKeyspace").id, nil); err != nil {
+		t.Fatal(err)
+	}
+	wd := waitForChange(t, changes)
+	if !topo.IsErrType(wd.Err, topo.NoNode) {
+		t.Errorf("got %v, want NoNode", wd.Err)
+	}
+	if _, ok := <-changes; ok {
+		t.Error("changes channel was not closed")
+	}
+}
+
+func TestWatchNoNode(t *testing.T) {
+	s, _ := newFakeServer()
+	current, changes, cancel := s.Watch(context.Background(), "/keyspaces/ks/SrvKeyspace")
+	if !topo.IsErrType(current.Err, topo.NoNode) {
+		t.Errorf("got %v, want NoNode", current.Err)
+	}
+	if changes != nil || cancel != nil {
+		t.Error("changes and cancel should be nil")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"sort"
 	"sync"
 	"time"
 
@@ -104,6 +105,9 @@ type locksInfo struct {
 	// info contains all the locks we took. It is indexed by
 	// keyspace (for keyspaces) or keyspace/shard (for shards).
 	info map[string]*lockInfo
+
+	// onLost contains the callbacks registered with OnLockLost.
+	onLost []func(name string)
 }
 
 // Context glue
@@ -111,6 +115,78 @@ type locksKeyType int
 
 var locksKey locksKeyType
 
+// lockActionsFromContext returns the sorted actions of the locks
+// held in the context.
+func lockActionsFromContext(ctx context.Context) []string {
+	i, ok := ctx.Value(locksKey).(*locksInfo)
+	if !ok {
+		return nil
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var actions []string
+	for _, li := range i.info {
+		actions = append(actions, li.actionNode.Action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// OnLockLost registers a callback that is called with the name of the
+// lock (keyspace or keyspace/shard) if any lock held in the context is
+// lost while the operation is still running, for instance because the
+// lease backing it could not be renewed. The context returned by
+// LockKeyspace or LockShard is canceled right after the callbacks run,
+// so long-running operations should also stop when it is done.
+func OnLockLost(ctx context.Context, f func(name string)) error {
+	i, ok := ctx.Value(locksKey).(*locksInfo)
+	if !ok {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no lock held in context")
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.onLost = append(i.onLost, f)
+	return nil
+}
+
+// watchLock returns a context that is canceled if the lock described
+// by lockDescriptor is lost, and a function to stop watching it once
+// the lock is released. Locks that don't implement LockLostNotifier
+// are not watched.
+// The returned context is not canceled when the lock is released
+// normally, as callers may keep using it after unlocking.
+func (i *locksInfo) watchLock(ctx context.Context, name string, lockDescriptor LockDescriptor) (context.Context, func()) {
+	notifier, ok := lockDescriptor.(LockLostNotifier)
+	if !ok {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-notifier.Lost():
+		case <-done:
+			return
+		}
+		select {
+		case <-done:
+			// Released at the same time, not lost.
+			return
+		default:
+		}
+		log.Errorf("lock on %v was lost, aborting the operation holding it", name)
+		i.mu.Lock()
+		callbacks := append([]func(string){}, i.onLost...)
+		i.mu.Unlock()
+		for _, f := range callbacks {
+			f(name)
+		}
+		cancel()
+	}()
+	return ctx, func() { close(done) }
+}
+
 // LockKeyspace will lock the keyspace, and return:
 // - a context with a locksInfo structure for future reference.
 // - an unlock method
@@ -152,7 +228,8 @@ func (ts *Server) LockKeyspace(ctx context.Context, keyspace, action string) (co
 		return nil, nil, err
 	}
 
-	// and update our structure
+	// watch the lock for loss, and update our structure
+	ctx, stopWatch := i.watchLock(ctx, keyspace, lockDescriptor)
 	i.info[keyspace] = &lockInfo{
 		lockDescriptor: lockDescriptor,
 		actionNode:     l,
@@ -170,6 +247,8 @@ func (ts *Server) LockKeyspace(ctx context.Context, keyspace, action string) (co
 			return
 		}
 
+		// stop watching first, releasing the lock may look like losing it
+		stopWatch()
 		err := l.unlockKeyspace(ctx, ts, keyspace, lockDescriptor, *finalErr)
 		if *finalErr != nil {
 			if err != nil {
@@ -295,7 +374,8 @@ func (ts *Server) LockShard(ctx context.Context, keyspace, shard, action string)
 		return nil, nil, err
 	}
 
-	// and update our structure
+	// watch the lock for loss, and update our structure
+	ctx, stopWatch := i.watchLock(ctx, mapKey, lockDescriptor)
 	i.info[mapKey] = &lockInfo{
 		lockDescriptor: lockDescriptor,
 		actionNode:     l,
@@ -313,6 +393,8 @@ func (ts *Server) LockShard(ctx context.Context, keyspace, shard, action string)
 			return
 		}
 
+		// stop watching first, releasing the lock may look like losing it
+		stopWatch()
 		err := l.unlockShard(ctx, ts, keyspace, shard, lockDescriptor, *finalErr)
 		if *finalErr != nil {
 			if err != nil {
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// fakeLeaseLockDescriptor is a LockDescriptor whose loss can be
+// triggered by closing its lost channel.
+type fakeLeaseLockDescriptor struct {
+	lost chan struct{}
+}
+
+func (ld *fakeLeaseLockDescriptor) Check(ctx context.Context) error  { return nil }
+func (ld *fakeLeaseLockDescriptor) Unlock(ctx context.Context) error { return nil }
+func (ld *fakeLeaseLockDescriptor) Lost() <-chan struct{}            { return ld.lost }
+
+func TestWatchLockLost(t *testing.T) {
+	i := &locksInfo{
+		info: make(map[string]*lockInfo),
+	}
+	ctx := context.WithValue(context.Background(), locksKey, i)
+
+	lostNames := make(chan string, 1)
+	if err := OnLockLost(ctx, func(name string) { lostNames <- name }); err != nil {
+		t.Fatalf("OnLockLost failed: %v", err)
+	}
+
+	ld := &fakeLeaseLockDescriptor{lost: make(chan struct{})}
+	lockCtx, stopWatch := i.watchLock(ctx, "ks/0", ld)
+	defer stopWatch()
+	close(ld.lost)
+
+	select {
+	case name := <-lostNames:
+		if name != "ks/0" {
+			t.Errorf("lost callback got %v, want ks/0", name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("lost callback was not called")
+	}
+	select {
+	case <-lockCtx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("lock context was not canceled")
+	}
+}
+
+func TestWatchLockReleased(t *testing.T) {
+	i := &locksInfo{
+		info: make(map[string]*lockInfo),
+	}
+	ctx := context.WithValue(context.Background(), locksKey, i)
+	called := make(chan string, 1)
+	if err := OnLockLost(ctx, func(name string) { called <- name }); err != nil {
+		t.Fatalf("OnLockLost failed: %v", err)
+	}
+
+	// Releasing the lock closes the lost channel for some
+	// implementations, it should not be reported once stopped.
+	ld := &fakeLeaseLockDescriptor{lost: make(chan struct{})}
+	lockCtx, stopWatch := i.watchLock(ctx, "ks", ld)
+	stopWatch()
+	close(ld.lost)
+
+	select {
+	case name := <-called:
+		t.Fatalf("lost callback called for released lock %v", name)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err := lockCtx.Err(); err != nil {
+		t.Errorf("lock context was canceled: %v", err)
+	}
+
+	if err := OnLockLost(context.Background(), func(string) {}); err == nil {
+		t.Errorf("OnLockLost without a lock should have failed")
+	}
+}
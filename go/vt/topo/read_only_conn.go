@@ -0,0 +1,392 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// This file contains the read-only mode of the global topology. It is
+// meant for disaster scenarios, when the global topo service is down:
+// processes that only need to read it, like vtgate and vttablet, can
+// still start and serve using the last known-good data, while all
+// mutations of the global topology are refused.
+
+var (
+	topoReadOnly = flag.Bool("topo_read_only", false, "refuse all changes to the global topology, and serve global topology reads from the last known-good data when the global topology server cannot be reached")
+
+	topoReadOnlyCacheDir = flag.String("topo_read_only_cache_dir", "", "directory where the last known-good global topology data is saved in -topo_read_only mode, so the process can start while the global topology server is down")
+
+	// readOnlyReconnectInterval is the minimum time between two
+	// attempts to connect to the topo server, and the time after
+	// which a watch served from the cache is restarted.
+	readOnlyReconnectInterval = 10 * time.Second
+
+	topoReadOnlyCacheHits = stats.NewCountersWithMultiLabels(
+		"TopologyReadOnlyCacheHits",
+		"Global topology reads served from the last known-good data",
+		[]string{"Operation", "Cell"})
+)
+
+var _ Conn = (*ReadOnlyConn)(nil)
+
+// readOnlyVersion is the Version of a file served from the cache.
+type readOnlyVersion string
+
+// String is part of the Version interface.
+func (v readOnlyVersion) String() string {
+	return string(v)
+}
+
+// readOnlyFile is a cached file. It needs to be public as we
+// JSON-serialize it.
+type readOnlyFile struct {
+	Contents []byte
+	Version  string
+}
+
+// ReadOnlyConn is a wrapper for a Conn that refuses all changes, and
+// remembers the results of the reads so they can be served when the
+// underlying topo server cannot be reached. It connects lazily, so it
+// can be created while the topo server is down.
+type ReadOnlyConn struct {
+	cell     string
+	cacheDir string
+	connect  func() (Conn, error)
+
+	// mu protects the following fields.
+	mu          sync.Mutex
+	conn        Conn
+	lastConnect time.Time
+	files       map[string]*readOnlyFile
+	dirs        map[string][]DirEntry
+}
+
+// NewReadOnlyConn returns a ReadOnlyConn that uses connect to create
+// the underlying Conn. If cacheDir is not empty, the cached data is
+// also saved there.
+func NewReadOnlyConn(cell, cacheDir string, connect func() (Conn, error)) *ReadOnlyConn {
+	roc := &ReadOnlyConn{
+		cell:     cell,
+		cacheDir: cacheDir,
+		connect:  connect,
+		files:    make(map[string]*readOnlyFile),
+		dirs:     make(map[string][]DirEntry),
+	}
+	if cacheDir != "" {
+		if err := os.MkdirAll(path.Join(cacheDir, cell), 0755); err != nil {
+			log.Warningf("cannot create topo read-only cache directory: %v", err)
+		}
+	}
+	if _, err := roc.getConn(); err != nil {
+		log.Warningf("cannot connect to the %v topology, serving it from the last known-good data: %v", cell, err)
+	}
+	return roc
+}
+
+// getConn returns the underlying Conn, trying to create it if it
+// doesn't exist yet.
+func (roc *ReadOnlyConn) getConn() (Conn, error) {
+	roc.mu.Lock()
+	defer roc.mu.Unlock()
+	if roc.conn != nil {
+		return roc.conn, nil
+	}
+	if time.Since(roc.lastConnect) < readOnlyReconnectInterval {
+		return nil, NewError(Timeout, roc.cell)
+	}
+	roc.lastConnect = time.Now()
+	conn, err := roc.connect()
+	if err != nil {
+		return nil, err
+	}
+	roc.conn = conn
+	return conn, nil
+}
+
+// unreachable returns true if err means the topo server could not
+// answer, as opposed to an answer about the node itself. Errors after
+// the caller's context expired are neither: the caller gave up, which
+// says nothing about the topo server, so they are returned as is.
+func unreachable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	return !IsErrType(err, NoNode) && !IsErrType(err, NodeExists) && !IsErrType(err, BadVersion) && !IsErrType(err, NodeNotEmpty)
+}
+
+// cachePath returns the file the cache entry for key is saved to.
+func (roc *ReadOnlyConn) cachePath(kind, key string) string {
+	return path.Join(roc.cacheDir, roc.cell, kind+"-"+url.PathEscape(key))
+}
+
+// save remembers a cache entry, and saves it on disk if configured.
+func (roc *ReadOnlyConn) save(kind, key string, value interface{}) {
+	if roc.cacheDir == "" {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Warningf("cannot JSON-marshal topo read-only cache entry %v: %v", key, err)
+		return
+	}
+	// Write to a temporary file first, so a reader never sees a
+	// partially written entry.
+	p := roc.cachePath(kind, key)
+	if err := ioutil.WriteFile(p+".tmp", data, 0644); err != nil {
+		log.Warningf("cannot save topo read-only cache entry %v: %v", key, err)
+		return
+	}
+	if err := os.Rename(p+".tmp", p); err != nil {
+		log.Warningf("cannot save topo read-only cache entry %v: %v", key, err)
+	}
+}
+
+// load reads a cache entry from disk. It returns false if there is none.
+func (roc *ReadOnlyConn) load(kind, key string, value interface{}) bool {
+	if roc.cacheDir == "" {
+		return false
+	}
+	data, err := ioutil.ReadFile(roc.cachePath(kind, key))
+	if err != nil {
+		return false
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		log.Warningf("cannot JSON-unmarshal topo read-only cache entry %v: %v", key, err)
+		return false
+	}
+	return true
+}
+
+// forget removes a cache entry, when the node is known to be gone.
+func (roc *ReadOnlyConn) forget(kind, key string) {
+	roc.mu.Lock()
+	if kind == "file" {
+		delete(roc.files, key)
+	} else {
+		delete(roc.dirs, key)
+	}
+	roc.mu.Unlock()
+	if roc.cacheDir != "" {
+		os.Remove(roc.cachePath(kind, key))
+	}
+}
+
+func (roc *ReadOnlyConn) putFile(filePath string, contents []byte, version Version) {
+	f := &readOnlyFile{
+		Contents: contents,
+	}
+	if version != nil {
+		f.Version = version.String()
+	}
+	roc.mu.Lock()
+	roc.files[filePath] = f
+	roc.mu.Unlock()
+	roc.save("file", filePath, f)
+}
+
+func (roc *ReadOnlyConn) getFile(filePath string) (*readOnlyFile, bool) {
+	roc.mu.Lock()
+	f, ok := roc.files[filePath]
+	roc.mu.Unlock()
+	if ok {
+		return f, true
+	}
+	f = &readOnlyFile{}
+	if !roc.load("file", filePath, f) {
+		return nil, false
+	}
+	roc.mu.Lock()
+	roc.files[filePath] = f
+	roc.mu.Unlock()
+	return f, true
+}
+
+// ListDir is part of the Conn interface
+func (roc *ReadOnlyConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	key := dirPath
+	if full {
+		key += "?full"
+	}
+
+	conn, err := roc.getConn()
+	if err == nil {
+		var entries []DirEntry
+		entries, err = conn.ListDir(ctx, dirPath, full)
+		if err == nil {
+			roc.mu.Lock()
+			roc.dirs[key] = entries
+			roc.mu.Unlock()
+			roc.save("dir", key, entries)
+			return entries, nil
+		}
+		if !unreachable(ctx, err) {
+			if ctx.Err() == nil {
+				roc.forget("dir", key)
+			}
+			return nil, err
+		}
+	}
+
+	roc.mu.Lock()
+	entries, ok := roc.dirs[key]
+	roc.mu.Unlock()
+	if !ok && !roc.load("dir", key, &entries) {
+		return nil, err
+	}
+	topoReadOnlyCacheHits.Add([]string{"ListDir", roc.cell}, 1)
+	return entries, nil
+}
+
+// Create is part of the Conn interface
+func (roc *ReadOnlyConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	return nil, NewError(ReadOnly, filePath)
+}
+
+// Update is part of the Conn interface
+func (roc *ReadOnlyConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	return nil, NewError(ReadOnly, filePath)
+}
+
+// Get is part of the Conn interface
+func (roc *ReadOnlyConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	conn, err := roc.getConn()
+	if err == nil {
+		var contents []byte
+		var version Version
+		contents, version, err = conn.Get(ctx, filePath)
+		if err == nil {
+			roc.putFile(filePath, contents, version)
+			return contents, version, nil
+		}
+		if !unreachable(ctx, err) {
+			if ctx.Err() == nil {
+				roc.forget("file", filePath)
+			}
+			return nil, nil, err
+		}
+	}
+
+	f, ok := roc.getFile(filePath)
+	if !ok {
+		return nil, nil, err
+	}
+	topoReadOnlyCacheHits.Add([]string{"Get", roc.cell}, 1)
+	return f.Contents, readOnlyVersion(f.Version), nil
+}
+
+// Delete is part of the Conn interface
+func (roc *ReadOnlyConn) Delete(ctx context.Context, filePath string, version Version) error {
+	return NewError(ReadOnly, filePath)
+}
+
+// Lock is part of the Conn interface
+func (roc *ReadOnlyConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	return nil, NewError(ReadOnly, dirPath)
+}
+
+// Watch is part of the Conn interface. If the watch cannot be
+// established, the cached value is returned, and the watch ends with
+// an error after readOnlyReconnectInterval so the caller tries again.
+func (roc *ReadOnlyConn) Watch(ctx context.Context, filePath string) (*WatchData, <-chan *WatchData, CancelFunc) {
+	conn, err := roc.getConn()
+	if err == nil {
+		current, changes, cancel := conn.Watch(ctx, filePath)
+		if current.Err == nil {
+			roc.putFile(filePath, current.Contents, current.Version)
+			return current, roc.cacheWatch(filePath, changes), cancel
+		}
+		if !unreachable(ctx, current.Err) {
+			if ctx.Err() == nil {
+				roc.forget("file", filePath)
+			}
+			return current, changes, cancel
+		}
+		err = current.Err
+	}
+
+	f, ok := roc.getFile(filePath)
+	if !ok {
+		return &WatchData{Err: err}, nil, nil
+	}
+	topoReadOnlyCacheHits.Add([]string{"Watch", roc.cell}, 1)
+
+	changes := make(chan *WatchData, 1)
+	stop := make(chan struct{})
+	var once sync.Once
+	go func() {
+		defer close(changes)
+		timer := time.NewTimer(readOnlyReconnectInterval)
+		defer timer.Stop()
+		select {
+		case <-stop:
+			changes <- &WatchData{Err: NewError(Interrupted, filePath)}
+		case <-timer.C:
+			changes <- &WatchData{Err: NewError(Timeout, filePath)}
+		}
+	}()
+	cancel := func() {
+		once.Do(func() { close(stop) })
+	}
+	current := &WatchData{
+		Contents: f.Contents,
+		Version:  readOnlyVersion(f.Version),
+	}
+	return current, changes, cancel
+}
+
+// cacheWatch remembers the values of a watch as they come in.
+func (roc *ReadOnlyConn) cacheWatch(filePath string, in <-chan *WatchData) <-chan *WatchData {
+	out := make(chan *WatchData, 10)
+	go func() {
+		defer close(out)
+		for wd := range in {
+			if wd.Err == nil {
+				roc.putFile(filePath, wd.Contents, wd.Version)
+			}
+			out <- wd
+		}
+	}()
+	return out
+}
+
+// NewMasterParticipation is part of the Conn interface
+func (roc *ReadOnlyConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
+	return nil, NewError(ReadOnly, name)
+}
+
+// Close is part of the Conn interface
+func (roc *ReadOnlyConn) Close() {
+	roc.mu.Lock()
+	defer roc.mu.Unlock()
+	if roc.conn != nil {
+		roc.conn.Close()
+		roc.conn = nil
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file provides the utility methods to save / retrieve the state
+// of the resharding workflows in the topology global cell.
+
+const (
+	reshardWorkflowsPath    = "reshard_workflows"
+	reshardWorkflowFilename = "ReshardWorkflow"
+)
+
+func pathForReshardWorkflow(keyspace, workflow string) string {
+	return path.Join(KeyspacesPath, keyspace, reshardWorkflowsPath, workflow, reshardWorkflowFilename)
+}
+
+// ReshardWorkflowInfo is a meta struct that contains the keyspace,
+// the name and the version of a ReshardWorkflow.
+type ReshardWorkflowInfo struct {
+	version  Version
+	keyspace string
+	workflow string
+	*topodatapb.ReshardWorkflow
+}
+
+// Keyspace returns the target keyspace of the workflow.
+func (rwi *ReshardWorkflowInfo) Keyspace() string {
+	return rwi.keyspace
+}
+
+// Workflow returns the name of the workflow.
+func (rwi *ReshardWorkflowInfo) Workflow() string {
+	return rwi.workflow
+}
+
+// CreateReshardWorkflow creates the given resharding workflow, and
+// returns the initial ReshardWorkflowInfo. If it already exists,
+// ErrNodeExists is returned.
+func (ts *Server) CreateReshardWorkflow(ctx context.Context, keyspace, workflow string, rw *topodatapb.ReshardWorkflow) (*ReshardWorkflowInfo, error) {
+	contents, err := proto.Marshal(rw)
+	if err != nil {
+		return nil, err
+	}
+	version, err := ts.globalCell.Create(ctx, pathForReshardWorkflow(keyspace, workflow), contents)
+	if err != nil {
+		return nil, err
+	}
+	return &ReshardWorkflowInfo{
+		version:         version,
+		keyspace:        keyspace,
+		workflow:        workflow,
+		ReshardWorkflow: rw,
+	}, nil
+}
+
+// GetReshardWorkflow reads a resharding workflow from the global cell.
+func (ts *Server) GetReshardWorkflow(ctx context.Context, keyspace, workflow string) (*ReshardWorkflowInfo, error) {
+	contents, version, err := ts.globalCell.Get(ctx, pathForReshardWorkflow(keyspace, workflow))
+	if err != nil {
+		return nil, err
+	}
+	rw := &topodatapb.ReshardWorkflow{}
+	if err := proto.Unmarshal(contents, rw); err != nil {
+		return nil, err
+	}
+	return &ReshardWorkflowInfo{
+		version:         version,
+		keyspace:        keyspace,
+		workflow:        workflow,
+		ReshardWorkflow: rw,
+	}, nil
+}
+
+// SaveReshardWorkflow saves the ReshardWorkflowInfo object. If the
+// version is not good any more, ErrBadVersion is returned.
+func (ts *Server) SaveReshardWorkflow(ctx context.Context, rwi *ReshardWorkflowInfo) error {
+	contents, err := proto.Marshal(rwi.ReshardWorkflow)
+	if err != nil {
+		return err
+	}
+	version, err := ts.globalCell.Update(ctx, pathForReshardWorkflow(rwi.keyspace, rwi.workflow), contents, rwi.version)
+	if err != nil {
+		return err
+	}
+	rwi.version = version
+	return nil
+}
+
+// DeleteReshardWorkflow deletes the specified resharding workflow.
+// After this, the ReshardWorkflowInfo object should not be used any more.
+func (ts *Server) DeleteReshardWorkflow(ctx context.Context, rwi *ReshardWorkflowInfo) error {
+	return ts.globalCell.Delete(ctx, pathForReshardWorkflow(rwi.keyspace, rwi.workflow), rwi.version)
+}
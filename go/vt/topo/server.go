@@ -124,6 +124,10 @@ type Server struct {
 	// the two.
 	globalReadOnlyCell Conn
 
+	// auditLog is the connection to the global topo service the audit
+	// log entries are written to. It is nil if -topo_audit is not set.
+	auditLog Conn
+
 	// factory allows the creation of connections to various backends.
 	// It is set at construction time.
 	factory Factory
@@ -177,12 +181,22 @@ func RegisterFactory(name string, factory Factory) {
 // NewWithFactory creates a new Server based on the given Factory.
 // It also opens the global cell connection.
 func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error) {
+	if *topoReadOnly {
+		return newReadOnlyWithFactory(factory, serverAddress, root), nil
+	}
+
 	conn, err := factory.Create(GlobalCell, serverAddress, root)
 	if err != nil {
 		return nil, err
 	}
 	conn = NewStatsConn(GlobalCell, conn)
 
+	var auditLog Conn
+	if *topoAudit {
+		auditLog = conn
+		conn = NewAuditConn(GlobalCell, conn, auditLog)
+	}
+
 	var connReadOnly Conn
 	if factory.HasGlobalReadOnlyCell(serverAddress, root) {
 		connReadOnly, err = factory.Create(GlobalReadOnlyCell, serverAddress, root)
@@ -197,11 +211,32 @@ func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error
 	return &Server{
 		globalCell:         conn,
 		globalReadOnlyCell: connReadOnly,
+		auditLog:           auditLog,
 		factory:            factory,
 		cells:              make(map[string]Conn),
 	}, nil
 }
 
+// newReadOnlyWithFactory creates a new Server for -topo_read_only mode.
+// The global cell connection is a ReadOnlyConn, also used for the
+// read-only global cell. It can be created while the global topo
+// server is down.
+func newReadOnlyWithFactory(factory Factory, serverAddress, root string) *Server {
+	conn := NewReadOnlyConn(GlobalCell, *topoReadOnlyCacheDir, func() (Conn, error) {
+		conn, err := factory.Create(GlobalCell, serverAddress, root)
+		if err != nil {
+			return nil, err
+		}
+		return NewStatsConn(GlobalCell, conn), nil
+	})
+	return &Server{
+		globalCell:         conn,
+		globalReadOnlyCell: conn,
+		factory:            factory,
+		cells:              make(map[string]Conn),
+	}
+}
+
 // OpenServer returns a Server using the provided implementation,
 // address and root for the global server.
 func OpenServer(implementation, serverAddress, root string) (*Server, error) {
@@ -265,6 +300,9 @@ func (ts *Server) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 	switch {
 	case err == nil:
 		conn = NewStatsConn(cell, conn)
+		if ts.auditLog != nil {
+			conn = NewAuditConn(cell, conn, ts.auditLog)
+		}
 		ts.cells[cell] = conn
 		return conn, nil
 	case IsErrType(err, NoNode):
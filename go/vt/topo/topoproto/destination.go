@@ -29,6 +29,8 @@ import (
 
 // ParseDestination parses the string representation of a Destination
 // of the form keyspace:shard@tablet_type. You can use a / instead of a :.
+// Multiple shards can be targeted with a comma separated list of shards
+// e.g. keyspace:-80,80-@tablet_type.
 func ParseDestination(targetString string, defaultTabletType topodatapb.TabletType) (string, topodatapb.TabletType, key.Destination, error) {
 	var dest key.Destination
 	var keyspace string
@@ -43,7 +45,18 @@ func ParseDestination(targetString string, defaultTabletType topodatapb.TabletTy
 	}
 	last = strings.LastIndexAny(targetString, "/:")
 	if last != -1 {
-		dest = key.DestinationShard(targetString[last+1:])
+		shardString := targetString[last+1:]
+		if strings.Contains(shardString, ",") {
+			shards := strings.Split(shardString, ",")
+			for _, shard := range shards {
+				if shard == "" {
+					return keyspace, tabletType, dest, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "empty shard name in shard list %s", shardString)
+				}
+			}
+			dest = key.DestinationShards(shards)
+		} else {
+			dest = key.DestinationShard(shardString)
+		}
 		targetString = targetString[:last]
 	}
 	// Try to parse it as a keyspace id or range
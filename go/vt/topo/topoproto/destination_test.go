@@ -65,6 +65,16 @@ func TestParseDestination(t *testing.T) {
 		keyspace:     "ks",
 		tabletType:   topodatapb.TabletType_MASTER,
 		dest:         key.DestinationShard("-80"),
+	}, {
+		targetString: "ks:-80,80-@replica",
+		keyspace:     "ks",
+		tabletType:   topodatapb.TabletType_REPLICA,
+		dest:         key.DestinationShards{"-80", "80-"},
+	}, {
+		targetString: "ks/-40,40-80",
+		keyspace:     "ks",
+		tabletType:   topodatapb.TabletType_MASTER,
+		dest:         key.DestinationShards{"-40", "40-80"},
 	}, {
 		targetString: ":-80@master",
 		keyspace:     "",
@@ -120,4 +130,10 @@ func TestParseDestination(t *testing.T) {
 	if err == nil || err.Error() != want {
 		t.Errorf("executorExec error: %v, want %s", err, want)
 	}
+
+	_, _, _, err = ParseDestination("ks:-80,,80-@master", topodatapb.TabletType_MASTER)
+	want = "empty shard name in shard list -80,,80-"
+	if err == nil || err.Error() != want {
+		t.Errorf("executorExec error: %v, want %s", err, want)
+	}
 }
@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file tests the audit log part of the topo.Server API.
+
+func TestAuditLog(t *testing.T) {
+	flag.Set("topo_audit", "true")
+	defer flag.Set("topo_audit", "false")
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	lockCtx, unlock, err := ts.LockKeyspace(ctx, "ks", "TestAction")
+	if err != nil {
+		t.Fatalf("LockKeyspace failed: %v", err)
+	}
+	ki, err := ts.GetKeyspace(lockCtx, "ks")
+	if err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	ki.ShardingColumnName = "id"
+	if err := ts.UpdateKeyspace(lockCtx, ki); err != nil {
+		t.Fatalf("UpdateKeyspace failed: %v", err)
+	}
+	unlock(&err)
+	if err := ts.DeleteKeyspace(ctx, "ks"); err != nil {
+		t.Fatalf("DeleteKeyspace failed: %v", err)
+	}
+
+	entries, err := ts.GetAuditLog(ctx, "keyspaces/ks", "", 0)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	var got [][]string
+	for _, e := range entries {
+		got = append(got, []string{e.Operation, e.Cell, e.Path})
+	}
+	want := [][]string{
+		{"Create", "global", "keyspaces/ks/Keyspace"},
+		{"Update", "global", "keyspaces/ks/Keyspace"},
+		{"Delete", "global", "keyspaces/ks/Keyspace"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("GetAuditLog: %v, want %v", got, want)
+	}
+
+	// The versions chain from one change to the next.
+	if entries[0].Version != "" || entries[0].NewVersion == "" || entries[0].NewHash == "" {
+		t.Errorf("Create entry has bad versions: %+v", entries[0])
+	}
+	if entries[1].Version != entries[0].NewVersion || entries[1].NewVersion == entries[0].NewVersion || entries[1].NewHash == entries[0].NewHash {
+		t.Errorf("Update entry has bad versions: %+v", entries[1])
+	}
+	if entries[2].NewVersion != "" || entries[2].NewHash != "" {
+		t.Errorf("Delete entry has bad versions: %+v", entries[2])
+	}
+
+	// The hashes chain too.
+	if entries[0].OldHash != "" {
+		t.Errorf("Create entry has an old hash: %+v", entries[0])
+	}
+	if entries[1].OldHash != entries[0].NewHash {
+		t.Errorf("Update entry old hash: %v, want %v", entries[1].OldHash, entries[0].NewHash)
+	}
+	if entries[2].OldHash != entries[1].NewHash {
+		t.Errorf("Delete entry old hash: %v, want %v", entries[2].OldHash, entries[1].NewHash)
+	}
+	if want := []string{"TestAction"}; !reflect.DeepEqual(entries[1].Actions, want) {
+		t.Errorf("Update entry actions: %v, want %v", entries[1].Actions, want)
+	}
+	if entries[0].HostName == "" || entries[0].UserName == "" || entries[0].Time.IsZero() {
+		t.Errorf("Create entry is missing who and when: %+v", entries[0])
+	}
+
+	// Changes in the cells are recorded too.
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	entries, err = ts.GetAuditLog(ctx, "tablets/", "", 0)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Cell != "cell1" || entries[0].Path != "tablets/cell1-0000000100/Tablet" {
+		t.Errorf("GetAuditLog(tablets/): %+v", entries)
+	}
+
+	// The limit returns the most recent entries.
+	entries, err = ts.GetAuditLog(ctx, "", "", 1)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Path != "keyspaces/ks/shards/0/ShardReplication" {
+		t.Errorf("GetAuditLog(limit 1): %+v", entries)
+	}
+
+	// The log can be read one page at a time.
+	all, err := ts.GetAuditLog(ctx, "", "", 0)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	var paged []*topo.AuditEntry
+	for before := ""; ; {
+		page, err := ts.GetAuditLog(ctx, "", before, 2)
+		if err != nil {
+			t.Fatalf("GetAuditLog failed: %v", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(page, paged...)
+		before = page[0].Name
+	}
+	if !reflect.DeepEqual(paged, all) {
+		t.Errorf("GetAuditLog by pages: %+v, want %+v", paged, all)
+	}
+
+	// Pruning deletes the old entries.
+	deleted, err := ts.PruneAuditLog(ctx, entries[0].Time)
+	if err != nil {
+		t.Fatalf("PruneAuditLog failed: %v", err)
+	}
+	if deleted != len(all)-1 {
+		t.Errorf("PruneAuditLog deleted %v entries, want %v", deleted, len(all)-1)
+	}
+	entries, err = ts.GetAuditLog(ctx, "", "", 0)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Path != "keyspaces/ks/shards/0/ShardReplication" {
+		t.Errorf("GetAuditLog after PruneAuditLog: %+v", entries)
+	}
+
+	// The audit log itself cannot be changed.
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	if _, err := conn.Create(ctx, topo.AuditPath+"/fake", []byte("{}")); err == nil {
+		t.Errorf("Create in the audit log should have failed")
+	}
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	entries, err := ts.GetAuditLog(ctx, "", "", 0)
+	if err != nil {
+		t.Fatalf("GetAuditLog failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("GetAuditLog: %v, want no entries", entries)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -149,3 +150,63 @@ func TestCellsAliases(t *testing.T) {
 		t.Fatalf("UpdateCellsAlias should fail, got nil")
 	}
 }
+
+func TestValidateCellsAliases(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2", "cell3")
+
+	if err := ts.ValidateCellsAliases(ctx); err != nil {
+		t.Fatalf("ValidateCellsAliases with no alias failed: %v", err)
+	}
+
+	if err := ts.CreateCellsAlias(ctx, "alias", &topodatapb.CellsAlias{Cells: []string{"cell1", "cell2"}}); err != nil {
+		t.Fatalf("CreateCellsAlias failed: %v", err)
+	}
+	if err := ts.ValidateCellsAliases(ctx); err != nil {
+		t.Fatalf("ValidateCellsAliases failed: %v", err)
+	}
+
+	if err := ts.CreateCellsAlias(ctx, "typo", &topodatapb.CellsAlias{Cells: []string{"cell3", "cel4"}}); err != nil {
+		t.Fatalf("CreateCellsAlias failed: %v", err)
+	}
+	err := ts.ValidateCellsAliases(ctx)
+	want := `invalid cells aliases: cells alias typo has unknown cell "cel4"`
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateCellsAliases: %v, want %v", err, want)
+	}
+}
+
+func TestGetServingCells(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2", "cell3")
+
+	if err := ts.CreateCellsAlias(ctx, "alias", &topodatapb.CellsAlias{Cells: []string{"cell2", "cell1", "gone"}}); err != nil {
+		t.Fatalf("CreateCellsAlias failed: %v", err)
+	}
+
+	table := []struct {
+		cell       string
+		tabletType topodatapb.TabletType
+		want       []string
+	}{
+		{"cell1", topodatapb.TabletType_MASTER, []string{"cell1", "cell2", "cell3"}},
+		{"cell1", topodatapb.TabletType_REPLICA, []string{"cell1", "cell2"}},
+		{"cell2", topodatapb.TabletType_RDONLY, []string{"cell1", "cell2"}},
+		{"cell3", topodatapb.TabletType_REPLICA, []string{"cell3"}},
+		{"cell3", topodatapb.TabletType_MASTER, []string{"cell1", "cell2", "cell3"}},
+	}
+	for _, test := range table {
+		got, err := ts.GetServingCells(ctx, test.cell, test.tabletType)
+		if err != nil {
+			t.Errorf("GetServingCells(%v, %v) failed: %v", test.cell, test.tabletType, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetServingCells(%v, %v): %v, want %v", test.cell, test.tabletType, got, test.want)
+		}
+	}
+
+	if _, err := ts.GetServingCells(ctx, "unknown", topodatapb.TabletType_REPLICA); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetServingCells(unknown): %v, want NoNode", err)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file tests the read-only mode of the topo.Server.
+
+func TestReadOnlyMode(t *testing.T) {
+	ctx := context.Background()
+	ts, factory := memorytopo.NewServerAndFactory("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "id"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+
+	cacheDir, err := ioutil.TempDir("", "topo_read_only")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(cacheDir)
+	flag.Set("topo_read_only", "true")
+	defer flag.Set("topo_read_only", "false")
+	flag.Set("topo_read_only_cache_dir", cacheDir)
+	defer flag.Set("topo_read_only_cache_dir", "")
+
+	rts, err := topo.NewWithFactory(factory, "", "")
+	if err != nil {
+		t.Fatalf("NewWithFactory failed: %v", err)
+	}
+
+	// Reads go to the topo server, changes are refused.
+	if _, err := rts.GetKeyspace(ctx, "ks"); err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	if _, err := rts.GetKeyspaces(ctx); err != nil {
+		t.Fatalf("GetKeyspaces failed: %v", err)
+	}
+	if err := rts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{}); !topo.IsErrType(err, topo.ReadOnly) {
+		t.Errorf("CreateKeyspace: got %v, want ReadOnly error", err)
+	}
+	if _, _, err := rts.LockKeyspace(ctx, "ks", "TestAction"); !topo.IsErrType(err, topo.ReadOnly) {
+		t.Errorf("LockKeyspace: got %v, want ReadOnly error", err)
+	}
+	if _, err := rts.GetKeyspace(ctx, "unknown"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetKeyspace(unknown): got %v, want NoNode error", err)
+	}
+
+	// The topo server goes down, reads are served from the last
+	// known-good data.
+	factory.SetError(errors.New("topo server down"))
+	defer factory.SetError(nil)
+	ki, err := rts.GetKeyspace(ctx, "ks")
+	if err != nil {
+		t.Fatalf("GetKeyspace with topo down failed: %v", err)
+	}
+	if ki.ShardingColumnName != "id" {
+		t.Errorf("GetKeyspace with topo down got %v", ki.Keyspace)
+	}
+	names, err := rts.GetKeyspaces(ctx)
+	if err != nil || len(names) != 1 || names[0] != "ks" {
+		t.Errorf("GetKeyspaces with topo down: got %v %v", names, err)
+	}
+	if _, err := rts.GetKeyspace(ctx, "unknown"); err == nil {
+		t.Errorf("GetKeyspace(unknown) with topo down should have failed")
+	}
+
+	// An expired caller context is not an outage: the read fails,
+	// and the cached data is kept.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := rts.GetKeyspace(cancelledCtx, "ks"); err == nil {
+		t.Errorf("GetKeyspace with a cancelled context should have failed")
+	}
+	if _, err := rts.GetKeyspace(ctx, "ks"); err != nil {
+		t.Errorf("GetKeyspace after a cancelled read failed: %v", err)
+	}
+
+	// A new process can start from the saved data.
+	rts2, err := topo.NewWithFactory(factory, "", "")
+	if err != nil {
+		t.Fatalf("NewWithFactory failed: %v", err)
+	}
+	if _, err := rts2.GetKeyspace(ctx, "ks"); err != nil {
+		t.Errorf("GetKeyspace from saved data failed: %v", err)
+	}
+}
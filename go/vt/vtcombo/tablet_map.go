@@ -337,9 +337,9 @@ func (itc *internalTabletConn) Begin(ctx context.Context, target *querypb.Target
 }
 
 // Commit is part of queryservice.QueryService
-func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
-	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	gtids, err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
+	return gtids, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // Rollback is part of queryservice.QueryService
@@ -417,14 +417,20 @@ func (itc *internalTabletConn) BeginExecuteBatch(ctx context.Context, target *qu
 }
 
 // MessageStream is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
-	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, callback)
+func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) error {
+	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, resumeToken, callback)
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // MessageAck is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
-	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, ids)
+func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (int64, error) {
+	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, ids, updates)
+	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
+// MessagePostpone is part of queryservice.QueryService
+func (itc *internalTabletConn) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (int64, error) {
+	count, err := itc.tablet.qsc.QueryService().MessagePostpone(ctx, target, name, ids, updates)
 	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
@@ -466,6 +472,21 @@ func (itc *internalTabletConn) VStreamResults(ctx context.Context, target *query
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// StreamSchemaChanges is part of the QueryService interface.
+func (itc *internalTabletConn) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	err := itc.tablet.qsc.QueryService().StreamSchemaChanges(ctx, target, callback)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
+// GetSchemaVersion is part of the QueryService interface.
+func (itc *internalTabletConn) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (*querypb.SchemaVersion, error) {
+	version, err := itc.tablet.qsc.QueryService().GetSchemaVersion(ctx, target, tableName, time)
+	if err != nil {
+		return nil, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+	}
+	return version, nil
+}
+
 //
 // TabletManagerClient implementation
 //
@@ -562,6 +583,14 @@ func (itmc *internalTabletManagerClient) IgnoreHealthError(ctx context.Context,
 	return nil
 }
 
+func (itmc *internalTabletManagerClient) ResizeTxPool(ctx context.Context, tablet *topodatapb.Tablet, size int64) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ResizeTxPool(ctx, size)
+}
+
 func (itmc *internalTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -578,6 +607,38 @@ func (itmc *internalTabletManagerClient) PreflightSchema(ctx context.Context, ta
 	return t.agent.PreflightSchema(ctx, changes)
 }
 
+func (itmc *internalTabletManagerClient) SubmitOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, sql, strategy string) (string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return "", fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SubmitOnlineDDL(ctx, sql, strategy)
+}
+
+func (itmc *internalTabletManagerClient) GetOnlineDDLMigrations(ctx context.Context, tablet *topodatapb.Tablet, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetOnlineDDLMigrations(ctx, uuid)
+}
+
+func (itmc *internalTabletManagerClient) CancelOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.CancelOnlineDDL(ctx, uuid)
+}
+
+func (itmc *internalTabletManagerClient) RetryOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.RetryOnlineDDL(ctx, uuid)
+}
+
 func (itmc *internalTabletManagerClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -586,6 +647,14 @@ func (itmc *internalTabletManagerClient) ApplySchema(ctx context.Context, tablet
 	return t.agent.ApplySchema(ctx, change)
 }
 
+func (itmc *internalTabletManagerClient) ApplyDeclarativeSchema(ctx context.Context, tablet *topodatapb.Tablet, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ApplyDeclarativeSchema(ctx, desired, allowDrop, dryRun)
+}
+
 func (itmc *internalTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -642,6 +711,18 @@ func (itmc *internalTabletManagerClient) VReplicationWaitForPos(ctx context.Cont
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) VReplicationPause(ctx context.Context, tablet *topodatapb.Tablet, id int) (string, error) {
+	return "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) VReplicationResume(ctx context.Context, tablet *topodatapb.Tablet, id int) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -690,11 +771,11 @@ func (itmc *internalTabletManagerClient) PromoteSlave(ctx context.Context, table
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -21,10 +21,14 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -39,29 +43,40 @@ func init() {
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
-		"[-allow_master=false] <keyspace/shard>",
-		"Chooses a tablet and creates a backup for a shard."})
+		"[-allow_master=false] [-incremental] <keyspace/shard>",
+		"Chooses a tablet and creates a backup for a shard. With -incremental, the backup is an incremental backup of the binary logs."})
 	addCommand("Shards", command{
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_latest=N] [-keep_daily=N] [-keep_weekly=N] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard which are not retained by the policy: the latest backups, the last backup of each of the most recent days, and of each of the most recent weeks. The incremental backups are kept if they can be restored on top of a retained backup. With -dry_run, only lists the backups which would be removed."})
+	addCommand("Shards", command{
+		"VerifyBackup",
+		commandVerifyBackup,
+		"[-concurrency=4] [-restore_tablet=<tablet alias>] <keyspace/shard> <backup name>",
+		"Downloads a backup and checks its files against the hashes of its MANIFEST. With -restore_tablet, then restores the backup on that tablet of the shard and starts mysqld, to prove it is restorable. The tablet must be SPARE or DRAINED, so a serving tablet is never overwritten, and it is left DRAINED without replication."})
 
 	addCommand("Tablets", command{
 		"Backup",
 		commandBackup,
-		"[-concurrency=4] [-allow_master=false] <tablet alias>",
-		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."})
+		"[-concurrency=4] [-allow_master=false] [-incremental] <tablet alias>",
+		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, the backup is an incremental backup of the binary logs, taken without stopping mysqld."})
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-backup_name <name>] [-backup_timestamp <RFC 3339 time>] [-restore_to_timestamp <RFC 3339 time>] [-restore_to_pos <position>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -backup_name, restores that full backup of the shard instead, without the incremental backups. With -backup_timestamp, restores the latest backups taken at or before that time. With -restore_to_timestamp or -restore_to_pos, restores the latest backup before that point in time and replays the incremental backups up to it, then leaves the tablet DRAINED without replication."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Allows backups to be taken on master. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Takes an incremental backup of the binary logs since the last backup of the shard, whatever the backup engine of the tablet")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -79,12 +94,13 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster, *incremental)
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Whether to use master tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Takes an incremental backup of the binary logs since the last backup of the shard, whatever the backup engine of the tablet")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -146,12 +162,12 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster, *incremental)
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster, incremental)
 	if err != nil {
 		return err
 	}
@@ -220,13 +236,163 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepLatest := subFlags.Int("keep_latest", 1, "Number of most recent backups to keep")
+	keepDaily := subFlags.Int("keep_daily", 0, "Number of days whose last backup is kept")
+	keepWeekly := subFlags.Int("keep_weekly", 0, "Number of weeks whose last backup is kept")
+	dryRun := subFlags.Bool("dry_run", false, "Only lists the backups which would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	policy := backupstorage.RetentionPolicy{
+		Latest:   *keepLatest,
+		Dailies:  *keepDaily,
+		Weeklies: *keepWeekly,
+	}
+	names, err := mysqlctl.PruneBackups(ctx, bs, bucket, policy, *dryRun, wr.Logger())
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return nil
+}
+
+func commandVerifyBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of files to download and check simultaneously")
+	restoreTablet := subFlags.String("restore_tablet", "", "If set, the alias of a SPARE or DRAINED tablet of the shard to restore the backup on, once verified")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("action VerifyBackup requires <keyspace/shard> <backup name>")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+	name := subFlags.Arg(1)
+
+	// Check the tablet before the backup is downloaded.
+	var tablet *topodatapb.Tablet
+	if *restoreTablet != "" {
+		tabletAlias, err := topoproto.ParseTabletAlias(*restoreTablet)
+		if err != nil {
+			return err
+		}
+		tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+		if err != nil {
+			return err
+		}
+		if tabletInfo.Keyspace != keyspace || tabletInfo.Shard != shard {
+			return fmt.Errorf("tablet %v is in %v/%v, not in %v/%v", *restoreTablet, tabletInfo.Keyspace, tabletInfo.Shard, keyspace, shard)
+		}
+		// The restore replaces the data of the tablet: only a tablet
+		// set aside for it can be used.
+		if tabletInfo.Type != topodatapb.TabletType_SPARE && tabletInfo.Type != topodatapb.TabletType_DRAINED {
+			return fmt.Errorf("tablet %v is %v, only a SPARE or DRAINED tablet can be restored", *restoreTablet, tabletInfo.Type)
+		}
+		tablet = tabletInfo.Tablet
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	var bh backupstorage.BackupHandle
+	for _, b := range bhs {
+		if b.Name() == name {
+			bh = b
+			break
+		}
+	}
+	if bh == nil {
+		return fmt.Errorf("no backup %v in %v", name, bucket)
+	}
+
+	params := mysqlctl.VerifyParams{
+		Logger:      wr.Logger(),
+		Concurrency: *concurrency,
+	}
+	manifest, err := mysqlctl.VerifyBackup(ctx, params, bh)
+	if err != nil {
+		return err
+	}
+	if tablet == nil {
+		return nil
+	}
+
+	// Restoring up to the position of the backup selects it, after the
+	// backups it is incremental to, if any.
+	wr.Logger().Infof("Restoring backup %v on tablet %v", name, *restoreTablet)
+	return execRestoreFromBackup(ctx, wr, tablet, &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: mysql.EncodePosition(manifest.Position),
+	})
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToTimestampStr := subFlags.String("restore_to_timestamp", "", "Restores up to this time, in RFC 3339 format (e.g. 2020-03-01T12:30:00Z)")
+	restoreToPos := subFlags.String("restore_to_pos", "", "Restores up to this replication position (e.g. MySQL56/<server uuid>:1-100)")
+	backupName := subFlags.String("backup_name", "", "Restores this backup, as listed by ListBackups, instead of the latest one")
+	backupTimestampStr := subFlags.String("backup_timestamp", "", "Restores the latest backup taken at or before this time, in RFC 3339 format (e.g. 2020-03-01T12:30:00Z)")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	if *restoreToTimestampStr != "" && *restoreToPos != "" {
+		return fmt.Errorf("only one of -restore_to_timestamp and -restore_to_pos can be specified")
+	}
+	if *backupTimestampStr != "" && (*backupName != "" || *restoreToTimestampStr != "" || *restoreToPos != "") {
+		return fmt.Errorf("-backup_timestamp can't be specified with -backup_name, -restore_to_timestamp or -restore_to_pos")
+	}
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: *restoreToPos,
+		BackupName:   *backupName,
+	}
+	if *backupTimestampStr != "" {
+		backupTimestamp, err := time.Parse(time.RFC3339, *backupTimestampStr)
+		if err != nil {
+			return fmt.Errorf("invalid -backup_timestamp %v: %v", *backupTimestampStr, err)
+		}
+		req.BackupTimestamp = logutil.TimeToProto(backupTimestamp)
+	}
+	if *restoreToTimestampStr != "" {
+		restoreToTimestamp, err := time.Parse(time.RFC3339, *restoreToTimestampStr)
+		if err != nil {
+			return fmt.Errorf("invalid -restore_to_timestamp %v: %v", *restoreToTimestampStr, err)
+		}
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+	if *restoreToPos != "" {
+		if _, err := mysql.DecodePosition(*restoreToPos); err != nil {
+			return fmt.Errorf("invalid -restore_to_pos %v: %v", *restoreToPos, err)
+		}
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -236,7 +402,11 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	return execRestoreFromBackup(ctx, wr, tabletInfo.Tablet, req)
+}
+
+func execRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) error {
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tablet, req)
 	if err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -58,6 +59,18 @@ func init() {
 		commandGetCellsAliases,
 		"",
 		"Lists all the cells for which we have a CellsAlias object."})
+
+	addCommand(cellsAliasesGroupName, command{
+		"ValidateCellsAliases",
+		commandValidateCellsAliases,
+		"",
+		"Checks that all the cells of the CellsAliases exist, and that no cell belongs to more than one alias."})
+
+	addCommand(cellsAliasesGroupName, command{
+		"GetServingCells",
+		commandGetServingCells,
+		"<cell> <tablet type>",
+		"Lists the cells whose tablets of the given type serve the queries routed from <cell>, according to the CellsAliases."})
 }
 
 func commandAddCellsAlias(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -98,7 +111,9 @@ func commandUpdateCellsAlias(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	alias := subFlags.Arg(0)
 
 	return wr.TopoServer().UpdateCellsAlias(ctx, alias, func(ca *topodatapb.CellsAlias) error {
-		ca.Cells = cells
+		if *cellsString != "" {
+			ca.Cells = cells
+		}
 		return nil
 	})
 }
@@ -128,3 +143,31 @@ func commandGetCellsAliases(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	}
 	return printJSON(wr.Logger(), aliases)
 }
+
+func commandValidateCellsAliases(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("ValidateCellsAliases command takes no parameter")
+	}
+	return wr.TopoServer().ValidateCellsAliases(ctx)
+}
+
+func commandGetServingCells(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <cell> and <tablet type> arguments are required for the GetServingCells command")
+	}
+	tabletType, err := topoproto.ParseTabletType(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	cells, err := wr.TopoServer().GetServingCells(ctx, subFlags.Arg(0), tabletType)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), cells)
+}
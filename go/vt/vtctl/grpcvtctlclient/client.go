@@ -44,13 +44,18 @@ type gRPCVtctlClient struct {
 	c  vtctlservicepb.VtctlClient
 }
 
-func gRPCVtctlClientFactory(addr string) (vtctlclient.VtctlClient, error) {
+// dial connects to the vtctld at addr.
+func dial(addr string) (*grpc.ClientConn, error) {
 	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
 	if err != nil {
 		return nil, err
 	}
+	return grpcclient.Dial(addr, grpcclient.FailFast(false), opt)
+}
+
+func gRPCVtctlClientFactory(addr string) (vtctlclient.VtctlClient, error) {
 	// create the RPC client
-	cc, err := grpcclient.Dial(addr, grpcclient.FailFast(false), opt)
+	cc, err := dial(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +100,7 @@ func TestVtctlAuthClient(t *testing.T) {
 	}
 
 	flag.Set("grpc_auth_static_client_creds", f.Name())
+	defer flag.Set("grpc_auth_static_client_creds", "")
 
 	// Create a VtctlClient gRPC client to talk to the fake server
 	client, err := gRPCVtctlClientFactory(fmt.Sprintf("localhost:%v", port))
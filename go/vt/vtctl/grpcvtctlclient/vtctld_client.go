@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctlclient
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"vitess.io/vitess/go/vt/logutil"
+
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+)
+
+// VtctldClient is a client of the typed Vtctld service. The few
+// commands that are not part of the typed service, like VtGateExecute,
+// can be run with ExecuteVtctlCommand.
+type VtctldClient struct {
+	vtctlservicepb.VtctldClient
+
+	cc    *grpc.ClientConn
+	vtctl *gRPCVtctlClient
+}
+
+// NewVtctldClient returns a VtctldClient for the vtctld at addr. It
+// uses the same -vtctld_grpc_* flags as the vtctl client.
+func NewVtctldClient(addr string) (*VtctldClient, error) {
+	cc, err := dial(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &VtctldClient{
+		VtctldClient: vtctlservicepb.NewVtctldClient(cc),
+		cc:           cc,
+		vtctl: &gRPCVtctlClient{
+			cc: cc,
+			c:  vtctlservicepb.NewVtctlClient(cc),
+		},
+	}, nil
+}
+
+// ExecuteVtctlCommand runs a vtctl command that has no typed RPC.
+func (client *VtctldClient) ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error) {
+	return client.vtctl.ExecuteVtctlCommand(ctx, args, actionTimeout)
+}
+
+// Close closes the connection to vtctld.
+func (client *VtctldClient) Close() {
+	client.cc.Close()
+}
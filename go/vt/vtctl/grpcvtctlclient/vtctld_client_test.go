@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctlclient
+
+import (
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctlserver"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+
+	// needed so that grpc client is registered
+	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+)
+
+func TestVtctldClient(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "id"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+
+	// Listen on a random port
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+
+	// Serve both the typed and the command line services.
+	server := grpc.NewServer()
+	grpcvtctldserver.StartServer(server, ts)
+	vtctlservicepb.RegisterVtctlServer(server, grpcvtctlserver.NewVtctlServer(ts))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	client, err := NewVtctldClient(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("Cannot create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: "ks"})
+	if err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	if got, want := resp.Keyspace.Keyspace.ShardingColumnName, "id"; got != want {
+		t.Errorf("GetKeyspace: sharding column %q, want %q", got, want)
+	}
+
+	stream, err := client.ExecuteVtctlCommand(ctx, []string{"GetKeyspaces"}, 10*time.Second)
+	if err != nil {
+		t.Fatalf("ExecuteVtctlCommand failed: %v", err)
+	}
+	var out []string
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ExecuteVtctlCommand failed: %v", err)
+		}
+		out = append(out, e.Value)
+	}
+	if got := strings.Join(out, ""); got != "ks\n" {
+		t.Errorf("GetKeyspaces: %q, want %q", got, "ks\n")
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the backup commands, except for Backup.
+
+// defaultVerifyBackupConcurrency is the same as the default of the
+// VerifyBackup vtctl command.
+const defaultVerifyBackupConcurrency = 4
+
+// BackupShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) BackupShard(req *vtctldatapb.BackupShardRequest, stream vtctlservicepb.Vtctld_BackupShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	ctx := stream.Context()
+	tablets, stats, err := s.wrangler(logutil.NewConsoleLogger()).ShardReplicationStatuses(ctx, req.Keyspace, req.Shard)
+	if tablets == nil {
+		return vterrors.ToGRPC(err)
+	}
+
+	// Back up the most up to date replica, rdonly or spare tablet, or
+	// the master if allowed and there is no other tablet.
+	var tablet, master *topodatapb.Tablet
+	var secondsBehind uint32
+	for i, ti := range tablets {
+		switch ti.Type {
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
+			if tablet == nil || stats[i].SecondsBehindMaster < secondsBehind {
+				tablet = ti.Tablet
+				secondsBehind = stats[i].SecondsBehindMaster
+			}
+		case topodatapb.TabletType_MASTER:
+			if master == nil {
+				master = ti.Tablet
+			}
+		}
+	}
+	if tablet == nil && req.AllowMaster {
+		tablet = master
+	}
+	if tablet == nil {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "no tablet available for backup"))
+	}
+
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBackupConcurrency
+	}
+	logStream, err := s.tmc.Backup(ctx, tablet, concurrency, req.AllowMaster, req.Incremental)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.BackupShardResponse{Event: e})
+	})
+}
+
+// ListBackups is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ListBackups(ctx context.Context, req *vtctldatapb.ListBackupsRequest) (*vtctldatapb.ListBackupsResponse, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, backupBucket(req.Keyspace, req.Shard))
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response := &vtctldatapb.ListBackupsResponse{}
+	for _, bh := range bhs {
+		response.Names = append(response.Names, bh.Name())
+	}
+	return response, nil
+}
+
+// RemoveBackup is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RemoveBackup(ctx context.Context, req *vtctldatapb.RemoveBackupRequest) (*vtctldatapb.RemoveBackupResponse, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer bs.Close()
+	if err := bs.RemoveBackup(ctx, backupBucket(req.Keyspace, req.Shard), req.Name); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RemoveBackupResponse{}, nil
+}
+
+// PruneBackups is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) PruneBackups(ctx context.Context, req *vtctldatapb.PruneBackupsRequest) (*vtctldatapb.PruneBackupsResponse, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer bs.Close()
+	policy := backupstorage.RetentionPolicy{
+		Latest:   int(req.KeepLatest),
+		Dailies:  int(req.KeepDaily),
+		Weeklies: int(req.KeepWeekly),
+	}
+	names, err := mysqlctl.PruneBackups(ctx, bs, backupBucket(req.Keyspace, req.Shard), policy, req.DryRun, logutil.NewConsoleLogger())
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.PruneBackupsResponse{Names: names}, nil
+}
+
+// VerifyBackup is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) VerifyBackup(req *vtctldatapb.VerifyBackupRequest, stream vtctlservicepb.Vtctld_VerifyBackupServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	ctx := stream.Context()
+	bucket := backupBucket(req.Keyspace, req.Shard)
+
+	// Check the tablet before the backup is downloaded.
+	var tablet *topodatapb.Tablet
+	if req.RestoreTabletAlias != nil {
+		ti, err := s.ts.GetTablet(ctx, req.RestoreTabletAlias)
+		if err != nil {
+			return vterrors.ToGRPC(err)
+		}
+		alias := topoproto.TabletAliasString(req.RestoreTabletAlias)
+		if ti.Keyspace != req.Keyspace || ti.Shard != req.Shard {
+			return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "tablet %v is in %v/%v, not in %v", alias, ti.Keyspace, ti.Shard, bucket))
+		}
+		// The restore replaces the data of the tablet: only a tablet
+		// set aside for it can be used.
+		if ti.Type != topodatapb.TabletType_SPARE && ti.Type != topodatapb.TabletType_DRAINED {
+			return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v is %v, only a SPARE or DRAINED tablet can be restored", alias, ti.Type))
+		}
+		tablet = ti.Tablet
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	var bh backupstorage.BackupHandle
+	for _, b := range bhs {
+		if b.Name() == req.Name {
+			bh = b
+			break
+		}
+	}
+	if bh == nil {
+		return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no backup %v in %v", req.Name, bucket))
+	}
+
+	mu := sync.Mutex{}
+	send := func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.VerifyBackupResponse{Event: e})
+	}
+	logger := streamLogger(&mu, send)
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultVerifyBackupConcurrency
+	}
+	manifest, err := mysqlctl.VerifyBackup(ctx, mysqlctl.VerifyParams{
+		Logger:      logger,
+		Concurrency: concurrency,
+	}, bh)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	if tablet == nil {
+		return nil
+	}
+
+	// Restoring up to the position of the backup selects it, after the
+	// backups it is incremental to, if any.
+	logger.Infof("Restoring backup %v on tablet %v", req.Name, topoproto.TabletAliasString(tablet.Alias))
+	logStream, err := s.tmc.RestoreFromBackup(ctx, tablet, &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: mysql.EncodePosition(manifest.Position),
+	})
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return send(e)
+	})
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RestoreFromBackup(req *vtctldatapb.RestoreFromBackupRequest, stream vtctlservicepb.Vtctld_RestoreFromBackupServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	restore := req.RestoreRequest
+	if restore == nil {
+		restore = &tabletmanagerdatapb.RestoreFromBackupRequest{}
+	}
+	if restore.RestoreToTimestamp != nil && restore.RestoreToPos != "" {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "only one of restore_to_timestamp and restore_to_pos can be set"))
+	}
+	if restore.BackupTimestamp != nil && (restore.BackupName != "" || restore.RestoreToTimestamp != nil || restore.RestoreToPos != "") {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "backup_timestamp can't be set with backup_name, restore_to_timestamp or restore_to_pos"))
+	}
+	if restore.RestoreToPos != "" {
+		if _, err := mysql.DecodePosition(restore.RestoreToPos); err != nil {
+			return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid restore_to_pos %v: %v", restore.RestoreToPos, err))
+		}
+	}
+
+	ctx := stream.Context()
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	logStream, err := s.tmc.RestoreFromBackup(ctx, ti.Tablet, restore)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.RestoreFromBackupResponse{Event: e})
+	})
+}
+
+// backupBucket returns the backup storage directory of a shard.
+func backupBucket(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v", keyspace, shard)
+}
+
+// forwardEvents sends the events of a tablet manager stream until it
+// ends.
+func forwardEvents(logStream logutil.EventStream, send func(*logutilpb.Event) error) error {
+	for {
+		e, err := logStream.Recv()
+		switch err {
+		case nil:
+			if err := send(e); err != nil {
+				return err
+			}
+		case io.EOF:
+			return nil
+		default:
+			return vterrors.ToGRPC(err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+type verifyBackupStream struct {
+	fakeStream
+}
+
+func (stream *verifyBackupStream) Send(response *vtctldatapb.VerifyBackupResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+type restoreFromBackupStream struct {
+	fakeStream
+}
+
+func (stream *restoreFromBackupStream) Send(response *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+func TestBackupRPCs(t *testing.T) {
+	ctx := context.Background()
+	s := newVtctldServer(memorytopo.NewServer("cell1"), &recordingTMC{})
+
+	root, err := ioutil.TempDir("", "backup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	for _, name := range []string{"backup1", "backup2"} {
+		bh, err := bs.StartBackup(ctx, "ks/0", name)
+		require.NoError(t, err)
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	list, err := s.ListBackups(ctx, &vtctldatapb.ListBackupsRequest{Keyspace: "ks", Shard: "0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup1", "backup2"}, list.Names)
+
+	_, err = s.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{Keyspace: "ks", Shard: "0"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = the retention policy must retain at least one backup")
+	// The backups without a MANIFEST are kept.
+	pruned, err := s.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{Keyspace: "ks", Shard: "0", KeepLatest: 1})
+	require.NoError(t, err)
+	assert.Empty(t, pruned.Names)
+
+	_, err = s.RemoveBackup(ctx, &vtctldatapb.RemoveBackupRequest{Keyspace: "ks", Shard: "0", Name: "backup1"})
+	require.NoError(t, err)
+	list, err = s.ListBackups(ctx, &vtctldatapb.ListBackupsRequest{Keyspace: "ks", Shard: "0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup2"}, list.Names)
+
+	err = s.VerifyBackup(&vtctldatapb.VerifyBackupRequest{Keyspace: "ks", Shard: "0", Name: "backup1"}, &verifyBackupStream{fakeStream{ctx: ctx}})
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = no backup backup1 in ks/0")
+
+	err = s.RestoreFromBackup(&vtctldatapb.RestoreFromBackupRequest{
+		RestoreRequest: &tabletmanagerdatapb.RestoreFromBackupRequest{
+			BackupName:      "backup2",
+			BackupTimestamp: &vttime.Time{Seconds: 1},
+		},
+	}, nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = backup_timestamp can't be set with backup_name, restore_to_timestamp or restore_to_pos")
+	err = s.RestoreFromBackup(&vtctldatapb.RestoreFromBackupRequest{}, &restoreFromBackupStream{fakeStream{ctx: ctx}})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_alias is required")
+}
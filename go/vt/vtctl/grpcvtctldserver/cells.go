@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// This file contains the RPCs of the Cells and CellsAliases commands.
+
+// AddCellInfo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) AddCellInfo(ctx context.Context, req *vtctldatapb.AddCellInfoRequest) (*vtctldatapb.AddCellInfoResponse, error) {
+	ci := req.CellInfo
+	if ci == nil {
+		ci = &topodatapb.CellInfo{}
+	}
+	if err := s.ts.CreateCellInfo(ctx, req.Name, ci); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.AddCellInfoResponse{}, nil
+}
+
+// UpdateCellInfo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) UpdateCellInfo(ctx context.Context, req *vtctldatapb.UpdateCellInfoRequest) (*vtctldatapb.UpdateCellInfoResponse, error) {
+	var updated *topodatapb.CellInfo
+	err := s.ts.UpdateCellInfoFields(ctx, req.Name, func(ci *topodatapb.CellInfo) error {
+		updated = ci
+		if req.CellInfo == nil ||
+			(req.CellInfo.ServerAddress == "" || ci.ServerAddress == req.CellInfo.ServerAddress) &&
+				(req.CellInfo.Root == "" || ci.Root == req.CellInfo.Root) {
+			return topo.NewError(topo.NoUpdateNeeded, req.Name)
+		}
+		if req.CellInfo.ServerAddress != "" {
+			ci.ServerAddress = req.CellInfo.ServerAddress
+		}
+		if req.CellInfo.Root != "" {
+			ci.Root = req.CellInfo.Root
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.UpdateCellInfoResponse{
+		Name:     req.Name,
+		CellInfo: updated,
+	}, nil
+}
+
+// DeleteCellInfo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteCellInfo(ctx context.Context, req *vtctldatapb.DeleteCellInfoRequest) (*vtctldatapb.DeleteCellInfoResponse, error) {
+	if err := s.ts.DeleteCellInfo(ctx, req.Name, req.Force); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.DeleteCellInfoResponse{}, nil
+}
+
+// GetCellInfoNames is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetCellInfoNames(ctx context.Context, req *vtctldatapb.GetCellInfoNamesRequest) (*vtctldatapb.GetCellInfoNamesResponse, error) {
+	names, err := s.ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetCellInfoNamesResponse{Names: names}, nil
+}
+
+// GetCellInfo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetCellInfo(ctx context.Context, req *vtctldatapb.GetCellInfoRequest) (*vtctldatapb.GetCellInfoResponse, error) {
+	// This is a strong read, as the cell infos are user-generated and
+	// not read by any automated process.
+	ci, err := s.ts.GetCellInfo(ctx, req.Cell, true /*strongRead*/)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetCellInfoResponse{CellInfo: ci}, nil
+}
+
+// AddCellsAlias is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCellsAliasRequest) (*vtctldatapb.AddCellsAliasResponse, error) {
+	if err := s.ts.CreateCellsAlias(ctx, req.Name, &topodatapb.CellsAlias{Cells: req.Cells}); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.AddCellsAliasResponse{}, nil
+}
+
+// UpdateCellsAlias is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.UpdateCellsAliasRequest) (*vtctldatapb.UpdateCellsAliasResponse, error) {
+	var updated *topodatapb.CellsAlias
+	err := s.ts.UpdateCellsAlias(ctx, req.Name, func(ca *topodatapb.CellsAlias) error {
+		updated = ca
+		if len(req.Cells) == 0 {
+			return topo.NewError(topo.NoUpdateNeeded, req.Name)
+		}
+		ca.Cells = req.Cells
+		return nil
+	})
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.UpdateCellsAliasResponse{
+		Name:       req.Name,
+		CellsAlias: updated,
+	}, nil
+}
+
+// DeleteCellsAlias is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteCellsAlias(ctx context.Context, req *vtctldatapb.DeleteCellsAliasRequest) (*vtctldatapb.DeleteCellsAliasResponse, error) {
+	if err := s.ts.DeleteCellsAlias(ctx, req.Name); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.DeleteCellsAliasResponse{}, nil
+}
+
+// GetCellsAliases is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetCellsAliases(ctx context.Context, req *vtctldatapb.GetCellsAliasesRequest) (*vtctldatapb.GetCellsAliasesResponse, error) {
+	aliases, err := s.ts.GetCellsAliases(ctx, true /*strongRead*/)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetCellsAliasesResponse{Aliases: aliases}, nil
+}
+
+// ValidateCellsAliases is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateCellsAliases(ctx context.Context, req *vtctldatapb.ValidateCellsAliasesRequest) (*vtctldatapb.ValidateCellsAliasesResponse, error) {
+	if err := s.ts.ValidateCellsAliases(ctx); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ValidateCellsAliasesResponse{}, nil
+}
+
+// GetServingCells is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetServingCells(ctx context.Context, req *vtctldatapb.GetServingCellsRequest) (*vtctldatapb.GetServingCellsResponse, error) {
+	cells, err := s.ts.GetServingCells(ctx, req.Cell, req.TabletType)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetServingCellsResponse{Cells: cells}, nil
+}
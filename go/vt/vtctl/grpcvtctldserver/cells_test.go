@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestCellRPCs(t *testing.T) {
+	ctx := context.Background()
+	s := newVtctldServer(memorytopo.NewServer("cell1", "cell2"), &recordingTMC{})
+
+	_, err := s.AddCellInfo(ctx, &vtctldatapb.AddCellInfoRequest{
+		Name:     "cell3",
+		CellInfo: &topodatapb.CellInfo{ServerAddress: "addr3", Root: "/root3"},
+	})
+	require.NoError(t, err)
+	updated, err := s.UpdateCellInfo(ctx, &vtctldatapb.UpdateCellInfoRequest{
+		Name:     "cell3",
+		CellInfo: &topodatapb.CellInfo{Root: "/new_root3"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "addr3", updated.CellInfo.ServerAddress)
+	assert.Equal(t, "/new_root3", updated.CellInfo.Root)
+	ci, err := s.GetCellInfo(ctx, &vtctldatapb.GetCellInfoRequest{Cell: "cell3"})
+	require.NoError(t, err)
+	assert.Equal(t, "/new_root3", ci.CellInfo.Root)
+	names, err := s.GetCellInfoNames(ctx, &vtctldatapb.GetCellInfoNamesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cell1", "cell2", "cell3"}, names.Names)
+	_, err = s.DeleteCellInfo(ctx, &vtctldatapb.DeleteCellInfoRequest{Name: "cell3", Force: true})
+	require.NoError(t, err)
+	names, err = s.GetCellInfoNames(ctx, &vtctldatapb.GetCellInfoNamesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cell1", "cell2"}, names.Names)
+
+	_, err = s.AddCellsAlias(ctx, &vtctldatapb.AddCellsAliasRequest{
+		Name:  "region",
+		Cells: []string{"cell1"},
+	})
+	require.NoError(t, err)
+	updatedAlias, err := s.UpdateCellsAlias(ctx, &vtctldatapb.UpdateCellsAliasRequest{
+		Name:  "region",
+		Cells: []string{"cell1", "cell2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cell1", "cell2"}, updatedAlias.CellsAlias.Cells)
+	aliases, err := s.GetCellsAliases(ctx, &vtctldatapb.GetCellsAliasesRequest{})
+	require.NoError(t, err)
+	require.Contains(t, aliases.Aliases, "region")
+	assert.Equal(t, []string{"cell1", "cell2"}, aliases.Aliases["region"].Cells)
+	_, err = s.ValidateCellsAliases(ctx, &vtctldatapb.ValidateCellsAliasesRequest{})
+	require.NoError(t, err)
+
+	serving, err := s.GetServingCells(ctx, &vtctldatapb.GetServingCellsRequest{
+		Cell:       "cell1",
+		TabletType: topodatapb.TabletType_REPLICA,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, serving.Cells, "cell1")
+
+	_, err = s.DeleteCellsAlias(ctx, &vtctldatapb.DeleteCellsAliasRequest{Name: "region"})
+	require.NoError(t, err)
+	aliases, err = s.GetCellsAliases(ctx, &vtctldatapb.GetCellsAliasesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, aliases.Aliases)
+}
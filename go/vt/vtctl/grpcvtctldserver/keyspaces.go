@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Keyspaces commands, except for the
+// workflows.
+
+// CreateKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
+	if req.Type == topodatapb.KeyspaceType_SNAPSHOT {
+		if req.BaseKeyspace == "" {
+			return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "base_keyspace must be specified while creating a snapshot keyspace"))
+		}
+		if _, err := s.ts.GetKeyspace(ctx, req.BaseKeyspace); err != nil {
+			return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "cannot find base_keyspace: %v", req.BaseKeyspace))
+		}
+		if req.SnapshotTime == nil {
+			return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "snapshot_time must be specified when creating a snapshot keyspace"))
+		}
+	}
+	for _, sf := range req.ServedFroms {
+		if err := checkServingTabletType(sf.TabletType); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+
+	ki := &topodatapb.Keyspace{
+		ShardingColumnName: req.ShardingColumnName,
+		ShardingColumnType: req.ShardingColumnType,
+		ServedFroms:        req.ServedFroms,
+		KeyspaceType:       req.Type,
+		BaseKeyspace:       req.BaseKeyspace,
+		SnapshotTime:       req.SnapshotTime,
+	}
+	err := s.ts.CreateKeyspace(ctx, req.Name, ki)
+	if req.Force && topo.IsErrType(err, topo.NodeExists) {
+		err = nil
+	}
+	if err == nil && !req.AllowEmptyVSchema {
+		err = s.ts.EnsureVSchema(ctx, req.Name)
+	}
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+
+	if req.Type == topodatapb.KeyspaceType_SNAPSHOT {
+		// Copy the vschema from the base keyspace. SNAPSHOT keyspaces
+		// are excluded from global routing.
+		vs, err := s.ts.GetVSchema(ctx, req.BaseKeyspace)
+		switch {
+		case err == nil:
+			vs.RequireExplicitRouting = true
+		case topo.IsErrType(err, topo.NoNode):
+			vs = &vschemapb.Keyspace{
+				Sharded:                false,
+				Tables:                 make(map[string]*vschemapb.Table),
+				Vindexes:               make(map[string]*vschemapb.Vindex),
+				RequireExplicitRouting: true,
+			}
+		default:
+			return nil, vterrors.ToGRPC(err)
+		}
+		if err := s.ts.SaveVSchema(ctx, req.Name, vs); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+		if err := s.ts.RebuildSrvVSchema(ctx, []string{} /* cells */); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+
+	created, err := s.ts.GetKeyspace(ctx, req.Name)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CreateKeyspaceResponse{
+		Keyspace: &vtctldatapb.Keyspace{
+			Name:     req.Name,
+			Keyspace: created.Keyspace,
+		},
+	}, nil
+}
+
+// DeleteKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteKeyspace(ctx context.Context, req *vtctldatapb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).DeleteKeyspace(ctx, req.Keyspace, req.Recursive); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.DeleteKeyspaceResponse{}, nil
+}
+
+// RemoveKeyspaceCell is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldatapb.RemoveKeyspaceCellRequest) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).RemoveKeyspaceCell(ctx, req.Keyspace, req.Cell, req.Force, req.Recursive); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RemoveKeyspaceCellResponse{}, nil
+}
+
+// SetKeyspaceShardingInfo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetKeyspaceShardingInfo(ctx context.Context, req *vtctldatapb.SetKeyspaceShardingInfoRequest) (*vtctldatapb.SetKeyspaceShardingInfoResponse, error) {
+	if (req.ColumnName == "") != (req.ColumnType == topodatapb.KeyspaceIdType_UNSET) {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "both column_name and column_type must be set, or both must be unset"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).SetKeyspaceShardingInfo(ctx, req.Keyspace, req.ColumnName, req.ColumnType, req.Force); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetKeyspaceShardingInfoResponse{Keyspace: ki.Keyspace}, nil
+}
+
+// SetKeyspaceDDLStrategy is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetKeyspaceDDLStrategy(ctx context.Context, req *vtctldatapb.SetKeyspaceDDLStrategyRequest) (*vtctldatapb.SetKeyspaceDDLStrategyResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).SetKeyspaceDDLStrategy(ctx, req.Keyspace, req.DdlStrategy); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetKeyspaceDDLStrategyResponse{Keyspace: ki.Keyspace}, nil
+}
+
+// SetKeyspaceServedFrom is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetKeyspaceServedFrom(ctx context.Context, req *vtctldatapb.SetKeyspaceServedFromRequest) (*vtctldatapb.SetKeyspaceServedFromResponse, error) {
+	if err := checkTabletType(req.TabletType, topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).SetKeyspaceServedFrom(ctx, req.Keyspace, req.TabletType, req.Cells, req.SourceKeyspace, req.Remove); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetKeyspaceServedFromResponse{Keyspace: ki.Keyspace}, nil
+}
+
+// RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RebuildKeyspaceGraph(ctx context.Context, req *vtctldatapb.RebuildKeyspaceGraphRequest) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
+	wr := s.wrangler(logutil.NewConsoleLogger())
+	for _, keyspace := range req.Keyspaces {
+		if err := wr.RebuildKeyspaceGraph(ctx, keyspace, req.Cells); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	return &vtctldatapb.RebuildKeyspaceGraphResponse{}, nil
+}
+
+// ValidateKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateKeyspace(req *vtctldatapb.ValidateKeyspaceRequest, stream vtctlservicepb.Vtctld_ValidateKeyspaceServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateKeyspaceResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateKeyspace(stream.Context(), req.Keyspace, req.PingTablets))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+type validateKeyspaceStream struct {
+	fakeStream
+}
+
+func (stream *validateKeyspaceStream) Send(response *vtctldatapb.ValidateKeyspaceResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+func TestKeyspaceRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+
+	created, err := s.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{
+		Name:               "ks",
+		ShardingColumnName: "id",
+		ShardingColumnType: topodatapb.KeyspaceIdType_UINT64,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "id", created.Keyspace.Keyspace.ShardingColumnName)
+	_, err = ts.GetVSchema(ctx, "ks")
+	require.NoError(t, err)
+	_, err = s.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{Name: "ks"})
+	assert.Error(t, err)
+	_, err = s.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{Name: "ks", Force: true})
+	require.NoError(t, err)
+
+	_, err = s.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{
+		Name: "snapshot",
+		Type: topodatapb.KeyspaceType_SNAPSHOT,
+	})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = base_keyspace must be specified while creating a snapshot keyspace")
+	_, err = s.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{
+		Name:         "snapshot",
+		Type:         topodatapb.KeyspaceType_SNAPSHOT,
+		BaseKeyspace: "ks",
+		SnapshotTime: &vttime.Time{Seconds: 1},
+	})
+	require.NoError(t, err)
+	vs, err := ts.GetVSchema(ctx, "snapshot")
+	require.NoError(t, err)
+	assert.True(t, vs.RequireExplicitRouting)
+
+	_, err = s.SetKeyspaceShardingInfo(ctx, &vtctldatapb.SetKeyspaceShardingInfoRequest{
+		Keyspace:   "ks",
+		ColumnName: "id",
+	})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = both column_name and column_type must be set, or both must be unset")
+	sharding, err := s.SetKeyspaceShardingInfo(ctx, &vtctldatapb.SetKeyspaceShardingInfoRequest{
+		Keyspace:   "ks",
+		ColumnName: "user_id",
+		ColumnType: topodatapb.KeyspaceIdType_BYTES,
+		Force:      true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user_id", sharding.Keyspace.ShardingColumnName)
+
+	strategy, err := s.SetKeyspaceDDLStrategy(ctx, &vtctldatapb.SetKeyspaceDDLStrategyRequest{
+		Keyspace:    "ks",
+		DdlStrategy: "online",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "online", strategy.Keyspace.DdlStrategy)
+
+	servedFrom, err := s.SetKeyspaceServedFrom(ctx, &vtctldatapb.SetKeyspaceServedFromRequest{
+		Keyspace:       "ks",
+		TabletType:     topodatapb.TabletType_RDONLY,
+		SourceKeyspace: "source",
+	})
+	require.NoError(t, err)
+	require.Len(t, servedFrom.Keyspace.ServedFroms, 1)
+	assert.Equal(t, "source", servedFrom.Keyspace.ServedFroms[0].Keyspace)
+
+	err = ts.CreateShard(ctx, "ks", "0")
+	require.NoError(t, err)
+	_, err = s.RebuildKeyspaceGraph(ctx, &vtctldatapb.RebuildKeyspaceGraphRequest{
+		Keyspaces: []string{"ks"},
+	})
+	require.NoError(t, err)
+	_, err = ts.GetSrvKeyspace(ctx, "cell1", "ks")
+	require.NoError(t, err)
+
+	stream := &validateKeyspaceStream{fakeStream{ctx: ctx}}
+	err = s.ValidateKeyspace(&vtctldatapb.ValidateKeyspaceRequest{Keyspace: "ks"}, stream)
+	assert.Error(t, err)
+	assert.NotEmpty(t, stream.events)
+
+	_, err = s.DeleteKeyspace(ctx, &vtctldatapb.DeleteKeyspaceRequest{
+		Keyspace:  "ks",
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	_, err = ts.GetKeyspace(ctx, "ks")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
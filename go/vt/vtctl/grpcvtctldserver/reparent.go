@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Reparenting commands.
+
+const defaultReparentSlaveTimeout = 30 * time.Second
+
+// InitShardMaster is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) InitShardMaster(req *vtctldatapb.InitShardMasterRequest, stream vtctlservicepb.Vtctld_InitShardMasterServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkActiveReparents(); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.InitShardMasterResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.InitShardMaster(stream.Context(), req.Keyspace, req.Shard, req.MasterElectTabletAlias, req.Force, durationOrDefault(req.WaitSlaveTimeout, defaultReparentSlaveTimeout)))
+}
+
+// PlannedReparentShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) PlannedReparentShard(req *vtctldatapb.PlannedReparentShardRequest, stream vtctlservicepb.Vtctld_PlannedReparentShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkActiveReparents(); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.PlannedReparentShardResponse{Event: e})
+	}))
+	waitSlaveTimeout := durationOrDefault(req.WaitSlaveTimeout, *topo.RemoteOperationTimeout)
+	newMaster := req.NewMaster
+	if req.DryRun || req.Precheck {
+		plan, err := wr.PlannedReparentShardDryRun(stream.Context(), req.Keyspace, req.Shard, newMaster, req.AvoidMaster, waitSlaveTimeout)
+		if err != nil {
+			return vterrors.ToGRPC(err)
+		}
+		if req.DryRun || len(plan.Problems) != 0 {
+			mu.Lock()
+			err := stream.Send(&vtctldatapb.PlannedReparentShardResponse{Plan: plannedReparentPlan(plan)})
+			mu.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		if len(plan.Problems) != 0 {
+			return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "PlannedReparentShard would fail: %v", strings.Join(plan.Problems, "; ")))
+		}
+		if req.DryRun {
+			return nil
+		}
+		// Promote the tablet the dry run chose.
+		if newMaster == nil && plan.NewMaster != "" {
+			if newMaster, err = topoproto.ParseTabletAlias(plan.NewMaster); err != nil {
+				return vterrors.ToGRPC(err)
+			}
+		}
+	}
+	return vterrors.ToGRPC(wr.PlannedReparentShard(stream.Context(), req.Keyspace, req.Shard, newMaster, req.AvoidMaster, waitSlaveTimeout))
+}
+
+// EmergencyReparentShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) EmergencyReparentShard(req *vtctldatapb.EmergencyReparentShardRequest, stream vtctlservicepb.Vtctld_EmergencyReparentShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkActiveReparents(); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.EmergencyReparentShardResponse{Event: e})
+	}))
+	constraints := &wrangler.EmergencyReparentConstraints{
+		PreferredCells: req.PreferredCells,
+		ForbiddenCells: req.ForbiddenCells,
+		Tags:           req.Tags,
+		MaxDataLoss:    durationOrDefault(req.MaxDataLoss, 0),
+	}
+	return vterrors.ToGRPC(wr.EmergencyReparentShard(stream.Context(), req.Keyspace, req.Shard, req.NewMaster, durationOrDefault(req.WaitSlaveTimeout, defaultReparentSlaveTimeout), constraints))
+}
+
+// ReparentTablet is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.ReparentTabletRequest) (*vtctldatapb.ReparentTabletResponse, error) {
+	if err := checkActiveReparents(); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).ReparentTablet(ctx, req.TabletAlias); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ReparentTabletResponse{}, nil
+}
+
+// TabletExternallyReparented is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldatapb.TabletExternallyReparentedRequest) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).TabletExternallyReparented(ctx, req.TabletAlias); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.TabletExternallyReparentedResponse{}, nil
+}
+
+// checkActiveReparents returns an error if the active reparents are
+// disabled by -disable_active_reparents.
+func checkActiveReparents() error {
+	if *mysqlctl.DisableActiveReparents {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "active reparent commands disabled (unset the -disable_active_reparents flag to enable)")
+	}
+	return nil
+}
+
+// plannedReparentPlan converts a dry run plan of the wrangler to its
+// proto.
+func plannedReparentPlan(plan *wrangler.PlannedReparentPlan) *vtctldatapb.PlannedReparentPlan {
+	result := &vtctldatapb.PlannedReparentPlan{
+		Keyspace:         plan.Keyspace,
+		Shard:            plan.Shard,
+		CurrentMaster:    plan.CurrentMaster,
+		MasterSemiSync:   plan.MasterSemiSync,
+		NewMaster:        plan.NewMaster,
+		CatchUpTime:      plan.CatchUpTime,
+		ExpectedDowntime: plan.ExpectedDowntime,
+		Problems:         plan.Problems,
+		Warnings:         plan.Warnings,
+	}
+	for _, c := range plan.Candidates {
+		result.Candidates = append(result.Candidates, &vtctldatapb.PlannedReparentCandidate{
+			Tablet:      c.Tablet,
+			Type:        c.Type,
+			Position:    c.Position,
+			Replicating: c.Replicating,
+			LagSeconds:  c.LagSeconds,
+			SemiSync:    c.SemiSync,
+			Reason:      c.Reason,
+			Health:      c.Health,
+		})
+	}
+	return result
+}
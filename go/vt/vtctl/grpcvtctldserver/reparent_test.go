@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+type plannedReparentShardStream struct {
+	fakeStream
+
+	plans []*vtctldatapb.PlannedReparentPlan
+}
+
+func (stream *plannedReparentShardStream) Send(response *vtctldatapb.PlannedReparentShardResponse) error {
+	if response.Plan != nil {
+		stream.plans = append(stream.plans, response.Plan)
+		return nil
+	}
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+func TestReparentRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+
+	stream := &plannedReparentShardStream{fakeStream: fakeStream{ctx: ctx}}
+	err := s.PlannedReparentShard(&vtctldatapb.PlannedReparentShardRequest{
+		Keyspace: "ks",
+		Shard:    "0",
+		DryRun:   true,
+	}, stream)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "code = FailedPrecondition desc = PlannedReparentShard would fail: ")
+	require.Len(t, stream.plans, 1)
+	assert.Equal(t, "ks", stream.plans[0].Keyspace)
+	assert.NotEmpty(t, stream.plans[0].Problems)
+
+	_, err = s.TabletExternallyReparented(ctx, &vtctldatapb.TabletExternallyReparentedRequest{})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_alias is required")
+
+	*mysqlctl.DisableActiveReparents = true
+	defer func() { *mysqlctl.DisableActiveReparents = false }()
+	_, err = s.ReparentTablet(ctx, &vtctldatapb.ReparentTabletRequest{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+	})
+	assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = active reparent commands disabled (unset the -disable_active_reparents flag to enable)")
+	err = s.InitShardMaster(&vtctldatapb.InitShardMasterRequest{Keyspace: "ks", Shard: "0"}, nil)
+	assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = active reparent commands disabled (unset the -disable_active_reparents flag to enable)")
+}
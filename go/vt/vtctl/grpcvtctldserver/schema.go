@@ -0,0 +1,315 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schemamanager"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Schema, Version and Permissions
+// commands.
+
+const (
+	defaultReloadSchemaConcurrency = 10
+	defaultOnlineDDLStrategy       = "gh-ost"
+)
+
+// GetSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetSchema(ctx context.Context, req *vtctldatapb.GetSchemaRequest) (*vtctldatapb.GetSchemaResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	sd, err := s.wrangler(logutil.NewConsoleLogger()).GetSchema(ctx, req.TabletAlias, req.Tables, req.ExcludeTables, req.IncludeViews)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if req.TableNamesOnly {
+		tds := make([]*tabletmanagerdatapb.TableDefinition, 0, len(sd.TableDefinitions))
+		for _, td := range sd.TableDefinitions {
+			tds = append(tds, &tabletmanagerdatapb.TableDefinition{Name: td.Name})
+		}
+		sd = &tabletmanagerdatapb.SchemaDefinition{TableDefinitions: tds}
+	}
+	return &vtctldatapb.GetSchemaResponse{Schema: sd}, nil
+}
+
+// ReloadSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReloadSchema(ctx context.Context, req *vtctldatapb.ReloadSchemaRequest) (*vtctldatapb.ReloadSchemaResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).ReloadSchema(ctx, req.TabletAlias); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ReloadSchemaResponse{}, nil
+}
+
+// ReloadSchemaShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReloadSchemaShard(req *vtctldatapb.ReloadSchemaShardRequest, stream vtctlservicepb.Vtctld_ReloadSchemaShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ReloadSchemaShardResponse{Event: e})
+	}))
+	sema := sync2.NewSemaphore(reloadSchemaConcurrency(req.Concurrency), 0)
+	wr.ReloadSchemaShard(stream.Context(), req.Keyspace, req.Shard, req.WaitPosition, sema, req.IncludeMaster)
+	return nil
+}
+
+// ReloadSchemaKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReloadSchemaKeyspace(req *vtctldatapb.ReloadSchemaKeyspaceRequest, stream vtctlservicepb.Vtctld_ReloadSchemaKeyspaceServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ReloadSchemaKeyspaceResponse{Event: e})
+	}))
+	sema := sync2.NewSemaphore(reloadSchemaConcurrency(req.Concurrency), 0)
+	return vterrors.ToGRPC(wr.ReloadSchemaKeyspace(stream.Context(), req.Keyspace, sema, req.IncludeMaster))
+}
+
+// ValidateSchemaShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateSchemaShard(req *vtctldatapb.ValidateSchemaShardRequest, stream vtctlservicepb.Vtctld_ValidateSchemaShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateSchemaShardResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateSchemaShard(stream.Context(), req.Keyspace, req.Shard, req.ExcludeTables, req.IncludeViews))
+}
+
+// ValidateSchemaKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateSchemaKeyspace(req *vtctldatapb.ValidateSchemaKeyspaceRequest, stream vtctlservicepb.Vtctld_ValidateSchemaKeyspaceServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateSchemaKeyspaceResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateSchemaKeyspace(stream.Context(), req.Keyspace, req.ExcludeTables, req.IncludeViews))
+}
+
+// GetSchemaDrift is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetSchemaDrift(ctx context.Context, req *vtctldatapb.GetSchemaDriftRequest) (*vtctldatapb.GetSchemaDriftResponse, error) {
+	drift, err := s.wrangler(logutil.NewConsoleLogger()).SchemaDriftKeyspace(ctx, req.Keyspace, req.ExcludeTables, req.IncludeViews)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response := &vtctldatapb.GetSchemaDriftResponse{}
+	for _, td := range drift {
+		d := &vtctldatapb.TableDrift{Table: td.Table}
+		for _, v := range td.Variants {
+			d.Variants = append(d.Variants, &vtctldatapb.TableVariant{
+				Schema: v.Schema,
+				Shards: v.Shards,
+			})
+		}
+		response.Drifts = append(response.Drifts, d)
+	}
+	return response, nil
+}
+
+// ApplySchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ApplySchema(req *vtctldatapb.ApplySchemaRequest, stream vtctlservicepb.Vtctld_ApplySchemaServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ApplySchemaResponse{Event: e})
+	}))
+	executor := schemamanager.NewTabletExecutor(wr, durationOrDefault(req.WaitSlaveTimeout, wrangler.DefaultWaitSlaveTimeout))
+	if req.AllowLongUnavailability {
+		executor.AllowBigSchemaChange()
+	}
+	controller := schemamanager.NewPlainController(req.Sql, req.Keyspace)
+	if !req.DryRun {
+		return vterrors.ToGRPC(schemamanager.Run(stream.Context(), controller, executor))
+	}
+
+	report, err := schemamanager.DryRun(stream.Context(), controller, executor)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	dryRunReport := &vtctldatapb.ApplySchemaDryRunReport{
+		Keyspace:       report.Keyspace,
+		DdlStrategy:    report.DDLStrategy,
+		PreflightError: report.PreflightError,
+		Safe:           report.Safe,
+	}
+	for _, sr := range report.Statements {
+		dryRunReport.Statements = append(dryRunReport.Statements, &vtctldatapb.ApplySchemaStatementReport{
+			Sql:             sr.SQL,
+			Action:          sr.Action,
+			Tables:          sr.Tables,
+			EstimatedRows:   sr.EstimatedRows,
+			ChangesSchema:   sr.ChangesSchema,
+			BigSchemaChange: sr.BigSchemaChange,
+		})
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return stream.Send(&vtctldatapb.ApplySchemaResponse{DryRunReport: dryRunReport})
+}
+
+// ApplyDeclarativeSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ApplyDeclarativeSchema(req *vtctldatapb.ApplyDeclarativeSchemaRequest, stream vtctlservicepb.Vtctld_ApplyDeclarativeSchemaServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ApplyDeclarativeSchemaResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ApplyDeclarativeSchema(stream.Context(), req.Keyspace, req.Statements, req.AllowDrop, req.DryRun))
+}
+
+// SubmitOnlineDDL is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SubmitOnlineDDL(ctx context.Context, req *vtctldatapb.SubmitOnlineDDLRequest) (*vtctldatapb.SubmitOnlineDDLResponse, error) {
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = defaultOnlineDDLStrategy
+	}
+	uuid, err := s.wrangler(logutil.NewConsoleLogger()).SubmitOnlineDDL(ctx, req.Keyspace, req.Shard, req.Sql, strategy)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SubmitOnlineDDLResponse{Uuid: uuid}, nil
+}
+
+// GetOnlineDDLMigrations is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetOnlineDDLMigrations(ctx context.Context, req *vtctldatapb.GetOnlineDDLMigrationsRequest) (*vtctldatapb.GetOnlineDDLMigrationsResponse, error) {
+	migrations, err := s.wrangler(logutil.NewConsoleLogger()).GetOnlineDDLMigrations(ctx, req.Keyspace, req.Shard, req.Uuid)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetOnlineDDLMigrationsResponse{Migrations: migrations}, nil
+}
+
+// CancelOnlineDDL is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CancelOnlineDDL(ctx context.Context, req *vtctldatapb.CancelOnlineDDLRequest) (*vtctldatapb.CancelOnlineDDLResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).CancelOnlineDDL(ctx, req.Keyspace, req.Shard, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CancelOnlineDDLResponse{}, nil
+}
+
+// RetryOnlineDDL is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RetryOnlineDDL(ctx context.Context, req *vtctldatapb.RetryOnlineDDLRequest) (*vtctldatapb.RetryOnlineDDLResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).RetryOnlineDDL(ctx, req.Keyspace, req.Shard, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RetryOnlineDDLResponse{}, nil
+}
+
+// CopySchemaShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CopySchemaShard(req *vtctldatapb.CopySchemaShardRequest, stream vtctlservicepb.Vtctld_CopySchemaShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if req.SourceTabletAlias == nil && (req.SourceKeyspace == "" || req.SourceShard == "") {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "either source_tablet_alias, or source_keyspace and source_shard are required"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.CopySchemaShardResponse{Event: e})
+	}))
+	waitSlaveTimeout := durationOrDefault(req.WaitSlaveTimeout, wrangler.DefaultWaitSlaveTimeout)
+	if req.SourceTabletAlias != nil {
+		return vterrors.ToGRPC(wr.CopySchemaShard(stream.Context(), req.SourceTabletAlias, req.Tables, req.ExcludeTables, req.IncludeViews, req.DestKeyspace, req.DestShard, waitSlaveTimeout))
+	}
+	return vterrors.ToGRPC(wr.CopySchemaShardFromShard(stream.Context(), req.Tables, req.ExcludeTables, req.IncludeViews, req.SourceKeyspace, req.SourceShard, req.DestKeyspace, req.DestShard, waitSlaveTimeout))
+}
+
+// ValidateVersionShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateVersionShard(req *vtctldatapb.ValidateVersionShardRequest, stream vtctlservicepb.Vtctld_ValidateVersionShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateVersionShardResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateVersionShard(stream.Context(), req.Keyspace, req.Shard))
+}
+
+// ValidateVersionKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateVersionKeyspace(req *vtctldatapb.ValidateVersionKeyspaceRequest, stream vtctlservicepb.Vtctld_ValidateVersionKeyspaceServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateVersionKeyspaceResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateVersionKeyspace(stream.Context(), req.Keyspace))
+}
+
+// GetPermissions is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetPermissions(ctx context.Context, req *vtctldatapb.GetPermissionsRequest) (*vtctldatapb.GetPermissionsResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	p, err := s.wrangler(logutil.NewConsoleLogger()).GetPermissions(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetPermissionsResponse{Permissions: p}, nil
+}
+
+// ValidatePermissionsShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidatePermissionsShard(req *vtctldatapb.ValidatePermissionsShardRequest, stream vtctlservicepb.Vtctld_ValidatePermissionsShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidatePermissionsShardResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidatePermissionsShard(stream.Context(), req.Keyspace, req.Shard))
+}
+
+// ValidatePermissionsKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidatePermissionsKeyspace(req *vtctldatapb.ValidatePermissionsKeyspaceRequest, stream vtctlservicepb.Vtctld_ValidatePermissionsKeyspaceServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidatePermissionsKeyspaceResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidatePermissionsKeyspace(stream.Context(), req.Keyspace))
+}
+
+// reloadSchemaConcurrency returns the number of tablets to reload in
+// parallel for a request.
+func reloadSchemaConcurrency(concurrency int64) int {
+	if concurrency <= 0 {
+		return defaultReloadSchemaConcurrency
+	}
+	return int(concurrency)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// schemaTMC returns the same schema and permissions for all the tablets.
+type schemaTMC struct {
+	recordingTMC
+}
+
+func (tmc *schemaTMC) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	tmc.record("GetSchema %v %v %v %v", topoproto.TabletAliasString(tablet.Alias), tables, excludeTables, includeViews)
+	return &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t1",
+			Schema: "CREATE TABLE t1 (id bigint)",
+		}},
+	}, nil
+}
+
+func (tmc *schemaTMC) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
+	tmc.record("GetPermissions %v", topoproto.TabletAliasString(tablet.Alias))
+	return &tabletmanagerdatapb.Permissions{
+		UserPermissions: []*tabletmanagerdatapb.UserPermission{{Host: "%", User: "vt_app"}},
+	}, nil
+}
+
+func TestSchemaRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tmc := &schemaTMC{}
+	s := newVtctldServer(ts, tmc)
+
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+	_, err := s.InitTablet(ctx, &vtctldatapb.InitTabletRequest{
+		Tablet: &topodatapb.Tablet{
+			Alias:    alias,
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		CreateShardAndKeyspace: true,
+	})
+	require.NoError(t, err)
+
+	schema, err := s.GetSchema(ctx, &vtctldatapb.GetSchemaRequest{
+		TabletAlias:   alias,
+		ExcludeTables: []string{"/^_/"},
+	})
+	require.NoError(t, err)
+	require.Len(t, schema.Schema.TableDefinitions, 1)
+	assert.Equal(t, "CREATE TABLE t1 (id bigint)", schema.Schema.TableDefinitions[0].Schema)
+	names, err := s.GetSchema(ctx, &vtctldatapb.GetSchemaRequest{
+		TabletAlias:    alias,
+		TableNamesOnly: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*tabletmanagerdatapb.TableDefinition{{Name: "t1"}}, names.Schema.TableDefinitions)
+	assert.Empty(t, names.Schema.DatabaseSchema)
+	_, err = s.GetSchema(ctx, &vtctldatapb.GetSchemaRequest{})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_alias is required")
+
+	permissions, err := s.GetPermissions(ctx, &vtctldatapb.GetPermissionsRequest{TabletAlias: alias})
+	require.NoError(t, err)
+	require.Len(t, permissions.Permissions.UserPermissions, 1)
+	assert.Equal(t, "vt_app", permissions.Permissions.UserPermissions[0].User)
+
+	assert.Equal(t, []string{
+		"GetSchema cell1-0000000100 [] [/^_/] false",
+		"GetSchema cell1-0000000100 [] [] false",
+		"GetPermissions cell1-0000000100",
+	}, tmc.Calls())
+
+	err = s.CopySchemaShard(&vtctldatapb.CopySchemaShardRequest{
+		SourceKeyspace: "ks",
+		DestKeyspace:   "ks2",
+		DestShard:      "0",
+	}, nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = either source_tablet_alias, or source_keyspace and source_shard are required")
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package grpcvtctldserver contains the gRPC implementation of the typed
+vtctld API. Unlike grpcvtctlserver, which runs a vtctl command line and
+streams its text output, each command is a RPC with typed arguments and
+results.
+
+The typed API covers all the vtctl commands, except Help and Panic, and
+the VtGateExecute and VtTablet* commands, which call services that have
+their own typed API. These are still run through grpcvtctlserver.
+Clients can use grpcvtctlclient.NewVtctldClient to call both.
+*/
+package grpcvtctldserver
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// defaultBackupConcurrency is the same as the default of the
+	// Backup vtctl command.
+	defaultBackupConcurrency = 4
+
+	// defaultProgressInterval is the time between two copy progress
+	// updates of Reshard, if the request doesn't set one.
+	defaultProgressInterval = 5 * time.Second
+)
+
+// VtctldServer is our RPC server
+type VtctldServer struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+// NewVtctldServer returns a new Vtctld Server for the topo server.
+func NewVtctldServer(ts *topo.Server) *VtctldServer {
+	return newVtctldServer(ts, tmclient.NewTabletManagerClient())
+}
+
+func newVtctldServer(ts *topo.Server, tmc tmclient.TabletManagerClient) *VtctldServer {
+	return &VtctldServer{
+		ts:  ts,
+		tmc: tmc,
+	}
+}
+
+// wrangler returns a wrangler that logs to logger.
+func (s *VtctldServer) wrangler(logger logutil.Logger) *wrangler.Wrangler {
+	return wrangler.New(logger, s.ts, s.tmc)
+}
+
+// streamLogger returns a logger that sends its events with send, and
+// also logs them to the console. The wrangler may log from several go
+// routines, but stream.Send() is not thread safe in gRPC: send is
+// called under mu, which the RPC must also hold for its other sends.
+func streamLogger(mu *sync.Mutex, send func(e *logutilpb.Event) error) logutil.Logger {
+	logstream := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the command.
+		send(e)
+	})
+	return logutil.NewTeeLogger(logstream, logutil.NewConsoleLogger())
+}
+
+// getTablet reads the tablet record of the alias of a request.
+func (s *VtctldServer) getTablet(ctx context.Context, alias *topodatapb.TabletAlias) (*topo.TabletInfo, error) {
+	if alias == nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required")
+	}
+	return s.ts.GetTablet(ctx, alias)
+}
+
+// GetKeyspaces is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKeyspacesRequest) (*vtctldatapb.GetKeyspacesResponse, error) {
+	names, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response := &vtctldatapb.GetKeyspacesResponse{}
+	for _, name := range names {
+		ki, err := s.ts.GetKeyspace(ctx, name)
+		if err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+		response.Keyspaces = append(response.Keyspaces, &vtctldatapb.Keyspace{
+			Name:     name,
+			Keyspace: ki.Keyspace,
+		})
+	}
+	return response, nil
+}
+
+// GetKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetKeyspace(ctx context.Context, req *vtctldatapb.GetKeyspaceRequest) (*vtctldatapb.GetKeyspaceResponse, error) {
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetKeyspaceResponse{
+		Keyspace: &vtctldatapb.Keyspace{
+			Name:     req.Keyspace,
+			Keyspace: ki.Keyspace,
+		},
+	}, nil
+}
+
+// FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldatapb.FindAllShardsInKeyspaceRequest) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
+	shards, err := s.ts.FindAllShardsInKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := &vtctldatapb.FindAllShardsInKeyspaceResponse{}
+	for _, name := range names {
+		response.Shards = append(response.Shards, &vtctldatapb.Shard{
+			Keyspace: req.Keyspace,
+			Name:     name,
+			Shard:    shards[name].Shard,
+		})
+	}
+	return response, nil
+}
+
+// GetShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetShard(ctx context.Context, req *vtctldatapb.GetShardRequest) (*vtctldatapb.GetShardResponse, error) {
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.ShardName)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetShardResponse{
+		Shard: &vtctldatapb.Shard{
+			Keyspace: req.Keyspace,
+			Name:     req.ShardName,
+			Shard:    si.Shard,
+		},
+	}, nil
+}
+
+// GetTablet is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetTablet(ctx context.Context, req *vtctldatapb.GetTabletRequest) (*vtctldatapb.GetTabletResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetTabletResponse{
+		Tablet: ti.Tablet,
+	}, nil
+}
+
+// GetTablets is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTabletsRequest) (*vtctldatapb.GetTabletsResponse, error) {
+	tabletMap, err := s.getTabletMap(ctx, req)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	aliases := make([]string, 0, len(tabletMap))
+	for alias := range tabletMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	response := &vtctldatapb.GetTabletsResponse{}
+	for _, alias := range aliases {
+		response.Tablets = append(response.Tablets, tabletMap[alias].Tablet)
+	}
+	return response, nil
+}
+
+// getTabletMap returns the tablets a GetTabletsRequest selects.
+func (s *VtctldServer) getTabletMap(ctx context.Context, req *vtctldatapb.GetTabletsRequest) (map[string]*topo.TabletInfo, error) {
+	switch {
+	case req.Shard != "" && req.Keyspace == "":
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "shard requires keyspace")
+	case req.Shard != "":
+		return s.ts.GetTabletMapForShardByCell(ctx, req.Keyspace, req.Shard, req.Cells)
+	case req.Keyspace != "":
+		shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]*topo.TabletInfo)
+		for _, shard := range shards {
+			tabletMap, err := s.ts.GetTabletMapForShardByCell(ctx, req.Keyspace, shard, req.Cells)
+			if err != nil {
+				return nil, err
+			}
+			for alias, ti := range tabletMap {
+				result[alias] = ti
+			}
+		}
+		return result, nil
+	}
+
+	cells := req.Cells
+	if len(cells) == 0 {
+		var err error
+		cells, err = s.ts.GetKnownCells(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var aliases []*topodatapb.TabletAlias
+	for _, cell := range cells {
+		cellAliases, err := s.ts.GetTabletsByCell(ctx, cell)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, cellAliases...)
+	}
+	return s.ts.GetTabletMap(ctx, aliases)
+}
+
+// Backup is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Backup(req *vtctldatapb.BackupRequest, stream vtctlservicepb.Vtctld_BackupServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	ctx := stream.Context()
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBackupConcurrency
+	}
+
+	logStream, err := s.tmc.Backup(ctx, ti.Tablet, concurrency, req.AllowMaster, req.Incremental)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.BackupResponse{Event: e})
+	})
+}
+
+// Reshard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Reshard(req *vtctldatapb.ReshardRequest, stream vtctlservicepb.Vtctld_ReshardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	ctx := stream.Context()
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ReshardResponse{Event: e})
+	}))
+
+	if err := wr.Reshard(ctx, req.Keyspace, req.Workflow, req.SourceShards, req.TargetShards, req.SkipSchemaCopy, req.Compression); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	if !req.WaitForCopy {
+		return nil
+	}
+
+	interval := logutil.ProtoToDuration(req.ProgressInterval)
+	if interval <= 0 {
+		interval = defaultProgressInterval
+	}
+	return vterrors.ToGRPC(wr.WaitForWorkflowCopy(ctx, req.Keyspace, req.Workflow, interval, func(progress map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(&vtctldatapb.ReshardResponse{CopyProgress: shardCopyProgress(progress)})
+	}))
+}
+
+// shardCopyProgress returns the copy progress of the shards, sorted by
+// shard name.
+func shardCopyProgress(progress map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress) []*vtctldatapb.ShardCopyProgress {
+	shards := make([]string, 0, len(progress))
+	for shard := range progress {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+
+	var result []*vtctldatapb.ShardCopyProgress
+	for _, shard := range shards {
+		result = append(result, &vtctldatapb.ShardCopyProgress{
+			Shard:   shard,
+			Streams: progress[shard],
+		})
+	}
+	return result
+}
+
+// StartServer registers the VtctldServer for RPCs
+func StartServer(s *grpc.Server, ts *topo.Server) {
+	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
+}
+
+// make sure VtctldServer implements the interface.
+var _ vtctlservicepb.VtctldServer = (*VtctldServer)(nil)
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+
+	// needed so that grpc client is registered
+	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+)
+
+func TestVtctldServer(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "id"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	for _, shard := range []string{"80-", "-80"} {
+		if err := ts.CreateShard(ctx, "ks", shard); err != nil {
+			t.Fatalf("CreateShard failed: %v", err)
+		}
+	}
+	tablets := []*topodatapb.Tablet{{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "-80",
+		Type:     topodatapb.TabletType_REPLICA,
+	}, {
+		Alias:    &topodatapb.TabletAlias{Cell: "cell2", Uid: 200},
+		Keyspace: "ks",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_REPLICA,
+	}}
+	for _, tablet := range tablets {
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+	}
+
+	// Listen on a random port, and connect to it.
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	server := grpc.NewServer()
+	StartServer(server, ts)
+	go server.Serve(listener)
+	defer server.Stop()
+	cc, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Cannot dial: %v", err)
+	}
+	defer cc.Close()
+	client := vtctlservicepb.NewVtctldClient(cc)
+
+	keyspaces, err := client.GetKeyspaces(ctx, &vtctldatapb.GetKeyspacesRequest{})
+	if err != nil {
+		t.Fatalf("GetKeyspaces failed: %v", err)
+	}
+	if len(keyspaces.Keyspaces) != 1 || keyspaces.Keyspaces[0].Name != "ks" || keyspaces.Keyspaces[0].Keyspace.ShardingColumnName != "id" {
+		t.Errorf("GetKeyspaces returned %v", keyspaces)
+	}
+
+	shards, err := client.FindAllShardsInKeyspace(ctx, &vtctldatapb.FindAllShardsInKeyspaceRequest{Keyspace: "ks"})
+	if err != nil {
+		t.Fatalf("FindAllShardsInKeyspace failed: %v", err)
+	}
+	if len(shards.Shards) != 2 || shards.Shards[0].Name != "-80" || shards.Shards[1].Name != "80-" {
+		t.Errorf("FindAllShardsInKeyspace returned %v", shards)
+	}
+
+	tablet, err := client.GetTablet(ctx, &vtctldatapb.GetTabletRequest{TabletAlias: tablets[1].Alias})
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if tablet.Tablet.Shard != "80-" {
+		t.Errorf("GetTablet returned %v", tablet)
+	}
+
+	testcases := []struct {
+		req  *vtctldatapb.GetTabletsRequest
+		want []*topodatapb.Tablet
+	}{{
+		req:  &vtctldatapb.GetTabletsRequest{},
+		want: tablets,
+	}, {
+		req:  &vtctldatapb.GetTabletsRequest{Cells: []string{"cell2"}},
+		want: tablets[1:],
+	}, {
+		req:  &vtctldatapb.GetTabletsRequest{Keyspace: "ks"},
+		want: tablets,
+	}, {
+		req:  &vtctldatapb.GetTabletsRequest{Keyspace: "ks", Shard: "-80"},
+		want: tablets[:1],
+	}}
+	for _, tcase := range testcases {
+		got, err := client.GetTablets(ctx, tcase.req)
+		if err != nil {
+			t.Errorf("GetTablets(%v) failed: %v", tcase.req, err)
+			continue
+		}
+		if len(got.Tablets) != len(tcase.want) {
+			t.Errorf("GetTablets(%v) returned %v, want %v", tcase.req, got.Tablets, tcase.want)
+			continue
+		}
+		for i := range tcase.want {
+			if !proto.Equal(got.Tablets[i].Alias, tcase.want[i].Alias) {
+				t.Errorf("GetTablets(%v) returned %v, want %v", tcase.req, got.Tablets, tcase.want)
+				break
+			}
+		}
+	}
+
+	_, err = client.GetTablets(ctx, &vtctldatapb.GetTabletsRequest{Shard: "-80"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetTablets without keyspace returned %v, want InvalidArgument", err)
+	}
+}
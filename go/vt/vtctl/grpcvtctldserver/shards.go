@@ -0,0 +1,242 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Shards commands.
+
+// CreateShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CreateShard(ctx context.Context, req *vtctldatapb.CreateShardRequest) (*vtctldatapb.CreateShardResponse, error) {
+	if req.IncludeParent {
+		if err := s.ts.CreateKeyspace(ctx, req.Keyspace, &topodatapb.Keyspace{}); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	if err := s.ts.CreateShard(ctx, req.Keyspace, req.ShardName); err != nil && !(req.Force && topo.IsErrType(err, topo.NodeExists)) {
+		return nil, vterrors.ToGRPC(err)
+	}
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.ShardName)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CreateShardResponse{
+		Shard: &vtctldatapb.Shard{
+			Keyspace: req.Keyspace,
+			Name:     req.ShardName,
+			Shard:    si.Shard,
+		},
+	}, nil
+}
+
+// ValidateShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ValidateShard(req *vtctldatapb.ValidateShardRequest, stream vtctlservicepb.Vtctld_ValidateShardServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateShardResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ValidateShard(stream.Context(), req.Keyspace, req.Shard, req.PingTablets))
+}
+
+// ShardReplicationPositions is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctldatapb.ShardReplicationPositionsRequest) (*vtctldatapb.ShardReplicationPositionsResponse, error) {
+	tablets, stats, err := s.wrangler(logutil.NewConsoleLogger()).ShardReplicationStatuses(ctx, req.Keyspace, req.Shard)
+	// Like the vtctl command, only fail if no tablet could be read:
+	// the status of the tablets that couldn't be reached is not set.
+	if tablets == nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+
+	response := &vtctldatapb.ShardReplicationPositionsResponse{}
+	for i, ti := range tablets {
+		response.Positions = append(response.Positions, &vtctldatapb.ShardReplicationPosition{
+			Tablet: ti.Tablet,
+			Status: stats[i],
+		})
+	}
+	sort.Slice(response.Positions, func(i, j int) bool {
+		return topoproto.TabletAliasString(response.Positions[i].Tablet.Alias) < topoproto.TabletAliasString(response.Positions[j].Tablet.Alias)
+	})
+	return response, nil
+}
+
+// SetShardIsMasterServing is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetShardIsMasterServing(ctx context.Context, req *vtctldatapb.SetShardIsMasterServingRequest) (*vtctldatapb.SetShardIsMasterServingResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).SetShardIsMasterServing(ctx, req.Keyspace, req.Shard, req.IsMasterServing); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetShardIsMasterServingResponse{Shard: si.Shard}, nil
+}
+
+// SetShardTabletControl is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetShardTabletControl(ctx context.Context, req *vtctldatapb.SetShardTabletControlRequest) (*vtctldatapb.SetShardTabletControlResponse, error) {
+	if err := checkServingTabletType(req.TabletType); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	wr := s.wrangler(logutil.NewConsoleLogger())
+	if err := wr.SetShardTabletControl(ctx, req.Keyspace, req.Shard, req.TabletType, req.Cells, req.Remove, req.BlacklistedTables); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if !req.Remove && len(req.BlacklistedTables) == 0 {
+		if err := wr.UpdateDisableQueryService(ctx, req.Keyspace, req.Shard, req.TabletType, req.Cells, req.DisableQueryService); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetShardTabletControlResponse{Shard: si.Shard}, nil
+}
+
+// UpdateSrvKeyspacePartition is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) UpdateSrvKeyspacePartition(ctx context.Context, req *vtctldatapb.UpdateSrvKeyspacePartitionRequest) (*vtctldatapb.UpdateSrvKeyspacePartitionResponse, error) {
+	if err := checkServingTabletType(req.TabletType); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).UpdateSrvKeyspacePartitions(ctx, req.Keyspace, req.Shard, req.TabletType, req.Cells, req.Remove); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.UpdateSrvKeyspacePartitionResponse{}, nil
+}
+
+// SourceShardDelete is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SourceShardDelete(ctx context.Context, req *vtctldatapb.SourceShardDeleteRequest) (*vtctldatapb.SourceShardDeleteResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).SourceShardDelete(ctx, req.Keyspace, req.Shard, req.Uid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SourceShardDeleteResponse{Shard: si.Shard}, nil
+}
+
+// SourceShardAdd is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SourceShardAdd(ctx context.Context, req *vtctldatapb.SourceShardAddRequest) (*vtctldatapb.SourceShardAddResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).SourceShardAdd(ctx, req.Keyspace, req.Shard, req.Uid, req.SourceKeyspace, req.SourceShard, req.KeyRange, req.Tables); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SourceShardAddResponse{Shard: si.Shard}, nil
+}
+
+// ShardReplicationAdd is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ShardReplicationAdd(ctx context.Context, req *vtctldatapb.ShardReplicationAddRequest) (*vtctldatapb.ShardReplicationAddResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	if err := topo.UpdateShardReplicationRecord(ctx, s.ts, req.Keyspace, req.Shard, req.TabletAlias); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ShardReplicationAddResponse{}, nil
+}
+
+// ShardReplicationRemove is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ShardReplicationRemove(ctx context.Context, req *vtctldatapb.ShardReplicationRemoveRequest) (*vtctldatapb.ShardReplicationRemoveResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	if err := topo.RemoveShardReplicationRecord(ctx, s.ts, req.TabletAlias.Cell, req.Keyspace, req.Shard, req.TabletAlias); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ShardReplicationRemoveResponse{}, nil
+}
+
+// ShardReplicationFix is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ShardReplicationFix(ctx context.Context, req *vtctldatapb.ShardReplicationFixRequest) (*vtctldatapb.ShardReplicationFixResponse, error) {
+	if err := topo.FixShardReplication(ctx, s.ts, logutil.NewConsoleLogger(), req.Cell, req.Keyspace, req.Shard); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ShardReplicationFixResponse{}, nil
+}
+
+// WaitForFilteredReplication is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WaitForFilteredReplication(req *vtctldatapb.WaitForFilteredReplicationRequest, stream vtctlservicepb.Vtctld_WaitForFilteredReplicationServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	maxDelay := wrangler.DefaultWaitForFilteredReplicationMaxDelay
+	if req.MaxDelay != nil {
+		maxDelay = logutil.ProtoToDuration(req.MaxDelay)
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.WaitForFilteredReplicationResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.WaitForFilteredReplication(stream.Context(), req.Keyspace, req.Shard, maxDelay))
+}
+
+// RemoveShardCell is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RemoveShardCell(ctx context.Context, req *vtctldatapb.RemoveShardCellRequest) (*vtctldatapb.RemoveShardCellResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).RemoveShardCell(ctx, req.Keyspace, req.Shard, req.Cell, req.Force, req.Recursive); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RemoveShardCellResponse{}, nil
+}
+
+// DeleteShards is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteShards(ctx context.Context, req *vtctldatapb.DeleteShardsRequest) (*vtctldatapb.DeleteShardsResponse, error) {
+	wr := s.wrangler(logutil.NewConsoleLogger())
+	for _, shard := range req.Shards {
+		err := wr.DeleteShard(ctx, shard.Keyspace, shard.Name, req.Recursive, req.EvenIfServing)
+		switch {
+		case err == nil:
+			// keep going
+		case topo.IsErrType(err, topo.NoNode):
+			wr.Logger().Infof("Shard %v/%v doesn't exist, skipping it", shard.Keyspace, shard.Name)
+		default:
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	return &vtctldatapb.DeleteShardsResponse{}, nil
+}
+
+// checkServingTabletType returns an error if the tablet type of a
+// request is not in the serving graph.
+func checkServingTabletType(tabletType topodatapb.TabletType) error {
+	if !topo.IsInServingGraph(tabletType) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_type has to be in the serving graph, not %v", tabletType)
+	}
+	return nil
+}
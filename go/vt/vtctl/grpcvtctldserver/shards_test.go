@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// fakeStream is the server side of a streaming RPC, for the tests
+// which call the server directly. The Send methods of the RPCs record
+// the events of the responses.
+type fakeStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events []*logutilpb.Event
+}
+
+func (stream *fakeStream) Context() context.Context {
+	return stream.ctx
+}
+
+type validateShardStream struct {
+	fakeStream
+}
+
+func (stream *validateShardStream) Send(response *vtctldatapb.ValidateShardResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+func TestShardRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+
+	created, err := s.CreateShard(ctx, &vtctldatapb.CreateShardRequest{
+		Keyspace:      "ks",
+		ShardName:     "-80",
+		IncludeParent: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "-80", created.Shard.Name)
+	assert.True(t, created.Shard.Shard.IsMasterServing)
+	_, err = s.CreateShard(ctx, &vtctldatapb.CreateShardRequest{
+		Keyspace:  "ks",
+		ShardName: "-80",
+	})
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = node already exists: Shard")
+	_, err = s.CreateShard(ctx, &vtctldatapb.CreateShardRequest{
+		Keyspace:  "ks",
+		ShardName: "-80",
+		Force:     true,
+	})
+	require.NoError(t, err)
+
+	serving, err := s.SetShardIsMasterServing(ctx, &vtctldatapb.SetShardIsMasterServingRequest{
+		Keyspace: "ks",
+		Shard:    "-80",
+	})
+	require.NoError(t, err)
+	assert.False(t, serving.Shard.IsMasterServing)
+
+	controlled, err := s.SetShardTabletControl(ctx, &vtctldatapb.SetShardTabletControlRequest{
+		Keyspace:          "ks",
+		Shard:             "-80",
+		TabletType:        topodatapb.TabletType_RDONLY,
+		BlacklistedTables: []string{"t1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, controlled.Shard.TabletControls, 1)
+	assert.Equal(t, []string{"t1"}, controlled.Shard.TabletControls[0].BlacklistedTables)
+	_, err = s.SetShardTabletControl(ctx, &vtctldatapb.SetShardTabletControlRequest{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_BACKUP,
+	})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_type has to be in the serving graph, not BACKUP")
+
+	added, err := s.SourceShardAdd(ctx, &vtctldatapb.SourceShardAddRequest{
+		Keyspace:       "ks",
+		Shard:          "-80",
+		Uid:            1,
+		SourceKeyspace: "source",
+		SourceShard:    "0",
+	})
+	require.NoError(t, err)
+	require.Len(t, added.Shard.SourceShards, 1)
+	assert.Equal(t, "source", added.Shard.SourceShards[0].Keyspace)
+	deleted, err := s.SourceShardDelete(ctx, &vtctldatapb.SourceShardDeleteRequest{
+		Keyspace: "ks",
+		Shard:    "-80",
+		Uid:      1,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, deleted.Shard.SourceShards)
+
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+	_, err = s.ShardReplicationAdd(ctx, &vtctldatapb.ShardReplicationAddRequest{
+		Keyspace:    "ks",
+		Shard:       "-80",
+		TabletAlias: alias,
+	})
+	require.NoError(t, err)
+	sri, err := ts.GetShardReplication(ctx, "cell1", "ks", "-80")
+	require.NoError(t, err)
+	assert.Len(t, sri.Nodes, 1)
+	_, err = s.ShardReplicationRemove(ctx, &vtctldatapb.ShardReplicationRemoveRequest{
+		Keyspace:    "ks",
+		Shard:       "-80",
+		TabletAlias: alias,
+	})
+	require.NoError(t, err)
+	sri, err = ts.GetShardReplication(ctx, "cell1", "ks", "-80")
+	require.NoError(t, err)
+	assert.Empty(t, sri.Nodes)
+
+	stream := &validateShardStream{fakeStream{ctx: ctx}}
+	err = s.ValidateShard(&vtctldatapb.ValidateShardRequest{
+		Keyspace: "ks",
+		Shard:    "-80",
+	}, stream)
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = some validation errors - see log")
+	require.NotEmpty(t, stream.events)
+	assert.Equal(t, "no master for shard ks/-80", stream.events[0].Value)
+
+	_, err = s.DeleteShards(ctx, &vtctldatapb.DeleteShardsRequest{
+		Shards: []*vtctldatapb.Shard{
+			{Keyspace: "ks", Name: "-80"},
+			{Keyspace: "ks", Name: "80-"},
+		},
+		EvenIfServing: true,
+	})
+	require.NoError(t, err)
+	_, err = ts.GetShard(ctx, "ks", "-80")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Tablets commands.
+
+// defaultMaxRows is the same as the default of the ExecuteFetchAs*
+// vtctl commands.
+const defaultMaxRows = 10000
+
+// InitTablet is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) InitTablet(ctx context.Context, req *vtctldatapb.InitTabletRequest) (*vtctldatapb.InitTabletResponse, error) {
+	if req.Tablet == nil || req.Tablet.Alias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet with an alias is required"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).InitTablet(ctx, req.Tablet, req.AllowMasterOverride, req.CreateShardAndKeyspace, req.AllowUpdate); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.InitTabletResponse{}, nil
+}
+
+// UpdateTabletAddrs is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) UpdateTabletAddrs(ctx context.Context, req *vtctldatapb.UpdateTabletAddrsRequest) (*vtctldatapb.UpdateTabletAddrsResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	tablet, err := s.ts.UpdateTabletFields(ctx, req.TabletAlias, func(tablet *topodatapb.Tablet) error {
+		if req.Hostname != "" {
+			tablet.Hostname = req.Hostname
+		}
+		if req.MysqlHostname != "" {
+			tablet.MysqlHostname = req.MysqlHostname
+		}
+		if req.VtPort != 0 || req.GrpcPort != 0 || req.MysqlPort != 0 {
+			if tablet.PortMap == nil {
+				tablet.PortMap = make(map[string]int32)
+			}
+			if req.VtPort != 0 {
+				tablet.PortMap["vt"] = req.VtPort
+			}
+			if req.GrpcPort != 0 {
+				tablet.PortMap["grpc"] = req.GrpcPort
+			}
+			if req.MysqlPort != 0 {
+				topoproto.SetMysqlPort(tablet, req.MysqlPort)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.UpdateTabletAddrsResponse{Tablet: tablet}, nil
+}
+
+// DeleteTablets is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.DeleteTabletsRequest) (*vtctldatapb.DeleteTabletsResponse, error) {
+	if len(req.TabletAliases) == 0 {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_aliases is required"))
+	}
+	wr := s.wrangler(logutil.NewConsoleLogger())
+	for _, alias := range req.TabletAliases {
+		if err := wr.DeleteTablet(ctx, alias, req.AllowMaster); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	return &vtctldatapb.DeleteTabletsResponse{}, nil
+}
+
+// SetReadOnly is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetReadOnly(ctx context.Context, req *vtctldatapb.SetReadOnlyRequest) (*vtctldatapb.SetReadOnlyResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.SetReadOnly(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetReadOnlyResponse{}, nil
+}
+
+// SetReadWrite is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SetReadWrite(ctx context.Context, req *vtctldatapb.SetReadWriteRequest) (*vtctldatapb.SetReadWriteResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.SetReadWrite(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SetReadWriteResponse{}, nil
+}
+
+// StartSlave is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) StartSlave(ctx context.Context, req *vtctldatapb.StartSlaveRequest) (*vtctldatapb.StartSlaveResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.StartSlave(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.StartSlaveResponse{}, nil
+}
+
+// StopSlave is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) StopSlave(ctx context.Context, req *vtctldatapb.StopSlaveRequest) (*vtctldatapb.StopSlaveResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.StopSlave(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.StopSlaveResponse{}, nil
+}
+
+// ChangeSlaveType is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ChangeSlaveType(ctx context.Context, req *vtctldatapb.ChangeSlaveTypeRequest) (*vtctldatapb.ChangeSlaveTypeResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response := &vtctldatapb.ChangeSlaveTypeResponse{
+		BeforeTablet: ti.Tablet,
+		WasDryRun:    req.DryRun,
+	}
+	if req.DryRun {
+		if !topo.IsTrivialTypeChange(ti.Type, req.DbType) {
+			return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "invalid type transition %v: %v -> %v", topoproto.TabletAliasString(req.TabletAlias), ti.Type, req.DbType))
+		}
+		response.AfterTablet = proto.Clone(ti.Tablet).(*topodatapb.Tablet)
+		response.AfterTablet.Type = req.DbType
+		return response, nil
+	}
+
+	if err := s.wrangler(logutil.NewConsoleLogger()).ChangeSlaveType(ctx, req.TabletAlias, req.DbType); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	after, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response.AfterTablet = after.Tablet
+	return response, nil
+}
+
+// Ping is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Ping(ctx context.Context, req *vtctldatapb.PingRequest) (*vtctldatapb.PingResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.Ping(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.PingResponse{}, nil
+}
+
+// RefreshState is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RefreshState(ctx context.Context, req *vtctldatapb.RefreshStateRequest) (*vtctldatapb.RefreshStateResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.RefreshState(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RefreshStateResponse{}, nil
+}
+
+// RefreshStateByShard is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RefreshStateByShard(ctx context.Context, req *vtctldatapb.RefreshStateByShardRequest) (*vtctldatapb.RefreshStateByShardResponse, error) {
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).RefreshTabletsByShard(ctx, si, nil /* tabletTypes */, req.Cells); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RefreshStateByShardResponse{}, nil
+}
+
+// RunHealthCheck is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RunHealthCheck(ctx context.Context, req *vtctldatapb.RunHealthCheckRequest) (*vtctldatapb.RunHealthCheckResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.RunHealthCheck(ctx, ti.Tablet); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RunHealthCheckResponse{}, nil
+}
+
+// IgnoreHealthError is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) IgnoreHealthError(ctx context.Context, req *vtctldatapb.IgnoreHealthErrorRequest) (*vtctldatapb.IgnoreHealthErrorResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.IgnoreHealthError(ctx, ti.Tablet, req.Pattern); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.IgnoreHealthErrorResponse{}, nil
+}
+
+// ResizeTxPool is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ResizeTxPool(ctx context.Context, req *vtctldatapb.ResizeTxPoolRequest) (*vtctldatapb.ResizeTxPoolResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.ResizeTxPool(ctx, ti.Tablet, req.Size); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ResizeTxPoolResponse{}, nil
+}
+
+// Sleep is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Sleep(ctx context.Context, req *vtctldatapb.SleepRequest) (*vtctldatapb.SleepResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.tmc.Sleep(ctx, ti.Tablet, logutil.ProtoToDuration(req.Duration)); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SleepResponse{}, nil
+}
+
+// ExecuteHook is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ExecuteHook(ctx context.Context, req *vtctldatapb.ExecuteHookRequest) (*vtctldatapb.ExecuteHookResponse, error) {
+	if req.TabletHookRequest == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_hook_request is required"))
+	}
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_alias is required"))
+	}
+	hr, err := s.wrangler(logutil.NewConsoleLogger()).ExecuteHook(ctx, req.TabletAlias, &hook.Hook{
+		Name:       req.TabletHookRequest.Name,
+		Parameters: req.TabletHookRequest.Parameters,
+		ExtraEnv:   req.TabletHookRequest.ExtraEnv,
+	})
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ExecuteHookResponse{
+		HookResult: &tabletmanagerdatapb.ExecuteHookResponse{
+			ExitStatus: int64(hr.ExitStatus),
+			Stdout:     hr.Stdout,
+			Stderr:     hr.Stderr,
+		},
+	}, nil
+}
+
+// ExecuteFetchAsApp is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ExecuteFetchAsApp(ctx context.Context, req *vtctldatapb.ExecuteFetchAsAppRequest) (*vtctldatapb.ExecuteFetchAsAppResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	qr, err := s.tmc.ExecuteFetchAsApp(ctx, ti.Tablet, req.UsePool, []byte(req.Query), maxRows(req.MaxRows))
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ExecuteFetchAsAppResponse{Result: qr}, nil
+}
+
+// ExecuteFetchAsDba is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ExecuteFetchAsDba(ctx context.Context, req *vtctldatapb.ExecuteFetchAsDbaRequest) (*vtctldatapb.ExecuteFetchAsDbaResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	qr, err := s.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(req.Query), maxRows(req.MaxRows), req.DisableBinlogs, req.ReloadSchema)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ExecuteFetchAsDbaResponse{Result: qr}, nil
+}
+
+// VReplicationExec is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) VReplicationExec(ctx context.Context, req *vtctldatapb.VReplicationExecRequest) (*vtctldatapb.VReplicationExecResponse, error) {
+	ti, err := s.getTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	qr, err := s.tmc.VReplicationExec(ctx, ti.Tablet, req.Query)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.VReplicationExecResponse{Result: qr}, nil
+}
+
+func maxRows(requested int64) int {
+	if requested == 0 {
+		return defaultMaxRows
+	}
+	return int(requested)
+}
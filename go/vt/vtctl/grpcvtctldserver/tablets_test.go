@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+// recordingTMC is a tablet manager client which records the calls it
+// receives. The calls it doesn't implement panic.
+type recordingTMC struct {
+	tmclient.TabletManagerClient
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (tmc *recordingTMC) record(format string, args ...interface{}) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.calls = append(tmc.calls, fmt.Sprintf(format, args...))
+}
+
+func (tmc *recordingTMC) Calls() []string {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	calls := tmc.calls
+	tmc.calls = nil
+	return calls
+}
+
+func (tmc *recordingTMC) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
+	tmc.record("SetReadOnly %v", topoproto.TabletAliasString(tablet.Alias))
+	return nil
+}
+
+func (tmc *recordingTMC) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+	tmc.record("ChangeType %v %v", topoproto.TabletAliasString(tablet.Alias), dbType)
+	return nil
+}
+
+func (tmc *recordingTMC) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
+	tmc.record("Sleep %v %v", topoproto.TabletAliasString(tablet.Alias), duration)
+	return nil
+}
+
+func (tmc *recordingTMC) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error) {
+	tmc.record("ExecuteHook %v %v %v", topoproto.TabletAliasString(tablet.Alias), hk.Name, hk.Parameters)
+	return &hook.HookResult{ExitStatus: hook.HOOK_SUCCESS, Stdout: "ok"}, nil
+}
+
+func (tmc *recordingTMC) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.record("ExecuteFetchAsDba %v %s %v", topoproto.TabletAliasString(tablet.Alias), query, maxRows)
+	return &querypb.QueryResult{RowsAffected: 1}, nil
+}
+
+func TestTabletRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tmc := &recordingTMC{}
+	s := newVtctldServer(ts, tmc)
+
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+	_, err := s.InitTablet(ctx, &vtctldatapb.InitTabletRequest{
+		Tablet: &topodatapb.Tablet{
+			Alias:    alias,
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		CreateShardAndKeyspace: true,
+	})
+	require.NoError(t, err)
+
+	updated, err := s.UpdateTabletAddrs(ctx, &vtctldatapb.UpdateTabletAddrsRequest{
+		TabletAlias: alias,
+		Hostname:    "host1",
+		VtPort:      15000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "host1", updated.Tablet.Hostname)
+	assert.Equal(t, int32(15000), updated.Tablet.PortMap["vt"])
+
+	_, err = s.SetReadOnly(ctx, &vtctldatapb.SetReadOnlyRequest{TabletAlias: alias})
+	require.NoError(t, err)
+	_, err = s.SetReadOnly(ctx, &vtctldatapb.SetReadOnlyRequest{})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_alias is required")
+
+	changed, err := s.ChangeSlaveType(ctx, &vtctldatapb.ChangeSlaveTypeRequest{
+		TabletAlias: alias,
+		DbType:      topodatapb.TabletType_RDONLY,
+		DryRun:      true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.TabletType_REPLICA, changed.BeforeTablet.Type)
+	assert.Equal(t, topodatapb.TabletType_RDONLY, changed.AfterTablet.Type)
+	_, err = s.ChangeSlaveType(ctx, &vtctldatapb.ChangeSlaveTypeRequest{
+		TabletAlias: alias,
+		DbType:      topodatapb.TabletType_MASTER,
+		DryRun:      true,
+	})
+	assert.Error(t, err)
+	_, err = s.ChangeSlaveType(ctx, &vtctldatapb.ChangeSlaveTypeRequest{
+		TabletAlias: alias,
+		DbType:      topodatapb.TabletType_RDONLY,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Sleep(ctx, &vtctldatapb.SleepRequest{
+		TabletAlias: alias,
+		Duration:    &vttime.Duration{Seconds: 2},
+	})
+	require.NoError(t, err)
+
+	hookResponse, err := s.ExecuteHook(ctx, &vtctldatapb.ExecuteHookRequest{
+		TabletAlias: alias,
+		TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+			Name:       "test.sh",
+			Parameters: []string{"--flag"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", hookResponse.HookResult.Stdout)
+
+	fetchResponse, err := s.ExecuteFetchAsDba(ctx, &vtctldatapb.ExecuteFetchAsDbaRequest{
+		TabletAlias: alias,
+		Query:       "select 1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), fetchResponse.Result.RowsAffected)
+
+	assert.Equal(t, []string{
+		"SetReadOnly cell1-0000000100",
+		"ChangeType cell1-0000000100 RDONLY",
+		"Sleep cell1-0000000100 2s",
+		"ExecuteHook cell1-0000000100 test.sh [--flag]",
+		"ExecuteFetchAsDba cell1-0000000100 select 1 10000",
+	}, tmc.Calls())
+
+	_, err = s.DeleteTablets(ctx, &vtctldatapb.DeleteTabletsRequest{
+		TabletAliases: []*topodatapb.TabletAlias{alias},
+	})
+	require.NoError(t, err)
+	_, err = s.GetTablet(ctx, &vtctldatapb.GetTabletRequest{TabletAlias: alias})
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/throttler/throttlerclient"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the resharding throttler commands. They
+// are proxied to the throttler service of a vtworker or vttablet.
+
+// throttlerTimeout is the same as the timeout of the throttler vtctl
+// commands.
+const throttlerTimeout = 15 * time.Second
+
+// ThrottlerMaxRates is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ThrottlerMaxRates(ctx context.Context, req *vtctldatapb.ThrottlerMaxRatesRequest) (*vtctldatapb.ThrottlerMaxRatesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, throttlerTimeout)
+	defer cancel()
+	client, err := newThrottlerClient(req.Server)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer client.Close()
+
+	rates, err := client.MaxRates(ctx)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "failed to get the throttler rate from server '%v'", req.Server))
+	}
+	return &vtctldatapb.ThrottlerMaxRatesResponse{Rates: rates}, nil
+}
+
+// ThrottlerSetMaxRate is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ThrottlerSetMaxRate(ctx context.Context, req *vtctldatapb.ThrottlerSetMaxRateRequest) (*vtctldatapb.ThrottlerSetMaxRateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, throttlerTimeout)
+	defer cancel()
+	client, err := newThrottlerClient(req.Server)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer client.Close()
+
+	names, err := client.SetMaxRate(ctx, req.Rate)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "failed to set the throttler rate on server '%v'", req.Server))
+	}
+	return &vtctldatapb.ThrottlerSetMaxRateResponse{Names: names}, nil
+}
+
+// GetThrottlerConfiguration is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetThrottlerConfiguration(ctx context.Context, req *vtctldatapb.GetThrottlerConfigurationRequest) (*vtctldatapb.GetThrottlerConfigurationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, throttlerTimeout)
+	defer cancel()
+	client, err := newThrottlerClient(req.Server)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer client.Close()
+
+	configurations, err := client.GetConfiguration(ctx, req.ThrottlerName)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "failed to get the throttler configuration from server '%v'", req.Server))
+	}
+	return &vtctldatapb.GetThrottlerConfigurationResponse{Configurations: configurations}, nil
+}
+
+// UpdateThrottlerConfiguration is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) UpdateThrottlerConfiguration(ctx context.Context, req *vtctldatapb.UpdateThrottlerConfigurationRequest) (*vtctldatapb.UpdateThrottlerConfigurationResponse, error) {
+	if req.Configuration == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "configuration is required"))
+	}
+	ctx, cancel := context.WithTimeout(ctx, throttlerTimeout)
+	defer cancel()
+	client, err := newThrottlerClient(req.Server)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer client.Close()
+
+	names, err := client.UpdateConfiguration(ctx, req.ThrottlerName, req.Configuration, req.CopyZeroValues)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "failed to update the throttler configuration on server '%v'", req.Server))
+	}
+	return &vtctldatapb.UpdateThrottlerConfigurationResponse{Names: names}, nil
+}
+
+// ResetThrottlerConfiguration is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ResetThrottlerConfiguration(ctx context.Context, req *vtctldatapb.ResetThrottlerConfigurationRequest) (*vtctldatapb.ResetThrottlerConfigurationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, throttlerTimeout)
+	defer cancel()
+	client, err := newThrottlerClient(req.Server)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	defer client.Close()
+
+	names, err := client.ResetConfiguration(ctx, req.ThrottlerName)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "failed to reset the throttler configuration on server '%v'", req.Server))
+	}
+	return &vtctldatapb.ResetThrottlerConfigurationResponse{Names: names}, nil
+}
+
+// newThrottlerClient connects to the throttler service of server.
+func newThrottlerClient(server string) (throttlerclient.Client, error) {
+	if server == "" {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "server is required")
+	}
+	client, err := throttlerclient.New(server)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "error creating a throttler client for server '%v'", server)
+	}
+	return client, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/throttler/throttlerclient"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// fakeThrottlerClient serves a single throttler, "t1".
+type fakeThrottlerClient struct {
+	rate          int64
+	configuration *throttlerdatapb.Configuration
+}
+
+func (c *fakeThrottlerClient) MaxRates(ctx context.Context) (map[string]int64, error) {
+	return map[string]int64{"t1": c.rate}, nil
+}
+
+func (c *fakeThrottlerClient) SetMaxRate(ctx context.Context, rate int64) ([]string, error) {
+	c.rate = rate
+	return []string{"t1"}, nil
+}
+
+func (c *fakeThrottlerClient) GetConfiguration(ctx context.Context, throttlerName string) (map[string]*throttlerdatapb.Configuration, error) {
+	if throttlerName != "" && throttlerName != "t1" {
+		return nil, errors.New("throttler not found")
+	}
+	return map[string]*throttlerdatapb.Configuration{"t1": c.configuration}, nil
+}
+
+func (c *fakeThrottlerClient) UpdateConfiguration(ctx context.Context, throttlerName string, configuration *throttlerdatapb.Configuration, copyZeroValues bool) ([]string, error) {
+	c.configuration = configuration
+	return []string{"t1"}, nil
+}
+
+func (c *fakeThrottlerClient) ResetConfiguration(ctx context.Context, throttlerName string) ([]string, error) {
+	c.configuration = &throttlerdatapb.Configuration{}
+	return []string{"t1"}, nil
+}
+
+func (c *fakeThrottlerClient) Close() {}
+
+// fakeThrottler is the client of the "fake" throttler client protocol.
+var fakeThrottler = &fakeThrottlerClient{}
+
+func init() {
+	throttlerclient.RegisterFactory("fake", func(addr string) (throttlerclient.Client, error) {
+		return fakeThrottler, nil
+	})
+}
+
+func TestThrottlerRPCs(t *testing.T) {
+	ctx := context.Background()
+	s := newVtctldServer(memorytopo.NewServer("cell1"), &recordingTMC{})
+
+	*fakeThrottler = fakeThrottlerClient{configuration: &throttlerdatapb.Configuration{}}
+	require.NoError(t, flag.Set("throttler_client_protocol", "fake"))
+	defer flag.Set("throttler_client_protocol", "grpc")
+
+	_, err := s.ThrottlerMaxRates(ctx, &vtctldatapb.ThrottlerMaxRatesRequest{})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = server is required")
+
+	set, err := s.ThrottlerSetMaxRate(ctx, &vtctldatapb.ThrottlerSetMaxRateRequest{Server: "vtworker", Rate: 100})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1"}, set.Names)
+	rates, err := s.ThrottlerMaxRates(ctx, &vtctldatapb.ThrottlerMaxRatesRequest{Server: "vtworker"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"t1": 100}, rates.Rates)
+
+	_, err = s.UpdateThrottlerConfiguration(ctx, &vtctldatapb.UpdateThrottlerConfigurationRequest{Server: "vtworker"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = configuration is required")
+	_, err = s.UpdateThrottlerConfiguration(ctx, &vtctldatapb.UpdateThrottlerConfigurationRequest{
+		Server:        "vtworker",
+		Configuration: &throttlerdatapb.Configuration{TargetReplicationLagSec: 5},
+	})
+	require.NoError(t, err)
+	configurations, err := s.GetThrottlerConfiguration(ctx, &vtctldatapb.GetThrottlerConfigurationRequest{Server: "vtworker"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, configurations.Configurations["t1"].TargetReplicationLagSec)
+	_, err = s.GetThrottlerConfiguration(ctx, &vtctldatapb.GetThrottlerConfigurationRequest{Server: "vtworker", ThrottlerName: "t2"})
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = failed to get the throttler configuration from server 'vtworker': throttler not found")
+
+	reset, err := s.ResetThrottlerConfiguration(ctx, &vtctldatapb.ResetThrottlerConfigurationRequest{Server: "vtworker"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1"}, reset.Names)
+	assert.Zero(t, fakeThrottler.configuration.TargetReplicationLagSec)
+}
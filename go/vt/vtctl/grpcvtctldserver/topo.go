@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"encoding/json"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Topo commands.
+
+// TopoCat is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) TopoCat(ctx context.Context, req *vtctldatapb.TopoCatRequest) (*vtctldatapb.TopoCatResponse, error) {
+	if len(req.Paths) == 0 {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "paths is required"))
+	}
+	cell := req.Cell
+	if cell == "" {
+		cell = topo.GlobalCell
+	}
+	resolved, err := s.ts.ResolveWildcards(ctx, cell, req.Paths)
+	if err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Wrap(err, "invalid wildcards"))
+	}
+	response := &vtctldatapb.TopoCatResponse{}
+	if len(resolved) == 0 {
+		return response, nil
+	}
+
+	conn, err := s.ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	for _, path := range resolved {
+		data, version, err := conn.Get(ctx, path)
+		if err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+		response.Files = append(response.Files, &vtctldatapb.TopoFile{
+			Path:    path,
+			Data:    data,
+			Version: version.String(),
+		})
+	}
+	return response, nil
+}
+
+// WriteTopoFile is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WriteTopoFile(ctx context.Context, req *vtctldatapb.WriteTopoFileRequest) (*vtctldatapb.WriteTopoFileResponse, error) {
+	if req.Path == "" {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "path is required"))
+	}
+	cell := req.Cell
+	if cell == "" {
+		cell = topo.GlobalCell
+	}
+	conn, err := s.ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	version, err := conn.Update(ctx, req.Path, req.Data, nil)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WriteTopoFileResponse{Version: version.String()}, nil
+}
+
+// ExportTopo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ExportTopo(ctx context.Context, req *vtctldatapb.ExportTopoRequest) (*vtctldatapb.ExportTopoResponse, error) {
+	export, err := helpers.ExportTopo(ctx, s.ts)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ExportTopoResponse{Export: data}, nil
+}
+
+// ImportTopo is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ImportTopo(ctx context.Context, req *vtctldatapb.ImportTopoRequest) (*vtctldatapb.ImportTopoResponse, error) {
+	export := &helpers.TopoExport{}
+	if err := json.Unmarshal(req.Export, export); err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot parse export: %v", err))
+	}
+	if err := helpers.ImportTopo(ctx, s.ts, export); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if req.SkipRebuild {
+		return &vtctldatapb.ImportTopoResponse{}, nil
+	}
+
+	wr := s.wrangler(logutil.NewConsoleLogger())
+	for keyspace := range export.Keyspaces {
+		if err := wr.RebuildKeyspaceGraph(ctx, keyspace, nil); err != nil {
+			return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "RebuildKeyspaceGraph(%v) failed", keyspace))
+		}
+	}
+	if err := s.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ImportTopoResponse{}, nil
+}
+
+// GetTopoAuditLog is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetTopoAuditLog(ctx context.Context, req *vtctldatapb.GetTopoAuditLogRequest) (*vtctldatapb.GetTopoAuditLogResponse, error) {
+	entries, err := s.ts.GetAuditLog(ctx, req.PathPrefix, req.Before, int(req.Limit))
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response := &vtctldatapb.GetTopoAuditLogResponse{}
+	for _, e := range entries {
+		response.Entries = append(response.Entries, &vtctldatapb.TopoAuditEntry{
+			Time:       logutil.TimeToProto(e.Time),
+			HostName:   e.HostName,
+			Process:    e.Process,
+			UserName:   e.UserName,
+			Principal:  e.Principal,
+			Actions:    e.Actions,
+			Operation:  e.Operation,
+			Cell:       e.Cell,
+			Path:       e.Path,
+			Version:    e.Version,
+			NewVersion: e.NewVersion,
+			OldHash:    e.OldHash,
+			NewHash:    e.NewHash,
+			Name:       e.Name,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestTopoRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+
+	_, err := s.WriteTopoFile(ctx, &vtctldatapb.WriteTopoFileRequest{Path: "/custom/file1", Data: []byte("data1")})
+	require.NoError(t, err)
+	_, err = s.WriteTopoFile(ctx, &vtctldatapb.WriteTopoFileRequest{Path: "/custom/file2", Data: []byte("data2")})
+	require.NoError(t, err)
+	cat, err := s.TopoCat(ctx, &vtctldatapb.TopoCatRequest{Paths: []string{"/custom/*"}})
+	require.NoError(t, err)
+	require.Len(t, cat.Files, 2)
+	assert.Equal(t, "/custom/file1", cat.Files[0].Path)
+	assert.Equal(t, []byte("data1"), cat.Files[0].Data)
+	assert.NotEmpty(t, cat.Files[0].Version)
+	_, err = s.TopoCat(ctx, &vtctldatapb.TopoCatRequest{})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = paths is required")
+
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	export, err := s.ExportTopo(ctx, &vtctldatapb.ExportTopoRequest{})
+	require.NoError(t, err)
+
+	ts2 := memorytopo.NewServer("cell1")
+	s2 := newVtctldServer(ts2, &recordingTMC{})
+	_, err = s2.ImportTopo(ctx, &vtctldatapb.ImportTopoRequest{Export: []byte("{")})
+	assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument desc = cannot parse export")
+	_, err = s2.ImportTopo(ctx, &vtctldatapb.ImportTopoRequest{Export: export.Export})
+	require.NoError(t, err)
+	shards, err := ts2.GetShardNames(ctx, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, shards)
+	_, err = ts2.GetSrvVSchema(ctx, "cell1")
+	require.NoError(t, err)
+
+	// The server is not started with -topo_audit.
+	log, err := s.GetTopoAuditLog(ctx, &vtctldatapb.GetTopoAuditLogRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, log.Entries)
+}
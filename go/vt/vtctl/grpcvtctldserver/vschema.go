@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the VSchema and serving graph commands,
+// and of Validate.
+
+// GetVSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSchemaRequest) (*vtctldatapb.GetVSchemaResponse, error) {
+	vs, err := s.ts.GetVSchema(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetVSchemaResponse{Vschema: vs}, nil
+}
+
+// ApplyVSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
+	if (req.Vschema == nil) == (req.Sql == "") {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "exactly one of vschema and sql must be set"))
+	}
+
+	vs := req.Vschema
+	if req.Sql != "" {
+		stmt, err := sqlparser.Parse(req.Sql)
+		if err != nil {
+			return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: %v", req.Sql, err))
+		}
+		ddl, ok := stmt.(*sqlparser.DDL)
+		if !ok {
+			return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: not a ddl statement", req.Sql))
+		}
+		vs, err = s.ts.GetVSchema(ctx, req.Keyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			vs = &vschemapb.Keyspace{}
+		case err != nil:
+			return nil, vterrors.ToGRPC(err)
+		}
+		if vs, err = topotools.ApplyVSchemaDDL(req.Keyspace, vs, ddl); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	if req.DryRun {
+		return &vtctldatapb.ApplyVSchemaResponse{Vschema: vs}, nil
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "keyspace(%s) doesn't exist, check if the keyspace is initialized", req.Keyspace))
+		}
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if !req.SkipRebuild {
+		if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	return &vtctldatapb.ApplyVSchemaResponse{Vschema: vs}, nil
+}
+
+// GetRoutingRules is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
+	rr, err := s.ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetRoutingRulesResponse{RoutingRules: rr}, nil
+}
+
+// ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
+	rr := req.RoutingRules
+	if rr == nil {
+		rr = &vschemapb.RoutingRules{}
+	}
+	if err := s.ts.SaveRoutingRules(ctx, rr); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if !req.SkipRebuild {
+		if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+	}
+	return &vtctldatapb.ApplyRoutingRulesResponse{}, nil
+}
+
+// RebuildVSchemaGraph is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) RebuildVSchemaGraph(ctx context.Context, req *vtctldatapb.RebuildVSchemaGraphRequest) (*vtctldatapb.RebuildVSchemaGraphResponse, error) {
+	if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.RebuildVSchemaGraphResponse{}, nil
+}
+
+// GetSrvKeyspaceNames is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetSrvKeyspaceNames(ctx context.Context, req *vtctldatapb.GetSrvKeyspaceNamesRequest) (*vtctldatapb.GetSrvKeyspaceNamesResponse, error) {
+	names, err := s.ts.GetSrvKeyspaceNames(ctx, req.Cell)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetSrvKeyspaceNamesResponse{Names: names}, nil
+}
+
+// GetSrvKeyspace is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetSrvKeyspace(ctx context.Context, req *vtctldatapb.GetSrvKeyspaceRequest) (*vtctldatapb.GetSrvKeyspaceResponse, error) {
+	srvKeyspace, err := s.ts.GetSrvKeyspace(ctx, req.Cell, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetSrvKeyspaceResponse{SrvKeyspace: srvKeyspace}, nil
+}
+
+// GetSrvVSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetSrvVSchema(ctx context.Context, req *vtctldatapb.GetSrvVSchemaRequest) (*vtctldatapb.GetSrvVSchemaResponse, error) {
+	srvVSchema, err := s.ts.GetSrvVSchema(ctx, req.Cell)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetSrvVSchemaResponse{SrvVschema: srvVSchema}, nil
+}
+
+// DeleteSrvVSchema is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) DeleteSrvVSchema(ctx context.Context, req *vtctldatapb.DeleteSrvVSchemaRequest) (*vtctldatapb.DeleteSrvVSchemaResponse, error) {
+	if err := s.ts.DeleteSrvVSchema(ctx, req.Cell); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.DeleteSrvVSchemaResponse{}, nil
+}
+
+// GetShardReplication is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetShardReplication(ctx context.Context, req *vtctldatapb.GetShardReplicationRequest) (*vtctldatapb.GetShardReplicationResponse, error) {
+	sri, err := s.ts.GetShardReplication(ctx, req.Cell, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetShardReplicationResponse{ShardReplication: sri.ShardReplication}, nil
+}
+
+// Validate is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Validate(req *vtctldatapb.ValidateRequest, stream vtctlservicepb.Vtctld_ValidateServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if req.DryRun && !req.Repair {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "dry_run requires repair"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ValidateResponse{Event: e})
+	}))
+	if req.Repair {
+		if err := wr.RepairTopology(stream.Context(), req.DryRun); err != nil {
+			return vterrors.ToGRPC(err)
+		}
+	}
+	return vterrors.ToGRPC(wr.Validate(stream.Context(), req.PingTablets))
+}
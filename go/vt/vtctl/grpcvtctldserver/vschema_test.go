@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestVSchemaRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	_, err := s.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{Keyspace: "ks"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = exactly one of vschema and sql must be set")
+	_, err = s.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace: "ks",
+		Sql:      "select 1",
+	})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = error parsing vschema statement `select 1`: not a ddl statement")
+
+	dryRun, err := s.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace: "ks",
+		Sql:      "alter vschema create vindex hash_vdx using hash",
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hash", dryRun.Vschema.Vindexes["hash_vdx"].Type)
+	_, err = s.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{Keyspace: "ks"})
+	assert.Error(t, err)
+
+	_, err = s.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace: "ks",
+		Sql:      "alter vschema create vindex hash_vdx using hash",
+	})
+	require.NoError(t, err)
+	vs, err := s.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{Keyspace: "ks"})
+	require.NoError(t, err)
+	assert.Equal(t, "hash", vs.Vschema.Vindexes["hash_vdx"].Type)
+	srvVSchema, err := s.GetSrvVSchema(ctx, &vtctldatapb.GetSrvVSchemaRequest{Cell: "cell1"})
+	require.NoError(t, err)
+	assert.Contains(t, srvVSchema.SrvVschema.Keyspaces, "ks")
+
+	_, err = s.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace: "missing",
+		Vschema:  &vschemapb.Keyspace{},
+	})
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = keyspace(missing) doesn't exist, check if the keyspace is initialized")
+
+	rules := &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{FromTable: "t1", ToTables: []string{"ks.t1"}}},
+	}
+	_, err = s.ApplyRoutingRules(ctx, &vtctldatapb.ApplyRoutingRulesRequest{
+		RoutingRules: rules,
+		SkipRebuild:  true,
+	})
+	require.NoError(t, err)
+	rr, err := s.GetRoutingRules(ctx, &vtctldatapb.GetRoutingRulesRequest{})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(rules, rr.RoutingRules), "%v", rr.RoutingRules)
+	srvVSchema, err = s.GetSrvVSchema(ctx, &vtctldatapb.GetSrvVSchemaRequest{Cell: "cell1"})
+	require.NoError(t, err)
+	assert.Empty(t, srvVSchema.SrvVschema.RoutingRules.GetRules())
+	_, err = s.RebuildVSchemaGraph(ctx, &vtctldatapb.RebuildVSchemaGraphRequest{})
+	require.NoError(t, err)
+	srvVSchema, err = s.GetSrvVSchema(ctx, &vtctldatapb.GetSrvVSchemaRequest{Cell: "cell1"})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(rules, srvVSchema.SrvVschema.RoutingRules), "%v", srvVSchema.SrvVschema.RoutingRules)
+
+	_, err = s.DeleteSrvVSchema(ctx, &vtctldatapb.DeleteSrvVSchemaRequest{Cell: "cell1"})
+	require.NoError(t, err)
+	_, err = s.GetSrvVSchema(ctx, &vtctldatapb.GetSrvVSchemaRequest{Cell: "cell1"})
+	assert.Error(t, err)
+
+	err = s.Validate(&vtctldatapb.ValidateRequest{DryRun: true}, nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = dry_run requires repair")
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/vtctl"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/workflow"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the RPCs of the Workflows commands, which drive the
+// workflow manager of vtctld. They use the same manager as the vtctl
+// commands, vtctl.WorkflowManager.
+
+// WorkflowCreate is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowCreate(ctx context.Context, req *vtctldatapb.WorkflowCreateRequest) (*vtctldatapb.WorkflowCreateResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	uuid, err := m.Create(ctx, req.FactoryName, req.Args)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if !req.SkipStart {
+		if err := m.Start(ctx, uuid); err != nil {
+			return nil, vterrors.ToGRPC(vterrors.Wrapf(err, "workflow %v was created but not started", uuid))
+		}
+	}
+	return &vtctldatapb.WorkflowCreateResponse{Uuid: uuid}, nil
+}
+
+// WorkflowStart is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowStart(ctx context.Context, req *vtctldatapb.WorkflowStartRequest) (*vtctldatapb.WorkflowStartResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := m.Start(ctx, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowStartResponse{}, nil
+}
+
+// WorkflowStop is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowStop(ctx context.Context, req *vtctldatapb.WorkflowStopRequest) (*vtctldatapb.WorkflowStopResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := m.Stop(ctx, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowStopResponse{}, nil
+}
+
+// WorkflowDelete is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowDelete(ctx context.Context, req *vtctldatapb.WorkflowDeleteRequest) (*vtctldatapb.WorkflowDeleteResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := m.Delete(ctx, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowDeleteResponse{}, nil
+}
+
+// WorkflowWait is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowWait(ctx context.Context, req *vtctldatapb.WorkflowWaitRequest) (*vtctldatapb.WorkflowWaitResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := m.Wait(ctx, req.Uuid); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowWaitResponse{}, nil
+}
+
+// WorkflowTree is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowTree(ctx context.Context, req *vtctldatapb.WorkflowTreeRequest) (*vtctldatapb.WorkflowTreeResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	tree, err := m.NodeManager().GetFullTree()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowTreeResponse{Tree: tree}, nil
+}
+
+// WorkflowAction is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error) {
+	m, err := workflowManager()
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	if err := m.NodeManager().Action(ctx, &workflow.ActionParameters{
+		Path: req.Path,
+		Name: req.Name,
+	}); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.WorkflowActionResponse{}, nil
+}
+
+// workflowManager returns the workflow manager of vtctld, which is only
+// set if vtctld runs with -workflow_manager_init.
+func workflowManager() (*workflow.Manager, error) {
+	if vtctl.WorkflowManager == nil {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "no workflow.Manager registered")
+	}
+	return vtctl.WorkflowManager, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl"
+	"vitess.io/vitess/go/vt/workflow"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestWorkflowManagerRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+
+	_, err := s.WorkflowTree(ctx, &vtctldatapb.WorkflowTreeRequest{})
+	assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = no workflow.Manager registered")
+
+	vtctl.WorkflowManager = workflow.NewManager(ts)
+	defer func() { vtctl.WorkflowManager = nil }()
+	wg, _, cancel := workflow.StartManager(vtctl.WorkflowManager)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	created, err := s.WorkflowCreate(ctx, &vtctldatapb.WorkflowCreateRequest{
+		FactoryName: "sleep",
+		Args:        []string{"-duration", "60"},
+		SkipStart:   true,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Uuid)
+	tree, err := s.WorkflowTree(ctx, &vtctldatapb.WorkflowTreeRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, string(tree.Tree), created.Uuid)
+
+	_, err = s.WorkflowStart(ctx, &vtctldatapb.WorkflowStartRequest{Uuid: created.Uuid})
+	require.NoError(t, err)
+	_, err = s.WorkflowStop(ctx, &vtctldatapb.WorkflowStopRequest{Uuid: created.Uuid})
+	require.NoError(t, err)
+	_, err = s.WorkflowDelete(ctx, &vtctldatapb.WorkflowDeleteRequest{Uuid: created.Uuid})
+	require.NoError(t, err)
+	tree, err = s.WorkflowTree(ctx, &vtctldatapb.WorkflowTreeRequest{})
+	require.NoError(t, err)
+	assert.NotContains(t, string(tree.Tree), created.Uuid)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+// This file contains the RPCs of the workflows of the Keyspaces
+// commands. The defaults of the optional fields of the requests are
+// the same as the defaults of the flags of the vtctl commands.
+const (
+	defaultFilteredReplicationWaitTime = 30 * time.Second
+	defaultMaxReplicationLag           = 30 * time.Second
+	defaultCopyCheckInterval           = 10 * time.Second
+	defaultBackfillPollInterval        = 5 * time.Second
+	defaultDrainRetryDelay             = 1 * time.Second
+	defaultDrainInitialWait            = 1 * time.Minute
+	defaultVDiffTabletTypes            = "master,replica,rdonly"
+)
+
+// MoveTables is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) MoveTables(req *vtctldatapb.MoveTablesRequest, stream vtctlservicepb.Vtctld_MoveTablesServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if req.Workflow == "" {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "workflow is required"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.MoveTablesResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.MoveTables(stream.Context(), req.Workflow, req.SourceKeyspace, req.TargetKeyspace, req.TableSpecs, req.Cell, req.TabletTypes, req.Compression))
+}
+
+// CreateLookupVindex is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CreateLookupVindex(ctx context.Context, req *vtctldatapb.CreateLookupVindexRequest) (*vtctldatapb.CreateLookupVindexResponse, error) {
+	if req.Spec == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "spec is required"))
+	}
+	if err := s.wrangler(logutil.NewConsoleLogger()).CreateLookupVindex(ctx, req.Keyspace, req.Spec, req.Cell, req.TabletTypes); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CreateLookupVindexResponse{}, nil
+}
+
+// BackfillLookupVindex is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) BackfillLookupVindex(req *vtctldatapb.BackfillLookupVindexRequest, stream vtctlservicepb.Vtctld_BackfillLookupVindexServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if req.Spec == nil {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "spec is required"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.BackfillLookupVindexResponse{Event: e})
+	}))
+	pollInterval := durationOrDefault(req.PollInterval, defaultBackfillPollInterval)
+	return vterrors.ToGRPC(wr.BackfillLookupVindex(stream.Context(), req.Keyspace, req.Spec, req.Cell, req.TabletTypes, pollInterval))
+}
+
+// ExternalizeVindex is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ExternalizeVindex(ctx context.Context, req *vtctldatapb.ExternalizeVindexRequest) (*vtctldatapb.ExternalizeVindexResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).ExternalizeVindex(ctx, req.Name); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ExternalizeVindexResponse{}, nil
+}
+
+// Materialize is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) Materialize(req *vtctldatapb.MaterializeRequest, stream vtctlservicepb.Vtctld_MaterializeServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if req.Settings == nil {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "settings is required"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.MaterializeResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.Materialize(stream.Context(), req.Settings))
+}
+
+// SplitClone is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SplitClone(ctx context.Context, req *vtctldatapb.SplitCloneRequest) (*vtctldatapb.SplitCloneResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).SplitClone(ctx, req.Keyspace, req.FromShards, req.ToShards); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.SplitCloneResponse{}, nil
+}
+
+// VerticalSplitClone is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) VerticalSplitClone(ctx context.Context, req *vtctldatapb.VerticalSplitCloneRequest) (*vtctldatapb.VerticalSplitCloneResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).VerticalSplitClone(ctx, req.FromKeyspace, req.ToKeyspace, req.Tables); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.VerticalSplitCloneResponse{}, nil
+}
+
+// VDiff is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) VDiff(req *vtctldatapb.VDiffRequest, stream vtctlservicepb.Vtctld_VDiffServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.VDiffResponse{Event: e})
+	}))
+	tabletTypes := req.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultVDiffTabletTypes
+	}
+	reports, err := wr.VDiff(stream.Context(), req.Keyspace, req.Workflow, req.SourceCell, req.TargetCell, tabletTypes,
+		durationOrDefault(req.FilteredReplicationWaitTime, defaultFilteredReplicationWaitTime),
+		*vtctl.HealthCheckTopologyRefresh, *vtctl.HealthcheckRetryDelay, *vtctl.HealthCheckTimeout,
+		"" /* format */, req.ChunkSize, req.CheckpointFile, req.Resume)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+
+	tables := make([]string, 0, len(reports))
+	for table := range reports {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	response := &vtctldatapb.VDiffResponse{}
+	for _, table := range tables {
+		dr := reports[table]
+		response.Reports = append(response.Reports, &vtctldatapb.VDiffTableReport{
+			Table:           table,
+			ProcessedRows:   int64(dr.ProcessedRows),
+			MatchingRows:    int64(dr.MatchingRows),
+			MismatchedRows:  int64(dr.MismatchedRows),
+			ExtraRowsSource: int64(dr.ExtraRowsSource),
+			ExtraRowsTarget: int64(dr.ExtraRowsTarget),
+		})
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return stream.Send(response)
+}
+
+// PauseWorkflow is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) PauseWorkflow(ctx context.Context, req *vtctldatapb.PauseWorkflowRequest) (*vtctldatapb.PauseWorkflowResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).PauseWorkflow(ctx, req.Keyspace, req.Workflow); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.PauseWorkflowResponse{}, nil
+}
+
+// ResumeWorkflow is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ResumeWorkflow(ctx context.Context, req *vtctldatapb.ResumeWorkflowRequest) (*vtctldatapb.ResumeWorkflowResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).ResumeWorkflow(ctx, req.Keyspace, req.Workflow); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.ResumeWorkflowResponse{}, nil
+}
+
+// CopyProgress is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CopyProgress(ctx context.Context, req *vtctldatapb.CopyProgressRequest) (*vtctldatapb.CopyProgressResponse, error) {
+	progress, err := s.wrangler(logutil.NewConsoleLogger()).WorkflowCopyProgress(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CopyProgressResponse{CopyProgress: shardCopyProgress(progress)}, nil
+}
+
+// MigrateServedTypes is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) MigrateServedTypes(req *vtctldatapb.MigrateServedTypesRequest, stream vtctlservicepb.Vtctld_MigrateServedTypesServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkServingTabletType(req.TabletType); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	if req.TabletType == topodatapb.TabletType_MASTER && req.SkipRefreshState {
+		return vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "skip_refresh_state can only be set for non-master migrations"))
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.MigrateServedTypesResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.MigrateServedTypes(stream.Context(), req.Keyspace, req.Shard, req.Cells, req.TabletType, req.Reverse, req.SkipRefreshState,
+		durationOrDefault(req.FilteredReplicationWaitTime, defaultFilteredReplicationWaitTime), req.ReverseReplication))
+}
+
+// MigrateServedFrom is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) MigrateServedFrom(req *vtctldatapb.MigrateServedFromRequest, stream vtctlservicepb.Vtctld_MigrateServedFromServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkTabletType(req.TabletType, topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.MigrateServedFromResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.MigrateServedFrom(stream.Context(), req.Keyspace, req.Shard, req.TabletType, req.Cells, req.Reverse,
+		durationOrDefault(req.FilteredReplicationWaitTime, defaultFilteredReplicationWaitTime)))
+}
+
+// SwitchReads is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SwitchReads(req *vtctldatapb.SwitchReadsRequest, stream vtctlservicepb.Vtctld_SwitchReadsServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkTabletType(req.TabletType, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	direction := wrangler.DirectionForward
+	if req.Reverse {
+		direction = wrangler.DirectionBackward
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.SwitchReadsResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.SwitchReads(stream.Context(), req.Keyspace, req.Workflow, req.TabletType, req.Cells, direction))
+}
+
+// SwitchWrites is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) SwitchWrites(req *vtctldatapb.SwitchWritesRequest, stream vtctlservicepb.Vtctld_SwitchWritesServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	ctx := stream.Context()
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.SwitchWritesResponse{Event: e})
+	}))
+	filteredReplicationWaitTime := durationOrDefault(req.FilteredReplicationWaitTime, defaultFilteredReplicationWaitTime)
+
+	if !req.Cancel && !req.SkipPrechecks {
+		vdiffTabletTypes := req.VdiffTabletTypes
+		if vdiffTabletTypes == "" {
+			vdiffTabletTypes = defaultVDiffTabletTypes
+		}
+		report, err := wr.CheckSwitchWrites(ctx, req.Keyspace, req.Workflow, &wrangler.SwitchWritesPrecheckParams{
+			MaxReplicationLag:           durationOrDefault(req.MaxReplicationLag, defaultMaxReplicationLag),
+			VDiffTabletTypes:            vdiffTabletTypes,
+			FilteredReplicationWaitTime: filteredReplicationWaitTime,
+			HealthCheckTopologyRefresh:  *vtctl.HealthCheckTopologyRefresh,
+			HealthCheckRetryDelay:       *vtctl.HealthcheckRetryDelay,
+			HealthCheckTimeout:          *vtctl.HealthCheckTimeout,
+		})
+		if err != nil {
+			return vterrors.ToGRPC(err)
+		}
+		response := &vtctldatapb.SwitchWritesResponse{}
+		for _, check := range report.Checks {
+			response.Prechecks = append(response.Prechecks, &vtctldatapb.SwitchWritesPrecheck{
+				Name:     check.Name,
+				Passed:   check.Passed,
+				Problems: check.Problems,
+			})
+		}
+		mu.Lock()
+		err = stream.Send(response)
+		mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if !report.Passed() {
+			return vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the checks of workflow %v.%v failed, its writes are not switched", req.Keyspace, req.Workflow))
+		}
+	}
+
+	journalID, err := wr.SwitchWrites(ctx, req.Keyspace, req.Workflow, filteredReplicationWaitTime, req.Cancel, !req.SkipReverseReplication)
+	if err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return stream.Send(&vtctldatapb.SwitchWritesResponse{JournalId: journalID})
+}
+
+// CancelResharding is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) CancelResharding(ctx context.Context, req *vtctldatapb.CancelReshardingRequest) (*vtctldatapb.CancelReshardingResponse, error) {
+	if err := s.wrangler(logutil.NewConsoleLogger()).CancelResharding(ctx, req.Keyspace, req.Shard); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.CancelReshardingResponse{}, nil
+}
+
+// ShowResharding is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ShowResharding(req *vtctldatapb.ShowReshardingRequest, stream vtctlservicepb.Vtctld_ShowReshardingServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ShowReshardingResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ShowResharding(stream.Context(), req.Keyspace, req.Shard))
+}
+
+// WaitForDrain is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) WaitForDrain(req *vtctldatapb.WaitForDrainRequest, stream vtctlservicepb.Vtctld_WaitForDrainServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	if err := checkServingTabletType(req.TabletType); err != nil {
+		return vterrors.ToGRPC(err)
+	}
+	ctx := stream.Context()
+	if timeout := logutil.ProtoToDuration(req.Timeout); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.WaitForDrainResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.WaitForDrain(ctx, req.Cells, req.Keyspace, req.Shard, req.TabletType,
+		durationOrDefault(req.RetryDelay, defaultDrainRetryDelay),
+		*vtctl.HealthCheckTopologyRefresh, *vtctl.HealthcheckRetryDelay, *vtctl.HealthCheckTimeout,
+		durationOrDefault(req.InitialWait, defaultDrainInitialWait)))
+}
+
+// StartReshardWorkflow is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) StartReshardWorkflow(req *vtctldatapb.StartReshardWorkflowRequest, stream vtctlservicepb.Vtctld_StartReshardWorkflowServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.StartReshardWorkflowResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.StartReshardWorkflow(stream.Context(), req.Keyspace, req.Workflow, req.SourceShards, req.TargetShards,
+		req.SkipSchemaCopy, req.Compression, req.SkipVdiff, reshardWorkflowOptions(req.Options)))
+}
+
+// GetReshardWorkflow is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) GetReshardWorkflow(ctx context.Context, req *vtctldatapb.GetReshardWorkflowRequest) (*vtctldatapb.GetReshardWorkflowResponse, error) {
+	rwi, err := s.ts.GetReshardWorkflow(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &vtctldatapb.GetReshardWorkflowResponse{Workflow: rwi.ReshardWorkflow}, nil
+}
+
+// ReshardWorkflowSwitchReads is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReshardWorkflowSwitchReads(req *vtctldatapb.ReshardWorkflowSwitchReadsRequest, stream vtctlservicepb.Vtctld_ReshardWorkflowSwitchReadsServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ReshardWorkflowSwitchReadsResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ReshardWorkflowSwitchReads(stream.Context(), req.Keyspace, req.Workflow, reshardWorkflowOptions(req.Options)))
+}
+
+// ReshardWorkflowSwitchWrites is part of the vtctlservicepb.VtctldServer interface
+func (s *VtctldServer) ReshardWorkflowSwitchWrites(req *vtctldatapb.ReshardWorkflowSwitchWritesRequest, stream vtctlservicepb.Vtctld_ReshardWorkflowSwitchWritesServer) (err error) {
+	defer servenv.HandlePanic("vtctld", &err)
+
+	mu := sync.Mutex{}
+	wr := s.wrangler(streamLogger(&mu, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.ReshardWorkflowSwitchWritesResponse{Event: e})
+	}))
+	return vterrors.ToGRPC(wr.ReshardWorkflowSwitchWrites(stream.Context(), req.Keyspace, req.Workflow, reshardWorkflowOptions(req.Options)))
+}
+
+// reshardWorkflowOptions returns the wrangler options of a resharding
+// workflow, with the defaults for the fields which are not set.
+func reshardWorkflowOptions(options *vtctldatapb.ReshardWorkflowOptions) *wrangler.ReshardWorkflowOptions {
+	if options == nil {
+		options = &vtctldatapb.ReshardWorkflowOptions{}
+	}
+	tabletTypes := options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultVDiffTabletTypes
+	}
+	return &wrangler.ReshardWorkflowOptions{
+		CopyCheckInterval:           durationOrDefault(options.CopyCheckInterval, defaultCopyCheckInterval),
+		SourceCell:                  options.SourceCell,
+		TargetCell:                  options.TargetCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: durationOrDefault(options.FilteredReplicationWaitTime, defaultFilteredReplicationWaitTime),
+		HealthCheckTopologyRefresh:  *vtctl.HealthCheckTopologyRefresh,
+		HealthCheckRetryDelay:       *vtctl.HealthcheckRetryDelay,
+		HealthCheckTimeout:          *vtctl.HealthCheckTimeout,
+		MaxReplicationLag:           durationOrDefault(options.MaxReplicationLag, defaultMaxReplicationLag),
+	}
+}
+
+// durationOrDefault returns the duration of a request, or def if it's
+// not set.
+func durationOrDefault(d *vttime.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	return logutil.ProtoToDuration(d)
+}
+
+// checkTabletType returns an error if the tablet type of a request is
+// not one of types.
+func checkTabletType(tabletType topodatapb.TabletType, types ...topodatapb.TabletType) error {
+	if !topoproto.IsTypeInList(tabletType, types) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "tablet_type %v is not one of: %v", tabletType, types)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+type moveTablesStream struct {
+	fakeStream
+}
+
+func (stream *moveTablesStream) Send(response *vtctldatapb.MoveTablesResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+type migrateServedTypesStream struct {
+	fakeStream
+}
+
+func (stream *migrateServedTypesStream) Send(response *vtctldatapb.MigrateServedTypesResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+type switchReadsStream struct {
+	fakeStream
+}
+
+func (stream *switchReadsStream) Send(response *vtctldatapb.SwitchReadsResponse) error {
+	stream.events = append(stream.events, response.Event)
+	return nil
+}
+
+func TestWorkflowRPCs(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := newVtctldServer(ts, &recordingTMC{})
+
+	err := s.MoveTables(&vtctldatapb.MoveTablesRequest{
+		SourceKeyspace: "source",
+		TargetKeyspace: "target",
+		TableSpecs:     "t1",
+	}, &moveTablesStream{fakeStream{ctx: ctx}})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = workflow is required")
+
+	err = s.MigrateServedTypes(&vtctldatapb.MigrateServedTypesRequest{
+		Keyspace:         "ks",
+		Shard:            "0",
+		TabletType:       topodatapb.TabletType_MASTER,
+		SkipRefreshState: true,
+	}, &migrateServedTypesStream{fakeStream{ctx: ctx}})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = skip_refresh_state can only be set for non-master migrations")
+
+	err = s.SwitchReads(&vtctldatapb.SwitchReadsRequest{
+		Keyspace:   "ks",
+		Workflow:   "wf",
+		TabletType: topodatapb.TabletType_MASTER,
+	}, &switchReadsStream{fakeStream{ctx: ctx}})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = tablet_type MASTER is not one of: [REPLICA RDONLY]")
+
+	_, err = ts.CreateReshardWorkflow(ctx, "ks", "wf", &topodatapb.ReshardWorkflow{
+		SourceShards: []string{"0"},
+		TargetShards: []string{"-80", "80-"},
+		State:        topodatapb.ReshardWorkflow_COPIED,
+	})
+	require.NoError(t, err)
+	rw, err := s.GetReshardWorkflow(ctx, &vtctldatapb.GetReshardWorkflowRequest{
+		Keyspace: "ks",
+		Workflow: "wf",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.ReshardWorkflow_COPIED, rw.Workflow.State)
+	assert.Equal(t, []string{"-80", "80-"}, rw.Workflow.TargetShards)
+}
+
+func TestReshardWorkflowOptions(t *testing.T) {
+	opts := reshardWorkflowOptions(nil)
+	assert.Equal(t, defaultCopyCheckInterval, opts.CopyCheckInterval)
+	assert.Equal(t, defaultVDiffTabletTypes, opts.TabletTypes)
+	assert.Equal(t, defaultFilteredReplicationWaitTime, opts.FilteredReplicationWaitTime)
+	assert.Equal(t, defaultMaxReplicationLag, opts.MaxReplicationLag)
+
+	opts = reshardWorkflowOptions(&vtctldatapb.ReshardWorkflowOptions{
+		CopyCheckInterval: &vttime.Duration{Seconds: 1},
+		TabletTypes:       "rdonly",
+		MaxReplicationLag: &vttime.Duration{},
+	})
+	assert.Equal(t, time.Second, opts.CopyCheckInterval)
+	assert.Equal(t, "rdonly", opts.TabletTypes)
+	assert.Equal(t, time.Duration(0), opts.MaxReplicationLag)
+}
@@ -19,9 +19,11 @@ package vtctl
 import (
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -45,13 +47,13 @@ func init() {
 	addCommand("Shards", command{
 		"PlannedReparentShard",
 		commandPlannedReparentShard,
-		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>] [-wait_slave_timeout=<duration>]",
-		"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running."})
+		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>] [-wait_slave_timeout=<duration>] [-dry_run] [-precheck]",
+		"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running. With -dry_run, only displays a JSON report of the tablet which would be promoted, the replication state and semi-sync status of the candidates, the expected downtime, the problems which would make the reparent fail and the warnings about the ones which may make it fail. With -precheck, the reparent only runs if the dry run finds no problem, and promotes the tablet it reported."})
 	addCommand("Shards", command{
 		"EmergencyReparentShard",
 		commandEmergencyReparentShard,
-		"-keyspace_shard=<keyspace/shard> -new_master=<tablet alias>",
-		"Reparents the shard to the new master. Assumes the old master is dead and not responsding."})
+		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-preferred_cells=c1,c2,...] [-forbidden_cells=c1,c2,...] [-tags=key:value,...] [-max_data_loss=<duration>]",
+		"Reparents the shard to the new master. Assumes the old master is dead and not responsding. Without -new_master, the most advanced replica which is not in -forbidden_cells, has all the -tags and whose last heartbeat is at most -max_data_loss behind the one of the most advanced tablet is chosen, preferably in -preferred_cells. With -new_master, the tablet must satisfy these constraints. If a tablet which cannot be promoted is more advanced than the new master, the new master catches up with it when -max_data_loss is set, and the reparent fails otherwise."})
 	addCommand("Shards", command{
 		"TabletExternallyReparented",
 		commandTabletExternallyReparented,
@@ -111,6 +113,8 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master")
 	avoidMaster := subFlags.String("avoid_master", "", "alias of a tablet that should not be the master, i.e. reparent to any other tablet if this one is the master")
+	dryRun := subFlags.Bool("dry_run", false, "only displays what the reparent would do, and the problems which would make it fail")
+	precheck := subFlags.Bool("precheck", false, "runs the reparent only if the dry run finds no problem")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -142,6 +146,30 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 			return err
 		}
 	}
+	if *dryRun || *precheck {
+		plan, err := wr.PlannedReparentShardDryRun(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout)
+		if err != nil {
+			return err
+		}
+		if *dryRun || len(plan.Problems) != 0 {
+			if err := printJSON(wr.Logger(), plan); err != nil {
+				return err
+			}
+		}
+		if len(plan.Problems) != 0 {
+			return fmt.Errorf("PlannedReparentShard would fail: %v", strings.Join(plan.Problems, "; "))
+		}
+		if *dryRun {
+			return nil
+		}
+		// Promote the tablet the dry run chose.
+		if newMasterAlias == nil && plan.NewMaster != "" {
+			newMasterAlias, err = topoproto.ParseTabletAlias(plan.NewMaster)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return wr.PlannedReparentShard(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout)
 }
 
@@ -152,7 +180,12 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", 30*time.Second, "time to wait for slaves to catch up in reparenting")
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
-	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master")
+	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master, chosen among the replicas if not set")
+	preferredCells := subFlags.String("preferred_cells", "", "comma-separated cells whose tablets are chosen first as the new master, among the most advanced ones")
+	forbiddenCells := subFlags.String("forbidden_cells", "", "comma-separated cells whose tablets cannot be the new master")
+	maxDataLoss := subFlags.Duration("max_data_loss", 0, "if set, the last heartbeat the new master applied must be at most this far behind the one the most advanced tablet applied. It requires -heartbeat_enable on the tablets")
+	var tags flagutil.StringMapValue
+	subFlags.Var(&tags, "tags", "comma-separated key:value pairs, the tablet tags the new master must have")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -164,18 +197,31 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 		*keyspaceShard = subFlags.Arg(0)
 		*newMaster = subFlags.Arg(1)
 	} else if subFlags.NArg() != 0 {
-		return fmt.Errorf("action EmergencyReparentShard requires -keyspace_shard=<keyspace/shard> -new_master=<tablet alias>")
+		return fmt.Errorf("action EmergencyReparentShard requires -keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>]")
 	}
 
 	keyspace, shard, err := topoproto.ParseKeyspaceShard(*keyspaceShard)
 	if err != nil {
 		return err
 	}
-	tabletAlias, err := topoproto.ParseTabletAlias(*newMaster)
-	if err != nil {
-		return err
+	var tabletAlias *topodatapb.TabletAlias
+	if *newMaster != "" {
+		tabletAlias, err = topoproto.ParseTabletAlias(*newMaster)
+		if err != nil {
+			return err
+		}
+	}
+	constraints := &wrangler.EmergencyReparentConstraints{
+		Tags:        tags,
+		MaxDataLoss: *maxDataLoss,
+	}
+	if *preferredCells != "" {
+		constraints.PreferredCells = strings.Split(*preferredCells, ",")
+	}
+	if *forbiddenCells != "" {
+		constraints.ForbiddenCells = strings.Split(*forbiddenCells, ",")
 	}
-	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitSlaveTimeout)
+	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitSlaveTimeout, constraints)
 }
 
 func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -29,6 +29,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -53,6 +54,24 @@ func init() {
 		commandTopoCp,
 		"[-cell <cell>] [-to_topo] <src> <dst>",
 		"Copies a file from topo to local file structure, or the other way around"})
+
+	addCommand(topoGroupName, command{
+		"ExportTopo",
+		commandExportTopo,
+		"<file>",
+		"Writes the cells, cells aliases, keyspaces, shards, vschemas, routing rules and tablets of the topology to a versioned JSON file."})
+
+	addCommand(topoGroupName, command{
+		"ImportTopo",
+		commandImportTopo,
+		"[-skip_rebuild] <file>",
+		"Restores a topology written by ExportTopo into the topo server, then rebuilds the serving graph. Cells that already exist are kept, so they can be created beforehand to point at a new topo implementation."})
+
+	addCommand(topoGroupName, command{
+		"GetTopoAuditLog",
+		commandGetTopoAuditLog,
+		"[-limit <count>] [-path_prefix <path>] [-before <entry name>]",
+		"Displays the most recent entries of the topology audit log, oldest first. Changes are only recorded by processes started with -topo_audit, and are kept for the -topo_audit_retention of vtctld."})
 }
 
 // DecodeContent uses the filename to imply a type, and proto-decodes
@@ -175,6 +194,77 @@ func copyFileToTopo(ctx context.Context, ts *topo.Server, cell, from, to string)
 	return err
 }
 
+func commandExportTopo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("ExportTopo: need a destination file")
+	}
+	export, err := helpers.ExportTopo(ctx, wr.TopoServer())
+	if err != nil {
+		return fmt.Errorf("ExportTopo: %v", err)
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(subFlags.Arg(0), data, 0644); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Exported %v cells, %v keyspaces and %v tablets to %v\n", len(export.Cells), len(export.Keyspaces), len(export.Tablets), subFlags.Arg(0))
+	return nil
+}
+
+func commandImportTopo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "do not rebuild the serving graph after the import")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("ImportTopo: need a source file")
+	}
+	data, err := ioutil.ReadFile(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	export := &helpers.TopoExport{}
+	if err := json.Unmarshal(data, export); err != nil {
+		return fmt.Errorf("ImportTopo: cannot parse %v: %v", subFlags.Arg(0), err)
+	}
+	if err := helpers.ImportTopo(ctx, wr.TopoServer(), export); err != nil {
+		return fmt.Errorf("ImportTopo: %v", err)
+	}
+	wr.Logger().Printf("Imported %v cells, %v keyspaces and %v tablets from %v\n", len(export.Cells), len(export.Keyspaces), len(export.Tablets), subFlags.Arg(0))
+	if *skipRebuild {
+		return nil
+	}
+
+	for keyspace := range export.Keyspaces {
+		if err := wr.RebuildKeyspaceGraph(ctx, keyspace, nil); err != nil {
+			return fmt.Errorf("ImportTopo: RebuildKeyspaceGraph(%v) failed: %v", keyspace, err)
+		}
+	}
+	return wr.TopoServer().RebuildSrvVSchema(ctx, nil)
+}
+
+func commandGetTopoAuditLog(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	limit := subFlags.Int("limit", 100, "maximum number of entries to display, 0 for all")
+	pathPrefix := subFlags.String("path_prefix", "", "only display the changes to the paths that start with this prefix, like keyspaces/<keyspace>/shards/<shard>")
+	before := subFlags.String("before", "", "only display the entries older than the entry with this name, to page through the log")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("GetTopoAuditLog command takes no parameter")
+	}
+	entries, err := wr.TopoServer().GetAuditLog(ctx, *pathPrefix, *before, *limit)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), entries)
+}
+
 type TopologyDecoder interface {
 	decode([]string, topo.Conn, context.Context, *wrangler.Wrangler, bool) error
 }
@@ -105,6 +105,7 @@ import (
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/olekukonko/tablewriter"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/flagutil"
@@ -115,6 +116,7 @@ import (
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -124,6 +126,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -206,6 +209,9 @@ var commands = []commandGroup{
 			{"IgnoreHealthError", commandIgnoreHealthError,
 				"<tablet alias> <ignore regexp>",
 				"Sets the regexp for health check errors to ignore on the specified tablet. The pattern has implicit ^$ anchors. Set to empty string or restart vttablet to stop ignoring anything."},
+			{"ResizeTxPool", commandResizeTxPool,
+				"<tablet alias> <size>",
+				"Changes the size of the transaction pool on the specified tablet. Growing the pool is limited by -queryserver-config-transaction-max-cap on the tablet. Shrinking the pool waits for in-flight transactions to finish."},
 			{"Sleep", commandSleep,
 				"<tablet alias> <duration>",
 				"Blocks the action queue on the specified tablet for the specified amount of time. This is typically used for testing."},
@@ -299,6 +305,9 @@ var commands = []commandGroup{
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
+			{"SetKeyspaceDDLStrategy", commandSetKeyspaceDDLStrategy,
+				"<keyspace name> <strategy>",
+				"Changes how the tablets of the keyspace apply the schema changes of ApplySchema. The strategy is either 'direct', to run the statements, 'online', to run each ALTER TABLE as an online DDL migration in the background, or 'declarative', to change the tables into the ones created by the CREATE TABLE statements."},
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
@@ -309,10 +318,14 @@ var commands = []commandGroup{
 				"[-ping-tablets] <keyspace name>",
 				"Validates that all nodes reachable from the specified keyspace are consistent."},
 			{"Reshard", commandReshard,
-				"[-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
-				"Start a Resharding process. Example: Reshard ks.workflow001 '0' '-80,80-'"},
+				"[-skip_schema_copy] [-compression=<compressor>] [-skip_vdiff] [-copy_check_interval=10s] [-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=master,replica,rdonly] [-filtered_replication_wait_time=30s] [-max_replication_lag=30s] [Start|Show|SwitchReads|SwitchWrites] <keyspace.workflow> [<source_shards> <target_shards>]",
+				"Start a Resharding process. Example: Reshard ks.workflow001 '0' '-80,80-'\n" +
+					"With an action, the whole resharding is run as a workflow whose state is saved in the topology:\n" +
+					"Start creates the missing target shards, starts the vreplication streams, waits for the copy to be done and runs VDiff. After a failure, it can be run again to resume the workflow from its last completed step. Example: Reshard Start ks.workflow001 '0' '-80,80-'\n" +
+					"SwitchReads switches the rdonly and replica traffic, and then SwitchWrites the master traffic, after checking that the streams run without error and lag at most -max_replication_lag. SwitchWrites is refused if the last VDiff found differences.\n" +
+					"Show displays the state of the workflow and the VDiff report of each table."},
 			{"MoveTables", commandMoveTables,
-				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] [-compression=<compressor>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{""column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{""column": "id2", "name": "hash"}]}}`},
 			{"CreateLookupVindex", commandCreateLookupVindex,
 				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
@@ -320,9 +333,12 @@ var commands = []commandGroup{
 			{"ExternalizeVindex", commandExternalizeVindex,
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
+			{"BackfillLookupVindex", commandBackfillLookupVindex,
+				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] [-poll_interval=<duration>] <keyspace> <json_spec>",
+				`Create a lookup vindex, wait for its backfill to complete, verify that the lookup table covers the source table and externalize the vindex. The json_spec is the same as for CreateLookupVindex. If the command is interrupted, the backfill continues and the vindex can be externalized later with ExternalizeVindex.`},
 			{"Materialize", commandMaterialize,
 				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec."},
+				"Performs materialization based on the json spec. To materialize from a MySQL server outside of Vitess, set external_mysql to its name in the vreplication_external_mysql_config file of the target tablets instead of source_keyspace."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -330,8 +346,17 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-chunk_size=<rows>] [-checkpoint_file=<path>] [-resume] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
+			{"PauseWorkflow", commandPauseWorkflow,
+				"<keyspace.workflow>",
+				"Pauses the vreplication streams of the workflow on the target shards. The streams stop between two transactions, and their positions are displayed."},
+			{"ResumeWorkflow", commandResumeWorkflow,
+				"<keyspace.workflow>",
+				"Resumes the vreplication streams of the workflow paused by PauseWorkflow."},
+			{"CopyProgress", commandCopyProgress,
+				"[-json] <keyspace.workflow>",
+				"Displays the progress of the copy phase of the workflow: the rows copied, the estimated total, the throughput and the estimated time left of the tables which remain to be copied on the target shards."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -342,8 +367,8 @@ var commands = []commandGroup{
 				"[-cells=c1,c2,...] [-reverse] -tablet_type={replica|rdonly} <keyspace.workflow>",
 				"Switch read traffic for the specified workflow."},
 			{"SwitchWrites", commandSwitchWrites,
-				"[-filtered_replication_wait_time=30s] [-cancel] [-reverse_replication=false] <keyspace.workflow>",
-				"Switch write traffic for the specified workflow."},
+				"[-filtered_replication_wait_time=30s] [-cancel] [-reverse_replication=false] [-max_replication_lag=30s] [-vdiff_tablet_types=master,replica,rdonly] [-skip_prechecks] <keyspace.workflow>",
+				"Switch write traffic for the specified workflow. For MoveTables workflows, it first checks that the streams run without error and lag at most -max_replication_lag, that the replicas of the target shards have no errant GTIDs, that VDiff finds no difference and that the target shards are healthy. For resharding workflows, it checks that VDiff finds no difference: the report of the last VDiff of a workflow run with the Reshard actions is used, otherwise VDiff is run. If a check fails, the writes are not switched and the report of the checks is displayed. -skip_prechecks switches the writes without running the checks."},
 			{"CancelResharding", commandCancelResharding,
 				"<keyspace/shard>",
 				"Permanently cancels a resharding in progress. All resharding related metadata will be deleted."},
@@ -363,8 +388,8 @@ var commands = []commandGroup{
 	{
 		"Generic", []command{
 			{"Validate", commandValidate,
-				"[-ping-tablets]",
-				"Validates that all nodes reachable from the global replication graph and that all tablets in all discoverable cells are consistent."},
+				"[-ping-tablets] [-repair [-dry_run]]",
+				"Validates that all nodes reachable from the global replication graph and that all tablets in all discoverable cells are consistent. With -repair, first deletes orphaned tablet records, removes dangling shard references and rebuilds missing SrvKeyspace records; -dry_run only lists these changes."},
 			{"ListAllTablets", commandListAllTablets,
 				"<cell name1>, <cell name2>, ...",
 				"Lists all tablets in an awk-friendly way."},
@@ -396,9 +421,18 @@ var commands = []commandGroup{
 			{"ValidateSchemaKeyspace", commandValidateSchemaKeyspace,
 				"[-exclude_tables=''] [-include-views] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
+			{"GetSchemaDrift", commandGetSchemaDrift,
+				"[-exclude_tables=''] [-include-views] <keyspace name>",
+				"Compares the table definitions of the masters of all the shards of the keyspace. Outputs a JSON list of the tables which differ, with their distinct definitions and the shards which have each of them."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-dry_run] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. If -dry_run is set, the changes are only tried on a scratch copy of the schema, and a JSON report of the affected tables, their estimated row counts and the big schema changes is displayed."},
+			{"ApplyDeclarativeSchema", commandApplyDeclarativeSchema,
+				"[-allow_drop] [-dry_run] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Changes the schema of every shard of the keyspace into the one created by the given CREATE TABLE statements. The ALTER, CREATE and DROP statements which make the changes are computed against the schema of each master, and applied on the master once the changes of all the shards are valid. A shard which fails doesn't stop the others. Tables which are not created by the statements are only dropped if -allow_drop is set. If -dry_run is set, the statements are only displayed."},
+			{"OnlineDDL", commandOnlineDDL,
+				"[-strategy=gh-ost] <keyspace/shard> {submit <sql> || show [<uuid>] || cancel <uuid> || retry <uuid>}",
+				"Manages the online DDL migrations of the master of a shard. submit queues an ALTER TABLE, to be run by gh-ost or pt-osc according to -strategy, and displays the uuid of the migration. show displays the migration with the given uuid, or all the migrations of the shard. cancel cancels a queued or running migration, and retry queues a failed or cancelled migration again."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-wait_slave_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -1045,6 +1079,28 @@ func commandWaitForDrain(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		*retryDelay, *HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout, *initialWait)
 }
 
+func commandResizeTxPool(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <tablet alias> and <size> arguments are required for the ResizeTxPool command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	size, err := strconv.ParseInt(subFlags.Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid size %q: %v", subFlags.Arg(1), err)
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return wr.TabletManagerClient().ResizeTxPool(ctx, tabletInfo.Tablet, size)
+}
+
 func commandSleep(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1738,6 +1794,16 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceDDLStrategy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <strategy> arguments are required for the SetKeyspaceDDLStrategy command")
+	}
+	return wr.SetKeyspaceDDLStrategy(ctx, subFlags.Arg(0), subFlags.Arg(1))
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -1802,25 +1868,94 @@ func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlag
 
 func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Skip copying of schema to targets")
+	compression := subFlags.String("compression", "", "gRPC compressor of the events streamed from the sources, like zstd. Default: no compression.")
+	skipVDiff := subFlags.Bool("skip_vdiff", false, "With Start, don't run VDiff once the copy is done, and allow switching the traffic without it")
+	copyCheckInterval := subFlags.Duration("copy_check_interval", 10*time.Second, "With Start, how often the copy progress is checked and displayed")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 30*time.Second, "With SwitchReads and SwitchWrites, the largest lag of the streams which allows switching the traffic")
+	sourceCell := subFlags.String("source_cell", "", "With Start, the source cell VDiff compares from")
+	targetCell := subFlags.String("target_cell", "", "With Start, the target cell VDiff compares with")
+	tabletTypes := subFlags.String("tablet_types", "master,replica,rdonly", "With Start, the tablet types VDiff uses for source and target")
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "With Start and SwitchWrites, the maximum time to wait for filtered replication to catch up")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
-	if subFlags.NArg() != 3 {
-		return fmt.Errorf("three arguments are required: <keyspace.workflow>, source_shards, target_shards")
+
+	// Without an action, only start the streams.
+	if subFlags.NArg() == 3 && strings.Contains(subFlags.Arg(0), ".") {
+		keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+		if err != nil {
+			return err
+		}
+		source := strings.Split(subFlags.Arg(1), ",")
+		target := strings.Split(subFlags.Arg(2), ",")
+		return wr.Reshard(ctx, keyspace, workflow, source, target, *skipSchemaCopy, *compression)
 	}
-	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("an action and <keyspace.workflow> are required, or <keyspace.workflow>, source_shards and target_shards")
+	}
+	action := subFlags.Arg(0)
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(1))
 	if err != nil {
 		return err
 	}
-	source := strings.Split(subFlags.Arg(1), ",")
-	target := strings.Split(subFlags.Arg(2), ",")
-	return wr.Reshard(ctx, keyspace, workflow, source, target, *skipSchemaCopy)
+	opts := &wrangler.ReshardWorkflowOptions{
+		CopyCheckInterval:           *copyCheckInterval,
+		SourceCell:                  *sourceCell,
+		TargetCell:                  *targetCell,
+		TabletTypes:                 *tabletTypes,
+		FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+		HealthCheckTopologyRefresh:  *HealthCheckTopologyRefresh,
+		HealthCheckRetryDelay:       *HealthcheckRetryDelay,
+		HealthCheckTimeout:          *HealthCheckTimeout,
+		MaxReplicationLag:           *maxReplicationLag,
+	}
+	if action == "Start" {
+		if subFlags.NArg() != 4 {
+			return fmt.Errorf("Start requires <keyspace.workflow>, source_shards and target_shards")
+		}
+		source := strings.Split(subFlags.Arg(2), ",")
+		target := strings.Split(subFlags.Arg(3), ",")
+		return wr.StartReshardWorkflow(ctx, keyspace, workflow, source, target, *skipSchemaCopy, *compression, *skipVDiff, opts)
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("%v only requires <keyspace.workflow>", action)
+	}
+	switch action {
+	case "Show":
+	case "SwitchReads":
+		return wr.ReshardWorkflowSwitchReads(ctx, keyspace, workflow, opts)
+	case "SwitchWrites":
+		return wr.ReshardWorkflowSwitchWrites(ctx, keyspace, workflow, opts)
+	default:
+		return fmt.Errorf("unknown Reshard action %v, expected one of Start, Show, SwitchReads, SwitchWrites", action)
+	}
+
+	rwi, err := wr.TopoServer().GetReshardWorkflow(ctx, keyspace, workflow)
+	if err != nil {
+		return fmt.Errorf("GetReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	}
+	wr.Logger().Printf("Workflow %v.%v: %v\n", keyspace, workflow, rwi.State)
+	wr.Logger().Printf("Source shards: %v\n", strings.Join(rwi.SourceShards, ","))
+	wr.Logger().Printf("Target shards: %v\n", strings.Join(rwi.TargetShards, ","))
+	if rwi.SkipVdiff {
+		wr.Logger().Printf("VDiff: skipped\n")
+	}
+	for _, tv := range rwi.Validation {
+		wr.Logger().Printf("VDiff %v: %v rows processed, %v matching, %v mismatched, %v extra on the source, %v extra on the target\n",
+			tv.Table, tv.ProcessedRows, tv.MatchingRows, tv.MismatchedRows, tv.ExtraRowsSource, tv.ExtraRowsTarget)
+	}
+	if rwi.LastError != "" {
+		wr.Logger().Printf("Last error: %v\n", rwi.LastError)
+	}
+	return nil
 }
 
 func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	workflow := subFlags.String("workflow", "", "Workflow name. Will be used to later migrate traffic.")
 	cell := subFlags.String("cell", "", "Cell to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	compression := subFlags.String("compression", "", "gRPC compressor of the events streamed from the sources, like zstd. Default: no compression.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1833,7 +1968,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	source := subFlags.Arg(0)
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
-	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cell, *tabletTypes)
+	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cell, *tabletTypes, *compression)
 }
 
 func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -1853,6 +1988,24 @@ func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFl
 	return wr.CreateLookupVindex(ctx, keyspace, specs, *cell, *tabletTypes)
 }
 
+func commandBackfillLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", "", "Cell to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	pollInterval := subFlags.Duration("poll_interval", 5*time.Second, "How often to check and report the progress of the backfill.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are required: keyspace and json_spec")
+	}
+	keyspace := subFlags.Arg(0)
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
+		return err
+	}
+	return wr.BackfillLookupVindex(ctx, keyspace, specs, *cell, *tabletTypes, *pollInterval)
+}
+
 func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1909,6 +2062,9 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	tabletTypes := subFlags.String("tablet_types", "master,replica,rdonly", "Tablet types for source and target")
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
+	chunkSize := subFlags.Int64("chunk_size", 0, "If set, diffs every table in chunks of at most this many rows per shard, each chunk with its own snapshot, instead of in one pass")
+	checkpointFile := subFlags.String("checkpoint_file", "", "If set, the progress of the diff is saved to this file on the vtctld after every chunk")
+	resume := subFlags.Bool("resume", false, "Resumes the diff saved in -checkpoint_file instead of starting over, with its chunk size if -chunk_size is not set")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1922,10 +2078,93 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	}
 
 	_, err = wr.VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime,
-		*HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout, *format)
+		*HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout, *format, *chunkSize, *checkpointFile, *resume)
 	return err
 }
 
+func commandPauseWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("<keyspace.workflow> is required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.PauseWorkflow(ctx, keyspace, workflow)
+}
+
+func commandResumeWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("<keyspace.workflow> is required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.ResumeWorkflow(ctx, keyspace, workflow)
+}
+
+func commandCopyProgress(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	jsonOutput := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("<keyspace.workflow> is required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	progress, err := wr.WorkflowCopyProgress(ctx, keyspace, workflow)
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(wr.Logger(), progress)
+	}
+
+	shards := make([]string, 0, len(progress))
+	for shard := range progress {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	table := tablewriter.NewWriter(loggerWriter{wr.Logger()})
+	table.SetAutoFormatHeaders(false)
+	table.SetHeader([]string{"Shard", "Stream", "Table", "Rows Copied", "Estimated Rows", "Rows/s", "ETA"})
+	for _, shard := range shards {
+		for _, stream := range progress[shard] {
+			for _, tp := range stream.Tables {
+				rowsTotal, eta := "unknown", "unknown"
+				if tp.RowsTotal != 0 {
+					rowsTotal = strconv.FormatInt(tp.RowsTotal, 10)
+				}
+				if tp.EtaSeconds >= 0 {
+					eta = (time.Duration(tp.EtaSeconds) * time.Second).String()
+				}
+				table.Append([]string{
+					shard,
+					strconv.FormatInt(stream.Id, 10),
+					tp.Table,
+					strconv.FormatInt(tp.RowsCopied, 10),
+					rowsTotal,
+					strconv.FormatFloat(tp.RowsPerSecond, 'f', 1, 64),
+					eta,
+				})
+			}
+		}
+	}
+	table.Render()
+	return nil
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2029,6 +2268,9 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
 	reverseReplication := subFlags.Bool("reverse_replication", true, "Also reverse the replication")
 	cancelMigrate := subFlags.Bool("cancel", false, "Cancel the failed migration and serve from source")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 30*time.Second, "For MoveTables workflows, the largest lag of the streams which allows switching the writes")
+	vdiffTabletTypes := subFlags.String("vdiff_tablet_types", "master,replica,rdonly", "For MoveTables and resharding workflows, the tablet types VDiff uses for source and target")
+	skipPrechecks := subFlags.Bool("skip_prechecks", false, "For MoveTables and resharding workflows, switch the writes without running the checks")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2041,6 +2283,26 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 
+	if !*cancelMigrate && !*skipPrechecks {
+		report, err := wr.CheckSwitchWrites(ctx, keyspace, workflow, &wrangler.SwitchWritesPrecheckParams{
+			MaxReplicationLag:           *maxReplicationLag,
+			VDiffTabletTypes:            *vdiffTabletTypes,
+			FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+			HealthCheckTopologyRefresh:  *HealthCheckTopologyRefresh,
+			HealthCheckRetryDelay:       *HealthcheckRetryDelay,
+			HealthCheckTimeout:          *HealthCheckTimeout,
+		})
+		if err != nil {
+			return err
+		}
+		if !report.Passed() {
+			if err := printJSON(wr.Logger(), report); err != nil {
+				return err
+			}
+			return fmt.Errorf("the checks of workflow %v.%v failed, its writes are not switched: fix the problems above and retry, or use -skip_prechecks", keyspace, workflow)
+		}
+	}
+
 	journalID, err := wr.SwitchWrites(ctx, keyspace, workflow, *filteredReplicationWaitTime, *cancelMigrate, *reverseReplication)
 	if err != nil {
 		return err
@@ -2097,6 +2359,8 @@ func commandFindAllShardsInKeyspace(ctx context.Context, wr *wrangler.Wrangler,
 
 func commandValidate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	pingTablets := subFlags.Bool("ping-tablets", false, "Indicates whether all tablets should be pinged during the validation process")
+	repair := subFlags.Bool("repair", false, "Fixes the inconsistencies that can be repaired automatically before validating")
+	dryRun := subFlags.Bool("dry_run", false, "With -repair, only lists the changes that would be made")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2104,6 +2368,14 @@ func commandValidate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	if subFlags.NArg() != 0 {
 		wr.Logger().Warningf("action Validate doesn't take any parameter any more")
 	}
+	if *dryRun && !*repair {
+		return fmt.Errorf("-dry_run requires -repair")
+	}
+	if *repair {
+		if err := wr.RepairTopology(ctx, *dryRun); err != nil {
+			return err
+		}
+	}
 	return wr.Validate(ctx, *pingTablets)
 }
 
@@ -2272,8 +2544,34 @@ func commandValidateSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, s
 	return wr.ValidateSchemaKeyspace(ctx, keyspace, excludeTableArray, *includeViews)
 }
 
+func commandGetSchemaDrift(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
+	includeViews := subFlags.Bool("include-views", false, "Includes views in the comparison")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace name> argument is required for the GetSchemaDrift command")
+	}
+
+	keyspace := subFlags.Arg(0)
+	var excludeTableArray []string
+	if *excludeTables != "" {
+		excludeTableArray = strings.Split(*excludeTables, ",")
+	}
+	drift, err := wr.SchemaDriftKeyspace(ctx, keyspace, excludeTableArray, *includeViews)
+	if err != nil {
+		return err
+	}
+	if drift == nil {
+		drift = []*tmutils.TableDrift{}
+	}
+	return printJSON(wr.Logger(), drift)
+}
+
 func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	dryRun := subFlags.Bool("dry_run", false, "Only display a report of what the SQL commands would do, without applying them")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitSlaveTimeout, "The amount of time to wait for slaves to receive the schema change via replication.")
@@ -2294,6 +2592,13 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	if *dryRun {
+		report, err := schemamanager.DryRun(ctx, schemamanager.NewPlainController(change, keyspace), executor)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), report)
+	}
 	return schemamanager.Run(
 		ctx,
 		schemamanager.NewPlainController(change, keyspace),
@@ -2301,6 +2606,85 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandApplyDeclarativeSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	allowDrop := subFlags.Bool("allow_drop", false, "Drop the tables which are not created by the statements")
+	dryRun := subFlags.Bool("dry_run", false, "Only display the statements which would change the schema")
+	sql := subFlags.String("sql", "", "A list of semicolon-delimited CREATE TABLE statements")
+	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the CREATE TABLE statements")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyDeclarativeSchema command")
+	}
+
+	keyspace := subFlags.Arg(0)
+	schema, err := getFileParam(*sql, *sqlFile, "sql")
+	if err != nil {
+		return err
+	}
+	pieces, err := sqlparser.SplitStatementToPieces(schema)
+	if err != nil {
+		return err
+	}
+	var desired []string
+	for _, piece := range pieces {
+		if s := strings.TrimSpace(piece); s != "" {
+			desired = append(desired, s)
+		}
+	}
+	return wr.ApplyDeclarativeSchema(ctx, keyspace, desired, *allowDrop, *dryRun)
+}
+
+func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	strategy := subFlags.String("strategy", "gh-ost", "The tool which runs a submitted migration: gh-ost or pt-osc")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <keyspace/shard> and <action> arguments are required for the OnlineDDL command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	action, arg := subFlags.Arg(1), subFlags.Arg(2)
+	switch action {
+	case "submit":
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("the <sql> argument is required for OnlineDDL submit")
+		}
+		uuid, err := wr.SubmitOnlineDDL(ctx, keyspace, shard, arg, *strategy)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v\n", uuid)
+		return nil
+	case "show":
+		if subFlags.NArg() > 3 {
+			return fmt.Errorf("OnlineDDL show takes at most one <uuid> argument")
+		}
+		migrations, err := wr.GetOnlineDDLMigrations(ctx, keyspace, shard, arg)
+		if err != nil {
+			return err
+		}
+		if migrations == nil {
+			migrations = []*tabletmanagerdatapb.OnlineDDLMigration{}
+		}
+		return printJSON(wr.Logger(), migrations)
+	case "cancel", "retry":
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("the <uuid> argument is required for OnlineDDL %v", action)
+		}
+		if action == "cancel" {
+			return wr.CancelOnlineDDL(ctx, keyspace, shard, arg)
+		}
+		return wr.RetryOnlineDDL(ctx, keyspace, shard, arg)
+	default:
+		return fmt.Errorf("unknown OnlineDDL action %v, want one of submit, show, cancel or retry", action)
+	}
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -76,11 +77,25 @@ func handleAPI(apiPath string, handlerFunc func(w http.ResponseWriter, r *http.R
 	})
 }
 
+// accessDeniedError is returned by the collection handlers
+// when the caller is not allowed to perform a request.
+type accessDeniedError struct {
+	err error
+}
+
+func (e accessDeniedError) Error() string {
+	return e.err.Error()
+}
+
 func handleCollection(collection string, getFunc func(*http.Request) (interface{}, error)) {
 	handleAPI(collection+"/", func(w http.ResponseWriter, r *http.Request) error {
 		// Get the requested object.
 		obj, err := getFunc(r)
 		if err != nil {
+			if ade, ok := err.(accessDeniedError); ok {
+				acl.SendError(w, ade.err)
+				return nil
+			}
 			if topo.IsErrType(err, topo.NoNode) {
 				http.NotFound(w, r)
 				return nil
@@ -134,6 +149,99 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		return ts.GetKnownCells(ctx)
 	})
 
+	// Cells aliases
+	handleCollection("cells_aliases", func(r *http.Request) (interface{}, error) {
+		alias := getItemPath(r.URL.Path)
+		if r.Method != "GET" {
+			// Only admins can modify the cells aliases.
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return nil, accessDeniedError{err}
+			}
+		}
+		switch r.Method {
+		case "GET":
+			aliases, err := ts.GetCellsAliases(ctx, true /*strongRead*/)
+			if err != nil {
+				return nil, err
+			}
+			// List all cells aliases.
+			if alias == "" {
+				if aliases == nil {
+					aliases = make(map[string]*topodatapb.CellsAlias)
+				}
+				return aliases, nil
+			}
+			ca, ok := aliases[alias]
+			if !ok {
+				return nil, topo.NewError(topo.NoNode, alias)
+			}
+			return ca, nil
+		case "POST":
+			// Create or update a cells alias.
+			if alias == "" {
+				return nil, errors.New("a POST request needs a cells alias in the URL")
+			}
+			ca := &topodatapb.CellsAlias{}
+			if err := unmarshalRequest(r, ca); err != nil {
+				return nil, fmt.Errorf("can't unmarshal request: %v", err)
+			}
+			if err := ts.UpdateCellsAlias(ctx, alias, func(toCA *topodatapb.CellsAlias) error {
+				*toCA = *ca
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+			return ca, nil
+		case "DELETE":
+			if alias == "" {
+				return nil, errors.New("a DELETE request needs a cells alias in the URL")
+			}
+			return nil, ts.DeleteCellsAlias(ctx, alias)
+		default:
+			return nil, fmt.Errorf("unsupported HTTP method: %v", r.Method)
+		}
+	})
+
+	// Topology audit log
+	handleCollection("topo_audit", func(r *http.Request) (interface{}, error) {
+		if getItemPath(r.URL.Path) != "" {
+			return nil, errors.New("the topology audit log can only be listed, not retrieved")
+		}
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		limit := 100
+		if l := r.FormValue("limit"); l != "" {
+			var err error
+			if limit, err = strconv.Atoi(l); err != nil {
+				return nil, fmt.Errorf("invalid limit %q: %v", l, err)
+			}
+		}
+		entries, err := ts.GetAuditLog(ctx, r.FormValue("path_prefix"), r.FormValue("before"), limit)
+		if err != nil {
+			return nil, err
+		}
+		if entries == nil {
+			entries = []*topo.AuditEntry{}
+		}
+		return entries, nil
+	})
+
+	// Serving cells
+	handleCollection("serving_cells", func(r *http.Request) (interface{}, error) {
+		// Valid requests: api/serving_cells/<cell>/<tablet type>
+		itemPath := getItemPath(r.URL.Path)
+		parts := strings.Split(itemPath, "/")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid serving_cells path: %q  expected path: /serving_cells/<cell>/<tablet type>", itemPath)
+		}
+		tabletType, err := topoproto.ParseTabletType(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		return ts.GetServingCells(ctx, parts[0], tabletType)
+	})
+
 	// Keyspaces
 	handleCollection("keyspaces", func(r *http.Request) (interface{}, error) {
 		keyspace := getItemPath(r.URL.Path)
@@ -139,6 +139,20 @@ func TestAPI(t *testing.T) {
 		// Cells
 		{"GET", "cells", "", `["cell1","cell2"]`},
 
+		// Cells aliases
+		{"GET", "cells_aliases", "", `{}`},
+		{"POST", "cells_aliases/region1", `{"cells": ["cell1", "cell2"]}`, `{"cells": ["cell1", "cell2"]}`},
+		{"GET", "cells_aliases", "", `{"region1": {"cells": ["cell1", "cell2"]}}`},
+		{"GET", "cells_aliases/region1", "", `{"cells": ["cell1", "cell2"]}`},
+		{"GET", "cells_aliases/region2", "", "404 page not found"},
+		{"GET", "serving_cells/cell1/replica", "", `["cell1", "cell2"]`},
+		{"GET", "serving_cells/cell2/master", "", `["cell1", "cell2"]`},
+		{"GET", "serving_cells/cell1", "", "can't get serving_cells: invalid serving_cells path"},
+
+		// Topology audit log
+		{"GET", "topo_audit", "", `[]`},
+		{"GET", "topo_audit/1", "", "can't get topo_audit: the topology audit log can only be listed, not retrieved"},
+
 		// Keyspaces
 		{"GET", "keyspaces", "", `["ks1", "ks3"]`},
 		{"GET", "keyspaces/ks1", "", `{
@@ -147,7 +161,8 @@ func TestAPI(t *testing.T) {
 				"served_froms": [],
                                 "keyspace_type":0,
                                 "base_keyspace":"",
-                                "snapshot_time":null
+                                "snapshot_time":null,
+                                "ddl_strategy":""
 			}`},
 		{"GET", "keyspaces/nonexistent", "", "404 page not found"},
 		{"POST", "keyspaces/ks1?action=TestKeyspaceAction", "", `{
@@ -286,11 +301,11 @@ func TestAPI(t *testing.T) {
 		// vtctl RunCommand
 		{"POST", "vtctl/", `["GetKeyspace","ks1"]`, `{
 		   "Error": "",
-		   "Output": "{\n  \"sharding_column_name\": \"shardcol\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 0,\n  \"base_keyspace\": \"\",\n  \"snapshot_time\": null\n}\n\n"
+		   "Output": "{\n  \"sharding_column_name\": \"shardcol\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 0,\n  \"base_keyspace\": \"\",\n  \"snapshot_time\": null,\n  \"ddl_strategy\": \"\"\n}\n\n"
 		}`},
 		{"POST", "vtctl/", `["GetKeyspace","ks3"]`, `{
 		   "Error": "",
-		   "Output": "{\n  \"sharding_column_name\": \"\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 1,\n  \"base_keyspace\": \"ks1\",\n  \"snapshot_time\": {\n    \"seconds\": \"1136214245\",\n    \"nanoseconds\": 0\n  }\n}\n\n"
+		   "Output": "{\n  \"sharding_column_name\": \"\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 1,\n  \"base_keyspace\": \"ks1\",\n  \"snapshot_time\": {\n    \"seconds\": \"1136214245\",\n    \"nanoseconds\": 0\n  },\n  \"ddl_strategy\": \"\"\n}\n\n"
 		}`},
 		{"POST", "vtctl/", `["GetVSchema","ks3"]`, `{
 		   "Error": "",
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	schemaDriftCheckInterval = flag.Duration("schema_drift_check_interval", 0, "If set, vtctld compares the table definitions across the shards of every keyspace at this interval, and exports the number of drifted shards of each table in the SchemaDrift gauge.")
+
+	schemaDrift = stats.NewGaugesWithMultiLabels(
+		"SchemaDrift",
+		"Number of shards whose definition of the table differs from the most common one",
+		[]string{"Keyspace", "Table"})
+)
+
+// schemaDriftChecker periodically compares the table definitions
+// across the shards of every keyspace.
+type schemaDriftChecker struct {
+	wr      *wrangler.Wrangler
+	timeout time.Duration
+
+	// reported maps the drifted tables of the last check to their
+	// labels, to reset the ones which aren't drifted anymore.
+	reported map[string][]string
+}
+
+func initSchemaDriftChecker(ts *topo.Server) {
+	if *schemaDriftCheckInterval == 0 {
+		return
+	}
+	checker := newSchemaDriftChecker(wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient()), *schemaDriftCheckInterval)
+	ticks := timer.NewTimer(*schemaDriftCheckInterval)
+	ticks.Start(func() {
+		checker.check(context.Background())
+	})
+	servenv.OnTerm(ticks.Stop)
+}
+
+func newSchemaDriftChecker(wr *wrangler.Wrangler, timeout time.Duration) *schemaDriftChecker {
+	return &schemaDriftChecker{
+		wr:       wr,
+		timeout:  timeout,
+		reported: make(map[string][]string),
+	}
+}
+
+// check compares the schemas of the keyspaces and updates the gauge.
+// The gauge of a keyspace which can't be checked keeps its values.
+func (c *schemaDriftChecker) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	keyspaces, err := c.wr.TopoServer().GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("Could not list the keyspaces to check the schema drift: %v", err)
+		return
+	}
+
+	current := make(map[string][]string)
+	for _, keyspace := range keyspaces {
+		drift, err := c.wr.SchemaDriftKeyspace(ctx, keyspace, nil, false)
+		if err != nil {
+			log.Warningf("Could not check the schema drift of keyspace %v: %v", keyspace, err)
+			for key, labels := range c.reported {
+				if labels[0] == keyspace {
+					current[key] = labels
+				}
+			}
+			continue
+		}
+		for _, td := range drift {
+			labels := []string{keyspace, td.Table}
+			schemaDrift.Set(labels, int64(td.DriftedShards()))
+			current[keyspace+"."+td.Table] = labels
+		}
+	}
+	for key, labels := range c.reported {
+		if _, ok := current[key]; !ok {
+			schemaDrift.Reset(labels)
+		}
+	}
+	c.reported = current
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/faketmclient"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// schemaTabletManagerClient returns the schemas of the tablets
+// by their uid.
+type schemaTabletManagerClient struct {
+	tmclient.TabletManagerClient
+	schemas map[uint32]*tabletmanagerdatapb.SchemaDefinition
+}
+
+func (client *schemaTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return client.schemas[tablet.Alias.Uid], nil
+}
+
+func TestSchemaDriftChecker(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "driftks", &topodatapb.Keyspace{}))
+	for i, shard := range []string{"-80", "80-"} {
+		require.NoError(t, ts.CreateShard(ctx, "driftks", shard))
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uint32(100 + i)},
+			Keyspace: "driftks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		_, err := ts.UpdateShardFields(ctx, "driftks", shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	t1 := &tabletmanagerdatapb.TableDefinition{Name: "t1", Schema: "create table t1 (id int)"}
+	t1Altered := &tabletmanagerdatapb.TableDefinition{Name: "t1", Schema: "create table t1 (id int, val int)"}
+	t2 := &tabletmanagerdatapb.TableDefinition{Name: "t2", Schema: "create table t2 (id int)"}
+	tmc := &schemaTabletManagerClient{
+		TabletManagerClient: faketmclient.NewFakeTabletManagerClient(),
+		schemas: map[uint32]*tabletmanagerdatapb.SchemaDefinition{
+			100: {TableDefinitions: []*tabletmanagerdatapb.TableDefinition{t1, t2}},
+			101: {TableDefinitions: []*tabletmanagerdatapb.TableDefinition{t1Altered, t2}},
+		},
+	}
+	checker := newSchemaDriftChecker(wrangler.New(logutil.NewConsoleLogger(), ts, tmc), 10*time.Second)
+
+	checker.check(ctx)
+	assert.Equal(t, int64(1), schemaDrift.Counts()["driftks.t1"])
+	_, ok := schemaDrift.Counts()["driftks.t2"]
+	assert.False(t, ok)
+
+	// t1 is altered on the other shard too.
+	tmc.schemas[100] = &tabletmanagerdatapb.SchemaDefinition{TableDefinitions: []*tabletmanagerdatapb.TableDefinition{t1Altered, t2}}
+	checker.check(ctx)
+	assert.Equal(t, int64(0), schemaDrift.Counts()["driftks.t1"])
+	assert.Empty(t, checker.reported)
+}
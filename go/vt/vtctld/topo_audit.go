@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var topoAuditRetention = flag.Duration("topo_audit_retention", 30*24*time.Hour, "how long vtctld keeps the entries of the topology audit log, 0 to keep them forever")
+
+// topoAuditPruneInterval is how often vtctld prunes the audit log.
+const topoAuditPruneInterval = time.Hour
+
+// initTopoAuditPruner periodically deletes the entries of the
+// topology audit log older than -topo_audit_retention. vtctld is the
+// only process that prunes the log, so the processes that write to
+// it don't have to list it.
+func initTopoAuditPruner(ts *topo.Server) {
+	if *topoAuditRetention <= 0 {
+		return
+	}
+	ticks := timer.NewTimer(topoAuditPruneInterval)
+	ticks.Start(func() {
+		pruneTopoAuditLog(context.Background(), ts, time.Now().Add(-*topoAuditRetention))
+	})
+	servenv.OnTerm(ticks.Stop)
+}
+
+func pruneTopoAuditLog(ctx context.Context, ts *topo.Server, cutoff time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+	deleted, err := ts.PruneAuditLog(ctx, cutoff)
+	if err != nil {
+		log.Warningf("Could not prune the topology audit log: %v", err)
+	}
+	if deleted > 0 {
+		log.Infof("Pruned %v entries of the topology audit log recorded before %v", deleted, cutoff)
+	}
+}
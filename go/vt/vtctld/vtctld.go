@@ -179,4 +179,13 @@ func InitVtctld(ts *topo.Server) {
 
 	// Init workflow manager.
 	initWorkflowManager(ts)
+
+	// Init the schema drift checker.
+	initSchemaDriftChecker(ts)
+
+	// Init the topology audit log pruner.
+	initTopoAuditPruner(ts)
+
+	// Init the workflows API and status page.
+	initWorkflowStatus(ts)
 }
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	workflowStatusTimeout  = flag.Duration("workflow_status_timeout", 10*time.Second, "timeout to gather the status of the vreplication workflows from the masters, for the workflows API and status page")
+	workflowStatusCacheTTL = flag.Duration("workflow_status_cache_ttl", 30*time.Second, "how long the status page shows the same status of the vreplication workflows before gathering it again from the masters")
+)
+
+// workflowStatusTemplate lists the workflows, with one row per stream.
+var workflowStatusTemplate = `
+<p>As of {{.Time.Format "2006-01-02 15:04:05"}}. The current status is served by <a href="/api/workflows/">/api/workflows/</a>.</p>
+{{if .ShardErrors}}<p>Could not read the streams of some shards, the workflows may be incomplete:<br>
+{{range .ShardErrors}}{{.}}<br>{{end}}</p>{{end}}
+<table>
+  <tr>
+    <th>Workflow</th>
+    <th>State</th>
+    <th>Max Lag (s)</th>
+    <th>Shard</th>
+    <th>Stream</th>
+    <th>Source</th>
+    <th>Stream State</th>
+    <th>Lag (s)</th>
+    <th>Tables To Copy</th>
+    <th>Last Message</th>
+  </tr>
+  {{range $w := .Workflows}}{{range $i, $s := $w.Streams}}<tr>
+    {{if eq $i 0}}<td rowspan="{{len $w.Streams}}">{{$w.Keyspace}}.{{$w.Workflow}}</td>
+    <td rowspan="{{len $w.Streams}}">{{$w.State}}</td>
+    <td rowspan="{{len $w.Streams}}">{{$w.MaxLagSeconds}}</td>{{end}}
+    <td>{{$s.Shard}}</td>
+    <td>{{$s.Tablet}}/{{$s.ID}}</td>
+    <td>{{$s.Source}}</td>
+    <td>{{$s.State}}</td>
+    <td>{{$s.LagSeconds}}</td>
+    <td>{{range $s.CopyProgress}}{{.Table}}: {{.RowsCopied}}/{{.RowsTotal}}<br>{{end}}</td>
+    <td>{{$s.Message}}</td>
+  </tr>{{end}}{{else}}<tr><td colspan="10">No workflow</td></tr>{{end}}
+</table>
+`
+
+// initWorkflowStatus serves the status of the vreplication workflows of
+// the cluster, as the workflows API and as a part of the status page.
+func initWorkflowStatus(ts *topo.Server) {
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	listAllWorkflows := func(keyspace string) (*wrangler.WorkflowsStatus, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), *workflowStatusTimeout)
+		defer cancel()
+		return wr.ListAllWorkflows(ctx, keyspace)
+	}
+
+	// Valid requests: api/workflows/ and api/workflows/<keyspace>
+	handleCollection("workflows", func(r *http.Request) (interface{}, error) {
+		keyspace := getItemPath(r.URL.Path)
+		if strings.Contains(keyspace, "/") {
+			return nil, errors.New("invalid workflows path, expected: /workflows/ or /workflows/<keyspace>")
+		}
+		return listAllWorkflows(keyspace)
+	})
+
+	// The status page is cached, so loading it doesn't query all the
+	// masters every time.
+	cache := &workflowStatusCache{}
+	servenv.AddStatusPart("VReplication Workflows", workflowStatusTemplate, func() interface{} {
+		return cache.get(*workflowStatusCacheTTL, func() *wrangler.WorkflowsStatus {
+			status, err := listAllWorkflows("")
+			if err != nil {
+				return &wrangler.WorkflowsStatus{ShardErrors: []string{err.Error()}}
+			}
+			return status
+		})
+	})
+}
+
+// workflowStatusCache keeps the status of the workflows for the
+// status page.
+type workflowStatusCache struct {
+	mu     sync.Mutex
+	status *workflowStatusPart
+}
+
+// workflowStatusPart is the status of the workflows at a given time.
+type workflowStatusPart struct {
+	*wrangler.WorkflowsStatus
+	Time time.Time
+}
+
+// get returns the cached status if it's more recent than ttl, and
+// gathers it again with fetch otherwise.
+func (c *workflowStatusCache) get(ttl time.Duration, fetch func() *wrangler.WorkflowsStatus) *workflowStatusPart {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.status == nil || time.Since(c.status.Time) >= ttl {
+		c.status = &workflowStatusPart{
+			WorkflowsStatus: fetch(),
+			Time:            time.Now(),
+		}
+	}
+	return c.status
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+func TestWorkflowStatusCache(t *testing.T) {
+	fetches := 0
+	fetch := func() *wrangler.WorkflowsStatus {
+		fetches++
+		return &wrangler.WorkflowsStatus{ShardErrors: []string{"shard error"}}
+	}
+	cache := &workflowStatusCache{}
+
+	status := cache.get(time.Hour, fetch)
+	assert.Equal(t, 1, fetches)
+	assert.True(t, cache.get(time.Hour, fetch) == status, "the status was not cached")
+	assert.Equal(t, 1, fetches)
+	cache.get(0, fetch)
+	assert.Equal(t, 2, fetches)
+
+	tmpl, err := template.New("workflows").Parse(workflowStatusTemplate)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, tmpl.Execute(buf, status))
+	assert.Contains(t, buf.String(), "shard error")
+	assert.Contains(t, buf.String(), "/api/workflows/")
+	assert.Contains(t, buf.String(), "No workflow")
+}
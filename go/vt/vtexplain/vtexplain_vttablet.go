@@ -133,7 +133,7 @@ func (t *explainTablet) Begin(ctx context.Context, target *querypb.Target, optio
 }
 
 // Commit is part of the QueryService interface.
-func (t *explainTablet) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (t *explainTablet) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
@@ -417,6 +417,15 @@ func initTabletEnvironment(ddls []*sqlparser.DDL, opts *Options) error {
 		Fields: mysql.ShowPrimaryFields,
 		Rows:   indexRows,
 	}
+	schemaQueries[mysql.BaseShowForeignKeys] = &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+	}
+	schemaQueries[mysql.BaseShowGeneratedColumns] = &sqltypes.Result{
+		Fields: mysql.ShowGeneratedColumnsFields,
+	}
+	schemaQueries[mysql.BaseShowPartitions] = &sqltypes.Result{
+		Fields: mysql.ShowPartitionsFields,
+	}
 
 	return nil
 }
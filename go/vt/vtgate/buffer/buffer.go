@@ -48,8 +48,20 @@ var (
 	bufferFullError      = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "master buffer is full")
 	entryEvictedError    = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "buffer full: request evicted for newer request")
 	contextCanceledError = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "context was canceled before failover finished")
+
+	// ShardMissingError is returned to buffered requests when the shard does
+	// not serve MASTER traffic anymore after the failover e.g. because the
+	// writes of a resharding operation were migrated to the new shards.
+	// The caller should resolve the destination shards again and retry.
+	ShardMissingError = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "destination shard is missing after a resharding operation")
 )
 
+// ShardServingCheck returns false if the given shard no longer serves MASTER
+// traffic according to the serving graph. It is used to detect the end of a
+// resharding cutover (SwitchWrites) because the old shards never get a new
+// MASTER in that case.
+type ShardServingCheck func(keyspace, shard string) bool
+
 // bufferMode specifies how the buffer is configured for a given shard.
 type bufferMode int
 
@@ -79,6 +91,9 @@ type Buffer struct {
 	shards map[string]bool
 	// now returns the current time. Overridden in tests.
 	now func() time.Time
+	// shardServing is optional and detects that a shard was removed from the
+	// serving graph while we buffer its requests.
+	shardServing ShardServingCheck
 
 	// bufferSizeSema limits how many requests can be buffered
 	// ("-buffer_size") and is shared by all shardBuffer instances.
@@ -100,11 +115,13 @@ type Buffer struct {
 }
 
 // New creates a new Buffer object.
-func New() *Buffer {
-	return newWithNow(time.Now)
+// shardServing may be nil. In that case, buffering during a resharding cutover
+// only stops after -buffer_max_failover_duration.
+func New(shardServing ShardServingCheck) *Buffer {
+	return newWithNow(time.Now, shardServing)
 }
 
-func newWithNow(now func() time.Time) *Buffer {
+func newWithNow(now func() time.Time, shardServing ShardServingCheck) *Buffer {
 	if err := verifyFlags(); err != nil {
 		log.Fatalf("Invalid buffer configuration: %v", err)
 	}
@@ -148,6 +165,7 @@ func newWithNow(now func() time.Time) *Buffer {
 		keyspaces:      keyspaces,
 		shards:         shards,
 		now:            now,
+		shardServing:   shardServing,
 		bufferSizeSema: sync2.NewSemaphore(*size, 0),
 		buffers:        make(map[string]*shardBuffer),
 	}
@@ -267,6 +285,17 @@ func causedByFailover(err error) bool {
 	return false
 }
 
+// IsShardMissingError returns true if "err" was caused by a buffered request
+// whose shard disappeared during a resharding cutover. The error may have been
+// wrapped by the caller of WaitForFailoverEnd() and is therefore matched by its
+// message.
+func IsShardMissingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return vterrors.Code(err) == vtrpcpb.Code_UNAVAILABLE && strings.Contains(err.Error(), ShardMissingError.Error())
+}
+
 // getOrCreateBuffer returns the ShardBuffer for the given keyspace and shard.
 // It returns nil if Buffer is shut down and all calls should be ignored.
 func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
@@ -288,7 +317,7 @@ func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
 	// Look it up again because it could have been created in the meantime.
 	sb, ok = b.buffers[key]
 	if !ok {
-		sb = newShardBuffer(b.mode(keyspace, shard), keyspace, shard, b.now, b.bufferSizeSema)
+		sb = newShardBuffer(b.mode(keyspace, shard), keyspace, shard, b.now, b.shardServing, b.bufferSizeSema)
 		b.buffers[key] = sb
 	}
 	return sb
@@ -26,6 +26,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -86,7 +87,7 @@ func TestBuffer(t *testing.T) {
 
 	// Create the buffer.
 	now := time.Now()
-	b := newWithNow(func() time.Time { return now })
+	b := newWithNow(func() time.Time { return now }, nil)
 
 	// Simulate that the current master reports its ExternallyReparentedTimestamp.
 	// vtgate sees this at startup. Additional periodic updates will be sent out
@@ -300,7 +301,7 @@ func TestDryRun(t *testing.T) {
 
 	flag.Set("enable_buffer_dry_run", "true")
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	// Request does not get buffered.
 	if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard, failoverErr); err != nil || retryDone != nil {
@@ -343,7 +344,7 @@ func TestPassthrough(t *testing.T) {
 	flag.Set("enable_buffer", "true")
 	flag.Set("buffer_keyspace_shards", topoproto.KeyspaceShardString(keyspace, shard))
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard, nil); err != nil || retryDone != nil {
 		t.Fatalf("requests with no error must never be buffered. err: %v retryDone: %v", err, retryDone)
@@ -368,7 +369,7 @@ func TestLastReparentTooRecent_BufferingSkipped(t *testing.T) {
 	// Enable the buffer (no explicit whitelist i.e. it applies to everything).
 	defer resetFlagsForTesting()
 	now := time.Now()
-	b := newWithNow(func() time.Time { return now })
+	b := newWithNow(func() time.Time { return now }, nil)
 
 	// Simulate that the old master notified us about its reparented timestamp
 	// very recently (time.Now()).
@@ -412,7 +413,7 @@ func TestLastReparentTooRecent_Buffering(t *testing.T) {
 	// Enable the buffer (no explicit whitelist i.e. it applies to everything).
 	defer resetFlagsForTesting()
 	now := time.Now()
-	b := newWithNow(func() time.Time { return now })
+	b := newWithNow(func() time.Time { return now }, nil)
 
 	// Simulate that the old master notified us about its reparented timestamp
 	// very recently (time.Now()).
@@ -470,7 +471,7 @@ func TestPassthroughDuringDrain(t *testing.T) {
 	flag.Set("enable_buffer", "true")
 	flag.Set("buffer_keyspace_shards", topoproto.KeyspaceShardString(keyspace, shard))
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	// Buffer one request.
 	markRetryDone := make(chan struct{})
@@ -516,7 +517,7 @@ func TestPassthroughIgnoredKeyspaceOrShard(t *testing.T) {
 	flag.Set("enable_buffer", "true")
 	flag.Set("buffer_keyspace_shards", topoproto.KeyspaceShardString(keyspace, shard))
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	ignoredKeyspace := "ignored_ks"
 	if retryDone, err := b.WaitForFailoverEnd(context.Background(), ignoredKeyspace, shard, failoverErr); err != nil || retryDone != nil {
@@ -562,7 +563,7 @@ func testRequestCanceled(t *testing.T, explicitEnd bool) {
 	// Enable buffering for the complete keyspace and not just a specific shard.
 	flag.Set("buffer_keyspace_shards", keyspace)
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 	if !explicitEnd {
 		// Set value after constructor to work-around hardcoded minimum values.
 		flag.Set("buffer_window", "100ms")
@@ -628,6 +629,62 @@ func testRequestCanceled(t *testing.T, explicitEnd bool) {
 	}
 }
 
+// TestShardRemovedByResharding tests the case when a resharding cutover
+// removes the shard from the serving graph while its requests are buffered.
+// No new MASTER will be seen for the shard and the buffered requests must fail
+// with ShardMissingError such that they can be retried on the new shards.
+func TestShardRemovedByResharding(t *testing.T) {
+	resetVariables()
+	defer checkVariables(t)
+
+	flag.Set("enable_buffer", "true")
+	flag.Set("buffer_keyspace_shards", topoproto.KeyspaceShardString(keyspace, shard))
+	flag.Set("buffer_shard_serving_check_interval", "10ms")
+	defer resetFlagsForTesting()
+	var serving sync2.AtomicBool
+	serving.Set(true)
+	b := New(func(ks, s string) bool {
+		if ks != keyspace || s != shard {
+			t.Errorf("wrong shard checked: got = %v/%v, want = %v/%v", ks, s, keyspace, shard)
+		}
+		return serving.Get()
+	})
+
+	stopped1 := issueRequest(context.Background(), t, b, failoverErr)
+	stopped2 := issueRequest(context.Background(), t, b, nil)
+	if err := waitForRequestsInFlight(b, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Mimic the SwitchWrites of the resharding operation.
+	serving.Set(false)
+
+	if err := waitForState(b, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+	for _, stopped := range []chan error{stopped1, stopped2} {
+		if err := <-stopped; !IsShardMissingError(err) {
+			t.Fatalf("buffered request should have failed with ShardMissingError: %v", err)
+		}
+	}
+	statsKeyJoinedReshardingCompleted := statsKeyJoined + "." + string(stopReshardingCompleted)
+	if got, want := stops.Counts()[statsKeyJoinedReshardingCompleted], int64(1); got != want {
+		t.Fatalf("resharding cutover was not tracked as end of buffering: got = %v, want = %v", got, want)
+	}
+	if err := waitForPoolSlots(b, *size); err != nil {
+		t.Fatal(err)
+	}
+
+	// A wrapped error is detected as well.
+	wrapped := vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "failed to automatically buffer and retry failed request during failover: %v", ShardMissingError)
+	if !IsShardMissingError(wrapped) {
+		t.Fatalf("IsShardMissingError(%v) = false, want true", wrapped)
+	}
+	if IsShardMissingError(failoverErr) {
+		t.Fatalf("IsShardMissingError(%v) = true, want false", failoverErr)
+	}
+}
+
 func TestEviction(t *testing.T) {
 	resetVariables()
 	defer checkVariables(t)
@@ -636,7 +693,7 @@ func TestEviction(t *testing.T) {
 	flag.Set("buffer_keyspace_shards", topoproto.KeyspaceShardString(keyspace, shard))
 	flag.Set("buffer_size", "2")
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	stopped1 := issueRequest(context.Background(), t, b, failoverErr)
 	// This wait is important because each request gets inserted asynchronously
@@ -720,7 +777,7 @@ func TestEvictionNotPossible(t *testing.T) {
 		topoproto.KeyspaceShardString(keyspace, shard2)))
 	flag.Set("buffer_size", "1")
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	// Make the buffer full (applies to all failovers).
 	// Also triggers buffering for the first shard.
@@ -774,7 +831,7 @@ func TestWindow(t *testing.T) {
 		topoproto.KeyspaceShardString(keyspace, shard2)))
 	flag.Set("buffer_size", "1")
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 	// Set value after constructor to work-around hardcoded minimum values.
 	flag.Set("buffer_window", "1ms")
 
@@ -881,7 +938,7 @@ func TestShutdown(t *testing.T) {
 
 	flag.Set("enable_buffer", "true")
 	defer resetFlagsForTesting()
-	b := New()
+	b := New(nil)
 
 	// Buffer one request.
 	stopped1 := issueRequest(context.Background(), t, b, failoverErr)
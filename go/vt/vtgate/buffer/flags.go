@@ -35,6 +35,8 @@ var (
 	maxFailoverDuration     = flag.Duration("buffer_max_failover_duration", 20*time.Second, "Stop buffering completely if a failover takes longer than this duration.")
 	minTimeBetweenFailovers = flag.Duration("buffer_min_time_between_failovers", 1*time.Minute, "Minimum time between the end of a failover and the start of the next one (tracked per shard). Faster consecutive failovers will not trigger buffering.")
 
+	shardServingCheckInterval = flag.Duration("buffer_shard_serving_check_interval", 1*time.Second, "How often the serving graph is checked during a failover to detect that the shard was removed by a resharding cutover. Requests buffered for such a shard are then failed such that vtgate can retry them on the new shards.")
+
 	drainConcurrency = flag.Int("buffer_drain_concurrency", 1, "Maximum number of requests retried simultaneously. More concurrency will increase the load on the MASTER vttablet when draining the buffer.")
 
 	shards = flag.String("buffer_keyspace_shards", "", "If not empty, limit buffering to these entries (comma separated). Entry format: keyspace or keyspace/shard. Requires --enable_buffer=true.")
@@ -49,6 +51,7 @@ func resetFlagsForTesting() {
 	flag.Set("buffer_keyspace_shards", "")
 	flag.Set("buffer_max_failover_duration", "20s")
 	flag.Set("buffer_min_time_between_failovers", "1m")
+	flag.Set("buffer_shard_serving_check_interval", "1s")
 }
 
 func verifyFlags() error {
@@ -65,6 +68,10 @@ func verifyFlags() error {
 		return fmt.Errorf("-buffer_min_time_between_failovers should be at least twice the length of -buffer_max_failover_duration: %v vs. %v", *minTimeBetweenFailovers, *maxFailoverDuration)
 	}
 
+	if *shardServingCheckInterval <= 0 {
+		return fmt.Errorf("-buffer_shard_serving_check_interval must be > 0 (specified value: %v)", *shardServingCheckInterval)
+	}
+
 	if *drainConcurrency < 1 {
 		return fmt.Errorf("-buffer_drain_concurrency must be >= 1 (specified value: %d)", *drainConcurrency)
 	}
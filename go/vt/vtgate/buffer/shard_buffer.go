@@ -60,6 +60,8 @@ type shardBuffer struct {
 	keyspace string
 	shard    string
 	now      func() time.Time
+	// shardServing may be nil. See "Buffer.shardServing".
+	shardServing ShardServingCheck
 	// bufferSizeSema is the shared pool of slots. See "Buffer.bufferSizeSema".
 	bufferSizeSema *sync2.Semaphore
 	// statsKey is used to update the stats variables.
@@ -120,7 +122,7 @@ type entry struct {
 	bufferCancel func()
 }
 
-func newShardBuffer(mode bufferMode, keyspace, shard string, now func() time.Time, bufferSizeSema *sync2.Semaphore) *shardBuffer {
+func newShardBuffer(mode bufferMode, keyspace, shard string, now func() time.Time, shardServing ShardServingCheck, bufferSizeSema *sync2.Semaphore) *shardBuffer {
 	statsKey := []string{keyspace, shard}
 	initVariablesForShard(statsKey)
 
@@ -129,6 +131,7 @@ func newShardBuffer(mode bufferMode, keyspace, shard string, now func() time.Tim
 		keyspace:       keyspace,
 		shard:          shard,
 		now:            now,
+		shardServing:   shardServing,
 		bufferSizeSema: bufferSizeSema,
 		statsKey:       statsKey,
 		statsKeyJoined: fmt.Sprintf("%s.%s", keyspace, shard),
@@ -507,6 +510,23 @@ func (sb *shardBuffer) stopBufferingDueToMaxDuration() {
 		fmt.Sprintf("stopping buffering because failover did not finish in time (%v)", *maxFailoverDuration))
 }
 
+// stopBufferingIfShardRemoved is used by timeoutThread to periodically check
+// if the shard is still part of the serving graph. A resharding cutover
+// removes the shard, and no new MASTER will ever show up for it.
+// It returns true if buffering was stopped.
+func (sb *shardBuffer) stopBufferingIfShardRemoved() bool {
+	if sb.shardServing(sb.keyspace, sb.shard) {
+		return false
+	}
+
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	sb.stopBufferingLocked(stopReshardingCompleted,
+		"stopping buffering because the shard was removed from the serving graph by a resharding operation")
+	return true
+}
+
 func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 	if sb.state != stateBuffering {
 		return
@@ -544,12 +564,19 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 	}
 	log.Infof("%v for shard: %s after: %.1f seconds due to: %v. Draining %d buffered requests now.", msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d.Seconds(), details, len(q))
 
+	// Requests of a removed shard must not be retried against it. Instead,
+	// they fail with an error which tells vtgate to resolve the shards again.
+	var drainErr error
+	if reason == stopReshardingCompleted {
+		drainErr = ShardMissingError
+	}
+
 	// Start the drain. (Use a new Go routine to release the lock.)
 	sb.wg.Add(1)
-	go sb.drain(q)
+	go sb.drain(q, drainErr)
 }
 
-func (sb *shardBuffer) drain(q []*entry) {
+func (sb *shardBuffer) drain(q []*entry, err error) {
 	defer sb.wg.Done()
 
 	// stop must be called outside of the lock because the thread may access
@@ -559,7 +586,11 @@ func (sb *shardBuffer) drain(q []*entry) {
 	start := sb.now()
 	// TODO(mberlin): Parallelize the drain by pumping the data through a channel.
 	for _, e := range q {
-		sb.unblockAndWait(e, nil /* err */, true /* releaseSlot */, true /* blockingWait */)
+		if err != nil {
+			// The request won't be retried and does not call "e.bufferCancel".
+			e.bufferCancel()
+		}
+		sb.unblockAndWait(e, err, true /* releaseSlot */, true /* blockingWait */)
 	}
 	d := sb.now().Sub(start)
 	log.Infof("Draining finished for shard: %s Took: %v for: %d requests.", topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d, len(q))
@@ -30,6 +30,9 @@ type timeoutThread struct {
 	// maxDuration enforces that a failover stops after
 	// -buffer_max_failover_duration at most.
 	maxDuration *time.Timer
+	// shardServingCheck periodically triggers the check if the shard was removed
+	// by a resharding cutover. It's nil if Buffer has no ShardServingCheck.
+	shardServingCheck *time.Ticker
 	// stopChan will be closed when the thread should stop e.g. before the drain.
 	stopChan chan struct{}
 	wg       sync.WaitGroup
@@ -44,12 +47,16 @@ type timeoutThread struct {
 }
 
 func newTimeoutThread(sb *shardBuffer) *timeoutThread {
-	return &timeoutThread{
+	tt := &timeoutThread{
 		sb:            sb,
 		maxDuration:   time.NewTimer(*maxFailoverDuration),
 		stopChan:      make(chan struct{}),
 		queueNotEmpty: make(chan struct{}),
 	}
+	if sb.shardServing != nil {
+		tt.shardServingCheck = time.NewTicker(*shardServingCheckInterval)
+	}
+	return tt
 }
 
 func (tt *timeoutThread) start() {
@@ -75,6 +82,9 @@ func (tt *timeoutThread) notifyQueueNotEmpty() {
 func (tt *timeoutThread) run() {
 	defer tt.wg.Done()
 	defer tt.maxDuration.Stop()
+	if tt.shardServingCheck != nil {
+		defer tt.shardServingCheck.Stop()
+	}
 
 	// While this thread is running, it can be in two states:
 	for {
@@ -107,6 +117,9 @@ func (tt *timeoutThread) waitForEntry(e *entry) bool {
 	case <-tt.stopChan:
 		// Failover ended before timeout. Do nothing.
 		return true
+	case <-tt.shardServingCheckChan():
+		// Stop buffering if a resharding cutover removed the shard.
+		return tt.sb.stopBufferingIfShardRemoved()
 	// b) Entry-specific checks.
 	case <-e.done:
 		// Entry was drained or evicted. Get the next entry.
@@ -140,9 +153,21 @@ func (tt *timeoutThread) waitForNonEmptyQueue() bool {
 	case <-tt.stopChan:
 		// Failover ended before timeout. Do nothing.
 		return true
+	case <-tt.shardServingCheckChan():
+		// Stop buffering if a resharding cutover removed the shard.
+		return tt.sb.stopBufferingIfShardRemoved()
 	// b) State-specific check.
 	case <-queueNotEmpty:
 		// At least one entry present. Check its timeout in the next iteration.
 		return false
 	}
 }
+
+// shardServingCheckChan returns the channel of the "shardServingCheck" ticker.
+// If there is no ticker, it returns nil which blocks forever in a select.
+func (tt *timeoutThread) shardServingCheckChan() <-chan time.Time {
+	if tt.shardServingCheck == nil {
+		return nil
+	}
+	return tt.shardServingCheck.C
+}
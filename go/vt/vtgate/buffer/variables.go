@@ -105,11 +105,12 @@ var (
 // stopReason is used in "stopsByReason" as "Reason" label.
 type stopReason string
 
-var stopReasons = []stopReason{stopFailoverEndDetected, stopMaxFailoverDurationExceeded, stopShutdown}
+var stopReasons = []stopReason{stopFailoverEndDetected, stopMaxFailoverDurationExceeded, stopReshardingCompleted, stopShutdown}
 
 const (
 	stopFailoverEndDetected         stopReason = "NewMasterSeen"
 	stopMaxFailoverDurationExceeded stopReason = "MaxDurationExceeded"
+	stopReshardingCompleted         stopReason = "ReshardingCompleted"
 	stopShutdown                    stopReason = "Shutdown"
 )
 
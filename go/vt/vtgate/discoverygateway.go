@@ -46,7 +46,7 @@ import (
 
 var (
 	cellsToWatch        = flag.String("cells_to_watch", "", "comma-separated list of cells for watching tablets")
-	refreshInterval     = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval")
+	refreshInterval     = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval, to find the tablets of new shards and recover from failed watches (the changes to the known tablets and shards are seen right away with -tablet_topology_watch)")
 	refreshKnownTablets = flag.Bool("tablet_refresh_known_tablets", true, "tablet refresh reloads the tablet address/port map from topo in case it changes")
 	topoReadConcurrency = flag.Int("topo_read_concurrency", 32, "concurrent topo reads")
 
@@ -71,7 +71,7 @@ type discoveryGateway struct {
 	tsc           *discovery.TabletStatsCache
 	srvTopoServer srvtopo.Server
 	localCell     string
-	retryCount    int
+	retryPolicies *retryPolicies
 
 	// tabletsWatchers contains a list of all the watchers we use.
 	// We create one per cell.
@@ -101,16 +101,21 @@ func NewDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv srv
 		}
 	}
 
+	retryPolicies, err := newRetryPolicies(*retryPolicyConfig, retryCount)
+	if err != nil {
+		log.Exitf("Invalid -retry_policy: %v", err)
+	}
+
 	dg := &discoveryGateway{
 		hc:                hc,
 		tsc:               discovery.NewTabletStatsCacheDoNotSetListener(topoServer, cell),
 		srvTopoServer:     serv,
 		localCell:         cell,
-		retryCount:        retryCount,
+		retryPolicies:     retryPolicies,
 		tabletsWatchers:   make([]*discovery.TopologyWatcher, 0, 1),
 		statusAggregators: make(map[string]*TabletStatusAggregator),
-		buffer:            buffer.New(),
 	}
+	dg.buffer = buffer.New(dg.masterServing)
 
 	// Set listener which will update TabletStatsCache and MasterBuffer.
 	// We set sendDownEvents=true because it's required by TabletStatsCache.
@@ -157,6 +162,12 @@ func (dg *discoveryGateway) RegisterStats() {
 		"crc32 checksum of the topology watcher state",
 		dg.topologyWatcherChecksum,
 	)
+
+	stats.NewGaugeFunc(
+		"TopologyWatcherWatches",
+		"number of topology records the topology watchers are watching",
+		dg.topologyWatcherWatches,
+	)
 }
 
 // topologyWatcherMaxRefreshLag returns the maximum lag since the watched
@@ -172,6 +183,15 @@ func (dg *discoveryGateway) topologyWatcherMaxRefreshLag() time.Duration {
 	return lag
 }
 
+// topologyWatcherWatches returns the number of records watched in all cells
+func (dg *discoveryGateway) topologyWatcherWatches() int64 {
+	var watches int64
+	for _, tw := range dg.tabletsWatchers {
+		watches += int64(tw.Watches())
+	}
+	return watches
+}
+
 // topologyWatcherChecksum returns a checksum of the topology watcher state
 func (dg *discoveryGateway) topologyWatcherChecksum() int64 {
 	var checksum int64
@@ -191,6 +211,34 @@ func (dg *discoveryGateway) StatsUpdate(ts *discovery.TabletStats) {
 	}
 }
 
+// masterServing returns false if the serving graph of the local cell no longer
+// lists the shard for MASTER traffic. This is the case after the writes of a
+// resharding operation were migrated to the new shards.
+// It is used by the buffer and therefore errs on the side of "true".
+func (dg *discoveryGateway) masterServing(keyspace, shard string) bool {
+	if dg.srvTopoServer == nil {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	srvKeyspace, err := dg.srvTopoServer.GetSrvKeyspace(ctx, dg.localCell, keyspace)
+	if err != nil {
+		return true
+	}
+	for _, partition := range srvKeyspace.Partitions {
+		if partition.ServedType != topodatapb.TabletType_MASTER {
+			continue
+		}
+		for _, shardReference := range partition.ShardReferences {
+			if shardReference.Name == shard {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
 // WaitForTablets is part of the gateway.Gateway interface.
 func (dg *discoveryGateway) WaitForTablets(ctx context.Context, tabletTypesToWait []topodatapb.TabletType) error {
 	// Skip waiting for tablets if we are not told to do so.
@@ -231,7 +279,7 @@ func (dg *discoveryGateway) CacheStatus() TabletCacheStatusList {
 }
 
 // withRetry gets available connections and executes the action. If there are retryable errors,
-// it retries retryCount times before failing. It does not retry if the connection is in
+// it retries as configured by the RetryPolicy of the target. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
 // a resharding event, and set the re-resolve bit and let the upper layers
 // re-resolve and retry.
@@ -253,8 +301,14 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		}
 	}
 
+	policy := dg.retryPolicies.policy(target.Keyspace, target.TabletType)
+	innerCtx := ctx
+	if policy.RetryableCodes != nil {
+		innerCtx = queryservice.WithRetryableCodes(ctx, policy.RetryableCodes)
+	}
+
 	bufferedOnce := false
-	for i := 0; i < dg.retryCount+1; i++ {
+	for i := 0; i < policy.MaxAttempts; i++ {
 		// Check if we should buffer MASTER queries which failed due to an ongoing
 		// failover.
 		// Note: We only buffer once and only "!inTransaction" queries i.e.
@@ -316,10 +370,13 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 
 		startTime := time.Now()
 		var canRetry bool
-		canRetry, err = inner(ctx, ts.Target, conn)
+		canRetry, err = inner(innerCtx, ts.Target, conn)
 		dg.updateStats(target, startTime, err)
-		if canRetry {
+		if canRetry && policy.canRetry(name, target) {
 			invalidTablets[ts.Key] = true
+			if i+1 < policy.MaxAttempts && !policy.waitBackoff(ctx, i) {
+				break
+			}
 			continue
 		}
 		break
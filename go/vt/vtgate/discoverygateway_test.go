@@ -78,7 +78,8 @@ func TestDiscoveryGatewayBegin(t *testing.T) {
 
 func TestDiscoveryGatewayCommit(t *testing.T) {
 	testDiscoveryGatewayTransact(t, func(dg *discoveryGateway, target *querypb.Target) error {
-		return dg.Commit(context.Background(), target, 1)
+		_, err := dg.Commit(context.Background(), target, 1)
+		return err
 	})
 }
 
@@ -264,6 +265,54 @@ func TestDiscoveryGatewayGetTabletsWithRegion(t *testing.T) {
 	}
 }
 
+func TestDiscoveryGatewayRetryPolicy(t *testing.T) {
+	*retryPolicyConfig = `{"ks@replica": {"retryable_codes": ["RESOURCE_EXHAUSTED"]}, "ks@master": {"idempotent_only": true}}`
+	defer func() { *retryPolicyConfig = "" }()
+	keyspace := "ks"
+	shard := "0"
+	hc := discovery.NewFakeHealthCheck()
+	dg := NewDiscoveryGateway(context.Background(), hc, nil, "cell", 2)
+
+	// Configured code is retried.
+	replica := &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_REPLICA}
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc1.MustFailCodes[vtrpcpb.Code_RESOURCE_EXHAUSTED] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_RESOURCE_EXHAUSTED] = 1
+	_, err := dg.Execute(context.Background(), replica, "query", nil, 0, nil)
+	verifyContainsError(t, err, "target: ks.0.replica", vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	if got := sc1.ExecCount.Get() + sc2.ExecCount.Get(); got != 2 {
+		t.Errorf("want 2 attempts, got %v", got)
+	}
+
+	// Default codes are not retried anymore.
+	hc.Reset()
+	dg.tsc.ResetForTesting()
+	sc1 = hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 = hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc1.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err = dg.Execute(context.Background(), replica, "query", nil, 0, nil)
+	verifyContainsError(t, err, "target: ks.0.replica", vtrpcpb.Code_UNAVAILABLE)
+	if got := sc1.ExecCount.Get() + sc2.ExecCount.Get(); got != 1 {
+		t.Errorf("want 1 attempt, got %v", got)
+	}
+
+	// A MASTER request which may have modified data is not retried.
+	hc.Reset()
+	dg.tsc.ResetForTesting()
+	master := &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER}
+	sc1 = hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_MASTER, true, 10, nil)
+	sc2 = hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_MASTER, true, 10, nil)
+	sc1.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err = dg.Execute(context.Background(), master, "query", nil, 0, nil)
+	verifyContainsError(t, err, "target: ks.0.master", vtrpcpb.Code_UNAVAILABLE)
+	if got := sc1.ExecCount.Get() + sc2.ExecCount.Get(); got != 1 {
+		t.Errorf("want 1 attempt, got %v", got)
+	}
+}
+
 func testDiscoveryGatewayGeneric(t *testing.T, f func(dg *discoveryGateway, target *querypb.Target) error) {
 	keyspace := "ks"
 	shard := "0"
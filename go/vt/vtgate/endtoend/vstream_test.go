@@ -66,7 +66,7 @@ func TestVStream(t *testing.T) {
 			Match: "/.*/",
 		}},
 	}
-	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter)
+	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Concatenate)(nil)
+
+// Concatenate is a primitive that returns the rows of all its
+// sources, one after the other. It's used for the branches of
+// a UNION that can't be sent to a single route. The fields
+// are those of the first source.
+type Concatenate struct {
+	Sources []Primitive
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (c *Concatenate) RouteType() string {
+	return "Concatenate"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (c *Concatenate) GetKeyspaceName() string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, source := range c.Sources {
+		name := source.GetKeyspaceName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return strings.Join(names, "_")
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (c *Concatenate) GetTableName() string {
+	names := make([]string, 0, len(c.Sources))
+	for _, source := range c.Sources {
+		names = append(names, source.GetTableName())
+	}
+	return strings.Join(names, "_")
+}
+
+// Execute performs a non-streaming exec.
+func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for i, source := range c.Sources {
+		qr, err := source.Execute(vcursor, bindVars, wantfields)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Fields = qr.Fields
+		} else if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for i, source := range c.Sources {
+		first := i == 0
+		err := source.StreamExecute(vcursor, bindVars, wantfields && first, func(qr *sqltypes.Result) error {
+			if !first && len(qr.Fields) != 0 {
+				if len(qr.Rows) == 0 {
+					return nil
+				}
+				qr = &sqltypes.Result{Rows: qr.Rows}
+			}
+			return callback(qr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return c.Sources[0].GetFields(vcursor, bindVars)
+}
+
+// Inputs returns the sources of the concatenation.
+func (c *Concatenate) Inputs() []Primitive {
+	return c.Sources
+}
+
+// NeedsTransaction returns true if any of the sources needs one.
+func (c *Concatenate) NeedsTransaction() bool {
+	for _, source := range c.Sources {
+		if source.NeedsTransaction() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Concatenate) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Concatenate",
+	}
+}
+
+// checkColumnCount returns an error if the fields of a UNION
+// branch don't have as many columns as those of the first one.
+// The check is skipped if any of them wasn't requested.
+func checkColumnCount(first, fields []*querypb.Field) error {
+	if len(first) == 0 || len(fields) == 0 {
+		return nil
+	}
+	if len(first) != len(fields) {
+		return fmt.Errorf("The used SELECT statements have a different number of columns: %d, %d", len(first), len(fields))
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestConcatenateExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	left := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+			"3|c",
+		)},
+	}
+	right := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"4|d",
+			"1|a",
+		)},
+	}
+	c := &Concatenate{
+		Sources: []Primitive{left, right},
+	}
+
+	result, err := c.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	left.ExpectLog(t, []string{`Execute  true`})
+	right.ExpectLog(t, []string{`Execute  true`})
+	expectResult(t, "c.Execute", result, sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"3|c",
+		"4|d",
+		"1|a",
+	))
+
+	left.rewind()
+	right.rewind()
+	result, err = wrapStreamExecute(c, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	left.ExpectLog(t, []string{`StreamExecute  true`})
+	right.ExpectLog(t, []string{`StreamExecute  false`})
+	expectResult(t, "c.StreamExecute", result, sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"3|c",
+		"4|d",
+		"1|a",
+	))
+}
+
+func TestConcatenateColumnCountMismatch(t *testing.T) {
+	left := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"int64|varchar",
+			),
+			"1|a",
+		)},
+	}
+	right := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"2",
+		)},
+	}
+	c := &Concatenate{
+		Sources: []Primitive{left, right},
+	}
+
+	_, err := c.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	assert.EqualError(t, err, "The used SELECT statements have a different number of columns: 2, 1")
+}
+
+func TestConcatenateError(t *testing.T) {
+	left := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"1",
+		)},
+	}
+	right := &fakePrimitive{
+		sendErr: errors.New("right err"),
+	}
+	c := &Concatenate{
+		Sources: []Primitive{left, right},
+	}
+
+	_, err := c.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	assert.EqualError(t, err, "right err")
+
+	left.rewind()
+	_, err = wrapStreamExecute(c, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	assert.EqualError(t, err, "right err")
+}
@@ -111,11 +111,7 @@ func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := del.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, vterrors.Wrap(err, "execDeleteEqual")
-	}
-	rs, ksid, err := resolveSingleShard(vcursor, del.Vindex, del.Keyspace, key)
+	rs, ksid, err := del.resolveEqualShard(vcursor, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteEqual")
 	}
@@ -150,24 +146,41 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 		return nil
 	}
 
+	// Collect the entries of all rows for each owned vindex. Vindexes
+	// that support it delete them all at once. The others get one
+	// Delete per row.
+	ksids := make([][]byte, 0, len(subQueryResults.Rows))
+	rowsColValues := make([][][]sqltypes.Value, len(del.Table.Owned))
 	for _, row := range subQueryResults.Rows {
-		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		colnum := del.KsidLength
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:del.KsidLength])
 		if err != nil {
 			return err
 		}
-		for _, colVindex := range del.Table.Owned {
+		ksids = append(ksids, ksid)
+		for i, colVindex := range del.Table.Owned {
 			// Fetch the column values. colnum must keep incrementing.
 			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
 			for range colVindex.Columns {
 				fromIds = append(fromIds, row[colnum])
 				colnum++
 			}
-			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+			rowsColValues[i] = append(rowsColValues[i], fromIds)
+		}
+	}
+
+	for i, colVindex := range del.Table.Owned {
+		if batch, ok := colVindex.Vindex.(vindexes.BatchDeleter); ok {
+			if err := batch.BatchDelete(vcursor, rowsColValues[i], ksids); err != nil {
+				return err
+			}
+			continue
+		}
+		for j, fromIds := range rowsColValues[i] {
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksids[j]); err != nil {
 				return err
 			}
 		}
-
 	}
 
 	return nil
@@ -224,6 +237,9 @@ func addFieldsIfNotEmpty(dml DML, other map[string]interface{}) {
 	if dml.KsidVindex != nil {
 		other["KsidVindex"] = dml.KsidVindex.String()
 	}
+	if dml.KsidLength > 1 {
+		other["KsidLength"] = dml.KsidLength
+	}
 	if len(dml.Values) > 0 {
 		other["Values"] = dml.Values
 	}
@@ -89,6 +89,35 @@ func TestDeleteEqual(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteEqual: missing bind var aa")
 }
 
+func TestDeleteEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{"column_count": "2"})
+	del := &Delete{
+		DML: DML{
+			Opcode: Equal,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex,
+			Values: []sqltypes.PlanValue{{Values: []sqltypes.PlanValue{
+				{Value: sqltypes.NewInt64(1)},
+				{Value: sqltypes.NewInt64(2)},
+			}}},
+		},
+	}
+
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b406e7ea22)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {} true true`,
+	})
+}
+
 func TestDeleteEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -156,6 +185,7 @@ func TestDeleteOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -229,8 +259,8 @@ func TestDeleteOwnedVindex(t *testing.T) {
 		// Delete 4,5 and 7,8 from lkp2.
 		// Delete 6 and 8 from lkp1.
 		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
-		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"7" from2: type:INT64 value:"8" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		// Send the DML.
 		`ExecuteMultiShard sharded.-20: dummy_delete {} true true`,
@@ -280,6 +310,7 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -353,8 +384,8 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 		// Delete 4,5 and 7,8 from lkp2.
 		// Delete 6 and 8 from lkp1.
 		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
-		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"7" from2: type:INT64 value:"8" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
 		// Send the DML.
 		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes the duplicate rows
+// of its input. It's used for a UNION that is executed
+// by a Concatenate. Values are compared by their bytes,
+// after numeric values are normalized. To honor the
+// collation of text columns, Keys points them to their
+// weight_string columns instead.
+type Distinct struct {
+	Input Primitive
+
+	// Keys specifies the columns which identify a row. If empty,
+	// all the columns of the row are compared.
+	Keys []int `json:",omitempty"`
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (d *Distinct) RouteType() string {
+	return d.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Input.GetTableName()
+}
+
+// Execute satisfies the Primtive interface.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := d.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	rows := result.Rows[:0]
+	for _, row := range result.Rows {
+		key := d.distinctKey(row)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		rows = append(rows, row)
+		if len(rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	return result.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primtive interface.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	seen := make(map[string]bool)
+	return d.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		result := &sqltypes.Result{Fields: qr.Fields}
+		for _, row := range qr.Rows {
+			key := d.distinctKey(row)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result.Rows = append(result.Rows, row)
+		}
+		if len(seen) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		if len(result.Fields) == 0 && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result.Truncate(d.TruncateColumnCount))
+	})
+}
+
+// GetFields satisfies the Primtive interface.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := d.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(d.TruncateColumnCount), nil
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (d *Distinct) SetTruncateColumnCount(count int) {
+	d.TruncateColumnCount = count
+}
+
+// Inputs returns the input to distinct
+func (d *Distinct) Inputs() []Primitive {
+	return []Primitive{d.Input}
+}
+
+// NeedsTransaction returns true if the input needs one.
+func (d *Distinct) NeedsTransaction() bool {
+	return d.Input.NeedsTransaction()
+}
+
+func (d *Distinct) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if len(d.Keys) != 0 {
+		other["Keys"] = GenericJoin(d.Keys, intToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Distinct",
+		Other:        other,
+	}
+}
+
+// distinctKey returns the key that identifies the row among
+// its duplicates. Unlike hashKey, NULLs are equal to each other.
+func (d *Distinct) distinctKey(row []sqltypes.Value) string {
+	var key strings.Builder
+	writeValue := func(v sqltypes.Value) {
+		if v.IsNull() {
+			key.WriteString("-:")
+			return
+		}
+		writeKeyValue(&key, v)
+	}
+	if len(d.Keys) == 0 {
+		for _, v := range row {
+			writeValue(v)
+		}
+		return key.String()
+	}
+	for _, col := range d.Keys {
+		writeValue(row[col])
+	}
+	return key.String()
+}
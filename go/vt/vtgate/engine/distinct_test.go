@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"decimal|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+			"1.0|a",
+			"null|c",
+			"2|B",
+			"null|c",
+		)},
+	}
+	d := &Distinct{
+		Input: fp,
+	}
+	want := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"null|c",
+		"2|B",
+	)
+
+	result, err := d.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", result, want)
+
+	fp.results[0] = sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"1.0|a",
+		"null|c",
+		"2|B",
+		"null|c",
+	)
+	fp.rewind()
+	result, err = wrapStreamExecute(d, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "d.StreamExecute", result, want)
+}
+
+func TestDistinctWeightString(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2|weight_string(col2)",
+		"int64|varchar|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|A",
+			"1|A|A",
+			"2|b|B",
+			"1|b|B",
+			"2|B|B",
+		)},
+	}
+	d := &Distinct{
+		Input:               fp,
+		Keys:                []int{0, 2},
+		TruncateColumnCount: 2,
+	}
+	want := sqltypes.MakeTestResult(
+		fields[:2],
+		"1|a",
+		"2|b",
+		"1|b",
+	)
+
+	result, err := d.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", result, want)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(d, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "d.StreamExecute", result, want)
+}
+
+func TestDistinctMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1",
+			"1",
+			"2",
+			"2",
+			"3",
+		)},
+	}
+	d := &Distinct{
+		Input: fp,
+	}
+
+	_, err := d.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+
+	fp.rewind()
+	_, err = wrapStreamExecute(d, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
@@ -19,7 +19,10 @@ package engine
 import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // DML contains the common elements between Update and Delete plans
@@ -37,14 +40,19 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For now, only one value is specified. For a MultiColumn
+	// vindex, Values[0] is the list of values of its columns.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of leading columns of
+	// OwnedVindexQuery that KsidVindex maps to a keyspace id.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -95,3 +103,23 @@ var opcodeName = map[DMLOpcode]string{
 func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
+
+// resolveEqualShard resolves the shard and the keyspace id
+// of the vindex values of an Equal DML.
+func (dml *DML) resolveEqualShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*srvtopo.ResolvedShard, []byte, error) {
+	var key []sqltypes.Value
+	if _, ok := dml.Vindex.(vindexes.MultiColumn); ok {
+		var err error
+		key, err = dml.Values[0].ResolveList(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		value, err := dml.Values[0].ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		key = []sqltypes.Value{value}
+	}
+	return resolveSingleShard(vcursor, dml.Vindex, dml.Keyspace, key)
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Expr is an expression evaluated by vtgate on the rows of a result,
+// for the clauses which can't be sent to the shards, like the HAVING
+// conditions on the results of scatter aggregates.
+type Expr interface {
+	// Evaluate returns the value of the expression for the row.
+	Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error)
+	// String returns the description of the expression in the plans.
+	String() string
+}
+
+var (
+	_ Expr = (*ColumnExpr)(nil)
+	_ Expr = (*LiteralExpr)(nil)
+	_ Expr = (*ComparisonExpr)(nil)
+	_ Expr = (*ArithmeticExpr)(nil)
+	_ Expr = (*LogicalExpr)(nil)
+	_ Expr = (*NotExpr)(nil)
+	_ Expr = (*IsNullExpr)(nil)
+
+	exprTrue  = sqltypes.NewInt64(1)
+	exprFalse = sqltypes.NewInt64(0)
+)
+
+// ColumnExpr is the value of a column of the row.
+type ColumnExpr struct {
+	Col int
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *ColumnExpr) Evaluate(_ map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	if c.Col >= len(row) {
+		return sqltypes.NULL, fmt.Errorf("column %d is out of range, the row has %d columns", c.Col, len(row))
+	}
+	return row[c.Col], nil
+}
+
+func (c *ColumnExpr) String() string {
+	return fmt.Sprintf("[%d]", c.Col)
+}
+
+// LiteralExpr is a constant or a bind variable.
+type LiteralExpr struct {
+	Value sqltypes.PlanValue
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *LiteralExpr) Evaluate(bindVars map[string]*querypb.BindVariable, _ []sqltypes.Value) (sqltypes.Value, error) {
+	return l.Value.ResolveValue(bindVars)
+}
+
+func (l *LiteralExpr) String() string {
+	if l.Value.Key != "" {
+		return ":" + l.Value.Key
+	}
+	buf := &bytes.Buffer{}
+	l.Value.Value.EncodeSQL(buf)
+	return buf.String()
+}
+
+// ComparisonExpr compares two values. As in MySQL, the result is NULL
+// if one of them is NULL, unless the operator is <=>.
+type ComparisonExpr struct {
+	Operator    string
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *ComparisonExpr) Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := c.Left.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := c.Right.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Operator != sqlparser.NullSafeEqualStr && (left.IsNull() || right.IsNull()) {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := sqltypes.NullsafeCompare(left, right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result bool
+	switch c.Operator {
+	case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+		result = cmp == 0
+	case sqlparser.NotEqualStr:
+		result = cmp != 0
+	case sqlparser.LessThanStr:
+		result = cmp < 0
+	case sqlparser.LessEqualStr:
+		result = cmp <= 0
+	case sqlparser.GreaterThanStr:
+		result = cmp > 0
+	case sqlparser.GreaterEqualStr:
+		result = cmp >= 0
+	default:
+		return sqltypes.NULL, fmt.Errorf("unsupported comparison operator: %s", c.Operator)
+	}
+	return boolValue(result), nil
+}
+
+func (c *ComparisonExpr) String() string {
+	return fmt.Sprintf("%v %s %v", c.Left, c.Operator, c.Right)
+}
+
+// ArithmeticExpr is the sum, difference, product or quotient of two values.
+type ArithmeticExpr struct {
+	Operator    string
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (a *ArithmeticExpr) Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := a.Left.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := a.Right.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch a.Operator {
+	case sqlparser.PlusStr:
+		return sqltypes.Add(left, right)
+	case sqlparser.MinusStr:
+		return sqltypes.Subtract(left, right)
+	case sqlparser.MultStr:
+		return sqltypes.Multiply(left, right)
+	case sqlparser.DivStr:
+		return sqltypes.Divide(left, right)
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported arithmetic operator: %s", a.Operator)
+}
+
+func (a *ArithmeticExpr) String() string {
+	return fmt.Sprintf("(%v %s %v)", a.Left, a.Operator, a.Right)
+}
+
+// The operators of LogicalExpr.
+const (
+	LogicalAnd = "and"
+	LogicalOr  = "or"
+)
+
+// LogicalExpr is the conjunction or disjunction of two conditions,
+// with the three-valued logic of MySQL.
+type LogicalExpr struct {
+	Operator    string
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *LogicalExpr) Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := l.Left.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := l.Right.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	lt, err := truthValue(left)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rt, err := truthValue(right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch l.Operator {
+	case LogicalAnd:
+		switch {
+		case (!left.IsNull() && !lt) || (!right.IsNull() && !rt):
+			return exprFalse, nil
+		case left.IsNull() || right.IsNull():
+			return sqltypes.NULL, nil
+		}
+		return exprTrue, nil
+	case LogicalOr:
+		switch {
+		case lt || rt:
+			return exprTrue, nil
+		case left.IsNull() || right.IsNull():
+			return sqltypes.NULL, nil
+		}
+		return exprFalse, nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported logical operator: %s", l.Operator)
+}
+
+func (l *LogicalExpr) String() string {
+	return fmt.Sprintf("(%v %s %v)", l.Left, l.Operator, l.Right)
+}
+
+// NotExpr is the negation of a condition. The negation of NULL is NULL.
+type NotExpr struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (n *NotExpr) Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	v, err := n.Expr.Evaluate(bindVars, row)
+	if err != nil || v.IsNull() {
+		return sqltypes.NULL, err
+	}
+	t, err := truthValue(v)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!t), nil
+}
+
+func (n *NotExpr) String() string {
+	return fmt.Sprintf("not %v", n.Expr)
+}
+
+// IsNullExpr checks if a value is NULL, or not NULL if Not is set.
+type IsNullExpr struct {
+	Expr Expr
+	Not  bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (i *IsNullExpr) Evaluate(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (sqltypes.Value, error) {
+	v, err := i.Expr.Evaluate(bindVars, row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue(v.IsNull() != i.Not), nil
+}
+
+func (i *IsNullExpr) String() string {
+	if i.Not {
+		return fmt.Sprintf("%v is not null", i.Expr)
+	}
+	return fmt.Sprintf("%v is null", i.Expr)
+}
+
+// EvaluateCondition evaluates expr as a condition: it's true only if
+// the value is not NULL and not zero.
+func EvaluateCondition(expr Expr, bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (bool, error) {
+	v, err := expr.Evaluate(bindVars, row)
+	if err != nil {
+		return false, err
+	}
+	return truthValue(v)
+}
+
+// truthValue returns true if the value is not NULL and not zero.
+// Like MySQL, the strings are converted to numbers.
+func truthValue(v sqltypes.Value) (bool, error) {
+	if v.IsNull() {
+		return false, nil
+	}
+	f, err := sqltypes.ToFloat64(v)
+	if err != nil {
+		return false, err
+	}
+	return f != 0, nil
+}
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return exprTrue
+	}
+	return exprFalse
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestExprEvaluate(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(10),
+		sqltypes.NULL,
+		sqltypes.NewVarBinary("abc"),
+		sqltypes.NewFloat64(2.5),
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"v": sqltypes.Int64BindVariable(10),
+	}
+	col := func(i int) Expr { return &ColumnExpr{Col: i} }
+	lit := func(v sqltypes.Value) Expr { return &LiteralExpr{Value: sqltypes.PlanValue{Value: v}} }
+	null := lit(sqltypes.NULL)
+	one, zero := lit(sqltypes.NewInt64(1)), lit(sqltypes.NewInt64(0))
+
+	testcases := []struct {
+		expr Expr
+		str  string
+		want sqltypes.Value
+	}{{
+		expr: &ComparisonExpr{Operator: "=", Left: col(0), Right: &LiteralExpr{Value: sqltypes.PlanValue{Key: "v"}}},
+		str:  "[0] = :v",
+		want: exprTrue,
+	}, {
+		expr: &ComparisonExpr{Operator: ">", Left: col(3), Right: lit(sqltypes.NewInt64(2))},
+		str:  "[3] > 2",
+		want: exprTrue,
+	}, {
+		expr: &ComparisonExpr{Operator: "<=", Left: col(0), Right: col(3)},
+		str:  "[0] <= [3]",
+		want: exprFalse,
+	}, {
+		expr: &ComparisonExpr{Operator: "!=", Left: col(2), Right: lit(sqltypes.NewVarBinary("abc"))},
+		str:  "[2] != 'abc'",
+		want: exprFalse,
+	}, {
+		expr: &ComparisonExpr{Operator: "=", Left: col(1), Right: col(1)},
+		str:  "[1] = [1]",
+		want: sqltypes.NULL,
+	}, {
+		expr: &ComparisonExpr{Operator: "<=>", Left: col(1), Right: null},
+		str:  "[1] <=> null",
+		want: exprTrue,
+	}, {
+		expr: &ArithmeticExpr{Operator: "/", Left: col(0), Right: lit(sqltypes.NewInt64(4))},
+		str:  "([0] / 4)",
+		want: sqltypes.NewFloat64(2.5),
+	}, {
+		expr: &ArithmeticExpr{Operator: "+", Left: col(0), Right: col(1)},
+		str:  "([0] + [1])",
+		want: sqltypes.NULL,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalAnd, Left: null, Right: zero},
+		str:  "(null and 0)",
+		want: exprFalse,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalAnd, Left: null, Right: one},
+		str:  "(null and 1)",
+		want: sqltypes.NULL,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalOr, Left: null, Right: one},
+		str:  "(null or 1)",
+		want: exprTrue,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalOr, Left: null, Right: zero},
+		str:  "(null or 0)",
+		want: sqltypes.NULL,
+	}, {
+		expr: &NotExpr{Expr: col(3)},
+		str:  "not [3]",
+		want: exprFalse,
+	}, {
+		expr: &NotExpr{Expr: null},
+		str:  "not null",
+		want: sqltypes.NULL,
+	}, {
+		expr: &IsNullExpr{Expr: col(1)},
+		str:  "[1] is null",
+		want: exprTrue,
+	}, {
+		expr: &IsNullExpr{Expr: col(1), Not: true},
+		str:  "[1] is not null",
+		want: exprFalse,
+	}}
+	for _, tc := range testcases {
+		got, err := tc.expr.Evaluate(bindVars, row)
+		if !assert.NoError(t, err, tc.str) {
+			continue
+		}
+		assert.Equal(t, tc.want, got, tc.str)
+		assert.Equal(t, tc.str, tc.expr.String())
+	}
+
+	_, err := col(4).Evaluate(bindVars, row)
+	assert.EqualError(t, err, "column 4 is out of range, the row has 4 columns")
+	_, err = (&ComparisonExpr{Operator: "like", Left: col(2), Right: col(2)}).Evaluate(bindVars, row)
+	assert.EqualError(t, err, "unsupported comparison operator: like")
+}
+
+func TestEvaluateCondition(t *testing.T) {
+	testcases := []struct {
+		in   sqltypes.Value
+		want bool
+	}{
+		{in: sqltypes.NULL, want: false},
+		{in: sqltypes.NewInt64(0), want: false},
+		{in: sqltypes.NewInt64(-1), want: true},
+		{in: sqltypes.NewFloat64(0.5), want: true},
+		{in: sqltypes.NewVarChar("0"), want: false},
+		{in: sqltypes.NewVarChar("3"), want: true},
+	}
+	for _, tc := range testcases {
+		got, err := EvaluateCondition(&LiteralExpr{Value: sqltypes.PlanValue{Value: tc.in}}, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.in.String())
+	}
+}
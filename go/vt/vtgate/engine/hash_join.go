@@ -0,0 +1,481 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// hashJoinPartitions is the number of partitions the build and probe
+// sides are split into once the build side doesn't fit in memory.
+const hashJoinPartitions = 16
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every row of the LHS,
+// HashJoin executes each side exactly once: the rows of the RHS are
+// loaded into a hash table keyed on RHSKeys, and the rows of the LHS
+// are matched against it using LHSKeys.
+// If the RHS has more rows than the vcursor allows to be held in
+// memory, both sides are hash-partitioned into temporary files and
+// joined one partition at a time. In that case, the output is not
+// produced in the order of the LHS.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It follows the same convention
+	// as Join.Cols.
+	Cols []int `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the column offsets of the
+	// join keys in the left and right results. The join
+	// condition is LHSKeys[i] = RHSKeys[i] for every i.
+	LHSKeys []int `json:",omitempty"`
+	RHSKeys []int `json:",omitempty"`
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := hj.join(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return hj.join(vcursor, bindVars, wantfields, callback)
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+func (hj *HashJoin) join(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	table := newHashTable(hj.RHSKeys, vcursor.MaxMemoryRows())
+	defer table.close()
+	err := hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		if rresult.Fields != nil {
+			table.fields = rresult.Fields
+		}
+		for _, rrow := range rresult.Rows {
+			if err := table.add(rrow); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var lpartitions []*spillFile
+	if table.spilled() {
+		if lpartitions, err = newSpillFiles(); err != nil {
+			return err
+		}
+		defer closeSpillFiles(lpartitions)
+	}
+	var lfields []*querypb.Field
+	err = hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if lresult.Fields != nil {
+			lfields = lresult.Fields
+		}
+		if wantfields && lfields != nil {
+			wantfields = false
+			if err := hj.sendFields(vcursor, bindVars, lfields, table.fields, callback); err != nil {
+				return err
+			}
+		}
+		result := &sqltypes.Result{}
+		for _, lrow := range lresult.Rows {
+			key, ok := hashKey(lrow, hj.LHSKeys)
+			if lpartitions == nil || !ok {
+				result.Rows = hj.probe(result.Rows, lrow, table.rows[key], ok)
+				continue
+			}
+			if err := lpartitions[partitionOf(key)].write(lrow); err != nil {
+				return err
+			}
+		}
+		if len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+	if err != nil {
+		return err
+	}
+	if wantfields {
+		if err := hj.sendFields(vcursor, bindVars, lfields, table.fields, callback); err != nil {
+			return err
+		}
+	}
+
+	for i, lpartition := range lpartitions {
+		rows, err := table.loadPartition(i)
+		if err != nil {
+			return err
+		}
+		result := &sqltypes.Result{}
+		err = lpartition.readAll(func(lrow []sqltypes.Value) error {
+			key, _ := hashKey(lrow, hj.LHSKeys)
+			result.Rows = hj.probe(result.Rows, lrow, rows[key], true)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(result.Rows) != 0 {
+			if err := callback(result); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// probe appends to rows the result of joining lrow with the rows
+// of the RHS that have the same key. If the key of lrow contains
+// a NULL, it has no matches.
+func (hj *HashJoin) probe(rows [][]sqltypes.Value, lrow []sqltypes.Value, matches [][]sqltypes.Value, ok bool) [][]sqltypes.Value {
+	if ok {
+		for _, rrow := range matches {
+			rows = append(rows, joinRows(lrow, rrow, hj.Cols))
+		}
+	}
+	if hj.Opcode == LeftJoin && (!ok || len(matches) == 0) {
+		rows = append(rows, joinRows(lrow, nil, hj.Cols))
+	}
+	return rows
+}
+
+// sendFields sends the field info of the join. If either side did
+// not return its fields while executing, they're fetched separately.
+func (hj *HashJoin) sendFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lfields, rfields []*querypb.Field, callback func(*sqltypes.Result) error) error {
+	if lfields == nil {
+		lresult, err := hj.Left.GetFields(vcursor, bindVars)
+		if err != nil {
+			return err
+		}
+		lfields = lresult.Fields
+	}
+	if rfields == nil {
+		rresult, err := hj.Right.GetFields(vcursor, bindVars)
+		if err != nil {
+			return err
+		}
+		rfields = rresult.Fields
+	}
+	return callback(&sqltypes.Result{Fields: joinFields(lfields, rfields, hj.Cols)})
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "Join"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": intsToString(hj.Cols),
+		"LHSKeys":           intsToString(hj.LHSKeys),
+		"RHSKeys":           intsToString(hj.RHSKeys),
+	}
+	return PrimitiveDescription{
+		OperatorType: "Join",
+		Variant:      "Hash" + hj.Opcode.String(),
+		Other:        other,
+	}
+}
+
+func intsToString(ints []int) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ints)), ","), "[]")
+}
+
+// hashKey returns the key used to match the row in the hash table.
+// Numeric values are normalized so that, for example, an int64 1
+// matches a decimal 1.0. The second return value is false if any of
+// the key columns is NULL, in which case the row can't match anything.
+func hashKey(row []sqltypes.Value, cols []int) (string, bool) {
+	var key strings.Builder
+	for _, col := range cols {
+		v := row[col]
+		if v.IsNull() {
+			return "", false
+		}
+		writeKeyValue(&key, v)
+	}
+	return key.String(), true
+}
+
+// writeKeyValue appends the normalized value to the key, prefixed
+// by its length so that the key can't be ambiguous.
+func writeKeyValue(key *strings.Builder, v sqltypes.Value) {
+	s := v.ToString()
+	if v.IsFloat() || v.Type() == sqltypes.Decimal {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			s = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	key.WriteString(strconv.Itoa(len(s)))
+	key.WriteByte(':')
+	key.WriteString(s)
+}
+
+func partitionOf(key string) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % hashJoinPartitions)
+}
+
+// hashTable is the build side of a HashJoin. Rows are kept in memory
+// until there are more than limit of them, at which point all rows
+// are moved to partition files on disk.
+type hashTable struct {
+	keys   []int
+	limit  int
+	fields []*querypb.Field
+
+	rows       map[string][][]sqltypes.Value
+	count      int
+	partitions []*spillFile
+}
+
+func newHashTable(keys []int, limit int) *hashTable {
+	return &hashTable{
+		keys:  keys,
+		limit: limit,
+		rows:  make(map[string][][]sqltypes.Value),
+	}
+}
+
+func (ht *hashTable) spilled() bool {
+	return ht.partitions != nil
+}
+
+func (ht *hashTable) add(row []sqltypes.Value) error {
+	key, ok := hashKey(row, ht.keys)
+	if !ok {
+		// NULL keys never match, so the row can be dropped.
+		return nil
+	}
+	if ht.spilled() {
+		return ht.partitions[partitionOf(key)].write(row)
+	}
+	ht.rows[key] = append(ht.rows[key], row)
+	ht.count++
+	if ht.count <= ht.limit {
+		return nil
+	}
+
+	partitions, err := newSpillFiles()
+	if err != nil {
+		return err
+	}
+	ht.partitions = partitions
+	for key, rows := range ht.rows {
+		for _, row := range rows {
+			if err := ht.partitions[partitionOf(key)].write(row); err != nil {
+				return err
+			}
+		}
+	}
+	ht.rows = nil
+	ht.count = 0
+	return nil
+}
+
+// loadPartition reads the rows of a spilled partition back into memory.
+func (ht *hashTable) loadPartition(i int) (map[string][][]sqltypes.Value, error) {
+	partition := ht.partitions[i]
+	if partition.count > ht.limit {
+		return nil, fmt.Errorf("hash join partition row count exceeded allowed limit of %d", ht.limit)
+	}
+	rows := make(map[string][][]sqltypes.Value)
+	err := partition.readAll(func(row []sqltypes.Value) error {
+		key, _ := hashKey(row, ht.keys)
+		rows[key] = append(rows[key], row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (ht *hashTable) close() {
+	closeSpillFiles(ht.partitions)
+}
+
+// spillFile is a temporary file that holds rows that could not be
+// kept in memory.
+type spillFile struct {
+	file  *os.File
+	w     *bufio.Writer
+	count int
+	buf   [binary.MaxVarintLen64]byte
+}
+
+func newSpillFiles() ([]*spillFile, error) {
+	files := make([]*spillFile, 0, hashJoinPartitions)
+	for i := 0; i < hashJoinPartitions; i++ {
+		file, err := ioutil.TempFile("", "vtgate_hashjoin")
+		if err != nil {
+			closeSpillFiles(files)
+			return nil, err
+		}
+		files = append(files, &spillFile{file: file, w: bufio.NewWriter(file)})
+	}
+	return files, nil
+}
+
+func closeSpillFiles(files []*spillFile) {
+	for _, sf := range files {
+		sf.file.Close()
+		os.Remove(sf.file.Name())
+	}
+}
+
+func (sf *spillFile) writeUvarint(v uint64) error {
+	n := binary.PutUvarint(sf.buf[:], v)
+	_, err := sf.w.Write(sf.buf[:n])
+	return err
+}
+
+// write appends the row to the file. Rows are encoded as the number
+// of values, followed by the type, length and bytes of each value.
+func (sf *spillFile) write(row []sqltypes.Value) error {
+	if err := sf.writeUvarint(uint64(len(row))); err != nil {
+		return err
+	}
+	for _, v := range row {
+		if err := sf.writeUvarint(uint64(v.Type())); err != nil {
+			return err
+		}
+		raw := v.Raw()
+		if err := sf.writeUvarint(uint64(len(raw))); err != nil {
+			return err
+		}
+		if _, err := sf.w.Write(raw); err != nil {
+			return err
+		}
+	}
+	sf.count++
+	return nil
+}
+
+// readAll calls fn for every row written to the file.
+func (sf *spillFile) readAll(fn func([]sqltypes.Value) error) error {
+	if err := sf.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := sf.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(sf.file)
+	for i := 0; i < sf.count; i++ {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		row := make([]sqltypes.Value, n)
+		for j := range row {
+			typ, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			size, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			var raw []byte
+			if size != 0 {
+				raw = make([]byte, size)
+				if _, err := io.ReadFull(r, raw); err != nil {
+					return err
+				}
+			}
+			row[j] = sqltypes.MakeTrusted(querypb.Type(typ), raw)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
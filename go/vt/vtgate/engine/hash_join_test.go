@@ -0,0 +1,311 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sort"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newHashJoinTestPrims() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+				"null|d|dd",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"decimal|varchar|varchar",
+				),
+				"3.0|e|ee",
+				"1|f|ff",
+				"3|g|gg",
+				"null|h|hh",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinTestPrims()
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	// Normal join
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10"  true`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col2|col4|col5",
+		"int64|varchar|decimal|varchar",
+	)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a|1|f",
+		"3|c|3.0|e",
+		"3|c|3|g",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = hj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a|1|f",
+		"2|b|null|null",
+		"3|c|3.0|e",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinTestPrims()
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|decimal|varchar",
+		),
+		"1|a|1|f",
+		"2|b|null|null",
+		"3|c|3.0|e",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinExecuteNoResult(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"int64|varchar|varchar",
+				),
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+	})
+}
+
+func TestHashJoinSpill(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+				"4|d",
+				"5|e",
+				"null|f",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"5|v",
+				"4|w",
+				"3|x",
+				"1|y",
+				"null|z",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Spilled partitions are not joined in the order of the LHS.
+	sort.Slice(r.Rows, func(i, j int) bool {
+		return r.Rows[i][1].ToString() < r.Rows[j][1].ToString()
+	})
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|y",
+		"2|b|null",
+		"3|c|x",
+		"4|d|w",
+		"5|e|v",
+		"null|f|null",
+	))
+}
+
+func TestHashJoinSpillPartitionTooLarge(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2",
+					"int64",
+				),
+				"1",
+				"1",
+				"1",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	_, err := wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	want := "hash join partition row count exceeded allowed limit of 2"
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute(): %v, want %v", err, want)
+	}
+}
+
+func TestHashJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim, rightPrim := newHashJoinTestPrims()
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	_, err := hj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	want := "in-memory row count exceeded allowed limit of 2"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -81,13 +82,31 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// Input is set for INSERT ... SELECT into a sharded keyspace.
+	// The rows it returns are inserted in batches, and VindexValues
+	// and Mid are computed for every batch. The rows are padded with
+	// NULLs up to ColumnCount, which is the number of columns in the
+	// column list of Prefix.
+	Input       Primitive `json:",omitempty"`
+	ColumnCount int       `json:",omitempty"`
+
+	// VindexOffsets are the offsets of the vindex columns in the rows
+	// of Input. VindexOffsets[i][j] is the offset of the j'th column
+	// of the i'th colvindex.
+	VindexOffsets [][]int `json:",omitempty"`
+
+	// GenerateOffset is the offset of the auto-increment column in the
+	// rows of Input. It's only used if Generate is set.
+	GenerateOffset int `json:",omitempty"`
 
 	// Insert needs tx handling
 	txNeeded
 }
 
+// insertSelectBatchSize is the number of rows of an INSERT ... SELECT
+// that are sent to the shards with each insert.
+var insertSelectBatchSize = 500
+
 // NewQueryInsert creates an Insert with a query string.
 func NewQueryInsert(opcode InsertOpcode, keyspace *vindexes.Keyspace, query string) *Insert {
 	return &Insert{
@@ -195,6 +214,9 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
 	case InsertSharded, InsertShardedIgnore:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -202,6 +224,14 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	}
 }
 
+// Inputs returns the input primitive of an INSERT ... SELECT, if any.
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 // StreamExecute performs a streaming exec.
 func (ins *Insert) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	return fmt.Errorf("query %q cannot be used for streaming", ins.Query)
@@ -250,7 +280,8 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 		return nil, vterrors.Wrap(err, "execInsertSharded")
 	}
 
-	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	// The batches of an INSERT ... SELECT must be committed together.
+	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && ins.Input == nil && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
 	if errs != nil {
 		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertSharded")
@@ -262,6 +293,127 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// execInsertSelect streams the rows of Input, and inserts
+// them into the sharded table in batches.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	var rows [][]sqltypes.Value
+	flush := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		qr, err := ins.execInsertRows(vcursor, bindVars, rows)
+		if err != nil {
+			return err
+		}
+		rows = nil
+		if qr == nil {
+			// All rows were skipped by an insert ignore.
+			return nil
+		}
+		result.RowsAffected += qr.RowsAffected
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+		return nil
+	}
+	err := ins.Input.StreamExecute(vcursor, bindVars, false, func(qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			rows = append(rows, row)
+			if len(rows) >= insertSelectBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if err := flush(); err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	return result, nil
+}
+
+// execInsertRows inserts one batch of rows of an INSERT ... SELECT.
+// It builds the VindexValues, Generate values and Mid for the rows,
+// as the planner would have for an INSERT with a VALUES clause,
+// and executes the result as a regular sharded insert.
+func (ins *Insert) execInsertRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	bv := make(map[string]*querypb.BindVariable, len(bindVars)+len(rows)*ins.ColumnCount)
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+
+	// Vindex columns are referenced by the name used for
+	// their vindex values, which have to be bound later.
+	columnVars := make([]string, ins.ColumnCount)
+	for vIdx, offsets := range ins.VindexOffsets {
+		for colIdx, offset := range offsets {
+			columnVars[offset] = "_" + ins.Table.ColumnVindexes[vIdx].Columns[colIdx].CompliantName()
+		}
+	}
+
+	batch := *ins
+	batch.Mid = make([]string, len(rows))
+	batch.VindexValues = make([]sqltypes.PlanValue, len(ins.VindexOffsets))
+	for vIdx, offsets := range ins.VindexOffsets {
+		batch.VindexValues[vIdx].Values = make([]sqltypes.PlanValue, len(offsets))
+		for colIdx := range offsets {
+			batch.VindexValues[vIdx].Values[colIdx].Values = make([]sqltypes.PlanValue, len(rows))
+		}
+	}
+	if ins.Generate != nil {
+		generate := *ins.Generate
+		generate.Values = sqltypes.PlanValue{Values: make([]sqltypes.PlanValue, len(rows))}
+		batch.Generate = &generate
+	}
+
+	buf := &bytes.Buffer{}
+	for rowNum, row := range rows {
+		values := make([]sqltypes.Value, ins.ColumnCount)
+		copy(values, row)
+		suffix := strconv.Itoa(rowNum)
+		if batch.Generate != nil {
+			batch.Generate.Values.Values[rowNum].Value = values[ins.GenerateOffset]
+		}
+		for vIdx, offsets := range ins.VindexOffsets {
+			for colIdx, offset := range offsets {
+				if batch.Generate != nil && offset == ins.GenerateOffset {
+					// The value is only known after the sequence is processed.
+					batch.VindexValues[vIdx].Values[colIdx].Values[rowNum].Key = SeqVarName + suffix
+					continue
+				}
+				batch.VindexValues[vIdx].Values[colIdx].Values[rowNum].Value = values[offset]
+			}
+		}
+
+		buf.Reset()
+		buf.WriteByte('(')
+		for i, value := range values {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			name := columnVars[i]
+			switch {
+			case name != "":
+				name += suffix
+			case batch.Generate != nil && i == ins.GenerateOffset:
+				name = SeqVarName + suffix
+			default:
+				name = "_c" + strconv.Itoa(i) + "_" + suffix
+				bv[name] = sqltypes.ValueBindVariable(value)
+			}
+			buf.WriteString(":" + name)
+		}
+		buf.WriteByte(')')
+		batch.Mid[rowNum] = buf.String()
+	}
+	return batch.execInsertSharded(vcursor, bv)
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
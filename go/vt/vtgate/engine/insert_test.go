@@ -423,6 +423,114 @@ func TestInsertShardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 2})
 }
 
+func TestInsertShardedSelect(t *testing.T) {
+	save := insertSelectBatchSize
+	insertSelectBatchSize = 2
+	defer func() { insertSelectBatchSize = save }()
+
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		nil,
+		ks.Tables["t1"],
+		"prefix ",
+		nil,
+		" suffix",
+	)
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|name",
+					"int64|varchar",
+				),
+				"1|a",
+				"null|b",
+				"3|c",
+			),
+		},
+	}
+	ins.ColumnCount = 3
+	ins.VindexOffsets = [][]int{{0}}
+	ins.GenerateOffset = 0
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query: "dummy_generate",
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{RowsAffected: 2},
+			{RowsAffected: 1},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// First batch: the second row needs a generated id.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id0, :_c1_0, :_c2_0) suffix ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"4" ` +
+			`_c1_0: type:VARCHAR value:"a" _c1_1: type:VARCHAR value:"b" _c2_0: _c2_1: ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"4" } ` +
+			`sharded.-20: prefix (:_id1, :_c1_1, :_c2_1) suffix ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"4" ` +
+			`_c1_0: type:VARCHAR value:"a" _c1_1: type:VARCHAR value:"b" _c2_0: _c2_1: ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"4" } ` +
+			`true false`,
+		// Second batch.
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id0, :_c1_0, :_c2_0) suffix ` +
+			`{__seq0: type:INT64 value:"3" _c1_0: type:VARCHAR value:"c" _c2_0: _id0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 4})
+}
+
 func TestInsertShardedOwned(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
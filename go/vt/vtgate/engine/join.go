@@ -79,14 +79,21 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 			wantfields = false
 			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 		}
-		for _, rrow := range rresult.Rows {
-			result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
-		}
-		if jn.Opcode == LeftJoin && len(rresult.Rows) == 0 {
-			result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
-			result.RowsAffected++
+		if jn.Opcode == SemiJoin || jn.Opcode == AntiJoin {
+			if jn.Opcode.matches(len(rresult.Rows) != 0) {
+				result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
+				result.RowsAffected++
+			}
 		} else {
-			result.RowsAffected += uint64(len(rresult.Rows))
+			for _, rrow := range rresult.Rows {
+				result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+			}
+			if jn.Opcode == LeftJoin && len(rresult.Rows) == 0 {
+				result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
+				result.RowsAffected++
+			} else {
+				result.RowsAffected += uint64(len(rresult.Rows))
+			}
 		}
 		if len(result.Rows) > vcursor.MaxMemoryRows() {
 			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
@@ -113,17 +120,36 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 					wantfields = false
 					result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 				}
-				for _, rrow := range rresult.Rows {
-					result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
-				}
 				if len(rresult.Rows) != 0 {
 					rowSent = true
 				}
+				if jn.Opcode == SemiJoin || jn.Opcode == AntiJoin {
+					// The row of the LHS is sent once the RHS is done.
+					if result.Fields == nil {
+						return nil
+					}
+					return callback(result)
+				}
+				for _, rrow := range rresult.Rows {
+					result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+				}
 				return callback(result)
 			})
 			if err != nil {
 				return err
 			}
+			if (jn.Opcode == SemiJoin || jn.Opcode == AntiJoin) && jn.Opcode.matches(rowSent) {
+				result := &sqltypes.Result{}
+				result.Rows = [][]sqltypes.Value{joinRows(
+					lrow,
+					nil,
+					jn.Cols,
+				)}
+				if err := callback(result); err != nil {
+					return err
+				}
+				continue
+			}
 			if jn.Opcode == LeftJoin && !rowSent {
 				result := &sqltypes.Result{}
 				result.Rows = [][]sqltypes.Value{joinRows(
@@ -211,15 +237,32 @@ type JoinOpcode int
 const (
 	NormalJoin = JoinOpcode(iota)
 	LeftJoin
+	// SemiJoin returns the rows of the LHS for which
+	// the RHS returns at least one row.
+	SemiJoin
+	// AntiJoin returns the rows of the LHS for which
+	// the RHS returns no rows.
+	AntiJoin
 )
 
 func (code JoinOpcode) String() string {
-	if code == NormalJoin {
+	switch code {
+	case NormalJoin:
 		return "Join"
+	case SemiJoin:
+		return "SemiJoin"
+	case AntiJoin:
+		return "AntiJoin"
 	}
 	return "LeftJoin"
 }
 
+// matches returns true if a row of the LHS should be returned
+// by a SemiJoin or AntiJoin, given whether the RHS returned rows.
+func (code JoinOpcode) matches(found bool) bool {
+	return found == (code == SemiJoin)
+}
+
 // MarshalJSON serializes the JoinOpcode as a JSON string.
 // It's used for testing and diagnostics.
 func (code JoinOpcode) MarshalJSON() ([]byte, error) {
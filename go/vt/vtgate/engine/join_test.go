@@ -415,6 +415,114 @@ func TestJoinStreamExecute(t *testing.T) {
 	))
 }
 
+func TestJoinExecuteSemiJoin(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1",
+				"1",
+			),
+		},
+	}
+
+	jn := &Join{
+		Opcode: SemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:VARCHAR value:"a"  true`,
+		`Execute bv: type:VARCHAR value:"b"  false`,
+		`Execute bv: type:VARCHAR value:"c"  false`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"3|c",
+	))
+
+	// Anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = AntiJoin
+	r, err = jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"2|b",
+	))
+
+	// Streaming semi join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = SemiJoin
+	r, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"3|c",
+	)
+	want.Fields = nil
+	expectResult(t, "jn.StreamExecute", r, want)
+
+	// Streaming anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = AntiJoin
+	r, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = sqltypes.MakeTestResult(
+		wantFields,
+		"2|b",
+	)
+	want.Fields = nil
+	expectResult(t, "jn.StreamExecute", r, want)
+}
+
 func TestGetFields(t *testing.T) {
 	leftPrim := &fakePrimitive{
 		results: []*sqltypes.Result{
@@ -46,6 +46,11 @@ type OrderedAggregate struct {
 	// the aggregation key.
 	Keys []int
 
+	// Having is the condition of the HAVING clause which must be
+	// evaluated on the aggregated rows. It can reference the columns
+	// which are truncated from the result.
+	Having Expr `json:",omitempty"`
+
 	// TruncateColumnCount specifies the number of columns to return
 	// in the final result. Rest of the columns are truncated
 	// from the result received. If 0, no truncation happens.
@@ -204,6 +209,19 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if current != nil {
 		out.Rows = append(out.Rows, current)
 	}
+	if oa.Having != nil {
+		rows := out.Rows[:0]
+		for _, row := range out.Rows {
+			ok, err := EvaluateCondition(oa.Having, bindVars, row)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rows = append(rows, row)
+			}
+		}
+		out.Rows = rows
+	}
 	out.RowsAffected = uint64(len(out.Rows))
 	return out, nil
 }
@@ -217,6 +235,16 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
+	// sendRow sends an aggregated row, if it satisfies the HAVING clause.
+	sendRow := func(row []sqltypes.Value) error {
+		if oa.Having != nil {
+			ok, err := EvaluateCondition(oa.Having, bindVars, row)
+			if err != nil || !ok {
+				return err
+			}
+		}
+		return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
+	}
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
@@ -244,7 +272,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := sendRow(current); err != nil {
 				return err
 			}
 			current, curDistinct = oa.convertRow(row)
@@ -256,7 +284,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := sendRow(current); err != nil {
 			return err
 		}
 	}
@@ -423,6 +451,9 @@ func (oa *OrderedAggregate) description() PrimitiveDescription {
 		"GroupBy":    groupBy,
 		"Distinct":   strconv.FormatBool(oa.HasDistinct),
 	}
+	if oa.Having != nil {
+		other["Having"] = oa.Having.String()
+	}
 	return PrimitiveDescription{
 		OperatorType: "Aggregate",
 		Variant:      "Ordered",
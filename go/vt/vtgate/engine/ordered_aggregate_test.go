@@ -196,6 +196,82 @@ func TestOrderedAggregateStreamExecuteTruncate(t *testing.T) {
 	assert.Equal(wantResults, results)
 }
 
+func TestOrderedAggregateExecuteHaving(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|count(*)|sum(val)",
+				"varbinary|decimal|decimal",
+			),
+			"a|1|1",
+			"a|1|2",
+			"b|2|null",
+			"c|3|4",
+			"c|4|5",
+		)},
+	}
+
+	// having count(*) > :min and sum(val) is not null
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+		}},
+		Keys: []int{0},
+		Having: &LogicalExpr{
+			Operator: LogicalAnd,
+			Left: &ComparisonExpr{
+				Operator: ">",
+				Left:     &ColumnExpr{Col: 1},
+				Right:    &LiteralExpr{Value: sqltypes.PlanValue{Key: "min"}},
+			},
+			Right: &IsNullExpr{Expr: &ColumnExpr{Col: 2}, Not: true},
+		},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+	bindVars := map[string]*querypb.BindVariable{"min": sqltypes.Int64BindVariable(1)}
+
+	result, err := oa.Execute(nil, bindVars, false)
+	assert.NoError(err)
+
+	wantFields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|decimal",
+	)
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"a|2",
+		"c|7",
+	)
+	assert.Equal(wantResult, result)
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = oa.StreamExecute(nil, bindVars, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"a|2",
+		"---",
+		"c|7",
+	)
+	assert.Equal(wantResults, results)
+
+	// The condition can't be evaluated without the bind variable.
+	fp.rewind()
+	_, err = oa.Execute(nil, nil, false)
+	assert.EqualError(err, "missing bind var min")
+}
+
 func TestOrderedAggregateGetFields(t *testing.T) {
 	assert := assert.New(t)
 	input := sqltypes.MakeTestResult(
@@ -87,6 +87,12 @@ type Plan struct {
 	Instructions           Primitive               // Instructions contains the instructions needed to fulfil the query.
 	sqlparser.BindVarNeeds                         // Stores BindVars needed to be provided as part of expression rewriting
 
+	// VSchemaKeyspaces and VSchemaNames are the keyspaces and the table or
+	// vindex names that were looked up in the vschema to build the plan.
+	// They are used to invalidate the plan when the vschema changes.
+	VSchemaKeyspaces map[string]bool
+	VSchemaNames     map[string]bool
+
 	mu           sync.Mutex    // Mutex to protect the fields below
 	ExecCount    uint64        // Count of times this plan was executed
 	ExecTime     time.Duration // Total execution time
@@ -66,8 +66,10 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a MultiColumn vindex, Values[0] is the list of values
+	// for the leading columns of the vindex.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -83,6 +85,10 @@ type Route struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
+	// MaxRows is the optional maximum number of rows the query may
+	// return across all shards. If 0, only the limits of the tablets apply.
+	MaxRows int
+
 	// ScatterErrorsAsWarnings is true if results should be returned even if some shards have an error
 	ScatterErrorsAsWarnings bool
 
@@ -145,6 +151,7 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		OrderBy                 []OrderbyParams      `json:",omitempty"`
 		TruncateColumnCount     int                  `json:",omitempty"`
 		QueryTimeout            int                  `json:",omitempty"`
+		MaxRows                 int                  `json:",omitempty"`
 		ScatterErrorsAsWarnings bool                 `json:",omitempty"`
 		Table                   string               `json:",omitempty"`
 	}{
@@ -157,6 +164,7 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		OrderBy:                 route.OrderBy,
 		TruncateColumnCount:     route.TruncateColumnCount,
 		QueryTimeout:            route.QueryTimeout,
+		MaxRows:                 route.MaxRows,
 		ScatterErrorsAsWarnings: route.ScatterErrorsAsWarnings,
 		Table:                   route.TableName,
 	}
@@ -248,26 +256,14 @@ func (route *Route) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 	if err != nil {
 		return nil, err
 	}
+	if route.MaxRows != 0 && len(qr.Rows) > route.MaxRows {
+		return nil, mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "row count exceeded %d", route.MaxRows)
+	}
 	return qr.Truncate(route.TruncateColumnCount), nil
 }
 
 func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	var rss []*srvtopo.ResolvedShard
-	var bvs []map[string]*querypb.BindVariable
-	var err error
-	switch route.Opcode {
-	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
-		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
-	case SelectScatter:
-		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
-	case SelectEqual, SelectEqualUnique:
-		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
-	case SelectIN:
-		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
-	default:
-		// Unreachable.
-		return nil, fmt.Errorf("unsupported query route: %v", route)
-	}
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -383,6 +379,24 @@ func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bind
 	return qr.Truncate(route.TruncateColumnCount), nil
 }
 
+// findRoute returns the shards the query must be sent to,
+// and the bind vars to use for each of them.
+func (route *Route) findRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	switch route.Opcode {
+	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
+		return route.paramsAnyShard(vcursor, bindVars)
+	case SelectScatter:
+		return route.paramsAllShards(vcursor, bindVars)
+	case SelectEqual, SelectEqualUnique:
+		return route.paramsSelectEqual(vcursor, bindVars)
+	case SelectIN:
+		return route.paramsSelectIn(vcursor, bindVars)
+	default:
+		// Unreachable.
+		return nil, nil, fmt.Errorf("unsupported query route: %v", route)
+	}
+}
+
 func (route *Route) paramsAllShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
@@ -408,11 +422,18 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		rss, err = route.resolveMultiColumnShards(vcursor, bindVars)
+	} else {
+		var key sqltypes.Value
+		key, err = route.Values[0].ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
+		}
+		rss, _, err = resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
@@ -435,15 +456,32 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 	return rss, shardVars(bindVars, values), nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+// resolveMultiColumnShards resolves the shards for the values of
+// the leading columns of a MultiColumn vindex.
+func (route *Route) resolveMultiColumnShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	row, err := route.Values[0].ResolveList(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := vindexes.Map(route.Vindex, vcursor, [][]sqltypes.Value{row})
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	return rss, err
+}
+
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
+	rowsColValues := make([][]sqltypes.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
 		ids[i] = sqltypes.ValueToProto(vik)
+		rowsColValues[i] = []sqltypes.Value{vik}
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -493,8 +531,8 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -517,8 +555,8 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	return rss[0], ksid, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
@@ -582,6 +620,12 @@ func (route *Route) description() PrimitiveDescription {
 	if len(route.Values) > 0 {
 		other["Values"] = route.Values
 	}
+	if route.QueryTimeout != 0 {
+		other["QueryTimeout"] = route.QueryTimeout
+	}
+	if route.MaxRows != 0 {
+		other["MaxRows"] = route.MaxRows
+	}
 
 	return PrimitiveDescription{
 		OperatorType:      "Route",
@@ -193,6 +193,56 @@ func TestSelectEqualUniqueScatter(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectEqualMultiColumn(t *testing.T) {
+	vindex, err := vindexes.NewMultiCol("tenant_user", map[string]string{"column_count": "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Values: []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+		{Value: sqltypes.NewInt64(1)},
+	}}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b4166b40b4)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// A prefix of the columns routes to a key range.
+	sel.Opcode = SelectEqual
+	sel.Values = []sqltypes.PlanValue{{Values: []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+	}}}
+	vc.Rewind()
+	_, err = wrapStreamExecute(sel, vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(166b40b4-166b40b5)`,
+		`StreamExecuteMulti dummy_select ks.-20: {} ks.20-: {} `,
+	})
+}
+
 func TestSelectEqual(t *testing.T) {
 	vindex, _ := vindexes.NewLookup("", map[string]string{
 		"table": "lkp",
@@ -752,6 +802,43 @@ func TestRouteStreamTruncate(t *testing.T) {
 	expectResult(t, "sel.Execute", result, wantResult)
 }
 
+func TestRouteMaxRows(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.MaxRows = 2
+
+	result := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+	)
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{result},
+	}
+	_, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "sel.Execute", err, "row count exceeded 2 (errno 10001) (sqlstate HY000)")
+
+	sel.MaxRows = 3
+	vc.Rewind()
+	got, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sel.Execute", got, result)
+}
+
 func TestRouteStreamSortTruncate(t *testing.T) {
 	sel := NewRoute(
 		SelectUnsharded,
@@ -118,11 +118,7 @@ func (upd *Update) execUpdateUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := upd.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, vterrors.Wrap(err, "execUpdateEqual")
-	}
-	rs, ksid, err := resolveSingleShard(vcursor, upd.Vindex, upd.Keyspace, key)
+	rs, ksid, err := upd.resolveEqualShard(vcursor, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateEqual")
 	}
@@ -164,12 +160,20 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 		fieldColNumMap[field.Name] = colNum
 	}
 
+	// Collect the old and new entries of all rows for each changing
+	// vindex. Vindexes that support it update them all at once. The
+	// others get one Update per row.
+	type vindexUpdates struct {
+		fromIds, toIds [][]sqltypes.Value
+		ksids          [][]byte
+	}
+	updates := make([]vindexUpdates, len(upd.Table.Owned))
 	for _, row := range subQueryResult.Rows {
-		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[0])
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[:upd.KsidLength])
 		if err != nil {
 			return err
 		}
-		for _, colVindex := range upd.Table.Owned {
+		for i, colVindex := range upd.Table.Owned {
 			// Update columns only if they're being changed.
 			if updColValues, ok := upd.ChangedVindexValues[colVindex.Name]; ok {
 				fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
@@ -189,10 +193,26 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 						vindexColumnKeys = append(vindexColumnKeys, origColValue)
 					}
 				}
+				updates[i].fromIds = append(updates[i].fromIds, fromIds)
+				updates[i].toIds = append(updates[i].toIds, vindexColumnKeys)
+				updates[i].ksids = append(updates[i].ksids, ksid)
+			}
+		}
+	}
 
-				if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, fromIds, ksid, vindexColumnKeys); err != nil {
-					return err
-				}
+	for i, colVindex := range upd.Table.Owned {
+		if len(updates[i].ksids) == 0 {
+			continue
+		}
+		if batch, ok := colVindex.Vindex.(vindexes.BatchUpdater); ok {
+			if err := batch.BatchUpdate(vcursor, updates[i].fromIds, updates[i].ksids, updates[i].toIds); err != nil {
+				return err
+			}
+			continue
+		}
+		for j, fromIds := range updates[i].fromIds {
+			if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, fromIds, updates[i].ksids[j], updates[i].toIds[j]); err != nil {
+				return err
 			}
 		}
 	}
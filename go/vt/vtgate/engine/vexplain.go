@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*VExplain)(nil)
+
+// VExplain is the primitive for a VEXPLAIN statement. Instead of
+// executing its input, it returns one row for each primitive of
+// the plan, in the order they appear in the tree. For routes,
+// the shards the query would be sent to are resolved, which may
+// require the lookup vindexes to be queried. If they can't be
+// resolved before execution, for instance because they depend on
+// the results of a join, the shards are reported as unknown.
+type VExplain struct {
+	Input Primitive
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (v *VExplain) RouteType() string {
+	return "VExplain"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (v *VExplain) GetKeyspaceName() string {
+	return v.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (v *VExplain) GetTableName() string {
+	return v.Input.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (v *VExplain) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{Fields: vexplainFields()}
+	addVExplainRows(vcursor, bindVars, v.Input, 0, result)
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (v *VExplain) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := v.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields satisfies the Primitive interface.
+func (v *VExplain) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: vexplainFields()}, nil
+}
+
+// Inputs returns the plan being explained.
+func (v *VExplain) Inputs() []Primitive {
+	return []Primitive{v.Input}
+}
+
+// NeedsTransaction returns false because nothing is executed.
+func (v *VExplain) NeedsTransaction() bool {
+	return false
+}
+
+func (v *VExplain) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "VExplain",
+	}
+}
+
+func vexplainFields() []*querypb.Field {
+	return []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "operator", Type: sqltypes.VarChar},
+		{Name: "variant", Type: sqltypes.VarChar},
+		{Name: "keyspace", Type: sqltypes.VarChar},
+		{Name: "table", Type: sqltypes.VarChar},
+		{Name: "shards", Type: sqltypes.VarChar},
+		{Name: "query", Type: sqltypes.VarChar},
+	}
+}
+
+// addVExplainRows adds the row of the primitive, and those of its
+// inputs, to the result. The operator is indented by its depth in
+// the tree.
+func addVExplainRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, prim Primitive, depth int, result *sqltypes.Result) {
+	desc := prim.description()
+	var keyspace, shards string
+	if desc.Keyspace != nil {
+		keyspace = desc.Keyspace.Name
+	}
+	if desc.TargetDestination != nil {
+		shards = desc.TargetDestination.String()
+	} else if route, ok := prim.(*Route); ok {
+		shards = routeShards(vcursor, bindVars, route)
+	}
+	table, _ := desc.Other["Table"].(string)
+	query, _ := desc.Other["Query"].(string)
+	result.Rows = append(result.Rows, []sqltypes.Value{
+		sqltypes.NewInt64(int64(len(result.Rows) + 1)),
+		sqltypes.NewVarChar(strings.Repeat("  ", depth) + desc.OperatorType),
+		sqltypes.NewVarChar(desc.Variant),
+		sqltypes.NewVarChar(keyspace),
+		sqltypes.NewVarChar(table),
+		sqltypes.NewVarChar(shards),
+		sqltypes.NewVarChar(query),
+	})
+	for _, input := range prim.Inputs() {
+		addVExplainRows(vcursor, bindVars, input, depth+1, result)
+	}
+}
+
+// routeShards returns the comma separated list of the shards
+// the route would send its query to.
+func routeShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable, route *Route) string {
+	rss, _, err := route.findRoute(vcursor, bindVars)
+	if err != nil {
+		return "unknown"
+	}
+	shards := make([]string, 0, len(rss))
+	for _, rs := range rss {
+		shards = append(shards, rs.Target.Keyspace+"/"+rs.Target.Shard)
+	}
+	return strings.Join(shards, ",")
+}
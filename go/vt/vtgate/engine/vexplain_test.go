@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestVExplainExecute(t *testing.T) {
+	ks := &vindexes.Keyspace{
+		Name:    "ks",
+		Sharded: true,
+	}
+	vindex, _ := vindexes.NewHash("", nil)
+	left := NewRoute(SelectEqualUnique, ks, "select id from t1 where id = :id", "select id from t1 where 1 != 1")
+	left.TableName = "t1"
+	left.Vindex = vindex.(vindexes.SingleColumn)
+	left.Values = []sqltypes.PlanValue{{Key: "id"}}
+	right := NewRoute(SelectEqualUnique, ks, "select col from t2 where id = :t1_id", "select col from t2 where 1 != 1")
+	right.TableName = "t2"
+	right.Vindex = vindex.(vindexes.SingleColumn)
+	right.Values = []sqltypes.PlanValue{{Key: "t1_id"}}
+	scatter := NewRoute(SelectScatter, ks, "select col from t3", "select col from t3 where 1 != 1")
+	scatter.TableName = "t3"
+	v := &VExplain{
+		Input: &Concatenate{
+			Sources: []Primitive{
+				&Join{
+					Opcode: NormalJoin,
+					Left:   left,
+					Right:  right,
+					Cols:   []int{1},
+					Vars:   map[string]int{"t1_id": 0},
+				},
+				scatter,
+			},
+		},
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"id": sqltypes.Int64BindVariable(1),
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|operator|variant|keyspace|table|shards|query",
+			"int64|varchar|varchar|varchar|varchar|varchar|varchar",
+		),
+		"1|Concatenate|||||",
+		"2|Join|Join||||",
+		"3|Route|SelectEqualUnique|ks|t1|ks/-20|select id from t1 where id = :id",
+		"4|Route|SelectEqualUnique|ks|t2|unknown|select col from t2 where id = :t1_id",
+		"5|Route|SelectScatter|ks|t3|ks/-20,ks/20-|select col from t3",
+	)
+	// MakeTestResult trims the values, so the indentation is set here.
+	for i, operator := range []string{"Concatenate", "  Join", "    Route", "    Route", "  Route"} {
+		want.Rows[i][1] = sqltypes.NewVarChar(operator)
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err := v.Execute(vc, bindVars, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+	})
+	expectResult(t, "v.Execute", result, want)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(v, vc, bindVars, true)
+	require.NoError(t, err)
+	expectResult(t, "v.StreamExecute", result, want)
+
+	result, err = v.GetFields(vc, bindVars)
+	require.NoError(t, err)
+	expectResult(t, "v.GetFields", result, &sqltypes.Result{Fields: want.Fields})
+}
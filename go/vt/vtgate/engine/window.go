@@ -0,0 +1,369 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions on the
+// results of the underlying primitive. It expects the rows to be
+// sorted by the PartitionBy columns, followed by the OrderBy columns.
+// The underlying primitive is typically a scatter route which merges
+// the sorted results of the shards.
+// Each window function replaces the value of its column. For ROW_NUMBER,
+// RANK and DENSE_RANK, the column is a placeholder. For LAG and LEAD,
+// the column contains the argument of the function.
+type Window struct {
+	// PartitionBy specifies the columns which identify a partition.
+	PartitionBy []int `json:",omitempty"`
+	// OrderBy specifies the columns of the ORDER BY of the window.
+	// Rows with the same values are peers, which get the same rank.
+	OrderBy []int `json:",omitempty"`
+	// Functions specifies the window functions to evaluate.
+	Functions []WindowParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowParams specify the parameters of a window function.
+type WindowParams struct {
+	Opcode WindowOpcode
+	Col    int
+	// Offset is the offset of LAG and LEAD.
+	Offset sqltypes.PlanValue
+	// Default is the value of LAG and LEAD when there is no row
+	// at the offset in the partition.
+	Default sqltypes.PlanValue
+	// Alias is the name of the column of the result.
+	Alias string
+}
+
+func (wp WindowParams) isOffset() bool {
+	return wp.Opcode == WindowLag || wp.Opcode == WindowLead
+}
+
+func (wp WindowParams) String() string {
+	args := strconv.Itoa(wp.Col)
+	if !wp.Offset.IsNull() {
+		offset, _ := wp.Offset.MarshalJSON()
+		args += ", " + string(offset)
+	}
+	if !wp.Default.IsNull() {
+		dflt, _ := wp.Default.MarshalJSON()
+		args += ", " + string(dflt)
+	}
+	return fmt.Sprintf("%s(%s) AS %s", wp.Opcode.String(), args, wp.Alias)
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowLag
+	WindowLead
+)
+
+// SupportedWindowFunctions maps the list of window functions
+// which can be evaluated by vtgate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+	"lag":        WindowLag,
+	"lead":       WindowLead,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result.Fields = w.convertFields(result.Fields)
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			same, err := w.sameValues(w.PartitionBy, result.Rows[i-1], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				continue
+			}
+		}
+		if err := w.evaluate(bindVars, result.Rows[start:i]); err != nil {
+			return nil, err
+		}
+		start = i
+	}
+	return result.Truncate(w.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+// The rows of a partition are buffered until the first row of the
+// next partition is received.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var partition [][]sqltypes.Value
+
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+	sendPartition := func() error {
+		if len(partition) == 0 {
+			return nil
+		}
+		if err := w.evaluate(bindVars, partition); err != nil {
+			return err
+		}
+		rows := partition
+		partition = nil
+		return cb(&sqltypes.Result{Rows: rows})
+	}
+
+	err := w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		for _, row := range qr.Rows {
+			if len(partition) != 0 {
+				same, err := w.sameValues(w.PartitionBy, partition[len(partition)-1], row)
+				if err != nil {
+					return err
+				}
+				if !same {
+					if err := sendPartition(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+			if len(partition) > vcursor.MaxMemoryRows() {
+				return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendPartition()
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this window.
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction is a Primitive function.
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	fields = append([]*querypb.Field(nil), fields...)
+	for _, wp := range w.Functions {
+		field := &querypb.Field{Name: wp.Alias, Type: sqltypes.Uint64}
+		switch wp.Opcode {
+		case WindowLag, WindowLead:
+			field.Type = fields[wp.Col].Type
+		}
+		fields[wp.Col] = field
+	}
+	return fields
+}
+
+// evaluate evaluates the window functions on the rows of a partition.
+func (w *Window) evaluate(bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) error {
+	// The values of LAG and LEAD are replaced in place.
+	// So, the arguments must be saved before.
+	args := make([][]sqltypes.Value, len(w.Functions))
+	offsets := make([]int, len(w.Functions))
+	defaults := make([]sqltypes.Value, len(w.Functions))
+	for i, wp := range w.Functions {
+		if !wp.isOffset() {
+			continue
+		}
+		var err error
+		if offsets[i], err = wp.fetchOffset(bindVars); err != nil {
+			return err
+		}
+		if defaults[i], err = wp.Default.ResolveValue(bindVars); err != nil {
+			return err
+		}
+		args[i] = make([]sqltypes.Value, len(rows))
+		for j, row := range rows {
+			args[i][j] = row[wp.Col]
+		}
+	}
+
+	rank, denseRank := 1, 1
+	for j, row := range rows {
+		if j > 0 {
+			peer, err := w.sameValues(w.OrderBy, rows[j-1], row)
+			if err != nil {
+				return err
+			}
+			if !peer {
+				rank = j + 1
+				denseRank++
+			}
+		}
+		for i, wp := range w.Functions {
+			switch wp.Opcode {
+			case WindowRowNumber:
+				row[wp.Col] = sqltypes.NewUint64(uint64(j + 1))
+			case WindowRank:
+				row[wp.Col] = sqltypes.NewUint64(uint64(rank))
+			case WindowDenseRank:
+				row[wp.Col] = sqltypes.NewUint64(uint64(denseRank))
+			case WindowLag:
+				row[wp.Col] = valueAt(args[i], j-offsets[i], defaults[i])
+			case WindowLead:
+				row[wp.Col] = valueAt(args[i], j+offsets[i], defaults[i])
+			default:
+				return fmt.Errorf("BUG: Unexpected opcode: %v", wp.Opcode)
+			}
+		}
+	}
+	return nil
+}
+
+func (wp WindowParams) fetchOffset(bindVars map[string]*querypb.BindVariable) (int, error) {
+	resolved, err := wp.Offset.ResolveValue(bindVars)
+	if err != nil {
+		return 0, err
+	}
+	if resolved.IsNull() {
+		return 1, nil
+	}
+	num, err := sqltypes.ToUint64(resolved)
+	if err != nil {
+		return 0, err
+	}
+	offset := int(num)
+	if offset < 0 {
+		return 0, fmt.Errorf("requested offset is out of range: %v", num)
+	}
+	return offset, nil
+}
+
+func valueAt(values []sqltypes.Value, index int, dflt sqltypes.Value) sqltypes.Value {
+	if index >= 0 && index < len(values) {
+		return values[index]
+	}
+	return dflt
+}
+
+func (w *Window) sameValues(cols []int, row1, row2 []sqltypes.Value) (bool, error) {
+	for _, col := range cols {
+		cmp, err := sqltypes.NullsafeCompare(row1[col], row2[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func windowParamsToString(in interface{}) string {
+	return in.(WindowParams).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowParamsToString),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, intToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, intToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newTestWindow(input Primitive) *Window {
+	return &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []int{1},
+		Functions: []WindowParams{{
+			Opcode: WindowRowNumber,
+			Col:    2,
+			Alias:  "rn",
+		}, {
+			Opcode: WindowRank,
+			Col:    3,
+			Alias:  "rk",
+		}, {
+			Opcode: WindowDenseRank,
+			Col:    4,
+			Alias:  "drk",
+		}, {
+			Opcode: WindowLag,
+			Col:    5,
+			Alias:  "lg",
+		}, {
+			Opcode:  WindowLead,
+			Col:     6,
+			Offset:  sqltypes.PlanValue{Key: "offset"},
+			Default: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)},
+			Alias:   "ld",
+		}},
+		TruncateColumnCount: 7,
+		Input:               input,
+	}
+}
+
+func newTestWindowInput() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"a|b|0|0|0|b|b|weight_string(a)",
+				"varbinary|int64|int64|int64|int64|int64|int64|varbinary",
+			),
+			"x|1|0|0|0|1|1|X",
+			"x|1|0|0|0|1|1|X",
+			"x|2|0|0|0|2|2|X",
+			"y|5|0|0|0|5|5|Y",
+			"y|7|0|0|0|7|7|Y",
+		)},
+	}
+}
+
+var windowBindVars = map[string]*querypb.BindVariable{
+	"offset": sqltypes.Int64BindVariable(1),
+}
+
+var wantWindowFields = sqltypes.MakeTestFields(
+	"a|b|rn|rk|drk|lg|ld",
+	"varbinary|int64|uint64|uint64|uint64|int64|int64",
+)
+
+func TestWindowExecute(t *testing.T) {
+	w := newTestWindow(newTestWindowInput())
+
+	result, err := w.Execute(nil, windowBindVars, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		wantWindowFields,
+		"x|1|1|1|1|null|1",
+		"x|1|2|1|1|1|2",
+		"x|2|3|3|2|1|0",
+		"y|5|1|1|1|null|7",
+		"y|7|2|2|2|5|0",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	w := newTestWindow(newTestWindowInput())
+
+	result, err := wrapStreamExecute(w, noopVCursor{}, windowBindVars, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		wantWindowFields,
+		"x|1|1|1|1|null|1",
+		"x|1|2|1|1|1|2",
+		"x|2|3|3|2|1|0",
+		"y|5|1|1|1|null|7",
+		"y|7|2|2|2|5|0",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	w := newTestWindow(newTestWindowInput())
+	err := w.StreamExecute(noopVCursor{}, windowBindVars, false, func(qr *sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fp := newTestWindowInput()
+	w := newTestWindow(fp)
+
+	result, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &sqltypes.Result{Fields: wantWindowFields}, result)
+}
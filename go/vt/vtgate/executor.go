@@ -26,6 +26,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
@@ -44,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -65,6 +67,9 @@ var (
 
 	queriesProcessedByTable = stats.NewCountersWithMultiLabels("QueriesProcessedByTable", "Queries processed at vtgate by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
 	queriesRoutedByTable    = stats.NewCountersWithMultiLabels("QueriesRoutedByTable", "Queries routed from vtgate to vttablet by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
+
+	plansInvalidated           = stats.NewCounter("QueryPlanCacheInvalidations", "Query plans invalidated by vschema updates")
+	plansInvalidatedLastUpdate = stats.NewGauge("QueryPlanCacheLastUpdateInvalidations", "Query plans invalidated by the last vschema update")
 )
 
 const (
@@ -146,6 +151,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathVindex, e)
 	})
 	return e
 }
@@ -211,7 +217,7 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 	}
 
 	switch stmtType {
-	case sqlparser.StmtSelect:
+	case sqlparser.StmtSelect, sqlparser.StmtVExplain:
 		return e.handleExec(ctx, safeSession, sql, bindVars, logStats, stmtType)
 	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
 		safeSession := safeSession
@@ -264,6 +270,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleComment(sql)
 	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback:
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "should be handled by plan_execute")
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "savepoint statements are not supported: %s", sql)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized statement: %s", sql)
 }
@@ -294,6 +302,14 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	}
 
 	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
+	if err != nil && buffer.IsShardMissingError(err) && !safeSession.HasShardSessions() &&
+		(stmtType == sqlparser.StmtSelect || atomic.LoadUint32(&vcursor.executedQueries) == 0) {
+		// The request was buffered during a resharding cutover and its shard
+		// went away. Executing the plan again resolves the new shards. This
+		// is only done if it can't apply a write twice: for a read, or if
+		// nothing was executed yet.
+		qr, err = plan.Instructions.Execute(vcursor, bindVars, true)
+	}
 	logStats.ExecuteTime = time.Since(execStart)
 
 	e.updateQueryCounts(plan.Instructions.RouteType(), plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName(), int64(logStats.ShardQueries))
@@ -304,7 +320,9 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		errCount = 1
 	} else {
 		logStats.RowsAffected = qr.RowsAffected
-		if qr != nil && stmtType == sqlparser.StmtInsert {
+		// Like MySQL, LAST_INSERT_ID is left unchanged by statements
+		// that don't generate a value.
+		if qr != nil && stmtType == sqlparser.StmtInsert && qr.InsertID > 0 {
 			safeSession.LastInsertId = qr.InsertID
 		}
 	}
@@ -439,7 +457,12 @@ func (e *Executor) handleBegin(ctx context.Context, safeSession *SafeSession, de
 	}
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
-	err := e.txConn.Begin(ctx, safeSession)
+	var err error
+	if e.txConn.nestedSavepoints && safeSession.InTransaction() {
+		err = e.txConn.Savepoint(ctx, safeSession)
+	} else {
+		err = e.txConn.Begin(ctx, safeSession)
+	}
 	logStats.ExecuteTime = time.Since(execStart)
 
 	e.updateQueryCounts("Begin", "", "", 0)
@@ -453,7 +476,12 @@ func (e *Executor) handleCommit(ctx context.Context, safeSession *SafeSession, l
 	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
 	e.updateQueryCounts("Commit", "", "", int64(logStats.ShardQueries))
 
-	err := e.txConn.Commit(ctx, safeSession)
+	var err error
+	if safeSession.HasSavepoints() {
+		err = e.txConn.ReleaseSavepoint(ctx, safeSession)
+	} else {
+		err = e.txConn.Commit(ctx, safeSession)
+	}
 	logStats.CommitTime = time.Since(execStart)
 	return &sqltypes.Result{}, err
 }
@@ -463,7 +491,12 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
 	e.updateQueryCounts("Rollback", "", "", int64(logStats.ShardQueries))
-	err := e.txConn.Rollback(ctx, safeSession)
+	var err error
+	if safeSession.HasSavepoints() {
+		err = e.txConn.RollbackToSavepoint(ctx, safeSession)
+	} else {
+		err = e.txConn.Rollback(ctx, safeSession)
+	}
 	logStats.CommitTime = time.Since(execStart)
 	return &sqltypes.Result{}, err
 }
@@ -548,6 +581,20 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				default:
 					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for skip_query_plan_cache: %d", val)
 				}
+			case "read_after_write":
+				val, err := validateSetOnOff(v, k.Key)
+				if err != nil {
+					return nil, err
+				}
+				switch val {
+				case 0:
+					safeSession.ReadAfterWrite = false
+					safeSession.WriteGtids = nil
+				case 1:
+					safeSession.ReadAfterWrite = true
+				default:
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for read_after_write: %d", val)
+				}
 			case "sql_safe_updates":
 				val, err := validateSetOnOff(v, k.Key)
 				if err != nil {
@@ -599,6 +646,19 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				default:
 					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for %v: %d", k.Key, val)
 				}
+			case "target_shards":
+				val, ok := v.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for target_shards: %T", v)
+				}
+				target, err := targetWithShards(safeSession.TargetString, val)
+				if err != nil {
+					return nil, err
+				}
+				if _, _, _, err := e.ParseDestinationTarget(target); err != nil {
+					return nil, err
+				}
+				safeSession.TargetString = target
 			case "workload":
 				val, ok := v.(string)
 				if !ok {
@@ -679,6 +739,25 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 	return &sqltypes.Result{}, nil
 }
 
+// targetWithShards replaces the shards of the target string with the comma
+// separated list of shards. An empty list removes the shards from the target.
+func targetWithShards(targetString, shards string) (string, error) {
+	keyspace, tabletType := targetString, ""
+	if last := strings.LastIndexAny(keyspace, "@"); last != -1 {
+		keyspace, tabletType = keyspace[:last], keyspace[last:]
+	}
+	if last := strings.LastIndexAny(keyspace, "/:["); last != -1 {
+		keyspace = keyspace[:last]
+	}
+	if keyspace == "" {
+		return "", vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "target_shards requires a keyspace to be selected with USE")
+	}
+	if shards == "" {
+		return keyspace + tabletType, nil
+	}
+	return keyspace + ":" + shards + tabletType, nil
+}
+
 func handleSetUserDefinedVariables(session *SafeSession, k sqlparser.SetKey, v interface{}) error {
 	variable, err := sqltypes.BuildBindVariable(v)
 	if err != nil {
@@ -1258,7 +1337,10 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	return err
 }
 
-// handleMessageStream executes queries of the form 'stream * from t'
+// handleMessageStream executes queries of the form 'stream * from t'.
+// The resume token of the consumer is given by the RESUME_TOKEN comment
+// directive. The rows of a resumable stream have a resume_token column,
+// which the consumer saves along with its progress.
 func (e *Executor) handleMessageStream(ctx context.Context, sql string, target querypb.Target, callback func(*sqltypes.Result) error, vcursor *vcursorImpl, logStats *LogStats) error {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -1282,7 +1364,17 @@ func (e *Executor) handleMessageStream(ctx context.Context, sql string, target q
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
-	err = e.MessageStream(ctx, table.Keyspace.Name, target.Shard, nil, table.Name.CompliantName(), callback)
+	var resumeToken string
+	if token, ok := sqlparser.ExtractCommentDirectives(streamStmt.Comments)[sqlparser.DirectiveResumeToken]; ok {
+		resumeToken = fmt.Sprint(token)
+		// A RESUME_TOKEN directive without a value starts a
+		// resumable stream.
+		if b, ok := token.(bool); ok && b {
+			resumeToken = emptyMessageResumeToken
+		}
+	}
+
+	err = e.MessageStream(ctx, table.Keyspace.Name, target.Shard, nil, table.Name.CompliantName(), resumeToken, callback)
 	logStats.Error = err
 	logStats.ExecuteTime = time.Since(execStart)
 	return err
@@ -1290,13 +1382,14 @@ func (e *Executor) handleMessageStream(ctx context.Context, sql string, target q
 
 // MessageStream is part of the vtgate service API. This is a V2 level API that's sent
 // to the Resolver.
-func (e *Executor) MessageStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, name string, callback func(*sqltypes.Result) error) error {
+func (e *Executor) MessageStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, name, resumeToken string, callback func(*sqltypes.Result) error) error {
 	err := e.resolver.MessageStream(
 		ctx,
 		keyspace,
 		shard,
 		keyRange,
 		name,
+		resumeToken,
 		callback,
 	)
 	return formatError(err)
@@ -1311,11 +1404,31 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 
 // SaveVSchema updates the vschema and stats
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
+	e.saveVSchema(vschema, stats, nil)
+}
+
+// saveVSchema saves the vschema and invalidates the cached plans
+// affected by the changes. If changes is nil, all plans are invalidated.
+func (e *Executor) saveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats, changes *vschemaChanges) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.vschema = vschema
 	e.vschemaStats = stats
-	e.plans.Clear()
+
+	var invalidated int64
+	if changes == nil {
+		invalidated = e.plans.Length()
+		e.plans.Clear()
+	} else {
+		for _, item := range e.plans.Items() {
+			if changes.affects(item.Value.(*engine.Plan)) {
+				e.plans.Delete(item.Key)
+				invalidated++
+			}
+		}
+	}
+	plansInvalidated.Add(invalidated)
+	plansInvalidatedLastUpdate.Set(invalidated)
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
@@ -1355,10 +1468,18 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, err
 	}
 	if !e.normalize || !sqlparser.CanNormalize(stmt) {
-		plan, err := planbuilder.BuildFromStmt(sql, stmt, vcursor, sqlparser.BindVarNeeds{})
+		// Functions like LAST_INSERT_ID() still have to be answered by
+		// vtgate, because the value kept by the mysql connections of the
+		// shards does not reflect the session.
+		result, err := sqlparser.RewriteAST(stmt)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "failed to rewrite ast before planning")
+		}
+		plan, err := planbuilder.BuildFromStmt(sql, result.AST, vcursor, result.BindVarNeeds)
 		if err != nil {
 			return nil, err
 		}
+		vcursor.setVSchemaDependencies(plan)
 		if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(stmt) {
 			e.plans.Set(planKey, plan)
 		}
@@ -1386,6 +1507,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	if err != nil {
 		return nil, err
 	}
+	vcursor.setVSchemaDependencies(plan)
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(rewrittenStatement) {
 		e.plans.Set(planKey, plan)
 	}
@@ -1414,6 +1536,8 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathVindex:
+		e.serveVindex(response, request)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1620,7 +1744,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	}
 
 	switch stmtType {
-	case sqlparser.StmtSelect:
+	case sqlparser.StmtSelect, sqlparser.StmtVExplain:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
 		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment:
@@ -1675,7 +1799,12 @@ func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.Resolve
 	return e.scatterConn.ExecuteMultiShard(ctx, rss, queries, tabletType, session, notInTransaction, autocommit)
 }
 
+// AfterCommit implements the IExecutor interface
+func (e *Executor) AfterCommit(session *SafeSession, f func()) {
+	e.txConn.AfterCommit(session, f)
+}
+
 // StreamExecuteMulti implements the IExecutor interface
-func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, tabletType, options, callback)
+func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, tabletType topodatapb.TabletType, session *SafeSession, callback func(reply *sqltypes.Result) error) error {
+	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, tabletType, session, callback)
 }
@@ -1434,6 +1434,43 @@ func TestMultiInsertGenerator(t *testing.T) {
 	}
 }
 
+func TestInsertGeneratorLastInsertID(t *testing.T) {
+	executor, sbc, _, sbclookup := createExecutorEnv()
+	masterSession = &vtgatepb.Session{TargetString: "@master"}
+	defer func() { masterSession = &vtgatepb.Session{TargetString: "@master"} }()
+
+	sbclookup.SetResults([]*sqltypes.Result{{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(5),
+		}},
+		RowsAffected: 1,
+	}, {
+		RowsAffected: 2,
+	}, {
+		// The insert into the lookup table must not change LAST_INSERT_ID.
+		RowsAffected: 1,
+		InsertID:     99,
+	}})
+	_, err := executorExec(executor, "insert into music(user_id, name) values (:u, 'myname1'),(:u, 'myname2')", map[string]*querypb.BindVariable{"u": sqltypes.Int64BindVariable(2)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, masterSession.LastInsertId)
+
+	// An insert that doesn't generate a value leaves LAST_INSERT_ID unchanged.
+	_, err = executorExec(executor, "insert into music(id, user_id, name) values (10, :u, 'myname3')", map[string]*querypb.BindVariable{"u": sqltypes.Int64BindVariable(2)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, masterSession.LastInsertId)
+
+	// LAST_INSERT_ID() is answered from the session even without normalization.
+	sbc.Queries = nil
+	_, err = executorExec(executor, "select last_insert_id() from dual", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select :__lastInsertId as `last_insert_id()` from dual",
+		BindVariables: map[string]*querypb.BindVariable{"__lastInsertId": sqltypes.Uint64BindVariable(5)},
+	}}
+	assert.Equal(t, wantQueries, sbc.Queries)
+}
+
 func TestMultiInsertGeneratorSparse(t *testing.T) {
 	executor, sbc, _, sbclookup := createExecutorEnv()
 
@@ -1579,6 +1616,18 @@ func TestKeyDestRangeQuery(t *testing.T) {
 			targetString:      "TestExecutor:-20",
 			expectedSbc1Query: insertOutput,
 		},
+		{
+			inputQuery:        deleteInput,
+			targetString:      "TestExecutor:-20,40-60",
+			expectedSbc1Query: deleteOutput,
+			expectedSbc2Query: deleteOutput,
+		},
+		{
+			inputQuery:        selectInput,
+			targetString:      "TestExecutor:-20,40-60",
+			expectedSbc1Query: selectOutput,
+			expectedSbc2Query: selectOutput,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1610,6 +1659,11 @@ func TestKeyDestRangeQuery(t *testing.T) {
 
 	require.EqualError(t, err, "range queries not supported for inserts: TestExecutor[-]")
 
+	masterSession.TargetString = "TestExecutor:-20,40-60"
+	_, err = executorExec(executor, insertInput, nil)
+
+	require.EqualError(t, err, "multi-shard queries not supported for inserts: TestExecutor:-20,40-60")
+
 	masterSession.TargetString = ""
 }
 
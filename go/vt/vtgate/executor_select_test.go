@@ -2103,3 +2103,38 @@ func TestSelectBindvarswithPrepare(t *testing.T) {
 		t.Errorf("sbc2.Queries: %+v, want nil\n", sbc2.Queries)
 	}
 }
+
+func TestSelectVExplain(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	executor.normalize = true
+
+	result, err := executorExec(executor, "vexplain select id from user where id = 1", nil)
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "operator", Type: sqltypes.VarChar},
+			{Name: "variant", Type: sqltypes.VarChar},
+			{Name: "keyspace", Type: sqltypes.VarChar},
+			{Name: "table", Type: sqltypes.VarChar},
+			{Name: "shards", Type: sqltypes.VarChar},
+			{Name: "query", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewVarChar("Route"),
+			sqltypes.NewVarChar("SelectEqualUnique"),
+			sqltypes.NewVarChar("TestExecutor"),
+			sqltypes.NewVarChar("user"),
+			sqltypes.NewVarChar("TestExecutor/-20"),
+			sqltypes.NewVarChar("select id from user where id = 1"),
+		}},
+	}
+	utils.MustMatch(t, wantResult, result, "")
+
+	// Nothing is sent to the tablets.
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+	assert.Empty(t, sbclookup.Queries)
+}
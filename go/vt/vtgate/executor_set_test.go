@@ -165,6 +165,15 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set workload = 'olap'",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}},
+	}, {
+		in:  "set read_after_write = 1",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: true},
+	}, {
+		in:  "set read_after_write = 0",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set read_after_write = 2",
+		err: "unexpected value for read_after_write: 2",
 	}, {
 		in:  "set workload = 'dba'",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA}},
@@ -255,6 +264,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set sql_safe_updates = 2",
 		err: "unexpected value for sql_safe_updates: 2",
+	}, {
+		in:  "set target_shards = '-20,40-60'",
+		err: "target_shards requires a keyspace to be selected with USE",
+	}, {
+		in:  "set target_shards = 1",
+		err: "unexpected value type for target_shards: int64",
 	}, {
 		in:  "set @foo = 'bar'",
 		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo"}, []interface{}{"bar"}), Autocommit: true},
@@ -282,6 +297,49 @@ func TestExecutorSet(t *testing.T) {
 	}
 }
 
+func TestExecutorSetTargetShards(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+
+	testcases := []struct {
+		target string
+		in     string
+		out    string
+		err    string
+	}{{
+		target: "TestExecutor@replica",
+		in:     "set target_shards = '-20,40-60'",
+		out:    "TestExecutor:-20,40-60@replica",
+	}, {
+		target: "TestExecutor/-20",
+		in:     "set target_shards = '40-60'",
+		out:    "TestExecutor:40-60",
+	}, {
+		target: "TestExecutor[-60]@master",
+		in:     "set target_shards = '-20,40-60'",
+		out:    "TestExecutor:-20,40-60@master",
+	}, {
+		target: "TestExecutor:-20,40-60@replica",
+		in:     "set target_shards = ''",
+		out:    "TestExecutor@replica",
+	}, {
+		target: "TestExecutor",
+		in:     "set target_shards = '-20,'",
+		err:    "empty shard name in shard list -20,",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.target+" "+tcase.in, func(t *testing.T) {
+			session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: tcase.target})
+			_, err := executor.Execute(context.Background(), "TestExecute", session, tcase.in, nil)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, session.TargetString)
+		})
+	}
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -20,12 +20,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 )
@@ -44,6 +47,48 @@ func TestStreamSQLUnsharded(t *testing.T) {
 	}
 }
 
+func TestStreamSQLResumeToken(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+
+	// Each shard gets its own token.
+	token := encodeMessageResumeToken(map[string]string{"0": "shard0token"})
+	_, err := executorStreamMessages(executor, "stream /*vt+ RESUME_TOKEN="+token+" */ * from user_msgs")
+	require.NoError(t, err)
+	assert.Equal(t, "shard0token", sbclookup.ResumeToken)
+
+	// A directive without a value starts a resumable stream.
+	_, err = executorStreamMessages(executor, "stream /*vt+ RESUME_TOKEN */ * from user_msgs")
+	require.NoError(t, err)
+	assert.Equal(t, emptyMessageResumeToken, sbclookup.ResumeToken)
+
+	_, err = executorStreamMessages(executor, "stream * from user_msgs")
+	require.NoError(t, err)
+	assert.Equal(t, "", sbclookup.ResumeToken)
+
+	_, err = executorStreamMessages(executor, "stream /*vt+ RESUME_TOKEN=consumer */ * from user_msgs")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+func TestMergeMessageResumeToken(t *testing.T) {
+	tokens := map[string]string{"-80": "a"}
+	qr := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), sqltypes.NewVarBinary("b1")},
+			{sqltypes.NewInt64(2), sqltypes.NewVarBinary("b2")},
+		},
+	}
+	got := mergeMessageResumeToken(tokens, "80-", qr)
+
+	want := encodeMessageResumeToken(map[string]string{"-80": "a", "80-": "b2"})
+	for _, row := range got.Rows {
+		assert.Equal(t, want, row[1].ToString())
+	}
+	assert.Equal(t, "b1", qr.Rows[0][1].ToString(), "the rows of the shard must not be changed")
+	merged, err := decodeMessageResumeToken(want)
+	require.NoError(t, err)
+	assert.Equal(t, tokens, merged)
+}
+
 func TestStreamSQLSharded(t *testing.T) {
 	// Special setup: Don't use createExecutorEnv.
 	cell := "aa"
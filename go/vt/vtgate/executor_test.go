@@ -2095,6 +2095,32 @@ func TestGetPlanNormalized(t *testing.T) {
 	}
 }
 
+func TestSaveVSchemaInvalidatesAffectedPlans(t *testing.T) {
+	r, _, _, _ := createExecutorEnv()
+	vc, _ := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.resolver.resolver)
+
+	query1 := "select * from user where id = 1"
+	query2 := "select * from music_user_map where id = 1"
+	plan, err := r.getPlan(vc, query1, makeComments(""), map[string]*querypb.BindVariable{}, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"user": true}, plan.VSchemaNames)
+	_, err = r.getPlan(vc, query2, makeComments(""), map[string]*querypb.BindVariable{}, false, nil)
+	require.NoError(t, err)
+
+	before := plansInvalidated.Get()
+	r.saveVSchema(r.vschema, r.vschemaStats, &vschemaChanges{
+		keyspaces: map[string]bool{},
+		names:     map[string]bool{"user": true},
+	})
+	assert.Equal(t, []string{"@unknown:" + query2}, r.plans.Keys())
+	assert.EqualValues(t, 1, plansInvalidated.Get()-before)
+	assert.EqualValues(t, 1, plansInvalidatedLastUpdate.Get())
+
+	r.saveVSchema(r.vschema, r.vschemaStats, nil)
+	assert.Empty(t, r.plans.Keys())
+	assert.EqualValues(t, 2, plansInvalidated.Get()-before)
+}
+
 func TestPassthroughDDL(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 	masterSession.TargetString = "TestExecutor"
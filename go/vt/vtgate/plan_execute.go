@@ -77,6 +77,7 @@ func NewTestExecutor(ctx context.Context, strat func(executor *Executor) execute
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathVindex, e)
 	})
 	return e
 }
@@ -25,11 +25,15 @@ import (
 )
 
 func buildPlanForBypass(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
-	switch vschema.Destination().(type) {
+	switch dest := vschema.Destination().(type) {
 	case key.DestinationExactKeyRange:
 		if _, ok := stmt.(*sqlparser.Insert); ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "range queries not supported for inserts: %s", vschema.TargetString())
 		}
+	case key.DestinationShards:
+		if _, ok := stmt.(*sqlparser.Insert); ok && len(dest) > 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "multi-shard queries not supported for inserts: %s", vschema.TargetString())
+		}
 	}
 
 	keyspace, err := vschema.DefaultKeyspace()
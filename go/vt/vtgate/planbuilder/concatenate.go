@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*concatenate)(nil)
+
+// concatenate is the builder for engine.Concatenate.
+// This gets built for the branches of a UNION that
+// can't be merged into a single route. The result
+// columns are those of the first source.
+type concatenate struct {
+	order        int
+	sources      []builder
+	eConcatenate *engine.Concatenate
+}
+
+// newConcatenate builds a new concatenate. If left is already
+// a concatenate, right is appended to its sources.
+func newConcatenate(left, right builder) (*concatenate, error) {
+	if len(left.ResultColumns()) != len(right.ResultColumns()) {
+		return nil, errors.New("The used SELECT statements have a different number of columns")
+	}
+	for _, bldr := range []builder{left, right} {
+		if rb, ok := bldr.(*route); ok && selectHasStar(rb.Select) {
+			return nil, errors.New("unsupported: '*' expression in cross-shard query")
+		}
+	}
+	if c, ok := left.(*concatenate); ok {
+		c.sources = append(c.sources, right)
+		return c, nil
+	}
+	return &concatenate{
+		sources:      []builder{left, right},
+		eConcatenate: &engine.Concatenate{},
+	}, nil
+}
+
+// selectHasStar returns true if the select list of the statement,
+// or of its first SELECT for a UNION, has a '*' expression.
+func selectHasStar(stmt sqlparser.SelectStatement) bool {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return true
+			}
+		}
+	case *sqlparser.Union:
+		return selectHasStar(stmt.Left)
+	case *sqlparser.ParenSelect:
+		return selectHasStar(stmt.Select)
+	}
+	return false
+}
+
+// Order satisfies the builder interface.
+func (c *concatenate) Order() int {
+	return c.order
+}
+
+// Reorder satisfies the builder interface.
+func (c *concatenate) Reorder(order int) {
+	for _, source := range c.sources {
+		source.Reorder(order)
+		order = source.Order()
+	}
+	c.order = order + 1
+}
+
+// Primitive satisfies the builder interface.
+func (c *concatenate) Primitive() engine.Primitive {
+	c.eConcatenate.Sources = nil
+	for _, source := range c.sources {
+		c.eConcatenate.Sources = append(c.eConcatenate.Sources, source.Primitive())
+	}
+	return c.eConcatenate
+}
+
+// First satisfies the builder interface.
+func (c *concatenate) First() builder {
+	return c.sources[0].First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (c *concatenate) ResultColumns() []*resultColumn {
+	return c.sources[0].ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (c *concatenate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("concatenate.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (c *concatenate) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("concatenate.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (c *concatenate) MakeDistinct() error {
+	return errors.New("concatenate.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (c *concatenate) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("concatenate.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+func (c *concatenate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return c, nil
+	}
+	return newMemorySort(c, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// None of the sources needs to return more rows
+// than the concatenation.
+func (c *concatenate) SetUpperLimit(count *sqlparser.SQLVal) {
+	for _, source := range c.sources {
+		source.SetUpperLimit(count)
+	}
+}
+
+// PushMisc satisfies the builder interface.
+func (c *concatenate) PushMisc(sel *sqlparser.Select) {
+	for _, source := range c.sources {
+		source.PushMisc(sel)
+	}
+}
+
+// Wireup satisfies the builder interface.
+func (c *concatenate) Wireup(bldr builder, jt *jointab) error {
+	for i := len(c.sources) - 1; i >= 0; i-- {
+		if err := c.sources[i].Wireup(bldr, jt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SupplyVar satisfies the builder interface.
+func (c *concatenate) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	for _, source := range c.sources {
+		if from <= source.Order() {
+			source.SupplyVar(from, to, col, varname)
+			return
+		}
+	}
+	panic("BUG: concatenate can't supply a var from outside its sources")
+}
+
+// SupplyCol satisfies the builder interface.
+// A concatenate can only supply the columns of its select list.
+func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	column := col.Metadata.(*column)
+	for i, rc := range c.ResultColumns() {
+		if rc.column == column {
+			return rc, i
+		}
+	}
+	panic("BUG: concatenate can't supply a column that's not in its select list")
+}
+
+// SupplyWeightString satisfies the builder interface.
+// The weight_string is requested from every source, and
+// they must all return it as the same column.
+func (c *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	for i, source := range c.sources {
+		n, err := source.SupplyWeightString(colNumber)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 {
+			weightcolNumber = n
+			continue
+		}
+		if n != weightcolNumber {
+			return 0, errors.New("unsupported: UNION branches supply weight_string at different columns")
+		}
+	}
+	return weightcolNumber, nil
+}
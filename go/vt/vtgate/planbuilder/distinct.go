@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built on top of a concatenate for
+// a UNION that's not a UNION ALL.
+type distinct struct {
+	resultsBuilder
+	eDistinct *engine.Distinct
+}
+
+// newDistinct builds a new distinct.
+func newDistinct(bldr builder) *distinct {
+	d := &distinct{
+		eDistinct: &engine.Distinct{},
+	}
+	d.resultsBuilder = newResultsBuilder(bldr, d.eDistinct)
+	return d
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.eDistinct.Input = d.input.Primitive()
+	return d.eDistinct
+}
+
+// PushFilter satisfies the builder interface.
+func (d *distinct) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("distinct.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() error {
+	return errors.New("distinct.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (d *distinct) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("distinct.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return d, nil
+	}
+	return newMemorySort(d, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because all the rows of the input
+// are needed to remove the duplicates.
+func (d *distinct) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the select list, then the function
+// modifies the primitive to pull a corresponding weight_string from
+// mysql and compare those instead. This is because we currently don't
+// have the ability to mimic mysql's collation behavior.
+func (d *distinct) Wireup(bldr builder, jt *jointab) error {
+	// The weight_strings requested by the builders above
+	// are already compared through their text columns.
+	supplied := make(map[int]bool)
+	for _, weightcolNumber := range d.weightStrings {
+		supplied[weightcolNumber] = true
+	}
+	var keys []int
+	hasText := false
+	for colNumber, rc := range d.resultColumns {
+		if supplied[colNumber] {
+			continue
+		}
+		if !sqltypes.IsText(rc.column.typ) {
+			keys = append(keys, colNumber)
+			continue
+		}
+		hasText = true
+		weightcolNumber, ok := d.weightStrings[rc]
+		if !ok {
+			var err error
+			if weightcolNumber, err = d.input.SupplyWeightString(colNumber); err != nil {
+				return err
+			}
+			d.weightStrings[rc] = weightcolNumber
+			d.eDistinct.TruncateColumnCount = len(d.resultColumns)
+		}
+		keys = append(keys, weightcolNumber)
+	}
+	if hasText {
+		d.eDistinct.Keys = keys
+	}
+	return d.input.Wireup(bldr, jt)
+}
@@ -28,33 +28,49 @@ import (
 
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
-func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+// A MultiColumn vindex routes the DML only if all its columns
+// are matched.
+func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.Vindex, []sqlparser.ColIdent, vindexes.Vindex, []sqltypes.PlanValue, error) {
+	var ksidVindex vindexes.Vindex
+	var ksidCols []sqlparser.ColIdent
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
 		}
-		single, ok := index.Vindex.(vindexes.SingleColumn)
-		if !ok {
-			continue
-		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
+		_, isMulti := index.Vindex.(vindexes.MultiColumn)
+		if ksidVindex == nil {
+			ksidVindex = index.Vindex
+			ksidCols = index.Columns[:1]
+			if isMulti {
+				ksidCols = index.Columns
+			}
 		}
 		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+			return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
 		}
 
-		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
-			return engine.Equal, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
+		if !isMulti {
+			if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
+				return engine.Equal, ksidVindex, ksidCols, index.Vindex, []sqltypes.PlanValue{pv}, nil
+			}
+			continue
+		}
+		values := make([]sqltypes.PlanValue, 0, len(index.Columns))
+		for _, col := range index.Columns {
+			pv, ok := getMatch(where.Expr, col)
+			if !ok {
+				break
+			}
+			values = append(values, pv)
+		}
+		if len(values) == len(index.Columns) {
+			return engine.Equal, ksidVindex, ksidCols, index.Vindex, []sqltypes.PlanValue{{Values: values}}, nil
 		}
 	}
 	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+		return engine.Scatter, nil, nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
 	}
-	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
 }
 
 // getMatch returns the matched value if there is an equality
@@ -92,12 +108,12 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.Vindex, []sqlparser.ColIdent, error) {
 	eupd := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
 	ro, err := pb.processDMLTable(tableExprs)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 	eupd.Keyspace = ro.eroute.Keyspace
 	if !eupd.Keyspace.Sharded {
@@ -106,21 +122,21 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		subqueryArgs = append(subqueryArgs, nodes...)
 		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
 		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		eupd.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		eupd.Query = generateQuery(stmt)
-		return eupd, nil, "", nil
+		return eupd, nil, nil, nil
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -135,38 +151,44 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	eupd.QueryTimeout = queryTimeout(directives)
 	eupd.Table = ro.vschemaTable
 	if eupd.Table == nil {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
 	}
 
 	if ro.eroute.TargetDestination != nil {
 		if ro.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		eupd.Opcode = engine.ByDestination
 		eupd.TargetDestination = ro.eroute.TargetDestination
-		return eupd, nil, "", nil
+		return eupd, nil, nil, nil
 	}
 
-	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, eupd.Table)
+	routingType, ksidVindex, ksidCols, vindex, values, err := getDMLRouting(where, eupd.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 	eupd.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
 		}
 	} else {
 		eupd.Vindex = vindex
 		eupd.Values = values
 	}
 
-	return eupd, ksidVindex, ksidCol, nil
+	return eupd, ksidVindex, ksidCols, nil
 }
 
-func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
+func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
+	for i, col := range ksidCols {
+		if i == 0 {
+			buf.Myprintf("select %v", col)
+			continue
+		}
+		buf.Myprintf(", %v", col)
+	}
 	for _, cv := range table.Owned {
 		for _, column := range cv.Columns {
 			buf.Myprintf(", %v", column)
@@ -42,6 +42,7 @@ func splitAndExpression(filters []sqlparser.Expr, node sqlparser.Expr) []sqlpars
 
 type subqueryInfo struct {
 	ast    *sqlparser.Subquery
+	spb    *primitiveBuilder
 	bldr   builder
 	origin builder
 }
@@ -70,6 +71,14 @@ type subqueryInfo struct {
 // If an expression has no references to the current query, then the left-most
 // origin is chosen as the default.
 func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pulloutSubquery, origin builder, pushExpr sqlparser.Expr, err error) {
+	return pb.findFilterOrigin(expr, false)
+}
+
+// findFilterOrigin is like findOrigin. Additionally, if decorrelate is set,
+// and expr is an EXISTS, NOT EXISTS or IN construct whose correlated subquery
+// cannot be merged, the subquery is joined with the current builder, and
+// the returned pushExpr is nil.
+func (pb *primitiveBuilder) findFilterOrigin(expr sqlparser.Expr, decorrelate bool) (pullouts []*pulloutSubquery, origin builder, pushExpr sqlparser.Expr, err error) {
 	// highestOrigin tracks the highest origin referenced by the expression.
 	// Default is the First.
 	highestOrigin := pb.bldr.First()
@@ -116,6 +125,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			}
 			sqi := subqueryInfo{
 				ast:  node,
+				spb:  spb,
 				bldr: spb.bldr,
 			}
 			for _, extern := range spb.st.Externs {
@@ -149,6 +159,15 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
+			if decorrelate && len(subqueries) == 1 {
+				ok, err := pb.pushSemiJoin(expr, sqi)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if ok {
+					return nil, highestOrigin, nil, nil
+				}
+			}
 			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
 		}
 
@@ -196,6 +215,70 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 	return pullouts, highestOrigin, expr, nil
 }
 
+// pushSemiJoin converts a filter that contains a correlated subquery
+// into a join with the subquery. The join executes the subquery for
+// every row of the current builder, and returns the row if the subquery
+// returned any rows (EXISTS, IN), or if it returned none (NOT EXISTS).
+// For IN, the comparison is pushed into the subquery as an equality
+// with the selected column, which allows the subquery to route by vindex
+// if the column has one. It returns false if the filter is not one of
+// the supported constructs.
+func (pb *primitiveBuilder) pushSemiJoin(filter sqlparser.Expr, sqi subqueryInfo) (bool, error) {
+	opcode := engine.SemiJoin
+	switch node := filter.(type) {
+	case *sqlparser.ExistsExpr:
+		if node.Subquery != sqi.ast {
+			return false, nil
+		}
+	case *sqlparser.NotExpr:
+		exists, ok := node.Expr.(*sqlparser.ExistsExpr)
+		if !ok || exists.Subquery != sqi.ast {
+			return false, nil
+		}
+		opcode = engine.AntiJoin
+	case *sqlparser.ComparisonExpr:
+		// NOT IN is not supported because of its NULL semantics.
+		if node.Operator != sqlparser.InStr || node.Right != sqi.ast || hasSubquery(node.Left) {
+			return false, nil
+		}
+		sel, ok := sqi.ast.Select.(*sqlparser.Select)
+		if !ok || len(sel.SelectExprs) != 1 || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+			return false, nil
+		}
+		expr, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+		if !ok {
+			return false, nil
+		}
+		col, ok := expr.Expr.(*sqlparser.ColName)
+		if !ok {
+			return false, nil
+		}
+		// a in (select b from t where ...) -> exists (select b from t where ... and b = a)
+		comparison := &sqlparser.ComparisonExpr{
+			Left:     col,
+			Operator: sqlparser.EqualStr,
+			Right:    node.Left,
+		}
+		if err := sqi.spb.pushFilter(comparison, sqlparser.WhereStr); err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+
+	pb.bldr = &join{
+		weightStrings: make(map[*resultColumn]int),
+		Left:          pb.bldr,
+		Right:         sqi.spb.bldr,
+		ejoin: &engine.Join{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+	pb.bldr.Reorder(0)
+	return true, nil
+}
+
 func hasSubquery(node sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
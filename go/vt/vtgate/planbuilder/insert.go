@@ -51,7 +51,7 @@ func buildInsertPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	if ins.Action == sqlparser.ReplaceStr {
 		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
 	}
-	return buildInsertShardedPlan(ins, ro.vschemaTable)
+	return buildInsertShardedPlan(ins, ro.vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -98,7 +98,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -131,7 +131,7 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+		return buildInsertSelectPlan(ins, eins, insertValues.(sqlparser.SelectStatement), vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -184,6 +184,52 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan for an INSERT ... SELECT into a
+// sharded table. The SELECT is planned on its own, and its rows are
+// streamed through vtgate and inserted in batches. The vindex and
+// auto-inc columns are located in the rows so that every batch can
+// be routed like an INSERT with a VALUES clause.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, sel sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		if err := pb.processSelect(sel, nil); err != nil {
+			return nil, err
+		}
+	case *sqlparser.Union:
+		if err := pb.processUnion(sel, nil); err != nil {
+			return nil, err
+		}
+	}
+	if len(pb.bldr.ResultColumns()) != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	if err := pb.bldr.Wireup(pb.bldr, pb.jt); err != nil {
+		return nil, err
+	}
+	eins.Query = generateQuery(ins)
+
+	// Columns that are not supplied by the select are added to the
+	// column list. The rows are padded with NULLs for them.
+	eins.VindexOffsets = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			eins.VindexOffsets[vIdx] = append(eins.VindexOffsets[vIdx], findOrAddColumn(ins, col))
+		}
+	}
+	if eins.Table.AutoIncrement != nil {
+		eins.GenerateOffset = findOrAddColumn(ins, eins.Table.AutoIncrement.Column)
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+		}
+	}
+	eins.ColumnCount = len(ins.Columns)
+	eins.Input = pb.bldr.Primitive()
+	generateInsertShardedQuery(ins, eins, nil)
+	return eins, nil
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -239,6 +285,7 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 
 // findOrAddColumn finds the position of a column in the insert. If it's
 // absent it appends it to the with NULL values and returns that position.
+// For an INSERT ... SELECT, only the column list is changed.
 func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	for i, column := range ins.Columns {
 		if col.Equal(column) {
@@ -246,9 +293,10 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 		}
 	}
 	ins.Columns = append(ins.Columns, col)
-	rows := ins.Rows.(sqlparser.Values)
-	for i := range rows {
-		rows[i] = append(rows[i], &sqlparser.NullVal{})
+	if rows, ok := ins.Rows.(sqlparser.Values); ok {
+		for i := range rows {
+			rows[i] = append(rows[i], &sqlparser.NullVal{})
+		}
 	}
 	return len(ins.Columns) - 1
 }
@@ -19,8 +19,11 @@ package planbuilder
 import (
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var _ builder = (*join)(nil)
@@ -63,7 +66,18 @@ type join struct {
 	// Left and Right are the nodes for the join.
 	Left, Right builder
 
+	// ordered is set if an ORDER BY was pushed into the left
+	// node, in which case the order of its rows must be preserved.
+	ordered bool
+
 	ejoin *engine.Join
+
+	// allowHashJoin is set by the HASH_JOIN directive.
+	allowHashJoin bool
+
+	// ehashJoin is set if Wireup decides to execute
+	// the join as a hash join instead of ejoin.
+	ehashJoin *engine.HashJoin
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -129,6 +143,12 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	if jb.ehashJoin != nil {
+		jb.ehashJoin.Left = jb.Left.Primitive()
+		jb.ehashJoin.Right = jb.Right.Primitive()
+		jb.ehashJoin.Cols = jb.ejoin.Cols
+		return jb.ehashJoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -259,6 +279,7 @@ func (jb *join) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 		return nil, err
 	}
 	jb.Left = l
+	jb.ordered = true
 	// Still need to push an empty order by to the right.
 	r, err := jb.Right.PushOrderBy(nil)
 	if err != nil {
@@ -281,8 +302,23 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 	jb.Right.PushMisc(sel)
 }
 
+// allowHashJoins allows the joins of the tree to be executed as hash
+// joins.
+func allowHashJoins(bldr builder) {
+	jb, ok := bldr.(*join)
+	if !ok {
+		return
+	}
+	jb.allowHashJoin = true
+	allowHashJoins(jb.Left)
+	allowHashJoins(jb.Right)
+}
+
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if err := jb.chooseHashJoin(); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
@@ -290,6 +326,161 @@ func (jb *join) Wireup(bldr builder, jt *jointab) error {
 	return jb.Left.Wireup(bldr, jt)
 }
 
+// hashJoinRowsPerQuery is the number of rows a hash join can read
+// from the right table for the cost of one query of a nested loop join.
+const hashJoinRowsPerQuery = 100
+
+// chooseHashJoin converts the join into a hash join if executing it as
+// a nested loop would send a scatter query for every row of the left
+// node, and reading the whole right table once is cheaper. That's the
+// case if the HASH_JOIN directive asks for it, or if the vschema
+// estimates that the right table has at most hashJoinRowsPerQuery rows
+// per row of the left node. This is not done if the left node returns
+// a single row, or if the right route depends on the left node through
+// anything other than equality conditions that can serve as hash keys.
+func (jb *join) chooseHashJoin() error {
+	if jb.ordered || (jb.ejoin.Opcode != engine.NormalJoin && jb.ejoin.Opcode != engine.LeftJoin) {
+		return nil
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok {
+		return nil
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.Distinct != "" || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil
+	}
+	rb.finalizeOptions()
+	if rb.routeOptions[0].eroute.Opcode != engine.SelectScatter {
+		return nil
+	}
+	if lb, ok := jb.Left.(*route); ok {
+		lb.finalizeOptions()
+		if lb.routeOptions[0].eroute.Opcode == engine.SelectEqualUnique {
+			return nil
+		}
+	}
+	if !jb.allowHashJoin && !hashJoinIsCheaper(jb.Left, rb) {
+		return nil
+	}
+
+	var lkeys, rkeys []*sqlparser.ColName
+	var filters []sqlparser.Expr
+	for _, filter := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+		if lkey, rkey := jb.hashKeys(rb, filter); lkey != nil {
+			lkeys = append(lkeys, lkey)
+			rkeys = append(rkeys, rkey)
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	if len(lkeys) == 0 {
+		return nil
+	}
+
+	// The remaining parts of the query must not reference
+	// the left node, or the route would still need join variables.
+	where := sel.Where
+	sel.Where = nil
+	for _, filter := range filters {
+		sel.AddWhere(filter)
+	}
+	dependent := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && !rb.isLocal(col) {
+			dependent = true
+			return false, nil
+		}
+		return true, nil
+	}, sel)
+	if dependent {
+		sel.Where = where
+		return nil
+	}
+
+	jb.ehashJoin = &engine.HashJoin{Opcode: jb.ejoin.Opcode}
+	for i := range lkeys {
+		lrc, lcol := jb.Left.SupplyCol(lkeys[i])
+		rrc, rcol := rb.SupplyCol(rkeys[i])
+		// MySQL compares text with the collation of the columns,
+		// which is honored by hashing their weight_string. Only
+		// the keys known not to be text can be hashed as is.
+		if !isKnownNonText(lrc.column.typ) || !isKnownNonText(rrc.column.typ) {
+			var err error
+			if lcol, err = jb.Left.SupplyWeightString(lcol); err != nil {
+				return err
+			}
+			if rcol, err = rb.SupplyWeightString(rcol); err != nil {
+				return err
+			}
+		}
+		jb.ehashJoin.LHSKeys = append(jb.ehashJoin.LHSKeys, lcol)
+		jb.ehashJoin.RHSKeys = append(jb.ehashJoin.RHSKeys, rcol)
+	}
+	return nil
+}
+
+// hashJoinIsCheaper returns true if the vschema estimates that reading
+// the whole table of rb is cheaper than querying it once for every row
+// of left.
+func hashJoinIsCheaper(left builder, rb *route) bool {
+	leftRows, rightRows := estimatedRows(left), estimatedRows(rb)
+	if leftRows == 0 || rightRows == 0 {
+		return false
+	}
+	return rightRows <= leftRows*hashJoinRowsPerQuery
+}
+
+// estimatedRows returns the number of rows of the table of the route,
+// as estimated by the vschema, or 0 if it's unknown.
+func estimatedRows(bldr builder) int64 {
+	rb, ok := bldr.(*route)
+	if !ok {
+		return 0
+	}
+	rb.finalizeOptions()
+	if rb.routeOptions[0].vschemaTable == nil {
+		return 0
+	}
+	return rb.routeOptions[0].vschemaTable.EstimatedRows
+}
+
+// isKnownNonText returns true if the type is known, and not text.
+func isKnownNonText(typ querypb.Type) bool {
+	return typ != sqltypes.Null && !sqltypes.IsText(typ)
+}
+
+// hashKeys returns the columns of filter if it's an equality
+// between a column of the left node and a column of rb.
+func (jb *join) hashKeys(rb *route, filter sqlparser.Expr) (lkey, rkey *sqlparser.ColName) {
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualStr {
+		return nil, nil
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	if rb.isLocal(left) {
+		left, right = right, left
+	}
+	if !rb.isLocal(right) || !jb.isInLeftTree(left) {
+		return nil, nil
+	}
+	return left, right
+}
+
+// isInLeftTree returns true if the column originates
+// from a node on the left side of the join.
+func (jb *join) isInLeftTree(col *sqlparser.ColName) bool {
+	order := col.Metadata.(*column).Origin().Order()
+	return order >= jb.Left.First().Order() && jb.isOnLeft(order)
+}
+
 // SupplyVar satisfies the builder interface.
 func (jb *join) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
 	if !jb.isOnLeft(from) {
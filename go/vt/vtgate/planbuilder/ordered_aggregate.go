@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -57,6 +58,13 @@ type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+
+	// groupBy is the GROUP BY clause pushed down to the route,
+	// without the extra distinct column.
+	groupBy sqlparser.GroupBy
+	// aggregates maps the aggregate functions to the columns
+	// which contain their results, for the HAVING clause.
+	aggregates map[string]int
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -134,6 +142,7 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	pb.bldr = &orderedAggregate{
 		resultsBuilder: newResultsBuilder(rb, eaggr),
 		eaggr:          eaggr,
+		aggregates:     make(map[string]int),
 	}
 	pb.bldr.Reorder(0)
 	return nil
@@ -244,8 +253,170 @@ func (oa *orderedAggregate) Primitive() engine.Primitive {
 }
 
 // PushFilter satisfies the builder interface.
-func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of aggregates")
+// The HAVING conditions which don't reference the aggregates are pushed
+// down to the route: they only filter on the grouping columns, which
+// have the same values on all the shards. The other conditions are
+// evaluated by oa after the final merge. The aggregates they reference
+// which are not in the select list are added as extra columns, which
+// are truncated from the result.
+// For example: 'select col, count(*) from t group by col having sum(val) > 10'
+// will be sent to the scatter route as:
+// 'select col, count(*), sum(val) from t group by col order by col asc'
+// and oa will return the rows for which the sum of column 2 is above 10.
+func (oa *orderedAggregate) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	if whereType != sqlparser.HavingStr {
+		return errors.New("unsupported: filtering on results of aggregates")
+	}
+	if !oa.referencesAggregates(filter) {
+		return oa.input.PushFilter(pb, filter, whereType, origin)
+	}
+	expr, err := oa.convertHaving(pb, filter, origin)
+	if err != nil {
+		return err
+	}
+	if oa.eaggr.Having == nil {
+		oa.eaggr.Having = expr
+		return nil
+	}
+	oa.eaggr.Having = &engine.LogicalExpr{
+		Operator: engine.LogicalAnd,
+		Left:     oa.eaggr.Having,
+		Right:    expr,
+	}
+	return nil
+}
+
+// referencesAggregates returns true if the expression contains aggregate
+// functions, or references the aggregates of the select list by their alias.
+func (oa *orderedAggregate) referencesAggregates(expr sqlparser.Expr) bool {
+	if nodeHasAggregates(expr) {
+		return true
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if c, ok := node.Metadata.(*column); ok && c.Origin() == oa {
+				found = true
+				return false, nil
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+// convertHaving converts a HAVING condition to the expression evaluated
+// by the engine on the aggregated rows.
+func (oa *orderedAggregate) convertHaving(pb *primitiveBuilder, expr sqlparser.Expr, origin builder) (engine.Expr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.AndExpr:
+		return oa.convertHavingLogical(pb, engine.LogicalAnd, node.Left, node.Right, origin)
+	case *sqlparser.OrExpr:
+		return oa.convertHavingLogical(pb, engine.LogicalOr, node.Left, node.Right, origin)
+	case *sqlparser.NotExpr:
+		inner, err := oa.convertHaving(pb, node.Expr, origin)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.NotExpr{Expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		switch node.Operator {
+		case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.NullSafeEqualStr,
+			sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		default:
+			return nil, fmt.Errorf("unsupported: in scatter query: having operator: %s", node.Operator)
+		}
+		left, err := oa.convertHaving(pb, node.Left, origin)
+		if err != nil {
+			return nil, err
+		}
+		right, err := oa.convertHaving(pb, node.Right, origin)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.ComparisonExpr{Operator: node.Operator, Left: left, Right: right}, nil
+	case *sqlparser.BinaryExpr:
+		switch node.Operator {
+		case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr:
+		default:
+			return nil, fmt.Errorf("unsupported: in scatter query: having operator: %s", node.Operator)
+		}
+		left, err := oa.convertHaving(pb, node.Left, origin)
+		if err != nil {
+			return nil, err
+		}
+		right, err := oa.convertHaving(pb, node.Right, origin)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.ArithmeticExpr{Operator: node.Operator, Left: left, Right: right}, nil
+	case *sqlparser.IsExpr:
+		if node.Operator != sqlparser.IsNullStr && node.Operator != sqlparser.IsNotNullStr {
+			return nil, fmt.Errorf("unsupported: in scatter query: having operator: %s", node.Operator)
+		}
+		inner, err := oa.convertHaving(pb, node.Expr, origin)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.IsNullExpr{Expr: inner, Not: node.Operator == sqlparser.IsNotNullStr}, nil
+	case *sqlparser.SQLVal, *sqlparser.NullVal:
+		pv, err := sqlparser.NewPlanValue(node)
+		if err != nil {
+			return nil, err
+		}
+		if pv.IsList() {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex having expression: %s", sqlparser.String(node))
+		}
+		return &engine.LiteralExpr{Value: pv}, nil
+	case *sqlparser.ColName:
+		c := node.Metadata.(*column)
+		for i, rc := range oa.resultColumns {
+			if rc.column == c {
+				return &engine.ColumnExpr{Col: i}, nil
+			}
+		}
+		return nil, fmt.Errorf("unsupported: in scatter query: having column must reference column in SELECT list: %s", sqlparser.String(node))
+	case *sqlparser.FuncExpr:
+		if _, ok := engine.SupportedAggregates[node.Name.Lowered()]; !ok || !node.IsAggregate() {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex having expression: %s", sqlparser.String(node))
+		}
+		if col, ok := oa.aggregates[aggregateKey(node)]; ok {
+			return &engine.ColumnExpr{Col: col}, nil
+		}
+		// The aggregate is not in the select list.
+		hadDistinct := oa.extraDistinct != nil
+		col, err := oa.addAggregate(pb, &sqlparser.AliasedExpr{Expr: node}, origin)
+		if err != nil {
+			return nil, err
+		}
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+		if !hadDistinct && oa.extraDistinct != nil {
+			// The group by clause was pushed down without the distinct column.
+			oa.pushInputGroupBy()
+		}
+		return &engine.ColumnExpr{Col: col}, nil
+	}
+	return nil, fmt.Errorf("unsupported: in scatter query: complex having expression: %s", sqlparser.String(expr))
+}
+
+func (oa *orderedAggregate) convertHavingLogical(pb *primitiveBuilder, operator string, left, right sqlparser.Expr, origin builder) (engine.Expr, error) {
+	l, err := oa.convertHaving(pb, left, origin)
+	if err != nil {
+		return nil, err
+	}
+	r, err := oa.convertHaving(pb, right, origin)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.LogicalExpr{Operator: operator, Left: l, Right: r}, nil
+}
+
+// aggregateKey returns the key of an aggregate function in oa.aggregates.
+func aggregateKey(funcExpr *sqlparser.FuncExpr) string {
+	return strings.ToLower(sqlparser.String(funcExpr))
 }
 
 // PushSelect satisfies the builder interface.
@@ -278,26 +449,40 @@ func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.Ali
 }
 
 func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if _, err := oa.addAggregate(pb, expr, origin); err != nil {
+		return nil, 0, err
+	}
+
+	// Build a new rc with oa as origin because it's semantically different
+	// from the expression we pushed down.
+	rc = newResultColumn(expr, oa)
+	oa.resultColumns = append(oa.resultColumns, rc)
+	return rc, len(oa.resultColumns) - 1, nil
+}
+
+// addAggregate pushes the aggregate expression to the underlying route,
+// and adds its final aggregation to oa. It returns the column which
+// contains the result.
+func (oa *orderedAggregate) addAggregate(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (innerCol int, err error) {
 	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
 	opcode := engine.SupportedAggregates[funcExpr.Name.Lowered()]
 	if len(funcExpr.Exprs) != 1 {
-		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
+		return 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
 	}
-	var innerCol int
 	handleDistinct, innerAliased, err := oa.needDistinctHandling(pb, funcExpr, opcode)
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
 	if handleDistinct {
 		if oa.extraDistinct != nil {
-			return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
+			return 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
 		}
 		// Push the expression that's inside the aggregate.
 		// The column will eventually get added to the group by and order by clauses.
 		_, innerCol, _ = oa.input.PushSelect(pb, innerAliased, origin)
 		col, err := BuildColName(oa.input.ResultColumns(), innerCol)
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		oa.extraDistinct = col
 		oa.eaggr.HasDistinct = true
@@ -325,12 +510,8 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			Col:    innerCol,
 		})
 	}
-
-	// Build a new rc with oa as origin because it's semantically different
-	// from the expression we pushed down.
-	rc = newResultColumn(expr, oa)
-	oa.resultColumns = append(oa.resultColumns, rc)
-	return rc, len(oa.resultColumns) - 1, nil
+	oa.aggregates[aggregateKey(funcExpr)] = innerCol
+	return innerCol, nil
 }
 
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
@@ -406,14 +587,19 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 		}
 		oa.eaggr.Keys = append(oa.eaggr.Keys, colNumber)
 	}
-	// Append the distinct aggregate if any.
+	oa.groupBy = groupBy
+	oa.pushInputGroupBy()
+	return nil
+}
+
+// pushInputGroupBy pushes the group by clause to the underlying route,
+// with the distinct aggregate if any.
+func (oa *orderedAggregate) pushInputGroupBy() {
+	groupBy := oa.groupBy
 	if oa.extraDistinct != nil {
-		groupBy = append(groupBy, oa.extraDistinct)
+		groupBy = append(append(sqlparser.GroupBy(nil), groupBy...), oa.extraDistinct)
 	}
-
 	_ = oa.input.PushGroupBy(groupBy)
-
-	return nil
 }
 
 // PushOrderBy pushes the order by expression into the primitive.
@@ -507,7 +693,13 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 }
 
 // SetUpperLimit satisfies the builder interface.
+// The groups are filtered by HAVING after they are merged, so a limit
+// on the number of groups of each shard could leave out groups which
+// pass the condition: the limit is only pushed down without HAVING.
 func (oa *orderedAggregate) SetUpperLimit(count *sqlparser.SQLVal) {
+	if oa.eaggr.Having != nil {
+		return
+	}
 	oa.input.SetUpperLimit(count)
 }
 
@@ -169,6 +169,9 @@ func TestPlan(t *testing.T) {
 	testFile(t, "wireup_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "memory_sort_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "use_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "vexplain_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
 }
 
 func TestOne(t *testing.T) {
@@ -179,6 +182,18 @@ func TestOne(t *testing.T) {
 	testFile(t, "onecase.txt", "", vschema)
 }
 
+func TestWindowTruncatesHiddenColumns(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+	}
+	// id and the weight_string of textcol1 are only needed by the window.
+	plan, err := Build("select col, row_number() over (partition by textcol1 order by id) from user", vschema)
+	require.NoError(t, err)
+	w, ok := plan.Instructions.(*engine.Window)
+	require.True(t, ok, "%T", plan.Instructions)
+	require.Equal(t, 2, w.TruncateColumnCount)
+}
+
 func TestBypassPlanningFromFile(t *testing.T) {
 	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
 	require.NoError(t, err)
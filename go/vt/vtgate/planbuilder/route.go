@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -423,11 +424,15 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return 0, errors.New("unsupported: cannot order by on a cross-shard UNION of a text column")
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
-				rb.Select.(*sqlparser.Select).SelectExprs[colNumber],
+				sel.SelectExprs[colNumber],
 			},
 		},
 	}
@@ -534,3 +539,12 @@ func queryTimeout(d sqlparser.CommentDirectives) int {
 	}
 	return 0
 }
+
+// maxRows returns DirectiveMaxRows value if set, otherwise returns 0.
+func maxRows(d sqlparser.CommentDirectives) int {
+	val, ok := d[sqlparser.DirectiveMaxRows].(int)
+	if !ok || val < 0 {
+		return 0
+	}
+	return val
+}
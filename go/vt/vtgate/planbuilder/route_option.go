@@ -39,6 +39,11 @@ type routeOption struct {
 	// for the routeOption.
 	vindexMap map[*column]vindexes.SingleColumn
 
+	// multiColValues stores, for each multi-column vindex of
+	// vschemaTable, the values supplied for its columns by
+	// equality constraints.
+	multiColValues map[*vindexes.ColumnVindex][]sqlparser.Expr
+
 	// condition stores the AST condition that will be used
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
@@ -202,10 +207,16 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference:
 		return
 	}
-	opcode, vindex, values := ro.computePlan(pb, filter)
-	if opcode == engine.SelectScatter {
-		return
+	if opcode, vindex, condition := ro.computePlan(pb, filter); opcode != engine.SelectScatter {
+		ro.improvePlan(opcode, vindex, condition)
+	}
+	if opcode, vindex, condition := ro.computeMultiColumnPlan(pb, filter); opcode != engine.SelectScatter {
+		ro.improvePlan(opcode, vindex, condition)
 	}
+}
+
+// improvePlan updates the route if the new plan is better.
+func (ro *routeOption) improvePlan(opcode engine.RouteOpcode, vindex vindexes.Vindex, values sqlparser.Expr) {
 	switch ro.eroute.Opcode {
 	case engine.SelectEqualUnique:
 		if opcode == engine.SelectEqualUnique && vindex.Cost() < ro.eroute.Vindex.Cost() {
@@ -216,7 +227,9 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique:
 			ro.updateRoute(opcode, vindex, values)
 		case engine.SelectEqual:
-			if vindex.Cost() < ro.eroute.Vindex.Cost() {
+			// The same vindex can only come back with more
+			// columns of a multi-column vindex.
+			if vindex.Cost() < ro.eroute.Vindex.Cost() || vindex == ro.eroute.Vindex {
 				ro.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -237,7 +250,7 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 }
 
-func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	ro.eroute.Opcode = opcode
 	ro.eroute.Vindex = vindex
 	ro.condition = condition
@@ -298,6 +311,85 @@ func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser
 	return engine.SelectScatter, nil, nil
 }
 
+// computeMultiColumnPlan records the value of an equality constraint
+// on a column of a multi-column vindex, and computes the best plan for
+// the values recorded so far. A vindex can be used once all its columns
+// have values, or only a prefix of them if it's a partial vindex. The
+// condition is then the tuple of values for the leading columns.
+func (ro *routeOption) computeMultiColumnPlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr || ro.vschemaTable == nil {
+		return engine.SelectScatter, nil, nil
+	}
+	left := comparison.Left
+	right := comparison.Right
+	col := ro.findLocalColumn(pb, left)
+	if col == nil {
+		left, right = right, left
+		col = ro.findLocalColumn(pb, left)
+	}
+	if col == nil || !ro.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+
+	opcode = engine.SelectScatter
+	for _, cv := range ro.vschemaTable.ColumnVindexes {
+		mc, ok := cv.Vindex.(vindexes.MultiColumn)
+		if !ok {
+			continue
+		}
+		for i, cvcol := range cv.Columns {
+			if !cvcol.Equal(col.Name) {
+				continue
+			}
+			if ro.multiColValues == nil {
+				ro.multiColValues = make(map[*vindexes.ColumnVindex][]sqlparser.Expr)
+			}
+			if ro.multiColValues[cv] == nil {
+				ro.multiColValues[cv] = make([]sqlparser.Expr, len(cv.Columns))
+			}
+			ro.multiColValues[cv][i] = right
+		}
+
+		values := ro.multiColValues[cv]
+		n := 0
+		for n < len(values) && values[n] != nil {
+			n++
+		}
+		var cvOpcode engine.RouteOpcode
+		switch {
+		case n == len(cv.Columns) && mc.IsUnique():
+			cvOpcode = engine.SelectEqualUnique
+		case n == len(cv.Columns) || (n > 0 && mc.PartialVindex()):
+			cvOpcode = engine.SelectEqual
+		default:
+			continue
+		}
+		if opcode == engine.SelectScatter || planCost[cvOpcode] < planCost[opcode] || (cvOpcode == opcode && mc.Cost() < vindex.Cost()) {
+			opcode, vindex, condition = cvOpcode, mc, sqlparser.ValTuple(append([]sqlparser.Expr(nil), values[:n]...))
+		}
+	}
+	return opcode, vindex, condition
+}
+
+// findLocalColumn returns the column if expr is a column of the route.
+func (ro *routeOption) findLocalColumn(pb *primitiveBuilder, expr sqlparser.Expr) *sqlparser.ColName {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := pb.st.Find(col); err != nil {
+			return nil
+		}
+	}
+	if col.Metadata.(*column).Origin() != ro.rb {
+		return nil
+	}
+	return col
+}
+
 var planCost = map[engine.RouteOpcode]int{
 	engine.SelectUnsharded:   0,
 	engine.SelectNext:        0,
@@ -76,12 +76,16 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.processTableExprs(sel.From); err != nil {
 		return err
 	}
+	if sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveHashJoin) {
+		allowHashJoins(pb.bldr)
+	}
 
 	if rb, ok := pb.bldr.(*route); ok {
 		// TODO(sougou): this can probably be improved.
 		for _, ro := range rb.routeOptions {
 			directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 			ro.eroute.QueryTimeout = queryTimeout(directives)
+			ro.eroute.MaxRows = maxRows(directives)
 			if ro.eroute.TargetDestination != nil {
 				return errors.New("unsupported: SELECT with a target destination")
 			}
@@ -103,6 +107,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
@@ -128,7 +135,7 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	filters := splitAndExpression(nil, in)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
-		pullouts, origin, expr, err := pb.findOrigin(filter)
+		pullouts, origin, expr, err := pb.findFilterOrigin(filter, whereType == sqlparser.WhereStr)
 		if err != nil {
 			return err
 		}
@@ -17,7 +17,6 @@ limitations under the License.
 package planbuilder
 
 import (
-	"errors"
 	"fmt"
 
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -46,8 +45,24 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 		return err
 	}
 
-	if err := unionRouteMerge(union, pb.bldr, rpb.bldr); err != nil {
-		return err
+	if !unionRouteMerge(union, pb.bldr, rpb.bldr) {
+		// The branches are executed separately, and their
+		// results are combined by vtgate.
+		left := pb.bldr
+		if d, ok := left.(*distinct); ok && union.Type != sqlparser.UnionAllStr {
+			// The duplicates of the left UNION are removed
+			// together with the others.
+			left = d.input
+		}
+		bldr, err := newConcatenate(left, rpb.bldr)
+		if err != nil {
+			return err
+		}
+		pb.bldr = bldr
+		if union.Type != sqlparser.UnionAllStr {
+			pb.bldr = newDistinct(pb.bldr)
+		}
+		pb.bldr.Reorder(0)
 	}
 	pb.st.Outer = outer
 
@@ -69,18 +84,21 @@ func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *s
 	return fmt.Errorf("BUG: unexpected SELECT type: %T", part)
 }
 
-func unionRouteMerge(union *sqlparser.Union, left, right builder) error {
+// unionRouteMerge merges the right route into the left one if
+// both sides are routes that can be executed as a single route.
+// It returns false if that's not possible.
+func unionRouteMerge(union *sqlparser.Union, left, right builder) bool {
 	lroute, ok := left.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	rroute, ok := right.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	if !lroute.MergeUnion(rroute) {
-		return errors.New("unsupported: UNION cannot be executed as a single route")
+		return false
 	}
 	lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
-	return nil
+	return true
 }
@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// buildVExplainPlan builds the plan of the explained statement,
+// and wraps it in a VExplain primitive that describes it.
+func buildVExplainPlan(stmt *sqlparser.VExplain, vschema ContextVSchema) (engine.Primitive, error) {
+	var input engine.Primitive
+	var err error
+	switch inner := stmt.Statement.(type) {
+	case *sqlparser.Select:
+		input, err = buildRoutePlan(inner, vschema, buildSelectPlan)
+	case *sqlparser.Union:
+		input, err = buildRoutePlan(inner, vschema, buildUnionPlan)
+	case *sqlparser.Insert:
+		input, err = buildRoutePlan(inner, vschema, buildInsertPlan)
+	case *sqlparser.Update:
+		input, err = buildRoutePlan(inner, vschema, buildUpdatePlan)
+	case *sqlparser.Delete:
+		input, err = buildRoutePlan(inner, vschema, buildDeletePlan)
+	default:
+		return nil, fmt.Errorf("BUG: unexpected statement type in VEXPLAIN: %T", inner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &engine.VExplain{Input: input}, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*window)(nil)
+
+// window is the builder for engine.Window.
+// This gets built if there are window functions on a scatter route,
+// and their PARTITION BY does not reference a unique vindex. The
+// primitive requests the underlying route to order the results
+// by the partition and order by columns of the window. This allows
+// the engine code to evaluate the window functions as the rows come.
+// For example: 'select col, row_number() over (partition by col order by id) from t'
+// will be sent to the scatter route as:
+// 'select col, 0 from t order by col asc, id asc'
+// The second column is a placeholder which gets replaced by the
+// row number. For LAG and LEAD, the placeholder is the argument of
+// the function, which gets replaced by the value of another row.
+// Only one window is supported: all the window functions must have
+// the same OVER clause.
+type window struct {
+	resultsBuilder
+	ewindow *engine.Window
+	over    *sqlparser.OverClause
+}
+
+// checkWindowFunctions analyzes the select expressions for window functions.
+// If they cannot be pushed down, it builds a window primitive on top of the
+// route.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	overs := findWindows(sel.SelectExprs)
+	if len(overs) == 0 {
+		return nil
+	}
+	rb, isRoute := pb.bldr.(*route)
+	if isRoute && rb.removeMultishardOptions() {
+		return nil
+	}
+	if !isRoute {
+		return errors.New("unsupported: cross-shard query with window functions")
+	}
+
+	// If the PARTITION BY of every window references a unique vindex,
+	// the partitions cannot span shards, and the window functions can
+	// be pushed down.
+	success := rb.removeOptions(func(ro *routeOption) bool {
+		for _, over := range overs {
+			if !partitionHasUniqueVindex(pb, ro, over) {
+				return false
+			}
+		}
+		return true
+	})
+	if success {
+		return nil
+	}
+
+	for _, over := range overs[1:] {
+		if sqlparser.String(over) != sqlparser.String(overs[0]) {
+			return errors.New("unsupported: in scatter query: window functions with different windows")
+		}
+	}
+	if sel.Distinct != "" {
+		return errors.New("unsupported: in scatter query: distinct cannot be combined with window functions")
+	}
+	ewindow := &engine.Window{}
+	pb.bldr = &window{
+		resultsBuilder: newResultsBuilder(rb, ewindow),
+		ewindow:        ewindow,
+		over:           overs[0],
+	}
+	pb.bldr.Reorder(0)
+	return nil
+}
+
+// findWindows returns the OVER clauses of the window functions of node.
+func findWindows(node sqlparser.SQLNode) []*sqlparser.OverClause {
+	var overs []*sqlparser.OverClause
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunction() {
+				overs = append(overs, node.Over)
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return overs
+}
+
+func partitionHasUniqueVindex(pb *primitiveBuilder, ro *routeOption, over *sqlparser.OverClause) bool {
+	for _, expr := range over.PartitionBy {
+		vindex := ro.FindVindex(pb, expr)
+		if vindex != nil && vindex.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
+
+// Primitive satisfies the builder interface.
+func (w *window) Primitive() engine.Primitive {
+	w.ewindow.Input = w.input.Primitive()
+	return w.ewindow
+}
+
+// PushFilter satisfies the builder interface.
+// Only the HAVING conditions which don't reference the window
+// functions can be pushed down to the route.
+func (w *window) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if node, ok := node.(*sqlparser.ColName); ok {
+			if c, ok := node.Metadata.(*column); ok && c.Origin() == w {
+				found = true
+				return false, nil
+			}
+		}
+		return true, nil
+	}, filter)
+	if found || len(findWindows(filter)) != 0 {
+		return errors.New("unsupported: filtering on results of window functions")
+	}
+	return w.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+// Normal expressions are pushed through to the underlying route.
+// For window functions, w pushes a placeholder or the argument of the
+// function, and replaces its value with the result of the function.
+// In this respect, w is the originator for window functions.
+func (w *window) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok && inner.IsWindowFunction() {
+		return w.pushWindowFunction(expr, inner)
+	}
+	if len(findWindows(expr.Expr)) != 0 {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: complex window function expression: %s", sqlparser.String(expr))
+	}
+
+	innerRC, _, _ := w.input.PushSelect(pb, expr, origin)
+	w.resultColumns = append(w.resultColumns, innerRC)
+	return innerRC, len(w.resultColumns) - 1, nil
+}
+
+func (w *window) pushWindowFunction(expr *sqlparser.AliasedExpr, funcExpr *sqlparser.FuncExpr) (rc *resultColumn, colNumber int, err error) {
+	opcode, ok := engine.SupportedWindowFunctions[funcExpr.Name.Lowered()]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: window function %s", funcExpr.Name.String())
+	}
+	params := engine.WindowParams{
+		Opcode: opcode,
+		Alias:  expr.As.String(),
+	}
+	if params.Alias == "" {
+		params.Alias = sqlparser.String(funcExpr)
+	}
+
+	// The pushed expression must not be reused by other references
+	// to the same column, because its value gets replaced. So, it's
+	// pushed as an anonymous expression.
+	pushExpr := &sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte("0")), As: expr.As}
+	switch opcode {
+	case engine.WindowLag, engine.WindowLead:
+		if len(funcExpr.Exprs) < 1 || len(funcExpr.Exprs) > 3 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: complex window function expression: %s", sqlparser.String(funcExpr))
+		}
+		var args []sqlparser.Expr
+		for _, selectExpr := range funcExpr.Exprs {
+			arg, ok := selectExpr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+			}
+			args = append(args, arg.Expr)
+		}
+		pushExpr.Expr = args[0]
+		if len(args) > 1 {
+			if params.Offset, err = sqlparser.NewPlanValue(args[1]); err != nil {
+				return nil, 0, err
+			}
+		}
+		if len(args) > 2 {
+			if params.Default, err = sqlparser.NewPlanValue(args[2]); err != nil {
+				return nil, 0, err
+			}
+		}
+		if params.Offset.IsList() || params.Default.IsList() {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: complex window function expression: %s", sqlparser.String(funcExpr))
+		}
+	default:
+		if len(funcExpr.Exprs) != 0 {
+			return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+	}
+	rb, ok := w.input.(*route)
+	if !ok {
+		// Unreachable: the input of w is always a route.
+		return nil, 0, errors.New("unsupported: cross-shard query with window functions")
+	}
+	rb.PushAnonymous(pushExpr)
+	params.Col = len(w.resultColumns)
+	w.ewindow.Functions = append(w.ewindow.Functions, params)
+
+	rc = newResultColumn(expr, w)
+	w.resultColumns = append(w.resultColumns, rc)
+	return rc, len(w.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (w *window) MakeDistinct() error {
+	return errors.New("unsupported: in scatter query: distinct cannot be combined with window functions")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (w *window) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if len(groupBy) != 0 {
+		// Unreachable: grouping is handled by orderedAggregate.
+		return errors.New("unsupported: in scatter query: group by cannot be combined with window functions")
+	}
+	return nil
+}
+
+// PushOrderBy satisfies the builder interface.
+// The route is requested to order the rows by the PARTITION BY
+// and the ORDER BY of the window. If the query has its own ORDER BY,
+// the result of w is sorted in memory. The columns which are not in
+// the select list are requested from the route, and truncated from
+// the result of w.
+func (w *window) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+
+	var windowOrderBy sqlparser.OrderBy
+	for _, expr := range w.over.PartitionBy {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex partition by expression: %s", sqlparser.String(expr))
+		}
+		w.ewindow.PartitionBy = append(w.ewindow.PartitionBy, w.supplyHiddenCol(col))
+		windowOrderBy = append(windowOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr})
+	}
+	for _, order := range w.over.OrderBy {
+		col, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex window order by expression: %s", sqlparser.String(order.Expr))
+		}
+		w.ewindow.OrderBy = append(w.ewindow.OrderBy, w.supplyHiddenCol(col))
+		windowOrderBy = append(windowOrderBy, order)
+	}
+
+	bldr, err := w.input.PushOrderBy(windowOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	w.input = bldr
+	if len(orderBy) == 0 {
+		return w, nil
+	}
+	return newMemorySort(w, orderBy)
+}
+
+// supplyHiddenCol requests col from the input of w, without adding it
+// to the result columns of w.
+func (w *window) supplyHiddenCol(col *sqlparser.ColName) int {
+	_, colNumber := w.input.SupplyCol(col)
+	if colNumber >= len(w.resultColumns) {
+		w.ewindow.TruncateColumnCount = len(w.resultColumns)
+	}
+	return colNumber
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The window functions need all the rows of a partition. So,
+// the limit cannot be pushed down.
+func (w *window) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the partition or order by columns,
+// then the function modifies the primitive to pull a corresponding
+// weight_string from mysql and compare those instead.
+func (w *window) Wireup(bldr builder, jt *jointab) error {
+	for _, cols := range [][]int{w.ewindow.PartitionBy, w.ewindow.OrderBy} {
+		for i, colNumber := range cols {
+			if !sqltypes.IsText(w.input.ResultColumns()[colNumber].column.typ) {
+				continue
+			}
+			weightcolNumber, err := w.input.SupplyWeightString(colNumber)
+			if err != nil {
+				return err
+			}
+			if weightcolNumber >= len(w.resultColumns) {
+				w.ewindow.TruncateColumnCount = len(w.resultColumns)
+			}
+			cols[i] = weightcolNumber
+		}
+	}
+	return w.input.Wireup(bldr, jt)
+}
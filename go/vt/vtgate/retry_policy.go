@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var retryPolicyConfig = flag.String("retry_policy", "", `Retry policies of the gateway as JSON object keyed by "<keyspace>@<tablet_type>", where "*" matches any keyspace or tablet type. Example: {"*@master": {"idempotent_only": true}, "commerce@replica": {"max_attempts": 4, "backoff": "10ms", "max_backoff": "100ms", "retryable_codes": ["UNAVAILABLE", "RESOURCE_EXHAUSTED"]}}. Unset fields default to -retry-count retries on UNAVAILABLE and FAILED_PRECONDITION errors without backoff.`)
+
+// nonIdempotentMethods are the QueryService methods which may have modified
+// data on a tablet although they returned an error.
+var nonIdempotentMethods = map[string]bool{
+	"Execute":           true,
+	"ExecuteBatch":      true,
+	"BeginExecute":      true,
+	"BeginExecuteBatch": true,
+	"MessageAck":        true,
+}
+
+// RetryPolicy controls if and how the gateway retries a request which failed
+// on a tablet on another tablet of the same shard.
+// Requests in a transaction and streams which already returned results are
+// never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of tablets a request is sent to.
+	MaxAttempts int
+	// Backoff is the wait time before the first retry. It doubles with every
+	// further retry, but never exceeds MaxBackoff, if MaxBackoff is set.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// RetryableCodes are the error codes which can be retried. If nil,
+	// UNAVAILABLE and FAILED_PRECONDITION errors are retried.
+	RetryableCodes map[vtrpcpb.Code]bool
+	// IdempotentOnly disables the retry of MASTER requests which may have
+	// modified data e.g. an Execute which timed out.
+	IdempotentOnly bool
+}
+
+// retryPolicyJSON is the JSON representation of a RetryPolicy.
+// All fields are optional and default to the values of the default policy.
+type retryPolicyJSON struct {
+	MaxAttempts    *int     `json:"max_attempts"`
+	Backoff        string   `json:"backoff"`
+	MaxBackoff     string   `json:"max_backoff"`
+	RetryableCodes []string `json:"retryable_codes"`
+	IdempotentOnly *bool    `json:"idempotent_only"`
+}
+
+// canRetry returns true if the policy allows the retry of the given method.
+func (p *RetryPolicy) canRetry(name string, target *querypb.Target) bool {
+	if p.IdempotentOnly && target.TabletType == topodatapb.TabletType_MASTER && nonIdempotentMethods[name] {
+		return false
+	}
+	return true
+}
+
+// backoff returns the wait time before the retry which follows the
+// given attempt. Attempts are counted from 0.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 0; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return d
+}
+
+// waitBackoff blocks for the backoff of the given attempt.
+// It returns false if the context is done before.
+func (p *RetryPolicy) waitBackoff(ctx context.Context, attempt int) bool {
+	d := p.backoff(attempt)
+	if d == 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryPolicies holds the RetryPolicy for each keyspace and tablet type.
+type retryPolicies struct {
+	defaultPolicy *RetryPolicy
+	// policies is keyed by "<keyspace>@<tablet_type>". Either part may be "*".
+	policies map[string]*RetryPolicy
+}
+
+// newRetryPolicies parses the -retry_policy flag value. The policy used for
+// unconfigured keyspaces and tablet types is derived from retryCount.
+func newRetryPolicies(config string, retryCount int) (*retryPolicies, error) {
+	rp := &retryPolicies{
+		defaultPolicy: &RetryPolicy{MaxAttempts: retryCount + 1},
+		policies:      make(map[string]*RetryPolicy),
+	}
+	if config == "" {
+		return rp, nil
+	}
+
+	var entries map[string]retryPolicyJSON
+	if err := json.Unmarshal([]byte(config), &entries); err != nil {
+		return nil, fmt.Errorf("cannot parse retry policy %v: %v", config, err)
+	}
+	for key, entry := range entries {
+		keyspace, tabletType, err := parseRetryPolicyKey(key)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := entry.toPolicy(rp.defaultPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry policy for %v: %v", key, err)
+		}
+		rp.policies[retryPolicyKey(keyspace, tabletType)] = policy
+	}
+	return rp, nil
+}
+
+// policy returns the RetryPolicy for the given keyspace and tablet type.
+// An exact match has precedence over a match on the keyspace, which has
+// precedence over a match on the tablet type.
+func (rp *retryPolicies) policy(keyspace string, tabletType topodatapb.TabletType) *RetryPolicy {
+	tt := topoproto.TabletTypeLString(tabletType)
+	for _, key := range []string{
+		retryPolicyKey(keyspace, tt),
+		retryPolicyKey(keyspace, "*"),
+		retryPolicyKey("*", tt),
+		retryPolicyKey("*", "*"),
+	} {
+		if policy, ok := rp.policies[key]; ok {
+			return policy
+		}
+	}
+	return rp.defaultPolicy
+}
+
+func retryPolicyKey(keyspace, tabletType string) string {
+	return keyspace + "@" + tabletType
+}
+
+// parseRetryPolicyKey splits "<keyspace>@<tablet_type>" and normalizes the
+// tablet type.
+func parseRetryPolicyKey(key string) (keyspace, tabletType string, err error) {
+	parts := strings.Split(key, "@")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid retry policy key %v: must be <keyspace>@<tablet_type>", key)
+	}
+	if parts[1] == "*" {
+		return parts[0], parts[1], nil
+	}
+	tt, err := topoproto.ParseTabletType(parts[1])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid retry policy key %v: %v", key, err)
+	}
+	return parts[0], topoproto.TabletTypeLString(tt), nil
+}
+
+func (entry *retryPolicyJSON) toPolicy(defaultPolicy *RetryPolicy) (*RetryPolicy, error) {
+	policy := *defaultPolicy
+	if entry.MaxAttempts != nil {
+		if *entry.MaxAttempts < 1 {
+			return nil, fmt.Errorf("max_attempts must be >= 1 (specified value: %d)", *entry.MaxAttempts)
+		}
+		policy.MaxAttempts = *entry.MaxAttempts
+	}
+	if entry.Backoff != "" {
+		d, err := time.ParseDuration(entry.Backoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backoff: %v", err)
+		}
+		policy.Backoff = d
+	}
+	if entry.MaxBackoff != "" {
+		d, err := time.ParseDuration(entry.MaxBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_backoff: %v", err)
+		}
+		policy.MaxBackoff = d
+	}
+	if policy.MaxBackoff > 0 && policy.MaxBackoff < policy.Backoff {
+		return nil, fmt.Errorf("max_backoff must be >= backoff: %v vs. %v", policy.MaxBackoff, policy.Backoff)
+	}
+	if entry.RetryableCodes != nil {
+		policy.RetryableCodes = make(map[vtrpcpb.Code]bool)
+		for _, name := range entry.RetryableCodes {
+			code, ok := vtrpcpb.Code_value[strings.ToUpper(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown error code %v", name)
+			}
+			policy.RetryableCodes[vtrpcpb.Code(code)] = true
+		}
+	}
+	if entry.IdempotentOnly != nil {
+		policy.IdempotentOnly = *entry.IdempotentOnly
+	}
+	return &policy, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRetryPolicies(t *testing.T) {
+	rp, err := newRetryPolicies(`{
+		"*@master": {"idempotent_only": true},
+		"ks@REPLICA": {"max_attempts": 4, "backoff": "10ms", "max_backoff": "30ms", "retryable_codes": ["unavailable", "RESOURCE_EXHAUSTED"]},
+		"ks@*": {"max_attempts": 1}
+	}`, 2)
+	require.NoError(t, err)
+
+	// Exact match.
+	p := rp.policy("ks", topodatapb.TabletType_REPLICA)
+	assert.Equal(t, 4, p.MaxAttempts)
+	assert.Equal(t, map[vtrpcpb.Code]bool{vtrpcpb.Code_UNAVAILABLE: true, vtrpcpb.Code_RESOURCE_EXHAUSTED: true}, p.RetryableCodes)
+	assert.False(t, p.IdempotentOnly)
+	var backoffs []time.Duration
+	for attempt := 0; attempt < 4; attempt++ {
+		backoffs = append(backoffs, p.backoff(attempt))
+	}
+	assert.Equal(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 30 * time.Millisecond}, backoffs)
+
+	// The keyspace has precedence over the tablet type.
+	p = rp.policy("ks", topodatapb.TabletType_MASTER)
+	assert.Equal(t, &RetryPolicy{MaxAttempts: 1}, p)
+
+	// Match on the tablet type. Unset fields come from the default policy.
+	p = rp.policy("other", topodatapb.TabletType_MASTER)
+	assert.Equal(t, &RetryPolicy{MaxAttempts: 3, IdempotentOnly: true}, p)
+	master := &querypb.Target{Keyspace: "other", Shard: "0", TabletType: topodatapb.TabletType_MASTER}
+	assert.False(t, p.canRetry("Execute", master))
+	assert.True(t, p.canRetry("StreamExecute", master))
+	assert.True(t, p.canRetry("Begin", master))
+
+	// No match.
+	p = rp.policy("other", topodatapb.TabletType_RDONLY)
+	assert.Equal(t, &RetryPolicy{MaxAttempts: 3}, p)
+	assert.Equal(t, time.Duration(0), p.backoff(2))
+	assert.True(t, p.canRetry("Execute", &querypb.Target{Keyspace: "other", Shard: "0", TabletType: topodatapb.TabletType_RDONLY}))
+}
+
+func TestRetryPoliciesErrors(t *testing.T) {
+	testcases := []struct {
+		config string
+		err    string
+	}{{
+		config: `[]`,
+		err:    "cannot parse retry policy []: json: cannot unmarshal array into Go value of type map[string]vtgate.retryPolicyJSON",
+	}, {
+		config: `{"ks": {}}`,
+		err:    "invalid retry policy key ks: must be <keyspace>@<tablet_type>",
+	}, {
+		config: `{"ks@foo": {}}`,
+		err:    "invalid retry policy key ks@foo: unknown TabletType foo",
+	}, {
+		config: `{"ks@master": {"max_attempts": 0}}`,
+		err:    "invalid retry policy for ks@master: max_attempts must be >= 1 (specified value: 0)",
+	}, {
+		config: `{"ks@master": {"backoff": "soon"}}`,
+		err:    "invalid retry policy for ks@master: invalid backoff: time: invalid duration",
+	}, {
+		config: `{"ks@master": {"backoff": "1s", "max_backoff": "10ms"}}`,
+		err:    "invalid retry policy for ks@master: max_backoff must be >= backoff: 10ms vs. 1s",
+	}, {
+		config: `{"ks@master": {"retryable_codes": ["FOO"]}}`,
+		err:    "invalid retry policy for ks@master: unknown error code FOO",
+	}}
+	for _, tcase := range testcases {
+		_, err := newRetryPolicies(tcase.config, 2)
+		require.Error(t, err, tcase.config)
+		assert.Contains(t, err.Error(), tcase.err, tcase.config)
+	}
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -82,6 +84,7 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	newSession.Savepoints = nil
 	return NewSafeSession(newSession)
 }
 
@@ -96,6 +99,7 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.Savepoints = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 }
 
@@ -224,6 +228,149 @@ func (session *SafeSession) MustRollback() bool {
 	return session.mustRollback
 }
 
+// PushSavepoint records a savepoint for the sessions that are currently
+// part of the transaction, and returns the name of the savepoint along
+// with those sessions.
+func (session *SafeSession) PushSavepoint() (string, []*vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Savepoints = append(session.Savepoints, &vtgatepb.Session_Savepoint{
+		PreSessions:   int32(len(session.PreSessions)),
+		ShardSessions: int32(len(session.ShardSessions)),
+		PostSessions:  int32(len(session.PostSessions)),
+	})
+	return savepointName(len(session.Savepoints)), session.allSessions()
+}
+
+// PopSavepoint removes the last savepoint. It returns the name of the
+// savepoint, the sessions that were part of the transaction when it
+// was created, and the sessions that joined the transaction afterwards.
+// If truncate is set, the latter are removed from the session.
+func (session *SafeSession) PopSavepoint(truncate bool) (name string, before, after []*vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.Savepoints) == 0 {
+		return "", nil, nil
+	}
+	name = savepointName(len(session.Savepoints))
+	sp := session.Savepoints[len(session.Savepoints)-1]
+	session.Savepoints = session.Savepoints[:len(session.Savepoints)-1]
+
+	split := func(sessions []*vtgatepb.Session_ShardSession, n int32) []*vtgatepb.Session_ShardSession {
+		before = append(before, sessions[:n]...)
+		after = append(after, sessions[n:]...)
+		if truncate {
+			return sessions[:n]
+		}
+		return sessions
+	}
+	session.PreSessions = split(session.PreSessions, sp.PreSessions)
+	session.ShardSessions = split(session.ShardSessions, sp.ShardSessions)
+	session.PostSessions = split(session.PostSessions, sp.PostSessions)
+	return name, before, after
+}
+
+// HasSavepoints returns true if there are savepoints in the session.
+func (session *SafeSession) HasSavepoints() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return len(session.Savepoints) != 0
+}
+
+// HasShardSessions returns true if a transaction was started on any shard.
+func (session *SafeSession) HasShardSessions() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return len(session.allSessions()) != 0
+}
+
+func (session *SafeSession) allSessions() []*vtgatepb.Session_ShardSession {
+	all := append([]*vtgatepb.Session_ShardSession{}, session.PreSessions...)
+	all = append(all, session.ShardSessions...)
+	return append(all, session.PostSessions...)
+}
+
+// ReadAfterWriteGTID returns the GTIDs a replica of the shard of target
+// must have executed to serve a read of the session. ok is false if the
+// read can go to any replica. An empty gtid with ok set means that the
+// GTIDs of a write are not known, and the read must go to the master.
+func (session *SafeSession) ReadAfterWriteGTID(target *querypb.Target) (gtid string, ok bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.GetReadAfterWrite() {
+		return "", false
+	}
+	gtid, ok = session.WriteGtids[writeGTIDKey(target)]
+	return gtid, ok
+}
+
+// RecordWriteGTIDs adds the GTIDs of a transaction committed by the
+// session on the master of the shard of target to the GTIDs which the
+// replicas must have executed to serve its reads. Empty gtids mean that
+// the transaction wrote nothing.
+func (session *SafeSession) RecordWriteGTIDs(target *querypb.Target, gtids string) {
+	if gtids == "" {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.recordsWriteGTIDs(target) {
+		return
+	}
+	key := writeGTIDKey(target)
+	previous, ok := session.WriteGtids[key]
+	switch {
+	case !ok:
+		session.WriteGtids[key] = gtids
+	case previous == "":
+		// The GTIDs of an earlier write are not known.
+	default:
+		previousSet, err := mysql.ParseMysql56GTIDSet(previous)
+		if err != nil {
+			session.WriteGtids[key] = ""
+			return
+		}
+		set, err := mysql.ParseMysql56GTIDSet(gtids)
+		if err != nil {
+			session.WriteGtids[key] = ""
+			return
+		}
+		session.WriteGtids[key] = previousSet.Union(set).String()
+	}
+}
+
+// RecordUnknownWrite records that the session committed a transaction
+// on the master of the shard of target, whose GTIDs are not known. The
+// reads of the session on the shard go to the master from then on.
+func (session *SafeSession) RecordUnknownWrite(target *querypb.Target) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.recordsWriteGTIDs(target) {
+		return
+	}
+	session.WriteGtids[writeGTIDKey(target)] = ""
+}
+
+// recordsWriteGTIDs returns true if the GTIDs of the writes of the
+// session on target must be recorded.
+func (session *SafeSession) recordsWriteGTIDs(target *querypb.Target) bool {
+	if !session.Session.GetReadAfterWrite() || target.TabletType != topodatapb.TabletType_MASTER {
+		return false
+	}
+	if session.WriteGtids == nil {
+		session.WriteGtids = make(map[string]string)
+	}
+	return true
+}
+
+func writeGTIDKey(target *querypb.Target) string {
+	return target.Keyspace + "/" + target.Shard
+}
+
+func savepointName(depth int) string {
+	return fmt.Sprintf("vt_sp%d", depth)
+}
+
 // RecordWarning stores the given warning in the session
 func (session *SafeSession) RecordWarning(warning *querypb.QueryWarning) {
 	session.mu.Lock()
@@ -17,11 +17,14 @@ limitations under the License.
 package vtgate
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"flag"
 	"io"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -39,6 +42,8 @@ import (
 
 var (
 	messageStreamGracePeriod = flag.Duration("message_stream_grace_period", 30*time.Second, "the amount of time to give for a vttablet to resume if it ends a message stream, usually because of a reparent.")
+
+	readAfterWriteMasterReads = stats.NewCounter("ReadAfterWriteMasterReads", "Reads of read-after-write sessions which went to the master because no replica had caught up")
 )
 
 // ScatterConn is used for executing queries across
@@ -211,8 +216,13 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			switch {
 			case autocommit:
 				innerqr, err = stc.executeAutocommit(ctx, rs, queries[i].Sql, queries[i].BindVariables, opts)
+				if err == nil {
+					session.RecordWriteGTIDs(rs.Target, innerqr.SessionStateChanges)
+				}
 			case shouldBegin:
 				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, opts)
+			case transactionID == 0:
+				innerqr, err = stc.executeReadAfterWrite(ctx, rs, queries[i].Sql, queries[i].BindVariables, session, opts)
 			default:
 				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, transactionID, opts)
 			}
@@ -251,6 +261,35 @@ func (stc *ScatterConn) executeAutocommit(ctx context.Context, rs *srvtopo.Resol
 	return &qrs[0], nil
 }
 
+// executeReadAfterWrite executes a query outside of a transaction. In a
+// read-after-write session, replicas only serve the query if they have
+// caught up with the last write of the session on the shard. If none
+// has, the query goes to the master.
+func (stc *ScatterConn) executeReadAfterWrite(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, session *SafeSession, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	if rs.Target.TabletType == topodatapb.TabletType_MASTER {
+		return rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, 0, options)
+	}
+	gtid, ok := session.ReadAfterWriteGTID(rs.Target)
+	if !ok {
+		return rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, 0, options)
+	}
+	if gtid != "" {
+		replicaOptions := &querypb.ExecuteOptions{}
+		if options != nil {
+			replicaOptions = proto.Clone(options).(*querypb.ExecuteOptions)
+		}
+		replicaOptions.ReadAfterWriteGtid = gtid
+		qr, err := rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, 0, replicaOptions)
+		if vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+			return qr, err
+		}
+	}
+	readAfterWriteMasterReads.Add(1)
+	master := proto.Clone(rs.Target).(*querypb.Target)
+	master.TabletType = topodatapb.TabletType_MASTER
+	return rs.QueryService.Execute(ctx, master, sql, bindVariables, 0, options)
+}
+
 func (stc *ScatterConn) processOneStreamingResult(mu *sync.Mutex, fieldSent *bool, qr *sqltypes.Result, callback func(*sqltypes.Result) error) error {
 	mu.Lock()
 	defer mu.Unlock()
@@ -306,7 +345,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
 	tabletType topodatapb.TabletType,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) error {
 	// mu protects fieldSent, callback and replyErr
@@ -314,13 +353,49 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	fieldSent := false
 
 	allErrors := stc.multiGo(ctx, "StreamExecute", rss, tabletType, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.QueryService.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
+		return stc.streamReadAfterWrite(ctx, rs, query, bindVars[i], session, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
 	})
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
+// streamReadAfterWrite is the streaming version of executeReadAfterWrite.
+// The query only goes to the master if the replica refused it before
+// returning anything.
+func (stc *ScatterConn) streamReadAfterWrite(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, session *SafeSession, callback func(*sqltypes.Result) error) error {
+	var options *querypb.ExecuteOptions
+	if session != nil && session.Session != nil {
+		options = session.Session.Options
+	}
+	if session == nil || rs.Target.TabletType == topodatapb.TabletType_MASTER {
+		return rs.QueryService.StreamExecute(ctx, rs.Target, sql, bindVariables, 0, options, callback)
+	}
+	gtid, ok := session.ReadAfterWriteGTID(rs.Target)
+	if !ok {
+		return rs.QueryService.StreamExecute(ctx, rs.Target, sql, bindVariables, 0, options, callback)
+	}
+	if gtid != "" {
+		replicaOptions := &querypb.ExecuteOptions{}
+		if options != nil {
+			replicaOptions = proto.Clone(options).(*querypb.ExecuteOptions)
+		}
+		replicaOptions.ReadAfterWriteGtid = gtid
+		received := false
+		err := rs.QueryService.StreamExecute(ctx, rs.Target, sql, bindVariables, 0, replicaOptions, func(qr *sqltypes.Result) error {
+			received = true
+			return callback(qr)
+		})
+		if received || vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+			return err
+		}
+	}
+	readAfterWriteMasterReads.Add(1)
+	master := proto.Clone(rs.Target).(*querypb.Target)
+	master.TabletType = topodatapb.TabletType_MASTER
+	return rs.QueryService.StreamExecute(ctx, master, sql, bindVariables, 0, options, callback)
+}
+
 // timeTracker is a convenience wrapper used by MessageStream
 // to track how long a stream has been unavailable.
 type timeTracker struct {
@@ -357,24 +432,49 @@ func (tt *timeTracker) Record(target *querypb.Target) time.Time {
 // MessageStream streams messages from the specified shards.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
-func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error {
+// If there is a resume token, each shard resumes from its own token,
+// and the resume_token column of the rows is replaced by the token of
+// all the shards.
+func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name, resumeToken string, callback func(*sqltypes.Result) error) error {
+	tokens, err := decodeMessageResumeToken(resumeToken)
+	if err != nil {
+		return err
+	}
+
 	// The cancelable context is used for handling errors
 	// from individual streams.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	// mu is used to merge multiple callback calls into one.
+	// It also protects tokens.
 	var mu sync.Mutex
 	fieldSent := false
 	lastErrors := newTimeTracker()
 	allErrors := stc.multiGo(ctx, "MessageStream", rss, topodatapb.TabletType_MASTER, func(rs *srvtopo.ResolvedShard, i int) error {
+		shardCallback := callback
+		if tokens != nil {
+			shardCallback = func(qr *sqltypes.Result) error {
+				return callback(mergeMessageResumeToken(tokens, rs.Target.Shard, qr))
+			}
+		}
 		// This loop handles the case where a reparent happens, which can cause
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
 		for {
-			err := rs.QueryService.MessageStream(ctx, rs.Target, name, func(qr *sqltypes.Result) error {
+			shardToken := ""
+			if tokens != nil {
+				// A shard without a token resumes from the empty token.
+				mu.Lock()
+				shardToken = tokens[rs.Target.Shard]
+				mu.Unlock()
+				if shardToken == "" {
+					shardToken = emptyMessageResumeToken
+				}
+			}
+			err := rs.QueryService.MessageStream(ctx, rs.Target, name, shardToken, func(qr *sqltypes.Result) error {
 				lastErrors.Reset(rs.Target)
-				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+				return stc.processOneStreamingResult(&mu, &fieldSent, qr, shardCallback)
 			})
 			// nil and EOF are equivalent. UNAVAILABLE can be returned by vttablet if it's demoted
 			// from master to replica. For any of these conditions, we have to retry.
@@ -409,6 +509,61 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
+// emptyMessageResumeToken is the resume token without any message,
+// of vtgate as well as of vttablet. A consumer passes it to start a
+// resumable message stream.
+var emptyMessageResumeToken = encodeMessageResumeToken(nil)
+
+// encodeMessageResumeToken returns the resume token of a message
+// stream from the resume tokens of its shards.
+func encodeMessageResumeToken(tokens map[string]string) string {
+	if tokens == nil {
+		tokens = map[string]string{}
+	}
+	b, _ := json.Marshal(tokens)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeMessageResumeToken returns the resume tokens of the shards
+// from the resume token of a message stream. It returns nil if the
+// stream is not resumable.
+func decodeMessageResumeToken(token string) (map[string]string, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q: %v", token, err)
+	}
+	var tokens map[string]string
+	if err := json.Unmarshal(b, &tokens); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q: %v", token, err)
+	}
+	if tokens == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q", token)
+	}
+	return tokens, nil
+}
+
+// mergeMessageResumeToken saves the resume token of the shard found
+// in the last column of the rows, and returns the rows with the token
+// of all the shards instead. It must be called with the lock of the
+// stream held.
+func mergeMessageResumeToken(tokens map[string]string, shard string, qr *sqltypes.Result) *sqltypes.Result {
+	if len(qr.Rows) == 0 {
+		return qr
+	}
+	last := qr.Rows[len(qr.Rows)-1]
+	tokens[shard] = last[len(last)-1].ToString()
+	token := sqltypes.NewVarBinary(encodeMessageResumeToken(tokens))
+	merged := *qr
+	merged.Rows = make([][]sqltypes.Value, len(qr.Rows))
+	for i, row := range qr.Rows {
+		merged.Rows[i] = append(append(make([]sqltypes.Value, 0, len(row)), row[:len(row)-1]...), token)
+	}
+	return &merged
+}
+
 // Close closes the underlying Gateway.
 func (stc *ScatterConn) Close() error {
 	return stc.gateway.Close(context.Background())
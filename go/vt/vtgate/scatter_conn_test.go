@@ -387,6 +387,101 @@ func TestScatterConnStreamExecuteSendError(t *testing.T) {
 	}
 }
 
+func TestScatterConnReadAfterWrite(t *testing.T) {
+	createSandbox("TestScatterConnReadAfterWrite")
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, "TestScatterConnReadAfterWrite", "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	rss := []*srvtopo.ResolvedShard{{
+		Target: &querypb.Target{
+			Keyspace:   "TestScatterConnReadAfterWrite",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_REPLICA,
+		},
+		QueryService: sbc,
+	}}
+	queries := []*querypb.BoundQuery{{Sql: "query1"}}
+	session := NewSafeSession(&vtgatepb.Session{ReadAfterWrite: true})
+	gtids := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+
+	// Without a recorded write, the read goes to the replica as is.
+	_, errs := sc.ExecuteMultiShard(context.Background(), rss, queries, topodatapb.TabletType_REPLICA, session, false, false)
+	require.Empty(t, errs)
+	require.Equal(t, "", sbc.Options[0].GetReadAfterWriteGtid())
+
+	// An autocommit write on the master records the GTIDs it returns.
+	master := []*srvtopo.ResolvedShard{{
+		Target: &querypb.Target{
+			Keyspace:   "TestScatterConnReadAfterWrite",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+		QueryService: sbc,
+	}}
+	sbc.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: gtids}})
+	_, errs = sc.ExecuteMultiShard(context.Background(), master, queries, topodatapb.TabletType_MASTER, session, false, true)
+	require.Empty(t, errs)
+
+	// With a recorded write, the replica must have caught up.
+	sbc.Options = nil
+	_, errs = sc.ExecuteMultiShard(context.Background(), rss, queries, topodatapb.TabletType_REPLICA, session, false, false)
+	require.Empty(t, errs)
+	require.Equal(t, 1, len(sbc.Options))
+	require.Equal(t, gtids, sbc.Options[0].GetReadAfterWriteGtid())
+
+	// A replica that has not caught up makes the read go to the master.
+	before := readAfterWriteMasterReads.Get()
+	sbc.Options = nil
+	sbc.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	_, errs = sc.ExecuteMultiShard(context.Background(), rss, queries, topodatapb.TabletType_REPLICA, session, false, false)
+	require.Empty(t, errs)
+	require.Equal(t, 2, len(sbc.Options))
+	require.Equal(t, "", sbc.Options[1].GetReadAfterWriteGtid())
+	require.Equal(t, before+1, readAfterWriteMasterReads.Get())
+}
+
+func TestScatterConnStreamReadAfterWrite(t *testing.T) {
+	createSandbox("TestScatterConnStreamReadAfterWrite")
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, "TestScatterConnStreamReadAfterWrite", "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	rss := []*srvtopo.ResolvedShard{{
+		Target: &querypb.Target{
+			Keyspace:   "TestScatterConnStreamReadAfterWrite",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_REPLICA,
+		},
+		QueryService: sbc,
+	}}
+	bvs := []map[string]*querypb.BindVariable{nil}
+	session := NewSafeSession(&vtgatepb.Session{ReadAfterWrite: true})
+	gtids := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	session.RecordWriteGTIDs(&querypb.Target{
+		Keyspace:   "TestScatterConnStreamReadAfterWrite",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_MASTER,
+	}, gtids)
+	callback := func(*sqltypes.Result) error { return nil }
+
+	// The replica must have caught up with the recorded write.
+	err := sc.StreamExecuteMulti(context.Background(), "query1", rss, bvs, topodatapb.TabletType_REPLICA, session, callback)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sbc.Options))
+	require.Equal(t, gtids, sbc.Options[0].GetReadAfterWriteGtid())
+
+	// A replica that has not caught up makes the read go to the master.
+	before := readAfterWriteMasterReads.Get()
+	sbc.Options = nil
+	sbc.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	err = sc.StreamExecuteMulti(context.Background(), "query1", rss, bvs, topodatapb.TabletType_REPLICA, session, callback)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sbc.Options))
+	require.Equal(t, "", sbc.Options[1].GetReadAfterWriteGtid())
+	require.Equal(t, before+1, readAfterWriteMasterReads.Get())
+}
+
 func TestScatterConnQueryNotInTransaction(t *testing.T) {
 	s := createSandbox("TestScatterConnQueryNotInTransaction")
 	hc := discovery.NewFakeHealthCheck()
@@ -35,6 +35,16 @@ import (
 type TxConn struct {
 	gateway Gateway
 	mode    vtgatepb.TransactionMode
+
+	// nestedSavepoints makes a BEGIN issued inside a transaction
+	// create a savepoint instead of committing the transaction.
+	nestedSavepoints bool
+
+	// afterCommit holds the functions to run when the
+	// transactions they were registered for end, indexed
+	// by txKey. See AfterCommit.
+	mu          sync.Mutex
+	afterCommit map[string][]func()
 }
 
 // NewTxConn builds a new TxConn.
@@ -75,6 +85,7 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	if !session.InTransaction() {
 		return nil
 	}
+	defer txc.runAfterCommit(txKey(session))
 
 	twopc := false
 	switch session.TransactionMode {
@@ -93,10 +104,21 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	return txc.commitNormal(ctx, session)
 }
 
+// commitShard commits the transaction of a shard session, and records
+// its GTIDs for the reads of a read-after-write session.
+func (txc *TxConn) commitShard(ctx context.Context, session *SafeSession, s *vtgatepb.Session_ShardSession) error {
+	gtids, err := txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+	if err != nil {
+		return err
+	}
+	session.RecordWriteGTIDs(s.Target, gtids)
+	return nil
+}
+
 func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error {
 	if err := txc.runSessions(session.PreSessions, func(s *vtgatepb.Session_ShardSession) error {
 		defer func() { s.TransactionId = 0 }()
-		return txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+		return txc.commitShard(ctx, session, s)
 	}); err != nil {
 		_ = txc.Rollback(ctx, session)
 		return err
@@ -104,7 +126,7 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
-		if err := txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
+		if err := txc.commitShard(ctx, session, shardSession); err != nil {
 			shardSession.TransactionId = 0
 			_ = txc.Rollback(ctx, session)
 			return err
@@ -114,7 +136,7 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	if err := txc.runSessions(session.PostSessions, func(s *vtgatepb.Session_ShardSession) error {
 		defer func() { s.TransactionId = 0 }()
-		return txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+		return txc.commitShard(ctx, session, s)
 	}); err != nil {
 		// If last commit fails, there will be nothing to rollback.
 		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("post-operation transaction had an error: %v", err)})
@@ -164,6 +186,11 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
+	// The GTIDs of distributed transactions are not returned.
+	for _, s := range session.ShardSessions {
+		session.RecordUnknownWrite(s.Target)
+	}
+
 	err = txc.runSessions(session.ShardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
 		return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
 	})
@@ -180,6 +207,7 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.Reset()
+	defer txc.runAfterCommit(txKey(session))
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -192,6 +220,98 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// AfterCommit registers f to run when the transaction of the session
+// ends, whether it's committed or rolled back. The transaction can span
+// several requests, so it's identified by its first shard transaction.
+// If the session has no shard transaction yet, f runs right away.
+func (txc *TxConn) AfterCommit(session *SafeSession, f func()) {
+	key := txKey(session)
+	if key == "" {
+		f()
+		return
+	}
+	txc.mu.Lock()
+	defer txc.mu.Unlock()
+	if txc.afterCommit == nil {
+		txc.afterCommit = make(map[string][]func())
+	}
+	txc.afterCommit[key] = append(txc.afterCommit[key], f)
+}
+
+func (txc *TxConn) runAfterCommit(key string) {
+	if key == "" {
+		return
+	}
+	txc.mu.Lock()
+	funcs := txc.afterCommit[key]
+	delete(txc.afterCommit, key)
+	txc.mu.Unlock()
+	for _, f := range funcs {
+		f()
+	}
+}
+
+// txKey identifies the transaction of the session by its first
+// shard transaction. It's empty if there's none.
+func txKey(session *SafeSession) string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, s := range session.allSessions() {
+		if s.TransactionId != 0 {
+			return fmt.Sprintf("%s/%s/%v:%d", s.Target.Keyspace, s.Target.Shard, s.Target.TabletType, s.TransactionId)
+		}
+	}
+	return ""
+}
+
+// Savepoint emulates a nested BEGIN by creating a savepoint in all the
+// transactions that are currently open.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession) error {
+	name, sessions := session.PushSavepoint()
+	err := txc.runSessions(sessions, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, "savepoint "+name, nil, s.TransactionId, session.Options)
+		return err
+	})
+	if err != nil {
+		_, _, _ = session.PopSavepoint(false)
+		return err
+	}
+	return nil
+}
+
+// ReleaseSavepoint concludes a nested BEGIN by releasing the most recent
+// savepoint. The transaction remains open.
+func (txc *TxConn) ReleaseSavepoint(ctx context.Context, session *SafeSession) error {
+	name, sessions, _ := session.PopSavepoint(false)
+	return txc.runSessions(sessions, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, "release savepoint "+name, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
+// RollbackToSavepoint undoes the work done since the most recent savepoint.
+// Transactions that were opened after the savepoint was created are
+// rolled back entirely and removed from the session.
+func (txc *TxConn) RollbackToSavepoint(ctx context.Context, session *SafeSession) error {
+	name, before, after := session.PopSavepoint(true)
+	allErrors := new(concurrency.AllErrorRecorder)
+	allErrors.RecordError(txc.runSessions(before, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, "rollback to "+name, nil, s.TransactionId, session.Options)
+		return err
+	}))
+	allErrors.RecordError(txc.runSessions(after, func(s *vtgatepb.Session_ShardSession) error {
+		if s.TransactionId == 0 {
+			return nil
+		}
+		return txc.gateway.Rollback(ctx, s.Target, s.TransactionId)
+	}))
+	if allErrors.HasErrors() {
+		session.SetRollback()
+		return allErrors.AggrError(vterrors.Aggregate)
+	}
+	return nil
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
@@ -17,10 +17,12 @@ limitations under the License.
 package vtgate
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
@@ -143,6 +145,49 @@ func TestTxConnCommitSuccess(t *testing.T) {
 	}
 }
 
+func TestTxConnCommitReadAfterWrite(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, rss01 := newTestTxConnEnv(t, "TestTxConn")
+	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
+	src := "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+	session := NewSafeSession(&vtgatepb.Session{ReadAfterWrite: true})
+	shard0 := &querypb.Target{Keyspace: "TestTxConn", Shard: "0"}
+	shard1 := &querypb.Target{Keyspace: "TestTxConn", Shard: "1"}
+
+	// The commits record the GTIDs of their transactions. Those of
+	// transactions which wrote nothing are empty.
+	session.Session.InTransaction = true
+	sc.Execute(context.Background(), "query1", nil, rss01, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sbc0.CommitGTIDs = src + ":3"
+	require.NoError(t, sc.txConn.Commit(context.Background(), session))
+	gtid, ok := session.ReadAfterWriteGTID(shard0)
+	require.True(t, ok)
+	require.Equal(t, src+":3", gtid)
+	_, ok = session.ReadAfterWriteGTID(shard1)
+	require.False(t, ok)
+
+	// The GTIDs of later transactions are added.
+	session.Session.InTransaction = true
+	sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sbc0.CommitGTIDs = src + ":5"
+	require.NoError(t, sc.txConn.Commit(context.Background(), session))
+	gtid, _ = session.ReadAfterWriteGTID(shard0)
+	require.Equal(t, src+":3:5", gtid)
+
+	// The GTIDs of distributed transactions are not known.
+	sc.txConn.mode = vtgatepb.TransactionMode_TWOPC
+	session.Session.InTransaction = true
+	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
+	sc.Execute(context.Background(), "query1", nil, rss1, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	require.NoError(t, sc.txConn.Commit(context.Background(), session))
+	for _, target := range []*querypb.Target{shard0, shard1} {
+		gtid, ok = session.ReadAfterWriteGTID(target)
+		require.True(t, ok)
+		require.Equal(t, "", gtid)
+	}
+	require.EqualValues(t, 1, sbc1.StartCommitCount.Get())
+}
+
 func TestTxConnCommitOrderFailure1(t *testing.T) {
 	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
@@ -590,6 +635,70 @@ func TestTxConnRollback(t *testing.T) {
 	}
 }
 
+func TestTxConnSavepoint(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TxConnSavepoint")
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	if err := sc.txConn.Savepoint(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+	sc.Execute(context.Background(), "query2", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sc.Execute(context.Background(), "query2", nil, rss1, topodatapb.TabletType_MASTER, session, false, nil, false)
+	if got := len(session.ShardSessions); got != 2 {
+		t.Fatalf("len(ShardSessions): %d, want 2", got)
+	}
+
+	// Rolling back to the savepoint keeps the first transaction
+	// and fully rolls back the one that was started after it.
+	if err := sc.txConn.RollbackToSavepoint(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+	if session.HasSavepoints() {
+		t.Errorf("HasSavepoints: true, want false")
+	}
+	if got := len(session.ShardSessions); got != 1 {
+		t.Errorf("len(ShardSessions): %d, want 1", got)
+	}
+	wantQueries := []string{"query1", "savepoint vt_sp1", "query2", "rollback to vt_sp1"}
+	var gotQueries []string
+	for _, q := range sbc0.Queries {
+		gotQueries = append(gotQueries, q.Sql)
+	}
+	if !reflect.DeepEqual(gotQueries, wantQueries) {
+		t.Errorf("sbc0.Queries: %v, want %v", gotQueries, wantQueries)
+	}
+	if c := sbc0.RollbackCount.Get(); c != 0 {
+		t.Errorf("sbc0.RollbackCount: %d, want 0", c)
+	}
+	if c := sbc1.RollbackCount.Get(); c != 1 {
+		t.Errorf("sbc1.RollbackCount: %d, want 1", c)
+	}
+
+	// Releasing a savepoint leaves the transaction open.
+	sbc0.Queries = nil
+	if err := sc.txConn.Savepoint(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.txConn.ReleaseSavepoint(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+	wantQueries = []string{"savepoint vt_sp1", "release savepoint vt_sp1"}
+	gotQueries = nil
+	for _, q := range sbc0.Queries {
+		gotQueries = append(gotQueries, q.Sql)
+	}
+	if !reflect.DeepEqual(gotQueries, wantQueries) {
+		t.Errorf("sbc0.Queries: %v, want %v", gotQueries, wantQueries)
+	}
+	if !session.InTransaction() || len(session.ShardSessions) != 1 {
+		t.Errorf("session: %v, want open transaction with one shard session", session.Session)
+	}
+	if c := sbc0.CommitCount.Get(); c != 0 {
+		t.Errorf("sbc0.CommitCount: %d, want 0", c)
+	}
+}
+
 func TestTxConnResolveOnPrepare(t *testing.T) {
 	sc, sbc0, sbc1, _, _, _ := newTestTxConnEnv(t, "TestTxConn")
 
@@ -932,6 +1041,35 @@ func TestTxConnMultiGoTargets(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTxConnAfterCommit(t *testing.T) {
+	sc, _, _, rss0, _, _ := newTestTxConnEnv(t, "TestTxConn")
+	var ran int
+	f := func() { ran++ }
+
+	// Without a shard transaction, the function runs right away.
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	sc.txConn.AfterCommit(session, f)
+	assert.Equal(t, 1, ran)
+
+	// The function runs when the transaction is committed, even
+	// if it's done with another SafeSession of the same session.
+	sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sc.txConn.AfterCommit(session, f)
+	assert.Equal(t, 1, ran)
+	err := sc.txConn.Commit(context.Background(), NewSafeSession(session.Session))
+	require.NoError(t, err)
+	assert.Equal(t, 2, ran)
+
+	// Or rolled back.
+	session = NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	sc.txConn.AfterCommit(session, f)
+	err = sc.txConn.Rollback(context.Background(), session)
+	require.NoError(t, err)
+	assert.Equal(t, 3, ran)
+	assert.Empty(t, sc.txConn.afterCommit)
+}
+
 func newTestTxConnEnv(t *testing.T, name string) (sc *ScatterConn, sbc0, sbc1 *sandboxconn.SandboxConn, rss0, rss1, rss01 []*srvtopo.ResolvedShard) {
 	createSandbox(name)
 	hc := discovery.NewFakeHealthCheck()
@@ -53,13 +53,14 @@ var _ iExecute = (*Executor)(nil)
 type iExecute interface {
 	Execute(ctx context.Context, method string, session *SafeSession, s string, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
 	ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, tabletType topodatapb.TabletType, session *SafeSession, notInTransaction bool, autocommit bool) (qr *sqltypes.Result, errs []error)
-	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
+	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, tabletType topodatapb.TabletType, session *SafeSession, callback func(reply *sqltypes.Result) error) error
+	AfterCommit(session *SafeSession, f func())
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
 }
 
-//VSchemaOperator is an interface to Vschema Operations
+// VSchemaOperator is an interface to Vschema Operations
 type VSchemaOperator interface {
 	GetCurrentSrvVschema() *vschemapb.SrvVSchema
 	GetCurrentVschema() (*vindexes.VSchema, error)
@@ -82,8 +83,15 @@ type vcursorImpl struct {
 	// executed. If there was a subsequent failure, the transaction
 	// must be forced to rollback.
 	rollbackOnPartialExec bool
-	vschema               *vindexes.VSchema
-	vm                    VSchemaOperator
+	// executedQueries counts the queries which succeeded, to
+	// know whether the statement can be executed again.
+	executedQueries uint32
+	vschema         *vindexes.VSchema
+	vm              VSchemaOperator
+	// vschemaKeyspaces and vschemaNames record the vschema lookups
+	// made while building a plan. See engine.Plan.
+	vschemaKeyspaces map[string]bool
+	vschemaNames     map[string]bool
 }
 
 func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.DDL) error {
@@ -189,6 +197,7 @@ func (vc *vcursorImpl) FindTable(name sqlparser.TableName) (*vindexes.Table, str
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
+	vc.recordVSchemaLookup(destKeyspace, name.Name.String())
 	table, err := vc.vschema.FindTable(destKeyspace, name.Name.String())
 	if err != nil {
 		return nil, "", destTabletType, nil, err
@@ -205,6 +214,7 @@ func (vc *vcursorImpl) FindTablesOrVindex(name sqlparser.TableName) ([]*vindexes
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
+	vc.recordVSchemaLookup(destKeyspace, name.Name.String())
 	tables, vindex, err := vc.vschema.FindTablesOrVindex(destKeyspace, name.Name.String(), vc.tabletType)
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
@@ -212,6 +222,31 @@ func (vc *vcursorImpl) FindTablesOrVindex(name sqlparser.TableName) ([]*vindexes
 	return tables, vindex, destKeyspace, destTabletType, dest, nil
 }
 
+// recordVSchemaLookup records that the plan being built depends on
+// the given keyspace and table or vindex name. Empty values are ignored.
+func (vc *vcursorImpl) recordVSchemaLookup(keyspace, name string) {
+	if vc.vschemaKeyspaces == nil {
+		vc.vschemaKeyspaces = make(map[string]bool)
+		vc.vschemaNames = make(map[string]bool)
+	}
+	if keyspace != "" {
+		vc.vschemaKeyspaces[keyspace] = true
+	}
+	if name != "" {
+		vc.vschemaNames[name] = true
+	}
+}
+
+// setVSchemaDependencies moves the vschema lookups recorded so far
+// into the plan.
+func (vc *vcursorImpl) setVSchemaDependencies(plan *engine.Plan) {
+	plan.VSchemaKeyspaces, plan.VSchemaNames = vc.vschemaKeyspaces, vc.vschemaNames
+	if plan.VSchemaKeyspaces == nil {
+		plan.VSchemaKeyspaces, plan.VSchemaNames = make(map[string]bool), make(map[string]bool)
+	}
+	vc.vschemaKeyspaces, vc.vschemaNames = nil, nil
+}
+
 // DefaultKeyspace returns the default keyspace of the current request
 // if there is one. If the keyspace specified in the target cannot be
 // identified, it returns an error.
@@ -219,6 +254,7 @@ func (vc *vcursorImpl) DefaultKeyspace() (*vindexes.Keyspace, error) {
 	if vc.keyspace == "" {
 		return nil, errNoKeyspace
 	}
+	vc.recordVSchemaLookup(vc.keyspace, "")
 	ks, ok := vc.vschema.Keyspaces[vc.keyspace]
 	if !ok {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", vc.keyspace)
@@ -241,10 +277,18 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 		session.SetCommitOrder(co)
 		defer session.SetCommitOrder(vtgatepb.CommitOrder_NORMAL)
 	}
+	// Queries issued by vtgate itself, like the inserts into lookup
+	// tables, must not change the LAST_INSERT_ID of the session.
+	defer func(lastInsertID uint64) {
+		vc.safeSession.LastInsertId = lastInsertID
+	}(vc.safeSession.LastInsertId)
 
 	qr, err := vc.executor.Execute(vc.ctx, method, session, vc.marginComments.Leading+query+vc.marginComments.Trailing, bindVars)
-	if err == nil && rollbackOnError {
-		vc.rollbackOnPartialExec = true
+	if err == nil {
+		atomic.AddUint32(&vc.executedQueries, 1)
+		if rollbackOnError {
+			vc.rollbackOnPartialExec = true
+		}
 	}
 	return qr, err
 }
@@ -253,6 +297,9 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(queries)))
 	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, commentedShardQueries(queries, vc.marginComments), vc.tabletType, vc.safeSession, false, autocommit)
+	if len(errs) < len(queries) {
+		atomic.AddUint32(&vc.executedQueries, uint32(len(queries)-len(errs)))
+	}
 
 	if errs == nil && rollbackOnError {
 		vc.rollbackOnPartialExec = true
@@ -283,7 +330,17 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.tabletType, vc.safeSession.Options, callback)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.tabletType, vc.safeSession, callback)
+}
+
+// InTransaction is part of the vindexes.TxVCursor interface.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
+// AfterCommit is part of the vindexes.TxVCursor interface.
+func (vc *vcursorImpl) AfterCommit(f func()) {
+	vc.executor.AfterCommit(vc.safeSession, f)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -361,6 +418,7 @@ func (vc *vcursorImpl) TargetDestination(qualifier string) (key.Destination, *vi
 	if keyspaceName == "" {
 		return nil, nil, 0, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace not specified")
 	}
+	vc.recordVSchemaLookup(keyspaceName, "")
 	keyspace := vc.vschema.Keyspaces[keyspaceName]
 	if keyspace == nil {
 		return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no keyspace with name [%s] found", keyspaceName)
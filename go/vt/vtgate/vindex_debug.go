@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+const pathVindex = "/debug/vindex"
+
+// VindexEvaluation describes how a vindex maps a value:
+// the destination and shards of the value, and for lookup
+// vindexes, the queries that were executed to find them.
+type VindexEvaluation struct {
+	Keyspace    string
+	Vindex      string
+	Values      []string
+	Destination string
+	KeyspaceIDs []string `json:",omitempty"`
+	Shards      []string
+	Queries     []*VindexQuery `json:",omitempty"`
+}
+
+// VindexQuery is a query executed by a vindex, along with the rows it returned.
+type VindexQuery struct {
+	Query    string
+	BindVars map[string]*querypb.BindVariable
+	Rows     [][]string
+}
+
+// recordingVCursor records the queries executed by a vindex.
+type recordingVCursor struct {
+	vindexes.VCursor
+	queries []*VindexQuery
+}
+
+// Execute executes the query and records it along with its result.
+func (rc *recordingVCursor) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
+	qr, err := rc.VCursor.Execute(method, query, bindVars, rollbackOnError, co)
+	vq := &VindexQuery{
+		Query:    query,
+		BindVars: bindVars,
+	}
+	if qr != nil {
+		for _, row := range qr.Rows {
+			values := make([]string, 0, len(row))
+			for _, value := range row {
+				values = append(values, value.ToString())
+			}
+			vq.Rows = append(vq.Rows, values)
+		}
+	}
+	rc.queries = append(rc.queries, vq)
+	return qr, err
+}
+
+// EvaluateVindex maps the values of a row with the vindex of the keyspace,
+// and returns where the row would be found for the tablet type.
+func (e *Executor) EvaluateVindex(ctx context.Context, keyspace, vindexName string, values []sqltypes.Value, tabletType topodatapb.TabletType) (*VindexEvaluation, error) {
+	vindex, err := e.VSchema().FindVindex(keyspace, vindexName)
+	if err != nil {
+		return nil, err
+	}
+	if vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in keyspace %s", vindexName, keyspace)
+	}
+	if _, ok := vindex.(vindexes.MultiColumn); !ok && len(values) != 1 {
+		return nil, fmt.Errorf("vindex %s needs exactly one value, got %d", vindexName, len(values))
+	}
+
+	safeSession := NewSafeSession(&vtgatepb.Session{TargetString: "@" + topoproto.TabletTypeLString(tabletType)})
+	logStats := NewLogStats(ctx, "EvaluateVindex", "", nil)
+	vcursor, err := newVCursorImpl(ctx, safeSession, sqlparser.MarginComments{}, e, logStats, e.vm, e.resolver.resolver)
+	if err != nil {
+		return nil, err
+	}
+	rc := &recordingVCursor{VCursor: vcursor}
+	destinations, err := vindexes.Map(vindex, rc, [][]sqltypes.Value{values})
+	if err != nil {
+		return nil, err
+	}
+
+	eval := &VindexEvaluation{
+		Keyspace:    keyspace,
+		Vindex:      vindexName,
+		Destination: destinations[0].String(),
+		Queries:     rc.queries,
+	}
+	for _, value := range values {
+		eval.Values = append(eval.Values, value.ToString())
+	}
+	switch d := destinations[0].(type) {
+	case key.DestinationKeyspaceID:
+		eval.KeyspaceIDs = append(eval.KeyspaceIDs, hex.EncodeToString(d))
+	case key.DestinationKeyspaceIDs:
+		for _, ksid := range d {
+			eval.KeyspaceIDs = append(eval.KeyspaceIDs, hex.EncodeToString(ksid))
+		}
+	}
+	rss, _, err := e.resolver.resolver.ResolveDestinations(ctx, keyspace, tabletType, nil, destinations)
+	if err != nil {
+		return nil, err
+	}
+	for _, rs := range rss {
+		eval.Shards = append(eval.Shards, rs.Target.Shard)
+	}
+	return eval, nil
+}
+
+// serveVindex serves the result of EvaluateVindex. The keyspace, vindex
+// and value parameters are required. A value must be supplied for each
+// column of a multi-column vindex. The tablet_type parameter defaults to master.
+func (e *Executor) serveVindex(response http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	keyspace := request.Form.Get("keyspace")
+	vindexName := request.Form.Get("vindex")
+	if keyspace == "" || vindexName == "" || len(request.Form["value"]) == 0 {
+		http.Error(response, "keyspace, vindex and value must be specified", http.StatusBadRequest)
+		return
+	}
+	tabletType := topodatapb.TabletType_MASTER
+	if tt := request.Form.Get("tablet_type"); tt != "" {
+		var err error
+		if tabletType, err = topoproto.ParseTabletType(tt); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	var values []sqltypes.Value
+	for _, v := range request.Form["value"] {
+		values = append(values, sqltypes.NewVarChar(v))
+	}
+	eval, err := e.EvaluateVindex(request.Context(), keyspace, vindexName, values, tabletType)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	returnAsJSON(response, eval)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDebugVindex(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+
+	serve := func(url string) (int, string) {
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		executor.ServeHTTP(resp, req)
+		return resp.Code, resp.Body.String()
+	}
+
+	code, body := serve("/debug/vindex?keyspace=TestExecutor&vindex=hash_index&value=1")
+	require.Equal(t, http.StatusOK, code, body)
+	got := &VindexEvaluation{}
+	require.NoError(t, json.Unmarshal([]byte(body), got))
+	want := &VindexEvaluation{
+		Keyspace:    "TestExecutor",
+		Vindex:      "hash_index",
+		Values:      []string{"1"},
+		Destination: "DestinationKeyspaceID(166b40b44aba4bd6)",
+		KeyspaceIDs: []string{"166b40b44aba4bd6"},
+		Shards:      []string{"-20"},
+	}
+	assert.Equal(t, want, got)
+
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("user_id", "int64"),
+		"1",
+	)})
+	code, body = serve("/debug/vindex?keyspace=TestExecutor&vindex=music_user_map&value=3")
+	require.Equal(t, http.StatusOK, code, body)
+	got = &VindexEvaluation{}
+	require.NoError(t, json.Unmarshal([]byte(body), got))
+	assert.Equal(t, []string{"-20"}, got.Shards)
+	require.Equal(t, 1, len(got.Queries))
+	assert.Equal(t, "select user_id from music_user_map where music_id = :music_id", got.Queries[0].Query)
+	assert.Equal(t, [][]string{{"1"}}, got.Queries[0].Rows)
+
+	errcases := []struct {
+		url  string
+		code int
+		err  string
+	}{{
+		url:  "/debug/vindex?keyspace=TestExecutor&vindex=hash_index",
+		code: http.StatusBadRequest,
+		err:  "keyspace, vindex and value must be specified\n",
+	}, {
+		url:  "/debug/vindex?keyspace=TestExecutor&vindex=nosuch&value=1",
+		code: http.StatusBadRequest,
+		err:  "vindex nosuch not found in keyspace TestExecutor\n",
+	}, {
+		url:  "/debug/vindex?keyspace=nosuch&vindex=hash_index&value=1",
+		code: http.StatusBadRequest,
+		err:  "keyspace nosuch not found in vschema\n",
+	}, {
+		url:  "/debug/vindex?keyspace=TestExecutor&vindex=hash_index&value=1&value=2",
+		code: http.StatusBadRequest,
+		err:  "vindex hash_index needs exactly one value, got 2\n",
+	}, {
+		url:  "/debug/vindex?keyspace=TestExecutor&vindex=hash_index&value=1&tablet_type=bad",
+		code: http.StatusBadRequest,
+		err:  "unknown TabletType bad\n",
+	}}
+	for _, tcase := range errcases {
+		code, body := serve(tcase.url)
+		assert.Equal(t, tcase.code, code, tcase.url)
+		assert.Equal(t, tcase.err, body, tcase.url)
+	}
+}
@@ -36,9 +36,13 @@ import (
 var (
 	_ SingleColumn  = (*ConsistentLookupUnique)(nil)
 	_ Lookup        = (*ConsistentLookupUnique)(nil)
+	_ BatchDeleter  = (*ConsistentLookupUnique)(nil)
+	_ BatchUpdater  = (*ConsistentLookupUnique)(nil)
 	_ WantOwnerInfo = (*ConsistentLookupUnique)(nil)
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
+	_ BatchDeleter  = (*ConsistentLookup)(nil)
+	_ BatchUpdater  = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
 )
 
@@ -200,6 +204,11 @@ func newCLCommon(name string, m map[string]string) (*clCommon, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The rows of a consistent lookup are created and deleted in
+	// separate transactions, which the cache can't follow.
+	if _, ok := m["cache_size"]; ok {
+		return nil, fmt.Errorf("cache_size is not supported by consistent lookup vindexes")
+	}
 
 	if err := lu.lkp.Init(m, false /* autocommit */, false /* upsert */); err != nil {
 		return nil, err
@@ -302,24 +311,54 @@ func (lu *clCommon) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_POST)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lu *clCommon) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	return lu.lkp.BatchDelete(vcursor, rowsColValues, ksidsToValues(ksids), vtgatepb.CommitOrder_POST)
+}
+
 // Update updates the entry in the vindex table.
 func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
-	equal := true
+	if valuesEqual(oldValues, newValues) {
+		return nil
+	}
+	if err := lu.Delete(vcursor, [][]sqltypes.Value{oldValues}, ksid); err != nil {
+		return err
+	}
+	return lu.Create(vcursor, [][]sqltypes.Value{newValues}, [][]byte{ksid}, false /* ignoreMode */)
+}
+
+// BatchUpdate updates the entries of all the rows in the vindex table.
+// Like Update, it skips the rows whose values don't change.
+func (lu *clCommon) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	var changedOld, changedNew [][]sqltypes.Value
+	var changedKsids [][]byte
 	for i := range oldValues {
-		result, err := sqltypes.NullsafeCompare(oldValues[i], newValues[i])
-		// errors from NullsafeCompare can be ignored. if they are real problems, we'll see them in the Create/Update
-		if err != nil || result != 0 {
-			equal = false
-			break
+		if valuesEqual(oldValues[i], newValues[i]) {
+			continue
 		}
+		changedOld = append(changedOld, oldValues[i])
+		changedNew = append(changedNew, newValues[i])
+		changedKsids = append(changedKsids, ksids[i])
 	}
-	if equal {
+	if len(changedKsids) == 0 {
 		return nil
 	}
-	if err := lu.Delete(vcursor, [][]sqltypes.Value{oldValues}, ksid); err != nil {
+	if err := lu.BatchDelete(vcursor, changedOld, changedKsids); err != nil {
 		return err
 	}
-	return lu.Create(vcursor, [][]sqltypes.Value{newValues}, [][]byte{ksid}, false /* ignoreMode */)
+	return lu.Create(vcursor, changedNew, changedKsids, false /* ignoreMode */)
+}
+
+// valuesEqual returns true if the old and new values of a row are equal.
+func valuesEqual(oldValues, newValues []sqltypes.Value) bool {
+	for i := range oldValues {
+		result, err := sqltypes.NullsafeCompare(oldValues[i], newValues[i])
+		// errors from NullsafeCompare can be ignored. if they are real problems, we'll see them in the Create/Update
+		if err != nil || result != 0 {
+			return false
+		}
+	}
+	return true
 }
 
 // MarshalJSON returns a JSON representation of clCommon.
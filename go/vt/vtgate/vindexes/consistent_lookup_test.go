@@ -50,6 +50,16 @@ func TestConsistentLookupInit(t *testing.T) {
 	}
 }
 
+func TestConsistentLookupCacheSize(t *testing.T) {
+	_, err := CreateVindex("consistent_lookup", "consistent_lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+	})
+	assert.EqualError(t, err, "cache_size is not supported by consistent lookup vindexes")
+}
+
 func TestConsistentLookupInfo(t *testing.T) {
 	lookup := createConsistentLookup(t, "consistent_lookup", false)
 	assert.Equal(t, 20, lookup.Cost())
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var (
+	_ SingleColumn = (*JSONPath)(nil)
+)
+
+func init() {
+	Register("json_path", NewJSONPath)
+}
+
+// JSONPath is a vindex for JSON columns. It extracts the scalar
+// value at the configured path from the document, and maps it
+// using another functional unique vindex. This allows a table
+// to be sharded by an attribute of its JSON documents.
+type JSONPath struct {
+	name   string
+	path   string
+	steps  []jsonPathStep
+	vindex SingleColumn
+}
+
+// jsonPathStep is an object member or an array element of a JSON path.
+type jsonPathStep struct {
+	member string
+	index  int
+	// isIndex is set if the step is an array element.
+	isIndex bool
+}
+
+// NewJSONPath creates a JSONPath vindex.
+// The path param is required. It uses the MySQL JSON path syntax
+// restricted to members and array elements, like $.user.ids[0] or
+// $."first name". The optional vindex param is the type of the vindex
+// used to map the extracted value. It defaults to binary_md5.
+func NewJSONPath(name string, m map[string]string) (Vindex, error) {
+	steps, err := parseJSONPath(m["path"])
+	if err != nil {
+		return nil, fmt.Errorf("json_path: %v", err)
+	}
+	vindexType := m["vindex"]
+	if vindexType == "" {
+		vindexType = "binary_md5"
+	}
+	vindex, err := CreateVindex(vindexType, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("json_path: %v", err)
+	}
+	single, ok := vindex.(SingleColumn)
+	if !ok || !single.IsUnique() || single.NeedsVCursor() {
+		return nil, fmt.Errorf("json_path: vindex must be a unique functional vindex: %s", vindexType)
+	}
+	return &JSONPath{
+		name:   name,
+		path:   m["path"],
+		steps:  steps,
+		vindex: single,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *JSONPath) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of the underlying vindex.
+func (vind *JSONPath) Cost() int {
+	return vind.vindex.Cost()
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *JSONPath) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *JSONPath) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *JSONPath) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	values, err := vind.extract(ids)
+	if err != nil {
+		return nil, fmt.Errorf("JSONPath.Map: %v", err)
+	}
+	return vind.vindex.Map(vcursor, values)
+}
+
+// Verify returns true if ids maps to ksids.
+func (vind *JSONPath) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	values, err := vind.extract(ids)
+	if err != nil {
+		return nil, fmt.Errorf("JSONPath.Verify: %v", err)
+	}
+	return vind.vindex.Verify(vcursor, values, ksids)
+}
+
+// extract returns the values at the path of the JSON documents.
+func (vind *JSONPath) extract(ids []sqltypes.Value) ([]sqltypes.Value, error) {
+	values := make([]sqltypes.Value, 0, len(ids))
+	for _, id := range ids {
+		decoder := json.NewDecoder(bytes.NewReader(id.ToBytes()))
+		decoder.UseNumber()
+		var doc interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("invalid JSON document: %v", err)
+		}
+		value, err := vind.lookupPath(doc)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (vind *JSONPath) lookupPath(doc interface{}) (sqltypes.Value, error) {
+	for _, step := range vind.steps {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			if step.isIndex {
+				return sqltypes.NULL, fmt.Errorf("path %s not found in document", vind.path)
+			}
+			var ok bool
+			if doc, ok = node[step.member]; !ok {
+				return sqltypes.NULL, fmt.Errorf("path %s not found in document", vind.path)
+			}
+		case []interface{}:
+			if !step.isIndex || step.index >= len(node) {
+				return sqltypes.NULL, fmt.Errorf("path %s not found in document", vind.path)
+			}
+			doc = node[step.index]
+		default:
+			return sqltypes.NULL, fmt.Errorf("path %s not found in document", vind.path)
+		}
+	}
+	switch value := doc.(type) {
+	case string:
+		return sqltypes.NewVarChar(value), nil
+	case json.Number:
+		if v, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			return sqltypes.NewInt64(v), nil
+		}
+		if v, err := strconv.ParseUint(string(value), 10, 64); err == nil {
+			return sqltypes.NewUint64(v), nil
+		}
+		v, err := value.Float64()
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return sqltypes.NewFloat64(v), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("value at path %s is not a string or a number", vind.path)
+}
+
+// parseJSONPath parses a path like $.a."b c"[1].
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path must start with $: '%s'", path)
+	}
+	var steps []jsonPathStep
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			if strings.HasPrefix(rest, `"`) {
+				end := strings.IndexByte(rest[1:], '"')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated quoted member in path: '%s'", path)
+				}
+				steps = append(steps, jsonPathStep{member: rest[1 : end+1]})
+				rest = rest[end+2:]
+				continue
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty member in path: '%s'", path)
+			}
+			steps = append(steps, jsonPathStep{member: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated array index in path: '%s'", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid array index in path: '%s'", path)
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path: '%s'", path)
+		}
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("path must select a member or an array element: '%s'", path)
+	}
+	return steps, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func createJSONPath(t *testing.T, params map[string]string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("json_path", "json", params)
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestJSONPathInfo(t *testing.T) {
+	vindex := createJSONPath(t, map[string]string{"path": "$.id"})
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "json", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+}
+
+func TestJSONPathNewErrors(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "json_path: path must start with $: ''",
+	}, {
+		params: map[string]string{"path": "$"},
+		err:    "json_path: path must select a member or an array element: '$'",
+	}, {
+		params: map[string]string{"path": "$.a..b"},
+		err:    "json_path: empty member in path: '$.a..b'",
+	}, {
+		params: map[string]string{"path": `$."a`},
+		err:    `json_path: unterminated quoted member in path: '$."a'`,
+	}, {
+		params: map[string]string{"path": "$[1"},
+		err:    "json_path: unterminated array index in path: '$[1'",
+	}, {
+		params: map[string]string{"path": "$[-1]"},
+		err:    "json_path: invalid array index in path: '$[-1]'",
+	}, {
+		params: map[string]string{"path": "$a"},
+		err:    "json_path: invalid path: '$a'",
+	}, {
+		params: map[string]string{"path": "$.a", "vindex": "nosuch"},
+		err:    `json_path: vindexType "nosuch" not found`,
+	}, {
+		params: map[string]string{"path": "$.a", "vindex": "lookup_hash"},
+		err:    "json_path: vindex must be a unique functional vindex: lookup_hash",
+	}}
+	for _, tcase := range testcases {
+		_, err := CreateVindex("json_path", "json", tcase.params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestJSONPathMap(t *testing.T) {
+	binVindex := createJSONPath(t, map[string]string{"path": `$.user."first name"`})
+	hashVindex := createJSONPath(t, map[string]string{"path": "$.ids[1]", "vindex": "hash"})
+
+	got, err := binVindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar(`{"user": {"first name": "abc", "age": 3}}`),
+		sqltypes.NewVarChar(`{"user": {"age": 4, "first name": "abc"}}`),
+	})
+	require.NoError(t, err)
+	want, err := binVindex.(*JSONPath).vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("abc")})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{want[0], want[0]}, got)
+
+	got, err = hashVindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(`{"ids": [5, 1, 2]}`)})
+	require.NoError(t, err)
+	want, err = hashVindex.(*JSONPath).vindex.Map(nil, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	errcases := []struct {
+		doc string
+		err string
+	}{{
+		doc: `{"ids": [5]}`,
+		err: "JSONPath.Map: path $.ids[1] not found in document",
+	}, {
+		doc: `{"ids": {"1": 1}}`,
+		err: "JSONPath.Map: path $.ids[1] not found in document",
+	}, {
+		doc: `{"ids": [5, {"a": 1}]}`,
+		err: "JSONPath.Map: value at path $.ids[1] is not a string or a number",
+	}, {
+		doc: `{"ids": [5, null]}`,
+		err: "JSONPath.Map: value at path $.ids[1] is not a string or a number",
+	}, {
+		doc: `[1, 2]`,
+		err: "JSONPath.Map: path $.ids[1] not found in document",
+	}, {
+		doc: `not json`,
+		err: "JSONPath.Map: invalid JSON document: invalid character 'o' in literal null (expecting 'u')",
+	}}
+	for _, tcase := range errcases {
+		_, err := hashVindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(tcase.doc)})
+		assert.EqualError(t, err, tcase.err, tcase.doc)
+	}
+}
+
+func TestJSONPathVerify(t *testing.T) {
+	vindex := createJSONPath(t, map[string]string{"path": "$.id", "vindex": "hash"})
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(`{"id": 1}`)})
+	require.NoError(t, err)
+	ksid := []byte(got[0].(key.DestinationKeyspaceID))
+
+	verified, err := vindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarChar(`{"id": 1, "a": "b"}`), sqltypes.NewVarChar(`{"id": 2}`)}, [][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, verified)
+
+	_, err = vindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarChar(`{}`)}, [][]byte{ksid})
+	assert.EqualError(t, err, "JSONPath.Verify: path $.id not found in document")
+}
@@ -29,8 +29,12 @@ import (
 var (
 	_ SingleColumn = (*LookupUnique)(nil)
 	_ Lookup       = (*LookupUnique)(nil)
+	_ BatchDeleter = (*LookupUnique)(nil)
+	_ BatchUpdater = (*LookupUnique)(nil)
 	_ SingleColumn = (*LookupNonUnique)(nil)
 	_ Lookup       = (*LookupNonUnique)(nil)
+	_ BatchDeleter = (*LookupNonUnique)(nil)
+	_ BatchUpdater = (*LookupNonUnique)(nil)
 )
 
 func init() {
@@ -116,11 +120,21 @@ func (ln *LookupNonUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	return ln.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (ln *LookupNonUnique) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	return ln.lkp.BatchDelete(vcursor, rowsColValues, ksidsToValues(ksids), vtgatepb.CommitOrder_NORMAL)
+}
+
 // Update updates the entry in the vindex table.
 func (ln *LookupNonUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
 	return ln.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (ln *LookupNonUnique) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	return ln.lkp.BatchUpdate(vcursor, oldValues, ksidsToValues(ksids), newValues)
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ln.lkp)
@@ -135,6 +149,9 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -182,6 +199,9 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -269,11 +289,21 @@ func (lu *LookupUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid
 	return lu.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (lu *LookupUnique) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	return lu.lkp.BatchUpdate(vcursor, oldValues, ksidsToValues(ksids), newValues)
+}
+
 // Delete deletes the entry from the vindex table.
 func (lu *LookupUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksid []byte) error {
 	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lu *LookupUnique) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	return lu.lkp.BatchDelete(vcursor, rowsColValues, ksidsToValues(ksids), vtgatepb.CommitOrder_NORMAL)
+}
+
 // MarshalJSON returns a JSON representation of LookupUnique.
 func (lu *LookupUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
@@ -29,8 +29,12 @@ import (
 var (
 	_ SingleColumn = (*LookupHash)(nil)
 	_ Lookup       = (*LookupHash)(nil)
+	_ BatchDeleter = (*LookupHash)(nil)
+	_ BatchUpdater = (*LookupHash)(nil)
 	_ SingleColumn = (*LookupHashUnique)(nil)
 	_ Lookup       = (*LookupHashUnique)(nil)
+	_ BatchDeleter = (*LookupHashUnique)(nil)
+	_ BatchUpdater = (*LookupHashUnique)(nil)
 )
 
 func init() {
@@ -59,6 +63,9 @@ type LookupHash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookupHash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupHash{name: name}
 
@@ -167,6 +174,15 @@ func (lh *LookupHash) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid [
 	return lh.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (lh *LookupHash) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.vunhash: %v", err)
+	}
+	return lh.lkp.BatchUpdate(vcursor, oldValues, values, newValues)
+}
+
 // Delete deletes the entry from the vindex table.
 func (lh *LookupHash) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksid []byte) error {
 	v, err := vunhash(ksid)
@@ -176,6 +192,15 @@ func (lh *LookupHash) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lh *LookupHash) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.vunhash: %v", err)
+	}
+	return lh.lkp.BatchDelete(vcursor, rowsColValues, values, vtgatepb.CommitOrder_NORMAL)
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupHash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -215,6 +240,9 @@ type LookupHashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupHashUnique{name: name}
 
@@ -321,6 +349,15 @@ func (lhu *LookupHashUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.
 	return lhu.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lhu *LookupHashUnique) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.vunhash: %v", err)
+	}
+	return lhu.lkp.BatchDelete(vcursor, rowsColValues, values, vtgatepb.CommitOrder_NORMAL)
+}
+
 // Update updates the entry in the vindex table.
 func (lhu *LookupHashUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
 	v, err := vunhash(ksid)
@@ -330,6 +367,15 @@ func (lhu *LookupHashUnique) Update(vcursor VCursor, oldValues []sqltypes.Value,
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (lhu *LookupHashUnique) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.vunhash: %v", err)
+	}
+	return lhu.lkp.BatchUpdate(vcursor, oldValues, values, newValues)
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupHashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
@@ -22,7 +22,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -36,7 +38,31 @@ type lookupInternal struct {
 	To            string   `json:"to"`
 	Autocommit    bool     `json:"autocommit,omitempty"`
 	Upsert        bool     `json:"upsert,omitempty"`
+	BatchLookup   bool     `json:"batch_lookup,omitempty"`
+	CacheSize     int64    `json:"cache_size,omitempty"`
+	CacheTTL      string   `json:"cache_ttl,omitempty"`
 	sel, ver, del string
+	batchSel      string
+
+	// cache contains the results of recent lookups. It's nil
+	// if caching is not enabled.
+	cache    *cache.LRUCache
+	cacheTTL time.Duration
+}
+
+// defaultLookupCacheTTL is how long a cached lookup result
+// remains valid if cache_ttl is not specified.
+const defaultLookupCacheTTL = 10 * time.Second
+
+// lookupCacheEntry is a cached lookup result.
+type lookupCacheEntry struct {
+	result  *sqltypes.Result
+	expires time.Time
+}
+
+// Size satisfies cache.Value.
+func (*lookupCacheEntry) Size() int {
+	return 1
 }
 
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
@@ -50,6 +76,14 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 
 	lkp.Autocommit = autocommit
 	lkp.Upsert = upsert
+	batchLookup, err := boolFromMap(lookupQueryParams, "batch_lookup")
+	if err != nil {
+		return err
+	}
+	lkp.BatchLookup = batchLookup
+	if err := lkp.initCache(lookupQueryParams); err != nil {
+		return err
+	}
 
 	// TODO @rafael: update sel and ver to support multi column vindexes. This will be done
 	// as part of face 2 of https://github.com/vitessio/vitess/issues/3481
@@ -57,14 +91,121 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s from %s where %s = :%s", lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
+	lkp.batchSel = fmt.Sprintf("select %s, %s from %s where %s in ::%s", lkp.FromColumns[0], lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	return nil
 }
 
+// initCache creates the cache of lookup results if a cache_size is specified.
+// Only the ids found outside of a transaction are cached. Entries expire
+// after cache_ttl, and are invalidated when vtgate writes to the lookup
+// table, and again when the transaction of the write ends. Writes that
+// don't go through this vtgate are only seen after the entry expires.
+func (lkp *lookupInternal) initCache(lookupQueryParams map[string]string) error {
+	size, ok := lookupQueryParams["cache_size"]
+	if !ok {
+		return nil
+	}
+	var err error
+	lkp.CacheSize, err = strconv.ParseInt(size, 10, 64)
+	if err != nil || lkp.CacheSize < 0 {
+		return fmt.Errorf("cache_size value must be a non-negative integer: '%s'", size)
+	}
+	if lkp.CacheSize == 0 {
+		return nil
+	}
+	lkp.cacheTTL = defaultLookupCacheTTL
+	if ttl, ok := lookupQueryParams["cache_ttl"]; ok {
+		lkp.cacheTTL, err = time.ParseDuration(ttl)
+		if err != nil || lkp.cacheTTL <= 0 {
+			return fmt.Errorf("cache_ttl value must be a positive duration: '%s'", ttl)
+		}
+	}
+	lkp.CacheTTL = lkp.cacheTTL.String()
+	lkp.cache = cache.NewLRUCache(lkp.CacheSize)
+	return nil
+}
+
+// invalidate removes the cached results of the 'from' values of the rows.
+// It's called after writing the rows. If the write is part of a transaction,
+// the results are removed again when it ends, because they may have been
+// read again before the commit.
+func (lkp *lookupInternal) invalidate(vcursor VCursor, rowsColValues [][]sqltypes.Value) {
+	if lkp.cache == nil {
+		return
+	}
+	keys := make([]string, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		if len(row) == 0 {
+			continue
+		}
+		keys = append(keys, row[0].ToString())
+	}
+	deleteKeys := func() {
+		for _, key := range keys {
+			lkp.cache.Delete(key)
+		}
+	}
+	deleteKeys()
+	if !lkp.Autocommit && inTransaction(vcursor) {
+		vcursor.(TxVCursor).AfterCommit(deleteKeys)
+	}
+}
+
+// inTransaction returns true if the vcursor is in a transaction.
+func inTransaction(vcursor VCursor) bool {
+	txvc, ok := vcursor.(TxVCursor)
+	return ok && txvc.InTransaction()
+}
+
 // Lookup performs a lookup for the ids.
 func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sqltypes.Result, error) {
 	if vcursor == nil {
 		return nil, fmt.Errorf("cannot perform lookup: no vcursor provided")
 	}
+	// A lookup in a transaction may see uncommitted rows. The
+	// lookups of autocommit vindexes are performed outside of it.
+	if lkp.cache == nil || (!lkp.Autocommit && inTransaction(vcursor)) {
+		return lkp.lookup(vcursor, ids)
+	}
+	results := make([]*sqltypes.Result, len(ids))
+	var missing []sqltypes.Value
+	var missingIdx []int
+	now := time.Now()
+	for i, id := range ids {
+		if v, ok := lkp.cache.Get(id.ToString()); ok {
+			if entry := v.(*lookupCacheEntry); now.Before(entry.expires) {
+				results[i] = entry.result
+				continue
+			}
+		}
+		missing = append(missing, id)
+		missingIdx = append(missingIdx, i)
+	}
+	if len(missing) == 0 {
+		return results, nil
+	}
+	fetched, err := lkp.lookup(vcursor, missing)
+	if err != nil {
+		return nil, err
+	}
+	expires := now.Add(lkp.cacheTTL)
+	for i, result := range fetched {
+		results[missingIdx[i]] = result
+		// Misses are not cached: the row may be created by
+		// a transaction which is not committed yet.
+		if len(result.Rows) == 0 {
+			continue
+		}
+		lkp.cache.Set(missing[i].ToString(), &lookupCacheEntry{result: result, expires: expires})
+	}
+	return results, nil
+}
+
+// lookup performs a lookup for the ids without using the cache.
+func (lkp *lookupInternal) lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sqltypes.Result, error) {
+	if lkp.BatchLookup && len(ids) > 1 {
+		return lkp.batchLookup(vcursor, ids)
+	}
 	results := make([]*sqltypes.Result, 0, len(ids))
 	for _, id := range ids {
 		bindVars := map[string]*querypb.BindVariable{
@@ -94,7 +235,55 @@ func (lkp *lookupInternal) Verify(vcursor VCursor, ids, values []sqltypes.Value)
 	return lkp.VerifyCustom(vcursor, ids, values, co)
 }
 
+// batchLookup performs the lookup for all the ids with a single query.
+// The rows are then distributed to the results of the ids they belong to.
+func (lkp *lookupInternal) batchLookup(vcursor VCursor, ids []sqltypes.Value) ([]*sqltypes.Result, error) {
+	co := vtgatepb.CommitOrder_NORMAL
+	if lkp.Autocommit {
+		co = vtgatepb.CommitOrder_AUTOCOMMIT
+	}
+	rows, err := lkp.executeBatchSelect(vcursor, "VindexLookup", ids, co)
+	if err != nil {
+		return nil, fmt.Errorf("lookup.Map: %v", err)
+	}
+	results := make([]*sqltypes.Result, 0, len(ids))
+	for _, id := range ids {
+		result := &sqltypes.Result{}
+		for _, to := range rows[id.ToString()] {
+			result.Rows = append(result.Rows, []sqltypes.Value{to})
+		}
+		result.RowsAffected = uint64(len(result.Rows))
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// executeBatchSelect fetches the lookup rows for all the ids with a single query
+// and returns the 'to' values indexed by the string value of their 'from' value.
+func (lkp *lookupInternal) executeBatchSelect(vcursor VCursor, method string, ids []sqltypes.Value, co vtgatepb.CommitOrder) (map[string][]sqltypes.Value, error) {
+	tuple := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for _, id := range ids {
+		tuple.Values = append(tuple.Values, sqltypes.ValueToProto(id))
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		lkp.FromColumns[0]: tuple,
+	}
+	result, err := vcursor.Execute(method, lkp.batchSel, bindVars, false /* rollbackOnError */, co)
+	if err != nil {
+		return nil, err
+	}
+	rows := make(map[string][]sqltypes.Value, len(ids))
+	for _, row := range result.Rows {
+		from := row[0].ToString()
+		rows[from] = append(rows[from], row[1])
+	}
+	return rows, nil
+}
+
 func (lkp *lookupInternal) VerifyCustom(vcursor VCursor, ids, values []sqltypes.Value, co vtgatepb.CommitOrder) ([]bool, error) {
+	if lkp.BatchLookup && len(ids) > 1 {
+		return lkp.batchVerify(vcursor, ids, values, co)
+	}
 	out := make([]bool, len(ids))
 	for i, id := range ids {
 		bindVars := map[string]*querypb.BindVariable{
@@ -110,6 +299,24 @@ func (lkp *lookupInternal) VerifyCustom(vcursor VCursor, ids, values []sqltypes.
 	return out, nil
 }
 
+// batchVerify verifies all the ids with a single query.
+func (lkp *lookupInternal) batchVerify(vcursor VCursor, ids, values []sqltypes.Value, co vtgatepb.CommitOrder) ([]bool, error) {
+	rows, err := lkp.executeBatchSelect(vcursor, "VindexVerify", ids, co)
+	if err != nil {
+		return nil, fmt.Errorf("lookup.Verify: %v", err)
+	}
+	out := make([]bool, len(ids))
+	for i, id := range ids {
+		for _, to := range rows[id.ToString()] {
+			if bytes.Equal(to.ToBytes(), values[i].ToBytes()) {
+				out[i] = true
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
 type sorter struct {
 	rowsColValues [][]sqltypes.Value
 	toValues      []sqltypes.Value
@@ -167,6 +374,7 @@ func (lkp *lookupInternal) createCustom(vcursor VCursor, rowsColValues [][]sqlty
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Create: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	defer lkp.invalidate(vcursor, rowsColValues)
 	buf := new(bytes.Buffer)
 	if ignoreMode {
 		fmt.Fprintf(buf, "insert ignore into %s(", lkp.Table)
@@ -241,6 +449,7 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	defer lkp.invalidate(vcursor, rowsColValues)
 	for _, column := range rowsColValues {
 		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
 		for colIdx, columnValue := range column {
@@ -255,6 +464,55 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	return nil
 }
 
+// BatchDelete deletes the associations of rowsColValues, where each row
+// has its own value in toValues. If batch_lookup is enabled, all the
+// entries are deleted with a single query. Otherwise, this is
+// equivalent to calling Delete for every row.
+func (lkp *lookupInternal) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, toValues []sqltypes.Value, co vtgatepb.CommitOrder) error {
+	if !lkp.BatchLookup || len(rowsColValues) <= 1 {
+		for i, column := range rowsColValues {
+			if err := lkp.Delete(vcursor, [][]sqltypes.Value{column}, toValues[i], co); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// In autocommit mode, it's not safe to delete. So, it's a no-op.
+	if lkp.Autocommit {
+		return nil
+	}
+	if len(rowsColValues[0]) != len(lkp.FromColumns) {
+		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
+	}
+	defer lkp.invalidate(vcursor, rowsColValues)
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "delete from %s where (", lkp.Table)
+	for _, col := range lkp.FromColumns {
+		fmt.Fprintf(buf, "%s, ", col)
+	}
+	fmt.Fprintf(buf, "%s) in (", lkp.To)
+	bindVars := make(map[string]*querypb.BindVariable, (len(lkp.FromColumns)+1)*len(rowsColValues))
+	for rowIdx, colIds := range rowsColValues {
+		if rowIdx != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		for colIdx, colID := range colIds {
+			fromStr := lkp.FromColumns[colIdx] + strconv.Itoa(rowIdx)
+			bindVars[fromStr] = sqltypes.ValueBindVariable(colID)
+			buf.WriteString(":" + fromStr + ", ")
+		}
+		toStr := lkp.To + strconv.Itoa(rowIdx)
+		buf.WriteString(":" + toStr + ")")
+		bindVars[toStr] = sqltypes.ValueBindVariable(toValues[rowIdx])
+	}
+	buf.WriteString(")")
+	if _, err := vcursor.Execute("VindexDelete", buf.String(), bindVars, true /* rollbackOnError */, co); err != nil {
+		return fmt.Errorf("lookup.Delete: %v", err)
+	}
+	return nil
+}
+
 // Update implements the update functionality.
 func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, toValue sqltypes.Value, newValues []sqltypes.Value) error {
 	if err := lkp.Delete(vcursor, [][]sqltypes.Value{oldValues}, toValue, vtgatepb.CommitOrder_NORMAL); err != nil {
@@ -263,6 +521,26 @@ func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return lkp.Create(vcursor, [][]sqltypes.Value{newValues}, []sqltypes.Value{toValue}, false /* ignoreMode */)
 }
 
+// BatchUpdate updates the associations of rows, where each row has its
+// own value in toValues. If batch_lookup is enabled, the old entries
+// are deleted with a single query, and the new ones are inserted with
+// another one. Otherwise, this is equivalent to calling Update for
+// every row.
+func (lkp *lookupInternal) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, toValues []sqltypes.Value, newValues [][]sqltypes.Value) error {
+	if !lkp.BatchLookup || len(oldValues) <= 1 {
+		for i := range oldValues {
+			if err := lkp.Update(vcursor, oldValues[i], nil, toValues[i], newValues[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := lkp.BatchDelete(vcursor, oldValues, toValues, vtgatepb.CommitOrder_NORMAL); err != nil {
+		return err
+	}
+	return lkp.Create(vcursor, newValues, toValues, false /* ignoreMode */)
+}
+
 func (lkp *lookupInternal) initDelStmt() string {
 	var delBuffer bytes.Buffer
 	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
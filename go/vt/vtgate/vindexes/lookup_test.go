@@ -20,6 +20,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"strings"
 
@@ -478,6 +479,332 @@ func TestLookupNonUniqueDelete(t *testing.T) {
 	}
 }
 
+func TestLookupNonUniqueBatchLookup(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":        "t",
+		"from":         "fromc",
+		"to":           "toc",
+		"batch_lookup": "true",
+	})
+	require.NoError(t, err)
+	lookupNonUnique := l.(SingleColumn)
+	vc := &vcursor{
+		result: sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("fromc|toc", "int64|varbinary"),
+			"1|a",
+			"1|b",
+			"3|c",
+		),
+	}
+
+	got, err := lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("a"), []byte("b")}),
+		key.DestinationNone{},
+		key.DestinationKeyspaceIDs([][]byte{[]byte("c")}),
+	}
+	assert.Equal(t, want, got)
+
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "select fromc, toc from t where fromc in ::fromc",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc": sqltypes.TestBindVariable([]interface{}{int64(1), int64(2), int64(3)}),
+		},
+	}}
+	assert.Equal(t, wantqueries, vc.queries)
+
+	// Verify also uses a single query.
+	vc.queries = nil
+	verified, err := lookupNonUnique.Verify(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(3)}, [][]byte{[]byte("b"), []byte("a")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, verified)
+	assert.Equal(t, 1, len(vc.queries))
+
+	// A single id still uses the regular query.
+	vc.queries = nil
+	vc.result = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, "select toc from t where fromc = :fromc", vc.queries[0].Sql)
+
+	// Test query fail.
+	vc.mustFail = true
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+	assert.EqualError(t, err, "lookup.Map: execute failed")
+	_, err = lookupNonUnique.Verify(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, [][]byte{[]byte("a"), []byte("b")})
+	assert.EqualError(t, err, "lookup.Verify: execute failed")
+
+	_, err = CreateVindex("lookup", "lookup", map[string]string{
+		"table":        "t",
+		"from":         "fromc",
+		"to":           "toc",
+		"batch_lookup": "invalid",
+	})
+	assert.EqualError(t, err, "batch_lookup value must be 'true' or 'false': 'invalid'")
+}
+
+func TestLookupNonUniqueBatchDelete(t *testing.T) {
+	// Without batch_lookup, there's one delete per row.
+	lookupNonUnique := createLookup(t, "lookup", false)
+	vc := &vcursor{}
+	err := lookupNonUnique.(BatchDeleter).BatchDelete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}}, [][]byte{[]byte("a"), []byte("b")})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vc.queries))
+
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":        "t",
+		"from":         "fromc1,fromc2",
+		"to":           "toc",
+		"batch_lookup": "true",
+	})
+	require.NoError(t, err)
+	vc = &vcursor{}
+	err = l.(BatchDeleter).BatchDelete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, {sqltypes.NewInt64(3), sqltypes.NewInt64(4)}}, [][]byte{[]byte("a"), []byte("b")})
+	require.NoError(t, err)
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "delete from t where (fromc1, fromc2, toc) in ((:fromc10, :fromc20, :toc0), (:fromc11, :fromc21, :toc1))",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc10": sqltypes.Int64BindVariable(1),
+			"fromc20": sqltypes.Int64BindVariable(2),
+			"toc0":    sqltypes.BytesBindVariable([]byte("a")),
+			"fromc11": sqltypes.Int64BindVariable(3),
+			"fromc21": sqltypes.Int64BindVariable(4),
+			"toc1":    sqltypes.BytesBindVariable([]byte("b")),
+		},
+	}}
+	assert.Equal(t, wantqueries, vc.queries)
+
+	// Test query fail.
+	vc.mustFail = true
+	err = l.(BatchDeleter).BatchDelete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, {sqltypes.NewInt64(3), sqltypes.NewInt64(4)}}, [][]byte{[]byte("a"), []byte("b")})
+	assert.EqualError(t, err, "lookup.Delete: execute failed")
+
+	// Test column count fail.
+	err = l.(BatchDeleter).BatchDelete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}}, [][]byte{[]byte("a"), []byte("b")})
+	assert.EqualError(t, err, "lookup.Delete: column vindex count does not match the columns in the lookup: 1 vs [fromc1 fromc2]")
+}
+
+func TestLookupNonUniqueBatchUpdate(t *testing.T) {
+	oldValues := [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}}
+	newValues := [][]sqltypes.Value{{sqltypes.NewInt64(3)}, {sqltypes.NewInt64(4)}}
+	ksids := [][]byte{[]byte("a"), []byte("b")}
+
+	// Without batch_lookup, there's one delete and one insert per row.
+	lookupNonUnique := createLookup(t, "lookup", false)
+	vc := &vcursor{}
+	err := lookupNonUnique.(BatchUpdater).BatchUpdate(vc, oldValues, ksids, newValues)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(vc.queries))
+
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":        "t",
+		"from":         "fromc",
+		"to":           "toc",
+		"batch_lookup": "true",
+	})
+	require.NoError(t, err)
+	vc = &vcursor{}
+	err = l.(BatchUpdater).BatchUpdate(vc, oldValues, ksids, newValues)
+	require.NoError(t, err)
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "delete from t where (fromc, toc) in ((:fromc0, :toc0), (:fromc1, :toc1))",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc0": sqltypes.Int64BindVariable(1),
+			"toc0":   sqltypes.BytesBindVariable([]byte("a")),
+			"fromc1": sqltypes.Int64BindVariable(2),
+			"toc1":   sqltypes.BytesBindVariable([]byte("b")),
+		},
+	}, {
+		Sql: "insert into t(fromc, toc) values(:fromc0, :toc0), (:fromc1, :toc1)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc0": sqltypes.Int64BindVariable(3),
+			"toc0":   sqltypes.BytesBindVariable([]byte("a")),
+			"fromc1": sqltypes.Int64BindVariable(4),
+			"toc1":   sqltypes.BytesBindVariable([]byte("b")),
+		},
+	}}
+	assert.Equal(t, wantqueries, vc.queries)
+
+	// Test query fail.
+	vc.mustFail = true
+	err = l.(BatchUpdater).BatchUpdate(vc, oldValues, ksids, newValues)
+	assert.EqualError(t, err, "lookup.Delete: execute failed")
+}
+
+func TestLookupNonUniqueCache(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+		"cache_ttl":  "1h",
+	})
+	require.NoError(t, err)
+	lookupNonUnique := l.(SingleColumn)
+	vc := &vcursor{numRows: 1}
+
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("1")}),
+		key.DestinationKeyspaceIDs([][]byte{[]byte("1")}),
+	}
+	got, err := lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 2, len(vc.queries))
+
+	// Cached ids are not looked up again.
+	vc.queries = nil
+	got, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(3)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "select toc from t where fromc = :fromc",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc": sqltypes.Int64BindVariable(3),
+		},
+	}}
+	assert.Equal(t, wantqueries, vc.queries)
+
+	// Writes invalidate the entries of the affected ids.
+	err = lookupNonUnique.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("test")}, false)
+	require.NoError(t, err)
+	err = lookupNonUnique.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, []byte("test"))
+	require.NoError(t, err)
+	vc.queries = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vc.queries))
+
+	// Failed lookups are not cached.
+	vc.mustFail = true
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(4)})
+	assert.EqualError(t, err, "lookup.Map: execute failed")
+	vc.mustFail = false
+	vc.queries = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(4)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(vc.queries))
+}
+
+// txVCursor is a vcursor in a transaction.
+type txVCursor struct {
+	vcursor
+	afterCommit []func()
+}
+
+func (vc *txVCursor) InTransaction() bool {
+	return true
+}
+
+func (vc *txVCursor) AfterCommit(f func()) {
+	vc.afterCommit = append(vc.afterCommit, f)
+}
+
+func (vc *txVCursor) commit() {
+	for _, f := range vc.afterCommit {
+		f()
+	}
+	vc.afterCommit = nil
+}
+
+func TestLookupNonUniqueCacheTransaction(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+		"cache_ttl":  "1h",
+	})
+	require.NoError(t, err)
+	lookupNonUnique := l.(SingleColumn)
+	vc := &vcursor{numRows: 1}
+	txvc := &txVCursor{vcursor: vcursor{numRows: 1}}
+
+	// Lookups in a transaction don't fill the cache.
+	_, err = lookupNonUnique.Map(txvc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(vc.queries))
+
+	// Nor do they read it.
+	_, err = lookupNonUnique.Map(txvc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(txvc.queries))
+
+	// A write in a transaction invalidates the entry again when it ends,
+	// in case it was read again before the commit.
+	err = lookupNonUnique.(Lookup).Delete(txvc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, []byte("test"))
+	require.NoError(t, err)
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vc.queries))
+	txvc.commit()
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(vc.queries))
+
+	// Misses are not cached.
+	vc = &vcursor{}
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vc.queries))
+}
+
+func TestLookupNonUniqueCacheExpiry(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+		"cache_ttl":  "1ns",
+	})
+	require.NoError(t, err)
+	lookupNonUnique := l.(SingleColumn)
+	vc := &vcursor{numRows: 1}
+
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vc.queries))
+}
+
+func TestLookupNonUniqueCacheParams(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{"cache_size": "a"},
+		err:    "cache_size value must be a non-negative integer: 'a'",
+	}, {
+		params: map[string]string{"cache_size": "-1"},
+		err:    "cache_size value must be a non-negative integer: '-1'",
+	}, {
+		params: map[string]string{"cache_size": "10", "cache_ttl": "a"},
+		err:    "cache_ttl value must be a positive duration: 'a'",
+	}, {
+		params: map[string]string{"cache_size": "10", "cache_ttl": "0s"},
+		err:    "cache_ttl value must be a positive duration: '0s'",
+	}}
+	for _, tcase := range testcases {
+		params := map[string]string{
+			"table": "t",
+			"from":  "fromc",
+			"to":    "toc",
+		}
+		for k, v := range tcase.params {
+			params[k] = v
+		}
+		_, err := CreateVindex("lookup", "lookup", params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
 func TestLookupNonUniqueDeleteAutocommit(t *testing.T) {
 	lookupNonUnique, _ := CreateVindex("lookup", "lookup", map[string]string{
 		"table":      "t",
@@ -30,8 +30,12 @@ import (
 var (
 	_ SingleColumn = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5Hash)(nil)
+	_ BatchDeleter = (*LookupUnicodeLooseMD5Hash)(nil)
+	_ BatchUpdater = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ SingleColumn = (*LookupUnicodeLooseMD5HashUnique)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5HashUnique)(nil)
+	_ BatchDeleter = (*LookupUnicodeLooseMD5HashUnique)(nil)
+	_ BatchUpdater = (*LookupUnicodeLooseMD5HashUnique)(nil)
 )
 
 func init() {
@@ -60,6 +64,9 @@ type LookupUnicodeLooseMD5Hash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookupUnicodeLooseMD5Hash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupUnicodeLooseMD5Hash{name: name}
 
@@ -188,6 +195,23 @@ func (lh *LookupUnicodeLooseMD5Hash) Update(vcursor VCursor, oldValues []sqltype
 	return lh.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (lh *LookupUnicodeLooseMD5Hash) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.vunhash: %v", err)
+	}
+	newValues, err = convertRows(newValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.convert: %v", err)
+	}
+	oldValues, err = convertRows(oldValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.convert: %v", err)
+	}
+	return lh.lkp.BatchUpdate(vcursor, oldValues, values, newValues)
+}
+
 // Delete deletes the entry from the vindex table.
 func (lh *LookupUnicodeLooseMD5Hash) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksid []byte) error {
 	v, err := vunhash(ksid)
@@ -201,6 +225,19 @@ func (lh *LookupUnicodeLooseMD5Hash) Delete(vcursor VCursor, rowsColValues [][]s
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lh *LookupUnicodeLooseMD5Hash) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.vunhash: %v", err)
+	}
+	rowsColValues, err = convertRows(rowsColValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.convert: %v", err)
+	}
+	return lh.lkp.BatchDelete(vcursor, rowsColValues, values, vtgatepb.CommitOrder_NORMAL)
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupUnicodeLooseMD5Hash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -227,6 +264,9 @@ type LookupUnicodeLooseMD5HashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_lookup: setting this to "true" will cause lookups, verifications, bulk deletes and bulk updates of multiple ids to be performed with a single query.
+//   cache_size: maximum number of lookup results to cache. Caching is disabled by default.
+//   cache_ttl: how long a cached result remains valid, like "30s". Defaults to 10s.
 func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupUnicodeLooseMD5HashUnique{name: name}
 
@@ -349,6 +389,19 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) Delete(vcursor VCursor, rowsColValue
 	return lhu.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// BatchDelete deletes the entries of all the rows from the vindex table.
+func (lhu *LookupUnicodeLooseMD5HashUnique) BatchDelete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.vunhash: %v", err)
+	}
+	rowsColValues, err = convertRows(rowsColValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Delete.convert: %v", err)
+	}
+	return lhu.lkp.BatchDelete(vcursor, rowsColValues, values, vtgatepb.CommitOrder_NORMAL)
+}
+
 // Update updates the entry in the vindex table.
 func (lhu *LookupUnicodeLooseMD5HashUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
 	v, err := vunhash(ksid)
@@ -366,6 +419,23 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) Update(vcursor VCursor, oldValues []
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// BatchUpdate updates the entries of all the rows in the vindex table.
+func (lhu *LookupUnicodeLooseMD5HashUnique) BatchUpdate(vcursor VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error {
+	values, err := unhashList(ksids)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.vunhash: %v", err)
+	}
+	newValues, err = convertRows(newValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.convert: %v", err)
+	}
+	oldValues, err = convertRows(oldValues)
+	if err != nil {
+		return fmt.Errorf("lookup.Update.convert: %v", err)
+	}
+	return lhu.lkp.BatchUpdate(vcursor, oldValues, values, newValues)
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupUnicodeLooseMD5HashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
@@ -0,0 +1,230 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// MultiCol is a multi-column unique vindex. Each column is mapped
+// by its own functional vindex, and the leading bytes of every result
+// are concatenated to produce the keyspace id. Because the keyspace
+// id starts with the bytes of the leading columns, values for a prefix
+// of the columns map to a key range. For example, with (tenant_id, user_id)
+// as columns, the rows of a tenant can be found without knowing the user.
+type MultiCol struct {
+	name        string
+	cost        int
+	columnBytes []int
+	vindexes    []SingleColumn
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The column_count param is required and must be between 1 and 8.
+// The optional column_vindex param lists the vindex type of each column,
+// separated by commas. The default type is hash.
+// The optional column_bytes param lists how many bytes of the keyspace id
+// each column contributes, separated by commas. They must add up to at
+// most 8. By default, the 8 bytes are split evenly, and the leading
+// columns get the remaining bytes.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	count, err := strconv.Atoi(m["column_count"])
+	if err != nil || count < 1 || count > 8 {
+		return nil, fmt.Errorf("multicol: column_count must be between 1 and 8: %q", m["column_count"])
+	}
+
+	vindexTypes := make([]string, count)
+	for i := range vindexTypes {
+		vindexTypes[i] = "hash"
+	}
+	if v := m["column_vindex"]; v != "" {
+		types := strings.Split(v, ",")
+		if len(types) != count {
+			return nil, fmt.Errorf("multicol: column_vindex must have %d values: %s", count, v)
+		}
+		for i, typ := range types {
+			vindexTypes[i] = strings.TrimSpace(typ)
+		}
+	}
+
+	columnBytes := make([]int, count)
+	for i := range columnBytes {
+		columnBytes[i] = 8 / count
+		if i < 8%count {
+			columnBytes[i]++
+		}
+	}
+	if v := m["column_bytes"]; v != "" {
+		values := strings.Split(v, ",")
+		if len(values) != count {
+			return nil, fmt.Errorf("multicol: column_bytes must have %d values: %s", count, v)
+		}
+		total := 0
+		for i, value := range values {
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("multicol: invalid column_bytes: %s", v)
+			}
+			columnBytes[i] = n
+			total += n
+		}
+		if total > 8 {
+			return nil, fmt.Errorf("multicol: column_bytes must add up to at most 8: %s", v)
+		}
+	}
+
+	mc := &MultiCol{
+		name:        name,
+		columnBytes: columnBytes,
+		vindexes:    make([]SingleColumn, count),
+	}
+	for i, typ := range vindexTypes {
+		vindex, err := CreateVindex(typ, name+"_"+strconv.Itoa(i), nil)
+		if err != nil {
+			return nil, fmt.Errorf("multicol: %v", err)
+		}
+		single, ok := vindex.(SingleColumn)
+		if !ok || !single.IsUnique() || single.NeedsVCursor() {
+			return nil, fmt.Errorf("multicol: column vindex must be a unique functional vindex: %s", typ)
+		}
+		if single.Cost() > mc.cost {
+			mc.cost = single.Cost()
+		}
+		mc.vindexes[i] = single
+	}
+	return mc, nil
+}
+
+// String returns the name of the vindex.
+func (mc *MultiCol) String() string {
+	return mc.name
+}
+
+// Cost returns the highest cost of the column vindexes.
+func (mc *MultiCol) Cost() int {
+	return mc.cost
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (mc *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (mc *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex satisfies MultiColumn.
+func (mc *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. A row with values for all the columns
+// maps to a keyspace id, and a row with values for a prefix of the
+// columns maps to a key range.
+func (mc *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	destinations := make([]key.Destination, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		if len(row) == 0 || len(row) > len(mc.vindexes) {
+			destinations = append(destinations, key.DestinationNone{})
+			continue
+		}
+		ksid, err := mc.keyspaceIDPrefix(row)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case ksid == nil:
+			destinations = append(destinations, key.DestinationNone{})
+		case len(row) == len(mc.vindexes):
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		default:
+			destinations = append(destinations, key.DestinationKeyRange{
+				KeyRange: &topodatapb.KeyRange{Start: ksid, End: prefixEnd(ksid)},
+			})
+		}
+	}
+	return destinations, nil
+}
+
+// Verify satisfies MultiColumn.
+func (mc *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	result := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) != len(mc.vindexes) {
+			continue
+		}
+		ksid, err := mc.keyspaceIDPrefix(row)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = ksid != nil && bytes.Equal(ksid, ksids[i])
+	}
+	return result, nil
+}
+
+// keyspaceIDPrefix returns the keyspace id bytes for the column values
+// of the row. It returns nil if a value cannot be mapped.
+func (mc *MultiCol) keyspaceIDPrefix(row []sqltypes.Value) ([]byte, error) {
+	var ksid []byte
+	for i, value := range row {
+		destinations, err := mc.vindexes[i].Map(nil, []sqltypes.Value{value})
+		if err != nil {
+			return nil, err
+		}
+		colKsid, ok := destinations[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, nil
+		}
+		// Short keyspace ids are padded with zeroes.
+		colBytes := make([]byte, mc.columnBytes[i])
+		copy(colBytes, colKsid)
+		ksid = append(ksid, colBytes...)
+	}
+	return ksid, nil
+}
+
+// prefixEnd returns the smallest keyspace id that is greater than all the
+// keyspace ids starting with prefix. It returns nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] != 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
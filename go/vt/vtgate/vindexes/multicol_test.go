@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMultiColMisc(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	mc := vindex.(MultiColumn)
+	assert.Equal(t, 1, mc.Cost())
+	assert.Equal(t, "multicol", mc.String())
+	assert.True(t, mc.IsUnique())
+	assert.False(t, mc.NeedsVCursor())
+	assert.True(t, mc.PartialVindex())
+}
+
+func TestMultiColMap(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count":  "3",
+		"column_vindex": "hash,binary,hash",
+		"column_bytes":  "1,2,3",
+	})
+	require.NoError(t, err)
+	mc := vindex.(MultiColumn)
+	got, err := mc.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewVarBinary("a"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewVarBinary("abc"),
+	}, {
+		sqltypes.NewInt64(1),
+	}, {
+		// Invalid value.
+		sqltypes.NewVarBinary("abcd"),
+	}, {
+		// Too many values.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID("\x16a\x00\x16k@"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x16ab"), End: []byte("\x16ac")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x16"), End: []byte("\x17")}},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestMultiColVerify(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	mc := vindex.(MultiColumn)
+	got, err := mc.Verify(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(1),
+	}}, [][]byte{
+		[]byte("\x16k@\xb4\x16k@\xb4"),
+		[]byte("\x16k@\xb4\x16k@\xb4"),
+		[]byte("\x16k@\xb4"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestMultiColPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte("\x01\x03"), prefixEnd([]byte("\x01\x02")))
+	assert.Equal(t, []byte("\x02"), prefixEnd([]byte("\x01\xff")))
+	assert.Nil(t, prefixEnd([]byte("\xff\xff")))
+}
+
+func TestMultiColCreateErrors(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: nil,
+		err:    `multicol: column_count must be between 1 and 8: ""`,
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    `multicol: column_count must be between 1 and 8: "9"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash"},
+		err:    "multicol: column_vindex must have 2 values: hash",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,lookup"},
+		err:    "multicol: column vindex must be a unique functional vindex: lookup",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,none"},
+		err:    `multicol: vindexType "none" not found`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "1"},
+		err:    "multicol: column_bytes must have 2 values: 1",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "1,a"},
+		err:    "multicol: invalid column_bytes: 1,a",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,5"},
+		err:    "multicol: column_bytes must add up to at most 8: 4,5",
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("multicol", "multicol", tc.params)
+		assert.EqualError(t, err, tc.err)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	// loadingPlugin is the path of the plugin being opened, if any.
+	// While it is set, Register records a duplicate vindex type in
+	// pluginErr instead of panicking.
+	loadingPlugin string
+	pluginErr     error
+)
+
+// LoadPlugin registers the custom vindex types of the plugin at path.
+// open is expected to open the plugin, whose init functions call
+// Register. The plugin must register at least one new vindex type.
+// This package doesn't open plugins itself: the vtgate vindexplugin
+// package does, for the plugins declared in the vschema.
+func LoadPlugin(path string, open func(path string) error) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	existing := make(map[string]bool, len(registry))
+	for vindexType := range registry {
+		existing[vindexType] = true
+	}
+	loadingPlugin, pluginErr = path, nil
+	err := open(path)
+	if err == nil {
+		err = pluginErr
+	}
+	loadingPlugin, pluginErr = "", nil
+	if err != nil {
+		return err
+	}
+
+	var added []string
+	for vindexType := range registry {
+		if !existing[vindexType] {
+			added = append(added, vindexType)
+		}
+	}
+	if len(added) == 0 {
+		return fmt.Errorf("vindex plugin %s did not register any vindex types", path)
+	}
+	sort.Strings(added)
+	log.Infof("Loaded vindex types %v from plugin %s", added, path)
+	return nil
+}
+
+var _ SingleColumn = (*unloadedVindex)(nil)
+
+// unloadedVindex stands for a vindex whose type isn't registered,
+// in a keyspace that declares vindex plugins. It's only used to
+// validate a vschema outside of vtgate, which is the only binary
+// that opens the plugins.
+type unloadedVindex struct {
+	name, vindexType string
+}
+
+// String returns the name of the vindex.
+func (vind *unloadedVindex) String() string {
+	return vind.name
+}
+
+// Cost returns the cost as 1.
+func (vind *unloadedVindex) Cost() int {
+	return 1
+}
+
+// IsUnique returns true so the vindex can be validated as a
+// primary vindex. vtgate checks the actual type.
+func (vind *unloadedVindex) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *unloadedVindex) NeedsVCursor() bool {
+	return false
+}
+
+// Map returns an error.
+func (vind *unloadedVindex) Map(VCursor, []sqltypes.Value) ([]key.Destination, error) {
+	return nil, fmt.Errorf("vindex %s: vindexType %q is provided by a plugin that is not loaded", vind.name, vind.vindexType)
+}
+
+// Verify returns an error.
+func (vind *unloadedVindex) Verify(VCursor, []sqltypes.Value, [][]byte) ([]bool, error) {
+	return nil, fmt.Errorf("vindex %s: vindexType %q is provided by a plugin that is not loaded", vind.name, vind.vindexType)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestLoadPlugin(t *testing.T) {
+	defer delete(registry, "plugin_test_vindex")
+
+	err := LoadPlugin("/plugins/vindex.so", func(string) error {
+		Register("plugin_test_vindex", NewBinary)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, isRegistered("plugin_test_vindex"))
+
+	err = LoadPlugin("/plugins/empty.so", func(string) error { return nil })
+	require.Error(t, err)
+	assert.Equal(t, "vindex plugin /plugins/empty.so did not register any vindex types", err.Error())
+
+	err = LoadPlugin("/plugins/duplicate.so", func(string) error {
+		Register("hash", NewHash)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, "vindex plugin /plugins/duplicate.so: hash is already registered", err.Error())
+
+	err = LoadPlugin("/plugins/broken.so", func(path string) error {
+		return fmt.Errorf("could not open %s", path)
+	})
+	require.Error(t, err)
+	assert.Equal(t, "could not open /plugins/broken.so", err.Error())
+}
+
+func TestValidateKeyspaceWithPlugins(t *testing.T) {
+	input := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"custom": {Type: "plugin_only_vindex"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "custom"}},
+			},
+		},
+	}
+	err := ValidateKeyspace(input)
+	require.Error(t, err)
+	assert.Equal(t, `vindexType "plugin_only_vindex" not found`, err.Error())
+
+	input.VindexPlugins = []string{"/plugins/vindex.so"}
+	err = ValidateKeyspace(input)
+	require.NoError(t, err)
+
+	_, err = BuildKeyspaceSchema(input, "ks")
+	require.Error(t, err)
+	assert.Equal(t, `vindexType "plugin_only_vindex" not found`, err.Error())
+}
@@ -85,6 +85,11 @@ func (ge *RegionExperimental) NeedsVCursor() bool {
 	return false
 }
 
+// PartialVindex satisfies MultiColumn.
+func (ge *RegionExperimental) PartialVindex() bool {
+	return false
+}
+
 // Map satisfies MultiColumn.
 func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
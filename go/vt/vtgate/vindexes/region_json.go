@@ -99,6 +99,11 @@ func (rv *RegionJSON) IsUnique() bool {
 	return true
 }
 
+// PartialVindex satisfies MultiColumn.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
+
 // Map satisfies MultiColumn.
 func (rv *RegionJSON) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
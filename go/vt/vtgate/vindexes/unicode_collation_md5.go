@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var (
+	_ SingleColumn = (*UnicodeCollationMD5)(nil)
+)
+
+// collationSpec describes how a MySQL collation compares strings.
+type collationSpec struct {
+	// binary collations compare the bytes as is.
+	binary bool
+	// generalCI collations map every character to a single
+	// weight instead of using the collator. See generalCIWeights.
+	generalCI bool
+	// options are the collator options that produce
+	// the same equality as the collation.
+	options []collate.Option
+	// padSpace is set for the collations that ignore trailing spaces.
+	padSpace bool
+}
+
+// collationSpecs lists the supported MySQL collations.
+var collationSpecs = map[string]collationSpec{
+	"binary":                 {binary: true},
+	"utf8_bin":               {binary: true, padSpace: true},
+	"utf8mb4_bin":            {binary: true, padSpace: true},
+	"utf8mb4_0900_bin":       {binary: true},
+	"utf8_general_ci":        {generalCI: true, padSpace: true},
+	"utf8mb4_general_ci":     {generalCI: true, padSpace: true},
+	"utf8_unicode_ci":        {options: []collate.Option{collate.Loose}, padSpace: true},
+	"utf8mb4_unicode_ci":     {options: []collate.Option{collate.Loose}, padSpace: true},
+	"utf8mb4_unicode_520_ci": {options: []collate.Option{collate.Loose}, padSpace: true},
+	"utf8mb4_0900_ai_ci":     {options: []collate.Option{collate.Loose}},
+	"utf8mb4_0900_as_ci":     {options: []collate.Option{collate.IgnoreCase}},
+	"utf8mb4_0900_as_cs":     {},
+}
+
+// UnicodeCollationMD5 is a vindex that normalizes strings according
+// to a MySQL collation before hashing them to a keyspace id. Strings
+// that are equal under the collation map to the same keyspace id.
+// The collation is specified with the required "collation" param.
+// For utf8mb4_unicode_ci and similar collations, the keyspace ids
+// are the same as the ones produced by unicode_loose_md5.
+type UnicodeCollationMD5 struct {
+	name      string
+	collation string
+	spec      collationSpec
+	pool      sync.Pool
+}
+
+// NewUnicodeCollationMD5 creates a new UnicodeCollationMD5.
+func NewUnicodeCollationMD5(name string, m map[string]string) (Vindex, error) {
+	collation := m["collation"]
+	spec, ok := collationSpecs[collation]
+	if !ok {
+		return nil, fmt.Errorf("UnicodeCollationMD5: unsupported collation: '%s'", collation)
+	}
+	vind := &UnicodeCollationMD5{
+		name:      name,
+		collation: collation,
+		spec:      spec,
+	}
+	vind.pool.New = func() interface{} {
+		// See newPooledCollator for the choice of locale.
+		return &pooledCollator{
+			col: collate.New(language.English, spec.options...),
+			buf: new(collate.Buffer),
+		}
+	}
+	return vind, nil
+}
+
+// String returns the name of the vindex.
+func (vind *UnicodeCollationMD5) String() string {
+	return vind.name
+}
+
+// Cost returns the cost as 1.
+func (vind *UnicodeCollationMD5) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *UnicodeCollationMD5) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *UnicodeCollationMD5) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids maps to ksids.
+func (vind *UnicodeCollationMD5) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		data, err := vind.hash(ids[i])
+		if err != nil {
+			return nil, fmt.Errorf("UnicodeCollationMD5.Verify: %v", err)
+		}
+		out[i] = bytes.Equal(data, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *UnicodeCollationMD5) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		data, err := vind.hash(id)
+		if err != nil {
+			return nil, fmt.Errorf("UnicodeCollationMD5.Map: %v", err)
+		}
+		out = append(out, key.DestinationKeyspaceID(data))
+	}
+	return out, nil
+}
+
+func (vind *UnicodeCollationMD5) hash(id sqltypes.Value) ([]byte, error) {
+	in := id.ToBytes()
+	if vind.spec.binary {
+		if vind.spec.padSpace {
+			in = bytes.TrimRight(in, " ")
+		}
+		return binHash(in), nil
+	}
+	if vind.spec.generalCI {
+		weights, err := generalCIWeights(in)
+		if err != nil {
+			return nil, err
+		}
+		return binHash(weights), nil
+	}
+
+	collator := vind.pool.Get().(*pooledCollator)
+	defer vind.pool.Put(collator)
+
+	if !vind.spec.padSpace {
+		// normalize trims trailing spaces. NO PAD collations
+		// treat them as significant. So, we protect them by
+		// appending a character that's never trimmed.
+		in = append(append([]byte(nil), in...), '\x00')
+	}
+	norm, err := normalize(collator.col, collator.buf, in)
+	if err != nil {
+		return nil, err
+	}
+	return binHash(norm), nil
+}
+
+// generalCIFolds lists the characters that general_ci sorts as a
+// different letter than their decomposition would suggest.
+var generalCIFolds = map[rune]rune{
+	'\u00b5': '\u039c', // MICRO SIGN sorts as GREEK CAPITAL LETTER MU.
+	'\u00d8': 'O',
+	'\u00df': 'S', // Unlike unicode_ci, where it sorts as "SS".
+	'\u00f8': 'O',
+	'\u0131': 'I',
+	'\u017f': 'S',
+}
+
+// generalCIFolded returns true if general_ci folds the case
+// and accents of the characters of the block of r. MySQL
+// only has weight tables for these blocks, and leaves the
+// other characters of the BMP as is.
+func generalCIFolded(r rune) bool {
+	switch r >> 8 {
+	case 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x1e, 0x1f, 0x21, 0x24, 0xff:
+		return true
+	}
+	return false
+}
+
+// generalCIWeights returns the weights of in under utf8_general_ci
+// and utf8mb4_general_ci. Unlike the UCA based collations, these
+// map every character to exactly one weight: its base letter in
+// upper case. There are no expansions, so 'ß' is equal to 's' and
+// not to "ss". All the characters outside the BMP share the same
+// weight. Trailing spaces are ignored.
+func generalCIWeights(in []byte) ([]byte, error) {
+	if !utf8.Valid(in) {
+		return nil, fmt.Errorf("cannot normalize string containing invalid UTF-8: %q", string(in))
+	}
+	in = bytes.TrimRight(in, " ")
+	out := make([]byte, 0, 2*len(in))
+	for len(in) > 0 {
+		r, size := utf8.DecodeRune(in)
+		in = in[size:]
+		switch {
+		case r > 0xffff:
+			r = utf8.RuneError
+		case generalCIFolds[r] != 0:
+			r = generalCIFolds[r]
+		case generalCIFolded(r):
+			var buf [utf8.UTFMax]byte
+			n := utf8.EncodeRune(buf[:], r)
+			base, _ := utf8.DecodeRune(norm.NFD.Bytes(buf[:n]))
+			r = unicode.ToUpper(base)
+		}
+		out = append(out, byte(r>>8), byte(r))
+	}
+	return out, nil
+}
+
+func init() {
+	Register("unicode_collation_md5", NewUnicodeCollationMD5)
+}
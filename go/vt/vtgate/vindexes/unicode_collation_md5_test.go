@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func createCollationVindex(t *testing.T, collation string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("unicode_collation_md5", "collation", map[string]string{"collation": collation})
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestUnicodeCollationMD5Info(t *testing.T) {
+	vindex := createCollationVindex(t, "utf8mb4_unicode_ci")
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "collation", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+
+	_, err := CreateVindex("unicode_collation_md5", "collation", map[string]string{"collation": "latin1_swedish_ci"})
+	assert.EqualError(t, err, "UnicodeCollationMD5: unsupported collation: 'latin1_swedish_ci'")
+	_, err = CreateVindex("unicode_collation_md5", "collation", nil)
+	assert.EqualError(t, err, "UnicodeCollationMD5: unsupported collation: ''")
+}
+
+func TestUnicodeCollationMD5Equality(t *testing.T) {
+	tcases := []struct {
+		collation string
+		a, b      string
+		equal     bool
+	}{
+		{"utf8mb4_unicode_ci", "Test", "TEST", true},
+		{"utf8mb4_unicode_ci", "Test", "Tést", true},
+		{"utf8mb4_unicode_ci", "Test", "Test ", true},
+		{"utf8mb4_unicode_ci", "Test", "Best", false},
+		{"utf8mb4_general_ci", "Test", "tést", true},
+		{"utf8mb4_general_ci", "Test", "Test ", true},
+		{"utf8mb4_general_ci", "Test", "Best", false},
+		{"utf8mb4_general_ci", "Straße", "STRASE", true},
+		{"utf8mb4_general_ci", "Straße", "Strasse", false},
+		{"utf8mb4_general_ci", "Ørsted", "orsted", true},
+		{"utf8mb4_general_ci", "\U0001F600", "\U0001F601", true},
+		{"utf8mb4_unicode_ci", "Straße", "Strasse", true},
+		{"utf8mb4_unicode_ci", "Straße", "STRASE", false},
+		{"utf8mb4_0900_ai_ci", "Test", "tést", true},
+		{"utf8mb4_0900_ai_ci", "Test", "Test ", false},
+		{"utf8mb4_0900_as_ci", "Test", "TEST", true},
+		{"utf8mb4_0900_as_ci", "Test", "Tést", false},
+		{"utf8mb4_0900_as_cs", "Test", "TEST", false},
+		{"utf8mb4_0900_as_cs", "Test", "Test", true},
+		{"utf8mb4_bin", "Test", "Test ", true},
+		{"utf8mb4_bin", "Test", "TEST", false},
+		{"utf8mb4_0900_bin", "Test", "Test ", false},
+		{"binary", "Test", "Test ", false},
+	}
+	for _, tcase := range tcases {
+		vindex := createCollationVindex(t, tcase.collation)
+		got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(tcase.a), sqltypes.NewVarChar(tcase.b)})
+		require.NoError(t, err)
+		if tcase.equal {
+			assert.Equal(t, got[0], got[1], "%s: %q vs %q", tcase.collation, tcase.a, tcase.b)
+		} else {
+			assert.NotEqual(t, got[0], got[1], "%s: %q vs %q", tcase.collation, tcase.a, tcase.b)
+		}
+	}
+}
+
+func TestUnicodeCollationMD5CompatibleWithLoose(t *testing.T) {
+	vindex := createCollationVindex(t, "utf8mb4_unicode_ci")
+	ids := []sqltypes.Value{sqltypes.NewVarChar("Test"), sqltypes.NewVarChar("Bést "), sqltypes.NewVarChar(" TéstLooong")}
+	got, err := vindex.Map(nil, ids)
+	require.NoError(t, err)
+	want, err := charVindex.Map(nil, ids)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestUnicodeCollationMD5Verify(t *testing.T) {
+	vindex := createCollationVindex(t, "utf8mb4_unicode_ci")
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("Test")})
+	require.NoError(t, err)
+	ksid := []byte(got[0].(key.DestinationKeyspaceID))
+
+	verified, err := vindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarChar("TEST"), sqltypes.NewVarChar("Best")}, [][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, verified)
+
+	_, err = vindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarBinary("\xff")}, [][]byte{ksid})
+	assert.EqualError(t, err, "UnicodeCollationMD5.Verify: cannot normalize string containing invalid UTF-8: \"\\xff\"")
+	_, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarBinary("\xff")})
+	assert.EqualError(t, err, "UnicodeCollationMD5.Map: cannot normalize string containing invalid UTF-8: \"\\xff\"")
+
+	vindex = createCollationVindex(t, "utf8mb4_general_ci")
+	_, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarBinary("\xff")})
+	assert.EqualError(t, err, "UnicodeCollationMD5.Map: cannot normalize string containing invalid UTF-8: \"\\xff\"")
+
+	// Binary collations accept any bytes.
+	vindex = createCollationVindex(t, "binary")
+	_, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarBinary("\xff")})
+	require.NoError(t, err)
+}
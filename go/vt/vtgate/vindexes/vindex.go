@@ -18,6 +18,7 @@ package vindexes
 
 import (
 	"fmt"
+	"sync"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
@@ -39,6 +40,16 @@ type VCursor interface {
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError, autocommit bool) (*sqltypes.Result, error)
 }
 
+// A TxVCursor is a VCursor that exposes the transaction of its
+// session. Lookup vindexes use it to keep their cache consistent
+// with the lookup table.
+type TxVCursor interface {
+	VCursor
+	InTransaction() bool
+	// AfterCommit runs f when the current transaction ends.
+	AfterCommit(f func())
+}
+
 // Vindex defines the interface required to register a vindex.
 type Vindex interface {
 	// String returns the name of the Vindex instance.
@@ -87,6 +98,10 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+	// PartialVindex returns true if Map also accepts values for
+	// only a prefix of the columns. The destination is then
+	// usually a key range.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -118,6 +133,22 @@ type Lookup interface {
 	Update(vc VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error
 }
 
+// BatchDeleter is an optional interface for Lookup vindexes
+// that can delete the entries of many rows, each with its own
+// keyspace id, in one operation. This is used by bulk deletes
+// to avoid issuing one query per row.
+type BatchDeleter interface {
+	BatchDelete(vc VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) error
+}
+
+// BatchUpdater is an optional interface for Lookup vindexes
+// that can update the entries of many rows, each with its own
+// keyspace id, in one operation. This is used by updates that
+// change the vindex columns of multiple rows.
+type BatchUpdater interface {
+	BatchUpdate(vc VCursor, oldValues [][]sqltypes.Value, ksids [][]byte, newValues [][]sqltypes.Value) error
+}
+
 // WantOwnerInfo defines the interface that a vindex must
 // satisfy to request info about the owner table. This information can
 // be used to query the owner's table for the owning row's presence.
@@ -130,14 +161,28 @@ type WantOwnerInfo interface {
 // register a NewVindexFunc under a unique vindexType.
 type NewVindexFunc func(string, map[string]string) (Vindex, error)
 
-var registry = make(map[string]NewVindexFunc)
+var (
+	// registryMu protects registry from plugins loaded while
+	// vschemas are built. Register doesn't need to take it:
+	// it's called from init functions, either at startup or
+	// while LoadPlugin holds it.
+	registryMu sync.RWMutex
+	registry   = make(map[string]NewVindexFunc)
+)
 
 // Register registers a vindex under the specified vindexType.
-// A duplicate vindexType will generate a panic.
+// A duplicate vindexType will generate a panic, or make
+// LoadPlugin fail if it's registered by a plugin.
 // New vindexes will be created using these functions at the
 // time of vschema loading.
 func Register(vindexType string, newVindexFunc NewVindexFunc) {
 	if _, ok := registry[vindexType]; ok {
+		if loadingPlugin != "" {
+			if pluginErr == nil {
+				pluginErr = fmt.Errorf("vindex plugin %s: %s is already registered", loadingPlugin, vindexType)
+			}
+			return
+		}
 		panic(fmt.Sprintf("%s is already registered", vindexType))
 	}
 	registry[vindexType] = newVindexFunc
@@ -146,13 +191,22 @@ func Register(vindexType string, newVindexFunc NewVindexFunc) {
 // CreateVindex creates a vindex of the specified type using the
 // supplied params. The type must have been previously registered.
 func CreateVindex(vindexType, name string, params map[string]string) (Vindex, error) {
+	registryMu.RLock()
 	f, ok := registry[vindexType]
+	registryMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("vindexType %q not found", vindexType)
 	}
 	return f(name, params)
 }
 
+func isRegistered(vindexType string) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	_, ok := registry[vindexType]
+	return ok
+}
+
 // Map invokes the Map implementation supplied by the vindex.
 func Map(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	switch vindex := vindex.(type) {
@@ -59,6 +59,10 @@ type VSchema struct {
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+
+	// allowUnloadedTypes is set when validating a keyspace: see
+	// ValidateKeyspace.
+	allowUnloadedTypes bool
 }
 
 // RoutingRule represents one routing rule.
@@ -91,6 +95,7 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	EstimatedRows           int64                `json:"estimated_rows,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -178,6 +183,20 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 // The build ignores sequence references because those dependencies can
 // go cross-keyspace.
 func BuildKeyspaceSchema(input *vschemapb.Keyspace, keyspace string) (*KeyspaceSchema, error) {
+	return buildKeyspaceSchema(input, keyspace, false)
+}
+
+// ValidateKeyspace ensures that the keyspace vschema is valid.
+// External references (like sequence) are not validated.
+// Vindex types that may be registered by the vindex plugins
+// of the keyspace are not validated either, because only
+// vtgate opens the plugins.
+func ValidateKeyspace(input *vschemapb.Keyspace) error {
+	_, err := buildKeyspaceSchema(input, "", true)
+	return err
+}
+
+func buildKeyspaceSchema(input *vschemapb.Keyspace, keyspace string, allowUnloadedTypes bool) (*KeyspaceSchema, error) {
 	if input == nil {
 		input = &vschemapb.Keyspace{}
 	}
@@ -187,22 +206,16 @@ func BuildKeyspaceSchema(input *vschemapb.Keyspace, keyspace string) (*KeyspaceS
 		},
 	}
 	vschema := &VSchema{
-		uniqueTables:   make(map[string]*Table),
-		uniqueVindexes: make(map[string]Vindex),
-		Keyspaces:      make(map[string]*KeyspaceSchema),
+		uniqueTables:       make(map[string]*Table),
+		uniqueVindexes:     make(map[string]Vindex),
+		Keyspaces:          make(map[string]*KeyspaceSchema),
+		allowUnloadedTypes: allowUnloadedTypes,
 	}
 	buildKeyspaces(formal, vschema)
 	err := vschema.Keyspaces[keyspace].Error
 	return vschema.Keyspaces[keyspace], err
 }
 
-// ValidateKeyspace ensures that the keyspace vschema is valid.
-// External references (like sequence) are not validated.
-func ValidateKeyspace(input *vschemapb.Keyspace) error {
-	_, err := BuildKeyspaceSchema(input, "")
-	return err
-}
-
 func buildKeyspaces(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	for ksname, ks := range source.Keyspaces {
 		ksvschema := &KeyspaceSchema{
@@ -222,6 +235,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 	keyspace := ksvschema.Keyspace
 	for vname, vindexInfo := range ks.Vindexes {
 		vindex, err := CreateVindex(vindexInfo.Type, vname, vindexInfo.Params)
+		if err != nil && vschema.allowUnloadedTypes && len(ks.VindexPlugins) != 0 && !isRegistered(vindexInfo.Type) {
+			vindex, err = &unloadedVindex{name: vname, vindexType: vindexInfo.Type}, nil
+		}
 		if err != nil {
 			return err
 		}
@@ -241,6 +257,7 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewTableIdent(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			EstimatedRows:           table.EstimatedRows,
 		}
 		switch table.Type {
 		case "", TypeReference:
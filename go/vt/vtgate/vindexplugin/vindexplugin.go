@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vindexplugin opens the Go plugins that register the custom
+// vindex types declared in the vschema. It's a separate package because
+// importing the Go plugin package disables the dead code elimination of
+// the linker: only vtgate should import it.
+package vindexplugin
+
+import (
+	"fmt"
+	"plugin"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+var (
+	mu     sync.Mutex
+	loaded = make(map[string]bool)
+)
+
+// Load opens the vindex plugins declared by the keyspaces of the
+// vschema that are not loaded yet. It must be called before the
+// vschema is built. Plugins can't be unloaded: a plugin that is
+// removed from the vschema stays loaded.
+// Plugins must be built with the same version of vitess and Go as
+// vtgate. The Go plugin package requires cgo: in a binary built with
+// CGO_ENABLED=0, opening a plugin always fails.
+func Load(vschema *vschemapb.SrvVSchema) error {
+	var paths []string
+	for _, ks := range vschema.GetKeyspaces() {
+		paths = append(paths, ks.VindexPlugins...)
+	}
+	sort.Strings(paths)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, path := range paths {
+		if loaded[path] {
+			continue
+		}
+		if err := vindexes.LoadPlugin(path, open); err != nil {
+			return err
+		}
+		loaded[path] = true
+	}
+	return nil
+}
+
+func open(path string) error {
+	if _, err := plugin.Open(path); err != nil {
+		return fmt.Errorf("could not load vindex plugin %s: %v", path, err)
+	}
+	return nil
+}
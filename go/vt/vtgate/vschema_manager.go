@@ -19,13 +19,16 @@ package vtgate
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vtgate/vindexplugin"
 
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
@@ -79,7 +82,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 			// Otherwise, keep what we already had before.
 			v = nil
 		default:
-			// Watch error, increment our counters.
+			// Watch error, increment our counters. v is the last
+			// known value while it is within srv_topo_stale_ttl.
 			if vschemaCounters != nil {
 				vschemaCounters.Add("WatchError", 1)
 			}
@@ -87,13 +91,21 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 
 		// keep a copy of the latest SrvVschema
 		vm.mu.Lock()
+		changes := diffSrvVSchema(vm.currentSrvVschema, v)
 		vm.currentSrvVschema = v
 		vm.mu.Unlock()
 
 		// Transform the provided SrvVSchema into a VSchema.
 		var vschema *vindexes.VSchema
 		if v != nil {
+			// Custom vindex types must be registered before the vschema
+			// is built. If a plugin can't be loaded, the keyspaces that
+			// use its vindex types are built with an error.
+			pluginErr := vindexplugin.Load(v)
 			vschema, err = vindexes.BuildVSchema(v)
+			if err == nil {
+				err = pluginErr
+			}
 			if err != nil {
 				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
 				err = fmt.Errorf("error creating VSchema for cell %v: %v", cell, err)
@@ -125,10 +137,131 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 			vschema = vm.e.vschema
 		}
 
-		vm.e.SaveVSchema(vschema, stats)
+		vm.e.saveVSchema(vschema, stats, changes)
 	})
 }
 
+// vschemaChanges describes what changed between two versions
+// of the SrvVSchema.
+type vschemaChanges struct {
+	// keyspaces are the keyspaces that changed as a whole.
+	keyspaces map[string]bool
+	// names are the table and vindex names that changed,
+	// in any keyspace.
+	names map[string]bool
+}
+
+// affects returns true if the plan depends on any of the changes.
+func (vc *vschemaChanges) affects(plan *engine.Plan) bool {
+	if plan.VSchemaKeyspaces == nil {
+		// We don't know what the plan depends on.
+		return true
+	}
+	for ks := range plan.VSchemaKeyspaces {
+		if vc.keyspaces[ks] {
+			return true
+		}
+	}
+	for name := range plan.VSchemaNames {
+		if vc.names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// diffSrvVSchema returns the changes from old to new. It returns nil
+// if the changes cannot be narrowed down, in which case all the plans
+// must be invalidated.
+func diffSrvVSchema(old, new *vschemapb.SrvVSchema) *vschemaChanges {
+	if old == nil || new == nil || len(old.Keyspaces) != len(new.Keyspaces) {
+		return nil
+	}
+	if !proto.Equal(old.RoutingRules, new.RoutingRules) {
+		return nil
+	}
+	changes := &vschemaChanges{
+		keyspaces: make(map[string]bool),
+		names:     make(map[string]bool),
+	}
+	for ksName, oldKs := range old.Keyspaces {
+		newKs, ok := new.Keyspaces[ksName]
+		if !ok {
+			return nil
+		}
+		if proto.Equal(oldKs, newKs) {
+			continue
+		}
+		if oldKs.Sharded != newKs.Sharded || oldKs.RequireExplicitRouting != newKs.RequireExplicitRouting {
+			// The routing of every table in the keyspace may change.
+			changes.keyspaces[ksName] = true
+			addKeyspaceNames(changes.names, oldKs)
+			addKeyspaceNames(changes.names, newKs)
+			continue
+		}
+		changedVindexes := make(map[string]bool)
+		diffVindexes(changedVindexes, oldKs.Vindexes, newKs.Vindexes)
+		diffVindexes(changedVindexes, newKs.Vindexes, oldKs.Vindexes)
+		diffTables(changes.names, oldKs.Tables, newKs.Tables, changedVindexes)
+		diffTables(changes.names, newKs.Tables, oldKs.Tables, changedVindexes)
+		for name := range changedVindexes {
+			changes.names[name] = true
+		}
+	}
+
+	// Tables using a changed sequence must also be replanned.
+	for _, ks := range new.Keyspaces {
+		for name, table := range ks.Tables {
+			if table.AutoIncrement == nil {
+				continue
+			}
+			seq := table.AutoIncrement.Sequence
+			if i := strings.LastIndex(seq, "."); i >= 0 {
+				seq = seq[i+1:]
+			}
+			if changes.names[seq] {
+				changes.names[name] = true
+			}
+		}
+	}
+	return changes
+}
+
+func addKeyspaceNames(names map[string]bool, ks *vschemapb.Keyspace) {
+	for name := range ks.Vindexes {
+		names[name] = true
+	}
+	for name := range ks.Tables {
+		names[name] = true
+	}
+}
+
+// diffVindexes adds the vindexes of a that are missing or different in b.
+func diffVindexes(changed map[string]bool, a, b map[string]*vschemapb.Vindex) {
+	for name, vindex := range a {
+		if !proto.Equal(vindex, b[name]) {
+			changed[name] = true
+		}
+	}
+}
+
+// diffTables adds the tables of a that are missing or different in b,
+// or that use one of the changed vindexes.
+func diffTables(changed map[string]bool, a, b map[string]*vschemapb.Table, changedVindexes map[string]bool) {
+	for name, table := range a {
+		if !proto.Equal(table, b[name]) {
+			changed[name] = true
+			continue
+		}
+		for _, cv := range table.ColumnVindexes {
+			if changedVindexes[cv.Name] {
+				changed[name] = true
+				break
+			}
+		}
+	}
+}
+
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
 // is updated in all known cells.
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestDiffSrvVSchema(t *testing.T) {
+	base := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash":   {Type: "hash"},
+					"lookup": {Type: "lookup_unique", Owner: "t1"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}, {Column: "c", Name: "lookup"}}},
+					"t2": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						AutoIncrement:  &vschemapb.AutoIncrement{Column: "id", Sequence: "unsharded.seq"},
+					},
+				},
+			},
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"seq": {Type: "sequence"},
+					"u1":  {},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name   string
+		change func(*vschemapb.SrvVSchema)
+		want   *vschemaChanges
+	}{{
+		name:   "no change",
+		change: func(*vschemapb.SrvVSchema) {},
+		want:   &vschemaChanges{keyspaces: map[string]bool{}, names: map[string]bool{}},
+	}, {
+		name: "table added",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.Keyspaces["unsharded"].Tables["u2"] = &vschemapb.Table{}
+		},
+		want: &vschemaChanges{keyspaces: map[string]bool{}, names: map[string]bool{"u2": true}},
+	}, {
+		name: "table removed",
+		change: func(v *vschemapb.SrvVSchema) {
+			delete(v.Keyspaces["unsharded"].Tables, "u1")
+		},
+		want: &vschemaChanges{keyspaces: map[string]bool{}, names: map[string]bool{"u1": true}},
+	}, {
+		name: "vindex changed",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.Keyspaces["sharded"].Vindexes["lookup"].Params = map[string]string{"table": "lkp"}
+		},
+		want: &vschemaChanges{keyspaces: map[string]bool{}, names: map[string]bool{"lookup": true, "t1": true}},
+	}, {
+		name: "sequence changed",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.Keyspaces["unsharded"].Tables["seq"].Columns = []*vschemapb.Column{{Name: "next_id"}}
+		},
+		want: &vschemaChanges{keyspaces: map[string]bool{}, names: map[string]bool{"seq": true, "t2": true}},
+	}, {
+		name: "sharding changed",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.Keyspaces["unsharded"].Sharded = true
+		},
+		want: &vschemaChanges{keyspaces: map[string]bool{"unsharded": true}, names: map[string]bool{"seq": true, "u1": true, "t2": true}},
+	}, {
+		name: "keyspace added",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.Keyspaces["other"] = &vschemapb.Keyspace{}
+		},
+	}, {
+		name: "routing rules changed",
+		change: func(v *vschemapb.SrvVSchema) {
+			v.RoutingRules = &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{{FromTable: "u1", ToTables: []string{"sharded.t1"}}}}
+		},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := proto.Clone(base).(*vschemapb.SrvVSchema)
+			tc.change(v)
+			assert.Equal(t, tc.want, diffSrvVSchema(base, v))
+		})
+	}
+
+	assert.Nil(t, diffSrvVSchema(nil, base))
+	assert.Nil(t, diffSrvVSchema(base, nil))
+}
+
+func TestVSchemaChangesAffects(t *testing.T) {
+	changes := &vschemaChanges{
+		keyspaces: map[string]bool{"ks1": true},
+		names:     map[string]bool{"t1": true},
+	}
+	assert.True(t, changes.affects(&engine.Plan{}))
+	assert.True(t, changes.affects(&engine.Plan{VSchemaKeyspaces: map[string]bool{"ks1": true}}))
+	assert.True(t, changes.affects(&engine.Plan{VSchemaKeyspaces: map[string]bool{"ks2": true}, VSchemaNames: map[string]bool{"t1": true}}))
+	assert.False(t, changes.affects(&engine.Plan{VSchemaKeyspaces: map[string]bool{"ks2": true}, VSchemaNames: map[string]bool{"t2": true}}))
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// This file implements VStream over HTTP, with JSON events, for the
+// change data capture consumers which don't use gRPC.
+
+var enableVStreamAPI = flag.Bool("enable_vstream_api", false, "If set, vtgate serves VStream on /api/vstream. The body of the POST request is a VStreamRequest in JSON, and the events are streamed as newline-delimited JSON objects.")
+
+const ndjsonContentType = "application/x-ndjson"
+
+// vstreamJSONEvent is the JSON encoding of a VEvent. The rows of the
+// ROW events are objects keyed by the column names, which come from
+// the last FIELD event of their table.
+type vstreamJSONEvent struct {
+	Type      string                  `json:"type,omitempty"`
+	Timestamp int64                   `json:"timestamp,omitempty"`
+	Gtid      string                  `json:"gtid,omitempty"`
+	DDL       string                  `json:"ddl,omitempty"`
+	DML       string                  `json:"dml,omitempty"`
+	Table     string                  `json:"table,omitempty"`
+	Fields    []*vstreamJSONField     `json:"fields,omitempty"`
+	Rows      []*vstreamJSONRowChange `json:"rows,omitempty"`
+	Vgtid     json.RawMessage         `json:"vgtid,omitempty"`
+	Journal   json.RawMessage         `json:"journal,omitempty"`
+	// Error is only set in the last event, if the stream failed.
+	Error string `json:"error,omitempty"`
+}
+
+// vstreamJSONField describes a column of a table.
+type vstreamJSONField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// vstreamJSONRowChange is a changed row. Before is nil for the
+// inserted rows, and After is nil for the deleted rows.
+type vstreamJSONRowChange struct {
+	Before map[string]interface{} `json:"before,omitempty"`
+	After  map[string]interface{} `json:"after,omitempty"`
+}
+
+// vstreamJSONEncoder encodes the events of a VStream in JSON.
+type vstreamJSONEncoder struct {
+	marshaler *jsonpb.Marshaler
+	// fields maps the tables to the fields of their last FIELD event.
+	fields map[string][]*querypb.Field
+}
+
+func newVStreamJSONEncoder() *vstreamJSONEncoder {
+	return &vstreamJSONEncoder{
+		marshaler: &jsonpb.Marshaler{OrigName: true},
+		fields:    make(map[string][]*querypb.Field),
+	}
+}
+
+func (enc *vstreamJSONEncoder) encode(event *binlogdatapb.VEvent) (*vstreamJSONEvent, error) {
+	jsonEvent := &vstreamJSONEvent{
+		Type:      event.Type.String(),
+		Timestamp: event.Timestamp,
+		Gtid:      event.Gtid,
+		DDL:       event.Ddl,
+		DML:       event.Dml,
+	}
+	switch event.Type {
+	case binlogdatapb.VEventType_FIELD:
+		fe := event.FieldEvent
+		enc.fields[fe.TableName] = fe.Fields
+		jsonEvent.Table = fe.TableName
+		for _, field := range fe.Fields {
+			jsonEvent.Fields = append(jsonEvent.Fields, &vstreamJSONField{
+				Name: field.Name,
+				Type: field.Type.String(),
+			})
+		}
+	case binlogdatapb.VEventType_ROW:
+		re := event.RowEvent
+		fields, ok := enc.fields[re.TableName]
+		if !ok {
+			return nil, fmt.Errorf("no field event received for table %v", re.TableName)
+		}
+		jsonEvent.Table = re.TableName
+		for _, change := range re.RowChanges {
+			jsonEvent.Rows = append(jsonEvent.Rows, &vstreamJSONRowChange{
+				Before: vstreamJSONRow(fields, change.Before),
+				After:  vstreamJSONRow(fields, change.After),
+			})
+		}
+	case binlogdatapb.VEventType_VGTID:
+		data, err := enc.marshaler.MarshalToString(event.Vgtid)
+		if err != nil {
+			return nil, err
+		}
+		jsonEvent.Vgtid = json.RawMessage(data)
+	case binlogdatapb.VEventType_JOURNAL:
+		data, err := enc.marshaler.MarshalToString(event.Journal)
+		if err != nil {
+			return nil, err
+		}
+		jsonEvent.Journal = json.RawMessage(data)
+	}
+	return jsonEvent, nil
+}
+
+// vstreamJSONRow returns the values of the row keyed by the column names.
+// The numbers are encoded as JSON numbers, the binary values in base64,
+// and the other values as strings.
+func vstreamJSONRow(fields []*querypb.Field, row *querypb.Row) map[string]interface{} {
+	if row == nil {
+		return nil
+	}
+	values := sqltypes.MakeRowTrusted(fields, row)
+	result := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		switch {
+		case value.IsNull():
+			result[fields[i].Name] = nil
+		case value.IsIntegral() || value.IsFloat() || value.Type() == sqltypes.Decimal:
+			result[fields[i].Name] = json.Number(value.ToString())
+		case value.IsBinary():
+			result[fields[i].Name] = value.ToBytes()
+		default:
+			result[fields[i].Name] = value.ToString()
+		}
+	}
+	return result
+}
+
+func initVStreamAPI(vtg *VTGate) {
+	if !*enableVStreamAPI {
+		return
+	}
+	handleAPI("vstream", func(w http.ResponseWriter, r *http.Request) error {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return nil
+		}
+		if r.Method != http.MethodPost {
+			return fmt.Errorf("the VStream request must be sent with POST")
+		}
+		request := &vtgatepb.VStreamRequest{}
+		if err := jsonpb.Unmarshal(r.Body, request); err != nil {
+			return fmt.Errorf("cannot parse the VStream request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", ndjsonContentType)
+		flusher, _ := w.(http.Flusher)
+		encoder := newVStreamJSONEncoder()
+		output := json.NewEncoder(w)
+		err := vtg.VStream(r.Context(), request.TabletType, request.Vgtid, request.Filter, request.Flags, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				jsonEvent, err := encoder.encode(event)
+				if err != nil {
+					return err
+				}
+				if err := output.Encode(jsonEvent); err != nil {
+					return err
+				}
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		})
+		// The status is already sent, so the error is sent as an event.
+		if err != nil {
+			output.Encode(&vstreamJSONEvent{Error: err.Error()})
+		}
+		return nil
+	})
+}
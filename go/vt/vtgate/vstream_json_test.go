@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestVStreamJSONEncoder(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "name", Type: sqltypes.VarChar},
+		{Name: "data", Type: sqltypes.VarBinary},
+	}
+	encoder := newVStreamJSONEncoder()
+	_, err := encoder.encode(&binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "t1"},
+	})
+	assert.EqualError(t, err, "no field event received for table t1")
+
+	events := []*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_BEGIN,
+	}, {
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "t1",
+			Fields:    fields,
+		},
+	}, {
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 1,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "t1",
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("aaa"), sqltypes.NewVarBinary("\x00")}),
+			}, {
+				Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NULL}),
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "ks",
+				Shard:    "-80",
+				Gtid:     "MySQL56/x:1-5",
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}
+	want := []string{
+		`{"type":"BEGIN"}`,
+		`{"type":"FIELD","table":"t1","fields":[{"name":"id","type":"INT64"},{"name":"name","type":"VARCHAR"},{"name":"data","type":"VARBINARY"}]}`,
+		`{"type":"ROW","timestamp":1,"table":"t1","rows":[{"after":{"data":"AA==","id":1,"name":"aaa"}},{"before":{"data":null,"id":2,"name":null}}]}`,
+		`{"type":"VGTID","vgtid":{"shard_gtids":[{"keyspace":"ks","shard":"-80","gtid":"MySQL56/x:1-5"}]}}`,
+		`{"type":"COMMIT"}`,
+	}
+	for i, event := range events {
+		jsonEvent, err := encoder.encode(event)
+		require.NoError(t, err)
+		data, err := json.Marshal(jsonEvent)
+		require.NoError(t, err)
+		assert.Equal(t, want[i], string(data))
+	}
+}
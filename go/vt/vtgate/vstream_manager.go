@@ -17,21 +17,33 @@ limitations under the License.
 package vtgate
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+var vstreamReconnectTimeout = flag.Duration("vstream_reconnect_timeout", 30*time.Second, "how long a VStream keeps reconnecting to a shard which is unavailable, for example while it's reparented or its tablet restarts, before it fails")
+
+// vstreamRetryDelay is the initial delay between the reconnections of
+// a shard stream. It doubles after every failure, up to maxVStreamRetryDelay.
+var vstreamRetryDelay = 100 * time.Millisecond
+
+const maxVStreamRetryDelay = 5 * time.Second
+
 // vstreamManager manages vstream requests.
 type vstreamManager struct {
 	resolver *srvtopo.Resolver
@@ -51,6 +63,8 @@ type vstream struct {
 	vgtid     *binlogdatapb.VGtid
 	send      func(events []*binlogdatapb.VEvent) error
 	journaler map[int64]*journalEvent
+	// lastSent is the time events were last sent.
+	lastSent time.Time
 
 	// err can only be set once.
 	once sync.Once
@@ -60,6 +74,8 @@ type vstream struct {
 	tabletType topodatapb.TabletType
 	filter     *binlogdatapb.Filter
 	resolver   *srvtopo.Resolver
+	// heartbeatInterval is 0 if heartbeats are disabled.
+	heartbeatInterval time.Duration
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
@@ -79,7 +95,7 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 	}
 }
 
-func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error {
+func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
 	vgtid, filter, err := vsm.resolveParams(ctx, tabletType, vgtid, filter)
 	if err != nil {
 		return err
@@ -91,6 +107,10 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		send:       send,
 		resolver:   vsm.resolver,
 		journaler:  make(map[int64]*journalEvent),
+		lastSent:   time.Now(),
+	}
+	if flags != nil {
+		vs.heartbeatInterval = time.Duration(flags.HeartbeatInterval) * time.Second
 	}
 	return vs.stream(ctx)
 }
@@ -162,10 +182,65 @@ func (vs *vstream) stream(ctx context.Context) error {
 	for _, sgtid := range copylist {
 		vs.startOneStream(ctx, sgtid)
 	}
+	heartbeatsDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatsDone)
+		vs.sendHeartbeats(ctx)
+	}()
 	vs.wg.Wait()
+	// Stop the heartbeats before returning: nothing can be sent after that.
+	vs.cancel()
+	<-heartbeatsDone
 	return vs.err
 }
 
+// sendHeartbeats sends a VGTID event with the current position and a HEARTBEAT
+// event every heartbeatInterval, if no other events were sent in the meantime.
+func (vs *vstream) sendHeartbeats(ctx context.Context) {
+	if vs.heartbeatInterval == 0 {
+		return
+	}
+	ticker := time.NewTicker(vs.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := vs.sendHeartbeat(); err != nil {
+			vs.once.Do(func() {
+				vs.err = err
+				vs.cancel()
+			})
+			return
+		}
+	}
+}
+
+func (vs *vstream) sendHeartbeat() error {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(vs.lastSent) < vs.heartbeatInterval {
+		return nil
+	}
+	events := []*binlogdatapb.VEvent{{
+		Type:  binlogdatapb.VEventType_VGTID,
+		Vgtid: proto.Clone(vs.vgtid).(*binlogdatapb.VGtid),
+	}, {
+		Type:        binlogdatapb.VEventType_HEARTBEAT,
+		Timestamp:   now.Unix(),
+		CurrentTime: now.UnixNano(),
+	}}
+	if err := vs.send(events); err != nil {
+		return err
+	}
+	vs.lastSent = now
+	return nil
+}
+
 // startOneStream sets up one shard stream.
 func (vs *vstream) startOneStream(ctx context.Context, sgtid *binlogdatapb.ShardGtid) {
 	vs.wg.Add(1)
@@ -189,7 +264,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	// It will be closed when all journal events converge.
 	var journalDone chan struct{}
 
-	errCount := 0
+	// firstFailure is the time of the first of the consecutive failures.
+	var firstFailure time.Time
+	retryDelay := vstreamRetryDelay
 	for {
 		select {
 		case <-ctx.Done():
@@ -213,8 +290,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 		}
 		// Safe to access sgtid.Gtid here (because it can't change until streaming begins).
 		err = rss[0].QueryService.VStream(ctx, rss[0].Target, sgtid.Gtid, vs.filter, func(events []*binlogdatapb.VEvent) error {
-			// We received a valid event. Reset error count.
-			errCount = 0
+			// We received a valid event. Reset the failures.
+			firstFailure = time.Time{}
+			retryDelay = vstreamRetryDelay
 
 			select {
 			case <-ctx.Done():
@@ -290,24 +368,52 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			// Unreachable.
 			err = vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "vstream ended unexpectedly")
 		}
-		if vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION && vterrors.Code(err) != vtrpcpb.Code_UNAVAILABLE {
+		if !isRetryableVStreamError(err) {
 			log.Errorf("vstream for %s/%s error: %v", sgtid.Keyspace, sgtid.Shard, err)
 			return err
 		}
-		errCount++
-		if errCount >= 3 {
-			log.Errorf("vstream for %s/%s had three consecutive failures: %v", sgtid.Keyspace, sgtid.Shard, err)
+		if firstFailure.IsZero() {
+			firstFailure = time.Now()
+		}
+		if time.Since(firstFailure) >= *vstreamReconnectTimeout {
+			log.Errorf("vstream for %s/%s could not reconnect for %v: %v", sgtid.Keyspace, sgtid.Shard, *vstreamReconnectTimeout, err)
 			return err
 		}
-		log.Infof("vstream for %s/%s error, retrying: %v", sgtid.Keyspace, sgtid.Shard, err)
+		// The stream resumes from the position of the last transaction sent,
+		// on the tablet the shard resolves to, which changes after a reparent.
+		log.Infof("vstream for %s/%s error, retrying in %v: %v", sgtid.Keyspace, sgtid.Shard, retryDelay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryDelay):
+		}
+		retryDelay *= 2
+		if retryDelay > maxVStreamRetryDelay {
+			retryDelay = maxVStreamRetryDelay
+		}
 	}
 }
 
+// isRetryableVStreamError returns true if the error is caused by the
+// tablet being unavailable. The stream can then continue on another tablet.
+func isRetryableVStreamError(err error) bool {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_CANCELED:
+		// CANCELED is returned when the tablet shuts down. The context of
+		// the vstream is checked before retrying.
+		return true
+	}
+	return false
+}
+
 // sendAll sends a group of events together while holding the lock.
 func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdatapb.VEvent) error {
 	vs.mu.Lock()
 	defer vs.mu.Unlock()
 
+	if alreadySent(sgtid, eventss) {
+		return nil
+	}
 	// Send all chunks while holding the lock.
 	for _, events := range eventss {
 		// convert all gtids to vgtids. This should be done here while holding the lock.
@@ -325,9 +431,35 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 			return err
 		}
 	}
+	vs.lastSent = time.Now()
 	return nil
 }
 
+// alreadySent returns true if the position of the transaction is not after
+// the position of the shard. This happens when the stream reconnects to a
+// tablet which sends the transactions from an earlier position, like
+// with the file based positions. Positions which can't be compared, like
+// "current", are considered new.
+func alreadySent(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdatapb.VEvent) bool {
+	current, err := mysql.DecodePosition(sgtid.Gtid)
+	if err != nil || current.IsZero() {
+		return false
+	}
+	for _, events := range eventss {
+		for _, event := range events {
+			if event.Type != binlogdatapb.VEventType_GTID {
+				continue
+			}
+			pos, err := mysql.DecodePosition(event.Gtid)
+			if err != nil {
+				return false
+			}
+			return current.AtLeast(pos)
+		}
+	}
+	return false
+}
+
 // getJournalEvent returns a journalEvent. The caller has to wait on its done channel.
 // Once it closes, the caller has to return (end their stream).
 // The function has three parts:
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -89,7 +91,7 @@ func TestVStreamEvents(t *testing.T) {
 	}
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
@@ -106,6 +108,63 @@ func TestVStreamEvents(t *testing.T) {
 	<-ch
 }
 
+// TestVStreamHeartbeats ensures that an idle stream sends its position
+// and a heartbeat at the requested interval.
+func TestVStreamHeartbeats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{HeartbeatInterval: 1}, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+		close(ch)
+	}()
+	wantVgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "gtid01",
+		}},
+	}
+	verifyEvents(t, ch, &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: wantVgtid},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}})
+
+	for i := 0; i < 2; i++ {
+		got := <-ch
+		require.Len(t, got.Events, 2)
+		assert.True(t, proto.Equal(got.Events[0].Vgtid, wantVgtid), "vgtid: %v", got.Events[0])
+		assert.Equal(t, binlogdatapb.VEventType_HEARTBEAT, got.Events[1].Type)
+		assert.NotZero(t, got.Events[1].Timestamp)
+	}
+
+	// No event is sent once the stream ended.
+	cancel()
+	for range ch {
+	}
+}
+
 // TestVStreamChunks ensures that a transaction that's broken
 // into chunks is sent together.
 func TestVStreamChunks(t *testing.T) {
@@ -140,7 +199,7 @@ func TestVStreamChunks(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		switch events[0].Type {
 		case binlogdatapb.VEventType_ROW:
 			if doneCounting {
@@ -240,6 +299,8 @@ func TestVStreamMulti(t *testing.T) {
 func TestVStreamRetry(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	defer func(saved time.Duration) { vstreamRetryDelay = saved }(vstreamRetryDelay)
+	vstreamRetryDelay = time.Millisecond
 
 	name := "TestVStream"
 	_ = createSandbox(name)
@@ -255,7 +316,7 @@ func TestVStreamRetry(t *testing.T) {
 	sbc0.AddVStreamEvents(commit, nil)
 	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "bb"))
 	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cc"))
-	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "final error"))
+	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "final error"))
 
 	count := 0
 	vgtid := &binlogdatapb.VGtid{
@@ -265,7 +326,7 @@ func TestVStreamRetry(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		count++
 		return nil
 	})
@@ -276,6 +337,80 @@ func TestVStreamRetry(t *testing.T) {
 	}
 }
 
+func TestVStreamReconnectTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func(saved time.Duration) { vstreamRetryDelay = saved }(vstreamRetryDelay)
+	vstreamRetryDelay = 5 * time.Millisecond
+	defer func(saved time.Duration) { *vstreamReconnectTimeout = saved }(*vstreamReconnectTimeout)
+	*vstreamReconnectTimeout = 20 * time.Millisecond
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	for i := 0; i < 100; i++ {
+		sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "tablet is down"))
+	}
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
+		t.Errorf("unexpected events: %v", events)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tablet is down")
+}
+
+func TestVStreamReconnectSkipsSentTransactions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func(saved time.Duration) { vstreamRetryDelay = saved }(vstreamRetryDelay)
+	vstreamRetryDelay = time.Millisecond
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	transaction := func(gtid string) []*binlogdatapb.VEvent {
+		return []*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: gtid},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}
+	}
+	sbc0.AddVStreamEvents(transaction("MariaDB/0-1-5"), nil)
+	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "reparented"))
+	// The new master sends the last transaction again.
+	sbc0.AddVStreamEvents(transaction("MariaDB/0-1-5"), nil)
+	sbc0.AddVStreamEvents(transaction("MariaDB/0-1-6"), nil)
+	sbc0.AddVStreamEvents(nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "final error"))
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "MariaDB/0-1-4",
+		}},
+	}
+	var gtids []string
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
+		gtids = append(gtids, events[0].Vgtid.ShardGtids[0].Gtid)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "final error")
+	assert.Equal(t, []string{"MariaDB/0-1-5", "MariaDB/0-1-6"}, gtids)
+}
+
 func TestVStreamHeartbeat(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -706,7 +841,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 			Gtid:     "pos1020",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -735,7 +870,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 		}},
 	}
 	sbc2.AddVStreamEvents(send, nil)
-	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -881,7 +1016,7 @@ func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell s
 func startVStream(ctx context.Context, t *testing.T, vsm *vstreamManager, vgtid *binlogdatapb.VGtid) <-chan *binlogdatapb.VStreamResponse {
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
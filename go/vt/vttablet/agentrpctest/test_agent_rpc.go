@@ -513,6 +513,28 @@ func agentRPCTestIgnoreHealthErrorPanic(ctx context.Context, t *testing.T, clien
 	expectHandleRPCPanic(t, "IgnoreHealthError", false /*verbose*/, err)
 }
 
+var testResizeTxPoolSize int64 = 42
+
+func (fra *fakeRPCAgent) ResizeTxPool(ctx context.Context, size int64) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ResizeTxPool size", size, testResizeTxPoolSize)
+	return nil
+}
+
+func agentRPCTestResizeTxPool(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ResizeTxPool(ctx, tablet, testResizeTxPoolSize)
+	if err != nil {
+		t.Errorf("ResizeTxPool failed: %v", err)
+	}
+}
+
+func agentRPCTestResizeTxPoolPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ResizeTxPool(ctx, tablet, testResizeTxPoolSize)
+	expectHandleRPCPanic(t, "ResizeTxPool", true /*verbose*/, err)
+}
+
 var testReloadSchemaCalled = false
 
 func (fra *fakeRPCAgent) ReloadSchema(ctx context.Context, waitPosition string) error {
@@ -595,6 +617,120 @@ func agentRPCTestApplySchemaPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "ApplySchema", true /*verbose*/, err)
 }
 
+var testDeclarativeSchema = []string{"create table fruit (basket int)"}
+
+var testDeclarativeSchemaChanges = []string{"alter table fruit add column basket int"}
+
+func (fra *fakeRPCAgent) ApplyDeclarativeSchema(ctx context.Context, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ApplyDeclarativeSchema desired", desired, testDeclarativeSchema)
+	compareBool(fra.t, "ApplyDeclarativeSchema allowDrop", allowDrop)
+	compareBool(fra.t, "ApplyDeclarativeSchema dryRun", dryRun)
+	return testDeclarativeSchemaChanges, nil
+}
+
+func agentRPCTestApplyDeclarativeSchema(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	changes, err := client.ApplyDeclarativeSchema(ctx, tablet, testDeclarativeSchema, true, true)
+	compareError(t, "ApplyDeclarativeSchema", err, changes, testDeclarativeSchemaChanges)
+}
+
+func agentRPCTestApplyDeclarativeSchemaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ApplyDeclarativeSchema(ctx, tablet, testDeclarativeSchema, true, true)
+	expectHandleRPCPanic(t, "ApplyDeclarativeSchema", true /*verbose*/, err)
+}
+
+var testOnlineDDLSQL = "alter table fruit add column basket int"
+var testOnlineDDLStrategy = "gh-ost"
+var testOnlineDDLUUID = "9f9b8ba1-4cba-11ea-ac9c-0242ac110003"
+var testOnlineDDLMigrations = []*tabletmanagerdatapb.OnlineDDLMigration{{
+	Uuid:     testOnlineDDLUUID,
+	Table:    "fruit",
+	Sql:      testOnlineDDLSQL,
+	Strategy: testOnlineDDLStrategy,
+	Status:   "running",
+	Progress: 42.5,
+	Attempts: 1,
+}}
+
+func (fra *fakeRPCAgent) SubmitOnlineDDL(ctx context.Context, sql, strategy string) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SubmitOnlineDDL sql", sql, testOnlineDDLSQL)
+	compare(fra.t, "SubmitOnlineDDL strategy", strategy, testOnlineDDLStrategy)
+	return testOnlineDDLUUID, nil
+}
+
+func agentRPCTestSubmitOnlineDDL(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	uuid, err := client.SubmitOnlineDDL(ctx, tablet, testOnlineDDLSQL, testOnlineDDLStrategy)
+	compareError(t, "SubmitOnlineDDL", err, uuid, testOnlineDDLUUID)
+}
+
+func agentRPCTestSubmitOnlineDDLPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SubmitOnlineDDL(ctx, tablet, testOnlineDDLSQL, testOnlineDDLStrategy)
+	expectHandleRPCPanic(t, "SubmitOnlineDDL", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) GetOnlineDDLMigrations(ctx context.Context, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetOnlineDDLMigrations uuid", uuid, testOnlineDDLUUID)
+	return testOnlineDDLMigrations, nil
+}
+
+func agentRPCTestGetOnlineDDLMigrations(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	migrations, err := client.GetOnlineDDLMigrations(ctx, tablet, testOnlineDDLUUID)
+	compareError(t, "GetOnlineDDLMigrations", err, migrations, testOnlineDDLMigrations)
+}
+
+func agentRPCTestGetOnlineDDLMigrationsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetOnlineDDLMigrations(ctx, tablet, testOnlineDDLUUID)
+	expectHandleRPCPanic(t, "GetOnlineDDLMigrations", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) CancelOnlineDDL(ctx context.Context, uuid string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CancelOnlineDDL uuid", uuid, testOnlineDDLUUID)
+	return nil
+}
+
+func agentRPCTestCancelOnlineDDL(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.CancelOnlineDDL(ctx, tablet, testOnlineDDLUUID)
+	if err != nil {
+		t.Errorf("CancelOnlineDDL failed: %v", err)
+	}
+}
+
+func agentRPCTestCancelOnlineDDLPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.CancelOnlineDDL(ctx, tablet, testOnlineDDLUUID)
+	expectHandleRPCPanic(t, "CancelOnlineDDL", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) RetryOnlineDDL(ctx context.Context, uuid string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RetryOnlineDDL uuid", uuid, testOnlineDDLUUID)
+	return nil
+}
+
+func agentRPCTestRetryOnlineDDL(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RetryOnlineDDL(ctx, tablet, testOnlineDDLUUID)
+	if err != nil {
+		t.Errorf("RetryOnlineDDL failed: %v", err)
+	}
+}
+
+func agentRPCTestRetryOnlineDDLPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RetryOnlineDDL(ctx, tablet, testOnlineDDLUUID)
+	expectHandleRPCPanic(t, "RetryOnlineDDL", true /*verbose*/, err)
+}
+
 var testExecuteFetchQuery = []byte("fetch this invalid utf8 character \x80")
 var testExecuteFetchMaxRows = 100
 var testExecuteFetchResult = &querypb.QueryResult{
@@ -901,6 +1037,80 @@ func agentRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T,
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var (
+	testPauseID       = 3
+	testPausePosition = "MariaDB/0-1-1083"
+)
+
+func (fra *fakeRPCAgent) VReplicationPause(ctx context.Context, id int) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VReplicationPause id", id, testPauseID)
+	return testPausePosition, nil
+}
+
+func agentRPCTestVReplicationPause(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	pos, err := client.VReplicationPause(ctx, tablet, testPauseID)
+	compareError(t, "VReplicationPause", err, pos, testPausePosition)
+}
+
+func agentRPCTestVReplicationPausePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VReplicationPause(ctx, tablet, testPauseID)
+	expectHandleRPCPanic(t, "VReplicationPause", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) VReplicationResume(ctx context.Context, id int) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VReplicationResume id", id, testPauseID)
+	return nil
+}
+
+func agentRPCTestVReplicationResume(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.VReplicationResume(ctx, tablet, testPauseID)
+	compareError(t, "VReplicationResume", err, true, true)
+}
+
+func agentRPCTestVReplicationResumePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.VReplicationResume(ctx, tablet, testPauseID)
+	expectHandleRPCPanic(t, "VReplicationResume", true /*verbose*/, err)
+}
+
+var (
+	testCopyProgressWorkflow = "test_workflow"
+	testCopyProgress         = []*tabletmanagerdatapb.VReplicationStreamCopyProgress{{
+		Id:       1,
+		Workflow: testCopyProgressWorkflow,
+		Tables: []*tabletmanagerdatapb.TableCopyProgress{{
+			Table:         "t1",
+			RowsCopied:    100,
+			RowsTotal:     1000,
+			RowsPerSecond: 10,
+			EtaSeconds:    90,
+		}},
+	}}
+)
+
+func (fra *fakeRPCAgent) VReplicationCopyProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VReplicationCopyProgress workflow", workflow, testCopyProgressWorkflow)
+	return testCopyProgress, nil
+}
+
+func agentRPCTestVReplicationCopyProgress(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	progress, err := client.VReplicationCopyProgress(ctx, tablet, testCopyProgressWorkflow)
+	compareError(t, "VReplicationCopyProgress", err, progress, testCopyProgress)
+}
+
+func agentRPCTestVReplicationCopyProgressPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VReplicationCopyProgress(ctx, tablet, testCopyProgressWorkflow)
+	expectHandleRPCPanic(t, "VReplicationCopyProgress", false /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1163,22 +1373,30 @@ func agentRPCTestPromoteSlavePanic(ctx context.Context, t *testing.T, client tmc
 
 var testBackupConcurrency = 24
 var testBackupAllowMaster = false
+var testBackupIncremental = true
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreFromBackupRequest = &tabletmanagerdatapb.RestoreFromBackupRequest{
+	RestoreToTimestamp: logutil.TimeToProto(time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC)),
+	RestoreToPos:       "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-100",
+	BackupName:         "2020-03-01.120000.cell1-0000000100",
+	BackupTimestamp:    logutil.TimeToProto(time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)),
+}
 
-func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster, incremental bool) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
 	compare(fra.t, "Backup args", allowMaster, testBackupAllowMaster)
+	compare(fra.t, "Backup args", incremental, testBackupIncremental)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
 func agentRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster, testBackupIncremental)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1187,7 +1405,7 @@ func agentRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.Table
 }
 
 func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster, testBackupIncremental)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1198,17 +1416,18 @@ func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, req *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup req", req, testRestoreFromBackupRequest)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1217,7 +1436,7 @@ func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmc
 }
 
 func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1266,9 +1485,15 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestRefreshState(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheck(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthError(ctx, t, client, tablet)
+	agentRPCTestResizeTxPool(ctx, t, client, tablet)
 	agentRPCTestReloadSchema(ctx, t, client, tablet)
 	agentRPCTestPreflightSchema(ctx, t, client, tablet)
 	agentRPCTestApplySchema(ctx, t, client, tablet)
+	agentRPCTestApplyDeclarativeSchema(ctx, t, client, tablet)
+	agentRPCTestSubmitOnlineDDL(ctx, t, client, tablet)
+	agentRPCTestGetOnlineDDLMigrations(ctx, t, client, tablet)
+	agentRPCTestCancelOnlineDDL(ctx, t, client, tablet)
+	agentRPCTestRetryOnlineDDL(ctx, t, client, tablet)
 	agentRPCTestExecuteFetch(ctx, t, client, tablet)
 
 	// Replication related methods
@@ -1284,6 +1509,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	agentRPCTestVReplicationExec(ctx, t, client, tablet)
 	agentRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	agentRPCTestVReplicationPause(ctx, t, client, tablet)
+	agentRPCTestVReplicationResume(ctx, t, client, tablet)
+	agentRPCTestVReplicationCopyProgress(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	agentRPCTestResetReplication(ctx, t, client, tablet)
@@ -1321,9 +1549,15 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestRefreshStatePanic(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthErrorPanic(ctx, t, client, tablet)
+	agentRPCTestResizeTxPoolPanic(ctx, t, client, tablet)
 	agentRPCTestReloadSchemaPanic(ctx, t, client, tablet)
 	agentRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	agentRPCTestApplySchemaPanic(ctx, t, client, tablet)
+	agentRPCTestApplyDeclarativeSchemaPanic(ctx, t, client, tablet)
+	agentRPCTestSubmitOnlineDDLPanic(ctx, t, client, tablet)
+	agentRPCTestGetOnlineDDLMigrationsPanic(ctx, t, client, tablet)
+	agentRPCTestCancelOnlineDDLPanic(ctx, t, client, tablet)
+	agentRPCTestRetryOnlineDDLPanic(ctx, t, client, tablet)
 	agentRPCTestExecuteFetchPanic(ctx, t, client, tablet)
 
 	// Replication related methods
@@ -1338,6 +1572,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	agentRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	agentRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	agentRPCTestVReplicationPausePanic(ctx, t, client, tablet)
+	agentRPCTestVReplicationResumePanic(ctx, t, client, tablet)
+	agentRPCTestVReplicationCopyProgressPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	agentRPCTestResetReplicationPanic(ctx, t, client, tablet)
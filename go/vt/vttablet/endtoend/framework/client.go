@@ -96,7 +96,8 @@ func (client *QueryClient) Begin(clientFoundRows bool) error {
 // Commit commits the current transaction.
 func (client *QueryClient) Commit() error {
 	defer func() { client.transactionID = 0 }()
-	return client.server.Commit(client.ctx, &client.target, client.transactionID)
+	_, err := client.server.Commit(client.ctx, &client.target, client.transactionID)
+	return err
 }
 
 // Rollback rolls back the current transaction.
@@ -247,11 +248,23 @@ func (client *QueryClient) ExecuteBatch(queries []*querypb.BoundQuery, asTransac
 
 // MessageStream streams messages from the message table.
 func (client *QueryClient) MessageStream(name string, callback func(*sqltypes.Result) error) (err error) {
-	return client.server.MessageStream(client.ctx, &client.target, name, callback)
+	return client.MessageStreamWithResumeToken(name, "", callback)
+}
+
+// MessageStreamWithResumeToken streams messages from the message table,
+// resending first the messages listed by resumeToken.
+func (client *QueryClient) MessageStreamWithResumeToken(name, resumeToken string, callback func(*sqltypes.Result) error) (err error) {
+	return client.server.MessageStream(client.ctx, &client.target, name, resumeToken, callback)
 }
 
 // MessageAck acks messages
 func (client *QueryClient) MessageAck(name string, ids []string) (int64, error) {
+	return client.MessageAckWithUpdates(name, ids, nil)
+}
+
+// MessageAckWithUpdates acks messages and sets the given
+// user-defined columns on them.
+func (client *QueryClient) MessageAckWithUpdates(name string, ids []string, updates map[string]*querypb.Value) (int64, error) {
 	bids := make([]*querypb.Value, 0, len(ids))
 	for _, id := range ids {
 		bids = append(bids, &querypb.Value{
@@ -259,5 +272,5 @@ func (client *QueryClient) MessageAck(name string, ids []string) (int64, error)
 			Value: []byte(id),
 		})
 	}
-	return client.server.MessageAck(client.ctx, &client.target, name, bids)
+	return client.server.MessageAck(client.ctx, &client.target, name, bids, updates)
 }
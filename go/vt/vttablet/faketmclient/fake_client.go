@@ -137,6 +137,11 @@ func (client *FakeTabletManagerClient) IgnoreHealthError(ctx context.Context, ta
 	return nil
 }
 
+// ResizeTxPool is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ResizeTxPool(ctx context.Context, tablet *topodatapb.Tablet, size int64) error {
+	return nil
+}
+
 // ReloadSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	return nil
@@ -152,6 +157,31 @@ func (client *FakeTabletManagerClient) ApplySchema(ctx context.Context, tablet *
 	return &tabletmanagerdatapb.SchemaChangeResult{}, nil
 }
 
+// ApplyDeclarativeSchema is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ApplyDeclarativeSchema(ctx context.Context, tablet *topodatapb.Tablet, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	return nil, nil
+}
+
+// SubmitOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SubmitOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, sql, strategy string) (string, error) {
+	return "", nil
+}
+
+// GetOnlineDDLMigrations is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetOnlineDDLMigrations(ctx context.Context, tablet *topodatapb.Tablet, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	return nil, nil
+}
+
+// CancelOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CancelOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	return nil
+}
+
+// RetryOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RetryOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	return nil
+}
+
 // ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
 	return &querypb.QueryResult{}, nil
@@ -231,6 +261,21 @@ func (client *FakeTabletManagerClient) VReplicationWaitForPos(ctx context.Contex
 	return nil
 }
 
+// VReplicationPause is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) VReplicationPause(ctx context.Context, tablet *topodatapb.Tablet, id int) (string, error) {
+	return "", nil
+}
+
+// VReplicationResume is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) VReplicationResume(ctx context.Context, tablet *topodatapb.Tablet, id int) error {
+	return nil
+}
+
+// VReplicationCopyProgress is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	return nil, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -306,12 +351,12 @@ func (e *eofEventStream) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
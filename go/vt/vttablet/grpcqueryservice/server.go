@@ -108,10 +108,11 @@ func (q *query) Commit(ctx context.Context, request *querypb.CommitRequest) (res
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	if err := q.server.Commit(ctx, request.Target, request.TransactionId); err != nil {
+	sessionStateChanges, err := q.server.Commit(ctx, request.Target, request.TransactionId)
+	if err != nil {
 		return nil, vterrors.ToGRPC(err)
 	}
-	return &querypb.CommitResponse{}, nil
+	return &querypb.CommitResponse{SessionStateChanges: sessionStateChanges}, nil
 }
 
 // Rollback is part of the queryservice.QueryServer interface
@@ -298,7 +299,7 @@ func (q *query) MessageStream(request *querypb.MessageStreamRequest, stream quer
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	err = q.server.MessageStream(ctx, request.Target, request.Name, func(qr *sqltypes.Result) error {
+	err = q.server.MessageStream(ctx, request.Target, request.Name, request.ResumeToken, func(qr *sqltypes.Result) error {
 		return stream.Send(&querypb.MessageStreamResponse{
 			Result: sqltypes.ResultToProto3(qr),
 		})
@@ -313,7 +314,7 @@ func (q *query) MessageAck(ctx context.Context, request *querypb.MessageAckReque
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	count, err := q.server.MessageAck(ctx, request.Target, request.Name, request.Ids)
+	count, err := q.server.MessageAck(ctx, request.Target, request.Name, request.Ids, request.Updates)
 	if err != nil {
 		return nil, vterrors.ToGRPC(err)
 	}
@@ -324,6 +325,24 @@ func (q *query) MessageAck(ctx context.Context, request *querypb.MessageAckReque
 	}, nil
 }
 
+// MessagePostpone is part of the queryservice.QueryServer interface
+func (q *query) MessagePostpone(ctx context.Context, request *querypb.MessagePostponeRequest) (response *querypb.MessagePostponeResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	count, err := q.server.MessagePostpone(ctx, request.Target, request.Name, request.Ids, request.Updates)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.MessagePostponeResponse{
+		Result: &querypb.QueryResult{
+			RowsAffected: uint64(count),
+		},
+	}, nil
+}
+
 // StreamHealth is part of the queryservice.QueryServer interface
 func (q *query) StreamHealth(request *querypb.StreamHealthRequest, stream queryservicepb.Query_StreamHealthServer) (err error) {
 	defer q.server.HandlePanic(&err)
@@ -368,6 +387,31 @@ func (q *query) VStreamResults(request *binlogdatapb.VStreamResultsRequest, stre
 	return vterrors.ToGRPC(err)
 }
 
+// StreamSchemaChanges is part of the queryservice.QueryServer interface
+func (q *query) StreamSchemaChanges(request *querypb.StreamSchemaChangesRequest, stream queryservicepb.Query_StreamSchemaChangesServer) (err error) {
+	defer q.server.HandlePanic(&err)
+	ctx := callerid.NewContext(callinfo.GRPCCallInfo(stream.Context()),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	err = q.server.StreamSchemaChanges(ctx, request.Target, stream.Send)
+	return vterrors.ToGRPC(err)
+}
+
+// GetSchemaVersion is part of the queryservice.QueryServer interface
+func (q *query) GetSchemaVersion(ctx context.Context, request *querypb.GetSchemaVersionRequest) (response *querypb.GetSchemaVersionResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	version, err := q.server.GetSchemaVersion(ctx, request.Target, request.TableName, request.Time)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.GetSchemaVersionResponse{Version: version}, nil
+}
+
 // Register registers the implementation on the provide gRPC Server.
 func Register(s *grpc.Server, server queryservice.QueryService) {
 	queryservicepb.RegisterQueryServer(s, &query{server})
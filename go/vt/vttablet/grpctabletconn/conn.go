@@ -218,11 +218,11 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 }
 
 // Commit commits the ongoing transaction.
-func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return tabletconn.ConnClosed
+		return "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.CommitRequest{
@@ -231,11 +231,11 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		TransactionId:     transactionID,
 	}
-	_, err := conn.c.Commit(ctx, req)
+	cr, err := conn.c.Commit(ctx, req)
 	if err != nil {
-		return tabletconn.ErrorFromGRPC(err)
+		return "", tabletconn.ErrorFromGRPC(err)
 	}
-	return nil
+	return cr.SessionStateChanges, nil
 }
 
 // Rollback rolls back the ongoing transaction.
@@ -491,7 +491,7 @@ func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *quer
 }
 
 // MessageStream streams messages.
-func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) error {
 	// Please see comments in StreamExecute to see how this works.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -508,6 +508,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Name:              name,
+			ResumeToken:       resumeToken,
 		}
 		stream, err := conn.c.MessageStream(ctx, req)
 		if err != nil {
@@ -537,7 +538,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 }
 
 // MessageAck acks messages.
-func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -549,6 +550,7 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Name:              name,
 		Ids:               ids,
+		Updates:           updates,
 	}
 	reply, err := conn.c.MessageAck(ctx, req)
 	if err != nil {
@@ -557,6 +559,28 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 	return int64(reply.Result.RowsAffected), nil
 }
 
+// MessagePostpone postpones the redelivery of messages.
+func (conn *gRPCQueryClient) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (int64, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return 0, tabletconn.ConnClosed
+	}
+	req := &querypb.MessagePostponeRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Name:              name,
+		Ids:               ids,
+		Updates:           updates,
+	}
+	reply, err := conn.c.MessagePostpone(ctx, req)
+	if err != nil {
+		return 0, tabletconn.ErrorFromGRPC(err)
+	}
+	return int64(reply.Result.RowsAffected), nil
+}
+
 // StreamHealth starts a streaming RPC for VTTablet health status updates.
 func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	// Please see comments in StreamExecute to see how this works.
@@ -609,7 +633,7 @@ func (conn *gRPCQueryClient) VStream(ctx context.Context, target *querypb.Target
 			Position:          position,
 			Filter:            filter,
 		}
-		stream, err := conn.c.VStream(ctx, req)
+		stream, err := conn.c.VStream(ctx, req, grpcclient.CompressionCallOptions(ctx)...)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -653,7 +677,7 @@ func (conn *gRPCQueryClient) VStreamRows(ctx context.Context, target *querypb.Ta
 			Query:             query,
 			Lastpk:            lastpk,
 		}
-		stream, err := conn.c.VStreamRows(ctx, req)
+		stream, err := conn.c.VStreamRows(ctx, req, grpcclient.CompressionCallOptions(ctx)...)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -718,6 +742,66 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 	}
 }
 
+// StreamSchemaChanges streams the changes to the schema of the tablet.
+func (conn *gRPCQueryClient) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	stream, err := func() (queryservicepb.Query_StreamSchemaChangesClient, error) {
+		conn.mu.RLock()
+		defer conn.mu.RUnlock()
+		if conn.cc == nil {
+			return nil, tabletconn.ConnClosed
+		}
+
+		req := &querypb.StreamSchemaChangesRequest{
+			Target:            target,
+			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		}
+		stream, err := conn.c.StreamSchemaChanges(ctx, req)
+		if err != nil {
+			return nil, tabletconn.ErrorFromGRPC(err)
+		}
+		return stream, nil
+	}()
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			return tabletconn.ErrorFromGRPC(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := callback(r); err != nil {
+			return err
+		}
+	}
+}
+
+// GetSchemaVersion returns the version of a table at a point in time.
+func (conn *gRPCQueryClient) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (*querypb.SchemaVersion, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, tabletconn.ConnClosed
+	}
+	req := &querypb.GetSchemaVersionRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		TableName:         tableName,
+		Time:              time,
+	}
+	reply, err := conn.c.GetSchemaVersion(ctx, req)
+	if err != nil {
+		return nil, tabletconn.ErrorFromGRPC(err)
+	}
+	return reply.Version, nil
+}
+
 // HandlePanic is a no-op.
 func (conn *gRPCQueryClient) HandlePanic(err *error) {
 }
@@ -290,6 +290,19 @@ func (client *Client) IgnoreHealthError(ctx context.Context, tablet *topodatapb.
 	return err
 }
 
+// ResizeTxPool is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ResizeTxPool(ctx context.Context, tablet *topodatapb.Tablet, size int64) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ResizeTxPool(ctx, &tabletmanagerdatapb.ResizeTxPoolRequest{
+		Size: size,
+	})
+	return err
+}
+
 // ReloadSchema is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	cc, c, err := client.dial(tablet)
@@ -344,6 +357,24 @@ func (client *Client) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet
 	}, nil
 }
 
+// ApplyDeclarativeSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ApplyDeclarativeSchema(ctx context.Context, tablet *topodatapb.Tablet, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.ApplyDeclarativeSchema(ctx, &tabletmanagerdatapb.ApplyDeclarativeSchemaRequest{
+		Sql:       desired,
+		AllowDrop: allowDrop,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Changes, nil
+}
+
 // LockTables is part of the tmclient.TabletManagerClient interface.
 func (client *Client) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	cc, c, err := client.dial(tablet)
@@ -368,6 +399,65 @@ func (client *Client) UnlockTables(ctx context.Context, tablet *topodatapb.Table
 	return err
 }
 
+// SubmitOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SubmitOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, sql, strategy string) (string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.SubmitOnlineDDL(ctx, &tabletmanagerdatapb.SubmitOnlineDDLRequest{
+		Sql:      sql,
+		Strategy: strategy,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Uuid, nil
+}
+
+// GetOnlineDDLMigrations is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetOnlineDDLMigrations(ctx context.Context, tablet *topodatapb.Tablet, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetOnlineDDLMigrations(ctx, &tabletmanagerdatapb.GetOnlineDDLMigrationsRequest{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Migrations, nil
+}
+
+// CancelOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CancelOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.CancelOnlineDDL(ctx, &tabletmanagerdatapb.CancelOnlineDDLRequest{
+		Uuid: uuid,
+	})
+	return err
+}
+
+// RetryOnlineDDL is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RetryOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.RetryOnlineDDL(ctx, &tabletmanagerdatapb.RetryOnlineDDLRequest{
+		Uuid: uuid,
+	})
+	return err
+}
+
 // ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
 	var c tabletmanagerservicepb.TabletManagerClient
@@ -600,6 +690,45 @@ func (client *Client) VReplicationWaitForPos(ctx context.Context, tablet *topoda
 	return nil
 }
 
+// VReplicationPause is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VReplicationPause(ctx context.Context, tablet *topodatapb.Tablet, id int) (string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.VReplicationPause(ctx, &tabletmanagerdatapb.VReplicationPauseRequest{Id: int64(id)})
+	if err != nil {
+		return "", err
+	}
+	return response.Position, nil
+}
+
+// VReplicationResume is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VReplicationResume(ctx context.Context, tablet *topodatapb.Tablet, id int) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.VReplicationResume(ctx, &tabletmanagerdatapb.VReplicationResumeRequest{Id: int64(id)})
+	return err
+}
+
+// VReplicationCopyProgress is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.VReplicationCopyProgress(ctx, &tabletmanagerdatapb.VReplicationCopyProgressRequest{Workflow: workflow})
+	if err != nil {
+		return nil, err
+	}
+	return response.Streams, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -787,7 +916,7 @@ func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -796,6 +925,7 @@ func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, con
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
 		Concurrency: int64(concurrency),
 		AllowMaster: bool(allowMaster),
+		Incremental: incremental,
 	})
 	if err != nil {
 		cc.Close()
@@ -822,13 +952,13 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	stream, err := c.RestoreFromBackup(ctx, req)
 	if err != nil {
 		cc.Close()
 		return nil, err
@@ -142,6 +142,13 @@ func (s *server) IgnoreHealthError(ctx context.Context, request *tabletmanagerda
 	return response, s.agent.IgnoreHealthError(ctx, request.Pattern)
 }
 
+func (s *server) ResizeTxPool(ctx context.Context, request *tabletmanagerdatapb.ResizeTxPoolRequest) (response *tabletmanagerdatapb.ResizeTxPoolResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ResizeTxPool", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ResizeTxPoolResponse{}
+	return response, s.agent.ResizeTxPool(ctx, request.Size)
+}
+
 func (s *server) ReloadSchema(ctx context.Context, request *tabletmanagerdatapb.ReloadSchemaRequest) (response *tabletmanagerdatapb.ReloadSchemaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ReloadSchema", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -178,6 +185,17 @@ func (s *server) ApplySchema(ctx context.Context, request *tabletmanagerdatapb.A
 	return response, err
 }
 
+func (s *server) ApplyDeclarativeSchema(ctx context.Context, request *tabletmanagerdatapb.ApplyDeclarativeSchemaRequest) (response *tabletmanagerdatapb.ApplyDeclarativeSchemaResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ApplyDeclarativeSchema", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ApplyDeclarativeSchemaResponse{}
+	changes, err := s.agent.ApplyDeclarativeSchema(ctx, request.Sql, request.AllowDrop, request.DryRun)
+	if err == nil {
+		response.Changes = changes
+	}
+	return response, err
+}
+
 func (s *server) LockTables(ctx context.Context, req *tabletmanagerdatapb.LockTablesRequest) (*tabletmanagerdatapb.LockTablesResponse, error) {
 	err := s.agent.LockTables(ctx)
 	if err != nil {
@@ -194,6 +212,42 @@ func (s *server) UnlockTables(ctx context.Context, req *tabletmanagerdatapb.Unlo
 	return &tabletmanagerdatapb.UnlockTablesResponse{}, nil
 }
 
+func (s *server) SubmitOnlineDDL(ctx context.Context, request *tabletmanagerdatapb.SubmitOnlineDDLRequest) (response *tabletmanagerdatapb.SubmitOnlineDDLResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SubmitOnlineDDL", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SubmitOnlineDDLResponse{}
+	uuid, err := s.agent.SubmitOnlineDDL(ctx, request.Sql, request.Strategy)
+	if err == nil {
+		response.Uuid = uuid
+	}
+	return response, err
+}
+
+func (s *server) GetOnlineDDLMigrations(ctx context.Context, request *tabletmanagerdatapb.GetOnlineDDLMigrationsRequest) (response *tabletmanagerdatapb.GetOnlineDDLMigrationsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetOnlineDDLMigrations", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetOnlineDDLMigrationsResponse{}
+	migrations, err := s.agent.GetOnlineDDLMigrations(ctx, request.Uuid)
+	if err == nil {
+		response.Migrations = migrations
+	}
+	return response, err
+}
+
+func (s *server) CancelOnlineDDL(ctx context.Context, request *tabletmanagerdatapb.CancelOnlineDDLRequest) (response *tabletmanagerdatapb.CancelOnlineDDLResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CancelOnlineDDL", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CancelOnlineDDLResponse{}
+	return response, s.agent.CancelOnlineDDL(ctx, request.Uuid)
+}
+
+func (s *server) RetryOnlineDDL(ctx context.Context, request *tabletmanagerdatapb.RetryOnlineDDLRequest) (response *tabletmanagerdatapb.RetryOnlineDDLResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "RetryOnlineDDL", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RetryOnlineDDLResponse{}
+	return response, s.agent.RetryOnlineDDL(ctx, request.Uuid)
+}
+
 func (s *server) ExecuteFetchAsDba(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (response *tabletmanagerdatapb.ExecuteFetchAsDbaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchAsDba", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -332,6 +386,29 @@ func (s *server) VReplicationWaitForPos(ctx context.Context, request *tabletmana
 	return &tabletmanagerdatapb.VReplicationWaitForPosResponse{}, err
 }
 
+func (s *server) VReplicationPause(ctx context.Context, request *tabletmanagerdatapb.VReplicationPauseRequest) (response *tabletmanagerdatapb.VReplicationPauseResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "VReplicationPause", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.VReplicationPauseResponse{}
+	response.Position, err = s.agent.VReplicationPause(ctx, int(request.Id))
+	return response, err
+}
+
+func (s *server) VReplicationResume(ctx context.Context, request *tabletmanagerdatapb.VReplicationResumeRequest) (response *tabletmanagerdatapb.VReplicationResumeResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "VReplicationResume", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.VReplicationResumeResponse{}
+	return response, s.agent.VReplicationResume(ctx, int(request.Id))
+}
+
+func (s *server) VReplicationCopyProgress(ctx context.Context, request *tabletmanagerdatapb.VReplicationCopyProgressRequest) (response *tabletmanagerdatapb.VReplicationCopyProgressResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "VReplicationCopyProgress", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.VReplicationCopyProgressResponse{}
+	response.Streams, err = s.agent.VReplicationCopyProgress(ctx, request.Workflow)
+	return response, err
+}
+
 //
 // Reparenting related functions
 //
@@ -456,7 +533,7 @@ func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream table
 		})
 	})
 
-	return s.agent.Backup(ctx, int(request.Concurrency), logger, bool(request.AllowMaster))
+	return s.agent.Backup(ctx, int(request.Concurrency), logger, bool(request.AllowMaster), request.Incremental)
 }
 
 func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBackupRequest, stream tabletmanagerservicepb.TabletManager_RestoreFromBackupServer) (err error) {
@@ -474,7 +551,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.agent.RestoreFromBackup(ctx, logger)
+	return s.agent.RestoreFromBackup(ctx, logger, request)
 }
 
 // registration glue
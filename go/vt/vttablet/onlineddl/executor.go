@@ -0,0 +1,521 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package onlineddl runs ALTER TABLE statements with gh-ost or
+pt-online-schema-change under the control of the tablet.
+
+Migrations are submitted to the Executor of the master tablet. They
+run one at a time, in the order in which they were submitted. The
+tools are told to throttle themselves whenever the replication lag
+exceeds -online_ddl_max_replication_lag. The Executor parses the
+output of the tools to report the progress of the running migration.
+A migration can be cancelled while it's queued or running, and it can
+be retried after it failed or was cancelled.
+
+The migrations are kept in the _vt.schema_migrations table, so they
+survive a restart of the tablet: the queued migrations run once the
+tablet is the master again. A migration which was running when the
+tablet stopped is failed, and can be retried.
+
+The credentials are given to the tools in a temporary defaults file,
+never on their command line. gh-ost cannot connect through a unix
+socket, so it requires the dba connection to have a host and a port.
+gh-ost runs on the master, so it's given the replicas of the shard to
+check their lag. pt-online-schema-change finds the replicas itself.
+*/
+package onlineddl
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gouuid "github.com/pborman/uuid"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	ghostPath         = flag.String("online_ddl_gh_ost_path", "gh-ost", "path of the gh-ost binary used by online DDL")
+	ptOSCPath         = flag.String("online_ddl_pt_osc_path", "pt-online-schema-change", "path of the pt-online-schema-change binary used by online DDL")
+	maxReplicationLag = flag.Duration("online_ddl_max_replication_lag", 1500*time.Millisecond, "online DDL migrations are throttled while the replication lag is above this value")
+)
+
+// alterRegexp extracts the optional schema, the table and the alter
+// options of an ALTER TABLE.
+var alterRegexp = regexp.MustCompile("(?is)^\\s*alter\\s+table\\s+(?:`?([^\\s.`]+)`?\\.)?`?([^\\s`]+)`?\\s+(.+?)\\s*;?\\s*$")
+
+// ReplicasFunc returns the host:port of the MySQL servers which
+// replicate from the tablet.
+type ReplicasFunc func(ctx context.Context) ([]string, error)
+
+// Executor runs the online DDL migrations of a tablet.
+type Executor struct {
+	cp       dbconfigs.Connector
+	store    store
+	replicas ReplicasFunc
+
+	// command creates the process of a tool. It's changed by tests.
+	command func(ctx context.Context, name string, args ...string) *exec.Cmd
+
+	// saveMu serializes the saves of the migrations, which are
+	// done without holding mu.
+	saveMu sync.Mutex
+
+	// mu protects the following fields.
+	mu         sync.Mutex
+	isOpen     bool
+	migrations map[string]*Migration
+	// order lists the uuids of the migrations in submission order.
+	order []string
+	// running is the uuid of the running migration, if any.
+	running string
+	// cancel cancels the running migration.
+	cancel context.CancelFunc
+	// unsaved lists the uuids of the migrations changed since
+	// they were last saved.
+	unsaved map[string]bool
+	// wg tracks the goroutine which runs the migrations.
+	wg sync.WaitGroup
+}
+
+// NewExecutor creates a new Executor. The tools connect to MySQL
+// with the parameters of cp, which also stores the migrations.
+// replicas can be nil, in which case gh-ost only checks the lag of
+// the master.
+func NewExecutor(cp dbconfigs.Connector, replicas ReplicasFunc) *Executor {
+	return &Executor{
+		cp: cp,
+		store: &dbStore{dbClientFactory: func() binlogplayer.DBClient {
+			return binlogplayer.NewDBClient(cp)
+		}},
+		replicas:   replicas,
+		command:    exec.CommandContext,
+		migrations: make(map[string]*Migration),
+		unsaved:    make(map[string]bool),
+	}
+}
+
+// Open loads the stored migrations, and allows migrations to be
+// submitted and run.
+func (e *Executor) Open() error {
+	defer e.flush()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.isOpen {
+		return nil
+	}
+	migrations, err := e.store.load()
+	if err != nil {
+		return vterrors.Wrap(err, "could not load the online DDL migrations")
+	}
+	e.migrations = make(map[string]*Migration, len(migrations))
+	e.order = make([]string, 0, len(migrations))
+	e.unsaved = make(map[string]bool)
+	for _, m := range migrations {
+		e.migrations[m.UUID] = m
+		e.order = append(e.order, m.UUID)
+		if m.Status == StatusRunning {
+			// The tablet stopped while the tool was running.
+			m.Status = StatusFailed
+			m.Message = "the tablet stopped while the migration was running"
+			m.CompletedAt = time.Now()
+			e.saveLocked(m)
+		}
+	}
+	e.isOpen = true
+	e.runNextLocked()
+	return nil
+}
+
+// Close stops the running migration, if any. It can be re-opened
+// after Close. The stopped migration is marked as cancelled.
+func (e *Executor) Close() {
+	e.mu.Lock()
+	if !e.isOpen {
+		e.mu.Unlock()
+		return
+	}
+	e.isOpen = false
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.mu.Unlock()
+	e.wg.Wait()
+	e.flush()
+}
+
+// Submit queues a migration for the ALTER TABLE statement sql,
+// and returns its uuid.
+func (e *Executor) Submit(sql string, strategy Strategy) (string, error) {
+	if strategy != StrategyGhost && strategy != StrategyPTOSC {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported online DDL strategy: %s", strategy)
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	if ddl, ok := stmt.(*sqlparser.DDL); !ok || ddl.Action != sqlparser.AlterStr {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "online DDL only supports ALTER TABLE: %s", sql)
+	}
+	match := alterRegexp.FindStringSubmatch(sql)
+	if match == nil {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse ALTER TABLE: %s", sql)
+	}
+
+	if !e.IsOpen() {
+		return "", vterrors.New(vtrpcpb.Code_UNAVAILABLE, "online DDL executor is not open")
+	}
+	m := &Migration{
+		UUID:     gouuid.New(),
+		Schema:   match[1],
+		Table:    match[2],
+		SQL:      sql,
+		Strategy: strategy,
+		Status:   StatusQueued,
+	}
+	// The migration is saved before anyone else can see it, so it
+	// doesn't need to go through flush. If the executor is closed
+	// meanwhile, the saved migration runs once it's open again.
+	if err := e.store.save(m); err != nil {
+		return "", vterrors.Wrap(err, "could not save the online DDL migration")
+	}
+
+	defer e.flush()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isOpen {
+		return m.UUID, nil
+	}
+	e.migrations[m.UUID] = m
+	e.order = append(e.order, m.UUID)
+	e.runNextLocked()
+	return m.UUID, nil
+}
+
+// IsOpen returns true if the executor is open.
+func (e *Executor) IsOpen() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.isOpen
+}
+
+// Migrations returns a copy of the migration with the given uuid,
+// or of all the migrations if uuid is empty.
+func (e *Executor) Migrations(uuid string) ([]*Migration, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if uuid != "" {
+		m, ok := e.migrations[uuid]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "online DDL migration not found: %s", uuid)
+		}
+		copied := *m
+		return []*Migration{&copied}, nil
+	}
+	migrations := make([]*Migration, 0, len(e.order))
+	for _, id := range e.order {
+		copied := *e.migrations[id]
+		migrations = append(migrations, &copied)
+	}
+	return migrations, nil
+}
+
+// Cancel cancels a queued or running migration.
+func (e *Executor) Cancel(uuid string) error {
+	defer e.flush()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	m, ok := e.migrations[uuid]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "online DDL migration not found: %s", uuid)
+	}
+	switch m.Status {
+	case StatusQueued:
+		m.Status = StatusCancelled
+		m.CompletedAt = time.Now()
+		e.saveLocked(m)
+	case StatusRunning:
+		// The runner marks the migration as cancelled
+		// once the tool exits.
+		e.cancel()
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "online DDL migration %s is %s", uuid, m.Status)
+	}
+	return nil
+}
+
+// Retry queues a failed or cancelled migration again.
+func (e *Executor) Retry(uuid string) error {
+	defer e.flush()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	m, ok := e.migrations[uuid]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "online DDL migration not found: %s", uuid)
+	}
+	if m.Status != StatusFailed && m.Status != StatusCancelled {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "online DDL migration %s is %s", uuid, m.Status)
+	}
+	m.Status = StatusQueued
+	m.Progress = 0
+	m.Message = ""
+	m.CompletedAt = time.Time{}
+	e.saveLocked(m)
+	// The migration keeps its place in the submission order.
+	e.runNextLocked()
+	return nil
+}
+
+// saveLocked marks the migration as changed. It must be called with
+// mu held, and followed by a call to flush once mu is released.
+func (e *Executor) saveLocked(m *Migration) {
+	e.unsaved[m.UUID] = true
+}
+
+// flush stores the changed migrations. It must be called without
+// holding mu. The saves are serialized, and each one stores the
+// latest state of its migration, so the store never goes back to an
+// older state. Failures are only logged: the migration keeps its
+// state in memory, and it's saved again by the next flush.
+func (e *Executor) flush() {
+	e.saveMu.Lock()
+	defer e.saveMu.Unlock()
+
+	e.mu.Lock()
+	migrations := make([]Migration, 0, len(e.unsaved))
+	for uuid := range e.unsaved {
+		if m, ok := e.migrations[uuid]; ok {
+			migrations = append(migrations, *m)
+		}
+	}
+	e.unsaved = make(map[string]bool)
+	e.mu.Unlock()
+
+	for i := range migrations {
+		m := &migrations[i]
+		if err := e.store.save(m); err != nil {
+			log.Errorf("Could not save online DDL migration %s: %v", m.UUID, err)
+			e.mu.Lock()
+			e.unsaved[m.UUID] = true
+			e.mu.Unlock()
+		}
+	}
+}
+
+// runNextLocked starts the next queued migration, unless one is
+// already running. It must be called with mu held.
+func (e *Executor) runNextLocked() {
+	if !e.isOpen || e.running != "" {
+		return
+	}
+	var next *Migration
+	for _, id := range e.order {
+		if m := e.migrations[id]; m.Status == StatusQueued {
+			next = m
+			break
+		}
+	}
+	if next == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e.running = next.UUID
+	e.cancel = cancel
+	next.Status = StatusRunning
+	next.Attempts++
+	next.StartedAt = time.Now()
+	e.saveLocked(next)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		err := e.run(ctx, next.UUID)
+
+		defer e.flush()
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.running = ""
+		e.cancel = nil
+		next.CompletedAt = time.Now()
+		switch {
+		case err == nil:
+			next.Status = StatusComplete
+			next.Progress = 100
+		case ctx.Err() != nil:
+			next.Status = StatusCancelled
+		default:
+			next.Status = StatusFailed
+			next.Message = err.Error()
+		}
+		e.saveLocked(next)
+		cancel()
+		log.Infof("Online DDL migration %s (%s) on %s: %s", next.UUID, next.Strategy, next.Table, next.Status)
+		e.runNextLocked()
+	}()
+}
+
+// run runs the tool of a migration until it exits.
+func (e *Executor) run(ctx context.Context, uuid string) error {
+	e.mu.Lock()
+	m := *e.migrations[uuid]
+	e.mu.Unlock()
+
+	params, err := e.cp.MysqlParams()
+	if err != nil {
+		return err
+	}
+	defaultsFile, err := writeDefaultsFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(defaultsFile)
+	var replicas []string
+	if e.replicas != nil {
+		if replicas, err = e.replicas(ctx); err != nil {
+			return vterrors.Wrap(err, "could not find the replicas to throttle on")
+		}
+	}
+	name, args, err := toolCommand(&m, params, defaultsFile, replicas)
+	if err != nil {
+		return err
+	}
+	cmd := e.command(ctx, name, args...)
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	// The tools write their progress to either stream.
+	cmd.Stderr = cmd.Stdout
+	log.Infof("Starting online DDL migration %s: %s", uuid, m.SQL)
+	if err := cmd.Start(); err != nil {
+		return vterrors.Wrapf(err, "could not start %s", name)
+	}
+	e.readProgress(uuid, out)
+	if err := cmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "%s failed", m.Strategy)
+	}
+	return nil
+}
+
+// readProgress updates the migration with the output of its tool
+// until the output is closed. The migration is saved whenever its
+// progress crosses a percent.
+func (e *Executor) readProgress(uuid string, out io.Reader) {
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		e.mu.Lock()
+		m := e.migrations[uuid]
+		before := math.Floor(m.Progress)
+		m.updateProgress(scanner.Text())
+		changed := math.Floor(m.Progress) != before
+		if changed {
+			e.saveLocked(m)
+		}
+		e.mu.Unlock()
+		if changed {
+			e.flush()
+		}
+	}
+}
+
+// writeDefaultsFile writes the credentials of params to a new option
+// file which only the tablet can read, and returns its path. Both tools
+// read the [client] group of the file.
+func writeDefaultsFile(params *mysql.ConnParams) (string, error) {
+	f, err := ioutil.TempFile("", "online-ddl-*.cnf")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	// The values are quoted: both MySQL and gh-ost unescape \\ and \".
+	if _, err := fmt.Fprintf(f, "[client]\nuser=%s\npassword=%s\n", strconv.Quote(params.Uname), strconv.Quote(params.Pass)); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// toolCommand returns the binary and the arguments which run the
+// migration m against the database of params. The credentials are
+// read from defaultsFile. gh-ost throttles on the lag of replicas,
+// the host:port of the replicas of the master.
+func toolCommand(m *Migration, params *mysql.ConnParams, defaultsFile string, replicas []string) (string, []string, error) {
+	alter := alterRegexp.FindStringSubmatch(m.SQL)[3]
+	database := params.DbName
+	if m.Schema != "" {
+		database = m.Schema
+	}
+	switch m.Strategy {
+	case StrategyPTOSC:
+		dsn := fmt.Sprintf("F=%s,D=%s,t=%s", defaultsFile, database, m.Table)
+		if params.UnixSocket != "" {
+			dsn += ",S=" + params.UnixSocket
+		} else {
+			dsn += fmt.Sprintf(",h=%s,P=%d", params.Host, params.Port)
+		}
+		return *ptOSCPath, []string{
+			"--alter=" + alter,
+			"--max-lag=" + strconv.FormatFloat(maxReplicationLag.Seconds(), 'f', -1, 64),
+			"--progress=percentage,1",
+			"--execute",
+			dsn,
+		}, nil
+	default:
+		if params.Host == "" {
+			return "", nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "gh-ost cannot connect through the unix socket %s, the dba connection needs a host and a port", params.UnixSocket)
+		}
+		args := []string{
+			"--conf=" + defaultsFile,
+			"--host=" + params.Host,
+			fmt.Sprintf("--port=%d", params.Port),
+			"--database=" + database,
+			"--table=" + m.Table,
+			"--alter=" + alter,
+			fmt.Sprintf("--max-lag-millis=%d", maxReplicationLag.Nanoseconds()/1e6),
+		}
+		if len(replicas) > 0 {
+			args = append(args, "--throttle-control-replicas="+strings.Join(replicas, ","))
+		}
+		return *ghostPath, append(args,
+			"--allow-on-master",
+			"--exact-rowcount",
+			"--initially-drop-ghost-table",
+			"--initially-drop-old-table",
+			"--ok-to-drop-table",
+			"--execute",
+		), nil
+	}
+}
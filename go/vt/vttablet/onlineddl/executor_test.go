@@ -0,0 +1,355 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+)
+
+// memStore keeps copies of the saved migrations in memory.
+type memStore struct {
+	mu         sync.Mutex
+	migrations []*Migration
+}
+
+func (s *memStore) load() ([]*Migration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	migrations := make([]*Migration, 0, len(s.migrations))
+	for _, m := range s.migrations {
+		copied := *m
+		migrations = append(migrations, &copied)
+	}
+	return migrations, nil
+}
+
+func (s *memStore) save(m *Migration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *m
+	for i, saved := range s.migrations {
+		if saved.UUID == m.UUID {
+			s.migrations[i] = &copied
+			return nil
+		}
+	}
+	s.migrations = append(s.migrations, &copied)
+	return nil
+}
+
+// newTestExecutor returns an open Executor which runs the
+// shell script of the test instead of the tools.
+func newTestExecutor(script string, store store) *Executor {
+	e := NewExecutor(dbconfigs.New(&mysql.ConnParams{DbName: "db", Host: "localhost", Port: 3306}), nil)
+	e.store = store
+	e.command = func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		return exec.CommandContext(ctx, "sh", "-c", script)
+	}
+	if err := e.Open(); err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// waitForStatus waits until the migration has the given status.
+func waitForStatus(t *testing.T, e *Executor, uuid string, status Status) *Migration {
+	t.Helper()
+	for i := 0; i < 500; i++ {
+		migrations, err := e.Migrations(uuid)
+		require.NoError(t, err)
+		if migrations[0].Status == status {
+			return migrations[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	migrations, _ := e.Migrations(uuid)
+	t.Fatalf("migration %s did not reach status %s: %+v", uuid, status, migrations[0])
+	return nil
+}
+
+func TestExecutorSubmitErrors(t *testing.T) {
+	e := newTestExecutor("true", &memStore{})
+	defer e.Close()
+
+	_, err := e.Submit("alter table t add column c int", "osc")
+	assert.EqualError(t, err, "unsupported online DDL strategy: osc")
+
+	_, err = e.Submit("create table t(id int)", StrategyGhost)
+	assert.EqualError(t, err, "online DDL only supports ALTER TABLE: create table t(id int)")
+
+	e.Close()
+	_, err = e.Submit("alter table t add column c int", StrategyGhost)
+	assert.EqualError(t, err, "online DDL executor is not open")
+}
+
+func TestExecutorComplete(t *testing.T) {
+	e := newTestExecutor("echo 'Copy: 5/10 50.0%; Applied: 0'; echo done", &memStore{})
+	defer e.Close()
+
+	uuid, err := e.Submit("alter table `ks`.`t1` add column c int", StrategyGhost)
+	require.NoError(t, err)
+	m := waitForStatus(t, e, uuid, StatusComplete)
+	assert.Equal(t, "ks", m.Schema)
+	assert.Equal(t, "t1", m.Table)
+	assert.Equal(t, float64(100), m.Progress)
+	assert.Equal(t, "done", m.Message)
+	assert.Equal(t, 1, m.Attempts)
+	assert.False(t, m.CompletedAt.IsZero())
+}
+
+func TestExecutorFailAndRetry(t *testing.T) {
+	e := newTestExecutor("echo 'Copying `db`.`t1`:  20% 00:30 remain'; exit 1", &memStore{})
+	defer e.Close()
+
+	uuid, err := e.Submit("alter table t1 add column c int", StrategyPTOSC)
+	require.NoError(t, err)
+	m := waitForStatus(t, e, uuid, StatusFailed)
+	assert.Equal(t, float64(20), m.Progress)
+	assert.Contains(t, m.Message, "pt-osc failed")
+
+	err = e.Retry(uuid)
+	require.NoError(t, err)
+	m = waitForStatus(t, e, uuid, StatusFailed)
+	assert.Equal(t, 2, m.Attempts)
+
+	err = e.Retry("unknown")
+	assert.EqualError(t, err, "online DDL migration not found: unknown")
+}
+
+func TestExecutorCancel(t *testing.T) {
+	e := newTestExecutor("echo 'Copy: 1/10 10.0%'; exec sleep 10", &memStore{})
+	defer e.Close()
+
+	running, err := e.Submit("alter table t1 add column c int", StrategyGhost)
+	require.NoError(t, err)
+	queued, err := e.Submit("alter table t2 add column c int", StrategyGhost)
+	require.NoError(t, err)
+	waitForStatus(t, e, running, StatusRunning)
+
+	// Migrations run one at a time.
+	m := waitForStatus(t, e, queued, StatusQueued)
+	assert.Equal(t, 0, m.Attempts)
+	err = e.Cancel(queued)
+	require.NoError(t, err)
+	waitForStatus(t, e, queued, StatusCancelled)
+
+	err = e.Cancel(running)
+	require.NoError(t, err)
+	waitForStatus(t, e, running, StatusCancelled)
+
+	err = e.Cancel(running)
+	assert.EqualError(t, err, "online DDL migration "+running+" is cancelled")
+
+	migrations, err := e.Migrations("")
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, running, migrations[0].UUID)
+	assert.Equal(t, queued, migrations[1].UUID)
+}
+
+func TestExecutorRestart(t *testing.T) {
+	store := &memStore{}
+	e := newTestExecutor("exec sleep 10", store)
+	running, err := e.Submit("alter table t1 add column c int", StrategyGhost)
+	require.NoError(t, err)
+	queued, err := e.Submit("alter table t2 add column c int", StrategyGhost)
+	require.NoError(t, err)
+	waitForStatus(t, e, running, StatusRunning)
+
+	// The tablet stops before the tool exits.
+	e.mu.Lock()
+	e.isOpen = false
+	e.cancel()
+	e.mu.Unlock()
+	e.wg.Wait()
+	store.mu.Lock()
+	store.migrations[0].Status = StatusRunning
+	store.mu.Unlock()
+
+	e = newTestExecutor("true", store)
+	defer e.Close()
+	m := waitForStatus(t, e, running, StatusFailed)
+	assert.Equal(t, "the tablet stopped while the migration was running", m.Message)
+	waitForStatus(t, e, queued, StatusComplete)
+	migrations, err := store.load()
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, migrations[0].Status)
+	assert.Equal(t, StatusComplete, migrations[1].Status)
+}
+
+// blockingStore blocks the saves of a memStore while blocked is set.
+type blockingStore struct {
+	memStore
+	blocked  chan struct{}
+	released chan struct{}
+}
+
+func (s *blockingStore) save(m *Migration) error {
+	select {
+	case <-s.blocked:
+		<-s.released
+	default:
+	}
+	return s.memStore.save(m)
+}
+
+func TestExecutorSaveWithoutLock(t *testing.T) {
+	store := &blockingStore{blocked: make(chan struct{}), released: make(chan struct{})}
+	e := newTestExecutor("exec sleep 10", store)
+	defer e.Close()
+	uuid, err := e.Submit("alter table t1 add column c int", StrategyGhost)
+	require.NoError(t, err)
+	waitForStatus(t, e, uuid, StatusRunning)
+
+	// A slow save doesn't block the executor.
+	close(store.blocked)
+	done := make(chan error)
+	go func() {
+		done <- e.Cancel(uuid)
+	}()
+	waitForStatus(t, e, uuid, StatusCancelled)
+	migrations, err := store.load()
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, migrations[0].Status)
+
+	close(store.released)
+	require.NoError(t, <-done)
+	e.Close()
+	migrations, err = store.load()
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, migrations[0].Status)
+}
+
+func TestDBStore(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	s := &dbStore{dbClientFactory: func() binlogplayer.DBClient { return dbClient }}
+
+	started := time.Unix(0, 1500000000000000000)
+	m := &Migration{
+		UUID:      "u1",
+		Schema:    "ks",
+		Table:     "t1",
+		SQL:       "alter table ks.t1 add column c int",
+		Strategy:  StrategyGhost,
+		Status:    StatusRunning,
+		Progress:  12.5,
+		Message:   "it's copying",
+		Attempts:  1,
+		StartedAt: started,
+	}
+	dbClient.ExpectRequest("insert into _vt.schema_migrations "+
+		"(migration_uuid, migration_schema, migration_table, migration_sql, strategy, status, progress, message, attempts, started_at, completed_at) "+
+		"values ('u1', 'ks', 't1', 'alter table ks.t1 add column c int', 'gh-ost', 'running', 12.5, 'it\\'s copying', 1, 1500000000000000000, 0) "+
+		"on duplicate key update status=values(status), progress=values(progress), message=values(message), attempts=values(attempts), started_at=values(started_at), completed_at=values(completed_at)",
+		&sqltypes.Result{}, nil)
+	require.NoError(t, s.save(m))
+
+	for _, query := range createSchemaMigrationsTable {
+		dbClient.ExpectRequest(query, &sqltypes.Result{}, nil)
+	}
+	dbClient.ExpectRequest(selectSchemaMigrations, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|migration_schema|migration_table|migration_sql|strategy|status|progress|message|attempts|started_at|completed_at",
+			"varchar|varchar|varchar|varchar|varchar|varchar|float64|varchar|int64|int64|int64",
+		),
+		fmt.Sprintf("u1|ks|t1|alter table ks.t1 add column c int|gh-ost|running|12.5|it's copying|1|%d|0", started.UnixNano()),
+	), nil)
+	migrations, err := s.load()
+	require.NoError(t, err)
+	assert.Equal(t, []*Migration{m}, migrations)
+}
+
+func TestToolCommand(t *testing.T) {
+	params := &mysql.ConnParams{
+		Host:   "localhost",
+		Port:   3306,
+		Uname:  "vt_dba",
+		Pass:   "secret",
+		DbName: "vt_db",
+	}
+	m := &Migration{
+		Schema:   "vt_other",
+		Table:    "t1",
+		SQL:      "ALTER TABLE vt_other.t1 ADD COLUMN c int, ADD KEY c_idx (c)",
+		Strategy: StrategyGhost,
+	}
+	name, args, err := toolCommand(m, params, "/tmp/online-ddl.cnf", []string{"replica1:3306", "replica2:3306"})
+	require.NoError(t, err)
+	assert.Equal(t, "gh-ost", name)
+	assert.Equal(t, []string{
+		"--conf=/tmp/online-ddl.cnf",
+		"--host=localhost",
+		"--port=3306",
+		"--database=vt_other",
+		"--table=t1",
+		"--alter=ADD COLUMN c int, ADD KEY c_idx (c)",
+		"--max-lag-millis=1500",
+		"--throttle-control-replicas=replica1:3306,replica2:3306",
+		"--allow-on-master",
+		"--exact-rowcount",
+		"--initially-drop-ghost-table",
+		"--initially-drop-old-table",
+		"--ok-to-drop-table",
+		"--execute",
+	}, args)
+
+	m.Schema = ""
+	m.Strategy = StrategyPTOSC
+	params.UnixSocket = "/tmp/mysql.sock"
+	name, args, err = toolCommand(m, params, "/tmp/online-ddl.cnf", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "pt-online-schema-change", name)
+	assert.Equal(t, []string{
+		"--alter=ADD COLUMN c int, ADD KEY c_idx (c)",
+		"--max-lag=1.5",
+		"--progress=percentage,1",
+		"--execute",
+		"F=/tmp/online-ddl.cnf,D=vt_db,t=t1,S=/tmp/mysql.sock",
+	}, args)
+
+	m.Strategy = StrategyGhost
+	params.Host = ""
+	_, _, err = toolCommand(m, params, "/tmp/online-ddl.cnf", nil)
+	assert.EqualError(t, err, "gh-ost cannot connect through the unix socket /tmp/mysql.sock, the dba connection needs a host and a port")
+}
+
+func TestWriteDefaultsFile(t *testing.T) {
+	name, err := writeDefaultsFile(&mysql.ConnParams{Uname: "vt_dba", Pass: `se"cr\et`})
+	require.NoError(t, err)
+	defer os.Remove(name)
+	info, err := os.Stat(name)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	content, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, "[client]\nuser=\"vt_dba\"\npassword=\"se\\\"cr\\\\et\"\n", string(content))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// Strategy is the tool that runs a migration.
+type Strategy string
+
+// The following are the supported strategies.
+const (
+	StrategyGhost Strategy = "gh-ost"
+	StrategyPTOSC Strategy = "pt-osc"
+)
+
+// Status is the state of a migration.
+type Status string
+
+// The following are the states of a migration.
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusComplete  Status = "complete"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// progressRegexps match the progress lines of the tools. The first
+// submatch is the percentage of rows copied.
+var progressRegexps = map[Strategy]*regexp.Regexp{
+	// Copy: 51000/100000 51.0%; Applied: 0; Backlog: 0/1000; ...
+	StrategyGhost: regexp.MustCompile(`Copy: \d+/\d+ ([\d.]+)%`),
+	// Copying `db`.`t`:  51% 00:28 remain
+	StrategyPTOSC: regexp.MustCompile("Copying `.*`:\\s+([\\d.]+)%"),
+}
+
+// Migration is an ALTER TABLE which is run by one of the tools.
+type Migration struct {
+	UUID string
+	// Schema is the qualifier of the table in the statement. The
+	// database of the tablet is used if it's empty.
+	Schema   string
+	Table    string
+	SQL      string
+	Strategy Strategy
+
+	Status   Status
+	Progress float64
+	// Message is the error of a failed migration, or the last
+	// line of output of the tool.
+	Message     string
+	Attempts    int
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// updateProgress updates the progress of the migration from a line
+// of output of its tool.
+func (m *Migration) updateProgress(line string) {
+	if line == "" {
+		return
+	}
+	m.Message = line
+	match := progressRegexps[m.Strategy].FindStringSubmatch(line)
+	if match == nil {
+		return
+	}
+	if progress, err := strconv.ParseFloat(match[1], 64); err == nil {
+		m.Progress = progress
+	}
+}
+
+// ToProto converts the migration to its proto representation.
+func (m *Migration) ToProto() *tabletmanagerdatapb.OnlineDDLMigration {
+	pb := &tabletmanagerdatapb.OnlineDDLMigration{
+		Uuid:     m.UUID,
+		Schema:   m.Schema,
+		Table:    m.Table,
+		Sql:      m.SQL,
+		Strategy: string(m.Strategy),
+		Status:   string(m.Status),
+		Progress: m.Progress,
+		Message:  m.Message,
+		Attempts: int32(m.Attempts),
+	}
+	if !m.StartedAt.IsZero() {
+		pb.StartedAt = m.StartedAt.Unix()
+	}
+	if !m.CompletedAt.IsZero() {
+		pb.CompletedAt = m.CompletedAt.Unix()
+	}
+	return pb
+}
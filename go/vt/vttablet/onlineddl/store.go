@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+)
+
+// store persists the migrations of an Executor.
+type store interface {
+	// load returns the migrations in submission order.
+	load() ([]*Migration, error)
+	// save inserts or updates the migration.
+	save(m *Migration) error
+}
+
+// createSchemaMigrationsTable lists the statements which create the
+// _vt.schema_migrations table.
+// id: the auto-increment column which keeps the submission order.
+// started_at and completed_at: nanoseconds since the epoch, 0 if unset.
+var createSchemaMigrationsTable = []string{
+	"CREATE DATABASE IF NOT EXISTS _vt",
+	`CREATE TABLE IF NOT EXISTS _vt.schema_migrations (
+  id BIGINT(20) AUTO_INCREMENT,
+  migration_uuid VARBINARY(64) NOT NULL,
+  migration_schema VARBINARY(255) NOT NULL,
+  migration_table VARBINARY(255) NOT NULL,
+  migration_sql BLOB NOT NULL,
+  strategy VARBINARY(32) NOT NULL,
+  status VARBINARY(32) NOT NULL,
+  progress DOUBLE NOT NULL,
+  message BLOB NOT NULL,
+  attempts INT NOT NULL,
+  started_at BIGINT(20) NOT NULL,
+  completed_at BIGINT(20) NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY migration_uuid_idx (migration_uuid)
+) ENGINE=InnoDB`,
+}
+
+const selectSchemaMigrations = "select migration_uuid, migration_schema, migration_table, migration_sql, strategy, status, progress, message, attempts, started_at, completed_at from _vt.schema_migrations order by id"
+
+// dbStore keeps the migrations in the _vt.schema_migrations table.
+type dbStore struct {
+	dbClientFactory func() binlogplayer.DBClient
+}
+
+func (s *dbStore) load() ([]*Migration, error) {
+	dbClient := s.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	for _, query := range createSchemaMigrationsTable {
+		if _, err := dbClient.ExecuteFetch(query, 0); err != nil {
+			return nil, fmt.Errorf("could not create the schema migrations table: %v", err)
+		}
+	}
+	qr, err := dbClient.ExecuteFetch(selectSchemaMigrations, 100000)
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]*Migration, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		m := &Migration{
+			UUID:     row[0].ToString(),
+			Schema:   row[1].ToString(),
+			Table:    row[2].ToString(),
+			SQL:      row[3].ToString(),
+			Strategy: Strategy(row[4].ToString()),
+			Status:   Status(row[5].ToString()),
+			Message:  row[7].ToString(),
+		}
+		if m.Progress, err = sqltypes.ToFloat64(row[6]); err != nil {
+			return nil, err
+		}
+		attempts, err := sqltypes.ToInt64(row[8])
+		if err != nil {
+			return nil, err
+		}
+		m.Attempts = int(attempts)
+		if m.StartedAt, err = toTime(row[9]); err != nil {
+			return nil, err
+		}
+		if m.CompletedAt, err = toTime(row[10]); err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+func (s *dbStore) save(m *Migration) error {
+	dbClient := s.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	query := fmt.Sprintf("insert into _vt.schema_migrations "+
+		"(migration_uuid, migration_schema, migration_table, migration_sql, strategy, status, progress, message, attempts, started_at, completed_at) "+
+		"values (%s, %s, %s, %s, %s, %s, %v, %s, %d, %d, %d) "+
+		"on duplicate key update status=values(status), progress=values(progress), message=values(message), attempts=values(attempts), started_at=values(started_at), completed_at=values(completed_at)",
+		encodeString(m.UUID), encodeString(m.Schema), encodeString(m.Table), encodeString(m.SQL), encodeString(string(m.Strategy)),
+		encodeString(string(m.Status)), m.Progress, encodeString(m.Message), m.Attempts, fromTime(m.StartedAt), fromTime(m.CompletedAt))
+	_, err := dbClient.ExecuteFetch(query, 0)
+	return err
+}
+
+func toTime(v sqltypes.Value) (time.Time, error) {
+	nanos, err := sqltypes.ToInt64(v)
+	if err != nil || nanos == 0 {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos), nil
+}
+
+func fromTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func encodeString(in string) string {
+	buf := bytes.NewBuffer(nil)
+	sqltypes.NewVarChar(in).EncodeSQL(buf)
+	return buf.String()
+}
@@ -43,8 +43,9 @@ type QueryService interface {
 	// Begin returns the transaction id to use for further operations
 	Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, error)
 
-	// Commit commits the current transaction
-	Commit(ctx context.Context, target *querypb.Target, transactionID int64) error
+	// Commit commits the current transaction. It returns the GTIDs
+	// of the transaction, if MySQL tracks them.
+	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error)
 
 	// Rollback aborts the current transaction
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error
@@ -89,8 +90,9 @@ type QueryService interface {
 	BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error)
 
 	// Messaging methods.
-	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
-	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
+	MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) error
+	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error)
+	MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error)
 
 	// VStream streams VReplication events based on the specified filter.
 	VStream(ctx context.Context, target *querypb.Target, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
@@ -101,6 +103,14 @@ type QueryService interface {
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
 
+	// StreamSchemaChanges streams the tables that are added, changed or
+	// dropped from the schema. The first response lists all the tables.
+	StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error
+
+	// GetSchemaVersion returns the version of a table at the given
+	// unix time, or its latest version if time is 0.
+	GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (*querypb.SchemaVersion, error)
+
 	// StreamHealth streams health status.
 	StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error
 
@@ -55,6 +55,16 @@ func Wrap(impl QueryService, wrapper WrapperFunc) QueryService {
 	}
 }
 
+// retryableCodesKey is the context key for the retryable error codes.
+type retryableCodesKey struct{}
+
+// WithRetryableCodes returns a context which overrides the error codes
+// that are considered retryable on a different vttablet.
+// By default, only UNAVAILABLE and FAILED_PRECONDITION are retried.
+func WithRetryableCodes(ctx context.Context, codes map[vtrpcpb.Code]bool) context.Context {
+	return context.WithValue(ctx, retryableCodesKey{}, codes)
+}
+
 // canRetry returns true if the error is retryable on a different vttablet.
 // Nil error or a canceled context make it return
 // false. Otherwise, the error code determines the outcome.
@@ -69,7 +79,11 @@ func canRetry(ctx context.Context, err error) bool {
 	default:
 	}
 
-	switch vterrors.Code(err) {
+	code := vterrors.Code(err)
+	if codes, ok := ctx.Value(retryableCodesKey{}).(map[vtrpcpb.Code]bool); ok {
+		return codes[code]
+	}
+	switch code {
 	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_FAILED_PRECONDITION:
 		return true
 	}
@@ -92,11 +106,13 @@ func (ws *wrappedService) Begin(ctx context.Context, target *querypb.Target, opt
 	return transactionID, err
 }
 
-func (ws *wrappedService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return ws.wrapper(ctx, target, ws.impl, "Commit", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.Commit(ctx, target, transactionID)
+func (ws *wrappedService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (sessionStateChanges string, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "Commit", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		sessionStateChanges, innerErr = conn.Commit(ctx, target, transactionID)
 		return canRetry(ctx, innerErr), innerErr
 	})
+	return sessionStateChanges, err
 }
 
 func (ws *wrappedService) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error {
@@ -219,17 +235,26 @@ func (ws *wrappedService) BeginExecuteBatch(ctx context.Context, target *querypb
 	return qrs, transactionID, err
 }
 
-func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "MessageStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.MessageStream(ctx, target, name, callback)
+		innerErr := conn.MessageStream(ctx, target, name, resumeToken, callback)
 		return canRetry(ctx, innerErr), innerErr
 	})
 }
 
-func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
 	err = ws.wrapper(ctx, target, ws.impl, "MessageAck", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		var innerErr error
-		count, innerErr = conn.MessageAck(ctx, target, name, ids)
+		count, innerErr = conn.MessageAck(ctx, target, name, ids, updates)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return count, err
+}
+
+func (ws *wrappedService) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "MessagePostpone", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		count, innerErr = conn.MessagePostpone(ctx, target, name, ids, updates)
 		return canRetry(ctx, innerErr), innerErr
 	})
 	return count, err
@@ -256,6 +281,22 @@ func (ws *wrappedService) VStreamResults(ctx context.Context, target *querypb.Ta
 	})
 }
 
+func (ws *wrappedService) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	return ws.wrapper(ctx, target, ws.impl, "StreamSchemaChanges", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		innerErr := conn.StreamSchemaChanges(ctx, target, callback)
+		return false, innerErr
+	})
+}
+
+func (ws *wrappedService) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (version *querypb.SchemaVersion, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "GetSchemaVersion", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		version, innerErr = conn.GetSchemaVersion(ctx, target, tableName, time)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return version, err
+}
+
 func (ws *wrappedService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return ws.wrapper(ctx, nil, ws.impl, "StreamHealth", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.StreamHealth(ctx, callback)
@@ -84,8 +84,14 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// CommitGTIDs is returned by Commit as the GTIDs of the transaction.
+	CommitGTIDs string
+
 	MessageIDs []*querypb.Value
 
+	// ResumeToken stores the resume token of the last MessageStream.
+	ResumeToken string
+
 	// vstream expectations.
 	StartPos      string
 	VStreamEvents [][]*binlogdatapb.VEvent
@@ -187,9 +193,12 @@ func (sbc *SandboxConn) Begin(ctx context.Context, target *querypb.Target, optio
 }
 
 // Commit is part of the QueryService interface.
-func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	sbc.CommitCount.Add(1)
-	return sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return "", err
+	}
+	return sbc.CommitGTIDs, nil
 }
 
 // Rollback is part of the QueryService interface.
@@ -306,10 +315,11 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 }
 
 // MessageStream is part of the QueryService interface.
-func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
 		return err
 	}
+	sbc.ResumeToken = resumeToken
 	r := sbc.getNextResult()
 	if r == nil {
 		return nil
@@ -319,7 +329,13 @@ func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Targe
 }
 
 // MessageAck is part of the QueryService interface.
-func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
+	sbc.MessageIDs = ids
+	return int64(len(ids)), nil
+}
+
+// MessagePostpone is part of the QueryService interface.
+func (sbc *SandboxConn) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
 	sbc.MessageIDs = ids
 	return int64(len(ids)), nil
 }
@@ -375,6 +391,16 @@ func (sbc *SandboxConn) VStreamResults(ctx context.Context, target *querypb.Targ
 	return fmt.Errorf("not implemented in test")
 }
 
+// StreamSchemaChanges is part of the QueryService interface.
+func (sbc *SandboxConn) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	return fmt.Errorf("not implemented in test")
+}
+
+// GetSchemaVersion is part of the QueryService interface.
+func (sbc *SandboxConn) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (*querypb.SchemaVersion, error) {
+	return nil, fmt.Errorf("not implemented in test")
+}
+
 // HandlePanic is part of the QueryService interface.
 func (sbc *SandboxConn) HandlePanic(err *error) {
 }
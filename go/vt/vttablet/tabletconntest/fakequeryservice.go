@@ -143,10 +143,13 @@ func (f *FakeQueryService) Begin(ctx context.Context, target *querypb.Target, op
 // CommitTransactionID is a test transaction id for Commit.
 const CommitTransactionID int64 = 999044
 
+// CommitGTIDs is the test GTIDs returned by Commit.
+const CommitGTIDs = "3e11fa47-71ca-11e1-9e33-c80aa9429562:23"
+
 // Commit is part of the queryservice.QueryService interface
-func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	if f.HasError {
-		return f.TabletError
+		return "", f.TabletError
 	}
 	if f.Panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -155,7 +158,7 @@ func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, t
 	if transactionID != CommitTransactionID {
 		f.t.Errorf("Commit: invalid TransactionId: got %v expected %v", transactionID, CommitTransactionID)
 	}
-	return nil
+	return CommitGTIDs, nil
 }
 
 // RollbackTransactionID is a test transactin id for Rollback.
@@ -612,10 +615,21 @@ var (
 		Type:  sqltypes.VarChar,
 		Value: []byte("1"),
 	}}
+
+	// MessageResumeToken is a test resume token for message streams.
+	MessageResumeToken = "test_consumer"
+
+	// MessageUpdates is a test list of message column updates.
+	MessageUpdates = map[string]*querypb.Value{
+		"status": {
+			Type:  sqltypes.VarChar,
+			Value: []byte("done"),
+		},
+	}
 )
 
 // MessageStream is part of the queryservice.QueryService interface
-func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) (err error) {
 	if f.HasError {
 		return f.TabletError
 	}
@@ -625,12 +639,15 @@ func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Ta
 	if name != MessageName {
 		f.t.Errorf("name: %s, want %s", name, MessageName)
 	}
+	if resumeToken != MessageResumeToken {
+		f.t.Errorf("resumeToken: %s, want %s", resumeToken, MessageResumeToken)
+	}
 	callback(MessageStreamResult)
 	return nil
 }
 
 // MessageAck is part of the queryservice.QueryService interface
-func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
 	if f.HasError {
 		return 0, f.TabletError
 	}
@@ -643,9 +660,91 @@ func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Targe
 	if !sqltypes.Proto3ValuesEqual(ids, MessageIDs) {
 		f.t.Errorf("ids: %v, want %v", ids, MessageIDs)
 	}
+	if !proto.Equal(&querypb.MessageAckRequest{Updates: updates}, &querypb.MessageAckRequest{Updates: MessageUpdates}) {
+		f.t.Errorf("updates: %v, want %v", updates, MessageUpdates)
+	}
 	return 1, nil
 }
 
+// MessagePostpone is part of the queryservice.QueryService interface
+func (f *FakeQueryService) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
+	if f.HasError {
+		return 0, f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if name != MessageName {
+		f.t.Errorf("name: %s, want %s", name, MessageName)
+	}
+	if !sqltypes.Proto3ValuesEqual(ids, MessageIDs) {
+		f.t.Errorf("ids: %v, want %v", ids, MessageIDs)
+	}
+	if !proto.Equal(&querypb.MessagePostponeRequest{Updates: updates}, &querypb.MessagePostponeRequest{Updates: MessageUpdates}) {
+		f.t.Errorf("updates: %v, want %v", updates, MessageUpdates)
+	}
+	return 1, nil
+}
+
+// TestStreamSchemaChangesResponse is a test schema changes response.
+var TestStreamSchemaChangesResponse = &querypb.StreamSchemaChangesResponse{
+	Changes: []*querypb.SchemaTableChange{{
+		TableName:  "added_table",
+		ChangeType: querypb.SchemaTableChange_ADDED,
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}},
+		PkColumns: []string{"id"},
+	}, {
+		TableName:  "dropped_table",
+		ChangeType: querypb.SchemaTableChange_DROPPED,
+	}},
+}
+
+// StreamSchemaChanges is part of the queryservice.QueryService interface
+func (f *FakeQueryService) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	callback(TestStreamSchemaChangesResponse)
+	return nil
+}
+
+// SchemaVersionTable is the table name for GetSchemaVersion.
+const SchemaVersionTable = "versioned_table"
+
+// SchemaVersionTime is the time for GetSchemaVersion.
+const SchemaVersionTime = int64(1577836800)
+
+// TestSchemaVersion is a test schema version.
+var TestSchemaVersion = &querypb.SchemaVersion{
+	TableName:   SchemaVersionTable,
+	Ddl:         "CREATE TABLE `versioned_table` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+	TimeUpdated: 1577836000,
+	Position:    "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-42",
+}
+
+// GetSchemaVersion is part of the queryservice.QueryService interface
+func (f *FakeQueryService) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (*querypb.SchemaVersion, error) {
+	if f.HasError {
+		return nil, f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if tableName != SchemaVersionTable {
+		f.t.Errorf("tableName: %s, want %s", tableName, SchemaVersionTable)
+	}
+	if time != SchemaVersionTime {
+		f.t.Errorf("time: %d, want %d", time, SchemaVersionTime)
+	}
+	return TestSchemaVersion, nil
+}
+
 // TestStreamHealthStreamHealthResponse is a test stream health response.
 var TestStreamHealthStreamHealthResponse = &querypb.StreamHealthResponse{
 	Target: &querypb.Target{
@@ -129,17 +129,21 @@ func testCommit(t *testing.T, conn queryservice.QueryService, f *FakeQueryServic
 	t.Log("testCommit")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+	gtids, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
 	if err != nil {
 		t.Fatalf("Commit failed: %v", err)
 	}
+	if gtids != CommitGTIDs {
+		t.Errorf("Commit returned GTIDs %v, want %v", gtids, CommitGTIDs)
+	}
 }
 
 func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testCommitError")
 	f.HasError = true
 	testErrorHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 	f.HasError = false
 }
@@ -147,7 +151,8 @@ func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryS
 func testCommitPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testCommitPanics")
 	testPanicHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 }
 
@@ -663,7 +668,7 @@ func testMessageStream(t *testing.T, conn queryservice.QueryService, f *FakeQuer
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
 	var got *sqltypes.Result
-	err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error {
+	err := conn.MessageStream(ctx, TestTarget, MessageName, MessageResumeToken, func(qr *sqltypes.Result) error {
 		got = qr
 		return nil
 	})
@@ -680,7 +685,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 	f.HasError = true
 	testErrorHelper(t, f, "MessageStream", func(ctx context.Context) error {
 		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-		return conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		return conn.MessageStream(ctx, TestTarget, MessageName, MessageResumeToken, func(qr *sqltypes.Result) error { return nil })
 	})
 	f.HasError = false
 }
@@ -688,7 +693,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 func testMessageStreamPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testMessageStreamPanics")
 	testPanicHelper(t, f, "MessageStream", func(ctx context.Context) error {
-		err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		err := conn.MessageStream(ctx, TestTarget, MessageName, MessageResumeToken, func(qr *sqltypes.Result) error { return nil })
 		return err
 	})
 }
@@ -697,7 +702,7 @@ func testMessageAck(t *testing.T, conn queryservice.QueryService, f *FakeQuerySe
 	t.Log("testMessageAck")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	count, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+	count, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
 	if err != nil {
 		t.Fatalf("MessageAck failed: %v", err)
 	}
@@ -711,7 +716,7 @@ func testMessageAckError(t *testing.T, conn queryservice.QueryService, f *FakeQu
 	f.HasError = true
 	testErrorHelper(t, f, "MessageAck", func(ctx context.Context) error {
 		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
 		return err
 	})
 	f.HasError = false
@@ -720,7 +725,105 @@ func testMessageAckError(t *testing.T, conn queryservice.QueryService, f *FakeQu
 func testMessageAckPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testMessageAckPanics")
 	testPanicHelper(t, f, "MessageAck", func(ctx context.Context) error {
-		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
+		return err
+	})
+}
+
+func testMessagePostpone(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessagePostpone")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	count, err := conn.MessagePostpone(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
+	if err != nil {
+		t.Fatalf("MessagePostpone failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Unexpected result from MessagePostpone: got %v wanted 1", count)
+	}
+}
+
+func testMessagePostponeError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessagePostponeError")
+	f.HasError = true
+	testErrorHelper(t, f, "MessagePostpone", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		_, err := conn.MessagePostpone(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
+		return err
+	})
+	f.HasError = false
+}
+
+func testMessagePostponePanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessagePostponePanics")
+	testPanicHelper(t, f, "MessagePostpone", func(ctx context.Context) error {
+		_, err := conn.MessagePostpone(ctx, TestTarget, MessageName, MessageIDs, MessageUpdates)
+		return err
+	})
+}
+
+func testStreamSchemaChanges(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamSchemaChanges")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	var got *querypb.StreamSchemaChangesResponse
+	err := conn.StreamSchemaChanges(ctx, TestTarget, func(response *querypb.StreamSchemaChangesResponse) error {
+		got = response
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamSchemaChanges failed: %v", err)
+	}
+	if !proto.Equal(got, TestStreamSchemaChangesResponse) {
+		t.Errorf("Unexpected result from StreamSchemaChanges: got %v wanted %v", got, TestStreamSchemaChangesResponse)
+	}
+}
+
+func testStreamSchemaChangesError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamSchemaChangesError")
+	f.HasError = true
+	testErrorHelper(t, f, "StreamSchemaChanges", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		return conn.StreamSchemaChanges(ctx, TestTarget, func(response *querypb.StreamSchemaChangesResponse) error { return nil })
+	})
+	f.HasError = false
+}
+
+func testStreamSchemaChangesPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamSchemaChangesPanics")
+	testPanicHelper(t, f, "StreamSchemaChanges", func(ctx context.Context) error {
+		return conn.StreamSchemaChanges(ctx, TestTarget, func(response *querypb.StreamSchemaChangesResponse) error { return nil })
+	})
+}
+
+func testGetSchemaVersion(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testGetSchemaVersion")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	version, err := conn.GetSchemaVersion(ctx, TestTarget, SchemaVersionTable, SchemaVersionTime)
+	if err != nil {
+		t.Fatalf("GetSchemaVersion failed: %v", err)
+	}
+	if !proto.Equal(version, TestSchemaVersion) {
+		t.Errorf("Unexpected result from GetSchemaVersion: got %v wanted %v", version, TestSchemaVersion)
+	}
+}
+
+func testGetSchemaVersionError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testGetSchemaVersionError")
+	f.HasError = true
+	testErrorHelper(t, f, "GetSchemaVersion", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		_, err := conn.GetSchemaVersion(ctx, TestTarget, SchemaVersionTable, SchemaVersionTime)
+		return err
+	})
+	f.HasError = false
+}
+
+func testGetSchemaVersionPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testGetSchemaVersionPanics")
+	testPanicHelper(t, f, "GetSchemaVersion", func(ctx context.Context) error {
+		_, err := conn.GetSchemaVersion(ctx, TestTarget, SchemaVersionTable, SchemaVersionTime)
 		return err
 	})
 }
@@ -794,6 +897,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecuteBatch,
 		testMessageStream,
 		testMessageAck,
+		testMessagePostpone,
+		testStreamSchemaChanges,
+		testGetSchemaVersion,
 
 		// error test cases
 		testBeginError,
@@ -816,6 +922,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecuteBatchErrorInExecuteBatch,
 		testMessageStreamError,
 		testMessageAckError,
+		testMessagePostponeError,
+		testStreamSchemaChangesError,
+		testGetSchemaVersionError,
 
 		// panic test cases
 		testBeginPanics,
@@ -836,6 +945,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecuteBatchPanics,
 		testMessageStreamPanics,
 		testMessageAckPanics,
+		testMessagePostponePanics,
+		testStreamSchemaChangesPanics,
+		testGetSchemaVersionPanics,
 	}
 
 	if !fake.TestingGateway {
@@ -51,6 +51,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"vitess.io/vitess/go/history"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/binlog"
@@ -65,6 +66,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
@@ -97,6 +99,7 @@ type ActionAgent struct {
 	MysqlDaemon         mysqlctl.MysqlDaemon
 	DBConfigs           *dbconfigs.DBConfigs
 	VREngine            *vreplication.Engine
+	OnlineDDL           *onlineddl.Executor
 	DemoteMasterType    topodatapb.TabletType
 
 	// exportStats is set only for production tablet.
@@ -191,6 +194,15 @@ type ActionAgent struct {
 	// _shardSyncCancel is the function to stop the background shard sync goroutine.
 	_shardSyncCancel context.CancelFunc
 
+	// _backupSchedulerDone is a channel for waiting until the backup
+	// scheduler goroutine has really finished after _backupSchedulerCancel
+	// was called.
+	_backupSchedulerDone chan struct{}
+
+	// _backupSchedulerCancel is the function to stop the background backup
+	// scheduler goroutine, and its running backup.
+	_backupSchedulerCancel context.CancelFunc
+
 	// _tablet has the Tablet record we last read from the topology server.
 	_tablet *topodatapb.Tablet
 
@@ -221,6 +233,9 @@ type ActionAgent struct {
 	// replication delay the last time we got it
 	_replicationDelay time.Duration
 
+	// replication position of a slave the last time we got it
+	_replicationPosition mysql.Position
+
 	// _masterTermStartTime is the time at which our term as master began.
 	_masterTermStartTime time.Time
 
@@ -330,7 +345,12 @@ func NewActionAgent(
 	},
 		filteredWithDBParams.DbName,
 	)
+	if tablet := agent.Tablet(); tablet != nil {
+		agent.VREngine.SetTargetShard(tablet.Keyspace, tablet.Shard)
+	}
 	servenv.OnTerm(agent.VREngine.Close)
+	agent.OnlineDDL = onlineddl.NewExecutor(agent.DBConfigs.DbaWithDB(), agent.onlineDDLReplicas)
+	servenv.OnTerm(agent.OnlineDDL.Close)
 
 	// Run a background task to rebuild the SrvKeyspace in our cell/keyspace
 	// if it doesn't exist yet.
@@ -421,6 +441,7 @@ func NewTestActionAgent(batchCtx context.Context, ts *topo.Server, tabletAlias *
 		MysqlDaemon:         mysqlDaemon,
 		DBConfigs:           &dbconfigs.DBConfigs{},
 		VREngine:            vreplication.NewEngine(ts, tabletAlias.Cell, mysqlDaemon, binlogplayer.NewFakeDBClient, ti.DbName()),
+		OnlineDDL:           onlineddl.NewExecutor((&dbconfigs.DBConfigs{}).DbaWithDB(), nil),
 		History:             history.New(historyLength),
 		DemoteMasterType:    demoteMasterTabletType,
 		_healthy:            fmt.Errorf("healthcheck not run yet"),
@@ -466,6 +487,7 @@ func NewComboActionAgent(batchCtx context.Context, ts *topo.Server, tabletAlias
 		MysqlDaemon:         mysqlDaemon,
 		DBConfigs:           dbcfgs,
 		VREngine:            vreplication.NewEngine(nil, "", nil, nil, ""),
+		OnlineDDL:           onlineddl.NewExecutor(dbcfgs.DbaWithDB(), nil),
 		gotMysqlPort:        true,
 		History:             history.New(historyLength),
 		DemoteMasterType:    demoteMasterType,
@@ -749,7 +771,8 @@ func (agent *ActionAgent) Start(ctx context.Context, mysqlHost string, mysqlPort
 	// to make sure it and our tablet record are in sync.
 	agent.startShardSync()
 
-	return nil
+	// Start a background goroutine to take the scheduled backups, if any.
+	return agent.startBackupScheduler()
 }
 
 // Close prepares a tablet for shutdown. First we check our tablet ownership and
@@ -760,6 +783,7 @@ func (agent *ActionAgent) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	agent.stopShardSync()
+	agent.stopBackupScheduler()
 
 	// cleanup initialized fields in the tablet entry
 	f := func(tablet *topodatapb.Tablet) error {
@@ -788,12 +812,14 @@ func (agent *ActionAgent) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	agent.stopShardSync()
+	agent.stopBackupScheduler()
 
 	if agent.UpdateStream != nil {
 		agent.UpdateStream.Disable()
 	}
 
 	agent.VREngine.Close()
+	agent.OnlineDDL.Close()
 
 	if agent.MysqlDaemon != nil {
 		agent.MysqlDaemon.Close()
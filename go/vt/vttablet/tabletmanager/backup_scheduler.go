@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"flag"
+	"math/rand"
+	"path"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	backupSchedule            = flag.String("backup_schedule", "", "if set, the tablet takes backups on this schedule in cron format (e.g. '0 3 * * *' for every day at 03:00), while it is a replica, rdonly or spare tablet")
+	backupScheduleJitter      = flag.Duration("backup_schedule_jitter", 10*time.Minute, "a random delay up to this duration is added to each scheduled backup, so the tablets of a shard don't start their backups at the same time")
+	backupScheduleMinInterval = flag.Duration("backup_schedule_min_interval", time.Hour, "a scheduled backup is skipped if a backup of the shard was started or taken more recently than this, e.g. by another tablet")
+	backupScheduleConcurrency = flag.Int("backup_schedule_concurrency", 4, "how many files the scheduled backups process concurrently")
+
+	scheduledBackups           = stats.NewCountersWithSingleLabel("ScheduledBackups", "Number of scheduled backups, by result", "result", scheduledBackupSuccess, scheduledBackupFailure, scheduledBackupSkipped)
+	scheduledBackupLastSuccess = stats.NewGauge("ScheduledBackupLastSuccessTimestamp", "Unix timestamp of the last successful scheduled backup")
+)
+
+const (
+	scheduledBackupSuccess = "Success"
+	scheduledBackupFailure = "Failure"
+	scheduledBackupSkipped = "Skipped"
+)
+
+// startBackupScheduler starts the background goroutine which takes the
+// scheduled backups, if -backup_schedule is set.
+func (agent *ActionAgent) startBackupScheduler() error {
+	if *backupSchedule == "" {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(*backupSchedule)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid -backup_schedule %q", *backupSchedule)
+	}
+
+	agent.mutex.Lock()
+	agent._backupSchedulerDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	agent._backupSchedulerCancel = cancel
+	doneChan := agent._backupSchedulerDone
+	agent.mutex.Unlock()
+
+	go agent.backupSchedulerLoop(ctx, schedule, doneChan)
+	return nil
+}
+
+// stopBackupScheduler stops the scheduler, and waits for the running
+// scheduled backup to be canceled, if any.
+func (agent *ActionAgent) stopBackupScheduler() {
+	var doneChan <-chan struct{}
+
+	agent.mutex.Lock()
+	if agent._backupSchedulerCancel != nil {
+		agent._backupSchedulerCancel()
+		agent._backupSchedulerCancel = nil
+
+		doneChan = agent._backupSchedulerDone
+		agent._backupSchedulerDone = nil
+	}
+	agent.mutex.Unlock()
+
+	if doneChan != nil {
+		<-doneChan
+	}
+}
+
+func (agent *ActionAgent) backupSchedulerLoop(ctx context.Context, schedule cron.Schedule, doneChan chan struct{}) {
+	defer close(doneChan)
+
+	for {
+		next := nextScheduledBackup(schedule, time.Now(), *backupScheduleJitter)
+		log.Infof("Next scheduled backup at %v", next)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		result, err := agent.scheduledBackup(ctx)
+		scheduledBackups.Add(result, 1)
+		switch result {
+		case scheduledBackupSuccess:
+			scheduledBackupLastSuccess.Set(time.Now().Unix())
+		case scheduledBackupFailure:
+			log.Errorf("Scheduled backup failed: %v", err)
+		}
+	}
+}
+
+// nextScheduledBackup returns the time of the next scheduled backup after
+// now, with a random delay up to jitter.
+func nextScheduledBackup(schedule cron.Schedule, now time.Time, jitter time.Duration) time.Time {
+	next := schedule.Next(now)
+	if jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(jitter))))
+	}
+	return next
+}
+
+// scheduledBackup takes a scheduled backup, unless the tablet is not a
+// replica, or a recent backup of the shard exists. It returns the result
+// of the scheduled backup for the stats.
+func (agent *ActionAgent) scheduledBackup(ctx context.Context) (string, error) {
+	tablet := agent.Tablet()
+	switch tablet.Type {
+	case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
+	default:
+		log.Infof("Skipping scheduled backup, the tablet is %v", tablet.Type)
+		return scheduledBackupSkipped, nil
+	}
+
+	// The tablets of the shard take their scheduled backups one at a
+	// time, and check the recent backups once they hold the lease: the
+	// first tablet to start its backup makes the other ones skip theirs.
+	unlock, err := agent.lockScheduledBackups(ctx, tablet)
+	if err != nil {
+		return scheduledBackupFailure, err
+	}
+	defer unlock()
+
+	recent, err := recentBackup(ctx, tablet.Keyspace, tablet.Shard, *backupScheduleMinInterval)
+	if err != nil {
+		return scheduledBackupFailure, err
+	}
+	if recent != "" {
+		log.Infof("Skipping scheduled backup, backup %v is less than %v old", recent, *backupScheduleMinInterval)
+		return scheduledBackupSkipped, nil
+	}
+
+	log.Infof("Starting scheduled backup")
+	// The backup logs to the console, there is no other client to stream them to.
+	logger := logutil.NewCallbackLogger(func(*logutilpb.Event) {})
+	if err := agent.Backup(ctx, *backupScheduleConcurrency, logger, false, false); err != nil {
+		return scheduledBackupFailure, err
+	}
+	log.Infof("Scheduled backup done")
+	return scheduledBackupSuccess, nil
+}
+
+// scheduledBackupLeaseDir is the directory of the shard in the global
+// topo which is locked while a tablet of the shard takes a scheduled
+// backup.
+const scheduledBackupLeaseDir = "scheduled_backup"
+
+// lockScheduledBackups takes the lease of the scheduled backups of the
+// shard of the tablet. It waits while another tablet holds it. The lease
+// is a lock of the global topo on its own directory, so reparents and
+// other actions which lock the shard are not blocked during the backup,
+// and it is released if the tablet dies.
+func (agent *ActionAgent) lockScheduledBackups(ctx context.Context, tablet *topodatapb.Tablet) (func(), error) {
+	conn, err := agent.TopoServer.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	// A directory can only be locked if it has a file.
+	dirPath := path.Join(topo.KeyspacesPath, tablet.Keyspace, topo.ShardsPath, tablet.Shard, scheduledBackupLeaseDir)
+	if _, err := conn.Create(ctx, path.Join(dirPath, "Lease"), nil); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+		return nil, vterrors.Wrap(err, "cannot create the scheduled backup lease")
+	}
+	lockDescriptor, err := conn.Lock(ctx, dirPath, topoproto.TabletAliasString(tablet.Alias))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot take the scheduled backup lease")
+	}
+	return func() {
+		// The scheduler context may be canceled.
+		ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		if err := lockDescriptor.Unlock(ctx); err != nil {
+			log.Errorf("Cannot release the scheduled backup lease: %v", err)
+		}
+	}, nil
+}
+
+// recentBackup returns the name of the last backup of the shard if it
+// was started or taken less than minInterval ago, and "" otherwise.
+// A backup without a valid MANIFEST is in progress, unless it was
+// started more than minInterval ago: it is then assumed to have been
+// abandoned.
+func recentBackup(ctx context.Context, keyspace, shard string, minInterval time.Duration) (string, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return "", err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBackupDir(keyspace, shard))
+	if err != nil {
+		return "", vterrors.Wrap(err, "ListBackups failed")
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		var backupTime time.Time
+		bm, err := mysqlctl.GetBackupManifest(ctx, bhs[i])
+		if err == nil {
+			backupTime, err = time.Parse(time.RFC3339, bm.BackupTime)
+		} else {
+			// The name of a backup starts with its start time.
+			backupTime, err = backupStartTime(bhs[i].Name())
+		}
+		if err != nil {
+			continue
+		}
+		if time.Since(backupTime) < minInterval {
+			return bhs[i].Name(), nil
+		}
+		if bm != nil {
+			return "", nil
+		}
+	}
+	return "", nil
+}
+
+// backupStartTime returns the time at which a backup was started, from
+// its name.
+func backupStartTime(name string) (time.Time, error) {
+	if len(name) < len(mysqlctl.BackupTimestampFormat) {
+		return time.Time{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid backup name %v", name)
+	}
+	return time.Parse(mysqlctl.BackupTimestampFormat, name[:len(mysqlctl.BackupTimestampFormat)])
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestNextScheduledBackup(t *testing.T) {
+	schedule, err := cron.ParseStandard("0 3 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.Local)
+	want := time.Date(2020, 3, 2, 3, 0, 0, 0, time.Local)
+
+	if got := nextScheduledBackup(schedule, now, 0); !got.Equal(want) {
+		t.Errorf("nextScheduledBackup without jitter: %v, want %v", got, want)
+	}
+	for i := 0; i < 100; i++ {
+		got := nextScheduledBackup(schedule, now, 10*time.Minute)
+		if got.Before(want) || !got.Before(want.Add(10*time.Minute)) {
+			t.Fatalf("nextScheduledBackup with 10m jitter: %v, want between %v and 10 minutes later", got, want)
+		}
+	}
+}
+
+func TestStartBackupScheduler(t *testing.T) {
+	defer func(schedule string) { *backupSchedule = schedule }(*backupSchedule)
+	*backupSchedule = "every day"
+	agent := &ActionAgent{}
+	if err := agent.startBackupScheduler(); err == nil {
+		t.Errorf("startBackupScheduler with an invalid schedule succeeded, want an error")
+	}
+
+	// The scheduler can be stopped.
+	*backupSchedule = "0 3 * * *"
+	if err := agent.startBackupScheduler(); err != nil {
+		t.Fatal(err)
+	}
+	agent.stopBackupScheduler()
+}
+
+func TestLockScheduledBackups(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	agent := &ActionAgent{TopoServer: ts}
+	tablet1 := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}, Keyspace: "ks", Shard: "0"}
+	tablet2 := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 2}, Keyspace: "ks", Shard: "0"}
+
+	unlock, err := agent.lockScheduledBackups(ctx, tablet1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The shard can still be locked.
+	_, unlockShard, err := ts.LockShard(ctx, "ks", "0", "reparent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlockShard(&err)
+
+	// The other tablets wait for the lease.
+	shortCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	if _, err := agent.lockScheduledBackups(shortCtx, tablet2); err == nil {
+		t.Errorf("lockScheduledBackups succeeded while the lease is taken, want an error")
+	}
+	unlock()
+	unlock, err = agent.lockScheduledBackups(ctx, tablet2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+}
+
+func TestBackupStartTime(t *testing.T) {
+	got, err := backupStartTime("2020-03-01.120000.cell1-0000000100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("backupStartTime: %v, want %v", got, want)
+	}
+	if _, err := backupStartTime("backup"); err == nil {
+		t.Errorf("backupStartTime with an invalid name succeeded, want an error")
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/health"
 	"vitess.io/vitess/go/vt/log"
@@ -308,11 +309,22 @@ func (agent *ActionAgent) runHealthCheckLocked() {
 		}
 	}
 
+	// Remember the replication position of slaves, which tells the
+	// query service which reads of read-after-write sessions it can
+	// serve.
+	var replicationPosition mysql.Position
+	if isSlaveType {
+		if status, err := agent.MysqlDaemon.SlaveStatus(); err == nil {
+			replicationPosition = status.Position
+		}
+	}
+
 	// remember our health status
 	agent.mutex.Lock()
 	agent._healthy = healthErr
 	agent._healthyTime = time.Now()
 	agent._replicationDelay = replicationDelay
+	agent._replicationPosition = replicationPosition
 	agent.mutex.Unlock()
 
 	// send it to our observers
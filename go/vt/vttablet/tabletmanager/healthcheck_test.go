@@ -27,6 +27,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/health"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
@@ -196,6 +197,11 @@ func TestHealthCheckControlsQueryService(t *testing.T) {
 	// and update the mysql port to 3306
 	before := time.Now()
 	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 12 * time.Second
+	pos, err := mysql.DecodePosition("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	agent.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon).CurrentMasterPosition = pos
 	agent.runHealthCheck()
 	ti, err := agent.TopoServer.GetTablet(ctx, tabletAlias)
 	if err != nil {
@@ -222,9 +228,13 @@ func TestHealthCheckControlsQueryService(t *testing.T) {
 	if agent.QueryServiceControl.(*tabletservermock.Controller).CurrentTarget.TabletType != topodatapb.TabletType_REPLICA {
 		t.Errorf("invalid tabletserver target: %v", agent.QueryServiceControl.(*tabletservermock.Controller).CurrentTarget.TabletType)
 	}
-	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "", 12); err != nil {
+	bd, err := expectBroadcastData(agent.QueryServiceControl, true, "", 12)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if got, want := bd.RealtimeStats.ReplicationPosition, mysql.EncodePosition(pos); got != want {
+		t.Errorf("BroadcastData.ReplicationPosition: %v, want %v", got, want)
+	}
 
 	// now make the tablet unhealthy
 	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 13 * time.Second
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -58,10 +59,27 @@ func (agent *ActionAgent) RestoreData(ctx context.Context, logger logutil.Logger
 	if agent.Cnf == nil {
 		return fmt.Errorf("cannot perform restore without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
-	return agent.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	return agent.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, &tabletmanagerdatapb.RestoreFromBackupRequest{})
 }
 
-func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the backup selected by req, the last one by
+// default. If RestoreToTimestamp or RestoreToPos is set, the incremental
+// backups are replayed up to it, and the tablet is left DRAINED without
+// replication, so the data can be inspected or exported. If BackupName
+// is set, that backup is restored, and if BackupTimestamp is set, the
+// last backup taken before it.
+func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, req *tabletmanagerdatapb.RestoreFromBackupRequest) error {
+	var restoreToPos mysql.Position
+	if req.RestoreToPos != "" {
+		var err error
+		restoreToPos, err = mysql.DecodePosition(req.RestoreToPos)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid position to restore to %v", req.RestoreToPos)
+		}
+	}
+	restoreToTimestamp := logutil.ProtoToTime(req.RestoreToTimestamp)
+	backupTimestamp := logutil.ProtoToTime(req.BackupTimestamp)
+
 	// change type to RESTORE (using UpdateTabletFields so it's
 	// always authorized)
 	var originalType topodatapb.TabletType
@@ -99,6 +117,10 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 		keyspace = keyspaceInfo.BaseKeyspace
 		log.Infof("Using base_keyspace %v to restore keyspace %v", keyspace, tablet.Keyspace)
 	}
+	startTime := logutil.ProtoToTime(keyspaceInfo.SnapshotTime)
+	if !backupTimestamp.IsZero() {
+		startTime = backupTimestamp
+	}
 
 	params := mysqlctl.RestoreParams{
 		Cnf:                 agent.Cnf,
@@ -111,7 +133,10 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 		DbName:              topoproto.TabletDbName(tablet),
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
-		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		StartTime:           startTime,
+		RestoreToTimestamp:  restoreToTimestamp,
+		RestoreToPos:        restoreToPos,
+		BackupName:          req.BackupName,
 	}
 
 	// Loop until a backup exists, unless we were told to give up immediately.
@@ -142,7 +167,11 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if params.PointInTime() {
+			// Replication would move past the point in time.
+			logger.Infof("Restored to the point in time at position %v, the tablet is left %v without replication", pos, topodatapb.TabletType_DRAINED)
+			originalType = topodatapb.TabletType_DRAINED
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := agent.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
@@ -164,3 +164,8 @@ func (agent *ActionAgent) IgnoreHealthError(ctx context.Context, pattern string)
 	agent.mutex.Unlock()
 	return nil
 }
+
+// ResizeTxPool changes the size of the transaction pool of the query service.
+func (agent *ActionAgent) ResizeTxPool(ctx context.Context, size int64) error {
+	return agent.QueryServiceControl.ResizeTxPool(ctx, int(size))
+}
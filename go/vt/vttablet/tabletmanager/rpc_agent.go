@@ -60,16 +60,28 @@ type RPCAgent interface {
 
 	IgnoreHealthError(ctx context.Context, pattern string) error
 
+	ResizeTxPool(ctx context.Context, size int64) error
+
 	ReloadSchema(ctx context.Context, waitPosition string) error
 
 	PreflightSchema(ctx context.Context, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
 
 	ApplySchema(ctx context.Context, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
 
+	ApplyDeclarativeSchema(ctx context.Context, desired []string, allowDrop, dryRun bool) ([]string, error)
+
 	LockTables(ctx context.Context) error
 
 	UnlockTables(ctx context.Context) error
 
+	SubmitOnlineDDL(ctx context.Context, sql, strategy string) (string, error)
+
+	GetOnlineDDLMigrations(ctx context.Context, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error)
+
+	CancelOnlineDDL(ctx context.Context, uuid string) error
+
+	RetryOnlineDDL(ctx context.Context, uuid string) error
+
 	ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error)
 
 	ExecuteFetchAsAllPrivs(ctx context.Context, query []byte, dbName string, maxrows int, reloadSchema bool) (*querypb.QueryResult, error)
@@ -99,6 +111,9 @@ type RPCAgent interface {
 	// VReplication API
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
+	VReplicationPause(ctx context.Context, id int) (string, error)
+	VReplicationResume(ctx context.Context, id int) error
+	VReplicationCopyProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error)
 
 	// Reparenting related functions
 
@@ -128,9 +143,9 @@ type RPCAgent interface {
 
 	// Backup / restore related methods
 
-	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
+	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster, incremental bool) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, req *tabletmanagerdatapb.RestoreFromBackupRequest) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
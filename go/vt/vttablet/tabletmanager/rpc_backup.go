@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -35,8 +36,9 @@ const (
 	backupModeOffline = "offline"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+// Backup takes a db backup and sends it to the BackupStorage. If incremental is
+// set, it's an incremental backup of the binary logs, whatever the backup engine.
+func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster, incremental bool) error {
 	if agent.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -49,7 +51,7 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	if !allowMaster && currentTablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot take backup. if you really need to do this, rerun the backup command with -allow_master")
 	}
-	engine, err := mysqlctl.GetBackupEngine()
+	engine, err := mysqlctl.GetBackupEngineFor(incremental)
 	if err != nil {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
@@ -108,6 +110,7 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 		Shard:        tablet.Shard,
 		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
 		BackupTime:   time.Now(),
+		Incremental:  incremental,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
@@ -142,8 +145,10 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	return returnErr
 }
 
-// RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// RestoreFromBackup deletes all local data and restores anew from the backup
+// selected by req, the latest one by default. If req.RestoreToTimestamp or
+// req.RestoreToPos is set, the restore stops at that point in time.
+func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, req *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -161,7 +166,7 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = agent.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = agent.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, req)
 
 	// re-run health check to be sure to capture any replication delay
 	agent.runHealthCheckLocked()
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// SubmitOnlineDDL queues an online DDL migration, and returns its uuid.
+func (agent *ActionAgent) SubmitOnlineDDL(ctx context.Context, sql, strategy string) (string, error) {
+	if agent.Tablet().Type != topodatapb.TabletType_MASTER {
+		return "", vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "online DDL migrations can only run on the master")
+	}
+	return agent.OnlineDDL.Submit(sql, onlineddl.Strategy(strategy))
+}
+
+// GetOnlineDDLMigrations returns the online DDL migration with the
+// given uuid, or all of them if uuid is empty.
+func (agent *ActionAgent) GetOnlineDDLMigrations(ctx context.Context, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	migrations, err := agent.OnlineDDL.Migrations(uuid)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*tabletmanagerdatapb.OnlineDDLMigration, 0, len(migrations))
+	for _, m := range migrations {
+		result = append(result, m.ToProto())
+	}
+	return result, nil
+}
+
+// CancelOnlineDDL cancels a queued or running online DDL migration.
+func (agent *ActionAgent) CancelOnlineDDL(ctx context.Context, uuid string) error {
+	return agent.OnlineDDL.Cancel(uuid)
+}
+
+// RetryOnlineDDL queues a failed or cancelled online DDL migration again.
+func (agent *ActionAgent) RetryOnlineDDL(ctx context.Context, uuid string) error {
+	return agent.OnlineDDL.Retry(uuid)
+}
+
+// onlineDDLReplicas returns the MySQL addresses of the replica and
+// rdonly tablets of the shard, which gh-ost checks the lag of.
+func (agent *ActionAgent) onlineDDLReplicas(ctx context.Context) ([]string, error) {
+	tablet := agent.Tablet()
+	tablets, err := agent.TopoServer.GetTabletMapForShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return nil, err
+	}
+	var replicas []string
+	for _, ti := range tablets {
+		if ti.Type == topodatapb.TabletType_REPLICA || ti.Type == topodatapb.TabletType_RDONLY {
+			replicas = append(replicas, topoproto.MysqlAddr(ti.Tablet))
+		}
+	}
+	sort.Strings(replicas)
+	return replicas, nil
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package tabletmanager
 
 import (
+	"strings"
+
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"golang.org/x/net/context"
@@ -24,9 +26,12 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // GetSchema returns the schema.
@@ -72,7 +77,8 @@ func (agent *ActionAgent) PreflightSchema(ctx context.Context, changes []string)
 	return agent.MysqlDaemon.PreflightSchemaChange(dbName, changes)
 }
 
-// ApplySchema will apply a schema change
+// ApplySchema will apply a schema change, with the DDL strategy of the
+// keyspace.
 func (agent *ActionAgent) ApplySchema(ctx context.Context, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
 	if err := agent.lock(ctx); err != nil {
 		return nil, err
@@ -82,6 +88,36 @@ func (agent *ActionAgent) ApplySchema(ctx context.Context, change *tmutils.Schem
 	// get the db name from the tablet
 	dbName := topoproto.TabletDbName(agent.Tablet())
 
+	// the strategy is read every time, so it can be changed
+	// without restarting the tablets
+	ki, err := agent.TopoServer.GetKeyspace(ctx, agent.Tablet().Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := tmutils.ParseDDLStrategy(ki.DdlStrategy)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "keyspace %v", agent.Tablet().Keyspace)
+	}
+	switch strategy {
+	case tmutils.DDLStrategyOnline:
+		return agent.applyOnlineSchemaChange(ctx, dbName, change)
+	case tmutils.DDLStrategyDeclarative:
+		sd, err := agent.MysqlDaemon.GetSchema(dbName, nil, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := tmutils.DeclarativeSchemaChanges(sd, splitStatements(change.SQL), false)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			return &tabletmanagerdatapb.SchemaChangeResult{BeforeSchema: sd, AfterSchema: sd}, nil
+		}
+		declarative := *change
+		declarative.SQL = strings.Join(changes, ";\n")
+		change = &declarative
+	}
+
 	// apply the change
 	scr, err := agent.MysqlDaemon.ApplySchemaChange(dbName, change)
 	if err != nil {
@@ -92,3 +128,79 @@ func (agent *ActionAgent) ApplySchema(ctx context.Context, change *tmutils.Schem
 	agent.ReloadSchema(ctx, "")
 	return scr, nil
 }
+
+// ApplyDeclarativeSchema changes the schema of the tablet into the
+// one created by the CREATE TABLE statements of desired, and returns
+// the statements which make the changes. Tables which are not in
+// desired are dropped only if allowDrop is set. If dryRun is set, the
+// changes are only computed. The changes are applied with replication,
+// and fail if the schema changed after they were computed.
+func (agent *ActionAgent) ApplyDeclarativeSchema(ctx context.Context, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	if err := agent.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer agent.unlock()
+
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	sd, err := agent.MysqlDaemon.GetSchema(dbName, nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := tmutils.DeclarativeSchemaChanges(sd, desired, allowDrop)
+	if err != nil || dryRun || len(changes) == 0 {
+		return changes, err
+	}
+	if _, err := agent.MysqlDaemon.ApplySchemaChange(dbName, &tmutils.SchemaChange{
+		SQL:              strings.Join(changes, ";\n"),
+		AllowReplication: true,
+		BeforeSchema:     sd,
+	}); err != nil {
+		return nil, err
+	}
+	agent.ReloadSchema(ctx, "")
+	return changes, nil
+}
+
+// applyOnlineSchemaChange submits the ALTER TABLE statements of the
+// change as online DDL migrations. They run in the background, so the
+// returned schema is the current one.
+func (agent *ActionAgent) applyOnlineSchemaChange(ctx context.Context, dbName string, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+	statements := splitStatements(change.SQL)
+	// Check all the statements before submitting any of them.
+	for _, sql := range statements {
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, err
+		}
+		if ddl, ok := stmt.(*sqlparser.DDL); !ok || ddl.Action != sqlparser.AlterStr {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the online DDL strategy only supports ALTER TABLE: %s", sql)
+		}
+	}
+	sd, err := agent.MysqlDaemon.GetSchema(dbName, nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, sql := range statements {
+		uuid, err := agent.SubmitOnlineDDL(ctx, sql, string(onlineddl.StrategyGhost))
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("ApplySchema: submitted online DDL migration %v: %v", uuid, sql)
+	}
+	return &tabletmanagerdatapb.SchemaChangeResult{BeforeSchema: sd, AfterSchema: sd}, nil
+}
+
+// splitStatements splits a list of semicolon-delimited statements.
+func splitStatements(sql string) []string {
+	pieces, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		pieces = []string{sql}
+	}
+	var statements []string
+	for _, piece := range pieces {
+		if s := strings.TrimSpace(piece); s != "" {
+			statements = append(statements, s)
+		}
+	}
+	return statements
+}
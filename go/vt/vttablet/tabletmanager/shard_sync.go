@@ -22,6 +22,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -292,6 +293,7 @@ func (agent *ActionAgent) setMasterTermStartTime(t time.Time) {
 	agent.mutex.Lock()
 	agent._masterTermStartTime = t
 	agent._replicationDelay = 0
+	agent._replicationPosition = mysql.Position{}
 	agent.mutex.Unlock()
 
 	// Notify the shard sync loop that the tablet state changed.
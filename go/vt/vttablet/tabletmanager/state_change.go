@@ -26,6 +26,7 @@ import (
 
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
@@ -100,6 +101,7 @@ func (agent *ActionAgent) broadcastHealth() {
 	// get the replication delays
 	agent.mutex.Lock()
 	replicationDelay := agent._replicationDelay
+	replicationPosition := agent._replicationPosition
 	healthError := agent._healthy
 	terTime := agent._masterTermStartTime
 	healthyTime := agent._healthyTime
@@ -110,6 +112,9 @@ func (agent *ActionAgent) broadcastHealth() {
 	stats := &querypb.RealtimeStats{
 		SecondsBehindMaster: uint32(replicationDelay.Seconds()),
 	}
+	if !replicationPosition.IsZero() {
+		stats.ReplicationPosition = mysql.EncodePosition(replicationPosition)
+	}
 	stats.SecondsBehindMasterFilteredReplication, stats.BinlogPlayersCount = vreplication.StatusSummary()
 	stats.Qps = agent.QueryServiceControl.Stats().QPSRates.TotalRate()
 	if healthError != nil {
@@ -365,8 +370,12 @@ func (agent *ActionAgent) changeCallback(ctx context.Context, oldTablet, newTabl
 		} else {
 			log.Info("VReplication engine started")
 		}
+		if err := agent.OnlineDDL.Open(); err != nil {
+			log.Errorf("Could not start online DDL executor: %v", err)
+		}
 	} else {
 		agent.VREngine.Close()
+		agent.OnlineDDL.Close()
 	}
 
 	// Broadcast health changes to vtgate immediately.
@@ -22,6 +22,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // VReplicationExec executes a vreplication command.
@@ -37,3 +38,18 @@ func (agent *ActionAgent) VReplicationExec(ctx context.Context, query string) (*
 func (agent *ActionAgent) VReplicationWaitForPos(ctx context.Context, id int, pos string) error {
 	return agent.VREngine.WaitForPos(ctx, id, pos)
 }
+
+// VReplicationPause stops a stream and returns its position.
+func (agent *ActionAgent) VReplicationPause(ctx context.Context, id int) (string, error) {
+	return agent.VREngine.Pause(id)
+}
+
+// VReplicationResume restarts a paused stream.
+func (agent *ActionAgent) VReplicationResume(ctx context.Context, id int) error {
+	return agent.VREngine.Resume(id)
+}
+
+// VReplicationCopyProgress returns the copy progress of the streams of the workflow.
+func (agent *ActionAgent) VReplicationCopyProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	return agent.VREngine.CopyProgress(workflow)
+}
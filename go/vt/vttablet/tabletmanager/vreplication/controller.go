@@ -54,6 +54,7 @@ type controller struct {
 	dbClientFactory func() binlogplayer.DBClient
 	mysqld          mysqlctl.MysqlDaemon
 	blpStats        *binlogplayer.Stats
+	copyProgress    *copyProgress
 
 	id           uint32
 	workflow     string
@@ -79,6 +80,7 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		dbClientFactory: dbClientFactory,
 		mysqld:          mysqld,
 		blpStats:        blpStats,
+		copyProgress:    newCopyProgress(),
 		done:            make(chan struct{}),
 	}
 
@@ -223,12 +225,17 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 
 		var vsClient VStreamerClient
 		if ct.source.GetExternalMysql() == "" {
-			vsClient = NewTabletVStreamerClient(tablet)
+			tabletClient := NewTabletVStreamerClient(tablet)
+			tabletClient.compression = ct.source.Compression
+			vsClient = tabletClient
 		} else {
-			vsClient = NewMySQLVStreamerClient()
+			vsClient, err = NewMySQLVStreamerClient(ct.source.ExternalMysql)
+			if err != nil {
+				return err
+			}
 		}
 
-		vr := newVReplicator(ct.id, &ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		vr := newVReplicator(ct.id, &ct.source, vsClient, ct.blpStats, ct.copyProgress, dbClient, ct.mysqld, ct.vre)
 		return vr.Replicate(ctx)
 	}
 	return fmt.Errorf("missing source")
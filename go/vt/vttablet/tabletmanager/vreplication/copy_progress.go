@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// copyProgress tracks the copy of the tables of a stream. It's kept
+// in memory by the controller, so it survives the restarts of the
+// vreplicator, but not the restarts of the tablet.
+type copyProgress struct {
+	mu     sync.Mutex
+	tables map[string]*tableCopyProgress
+}
+
+type tableCopyProgress struct {
+	rowsCopied int64
+	rowsTotal  int64
+	// startRows and started are the rows copied and the time when
+	// the copy of the table was started or resumed.
+	startRows int64
+	started   time.Time
+}
+
+func newCopyProgress() *copyProgress {
+	return &copyProgress{
+		tables: make(map[string]*tableCopyProgress),
+	}
+}
+
+// start records that the copy of the table is started or resumed.
+// rowsCopied and rowsTotal are estimates, 0 if they're unknown.
+func (cp *copyProgress) start(table string, rowsCopied, rowsTotal int64) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if tp, ok := cp.tables[table]; ok && tp.rowsCopied > rowsCopied {
+		// The rows counted by a previous run are more accurate.
+		rowsCopied = tp.rowsCopied
+	}
+	cp.tables[table] = &tableCopyProgress{
+		rowsCopied: rowsCopied,
+		rowsTotal:  rowsTotal,
+		startRows:  rowsCopied,
+		started:    time.Now(),
+	}
+}
+
+// add adds rows to the rows copied of the table.
+func (cp *copyProgress) add(table string, rows int64) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if tp, ok := cp.tables[table]; ok {
+		tp.rowsCopied += rows
+	}
+}
+
+// done forgets the table once it's copied.
+func (cp *copyProgress) done(table string) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	delete(cp.tables, table)
+}
+
+// progress returns the progress of the table.
+func (cp *copyProgress) progress(table string) *tabletmanagerdatapb.TableCopyProgress {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	result := &tabletmanagerdatapb.TableCopyProgress{
+		Table:      table,
+		EtaSeconds: -1,
+	}
+	tp, ok := cp.tables[table]
+	if !ok {
+		return result
+	}
+	result.RowsCopied = tp.rowsCopied
+	result.RowsTotal = tp.rowsTotal
+	if elapsed := time.Since(tp.started).Seconds(); elapsed > 0 {
+		result.RowsPerSecond = float64(tp.rowsCopied-tp.startRows) / elapsed
+	}
+	switch {
+	case tp.rowsTotal == 0:
+	case tp.rowsCopied >= tp.rowsTotal:
+		// The estimate of the total was too low.
+		result.EtaSeconds = 0
+	case result.RowsPerSecond > 0:
+		result.EtaSeconds = int64(float64(tp.rowsTotal-tp.rowsCopied) / result.RowsPerSecond)
+	}
+	return result
+}
+
+// CopyProgress returns the progress of the tables which remain to be
+// copied by the streams of the workflow, or of all the streams if
+// workflow is empty.
+func (vre *Engine) CopyProgress(workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	vre.mu.Lock()
+	defer vre.mu.Unlock()
+	if !vre.isOpen {
+		return nil, errors.New("vreplication engine is closed")
+	}
+
+	var ids []int
+	for id, ct := range vre.controllers {
+		if workflow == "" || ct.workflow == workflow {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	dbClient := vre.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	var result []*tabletmanagerdatapb.VReplicationStreamCopyProgress
+	for _, id := range ids {
+		ct := vre.controllers[id]
+		qr, err := vre.executeFetchMaybeCreateTable(dbClient, fmt.Sprintf("select table_name from _vt.copy_state where vrepl_id=%d order by table_name", id), 10000)
+		if err != nil {
+			return nil, err
+		}
+		stream := &tabletmanagerdatapb.VReplicationStreamCopyProgress{
+			Id:       int64(id),
+			Workflow: ct.workflow,
+		}
+		for _, row := range qr.Rows {
+			stream.Tables = append(stream.Tables, ct.copyProgress.progress(row[0].ToString()))
+		}
+		result = append(result, stream)
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyProgress(t *testing.T) {
+	cp := newCopyProgress()
+
+	// Not started.
+	tp := cp.progress("t1")
+	assert.Equal(t, "t1", tp.Table)
+	assert.Equal(t, int64(0), tp.RowsCopied)
+	assert.Equal(t, int64(-1), tp.EtaSeconds)
+
+	cp.start("t1", 0, 1000)
+	cp.tables["t1"].started = time.Now().Add(-10 * time.Second)
+	cp.add("t1", 100)
+	tp = cp.progress("t1")
+	assert.Equal(t, int64(100), tp.RowsCopied)
+	assert.Equal(t, int64(1000), tp.RowsTotal)
+	assert.InDelta(t, 10, tp.RowsPerSecond, 0.1)
+	assert.InDelta(t, 90, tp.EtaSeconds, 1)
+
+	// Resumed with a lower estimate: the counted rows are kept,
+	// and the throughput restarts.
+	cp.start("t1", 50, 1000)
+	tp = cp.progress("t1")
+	assert.Equal(t, int64(100), tp.RowsCopied)
+	assert.Equal(t, int64(-1), tp.EtaSeconds)
+
+	// The total was underestimated.
+	cp.add("t1", 1000)
+	assert.Equal(t, int64(0), cp.progress("t1").EtaSeconds)
+
+	// Unknown total.
+	cp.start("t2", 0, 0)
+	cp.add("t2", 10)
+	assert.Equal(t, int64(-1), cp.progress("t2").EtaSeconds)
+
+	cp.done("t1")
+	assert.Equal(t, int64(0), cp.progress("t1").RowsCopied)
+}
@@ -54,6 +54,9 @@ const (
   primary key (vrepl_id, table_name))`
 )
 
+// pausedMessage is the message of the streams stopped by Pause.
+const pausedMessage = "Paused"
+
 var tabletTypesStr = flag.String("vreplication_tablet_type", "REPLICA", "comma separated list of tablet types used as a source")
 
 // waitRetryTime can be changed to a smaller value for tests.
@@ -86,6 +89,9 @@ type Engine struct {
 	dbName          string
 
 	journaler map[string]*journalEvent
+
+	// lagChecker is nil until SetTargetShard is called.
+	lagChecker *replicaLagChecker
 }
 
 type journalEvent struct {
@@ -228,6 +234,7 @@ func (vre *Engine) Close() {
 	// We still have to wait for all controllers to stop.
 	for _, ct := range vre.controllers {
 		ct.Stop()
+		ct.blpStats.Stop()
 	}
 	vre.controllers = make(map[int]*controller)
 
@@ -287,6 +294,7 @@ func (vre *Engine) Exec(query string) (*sqltypes.Result, error) {
 			if ct := vre.controllers[id]; ct != nil {
 				// Unreachable. Just a failsafe.
 				ct.Stop()
+				ct.blpStats.Stop()
 				delete(vre.controllers, id)
 			}
 			params, err := readRow(dbClient, id)
@@ -350,6 +358,7 @@ func (vre *Engine) Exec(query string) (*sqltypes.Result, error) {
 		for _, id := range ids {
 			if ct := vre.controllers[id]; ct != nil {
 				ct.Stop()
+				ct.blpStats.Stop()
 				delete(vre.controllers, id)
 			}
 		}
@@ -565,6 +574,7 @@ func (vre *Engine) transitionJournal(je *journalEvent) {
 	for id := range participants {
 		ks := participants[id]
 		id := je.participants[ks]
+		vre.controllers[id].blpStats.Stop()
 		delete(vre.controllers, id)
 	}
 
@@ -650,6 +660,65 @@ func (vre *Engine) WaitForPos(ctx context.Context, id int, pos string) error {
 	}
 }
 
+// Pause stops the stream and returns the position where it stopped.
+// The player is stopped between two transactions, so the position is
+// consistent with the target tables. A paused stream is restarted by
+// Resume. Pausing a paused stream only returns its position.
+func (vre *Engine) Pause(id int) (string, error) {
+	_, state, message, err := vre.readStatus(id)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case state == binlogplayer.BlpStopped && message == pausedMessage:
+	case state == binlogplayer.BlpStopped:
+		return "", fmt.Errorf("vreplication stream %d is already stopped: %s", id, message)
+	default:
+		// Exec waits for the controller to stop before updating the state.
+		if _, err := vre.Exec(binlogplayer.StopVReplication(uint32(id), pausedMessage)); err != nil {
+			return "", err
+		}
+	}
+	pos, _, _, err := vre.readStatus(id)
+	return pos, err
+}
+
+// Resume restarts a stream paused by Pause. The stream keeps its stop
+// position, if it has one.
+func (vre *Engine) Resume(id int) error {
+	_, state, message, err := vre.readStatus(id)
+	if err != nil {
+		return err
+	}
+	if state != binlogplayer.BlpStopped || message != pausedMessage {
+		return fmt.Errorf("vreplication stream %d is not paused, state: %s, message: %s", id, state, message)
+	}
+	_, err = vre.Exec(binlogplayer.ResumeVReplication(uint32(id)))
+	return err
+}
+
+// readStatus returns the position, state and message of the stream.
+func (vre *Engine) readStatus(id int) (pos, state, message string, err error) {
+	if !vre.IsOpen() {
+		return "", "", "", errors.New("vreplication engine is closed")
+	}
+	dbClient := vre.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return "", "", "", err
+	}
+	defer dbClient.Close()
+	qr, err := dbClient.ExecuteFetch(binlogplayer.ReadVReplicationStatus(uint32(id)), 10)
+	switch {
+	case err != nil:
+		return "", "", "", err
+	case len(qr.Rows) == 0:
+		return "", "", "", fmt.Errorf("vreplication stream %d not found", id)
+	case len(qr.Rows) > 1 || len(qr.Rows[0]) != 3:
+		return "", "", "", fmt.Errorf("unexpected result: %v", qr)
+	}
+	return qr.Rows[0][0].ToString(), qr.Rows[0][1].ToString(), qr.Rows[0][2].ToString(), nil
+}
+
 // UpdateStats must be called with lock held.
 func (vre *Engine) updateStats() {
 	globalStats.mu.Lock()
@@ -423,6 +423,101 @@ func TestWaitForPosCancel(t *testing.T) {
 	}
 }
 
+func TestEnginePauseResume(t *testing.T) {
+	defer func() { globalStats = &vrStats{} }()
+
+	defer deleteTablet(addTablet(100))
+	resetBinlogClient()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClientFactory := func() binlogplayer.DBClient { return dbClient }
+	mysqld := &fakemysqldaemon.FakeMysqlDaemon{MysqlPort: 3306}
+	vre := NewEngine(env.TopoServ, env.Cells[0], mysqld, dbClientFactory, dbClient.DBName())
+
+	dbClient.ExpectRequest("select * from _vt.vreplication where db_name='db'", &sqltypes.Result{}, nil)
+	if err := vre.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer vre.Close()
+
+	statusResult := func(pos, state, message string) *sqltypes.Result {
+		return &sqltypes.Result{Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary(pos),
+			sqltypes.NewVarBinary(state),
+			sqltypes.NewVarBinary(message),
+		}}}
+	}
+
+	// Pause a running stream.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1083", "Running", ""), nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id from _vt.vreplication where id = 1", testSelectorResponse1, nil)
+	dbClient.ExpectRequest("update _vt.vreplication set state = 'Stopped', message = 'Paused' where id in (1)", testDMLResponse, nil)
+	dbClient.ExpectRequest("select * from _vt.vreplication where id = 1", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|state|source",
+			"int64|varchar|varchar",
+		),
+		fmt.Sprintf(`1|Stopped|keyspace:"%s" shard:"0" key_range:<end:"\200" > `, env.KeyspaceName),
+	), nil)
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1084", "Stopped", "Paused"), nil)
+	pos, err := vre.Pause(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos != "MariaDB/0-1-1084" {
+		t.Errorf("Pause: %v, want MariaDB/0-1-1084", pos)
+	}
+	dbClient.Wait()
+
+	// Pausing a paused stream only returns its position.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1084", "Stopped", "Paused"), nil)
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1084", "Stopped", "Paused"), nil)
+	if _, err := vre.Pause(1); err != nil {
+		t.Fatal(err)
+	}
+	dbClient.Wait()
+
+	// Resume the paused stream.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1084", "Stopped", "Paused"), nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id from _vt.vreplication where id = 1", testSelectorResponse1, nil)
+	dbClient.ExpectRequest("update _vt.vreplication set state = 'Running', message = '' where id in (1)", testDMLResponse, nil)
+	dbClient.ExpectRequest("select * from _vt.vreplication where id = 1", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|state|source",
+			"int64|varchar|varchar",
+		),
+		fmt.Sprintf(`1|Running|keyspace:"%s" shard:"0" key_range:<end:"\200" > `, env.KeyspaceName),
+	), nil)
+	dbClient.ExpectRequest("update _vt.vreplication set state='Running', message='' where id=1", testDMLResponse, nil)
+	dbClient.ExpectRequest("select pos, stop_pos, max_tps, max_replication_lag, state from _vt.vreplication where id=1", testSettingsResponse, nil)
+	dbClient.ExpectRequest("begin", nil, nil)
+	dbClient.ExpectRequest("insert into t values(1)", testDMLResponse, nil)
+	dbClient.ExpectRequestRE("update _vt.vreplication set pos='MariaDB/0-1-1235', time_updated=.*", testDMLResponse, nil)
+	dbClient.ExpectRequest("commit", nil, nil)
+	if err := vre.Resume(1); err != nil {
+		t.Fatal(err)
+	}
+	dbClient.Wait()
+
+	// A running stream can't be resumed.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", statusResult("MariaDB/0-1-1235", "Running", ""), nil)
+	err = vre.Resume(1)
+	want := "vreplication stream 1 is not paused, state: Running, message: "
+	if err == nil || err.Error() != want {
+		t.Errorf("Resume: %v, want %v", err, want)
+	}
+
+	// A stream stopped for another reason can't be paused.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=2", statusResult("MariaDB/0-1-1083", "Stopped", "Stopped after copy."), nil)
+	_, err = vre.Pause(2)
+	want = "vreplication stream 2 is already stopped: Stopped after copy."
+	if err == nil || err.Error() != want {
+		t.Errorf("Pause: %v, want %v", err, want)
+	}
+	dbClient.Wait()
+}
+
 func TestCreateDBAndTable(t *testing.T) {
 	defer func() { globalStats = &vrStats{} }()
 
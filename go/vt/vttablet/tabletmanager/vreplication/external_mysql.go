@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/dbconfigs"
+)
+
+var externalMySQLConfigFile = flag.String("vreplication_external_mysql_config", "", "JSON file which maps the names of the external MySQL servers vreplication can stream from to their connection parameters: host, port, socket, user, password, dbname, charset, flavor, ssl_ca, ssl_cert, ssl_key and server_name. If not set, the streams from an external MySQL use the erepl db config.")
+
+// externalMySQL is the configuration of an external MySQL server in
+// the vreplication_external_mysql_config file.
+type externalMySQL struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Socket     string `json:"socket"`
+	User       string `json:"user"`
+	Password   string `json:"password"`
+	DBName     string `json:"dbname"`
+	Charset    string `json:"charset"`
+	Flavor     string `json:"flavor"`
+	SslCa      string `json:"ssl_ca"`
+	SslCert    string `json:"ssl_cert"`
+	SslKey     string `json:"ssl_key"`
+	ServerName string `json:"server_name"`
+}
+
+func (em *externalMySQL) connParams() *mysql.ConnParams {
+	params := &mysql.ConnParams{
+		Host:       em.Host,
+		Port:       em.Port,
+		UnixSocket: em.Socket,
+		Uname:      em.User,
+		Pass:       em.Password,
+		DbName:     em.DBName,
+		Charset:    em.Charset,
+		Flavor:     em.Flavor,
+		SslCa:      em.SslCa,
+		SslCert:    em.SslCert,
+		SslKey:     em.SslKey,
+		ServerName: em.ServerName,
+	}
+	if params.SslCa != "" || params.SslCert != "" {
+		params.EnableSSL()
+	}
+	return params
+}
+
+// readExternalMySQLConfig reads the external MySQL servers of the file.
+func readExternalMySQLConfig(file string) (map[string]*externalMySQL, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := make(map[string]*externalMySQL)
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid external mysql config %v: %v", file, err)
+	}
+	return config, nil
+}
+
+// externalMySQLConnector returns the connection parameters of the named
+// external MySQL server. The configuration file is read every time, so
+// that its changes, like rotated credentials, apply to the streams which
+// are restarted.
+func externalMySQLConnector(name string) (dbconfigs.Connector, error) {
+	if *externalMySQLConfigFile == "" {
+		if dbcfgs == nil {
+			return dbconfigs.Connector{}, fmt.Errorf("no db config for the external mysql %v", name)
+		}
+		return dbcfgs.ExternalReplWithDB(), nil
+	}
+	config, err := readExternalMySQLConfig(*externalMySQLConfigFile)
+	if err != nil {
+		return dbconfigs.Connector{}, err
+	}
+	em, ok := config[name]
+	if !ok {
+		return dbconfigs.Connector{}, fmt.Errorf("external mysql %v not found in %v", name, *externalMySQLConfigFile)
+	}
+	if em.DBName == "" {
+		return dbconfigs.Connector{}, fmt.Errorf("external mysql %v has no dbname", name)
+	}
+	return dbconfigs.New(em.connParams()), nil
+}
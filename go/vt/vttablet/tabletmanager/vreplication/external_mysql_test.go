@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalMySQLConnector(t *testing.T) {
+	f, err := ioutil.TempFile("", "external_mysql")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(`{
+  "legacy": {"host": "legacy-db", "port": 3306, "user": "repl", "password": "secret", "dbname": "commerce", "flavor": "MySQL56"},
+  "nodb": {"host": "other-db", "port": 3306}
+}`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	defer func(saved string) { *externalMySQLConfigFile = saved }(*externalMySQLConfigFile)
+	*externalMySQLConfigFile = f.Name()
+
+	connector, err := externalMySQLConnector("legacy")
+	require.NoError(t, err)
+	params, err := connector.MysqlParams()
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-db", params.Host)
+	assert.Equal(t, 3306, params.Port)
+	assert.Equal(t, "repl", params.Uname)
+	assert.Equal(t, "secret", params.Pass)
+	assert.Equal(t, "MySQL56", params.Flavor)
+	assert.Equal(t, "commerce", connector.DBName())
+
+	_, err = externalMySQLConnector("nodb")
+	assert.EqualError(t, err, "external mysql nodb has no dbname")
+
+	_, err = externalMySQLConnector("unknown")
+	assert.EqualError(t, err, "external mysql unknown not found in "+f.Name())
+}
@@ -0,0 +1,555 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	// parallelApplyByPK applies the changes of different rows in parallel.
+	parallelApplyByPK = "pk"
+	// parallelApplyByWriteset applies the source transactions which
+	// write different rows in parallel.
+	parallelApplyByWriteset = "writeset"
+
+	// parallelApplyLockWaitTimeout is the lock wait timeout of the workers,
+	// in seconds. The workers never write the same rows, so a lock wait means
+	// that rows conflict on another unique key, and that the transaction is
+	// better applied serially.
+	parallelApplyLockWaitTimeout = 1
+)
+
+var (
+	parallelApplyWorkers = flag.Int("vreplication_parallel_apply_workers", 1, "default number of connections which apply the row changes of a vreplication stream in parallel, for the streams which don't set parallel_apply_workers in their source. 1 applies them serially")
+	parallelApplyMode    = flag.String("vreplication_parallel_apply_mode", parallelApplyByPK, "default distribution of the row changes to the parallel apply workers, for the streams which don't set parallel_apply_mode in their source: pk applies the changes of different rows in parallel, writeset applies the source transactions which write different rows in parallel")
+)
+
+// parallelApplier applies the row changes of the transactions of a vplayer
+// with several workers, each with its own connection.
+//
+// The changes which write the same rows, based on their primary key, are
+// applied in order by the same worker. Each worker applies its changes in
+// an XA transaction, which it prepares. The vplayer then saves the position,
+// and the prepared transactions are committed. If the tablet fails before
+// they are committed, recoverParallelApply commits them if the position was
+// saved, and rolls them back otherwise.
+//
+// If a worker fails, typically because rows conflict on a secondary unique
+// key, all the workers are rolled back and the changes are applied serially
+// by the vplayer connection.
+type parallelApplier struct {
+	vr         *vreplicator
+	byWriteset bool
+	workers    []*applyWorker
+
+	// units are the units of the current transaction, in the order of
+	// the events. The current transaction can contain several source
+	// transactions.
+	units []*applyUnit
+	// current is the unit of the current source transaction in writeset mode.
+	current *applyUnit
+	// serial is set if a row change can't be applied in parallel.
+	serial bool
+}
+
+// applyUnit is a sequence of row changes which are applied in order by the
+// same worker. It's a single row change in pk mode, and a source transaction
+// in writeset mode.
+type applyUnit struct {
+	changes []unitChange
+	keys    []string
+	// parent is used to merge the units which write the same rows.
+	parent *applyUnit
+}
+
+type unitChange struct {
+	tplan  *TablePlan
+	change *binlogdatapb.RowChange
+}
+
+type applyWorker struct {
+	id       int
+	dbClient binlogplayer.DBClient
+	stats    *binlogplayer.Stats
+	// xid is set while the worker is in an XA transaction.
+	xid      string
+	prepared bool
+}
+
+// newParallelApplier first resolves the transactions which were left prepared
+// by a previous run, even if parallel apply was disabled since. It returns nil
+// if parallel apply is disabled. The settings of the source of the stream
+// override the flags.
+func newParallelApplier(vr *vreplicator, pos mysql.Position) (*parallelApplier, error) {
+	if vr.vre == nil {
+		return nil, nil
+	}
+	if err := recoverParallelApply(vr.dbClient, vr.id, pos); err != nil {
+		return nil, vterrors.Wrap(err, "recoverParallelApply")
+	}
+	workers, mode := int64(*parallelApplyWorkers), *parallelApplyMode
+	if vr.source.ParallelApplyWorkers != 0 {
+		workers = vr.source.ParallelApplyWorkers
+	}
+	if vr.source.ParallelApplyMode != "" {
+		mode = vr.source.ParallelApplyMode
+	}
+	if workers <= 1 {
+		return nil, nil
+	}
+	pa := &parallelApplier{vr: vr}
+	switch mode {
+	case parallelApplyByPK:
+	case parallelApplyByWriteset:
+		pa.byWriteset = true
+	default:
+		return nil, fmt.Errorf("unknown parallel apply mode: %v", mode)
+	}
+	for i := 0; i < int(workers); i++ {
+		dbClient := vr.vre.dbClientFactory()
+		if err := dbClient.Connect(); err != nil {
+			pa.close()
+			return nil, vterrors.Wrap(err, "can't connect to database")
+		}
+		pa.workers = append(pa.workers, &applyWorker{
+			id:       i,
+			dbClient: dbClient,
+			stats:    vr.stats,
+		})
+		// The same session settings as the controller.
+		for _, query := range []string{
+			"set @@session.time_zone = '+00:00'",
+			"set names binary",
+			fmt.Sprintf("set @@session.innodb_lock_wait_timeout = %d", parallelApplyLockWaitTimeout),
+		} {
+			if _, err := dbClient.ExecuteFetch(query, 0); err != nil {
+				pa.close()
+				return nil, err
+			}
+		}
+	}
+	return pa, nil
+}
+
+// close closes the connections of the workers. The transactions which are
+// not prepared are rolled back by MySQL, the prepared ones are resolved by
+// recoverParallelApply when the stream restarts.
+func (pa *parallelApplier) close() {
+	if pa == nil {
+		return
+	}
+	for _, worker := range pa.workers {
+		worker.dbClient.Close()
+	}
+}
+
+// pending returns true if row changes are waiting to be applied.
+func (pa *parallelApplier) pending() bool {
+	return pa != nil && len(pa.units) != 0
+}
+
+// addRowEvent adds the row changes of an event to the current transaction.
+func (pa *parallelApplier) addRowEvent(tplan *TablePlan, rowEvent *binlogdatapb.RowEvent) {
+	for _, change := range rowEvent.RowChanges {
+		keys, ok := rowChangeKeys(tplan, change)
+		if !ok {
+			pa.serial = true
+		}
+		unit := pa.current
+		if unit == nil {
+			unit = &applyUnit{}
+			pa.units = append(pa.units, unit)
+			if pa.byWriteset {
+				pa.current = unit
+			}
+		}
+		unit.changes = append(unit.changes, unitChange{tplan: tplan, change: change})
+		unit.keys = append(unit.keys, keys...)
+	}
+}
+
+// endTransaction ends the current source transaction.
+func (pa *parallelApplier) endTransaction() {
+	if pa == nil {
+		return
+	}
+	pa.current = nil
+}
+
+// prepare applies the row changes of the current transaction with the workers,
+// and prepares their transactions. If that fails, the changes are applied
+// serially with apply instead.
+func (pa *parallelApplier) prepare(ctx context.Context, pos mysql.Position, apply func(*TablePlan, *binlogdatapb.RowChange) error) error {
+	if !pa.pending() {
+		return nil
+	}
+	if !pa.serial {
+		err := pa.prepareWorkers(ctx, pos)
+		if err == nil {
+			pa.reset()
+			return nil
+		}
+		if err == io.EOF {
+			return err
+		}
+		log.Infof("Parallel apply failed for vreplication stream %d, applying the transaction serially: %v", pa.vr.id, err)
+		pa.rollback()
+	}
+	pa.vr.stats.ParallelApplyFallbacks.Add(1)
+	return pa.flush(apply)
+}
+
+// flush applies the pending row changes serially with apply, in the order
+// of the events.
+func (pa *parallelApplier) flush(apply func(*TablePlan, *binlogdatapb.RowChange) error) error {
+	if !pa.pending() {
+		return nil
+	}
+	defer pa.reset()
+	for _, unit := range pa.units {
+		for _, uc := range unit.changes {
+			if err := apply(uc.tplan, uc.change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (pa *parallelApplier) reset() {
+	pa.units = nil
+	pa.current = nil
+	pa.serial = false
+}
+
+func (pa *parallelApplier) prepareWorkers(ctx context.Context, pos mysql.Position) error {
+	gtrid := parallelApplyGtrid(pa.vr.id, pos)
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for i, units := range assignUnits(pa.units, len(pa.workers)) {
+		if len(units) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(worker *applyWorker, units []*applyUnit) {
+			defer wg.Done()
+			if err := worker.prepare(ctx, gtrid, units); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(pa.workers[i], units)
+	}
+	wg.Wait()
+	select {
+	case <-ctx.Done():
+		pa.rollback()
+		return io.EOF
+	default:
+	}
+	return allErrors.Error()
+}
+
+// rollback rolls back the transactions of the workers.
+func (pa *parallelApplier) rollback() {
+	if pa == nil {
+		return
+	}
+	for _, worker := range pa.workers {
+		worker.rollback()
+	}
+}
+
+// commit commits the prepared transactions of the workers. It must be called
+// after the position of the transaction is saved. A transaction which can't be
+// committed remains prepared, and is committed by recoverParallelApply when
+// the stream restarts.
+func (pa *parallelApplier) commit() error {
+	if pa == nil {
+		return nil
+	}
+	var firstErr error
+	for _, worker := range pa.workers {
+		if !worker.prepared {
+			continue
+		}
+		if _, err := worker.dbClient.ExecuteFetch("xa commit "+worker.xid, 0); err != nil && firstErr == nil {
+			firstErr = vterrors.Wrapf(err, "commit of parallel apply worker %d", worker.id)
+		}
+		// The position is saved: the transaction must not be rolled back.
+		worker.xid = ""
+		worker.prepared = false
+	}
+	return firstErr
+}
+
+// prepare applies the units in an XA transaction, and prepares it. Like
+// ExecuteWithRetry, it retries the transaction after a deadlock. It doesn't
+// retry after a lock wait timeout, because the rows may be locked by the
+// prepared transaction of another worker: the changes are applied serially
+// instead.
+func (w *applyWorker) prepare(ctx context.Context, gtrid string, units []*applyUnit) error {
+	for {
+		err := w.tryPrepare(ctx, gtrid, units)
+		if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERLockDeadlock {
+			return err
+		}
+		log.Infof("retryable error in parallel apply worker %d: %v, waiting for %v and retrying", w.id, err, dbLockRetryDelay)
+		w.rollback()
+		time.Sleep(dbLockRetryDelay)
+		// Check context here. Otherwise this can become an infinite loop.
+		select {
+		case <-ctx.Done():
+			return io.EOF
+		default:
+		}
+	}
+}
+
+func (w *applyWorker) tryPrepare(ctx context.Context, gtrid string, units []*applyUnit) error {
+	xid := fmt.Sprintf("%s,%s", encodeString(gtrid), encodeString(strconv.Itoa(w.id)))
+	if _, err := w.dbClient.ExecuteFetch("xa start "+xid, 0); err != nil {
+		return err
+	}
+	w.xid = xid
+	execute := func(sql string) (*sqltypes.Result, error) {
+		stats := NewVrLogStats("ROWCHANGE")
+		result, err := w.dbClient.ExecuteFetch(sql, 0)
+		stats.Send(sql)
+		return result, err
+	}
+	rowChanges := 0
+	for _, unit := range units {
+		select {
+		case <-ctx.Done():
+			return io.EOF
+		default:
+		}
+		for _, uc := range unit.changes {
+			if _, err := uc.tplan.applyChange(uc.change, execute); err != nil {
+				return err
+			}
+		}
+		rowChanges += len(unit.changes)
+	}
+	if _, err := w.dbClient.ExecuteFetch("xa end "+xid, 0); err != nil {
+		return err
+	}
+	if _, err := w.dbClient.ExecuteFetch("xa prepare "+xid, 0); err != nil {
+		return err
+	}
+	w.prepared = true
+	w.stats.ParallelApplyRowChanges.Add(strconv.Itoa(w.id), int64(rowChanges))
+	return nil
+}
+
+func (w *applyWorker) rollback() {
+	if w.xid == "" {
+		return
+	}
+	if !w.prepared {
+		// This fails if the transaction already ended, which is fine.
+		_, _ = w.dbClient.ExecuteFetch("xa end "+w.xid, 0)
+	}
+	if _, err := w.dbClient.ExecuteFetch("xa rollback "+w.xid, 0); err != nil {
+		log.Warningf("Could not roll back parallel apply worker %d: %v", w.id, err)
+	}
+	w.xid = ""
+	w.prepared = false
+}
+
+// assignUnits merges the units which write the same rows, and distributes
+// the merged units to the workers, the largest first, each to the worker
+// with the fewest row changes. The units of a worker remain in the order
+// of the events.
+func assignUnits(units []*applyUnit, workers int) [][]*applyUnit {
+	owners := make(map[string]*applyUnit)
+	for _, unit := range units {
+		unit.parent = nil
+		for _, key := range unit.keys {
+			owner, ok := owners[key]
+			if !ok {
+				owners[key] = unit
+				continue
+			}
+			if r1, r2 := owner.root(), unit.root(); r1 != r2 {
+				r2.parent = r1
+			}
+		}
+	}
+
+	var roots []*applyUnit
+	groups := make(map[*applyUnit][]*applyUnit)
+	sizes := make(map[*applyUnit]int)
+	for _, unit := range units {
+		root := unit.root()
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], unit)
+		sizes[root] += len(unit.changes)
+	}
+	sort.SliceStable(roots, func(i, j int) bool { return sizes[roots[i]] > sizes[roots[j]] })
+
+	assignment := make([][]*applyUnit, workers)
+	loads := make([]int, workers)
+	for _, root := range roots {
+		worker := 0
+		for i := range loads {
+			if loads[i] < loads[worker] {
+				worker = i
+			}
+		}
+		assignment[worker] = append(assignment[worker], groups[root]...)
+		loads[worker] += sizes[root]
+	}
+	// Merged groups were appended in assignment order: restore the event order.
+	order := make(map[*applyUnit]int, len(units))
+	for i, unit := range units {
+		order[unit] = i
+	}
+	for _, assigned := range assignment {
+		sort.Slice(assigned, func(i, j int) bool { return order[assigned[i]] < order[assigned[j]] })
+	}
+	return assignment
+}
+
+func (u *applyUnit) root() *applyUnit {
+	for u.parent != nil {
+		if u.parent.parent != nil {
+			u.parent = u.parent.parent
+		}
+		u = u.parent
+	}
+	return u
+}
+
+// rowChangeKeys returns the keys of the rows written by the change: its
+// target table and the values of its primary key, before and after the
+// change. Text values are normalized so that the rows which may be equal
+// in a case insensitive collation get the same key. It returns false if
+// the table has no primary key.
+func rowChangeKeys(tplan *TablePlan, change *binlogdatapb.RowChange) ([]string, bool) {
+	if len(tplan.PKReferences) == 0 {
+		return nil, false
+	}
+	var keys []string
+	for _, row := range []*querypb.Row{change.Before, change.After} {
+		if row == nil {
+			continue
+		}
+		values := sqltypes.MakeRowTrusted(tplan.Fields, row)
+		var key strings.Builder
+		key.WriteString(tplan.TargetName)
+		for _, pkref := range tplan.PKReferences {
+			key.WriteByte(0)
+			for i, field := range tplan.Fields {
+				if field.Name != pkref {
+					continue
+				}
+				switch {
+				case values[i].IsNull():
+					key.WriteByte(1)
+				case sqltypes.IsText(field.Type):
+					key.WriteString(strings.ToLower(strings.TrimRight(values[i].ToString(), " ")))
+				default:
+					key.WriteString(values[i].ToString())
+				}
+				break
+			}
+		}
+		keys = append(keys, key.String())
+	}
+	return keys, true
+}
+
+// parallelApplyGtrid returns the XA global transaction id of the workers of a
+// stream for a transaction. It contains a hash of the position the vplayer
+// saves with the transaction, so that recoverParallelApply can tell if the
+// position was saved.
+func parallelApplyGtrid(id uint32, pos mysql.Position) string {
+	h := fnv.New64a()
+	h.Write([]byte(mysql.EncodePosition(pos)))
+	return fmt.Sprintf("%s%016x", parallelApplyGtridPrefix(id), h.Sum64())
+}
+
+func parallelApplyGtridPrefix(id uint32) string {
+	return fmt.Sprintf("vrepl_%d_", id)
+}
+
+// recoverParallelApply commits the prepared transactions of the workers of
+// the stream if their position is the saved position, and rolls back the
+// others.
+func recoverParallelApply(dbClient binlogplayer.DBClient, id uint32, pos mysql.Position) error {
+	qr, err := dbClient.ExecuteFetch("xa recover", 10000)
+	if err != nil {
+		return err
+	}
+	prefix := parallelApplyGtridPrefix(id)
+	savedGtrid := parallelApplyGtrid(id, pos)
+	for _, row := range qr.Rows {
+		// The columns are formatID, gtrid_length, bqual_length and data.
+		if len(row) != 4 {
+			return fmt.Errorf("unexpected xa recover row: %v", row)
+		}
+		gtridLength, err := sqltypes.ToInt64(row[1])
+		if err != nil {
+			return err
+		}
+		bqualLength, err := sqltypes.ToInt64(row[2])
+		if err != nil {
+			return err
+		}
+		data := row[3].ToString()
+		if int64(len(data)) < gtridLength+bqualLength {
+			return fmt.Errorf("unexpected xa recover row: %v", row)
+		}
+		gtrid := data[:gtridLength]
+		if !strings.HasPrefix(gtrid, prefix) {
+			continue
+		}
+		xid := fmt.Sprintf("%s,%s", encodeString(gtrid), encodeString(data[gtridLength:gtridLength+bqualLength]))
+		action := "rollback"
+		if gtrid == savedGtrid {
+			action = "commit"
+		}
+		log.Infof("Recovering parallel apply transaction %s of vreplication stream %d: %s", xid, id, action)
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf("xa %s %s", action, xid), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func parallelApplyTestPlan() *TablePlan {
+	return &TablePlan{
+		TargetName: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "name",
+			Type: sqltypes.VarChar,
+		}},
+		PKReferences: []string{"id", "name"},
+	}
+}
+
+func parallelApplyTestChange(before, after []sqltypes.Value) *binlogdatapb.RowChange {
+	change := &binlogdatapb.RowChange{}
+	if before != nil {
+		change.Before = sqltypes.RowToProto3(before)
+	}
+	if after != nil {
+		change.After = sqltypes.RowToProto3(after)
+	}
+	return change
+}
+
+func TestRowChangeKeys(t *testing.T) {
+	tplan := parallelApplyTestPlan()
+
+	keys, ok := rowChangeKeys(tplan, parallelApplyTestChange(
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("Abc  ")},
+		[]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NULL},
+	))
+	require.True(t, ok)
+	assert.Equal(t, []string{"t1\x001\x00abc", "t1\x002\x00\x01"}, keys)
+
+	// Rows which may be equal in a case insensitive collation get the same key.
+	keys2, _ := rowChangeKeys(tplan, parallelApplyTestChange(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("ABC")}))
+	assert.Equal(t, keys[:1], keys2)
+
+	tplan.PKReferences = nil
+	_, ok = rowChangeKeys(tplan, parallelApplyTestChange(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}))
+	assert.False(t, ok)
+}
+
+func TestAssignUnits(t *testing.T) {
+	unit := func(keys ...string) *applyUnit {
+		u := &applyUnit{keys: keys}
+		for range keys {
+			u.changes = append(u.changes, unitChange{})
+		}
+		return u
+	}
+	u1 := unit("a")
+	u2 := unit("b")
+	u3 := unit("c", "d")
+	u4 := unit("a", "e")
+	u5 := unit("e")
+	u6 := unit("f")
+
+	// u1, u4 and u5 write the same rows, and must be applied in order by
+	// the same worker. They're the largest group, assigned first.
+	got := assignUnits([]*applyUnit{u1, u2, u3, u4, u5, u6}, 3)
+	want := [][]*applyUnit{{u1, u4, u5}, {u3}, {u2, u6}}
+	assert.Equal(t, want, got)
+}
+
+func TestParallelApplierUnits(t *testing.T) {
+	tplan := parallelApplyTestPlan()
+	event := func(ids ...int64) *binlogdatapb.RowEvent {
+		rowEvent := &binlogdatapb.RowEvent{TableName: "t1"}
+		for _, id := range ids {
+			rowEvent.RowChanges = append(rowEvent.RowChanges, parallelApplyTestChange(nil, []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarChar("a")}))
+		}
+		return rowEvent
+	}
+
+	testcases := []struct {
+		byWriteset bool
+		want       []int
+	}{{
+		byWriteset: false,
+		want:       []int{1, 1, 1, 1},
+	}, {
+		byWriteset: true,
+		want:       []int{3, 1},
+	}}
+	for _, tcase := range testcases {
+		pa := &parallelApplier{byWriteset: tcase.byWriteset}
+		pa.addRowEvent(tplan, event(1, 2))
+		pa.addRowEvent(tplan, event(3))
+		pa.endTransaction()
+		pa.addRowEvent(tplan, event(4))
+		var got []int
+		for _, unit := range pa.units {
+			got = append(got, len(unit.changes))
+		}
+		assert.Equal(t, tcase.want, got, "byWriteset: %v", tcase.byWriteset)
+		assert.True(t, pa.pending())
+		assert.False(t, pa.serial)
+	}
+
+	// A nil parallelApplier has nothing pending.
+	var pa *parallelApplier
+	assert.False(t, pa.pending())
+	pa.endTransaction()
+	assert.NoError(t, pa.commit())
+}
+
+func TestParallelApplierPrepare(t *testing.T) {
+	pos, err := mysql.DecodePosition("MariaDB/0-1-1083")
+	require.NoError(t, err)
+	gtrid := parallelApplyGtrid(1, pos)
+
+	tplan, err := buildReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}}}, map[string][]string{"t1": {"id"}}, nil)
+	require.NoError(t, err)
+	plan, err := tplan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	})
+	require.NoError(t, err)
+
+	newApplier := func() (*parallelApplier, []*binlogplayer.MockDBClient) {
+		stats := binlogplayer.NewStats()
+		pa := &parallelApplier{vr: &vreplicator{id: 1, stats: stats}}
+		var clients []*binlogplayer.MockDBClient
+		for i := 0; i < 2; i++ {
+			dbClient := binlogplayer.NewMockDBClient(t)
+			clients = append(clients, dbClient)
+			pa.workers = append(pa.workers, &applyWorker{id: i, dbClient: dbClient, stats: stats})
+		}
+		pa.addRowEvent(plan, &binlogdatapb.RowEvent{
+			TableName: "t1",
+			RowChanges: []*binlogdatapb.RowChange{
+				parallelApplyTestChange(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")}),
+				parallelApplyTestChange(nil, []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewVarBinary("b")}),
+				parallelApplyTestChange([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")}, nil),
+			},
+		})
+		return pa, clients
+	}
+	xid := func(worker int) string {
+		return fmt.Sprintf("'%s','%d'", gtrid, worker)
+	}
+
+	// The changes of id 1 are applied by the first worker, in order.
+	pa, clients := newApplier()
+	clients[0].ExpectRequest("xa start "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("insert into t1(id,val) values (1,'a')", &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("delete from t1 where id=1", &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa end "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa prepare "+xid(0), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa start "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("insert into t1(id,val) values (2,'b')", &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa end "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa prepare "+xid(1), &sqltypes.Result{}, nil)
+	err = pa.prepare(context.Background(), pos, func(*TablePlan, *binlogdatapb.RowChange) error {
+		t.Fatal("unexpected serial apply")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, pa.pending())
+	clients[0].ExpectRequest("xa commit "+xid(0), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa commit "+xid(1), &sqltypes.Result{}, nil)
+	require.NoError(t, pa.commit())
+	clients[0].Wait()
+	clients[1].Wait()
+	assert.Equal(t, map[string]int64{"0": 2, "1": 1}, pa.vr.stats.ParallelApplyRowChanges.Counts())
+
+	// If a worker fails, the workers are rolled back and the changes are
+	// applied serially.
+	pa, clients = newApplier()
+	clients[0].ExpectRequest("xa start "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("insert into t1(id,val) values (1,'a')", nil, errors.New("lock wait timeout"))
+	clients[0].ExpectRequest("xa end "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa rollback "+xid(0), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa start "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("insert into t1(id,val) values (2,'b')", &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa end "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa prepare "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa rollback "+xid(1), &sqltypes.Result{}, nil)
+	applied := 0
+	err = pa.prepare(context.Background(), pos, func(*TablePlan, *binlogdatapb.RowChange) error {
+		applied++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, applied)
+	assert.Equal(t, int64(1), pa.vr.stats.ParallelApplyFallbacks.Get())
+	require.NoError(t, pa.commit())
+	clients[0].Wait()
+	clients[1].Wait()
+
+	// A deadlock is retried.
+	savedDelay := dbLockRetryDelay
+	dbLockRetryDelay = 1 * time.Millisecond
+	defer func() { dbLockRetryDelay = savedDelay }()
+	pa, clients = newApplier()
+	clients[0].ExpectRequest("xa start "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("insert into t1(id,val) values (1,'a')", nil, mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "deadlock"))
+	clients[0].ExpectRequest("xa end "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa rollback "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa start "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("insert into t1(id,val) values (1,'a')", &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("delete from t1 where id=1", &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa end "+xid(0), &sqltypes.Result{}, nil)
+	clients[0].ExpectRequest("xa prepare "+xid(0), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa start "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("insert into t1(id,val) values (2,'b')", &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa end "+xid(1), &sqltypes.Result{}, nil)
+	clients[1].ExpectRequest("xa prepare "+xid(1), &sqltypes.Result{}, nil)
+	err = pa.prepare(context.Background(), pos, func(*TablePlan, *binlogdatapb.RowChange) error {
+		t.Fatal("unexpected serial apply")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"0": 2, "1": 1}, pa.vr.stats.ParallelApplyRowChanges.Counts())
+	clients[0].Wait()
+	clients[1].Wait()
+}
+
+func TestRecoverParallelApply(t *testing.T) {
+	pos, err := mysql.DecodePosition("MariaDB/0-1-1083")
+	require.NoError(t, err)
+	saved := parallelApplyGtrid(1, pos)
+	other := parallelApplyGtrid(1, mysql.Position{})
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequest("xa recover", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"formatID|gtrid_length|bqual_length|data",
+			"int64|int64|int64|varchar",
+		),
+		fmt.Sprintf("1|%d|1|%s0", len(saved), saved),
+		fmt.Sprintf("1|%d|1|%s1", len(other), other),
+		"1|8|1|vrepl_2_0",
+	), nil)
+	dbClient.ExpectRequest(fmt.Sprintf("xa commit '%s','0'", saved), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf("xa rollback '%s','1'", other), &sqltypes.Result{}, nil)
+	require.NoError(t, recoverParallelApply(dbClient, 1, pos))
+	dbClient.Wait()
+}
+
+func TestNewParallelApplier(t *testing.T) {
+	xaRecoverResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"formatID|gtrid_length|bqual_length|data",
+		"int64|int64|int64|varchar",
+	))
+	newVR := func(source *binlogdatapb.BinlogSource) (*vreplicator, *binlogplayer.MockDBClient) {
+		dbClient := binlogplayer.NewMockDBClient(t)
+		stats := binlogplayer.NewStats()
+		return &vreplicator{id: 1, source: source, stats: stats, vre: &Engine{}, dbClient: newVDBClient(dbClient, stats)}, dbClient
+	}
+
+	// The prepared transactions are recovered even if parallel apply
+	// is disabled.
+	vr, dbClient := newVR(&binlogdatapb.BinlogSource{})
+	dbClient.ExpectRequest("xa recover", xaRecoverResult, nil)
+	pa, err := newParallelApplier(vr, mysql.Position{})
+	require.NoError(t, err)
+	assert.Nil(t, pa)
+	dbClient.Wait()
+
+	// The settings of the source override the flags.
+	vr, dbClient = newVR(&binlogdatapb.BinlogSource{ParallelApplyWorkers: 2, ParallelApplyMode: "rows"})
+	dbClient.ExpectRequest("xa recover", xaRecoverResult, nil)
+	_, err = newParallelApplier(vr, mysql.Position{})
+	assert.EqualError(t, err, "unknown parallel apply mode: rows")
+	dbClient.Wait()
+}
@@ -167,7 +167,11 @@ type TablePlan struct {
 	Insert *sqlparser.ParsedQuery
 	Update *sqlparser.ParsedQuery
 	Delete *sqlparser.ParsedQuery
-	Fields []*querypb.Field
+	// DeleteEmpty is run after Delete for the plans which
+	// aggregate with a count(*), to delete the groups which
+	// have no rows left.
+	DeleteEmpty *sqlparser.ParsedQuery
+	Fields      []*querypb.Field
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
@@ -184,6 +188,7 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Insert       *sqlparser.ParsedQuery `json:",omitempty"`
 		Update       *sqlparser.ParsedQuery `json:",omitempty"`
 		Delete       *sqlparser.ParsedQuery `json:",omitempty"`
+		DeleteEmpty  *sqlparser.ParsedQuery `json:",omitempty"`
 		PKReferences []string               `json:",omitempty"`
 	}{
 		TargetName:   tp.TargetName,
@@ -194,6 +199,7 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Insert:       tp.Insert,
 		Update:       tp.Update,
 		Delete:       tp.Delete,
+		DeleteEmpty:  tp.DeleteEmpty,
 		PKReferences: tp.PKReferences,
 	}
 	return json.Marshal(&v)
@@ -247,13 +253,13 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 		if tp.Delete == nil {
 			return nil, nil
 		}
-		return execParsedQuery(tp.Delete, bindvars, executor)
+		return tp.applyDelete(bindvars, executor)
 	case before && after:
 		if !tp.pkChanged(bindvars) {
 			return execParsedQuery(tp.Update, bindvars, executor)
 		}
 		if tp.Delete != nil {
-			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
+			if _, err := tp.applyDelete(bindvars, executor); err != nil {
 				return nil, err
 			}
 		}
@@ -263,6 +269,18 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	return nil, nil
 }
 
+// applyDelete runs Delete, and then DeleteEmpty if it's set.
+func (tp *TablePlan) applyDelete(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	qr, err := execParsedQuery(tp.Delete, bindvars, executor)
+	if err != nil || tp.DeleteEmpty == nil {
+		return qr, err
+	}
+	if _, err := execParsedQuery(tp.DeleteEmpty, bindvars, executor); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
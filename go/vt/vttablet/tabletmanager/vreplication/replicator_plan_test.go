@@ -22,8 +22,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -41,6 +43,7 @@ type TestTablePlan struct {
 	Insert       string   `json:",omitempty"`
 	Update       string   `json:",omitempty"`
 	Delete       string   `json:",omitempty"`
+	DeleteEmpty  string   `json:",omitempty"`
 	PKReferences []string `json:",omitempty"`
 }
 
@@ -313,6 +316,112 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// aggregates of expressions, grouped by the source column
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c as c1, sum(a*b) as s, count(a+b) as n from t2 group by c",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c, a, b from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c"},
+					InsertFront:  "insert into t1(c1,s,n)",
+					InsertValues: "(:a_c,ifnull(:a_a * :a_b, 0),if((:a_a + :a_b) is null, 0, 1))",
+					InsertOnDup:  "on duplicate key update s=s+ifnull(values(s), 0), n=n+values(n)",
+					Insert:       "insert into t1(c1,s,n) values (:a_c,ifnull(:a_a * :a_b, 0),if((:a_a + :a_b) is null, 0, 1)) on duplicate key update s=s+ifnull(values(s), 0), n=n+values(n)",
+					Update:       "update t1 set s=s-ifnull(:b_a * :b_b, 0)+ifnull(:a_a * :a_b, 0), n=n-if((:b_a + :b_b) is null, 0, 1)+if((:a_a + :a_b) is null, 0, 1) where c1=:b_c",
+					Delete:       "update t1 set s=s-ifnull(:b_a * :b_b, 0), n=n-if((:b_a + :b_b) is null, 0, 1) where c1=:b_c",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c, a, b, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,s,n)",
+					InsertValues: "(:a_c,ifnull(:a_a * :a_b, 0),if((:a_a + :a_b) is null, 0, 1))",
+					InsertOnDup:  "on duplicate key update s=s+ifnull(values(s), 0), n=n+values(n)",
+					Insert:       "insert into t1(c1,s,n) select :a_c, ifnull(:a_a * :a_b, 0), if((:a_a + :a_b) is null, 0, 1) from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update s=s+ifnull(values(s), 0), n=n+values(n)",
+					Update:       "update t1 set s=s-ifnull(:b_a * :b_b, 0)+ifnull(:a_a * :a_b, 0), n=n-if((:b_a + :b_b) is null, 0, 1)+if((:a_a + :a_b) is null, 0, 1) where c1=:b_c and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set s=s-ifnull(:b_a * :b_b, 0), n=n-if((:b_a + :b_b) is null, 0, 1) where c1=:b_c and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
+	}, {
+		// aggregates
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, count(c2) as c2, sum(c3) as c3, count(*) as c4 from t2 group by c1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c3, 0),1)",
+					InsertOnDup:  "on duplicate key update c2=c2+values(c2), c3=c3+ifnull(values(c3), 0), c4=c4+1",
+					Insert:       "insert into t1(c1,c2,c3,c4) values (:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c3, 0),1) on duplicate key update c2=c2+values(c2), c3=c3+ifnull(values(c3), 0), c4=c4+1",
+					Update:       "update t1 set c2=c2-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), c3=c3-ifnull(:b_c3, 0)+ifnull(:a_c3, 0), c4=c4 where c1=:b_c1",
+					Delete:       "update t1 set c2=c2-if(:b_c2 is null, 0, 1), c3=c3-ifnull(:b_c3, 0), c4=c4-1 where c1=:b_c1",
+					DeleteEmpty:  "delete from t1 where c1=:b_c1 and c4=0",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c3, 0),1)",
+					InsertOnDup:  "on duplicate key update c2=c2+values(c2), c3=c3+ifnull(values(c3), 0), c4=c4+1",
+					Insert:       "insert into t1(c1,c2,c3,c4) select :a_c1, if(:a_c2 is null, 0, 1), ifnull(:a_c3, 0), 1 from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update c2=c2+values(c2), c3=c3+ifnull(values(c3), 0), c4=c4+1",
+					Update:       "update t1 set c2=c2-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), c3=c3-ifnull(:b_c3, 0)+ifnull(:a_c3, 0), c4=c4 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set c2=c2-if(:b_c2 is null, 0, 1), c3=c3-ifnull(:b_c3, 0), c4=c4-1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					DeleteEmpty:  "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and c4=0",
+				},
+			},
+		},
 	}, {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
@@ -602,14 +711,14 @@ func TestBuildPlayerPlan(t *testing.T) {
 		},
 		err: "expression needs an alias: hour(c1)",
 	}, {
-		// only count(*)
+		// only count(*) and count(column)
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
-				Filter: "select count(c1) as c from t1",
+				Filter: "select count(max(c1)) as c from t1",
 			}},
 		},
-		err: "only count(*) is supported: count(c1)",
+		err: "unexpected: max(c1)",
 	}, {
 		// no sum(*)
 		input: &binlogdatapb.Filter{
@@ -633,10 +742,10 @@ func TestBuildPlayerPlan(t *testing.T) {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
-				Filter: "select sum(a + b) as c from t1",
+				Filter: "select sum(a + (select b from t2)) as c from t1",
 			}},
 		},
-		err: "unexpected: sum(a + b)",
+		err: "unsupported subquery: (select b from t2)",
 	}, {
 		// no complex expr in group by
 		input: &binlogdatapb.Filter{
@@ -651,10 +760,10 @@ func TestBuildPlayerPlan(t *testing.T) {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
-				Filter: "select a as b from t1 group by a",
+				Filter: "select a as b from t1 group by c",
 			}},
 		},
-		err: "group by expression does not reference an alias in the select list: a",
+		err: "group by expression does not reference an alias in the select list: c",
 	}, {
 		// cannot group by aggr
 		input: &binlogdatapb.Filter{
@@ -765,3 +874,48 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestApplyChangeDeleteEmpty(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, count(*) as c2 from t2 group by c1",
+		}},
+	}
+	rp, err := buildReplicatorPlan(input, map[string][]string{"t1": {"c1"}}, nil)
+	require.NoError(t, err)
+	tplan, err := rp.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t2",
+		Fields: []*querypb.Field{{
+			Name: "c1",
+			Type: sqltypes.Int64,
+		}},
+	})
+	require.NoError(t, err)
+
+	var got []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		got = append(got, sql)
+		return &sqltypes.Result{}, nil
+	}
+	row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})
+	row2 := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2)})
+
+	// The group is deleted after its last row.
+	_, err = tplan.applyChange(&binlogdatapb.RowChange{Before: row}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"update t1 set c2=c2-1 where c1=1",
+		"delete from t1 where c1=1 and c2=0",
+	}, got)
+
+	// A row which moves to another group leaves the previous one.
+	got = nil
+	_, err = tplan.applyChange(&binlogdatapb.RowChange{Before: row, After: row2}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"update t1 set c2=c2-1 where c1=1",
+		"delete from t1 where c1=1 and c2=0",
+		"insert into t1(c1,c2) values (2,1) on duplicate key update c2=c2+1",
+	}, got)
+}
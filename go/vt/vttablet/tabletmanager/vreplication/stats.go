@@ -23,6 +23,8 @@ import (
 
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/servenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var (
@@ -72,6 +74,50 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationThrottledSeconds",
+		"vreplication seconds throttled per stream",
+		[]string{"counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[fmt.Sprintf("%v", ct.id)] = int64(ct.blpStats.ThrottledTime.Get().Seconds())
+			}
+			return result
+		})
+
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationParallelApplyRowChanges",
+		"vreplication row changes applied per stream and parallel apply worker",
+		[]string{"stream", "worker"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64)
+			for _, ct := range st.controllers {
+				for worker, count := range ct.blpStats.ParallelApplyRowChanges.Counts() {
+					result[fmt.Sprintf("%v.%v", ct.id, worker)] = count
+				}
+			}
+			return result
+		})
+
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationParallelApplyFallbacks",
+		"vreplication transactions applied serially because they could not be applied in parallel, per stream",
+		[]string{"counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[fmt.Sprintf("%v", ct.id)] = ct.blpStats.ParallelApplyFallbacks.Get()
+			}
+			return result
+		})
+
 	stats.NewCounterFunc(
 		"VReplicationTotalSecondsBehindMaster",
 		"vreplication seconds behind master aggregated across all streams",
@@ -100,6 +146,53 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowRowsPerSecond",
+		"vreplication rows applied per second, aggregated across the streams of each workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 {
+				rows, _ := ct.blpStats.ThroughputPerSecond()
+				return int64(rows)
+			}, sumInt64)
+		})
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowBytesPerSecond",
+		"vreplication bytes applied per second, aggregated across the streams of each workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 {
+				_, bytes := ct.blpStats.ThroughputPerSecond()
+				return int64(bytes)
+			}, sumInt64)
+		})
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowLagSeconds",
+		"vreplication transaction lag in seconds of the most lagging stream of each workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 {
+				return ct.blpStats.SecondsBehindMaster.Get()
+			}, maxInt64)
+		})
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowCatchupEtaSeconds",
+		"estimated seconds until all the streams of each workflow catch up, -1 if unknown",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 {
+				return ct.blpStats.CatchupETA()
+			}, func(a, b int64) int64 {
+				if a < 0 || b < 0 {
+					return -1
+				}
+				return maxInt64(a, b)
+			})
+		})
+
 	stats.Publish("VReplicationSource", stats.StringMapFunc(func() map[string]string {
 		st.mu.Lock()
 		defer st.mu.Unlock()
@@ -120,6 +213,33 @@ func (st *vrStats) register() {
 	}))
 }
 
+// perWorkflow returns the values of the streams of each workflow,
+// combined with combine.
+func (st *vrStats) perWorkflow(value func(ct *controller) int64, combine func(a, b int64) int64) map[string]int64 {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	result := make(map[string]int64)
+	for _, ct := range st.controllers {
+		v := value(ct)
+		if cur, ok := result[ct.workflow]; ok {
+			v = combine(cur, v)
+		}
+		result[ct.workflow] = v
+	}
+	return result
+}
+
+func sumInt64(a, b int64) int64 {
+	return a + b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 func (st *vrStats) numControllers() int64 {
 	st.mu.Lock()
 	defer st.mu.Unlock()
@@ -166,6 +286,17 @@ func (st *vrStats) status() *EngineStatus {
 	return status
 }
 
+// rowsBytes returns the size of the values of the rows.
+func rowsBytes(rows ...*querypb.Row) int64 {
+	var size int64
+	for _, row := range rows {
+		if row != nil {
+			size += int64(len(row.Values))
+		}
+	}
+	return size
+}
+
 // EngineStatus contains a renderable status of the Engine.
 type EngineStatus struct {
 	IsOpen      bool
@@ -19,6 +19,7 @@ package vreplication
 import (
 	"bytes"
 	"html/template"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -116,3 +117,30 @@ func TestStatusHtml(t *testing.T) {
 		t.Errorf("output: %v, want %v", buf, wantOut)
 	}
 }
+
+func TestStatsPerWorkflow(t *testing.T) {
+	newStats := func(sbm int64) *binlogplayer.Stats {
+		blpStats := binlogplayer.NewStats()
+		blpStats.SecondsBehindMaster.Set(sbm)
+		return blpStats
+	}
+	testStats := &vrStats{}
+	testStats.controllers = map[int]*controller{
+		1: {id: 1, workflow: "wf1", blpStats: newStats(3)},
+		2: {id: 2, workflow: "wf1", blpStats: newStats(5)},
+		3: {id: 3, workflow: "wf2", blpStats: newStats(0)},
+	}
+
+	lag := testStats.perWorkflow(func(ct *controller) int64 {
+		return ct.blpStats.SecondsBehindMaster.Get()
+	}, maxInt64)
+	if want := map[string]int64{"wf1": 5, "wf2": 0}; !reflect.DeepEqual(lag, want) {
+		t.Errorf("lag: %v, want %v", lag, want)
+	}
+	ids := testStats.perWorkflow(func(ct *controller) int64 {
+		return int64(ct.id)
+	}, sumInt64)
+	if want := map[string]int64{"wf1": 3, "wf2": 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids: %v, want %v", ids, want)
+	}
+}
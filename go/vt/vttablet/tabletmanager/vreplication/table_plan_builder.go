@@ -55,8 +55,11 @@ type tablePlanBuilder struct {
 type colExpr struct {
 	colName sqlparser.ColIdent
 	// operation==opExpr: full expression is set
-	// operation==opCount: nothing is set.
+	// operation==opCount: for 'count(a)', expr is set to 'a'. Nothing is
+	// set for 'count(*)'.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// The argument of count and sum can be any expression without
+	// aggregates, like 'a*b'.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -281,6 +284,7 @@ func (tpb *tablePlanBuilder) generate(tableKeys map[string][]string) *TablePlan
 		Insert:           tpb.generateInsertStatement(),
 		Update:           tpb.generateUpdateStatement(),
 		Delete:           tpb.generateDeleteStatement(),
+		DeleteEmpty:      tpb.generateDeleteEmptyStatement(),
 		PKReferences:     pkrefs,
 	}
 }
@@ -346,10 +350,22 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		}
 		switch fname := expr.Name.Lowered(); fname {
 		case "count":
-			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); !ok {
-				return nil, fmt.Errorf("only count(*) is supported: %v", sqlparser.String(expr))
+			if len(expr.Exprs) != 1 {
+				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
 			cexpr.operation = opCount
+			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); ok {
+				return cexpr, nil
+			}
+			// count(a) counts the rows where a is not null.
+			aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			if err := tpb.analyzeExprReferences(cexpr, aInner.Expr); err != nil {
+				return nil, err
+			}
+			cexpr.expr = aInner.Expr
 			return cexpr, nil
 		case "sum":
 			if len(expr.Exprs) != 1 {
@@ -359,17 +375,11 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if !ok {
 				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
-			innerCol, ok := aInner.Expr.(*sqlparser.ColName)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !innerCol.Qualifier.IsEmpty() {
-				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+			if err := tpb.analyzeExprReferences(cexpr, aInner.Expr); err != nil {
+				return nil, err
 			}
 			cexpr.operation = opSum
-			cexpr.expr = innerCol
-			tpb.addCol(innerCol.Name)
-			cexpr.references[innerCol.Name.Lowered()] = true
+			cexpr.expr = aInner.Expr
 			return cexpr, nil
 		case "keyspace_id":
 			if len(expr.Exprs) != 0 {
@@ -381,7 +391,18 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			return cexpr, nil
 		}
 	}
-	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+	if err := tpb.analyzeExprReferences(cexpr, aliased.Expr); err != nil {
+		return nil, err
+	}
+	cexpr.expr = aliased.Expr
+	return cexpr, nil
+}
+
+// analyzeExprReferences adds the columns referenced by the expression to
+// the send query and to the references of cexpr. The expression must not
+// contain aggregates or subqueries.
+func (tpb *tablePlanBuilder) analyzeExprReferences(cexpr *colExpr, expr sqlparser.Expr) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		switch node := node.(type) {
 		case *sqlparser.ColName:
 			if !node.Qualifier.IsEmpty() {
@@ -398,12 +419,7 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			}
 		}
 		return true, nil
-	}, aliased.Expr)
-	if err != nil {
-		return nil, err
-	}
-	cexpr.expr = aliased.Expr
-	return cexpr, nil
+	}, expr)
 }
 
 // addCol adds the specified column to the send query
@@ -429,6 +445,11 @@ func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 			return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 		}
 		cexpr := tpb.findCol(colname.Name)
+		if cexpr == nil {
+			// Like MySQL, the group by can also reference the column
+			// of the source table.
+			cexpr = tpb.findSourceCol(colname.Name)
+		}
 		if cexpr == nil {
 			return fmt.Errorf("group by expression does not reference an alias in the select list: %v", sqlparser.String(expr))
 		}
@@ -478,6 +499,20 @@ func (tpb *tablePlanBuilder) findCol(name sqlparser.ColIdent) *colExpr {
 	return nil
 }
 
+// findSourceCol returns the expression which selects the column of the
+// source table, like 'c' in 'c as a'.
+func (tpb *tablePlanBuilder) findSourceCol(name sqlparser.ColIdent) *colExpr {
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation != opExpr {
+			continue
+		}
+		if colName, ok := cexpr.expr.(*sqlparser.ColName); ok && colName.Name.Equal(name) {
+			return cexpr
+		}
+	}
+	return nil
+}
+
 func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	bvf := &bindvarFormatter{}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
@@ -522,7 +557,7 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opExpr:
 			buf.Myprintf("%v", cexpr.expr)
 		case opCount:
-			buf.WriteString("1")
+			cexpr.generateCount(buf)
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
@@ -543,7 +578,7 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 		case opExpr:
 			buf.Myprintf("%v", cexpr.expr)
 		case opCount:
-			buf.WriteString("1")
+			cexpr.generateCount(buf)
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
 		}
@@ -576,7 +611,11 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opExpr:
 			buf.Myprintf("values(%v)", cexpr.colName)
 		case opCount:
-			buf.Myprintf("%v+1", cexpr.colName)
+			if cexpr.expr == nil {
+				buf.Myprintf("%v+1", cexpr.colName)
+			} else {
+				buf.Myprintf("%v+values(%v)", cexpr.colName, cexpr.colName)
+			}
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
@@ -605,6 +644,14 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("%v", cexpr.expr)
 		case opCount:
 			buf.Myprintf("%v", cexpr.colName)
+			if cexpr.expr != nil {
+				bvf.mode = bvBefore
+				buf.WriteString("-")
+				cexpr.generateCount(buf)
+				bvf.mode = bvAfter
+				buf.WriteString("+")
+				cexpr.generateCount(buf)
+			}
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			bvf.mode = bvBefore
@@ -638,7 +685,8 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 			case opExpr:
 				buf.WriteString("null")
 			case opCount:
-				buf.Myprintf("%v-1", cexpr.colName)
+				buf.Myprintf("%v-", cexpr.colName)
+				cexpr.generateCount(buf)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
 			}
@@ -650,6 +698,42 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// generateDeleteEmptyStatement generates the statement which deletes a group
+// after its last row was deleted, which is when its count(*) drops to 0. It
+// returns nil if the plan doesn't aggregate, or has no count(*).
+func (tpb *tablePlanBuilder) generateDeleteEmptyStatement() *sqlparser.ParsedQuery {
+	if tpb.onInsert != insertOnDup {
+		return nil
+	}
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation != opCount || cexpr.expr != nil {
+			continue
+		}
+		bvf := &bindvarFormatter{}
+		buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+		buf.Myprintf("delete from %v", tpb.name)
+		tpb.generateWhere(buf, bvf)
+		buf.Myprintf(" and %v=0", cexpr.colName)
+		return buf.ParsedQuery()
+	}
+	return nil
+}
+
+// generateCount generates the contribution of a row to a count: 1 for
+// count(*), and 1 if the column is not null for count(column).
+func (cexpr *colExpr) generateCount(buf *sqlparser.TrackedBuffer) {
+	if cexpr.expr == nil {
+		buf.WriteString("1")
+		return
+	}
+	if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
+		buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
+		return
+	}
+	// Parenthesize non-trivial expressions.
+	buf.Myprintf("if((%v) is null, 0, 1)", cexpr.expr)
+}
+
 func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
 	buf.WriteString(" where ")
 	bvf.mode = bvBefore
@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var throttleCheckInterval = flag.Duration("vreplication_throttle_check_interval", 1*time.Second, "interval at which a throttled vreplication stream checks the replica lag of the target and the threads running on the source")
+
+// replicaLagChecker returns the max replication lag of the replicas
+// of a shard. The lag is checked at most once per check interval,
+// and is shared by all the streams of the Engine.
+type replicaLagChecker struct {
+	ts       *topo.Server
+	keyspace string
+	shard    string
+
+	mu        sync.Mutex
+	tmc       tmclient.TabletManagerClient
+	lag       time.Duration
+	err       error
+	checkedAt time.Time
+}
+
+// SetTargetShard sets the keyspace and shard of the tablet. The
+// replication lag of the replicas of this shard is used to throttle
+// the streams which have a max_replication_lag.
+func (vre *Engine) SetTargetShard(keyspace, shard string) {
+	vre.lagChecker = &replicaLagChecker{
+		ts:       vre.ts,
+		keyspace: keyspace,
+		shard:    shard,
+	}
+}
+
+func (rc *replicaLagChecker) replicaLag(ctx context.Context) (time.Duration, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if time.Since(rc.checkedAt) < *throttleCheckInterval {
+		return rc.lag, rc.err
+	}
+	rc.lag, rc.err = rc.check(ctx)
+	rc.checkedAt = time.Now()
+	return rc.lag, rc.err
+}
+
+func (rc *replicaLagChecker) check(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+	tablets, err := rc.ts.GetTabletMapForShard(ctx, rc.keyspace, rc.shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return 0, err
+	}
+	if rc.tmc == nil {
+		rc.tmc = tmclient.NewTabletManagerClient()
+	}
+	var maxLag time.Duration
+	for _, ti := range tablets {
+		if ti.Type != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		status, err := rc.tmc.SlaveStatus(ctx, ti.Tablet)
+		if err != nil {
+			log.Warningf("Could not get the replication lag of %v: %v", topoproto.TabletAliasString(ti.Alias), err)
+			continue
+		}
+		if lag := time.Duration(status.SecondsBehindMaster) * time.Second; lag > maxLag {
+			maxLag = lag
+		}
+	}
+	return maxLag, nil
+}
+
+// replicationThrottler slows down a stream while the replicas of the
+// target lag too much, or while the source runs too many threads.
+type replicationThrottler struct {
+	// maxReplicaLag is 0 if the replica lag is not checked.
+	maxReplicaLag time.Duration
+	// maxThreadsRunning is 0 if the source is not checked.
+	maxThreadsRunning int64
+
+	replicaLag     func(ctx context.Context) (time.Duration, error)
+	threadsRunning func(ctx context.Context) (int64, error)
+	stats          *binlogplayer.Stats
+
+	lastCheck time.Time
+}
+
+func newReplicationThrottler(vr *vreplicator, settings binlogplayer.VRSettings) *replicationThrottler {
+	rt := &replicationThrottler{
+		maxThreadsRunning: vr.source.MaxSourceThreadsRunning,
+		threadsRunning:    vr.sourceVStreamer.ThreadsRunning,
+		stats:             vr.stats,
+	}
+	if settings.MaxReplicationLag > 0 && settings.MaxReplicationLag != throttler.ReplicationLagModuleDisabled && vr.vre != nil && vr.vre.lagChecker != nil {
+		rt.maxReplicaLag = time.Duration(settings.MaxReplicationLag) * time.Second
+		rt.replicaLag = vr.vre.lagChecker.replicaLag
+	}
+	return rt
+}
+
+// throttle blocks while the stream is over its limits, and adds the
+// time it was blocked to the throttled time of the stream. The limits
+// are checked at most once per check interval. If they can't be
+// checked, the stream is not throttled.
+func (rt *replicationThrottler) throttle(ctx context.Context) error {
+	if rt == nil || (rt.maxReplicaLag == 0 && rt.maxThreadsRunning == 0) {
+		return nil
+	}
+	if time.Since(rt.lastCheck) < *throttleCheckInterval {
+		return nil
+	}
+	start := time.Now()
+	defer func() {
+		rt.stats.ThrottledTime.Add(time.Since(start))
+	}()
+	for {
+		reason := rt.check(ctx)
+		rt.lastCheck = time.Now()
+		if reason == "" {
+			return nil
+		}
+		log.V(2).Infof("Throttling vreplication: %v", reason)
+		select {
+		case <-ctx.Done():
+			return io.EOF
+		case <-time.After(*throttleCheckInterval):
+		}
+	}
+}
+
+// check returns why the stream must be throttled, or "" if it must not.
+func (rt *replicationThrottler) check(ctx context.Context) string {
+	if rt.maxReplicaLag != 0 {
+		lag, err := rt.replicaLag(ctx)
+		switch {
+		case err != nil:
+			log.Warningf("Could not check the replica lag to throttle vreplication: %v", err)
+		case lag > rt.maxReplicaLag:
+			return fmt.Sprintf("replica lag %v exceeds %v", lag, rt.maxReplicaLag)
+		}
+	}
+	if rt.maxThreadsRunning != 0 {
+		threads, err := rt.threadsRunning(ctx)
+		switch {
+		case err != nil:
+			log.Warningf("Could not check the threads running on the source to throttle vreplication: %v", err)
+		case threads > rt.maxThreadsRunning:
+			return fmt.Sprintf("%v threads running on the source exceeds %v", threads, rt.maxThreadsRunning)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+)
+
+func TestReplicationThrottler(t *testing.T) {
+	defer func(saved time.Duration) { *throttleCheckInterval = saved }(*throttleCheckInterval)
+	*throttleCheckInterval = 10 * time.Millisecond
+
+	lags := []time.Duration{5 * time.Second, 3 * time.Second, 1 * time.Second}
+	threads := []int64{20, 5}
+	stats := binlogplayer.NewStats()
+	rt := &replicationThrottler{
+		maxReplicaLag:     2 * time.Second,
+		maxThreadsRunning: 10,
+		replicaLag: func(ctx context.Context) (time.Duration, error) {
+			lag := lags[0]
+			if len(lags) > 1 {
+				lags = lags[1:]
+			}
+			return lag, nil
+		},
+		threadsRunning: func(ctx context.Context) (int64, error) {
+			n := threads[0]
+			if len(threads) > 1 {
+				threads = threads[1:]
+			}
+			return n, nil
+		},
+		stats: stats,
+	}
+	// Throttled twice on the lag, then once on the threads.
+	assert.NoError(t, rt.throttle(context.Background()))
+	assert.Equal(t, []time.Duration{1 * time.Second}, lags)
+	assert.Equal(t, []int64{5}, threads)
+	assert.True(t, stats.ThrottledTime.Get() >= 3**throttleCheckInterval, "throttled time: %v", stats.ThrottledTime.Get())
+
+	// The limits can't be checked: not throttled.
+	rt.replicaLag = func(ctx context.Context) (time.Duration, error) {
+		return 0, errors.New("no replica")
+	}
+	time.Sleep(*throttleCheckInterval)
+	assert.NoError(t, rt.throttle(context.Background()))
+
+	// Canceled while throttled.
+	rt.maxThreadsRunning = 1
+	time.Sleep(*throttleCheckInterval)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, io.EOF, rt.throttle(ctx))
+
+	// Disabled.
+	var nilThrottler *replicationThrottler
+	assert.NoError(t, nilThrottler.throttle(context.Background()))
+	assert.NoError(t, (&replicationThrottler{}).throttle(context.Background()))
+}
@@ -214,6 +214,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	if lastpkqr := copyState[tableName]; lastpkqr != nil {
 		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
 	}
+	vc.startCopyProgress(ctx, tableName, initialPlan.SendRule.Match, lastpkpb != nil)
 
 	var pkfields []*querypb.Field
 	var updateCopyState *sqlparser.ParsedQuery
@@ -252,6 +253,9 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		// to data size, this should map to a uniform amount of pages affected
 		// per statement. A packet size of 30K will roughly translate to 8
 		// mysql pages of 4K each.
+		if err := vc.vr.throttler.throttle(ctx); err != nil {
+			return err
+		}
 		if err := vc.vr.dbClient.Begin(); err != nil {
 			return err
 		}
@@ -288,6 +292,8 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if err := vc.vr.dbClient.Commit(); err != nil {
 			return err
 		}
+		vc.vr.copyProgress.add(tableName, int64(len(rows.Rows)))
+		vc.vr.stats.AddThroughput(int64(len(rows.Rows)), rowsBytes(rows.Rows...))
 		return nil
 	})
 	// If there was a timeout, return without an error.
@@ -306,9 +312,31 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
 		return err
 	}
+	vc.vr.copyProgress.done(tableName)
 	return nil
 }
 
+// startCopyProgress starts tracking the copy of the table. The total
+// is estimated from the source table. If the copy is resumed, the rows
+// already copied are estimated from the target table.
+func (vc *vcopier) startCopyProgress(ctx context.Context, tableName, sourceTableName string, resumed bool) {
+	rowsTotal, err := vc.vr.sourceVStreamer.EstimateTableRows(ctx, sourceTableName)
+	if err != nil {
+		log.Warningf("Could not estimate the rows of the source table %v: %v", sourceTableName, err)
+	}
+	var rowsCopied int64
+	if resumed {
+		qr, err := vc.vr.dbClient.Execute(fmt.Sprintf(estimateTableRowsQuery, encodeString(tableName)))
+		if err == nil {
+			rowsCopied, err = parseTableRows(qr)
+		}
+		if err != nil {
+			log.Warningf("Could not estimate the rows of the table %v: %v", tableName, err)
+		}
+	}
+	vc.vr.copyProgress.start(tableName, rowsCopied, rowsTotal)
+}
+
 func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
 	pos, err := mysql.DecodePosition(gtid)
 	if err != nil {
@@ -59,6 +59,9 @@ type vplayer struct {
 	timeOffsetNs int64
 	// canAcceptStmtEvents is set to true if the current player can accept events in statement mode. Only true for filters that are match all.
 	canAcceptStmtEvents bool
+	// parallel applies the row changes with parallel workers. It's nil
+	// if parallel apply is disabled.
+	parallel *parallelApplier
 }
 
 // newVPlayer creates a new vplayer. Parameters:
@@ -104,6 +107,12 @@ func (vp *vplayer) play(ctx context.Context) error {
 	}
 	vp.replicatorPlan = plan
 
+	vp.parallel, err = newParallelApplier(vp.vr, vp.startPos)
+	if err != nil {
+		return err
+	}
+	defer vp.parallel.close()
+
 	// We can't run in statement mode if there are filters defined.
 	vp.canAcceptStmtEvents = true
 	for _, rule := range vp.vr.source.Filter.Rules {
@@ -203,19 +212,31 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
 	for _, change := range rowEvent.RowChanges {
-		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
-			stats := NewVrLogStats("ROWCHANGE")
-			result, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
-			stats.Send(sql)
-			return result, err
-		})
-		if err != nil {
+		vp.vr.stats.AddThroughput(1, rowsBytes(change.Before, change.After))
+	}
+	if vp.parallel != nil {
+		// The changes are applied by the workers on commit.
+		vp.parallel.addRowEvent(tplan, rowEvent)
+		return nil
+	}
+	for _, change := range rowEvent.RowChanges {
+		if err := vp.applyChange(ctx, tplan, change); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (vp *vplayer) applyChange(ctx context.Context, tplan *TablePlan, change *binlogdatapb.RowChange) error {
+	_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
+		stats := NewVrLogStats("ROWCHANGE")
+		result, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+		stats.Send(sql)
+		return result, err
+	})
+	return err
+}
+
 func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	update := binlogplayer.GenerateUpdatePos(vp.vr.id, vp.pos, time.Now().Unix(), ts)
 	if _, err := vp.vr.dbClient.Execute(update); err != nil {
@@ -286,13 +307,17 @@ func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 // way to handle them.
 func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	defer vp.vr.dbClient.Rollback()
+	defer vp.parallel.rollback()
 
 	// If we're not running, set SecondsBehindMaster to be very high.
 	// TODO(sougou): if we also stored the time of the last event, we
 	// can estimate this value more accurately.
-	defer vp.vr.stats.SecondsBehindMaster.Set(math.MaxInt64)
+	defer vp.vr.stats.SetSecondsBehindMaster(math.MaxInt64)
 	var sbm int64 = -1
 	for {
+		if err := vp.vr.throttler.throttle(ctx); err != nil {
+			return err
+		}
 		items, err := relay.Fetch()
 		if err != nil {
 			return err
@@ -301,7 +326,7 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 		// So, we should assume we're falling behind.
 		if len(items) == 0 {
 			behind := time.Now().UnixNano() - vp.lastTimestampNs - vp.timeOffsetNs
-			vp.vr.stats.SecondsBehindMaster.Set(behind / 1e9)
+			vp.vr.stats.SetSecondsBehindMaster(behind / 1e9)
 		}
 		// Empty transactions are saved at most once every idleTimeout.
 		// This covers two situations:
@@ -342,6 +367,7 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 					// also handles the case where the last transaction is partial. In that case,
 					// we only group the transactions with commits we've seen so far.
 					if hasAnotherCommit(items, i, j+1) {
+						vp.parallel.endTransaction()
 						continue
 					}
 				}
@@ -351,7 +377,7 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 			}
 		}
 		if sbm >= 0 {
-			vp.vr.stats.SecondsBehindMaster.Set(sbm)
+			vp.vr.stats.SetSecondsBehindMaster(sbm)
 		}
 
 	}
@@ -403,6 +429,14 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			vp.unsavedEvent = event
 			return nil
 		}
+		// The workers prepare their transactions, which are committed
+		// once the position is saved.
+		if err := vp.parallel.prepare(ctx, vp.pos, func(tplan *TablePlan, change *binlogdatapb.RowChange) error {
+			return vp.applyChange(ctx, tplan, change)
+		}); err != nil {
+			return err
+		}
+		vp.parallel.endTransaction()
 		posReached, err := vp.updatePos(event.Timestamp)
 		if err != nil {
 			return err
@@ -410,6 +444,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err := vp.vr.dbClient.Commit(); err != nil {
 			return err
 		}
+		if err := vp.parallel.commit(); err != nil {
+			return err
+		}
 		if posReached {
 			return io.EOF
 		}
@@ -431,6 +468,12 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if err := vp.vr.dbClient.Begin(); err != nil {
 				return err
 			}
+			// Statements must be applied after the pending row changes.
+			if err := vp.parallel.flush(func(tplan *TablePlan, change *binlogdatapb.RowChange) error {
+				return vp.applyChange(ctx, tplan, change)
+			}); err != nil {
+				return err
+			}
 
 			if err := vp.applyStmtEvent(ctx, event); err != nil {
 				return err
@@ -53,10 +53,12 @@ type vreplicator struct {
 	source          *binlogdatapb.BinlogSource
 	sourceVStreamer VStreamerClient
 
-	stats *binlogplayer.Stats
+	stats        *binlogplayer.Stats
+	copyProgress *copyProgress
 	// mysqld is used to fetch the local schema.
 	mysqld    mysqlctl.MysqlDaemon
 	tableKeys map[string][]string
+	throttler *replicationThrottler
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
@@ -79,13 +81,14 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, copyProgress *copyProgress, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
+		copyProgress:    copyProgress,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
 	}
@@ -142,6 +145,7 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 		if settings.State == binlogplayer.BlpStopped {
 			return nil
 		}
+		vr.throttler = newReplicationThrottler(vr, settings)
 
 		switch {
 		case numTablesToCopy != 0:
@@ -55,6 +55,12 @@ type VStreamerClient interface {
 
 	// VStreamRows streams rows of a table from the specified starting point.
 	VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+	// ThreadsRunning returns the Threads_running status of the source.
+	ThreadsRunning(ctx context.Context) (int64, error)
+
+	// EstimateTableRows returns the estimated number of rows of a source table.
+	EstimateTableRows(ctx context.Context, table string) (int64, error)
 }
 
 // TabletVStreamerClient a vstream client backed by vttablet
@@ -67,6 +73,8 @@ type TabletVStreamerClient struct {
 	tablet         *topodatapb.Tablet
 	target         *querypb.Target
 	tsQueryService queryservice.QueryService
+	// compression is the gRPC compressor of the streams, if any.
+	compression string
 }
 
 // MySQLVStreamerClient a vstream client backed by MySQL
@@ -121,6 +129,9 @@ func (vsClient *TabletVStreamerClient) VStream(ctx context.Context, startPos str
 	if !vsClient.isOpen {
 		return errors.New("can't VStream without opening client")
 	}
+	if vsClient.compression != "" {
+		ctx = grpcclient.WithCompression(ctx, vsClient.compression)
+	}
 	return vsClient.tsQueryService.VStream(ctx, vsClient.target, startPos, filter, send)
 }
 
@@ -129,21 +140,50 @@ func (vsClient *TabletVStreamerClient) VStreamRows(ctx context.Context, query st
 	if !vsClient.isOpen {
 		return errors.New("can't VStreamRows without opening client")
 	}
+	if vsClient.compression != "" {
+		ctx = grpcclient.WithCompression(ctx, vsClient.compression)
+	}
 	return vsClient.tsQueryService.VStreamRows(ctx, vsClient.target, query, lastpk, send)
 }
 
+// ThreadsRunning part of the VStreamerClient interface
+func (vsClient *TabletVStreamerClient) ThreadsRunning(ctx context.Context) (int64, error) {
+	if !vsClient.isOpen {
+		return 0, errors.New("can't get the threads running without opening client")
+	}
+	qr, err := vsClient.tsQueryService.Execute(ctx, vsClient.target, showThreadsRunning, nil, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	return parseThreadsRunning(qr)
+}
+
+// EstimateTableRows part of the VStreamerClient interface
+func (vsClient *TabletVStreamerClient) EstimateTableRows(ctx context.Context, table string) (int64, error) {
+	if !vsClient.isOpen {
+		return 0, errors.New("can't estimate the table rows without opening client")
+	}
+	qr, err := vsClient.tsQueryService.Execute(ctx, vsClient.target, fmt.Sprintf(estimateTableRowsQuery, encodeString(table)), nil, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	return parseTableRows(qr)
+}
+
 // NewMySQLVStreamerClient is a vstream client that allows you to stream directly from MySQL.
 // In order to achieve this, the following creates a vstreamer Engine with a dummy in memorytopo.
-func NewMySQLVStreamerClient() *MySQLVStreamerClient {
-	if dbcfgs == nil {
-		panic("can't use MySQLVStreamerClient without calling InitVStreamerClient() ")
+// The connection parameters of the named external MySQL come from the
+// vreplication_external_mysql_config file, or from the erepl db config
+// if the file is not set.
+func NewMySQLVStreamerClient(externalMySQL string) (*MySQLVStreamerClient, error) {
+	sourceConnParams, err := externalMySQLConnector(externalMySQL)
+	if err != nil {
+		return nil, err
 	}
-	// TODO: For now external mysql streams can only be used with ExternalReplWithDB creds.
-	// In the future we will support multiple users.
 	vsClient := &MySQLVStreamerClient{
-		sourceConnParams: dbcfgs.ExternalReplWithDB(),
+		sourceConnParams: sourceConnParams,
 	}
-	return vsClient
+	return vsClient, nil
 }
 
 // Open part of the VStreamerClient interface
@@ -210,3 +250,58 @@ func (vsClient *MySQLVStreamerClient) VStreamRows(ctx context.Context, query str
 func InitVStreamerClient(cfg *dbconfigs.DBConfigs) {
 	dbcfgs = cfg
 }
+
+// ThreadsRunning part of the VStreamerClient interface
+func (vsClient *MySQLVStreamerClient) ThreadsRunning(ctx context.Context) (int64, error) {
+	if !vsClient.isOpen {
+		return 0, errors.New("can't get the threads running without opening client")
+	}
+	conn, err := vsClient.sourceConnParams.Connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(showThreadsRunning, 1, false)
+	if err != nil {
+		return 0, err
+	}
+	return parseThreadsRunning(qr)
+}
+
+// EstimateTableRows part of the VStreamerClient interface
+func (vsClient *MySQLVStreamerClient) EstimateTableRows(ctx context.Context, table string) (int64, error) {
+	if !vsClient.isOpen {
+		return 0, errors.New("can't estimate the table rows without opening client")
+	}
+	conn, err := vsClient.sourceConnParams.Connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(fmt.Sprintf(estimateTableRowsQuery, encodeString(table)), 1, false)
+	if err != nil {
+		return 0, err
+	}
+	return parseTableRows(qr)
+}
+
+const showThreadsRunning = "show global status like 'Threads_running'"
+
+func parseThreadsRunning(qr *sqltypes.Result) (int64, error) {
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return 0, fmt.Errorf("unexpected result for %v: %v", showThreadsRunning, qr.Rows)
+	}
+	return sqltypes.ToInt64(qr.Rows[0][1])
+}
+
+const estimateTableRowsQuery = "select table_rows from information_schema.tables where table_schema = database() and table_name = %s"
+
+func parseTableRows(qr *sqltypes.Result) (int64, error) {
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return 0, fmt.Errorf("table not found in information_schema.tables: %v", qr.Rows)
+	}
+	if qr.Rows[0][0].IsNull() {
+		return 0, nil
+	}
+	return sqltypes.ToInt64(qr.Rows[0][0])
+}
@@ -279,7 +279,11 @@ func TestNewMySQLVStreamerClient(t *testing.T) {
 	}
 	for _, tcase := range tests {
 		t.Run(tcase.name, func(t *testing.T) {
-			if got := NewMySQLVStreamerClient(); !reflect.DeepEqual(got, tcase.want) {
+			got, err := NewMySQLVStreamerClient("erepl")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tcase.want) {
 				t.Errorf("NewMySQLVStreamerClient() = %v, want %v", got, tcase.want)
 			}
 		})
@@ -0,0 +1,324 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	sqlThreadsRunning = "show global status like 'Threads_running'"
+	sqlHistoryLength  = "select count from information_schema.innodb_metrics where name = 'trx_rseg_history_len'"
+)
+
+// AdmissionController protects MySQL and vttablet from low priority
+// queries while either of them is saturated. It periodically samples
+// the MySQL threads_running and InnoDB history list length, as well
+// as the CPU and memory usage of the vttablet process. If any of them
+// is above its configured maximum, queries with the OLAP workload are
+// queued until the load goes down, or rejected if that takes too long.
+type AdmissionController struct {
+	env tabletenv.Env
+
+	enabled           bool
+	interval          time.Duration
+	maxThreadsRunning int64
+	maxHistoryLength  int64
+	maxCPU            float64
+	maxMemory         int64
+	queueTimeout      time.Duration
+
+	pool     *connpool.Pool
+	ticks    *timer.Timer
+	errorLog *logutil.ThrottledLogger
+
+	threadsRunning sync2.AtomicInt64
+	historyLength  sync2.AtomicInt64
+	cpuPercent     sync2.AtomicInt64
+	memory         sync2.AtomicInt64
+	queued         *stats.Counter
+	shed           *stats.CountersWithSingleLabel
+
+	// lastCPUTime and lastSample are used to compute the CPU
+	// usage between two samples. They are only accessed by sample.
+	lastCPUTime time.Duration
+	lastSample  time.Time
+
+	mu     sync.Mutex
+	isOpen bool
+	// mysqlReason and processReason describe why MySQL or vttablet
+	// is saturated. They're empty if it is not.
+	mysqlReason, processReason string
+	// recovered is non-nil while MySQL or vttablet is saturated.
+	// It gets closed once both have recovered.
+	recovered chan struct{}
+}
+
+// NewAdmissionController creates a new AdmissionController.
+func NewAdmissionController(env tabletenv.Env) *AdmissionController {
+	config := env.Config()
+	ac := &AdmissionController{
+		env:               env,
+		enabled:           config.EnableAdmissionControl,
+		interval:          config.AdmissionControlInterval,
+		maxThreadsRunning: config.AdmissionControlMaxThreadsRunning,
+		maxHistoryLength:  config.AdmissionControlMaxHistoryLength,
+		maxCPU:            config.AdmissionControlMaxCPU,
+		maxMemory:         config.AdmissionControlMaxMemory,
+		queueTimeout:      config.AdmissionControlQueueTimeout,
+		errorLog:          logutil.NewThrottledLogger("AdmissionControl", 60*time.Second),
+	}
+	if !ac.enabled {
+		return ac
+	}
+	ac.pool = connpool.New(env, "AdmissionControlPool", 1, 0, time.Duration(config.IdleTimeout*1e9))
+	ac.ticks = timer.NewTimer(ac.interval)
+	env.Exporter().NewGaugeFunc("AdmissionControlThreadsRunning", "MySQL threads_running as of the last admission control sample", ac.threadsRunning.Get)
+	env.Exporter().NewGaugeFunc("AdmissionControlHistoryLength", "InnoDB history list length as of the last admission control sample", ac.historyLength.Get)
+	env.Exporter().NewGaugeFunc("AdmissionControlProcessCPUPercent", "vttablet CPU usage in percent of the available CPUs as of the last admission control sample", ac.cpuPercent.Get)
+	env.Exporter().NewGaugeFunc("AdmissionControlProcessMemoryBytes", "Memory obtained from the OS by vttablet as of the last admission control sample", ac.memory.Get)
+	env.Exporter().NewGaugeFunc("AdmissionControlSaturated", "1 if admission control considers MySQL or vttablet saturated", func() int64 {
+		if ac.isSaturated() {
+			return 1
+		}
+		return 0
+	})
+	ac.queued = env.Exporter().NewCounter("AdmissionControlQueued", "Number of low priority queries queued because MySQL or vttablet was saturated")
+	ac.shed = env.Exporter().NewCountersWithSingleLabel("AdmissionControlShed", "Number of low priority queries rejected because MySQL or vttablet was saturated", "Reason")
+	return ac
+}
+
+// Open starts sampling the load.
+func (ac *AdmissionController) Open() {
+	if !ac.enabled {
+		return
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if ac.isOpen {
+		return
+	}
+	dbconfigs := ac.env.DBConfigs()
+	ac.pool.Open(dbconfigs.AppWithDB(), dbconfigs.DbaWithDB(), dbconfigs.AppDebugWithDB())
+	ac.lastCPUTime, ac.lastSample = processCPUTime(), time.Now()
+	ac.ticks.Start(ac.sample)
+	ac.isOpen = true
+}
+
+// Close stops sampling the load and admits all queued queries.
+func (ac *AdmissionController) Close() {
+	if !ac.enabled {
+		return
+	}
+	ac.mu.Lock()
+	if !ac.isOpen {
+		ac.mu.Unlock()
+		return
+	}
+	ac.isOpen = false
+	ac.mysqlReason, ac.processReason = "", ""
+	ac.updateLocked()
+	ac.mu.Unlock()
+
+	// Stop waits for a running sample, which needs the lock.
+	ac.ticks.Stop()
+	ac.pool.Close()
+}
+
+// Admit returns nil if the query can be executed. Queries which are
+// not low priority are always admitted. Low priority queries are
+// queued while MySQL or vttablet is saturated, and rejected if the
+// load does not go down in time.
+func (ac *AdmissionController) Admit(ctx context.Context, options *querypb.ExecuteOptions) error {
+	if !ac.enabled || options.GetWorkload() != querypb.ExecuteOptions_OLAP {
+		return nil
+	}
+	ac.mu.Lock()
+	recovered := ac.recovered
+	ac.mu.Unlock()
+	if recovered == nil {
+		return nil
+	}
+
+	if ac.queueTimeout > 0 {
+		ac.queued.Add(1)
+		tmr := time.NewTimer(ac.queueTimeout)
+		defer tmr.Stop()
+		select {
+		case <-recovered:
+			return nil
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "admission control: %v while waiting for the load to go down", ctx.Err())
+		case <-tmr.C:
+		}
+	}
+
+	ac.mu.Lock()
+	label, reason := "MySQL", ac.mysqlReason
+	if ac.processReason != "" {
+		label, reason = "Process", ac.processReason
+	}
+	ac.mu.Unlock()
+	if reason == "" {
+		// The load went down just now.
+		return nil
+	}
+	ac.shed.Add(label, 1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "admission control: low priority query rejected, %s", reason)
+}
+
+func (ac *AdmissionController) isSaturated() bool {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.recovered != nil
+}
+
+// updateLocked creates or closes the recovered channel based on the
+// current reasons.
+func (ac *AdmissionController) updateLocked() {
+	saturated := ac.mysqlReason != "" || ac.processReason != ""
+	switch {
+	case saturated && ac.recovered == nil:
+		ac.recovered = make(chan struct{})
+	case !saturated && ac.recovered != nil:
+		close(ac.recovered)
+		ac.recovered = nil
+	}
+}
+
+// sample measures the load once. If MySQL can't be sampled, its
+// previous state is kept.
+func (ac *AdmissionController) sample() {
+	defer tabletenv.LogError()
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), ac.interval)
+	defer cancel()
+
+	processReason := ac.sampleProcess()
+	mysqlReason, err := ac.sampleMySQL(ctx)
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if !ac.isOpen {
+		return
+	}
+	ac.processReason = processReason
+	if err != nil {
+		ac.errorLog.Errorf("Error sampling the MySQL load: %v", err)
+	} else {
+		ac.mysqlReason = mysqlReason
+	}
+	ac.updateLocked()
+}
+
+// sampleMySQL returns why MySQL is saturated, or "" if it is not.
+func (ac *AdmissionController) sampleMySQL(ctx context.Context) (string, error) {
+	if ac.maxThreadsRunning > 0 {
+		v, err := ac.readStatus(ctx, sqlThreadsRunning, 1)
+		if err != nil {
+			return "", err
+		}
+		ac.threadsRunning.Set(v)
+		if v > ac.maxThreadsRunning {
+			return fmt.Sprintf("MySQL threads_running is %d (max %d)", v, ac.maxThreadsRunning), nil
+		}
+	}
+	if ac.maxHistoryLength > 0 {
+		v, err := ac.readStatus(ctx, sqlHistoryLength, 0)
+		if err != nil {
+			return "", err
+		}
+		ac.historyLength.Set(v)
+		if v > ac.maxHistoryLength {
+			return fmt.Sprintf("InnoDB history list length is %d (max %d)", v, ac.maxHistoryLength), nil
+		}
+	}
+	return "", nil
+}
+
+// sampleProcess returns why vttablet is saturated, or "" if it is not.
+func (ac *AdmissionController) sampleProcess() string {
+	reason := ""
+	if ac.maxCPU > 0 {
+		now, cpuTime := time.Now(), processCPUTime()
+		var usage float64
+		if elapsed := now.Sub(ac.lastSample); elapsed > 0 {
+			usage = float64(cpuTime-ac.lastCPUTime) / float64(elapsed) / float64(runtime.NumCPU())
+		}
+		ac.lastCPUTime, ac.lastSample = cpuTime, now
+		ac.cpuPercent.Set(int64(usage * 100))
+		if usage > ac.maxCPU {
+			reason = fmt.Sprintf("vttablet CPU usage is %.0f%% (max %.0f%%)", usage*100, ac.maxCPU*100)
+		}
+	}
+	if ac.maxMemory > 0 {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		memory := int64(ms.Sys - ms.HeapReleased)
+		ac.memory.Set(memory)
+		if memory > ac.maxMemory && reason == "" {
+			reason = fmt.Sprintf("vttablet memory usage is %d bytes (max %d)", memory, ac.maxMemory)
+		}
+	}
+	return reason
+}
+
+// processCPUTime returns the user and system CPU time used by
+// the process so far.
+func processCPUTime() time.Duration {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &ru); err != nil {
+		return 0
+	}
+	return time.Duration(ru.Utime.Nano() + ru.Stime.Nano())
+}
+
+// readStatus runs a query which returns a single row, and parses
+// the given column as an int64.
+func (ac *AdmissionController) readStatus(ctx context.Context, query string, column int) (int64, error) {
+	conn, err := ac.pool.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, query, 1, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) <= column {
+		return 0, fmt.Errorf("unexpected result for %v: %v", query, qr.Rows)
+	}
+	return sqltypes.ToInt64(qr.Rows[0][column])
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestAdmissionController(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	setThreadsRunning := func(v string) {
+		db.AddQuery(sqlThreadsRunning, sqltypes.MakeTestResult(sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"), "Threads_running|"+v))
+	}
+
+	config := tabletenv.DefaultQsConfig
+	config.EnableAdmissionControl = true
+	// Samples are taken explicitly by the test.
+	config.AdmissionControlInterval = time.Hour
+	config.AdmissionControlMaxThreadsRunning = 10
+	config.AdmissionControlQueueTimeout = time.Hour
+	ac := NewAdmissionController(tabletenv.NewTestEnv(&config, newDBConfigs(db), "AdmissionControllerTest"))
+	ac.Open()
+	defer ac.Close()
+
+	ctx := context.Background()
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+
+	setThreadsRunning("5")
+	ac.sample()
+	assert.Equal(t, int64(5), ac.threadsRunning.Get())
+	require.NoError(t, ac.Admit(ctx, olap))
+
+	// MySQL is saturated: low priority queries are queued, others are admitted.
+	setThreadsRunning("20")
+	ac.sample()
+	require.NoError(t, ac.Admit(ctx, nil))
+	admitted := make(chan error)
+	go func() {
+		admitted <- ac.Admit(ctx, olap)
+	}()
+	select {
+	case err := <-admitted:
+		t.Fatalf("Admit returned while MySQL is saturated: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// The queued query is admitted once MySQL recovers.
+	setThreadsRunning("5")
+	ac.sample()
+	require.NoError(t, <-admitted)
+	assert.Equal(t, int64(1), ac.queued.Get())
+
+	// Low priority queries are rejected if MySQL doesn't recover in time.
+	setThreadsRunning("20")
+	ac.sample()
+	ac.queueTimeout = time.Millisecond
+	err := ac.Admit(ctx, olap)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "Admit: %v", err)
+	assert.Equal(t, int64(1), ac.shed.Counts()["MySQL"])
+}
+
+func TestAdmissionControllerProcess(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	config := tabletenv.DefaultQsConfig
+	config.EnableAdmissionControl = true
+	config.AdmissionControlInterval = time.Hour
+	config.AdmissionControlMaxThreadsRunning = 0
+	// Any process uses more than one byte.
+	config.AdmissionControlMaxMemory = 1
+	ac := NewAdmissionController(tabletenv.NewTestEnv(&config, newDBConfigs(db), "AdmissionControllerTest"))
+	ac.Open()
+	defer ac.Close()
+
+	ctx := context.Background()
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+
+	ac.sample()
+	assert.True(t, ac.memory.Get() > 0)
+	err := ac.Admit(ctx, olap)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "Admit: %v", err)
+	assert.Contains(t, err.Error(), "vttablet memory usage")
+	assert.Equal(t, int64(1), ac.shed.Counts()["Process"])
+	require.NoError(t, ac.Admit(ctx, &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP}))
+
+	ac.maxMemory = 1 << 62
+	ac.sample()
+	require.NoError(t, ac.Admit(ctx, olap))
+}
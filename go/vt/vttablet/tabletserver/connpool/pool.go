@@ -49,6 +49,7 @@ type Pool struct {
 	mu                 sync.Mutex
 	connections        *pools.ResourcePool
 	capacity           int
+	maxCapacity        int
 	prefillParallelism int
 	idleTimeout        time.Duration
 	dbaPool            *dbconnpool.ConnectionPool
@@ -102,7 +103,11 @@ func (cp *Pool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	f := func() (pools.Resource, error) {
 		return NewDBConn(cp, appParams)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
+	maxCapacity := cp.capacity
+	if cp.maxCapacity > maxCapacity {
+		maxCapacity = cp.maxCapacity
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCapacity, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
 	cp.appDebugParams = appDebugParams
 
 	cp.dbaPool.Open(dbaParams)
@@ -173,18 +178,28 @@ func (cp *Pool) Put(conn *DBConn) {
 
 // SetCapacity alters the size of the pool at runtime.
 func (cp *Pool) SetCapacity(capacity int) (err error) {
-	cp.mu.Lock()
-	defer cp.mu.Unlock()
-	if cp.connections != nil {
-		err = cp.connections.SetCapacity(capacity)
-		if err != nil {
+	// The lock must not be held while resizing: shrinking waits
+	// for connections to be returned, and Put needs the lock.
+	if p := cp.pool(); p != nil {
+		if err := p.SetCapacity(capacity); err != nil {
 			return err
 		}
 	}
+	cp.mu.Lock()
 	cp.capacity = capacity
+	cp.mu.Unlock()
 	return nil
 }
 
+// SetMaxCapacity sets the largest capacity the pool can later be
+// resized to. It only takes effect the next time the pool is opened.
+// A value below the current capacity is ignored.
+func (cp *Pool) SetMaxCapacity(maxCapacity int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.maxCapacity = maxCapacity
+}
+
 // SetIdleTimeout sets the idleTimeout on the pool.
 func (cp *Pool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.mu.Lock()
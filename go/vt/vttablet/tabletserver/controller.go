@@ -88,6 +88,9 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// ResizeTxPool changes the size of the transaction pool.
+	ResizeTxPool(ctx context.Context, size int) error
 }
 
 // Ensure TabletServer satisfies Controller interface.
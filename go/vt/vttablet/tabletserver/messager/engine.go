@@ -37,8 +37,10 @@ import (
 // that the messager needs for callback.
 type TabletService interface {
 	tabletenv.Env
-	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
+	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string, updates map[string]*querypb.Value) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
+	ReadMessageBacklog(ctx context.Context, target *querypb.Target, name string) (*sqltypes.Result, error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -104,7 +106,7 @@ func (me *Engine) Close() {
 // usually triggered by Close. It's the responsibility of the send
 // function to promptly return if the done channel is closed. Otherwise,
 // the engine's Close function will hang indefinitely.
-func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
+func (me *Engine) Subscribe(ctx context.Context, name, resumeToken string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	if !me.isOpen {
@@ -114,31 +116,45 @@ func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltype
 	if mm == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found", name)
 	}
-	return mm.Subscribe(ctx, send), nil
+	resumeIDs, err := decodeResumeToken(resumeToken, mm.cache.Size())
+	if err != nil {
+		return nil, err
+	}
+	return mm.Subscribe(ctx, resumeIDs, send), nil
+}
+
+// Acked updates the resume tokens of the receivers after the
+// messages with the given ids were acked.
+func (me *Engine) Acked(name string, ids []string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	if mm := me.managers[name]; mm != nil {
+		mm.acked(ids)
+	}
 }
 
 // GenerateAckQuery returns the query and bind vars for acking a message.
-func (me *Engine) GenerateAckQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+// The user-defined columns in updates are set on the acked messages.
+func (me *Engine) GenerateAckQuery(name string, ids []string, updates map[string]*querypb.Value) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	mm := me.managers[name]
 	if mm == nil {
 		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
 	}
-	query, bv := mm.GenerateAckQuery(ids)
-	return query, bv, nil
+	return mm.GenerateAckQuery(ids, updates)
 }
 
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
-func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+// The user-defined columns in updates are set on the postponed messages.
+func (me *Engine) GeneratePostponeQuery(name string, ids []string, updates map[string]*querypb.Value) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	mm := me.managers[name]
 	if mm == nil {
 		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
 	}
-	query, bv := mm.GeneratePostponeQuery(ids)
-	return query, bv, nil
+	return mm.GeneratePostponeQuery(ids, updates)
 }
 
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
@@ -153,6 +169,31 @@ func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map
 	return query, bv, nil
 }
 
+// GenerateBacklogQuery returns the query and bind vars for reading the
+// backlog of a message table.
+func (me *Engine) GenerateBacklogQuery(name string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	query, bv := mm.GenerateBacklogQuery()
+	return query, bv, nil
+}
+
+// GenerateDeadLetterQuery returns the query and bind vars for dead-lettering messages.
+func (me *Engine) GenerateDeadLetterQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	query, bv := mm.GenerateDeadLetterQuery(ids)
+	return query, bv, nil
+}
+
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
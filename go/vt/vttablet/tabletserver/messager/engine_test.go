@@ -114,9 +114,9 @@ func TestSubscribe(t *testing.T) {
 	f1, ch1 := newEngineReceiver()
 	f2, ch2 := newEngineReceiver()
 	// Each receiver is subscribed to different managers.
-	engine.Subscribe(context.Background(), "t1", f1)
+	engine.Subscribe(context.Background(), "t1", "", f1)
 	<-ch1
-	engine.Subscribe(context.Background(), "t2", f2)
+	engine.Subscribe(context.Background(), "t2", "", f2)
 	<-ch2
 	engine.managers["t1"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	engine.managers["t2"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -125,14 +125,18 @@ func TestSubscribe(t *testing.T) {
 
 	// Error case.
 	want := "message table t3 not found"
-	_, err := engine.Subscribe(context.Background(), "t3", f1)
+	_, err := engine.Subscribe(context.Background(), "t3", "", f1)
 	if err == nil || err.Error() != want {
 		t.Errorf("Subscribe: %v, want %s", err, want)
 	}
+	_, err = engine.Subscribe(context.Background(), "t1", "consumer", f1)
+	if got, want := vterrors.Code(err), vtrpcpb.Code_INVALID_ARGUMENT; got != want {
+		t.Errorf("Subscribed with invalid resume token error code: %v, want %v", got, want)
+	}
 
 	// After close, Subscribe should return a closed channel.
 	engine.Close()
-	_, err = engine.Subscribe(context.Background(), "t1", nil)
+	_, err = engine.Subscribe(context.Background(), "t1", "", nil)
 	if got, want := vterrors.Code(err), vtrpcpb.Code_UNAVAILABLE; got != want {
 		t.Errorf("Subscribed on closed engine error code: %v, want %v", got, want)
 	}
@@ -146,18 +150,18 @@ func TestEngineGenerate(t *testing.T) {
 	engine.schemaChanged(map[string]*schema.Table{
 		"t1": meTable,
 	}, []string{"t1"}, nil, nil)
-	if _, _, err := engine.GenerateAckQuery("t1", []string{"1"}); err != nil {
+	if _, _, err := engine.GenerateAckQuery("t1", []string{"1"}, nil); err != nil {
 		t.Error(err)
 	}
 	want := "message table t2 not found in schema"
-	if _, _, err := engine.GenerateAckQuery("t2", []string{"1"}); err == nil || err.Error() != want {
+	if _, _, err := engine.GenerateAckQuery("t2", []string{"1"}, nil); err == nil || err.Error() != want {
 		t.Errorf("engine.GenerateAckQuery(invalid): %v, want %s", err, want)
 	}
 
-	if _, _, err := engine.GeneratePostponeQuery("t1", []string{"1"}); err != nil {
+	if _, _, err := engine.GeneratePostponeQuery("t1", []string{"1"}, nil); err != nil {
 		t.Error(err)
 	}
-	if _, _, err := engine.GeneratePostponeQuery("t2", []string{"1"}); err == nil || err.Error() != want {
+	if _, _, err := engine.GeneratePostponeQuery("t2", []string{"1"}, nil); err == nil || err.Error() != want {
 		t.Errorf("engine.GeneratePostponeQuery(invalid): %v, want %s", err, want)
 	}
 
@@ -17,8 +17,12 @@ limitations under the License.
 package messager
 
 import (
+	"container/heap"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,11 +35,13 @@ import (
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -44,6 +50,26 @@ var (
 		"Messages",
 		"Stats for messages",
 		[]string{"TableName", "Metric"})
+
+	// MessagePriorityStats tracks the number of messages sent per
+	// priority bucket, see priorityBucket.
+	MessagePriorityStats = stats.NewCountersWithMultiLabels(
+		"MessagesSentByPriority",
+		"Number of messages sent by priority",
+		[]string{"TableName", "Priority"})
+
+	// MessageDeadLetterStats tracks the number of dead-lettered messages.
+	MessageDeadLetterStats = stats.NewGaugesWithSingleLabel(
+		"MessagesDeadLettered",
+		"Number of dead-lettered messages",
+		"TableName")
+
+	// MessageLagStats tracks the age of the oldest pending message,
+	// from its time_created.
+	MessageLagStats = stats.NewGaugesWithSingleLabel(
+		"MessagesOldestUnackedAgeSeconds",
+		"Age in seconds of the oldest pending message which is not acked yet",
+		"TableName")
 )
 
 type messageReceiver struct {
@@ -89,6 +115,10 @@ func (rcv *messageReceiver) Send(qr *sqltypes.Result) error {
 type receiverWithStatus struct {
 	receiver *messageReceiver
 	busy     bool
+	// unacked is set if the receiver subscribed with a resume token.
+	// It maps the ids of the messages sent to the receiver and not
+	// acked yet to their epoch after the send.
+	unacked map[string]int64
 }
 
 // messageManager manages messages for a message table.
@@ -154,9 +184,34 @@ type receiverWithStatus struct {
 // If, for some reason, a client is closed, the load balancer resets
 // by starting with the first non-busy client.
 //
+// Resuming
+// A receiver can subscribe with a resume token. Its stream then has an
+// additional resume_token column, which lists the messages sent to the
+// receiver and not acked yet, along with their epoch after the send. The
+// token is updated on every send and ack. When the consumer reconnects with
+// its last token, the messages of the token that are still not acked and were
+// not resent since are read back into the cache, instead of waiting for their
+// ack wait to expire. The other messages of the token are read back only if
+// they're due. If a receiver has more unacked messages than the cache can
+// hold, its token drops some of them: those are resent after their ack wait.
+//
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Dead-lettering
+// If the table specifies a maximum number of attempts, the poller does not
+// resend messages which have been sent that many times. Instead, it moves them
+// to the dead-letter state: time_next is set to null, but time_acked is not set.
+// Such messages are neither resent nor purged, but they can still be acked.
+//
+// Backlog stats
+// Whenever the purge thread wakes up, it also reads the number of pending and
+// dead-lettered messages, and the age of the oldest pending message. This is
+// done with a single aggregate query, which is not expected to be expensive if
+// the table has the recommended index on time_acked, time_next. The age is
+// computed from the time_created column, which holds the creation time of the
+// message in nanoseconds. Without that column, the age is not measured.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
@@ -168,6 +223,7 @@ type messageManager struct {
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
 	batchSize    int
+	maxAttempts  int64
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
 	postponeSema *sync2.Semaphore
@@ -198,6 +254,11 @@ type messageManager struct {
 	// to be idempotent.
 	streamCancel     func()
 	lastPollPosition *mysql.Position
+	// dueTimes are the distinct time_next values of the messages which
+	// the vstream skipped because they are scheduled in the future,
+	// before the next regular poll. They are protected by streamMu.
+	dueTimes dueHeap
+	dueSet   map[int64]bool
 
 	// wg is for ensuring all running goroutines have returned
 	// before we can close the manager. You need to Add before
@@ -205,11 +266,21 @@ type messageManager struct {
 	// The goroutine must in turn defer on Done.
 	wg sync.WaitGroup
 
+	// updatableColumns contains the lower-cased names of the
+	// user-defined columns which can be set on ack or postpone.
+	updatableColumns map[string]bool
+
+	// dedupColumn is the dedup column of the table, if any.
+	dedupColumn sqlparser.ColIdent
+
 	vsFilter                  *binlogdatapb.Filter
 	readByPriorityAndTimeNext *sqlparser.ParsedQuery
+	readByIDs                 *sqlparser.ParsedQuery
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterQuery           *sqlparser.ParsedQuery
+	backlogQuery              *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -228,16 +299,32 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
 		batchSize:       table.MessageInfo.BatchSize,
+		maxAttempts:     int64(table.MessageInfo.MaxAttempts),
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
 		postponeSema:    postponeSema,
 		messagesPending: true,
+		dueSet:          make(map[int64]bool),
 	}
 	mm.cond.L = &mm.mu
+	mm.dedupColumn = sqlparser.NewColIdent(table.MessageInfo.DedupColumn)
+
+	// The id and the dedup key identify the message. So, they
+	// cannot be updated.
+	mm.updatableColumns = make(map[string]bool)
+	for _, field := range table.MessageInfo.Fields {
+		if name := strings.ToLower(field.Name); name != "id" && name != table.MessageInfo.DedupColumn {
+			mm.updatableColumns[name] = true
+		}
+	}
 
+	priorityColumn := sqlparser.NewColIdent(table.MessageInfo.PriorityColumn)
+	if priorityColumn.IsEmpty() {
+		priorityColumn = sqlparser.NewColIdent("priority")
+	}
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	vsQuery := sqlparser.BuildParsedQuery("select %v, time_next, epoch, time_acked, %s from %v", priorityColumn, columnList, mm.name).Query
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
@@ -245,25 +332,73 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		}},
 	}
 	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
-	mm.ackQuery = sqlparser.BuildParsedQuery(
-		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
-		mm.name, ":time_acked", "::ids")
+		"select %v, time_next, epoch, time_acked, %s from %v where time_next < %a order by %v, time_next desc limit %a",
+		priorityColumn, columnList, mm.name, ":time_next", priorityColumn, ":max")
+	mm.readByIDs = sqlparser.BuildParsedQuery(
+		"select %v, time_next, epoch, time_acked, %s from %v where id in %a and time_acked is null and time_next is not null",
+		priorityColumn, columnList, mm.name, "::ids")
+	mm.ackQuery = mm.buildAckQuery("")
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+	mm.postponeQuery = mm.buildPostponeQuery("")
+	mm.deadLetterQuery = sqlparser.BuildParsedQuery(
+		"update %v set time_next = null where id in %a and time_acked is null and time_next is not null", mm.name, "::ids")
+	oldest := "null"
+	if table.FindColumn(sqlparser.NewColIdent("time_created")) != -1 {
+		oldest = "min(if(time_next is null, null, time_created))"
+	}
+	mm.backlogQuery = sqlparser.BuildParsedQuery(
+		"select count(time_next), %s, count(*)-count(time_next) from %v where time_acked is null", oldest, mm.name)
+	return mm
+}
 
+// buildAckQuery builds the ack query. The additional assignments
+// in updates are appended to its set clause. If the table has a
+// dedup column, the messages can be acked by their dedup key too.
+func (mm *messageManager) buildAckQuery(updates string) *sqlparser.ParsedQuery {
+	if !mm.dedupColumn.IsEmpty() {
+		return sqlparser.BuildParsedQuery(
+			"update %v set time_acked = %a, time_next = null%s where (id in %a or %v in %a) and time_acked is null",
+			mm.name, ":time_acked", updates, "::ids", mm.dedupColumn, "::ids")
+	}
+	return sqlparser.BuildParsedQuery(
+		"update %v set time_acked = %a, time_next = null%s where id in %a and time_acked is null",
+		mm.name, ":time_acked", updates, "::ids")
+}
+
+// buildPostponeQuery builds the postpone query. The additional
+// assignments in updates are appended to its set clause.
+func (mm *messageManager) buildPostponeQuery(updates string) *sqlparser.ParsedQuery {
 	// if a maxBackoff is set, incorporate it into the update statement
 	if mm.maxBackoff > 0 {
-		mm.postponeQuery = sqlparser.BuildParsedQuery(
-			"update %v set time_next = %a+if(%a<<ifnull(epoch, 0) > %a, %a, %a<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1 where id in %a and time_acked is null",
-			mm.name, ":time_now", ":min_backoff", ":max_backoff", ":max_backoff", ":min_backoff", "::ids")
-	} else {
-		mm.postponeQuery = sqlparser.BuildParsedQuery(
-			"update %v set time_next = %a+(%a<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1 where id in %a and time_acked is null",
-			mm.name, ":time_now", ":min_backoff", "::ids")
+		return sqlparser.BuildParsedQuery(
+			"update %v set time_next = %a+if(%a<<ifnull(epoch, 0) > %a, %a, %a<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1%s where id in %a and time_acked is null",
+			mm.name, ":time_now", ":min_backoff", ":max_backoff", ":max_backoff", ":min_backoff", updates, "::ids")
 	}
-	return mm
+	return sqlparser.BuildParsedQuery(
+		"update %v set time_next = %a+(%a<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1%s where id in %a and time_acked is null",
+		mm.name, ":time_now", ":min_backoff", updates, "::ids")
+}
+
+// buildUpdates returns the assignments for setting the user-defined
+// columns in updates, and adds their values to bvs.
+func (mm *messageManager) buildUpdates(updates map[string]*querypb.Value, bvs map[string]*querypb.BindVariable) (string, error) {
+	columns := make([]string, 0, len(updates))
+	for column := range updates {
+		if !mm.updatableColumns[strings.ToLower(column)] {
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column %s cannot be updated in message table %v", column, mm.name)
+		}
+		columns = append(columns, column)
+	}
+	// Sort the columns to generate the same query for the same updates.
+	sort.Strings(columns)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, column := range columns {
+		bvname := fmt.Sprintf("update%d", i)
+		buf.Myprintf(", %v = %a", sqlparser.NewColIdent(column), ":"+bvname)
+		bvs[bvname] = sqltypes.ValueBindVariable(sqltypes.ProtoToValue(updates[column]))
+	}
+	return buf.String(), nil
 }
 
 // buildSelectColumnList is a convenience function that
@@ -328,7 +463,9 @@ func (mm *messageManager) Close() {
 // and returns a 'done' channel that will be closed when the subscription
 // ends. There are many reasons for a subscription to end: a grpc context
 // cancel or timeout, or tabletserver shutdown, etc.
-func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Result) error) <-chan struct{} {
+// If resumeIDs is not nil, the receiver is resumable, and the messages
+// in resumeIDs that are not acked yet are resent right away.
+func (mm *messageManager) Subscribe(ctx context.Context, resumeIDs map[string]int64, send func(*sqltypes.Result) error) <-chan struct{} {
 	receiver, done := newMessageReceiver(ctx, send)
 
 	mm.mu.Lock()
@@ -338,7 +475,13 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 		return done
 	}
 
-	if err := receiver.Send(mm.fieldResult); err != nil {
+	fieldResult := mm.fieldResult
+	if resumeIDs != nil {
+		fieldResult = &sqltypes.Result{
+			Fields: append(append([]*querypb.Field(nil), mm.fieldResult.Fields...), resumeTokenField),
+		}
+	}
+	if err := receiver.Send(fieldResult); err != nil {
 		log.Errorf("Terminating connection due to error sending field info: %v", err)
 		receiver.cancel()
 		return done
@@ -347,6 +490,12 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 	withStatus := &receiverWithStatus{
 		receiver: receiver,
 	}
+	if resumeIDs != nil {
+		withStatus.unacked = make(map[string]int64, len(resumeIDs))
+		for id, epoch := range resumeIDs {
+			withStatus.unacked[id] = epoch
+		}
+	}
 	if len(mm.receivers) == 0 {
 		mm.startVStream()
 	}
@@ -355,6 +504,10 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 	if mm.curReceiver == -1 {
 		mm.rescanReceivers(-1)
 	}
+	if len(resumeIDs) != 0 {
+		mm.wg.Add(1)
+		go mm.resume(resumeIDs)
+	}
 
 	// Track the context and unsubscribe if it gets cancelled.
 	go func() {
@@ -448,7 +601,7 @@ func (mm *messageManager) runSend() {
 		mm.mu.Unlock()
 		mm.mu.Lock()
 
-		var rows [][]sqltypes.Value
+		var mrs []*MessageRow
 		for {
 			if !mm.isOpen {
 				return
@@ -482,21 +635,23 @@ func (mm *messageManager) runSend() {
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
-				rows = append(rows, mr.Row)
+				MessagePriorityStats.Add([]string{mm.name.String(), priorityBucket(mr.Priority)}, 1)
+				mrs = append(mrs, mr)
 			}
 			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
 
 			// If we have rows to send, break out of this loop.
-			if rows != nil {
+			if mrs != nil {
 				break
 			}
 		}
-		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
+		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(mrs)))
 		// If we're here, there is a current receiver, and messages
 		// to send. Reserve the receiver and find the next one.
 		receiver := mm.receivers[mm.curReceiver]
 		receiver.busy = true
 		mm.rescanReceivers(mm.curReceiver)
+		rows := mm.buildRowsLocked(receiver, mrs)
 
 		// Send the message asynchronously.
 		mm.wg.Add(1)
@@ -504,6 +659,104 @@ func (mm *messageManager) runSend() {
 	}
 }
 
+// buildRowsLocked returns the rows to send to the receiver. If the
+// receiver is resumable, the messages are added to its unacked list,
+// and the rows get its resume token as an additional column.
+func (mm *messageManager) buildRowsLocked(receiver *receiverWithStatus, mrs []*MessageRow) [][]sqltypes.Value {
+	rows := make([][]sqltypes.Value, len(mrs))
+	if receiver.unacked == nil {
+		for i, mr := range mrs {
+			rows[i] = mr.Row
+		}
+		return rows
+	}
+	for _, mr := range mrs {
+		// The send postpones the message, which increments its epoch.
+		receiver.unacked[mr.Row[0].ToString()] = mr.Epoch + 1
+	}
+	for id := range receiver.unacked {
+		if len(receiver.unacked) <= mm.cache.Size() {
+			break
+		}
+		delete(receiver.unacked, id)
+	}
+	token := sqltypes.NewVarBinary(encodeResumeToken(receiver.unacked))
+	for i, mr := range mrs {
+		row := make([]sqltypes.Value, 0, len(mr.Row)+1)
+		rows[i] = append(append(row, mr.Row...), token)
+	}
+	return rows
+}
+
+// acked removes the acked messages from the unacked lists of the
+// receivers.
+func (mm *messageManager) acked(ids []string) {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	for _, receiver := range mm.receivers {
+		if receiver.unacked == nil {
+			continue
+		}
+		for _, id := range ids {
+			delete(receiver.unacked, id)
+		}
+	}
+}
+
+// resume reads the messages in ids that are not acked yet, and
+// adds them to the cache. A message is added only if it is due, or
+// if its epoch is still the one of the token: otherwise, it was resent
+// to another receiver since, which is now in charge of it.
+func (mm *messageManager) resume(ids map[string]int64) {
+	defer func() {
+		tabletenv.LogError()
+		mm.wg.Done()
+	}()
+
+	// Hold streamMu like the poller does, so that the rows
+	// cannot be added back while they're being postponed.
+	mm.streamMu.Lock()
+	defer mm.streamMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.pollerTicks.Interval())
+	defer cancel()
+	qr, err := mm.readResumable(ctx, ids)
+	if err != nil {
+		return
+	}
+
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	if len(mm.receivers) == 0 {
+		return
+	}
+	if len(qr.Rows) != 0 {
+		defer mm.cond.Broadcast()
+	}
+	resumed := int64(0)
+	now := time.Now().UnixNano()
+	for _, row := range qr.Rows {
+		mr, err := BuildMessageRow(row)
+		if err != nil {
+			tabletenv.InternalErrors.Add("Messages", 1)
+			log.Errorf("Error reading message row: %v", err)
+			continue
+		}
+		if mr.TimeNext > now && mr.Epoch != ids[mr.Row[0].ToString()] {
+			continue
+		}
+		if mm.exhausted(mr) {
+			continue
+		}
+		if !mm.cache.Add(mr) {
+			mm.messagesPending = true
+			break
+		}
+		resumed++
+	}
+	MessageStats.Add([]string{mm.name.String(), "Resumed"}, resumed)
+}
+
 func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result) {
 	defer func() {
 		tabletenv.LogError()
@@ -555,10 +808,13 @@ func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime t
 	defer mm.postponeSema.Release()
 	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), ackWaitTime)
 	defer cancel()
-	if _, err := tsv.PostponeMessages(ctx, nil, name, ids); err != nil {
+	count, err := tsv.PostponeMessages(ctx, nil, name, ids, nil)
+	if err != nil {
 		// This can happen during spikes. Record the incident for monitoring.
 		MessageStats.Add([]string{mm.name.String(), "PostponeFailed"}, 1)
+		return
 	}
+	MessageStats.Add([]string{mm.name.String(), "Postponed"}, count)
 }
 
 func (mm *messageManager) startVStream() {
@@ -683,7 +939,11 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if err != nil {
 			return err
 		}
-		if mr.TimeAcked != 0 || mr.TimeNext > now {
+		if mr.TimeAcked != 0 || mm.exhausted(mr) {
+			continue
+		}
+		if mr.TimeNext > now {
+			mm.scheduleLocked(mr.TimeNext, now)
 			continue
 		}
 		mm.Add(mr)
@@ -691,6 +951,62 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 	return nil
 }
 
+// scheduleLocked makes sure that the poller runs as soon as a
+// message scheduled for timeNext becomes due, if that's earlier
+// than the next regular poll. The caller must hold streamMu.
+func (mm *messageManager) scheduleLocked(timeNext, now int64) {
+	delay := time.Duration(timeNext - now)
+	if delay >= mm.pollerTicks.Interval() || mm.dueSet[timeNext] {
+		return
+	}
+	mm.dueSet[timeNext] = true
+	heap.Push(&mm.dueTimes, timeNext)
+	// The poller is already triggered for the earlier due times,
+	// and triggers itself again for the next one after each poll.
+	if mm.dueTimes[0] == timeNext {
+		mm.pollerTicks.TriggerAfter(delay)
+	}
+}
+
+// rescheduleLocked forgets the due times which have passed, and
+// triggers the poller again for the next one. The caller must hold
+// streamMu.
+func (mm *messageManager) rescheduleLocked(now int64) {
+	for len(mm.dueTimes) > 0 && mm.dueTimes[0] <= now {
+		delete(mm.dueSet, heap.Pop(&mm.dueTimes).(int64))
+	}
+	if len(mm.dueTimes) > 0 {
+		mm.pollerTicks.TriggerAfter(time.Duration(mm.dueTimes[0] - now))
+	}
+}
+
+// dueHeap is a min-heap of times.
+type dueHeap []int64
+
+func (dh dueHeap) Len() int {
+	return len(dh)
+}
+
+func (dh dueHeap) Less(i, j int) bool {
+	return dh[i] < dh[j]
+}
+
+func (dh dueHeap) Swap(i, j int) {
+	dh[i], dh[j] = dh[j], dh[i]
+}
+
+func (dh *dueHeap) Push(x interface{}) {
+	*dh = append(*dh, x.(int64))
+}
+
+func (dh *dueHeap) Pop() interface{} {
+	old := *dh
+	n := len(old)
+	x := old[n-1]
+	*dh = old[0 : n-1]
+	return x
+}
+
 func (mm *messageManager) runPoller() {
 	// Fast-path. Skip all the work.
 	if mm.receiverCount() == 0 {
@@ -699,6 +1015,7 @@ func (mm *messageManager) runPoller() {
 
 	mm.streamMu.Lock()
 	defer mm.streamMu.Unlock()
+	mm.rescheduleLocked(time.Now().UnixNano())
 
 	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.pollerTicks.Interval())
 	defer func() {
@@ -733,6 +1050,7 @@ func (mm *messageManager) runPoller() {
 		// Wake up the sender.
 		defer mm.cond.Broadcast()
 	}
+	var exhausted []string
 	for _, row := range qr.Rows {
 		mr, err := BuildMessageRow(row)
 		if err != nil {
@@ -740,15 +1058,92 @@ func (mm *messageManager) runPoller() {
 			log.Errorf("Error reading message row: %v", err)
 			continue
 		}
+		if mm.exhausted(mr) {
+			exhausted = append(exhausted, mr.Row[0].ToString())
+			continue
+		}
 		if !mm.cache.Add(mr) {
 			mm.messagesPending = true
-			return
+			break
 		}
 	}
+	if exhausted != nil {
+		mm.wg.Add(1)
+		go mm.deadLetter(exhausted)
+	}
+}
+
+// exhausted returns true if the message has been sent as many
+// times as allowed, and must be dead-lettered instead of resent.
+func (mm *messageManager) exhausted(mr *MessageRow) bool {
+	return mm.maxAttempts > 0 && mr.Epoch >= mm.maxAttempts
+}
+
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		tabletenv.LogError()
+		mm.wg.Done()
+	}()
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.name.String(), "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to dead-letter messages: %v", err)
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "DeadLettered"}, count)
 }
 
 func (mm *messageManager) runPurge() {
 	go purge(mm.tsv, mm.name.String(), mm.purgeAfter, mm.purgeTicks.Interval())
+	go mm.measureBacklog()
+}
+
+// measureBacklog updates the stats of the messages which are not acked yet.
+func (mm *messageManager) measureBacklog() {
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.purgeTicks.Interval())
+	defer func() {
+		tabletenv.LogError()
+		cancel()
+	}()
+
+	qr, err := mm.tsv.ReadMessageBacklog(ctx, nil, mm.name.String())
+	if err != nil {
+		log.Errorf("Unable to read message backlog: %v", err)
+		return
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 3 {
+		tabletenv.InternalErrors.Add("Messages", 1)
+		log.Errorf("Unexpected message backlog result: %v", qr.Rows)
+		return
+	}
+	row := qr.Rows[0]
+	pending, err := sqltypes.ToInt64(row[0])
+	if err != nil {
+		log.Errorf("Unexpected message backlog result: %v", err)
+		return
+	}
+	deadLettered, err := sqltypes.ToInt64(row[2])
+	if err != nil {
+		log.Errorf("Unexpected message backlog result: %v", err)
+		return
+	}
+	age := int64(0)
+	if !row[1].IsNull() {
+		oldest, err := sqltypes.ToInt64(row[1])
+		if err != nil {
+			log.Errorf("Unexpected message backlog result: %v", err)
+			return
+		}
+		if d := time.Since(time.Unix(0, oldest)); d > 0 {
+			age = int64(d / time.Second)
+		}
+	}
+	MessageStats.Set([]string{mm.name.String(), "Pending"}, pending)
+	MessageDeadLetterStats.Set(mm.name.String(), deadLettered)
+	MessageLagStats.Set(mm.name.String(), age)
 }
 
 // purge is a non-member because it should be called asynchronously and should
@@ -775,7 +1170,9 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 }
 
 // GenerateAckQuery returns the query and bind vars for acking a message.
-func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+// The user-defined columns in updates are set on the acked messages.
+// If the table has a dedup column, ids can also be dedup keys.
+func (mm *messageManager) GenerateAckQuery(ids []string, updates map[string]*querypb.Value) (string, map[string]*querypb.BindVariable, error) {
 	idbvs := &querypb.BindVariable{
 		Type:   querypb.Type_TUPLE,
 		Values: make([]*querypb.Value, 0, len(ids)),
@@ -786,14 +1183,23 @@ func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*qu
 			Value: []byte(id),
 		})
 	}
-	return mm.ackQuery.Query, map[string]*querypb.BindVariable{
+	bvs := map[string]*querypb.BindVariable{
 		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"ids":        idbvs,
 	}
+	if len(updates) == 0 {
+		return mm.ackQuery.Query, bvs, nil
+	}
+	assignments, err := mm.buildUpdates(updates, bvs)
+	if err != nil {
+		return "", nil, err
+	}
+	return mm.buildAckQuery(assignments).Query, bvs, nil
 }
 
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
-func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+// The user-defined columns in updates are set on the postponed messages.
+func (mm *messageManager) GeneratePostponeQuery(ids []string, updates map[string]*querypb.Value) (string, map[string]*querypb.BindVariable, error) {
 	idbvs := &querypb.BindVariable{
 		Type:   querypb.Type_TUPLE,
 		Values: make([]*querypb.Value, 0, len(ids)),
@@ -815,7 +1221,14 @@ func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[strin
 		bvs["max_backoff"] = sqltypes.Int64BindVariable(int64(mm.maxBackoff))
 	}
 
-	return mm.postponeQuery.Query, bvs
+	if len(updates) == 0 {
+		return mm.postponeQuery.Query, bvs, nil
+	}
+	assignments, err := mm.buildUpdates(updates, bvs)
+	if err != nil {
+		return "", nil, err
+	}
+	return mm.buildPostponeQuery(assignments).Query, bvs, nil
 }
 
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
@@ -825,6 +1238,30 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateBacklogQuery returns the query and bind vars for reading the
+// number of pending messages, the time_next of the oldest one and the
+// number of dead-lettered messages.
+func (mm *messageManager) GenerateBacklogQuery() (string, map[string]*querypb.BindVariable) {
+	return mm.backlogQuery.Query, make(map[string]*querypb.BindVariable)
+}
+
+// GenerateDeadLetterQuery returns the query and bind vars for dead-lettering messages.
+func (mm *messageManager) GenerateDeadLetterQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARCHAR,
+			Value: []byte(id),
+		})
+	}
+	return mm.deadLetterQuery.Query, map[string]*querypb.BindVariable{
+		"ids": idbvs,
+	}
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
@@ -836,21 +1273,21 @@ func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 		mr.Priority = v
 	}
 	if !row[1].IsNull() {
-		v, err := sqltypes.ToInt64(row[0])
+		v, err := sqltypes.ToInt64(row[1])
 		if err != nil {
 			return nil, err
 		}
 		mr.TimeNext = v
 	}
 	if !row[2].IsNull() {
-		v, err := sqltypes.ToInt64(row[1])
+		v, err := sqltypes.ToInt64(row[2])
 		if err != nil {
 			return nil, err
 		}
 		mr.Epoch = v
 	}
 	if !row[3].IsNull() {
-		v, err := sqltypes.ToInt64(row[2])
+		v, err := sqltypes.ToInt64(row[3])
 		if err != nil {
 			return nil, err
 		}
@@ -859,12 +1296,64 @@ func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	return mr, nil
 }
 
+// priorityBucket returns the MessagePriorityStats label for priority.
+// Priorities are user-defined values, so only the small ones get their
+// own label, to bound the number of exported series.
+func priorityBucket(priority int64) string {
+	switch {
+	case priority < 0:
+		return "<0"
+	case priority < 10:
+		return strconv.FormatInt(priority, 10)
+	case priority < 100:
+		return "10-99"
+	default:
+		return ">=100"
+	}
+}
+
 func (mm *messageManager) receiverCount() int {
 	mm.mu.Lock()
 	defer mm.mu.Unlock()
 	return len(mm.receivers)
 }
 
+// readResumable reads the messages in ids that are not acked yet.
+// Unlike readPending, it doesn't update lastPollPosition because
+// it doesn't read all the pending messages.
+func (mm *messageManager) readResumable(ctx context.Context, ids map[string]int64) (*sqltypes.Result, error) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARCHAR,
+			Value: []byte(id),
+		})
+	}
+	query, err := mm.readByIDs.GenerateQuery(map[string]*querypb.BindVariable{"ids": idbvs}, nil)
+	if err != nil {
+		tabletenv.InternalErrors.Add("Messages", 1)
+		log.Errorf("Error reading rows from message table: %v", err)
+		return nil, err
+	}
+	qr := &sqltypes.Result{}
+	err = mm.vs.StreamResults(ctx, query, func(response *binlogdatapb.VStreamResultsResponse) error {
+		if response.Fields != nil {
+			qr.Fields = response.Fields
+		}
+		for _, row := range response.Rows {
+			qr.Rows = append(qr.Rows, sqltypes.MakeRowTrusted(qr.Fields, row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
 func (mm *messageManager) readPending(ctx context.Context, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	query, err := mm.readByPriorityAndTimeNext.GenerateQuery(bindVars, nil)
 	if err != nil {
@@ -22,21 +22,25 @@ import (
 	"io"
 	"reflect"
 	"runtime"
+	"sort"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -138,7 +142,7 @@ func TestReceiverCancel(t *testing.T) {
 	r1 := newTestReceiver(0)
 	ctx, cancel := context.WithCancel(context.Background())
 	go cancel()
-	_ = mm.Subscribe(ctx, r1.rcv)
+	_ = mm.Subscribe(ctx, nil, r1.rcv)
 
 	// r1 should eventually be unsubscribed.
 	for i := 0; i < 10; i++ {
@@ -184,7 +188,7 @@ func TestMessageManagerAdd(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 
 	if !mm.Add(row1) {
 		t.Error("Add(1 receiver): false, want true")
@@ -207,7 +211,7 @@ func TestMessageManagerSend(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 
 	want := &sqltypes.Result{
 		Fields: testFields,
@@ -262,7 +266,7 @@ func TestMessageManagerSend(t *testing.T) {
 	// Test that mm stops sending to a canceled receiver.
 	r2 := newTestReceiver(1)
 	ctx, cancel := context.WithCancel(context.Background())
-	mm.Subscribe(ctx, r2.rcv)
+	mm.Subscribe(ctx, nil, r2.rcv)
 	<-r2.ch
 
 	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -294,6 +298,65 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerPriority(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PriorityColumn = "urgency"
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	wantFilter := "select urgency, time_next, epoch, time_acked, id, message from foo"
+	if got := mm.vsFilter.Rules[0].Filter; got != wantFilter {
+		t.Errorf("vstream filter: %s, want %s", got, wantFilter)
+	}
+	wantQuery := "select urgency, time_next, epoch, time_acked, id, message from foo where time_next < :time_next order by urgency, time_next desc limit :max"
+	if got := mm.readByPriorityAndTimeNext.Query; got != wantQuery {
+		t.Errorf("poller query: %s, want %s", got, wantQuery)
+	}
+
+	mr, err := BuildMessageRow([]sqltypes.Value{
+		sqltypes.NewInt64(2),
+		sqltypes.NewInt64(3),
+		sqltypes.NewInt64(4),
+		sqltypes.NULL,
+		sqltypes.NewVarBinary("1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRow := &MessageRow{Priority: 2, TimeNext: 3, Epoch: 4, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}}
+	if !reflect.DeepEqual(mr, wantRow) {
+		t.Errorf("BuildMessageRow: %+v, want %+v", mr, wantRow)
+	}
+
+	// Sent messages are counted by priority.
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
+	<-r1.ch
+	before := MessagePriorityStats.Counts()["foo.2"]
+	mm.Add(mr)
+	<-r1.ch
+	if got := MessagePriorityStats.Counts()["foo.2"] - before; got != 1 {
+		t.Errorf("MessagesSentByPriority[foo.2]: %d, want 1", got)
+	}
+}
+
+func TestPriorityBucket(t *testing.T) {
+	for priority, want := range map[int64]string{
+		-5:   "<0",
+		0:    "0",
+		9:    "9",
+		10:   "10-99",
+		99:   "10-99",
+		100:  ">=100",
+		1e12: ">=100",
+	} {
+		if got := priorityBucket(priority); got != want {
+			t.Errorf("priorityBucket(%d): %s, want %s", priority, got, want)
+		}
+	}
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -301,7 +364,7 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 	<-r1.ch
 
 	// Set the channel to verify call to Postpone.
@@ -316,7 +379,7 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 
 	// Set up a second subsriber, add a message.
 	r2 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r2.rcv)
+	mm.Subscribe(context.Background(), nil, r2.rcv)
 	<-r2.ch
 
 	// Wait.
@@ -352,7 +415,7 @@ func TestMessageManagerSendError(t *testing.T) {
 	ch := make(chan *sqltypes.Result)
 	go func() { <-ch }()
 	fieldSent := false
-	mm.Subscribe(ctx, func(qr *sqltypes.Result) error {
+	mm.Subscribe(ctx, nil, func(qr *sqltypes.Result) error {
 		ch <- qr
 		if !fieldSent {
 			fieldSent = true
@@ -380,7 +443,7 @@ func TestMessageManagerFieldSendError(t *testing.T) {
 
 	ch := make(chan *sqltypes.Result)
 	go func() { <-ch }()
-	done := mm.Subscribe(ctx, func(qr *sqltypes.Result) error {
+	done := mm.Subscribe(ctx, nil, func(qr *sqltypes.Result) error {
 		ch <- qr
 		return errors.New("non-eof")
 	})
@@ -398,7 +461,7 @@ func TestMessageManagerBatchSend(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 	<-r1.ch
 
 	row1 := &MessageRow{
@@ -468,7 +531,7 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 	<-r1.ch
 
 	want := &sqltypes.Result{
@@ -482,6 +545,114 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	}
 }
 
+func TestMessageManagerScheduled(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	due := time.Now().Add(100 * time.Millisecond).UnixNano()
+	row := sqltypes.RowToProto3([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(due),
+		sqltypes.NewInt64(0),
+		sqltypes.NULL,
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarBinary("1"),
+	})
+	fvs := newFakeVStreamer()
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}})
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
+	<-r1.ch
+
+	// The vstream skips the message because it's not due yet.
+	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}, {
+		Type: binlogdatapb.VEventType_OTHER,
+	}}, {{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "foo",
+			Fields:    testDBFields,
+		},
+	}, {
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  "foo",
+			RowChanges: []*binlogdatapb.RowChange{{After: row}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-101",
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}})
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		mm.streamMu.Lock()
+		scheduled := len(mm.dueTimes) == 1 && mm.dueTimes[0] == due
+		mm.streamMu.Unlock()
+		if scheduled {
+			break
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("vstream did not schedule the message")
+		}
+	}
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-101",
+	}, {
+		Rows: []*querypb.Row{row},
+	}})
+
+	// The poller picks up the message once it's due, without
+	// waiting for the poll interval.
+	select {
+	case got := <-r1.ch:
+		want := [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewVarBinary("1")}}
+		if !reflect.DeepEqual(got.Rows, want) {
+			t.Errorf("Received: %v, want %v", got.Rows, want)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("scheduled message was not delivered")
+	}
+}
+
+func TestMessageManagerSchedule(t *testing.T) {
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm.streamMu.Lock()
+	defer mm.streamMu.Unlock()
+
+	// All the due times before the next regular poll are kept,
+	// once each.
+	now := time.Now().UnixNano()
+	for _, timeNext := range []int64{now + 3e6, now + 1e6, now + 2e6, now + 1e6, now + 1e12} {
+		mm.scheduleLocked(timeNext, now)
+	}
+	var got []int64
+	for _, timeNext := range mm.dueTimes {
+		got = append(got, timeNext-now)
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	if want := []int64{1e6, 2e6, 3e6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("dueTimes: %v, want %v", got, want)
+	}
+
+	// A poll forgets the due times which have passed, and keeps the
+	// later ones.
+	mm.rescheduleLocked(now + 2e6)
+	if len(mm.dueTimes) != 1 || mm.dueTimes[0] != now+3e6 || len(mm.dueSet) != 1 {
+		t.Errorf("dueTimes after poll: %v, want [%d]", mm.dueTimes, now+3e6)
+	}
+}
+
 func TestMessageManagerStreamerAndPoller(t *testing.T) {
 	fvs := newFakeVStreamer()
 	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
@@ -493,7 +664,7 @@ func TestMessageManagerStreamerAndPoller(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 	<-r1.ch
 
 	for {
@@ -593,7 +764,7 @@ func TestMessageManagerPoller(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	r1 := newTestReceiver(1)
-	mm.Subscribe(ctx, r1.rcv)
+	mm.Subscribe(ctx, nil, r1.rcv)
 	<-r1.ch
 
 	want := [][]sqltypes.Value{{
@@ -649,7 +820,7 @@ func TestMessagesPending1(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 
 	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	// Make sure the first message is enqueued.
@@ -698,7 +869,7 @@ func TestMessagesPending2(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
 
 	// Now, let's pull more than 1 item. It should
 	// trigger the poller every time cache gets empty.
@@ -711,6 +882,192 @@ func TestMessagesPending2(t *testing.T) {
 	}
 }
 
+func TestMessageManagerResume(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	fvs := newFakeVStreamer()
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r1 := newTestReceiver(1)
+	mm.Subscribe(ctx, nil, r1.rcv)
+	<-r1.ch
+
+	// Wait for the first poll, so that only a resume can
+	// read the message back.
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		mm.mu.Lock()
+		pending := mm.messagesPending
+		mm.mu.Unlock()
+		if !pending {
+			break
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("poller did not run")
+		}
+	}
+
+	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("1")}})
+	<-r1.ch
+	cancel()
+	for start := time.Now(); mm.receiverCount() != 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("receiver was not unsubscribed")
+		}
+	}
+
+	// The message was postponed, but not acked.
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+	}, {
+		Rows: []*querypb.Row{
+			sqltypes.RowToProto3([]sqltypes.Value{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(time.Now().Add(time.Hour).UnixNano()),
+				sqltypes.NewInt64(1),
+				sqltypes.NULL,
+				sqltypes.NewInt64(1),
+				sqltypes.NewVarBinary("1"),
+			}),
+		},
+	}})
+
+	// The consumer resumes with the message it didn't ack. The
+	// stream of a resumable receiver has a resume_token column.
+	r2 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), map[string]int64{"1": 1}, r2.rcv)
+	fields := <-r2.ch
+	assert.Equal(t, "resume_token", fields.Fields[len(fields.Fields)-1].Name)
+	select {
+	case got := <-r2.ch:
+		want := [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NewVarBinary(encodeResumeToken(map[string]int64{"1": 2})),
+		}}
+		if !reflect.DeepEqual(got.Rows, want) {
+			t.Errorf("Received: %v, want %v", got.Rows, want)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("message was not resent on resume")
+	}
+
+	// The ack removes the message from the token.
+	mm.acked([]string{"1"})
+	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewVarBinary("2")}})
+	got := <-r2.ch
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarBinary("2"),
+		sqltypes.NewVarBinary(encodeResumeToken(map[string]int64{"2": 1})),
+	}}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("Received: %v, want %v", got.Rows, want)
+	}
+}
+
+func TestMessageManagerResumeSkipsResent(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	fvs := newFakeVStreamer()
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	// Wait for the first poll, so that only a resume can
+	// read the messages.
+	ctx, cancel := context.WithCancel(context.Background())
+	r0 := newTestReceiver(1)
+	mm.Subscribe(ctx, nil, r0.rcv)
+	<-r0.ch
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		mm.mu.Lock()
+		pending := mm.messagesPending
+		mm.mu.Unlock()
+		if !pending {
+			break
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("poller did not run")
+		}
+	}
+	cancel()
+	for start := time.Now(); mm.receiverCount() != 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("receiver was not unsubscribed")
+		}
+	}
+
+	// Message 1 was resent to another receiver since the token was
+	// emitted, and message 2 is not due yet: neither is resent.
+	// Message 3 was resent too, but it is due again.
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+	}, {
+		Rows: []*querypb.Row{
+			sqltypes.RowToProto3([]sqltypes.Value{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(time.Now().Add(time.Hour).UnixNano()),
+				sqltypes.NewInt64(2),
+				sqltypes.NULL,
+				sqltypes.NewInt64(1),
+				sqltypes.NewVarBinary("1"),
+			}),
+			sqltypes.RowToProto3([]sqltypes.Value{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(time.Now().Add(time.Hour).UnixNano()),
+				sqltypes.NewInt64(0),
+				sqltypes.NULL,
+				sqltypes.NewInt64(2),
+				sqltypes.NewVarBinary("2"),
+			}),
+			sqltypes.RowToProto3([]sqltypes.Value{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(time.Now().Add(-time.Second).UnixNano()),
+				sqltypes.NewInt64(2),
+				sqltypes.NULL,
+				sqltypes.NewInt64(3),
+				sqltypes.NewVarBinary("3"),
+			}),
+		},
+	}})
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), map[string]int64{"1": 1, "2": 1, "3": 1}, r1.rcv)
+	<-r1.ch
+	select {
+	case got := <-r1.ch:
+		assert.Equal(t, 1, len(got.Rows))
+		assert.Equal(t, "3", got.Rows[0][0].ToString())
+	case <-time.After(10 * time.Second):
+		t.Fatal("message was not resent on resume")
+	}
+}
+
+func TestResumeToken(t *testing.T) {
+	unacked := map[string]int64{"1": 1, "a,b": 3, "": 2}
+	token := encodeResumeToken(unacked)
+	got, err := decodeResumeToken(token, 3)
+	require.NoError(t, err)
+	assert.Equal(t, unacked, got)
+
+	_, err = decodeResumeToken(token, 2)
+	assert.EqualError(t, err, "resume token has 3 message ids, more than the cache size 2")
+
+	got, err = decodeResumeToken(encodeResumeToken(nil), 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{}, got)
+
+	got, err = decodeResumeToken("", 2)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = decodeResumeToken("consumer", 2)
+	assert.Error(t, err)
+}
+
 func TestMessageManagerPurge(t *testing.T) {
 	tsv := newFakeTabletServer()
 
@@ -729,11 +1086,95 @@ func TestMessageManagerPurge(t *testing.T) {
 	}
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+
+	ti := newMMTable()
+	ti.MessageInfo.BatchSize = 2
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	ti.MessageInfo.MaxAttempts = 3
+	fvs := newFakeVStreamer()
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}, {
+		Rows: []*querypb.Row{
+			newMMRow(1),
+			sqltypes.RowToProto3([]sqltypes.Value{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(3),
+				sqltypes.NULL,
+				sqltypes.NewInt64(2),
+				sqltypes.NewVarBinary("2"),
+			}),
+		},
+	}})
+	mm := newMessageManager(tsv, fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), nil, r1.rcv)
+	<-r1.ch
+
+	// Only the message which has attempts left is sent.
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewVarBinary("1"),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+	for {
+		if got := <-ch; got == "deadletter" {
+			break
+		}
+	}
+	tsv.mu.Lock()
+	defer tsv.mu.Unlock()
+	assert.Equal(t, []string{"2"}, tsv.deadLettered)
+}
+
+func TestMessageManagerBacklog(t *testing.T) {
+	tsv := newFakeTabletServer()
+	tsv.SetBacklog(sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pending|oldest|dead", "int64|int64|int64"),
+		fmt.Sprintf("5|%d|2", time.Now().Add(-time.Minute).UnixNano()),
+	))
+
+	ti := newMMTable()
+	ti.Name = sqlparser.NewTableIdent("backlog")
+	ti.MessageInfo.PollInterval = 1 * time.Millisecond
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	for start := time.Now(); MessageLagStats.Counts()["backlog"] == 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("backlog was not measured")
+		}
+	}
+	if got := MessageLagStats.Counts()["backlog"]; got < 60 || got > 70 {
+		t.Errorf("MessagesOldestUnackedAgeSeconds: %d, want about 60", got)
+	}
+	if got, want := MessageStats.Counts()["backlog.Pending"], int64(5); got != want {
+		t.Errorf("Messages Pending: %d, want %d", got, want)
+	}
+	if got, want := MessageDeadLetterStats.Counts()["backlog"], int64(2); got != want {
+		t.Errorf("MessagesDeadLettered: %d, want %d", got, want)
+	}
+}
+
 func TestMMGenerate(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
-	query, bv := mm.GenerateAckQuery([]string{"1", "2"})
+	query, bv, _ := mm.GenerateAckQuery([]string{"1", "2"}, nil)
 	wantQuery := "update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null"
 	if query != wantQuery {
 		t.Errorf("GenerateAckQuery query: %s, want %s", query, wantQuery)
@@ -750,7 +1191,7 @@ func TestMMGenerate(t *testing.T) {
 		t.Errorf("gotid: %v, want %v", gotids, wantids)
 	}
 
-	query, bv = mm.GeneratePostponeQuery([]string{"1", "2"})
+	query, bv, _ = mm.GeneratePostponeQuery([]string{"1", "2"}, nil)
 	wantQuery = "update foo set time_next = :time_now+(:min_backoff<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1 where id in ::ids and time_acked is null"
 	if query != wantQuery {
 		t.Errorf("GeneratePostponeQuery query: %s, want %s", query, wantQuery)
@@ -780,6 +1221,35 @@ func TestMMGenerate(t *testing.T) {
 	if !reflect.DeepEqual(bv, wantbv) {
 		t.Errorf("gotid: %v, want %v", bv, wantbv)
 	}
+
+	// Without a time_created column, the age is not measured.
+	query, bv = mm.GenerateBacklogQuery()
+	wantQuery = "select count(time_next), null, count(*)-count(time_next) from foo where time_acked is null"
+	if query != wantQuery {
+		t.Errorf("GenerateBacklogQuery query: %s, want %s", query, wantQuery)
+	}
+	if len(bv) != 0 {
+		t.Errorf("GenerateBacklogQuery bind vars: %v, want none", bv)
+	}
+	ti := newMMTable()
+	ti.Fields = []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "time_created", Type: sqltypes.Int64}}
+	query, _ = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0)).GenerateBacklogQuery()
+	wantQuery = "select count(time_next), min(if(time_next is null, null, time_created)), count(*)-count(time_next) from foo where time_acked is null"
+	if query != wantQuery {
+		t.Errorf("GenerateBacklogQuery query: %s, want %s", query, wantQuery)
+	}
+
+	query, bv = mm.GenerateDeadLetterQuery([]string{"1", "2"})
+	wantQuery = "update foo set time_next = null where id in ::ids and time_acked is null and time_next is not null"
+	if query != wantQuery {
+		t.Errorf("GenerateDeadLetterQuery query: %s, want %s", query, wantQuery)
+	}
+	wantbv = map[string]*querypb.BindVariable{
+		"ids": wantids,
+	}
+	if !reflect.DeepEqual(bv, wantbv) {
+		t.Errorf("gotid: %v, want %v", bv, wantbv)
+	}
 }
 
 func TestMMGenerateWithBackoff(t *testing.T) {
@@ -789,7 +1259,7 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 
 	wantids := sqltypes.TestBindVariable([]interface{}{"1", "2"})
 
-	query, bv := mm.GeneratePostponeQuery([]string{"1", "2"})
+	query, bv, _ := mm.GeneratePostponeQuery([]string{"1", "2"}, nil)
 	wantQuery := "update foo set time_next = :time_now+if(:min_backoff<<ifnull(epoch, 0) > :max_backoff, :max_backoff, :min_backoff<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1 where id in ::ids and time_acked is null"
 	if query != wantQuery {
 		t.Errorf("GeneratePostponeQuery query: %s, want %s", query, wantQuery)
@@ -810,13 +1280,68 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 	}
 }
 
+func TestMMGenerateWithUpdates(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.Fields = append(testFields, &querypb.Field{
+		Name: "payload",
+		Type: sqltypes.TypeJSON,
+	}, &querypb.Field{
+		Name: "dedup_key",
+		Type: sqltypes.VarChar,
+	})
+	ti.MessageInfo.DedupColumn = "dedup_key"
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	updates := map[string]*querypb.Value{
+		"payload": {Type: sqltypes.TypeJSON, Value: []byte(`{"status": "done"}`)},
+		"message": {Type: sqltypes.VarBinary, Value: []byte("b")},
+	}
+	query, bv, err := mm.GenerateAckQuery([]string{"1"}, updates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantQuery := "update foo set time_acked = :time_acked, time_next = null, message = :update0, payload = :update1 where (id in ::ids or dedup_key in ::ids) and time_acked is null"
+	if query != wantQuery {
+		t.Errorf("GenerateAckQuery query: %s, want %s", query, wantQuery)
+	}
+	wantPayload := &querypb.BindVariable{Type: sqltypes.TypeJSON, Value: []byte(`{"status": "done"}`)}
+	if got := bv["update1"]; !reflect.DeepEqual(got, wantPayload) {
+		t.Errorf("update1: %v, want %v", got, wantPayload)
+	}
+
+	query, _, err = mm.GeneratePostponeQuery([]string{"1"}, map[string]*querypb.Value{"payload": updates["payload"]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantQuery = "update foo set time_next = :time_now+(:min_backoff<<ifnull(epoch, 0)), epoch = ifnull(epoch, 0)+1, payload = :update0 where id in ::ids and time_acked is null"
+	if query != wantQuery {
+		t.Errorf("GeneratePostponeQuery query: %s, want %s", query, wantQuery)
+	}
+
+	// Only user-defined columns other than the id and the dedup key can be set.
+	for _, column := range []string{"id", "dedup_key", "time_next", "unknown"} {
+		_, _, err := mm.GenerateAckQuery([]string{"1"}, map[string]*querypb.Value{column: updates["message"]})
+		want := fmt.Sprintf("column %s cannot be updated in message table foo", column)
+		if err == nil || err.Error() != want {
+			t.Errorf("GenerateAckQuery(%s): %v, want %s", column, err, want)
+		}
+		if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+			t.Errorf("GenerateAckQuery(%s): code %v, want %v", column, code, vtrpcpb.Code_INVALID_ARGUMENT)
+		}
+	}
+}
+
 type fakeTabletServer struct {
 	tabletenv.Env
 	postponeCount sync2.AtomicInt64
 	purgeCount    sync2.AtomicInt64
 
-	mu sync.Mutex
-	ch chan string
+	mu           sync.Mutex
+	ch           chan string
+	deadLettered []string
+	backlog      *sqltypes.Result
 }
 
 func newFakeTabletServer() *fakeTabletServer {
@@ -834,7 +1359,7 @@ func (fts *fakeTabletServer) SetChannel(ch chan string) {
 	fts.mu.Unlock()
 }
 
-func (fts *fakeTabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+func (fts *fakeTabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string, updates map[string]*querypb.Value) (count int64, err error) {
 	fts.postponeCount.Add(1)
 	fts.mu.Lock()
 	ch := fts.ch
@@ -856,6 +1381,32 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	fts.mu.Lock()
+	fts.deadLettered = append(fts.deadLettered, ids...)
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
+func (fts *fakeTabletServer) SetBacklog(backlog *sqltypes.Result) {
+	fts.mu.Lock()
+	fts.backlog = backlog
+	fts.mu.Unlock()
+}
+
+func (fts *fakeTabletServer) ReadMessageBacklog(ctx context.Context, target *querypb.Target, name string) (*sqltypes.Result, error) {
+	fts.mu.Lock()
+	defer fts.mu.Unlock()
+	if fts.backlog == nil {
+		return nil, errors.New("no backlog")
+	}
+	return fts.backlog, nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
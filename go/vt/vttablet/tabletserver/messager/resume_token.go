@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// resumeTokenField is the column that a resumable stream adds to
+// the messages it sends. Every row of a batch carries the same token.
+var resumeTokenField = &querypb.Field{
+	Name: "resume_token",
+	Type: sqltypes.VarBinary,
+}
+
+// encodeResumeToken returns the resume token of a receiver. The token
+// maps the ids of the messages sent to the receiver and not acked yet
+// to their epoch after the send. The tablet doesn't keep the token:
+// the consumer persists the last one it received along with its own
+// progress, and passes it when it reconnects.
+// The token is never empty, a number or a boolean, so it can be given
+// as a RESUME_TOKEN comment directive.
+func encodeResumeToken(unacked map[string]int64) string {
+	if unacked == nil {
+		unacked = map[string]int64{}
+	}
+	b, _ := json.Marshal(unacked)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeResumeToken returns the message ids and epochs of a token
+// generated by encodeResumeToken. The token cannot have more than
+// max ids. An empty token returns nil: the stream is not resumable.
+func decodeResumeToken(token string, max int) (map[string]int64, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q: %v", token, err)
+	}
+	var unacked map[string]int64
+	if err := json.Unmarshal(b, &unacked); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q: %v", token, err)
+	}
+	if unacked == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid resume token %q", token)
+	}
+	if len(unacked) > max {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "resume token has %d message ids, more than the cache size %d", len(unacked), max)
+	}
+	return unacked, nil
+}
@@ -17,7 +17,12 @@ limitations under the License.
 package planbuilder
 
 import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
@@ -31,6 +36,7 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		FieldQuery: GenerateFieldQuery(sel),
 		FullQuery:  GenerateLimitQuery(sel),
 	}
+	plan.LagSensitivity = lagSensitivity(sel.Comments)
 	if sel.Lock != "" {
 		plan.PlanID = PlanSelectLock
 	}
@@ -66,12 +72,14 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		PlanID: PlanUpdate,
 		Table:  lookupTable(upd.TableExprs, tables),
 	}
+	plan.ForeignKeyCascades = foreignKeyCascades(plan.Table, false, upd.Exprs)
 
 	// Store the WHERE clause as string for the hot row protection (txserializer).
 	if upd.Where != nil {
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.WhereEqualities = whereEqualities(upd.Where)
 	}
 
 	// Situations when we pass-through:
@@ -90,17 +98,81 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// foreignKeyCascades returns the foreign keys which reference table and
+// whose referential actions change the child rows when the rows of
+// table are deleted, if deletes is set, or when the columns of updated
+// are updated.
+func foreignKeyCascades(table *schema.Table, deletes bool, updated sqlparser.UpdateExprs) []*schema.ForeignKey {
+	if table == nil {
+		return nil
+	}
+	var cascades []*schema.ForeignKey
+	for _, fk := range table.ReferencedBy {
+		switch {
+		case deletes && fk.CascadesOnDelete():
+		case fk.CascadesOnUpdate() && updatesAny(updated, fk.ReferencedColumns):
+		default:
+			continue
+		}
+		cascades = append(cascades, fk)
+	}
+	return cascades
+}
+
+// updatesAny returns true if exprs update any of the columns.
+func updatesAny(exprs sqlparser.UpdateExprs, columns []string) bool {
+	for _, expr := range exprs {
+		for _, col := range columns {
+			if expr.Name.Name.EqualString(col) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// whereEqualities returns the columns that are compared to a value
+// with "=" in the top level AND expressions of the where clause.
+func whereEqualities(where *sqlparser.Where) map[string]sqltypes.PlanValue {
+	var equalities map[string]sqltypes.PlanValue
+	for _, filter := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		comparison, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || comparison.Operator != sqlparser.EqualStr {
+			continue
+		}
+		col, val := comparison.Left, comparison.Right
+		if _, ok := col.(*sqlparser.ColName); !ok {
+			col, val = val, col
+		}
+		colName, ok := col.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		pv, err := sqlparser.NewPlanValue(val)
+		if err != nil {
+			continue
+		}
+		if equalities == nil {
+			equalities = make(map[string]sqltypes.PlanValue)
+		}
+		equalities[colName.Name.Lowered()] = pv
+	}
+	return equalities
+}
+
 // analyzeDelete code is almost identical to analyzeUpdate.
 func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID: PlanDelete,
 		Table:  lookupTable(del.TableExprs, tables),
 	}
+	plan.ForeignKeyCascades = foreignKeyCascades(plan.Table, true, nil)
 
 	if del.Where != nil {
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.WhereEqualities = whereEqualities(del.Where)
 	}
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
@@ -116,15 +188,97 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
-		PlanID:    PlanInsert,
-		FullQuery: GenerateFullQuery(ins),
+		PlanID: PlanInsert,
 	}
 
 	tableName := sqlparser.GetTableName(ins.Table)
 	plan.Table = tables[tableName.String()]
+	// REPLACE deletes the rows it replaces.
+	plan.ForeignKeyCascades = foreignKeyCascades(plan.Table, ins.Action == sqlparser.ReplaceStr, sqlparser.UpdateExprs(ins.OnDup))
+	if plan.Table != nil && plan.Table.Type == schema.Message {
+		if err := analyzeInsertMessage(ins, plan, tables); err != nil {
+			return nil, err
+		}
+	}
+	plan.FullQuery = GenerateFullQuery(ins)
 	return plan, nil
 }
 
+// analyzeInsertMessage analyzes an insert into a message table. If the
+// table has consumer groups, the insert is repeated for each of their
+// tables, which also require the WRITER role. The groups must get the
+// same ids as the table: if the insert doesn't set them, the group
+// inserts set the ids generated by the insert.
+func analyzeInsertMessage(ins *sqlparser.Insert, plan *Plan, tables map[string]*schema.Table) error {
+	if err := analyzeInsertMessageRows(ins, plan); err != nil {
+		return err
+	}
+	info := plan.Table.MessageInfo
+	if info == nil || len(info.ConsumerGroups) == 0 {
+		return nil
+	}
+	groupIns := *ins
+	if id := sqlparser.NewColIdent("id"); ins.Columns.FindColumn(id) == -1 {
+		rows, ok := ins.Rows.(sqlparser.Values)
+		if !ok || len(ins.Columns) == 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "insert into %s requires an explicit id, or a column list and values, because of its consumer groups", plan.Table.Name.String())
+		}
+		groupRows := make(sqlparser.Values, len(rows))
+		for i, row := range rows {
+			groupRows[i] = append(append(sqlparser.ValTuple(nil), row...), sqlparser.NewValArg([]byte(fmt.Sprintf(":#message_id%d", i))))
+		}
+		groupIns.Columns = append(append(sqlparser.Columns(nil), ins.Columns...), id)
+		groupIns.Rows = groupRows
+		plan.GroupIDs = len(rows)
+	}
+	for _, group := range info.ConsumerGroups {
+		if table := tables[group]; table == nil || table.Type != schema.Message {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "consumer group %s of %s is not a message table", group, plan.Table.Name.String())
+		}
+		groupIns.Table = sqlparser.TableName{Name: sqlparser.NewTableIdent(group), Qualifier: ins.Table.Qualifier}
+		plan.GroupQueries = append(plan.GroupQueries, GenerateFullQuery(&groupIns))
+		plan.Permissions = buildTableNamePermissions(groupIns.Table, tableacl.WRITER, plan.Permissions)
+	}
+	return nil
+}
+
+// analyzeInsertMessageRows handles the DELIVER_AFTER directive for inserts
+// into message tables: the plan becomes PlanInsertMessage, and time_next is
+// set to :#time_next, which holds the time at which the messages become due.
+// Plain inserts keep the PlanInsert plan. If the table has a dedup column
+// and the insert sets it, the insert becomes an insert ignore: the unique
+// index on the dedup column makes it skip the rows which repeat a key.
+func analyzeInsertMessageRows(ins *sqlparser.Insert, plan *Plan) error {
+	if info := plan.Table.MessageInfo; info != nil && info.DedupColumn != "" && ins.Action == sqlparser.InsertStr && ins.OnDup == nil {
+		if ins.Columns.FindColumn(sqlparser.NewColIdent(info.DedupColumn)) != -1 {
+			ins.Ignore = sqlparser.IgnoreStr
+		}
+	}
+	val, ok := sqlparser.ExtractCommentDirectives(ins.Comments)[sqlparser.DirectiveDeliverAfter]
+	if !ok {
+		return nil
+	}
+	seconds, ok := val.(int)
+	if !ok || seconds < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s must be a non-negative number of seconds: %v", sqlparser.DirectiveDeliverAfter, val)
+	}
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if !ok || len(ins.Columns) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s requires an insert with a column list and values", sqlparser.DirectiveDeliverAfter)
+	}
+	timeNext := sqlparser.NewColIdent("time_next")
+	if ins.Columns.FindColumn(timeNext) != -1 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s cannot be combined with an explicit time_next", sqlparser.DirectiveDeliverAfter)
+	}
+	ins.Columns = append(ins.Columns, timeNext)
+	for i := range rows {
+		rows[i] = append(rows[i], sqlparser.NewValArg([]byte(":#time_next")))
+	}
+	plan.PlanID = PlanInsertMessage
+	plan.DeliverAfter = time.Duration(seconds) * time.Second
+	return nil
+}
+
 func analyzeSet(set *sqlparser.Set) (plan *Plan) {
 	return &Plan{
 		PlanID:    PlanSet,
@@ -18,6 +18,8 @@ package planbuilder
 
 import (
 	"encoding/json"
+	"strings"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -61,6 +63,9 @@ const (
 	PlanSelectStream
 	// PlanMessageStream is for "stream" statements.
 	PlanMessageStream
+	// PlanSavepoint is for savepoint, rollback to and release
+	// savepoint statements.
+	PlanSavepoint
 	NumPlans
 )
 
@@ -82,6 +87,7 @@ var planName = [NumPlans]string{
 	"OtherAdmin",
 	"SelectStream",
 	"MessageStream",
+	"Savepoint",
 }
 
 func (pt PlanType) String() string {
@@ -133,6 +139,150 @@ type Plan struct {
 	// WhereClause is set for DMLs. It is used by the hot row protection
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
+
+	// WhereEqualities is set for DMLs. It maps the lowercased names of
+	// columns compared with "=" at the top level of the WHERE clause to
+	// their values. It is used by the hot row protection to serialize
+	// e.g. UPDATEs by a configured set of key columns.
+	WhereEqualities map[string]sqltypes.PlanValue
+
+	// LagSensitivity is set for selects which carry the LAG_SENSITIVE
+	// directive.
+	LagSensitivity LagSensitivity
+
+	// Priority is set for statements which carry the PRIORITY directive.
+	Priority Priority
+
+	// QueryTimeout is set for statements which carry the
+	// QUERY_TIMEOUT_MS directive.
+	QueryTimeout time.Duration
+
+	// MaxRows is set for statements which carry the MAX_ROWS
+	// directive. It overrides the max result size of the tablet.
+	MaxRows int64
+
+	// DeliverAfter is set for inserts into message tables which carry
+	// the DELIVER_AFTER directive.
+	DeliverAfter time.Duration
+
+	// GroupQueries is set for inserts into message tables which have
+	// consumer groups. They insert the same messages into the tables
+	// of the groups.
+	GroupQueries []*sqlparser.ParsedQuery
+
+	// GroupIDs is the number of rows of an insert with consumer groups
+	// which doesn't set the ids. The group queries set the ids that the
+	// insert generated, given as the :#message_id<n> bind variables.
+	GroupIDs int
+
+	// ForeignKeyCascades is set for DMLs which change the rows of
+	// other tables through the referential actions of foreign keys.
+	// The changed rows can be on other shards, and MySQL doesn't write
+	// the cascaded changes to the binlog for vreplication.
+	ForeignKeyCascades []*schema.ForeignKey
+}
+
+// LagSensitivity is the replication lag sensitivity of a read.
+type LagSensitivity int
+
+// The following are LagSensitivity values.
+const (
+	// LagSensitivityUnspecified means that the query does not say.
+	LagSensitivityUnspecified LagSensitivity = iota
+	LagSensitive
+	LagTolerant
+)
+
+// Priority is the scheduling priority of a query.
+type Priority int
+
+// The following are Priority values.
+const (
+	// PriorityUnspecified means that the query does not say.
+	PriorityUnspecified Priority = iota
+	PriorityCritical
+	PriorityNormal
+	PriorityBatch
+)
+
+var priorityNames = []string{
+	"unspecified",
+	"critical",
+	"normal",
+	"batch",
+}
+
+func (p Priority) String() string {
+	return priorityNames[p]
+}
+
+// PriorityFromName returns the Priority for the given name,
+// or PriorityUnspecified if the name is not known.
+func PriorityFromName(name string) Priority {
+	for p, n := range priorityNames {
+		if n == strings.ToLower(name) {
+			return Priority(p)
+		}
+	}
+	return PriorityUnspecified
+}
+
+// statementDirectives returns the comment directives of the statement.
+func statementDirectives(statement sqlparser.Statement) sqlparser.CommentDirectives {
+	var comments sqlparser.Comments
+	switch stmt := statement.(type) {
+	case *sqlparser.Select:
+		comments = stmt.Comments
+	case *sqlparser.Insert:
+		comments = stmt.Comments
+	case *sqlparser.Update:
+		comments = stmt.Comments
+	case *sqlparser.Delete:
+		comments = stmt.Comments
+	}
+	return sqlparser.ExtractCommentDirectives(comments)
+}
+
+// priority returns the priority requested by the PRIORITY directive.
+func priority(directives sqlparser.CommentDirectives) Priority {
+	name, ok := directives[sqlparser.DirectivePriority].(string)
+	if !ok {
+		return PriorityUnspecified
+	}
+	return PriorityFromName(name)
+}
+
+// queryTimeout returns the timeout requested by the QUERY_TIMEOUT_MS
+// directive, or 0 if there is none.
+func queryTimeout(directives sqlparser.CommentDirectives) time.Duration {
+	ms, ok := directives[sqlparser.DirectiveQueryTimeout].(int)
+	if !ok || ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// maxRows returns the limit requested by the MAX_ROWS directive,
+// or 0 if there is none.
+func maxRows(directives sqlparser.CommentDirectives) int64 {
+	n, ok := directives[sqlparser.DirectiveMaxRows].(int)
+	if !ok || n <= 0 {
+		return 0
+	}
+	return int64(n)
+}
+
+// lagSensitivity returns the lag sensitivity requested by the
+// LAG_SENSITIVE directive.
+func lagSensitivity(comments sqlparser.Comments) LagSensitivity {
+	directives := sqlparser.ExtractCommentDirectives(comments)
+	if _, ok := directives[sqlparser.DirectiveLagSensitive]; !ok {
+		return LagSensitivityUnspecified
+	}
+	if directives.IsSet(sqlparser.DirectiveLagSensitive) {
+		return LagSensitive
+	}
+	return LagTolerant
 }
 
 // TableName returns the table name for the plan.
@@ -180,13 +330,20 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		plan, err = &Plan{PlanID: PlanSavepoint}, nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
 	if err != nil {
 		return nil, err
 	}
-	plan.Permissions = BuildPermissions(statement)
+	// The analyzers only add the permissions the statement doesn't show.
+	plan.Permissions = append(BuildPermissions(statement), plan.Permissions...)
+	directives := statementDirectives(statement)
+	plan.Priority = priority(directives)
+	plan.QueryTimeout = queryTimeout(directives)
+	plan.MaxRows = maxRows(directives)
 	return plan, nil
 }
 
@@ -214,6 +371,11 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 			return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "select with lock not allowed for streaming")
 		}
 		plan.Table = lookupTable(stmt.From, tables)
+		plan.LagSensitivity = lagSensitivity(stmt.Comments)
+		directives := sqlparser.ExtractCommentDirectives(stmt.Comments)
+		plan.QueryTimeout = queryTimeout(directives)
+		plan.MaxRows = maxRows(directives)
+		plan.Priority = priority(directives)
 	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union:
 		// pass
 	default:
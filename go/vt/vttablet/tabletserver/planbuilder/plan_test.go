@@ -28,6 +28,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -39,20 +40,38 @@ import (
 // This is only for testing.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	mplan := struct {
-		PlanID      PlanType
-		TableName   sqlparser.TableIdent   `json:",omitempty"`
-		Permissions []Permission           `json:",omitempty"`
-		FieldQuery  *sqlparser.ParsedQuery `json:",omitempty"`
-		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
-		NextCount   string                 `json:",omitempty"`
-		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		PlanID             PlanType
+		TableName          sqlparser.TableIdent     `json:",omitempty"`
+		Permissions        []Permission             `json:",omitempty"`
+		FieldQuery         *sqlparser.ParsedQuery   `json:",omitempty"`
+		FullQuery          *sqlparser.ParsedQuery   `json:",omitempty"`
+		NextCount          string                   `json:",omitempty"`
+		WhereClause        *sqlparser.ParsedQuery   `json:",omitempty"`
+		LagSensitivity     LagSensitivity           `json:",omitempty"`
+		Priority           Priority                 `json:",omitempty"`
+		QueryTimeout       time.Duration            `json:",omitempty"`
+		MaxRows            int64                    `json:",omitempty"`
+		DeliverAfter       time.Duration            `json:",omitempty"`
+		GroupQueries       []*sqlparser.ParsedQuery `json:",omitempty"`
+		GroupIDs           int                      `json:",omitempty"`
+		ForeignKeyCascades []string                 `json:",omitempty"`
 	}{
-		PlanID:      p.PlanID,
-		TableName:   p.TableName(),
-		Permissions: p.Permissions,
-		FieldQuery:  p.FieldQuery,
-		FullQuery:   p.FullQuery,
-		WhereClause: p.WhereClause,
+		PlanID:         p.PlanID,
+		TableName:      p.TableName(),
+		Permissions:    p.Permissions,
+		FieldQuery:     p.FieldQuery,
+		FullQuery:      p.FullQuery,
+		WhereClause:    p.WhereClause,
+		LagSensitivity: p.LagSensitivity,
+		Priority:       p.Priority,
+		QueryTimeout:   p.QueryTimeout,
+		MaxRows:        p.MaxRows,
+		DeliverAfter:   p.DeliverAfter,
+		GroupQueries:   p.GroupQueries,
+		GroupIDs:       p.GroupIDs,
+	}
+	for _, fk := range p.ForeignKeyCascades {
+		mplan.ForeignKeyCascades = append(mplan.ForeignKeyCascades, fk.Name)
 	}
 	if !p.NextCount.IsNull() {
 		b, _ := p.NextCount.MarshalJSON()
@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// priorityWeights are the shares of the freed up slots which each
+// priority gets while queries of several priorities are waiting.
+var priorityWeights = map[planbuilder.Priority]int{
+	planbuilder.PriorityCritical: 8,
+	planbuilder.PriorityNormal:   4,
+	planbuilder.PriorityBatch:    1,
+}
+
+var schedulerPriorities = []planbuilder.Priority{
+	planbuilder.PriorityCritical,
+	planbuilder.PriorityNormal,
+	planbuilder.PriorityBatch,
+}
+
+// PriorityScheduler limits the number of queries which run at the
+// same time to -query_priority_concurrency. Queries beyond that
+// limit are queued per priority. Whenever a query finishes, its slot
+// is handed to a waiting query, picked by a smooth weighted round
+// robin across the priorities which have waiting queries. This way,
+// batch traffic yields to interactive traffic, without starving.
+type PriorityScheduler struct {
+	enabled  bool
+	capacity int
+	callers  map[string]planbuilder.Priority
+
+	waits   *servenv.TimingsWrapper
+	waiting *stats.GaugesWithSingleLabel
+
+	mu      sync.Mutex
+	inUse   int
+	queues  map[planbuilder.Priority][]chan struct{}
+	current map[planbuilder.Priority]int
+}
+
+// NewPriorityScheduler creates a PriorityScheduler based on the
+// -enable_query_priority_scheduling config.
+func NewPriorityScheduler(env tabletenv.Env) *PriorityScheduler {
+	config := env.Config()
+	ps := &PriorityScheduler{
+		enabled:  config.EnableQueryPriorityScheduling,
+		capacity: config.QueryPriorityConcurrency,
+		callers:  make(map[string]planbuilder.Priority),
+		queues:   make(map[planbuilder.Priority][]chan struct{}),
+		current:  make(map[planbuilder.Priority]int),
+	}
+	if !ps.enabled {
+		return ps
+	}
+	callers, err := config.QueryPriorityByCaller()
+	if err != nil {
+		log.Errorf("Ignoring query priority callers: %v", err)
+	}
+	for principal, name := range callers {
+		ps.callers[principal] = planbuilder.PriorityFromName(name)
+	}
+	ps.waits = env.Exporter().NewTimings("QueryPriorityWaits", "Time spent waiting for a query slot", "Priority")
+	ps.waiting = env.Exporter().NewGaugesWithSingleLabel("QueryPriorityWaiting", "Number of queries waiting for a query slot", "Priority")
+	return ps
+}
+
+// Priority returns the priority of a query: the one requested by
+// its directive, or else the one configured for its caller, or else
+// normal.
+func (ps *PriorityScheduler) Priority(ctx context.Context, plan *TabletPlan) planbuilder.Priority {
+	if plan.Priority != planbuilder.PriorityUnspecified {
+		return plan.Priority
+	}
+	if p, ok := ps.callers[callerid.EffectiveCallerIDFromContext(ctx).GetPrincipal()]; ok {
+		return p
+	}
+	return planbuilder.PriorityNormal
+}
+
+// Acquire waits for a query slot. The returned function must be
+// called to release the slot once the query is done.
+func (ps *PriorityScheduler) Acquire(ctx context.Context, priority planbuilder.Priority) (release func(), err error) {
+	if !ps.enabled {
+		return func() {}, nil
+	}
+
+	ps.mu.Lock()
+	if ps.inUse < ps.capacity && ps.numWaitingLocked() == 0 {
+		ps.inUse++
+		ps.mu.Unlock()
+		return ps.release, nil
+	}
+	ready := make(chan struct{}, 1)
+	ps.queues[priority] = append(ps.queues[priority], ready)
+	ps.mu.Unlock()
+
+	name := priority.String()
+	start := time.Now()
+	ps.waiting.Add(name, 1)
+	defer ps.waiting.Add(name, -1)
+	select {
+	case <-ready:
+		ps.waits.Record(name, start)
+		return ps.release, nil
+	case <-ctx.Done():
+	}
+
+	ps.mu.Lock()
+	removed := ps.removeLocked(priority, ready)
+	ps.mu.Unlock()
+	if !removed {
+		// The slot was handed to us concurrently. Pass it on.
+		ps.release()
+	}
+	ps.waits.Record(name, start)
+	return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query scheduler: %v while waiting for a %s query slot", ctx.Err(), name)
+}
+
+// release hands the slot to the next waiting query, if any.
+func (ps *PriorityScheduler) release() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	next := ps.nextLocked()
+	if next == nil {
+		ps.inUse--
+		return
+	}
+	next <- struct{}{}
+}
+
+// nextLocked dequeues the next waiting query using a smooth weighted
+// round robin across the priorities which have waiting queries.
+func (ps *PriorityScheduler) nextLocked() chan struct{} {
+	total := 0
+	best := planbuilder.PriorityUnspecified
+	for _, p := range schedulerPriorities {
+		if len(ps.queues[p]) == 0 {
+			ps.current[p] = 0
+			continue
+		}
+		total += priorityWeights[p]
+		ps.current[p] += priorityWeights[p]
+		if best == planbuilder.PriorityUnspecified || ps.current[p] > ps.current[best] {
+			best = p
+		}
+	}
+	if best == planbuilder.PriorityUnspecified {
+		return nil
+	}
+	ps.current[best] -= total
+	next := ps.queues[best][0]
+	ps.queues[best] = ps.queues[best][1:]
+	return next
+}
+
+func (ps *PriorityScheduler) removeLocked(priority planbuilder.Priority, ready chan struct{}) bool {
+	queue := ps.queues[priority]
+	for i, c := range queue {
+		if c == ready {
+			ps.queues[priority] = append(queue[:i:i], queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (ps *PriorityScheduler) numWaitingLocked() int {
+	n := 0
+	for _, queue := range ps.queues {
+		n += len(queue)
+	}
+	return n
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestPriorityScheduler(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.EnableQueryPriorityScheduling = true
+	config.QueryPriorityConcurrency = 1
+	config.QueryPriorityCallers = []string{"etl:batch"}
+	ps := NewPriorityScheduler(tabletenv.NewTestEnv(&config, nil, "PrioritySchedulerTest"))
+	ctx := context.Background()
+
+	etlCtx := callerid.NewContext(ctx, &vtrpcpb.CallerID{Principal: "etl"}, nil)
+	if got, want := ps.Priority(etlCtx, &TabletPlan{Plan: &planbuilder.Plan{}}), planbuilder.PriorityBatch; got != want {
+		t.Errorf("Priority(etl): %v, want %v", got, want)
+	}
+	if got, want := ps.Priority(etlCtx, &TabletPlan{Plan: &planbuilder.Plan{Priority: planbuilder.PriorityCritical}}), planbuilder.PriorityCritical; got != want {
+		t.Errorf("Priority(etl, directive): %v, want %v", got, want)
+	}
+	if got, want := ps.Priority(ctx, &TabletPlan{Plan: &planbuilder.Plan{}}), planbuilder.PriorityNormal; got != want {
+		t.Errorf("Priority(): %v, want %v", got, want)
+	}
+
+	release, err := ps.Acquire(ctx, planbuilder.PriorityNormal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Batch queries arrive before the critical ones, but get their
+	// slot after them.
+	order := make(chan planbuilder.Priority)
+	waiting := 0
+	for _, p := range []planbuilder.Priority{planbuilder.PriorityBatch, planbuilder.PriorityBatch, planbuilder.PriorityCritical, planbuilder.PriorityCritical} {
+		go func(p planbuilder.Priority) {
+			release, err := ps.Acquire(ctx, p)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- p
+			release()
+		}(p)
+		waiting++
+		waitForWaiting(t, ps, waiting)
+	}
+	release()
+	var got []planbuilder.Priority
+	for i := 0; i < 4; i++ {
+		got = append(got, <-order)
+	}
+	want := []planbuilder.Priority{planbuilder.PriorityCritical, planbuilder.PriorityCritical, planbuilder.PriorityBatch, planbuilder.PriorityBatch}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("order: %v, want %v", got, want)
+	}
+
+	// A query gives up when its context is done.
+	release, err = ps.Acquire(ctx, planbuilder.PriorityNormal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = ps.Acquire(shortCtx, planbuilder.PriorityBatch)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Acquire: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+	release()
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.inUse != 0 || ps.numWaitingLocked() != 0 {
+		t.Errorf("inUse, waiting: %d, %d, want 0, 0", ps.inUse, ps.numWaitingLocked())
+	}
+}
+
+func waitForWaiting(t *testing.T, ps *PriorityScheduler, want int) {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(time.Millisecond) {
+		ps.mu.Lock()
+		got := ps.numWaitingLocked()
+		ps.mu.Unlock()
+		if got == want {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting for %d queued queries", want)
+}
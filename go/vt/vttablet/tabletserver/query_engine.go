@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -136,7 +137,13 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
-	streamQList  *QueryList
+	// tableLimiter prevents a single hot table from consuming
+	// the entire connection pool.
+	tableLimiter *TableLimiter
+	// scheduler lets interactive traffic through before batch traffic
+	// while the connection pool is contended.
+	scheduler   *PriorityScheduler
+	streamQList *QueryList
 
 	// Vars
 	connTimeout        sync2.AtomicDuration
@@ -146,6 +153,7 @@ type QueryEngine struct {
 	warnResultSize     sync2.AtomicInt64
 	maxDMLRows         sync2.AtomicInt64
 	streamBufferSize   sync2.AtomicInt64
+	deadlockRetryCount sync2.AtomicInt64
 	// tableaclExemptCount count the number of accesses allowed
 	// based on membership in the superuser ACL
 	tableaclExemptCount  sync2.AtomicInt64
@@ -159,12 +167,15 @@ type QueryEngine struct {
 	enableConsolidator          bool
 	enableConsolidatorReplicas  bool
 	enableQueryPlanFieldCaching bool
+	rejectForeignKeyCascades    bool
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	foreignKeyCascades                                        *stats.CountersWithSingleLabel
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
+	foreignKeyLogger    *logutil.ThrottledLogger
 }
 
 // NewQueryEngine creates a new QueryEngine.
@@ -188,8 +199,11 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.enableConsolidator = config.EnableConsolidator
 	qe.enableConsolidatorReplicas = config.EnableConsolidatorReplicas
 	qe.enableQueryPlanFieldCaching = config.EnableQueryPlanFieldCaching
+	qe.rejectForeignKeyCascades = config.RejectForeignKeyCascades
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(env)
+	qe.tableLimiter = NewTableLimiter(env)
+	qe.scheduler = NewPriorityScheduler(env)
 	qe.streamQList = NewQueryList()
 
 	qe.strictTableACL = config.StrictTableACL
@@ -214,15 +228,18 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.warnResultSize = sync2.NewAtomicInt64(int64(config.WarnResultSize))
 	qe.maxDMLRows = sync2.NewAtomicInt64(int64(config.MaxDMLRows))
 	qe.streamBufferSize = sync2.NewAtomicInt64(int64(config.StreamBufferSize))
+	qe.deadlockRetryCount = sync2.NewAtomicInt64(int64(config.DeadlockRetryCount))
 
 	planbuilder.PassthroughDMLs = config.PassthroughDMLs
 
 	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
+	qe.foreignKeyLogger = logutil.NewThrottledLogger("foreignKeyCascades", 1*time.Second)
 
 	env.Exporter().NewGaugeFunc("MaxResultSize", "Query engine max result size", qe.maxResultSize.Get)
 	env.Exporter().NewGaugeFunc("WarnResultSize", "Query engine warn result size", qe.warnResultSize.Get)
 	env.Exporter().NewGaugeFunc("MaxDMLRows", "Query engine max DML rows", qe.maxDMLRows.Get)
 	env.Exporter().NewGaugeFunc("StreamBufferSize", "Query engine stream buffer size", qe.streamBufferSize.Get)
+	env.Exporter().NewGaugeFunc("DeadlockRetryCount", "Query engine deadlock retry count", qe.deadlockRetryCount.Get)
 	env.Exporter().NewCounterFunc("TableACLExemptCount", "Query engine table ACL exempt count", qe.tableaclExemptCount.Get)
 	env.Exporter().NewGaugeFunc("QueryPoolWaiters", "Query engine query pool waiters", qe.queryPoolWaiters.Get)
 
@@ -237,6 +254,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.foreignKeyCascades = env.Exporter().NewCountersWithSingleLabel("ForeignKeyCascades", "Number of plans built for DMLs which cascade to other tables through foreign keys", "Table")
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
@@ -309,6 +327,9 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	if err != nil {
 		return nil, err
 	}
+	if err := qe.checkForeignKeyCascades(splan); err != nil {
+		return nil, err
+	}
 	plan := &TabletPlan{Plan: splan}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
@@ -418,6 +439,27 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	}
 }
 
+// checkForeignKeyCascades logs the DMLs which cascade to other tables
+// through foreign keys, or rejects them if -reject_foreign_key_cascades
+// is set.
+func (qe *QueryEngine) checkForeignKeyCascades(plan *planbuilder.Plan) error {
+	if len(plan.ForeignKeyCascades) == 0 {
+		return nil
+	}
+	tableName := plan.TableName().String()
+	qe.foreignKeyCascades.Add(tableName, 1)
+	var fks []string
+	for _, fk := range plan.ForeignKeyCascades {
+		fks = append(fks, fmt.Sprintf("%s (%s)", fk.Name, fk.Table))
+	}
+	msg := fmt.Sprintf("changes to %s cascade through foreign keys %s: the cascaded changes don't reach other shards, and they're not in the binlog", tableName, strings.Join(fks, ", "))
+	if qe.rejectForeignKeyCascades {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, msg)
+	}
+	qe.foreignKeyLogger.Warningf("%s", msg)
+	return nil
+}
+
 // getQuery fetches the plan and makes it the most recent.
 func (qe *QueryEngine) getQuery(sql string) *TabletPlan {
 	if cacheResult, ok := qe.plans.Get(sql); ok {
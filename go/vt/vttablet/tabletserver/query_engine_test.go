@@ -28,6 +28,7 @@ import (
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/streamlog"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
@@ -135,6 +136,49 @@ func TestGetMessageStreamPlan(t *testing.T) {
 	}
 }
 
+func TestGetPlanForeignKeyCascades(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("test_table_02", "fk_01", "pk", "test_table_01", "pk", "RESTRICT", "CASCADE"),
+		},
+	})
+	qe := newTestQueryEngine(10, 10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	plan, err := qe.GetPlan(ctx, logStats, "delete from test_table_01 where pk = 1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.ForeignKeyCascades) != 1 || plan.ForeignKeyCascades[0].Name != "fk_01" {
+		t.Errorf("ForeignKeyCascades: %v, want fk_01", plan.ForeignKeyCascades)
+	}
+	if got := qe.foreignKeyCascades.Counts()["test_table_01"]; got != 1 {
+		t.Errorf("ForeignKeyCascades count: %d, want 1", got)
+	}
+
+	qe.rejectForeignKeyCascades = true
+	_, err = qe.GetPlan(ctx, logStats, "delete from test_table_01 where pk = 2", false)
+	want := "changes to test_table_01 cascade through foreign keys fk_01 (test_table_02): the cascaded changes don't reach other shards, and they're not in the binlog"
+	if err == nil || err.Error() != want {
+		t.Errorf("GetPlan: %v, want %s", err, want)
+	}
+
+	// Updates which don't change the referenced columns don't cascade.
+	if _, err := qe.GetPlan(ctx, logStats, "update test_table_01 set name = 'a' where pk = 1", false); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestQueryPlanCache(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -36,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -119,6 +120,20 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		return qre.txConnExec(conn)
 	}
 
+	// Queries inside a transaction already own their connection,
+	// so only the remaining ones are subject to the table limits.
+	release, err := qre.tsv.qe.tableLimiter.Acquire(qre.ctx, qre.plan.TableName().String())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	scheduler := qre.tsv.qe.scheduler
+	releaseSlot, err := scheduler.Acquire(qre.ctx, scheduler.Priority(qre.ctx, qre.plan))
+	if err != nil {
+		return nil, err
+	}
+	defer releaseSlot()
+
 	switch qre.plan.PlanID {
 	case planbuilder.PlanSelect, planbuilder.PlanSelectImpossible:
 		maxrows := qre.getSelectLimit()
@@ -131,11 +146,17 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		return qr, nil
-	case planbuilder.PlanSelectLock:
+	case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 	case planbuilder.PlanSet, planbuilder.PlanOtherRead, planbuilder.PlanOtherAdmin:
 		return qre.execOther()
-	case planbuilder.PlanInsert, planbuilder.PlanUpdate, planbuilder.PlanDelete, planbuilder.PlanInsertMessage, planbuilder.PlanDDL:
+	case planbuilder.PlanInsert, planbuilder.PlanInsertMessage, planbuilder.PlanUpdate, planbuilder.PlanDelete:
+		if len(qre.plan.GroupQueries) != 0 {
+			// The messages must be inserted for all consumer groups or none.
+			return qre.execAsTransaction(qre.txConnExec)
+		}
+		return qre.execAutocommitWithRetry(qre.txConnExec)
+	case planbuilder.PlanDDL:
 		return qre.execAutocommit(qre.txConnExec)
 	case planbuilder.PlanUpdateLimit, planbuilder.PlanDeleteLimit:
 		return qre.execAsTransaction(qre.txConnExec)
@@ -157,6 +178,26 @@ func (qre *QueryExecutor) execAutocommit(f func(conn *TxConnection) (*sqltypes.R
 	return f(conn)
 }
 
+// execAutocommitWithRetry is like execAutocommit, but retries the
+// statement if MySQL chose it as a deadlock victim. InnoDB rolls back
+// a deadlocked autocommit statement in its entirety, which makes it
+// safe to re-execute.
+func (qre *QueryExecutor) execAutocommitWithRetry(f func(conn *TxConnection) (*sqltypes.Result, error)) (reply *sqltypes.Result, err error) {
+	retries := qre.tsv.qe.deadlockRetryCount.Get()
+	for attempt := int64(0); ; attempt++ {
+		reply, err = qre.execAutocommit(f)
+		if err == nil || attempt >= retries || !isDeadlock(err) {
+			return reply, err
+		}
+		select {
+		case <-qre.ctx.Done():
+			return reply, err
+		default:
+		}
+		qre.tsv.stats.DeadlockRetries.Add(qre.plan.TableName().String(), 1)
+	}
+}
+
 func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltypes.Result, error)) (reply *sqltypes.Result, err error) {
 	conn, beginSQL, err := qre.tsv.te.txPool.LocalBegin(qre.ctx, qre.options)
 	if err != nil {
@@ -180,23 +221,34 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltype
 	}
 
 	defer qre.logStats.AddRewrittenSQL("commit", time.Now())
-	if _, err := qre.tsv.te.txPool.LocalCommit(qre.ctx, conn); err != nil {
+	_, gtids, err := qre.tsv.te.txPool.LocalCommit(qre.ctx, conn)
+	if err != nil {
 		return nil, err
 	}
+	if gtids != "" {
+		reply.SessionStateChanges = gtids
+	}
 	return reply, nil
 }
 
 func (qre *QueryExecutor) txConnExec(conn *TxConnection) (*sqltypes.Result, error) {
 	switch qre.plan.PlanID {
-	case planbuilder.PlanInsert, planbuilder.PlanUpdate, planbuilder.PlanDelete:
+	case planbuilder.PlanInsert:
+		if qre.plan.Table != nil && qre.plan.Table.Type == schema.Message {
+			return qre.txInsertMessages(conn)
+		}
 		return qre.txFetch(conn, true)
-	case planbuilder.PlanInsertMessage:
-		qre.bindVars["#time_now"] = sqltypes.Int64BindVariable(time.Now().UnixNano())
+	case planbuilder.PlanUpdate, planbuilder.PlanDelete:
 		return qre.txFetch(conn, true)
+	case planbuilder.PlanInsertMessage:
+		qre.bindVars["#time_next"] = sqltypes.Int64BindVariable(time.Now().Add(qre.plan.DeliverAfter).UnixNano())
+		return qre.txInsertMessages(conn)
 	case planbuilder.PlanUpdateLimit, planbuilder.PlanDeleteLimit:
 		return qre.execDMLLimit(conn)
 	case planbuilder.PlanSet, planbuilder.PlanOtherRead, planbuilder.PlanOtherAdmin:
 		return qre.execSQL(conn, qre.query, true)
+	case planbuilder.PlanSavepoint:
+		return qre.execSQL(conn, qre.query, false)
 	case planbuilder.PlanSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectImpossible:
 		maxrows := qre.getSelectLimit()
 		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
@@ -214,6 +266,39 @@ func (qre *QueryExecutor) txConnExec(conn *TxConnection) (*sqltypes.Result, erro
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s unexpected plan type", qre.plan.PlanID.String())
 }
 
+// txInsertMessages inserts messages into a message table, and into
+// the tables of its consumer groups. The messages are counted as
+// queued once the transaction commits.
+func (qre *QueryExecutor) txInsertMessages(conn *TxConnection) (*sqltypes.Result, error) {
+	qr, err := qre.txFetch(conn, true)
+	if err != nil {
+		return nil, err
+	}
+	if qre.plan.GroupIDs != 0 && len(qre.plan.GroupQueries) != 0 {
+		// The rows of a multi-row insert get consecutive ids.
+		if qr.InsertID == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "insert into %s did not generate the message ids for its consumer groups", qre.plan.TableName())
+		}
+		for i := 0; i < qre.plan.GroupIDs; i++ {
+			qre.bindVars[fmt.Sprintf("#message_id%d", i)] = sqltypes.Uint64BindVariable(qr.InsertID + uint64(i))
+		}
+	}
+	for i, query := range qre.plan.GroupQueries {
+		sql, _, err := qre.generateFinalSQL(query, qre.bindVars)
+		if err != nil {
+			return nil, err
+		}
+		groupQR, err := qre.execSQL(conn, sql, true)
+		if err != nil {
+			return nil, err
+		}
+		conn.RecordQuery(sql)
+		conn.queueMessages(qre.plan.Table.MessageInfo.ConsumerGroups[i], int64(groupQR.RowsAffected))
+	}
+	conn.queueMessages(qre.plan.TableName().String(), int64(qr.RowsAffected))
+	return qr, nil
+}
+
 // Stream performs a streaming query execution.
 func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.logStats.OriginalSQL = qre.query
@@ -227,6 +312,12 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	scheduler := qre.tsv.qe.scheduler
+	releaseSlot, err := scheduler.Acquire(qre.ctx, scheduler.Priority(qre.ctx, qre.plan))
+	if err != nil {
+		return err
+	}
+	defer releaseSlot()
 
 	// if we have a transaction id, let's use the txPool for this query
 	var conn *connpool.DBConn
@@ -238,6 +329,13 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 		defer txConn.Recycle()
 		conn = txConn.dbConn
 	} else {
+		// As in Execute, only the queries outside a transaction
+		// are subject to the table limits.
+		release, err := qre.tsv.qe.tableLimiter.Acquire(qre.ctx, qre.plan.TableName().String())
+		if err != nil {
+			return err
+		}
+		defer release()
 		dbConn, err := qre.getStreamConn()
 		if err != nil {
 			return err
@@ -250,11 +348,24 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.tsv.qe.streamQList.Add(qd)
 	defer qre.tsv.qe.streamQList.Remove(qd)
 
+	// The streaming queries are only limited by the MAX_ROWS directive.
+	if maxRows := qre.plan.MaxRows; maxRows != 0 {
+		rows := int64(0)
+		next := callback
+		callback = func(qr *sqltypes.Result) error {
+			rows += int64(len(qr.Rows))
+			if rows > maxRows {
+				return mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "Row count exceeded %d", maxRows)
+			}
+			return next(qr)
+		}
+	}
 	return qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, callback)
 }
 
-// MessageStream streams messages from a message table.
-func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) error {
+// MessageStream streams messages from a message table. The messages
+// listed by resumeToken are resent first if they are not acked yet.
+func (qre *QueryExecutor) MessageStream(resumeToken string, callback func(*sqltypes.Result) error) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
@@ -267,7 +378,7 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 		return err
 	}
 
-	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
+	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), resumeToken, func(r *sqltypes.Result) error {
 		select {
 		case <-qre.ctx.Done():
 			return io.EOF
@@ -473,7 +584,7 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 }
 
 func (qre *QueryExecutor) execDMLLimit(conn *TxConnection) (*sqltypes.Result, error) {
-	maxrows := qre.tsv.qe.maxResultSize.Get()
+	maxrows := qre.maxResultSize()
 	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 	result, err := qre.txFetch(conn, true)
 	if err != nil {
@@ -633,8 +744,18 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	return fullSQL, withoutComments, nil
 }
 
-func (qre *QueryExecutor) getSelectLimit() int64 {
+// maxResultSize returns the maximum number of rows the query may return
+// or affect. The MAX_ROWS directive can only lower the limit of the tablet.
+func (qre *QueryExecutor) maxResultSize() int64 {
 	maxRows := qre.tsv.qe.maxResultSize.Get()
+	if qre.plan.MaxRows != 0 && qre.plan.MaxRows < maxRows {
+		return qre.plan.MaxRows
+	}
+	return maxRows
+}
+
+func (qre *QueryExecutor) getSelectLimit() int64 {
+	maxRows := qre.maxResultSize()
 	sqlLimit := qre.options.GetSqlSelectLimit()
 	if sqlLimit > 0 && sqlLimit < maxRows {
 		return sqlLimit
@@ -652,7 +773,11 @@ func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*
 	defer span.Finish()
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	result, err := conn.Exec(ctx, sql, int(qre.maxResultSize()), wantfields)
+	if isDeadlock(err) {
+		qre.tsv.stats.DeadlockCounts.Add(qre.plan.TableName().String(), 1)
+	}
+	return result, err
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
@@ -667,12 +792,21 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 	err := conn.Stream(ctx, sql, callBackClosingSpan, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
 	qre.logStats.AddRewrittenSQL(sql, start)
 	if err != nil {
+		if isDeadlock(err) {
+			qre.tsv.stats.DeadlockCounts.Add(qre.plan.TableName().String(), 1)
+		}
 		// MySQL error that isn't due to a connection issue
 		return err
 	}
 	return nil
 }
 
+// isDeadlock returns true if err is a MySQL deadlock error.
+func isDeadlock(err error) bool {
+	sqlErr, ok := err.(*mysql.SQLError)
+	return ok && sqlErr.Number() == mysql.ERLockDeadlock
+}
+
 // resolveNumber extracts a number from a bind variable or sql value.
 func resolveNumber(pv sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) (int64, error) {
 	v, err := pv.ResolveValue(bindVars)
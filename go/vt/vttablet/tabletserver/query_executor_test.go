@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -428,6 +430,61 @@ func TestQueryExecutorLimitFailure(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorDeadlockRetry(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "insert into test_table(a) values (1)"
+	db.AddRejectedQuery(query, mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSUnknownSQLState, "Deadlock found when trying to get lock"))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// No retries by default.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_ABORTED, convertErrorCode(err))
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+	assert.Equal(t, int64(1), tsv.stats.DeadlockCounts.Counts()["test_table"])
+
+	tsv.SetDeadlockRetryCount(2)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_ABORTED, convertErrorCode(err))
+	assert.Equal(t, 4, db.GetQueryCalledNum(query))
+	assert.Equal(t, int64(4), tsv.stats.DeadlockCounts.Counts()["test_table"])
+	assert.Equal(t, int64(2), tsv.stats.DeadlockRetries.Counts()["test_table"])
+
+	// Statements within a transaction are never retried.
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_ABORTED, convertErrorCode(err))
+	assert.Equal(t, 5, db.GetQueryCalledNum(query))
+	assert.Equal(t, int64(2), tsv.stats.DeadlockRetries.Counts()["test_table"])
+	_ = tsv.Rollback(ctx, &tsv.target, txid)
+}
+
+func TestQueryExecutorStreamDeadlock(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	db.AddRejectedQuery(query, mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSUnknownSQLState, "Deadlock found when trying to get lock"))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	deadlocks := tsv.stats.DeadlockCounts.Counts()["test_table"]
+	txid := newTransaction(tsv, nil)
+	qre := newTestQueryExecutor(ctx, tsv, query, txid)
+	var err error
+	qre.plan, err = tsv.qe.GetStreamPlan(qre.query)
+	require.NoError(t, err)
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_ABORTED, convertErrorCode(err))
+	assert.Equal(t, deadlocks+1, tsv.stats.DeadlockCounts.Counts()["test_table"])
+	_ = tsv.Rollback(ctx, &tsv.target, txid)
+}
+
 func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -451,6 +508,182 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorMaxRowsDirective(t *testing.T) {
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	selectResult := sqltypes.MakeTestResult(fields, "1|aaa", "2|bbb", "3|ccc")
+
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select /*vt+ MAX_ROWS=3 */ * from t limit 3", selectResult)
+	db.AddQuery("select /*vt+ MAX_ROWS=1 */ * from t limit 2", sqltypes.MakeTestResult(fields, "1|aaa", "2|bbb"))
+	db.AddQuery("select /*vt+ MAX_ROWS=2 */ * from t", selectResult)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, smallResultSize, db)
+	defer tsv.StopService()
+
+	// The directive can't raise the limit above the max result size of the tablet.
+	qre := newTestQueryExecutor(ctx, tsv, "select /*vt+ MAX_ROWS=3 */ * from t", 0)
+	_, err := qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "count exceeded")
+
+	// But it can lower it.
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ MAX_ROWS=1 */ * from t", 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "count exceeded")
+
+	// And it limits the streaming queries too.
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ MAX_ROWS=2 */ * from t", 0)
+	qre.plan, err = tsv.qe.GetStreamPlan(qre.query)
+	require.NoError(t, err)
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "count exceeded")
+}
+
+func TestQueryExecutorTableConcurrencyLimit(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 10001"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.tableLimiter.slots["test_table"] = make(chan struct{}, 1)
+
+	release, err := tsv.qe.tableLimiter.Acquire(ctx, "test_table")
+	require.NoError(t, err)
+
+	// The only slot is taken: the query must wait until its deadline.
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	qre := newTestQueryExecutor(shortCtx, tsv, "select * from test_table", 0)
+	_, err = qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Execute: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+	assert.Equal(t, int64(1), tsv.qe.tableLimiter.waits.Counts()["TabletServerTest.test_table"])
+
+	// Streaming queries are limited too.
+	qre = newTestQueryExecutor(shortCtx, tsv, "select * from test_table", 0)
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Stream: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+
+	release()
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestQueryExecutorStreamPriority(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	config := tabletenv.DefaultQsConfig
+	config.EnableQueryPriorityScheduling = true
+	config.QueryPriorityConcurrency = 1
+	scheduler := NewPriorityScheduler(tabletenv.NewTestEnv(&config, nil, "QueryExecutorStreamPriorityTest"))
+	tsv.qe.scheduler = scheduler
+
+	release, err := scheduler.Acquire(ctx, planbuilder.PriorityNormal)
+	require.NoError(t, err)
+	defer release()
+
+	// The only slot is taken: the streaming query must wait until
+	// its deadline.
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	qre := newTestQueryExecutor(shortCtx, tsv, "select /*vt+ PRIORITY=batch */ * from test_table", 0)
+	qre.plan, err = tsv.qe.GetStreamPlan(qre.query)
+	require.NoError(t, err)
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Stream: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+	assert.Contains(t, err.Error(), "batch query slot")
+}
+
+func TestQueryExecutorInsertMessage(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "insert into msg(id, message) values (1, 'a'), (2, 'b')"
+	db.AddQuery(query, &sqltypes.Result{RowsAffected: 2})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	queued := messager.MessageStats.Counts()["msg.Queued"]
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "Insert", qre.logStats.PlanType)
+	assert.Equal(t, queued+2, messager.MessageStats.Counts()["msg.Queued"])
+
+	// Only the delayed inserts get their own plan, which sets time_next.
+	db.AddQueryPattern(`insert /\*vt\+ DELIVER_AFTER=60 \*/ into msg\(id, message, time_next\) values \(3, 'c', \d+\)`, &sqltypes.Result{RowsAffected: 1})
+	qre = newTestQueryExecutor(ctx, tsv, "insert /*vt+ DELIVER_AFTER=60 */ into msg(id, message) values (3, 'c')", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "InsertMessage", qre.logStats.PlanType)
+	assert.Equal(t, queued+3, messager.MessageStats.Counts()["msg.Queued"])
+
+	// In a transaction, the messages are queued on commit.
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, queued+3, messager.MessageStats.Counts()["msg.Queued"])
+	_, err = tsv.Commit(ctx, &tsv.target, txid)
+	require.NoError(t, err)
+	assert.Equal(t, queued+5, messager.MessageStats.Counts()["msg.Queued"])
+}
+
+func TestQueryExecutorInsertMessageGroups(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=msg_audit"),
+			mysql.BaseShowTablesRow("msg_audit", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30"),
+		},
+	})
+	db.AddQuery(mysql.BaseShowPrimary, &sqltypes.Result{
+		Fields: mysql.ShowPrimaryFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowPrimaryRow("msg", "id"),
+			mysql.ShowPrimaryRow("msg_audit", "id"),
+		},
+	})
+	db.AddQuery("select * from msg_audit where 1 != 1", getQueryExecutorSupportedQueries(false)["select * from msg where 1 != 1"])
+	query := "insert into msg(message) values ('a'), ('b')"
+	db.AddQuery(query, &sqltypes.Result{RowsAffected: 2, InsertID: 5})
+	// The group gets the ids generated for the table.
+	db.AddQuery("insert into msg_audit(message, id) values ('a', 5), ('b', 6)", &sqltypes.Result{RowsAffected: 2})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	queued := messager.MessageStats.Counts()["msg.Queued"]
+	groupQueued := messager.MessageStats.Counts()["msg_audit.Queued"]
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, queued+2, messager.MessageStats.Counts()["msg.Queued"])
+	assert.Equal(t, groupQueued+2, messager.MessageStats.Counts()["msg_audit.Queued"])
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -620,7 +853,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 
 	// Should not fail because u1 has permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 	if err != nil {
@@ -632,7 +865,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 	qre.ctx = callerid.NewContext(context.Background(), nil, callerID)
 	// Should fail because u2 does not have permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 
@@ -1200,6 +1433,15 @@ func getQueryExecutorSupportedQueries(testTableHasMultipleUniqueKeys bool) map[s
 			},
 			RowsAffected: 3,
 		},
+		mysql.BaseShowForeignKeys: {
+			Fields: mysql.ShowForeignKeysFields,
+		},
+		mysql.BaseShowGeneratedColumns: {
+			Fields: mysql.ShowGeneratedColumnsFields,
+		},
+		mysql.BaseShowPartitions: {
+			Fields: mysql.ShowPartitionsFields,
+		},
 		"select * from test_table where 1 != 1": {
 			Fields: []*querypb.Field{{
 				Name: "pk",
@@ -34,8 +34,8 @@ type VStreamer interface {
 }
 
 // ReplicationWatcher is a tabletserver service that watches the
-// replication stream.  It will trigger schema reloads if a DDL
-// is encountered.
+// replication stream.  It will trigger a reload of the schema of
+// the changed tables if a DDL is encountered.
 type ReplicationWatcher struct {
 	watchReplication bool
 	vs               VStreamer
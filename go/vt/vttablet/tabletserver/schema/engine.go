@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
@@ -57,6 +58,8 @@ type Engine struct {
 	lastChange int64
 	reloadTime time.Duration
 	notifiers  map[string]notifier
+	// closed is closed when the engine closes.
+	closed chan struct{}
 
 	// The following fields have their own synchronization
 	// and do not require locking mu.
@@ -75,7 +78,9 @@ func NewEngine(env tabletenv.Env) *Engine {
 		conns:      connpool.New(env, "", 1, 0, idleTimeout),
 		ticks:      timer.NewTimer(reloadTime),
 		reloadTime: reloadTime,
+		closed:     make(chan struct{}),
 	}
+	close(se.closed)
 	_ = env.Exporter().NewGaugeDurationFunc("SchemaReloadTime", "vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.", se.ticks.Interval)
 
 	env.Exporter().HandleFunc("/debug/schema", se.handleDebugSchema)
@@ -123,6 +128,7 @@ func (se *Engine) Open() error {
 			log.Errorf("periodic schema reload failed: %v", err)
 		}
 	})
+	se.closed = make(chan struct{})
 	se.isOpen = true
 	return nil
 }
@@ -147,9 +153,18 @@ func (se *Engine) Close() {
 	se.tables = make(map[string]*Table)
 	se.lastChange = 0
 	se.notifiers = make(map[string]notifier)
+	close(se.closed)
 	se.isOpen = false
 }
 
+// Closed returns a channel which is closed when the engine closes.
+// If the engine is not open, the channel is already closed.
+func (se *Engine) Closed() <-chan struct{} {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	return se.closed
+}
+
 // MakeNonMaster clears the sequence caches to make sure that
 // they don't get accidentally reused after losing mastership.
 func (se *Engine) MakeNonMaster() {
@@ -243,16 +258,103 @@ func (se *Engine) reload(ctx context.Context) error {
 		delete(se.tables, tableName)
 	}
 
+	if err := se.updateTables(ctx, conn, changedTables, created, altered, dropped); err != nil {
+		return err
+	}
+	se.lastChange = curTime
+	return nil
+}
+
+// ReloadTables reloads the schema info of the specified tables only,
+// without waiting for the next reload. It's used when the tables are
+// known to have changed, like after a DDL. The tables which don't
+// exist anymore are dropped.
+func (se *Engine) ReloadTables(ctx context.Context, tableNames []string) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	if !se.isOpen {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		log.Infof("Time taken to load the schema of %v: %v", tableNames, time.Since(start))
+	}()
+
+	conn, err := se.conns.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	changedTables := make(map[string]*Table)
+	var created, altered, dropped []string
+	for _, tableName := range tableNames {
+		if _, ok := changedTables[tableName]; ok || tableName == "dual" {
+			continue
+		}
+		tableData, err := conn.Exec(ctx, mysql.BaseShowTablesForTable(tableName), 1, false)
+		if err != nil {
+			return err
+		}
+		if len(tableData.Rows) == 0 {
+			if _, ok := se.tables[tableName]; ok {
+				dropped = append(dropped, tableName)
+				delete(se.tables, tableName)
+			}
+			continue
+		}
+		log.Infof("Reading schema for table: %s", tableName)
+
+		row := tableData.Rows[0]
+		table, err := LoadTable(conn, tableName, row[1].ToString(), row[3].ToString())
+		if err != nil {
+			return err
+		}
+		changedTables[tableName] = table
+		if _, ok := se.tables[tableName]; ok {
+			altered = append(altered, tableName)
+		} else {
+			created = append(created, tableName)
+		}
+	}
+	return se.updateTables(ctx, conn, changedTables, created, altered, dropped)
+}
+
+// updateTables populates the keys of the changed tables, stores them in
+// se.tables and broadcasts the changes. The dropped tables must already
+// be removed from se.tables.
+func (se *Engine) updateTables(ctx context.Context, conn *connpool.DBConn, changedTables map[string]*Table, created, altered, dropped []string) error {
 	// Populate PKColumns for changed tables.
 	if err := se.populatePrimaryKeys(ctx, conn, changedTables); err != nil {
 		return err
 	}
 
-	// Update se.tables and se.lastChange
+	// Populate GeneratedColumns for changed tables.
+	if err := se.populateGeneratedColumns(ctx, conn, changedTables); err != nil {
+		return err
+	}
+
+	// Populate Partitioning for changed tables.
+	if err := se.populatePartitions(ctx, conn, changedTables); err != nil {
+		return err
+	}
+
+	// Populate the foreign keys. They're reloaded for all the tables,
+	// because changing a table can change the foreign keys which
+	// reference other tables.
+	if len(changedTables) != 0 || len(dropped) != 0 {
+		fkAltered, err := se.populateForeignKeys(ctx, conn, changedTables)
+		if err != nil {
+			return err
+		}
+		altered = append(altered, fkAltered...)
+	}
+
+	// Update se.tables.
 	for k, t := range changedTables {
 		se.tables[k] = t
 	}
-	se.lastChange = curTime
 
 	se.broadcast(created, altered, dropped)
 	return nil
@@ -295,6 +397,113 @@ func (se *Engine) populatePrimaryKeys(ctx context.Context, conn *connpool.DBConn
 	return nil
 }
 
+// populateGeneratedColumns populates the GeneratedColumns for the
+// specified tables. MySQL versions without generated columns have
+// none to populate.
+func (se *Engine) populateGeneratedColumns(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) error {
+	genData, err := conn.Exec(ctx, mysql.BaseShowGeneratedColumns, maxTableCount, false)
+	if err != nil {
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERBadFieldError {
+			return nil
+		}
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table generated column info: %v", err)
+	}
+	for _, row := range genData.Rows {
+		tableName := row[0].ToString()
+		table, ok := tables[tableName]
+		if !ok {
+			continue
+		}
+		colName := sqlparser.NewColIdent(row[1].ToString())
+		if table.FindColumn(colName) < 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "column %v is listed as generated, but not present in table %v", colName.String(), tableName)
+		}
+		table.GeneratedColumns = append(table.GeneratedColumns, &GeneratedColumn{
+			Name:       colName,
+			Expression: row[2].ToString(),
+			Stored:     row[3].ToString() == "STORED GENERATED",
+		})
+	}
+	return nil
+}
+
+// populatePartitions populates the Partitioning for the specified
+// tables which are partitioned.
+func (se *Engine) populatePartitions(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) error {
+	partData, err := conn.Exec(ctx, mysql.BaseShowPartitions, maxTableCount, false)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table partition info: %v", err)
+	}
+	for _, row := range partData.Rows {
+		table, ok := tables[row[0].ToString()]
+		if !ok {
+			continue
+		}
+		if table.Partitioning == nil {
+			table.Partitioning = &Partitioning{
+				Method:     row[2].ToString(),
+				Expression: row[3].ToString(),
+			}
+		}
+		rows, _ := sqltypes.ToUint64(row[5])
+		table.Partitioning.Partitions = append(table.Partitioning.Partitions, &Partition{
+			Name:        row[1].ToString(),
+			Description: row[4].ToString(),
+			Rows:        rows,
+		})
+	}
+	return nil
+}
+
+// populateForeignKeys populates ForeignKeys and ReferencedBy for the
+// specified tables. The unchanged tables whose foreign keys changed are
+// copied into tables, and their names are returned.
+func (se *Engine) populateForeignKeys(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) ([]string, error) {
+	fkData, err := conn.Exec(ctx, mysql.BaseShowForeignKeys, maxTableCount, false)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table foreign key info: %v", err)
+	}
+	foreignKeys := make(map[string][]*ForeignKey)
+	referencedBy := make(map[string][]*ForeignKey)
+	var fk *ForeignKey
+	for _, row := range fkData.Rows {
+		tableName, name := row[0].ToString(), row[1].ToString()
+		// The rows of a foreign key are consecutive.
+		if fk == nil || fk.Table != tableName || fk.Name != name {
+			fk = &ForeignKey{
+				Name:            name,
+				Table:           tableName,
+				ReferencedTable: row[3].ToString(),
+				OnUpdate:        row[5].ToString(),
+				OnDelete:        row[6].ToString(),
+			}
+			foreignKeys[fk.Table] = append(foreignKeys[fk.Table], fk)
+			referencedBy[fk.ReferencedTable] = append(referencedBy[fk.ReferencedTable], fk)
+		}
+		fk.Columns = append(fk.Columns, row[2].ToString())
+		fk.ReferencedColumns = append(fk.ReferencedColumns, row[4].ToString())
+	}
+
+	var altered []string
+	for name, table := range se.tables {
+		if _, ok := tables[name]; ok {
+			continue
+		}
+		if reflect.DeepEqual(table.ForeignKeys, foreignKeys[name]) && reflect.DeepEqual(table.ReferencedBy, referencedBy[name]) {
+			continue
+		}
+		// The tables can be in use. So, they're not modified in place.
+		copied := *table
+		tables[name] = &copied
+		altered = append(altered, name)
+	}
+	for name, table := range tables {
+		table.ForeignKeys = foreignKeys[name]
+		table.ReferencedBy = referencedBy[name]
+	}
+	return altered, nil
+}
+
 // RegisterNotifier registers the function for schema change notification.
 // It also causes an immediate notification to the caller. The notified
 // function must not change the map or its contents. The only exception
@@ -162,6 +162,244 @@ func TestOpenAndReload(t *testing.T) {
 	assert.Equal(t, want, se.GetSchema())
 }
 
+func TestForeignKeys(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("test_table_02", "fk_01", "pk", "test_table_01", "pk", "RESTRICT", "CASCADE"),
+			mysql.ShowForeignKeysRow("test_table_03", "fk_02", "pk", "test_table_02", "pk", "NO ACTION", "NO ACTION"),
+		},
+	})
+	// pre-advance to above the default 1427325875.
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325876"))
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	fk01 := &ForeignKey{
+		Name:              "fk_01",
+		Table:             "test_table_02",
+		Columns:           []string{"pk"},
+		ReferencedTable:   "test_table_01",
+		ReferencedColumns: []string{"pk"},
+		OnUpdate:          "RESTRICT",
+		OnDelete:          "CASCADE",
+	}
+	fk02 := &ForeignKey{
+		Name:              "fk_02",
+		Table:             "test_table_03",
+		Columns:           []string{"pk"},
+		ReferencedTable:   "test_table_02",
+		ReferencedColumns: []string{"pk"},
+		OnUpdate:          "NO ACTION",
+		OnDelete:          "NO ACTION",
+	}
+	tables := se.GetSchema()
+	assert.Equal(t, []*ForeignKey(nil), tables["test_table_01"].ForeignKeys)
+	assert.Equal(t, []*ForeignKey{fk01}, tables["test_table_01"].ReferencedBy)
+	assert.Equal(t, []*ForeignKey{fk01}, tables["test_table_02"].ForeignKeys)
+	assert.Equal(t, []*ForeignKey{fk02}, tables["test_table_02"].ReferencedBy)
+	assert.Equal(t, []*ForeignKey{fk02}, tables["test_table_03"].ForeignKeys)
+	assert.True(t, fk01.CascadesOnDelete())
+	assert.False(t, fk01.CascadesOnUpdate())
+	assert.False(t, fk02.CascadesOnDelete())
+
+	// Alter test_table_03 to drop its foreign key. The table it
+	// referenced is altered too.
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325877"))
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+			mysql.BaseShowTablesRow("test_table_02", false, ""),
+			{
+				sqltypes.MakeTrusted(sqltypes.VarChar, []byte("test_table_03")),
+				sqltypes.MakeTrusted(sqltypes.VarChar, []byte("BASE TABLE")),
+				sqltypes.MakeTrusted(sqltypes.Int64, []byte("1427325877")),
+				sqltypes.MakeTrusted(sqltypes.VarChar, []byte("")),
+			},
+			mysql.BaseShowTablesRow("seq", false, "vitess_sequence"),
+			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30"),
+		},
+	})
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("test_table_02", "fk_01", "pk", "test_table_01", "pk", "RESTRICT", "CASCADE"),
+		},
+	})
+	var gotAltered []string
+	se.RegisterNotifier("test", func(full map[string]*Table, created, altered, dropped []string) {
+		gotAltered = altered
+	})
+	require.NoError(t, se.Reload(context.Background()))
+	sort.Strings(gotAltered)
+	assert.Equal(t, []string{"test_table_02", "test_table_03"}, gotAltered)
+
+	newTables := se.GetSchema()
+	assert.Equal(t, []*ForeignKey(nil), newTables["test_table_02"].ReferencedBy)
+	assert.Equal(t, []*ForeignKey(nil), newTables["test_table_03"].ForeignKeys)
+	// The previous version of the table is unchanged.
+	assert.Equal(t, []*ForeignKey{fk02}, tables["test_table_02"].ReferencedBy)
+}
+
+func TestReloadTables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	// pre-advance to above the default 1427325875.
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325876"))
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	// Add a column to test_table_01, create test_table_04 and
+	// drop test_table_03.
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_01"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows:   [][]sqltypes.Value{mysql.BaseShowTablesRow("test_table_01", false, "")},
+	})
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarChar,
+		}},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_04"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows:   [][]sqltypes.Value{mysql.BaseShowTablesRow("test_table_04", false, "")},
+	})
+	db.AddQuery("select * from test_table_04 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_03"), &sqltypes.Result{Fields: mysql.BaseShowTablesFields})
+
+	var gotCreated, gotAltered, gotDropped []string
+	se.RegisterNotifier("test", func(full map[string]*Table, created, altered, dropped []string) {
+		gotCreated, gotAltered, gotDropped = created, altered, dropped
+	})
+	showTables := db.GetQueryCalledNum(mysql.BaseShowTables)
+	require.NoError(t, se.ReloadTables(context.Background(), []string{"test_table_01", "test_table_04", "test_table_03", "test_table_01"}))
+	assert.Equal(t, []string{"test_table_04"}, gotCreated)
+	assert.Equal(t, []string{"test_table_01"}, gotAltered)
+	assert.Equal(t, []string{"test_table_03"}, gotDropped)
+	// Only the specified tables were read.
+	assert.Equal(t, showTables, db.GetQueryCalledNum(mysql.BaseShowTables))
+
+	tables := se.GetSchema()
+	assert.Equal(t, 2, len(tables["test_table_01"].Fields))
+	assert.Equal(t, []int{0}, tables["test_table_01"].PKColumns)
+	assert.NotNil(t, tables["test_table_02"])
+	assert.Nil(t, tables["test_table_03"])
+	assert.NotNil(t, tables["test_table_04"])
+}
+
+func TestGeneratedColumns(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "pk2",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "pk3",
+			Type: sqltypes.Int32,
+		}},
+	})
+	db.AddQuery(mysql.BaseShowGeneratedColumns, &sqltypes.Result{
+		Fields: mysql.ShowGeneratedColumnsFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowGeneratedColumnsRow("test_table_01", "pk2", "(`pk` * 2)", false),
+			mysql.ShowGeneratedColumnsRow("test_table_01", "pk3", "(`pk` * 3)", true),
+		},
+	})
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	table := se.GetTable(sqlparser.NewTableIdent("test_table_01"))
+	assert.Equal(t, []*GeneratedColumn{{
+		Name:       sqlparser.NewColIdent("pk2"),
+		Expression: "(`pk` * 2)",
+	}, {
+		Name:       sqlparser.NewColIdent("pk3"),
+		Expression: "(`pk` * 3)",
+		Stored:     true,
+	}}, table.GeneratedColumns)
+	assert.Equal(t, table.GeneratedColumns[1], table.FindGeneratedColumn(sqlparser.NewColIdent("PK3")))
+	assert.Nil(t, table.FindGeneratedColumn(sqlparser.NewColIdent("pk")))
+	assert.Nil(t, se.GetTable(sqlparser.NewTableIdent("test_table_02")).GeneratedColumns)
+}
+
+func TestGeneratedColumnsUnsupported(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddRejectedQuery(mysql.BaseShowGeneratedColumns, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSUnknownSQLState, "Unknown column 'generation_expression' in 'field list'"))
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+	assert.Nil(t, se.GetTable(sqlparser.NewTableIdent("test_table_01")).GeneratedColumns)
+}
+
+func TestPartitions(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowPartitions, &sqltypes.Result{
+		Fields: mysql.ShowPartitionsFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowPartitionsRow("test_table_01", "p0", "RANGE", "`pk`", "100", 10),
+			mysql.ShowPartitionsRow("test_table_01", "p1", "RANGE", "`pk`", "MAXVALUE", 20),
+			mysql.ShowPartitionsRow("unknown_table", "p0", "HASH", "`id`", "", 0),
+		},
+	})
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	table := se.GetTable(sqlparser.NewTableIdent("test_table_01"))
+	assert.Equal(t, &Partitioning{
+		Method:     "RANGE",
+		Expression: "`pk`",
+		Partitions: []*Partition{{
+			Name:        "p0",
+			Description: "100",
+			Rows:        10,
+		}, {
+			Name:        "p1",
+			Description: "MAXVALUE",
+			Rows:        20,
+		}},
+	}, table.Partitioning)
+	assert.Equal(t, table.Partitioning.Partitions[1], table.FindPartition("P1"))
+	assert.Nil(t, table.FindPartition("p2"))
+	assert.Nil(t, se.GetTable(sqlparser.NewTableIdent("test_table_02")).Partitioning)
+}
+
 func TestOpenFailedDueToMissMySQLTime(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -399,6 +637,7 @@ func initialSchema() map[string]*Table {
 				BatchSize:          1,
 				CacheSize:          10,
 				PollInterval:       30 * time.Second,
+				PriorityColumn:     "priority",
 			},
 		},
 	}
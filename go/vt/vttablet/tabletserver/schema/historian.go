@@ -0,0 +1,435 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	sqlCreateSidecarDB          = "create database if not exists %s"
+	sqlCreateSchemaVersionTable = `CREATE TABLE IF NOT EXISTS %s.schema_version (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  table_name VARBINARY(256) NOT NULL,
+  ddl LONGBLOB NOT NULL,
+  time_updated BIGINT NOT NULL,
+  pos VARBINARY(10000) NOT NULL,
+  PRIMARY KEY (id),
+  KEY table_time (table_name, time_updated)
+        ) engine=InnoDB`
+	sqlReadLastVersions      = "select table_name, ddl from %s.schema_version where id in (select max(id) from %s.schema_version group by table_name)"
+	sqlInsertSchemaVersion   = "insert into %s.schema_version(table_name, ddl, time_updated, pos) values (%a, %a, %a, %a)"
+	sqlReadSchemaVersion     = "select table_name, ddl, time_updated, pos from %s.schema_version where table_name = %a and time_updated <= %a order by id desc limit 1"
+	sqlReadLastSchemaVersion = "select table_name, ddl, time_updated, pos from %s.schema_version where table_name = %a order by id desc limit 1"
+
+	// replayDB is the scratch database in which the DDLs are replayed.
+	replayDB = "`_vt_schema_version_replay`"
+)
+
+// VStreamer defines the functions of VStreamer that the Historian
+// needs.
+type VStreamer interface {
+	Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
+}
+
+// Historian runs on master tablets and records the versions of the
+// tables in the _vt.schema_version table. The table replicates, which
+// lets any tablet return the version of a table at a point in time.
+// This is needed to interpret old binlog events.
+//
+// The Historian watches the binlog: a new version is recorded with the
+// position and the time of the DDL which created it. When it opens, the
+// tables whose version differs from their last recorded version are
+// recorded with the current position, and the binlog is watched from
+// there.
+//
+// The DDLs are read from the binlog after they ran, possibly long after
+// if the Historian is behind, so the live tables may have changed again.
+// Instead, a DDL is replayed on the last recorded versions of its tables,
+// in a scratch database which doesn't go to the binlog. The live tables
+// are only read if the DDL cannot be replayed, like when it names a
+// database or doesn't name its tables.
+type Historian struct {
+	env tabletenv.Env
+	se  *Engine
+	vs  VStreamer
+
+	// mu protects the following fields.
+	mu     sync.Mutex
+	isOpen bool
+	cancel context.CancelFunc
+	dbName string
+	// lastVersions maps the tables to the ddl of their last recorded
+	// version.
+	lastVersions map[string]string
+
+	// wg tracks the goroutine which watches the binlog.
+	wg sync.WaitGroup
+}
+
+// NewHistorian creates a new Historian.
+func NewHistorian(env tabletenv.Env, se *Engine, vs VStreamer) *Historian {
+	return &Historian{
+		env: env,
+		se:  se,
+		vs:  vs,
+	}
+}
+
+// Open creates the _vt.schema_version table if needed, records the
+// tables whose version differs from their last recorded version, and
+// starts watching the binlog for DDLs.
+func (h *Historian) Open() error {
+	if !h.env.Config().TrackSchemaVersions {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.isOpen {
+		return nil
+	}
+	h.dbName = sqlescape.EscapeID(h.env.DBConfigs().SidecarDBName.Get())
+
+	conn, err := dbconnpool.NewDBConnection(h.env.DBConfigs().DbaWithDB())
+	if err != nil {
+		return vterrors.Wrap(err, "could not connect to record schema versions")
+	}
+	defer conn.Close()
+	statements := []string{
+		fmt.Sprintf(sqlCreateSidecarDB, h.dbName),
+		fmt.Sprintf(sqlCreateSchemaVersionTable, h.dbName),
+	}
+	for _, s := range statements {
+		if _, err := conn.ExecuteFetch(s, 0, false); err != nil {
+			return vterrors.Wrap(err, "could not create the schema_version table")
+		}
+	}
+	qr, err := conn.ExecuteFetch(fmt.Sprintf(sqlReadLastVersions, h.dbName, h.dbName), maxTableCount, false)
+	if err != nil {
+		return vterrors.Wrap(err, "could not read the last schema versions")
+	}
+	h.lastVersions = make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		h.lastVersions[row[0].ToString()] = row[1].ToString()
+	}
+
+	pos, err := conn.MasterPosition()
+	if err != nil {
+		return vterrors.Wrap(err, "could not read the position to record schema versions")
+	}
+	h.recordTablesLocked(conn, h.allTablesLocked(), mysql.EncodePosition(pos), historianNow().Unix())
+
+	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
+	h.cancel = cancel
+	h.isOpen = true
+	h.wg.Add(1)
+	go h.watch(ctx, mysql.EncodePosition(pos))
+	return nil
+}
+
+// Close stops recording the versions of the tables.
+// It can be re-opened after Close.
+func (h *Historian) Close() {
+	h.mu.Lock()
+	if !h.isOpen {
+		h.mu.Unlock()
+		return
+	}
+	h.cancel()
+	h.isOpen = false
+	h.mu.Unlock()
+	h.wg.Wait()
+}
+
+// watch streams the binlog from pos, and records the versions of the
+// tables changed by each DDL with the position and the time of the DDL.
+// The stream is restarted from the last position if it fails.
+func (h *Historian) watch(ctx context.Context, pos string) {
+	defer h.wg.Done()
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	for {
+		err := h.vs.Stream(ctx, pos, filter, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				switch event.Type {
+				case binlogdatapb.VEventType_GTID:
+					pos = event.Gtid
+				case binlogdatapb.VEventType_DDL:
+					h.ddlApplied(ctx, event.Ddl, pos, event.Timestamp)
+				}
+			}
+			return nil
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(historianRetryDelay):
+		}
+		log.Infof("Schema version binlog stream ended: %v, restarting from %s", err, pos)
+	}
+}
+
+// historianRetryDelay is the delay before the binlog stream restarts.
+// It's changed by tests.
+var historianRetryDelay = 5 * time.Second
+
+// historianNow returns the time of the versions recorded when the
+// Historian opens. It's changed by tests.
+var historianNow = time.Now
+
+// ddlApplied records the versions of the tables changed by ddl,
+// whose transaction ended at pos, at the unix time timestamp.
+func (h *Historian) ddlApplied(ctx context.Context, ddl, pos string, timestamp int64) {
+	// The schema engine must know the new tables.
+	if err := h.se.Reload(ctx); err != nil {
+		log.Errorf("Could not reload the schema after %s: %v", ddl, err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.isOpen {
+		return
+	}
+	conn, err := dbconnpool.NewDBConnection(h.env.DBConfigs().DbaWithDB())
+	if err != nil {
+		log.Errorf("Could not connect to record schema versions: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	if timestamp == 0 {
+		timestamp = historianNow().Unix()
+	}
+	var tables []string
+	qualified := false
+	if stmt, err := sqlparser.Parse(ddl); err == nil {
+		if ddlStmt, ok := stmt.(*sqlparser.DDL); ok {
+			for _, table := range append(append(ddlStmt.FromTables, ddlStmt.ToTables...), ddlStmt.Table) {
+				if !table.IsEmpty() {
+					tables = append(tables, table.Name.String())
+					qualified = qualified || !table.Qualifier.IsEmpty()
+				}
+			}
+		}
+	}
+	if len(tables) == 0 {
+		// The statement doesn't tell which tables it changed.
+		h.recordTablesLocked(conn, h.allTablesLocked(), pos, timestamp)
+		return
+	}
+	if qualified {
+		// The statement would not run in the scratch database.
+		log.Warningf("Cannot replay %s, recording the current versions of its tables", ddl)
+		h.recordTablesLocked(conn, tables, pos, timestamp)
+		return
+	}
+	versions, err := h.replayLocked(ddl, tables)
+	if err != nil {
+		log.Warningf("Cannot replay %s, recording the current versions of its tables: %v", ddl, err)
+		h.recordTablesLocked(conn, tables, pos, timestamp)
+		return
+	}
+	sort.Strings(tables)
+	for _, name := range tables {
+		h.recordVersionLocked(conn, name, versions[name], pos, timestamp)
+	}
+}
+
+// replayLocked runs ddl on the last recorded versions of tables in the
+// scratch database, and returns the resulting versions of the tables.
+// The version of a table which doesn't exist is empty. It must be
+// called with mu held.
+func (h *Historian) replayLocked(ddl string, tables []string) (map[string]string, error) {
+	conn, err := dbconnpool.NewDBConnection(h.env.DBConfigs().DbaWithDB())
+	if err != nil {
+		return nil, err
+	}
+	// The session settings end with the connection.
+	defer conn.Close()
+
+	statements := []string{
+		"set @@session.sql_log_bin = 0",
+		"set @@session.foreign_key_checks = 0",
+		"drop database if exists " + replayDB,
+		"create database " + replayDB,
+		"use " + replayDB,
+	}
+	for _, name := range tables {
+		if last := h.lastVersions[name]; last != "" {
+			statements = append(statements, last)
+		}
+	}
+	statements = append(statements, ddl)
+	defer conn.ExecuteFetch("drop database if exists "+replayDB, 0, false)
+	for _, statement := range statements {
+		if _, err := conn.ExecuteFetch(statement, 0, false); err != nil {
+			return nil, err
+		}
+	}
+
+	versions := make(map[string]string, len(tables))
+	for _, name := range tables {
+		ddl, err := showCreateTable(conn, replayDB+"."+sqlescape.EscapeID(name))
+		if err != nil {
+			return nil, err
+		}
+		versions[name] = ddl
+	}
+	return versions, nil
+}
+
+// showCreateTable returns the definition of a table, or "" if it
+// doesn't exist.
+func showCreateTable(conn *dbconnpool.DBConnection, table string) (string, error) {
+	qr, err := conn.ExecuteFetch("show create table "+table, 1, false)
+	if err != nil {
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) < 2 {
+		return "", fmt.Errorf("unexpected result for show create table %s: %v", table, qr.Rows)
+	}
+	return qr.Rows[0][1].ToString(), nil
+}
+
+// allTablesLocked returns the tables known by the schema engine, and
+// the tables which have a recorded version. It must be called with mu
+// held.
+func (h *Historian) allTablesLocked() []string {
+	var tables []string
+	for name := range h.se.GetSchema() {
+		// dual is not a real table.
+		if name != "dual" {
+			tables = append(tables, name)
+		}
+	}
+	for name := range h.lastVersions {
+		if _, ok := h.se.GetSchema()[name]; !ok {
+			tables = append(tables, name)
+		}
+	}
+	return tables
+}
+
+// recordTablesLocked records the current versions of tables at pos and
+// at the unix time timestamp. The version of a table which doesn't exist
+// has an empty ddl. It must be called with mu held.
+func (h *Historian) recordTablesLocked(conn *dbconnpool.DBConnection, tables []string, pos string, timestamp int64) {
+	sort.Strings(tables)
+	for _, name := range tables {
+		ddl, err := showCreateTable(conn, sqlescape.EscapeID(name))
+		if err != nil {
+			log.Errorf("Could not read the schema of table %s: %v", name, err)
+			continue
+		}
+		h.recordVersionLocked(conn, name, ddl, pos, timestamp)
+	}
+}
+
+// recordVersionLocked inserts a version of a table, unless it's the
+// same as its last recorded version, or the table was never recorded
+// and doesn't exist. It must be called with mu held.
+func (h *Historian) recordVersionLocked(conn *dbconnpool.DBConnection, name, ddl, pos string, timestamp int64) {
+	if last, ok := h.lastVersions[name]; ok && last == ddl || !ok && ddl == "" {
+		return
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"table_name":   sqltypes.StringBindVariable(name),
+		"ddl":          sqltypes.StringBindVariable(ddl),
+		"time_updated": sqltypes.Int64BindVariable(timestamp),
+		"pos":          sqltypes.StringBindVariable(pos),
+	}
+	query, err := sqlparser.BuildParsedQuery(sqlInsertSchemaVersion, h.dbName, ":table_name", ":ddl", ":time_updated", ":pos").GenerateQuery(bindVars, nil)
+	if err != nil {
+		log.Errorf("Could not build the schema version of table %s: %v", name, err)
+		return
+	}
+	if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+		log.Errorf("Could not record the schema version of table %s: %v", name, err)
+		return
+	}
+	log.Infof("Recorded a new schema version of table %s at %s", name, pos)
+	h.lastVersions[name] = ddl
+}
+
+// SchemaVersion returns the version of the table at the unix time t,
+// or its last version if t is 0. It reads the replicated
+// _vt.schema_version table, so it works on any tablet.
+func (h *Historian) SchemaVersion(tableName string, t int64) (*querypb.SchemaVersion, error) {
+	dbName := sqlescape.EscapeID(h.env.DBConfigs().SidecarDBName.Get())
+	bindVars := map[string]*querypb.BindVariable{
+		"table_name": sqltypes.StringBindVariable(tableName),
+		"time":       sqltypes.Int64BindVariable(t),
+	}
+	var parsed *sqlparser.ParsedQuery
+	if t == 0 {
+		parsed = sqlparser.BuildParsedQuery(sqlReadLastSchemaVersion, dbName, ":table_name")
+	} else {
+		parsed = sqlparser.BuildParsedQuery(sqlReadSchemaVersion, dbName, ":table_name", ":time")
+	}
+	query, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dbconnpool.NewDBConnection(h.env.DBConfigs().DbaWithDB())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(query, 1, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "could not read the schema version")
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no schema version of table %s at time %d", tableName, t)
+	}
+	row := qr.Rows[0]
+	timeUpdated, err := sqltypes.ToInt64(row[2])
+	if err != nil {
+		return nil, err
+	}
+	return &querypb.SchemaVersion{
+		TableName:   row[0].ToString(),
+		Ddl:         row[1].ToString(),
+		TimeUpdated: timeUpdated,
+		Position:    row[3].ToString(),
+	}, nil
+}
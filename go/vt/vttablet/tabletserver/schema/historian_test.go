@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	testGTIDSet  = "16b1039f-22b6-11ed-b765-0a43f95f28a3:1-42"
+	testPosition = "MySQL56/" + testGTIDSet
+	t1DDL        = "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	t2DDL        = "CREATE TABLE `t2` (\n  `id` int(11) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	ddlPosition  = "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-43"
+	// openTime is the time of the versions recorded by Open, and
+	// ddlTime the time of the DDLs.
+	openTime = 1427325875
+	ddlTime  = 1427325880
+)
+
+// fakeVStreamer sends the events of its channel.
+type fakeVStreamer struct {
+	startPos chan string
+	events   chan []*binlogdatapb.VEvent
+}
+
+func newFakeVStreamer() *fakeVStreamer {
+	return &fakeVStreamer{
+		startPos: make(chan string, 10),
+		events:   make(chan []*binlogdatapb.VEvent),
+	}
+}
+
+func (vs *fakeVStreamer) Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	vs.startPos <- startPos
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case events := <-vs.events:
+			if events == nil {
+				return fmt.Errorf("stream failed")
+			}
+			if err := send(events); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendDDL sends the transaction of a DDL, and waits until it's handled.
+func (vs *fakeVStreamer) sendDDL(pos, ddl string) {
+	vs.events <- []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: pos},
+		{Type: binlogdatapb.VEventType_DDL, Ddl: ddl, Timestamp: ddlTime},
+	}
+	// The channel is unbuffered: the next send only succeeds once the
+	// previous events are handled.
+	vs.events <- []*binlogdatapb.VEvent{}
+}
+
+func newTestHistorian(db *fakesqldb.DB) (*Engine, *Historian, *fakeVStreamer) {
+	config := tabletenv.DefaultQsConfig
+	config.SchemaReloadTime = 10
+	config.IdleTimeout = 10
+	config.TrackSchemaVersions = true
+	dbcfgs := newDBConfigs(db)
+	env := tabletenv.NewTestEnv(&config, dbcfgs, "HistorianTest")
+	se := NewEngine(env)
+	se.InitDBConfig(dbcfgs.DbaWithDB())
+	vs := newFakeVStreamer()
+	historianNow = func() time.Time { return time.Unix(openTime, 0) }
+	return se, NewHistorian(env, se, vs), vs
+}
+
+// addReplayQueries adds the queries which replay statements in the
+// scratch database.
+func addReplayQueries(db *fakesqldb.DB, statements ...string) {
+	for _, query := range []string{
+		"set @@session.sql_log_bin = 0",
+		"set @@session.foreign_key_checks = 0",
+		"drop database if exists `_vt_schema_version_replay`",
+		"create database `_vt_schema_version_replay`",
+		"use `_vt_schema_version_replay`",
+	} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+	for _, statement := range statements {
+		db.AddQuery(statement, &sqltypes.Result{})
+	}
+}
+
+func addHistorianQueries(db *fakesqldb.DB, tables ...string) {
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325875"))
+	showTables := &sqltypes.Result{Fields: mysql.BaseShowTablesFields}
+	showPrimary := &sqltypes.Result{Fields: mysql.ShowPrimaryFields}
+	for _, table := range tables {
+		showTables.Rows = append(showTables.Rows, mysql.BaseShowTablesRow(table, false, ""))
+		showPrimary.Rows = append(showPrimary.Rows, mysql.ShowPrimaryRow(table, "id"))
+		db.AddQuery(fmt.Sprintf("select * from %s where 1 != 1", table), &sqltypes.Result{
+			Fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		})
+	}
+	db.AddQuery(mysql.BaseShowTables, showTables)
+	db.AddQuery(mysql.BaseShowPrimary, showPrimary)
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{Fields: mysql.ShowForeignKeysFields})
+	db.AddQuery(mysql.BaseShowGeneratedColumns, &sqltypes.Result{Fields: mysql.ShowGeneratedColumnsFields})
+	db.AddQuery(mysql.BaseShowPartitions, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
+	db.AddQuery("SELECT @@GLOBAL.gtid_executed", sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid", "varchar"), testGTIDSet))
+	db.AddQuery("create database if not exists `_vt`", &sqltypes.Result{})
+	db.AddQuery(fmt.Sprintf(sqlCreateSchemaVersionTable, "`_vt`"), &sqltypes.Result{})
+	db.AddQuery("show create table `t1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"), "t1|"+t1DDL))
+	db.AddQuery("show create table `t2`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"), "t2|"+t2DDL))
+}
+
+func insertVersionQuery(table, ddl, pos string, timeUpdated int64) string {
+	buf := &bytes.Buffer{}
+	sqltypes.NewVarChar(ddl).EncodeSQL(buf)
+	return fmt.Sprintf("insert into `_vt`.schema_version(table_name, ddl, time_updated, pos) values ('%s', %s, %d, '%s')", table, buf.String(), timeUpdated, pos)
+}
+
+func TestHistorianRecordsVersions(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	addHistorianQueries(db, "t1", "t2")
+	// t2 is already recorded with its current schema.
+	db.AddQuery("select table_name, ddl from `_vt`.schema_version where id in (select max(id) from `_vt`.schema_version group by table_name)", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|ddl", "varchar|varchar"),
+		"t2|"+t2DDL,
+	))
+	db.AddQuery(insertVersionQuery("t1", t1DDL, testPosition, openTime), &sqltypes.Result{})
+
+	se, h, vs := newTestHistorian(db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+	require.NoError(t, h.Open())
+	defer h.Close()
+	assert.Equal(t, 1, db.GetQueryCalledNum(insertVersionQuery("t1", t1DDL, testPosition, openTime)))
+	assert.Equal(t, 0, db.GetQueryCalledNum(insertVersionQuery("t2", t2DDL, testPosition, openTime)))
+	assert.Equal(t, testPosition, <-vs.startPos)
+
+	// Drop t2. Its version is recorded with an empty ddl, at the
+	// position and the time of the DDL.
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325876"))
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows:   [][]sqltypes.Value{mysql.BaseShowTablesRow("t1", false, "")},
+	})
+	addReplayQueries(db, t2DDL, "drop table t2")
+	db.AddRejectedQuery("show create table `_vt_schema_version_replay`.`t2`", mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownSQLState, "Table 't2' doesn't exist"))
+	db.AddQuery(insertVersionQuery("t2", "", ddlPosition, ddlTime), &sqltypes.Result{})
+	vs.sendDDL(ddlPosition, "drop table t2")
+	assert.Equal(t, 1, db.GetQueryCalledNum(t2DDL))
+	assert.Equal(t, 1, db.GetQueryCalledNum(insertVersionQuery("t2", "", ddlPosition, ddlTime)))
+	assert.Equal(t, 0, db.GetQueryCalledNum(insertVersionQuery("t1", t1DDL, ddlPosition, ddlTime)))
+
+	// Alter t1 while it was already altered again: the version of the
+	// DDL is the replayed one, not the live one.
+	t1Altered := "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  `c` int(11) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	t1Live := "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  `c` int(11) DEFAULT NULL,\n  `d` int(11) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	alterPosition := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-44"
+	addReplayQueries(db, t1DDL, "alter table t1 add column c int")
+	db.AddQuery("show create table `_vt_schema_version_replay`.`t1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"), "t1|"+t1Altered))
+	db.AddQuery("show create table `t1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"), "t1|"+t1Live))
+	db.AddQuery(insertVersionQuery("t1", t1Altered, alterPosition, ddlTime), &sqltypes.Result{})
+	vs.sendDDL(alterPosition, "alter table t1 add column c int")
+	assert.Equal(t, 1, db.GetQueryCalledNum(insertVersionQuery("t1", t1Altered, alterPosition, ddlTime)))
+
+	// A DDL which names the database cannot be replayed: the live
+	// version is recorded.
+	qualifiedPosition := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-45"
+	db.AddQuery(insertVersionQuery("t1", t1Live, qualifiedPosition, ddlTime), &sqltypes.Result{})
+	vs.sendDDL(qualifiedPosition, "alter table vt_db.t1 add column d int")
+	assert.Equal(t, 1, db.GetQueryCalledNum(insertVersionQuery("t1", t1Live, qualifiedPosition, ddlTime)))
+	assert.Equal(t, 0, db.GetQueryCalledNum("alter table vt_db.t1 add column d int"))
+
+	// The stream restarts from the last position.
+	historianRetryDelay = 10 * time.Millisecond
+	defer func() { historianRetryDelay = 5 * time.Second }()
+	vs.events <- nil
+	assert.Equal(t, qualifiedPosition, <-vs.startPos)
+
+	// Nothing is recorded after Close.
+	h.Close()
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{Fields: mysql.BaseShowTablesFields})
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325877"))
+	require.NoError(t, se.Reload(tabletenv.LocalContext()))
+	assert.Equal(t, 0, db.GetQueryCalledNum(insertVersionQuery("t1", "", testPosition, openTime)))
+}
+
+func TestHistorianDisabled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	addHistorianQueries(db, "t1")
+
+	se, h, _ := newTestHistorian(db)
+	h.env.Config().TrackSchemaVersions = false
+	require.NoError(t, se.Open())
+	defer se.Close()
+	require.NoError(t, h.Open())
+	defer h.Close()
+	assert.Equal(t, 0, db.GetQueryCalledNum("create database if not exists `_vt`"))
+}
+
+func TestHistorianSchemaVersion(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	_, h, _ := newTestHistorian(db)
+
+	result := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|ddl|time_updated|pos", "varchar|varchar|int64|varchar"),
+		"t1|"+t1DDL+"|1427325870|"+testPosition,
+	)
+	db.AddQuery("select table_name, ddl, time_updated, pos from `_vt`.schema_version where table_name = 't1' and time_updated <= 1427325875 order by id desc limit 1", result)
+	db.AddQuery("select table_name, ddl, time_updated, pos from `_vt`.schema_version where table_name = 't1' order by id desc limit 1", result)
+	db.AddQuery("select table_name, ddl, time_updated, pos from `_vt`.schema_version where table_name = 't1' and time_updated <= 1427325000 order by id desc limit 1", &sqltypes.Result{})
+
+	want := &querypb.SchemaVersion{
+		TableName:   "t1",
+		Ddl:         t1DDL,
+		TimeUpdated: 1427325870,
+		Position:    testPosition,
+	}
+	got, err := h.SchemaVersion("t1", 1427325875)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = h.SchemaVersion("t1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = h.SchemaVersion("t1", 1427325000)
+	assert.EqualError(t, err, "no schema version of table t1 at time 1427325000")
+}
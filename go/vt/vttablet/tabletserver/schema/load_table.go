@@ -25,6 +25,8 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // LoadTable creates a Table from the schema info in the database.
@@ -57,21 +59,6 @@ func fetchColumns(ta *Table, conn *connpool.DBConn, sqlTableName string) error {
 }
 
 func loadMessageInfo(ta *Table, comment string) error {
-	hiddenCols := map[string]struct{}{
-		"priority":   {},
-		"time_next":  {},
-		"epoch":      {},
-		"time_acked": {},
-	}
-
-	requiredCols := []string{
-		"id",
-		"priority",
-		"time_next",
-		"epoch",
-		"time_acked",
-	}
-
 	ta.MessageInfo = &MessageInfo{}
 	// Extract keyvalues.
 	keyvals := make(map[string]string)
@@ -84,6 +71,27 @@ func loadMessageInfo(ta *Table, comment string) error {
 		keyvals[kv[0]] = kv[1]
 	}
 
+	// The priority column is optional and defaults to "priority".
+	ta.MessageInfo.PriorityColumn = strings.ToLower(keyvals["vt_priority_column"])
+	if ta.MessageInfo.PriorityColumn == "" {
+		ta.MessageInfo.PriorityColumn = "priority"
+	}
+
+	hiddenCols := map[string]struct{}{
+		ta.MessageInfo.PriorityColumn: {},
+		"time_next":                   {},
+		"epoch":                       {},
+		"time_acked":                  {},
+	}
+
+	requiredCols := []string{
+		"id",
+		ta.MessageInfo.PriorityColumn,
+		"time_next",
+		"epoch",
+		"time_acked",
+	}
+
 	var err error
 	if ta.MessageInfo.AckWaitDuration, err = getDuration(keyvals, "vt_ack_wait"); err != nil {
 		return err
@@ -110,6 +118,25 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	// The max attempts are optional, 0 means no limit.
+	if keyvals["vt_max_attempts"] != "" {
+		if ta.MessageInfo.MaxAttempts, err = getNum(keyvals, "vt_max_attempts"); err != nil {
+			return err
+		}
+	}
+
+	// The consumer groups are optional. They're separated by '|'
+	// because ',' separates the attributes.
+	if groups := keyvals["vt_consumer_groups"]; groups != "" {
+		ta.MessageInfo.ConsumerGroups = strings.Split(groups, "|")
+	}
+
+	// The dedup column is optional.
+	ta.MessageInfo.DedupColumn = strings.ToLower(keyvals["vt_dedup_column"])
+	if ta.MessageInfo.DedupColumn != "" {
+		requiredCols = append(requiredCols, ta.MessageInfo.DedupColumn)
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -117,6 +144,15 @@ func loadMessageInfo(ta *Table, comment string) error {
 		}
 	}
 
+	// MySQL only flags a column as a unique key if a unique index
+	// has that column alone.
+	if dedup := ta.MessageInfo.DedupColumn; dedup != "" {
+		field := ta.Fields[ta.FindColumn(sqlparser.NewColIdent(dedup))]
+		if field.Flags&uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG) == 0 {
+			return fmt.Errorf("dedup column %s of message table %s must have a unique index of its own", dedup, ta.Name.String())
+		}
+	}
+
 	// Load user-defined columns. Any "unrecognized" column is user-defined.
 	for _, field := range ta.Fields {
 		if _, ok := hiddenCols[strings.ToLower(field.Name)]; ok {
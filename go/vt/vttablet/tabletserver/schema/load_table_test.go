@@ -114,6 +114,12 @@ func TestLoadTableMessage(t *testing.T) {
 		}, {
 			Name: "message",
 			Type: sqltypes.VarBinary,
+		}, {
+			Name:         "request_id",
+			Type:         sqltypes.VarChar,
+			ColumnLength: 64,
+			Charset:      33,
+			Flags:        uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG),
 		}},
 		MessageInfo: &MessageInfo{
 			Fields: []*querypb.Field{{
@@ -122,6 +128,12 @@ func TestLoadTableMessage(t *testing.T) {
 			}, {
 				Name: "message",
 				Type: sqltypes.VarBinary,
+			}, {
+				Name:         "request_id",
+				Type:         sqltypes.VarChar,
+				ColumnLength: 64,
+				Charset:      33,
+				Flags:        uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG),
 			}},
 			AckWaitDuration:    30 * time.Second,
 			PurgeAfterDuration: 120 * time.Second,
@@ -129,6 +141,7 @@ func TestLoadTableMessage(t *testing.T) {
 			BatchSize:          1,
 			CacheSize:          10,
 			PollInterval:       30 * time.Second,
+			PriorityColumn:     "priority",
 		},
 	}
 	assert.Equal(t, want, table)
@@ -140,6 +153,40 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max attempts
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxAttempts = 5
+	assert.Equal(t, want, table)
+
+	// Test loading a dedup column
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_dedup_column=REQUEST_ID", db)
+	require.NoError(t, err)
+	want.MessageInfo.DedupColumn = "request_id"
+	assert.Equal(t, want, table)
+
+	// Test loading consumer groups
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_dedup_column=REQUEST_ID,vt_consumer_groups=billing|audit", db)
+	require.NoError(t, err)
+	want.MessageInfo.ConsumerGroups = []string{"billing", "audit"}
+	assert.Equal(t, want, table)
+
+	// Invalid max attempts
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_attempts=five", db)
+	assert.EqualError(t, err, `strconv.Atoi: parsing "five": invalid syntax`)
+
+	// Missing dedup column
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_dedup_column=request_key", db)
+	assert.EqualError(t, err, "request_key missing from message table: test_table")
+
+	// Dedup column without a unique index
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_dedup_column=message", db)
+	assert.EqualError(t, err, "dedup column message of message table test_table must have a unique index of its own")
+
+	// Missing priority column
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_priority_column=urgency", db)
+	assert.EqualError(t, err, "urgency missing from message table: test_table")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -211,6 +258,12 @@ func getMessageTableQueries() map[string]*sqltypes.Result {
 			}, {
 				Name: "message",
 				Type: sqltypes.VarBinary,
+			}, {
+				Name:         "request_id",
+				Type:         sqltypes.VarChar,
+				ColumnLength: 64,
+				Charset:      33,
+				Flags:        uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG),
 			}},
 		},
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package schema
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -52,6 +53,54 @@ type Table struct {
 
 	// MessageInfo contains info for message tables.
 	MessageInfo *MessageInfo
+
+	// ForeignKeys lists the foreign keys of the table.
+	ForeignKeys []*ForeignKey
+
+	// ReferencedBy lists the foreign keys of other tables
+	// which reference this table.
+	ReferencedBy []*ForeignKey
+
+	// GeneratedColumns lists the generated columns of the table,
+	// in the order of Fields.
+	GeneratedColumns []*GeneratedColumn
+
+	// Partitioning describes how the table is partitioned.
+	// It's nil if the table isn't partitioned.
+	Partitioning *Partitioning
+}
+
+// Partitioning describes how the rows of a table are split
+// into partitions.
+type Partitioning struct {
+	// Method is the partitioning method, like RANGE or HASH.
+	Method string
+	// Expression is the expression or the columns whose values
+	// select the partition of a row.
+	Expression string
+	// Partitions lists the partitions in their order.
+	Partitions []*Partition
+}
+
+// Partition is a partition of a table.
+type Partition struct {
+	Name string
+	// Description contains the values of the partition, for the
+	// RANGE and LIST methods.
+	Description string
+	// Rows is the approximate number of rows of the partition.
+	Rows uint64
+}
+
+// GeneratedColumn describes a column whose value is computed by MySQL.
+// Its value can't be written, so it must be skipped when copying rows.
+type GeneratedColumn struct {
+	Name       sqlparser.ColIdent
+	Expression string
+
+	// Stored is true if the value is stored in the rows. Otherwise,
+	// the column is virtual, and it's computed when it's read.
+	Stored bool
 }
 
 // SequenceInfo contains info specific to sequence tabels.
@@ -100,6 +149,70 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// PriorityColumn specifies the column by which messages
+	// are prioritized. Among the messages which are due,
+	// those with a lower value are delivered first.
+	PriorityColumn string
+
+	// MaxAttempts specifies the number of times a message is
+	// sent before it's moved to the dead-letter state instead
+	// of being retried. 0 means that messages are retried forever.
+	MaxAttempts int
+
+	// DedupColumn optionally specifies a user-defined column
+	// which holds an idempotency key supplied by the producer.
+	// It must have a unique index of its own. Inserts which
+	// repeat a key are skipped, also after the message was
+	// acked, until it gets purged. Messages can be acked by
+	// their key as well as by their id.
+	DedupColumn string
+
+	// ConsumerGroups lists the message tables which back the
+	// consumer groups of this table. Every message inserted
+	// into this table is also inserted into each of them, with
+	// the same id, and every group consumes and acks the messages
+	// through its own table.
+	ConsumerGroups []string
+}
+
+// ForeignKey contains info about a foreign key.
+type ForeignKey struct {
+	Name string
+
+	// Table is the child table, which has the foreign key.
+	Table   string
+	Columns []string
+
+	// ReferencedTable is the parent table.
+	ReferencedTable   string
+	ReferencedColumns []string
+
+	// OnUpdate and OnDelete are the referential actions of the
+	// foreign key: CASCADE, SET NULL, SET DEFAULT, RESTRICT or
+	// NO ACTION.
+	OnUpdate string
+	OnDelete string
+}
+
+// CascadesOnUpdate returns true if updating the referenced
+// columns of the parent rows changes the child rows.
+func (fk *ForeignKey) CascadesOnUpdate() bool {
+	return cascades(fk.OnUpdate)
+}
+
+// CascadesOnDelete returns true if deleting the parent rows
+// changes the child rows.
+func (fk *ForeignKey) CascadesOnDelete() bool {
+	return cascades(fk.OnDelete)
+}
+
+func cascades(action string) bool {
+	switch action {
+	case "CASCADE", "SET NULL", "SET DEFAULT":
+		return true
+	}
+	return false
 }
 
 // NewTable creates a new Table.
@@ -120,6 +233,31 @@ func (ta *Table) FindColumn(name sqlparser.ColIdent) int {
 	return -1
 }
 
+// FindGeneratedColumn returns the generated column of the specified
+// name, or nil if there is none.
+func (ta *Table) FindGeneratedColumn(name sqlparser.ColIdent) *GeneratedColumn {
+	for _, col := range ta.GeneratedColumns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// FindPartition returns the partition of the specified name,
+// or nil if there is none.
+func (ta *Table) FindPartition(name string) *Partition {
+	if ta.Partitioning == nil {
+		return nil
+	}
+	for _, partition := range ta.Partitioning.Partitions {
+		if strings.EqualFold(partition.Name, name) {
+			return partition
+		}
+	}
+	return nil
+}
+
 // GetPKColumn returns the pk column specified by the index.
 func (ta *Table) GetPKColumn(index int) *querypb.Field {
 	return ta.Fields[ta.PKColumns[index]]
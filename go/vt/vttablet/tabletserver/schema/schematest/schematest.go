@@ -81,6 +81,15 @@ func Queries() map[string]*sqltypes.Result {
 				mysql.ShowPrimaryRow("msg", "id"),
 			},
 		},
+		mysql.BaseShowForeignKeys: {
+			Fields: mysql.ShowForeignKeysFields,
+		},
+		mysql.BaseShowGeneratedColumns: {
+			Fields: mysql.ShowGeneratedColumnsFields,
+		},
+		mysql.BaseShowPartitions: {
+			Fields: mysql.ShowPartitionsFields,
+		},
 		"select * from test_table_01 where 1 != 1": {
 			Fields: []*querypb.Field{{
 				Name: "pk",
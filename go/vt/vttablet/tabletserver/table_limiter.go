@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// TableLimiter limits the number of queries that can run against
+// a table at the same time. Queries beyond the limit are queued
+// until a slot frees up or their context expires. Tables without
+// a configured limit are not restricted.
+type TableLimiter struct {
+	slots   map[string]chan struct{}
+	waits   *servenv.TimingsWrapper
+	waiting *stats.GaugesWithSingleLabel
+}
+
+// NewTableLimiter creates a TableLimiter based on the
+// -table_concurrency_limits config.
+func NewTableLimiter(env tabletenv.Env) *TableLimiter {
+	limits, err := env.Config().TableConcurrencyLimitsByTable()
+	if err != nil {
+		log.Errorf("Ignoring table concurrency limits: %v", err)
+	}
+	tl := &TableLimiter{
+		slots:   make(map[string]chan struct{}),
+		waits:   env.Exporter().NewTimings("TableConcurrencyWaits", "Time spent waiting for a per-table concurrency slot", "Table"),
+		waiting: env.Exporter().NewGaugesWithSingleLabel("TableConcurrencyWaiting", "Number of queries waiting for a per-table concurrency slot", "Table"),
+	}
+	for table, limit := range limits {
+		tl.slots[table] = make(chan struct{}, limit)
+	}
+	return tl
+}
+
+// Acquire waits for a slot for table. The returned function must be
+// called to release the slot once the query is done.
+func (tl *TableLimiter) Acquire(ctx context.Context, table string) (release func(), err error) {
+	slots, ok := tl.slots[table]
+	if !ok {
+		return func() {}, nil
+	}
+	release = func() { <-slots }
+
+	// Fast path: don't record a wait if a slot is available.
+	select {
+	case slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	start := time.Now()
+	tl.waiting.Add(table, 1)
+	defer tl.waiting.Add(table, -1)
+	select {
+	case slots <- struct{}{}:
+		tl.waits.Record(table, start)
+		return release, nil
+	case <-ctx.Done():
+		tl.waits.Record(table, start)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "concurrency limit of %d queries for table %s exceeded: %v", cap(slots), table, ctx.Err())
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -58,13 +60,16 @@ func init() {
 	flag.IntVar(&Config.MessagePoolSize, "queryserver-config-message-conn-pool-size", DefaultQsConfig.MessagePoolSize, "query server message connection pool size, message pool is used by message managers: recommended value is one per message table")
 	flag.IntVar(&deprecatedMessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", 0, "DEPRECATED: Unused.")
 	flag.IntVar(&Config.TransactionCap, "queryserver-config-transaction-cap", DefaultQsConfig.TransactionCap, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
+	flag.IntVar(&Config.TransactionMaxCap, "queryserver-config-transaction-max-cap", DefaultQsConfig.TransactionMaxCap, "query server transaction max cap is the largest size the transaction pool can be resized to at runtime. 0 means the pool cannot grow beyond queryserver-config-transaction-cap.")
 	flag.IntVar(&Config.TxPoolPrefillParallelism, "queryserver-config-transaction-prefill-parallelism", DefaultQsConfig.TxPoolPrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&Config.MessagePostponeCap, "queryserver-config-message-postpone-cap", DefaultQsConfig.MessagePostponeCap, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&Config.FoundRowsPoolSize, "client-found-rows-pool-size", DefaultQsConfig.FoundRowsPoolSize, "size of a special pool that will be used if the client requests that statements be executed with the CLIENT_FOUND_ROWS option of MySQL.")
 	flag.Float64Var(&Config.TransactionTimeout, "queryserver-config-transaction-timeout", DefaultQsConfig.TransactionTimeout, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
+	flag.Float64Var(&Config.TxIdleTimeout, "queryserver-config-transaction-idle-timeout", DefaultQsConfig.TxIdleTimeout, "query server transaction idle timeout (in seconds), a transaction will be killed if no statement has been executed in it for longer than this value. Unlike queryserver-config-transaction-timeout, time spent executing statements does not count. 0 disables the idle timeout.")
 	flag.Float64Var(&Config.TxShutDownGracePeriod, "transaction_shutdown_grace_period", DefaultQsConfig.TxShutDownGracePeriod, "how long to wait (in seconds) for transactions to complete during graceful shutdown.")
 	flag.IntVar(&Config.MaxResultSize, "queryserver-config-max-result-size", DefaultQsConfig.MaxResultSize, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
 	flag.IntVar(&Config.WarnResultSize, "queryserver-config-warn-result-size", DefaultQsConfig.WarnResultSize, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
+	flag.IntVar(&Config.DeadlockRetryCount, "queryserver-config-deadlock-retry-count", DefaultQsConfig.DeadlockRetryCount, "query server deadlock retry count, the number of times an autocommit DML that fails with a deadlock will be retried before the error is returned to the client. 0 disables retries.")
 	flag.IntVar(&Config.MaxDMLRows, "queryserver-config-max-dml-rows", DefaultQsConfig.MaxDMLRows, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&Config.PassthroughDMLs, "queryserver-config-passthrough-dmls", DefaultQsConfig.PassthroughDMLs, "query server pass through all dml statements without rewriting")
 	flag.BoolVar(&deprecateAllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", false, "deprecated")
@@ -84,20 +89,32 @@ func init() {
 	flag.StringVar(&Config.TableACLExemptACL, "queryserver-config-acl-exempt-acl", DefaultQsConfig.TableACLExemptACL, "an acl that exempt from table acl checking (this acl is free to access any vitess tables).")
 	flag.BoolVar(&Config.TerseErrors, "queryserver-config-terse-errors", DefaultQsConfig.TerseErrors, "prevent bind vars from escaping in returned errors")
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
-	flag.BoolVar(&Config.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to support the include_event_token ExecuteOptions.")
+	flag.BoolVar(&Config.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to support the include_event_token ExecuteOptions, and to immediately reload the schema of the tables changed by DDLs.")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&Config.TwoPCEnable, "twopc_enable", DefaultQsConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&Config.TwoPCCoordinatorAddress, "twopc_coordinator_address", DefaultQsConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
 	flag.Float64Var(&Config.TwoPCAbandonAge, "twopc_abandon_age", DefaultQsConfig.TwoPCAbandonAge, "time in seconds. Any unresolved transaction older than this time will be sent to the coordinator to be resolved.")
+	flag.StringVar(&Config.TwoPCAlertWebhook, "twopc_alert_webhook", DefaultQsConfig.TwoPCAlertWebhook, "URL that receives a JSON POST request whenever the 2pc watcher detects a problem: a prepare older than twopc_alert_prepare_age, or new TwopcCommit or TwopcResurrection errors. Empty disables alerting.")
+	flag.Float64Var(&Config.TwoPCAlertPrepareAge, "twopc_alert_prepare_age", DefaultQsConfig.TwoPCAlertPrepareAge, "time in seconds. The 2pc watcher raises an alert if an unresolved prepare is older than this time. 0 means 5 times twopc_abandon_age.")
 	flag.BoolVar(&Config.EnableTxThrottler, "enable-tx-throttler", DefaultQsConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flag.StringVar(&Config.TxThrottlerConfig, "tx-throttler-config", DefaultQsConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.StringListVar(&Config.TxThrottlerHealthCheckCells, "tx-throttler-healthcheck-cells", DefaultQsConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
+	flagutil.StringListVar(&Config.TxThrottlerMetricSources, "tx-throttler-metric-sources", DefaultQsConfig.TxThrottlerMetricSources, "A comma-separated list of name:weight entries. The transaction throttler also throttles transactions while the weighted average load of these metric sources (e.g. disk_io, sql_probe) is at or above 1.")
+	flag.DurationVar(&Config.TxThrottlerMetricInterval, "tx-throttler-metric-interval", DefaultQsConfig.TxThrottlerMetricInterval, "How frequently the transaction throttler samples its metric sources.")
+	flag.StringVar(&Config.TxThrottlerDiskDevice, "tx-throttler-disk-device", DefaultQsConfig.TxThrottlerDiskDevice, "The block device (as named in /proc/diskstats) whose I/O utilization is reported by the disk_io metric source.")
+	flag.Float64Var(&Config.TxThrottlerMaxDiskUtilization, "tx-throttler-max-disk-utilization", DefaultQsConfig.TxThrottlerMaxDiskUtilization, "The disk_io metric source reports a load of 1 at this fraction of the time the disk is busy.")
+	flag.StringVar(&Config.TxThrottlerProbeQuery, "tx-throttler-probe-query", DefaultQsConfig.TxThrottlerProbeQuery, "A query returning a single number, which the sql_probe metric source runs against the local MySQL.")
+	flag.Float64Var(&Config.TxThrottlerProbeThreshold, "tx-throttler-probe-threshold", DefaultQsConfig.TxThrottlerProbeThreshold, "The sql_probe metric source reports a load of 1 when -tx-throttler-probe-query returns this value.")
 
 	flag.BoolVar(&Config.EnableHotRowProtection, "enable_hot_row_protection", DefaultQsConfig.EnableHotRowProtection, "If true, incoming transactions for the same row (range) will be queued and cannot consume all txpool slots.")
 	flag.BoolVar(&Config.EnableHotRowProtectionDryRun, "enable_hot_row_protection_dry_run", DefaultQsConfig.EnableHotRowProtectionDryRun, "If true, hot row protection is not enforced but logs if transactions would have been queued.")
 	flag.IntVar(&Config.HotRowProtectionMaxQueueSize, "hot_row_protection_max_queue_size", DefaultQsConfig.HotRowProtectionMaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&Config.HotRowProtectionMaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", DefaultQsConfig.HotRowProtectionMaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&Config.HotRowProtectionConcurrentTransactions, "hot_row_protection_concurrent_transactions", DefaultQsConfig.HotRowProtectionConcurrentTransactions, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.BoolVar(&Config.EnableHotRowProtectionAdaptive, "enable_hot_row_protection_adaptive", DefaultQsConfig.EnableHotRowProtectionAdaptive, "If true, the number of concurrent and queued transactions for each hot row adapt to the observed transaction latency for that row. -hot_row_protection_concurrent_transactions and -hot_row_protection_max_queue_size become upper bounds.")
+	flag.Float64Var(&Config.HotRowProtectionTargetLatency, "hot_row_protection_target_latency", DefaultQsConfig.HotRowProtectionTargetLatency, "Target transaction latency (in seconds) for a hot row when -enable_hot_row_protection_adaptive is set. Fewer transactions are let through while the observed latency is above this value, and more while it is below half of it.")
+	flagutil.StringListVar(&Config.HotRowProtectionKeyColumns, "hot_row_protection_key_columns", DefaultQsConfig.HotRowProtectionKeyColumns, "A comma-separated list of table.column entries. UPDATEs and DELETEs on these tables are serialized by the values the listed columns are compared to in the WHERE clause, instead of by the whole WHERE clause. E.g. accounts.account_id serializes all updates to rows of the same account.")
+	flagutil.StringListVar(&Config.TableConcurrencyLimits, "table_concurrency_limits", DefaultQsConfig.TableConcurrencyLimits, "A comma-separated list of table:N entries. At most N queries against the table are let through to MySQL at the same time. Further queries are queued until a slot frees up or their deadline expires. This prevents a single hot table from consuming the entire connection pool.")
 
 	flag.BoolVar(&Config.EnableTransactionLimit, "enable_transaction_limit", DefaultQsConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&Config.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", DefaultQsConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -110,10 +127,28 @@ func init() {
 	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&Config.HeartbeatInterval, "heartbeat_interval", DefaultQsConfig.HeartbeatInterval, "How frequently to read and write replication heartbeat.")
 
+	flag.BoolVar(&Config.TrackSchemaVersions, "track_schema_versions", DefaultQsConfig.TrackSchemaVersions, "If true, the master vttablet records every version of the tables it detects, along with the time and the replication position, in the table _vt.schema_version. The versions can then be requested from any vttablet with GetSchemaVersion.")
+
+	flag.BoolVar(&Config.EnableAdmissionControl, "enable_admission_control", DefaultQsConfig.EnableAdmissionControl, "If true, vttablet samples the MySQL threads_running and InnoDB history list length as well as its own CPU and memory usage, and queues or rejects low priority (OLAP workload) queries while MySQL or vttablet is saturated.")
+	flag.DurationVar(&Config.AdmissionControlInterval, "admission_control_interval", DefaultQsConfig.AdmissionControlInterval, "How frequently to sample the MySQL and vttablet load for admission control.")
+	flag.Int64Var(&Config.AdmissionControlMaxThreadsRunning, "admission_control_max_threads_running", DefaultQsConfig.AdmissionControlMaxThreadsRunning, "MySQL is considered saturated if threads_running is above this value. 0 disables the check.")
+	flag.Int64Var(&Config.AdmissionControlMaxHistoryLength, "admission_control_max_history_length", DefaultQsConfig.AdmissionControlMaxHistoryLength, "MySQL is considered saturated if the InnoDB history list length is above this value. 0 disables the check.")
+	flag.Float64Var(&Config.AdmissionControlMaxCPU, "admission_control_max_cpu", DefaultQsConfig.AdmissionControlMaxCPU, "vttablet is considered saturated if its CPU usage is above this fraction of the available CPUs. 0 disables the check.")
+	flag.Int64Var(&Config.AdmissionControlMaxMemory, "admission_control_max_memory_bytes", DefaultQsConfig.AdmissionControlMaxMemory, "vttablet is considered saturated if the memory it obtained from the OS is above this value. 0 disables the check.")
+	flag.DurationVar(&Config.AdmissionControlQueueTimeout, "admission_control_queue_timeout", DefaultQsConfig.AdmissionControlQueueTimeout, "How long a low priority query waits for MySQL or vttablet to recover before it is rejected. 0 rejects low priority queries immediately.")
+
+	flag.BoolVar(&Config.EnableQueryPriorityScheduling, "enable_query_priority_scheduling", DefaultQsConfig.EnableQueryPriorityScheduling, "If true, queries outside of transactions are scheduled by priority once -query_priority_concurrency queries are running, so that batch traffic yields to interactive traffic. The priority is set with the /*vt+ PRIORITY=critical|normal|batch */ directive or per caller with -query_priority_callers, and defaults to normal.")
+	flagutil.StringListVar(&Config.QueryPriorityCallers, "query_priority_callers", DefaultQsConfig.QueryPriorityCallers, "A comma-separated list of principal:priority entries, which set the priority of queries from the given effective callers. E.g. etl:batch,frontend:critical.")
+	flag.IntVar(&Config.QueryPriorityConcurrency, "query_priority_concurrency", DefaultQsConfig.QueryPriorityConcurrency, "Number of queries outside of transactions which run at the same time when -enable_query_priority_scheduling is set. Further queries wait in their priority queue. It should not be above -queryserver-config-pool-size, so that queries wait for a slot rather than for a connection.")
+
+	flag.DurationVar(&Config.LagSensitiveMaxReplicationLag, "lag_sensitive_max_replication_lag", DefaultQsConfig.LagSensitiveMaxReplicationLag, "Lag sensitive reads are rejected by replicas whose replication lag is above this value, so that vtgate can retry them on another tablet. Reads are lag sensitive if they carry the /*vt+ LAG_SENSITIVE=1 */ directive, or come from one of -lag_sensitive_callers. 0 disables the check.")
+	flagutil.StringListVar(&Config.LagSensitiveCallers, "lag_sensitive_callers", DefaultQsConfig.LagSensitiveCallers, "A comma-separated list of effective caller principals whose reads are lag sensitive unless they carry the /*vt+ LAG_SENSITIVE=0 */ directive.")
+
 	flag.BoolVar(&Config.EnforceStrictTransTables, "enforce_strict_trans_tables", DefaultQsConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flag.BoolVar(&Config.EnableConsolidator, "enable-consolidator", DefaultQsConfig.EnableConsolidator, "This option enables the query consolidator.")
 	flag.BoolVar(&Config.EnableConsolidatorReplicas, "enable-consolidator-replicas", DefaultQsConfig.EnableConsolidatorReplicas, "This option enables the query consolidator only on replicas.")
 	flag.BoolVar(&Config.EnableQueryPlanFieldCaching, "enable-query-plan-field-caching", DefaultQsConfig.EnableQueryPlanFieldCaching, "This option fetches & caches fields (columns) when storing query plans")
+	flag.BoolVar(&Config.RejectForeignKeyCascades, "reject_foreign_key_cascades", DefaultQsConfig.RejectForeignKeyCascades, "If true, vttablet rejects DMLs whose changes cascade to other tables through the ON DELETE or ON UPDATE actions of foreign keys. Otherwise, it only logs them. The cascaded changes don't reach rows on other shards, and they're not in the binlog used by vreplication.")
 }
 
 // Init must be called after flag.Parse, and before doing any other operations.
@@ -142,14 +177,17 @@ type TabletConfig struct {
 	StreamPoolPrefillParallelism int
 	MessagePoolSize              int
 	TransactionCap               int
+	TransactionMaxCap            int
 	MessagePostponeCap           int
 	FoundRowsPoolSize            int
 	TxPoolPrefillParallelism     int
 	TransactionTimeout           float64
+	TxIdleTimeout                float64
 	TxShutDownGracePeriod        float64
 	MaxResultSize                int
 	WarnResultSize               int
 	MaxDMLRows                   int
+	DeadlockRetryCount           int
 	PassthroughDMLs              bool
 	AllowUnsafeDMLs              bool
 	StreamBufferSize             int
@@ -169,26 +207,56 @@ type TabletConfig struct {
 	TwoPCEnable                  bool
 	TwoPCCoordinatorAddress      string
 	TwoPCAbandonAge              float64
-
-	EnableTxThrottler           bool
-	TxThrottlerConfig           string
-	TxThrottlerHealthCheckCells []string
+	TwoPCAlertWebhook            string
+	TwoPCAlertPrepareAge         float64
+
+	EnableTxThrottler             bool
+	TxThrottlerConfig             string
+	TxThrottlerHealthCheckCells   []string
+	TxThrottlerMetricSources      []string
+	TxThrottlerMetricInterval     time.Duration
+	TxThrottlerDiskDevice         string
+	TxThrottlerMaxDiskUtilization float64
+	TxThrottlerProbeQuery         string
+	TxThrottlerProbeThreshold     float64
 
 	EnableHotRowProtection                 bool
 	EnableHotRowProtectionDryRun           bool
 	HotRowProtectionMaxQueueSize           int
 	HotRowProtectionMaxGlobalQueueSize     int
 	HotRowProtectionConcurrentTransactions int
+	EnableHotRowProtectionAdaptive         bool
+	HotRowProtectionTargetLatency          float64
+	HotRowProtectionKeyColumns             []string
+	TableConcurrencyLimits                 []string
 
 	TransactionLimitConfig
 
 	HeartbeatEnable   bool
 	HeartbeatInterval time.Duration
 
+	TrackSchemaVersions bool
+
+	EnableAdmissionControl            bool
+	AdmissionControlInterval          time.Duration
+	AdmissionControlMaxThreadsRunning int64
+	AdmissionControlMaxHistoryLength  int64
+	AdmissionControlMaxCPU            float64
+	AdmissionControlMaxMemory         int64
+	AdmissionControlQueueTimeout      time.Duration
+
+	EnableQueryPriorityScheduling bool
+	QueryPriorityCallers          []string
+	QueryPriorityConcurrency      int
+
+	LagSensitiveMaxReplicationLag time.Duration
+	LagSensitiveCallers           []string
+
 	EnforceStrictTransTables    bool
 	EnableConsolidator          bool
 	EnableConsolidatorReplicas  bool
 	EnableQueryPlanFieldCaching bool
+	RejectForeignKeyCascades    bool
 }
 
 // TransactionLimitConfig captures configuration of transaction pool slots
@@ -217,14 +285,17 @@ var DefaultQsConfig = TabletConfig{
 	StreamPoolPrefillParallelism: 0,
 	MessagePoolSize:              5,
 	TransactionCap:               20,
+	TransactionMaxCap:            0,
 	MessagePostponeCap:           4,
 	FoundRowsPoolSize:            20,
 	TxPoolPrefillParallelism:     0,
 	TransactionTimeout:           30,
+	TxIdleTimeout:                0,
 	TxShutDownGracePeriod:        0,
 	MaxResultSize:                10000,
 	WarnResultSize:               0,
 	MaxDMLRows:                   500,
+	DeadlockRetryCount:           0,
 	PassthroughDMLs:              false,
 	AllowUnsafeDMLs:              false,
 	QueryPlanCacheSize:           5000,
@@ -244,10 +315,15 @@ var DefaultQsConfig = TabletConfig{
 	TwoPCEnable:                  false,
 	TwoPCCoordinatorAddress:      "",
 	TwoPCAbandonAge:              0,
+	TwoPCAlertWebhook:            "",
+	TwoPCAlertPrepareAge:         0,
 
-	EnableTxThrottler:           false,
-	TxThrottlerConfig:           defaultTxThrottlerConfig(),
-	TxThrottlerHealthCheckCells: []string{},
+	EnableTxThrottler:             false,
+	TxThrottlerConfig:             defaultTxThrottlerConfig(),
+	TxThrottlerHealthCheckCells:   []string{},
+	TxThrottlerMetricSources:      []string{},
+	TxThrottlerMetricInterval:     time.Second,
+	TxThrottlerMaxDiskUtilization: 0.9,
 
 	EnableHotRowProtection:       false,
 	EnableHotRowProtectionDryRun: false,
@@ -257,16 +333,38 @@ var DefaultQsConfig = TabletConfig{
 	// Allow more than 1 transaction for the same hot row through to have enough
 	// of them ready in MySQL and profit from a pipelining effect.
 	HotRowProtectionConcurrentTransactions: 5,
+	EnableHotRowProtectionAdaptive:         false,
+	HotRowProtectionTargetLatency:          0.1,
+	HotRowProtectionKeyColumns:             []string{},
+	TableConcurrencyLimits:                 []string{},
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
 	HeartbeatEnable:   false,
 	HeartbeatInterval: 1 * time.Second,
 
+	TrackSchemaVersions: false,
+
+	EnableAdmissionControl:            false,
+	AdmissionControlInterval:          1 * time.Second,
+	AdmissionControlMaxThreadsRunning: 100,
+	AdmissionControlMaxHistoryLength:  0,
+	AdmissionControlMaxCPU:            0,
+	AdmissionControlMaxMemory:         0,
+	AdmissionControlQueueTimeout:      0,
+
+	EnableQueryPriorityScheduling: false,
+	QueryPriorityCallers:          []string{},
+	QueryPriorityConcurrency:      16,
+
+	LagSensitiveMaxReplicationLag: 0,
+	LagSensitiveCallers:           []string{},
+
 	EnforceStrictTransTables:    true,
 	EnableConsolidator:          true,
 	EnableConsolidatorReplicas:  false,
 	EnableQueryPlanFieldCaching: true,
+	RejectForeignKeyCascades:    false,
 }
 
 // defaultTxThrottlerConfig formats the default throttlerdata.Configuration
@@ -337,6 +435,26 @@ func VerifyConfig() error {
 	if err := Config.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if maxCap, cap := Config.TransactionMaxCap, Config.TransactionCap; maxCap != 0 && maxCap < cap {
+		return fmt.Errorf("-queryserver-config-transaction-max-cap must be 0 or >= -queryserver-config-transaction-cap (specified values: %v, %v)", maxCap, cap)
+	}
+	if v := Config.TxIdleTimeout; v < 0 {
+		return fmt.Errorf("-queryserver-config-transaction-idle-timeout must be >= 0 (specified value: %v)", v)
+	}
+	if Config.EnableAdmissionControl {
+		if v := Config.AdmissionControlInterval; v <= 0 {
+			return fmt.Errorf("-admission_control_interval must be > 0 (specified value: %v)", v)
+		}
+		if v := Config.AdmissionControlMaxCPU; v < 0 || v > 1 {
+			return fmt.Errorf("-admission_control_max_cpu must be between 0 and 1 (specified value: %v)", v)
+		}
+		if Config.AdmissionControlMaxThreadsRunning <= 0 && Config.AdmissionControlMaxHistoryLength <= 0 && Config.AdmissionControlMaxCPU <= 0 && Config.AdmissionControlMaxMemory <= 0 {
+			return errors.New("-enable_admission_control requires at least one of -admission_control_max_threads_running, -admission_control_max_history_length, -admission_control_max_cpu or -admission_control_max_memory_bytes to be > 0")
+		}
+	}
+	if v := Config.DeadlockRetryCount; v < 0 {
+		return fmt.Errorf("-queryserver-config-deadlock-retry-count must be >= 0 (specified value: %v)", v)
+	}
 	if actual, dryRun := Config.EnableHotRowProtection, Config.EnableHotRowProtectionDryRun; actual && dryRun {
 		return errors.New("only one of two flags allowed: -enable_hot_row_protection or -enable_hot_row_protection_dry_run")
 	}
@@ -352,5 +470,114 @@ func VerifyConfig() error {
 	if v := Config.HotRowProtectionConcurrentTransactions; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if v := Config.HotRowProtectionTargetLatency; v <= 0 {
+		return fmt.Errorf("-hot_row_protection_target_latency must be > 0 (specified value: %v)", v)
+	}
+	if _, err := Config.HotRowKeyColumns(); err != nil {
+		return err
+	}
+	if _, err := Config.TableConcurrencyLimitsByTable(); err != nil {
+		return err
+	}
+	if _, err := Config.QueryPriorityByCaller(); err != nil {
+		return err
+	}
+	if Config.EnableQueryPriorityScheduling && Config.QueryPriorityConcurrency <= 0 {
+		return fmt.Errorf("-query_priority_concurrency must be positive (specified value: %v)", Config.QueryPriorityConcurrency)
+	}
+	if len(Config.TxThrottlerMetricSources) > 0 {
+		weights, err := Config.TxThrottlerMetricSourceWeights()
+		if err != nil {
+			return err
+		}
+		if v := Config.TxThrottlerMetricInterval; v <= 0 {
+			return fmt.Errorf("-tx-throttler-metric-interval must be > 0 (specified value: %v)", v)
+		}
+		if _, ok := weights["disk_io"]; ok {
+			if Config.TxThrottlerDiskDevice == "" {
+				return errors.New("the disk_io metric source requires -tx-throttler-disk-device")
+			}
+			if v := Config.TxThrottlerMaxDiskUtilization; v <= 0 || v > 1 {
+				return fmt.Errorf("-tx-throttler-max-disk-utilization must be > 0 and <= 1 (specified value: %v)", v)
+			}
+		}
+		if _, ok := weights["sql_probe"]; ok {
+			if Config.TxThrottlerProbeQuery == "" {
+				return errors.New("the sql_probe metric source requires -tx-throttler-probe-query")
+			}
+			if v := Config.TxThrottlerProbeThreshold; v <= 0 {
+				return fmt.Errorf("-tx-throttler-probe-threshold must be > 0 (specified value: %v)", v)
+			}
+		}
+	}
 	return nil
 }
+
+// HotRowKeyColumns parses HotRowProtectionKeyColumns and returns
+// the list of key columns for each table.
+func (c *TabletConfig) HotRowKeyColumns() (map[string][]string, error) {
+	keyColumns := make(map[string][]string)
+	for _, entry := range c.HotRowProtectionKeyColumns {
+		parts := strings.Split(strings.TrimSpace(entry), ".")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("-hot_row_protection_key_columns entries must have the form table.column (specified value: %v)", entry)
+		}
+		keyColumns[parts[0]] = append(keyColumns[parts[0]], strings.ToLower(parts[1]))
+	}
+	return keyColumns, nil
+}
+
+// TableConcurrencyLimitsByTable parses TableConcurrencyLimits and
+// returns the maximum number of concurrent queries for each table.
+func (c *TabletConfig) TableConcurrencyLimitsByTable() (map[string]int, error) {
+	limits := make(map[string]int)
+	for _, entry := range c.TableConcurrencyLimits {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("-table_concurrency_limits entries must have the form table:N (specified value: %v)", entry)
+		}
+		limit, err := strconv.Atoi(parts[1])
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("-table_concurrency_limits limit for table %v must be > 0 (specified value: %v)", parts[0], parts[1])
+		}
+		limits[parts[0]] = limit
+	}
+	return limits, nil
+}
+
+// QueryPriorityByCaller parses QueryPriorityCallers and returns the
+// priority name for each effective caller principal.
+func (c *TabletConfig) QueryPriorityByCaller() (map[string]string, error) {
+	priorities := make(map[string]string)
+	for _, entry := range c.QueryPriorityCallers {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("-query_priority_callers entries must have the form principal:priority (specified value: %v)", entry)
+		}
+		switch priority := strings.ToLower(parts[1]); priority {
+		case "critical", "normal", "batch":
+			priorities[parts[0]] = priority
+		default:
+			return nil, fmt.Errorf("-query_priority_callers priority for %v must be critical, normal or batch (specified value: %v)", parts[0], parts[1])
+		}
+	}
+	return priorities, nil
+}
+
+// TxThrottlerMetricSourceWeights parses TxThrottlerMetricSources and
+// returns the weight of each metric source.
+func (c *TabletConfig) TxThrottlerMetricSourceWeights() (map[string]float64, error) {
+	weights := make(map[string]float64)
+	for _, entry := range c.TxThrottlerMetricSources {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("-tx-throttler-metric-sources entries must have the form name:weight (specified value: %v)", entry)
+		}
+		weight, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("-tx-throttler-metric-sources weight for %v must be > 0 (specified value: %v)", parts[0], parts[1])
+		}
+		weights[parts[0]] = weight
+	}
+	return weights, nil
+}
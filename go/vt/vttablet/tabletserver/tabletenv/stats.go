@@ -43,6 +43,8 @@ type Stats struct {
 	TableaclAllowed        *stats.CountersWithMultiLabels // Number of allows
 	TableaclDenied         *stats.CountersWithMultiLabels // Number of denials
 	TableaclPseudoDenied   *stats.CountersWithMultiLabels // Number of pseudo denials
+	DeadlockCounts         *stats.CountersWithSingleLabel // Per table deadlock counts
+	DeadlockRetries        *stats.CountersWithSingleLabel // Per table deadlock retries
 }
 
 // NewStats instantiates a new set of stats scoped by exporter.
@@ -51,7 +53,7 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		MySQLTimings: exporter.NewTimings("Mysql", "MySQl query time", "operation"),
 		QueryTimings: exporter.NewTimings("Queries", "MySQL query timings", "plan_type"),
 		WaitTimings:  exporter.NewTimings("Waits", "Wait operations", "type"),
-		KillCounters: exporter.NewCountersWithSingleLabel("Kills", "Number of connections being killed", "query_type", "Transactions", "Queries"),
+		KillCounters: exporter.NewCountersWithSingleLabel("Kills", "Number of connections being killed", "query_type", "Transactions", "IdleTransactions", "Queries"),
 		ErrorCounters: exporter.NewCountersWithSingleLabel(
 			"Errors",
 			"Critical errors",
@@ -85,6 +87,8 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		TableaclAllowed:        exporter.NewCountersWithMultiLabels("TableACLAllowed", "ACL acceptances", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclDenied:         exporter.NewCountersWithMultiLabels("TableACLDenied", "ACL denials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclPseudoDenied:   exporter.NewCountersWithMultiLabels("TableACLPseudoDenied", "ACL pseudodenials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
+		DeadlockCounts:         exporter.NewCountersWithSingleLabel("DeadlockCounts", "Deadlocks reported by MySQL for each table", "table"),
+		DeadlockRetries:        exporter.NewCountersWithSingleLabel("DeadlockRetries", "Autocommit statements retried after a deadlock for each table", "table"),
 	}
 	stats.QPSRates = exporter.NewRates("QPS", stats.QueryTimings, 15*60/5, 5*time.Second)
 	return stats
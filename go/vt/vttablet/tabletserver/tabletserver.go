@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/history"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
@@ -140,6 +141,14 @@ type TabletServer struct {
 	QueryTimeout           sync2.AtomicDuration
 	TerseErrors            bool
 	enableHotRowProtection bool
+	// hotRowKeyColumns has the columns by which the hot row protection
+	// serializes transactions, for the tables where they are configured.
+	hotRowKeyColumns map[string][]string
+	// lagSensitiveCallers are the effective callers whose reads are
+	// rejected if the replication lag is above lagSensitiveMaxLag.
+	lagSensitiveCallers    map[string]bool
+	lagSensitiveMaxLag     time.Duration
+	lagSensitiveRejections *stats.Counter
 
 	// mu is used to access state. The lock should only be held
 	// for short periods. For longer periods, you have to transition
@@ -164,6 +173,7 @@ type TabletServer struct {
 	// The following variables should only be accessed within
 	// the context of a startRequest-endRequest.
 	se        *schema.Engine
+	historian *schema.Historian
 	qe        *QueryEngine
 	te        *TxEngine
 	hw        *heartbeat.Writer
@@ -178,7 +188,9 @@ type TabletServer struct {
 
 	// txThrottler is used to throttle transactions based on the observed replication lag.
 	txThrottler *txthrottler.TxThrottler
-	topoServer  *topo.Server
+	// admission is used to shed low priority queries while MySQL is saturated.
+	admission  *AdmissionController
+	topoServer *topo.Server
 
 	// streamHealthMutex protects all the following fields
 	streamHealthMutex          sync.Mutex
@@ -187,6 +199,10 @@ type TabletServer struct {
 	lastStreamHealthResponse   *querypb.StreamHealthResponse
 	lastStreamHealthExpiration time.Time
 
+	// schemaStreamIndex is used to name the schema notifiers
+	// of the StreamSchemaChanges streams.
+	schemaStreamIndex sync2.AtomicInt64
+
 	// history records changes in state for display on the status page.
 	// It has its own internal mutex.
 	history *history.History
@@ -227,14 +243,27 @@ func NewTabletServer(name string, config tabletenv.TabletConfig, topoServer *top
 		topoServer:             topoServer,
 		alias:                  alias,
 	}
+	hotRowKeyColumns, err := config.HotRowKeyColumns()
+	if err != nil {
+		log.Errorf("Ignoring hot row protection key columns: %v", err)
+	}
+	tsv.hotRowKeyColumns = hotRowKeyColumns
+	tsv.lagSensitiveMaxLag = config.LagSensitiveMaxReplicationLag
+	tsv.lagSensitiveCallers = make(map[string]bool)
+	for _, caller := range config.LagSensitiveCallers {
+		tsv.lagSensitiveCallers[caller] = true
+	}
+	tsv.lagSensitiveRejections = exporter.NewCounter("LagSensitiveRejections", "Number of lag sensitive reads rejected because of replication lag")
 	tsv.se = schema.NewEngine(tsv)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.te = NewTxEngine(tsv)
 	tsv.hw = heartbeat.NewWriter(tsv, alias)
 	tsv.hr = heartbeat.NewReader(tsv)
-	tsv.txThrottler = txthrottler.CreateTxThrottlerFromTabletConfig(topoServer)
+	tsv.txThrottler = txthrottler.CreateTxThrottlerFromTabletConfig(tsv, topoServer)
+	tsv.admission = NewAdmissionController(tsv)
 	tsOnce.Do(func() { srvTopoServer = srvtopo.NewResilientServer(topoServer, "TabletSrvTopo") })
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se)
+	tsv.historian = schema.NewHistorian(tsv, tsv.se, tsv.vstreamer)
 	tsv.watcher = NewReplicationWatcher(tsv.vstreamer, config)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
@@ -520,6 +549,7 @@ func (tsv *TabletServer) fullStart() (err error) {
 	if err := tsv.qe.Open(); err != nil {
 		return err
 	}
+	tsv.admission.Open()
 	if err := tsv.te.Init(); err != nil {
 		return err
 	}
@@ -543,11 +573,15 @@ func (tsv *TabletServer) serveNewType() (err error) {
 			return err
 		}
 		tsv.messager.Open()
+		if err := tsv.historian.Open(); err != nil {
+			log.Errorf("Could not start recording schema versions: %v", err)
+		}
 		tsv.hr.Close()
 		tsv.hw.Open()
 	} else {
 		tsv.te.AcceptReadOnly()
 		tsv.messager.Close()
+		tsv.historian.Close()
 		tsv.hr.Open()
 		tsv.hw.Close()
 		tsv.watcher.Open()
@@ -587,6 +621,7 @@ func (tsv *TabletServer) StopService() {
 	tsv.waitForShutdown()
 	tsv.watcher.Close()
 	tsv.vstreamer.Close()
+	tsv.admission.Close()
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.hw.Close()
@@ -602,6 +637,7 @@ func (tsv *TabletServer) waitForShutdown() {
 	// will be allowed. They will enable the conclusion of outstanding
 	// transactions.
 	tsv.messager.Close()
+	tsv.historian.Close()
 	tsv.te.StopGently()
 	tsv.qe.streamQList.TerminateAll()
 	tsv.watcher.Close()
@@ -613,12 +649,14 @@ func (tsv *TabletServer) waitForShutdown() {
 // It forcibly shuts down everything.
 func (tsv *TabletServer) closeAll() {
 	tsv.messager.Close()
+	tsv.historian.Close()
 	tsv.watcher.Close()
 	tsv.vstreamer.Close()
 	tsv.hr.Close()
 	tsv.hw.Close()
 	tsv.te.StopGently()
 	tsv.watcher.Close()
+	tsv.admission.Close()
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.txThrottler.Close()
@@ -768,9 +806,10 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, opti
 	return transactionID, err
 }
 
-// Commit commits the specified transaction.
-func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
-	return tsv.execRequest(
+// Commit commits the specified transaction. It returns the GTIDs of
+// the transaction, if MySQL tracks them.
+func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (sessionStateChanges string, err error) {
+	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Commit", "commit", nil,
 		target, nil, true, /* allowOnShutdown */
@@ -779,7 +818,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			logStats.TransactionID = transactionID
 
 			var commitSQL string
-			commitSQL, err = tsv.te.Commit(ctx, transactionID)
+			commitSQL, sessionStateChanges, err = tsv.te.Commit(ctx, transactionID)
 
 			// If nothing was actually executed, don't count the operation in
 			// the tablet metrics, and clear out the logStats Method so that
@@ -792,6 +831,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			return err
 		},
 	)
+	return sessionStateChanges, err
 }
 
 // Rollback rollsback the specified transaction.
@@ -961,6 +1001,11 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 		"Execute", sql, bindVariables,
 		target, options, allowOnShutdown,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if transactionID == 0 {
+				if err := tsv.admission.Admit(ctx, options); err != nil {
+					return err
+				}
+			}
 			if bindVariables == nil {
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
@@ -969,6 +1014,14 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			if err := tsv.checkReplicationLag(ctx, target, plan); err != nil {
+				return err
+			}
+			if err := tsv.checkReadAfterWrite(target, options); err != nil {
+				return err
+			}
+			ctx, cancel := withPlanTimeout(ctx, plan)
+			defer cancel()
 			qre := &QueryExecutor{
 				query:          query,
 				marginComments: comments,
@@ -1001,6 +1054,11 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 		"StreamExecute", sql, bindVariables,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if transactionID == 0 {
+				if err := tsv.admission.Admit(ctx, options); err != nil {
+					return err
+				}
+			}
 			if bindVariables == nil {
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
@@ -1009,6 +1067,14 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
+			if err := tsv.checkReplicationLag(ctx, target, plan); err != nil {
+				return err
+			}
+			if err := tsv.checkReadAfterWrite(target, options); err != nil {
+				return err
+			}
+			ctx, cancel := withPlanTimeout(ctx, plan)
+			defer cancel()
 			qre := &QueryExecutor{
 				query:          query,
 				marginComments: comments,
@@ -1105,11 +1171,15 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 		results = append(results, *localReply)
 	}
 	if asTransaction {
-		if err = tsv.Commit(ctx, target, transactionID); err != nil {
+		gtids, err := tsv.Commit(ctx, target, transactionID)
+		if err != nil {
 			transactionID = 0
 			return nil, err
 		}
 		transactionID = 0
+		if len(results) != 0 {
+			results[len(results)-1].SessionStateChanges = gtids
+		}
 	}
 	return results, nil
 }
@@ -1202,6 +1272,14 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		return "", ""
 	}
 
+	if keyColumns, ok := tsv.hotRowKeyColumns[tableName.String()]; ok {
+		if key := computeKeyColumnsSerializerKey(tableName.String(), keyColumns, plan.WhereEqualities, bindVariables); key != "" {
+			return key, tableName.String()
+		}
+		// Fall back to the whole WHERE clause if not all key columns are
+		// compared to a value.
+	}
+
 	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
@@ -1213,6 +1291,32 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 	return key, tableName.String()
 }
 
+// computeKeyColumnsSerializerKey returns a key made of the values of the
+// configured key columns in the WHERE clause, e.g. "accounts where
+// account_id = 1". It returns an empty string if any key column is not
+// compared to a value with "=".
+func computeKeyColumnsSerializerKey(tableName string, keyColumns []string, equalities map[string]sqltypes.PlanValue, bindVariables map[string]*querypb.BindVariable) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%s where ", tableName)
+	for i, col := range keyColumns {
+		pv, ok := equalities[col]
+		if !ok {
+			return ""
+		}
+		val, err := pv.ResolveValue(bindVariables)
+		if err != nil {
+			logComputeRowSerializerKey.Errorf("failed to resolve value of key column %v: %v bind vars: %v", col, err, bindVariables)
+			return ""
+		}
+		if i > 0 {
+			buf.WriteString(" and ")
+		}
+		buf.Myprintf("%s = ", col)
+		val.EncodeSQL(buf)
+	}
+	return buf.String()
+}
+
 // BeginExecuteBatch combines Begin and ExecuteBatch.
 func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error) {
 	// TODO(mberlin): Integrate hot row protection here as we did for BeginExecute()
@@ -1227,7 +1331,10 @@ func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.
 }
 
 // MessageStream streams messages from the requested table.
-func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+// If resumeToken is not empty, the stream is resumable: its rows carry
+// the token of the consumer, and the messages of the given token which
+// are still in flight to the consumer are resent right away.
+func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name, resumeToken string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
 		ctx, 0,
 		"MessageStream", "stream", nil,
@@ -1244,33 +1351,48 @@ func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Targ
 				logStats: logStats,
 				tsv:      tsv,
 			}
-			return qre.MessageStream(callback)
+			return qre.MessageStream(resumeToken, callback)
 		},
 	)
 }
 
-// MessageAck acks the list of messages for a given message table.
+// MessageAck acks the list of messages for a given message table,
+// and sets the user-defined columns in updates on them. If the table
+// has a dedup column, the messages can be given by their dedup key.
 // It returns the number of messages successfully acked.
-func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
 	sids := make([]string, 0, len(ids))
 	for _, val := range ids {
 		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
 	}
 	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GenerateAckQuery(name, sids)
+		return tsv.messager.GenerateAckQuery(name, sids, updates)
 	})
 	if err != nil {
 		return 0, err
 	}
 	messager.MessageStats.Add([]string{name, "Acked"}, count)
+	tsv.messager.Acked(name, sids)
 	return count, nil
 }
 
-// PostponeMessages postpones the list of messages for a given message table.
+// MessagePostpone postpones the redelivery of the list of messages for
+// a given message table, and sets the user-defined columns in updates
+// on them. It returns the number of messages successfully postponed.
+func (tsv *TabletServer) MessagePostpone(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value, updates map[string]*querypb.Value) (count int64, err error) {
+	sids := make([]string, 0, len(ids))
+	for _, val := range ids {
+		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
+	}
+	return tsv.PostponeMessages(ctx, target, name, sids, updates)
+}
+
+// PostponeMessages postpones the list of messages for a given message table,
+// and sets the user-defined columns in updates on them.
 // It returns the number of messages successfully postponed.
-func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string, updates map[string]*querypb.Value) (count int64, err error) {
 	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GeneratePostponeQuery(name, ids)
+		return tsv.messager.GeneratePostponeQuery(name, ids, updates)
 	})
 }
 
@@ -1282,6 +1404,25 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the list of messages for a given message table
+// to the dead-letter state. It returns the number of messages dead-lettered.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateDeadLetterQuery(name, ids)
+	})
+}
+
+// ReadMessageBacklog returns the number of pending messages, the time_next
+// of the oldest one, and the number of dead-lettered messages of a given
+// message table.
+func (tsv *TabletServer) ReadMessageBacklog(ctx context.Context, target *querypb.Target, name string) (*sqltypes.Result, error) {
+	query, bv, err := tsv.messager.GenerateBacklogQuery(name)
+	if err != nil {
+		return nil, err
+	}
+	return tsv.Execute(ctx, target, query, bv, 0, nil)
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.startRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
@@ -1309,7 +1450,7 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 	if err != nil {
 		return 0, err
 	}
-	if err = tsv.Commit(ctx, target, transactionID); err != nil {
+	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
 		return 0, err
 	}
@@ -1349,6 +1490,170 @@ func (tsv *TabletServer) VStreamResults(ctx context.Context, target *querypb.Tar
 	return tsv.vstreamer.StreamResults(ctx, query, send)
 }
 
+// StreamSchemaChanges streams the tables that are added, changed or
+// dropped from the schema, along with their definition. The first
+// response lists all the tables as added. Only the tables which the
+// caller can read are streamed. The stream ends with an error when the
+// context is done or the schema engine closes.
+func (tsv *TabletServer) StreamSchemaChanges(ctx context.Context, target *querypb.Target, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	return tsv.execRequest(
+		ctx, 0,
+		"StreamSchemaChanges", "", nil,
+		target, nil, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			return tsv.streamSchemaChanges(ctx, callback)
+		},
+	)
+}
+
+func (tsv *TabletServer) streamSchemaChanges(ctx context.Context, callback func(*querypb.StreamSchemaChangesResponse) error) error {
+	closed := tsv.se.Closed()
+	if !tsv.se.IsOpen() {
+		return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "schema engine is not open")
+	}
+
+	// The notifier is called with the schema engine locked. So, it
+	// only queues the changes, and the stream sends them.
+	var (
+		mu      sync.Mutex
+		pending []*querypb.SchemaTableChange
+	)
+	ready := make(chan struct{}, 1)
+	name := fmt.Sprintf("SchemaStream%d", tsv.schemaStreamIndex.Add(1))
+	tsv.se.RegisterNotifier(name, func(tables map[string]*schema.Table, created, altered, dropped []string) {
+		changes := schemaTableChanges(tables, created, altered, dropped)
+		if len(changes) == 0 {
+			return
+		}
+		mu.Lock()
+		pending = append(pending, changes...)
+		mu.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	})
+	defer tsv.se.UnregisterNotifier(name)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-closed:
+			return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "schema engine closed")
+		case <-ready:
+		}
+		mu.Lock()
+		changes := pending
+		pending = nil
+		mu.Unlock()
+		var readable []*querypb.SchemaTableChange
+		for _, change := range changes {
+			// dual is not a real table.
+			if change.TableName == "dual" || !tsv.canReadTable(ctx, change.TableName) {
+				continue
+			}
+			if change.ChangeType != querypb.SchemaTableChange_DROPPED {
+				createStatement, err := tsv.showCreateTable(ctx, change.TableName)
+				if err != nil {
+					return err
+				}
+				change.CreateStatement = createStatement
+			}
+			readable = append(readable, change)
+		}
+		if len(readable) == 0 {
+			continue
+		}
+		if err := callback(&querypb.StreamSchemaChangesResponse{Changes: readable}); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// showCreateTable returns the definition of a table. It returns
+// an empty definition if the table was dropped since.
+func (tsv *TabletServer) showCreateTable(ctx context.Context, tableName string) (string, error) {
+	conn, err := tsv.qe.conns.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, fmt.Sprintf("show create table %s", sqlescape.EscapeID(tableName)), 1, false)
+	if err != nil {
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) < 2 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for show create table %s: %v", tableName, qr.Rows)
+	}
+	return qr.Rows[0][1].ToString(), nil
+}
+
+// canReadTable returns true if the caller can read the table. It
+// follows the table ACL rules of the queries.
+func (tsv *TabletServer) canReadTable(ctx context.Context, tableName string) bool {
+	if tabletenv.IsLocalContext(ctx) || !tsv.qe.strictTableACL || tsv.qe.enableTableACLDryRun {
+		return true
+	}
+	callerID := callerid.ImmediateCallerIDFromContext(ctx)
+	if callerID == nil {
+		return false
+	}
+	if tsv.qe.exemptACL != nil && tsv.qe.exemptACL.IsMember(callerID) {
+		return true
+	}
+	return tableacl.Authorized(tableName, tableacl.READER).IsMember(callerID)
+}
+
+// schemaTableChanges converts a schema change notification to
+// the list of changes sent by StreamSchemaChanges.
+func schemaTableChanges(tables map[string]*schema.Table, created, altered, dropped []string) []*querypb.SchemaTableChange {
+	var changes []*querypb.SchemaTableChange
+	add := func(names []string, changeType querypb.SchemaTableChange_ChangeType) {
+		names = append([]string(nil), names...)
+		sort.Strings(names)
+		for _, name := range names {
+			change := &querypb.SchemaTableChange{
+				TableName:  name,
+				ChangeType: changeType,
+			}
+			if table := tables[name]; table != nil && changeType != querypb.SchemaTableChange_DROPPED {
+				change.Fields = table.Fields
+				for _, index := range table.PKColumns {
+					change.PkColumns = append(change.PkColumns, table.Fields[index].Name)
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+	add(created, querypb.SchemaTableChange_ADDED)
+	add(altered, querypb.SchemaTableChange_CHANGED)
+	add(dropped, querypb.SchemaTableChange_DROPPED)
+	return changes
+}
+
+// GetSchemaVersion returns the version of a table at the given unix
+// time, or its latest version if time is 0. The versions are recorded
+// by the master if -track_schema_versions is set.
+func (tsv *TabletServer) GetSchemaVersion(ctx context.Context, target *querypb.Target, tableName string, time int64) (version *querypb.SchemaVersion, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"GetSchemaVersion", "", nil,
+		target, nil, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			version, err = tsv.historian.SchemaVersion(tableName, time)
+			return err
+		},
+	)
+	return version, err
+}
+
 // execRequest performs verifications, sets up the necessary environments
 // and calls the supplied function for executing the request.
 func (tsv *TabletServer) execRequest(
@@ -1703,6 +2008,68 @@ func (tsv *TabletServer) BroadcastHealth(terTimestamp int64, stats *querypb.Real
 	tsv.lastStreamHealthExpiration = time.Now().Add(maxCache)
 }
 
+// checkReplicationLag rejects lag sensitive reads on replicas whose
+// replication lag is above the configured maximum. The error code
+// allows vtgate to retry the read on another tablet.
+func (tsv *TabletServer) checkReplicationLag(ctx context.Context, target *querypb.Target, plan *TabletPlan) error {
+	if tsv.lagSensitiveMaxLag == 0 || target.GetTabletType() == topodatapb.TabletType_MASTER {
+		return nil
+	}
+	if plan.PlanID != planbuilder.PlanSelect && plan.PlanID != planbuilder.PlanSelectStream {
+		return nil
+	}
+	switch plan.LagSensitivity {
+	case planbuilder.LagTolerant:
+		return nil
+	case planbuilder.LagSensitivityUnspecified:
+		if !tsv.lagSensitiveCallers[callerid.EffectiveCallerIDFromContext(ctx).GetPrincipal()] {
+			return nil
+		}
+	}
+
+	tsv.streamHealthMutex.Lock()
+	shr := tsv.lastStreamHealthResponse
+	tsv.streamHealthMutex.Unlock()
+	if shr == nil || shr.RealtimeStats == nil {
+		return nil
+	}
+	lag := time.Duration(shr.RealtimeStats.SecondsBehindMaster) * time.Second
+	if lag <= tsv.lagSensitiveMaxLag {
+		return nil
+	}
+	tsv.lagSensitiveRejections.Add(1)
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "replication lag %v exceeds %v for lag sensitive read", lag, tsv.lagSensitiveMaxLag)
+}
+
+// checkReadAfterWrite rejects reads on replicas which had not executed
+// the GTIDs required by the read-after-write session of the caller as
+// of the last health check. The error code allows vtgate to retry the
+// read on another tablet, or to send it to the master.
+func (tsv *TabletServer) checkReadAfterWrite(target *querypb.Target, options *querypb.ExecuteOptions) error {
+	gtids := options.GetReadAfterWriteGtid()
+	if gtids == "" || target.GetTabletType() == topodatapb.TabletType_MASTER {
+		return nil
+	}
+
+	tsv.streamHealthMutex.Lock()
+	shr := tsv.lastStreamHealthResponse
+	tsv.streamHealthMutex.Unlock()
+	if shr != nil && shr.RealtimeStats != nil && shr.RealtimeStats.HealthError == "" {
+		pos, err := mysql.DecodePosition(shr.RealtimeStats.ReplicationPosition)
+		if err != nil {
+			return err
+		}
+		want, err := mysql.ParseMysql56GTIDSet(gtids)
+		if err != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid read after write GTIDs %q: %v", gtids, err)
+		}
+		if !pos.IsZero() && pos.GTIDSet.Contains(want) {
+			return nil
+		}
+	}
+	return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "replica has not caught up with the GTIDs of the session")
+}
+
 // HeartbeatLag returns the current lag as calculated by the heartbeat
 // package, if heartbeat is enabled. Otherwise returns 0.
 func (tsv *TabletServer) HeartbeatLag() (time.Duration, error) {
@@ -1850,6 +2217,12 @@ func (tsv *TabletServer) SetTxPoolSize(val int) {
 	tsv.te.txPool.conns.SetCapacity(val)
 }
 
+// ResizeTxPool changes the tx pool size at runtime.
+// Shrinking the pool waits for in-flight transactions to finish.
+func (tsv *TabletServer) ResizeTxPool(ctx context.Context, size int) error {
+	return tsv.te.txPool.Resize(ctx, size)
+}
+
 // TxPoolSize returns the tx pool size.
 func (tsv *TabletServer) TxPoolSize() int {
 	return int(tsv.te.txPool.conns.Capacity())
@@ -1866,6 +2239,17 @@ func (tsv *TabletServer) TxTimeout() time.Duration {
 	return tsv.te.txPool.Timeout()
 }
 
+// SetTxIdleTimeout changes the transaction idle timeout to the specified value.
+// This function should only be used for testing.
+func (tsv *TabletServer) SetTxIdleTimeout(val time.Duration) {
+	tsv.te.txPool.SetIdleTimeout(val)
+}
+
+// TxIdleTimeout returns the transaction idle timeout.
+func (tsv *TabletServer) TxIdleTimeout() time.Duration {
+	return tsv.te.txPool.IdleTimeout()
+}
+
 // SetTxPoolTimeout changes the transaction pool timeout to the specified value.
 // This function should only be used for testing.
 func (tsv *TabletServer) SetTxPoolTimeout(val time.Duration) {
@@ -1921,6 +2305,18 @@ func (tsv *TabletServer) MaxDMLRows() int {
 	return int(tsv.qe.maxDMLRows.Get())
 }
 
+// SetDeadlockRetryCount changes the number of times an autocommit DML
+// is retried after a deadlock.
+// This function should only be used for testing.
+func (tsv *TabletServer) SetDeadlockRetryCount(val int) {
+	tsv.qe.deadlockRetryCount.Set(int64(val))
+}
+
+// DeadlockRetryCount returns the deadlock retry count.
+func (tsv *TabletServer) DeadlockRetryCount() int {
+	return int(tsv.qe.deadlockRetryCount.Get())
+}
+
 // SetPassthroughDMLs changes the setting to pass through all DMLs
 // It should only be used for testing
 func (tsv *TabletServer) SetPassthroughDMLs(val bool) {
@@ -2012,6 +2408,16 @@ func withTimeout(ctx context.Context, timeout time.Duration, options *querypb.Ex
 	return context.WithTimeout(ctx, timeout)
 }
 
+// withPlanTimeout applies the timeout requested by the QUERY_TIMEOUT_MS
+// directive of the plan, if any. The directive can only shorten the
+// deadline set by the caller or by the query timeout of the tablet.
+func withPlanTimeout(ctx context.Context, plan *TabletPlan) (context.Context, context.CancelFunc) {
+	if plan.QueryTimeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, plan.QueryTimeout)
+}
+
 // skipQueryPlanCache returns true if the query plan should be cached
 func skipQueryPlanCache(options *querypb.ExecuteOptions) bool {
 	if options == nil {
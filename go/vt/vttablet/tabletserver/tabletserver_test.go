@@ -30,21 +30,25 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -876,7 +880,7 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, transactionID, nil); err != nil {
 		t.Fatalf("failed to execute query: %s: %s", executeSQL, err)
 	}
-	if err := tsv.Commit(ctx, &target, transactionID); err != nil {
+	if _, err := tsv.Commit(ctx, &target, transactionID); err != nil {
 		t.Fatalf("call TabletServer.Commit failed: %v", err)
 	}
 }
@@ -894,7 +898,7 @@ func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	}
 	defer tsv.StopService()
 	ctx := context.Background()
-	err = tsv.Commit(ctx, &target, -1)
+	_, err = tsv.Commit(ctx, &target, -1)
 	want := "transaction -1: not found"
 	if err == nil || err.Error() != want {
 		t.Fatalf("Commit err: %v, want %v", err, want)
@@ -1345,7 +1349,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1365,7 +1369,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		// open a second connection while the request of the first connection is
 		// still pending.
 		<-tx3Finished
-		if err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1380,7 +1384,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 		close(tx3Finished)
@@ -1416,10 +1420,190 @@ func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	ctx := context.Background()
 	_, txid, err := tsv.BeginExecute(ctx, &target, q, nil, nil)
 	require.NoError(t, err)
-	err = tsv.Commit(ctx, &target, txid)
+	_, err = tsv.Commit(ctx, &target, txid)
 	require.NoError(t, err)
 }
 
+func TestComputeTxSerializerKeyColumns(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	config := tabletenv.DefaultQsConfig
+	config.EnableHotRowProtection = true
+	config.HotRowProtectionKeyColumns = []string{"test_table.name"}
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	dbcfgs := newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "TestComputeTxSerializerKeyColumns")
+
+	testcases := []struct {
+		sql     string
+		bv      map[string]*querypb.BindVariable
+		wantKey string
+	}{{
+		sql:     "update test_table set name_string = 'a' where pk = :pk and name = :name",
+		bv:      map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1), "name": sqltypes.Int64BindVariable(2)},
+		wantKey: "test_table where name = 2",
+	}, {
+		sql:     "delete from test_table where 'x' = name_string and 3 = name",
+		wantKey: "test_table where name = 3",
+	}, {
+		// Falls back to the whole WHERE clause without the key column.
+		sql:     "update test_table set name_string = 'a' where pk = 1",
+		wantKey: "test_table where pk = 1",
+	}, {
+		sql:     "update test_table set name_string = 'a' where name > 1",
+		wantKey: "test_table where name > 1",
+	}}
+	for _, tc := range testcases {
+		key, table := tsv.computeTxSerializerKey(ctx, logStats, tc.sql, tc.bv)
+		if key != tc.wantKey || table != "test_table" {
+			t.Errorf("computeTxSerializerKey(%v): %v, %v, want %v, test_table", tc.sql, key, table, tc.wantKey)
+		}
+	}
+}
+
+func TestCheckReplicationLag(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	config := tabletenv.DefaultQsConfig
+	config.LagSensitiveMaxReplicationLag = 10 * time.Second
+	config.LagSensitiveCallers = []string{"sensitive"}
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	dbcfgs := newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{SecondsBehindMaster: 30}, time.Minute)
+
+	ctx := context.Background()
+	sensitiveCtx := callerid.NewContext(ctx, &vtrpcpb.CallerID{Principal: "sensitive"}, nil)
+	replica := &querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	master := &querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	testcases := []struct {
+		ctx     context.Context
+		target  *querypb.Target
+		sql     string
+		wantErr bool
+	}{{
+		ctx:    ctx,
+		target: replica,
+		sql:    "select * from test_table",
+	}, {
+		ctx:     ctx,
+		target:  replica,
+		sql:     "select /*vt+ LAG_SENSITIVE=1 */ * from test_table",
+		wantErr: true,
+	}, {
+		ctx:     sensitiveCtx,
+		target:  replica,
+		sql:     "select * from test_table",
+		wantErr: true,
+	}, {
+		ctx:    sensitiveCtx,
+		target: replica,
+		sql:    "select /*vt+ LAG_SENSITIVE=0 */ * from test_table",
+	}, {
+		ctx:    ctx,
+		target: master,
+		sql:    "select /*vt+ LAG_SENSITIVE=1 */ * from test_table",
+	}}
+	for _, tc := range testcases {
+		logStats := tabletenv.NewLogStats(tc.ctx, "TestCheckReplicationLag")
+		plan, err := tsv.qe.GetPlan(tc.ctx, logStats, tc.sql, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tsv.checkReplicationLag(tc.ctx, tc.target, plan)
+		if tc.wantErr {
+			if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+				t.Errorf("checkReplicationLag(%v, %v): %v, want %v", tc.target.TabletType, tc.sql, err, vtrpcpb.Code_FAILED_PRECONDITION)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("checkReplicationLag(%v, %v): %v, want nil", tc.target.TabletType, tc.sql, err)
+		}
+	}
+	if got, want := tsv.lagSensitiveRejections.Get(), int64(2); got != want {
+		t.Errorf("LagSensitiveRejections: %v, want %v", got, want)
+	}
+}
+
+func TestCheckReadAfterWrite(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	config := tabletenv.DefaultQsConfig
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	dbcfgs := newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	replica := &querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	master := &querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	src := "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+	// Without a replication position, replicas can't serve the reads.
+	options := &querypb.ExecuteOptions{ReadAfterWriteGtid: src + ":3"}
+	if err := tsv.checkReadAfterWrite(replica, options); vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("checkReadAfterWrite without a replication position: %v, want %v", err, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{ReplicationPosition: "MySQL56/" + src + ":1-5"}, time.Minute)
+	testcases := []struct {
+		target  *querypb.Target
+		gtid    string
+		wantErr bool
+	}{{
+		target: replica,
+	}, {
+		target: replica,
+		gtid:   src + ":2:4",
+	}, {
+		target: replica,
+		gtid:   src + ":1-5",
+	}, {
+		target:  replica,
+		gtid:    src + ":5-6",
+		wantErr: true,
+	}, {
+		target:  replica,
+		gtid:    "ad7d9f55-71ca-11e1-9e33-c80aa9429562:1",
+		wantErr: true,
+	}, {
+		target: master,
+		gtid:   src + ":9",
+	}}
+	for _, tc := range testcases {
+		options := &querypb.ExecuteOptions{ReadAfterWriteGtid: tc.gtid}
+		err := tsv.checkReadAfterWrite(tc.target, options)
+		if tc.wantErr {
+			if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+				t.Errorf("checkReadAfterWrite(%v, %v): %v, want %v", tc.target.TabletType, tc.gtid, err, vtrpcpb.Code_FAILED_PRECONDITION)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("checkReadAfterWrite(%v, %v): %v, want nil", tc.target.TabletType, tc.gtid, err)
+		}
+	}
+
+	// Unhealthy replicas can't serve the reads.
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{HealthError: "replication stopped", ReplicationPosition: "MySQL56/" + src + ":1-5"}, time.Minute)
+	if err := tsv.checkReadAfterWrite(replica, options); vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("checkReadAfterWrite on an unhealthy replica: %v, want %v", err, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+}
+
 // TestSerializeTransactionsSameRow_ExecuteBatchAsTransaction tests the same as
 // TestSerializeTransactionsSameRow but for the ExecuteBatch method with
 // asTransaction=true (i.e. vttablet wraps the query in a BEGIN/Query/COMMIT
@@ -1609,7 +1793,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1628,7 +1812,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q2, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1647,7 +1831,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1746,7 +1930,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1932,7 +2116,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1969,7 +2153,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1997,7 +2181,7 @@ func TestMessageStream(t *testing.T) {
 	ctx := context.Background()
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
 
-	err := tsv.MessageStream(ctx, &target, "nomsg", func(qr *sqltypes.Result) error {
+	err := tsv.MessageStream(ctx, &target, "nomsg", "", func(qr *sqltypes.Result) error {
 		return nil
 	})
 	wantErr := "table nomsg not found in schema"
@@ -2007,7 +2191,7 @@ func TestMessageStream(t *testing.T) {
 
 	// Check that the streaming mechanism works.
 	called := false
-	err = tsv.MessageStream(ctx, &target, "msg", func(qr *sqltypes.Result) error {
+	err = tsv.MessageStream(ctx, &target, "msg", "", func(qr *sqltypes.Result) error {
 		called = true
 		return io.EOF
 	})
@@ -2033,20 +2217,20 @@ func TestMessageAck(t *testing.T) {
 		Type:  sqltypes.VarChar,
 		Value: []byte("2"),
 	}}
-	_, err := tsv.MessageAck(ctx, &target, "nonmsg", ids)
+	_, err := tsv.MessageAck(ctx, &target, "nonmsg", ids, nil)
 	want := "message table nonmsg not found in schema"
 	if err == nil || strings.HasPrefix(err.Error(), want) {
 		t.Errorf("tsv.MessageAck(invalid): %v, want %s", err, want)
 	}
 
-	_, err = tsv.MessageAck(ctx, &target, "msg", ids)
+	_, err = tsv.MessageAck(ctx, &target, "msg", ids, nil)
 	want = "query: 'update msg set time_acked"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("tsv.MessageAck(invalid):\n%v, want\n%s", err, want)
 	}
 
 	db.AddQueryPattern("update msg set time_acked = .*", &sqltypes.Result{RowsAffected: 1})
-	count, err := tsv.MessageAck(ctx, &target, "msg", ids)
+	count, err := tsv.MessageAck(ctx, &target, "msg", ids, nil)
 	require.NoError(t, err)
 	if count != 1 {
 		t.Errorf("count: %d, want 1", count)
@@ -2060,25 +2244,50 @@ func TestRescheduleMessages(t *testing.T) {
 	ctx := context.Background()
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
 
-	_, err := tsv.PostponeMessages(ctx, &target, "nonmsg", []string{"1", "2"})
+	_, err := tsv.PostponeMessages(ctx, &target, "nonmsg", []string{"1", "2"}, nil)
 	want := "message table nonmsg not found in schema"
 	if err == nil || strings.HasPrefix(err.Error(), want) {
 		t.Errorf("tsv.PostponeMessages(invalid): %v, want %s", err, want)
 	}
 
-	_, err = tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"})
+	_, err = tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"}, nil)
 	want = "query: 'update msg set time_next"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("tsv.PostponeMessages(invalid):\n%v, want\n%s", err, want)
 	}
 	db.AddQueryPattern("update msg set time_next = .*", &sqltypes.Result{RowsAffected: 1})
-	count, err := tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"})
+	count, err := tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"}, nil)
 	require.NoError(t, err)
 	if count != 1 {
 		t.Errorf("count: %d, want 1", count)
 	}
 }
 
+func TestMessagePostpone(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	ids := []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}, {
+		Type:  sqltypes.VarChar,
+		Value: []byte("2"),
+	}}
+	updates := map[string]*querypb.Value{
+		"message": {Type: sqltypes.VarChar, Value: []byte("retried")},
+	}
+	db.AddQueryPattern("update msg set time_next = .*, message = 'retried' where id in \\('1', '2'\\) and .*", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.MessagePostpone(ctx, &target, "msg", ids, updates)
+	require.NoError(t, err)
+	if count != 2 {
+		t.Errorf("count: %d, want 2", count)
+	}
+}
+
 func TestPurgeMessages(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -2106,6 +2315,184 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", []string{"1"})
+	want := "message table nonmsg not found in schema"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.DeadLetterMessages(invalid): %v, want %s", err, want)
+	}
+
+	db.AddQuery("update msg set time_next = null where id in ('1') and time_acked is null and time_next is not null limit 10001", &sqltypes.Result{RowsAffected: 1})
+	count, err := tsv.DeadLetterMessages(ctx, &target, "msg", []string{"1"})
+	require.NoError(t, err)
+	if count != 1 {
+		t.Errorf("count: %d, want 1", count)
+	}
+}
+
+func TestReadMessageBacklog(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.ReadMessageBacklog(ctx, &target, "nonmsg")
+	want := "message table nonmsg not found in schema"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.ReadMessageBacklog(invalid): %v, want %s", err, want)
+	}
+
+	backlog := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(time_next)|null|count(*) - count(time_next)", "int64|null_type|int64"),
+		"5|null|2",
+	)
+	db.AddQuery("select count(time_next), null, count(*) - count(time_next) from msg where 1 != 1", &sqltypes.Result{Fields: backlog.Fields})
+	db.AddQuery("select count(time_next), null, count(*) - count(time_next) from msg where time_acked is null limit 10001", backlog)
+	got, err := tsv.ReadMessageBacklog(ctx, &target, "msg")
+	require.NoError(t, err)
+	if !reflect.DeepEqual(got.Rows, backlog.Rows) {
+		t.Errorf("tsv.ReadMessageBacklog: %v, want %v", got.Rows, backlog.Rows)
+	}
+}
+
+func TestStreamSchemaChanges(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	db.AddQueryPattern("show create table .*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"),
+		"test_table|create table test_table (pk int primary key)",
+	))
+	ctx, cancel := context.WithCancel(context.Background())
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	ch := make(chan *querypb.StreamSchemaChangesResponse, 1)
+	done := make(chan error)
+	go func() {
+		done <- tsv.StreamSchemaChanges(ctx, &target, func(response *querypb.StreamSchemaChangesResponse) error {
+			ch <- response
+			return nil
+		})
+	}()
+
+	response := <-ch
+	var got *querypb.SchemaTableChange
+	for _, change := range response.Changes {
+		if change.ChangeType != querypb.SchemaTableChange_ADDED {
+			t.Errorf("change type of %s: %v, want ADDED", change.TableName, change.ChangeType)
+		}
+		if change.TableName == "test_table" {
+			got = change
+		}
+	}
+	if got == nil {
+		t.Fatalf("test_table not in first response: %v", response)
+	}
+	if len(got.Fields) != 3 || !reflect.DeepEqual(got.PkColumns, []string{"pk"}) {
+		t.Errorf("test_table change: %v, want 3 fields and pk column pk", got)
+	}
+	assert.Equal(t, "create table test_table (pk int primary key)", got.CreateStatement)
+
+	// The stream ends with an error when its context is done.
+	cancel()
+	if code := vterrors.Code(<-done); code != vtrpcpb.Code_CANCELED {
+		t.Errorf("StreamSchemaChanges after cancel: %v, want %v", code, vtrpcpb.Code_CANCELED)
+	}
+
+	// It also ends when the schema engine closes.
+	go func() {
+		done <- tsv.StreamSchemaChanges(context.Background(), &target, func(response *querypb.StreamSchemaChangesResponse) error {
+			ch <- response
+			return nil
+		})
+	}()
+	<-ch
+	tsv.se.Close()
+	if code := vterrors.Code(<-done); code != vtrpcpb.Code_UNAVAILABLE {
+		t.Errorf("StreamSchemaChanges after close: %v, want %v", code, vtrpcpb.Code_UNAVAILABLE)
+	}
+}
+
+func TestStreamSchemaChangesACL(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	db.AddQueryPattern("show create table .*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"),
+		"t|create table t (id int)",
+	))
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+	}
+	if err := tableacl.InitFromProto(config); err != nil {
+		t.Fatalf("unable to load tableacl config, error: %v", err)
+	}
+	defer tableacl.InitFromProto(&tableaclpb.Config{})
+	tsv.qe.strictTableACL = true
+	defer func() { tsv.qe.strictTableACL = false }()
+
+	ctx, cancel := context.WithCancel(callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"}))
+	defer cancel()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	ch := make(chan *querypb.StreamSchemaChangesResponse, 1)
+	go tsv.StreamSchemaChanges(ctx, &target, func(response *querypb.StreamSchemaChangesResponse) error {
+		ch <- response
+		return nil
+	})
+	response := <-ch
+	var names []string
+	for _, change := range response.Changes {
+		names = append(names, change.TableName)
+	}
+	assert.Equal(t, []string{"test_table"}, names)
+}
+
+func TestSchemaTableChanges(t *testing.T) {
+	fields := []*querypb.Field{{
+		Name: "id",
+		Type: sqltypes.Int64,
+	}, {
+		Name: "val",
+		Type: sqltypes.VarChar,
+	}}
+	tables := map[string]*schema.Table{
+		"t1": {Name: sqlparser.NewTableIdent("t1"), Fields: fields, PKColumns: []int{0}},
+		"t2": {Name: sqlparser.NewTableIdent("t2"), Fields: fields, PKColumns: []int{1, 0}},
+	}
+	got := schemaTableChanges(tables, []string{"t2"}, []string{"t1"}, []string{"t3"})
+	want := []*querypb.SchemaTableChange{{
+		TableName:  "t2",
+		ChangeType: querypb.SchemaTableChange_ADDED,
+		Fields:     fields,
+		PkColumns:  []string{"val", "id"},
+	}, {
+		TableName:  "t1",
+		ChangeType: querypb.SchemaTableChange_CHANGED,
+		Fields:     fields,
+		PkColumns:  []string{"id"},
+	}, {
+		TableName:  "t3",
+		ChangeType: querypb.SchemaTableChange_DROPPED,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("schemaTableChanges:\n%v, want\n%v", got, want)
+	}
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	ctx := context.Background()
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
@@ -2558,6 +2945,15 @@ func getSupportedQueries() map[string]*sqltypes.Result {
 				mysql.ShowPrimaryRow("msg", "id"),
 			},
 		},
+		mysql.BaseShowForeignKeys: {
+			Fields: mysql.ShowForeignKeysFields,
+		},
+		mysql.BaseShowGeneratedColumns: {
+			Fields: mysql.ShowGeneratedColumnsFields,
+		},
+		mysql.BaseShowPartitions: {
+			Fields: mysql.ShowPartitionsFields,
+		},
 		"select * from test_table where 1 != 1": {
 			Fields: []*querypb.Field{{
 				Name: "pk",
@@ -106,6 +106,7 @@ type TwoPC struct {
 	deleteRedoStmt      *sqlparser.ParsedQuery
 	readAllRedo         string
 	countUnresolvedRedo *sqlparser.ParsedQuery
+	readOldestRedo      string
 
 	insertTransaction   *sqlparser.ParsedQuery
 	insertParticipants  *sqlparser.ParsedQuery
@@ -168,6 +169,7 @@ func (tpc *TwoPC) Init(sidecarDBName string, dbaparams dbconfigs.Connector) erro
 	tpc.countUnresolvedRedo = sqlparser.BuildParsedQuery(
 		"select count(*) from %s.redo_state where time_created < %a",
 		dbname, ":time_created")
+	tpc.readOldestRedo = fmt.Sprintf("select min(time_created) from %s.redo_state", dbname)
 
 	tpc.insertTransaction = sqlparser.BuildParsedQuery(
 		"insert into %s.dt_state(dtid, state, time_created) values (%a, %a, %a)",
@@ -334,6 +336,30 @@ func (tpc *TwoPC) CountUnresolvedRedo(ctx context.Context, unresolvedTime time.T
 	return v, nil
 }
 
+// ReadOldestRedo returns the creation time of the oldest prepared
+// transaction that is still unresolved. It returns the zero time if
+// there are none.
+func (tpc *TwoPC) ReadOldestRedo(ctx context.Context) (time.Time, error) {
+	conn, err := tpc.readPool.Get(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer conn.Recycle()
+
+	qr, err := conn.Exec(ctx, tpc.readOldestRedo, 1, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(qr.Rows) < 1 || qr.Rows[0][0].IsNull() {
+		return time.Time{}, nil
+	}
+	v, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, v), nil
+}
+
 // CreateTransaction saves the metadata of a 2pc transaction as Prepared.
 func (tpc *TwoPC) CreateTransaction(ctx context.Context, conn *TxConnection, dtid string, participants []*querypb.Target) error {
 	bindVars := map[string]*querypb.BindVariable{
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// twoPCErrorTypes are the InternalErrors counters watched by the TwoPCWatcher.
+var twoPCErrorTypes = []string{"TwopcCommit", "TwopcResurrection"}
+
+// TwoPCWatcher tracks the health of 2PC on this tablet. It is driven
+// by the TxEngine watchdog. On every run, it measures the age of the
+// oldest unresolved prepare and looks for new TwopcCommit or
+// TwopcResurrection errors. If any of these breach their threshold,
+// it posts a TwoPCAlert to the configured webhook.
+type TwoPCWatcher struct {
+	twoPC         *TwoPC
+	webhook       string
+	maxPrepareAge time.Duration
+	client        *http.Client
+
+	oldestPrepareAge sync2.AtomicDuration
+
+	mu          sync.Mutex
+	ageBreached bool
+	errorCounts map[string]int64
+}
+
+// TwoPCAlert is the payload posted to the 2PC alert webhook.
+type TwoPCAlert struct {
+	Host                    string   `json:"host"`
+	Reasons                 []string `json:"reasons"`
+	UnresolvedPrepares      int64    `json:"unresolved_prepares"`
+	OldestPrepareAgeSecs    float64  `json:"oldest_prepare_age_seconds"`
+	TwopcCommitErrors       int64    `json:"twopc_commit_errors"`
+	TwopcResurrectionErrors int64    `json:"twopc_resurrection_errors"`
+}
+
+// NewTwoPCWatcher creates a TwoPCWatcher. Errors that were
+// counted before it was created do not raise alerts.
+func NewTwoPCWatcher(env tabletenv.Env, twoPC *TwoPC, abandonAge time.Duration) *TwoPCWatcher {
+	config := env.Config()
+	w := &TwoPCWatcher{
+		twoPC:         twoPC,
+		webhook:       config.TwoPCAlertWebhook,
+		maxPrepareAge: time.Duration(config.TwoPCAlertPrepareAge * 1e9),
+		client:        &http.Client{Timeout: 10 * time.Second},
+		errorCounts:   make(map[string]int64),
+	}
+	if w.maxPrepareAge == 0 {
+		w.maxPrepareAge = abandonAge * 5
+	}
+	counts := tabletenv.InternalErrors.Counts()
+	for _, name := range twoPCErrorTypes {
+		w.errorCounts[name] = counts[name]
+	}
+	env.Exporter().NewGaugeDurationFunc("TwopcOldestUnresolvedPrepare", "Age of the oldest unresolved prepared transaction", w.oldestPrepareAge.Get)
+	return w
+}
+
+// OldestPrepareAge returns the age of the oldest unresolved
+// prepare as of the last check.
+func (w *TwoPCWatcher) OldestPrepareAge() time.Duration {
+	return w.oldestPrepareAge.Get()
+}
+
+// Check updates the oldest prepare age, and raises an alert if a
+// threshold was breached since the last check. unresolved is the
+// current number of unresolved prepares, and is only reported.
+func (w *TwoPCWatcher) Check(ctx context.Context, unresolved int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var reasons []string
+	oldest, err := w.twoPC.ReadOldestRedo(ctx)
+	if err != nil {
+		tabletenv.InternalErrors.Add("WatchdogFail", 1)
+		log.Errorf("Error reading oldest unresolved prepare: %v", err)
+	} else {
+		var age time.Duration
+		if !oldest.IsZero() {
+			age = time.Since(oldest)
+		}
+		w.oldestPrepareAge.Set(age)
+
+		// Only alert when the threshold is first crossed.
+		breached := age > w.maxPrepareAge
+		if breached && !w.ageBreached {
+			reasons = append(reasons, fmt.Sprintf("oldest unresolved prepare is %v old, threshold is %v", age.Round(time.Second), w.maxPrepareAge))
+		}
+		w.ageBreached = breached
+	}
+
+	counts := tabletenv.InternalErrors.Counts()
+	for _, name := range twoPCErrorTypes {
+		if diff := counts[name] - w.errorCounts[name]; diff > 0 {
+			reasons = append(reasons, fmt.Sprintf("%d new %s errors", diff, name))
+		}
+		w.errorCounts[name] = counts[name]
+	}
+	if len(reasons) == 0 {
+		return
+	}
+
+	log.Warningf("2pc watcher alert: %v", reasons)
+	if w.webhook == "" {
+		return
+	}
+	host, _ := os.Hostname()
+	alert := &TwoPCAlert{
+		Host:                    host,
+		Reasons:                 reasons,
+		UnresolvedPrepares:      unresolved,
+		OldestPrepareAgeSecs:    w.oldestPrepareAge.Get().Seconds(),
+		TwopcCommitErrors:       counts["TwopcCommit"],
+		TwopcResurrectionErrors: counts["TwopcResurrection"],
+	}
+	if err := w.post(ctx, alert); err != nil {
+		tabletenv.InternalErrors.Add("WatchdogFail", 1)
+		log.Errorf("Error calling 2pc alert webhook %v: %v", w.webhook, err)
+	}
+}
+
+func (w *TwoPCWatcher) post(ctx context.Context, alert *TwoPCAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", w.webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status: %v", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestTwoPCWatcher(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	w := tsv.te.watcher
+	ctx := context.Background()
+
+	var alerts []*TwoPCAlert
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		alert := &TwoPCAlert{}
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil {
+			t.Errorf("Decode: %v", err)
+		}
+		alerts = append(alerts, alert)
+	}))
+	defer server.Close()
+	w.webhook = server.URL
+	w.maxPrepareAge = time.Minute
+
+	// No unresolved prepares.
+	db.AddQuery(w.twoPC.readOldestRedo, sqltypes.MakeTestResult(sqltypes.MakeTestFields("min(time_created)", "int64"), "null"))
+	w.Check(ctx, 0)
+	if got := w.OldestPrepareAge(); got != 0 {
+		t.Errorf("OldestPrepareAge: %v, want 0", got)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("alerts: %v, want none", alerts)
+	}
+
+	// A prepare older than the threshold raises a single alert.
+	created := time.Now().Add(-2 * time.Minute).UnixNano()
+	db.AddQuery(w.twoPC.readOldestRedo, sqltypes.MakeTestResult(sqltypes.MakeTestFields("min(time_created)", "int64"), sqltypes.NewInt64(created).ToString()))
+	w.Check(ctx, 1)
+	w.Check(ctx, 1)
+	if got := w.OldestPrepareAge(); got < 2*time.Minute {
+		t.Errorf("OldestPrepareAge: %v, want >= 2m", got)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("alerts: %d, want 1", len(alerts))
+	}
+	if got := alerts[0]; got.UnresolvedPrepares != 1 || !strings.Contains(got.Reasons[0], "oldest unresolved prepare") {
+		t.Errorf("alert: %+v, want oldest unresolved prepare alert", got)
+	}
+
+	// New commit errors raise another alert.
+	tabletenv.InternalErrors.Add("TwopcCommit", 1)
+	w.Check(ctx, 1)
+	if len(alerts) != 2 {
+		t.Fatalf("alerts: %d, want 2", len(alerts))
+	}
+	if got, want := alerts[1].Reasons, []string{"1 new TwopcCommit errors"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("alert reasons: %v, want %v", got, want)
+	}
+}
@@ -93,6 +93,7 @@ type TxEngine struct {
 	txPool       *TxPool
 	preparedPool *TxPreparedPool
 	twoPC        *TwoPC
+	watcher      *TwoPCWatcher
 }
 
 // NewTxEngine creates a new TxEngine.
@@ -127,6 +128,7 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 	te.preparedPool = NewTxPreparedPool(config.TransactionCap - 2)
 	readPool := connpool.New(env, "TxReadPool", 3, 0, time.Duration(config.IdleTimeout*1e9))
 	te.twoPC = NewTwoPC(readPool)
+	te.watcher = NewTwoPCWatcher(env, te.twoPC, te.abandonAge)
 	te.transitionSignal = make(chan struct{})
 	// By immediately closing this channel, all state changes can simply be made blocking by issuing the
 	// state change desired, and then selecting on this channel. It will contain an open channel while
@@ -273,7 +275,7 @@ func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions)
 }
 
 // Commit commits the specified transaction.
-func (te *TxEngine) Commit(ctx context.Context, transactionID int64) (string, error) {
+func (te *TxEngine) Commit(ctx context.Context, transactionID int64) (string, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Commit")
 	defer span.Finish()
 	return te.txPool.Commit(ctx, transactionID)
@@ -522,6 +524,7 @@ func (te *TxEngine) startWatchdog() {
 			log.Errorf("Error reading unresolved prepares: '%v': %v", te.coordinatorAddress, err)
 		}
 		tabletenv.Unresolved.Set("Prepares", count)
+		te.watcher.Check(ctx, count)
 
 		// Resolve lingering distributed transactions.
 		txs, err := te.twoPC.ReadAbandoned(ctx, time.Now().Add(-te.abandonAge))
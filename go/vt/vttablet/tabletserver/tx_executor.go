@@ -77,7 +77,7 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 		return err
 	}
 
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, localConn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, localConn)
 	if err != nil {
 		return err
 	}
@@ -109,7 +109,7 @@ func (txe *TxExecutor) CommitPrepared(dtid string) error {
 		txe.markFailed(ctx, dtid)
 		return err
 	}
-	_, err = txe.te.txPool.LocalCommit(ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(ctx, conn)
 	if err != nil {
 		txe.markFailed(ctx, dtid)
 		return err
@@ -140,7 +140,7 @@ func (txe *TxExecutor) markFailed(ctx context.Context, dtid string) {
 		return
 	}
 
-	if _, err = txe.te.txPool.LocalCommit(ctx, conn); err != nil {
+	if _, _, err = txe.te.txPool.LocalCommit(ctx, conn); err != nil {
 		log.Errorf("markFailed: Commit failed for dtid %s: %v", dtid, err)
 	}
 }
@@ -179,7 +179,7 @@ func (txe *TxExecutor) RollbackPrepared(dtid string, originalID int64) error {
 		goto returnConn
 	}
 
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
 
 returnConn:
 	if preparedConn := txe.te.preparedPool.FetchForRollback(dtid); preparedConn != nil {
@@ -208,7 +208,7 @@ func (txe *TxExecutor) CreateTransaction(dtid string, participants []*querypb.Ta
 	if err != nil {
 		return err
 	}
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
 	return err
 }
 
@@ -231,7 +231,7 @@ func (txe *TxExecutor) StartCommit(transactionID int64, dtid string) error {
 	if err != nil {
 		return err
 	}
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
 	return err
 }
 
@@ -259,7 +259,7 @@ func (txe *TxExecutor) SetRollback(dtid string, transactionID int64) error {
 		return err
 	}
 
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
 	if err != nil {
 		return err
 	}
@@ -285,7 +285,7 @@ func (txe *TxExecutor) ConcludeTransaction(dtid string) error {
 	if err != nil {
 		return err
 	}
-	_, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.LocalCommit(txe.ctx, conn)
 	return err
 }
 
@@ -37,6 +37,7 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txlimiter"
 
@@ -87,6 +88,7 @@ type TxPool struct {
 	activePool             *pools.Numbered
 	lastID                 sync2.AtomicInt64
 	transactionTimeout     sync2.AtomicDuration
+	transactionIdleTimeout sync2.AtomicDuration
 	transactionPoolTimeout sync2.AtomicDuration
 	ticks                  *timer.Timer
 	limiter                txlimiter.TxLimiter
@@ -104,6 +106,7 @@ type TxPool struct {
 func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 	config := env.Config()
 	transactionTimeout := time.Duration(config.TransactionTimeout * 1e9)
+	transactionIdleTimeout := time.Duration(config.TxIdleTimeout * 1e9)
 	axp := &TxPool{
 		env:                    env,
 		conns:                  connpool.New(env, "TransactionPool", config.TransactionCap, config.TxPoolPrefillParallelism, time.Duration(config.IdleTimeout*1e9)),
@@ -111,16 +114,19 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 		activePool:             pools.NewNumbered(),
 		lastID:                 sync2.NewAtomicInt64(time.Now().UnixNano()),
 		transactionTimeout:     sync2.NewAtomicDuration(transactionTimeout),
+		transactionIdleTimeout: sync2.NewAtomicDuration(transactionIdleTimeout),
 		transactionPoolTimeout: sync2.NewAtomicDuration(time.Duration(config.TxPoolTimeout * 1e9)),
 		waiterCap:              sync2.NewAtomicInt64(int64(config.TxPoolWaiterCap)),
 		waiters:                sync2.NewAtomicInt64(0),
-		ticks:                  timer.NewTimer(transactionTimeout / 10),
+		ticks:                  timer.NewTimer(killerInterval(transactionTimeout, transactionIdleTimeout)),
 		limiter:                limiter,
 		txStats:                env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
 	}
+	axp.conns.SetMaxCapacity(config.TransactionMaxCap)
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
 	env.Exporter().NewGaugeDurationFunc("TransactionTimeout", "Transaction timeout", axp.transactionTimeout.Get)
+	env.Exporter().NewGaugeDurationFunc("TransactionIdleTimeout", "Transaction idle timeout", axp.transactionIdleTimeout.Get)
 	env.Exporter().NewGaugeDurationFunc("TransactionPoolTimeout", "Timeout to get a connection from the transaction pool", axp.transactionPoolTimeout.Get)
 	env.Exporter().NewGaugeFunc("TransactionPoolWaiters", "Transaction pool waiters", axp.waiters.Get)
 	return axp
@@ -180,6 +186,26 @@ func (axp *TxPool) transactionKiller() {
 		conn.Close()
 		conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", axp.Timeout()))
 	}
+	idleTimeout := axp.IdleTimeout()
+	if idleTimeout == 0 {
+		return
+	}
+	for _, v := range axp.activePool.GetIdle(idleTimeout, "for tx killer idle rollback") {
+		conn := v.(*TxConnection)
+		log.Warningf("killing transaction (exceeded idle timeout: %v): %s", idleTimeout, conn.Format(nil))
+		axp.env.Stats().KillCounters.Add("IdleTransactions", 1)
+		conn.Close()
+		conn.conclude(TxKill, fmt.Sprintf("exceeded idle timeout: %v", idleTimeout))
+	}
+}
+
+// killerInterval returns how often the transaction killer must run
+// to enforce both the transaction timeout and the idle timeout.
+func killerInterval(timeout, idleTimeout time.Duration) time.Duration {
+	if idleTimeout != 0 && idleTimeout < timeout {
+		return idleTimeout / 10
+	}
+	return timeout / 10
 }
 
 // WaitForEmpty waits until all active transactions are completed.
@@ -282,13 +308,15 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 	return transactionID, beginQueries, nil
 }
 
-// Commit commits the specified transaction.
-func (axp *TxPool) Commit(ctx context.Context, transactionID int64) (string, error) {
+// Commit commits the specified transaction. It returns the commit
+// statement it executed, if any, and the GTIDs of the transaction, if
+// MySQL tracks them.
+func (axp *TxPool) Commit(ctx context.Context, transactionID int64) (string, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
 	defer span.Finish()
 	conn, err := axp.Get(transactionID, "for commit")
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	return axp.LocalCommit(ctx, conn)
 }
@@ -331,20 +359,24 @@ func (axp *TxPool) LocalBegin(ctx context.Context, options *querypb.ExecuteOptio
 }
 
 // LocalCommit is the commit function for LocalBegin.
-func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection) (string, error) {
+func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection) (commitSQL, gtids string, err error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalCommit")
 	defer span.Finish()
 	defer conn.conclude(TxCommit, "transaction committed")
 
 	if conn.Autocommit {
-		return "", nil
+		return "", "", nil
 	}
 
-	if _, err := conn.Exec(ctx, "commit", 1, false); err != nil {
+	qr, err := conn.Exec(ctx, "commit", 1, false)
+	if err != nil {
 		conn.Close()
-		return "", err
+		return "", "", err
+	}
+	for table, count := range conn.queuedMessages {
+		messager.MessageStats.Add([]string{table, "Queued"}, count)
 	}
-	return "commit", nil
+	return "commit", qr.SessionStateChanges, nil
 }
 
 // LocalConclude concludes a transaction started by LocalBegin.
@@ -394,7 +426,19 @@ func (axp *TxPool) Timeout() time.Duration {
 // SetTimeout sets the transaction timeout.
 func (axp *TxPool) SetTimeout(timeout time.Duration) {
 	axp.transactionTimeout.Set(timeout)
-	axp.ticks.SetInterval(timeout / 10)
+	axp.ticks.SetInterval(killerInterval(timeout, axp.IdleTimeout()))
+}
+
+// IdleTimeout returns the transaction idle timeout.
+func (axp *TxPool) IdleTimeout() time.Duration {
+	return axp.transactionIdleTimeout.Get()
+}
+
+// SetIdleTimeout sets the transaction idle timeout.
+// A value of 0 disables the idle timeout.
+func (axp *TxPool) SetIdleTimeout(timeout time.Duration) {
+	axp.transactionIdleTimeout.Set(timeout)
+	axp.ticks.SetInterval(killerInterval(axp.Timeout(), timeout))
 }
 
 // PoolTimeout returns the transaction pool timeout.
@@ -407,6 +451,36 @@ func (axp *TxPool) SetPoolTimeout(timeout time.Duration) {
 	axp.transactionPoolTimeout.Set(timeout)
 }
 
+// Resize changes the capacity of the transaction pool. Growing the pool
+// takes effect immediately. Shrinking it waits for in-flight transactions
+// to return their connections, so none of them get interrupted. If ctx
+// expires before the pool is drained, an error is returned and the
+// resize completes in the background.
+func (axp *TxPool) Resize(ctx context.Context, size int) error {
+	if size <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "transaction pool size must be > 0 (specified value: %v)", size)
+	}
+	maxCap := int(axp.conns.MaxCap())
+	if maxCap == 0 {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "transaction pool is not open")
+	}
+	if size > maxCap {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "transaction pool size %v exceeds the max cap of %v, see -queryserver-config-transaction-max-cap", size, maxCap)
+	}
+
+	log.Infof("Resizing transaction pool from %v to %v", axp.conns.Capacity(), size)
+	done := make(chan error, 1)
+	go func() {
+		done <- axp.conns.SetCapacity(size)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "transaction pool is still draining to size %v: %v", size, ctx.Err())
+	}
+}
+
 // TxConnection is meant for executing transactions. It can return itself to
 // the tx pool correctly. It also does not retry statements if there
 // are failures.
@@ -422,6 +496,10 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+
+	// queuedMessages counts the messages inserted by the transaction
+	// per message table. They're added to the stats on commit.
+	queuedMessages map[string]int64
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
@@ -496,6 +574,19 @@ func (txc *TxConnection) RecordQuery(query string) {
 	txc.Queries = append(txc.Queries, query)
 }
 
+// queueMessages records the messages inserted into a message table.
+// In autocommit mode, the insert is already committed.
+func (txc *TxConnection) queueMessages(table string, count int64) {
+	if txc.Autocommit {
+		messager.MessageStats.Add([]string{table, "Queued"}, count)
+		return
+	}
+	if txc.queuedMessages == nil {
+		txc.queuedMessages = make(map[string]int64)
+	}
+	txc.queuedMessages[table] += count
+}
+
 func (txc *TxConnection) conclude(conclusion, reason string) {
 	if txc.dbConn == nil {
 		return
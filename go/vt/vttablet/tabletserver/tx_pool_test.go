@@ -65,7 +65,7 @@ func TestTxPoolExecuteCommit(t *testing.T) {
 	_, _ = txConn.Exec(ctx, sql, 1, true)
 	txConn.Recycle()
 
-	commitSQL, err := txPool.Commit(ctx, transactionID)
+	commitSQL, _, err := txPool.Commit(ctx, transactionID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -210,6 +210,55 @@ func TestTxPoolTransactionKillerEnforceTimeoutEnabled(t *testing.T) {
 	}
 
 }
+func TestTxPoolTransactionKillerIdleTimeout(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.SetIdleTimeout(10 * time.Millisecond)
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	killCounts := txPool.env.Stats().KillCounters.Counts()
+
+	idleTx, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A transaction with a statement in flight is not idle,
+	// even if it runs for longer than the idle timeout.
+	busyTx, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	busyConn, err := txPool.Get(busyTx, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeoutCh := time.After(5 * time.Second)
+	for txPool.env.Stats().KillCounters.Counts()["IdleTransactions"]-killCounts["IdleTransactions"] < 1 {
+		select {
+		case <-timeoutCh:
+			t.Fatal("waited too long for idle transaction to be killed by transaction killer")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	busyConn.Recycle()
+
+	if _, err := txPool.Get(idleTx, "for query"); err == nil || !strings.Contains(err.Error(), "exceeded idle timeout") {
+		t.Errorf("Get(idleTx): %v, want exceeded idle timeout", err)
+	}
+	if got, want := txPool.env.Stats().KillCounters.Counts()["Transactions"], killCounts["Transactions"]; got != want {
+		t.Errorf("Transactions kills: %d, want %d", got, want)
+	}
+	if err := txPool.Rollback(ctx, busyTx); err != nil {
+		t.Error(err)
+	}
+}
+
 func addQuery(ctx context.Context, sql string, txPool *TxPool, workload querypb.ExecuteOptions_Workload) (int64, error) {
 	transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{Workload: workload})
 	if err != nil {
@@ -334,7 +383,7 @@ func TestTxPoolAutocommit(t *testing.T) {
 	if beginSQL != "" {
 		t.Errorf("beginSQL got %q want ''", beginSQL)
 	}
-	commitSQL, err := txPool.Commit(ctx, txid)
+	commitSQL, _, err := txPool.Commit(ctx, txid)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -563,7 +612,7 @@ func TestTxPoolGetConnRecentlyRemovedTransaction(t *testing.T) {
 	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
 
 	id, _, err = txPool.Begin(ctx, &querypb.ExecuteOptions{})
-	if _, err := txPool.Commit(ctx, id); err != nil {
+	if _, _, err := txPool.Commit(ctx, id); err != nil {
 		t.Fatalf("got error: %v", err)
 	}
 
@@ -671,6 +720,67 @@ func TestTxPoolExecFailDueToConnFail_Errno2013(t *testing.T) {
 	}
 }
 
+func TestTxPoolResize(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newTxPool()
+	ctx := context.Background()
+
+	if err := txPool.Resize(ctx, 10); vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("Resize on closed pool: %v, want FAILED_PRECONDITION", err)
+	}
+
+	txPool.conns.SetMaxCapacity(400)
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+
+	if err := txPool.Resize(ctx, 0); vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("Resize(0): %v, want INVALID_ARGUMENT", err)
+	}
+	if err := txPool.Resize(ctx, 401); vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("Resize(401): %v, want INVALID_ARGUMENT", err)
+	}
+	if err := txPool.Resize(ctx, 400); err != nil {
+		t.Fatal(err)
+	}
+	if got := txPool.conns.Capacity(); got != 400 {
+		t.Errorf("Capacity: %d, want 400", got)
+	}
+
+	// Shrinking below the number of in-flight transactions
+	// waits for them to finish.
+	if err := txPool.Resize(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	txid1, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txid2, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := txPool.Resize(shortCtx, 1); vterrors.Code(err) != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("Resize(1) with in-flight transactions: %v, want DEADLINE_EXCEEDED", err)
+	}
+	if err := txPool.Rollback(ctx, txid1); err != nil {
+		t.Fatal(err)
+	}
+	if err := txPool.Rollback(ctx, txid2); err != nil {
+		t.Fatal(err)
+	}
+	for txPool.conns.Available() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	if got := txPool.conns.Capacity(); got != 1 {
+		t.Errorf("Capacity: %d, want 1", got)
+	}
+}
+
 func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
 	startingStray := tabletenv.InternalErrors.Counts()["StrayTransactions"]
 	db := fakesqldb.New(t)
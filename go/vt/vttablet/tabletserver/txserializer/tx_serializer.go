@@ -21,6 +21,8 @@ package txserializer
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,6 +54,11 @@ import (
 //   limited to avoid that queued transactions can consume the full capacity
 //   of vttablet. This is important if the capaciy is finite. For example, the
 //   number of RPCs in flight could be limited by the RPC subsystem.
+//
+// If adaptive mode is enabled, the number of concurrent transactions and the
+// max queue size per row range are not fixed. Instead, they follow the
+// observed latency of the transactions for that row range. The configured
+// values become the upper bounds.
 type TxSerializer struct {
 	env tabletenv.Env
 	*sync2.ConsolidatorCache
@@ -61,6 +68,8 @@ type TxSerializer struct {
 	maxQueueSize           int
 	maxGlobalQueueSize     int
 	concurrentTransactions int
+	adaptive               bool
+	targetLatency          time.Duration
 
 	// waits stores how many times a transaction was queued because another
 	// transaction was already in flight for the same row (range).
@@ -94,13 +103,15 @@ type TxSerializer struct {
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.EnableHotRowProtectionDryRun,
 		maxQueueSize:           config.HotRowProtectionMaxQueueSize,
 		maxGlobalQueueSize:     config.HotRowProtectionMaxGlobalQueueSize,
 		concurrentTransactions: config.HotRowProtectionConcurrentTransactions,
+		adaptive:               config.EnableHotRowProtectionAdaptive,
+		targetLatency:          time.Duration(config.HotRowProtectionTargetLatency * 1e9),
 		waits: env.Exporter().NewCountersWithSingleLabel(
 			"TxSerializerWaits",
 			"Number of times a transaction was queued because another transaction was already in flight for the same row range",
@@ -130,7 +141,17 @@ func New(env tabletenv.Env) *TxSerializer {
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
 	}
-
+	env.Exporter().NewGaugesFuncWithMultiLabels(
+		"TxSerializerQueueDepth",
+		"Number of queued and in flight transactions for the most contended row ranges",
+		[]string{"key"},
+		func() map[string]int64 { return txs.topQueues(func(q *queue) int { return q.size }) })
+	env.Exporter().NewGaugesFuncWithMultiLabels(
+		"TxSerializerConcurrencyLimit",
+		"Number of concurrent transactions let through for the most contended row ranges",
+		[]string{"key"},
+		func() map[string]int64 { return txs.topQueues(func(q *queue) int { return q.limit }) })
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -155,7 +176,8 @@ func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done Done
 		}
 		return nil, waited, err
 	}
-	return func() { txs.unlock(key) }, waited, nil
+	start := time.Now()
+	return func() { txs.unlock(key, time.Since(start)) }, waited, nil
 }
 
 // lockLocked queues this transaction. It will unblock immediately if this
@@ -165,7 +187,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
-		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions)
+		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions, txs.maxQueueSize)
 		txs.globalSize++
 		return false, nil
 	}
@@ -181,14 +203,14 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 		}
 	}
 
-	if q.size >= txs.maxQueueSize {
+	if q.size >= q.maxSize {
 		if txs.dryRun {
 			txs.queueExceededDryRun.Add(table, 1)
-			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, q.maxSize, key)
 		} else {
 			txs.queueExceeded.Add(table, 1)
 			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
-				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, q.maxSize, key)
 		}
 	}
 
@@ -243,13 +265,46 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	}
 }
 
-func (txs *TxSerializer) unlock(key string) {
+func (txs *TxSerializer) unlock(key string, latency time.Duration) {
 	txs.mu.Lock()
 	defer txs.mu.Unlock()
 
+	if txs.adaptive && !txs.dryRun {
+		txs.adaptLocked(txs.queues[key], latency)
+	}
 	txs.unlockLocked(key, true)
 }
 
+// adaptLocked updates the latency estimate of the row range with the
+// latency of a finished transaction. If the estimate is above the target
+// latency, fewer transactions are let through. If it's well below, more
+// transactions are let through, up to the configured maximum.
+// The max queue size is scaled by the same ratio.
+func (txs *TxSerializer) adaptLocked(q *queue, latency time.Duration) {
+	if q.availableSlots == nil {
+		// Not a hot row (yet).
+		return
+	}
+
+	// Exponentially weighted moving average.
+	if q.latency == 0 {
+		q.latency = latency
+	} else {
+		q.latency += (latency - q.latency) / 4
+	}
+
+	switch {
+	case q.latency > txs.targetLatency && q.limit > 1:
+		q.limit--
+	case q.latency < txs.targetLatency/2 && q.limit < txs.concurrentTransactions:
+		q.limit++
+	}
+	q.maxSize = txs.maxQueueSize * q.limit / txs.concurrentTransactions
+	if q.maxSize < 1 {
+		q.maxSize = 1
+	}
+}
+
 func (txs *TxSerializer) unlockLocked(key string, returnSlot bool) {
 	q := txs.queues[key]
 	q.size--
@@ -287,8 +342,46 @@ func (txs *TxSerializer) unlockLocked(key string, returnSlot bool) {
 		return
 	}
 
+	if cap(q.availableSlots)-q.reserved > q.limit {
+		// Keep the slot to lower the number of concurrent transactions.
+		q.reserved++
+		return
+	}
 	// This should never block.
 	<-q.availableSlots
+	for q.reserved > 0 && cap(q.availableSlots)-q.reserved < q.limit {
+		// Give up reserved slots to raise the number of concurrent transactions.
+		<-q.availableSlots
+		q.reserved--
+	}
+}
+
+// topQueuesToExport is the number of row ranges which are exported by the
+// per row range stats.
+const topQueuesToExport = 10
+
+// topQueues returns the value of the given field for the row ranges with
+// the most queued transactions.
+func (txs *TxSerializer) topQueues(field func(q *queue) int) map[string]int64 {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	keys := make([]string, 0, len(txs.queues))
+	for key := range txs.queues {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return txs.queues[keys[i]].size > txs.queues[keys[j]].size
+	})
+	if len(keys) > topQueuesToExport {
+		keys = keys[:topQueuesToExport]
+	}
+	result := make(map[string]int64, len(keys))
+	for _, key := range keys {
+		// "." is the label separator of the stats package.
+		result[strings.Replace(key, ".", "_", -1)] = int64(field(txs.queues[key]))
+	}
+	return result
 }
 
 // Pending returns the number of queued transactions (including the ones which
@@ -362,12 +455,27 @@ type queue struct {
 	// NOTE: As an optimization, we defer the creation of the channel until
 	// a second transaction for the same hot row is running.
 	availableSlots chan struct{}
+
+	// limit is the number of concurrent transactions which are currently let
+	// through. It's only lower than the capacity of "availableSlots" in
+	// adaptive mode.
+	limit int
+	// reserved is the number of slots in "availableSlots" which are held back
+	// to enforce "limit".
+	reserved int
+	// maxSize is the current max queue size.
+	maxSize int
+	// latency is the moving average of the transaction latency. It's only
+	// tracked in adaptive mode.
+	latency time.Duration
 }
 
-func newQueueForFirstTransaction(concurrentTransactions int) *queue {
+func newQueueForFirstTransaction(concurrentTransactions, maxQueueSize int) *queue {
 	return &queue{
-		size:  1,
-		count: 1,
-		max:   1,
+		size:    1,
+		count:   1,
+		max:     1,
+		limit:   concurrentTransactions,
+		maxSize: maxQueueSize,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -251,6 +252,81 @@ func TestTxSerializer_ConcurrentTransactions(t *testing.T) {
 	}
 }
 
+func TestTxSerializerAdaptive(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.EnableHotRowProtectionDryRun = false
+	config.HotRowProtectionMaxQueueSize = 4
+	config.HotRowProtectionMaxGlobalQueueSize = 10
+	config.HotRowProtectionConcurrentTransactions = 2
+	config.EnableHotRowProtectionAdaptive = true
+	// Every transaction is slower than the target.
+	config.HotRowProtectionTargetLatency = 1e-9
+	txs := New(tabletenv.NewTestEnv(&config, nil, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// tx1 and tx2 run concurrently.
+	done1, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done2, waited2, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if waited2 {
+		t.Error("tx2 must not wait")
+	}
+	// tx1 was too slow. Only one transaction is let through from now on and
+	// the queue size is halved.
+	done1()
+	q := txs.queues["t1 where1"]
+	if got, want := q.limit, 1; got != want {
+		t.Errorf("limit: got = %v, want = %v", got, want)
+	}
+	if got, want := q.maxSize, 2; got != want {
+		t.Errorf("maxSize: got = %v, want = %v", got, want)
+	}
+
+	// tx3 has to wait although tx2 is the only transaction in flight.
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		done3, waited3, err3 := txs.Wait(context.Background(), "t1 where1", "t1")
+		if err3 != nil {
+			t.Error(err3)
+			return
+		}
+		if !waited3 {
+			t.Error("tx3 must wait")
+		}
+		done3()
+	}()
+	if err := waitForPending(txs, "t1 where1", 2); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := txs.topQueues(func(q *queue) int { return q.size }), map[string]int64{"t1 where1": 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("topQueues: got = %v, want = %v", got, want)
+	}
+
+	// tx4 exceeds the reduced queue size.
+	_, _, err = txs.Wait(context.Background(), "t1 where1", "t1")
+	if got, want := vterrors.Code(err), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("tx4 error code: got = %v, want = %v (err: %v)", got, want, err)
+	}
+
+	// Transactions are fast again: tx2 frees up the reserved slot as well.
+	txs.mu.Lock()
+	txs.targetLatency = time.Hour
+	txs.mu.Unlock()
+	done2()
+	wg.Wait()
+	if txs.queues["t1 where1"] != nil {
+		t.Error("queue object was not deleted after last transaction")
+	}
+}
+
 func waitForPending(txs *TxSerializer, key string, i int) error {
 	start := time.Now()
 	for {
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func init() {
+	RegisterMetricSource("disk_io", newDiskIOSource)
+}
+
+// diskIOSource reports the fraction of time a block device was busy
+// since the previous sample, relative to the maximum utilization.
+type diskIOSource struct {
+	device         string
+	maxUtilization float64
+	// readDiskStats returns the contents of /proc/diskstats.
+	readDiskStats func() ([]byte, error)
+
+	lastBusy   time.Duration
+	lastSample time.Time
+}
+
+func newDiskIOSource(env tabletenv.Env) (MetricSource, error) {
+	config := env.Config()
+	ds := &diskIOSource{
+		device:         config.TxThrottlerDiskDevice,
+		maxUtilization: config.TxThrottlerMaxDiskUtilization,
+		readDiskStats: func() ([]byte, error) {
+			return ioutil.ReadFile("/proc/diskstats")
+		},
+	}
+	// Take the first sample, so that Load can compute a utilization.
+	if _, err := ds.Load(); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// Load is part of the MetricSource interface.
+func (ds *diskIOSource) Load() (float64, error) {
+	data, err := ds.readDiskStats()
+	if err != nil {
+		return 0, err
+	}
+	busy, err := diskBusyTime(data, ds.device)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	var utilization float64
+	if elapsed := now.Sub(ds.lastSample); !ds.lastSample.IsZero() && elapsed > 0 {
+		utilization = float64(busy-ds.lastBusy) / float64(elapsed)
+	}
+	ds.lastBusy, ds.lastSample = busy, now
+	return utilization / ds.maxUtilization, nil
+}
+
+// Close is part of the MetricSource interface.
+func (ds *diskIOSource) Close() {}
+
+// diskBusyTime returns the total time the device spent doing I/O,
+// as reported in the given /proc/diskstats contents.
+func diskBusyTime(diskstats []byte, device string) (time.Duration, error) {
+	for _, line := range strings.Split(string(diskstats), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 13 || fields[2] != device {
+			continue
+		}
+		ms, err := strconv.ParseUint(fields[12], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid I/O time for device %v: %v", device, err)
+		}
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+	return 0, fmt.Errorf("device %v not found in /proc/diskstats", device)
+}
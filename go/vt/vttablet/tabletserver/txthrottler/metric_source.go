@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// MetricSource is a throttle signal which the transaction throttler
+// considers in addition to the replication lag of the replicas.
+type MetricSource interface {
+	// Load returns the current load, scaled so that 1 is the
+	// load at which transactions should be throttled.
+	Load() (float64, error)
+	// Close releases the resources held by the source.
+	Close()
+}
+
+// MetricSourceFactory creates a MetricSource. It's called whenever
+// the transaction throttler is opened.
+type MetricSourceFactory func(env tabletenv.Env) (MetricSource, error)
+
+var metricSourceFactories = make(map[string]MetricSourceFactory)
+
+// RegisterMetricSource makes a MetricSource available under the given
+// name, so that it can be enabled with -tx-throttler-metric-sources.
+// It must be called from an init function.
+func RegisterMetricSource(name string, factory MetricSourceFactory) {
+	if _, ok := metricSourceFactories[name]; ok {
+		panic(fmt.Sprintf("metric source %v is already registered", name))
+	}
+	metricSourceFactories[name] = factory
+}
+
+// weightedSource is an open MetricSource with its configured weight.
+type weightedSource struct {
+	name   string
+	weight float64
+	source MetricSource
+}
+
+// metricSampler periodically samples the configured metric sources
+// and combines their load into a weighted average.
+type metricSampler struct {
+	env      tabletenv.Env
+	weights  map[string]float64
+	ticks    *timer.Timer
+	errorLog *logutil.ThrottledLogger
+
+	// sources is only modified while the timer is stopped.
+	sources []weightedSource
+
+	mu sync.Mutex
+	// loads holds the last successfully sampled load of each source.
+	loads map[string]float64
+	// load is the weighted average of loads.
+	load float64
+}
+
+func newMetricSampler(env tabletenv.Env, weights map[string]float64, interval time.Duration) (*metricSampler, error) {
+	for name := range weights {
+		if _, ok := metricSourceFactories[name]; !ok {
+			return nil, fmt.Errorf("unknown transaction throttler metric source: %v", name)
+		}
+	}
+	return &metricSampler{
+		env:      env,
+		weights:  weights,
+		ticks:    timer.NewTimer(interval),
+		errorLog: logutil.NewThrottledLogger("TxThrottlerMetricSource", 60*time.Second),
+		loads:    make(map[string]float64),
+	}, nil
+}
+
+// open creates the metric sources and starts sampling them.
+func (ms *metricSampler) open() error {
+	names := make([]string, 0, len(ms.weights))
+	for name := range ms.weights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		source, err := metricSourceFactories[name](ms.env)
+		if err != nil {
+			ms.closeSources()
+			return fmt.Errorf("creating transaction throttler metric source %v: %v", name, err)
+		}
+		ms.sources = append(ms.sources, weightedSource{name: name, weight: ms.weights[name], source: source})
+	}
+	ms.sample()
+	ms.ticks.Start(ms.sample)
+	return nil
+}
+
+// close stops sampling and closes the metric sources.
+func (ms *metricSampler) close() {
+	ms.ticks.Stop()
+	ms.closeSources()
+}
+
+func (ms *metricSampler) closeSources() {
+	for _, ws := range ms.sources {
+		ws.source.Close()
+	}
+	ms.sources = nil
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.loads = make(map[string]float64)
+	ms.load = 0
+}
+
+// sample updates the load of all sources. A source which fails
+// keeps its previous load, and doesn't count if it never succeeded.
+func (ms *metricSampler) sample() {
+	defer tabletenv.LogError()
+
+	loads := make(map[string]float64, len(ms.sources))
+	for _, ws := range ms.sources {
+		load, err := ws.source.Load()
+		if err != nil {
+			ms.errorLog.Errorf("Error sampling transaction throttler metric source %v: %v", ws.name, err)
+			continue
+		}
+		loads[ws.name] = load
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	for name, load := range loads {
+		ms.loads[name] = load
+	}
+	var sum, total float64
+	for _, ws := range ms.sources {
+		load, ok := ms.loads[ws.name]
+		if !ok {
+			continue
+		}
+		sum += ws.weight * load
+		total += ws.weight
+	}
+	ms.load = 0
+	if total > 0 {
+		ms.load = sum / total
+	}
+}
+
+// overloaded returns true if the weighted load is at or above 1.
+func (ms *metricSampler) overloaded() bool {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.load >= 1
+}
+
+// percentLoads returns the last load of each source and the
+// weighted load, in percent.
+func (ms *metricSampler) percentLoads() map[string]int64 {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	result := make(map[string]int64, len(ms.loads)+1)
+	for name, load := range ms.loads {
+		result[name] = int64(load * 100)
+	}
+	result["weighted"] = int64(ms.load * 100)
+	return result
+}
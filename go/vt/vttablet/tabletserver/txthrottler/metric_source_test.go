@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+type fakeMetricSource struct {
+	load   float64
+	err    error
+	closed bool
+}
+
+func (fs *fakeMetricSource) Load() (float64, error) { return fs.load, fs.err }
+func (fs *fakeMetricSource) Close()                 { fs.closed = true }
+
+func TestMetricSampler(t *testing.T) {
+	sources := map[string]*fakeMetricSource{
+		"fake_a": {},
+		"fake_b": {},
+	}
+	for name, source := range sources {
+		source := source
+		metricSourceFactories[name] = func(tabletenv.Env) (MetricSource, error) { return source, nil }
+	}
+	defer func() {
+		for name := range sources {
+			delete(metricSourceFactories, name)
+		}
+	}()
+
+	if _, err := newMetricSampler(nil, map[string]float64{"unknown": 1}, time.Hour); err == nil {
+		t.Errorf("newMetricSampler(unknown): nil, want error")
+	}
+
+	config := tabletenv.DefaultQsConfig
+	env := tabletenv.NewTestEnv(&config, nil, "MetricSamplerTest")
+	// Samples are taken explicitly by the test.
+	ms, err := newMetricSampler(env, map[string]float64{"fake_a": 3, "fake_b": 1}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources["fake_a"].load = 0.5
+	sources["fake_b"].load = 0.5
+	if err := ms.open(); err != nil {
+		t.Fatal(err)
+	}
+	if ms.overloaded() {
+		t.Errorf("overloaded: true, want false")
+	}
+
+	// The weighted load is (3*1.25 + 1*0.25) / 4 = 1.
+	sources["fake_a"].load = 1.25
+	sources["fake_b"].load = 0.25
+	ms.sample()
+	if !ms.overloaded() {
+		t.Errorf("overloaded: false, want true")
+	}
+	if got, want := ms.percentLoads()["fake_a"], int64(125); got != want {
+		t.Errorf("percentLoads[fake_a]: %v, want %v", got, want)
+	}
+
+	// A failing source keeps its previous load.
+	sources["fake_a"].err = errors.New("sample failed")
+	sources["fake_b"].load = 0
+	ms.sample()
+	if got, want := ms.percentLoads()["weighted"], int64(93); got != want {
+		t.Errorf("percentLoads[weighted]: %v, want %v", got, want)
+	}
+
+	ms.close()
+	if !sources["fake_a"].closed || !sources["fake_b"].closed {
+		t.Errorf("sources not closed")
+	}
+	if ms.overloaded() {
+		t.Errorf("overloaded after close: true, want false")
+	}
+}
+
+func TestDiskBusyTime(t *testing.T) {
+	diskstats := []byte(`   8       0 sda 105 0 7458 52 48 4 416 31 0 1012 84 0 0 0 0
+ 259       0 nvme0n1 205426 3917 11349812 40672 1180911 1002350 81356552 1460236 0 630440 1527340 0 0 0 0
+`)
+	busy, err := diskBusyTime(diskstats, "nvme0n1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 630440 * time.Millisecond; busy != want {
+		t.Errorf("diskBusyTime: %v, want %v", busy, want)
+	}
+	if _, err := diskBusyTime(diskstats, "sdb"); err == nil {
+		t.Errorf("diskBusyTime(sdb): nil, want error")
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func init() {
+	RegisterMetricSource("sql_probe", newSQLProbeSource)
+}
+
+// sqlProbeSource runs a query against the local MySQL and reports
+// the number it returns, relative to the configured threshold.
+type sqlProbeSource struct {
+	query     string
+	threshold float64
+	timeout   time.Duration
+	pool      *connpool.Pool
+}
+
+func newSQLProbeSource(env tabletenv.Env) (MetricSource, error) {
+	config := env.Config()
+	sp := &sqlProbeSource{
+		query:     config.TxThrottlerProbeQuery,
+		threshold: config.TxThrottlerProbeThreshold,
+		timeout:   config.TxThrottlerMetricInterval,
+		pool:      connpool.New(env, "TxThrottlerProbePool", 1, 0, time.Duration(config.IdleTimeout*1e9)),
+	}
+	dbconfigs := env.DBConfigs()
+	sp.pool.Open(dbconfigs.AppWithDB(), dbconfigs.DbaWithDB(), dbconfigs.AppDebugWithDB())
+	return sp, nil
+}
+
+// Load is part of the MetricSource interface.
+func (sp *sqlProbeSource) Load() (float64, error) {
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), sp.timeout)
+	defer cancel()
+	conn, err := sp.pool.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, sp.query, 1, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return 0, fmt.Errorf("probe query must return a single value, got: %v", qr.Rows)
+	}
+	v, err := sqltypes.ToFloat64(qr.Rows[0][0])
+	if err != nil {
+		return 0, err
+	}
+	return v / sp.threshold, nil
+}
+
+// Close is part of the MetricSource interface.
+func (sp *sqlProbeSource) Close() {
+	sp.pool.Close()
+}
@@ -37,10 +37,12 @@ import (
 // TxThrottler throttles transactions based on replication lag.
 // It's a thin wrapper around the throttler found in vitess/go/vt/throttler.
 // It uses a discovery.HealthCheck to send replication-lag updates to the wrapped throttler.
+// Additionally, transactions are throttled while the weighted load of the
+// metric sources configured in -tx-throttler-metric-sources is too high.
 //
 // Intended Usage:
 //   // Assuming topoServer is a topo.Server variable pointing to a Vitess topology server.
-//   t := CreateTxThrottlerFromTabletConfig(topoServer)
+//   t := CreateTxThrottlerFromTabletConfig(env, topoServer)
 //
 //   // A transaction throttler must be opened before its first use:
 //   if err := t.Open(keyspace, shard); err != nil {
@@ -69,6 +71,10 @@ type TxThrottler struct {
 	// state holds an open transaction throttler state. It is nil
 	// if the TransactionThrottler is closed.
 	state *txThrottlerState
+
+	// metrics samples the configured metric sources while the
+	// throttler is open. It is nil if there are none.
+	metrics *metricSampler
 }
 
 // CreateTxThrottlerFromTabletConfig tries to construct a TxThrottler from the
@@ -76,8 +82,8 @@ type TxThrottler struct {
 // any error occurs.
 // This function calls tryCreateTxThrottler that does the actual creation work
 // and returns an error if one occurred.
-func CreateTxThrottlerFromTabletConfig(topoServer *topo.Server) *TxThrottler {
-	txThrottler, err := tryCreateTxThrottler(topoServer)
+func CreateTxThrottlerFromTabletConfig(env tabletenv.Env, topoServer *topo.Server) *TxThrottler {
+	txThrottler, err := tryCreateTxThrottler(env, topoServer)
 	if err != nil {
 		log.Errorf("Error creating transaction throttler. Transaction throttling will"+
 			" be disabled. Error: %v", err)
@@ -91,7 +97,7 @@ func CreateTxThrottlerFromTabletConfig(topoServer *topo.Server) *TxThrottler {
 	return txThrottler
 }
 
-func tryCreateTxThrottler(topoServer *topo.Server) (*TxThrottler, error) {
+func tryCreateTxThrottler(env tabletenv.Env, topoServer *topo.Server) (*TxThrottler, error) {
 	if !tabletenv.Config.EnableTxThrottler {
 		return newTxThrottler(&txThrottlerConfig{enabled: false})
 	}
@@ -106,12 +112,28 @@ func tryCreateTxThrottler(topoServer *topo.Server) (*TxThrottler, error) {
 	healthCheckCells := make([]string, len(tabletenv.Config.TxThrottlerHealthCheckCells))
 	copy(healthCheckCells, tabletenv.Config.TxThrottlerHealthCheckCells)
 
-	return newTxThrottler(&txThrottlerConfig{
+	txThrottler, err := newTxThrottler(&txThrottlerConfig{
 		enabled:          true,
 		topoServer:       topoServer,
 		throttlerConfig:  &throttlerConfig,
 		healthCheckCells: healthCheckCells,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tabletenv.Config.TxThrottlerMetricSources) == 0 {
+		return txThrottler, nil
+	}
+	weights, err := tabletenv.Config.TxThrottlerMetricSourceWeights()
+	if err != nil {
+		return nil, err
+	}
+	txThrottler.metrics, err = newMetricSampler(env, weights, tabletenv.Config.TxThrottlerMetricInterval)
+	if err != nil {
+		return nil, err
+	}
+	env.Exporter().NewGaugesFuncWithMultiLabels("TxThrottlerMetricLoad", "Load of the transaction throttler metric sources in percent of their throttling threshold", []string{"Source"}, txThrottler.metrics.percentLoads)
+	return txThrottler, nil
 }
 
 // txThrottlerConfig holds the parameters that need to be
@@ -217,9 +239,18 @@ func (t *TxThrottler) Open(keyspace, shard string) error {
 	if t.state != nil {
 		return fmt.Errorf("transaction throttler already opened")
 	}
-	var err error
-	t.state, err = newTxThrottlerState(t.config, keyspace, shard)
-	return err
+	state, err := newTxThrottlerState(t.config, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if t.metrics != nil {
+		if err := t.metrics.open(); err != nil {
+			state.deallocateResources()
+			return err
+		}
+	}
+	t.state = state
+	return nil
 }
 
 // Close closes the TxThrottler object and releases resources.
@@ -233,6 +264,9 @@ func (t *TxThrottler) Close() {
 		return
 	}
 	log.Infof("Shutting down transaction throttler.")
+	if t.metrics != nil {
+		t.metrics.close()
+	}
 	t.state.deallocateResources()
 	t.state = nil
 }
@@ -248,7 +282,10 @@ func (t *TxThrottler) Throttle() (result bool) {
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	if t.state.throttle() {
+		return true
+	}
+	return t.metrics != nil && t.metrics.overloaded()
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
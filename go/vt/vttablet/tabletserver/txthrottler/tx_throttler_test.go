@@ -39,7 +39,7 @@ func TestDisabledThrottler(t *testing.T) {
 	oldConfig := tabletenv.Config
 	defer func() { tabletenv.Config = oldConfig }()
 	tabletenv.Config.EnableTxThrottler = false
-	throttler := CreateTxThrottlerFromTabletConfig(nil)
+	throttler := CreateTxThrottlerFromTabletConfig(nil, nil)
 	if err := throttler.Open("keyspace", "shard"); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
@@ -115,7 +115,7 @@ func TestEnabledThrottler(t *testing.T) {
 	tabletenv.Config.EnableTxThrottler = true
 	tabletenv.Config.TxThrottlerHealthCheckCells = []string{"cell1", "cell2"}
 
-	throttler, err := tryCreateTxThrottler(ts)
+	throttler, err := tryCreateTxThrottler(nil, ts)
 	if err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
@@ -53,6 +53,22 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// NotEqual is used to filter a column on all but a specific value
+	NotEqual
+	// LessThan is used to filter a column on the values below a value
+	LessThan
+	// LessEqual is used to filter a column on the values up to a value
+	LessEqual
+	// GreaterThan is used to filter a column on the values above a value
+	GreaterThan
+	// GreaterEqual is used to filter a column on the values from a value
+	GreaterEqual
+	// In is used to filter a column on a list of values
+	In
+	// IsNull is used to filter a column on the NULL values
+	IsNull
+	// IsNotNull is used to filter a column on the non-NULL values
+	IsNotNull
 )
 
 // Filter contains opcodes for filtering.
@@ -60,6 +76,8 @@ type Filter struct {
 	Opcode Opcode
 	ColNum int
 	Value  sqltypes.Value
+	// Values is the list of values for In.
+	Values []sqltypes.Value
 
 	// Parameters for VindexMatch.
 	// Vindex, VindexColumns and KeyRange, if set, will be used
@@ -114,12 +132,42 @@ func (plan *Plan) fields() []*querypb.Field {
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
 	for _, filter := range plan.Filters {
 		switch filter.Opcode {
-		case Equal:
+		case Equal, NotEqual, LessThan, LessEqual, GreaterThan, GreaterEqual:
+			// As in MySQL, NULL doesn't match any comparison.
+			if values[filter.ColNum].IsNull() {
+				return false, nil, nil
+			}
 			result, err := sqltypes.NullsafeCompare(values[filter.ColNum], filter.Value)
 			if err != nil {
 				return false, nil, err
 			}
-			if result != 0 {
+			if !compareMatches(filter.Opcode, result) {
+				return false, nil, nil
+			}
+		case In:
+			if values[filter.ColNum].IsNull() {
+				return false, nil, nil
+			}
+			found := false
+			for _, value := range filter.Values {
+				result, err := sqltypes.NullsafeCompare(values[filter.ColNum], value)
+				if err != nil {
+					return false, nil, err
+				}
+				if result == 0 {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false, nil, nil
+			}
+		case IsNull:
+			if !values[filter.ColNum].IsNull() {
+				return false, nil, nil
+			}
+		case IsNotNull:
+			if values[filter.ColNum].IsNull() {
 				return false, nil, nil
 			}
 		case VindexMatch:
@@ -151,6 +199,26 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 	return true, result, nil
 }
 
+// compareMatches returns true if the result of comparing a value
+// with the value of a filter matches the opcode of the filter.
+func compareMatches(opcode Opcode, result int) bool {
+	switch opcode {
+	case Equal:
+		return result == 0
+	case NotEqual:
+		return result != 0
+	case LessThan:
+		return result < 0
+	case LessEqual:
+		return result <= 0
+	case GreaterThan:
+		return result > 0
+	case GreaterEqual:
+		return result >= 0
+	}
+	return false
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -209,6 +277,34 @@ func mustSendDDL(query mysql.Query, dbname string, filter *binlogdatapb.Filter)
 	return true
 }
 
+// ddlTables returns the names of the tables of dbname which are changed
+// by the DDL. It returns false if they can't be determined.
+func ddlTables(query mysql.Query, dbname string) ([]string, bool) {
+	ast, err := sqlparser.Parse(query.SQL)
+	if err != nil {
+		return nil, false
+	}
+	stmt, ok := ast.(*sqlparser.DDL)
+	if !ok {
+		return nil, false
+	}
+	var tables []string
+	for _, table := range stmt.AffectedTables() {
+		if table.IsEmpty() {
+			return nil, false
+		}
+		qualifier := query.Database
+		if !table.Qualifier.IsEmpty() {
+			qualifier = table.Qualifier.String()
+		}
+		if qualifier != "" && qualifier != dbname {
+			continue
+		}
+		tables = append(tables, table.Name.String())
+	}
+	return tables, true
+}
+
 // tableMatches is similar to buildPlan below and MatchTable in vreplication/table_plan_builder.go.
 func tableMatches(table sqlparser.TableName, dbname string, filter *binlogdatapb.Filter) bool {
 	if !table.Qualifier.IsEmpty() && table.Qualifier.String() != dbname {
@@ -356,37 +452,59 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			colnum, err := plan.filterColumn(expr, expr.Left)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.SQLVal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			if expr.Operator == sqlparser.InStr {
+				tuple, ok := expr.Right.(sqlparser.ValTuple)
+				if !ok {
+					return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				}
+				filter := Filter{
+					Opcode: In,
+					ColNum: colnum,
+				}
+				for _, val := range tuple {
+					resolved, err := filterValue(expr, val)
+					if err != nil {
+						return err
+					}
+					filter.Values = append(filter.Values, resolved)
+				}
+				plan.Filters = append(plan.Filters, filter)
+				continue
 			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			opcode, ok := comparisonOpcodes[expr.Operator]
+			if !ok {
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 			}
-			pv, err := sqlparser.NewPlanValue(val)
+			resolved, err := filterValue(expr, expr.Right)
 			if err != nil {
 				return err
 			}
-			resolved, err := pv.ResolveValue(nil)
+			plan.Filters = append(plan.Filters, Filter{
+				Opcode: opcode,
+				ColNum: colnum,
+				Value:  resolved,
+			})
+		case *sqlparser.IsExpr:
+			colnum, err := plan.filterColumn(expr, expr.Expr)
 			if err != nil {
 				return err
 			}
+			var opcode Opcode
+			switch expr.Operator {
+			case sqlparser.IsNullStr:
+				opcode = IsNull
+			case sqlparser.IsNotNullStr:
+				opcode = IsNotNull
+			default:
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
 			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
+				Opcode: opcode,
 				ColNum: colnum,
-				Value:  resolved,
 			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
@@ -402,6 +520,46 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	return nil
 }
 
+// comparisonOpcodes maps the comparison operators supported in a where
+// clause to their opcodes.
+var comparisonOpcodes = map[string]Opcode{
+	sqlparser.EqualStr:        Equal,
+	sqlparser.NotEqualStr:     NotEqual,
+	sqlparser.LessThanStr:     LessThan,
+	sqlparser.LessEqualStr:    LessEqual,
+	sqlparser.GreaterThanStr:  GreaterThan,
+	sqlparser.GreaterEqualStr: GreaterEqual,
+}
+
+// filterColumn returns the column number of the column compared by expr.
+func (plan *Plan) filterColumn(expr, col sqlparser.Expr) (int, error) {
+	qualifiedName, ok := col.(*sqlparser.ColName)
+	if !ok {
+		return 0, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	return findColumn(plan.Table, qualifiedName.Name)
+}
+
+// filterValue returns the value of the literal val compared by expr.
+func filterValue(expr, val sqlparser.Expr) (sqltypes.Value, error) {
+	sqlVal, ok := val.(*sqlparser.SQLVal)
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if sqlVal.Type != sqlparser.IntVal && sqlVal.Type != sqlparser.StrVal {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	pv, err := sqlparser.NewPlanValue(sqlVal)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return pv.ResolveValue(nil)
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -170,6 +170,60 @@ func TestMustSendDDL(t *testing.T) {
 	}
 }
 
+func TestDDLTables(t *testing.T) {
+	testcases := []struct {
+		sql    string
+		db     string
+		tables []string
+		ok     bool
+	}{{
+		sql:    "create table t1(id int)",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql:    "alter table mydb.t1 add column val int",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql: "alter table db.t1 add column val int",
+		ok:  true,
+	}, {
+		sql: "alter table t1 add column val int",
+		db:  "db",
+		ok:  true,
+	}, {
+		sql:    "alter table mydb.t1 add column val int",
+		db:     "db",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql:    "rename table t1 to t2, db.t3 to t4",
+		tables: []string{"t1", "t2", "t4"},
+		ok:     true,
+	}, {
+		sql:    "drop table t1, t2",
+		tables: []string{"t1", "t2"},
+		ok:     true,
+	}, {
+		sql:    "truncate table t1",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql: "create database db",
+		ok:  false,
+	}, {
+		sql: "bad query",
+		ok:  false,
+	}}
+	for _, tcase := range testcases {
+		q := mysql.Query{SQL: tcase.sql, Database: tcase.db}
+		tables, ok := ddlTables(q, "mydb")
+		if !reflect.DeepEqual(tables, tcase.tables) || ok != tcase.ok {
+			t.Errorf("%v: %v, %v, want %v, %v", q, tables, ok, tcase.tables, tcase.ok)
+		}
+	}
+}
+
 func TestPlanbuilder(t *testing.T) {
 	t1 := &Table{
 		Name: "t1",
@@ -362,6 +416,36 @@ func TestPlanbuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id > 1 and id in (2, 3) and val is not null and val != 'a'"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}, {
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}},
+			Filters: []Filter{{
+				Opcode: GreaterThan,
+				ColNum: 0,
+				Value:  sqltypes.NewInt64(1),
+			}, {
+				Opcode: In,
+				ColNum: 0,
+				Values: []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(3)},
+			}, {
+				Opcode: IsNotNull,
+				ColNum: 1,
+			}, {
+				Opcode: NotEqual,
+				ColNum: 1,
+				Value:  sqltypes.NewVarBinary("a"),
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -454,6 +538,18 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
 		outErr:  `unsupported constraint: max(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val like 'a%'"},
+		outErr:  `unsupported constraint: val like 'a%'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (1, val)"},
+		outErr:  `unexpected: id in (1, val)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val is true"},
+		outErr:  `unsupported constraint: val is true`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -539,3 +635,73 @@ func TestPlanbuilder(t *testing.T) {
 
 	}
 }
+
+func TestPlanFilter(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	testcases := []struct {
+		where string
+		match []bool
+	}{{
+		where: "id = 2",
+		match: []bool{false, true, false, false},
+	}, {
+		where: "id != 2",
+		match: []bool{true, false, true, true},
+	}, {
+		where: "id < 2",
+		match: []bool{true, false, false, false},
+	}, {
+		where: "id <= 2",
+		match: []bool{true, true, false, false},
+	}, {
+		where: "id > 2",
+		match: []bool{false, false, true, true},
+	}, {
+		where: "id >= 3 and val = 'c'",
+		match: []bool{false, false, true, false},
+	}, {
+		where: "val in ('a', 'c')",
+		match: []bool{true, false, true, false},
+	}, {
+		where: "val != 'a'",
+		match: []bool{false, true, true, false},
+	}, {
+		where: "val is null",
+		match: []bool{false, false, false, true},
+	}, {
+		where: "val is not null",
+		match: []bool{true, true, true, false},
+	}}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("b")},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("c")},
+		{sqltypes.NewInt64(4), sqltypes.NULL},
+	}
+	for _, tcase := range testcases {
+		plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1 where " + tcase.where}},
+		})
+		if err != nil {
+			t.Fatalf("buildPlan(%v): %v", tcase.where, err)
+		}
+		for i, row := range rows {
+			ok, _, err := plan.filter(row)
+			if err != nil {
+				t.Fatalf("filter(%v, %v): %v", tcase.where, row, err)
+			}
+			if ok != tcase.match[i] {
+				t.Errorf("filter(%v, %v): %v, want %v", tcase.where, row, ok, tcase.match[i])
+			}
+		}
+	}
+}
@@ -218,6 +218,11 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// ResizeTxPool is part of the tabletserver.Controller interface.
+func (tqsc *Controller) ResizeTxPool(ctx context.Context, size int) error {
+	return nil
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
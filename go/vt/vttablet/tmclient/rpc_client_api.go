@@ -80,6 +80,10 @@ type TabletManagerClient interface {
 	// IgnoreHealthError sets the regexp for health errors to ignore.
 	IgnoreHealthError(ctx context.Context, tablet *topodatapb.Tablet, pattern string) error
 
+	// ResizeTxPool changes the size of the transaction pool on the
+	// remote tablet. Shrinking waits for in-flight transactions.
+	ResizeTxPool(ctx context.Context, tablet *topodatapb.Tablet, size int64) error
+
 	// ReloadSchema asks the remote tablet to reload its schema
 	ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error
 
@@ -89,10 +93,30 @@ type TabletManagerClient interface {
 	// ApplySchema will apply a schema change
 	ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
 
+	// ApplyDeclarativeSchema changes the schema of the tablet into the
+	// one created by the CREATE TABLE statements of desired, and returns
+	// the statements which make the changes. If dryRun is set, the
+	// changes are only computed.
+	ApplyDeclarativeSchema(ctx context.Context, tablet *topodatapb.Tablet, desired []string, allowDrop, dryRun bool) ([]string, error)
+
 	LockTables(ctx context.Context, tablet *topodatapb.Tablet) error
 
 	UnlockTables(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// SubmitOnlineDDL queues an ALTER TABLE to be run by gh-ost or
+	// pt-online-schema-change on the master, and returns its uuid.
+	SubmitOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, sql, strategy string) (string, error)
+
+	// GetOnlineDDLMigrations returns the online DDL migration with the
+	// given uuid, or all of them if uuid is empty.
+	GetOnlineDDLMigrations(ctx context.Context, tablet *topodatapb.Tablet, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error)
+
+	// CancelOnlineDDL cancels a queued or running online DDL migration.
+	CancelOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error
+
+	// RetryOnlineDDL queues a failed or cancelled online DDL migration again.
+	RetryOnlineDDL(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error
+
 	// ExecuteFetchAsDba executes a query remotely using the DBA pool.
 	// If usePool is set, a connection pool may be used to make the
 	// query faster. Close() should close the pool in that case.
@@ -146,6 +170,13 @@ type TabletManagerClient interface {
 	// VReplicationExec executes a VReplication command
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
+	// VReplicationPause stops a stream and returns its position.
+	VReplicationPause(ctx context.Context, tablet *topodatapb.Tablet, id int) (string, error)
+	// VReplicationResume restarts a paused stream.
+	VReplicationResume(ctx context.Context, tablet *topodatapb.Tablet, id int) error
+	// VReplicationCopyProgress returns the progress of the copy phase of
+	// the streams of the workflow, or of all the streams if it's empty.
+	VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error)
 
 	//
 	// Reparenting related functions
@@ -203,11 +234,13 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
+	// Backup creates a database backup. If incremental is set, it's an
+	// incremental backup of the binary logs.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// The backup and the point in time to restore to are selected by req.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/concurrency"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// WorkflowCopyProgress returns the copy progress of the streams of the
+// workflow on the masters of the target keyspace, keyed by shard. The
+// shards which have no stream of the workflow are omitted.
+func (wr *Wrangler) WorkflowCopyProgress(ctx context.Context, targetKeyspace, workflow string) (map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	shards, err := wr.ts.GetShardNames(ctx, targetKeyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", targetKeyspace, err)
+	}
+
+	var mu sync.Mutex
+	result := make(map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress)
+	er := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, targetKeyspace, shard)
+		if err != nil {
+			return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", targetKeyspace, shard, err)
+		}
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("no master in shard %v/%v", targetKeyspace, shard)
+		}
+		wg.Add(1)
+		go func(shard string, alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+			ti, err := wr.ts.GetTablet(ctx, alias)
+			if err != nil {
+				er.RecordError(err)
+				return
+			}
+			streams, err := wr.tmc.VReplicationCopyProgress(ctx, ti.Tablet, workflow)
+			if err != nil {
+				er.RecordError(fmt.Errorf("VReplicationCopyProgress(%v, %v) failed: %v", ti.AliasString(), workflow, err))
+				return
+			}
+			if len(streams) == 0 {
+				return
+			}
+			mu.Lock()
+			result[shard] = streams
+			mu.Unlock()
+		}(shard, si.MasterAlias)
+	}
+	wg.Wait()
+	if er.HasErrors() {
+		return nil, er.Error()
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no streams found for workflow %v in keyspace %v", workflow, targetKeyspace)
+	}
+	return result, nil
+}
+
+// WaitForWorkflowCopy calls report with the copy progress of the
+// workflow every interval, until no table remains to be copied. The copy
+// state of a stream is only populated after it starts, so the copy is
+// considered done after two consecutive checks find nothing left to copy.
+func (wr *Wrangler) WaitForWorkflowCopy(ctx context.Context, targetKeyspace, workflow string, interval time.Duration, report func(map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	idleChecks := 0
+	for {
+		progress, err := wr.WorkflowCopyProgress(ctx, targetKeyspace, workflow)
+		if err != nil {
+			return err
+		}
+		if err := report(progress); err != nil {
+			return err
+		}
+
+		copying := false
+		for _, streams := range progress {
+			for _, stream := range streams {
+				if len(stream.Tables) > 0 {
+					copying = true
+				}
+			}
+		}
+		if copying {
+			idleChecks = 0
+		} else {
+			idleChecks++
+		}
+		if idleChecks == 2 {
+			wr.Logger().Infof("Copy phase of workflow %v.%v is done", targetKeyspace, workflow)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type copyProgressTMClient struct {
+	tmclient.TabletManagerClient
+	progress map[uint32][]*tabletmanagerdatapb.VReplicationStreamCopyProgress
+}
+
+func (tmc *copyProgressTMClient) VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	var result []*tabletmanagerdatapb.VReplicationStreamCopyProgress
+	for _, stream := range tmc.progress[tablet.Alias.Uid] {
+		if stream.Workflow == workflow {
+			result = append(result, stream)
+		}
+	}
+	return result, nil
+}
+
+// newTestShardMasters creates the shards of the keyspace, with one
+// master per shard. The uids of the masters are 100, 101...
+func newTestShardMasters(t *testing.T, keyspace string, shards ...string) *topo.Server {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}))
+	for i, shard := range shards {
+		require.NoError(t, ts.CreateShard(ctx, keyspace, shard))
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uint32(100 + i)},
+			Keyspace: keyspace,
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		_, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	return ts
+}
+
+func TestWorkflowCopyProgress(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-")
+
+	stream := &tabletmanagerdatapb.VReplicationStreamCopyProgress{
+		Id:       1,
+		Workflow: "wf",
+		Tables: []*tabletmanagerdatapb.TableCopyProgress{{
+			Table:         "t1",
+			RowsCopied:    10,
+			RowsTotal:     100,
+			RowsPerSecond: 5,
+			EtaSeconds:    18,
+		}},
+	}
+	tmc := &copyProgressTMClient{
+		progress: map[uint32][]*tabletmanagerdatapb.VReplicationStreamCopyProgress{
+			100: {stream},
+		},
+	}
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+
+	progress, err := wr.WorkflowCopyProgress(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress{
+		"-80": {stream},
+	}, progress)
+
+	_, err = wr.WorkflowCopyProgress(ctx, "ks", "other")
+	assert.EqualError(t, err, "no streams found for workflow other in keyspace ks")
+}
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo"
@@ -90,6 +91,29 @@ func (wr *Wrangler) SetKeyspaceShardingInfo(ctx context.Context, keyspace, shard
 	return wr.ts.UpdateKeyspace(ctx, ki)
 }
 
+// SetKeyspaceDDLStrategy changes the DDL strategy used by the tablets of
+// the keyspace to apply the schema changes of ApplySchema.
+func (wr *Wrangler) SetKeyspaceDDLStrategy(ctx context.Context, keyspace, strategy string) (err error) {
+	if _, err := tmutils.ParseDDLStrategy(strategy); err != nil {
+		return err
+	}
+
+	// Lock the keyspace
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceDDLStrategy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// and change it
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.DdlStrategy = strategy
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
 // validateNewWorkflow ensures that the specified workflow doesn't already exist
 // in the keyspace.
 func (wr *Wrangler) validateNewWorkflow(ctx context.Context, keyspace, workflow string) error {
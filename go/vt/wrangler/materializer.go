@@ -21,6 +21,7 @@ import (
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
@@ -29,12 +30,14 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
@@ -49,7 +52,10 @@ type materializer struct {
 }
 
 // MoveTables initiates moving table(s) over to another keyspace
-func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes string) error {
+func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes, compression string) error {
+	if err := grpcclient.ValidateCompression(compression); err != nil {
+		return err
+	}
 	var tables []string
 	var vschema *vschemapb.Keyspace
 	if strings.HasPrefix(tableSpecs, "{") {
@@ -103,6 +109,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		TargetKeyspace: targetKeyspace,
 		Cell:           cell,
 		TabletTypes:    tabletTypes,
+		Compression:    compression,
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -118,25 +125,192 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 
 // CreateLookupVindex creates a lookup vindex and sets up the backfill.
 func (wr *Wrangler) CreateLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) error {
+	_, err := wr.createLookupVindex(ctx, keyspace, specs, cell, tabletTypes)
+	return err
+}
+
+// createLookupVindex creates a lookup vindex and returns the settings of the backfill workflow.
+func (wr *Wrangler) createLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) (*vtctldatapb.MaterializeSettings, error) {
 	ms, sourceVSchema, targetVSchema, err := wr.prepareCreateLookup(ctx, keyspace, specs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err := wr.ts.SaveVSchema(ctx, ms.TargetKeyspace, targetVSchema); err != nil {
-		return err
+		return nil, err
 	}
 	ms.Cell = cell
 	ms.TabletTypes = tabletTypes
 	if err := wr.Materialize(ctx, ms); err != nil {
-		return err
+		return nil, err
 	}
 	if err := wr.ts.SaveVSchema(ctx, keyspace, sourceVSchema); err != nil {
+		return nil, err
+	}
+	if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// BackfillLookupVindex creates a lookup vindex, waits for its backfill to complete,
+// verifies that the lookup table covers the source table and externalizes the vindex.
+// Progress is reported through the logger every pollInterval. If the context is
+// canceled before the backfill completes, the workflow is left in place and the
+// vindex can be externalized later with ExternalizeVindex.
+func (wr *Wrangler) BackfillLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string, pollInterval time.Duration) error {
+	ms, err := wr.createLookupVindex(ctx, keyspace, specs, cell, tabletTypes)
+	if err != nil {
 		return err
 	}
+	// prepareCreateLookup has validated that there's exactly one table and ColumnVindex.
+	var sourceTableName string
+	var colVindex *vschemapb.ColumnVindex
+	for name, table := range specs.Tables {
+		sourceTableName = name
+		colVindex = table.ColumnVindexes[0]
+	}
+	sourceColumns := colVindex.Columns
+	if len(sourceColumns) == 0 {
+		sourceColumns = []string{colVindex.Column}
+	}
+	qualifiedVindexName := keyspace + "." + colVindex.Name
 
+	wr.Logger().Infof("Waiting for the backfill of %s, workflow %s.%s", qualifiedVindexName, ms.TargetKeyspace, ms.Workflow)
+	if err := wr.waitForLookupBackfill(ctx, ms, pollInterval); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("backfill of %s was interrupted, workflow %s.%s was left in place: use ExternalizeVindex once it completes: %v", qualifiedVindexName, ms.TargetKeyspace, ms.Workflow, err)
+		}
+		return err
+	}
+	if err := wr.verifyLookupBackfill(ctx, ms, sourceTableName, sourceColumns); err != nil {
+		return err
+	}
+	wr.Logger().Infof("Backfill of %s is complete, externalizing", qualifiedVindexName)
+	if err := wr.ExternalizeVindex(ctx, qualifiedVindexName); err != nil {
+		return err
+	}
 	return wr.ts.RebuildSrvVSchema(ctx, nil)
 }
 
+// waitForLookupBackfill polls the streams of the backfill workflow until the copy
+// phase has completed on all target shards. For an owned vindex, the streams must
+// have stopped after copy. Otherwise, they must be running with no tables left to copy.
+func (wr *Wrangler) waitForLookupBackfill(ctx context.Context, ms *vtctldatapb.MaterializeSettings, pollInterval time.Duration) error {
+	targetShards, err := wr.ts.GetServingShards(ctx, ms.TargetKeyspace)
+	if err != nil {
+		return err
+	}
+	for {
+		total, done := 0, 0
+		for _, targetShard := range targetShards {
+			targetMaster, err := wr.ts.GetTablet(ctx, targetShard.MasterAlias)
+			if err != nil {
+				return err
+			}
+			p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(ms.Workflow), encodeString(targetMaster.DbName())))
+			if err != nil {
+				return err
+			}
+			qr := sqltypes.Proto3ToResult(p3qr)
+			var running []string
+			for _, row := range qr.Rows {
+				total++
+				id := row[0].ToString()
+				state := row[1].ToString()
+				message := row[2].ToString()
+				switch {
+				case state == binlogplayer.BlpError:
+					return fmt.Errorf("stream %s for %v.%v is in Error state: %v", id, targetShard.Keyspace(), targetShard.ShardName(), message)
+				case ms.StopAfterCopy && state == binlogplayer.BlpStopped && strings.Contains(message, "Stopped after copy"):
+					done++
+				case !ms.StopAfterCopy && state == binlogplayer.BlpRunning:
+					running = append(running, id)
+				}
+			}
+			if len(running) == 0 {
+				continue
+			}
+			p3qr, err = wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(running, ", ")))
+			if err != nil {
+				return err
+			}
+			done += len(running) - len(p3qr.Rows)
+		}
+		if total == 0 {
+			return fmt.Errorf("no streams found for workflow %s.%s", ms.TargetKeyspace, ms.Workflow)
+		}
+		if done == total {
+			return nil
+		}
+		wr.Logger().Infof("Workflow %s.%s: %d of %d streams have completed the backfill", ms.TargetKeyspace, ms.Workflow, done, total)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// verifyLookupBackfill verifies that the lookup table has at least as many rows
+// as there are distinct vindex values in the source table.
+func (wr *Wrangler) verifyLookupBackfill(ctx context.Context, ms *vtctldatapb.MaterializeSettings, sourceTableName string, sourceColumns []string) error {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select count(distinct ")
+	prefix := ""
+	for _, col := range sourceColumns {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	buf.Myprintf(") from %v", sqlparser.NewTableIdent(sourceTableName))
+	sourceCount, err := wr.countRows(ctx, ms.SourceKeyspace, buf.String())
+	if err != nil {
+		return err
+	}
+
+	targetTableName := ms.TableSettings[0].TargetTable
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select count(*) from %v", sqlparser.NewTableIdent(targetTableName))
+	targetCount, err := wr.countRows(ctx, ms.TargetKeyspace, buf.String())
+	if err != nil {
+		return err
+	}
+	wr.Logger().Infof("Lookup table %s.%s has %d rows for %d distinct values in %s.%s", ms.TargetKeyspace, targetTableName, targetCount, sourceCount, ms.SourceKeyspace, sourceTableName)
+	if targetCount < sourceCount {
+		return fmt.Errorf("lookup table %s.%s has %d rows, want at least %d: the vindex was not externalized", ms.TargetKeyspace, targetTableName, targetCount, sourceCount)
+	}
+	return nil
+}
+
+// countRows runs the count query on the masters of all serving shards of the keyspace
+// and returns the sum of the results.
+func (wr *Wrangler) countRows(ctx context.Context, keyspace, query string) (int64, error) {
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, shard := range shards {
+		master, err := wr.ts.GetTablet(ctx, shard.MasterAlias)
+		if err != nil {
+			return 0, err
+		}
+		p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 1, false, false)
+		if err != nil {
+			return 0, err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		if len(qr.Rows) != 1 {
+			return 0, fmt.Errorf("unexpected result for %s on %v: %v", query, topoproto.TabletAliasString(master.Alias), qr.Rows)
+		}
+		count, err := sqltypes.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
 // prepareCreateLookup performs the preparatory steps for creating a lookup vindex.
 func (wr *Wrangler) prepareCreateLookup(ctx context.Context, keyspace string, specs *vschemapb.Keyspace) (ms *vtctldatapb.MaterializeSettings, sourceVSchema, targetVSchema *vschemapb.Keyspace, err error) {
 	// Important variables are pulled out here.
@@ -219,6 +393,11 @@ func (wr *Wrangler) prepareCreateLookup(ctx context.Context, keyspace string, sp
 	if vindex.Owner != "" && vindex.Owner != sourceTableName {
 		return nil, nil, nil, fmt.Errorf("vindex owner must match table name: %v vs %v", vindex.Owner, sourceTableName)
 	}
+	// Consistent lookups lock the owner rows to resolve conflicts.
+	// They cannot be kept consistent without an owner.
+	if strings.HasPrefix(vindex.Type, "consistent_lookup") && vindex.Owner == "" {
+		return nil, nil, nil, fmt.Errorf("vindex %s of type %s must have an owner", vindexName, vindex.Type)
+	}
 	if len(sourceTable.ColumnVindexes[0].Columns) != 0 {
 		sourceVindexColumns = sourceTable.ColumnVindexes[0].Columns
 	} else {
@@ -526,6 +705,9 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 
 // Materialize performs the steps needed to materialize a list of tables based on the materialization specs.
 func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
+	if err := grpcclient.ValidateCompression(ms.Compression); err != nil {
+		return err
+	}
 	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
 		return err
 	}
@@ -563,9 +745,12 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 		}
 	}
 
-	sourceShards, err := wr.ts.GetServingShards(ctx, ms.SourceKeyspace)
-	if err != nil {
-		return nil, err
+	var sourceShards []*topo.ShardInfo
+	if ms.ExternalMysql == "" {
+		sourceShards, err = wr.ts.GetServingShards(ctx, ms.SourceKeyspace)
+		if err != nil {
+			return nil, err
+		}
 	}
 	targetShards, err := wr.ts.GetServingShards(ctx, ms.TargetKeyspace)
 	if err != nil {
@@ -596,10 +781,18 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 			}
 			createddl := ts.CreateDdl
 			if createddl == "copy" {
+				if mz.ms.ExternalMysql != "" {
+					return fmt.Errorf("schema cannot be copied from the external mysql %v: table %v needs a create ddl", mz.ms.ExternalMysql, ts.TargetTable)
+				}
 				sourceTableName, err := sqlparser.TableFromStatement(ts.SourceExpression)
 				if err != nil {
 					return err
 				}
+				if stmt, err := sqlparser.Parse(ts.SourceExpression); err == nil {
+					if sel, ok := stmt.(*sqlparser.Select); ok && sel.GroupBy != nil {
+						return fmt.Errorf("schema cannot be copied for the aggregate expression of table %v: %v", ts.TargetTable, ts.SourceExpression)
+					}
+				}
 				if sourceTableName.Name.String() != ts.TargetTable {
 					return fmt.Errorf("source and target table names must match for copying schema: %v vs %v", sqlparser.String(sourceTableName), ts.TargetTable)
 				}
@@ -631,13 +824,23 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, "{{.dbname}}")
 
+	var sources []*binlogdatapb.BinlogSource
+	if mz.ms.ExternalMysql != "" {
+		// A single stream copies and replicates from the external mysql.
+		sources = append(sources, &binlogdatapb.BinlogSource{
+			ExternalMysql: mz.ms.ExternalMysql,
+		})
+	}
 	for _, source := range mz.sourceShards {
-		bls := &binlogdatapb.BinlogSource{
-			Keyspace:      mz.ms.SourceKeyspace,
-			Shard:         source.ShardName(),
-			Filter:        &binlogdatapb.Filter{},
-			StopAfterCopy: mz.ms.StopAfterCopy,
-		}
+		sources = append(sources, &binlogdatapb.BinlogSource{
+			Keyspace:    mz.ms.SourceKeyspace,
+			Shard:       source.ShardName(),
+			Compression: mz.ms.Compression,
+		})
+	}
+	for _, bls := range sources {
+		bls.Filter = &binlogdatapb.Filter{}
+		bls.StopAfterCopy = mz.ms.StopAfterCopy
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
 				Match: ts.TargetTable,
@@ -662,6 +865,10 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 					if err != nil {
 						return "", err
 					}
+					// Every row of a group must go to the same shard.
+					if sel.GroupBy != nil && !isGroupedBy(col, colName, sel.GroupBy) {
+						return "", fmt.Errorf("vindex column %v must be in the group by of the aggregate expression: %v", sqlparser.String(col), ts.SourceExpression)
+					}
 					mappedCols = append(mappedCols, colName)
 				}
 				subExprs := make(sqlparser.SelectExprs, 0, len(mappedCols)+2)
@@ -689,6 +896,21 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 	return ig.String(), nil
 }
 
+// isGroupedBy returns true if the group by references the column of the target,
+// or the source column it's selected from, like vreplication does.
+func isGroupedBy(col sqlparser.ColIdent, source *sqlparser.ColName, groupBy sqlparser.GroupBy) bool {
+	for _, expr := range groupBy {
+		colName, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		if colName.Name.Equal(col) || colName.Name.Equal(source.Name) {
+			return true
+		}
+	}
+	return false
+}
+
 func matchColInSelect(col sqlparser.ColIdent, sel *sqlparser.Select) (*sqlparser.ColName, error) {
 	for _, selExpr := range sel.SelectExprs {
 		switch selExpr := selExpr.(type) {
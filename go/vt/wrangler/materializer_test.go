@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -54,7 +55,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", "")
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -86,7 +87,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", "")
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -224,6 +225,165 @@ func TestCreateLookupVindexFull(t *testing.T) {
 	assert.Equal(t, wantvschema, vschema)
 }
 
+func newTestBackfillLookupEnv(t *testing.T) (*testMaterializerEnv, *vschemapb.Keyspace) {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "lkp_vdx",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+
+	specs := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"v": {
+				Type: "consistent_lookup_unique",
+				Params: map[string]string{
+					"table": "targetks.lkp",
+					"from":  "c1",
+					"to":    "c2",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "v",
+					Column: "col2",
+				}},
+			},
+		},
+	}
+	sourceVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "hash",
+					Column: "col1",
+				}},
+			},
+		},
+	}
+	env.tmc.schema[ms.SourceKeyspace+".t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Schema: "CREATE TABLE `t1` (\n" +
+				"  `col1` int(11) NOT NULL AUTO_INCREMENT,\n" +
+				"  `col2` int(11) DEFAULT NULL,\n" +
+				"  PRIMARY KEY (`id`)\n" +
+				") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=latin1",
+		}},
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), ms.TargetKeyspace, &vschemapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, sourceVSchema); err != nil {
+		t.Fatal(err)
+	}
+
+	env.tmc.expectVRQuery(200, "/CREATE TABLE `lkp`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+	return env, specs
+}
+
+func TestBackfillLookupVindex(t *testing.T) {
+	env, specs := newTestBackfillLookupEnv(t)
+	defer env.close()
+
+	fields := sqltypes.MakeTestFields(
+		"id|state|message",
+		"int64|varbinary|varbinary",
+	)
+	streamQuery := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Running|"))
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Stopped|Stopped after copy"))
+	env.tmc.expectVRQuery(100, "select count(distinct col2) from t1", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), "3"))
+	env.tmc.expectVRQuery(200, "select count(*) from lkp", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), "3"))
+	// ExternalizeVindex
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Stopped|Stopped after copy"))
+	env.tmc.expectVRQuery(200, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+
+	ctx := context.Background()
+	err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "MASTER", time.Millisecond)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.topoServ.GetVSchema(ctx, "sourceks")
+	require.NoError(t, err)
+	wantVindex := &vschemapb.Vindex{
+		Type: "consistent_lookup_unique",
+		Params: map[string]string{
+			"table": "targetks.lkp",
+			"from":  "c1",
+			"to":    "c2",
+		},
+		Owner: "t1",
+	}
+	assert.Equal(t, wantVindex, vschema.Vindexes["v"])
+}
+
+func TestBackfillLookupVindexIncomplete(t *testing.T) {
+	env, specs := newTestBackfillLookupEnv(t)
+	defer env.close()
+
+	fields := sqltypes.MakeTestFields(
+		"id|state|message",
+		"int64|varbinary|varbinary",
+	)
+	streamQuery := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Stopped|Stopped after copy"))
+	env.tmc.expectVRQuery(100, "select count(distinct col2) from t1", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), "3"))
+	env.tmc.expectVRQuery(200, "select count(*) from lkp", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), "2"))
+
+	ctx := context.Background()
+	err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "MASTER", time.Millisecond)
+	assert.EqualError(t, err, "lookup table targetks.lkp has 2 rows, want at least 3: the vindex was not externalized")
+
+	vschema, err := env.topoServ.GetVSchema(ctx, "sourceks")
+	require.NoError(t, err)
+	assert.Equal(t, "true", vschema.Vindexes["v"].Params["write_only"])
+}
+
+func TestBackfillLookupVindexCanceled(t *testing.T) {
+	env, specs := newTestBackfillLookupEnv(t)
+	defer env.close()
+
+	fields := sqltypes.MakeTestFields(
+		"id|state|message",
+		"int64|varbinary|varbinary",
+	)
+	streamQuery := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Running|"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "MASTER", time.Hour)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backfill of sourceks.v was interrupted, workflow targetks.lkp_vdx was left in place")
+}
+
+func TestBackfillLookupVindexStreamError(t *testing.T) {
+	env, specs := newTestBackfillLookupEnv(t)
+	defer env.close()
+
+	fields := sqltypes.MakeTestFields(
+		"id|state|message",
+		"int64|varbinary|varbinary",
+	)
+	streamQuery := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	env.tmc.expectVRQuery(200, streamQuery, sqltypes.MakeTestResult(fields, "1|Error|duplicate key"))
+
+	err := env.wr.BackfillLookupVindex(context.Background(), "sourceks", specs, "cell", "MASTER", time.Millisecond)
+	assert.EqualError(t, err, "stream 1 for targetks.0 is in Error state: duplicate key")
+}
+
 func TestCreateLookupVindexCreateDDL(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		SourceKeyspace: "sourceks",
@@ -441,6 +601,84 @@ func TestCreateLookupVindexCreateDDL(t *testing.T) {
 	}
 }
 
+func TestCreateLookupVindexConsistent(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "col1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, vs); err != nil {
+		t.Fatal(err)
+	}
+	env.tmc.schema[ms.SourceKeyspace+".t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Schema: "CREATE TABLE `t1` (\n" +
+				"  `col1` int(11) NOT NULL AUTO_INCREMENT,\n" +
+				"  `col2` int(11) DEFAULT NULL,\n" +
+				"  PRIMARY KEY (`id`)\n" +
+				") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=latin1",
+		}},
+	}
+
+	specs := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"v": {
+				Type: "consistent_lookup_unique",
+				Params: map[string]string{
+					"table": fmt.Sprintf("%s.lkp", ms.TargetKeyspace),
+					"from":  "c1",
+					"to":    "c2",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "v",
+					Column: "col2",
+				}},
+			},
+		},
+	}
+	outms, sourceVSchema, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs)
+	require.NoError(t, err)
+
+	// The backfill must stop after copy because vtgate keeps the
+	// lookup table consistent from then on.
+	assert.True(t, outms.StopAfterCopy)
+	assert.Equal(t, "select col2 as c1, keyspace_id() as c2 from t1 group by c1, c2", outms.TableSettings[0].SourceExpression)
+
+	wantVindex := &vschemapb.Vindex{
+		Type: "consistent_lookup_unique",
+		Params: map[string]string{
+			"table":      "targetks.lkp",
+			"from":       "c1",
+			"to":         "c2",
+			"write_only": "true",
+		},
+		Owner: "t1",
+	}
+	assert.Equal(t, wantVindex, sourceVSchema.Vindexes["v"])
+}
+
 func TestCreateLookupVindexSourceVSchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		SourceKeyspace: "sourceks",
@@ -1203,6 +1441,28 @@ func TestCreateLookupVindexFailures(t *testing.T) {
 			},
 		},
 		err: "vindex owner must match table name: otherTable vs t1",
+	}, {
+		description: "consistent lookup without owner",
+		input: &vschemapb.Keyspace{
+			Vindexes: map[string]*vschemapb.Vindex{
+				"v": {
+					Type: "consistent_lookup_unique",
+					Params: map[string]string{
+						"table": "targetks.t",
+						"from":  "c1",
+					},
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"t1": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{
+						Name:   "v",
+						Column: "col",
+					}},
+				},
+			},
+		},
+		err: "vindex v of type consistent_lookup_unique must have an owner",
 	}, {
 		description: "owner must match",
 		input: &vschemapb.Keyspace{
@@ -2146,3 +2406,221 @@ func TestMaterializerNoVindexInExpression(t *testing.T) {
 	err := env.wr.Materialize(context.Background(), ms)
 	assert.EqualError(t, err, "could not find vindex column c1")
 }
+
+func TestMaterializerAggregate(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c1, sum(c2) as c2, count(c3) as c3, count(*) as c4 from t1 group by c1",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`.*shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select c1, sum\(c2\) as c2, count\(c3\) as c3, count\(\*\) as c4 from t1 where in_keyrange\(c1.*targetks\.hash.*-80.*group by c1.*`,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(
+		210,
+		insertPrefix+
+			`.*shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select c1, sum\(c2\) as c2, count\(c3\) as c3, count\(\*\) as c4 from t1 where in_keyrange\(c1.*targetks\.hash.*80-.*group by c1.*`,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+// TestMaterializerAggregateAliased groups by the source column of the vindex column.
+func TestMaterializerAggregateAliased(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c as c1, sum(c2*c3) as c2, count(*) as c4 from t1 group by c",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`.*shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select c as c1, sum\(c2 \* c3\) as c2, count\(\*\) as c4 from t1 where in_keyrange\(c, .*targetks\.hash.*-80.*group by c\\".*`,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(
+		210,
+		insertPrefix+
+			`.*shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select c as c1, sum\(c2 \* c3\) as c2, count\(\*\) as c4 from t1 where in_keyrange\(c, .*targetks\.hash.*80-.*group by c\\".*`,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerAggregateNotGroupedByVindex(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c1, c2, count(*) as c3 from t1 group by c2",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "vindex column c1 must be in the group by of the aggregate expression: select c1, c2, count(*) as c3 from t1 group by c2")
+}
+
+func TestMaterializerAggregateCopySchema(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c1, count(*) as c2 from t1 group by c1",
+			CreateDdl:        "copy",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	delete(env.tmc.schema, "targetks.t1")
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "schema cannot be copied for the aggregate expression of table t1: select c1, count(*) as c2 from t1 group by c1")
+}
+
+func TestMaterializerExternalMySQL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		TargetKeyspace: "targetks",
+		ExternalMysql:  "legacy",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('workflow', 'filter:<rules:<match:\\"t1\\" filter:\\"select.*t1\\" > > external_mysql:\\"legacy\\" ', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks'\)`+
+			eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerExternalMySQLCopySchema(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		TargetKeyspace: "targetks",
+		ExternalMysql:  "legacy",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "copy",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	delete(env.tmc.schema, "targetks.t1")
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "schema cannot be copied from the external mysql legacy: table t1 needs a create ddl")
+}
@@ -23,12 +23,15 @@ This file handles the reparenting operations.
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
@@ -823,11 +826,239 @@ func (wr *Wrangler) chooseNewMaster(
 	return maxPosSearch.maxPosTablet.Alias, nil
 }
 
+// EmergencyReparentConstraints restrict the tablets EmergencyReparentShard
+// can promote.
+type EmergencyReparentConstraints struct {
+	// PreferredCells are the cells whose tablets are chosen, among
+	// the most advanced ones, over the tablets of the other cells.
+	PreferredCells []string
+
+	// ForbiddenCells are the cells whose tablets are never promoted.
+	ForbiddenCells []string
+
+	// Tags are the tablet tags the new master must have.
+	Tags map[string]string
+
+	// MaxDataLoss is, if not zero, the largest time between the last
+	// heartbeat of the old master the new master applied and the one
+	// the most advanced tablet of the shard applied. It requires
+	// -heartbeat_enable on the tablets. If it is set and a tablet which
+	// cannot be promoted is more advanced than the new master, the new
+	// master catches up with the most advanced tablet before its
+	// promotion. Otherwise, EmergencyReparentShard fails.
+	MaxDataLoss time.Duration
+}
+
+// check returns why the tablet cannot be promoted, or an empty string.
+func (c *EmergencyReparentConstraints) check(tablet *topodatapb.Tablet) string {
+	if c == nil {
+		return ""
+	}
+	for _, cell := range c.ForbiddenCells {
+		if tablet.Alias.Cell == cell {
+			return fmt.Sprintf("cell %v is forbidden", cell)
+		}
+	}
+	for key, value := range c.Tags {
+		if tablet.Tags[key] != value {
+			return fmt.Sprintf("tag %v is %q instead of %q", key, tablet.Tags[key], value)
+		}
+	}
+	return ""
+}
+
+func (c *EmergencyReparentConstraints) preferred(tablet *topodatapb.Tablet) bool {
+	if c == nil {
+		return false
+	}
+	for _, cell := range c.PreferredCells {
+		if tablet.Alias.Cell == cell {
+			return true
+		}
+	}
+	return false
+}
+
+// eligible returns why the tablet cannot be chosen as the new master
+// by EmergencyReparentShard, or an empty string.
+func (c *EmergencyReparentConstraints) eligible(tablet *topodatapb.Tablet) string {
+	if tablet.Type != topodatapb.TabletType_REPLICA {
+		return fmt.Sprintf("type %v cannot be promoted", tablet.Type)
+	}
+	return c.check(tablet)
+}
+
+// lastHeartbeat returns the last heartbeat of the shard the tablet applied.
+func (wr *Wrangler) lastHeartbeat(ctx context.Context, keyspace, shard string, tablet *topodatapb.Tablet) (time.Time, error) {
+	query := fmt.Sprintf("select ts from _vt.heartbeat where keyspaceShard=%s", encodeString(keyspace+":"+shard))
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return time.Time{}, fmt.Errorf("no heartbeat")
+	}
+	ts, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, ts), nil
+}
+
+// mostAdvancedTablet returns the alias of the tablet of statusMap with the
+// most advanced position. If several positions are not comparable, the
+// first one found is kept.
+func mostAdvancedTablet(statusMap map[string]*replicationdatapb.Status) (string, error) {
+	aliases := make([]string, 0, len(statusMap))
+	for alias := range statusMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var mostAdvanced string
+	var mostAdvancedPos mysql.Position
+	for _, alias := range aliases {
+		pos, err := mysql.DecodePosition(statusMap[alias].Position)
+		if err != nil {
+			return "", fmt.Errorf("cannot decode slave %v position %v: %v", alias, statusMap[alias].Position, err)
+		}
+		if mostAdvanced == "" || (pos.AtLeast(mostAdvancedPos) && !mostAdvancedPos.AtLeast(pos)) {
+			mostAdvanced = alias
+			mostAdvancedPos = pos
+		}
+	}
+	return mostAdvanced, nil
+}
+
+// dataLossReference returns the last heartbeat applied by the most
+// advanced tablet, which the data loss of the candidates is measured
+// against, if constraints.MaxDataLoss is set.
+func (wr *Wrangler) dataLossReference(ctx context.Context, keyspace, shard string, tabletMap map[string]*topo.TabletInfo, statusMap map[string]*replicationdatapb.Status, constraints *EmergencyReparentConstraints) (time.Time, error) {
+	if constraints == nil || constraints.MaxDataLoss == 0 {
+		return time.Time{}, nil
+	}
+	alias, err := mostAdvancedTablet(statusMap)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if alias == "" {
+		return time.Time{}, fmt.Errorf("no tablet returned its replication position")
+	}
+	heartbeat, err := wr.lastHeartbeat(ctx, keyspace, shard, tabletMap[alias].Tablet)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot read the last heartbeat of the most advanced tablet %v: %v", alias, err)
+	}
+	return heartbeat, nil
+}
+
+// checkDataLoss returns why the data the tablet misses compared to the
+// most advanced tablet, whose last heartbeat is reference, exceeds
+// constraints.MaxDataLoss, or an empty string.
+func (wr *Wrangler) checkDataLoss(ctx context.Context, keyspace, shard string, tablet *topodatapb.Tablet, reference time.Time, constraints *EmergencyReparentConstraints) string {
+	if constraints == nil || constraints.MaxDataLoss == 0 {
+		return ""
+	}
+	heartbeat, err := wr.lastHeartbeat(ctx, keyspace, shard, tablet)
+	if err != nil {
+		return fmt.Sprintf("cannot read its last heartbeat: %v", err)
+	}
+	if loss := reference.Sub(heartbeat); loss > constraints.MaxDataLoss {
+		return fmt.Sprintf("its last heartbeat is %v behind the most advanced tablet", loss.Round(time.Second))
+	}
+	return ""
+}
+
+// chooseEmergencyReparentCandidate returns the most advanced of the
+// replicas whose replication is stopped which satisfy the constraints.
+// Among the most advanced ones, the tablets of the preferred cells are
+// chosen first. reference is the last heartbeat of the most advanced
+// tablet, see dataLossReference.
+func (wr *Wrangler) chooseEmergencyReparentCandidate(ctx context.Context, keyspace, shard string, tabletMap map[string]*topo.TabletInfo, statusMap map[string]*replicationdatapb.Status, reference time.Time, constraints *EmergencyReparentConstraints) (*topo.TabletInfo, error) {
+	aliases := make([]string, 0, len(statusMap))
+	for alias := range statusMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var candidate *topo.TabletInfo
+	var candidatePos mysql.Position
+	var rejected []string
+	for _, alias := range aliases {
+		tabletInfo := tabletMap[alias]
+		reason := constraints.eligible(tabletInfo.Tablet)
+		if reason == "" {
+			reason = wr.checkDataLoss(ctx, keyspace, shard, tabletInfo.Tablet, reference, constraints)
+		}
+		pos, err := mysql.DecodePosition(statusMap[alias].Position)
+		if reason == "" && err != nil {
+			reason = fmt.Sprintf("cannot decode its position %v: %v", statusMap[alias].Position, err)
+		}
+		if reason != "" {
+			rejected = append(rejected, fmt.Sprintf("%v: %v", alias, reason))
+			continue
+		}
+		if candidate == nil ||
+			(pos.AtLeast(candidatePos) && (!candidatePos.AtLeast(pos) || (constraints.preferred(tabletInfo.Tablet) && !constraints.preferred(candidate.Tablet)))) {
+			candidate = tabletInfo
+			candidatePos = pos
+		}
+	}
+	for _, reason := range rejected {
+		wr.logger.Infof("tablet %v cannot be the new master", reason)
+	}
+	if candidate == nil {
+		return nil, fmt.Errorf("no tablet can be the new master: %v", strings.Join(rejected, "; "))
+	}
+	return candidate, nil
+}
+
+// catchUpMasterElect makes the master elect replicate from the most
+// advanced tablet until it applied all its transactions, and stops its
+// replication again. The most advanced tablet must have all the
+// transactions of the other tablets.
+func (wr *Wrangler) catchUpMasterElect(ctx context.Context, masterElect *topo.TabletInfo, tabletMap map[string]*topo.TabletInfo, statusMap map[string]*replicationdatapb.Status, waitReplicasTimeout time.Duration) error {
+	alias, err := mostAdvancedTablet(statusMap)
+	if err != nil {
+		return err
+	}
+	mostAdvancedPos, err := mysql.DecodePosition(statusMap[alias].Position)
+	if err != nil {
+		return err
+	}
+	for other, status := range statusMap {
+		pos, err := mysql.DecodePosition(status.Position)
+		if err != nil {
+			return fmt.Errorf("cannot decode slave %v position %v: %v", other, status.Position, err)
+		}
+		if !mostAdvancedPos.AtLeast(pos) {
+			return fmt.Errorf("the positions of tablets %v and %v diverge, the master elect %v cannot catch up with both: %v, %v", alias, other, masterElect.AliasString(), statusMap[alias].Position, status.Position)
+		}
+	}
+
+	wr.logger.Infof("master elect %v catches up with the most advanced tablet %v", masterElect.AliasString(), alias)
+	ctx, cancel := context.WithTimeout(ctx, waitReplicasTimeout)
+	defer cancel()
+	if err := wr.tmc.SetMaster(ctx, masterElect.Tablet, tabletMap[alias].Alias, 0, statusMap[alias].Position, true); err != nil {
+		return fmt.Errorf("master elect %v failed to catch up with the most advanced tablet %v: %v", masterElect.AliasString(), alias, err)
+	}
+	if err := wr.tmc.StopSlave(ctx, masterElect.Tablet); err != nil {
+		return fmt.Errorf("master elect %v failed to stop replicating from the most advanced tablet %v: %v", masterElect.AliasString(), alias, err)
+	}
+	return nil
+}
+
 // EmergencyReparentShard will make the provided tablet the master for
-// the shard, when the old master is completely unreachable.
-func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) (err error) {
+// the shard, when the old master is completely unreachable. If no tablet
+// is provided, the most advanced replica which satisfies the constraints
+// is chosen. If a tablet is provided, it must satisfy them.
+func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, constraints *EmergencyReparentConstraints) (err error) {
 	// lock the shard
-	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, fmt.Sprintf("EmergencyReparentShard(%v)", topoproto.TabletAliasString(masterElectTabletAlias)))
+	lockAction := "EmergencyReparentShard"
+	if masterElectTabletAlias != nil {
+		lockAction = fmt.Sprintf("EmergencyReparentShard(%v)", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, lockAction)
 	if lockErr != nil {
 		return lockErr
 	}
@@ -837,7 +1068,7 @@ func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard
 	ev := &events.Reparent{}
 
 	// do the work
-	err = wr.emergencyReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, waitReplicasTimeout)
+	err = wr.emergencyReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, waitReplicasTimeout, constraints)
 	if err != nil {
 		event.DispatchUpdate(ev, "failed EmergencyReparentShard: "+err.Error())
 	} else {
@@ -846,7 +1077,7 @@ func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard
 	return err
 }
 
-func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, constraints *EmergencyReparentConstraints) error {
 	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
 	if err != nil {
 		return err
@@ -860,14 +1091,22 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 	}
 
 	// Check invariants we're going to depend on.
-	masterElectTabletAliasStr := topoproto.TabletAliasString(masterElectTabletAlias)
-	masterElectTabletInfo, ok := tabletMap[masterElectTabletAliasStr]
-	if !ok {
-		return fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
-	}
-	ev.NewMaster = *masterElectTabletInfo.Tablet
-	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+	var masterElectTabletAliasStr string
+	var masterElectTabletInfo *topo.TabletInfo
+	if masterElectTabletAlias != nil {
+		masterElectTabletAliasStr = topoproto.TabletAliasString(masterElectTabletAlias)
+		var ok bool
+		masterElectTabletInfo, ok = tabletMap[masterElectTabletAliasStr]
+		if !ok {
+			return fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
+		}
+		ev.NewMaster = *masterElectTabletInfo.Tablet
+		if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
+			return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+		}
+		if reason := constraints.check(masterElectTabletInfo.Tablet); reason != "" {
+			return fmt.Errorf("master-elect tablet %v cannot be promoted: %v", masterElectTabletAliasStr, reason)
+		}
 	}
 
 	// Deal with the old master: try to remote-scrap it, if it's
@@ -929,7 +1168,29 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 		return fmt.Errorf("lost topology lock, aborting: %v", err)
 	}
 
-	// Verify masterElect is alive and has the most advanced position
+	// Choose the masterElect if it was not provided, or check how much
+	// data it may miss.
+	reference, err := wr.dataLossReference(ctx, keyspace, shard, tabletMap, statusMap, constraints)
+	if err != nil {
+		return err
+	}
+	if masterElectTabletAlias == nil {
+		candidate, err := wr.chooseEmergencyReparentCandidate(ctx, keyspace, shard, tabletMap, statusMap, reference, constraints)
+		if err != nil {
+			return err
+		}
+		masterElectTabletInfo = candidate
+		masterElectTabletAlias = candidate.Alias
+		masterElectTabletAliasStr = candidate.AliasString()
+		ev.NewMaster = *candidate.Tablet
+		wr.logger.Infof("chose %v as the new master", masterElectTabletAliasStr)
+	} else if reason := wr.checkDataLoss(ctx, keyspace, shard, masterElectTabletInfo.Tablet, reference, constraints); reason != "" {
+		return fmt.Errorf("master-elect tablet %v cannot be promoted: %v", masterElectTabletAliasStr, reason)
+	}
+
+	// Verify masterElect is alive and has the most advanced position.
+	// With a data loss constraint, it catches up with the tablets which
+	// are more advanced but cannot be promoted.
 	masterElectStatus, ok := statusMap[masterElectTabletAliasStr]
 	if !ok {
 		return fmt.Errorf("couldn't get master elect %v replication position", topoproto.TabletAliasString(masterElectTabletAlias))
@@ -938,6 +1199,7 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 	if err != nil {
 		return fmt.Errorf("cannot decode master elect position %v: %v", masterElectStatus.Position, err)
 	}
+	catchUp := false
 	for alias, status := range statusMap {
 		if alias == masterElectTabletAliasStr {
 			continue
@@ -946,8 +1208,22 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 		if err != nil {
 			return fmt.Errorf("cannot decode slave %v position %v: %v", alias, status.Position, err)
 		}
-		if !masterElectPos.AtLeast(pos) {
-			return fmt.Errorf("tablet %v is more advanced than master elect tablet %v: %v > %v", alias, masterElectTabletAliasStr, status.Position, masterElectStatus)
+		if masterElectPos.AtLeast(pos) {
+			continue
+		}
+		reason := constraints.eligible(tabletMap[alias].Tablet)
+		if reason != "" && constraints != nil && constraints.MaxDataLoss != 0 {
+			catchUp = true
+			continue
+		}
+		if reason != "" {
+			return fmt.Errorf("tablet %v is more advanced than master elect tablet %v: %v > %v, and it cannot be promoted (%v): set a maximum data loss to let the master elect catch up with it", alias, masterElectTabletAliasStr, status.Position, masterElectStatus, reason)
+		}
+		return fmt.Errorf("tablet %v is more advanced than master elect tablet %v: %v > %v", alias, masterElectTabletAliasStr, status.Position, masterElectStatus)
+	}
+	if catchUp {
+		if err := wr.catchUpMasterElect(ctx, masterElectTabletInfo, tabletMap, statusMap, waitReplicasTimeout); err != nil {
+			return err
 		}
 	}
 
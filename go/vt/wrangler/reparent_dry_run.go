@@ -0,0 +1,285 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// PlannedReparentCandidate is the replication state of a tablet of the
+// shard, as seen by PlannedReparentShardDryRun.
+type PlannedReparentCandidate struct {
+	Tablet      string
+	Type        string
+	Position    string `json:",omitempty"`
+	Replicating bool
+	LagSeconds  uint32
+	SemiSync    bool
+
+	// Reason is why the tablet cannot be the new master.
+	Reason string `json:",omitempty"`
+
+	// Health is why the tablet may not catch up with the master in time,
+	// if it is the new master. It doesn't prevent choosing the tablet.
+	Health string `json:",omitempty"`
+
+	// rtt is the round trip time of SlaveStatus.
+	rtt time.Duration
+	pos mysql.Position
+}
+
+// PlannedReparentPlan is returned by PlannedReparentShardDryRun.
+type PlannedReparentPlan struct {
+	Keyspace       string
+	Shard          string
+	CurrentMaster  string
+	MasterSemiSync bool
+
+	// NewMaster is the tablet which would be promoted. It is empty if
+	// PlannedReparentShard has nothing to do.
+	NewMaster  string
+	Candidates []*PlannedReparentCandidate
+
+	// CatchUpTime is the time the new master needs to catch up with the
+	// current master, which still accepts writes meanwhile.
+	CatchUpTime string
+
+	// ExpectedDowntime is the time during which the writes are refused,
+	// from the demotion of the current master until the new master has
+	// written the reparent journal. It is estimated from the round trip
+	// times of the tablets and the replication lag of the new master,
+	// which PromoteSlaveWhenCaughtUp waits for once the current master
+	// is demoted. It doesn't include the time the current master needs
+	// to finish its transactions.
+	ExpectedDowntime string
+
+	// Problems are the reasons why PlannedReparentShard would fail.
+	Problems []string `json:",omitempty"`
+
+	// Warnings are the reasons why PlannedReparentShard may fail, such
+	// as the Health of the new master.
+	Warnings []string `json:",omitempty"`
+}
+
+// PlannedReparentShardDryRun reports what PlannedReparentShard would do
+// with the same arguments, without changing anything: the tablet which
+// would be promoted, the replication state of the candidates, the
+// semi-sync status and the expected downtime. Without a master-elect,
+// the tablet is chosen by chooseNewMaster, as in PlannedReparentShard.
+// The problems which would make PlannedReparentShard fail are in the
+// plan, not in the error.
+func (wr *Wrangler) PlannedReparentShardDryRun(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) (*PlannedReparentPlan, error) {
+	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	plan := &PlannedReparentPlan{
+		Keyspace: keyspace,
+		Shard:    shard,
+	}
+
+	// Same defaults and invariants as PlannedReparentShard.
+	if masterElectTabletAlias == nil && avoidMasterAlias == nil {
+		avoidMasterAlias = shardInfo.MasterAlias
+	}
+	if topoproto.TabletAliasIsZero(shardInfo.MasterAlias) {
+		plan.Problems = append(plan.Problems, "the shard has no master, use EmergencyReparentShard")
+	}
+	if masterElectTabletAlias != nil && topoproto.TabletAliasEqual(masterElectTabletAlias, avoidMasterAlias) {
+		plan.Problems = append(plan.Problems, fmt.Sprintf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias)))
+	}
+
+	var masterRTT time.Duration
+	currentMaster := wr.findCurrentMaster(tabletMap)
+	if currentMaster == nil {
+		plan.Problems = append(plan.Problems, "cannot determine the current master, all the tablets would be demoted")
+	} else {
+		plan.CurrentMaster = currentMaster.AliasString()
+		masterRTT, err = wr.dryRunMasterCheck(ctx, currentMaster.Tablet)
+		if err != nil {
+			plan.Problems = append(plan.Problems, err.Error())
+		}
+		plan.MasterSemiSync = wr.semiSyncStatus(ctx, currentMaster.Tablet, "rpl_semi_sync_master_status")
+	}
+
+	// Without a master-elect, the new master is a replica of the cell of
+	// the master, see chooseNewMaster.
+	// A given master-elect can have any type and be in any cell.
+	var masterCell string
+	if masterElectTabletAlias == nil && shardInfo.MasterAlias != nil {
+		masterCell = shardInfo.MasterAlias.Cell
+	}
+	aliases := make([]string, 0, len(tabletMap))
+	for alias := range tabletMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	candidates := make(map[string]*PlannedReparentCandidate)
+	for _, alias := range aliases {
+		if alias == plan.CurrentMaster {
+			continue
+		}
+		tablet := tabletMap[alias].Tablet
+		c := wr.dryRunCandidate(ctx, tablet)
+		switch {
+		case c.Reason != "":
+		case topoproto.TabletAliasEqual(tablet.Alias, avoidMasterAlias):
+			c.Reason = "it is the tablet to avoid"
+		case masterElectTabletAlias == nil && tablet.Type != topodatapb.TabletType_REPLICA:
+			c.Reason = fmt.Sprintf("type %v cannot be promoted", tablet.Type)
+		case masterCell != "" && tablet.Alias.Cell != masterCell:
+			c.Reason = fmt.Sprintf("it is not in the cell %v of the master", masterCell)
+		}
+		switch {
+		case c.Reason != "":
+		case !c.Replicating:
+			c.Health = "it is not replicating"
+		case time.Duration(c.LagSeconds)*time.Second > waitReplicasTimeout:
+			c.Health = fmt.Sprintf("it lags %vs behind, more than the %v it can take to catch up", c.LagSeconds, waitReplicasTimeout)
+		}
+		plan.Candidates = append(plan.Candidates, c)
+		candidates[alias] = c
+	}
+
+	var newMaster *PlannedReparentCandidate
+	switch {
+	case masterElectTabletAlias != nil:
+		alias := topoproto.TabletAliasString(masterElectTabletAlias)
+		if alias == plan.CurrentMaster {
+			// The master is only refreshed.
+			plan.NewMaster = alias
+			return plan, nil
+		}
+		newMaster = candidates[alias]
+		if newMaster == nil {
+			plan.Problems = append(plan.Problems, fmt.Sprintf("master-elect tablet %v is not in the shard", alias))
+			return plan, nil
+		}
+		if newMaster.Reason != "" {
+			plan.Problems = append(plan.Problems, fmt.Sprintf("master-elect tablet %v cannot be promoted: %v", alias, newMaster.Reason))
+		}
+	case !topoproto.TabletAliasEqual(avoidMasterAlias, shardInfo.MasterAlias):
+		// The current master is not the tablet to avoid, nothing to do.
+		return plan, nil
+	default:
+		alias, err := wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterAlias, waitReplicasTimeout)
+		if err != nil {
+			plan.Problems = append(plan.Problems, err.Error())
+			return plan, nil
+		}
+		if alias == nil {
+			plan.Problems = append(plan.Problems, "cannot find a tablet to reparent to")
+			return plan, nil
+		}
+		newMaster = candidates[topoproto.TabletAliasString(alias)]
+	}
+	plan.NewMaster = newMaster.Tablet
+	plan.CatchUpTime = (time.Duration(newMaster.LagSeconds) * time.Second).String()
+	if newMaster.Health != "" {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("new master %v may not catch up: %v", newMaster.Tablet, newMaster.Health))
+	}
+
+	// While the writes are refused, the current master is demoted, the
+	// new master catches up with it, is promoted and writes the reparent
+	// journal. With semi-sync, the journal is only written once a replica
+	// acknowledges it.
+	downtime := masterRTT + 2*newMaster.rtt + time.Duration(newMaster.LagSeconds)*time.Second
+	if plan.MasterSemiSync {
+		var ackRTT time.Duration
+		for _, c := range plan.Candidates {
+			if c != newMaster && c.SemiSync && (ackRTT == 0 || c.rtt < ackRTT) {
+				ackRTT = c.rtt
+			}
+		}
+		downtime += ackRTT
+	}
+	plan.ExpectedDowntime = downtime.Round(time.Millisecond).String()
+	return plan, nil
+}
+
+// dryRunMasterCheck checks that the master answers and returns its
+// position, and returns its round trip time.
+func (wr *Wrangler) dryRunMasterCheck(ctx context.Context, master *topodatapb.Tablet) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := wr.tmc.Ping(ctx, master); err != nil {
+		return 0, fmt.Errorf("current master %v does not answer: %v", topoproto.TabletAliasString(master.Alias), err)
+	}
+	rtt := time.Since(start)
+	if _, err := wr.tmc.MasterPosition(ctx, master); err != nil {
+		return rtt, fmt.Errorf("can't get replication position on current master %v: %v", topoproto.TabletAliasString(master.Alias), err)
+	}
+	return rtt, nil
+}
+
+// dryRunCandidate reads the replication state of the tablet.
+func (wr *Wrangler) dryRunCandidate(ctx context.Context, tablet *topodatapb.Tablet) *PlannedReparentCandidate {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	c := &PlannedReparentCandidate{
+		Tablet: topoproto.TabletAliasString(tablet.Alias),
+		Type:   tablet.Type.String(),
+	}
+	start := time.Now()
+	status, err := wr.tmc.SlaveStatus(ctx, tablet)
+	if err != nil {
+		c.Reason = fmt.Sprintf("SlaveStatus failed: %v", err)
+		return c
+	}
+	c.rtt = time.Since(start)
+	c.Position = status.Position
+	c.Replicating = status.SlaveIoRunning && status.SlaveSqlRunning
+	c.LagSeconds = status.SecondsBehindMaster
+	c.SemiSync = wr.semiSyncStatus(ctx, tablet, "rpl_semi_sync_slave_status")
+	c.pos, err = mysql.DecodePosition(status.Position)
+	if err != nil {
+		c.Reason = fmt.Sprintf("cannot decode its position %v: %v", status.Position, err)
+	}
+	return c
+}
+
+// semiSyncStatus returns whether the given semi-sync status variable of
+// the tablet is ON. Without the semi-sync plugin, the variable doesn't
+// exist and semi-sync is off.
+func (wr *Wrangler) semiSyncStatus(ctx context.Context, tablet *topodatapb.Tablet, variable string) bool {
+	query := fmt.Sprintf("show global status like %s", encodeString(variable))
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+	if err != nil {
+		wr.logger.Warningf("cannot read the semi-sync status of %v, assuming it is off: %v", topoproto.TabletAliasString(tablet.Alias), err)
+		return false
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	return len(qr.Rows) != 0 && len(qr.Rows[0]) == 2 && qr.Rows[0][1].ToString() == "ON"
+}
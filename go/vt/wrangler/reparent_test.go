@@ -0,0 +1,230 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// heartbeatTMClient returns the last heartbeat of the tablets by uid.
+type heartbeatTMClient struct {
+	tmclient.TabletManagerClient
+	heartbeats map[uint32]time.Time
+}
+
+func (tmc *heartbeatTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	if string(query) != "select ts from _vt.heartbeat where keyspaceShard='ks:0'" {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	heartbeat, ok := tmc.heartbeats[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("table _vt.heartbeat doesn't exist")
+	}
+	return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("ts", "int64"), fmt.Sprintf("%d", heartbeat.UnixNano()))), nil
+}
+
+func TestChooseEmergencyReparentCandidate(t *testing.T) {
+	ctx := context.Background()
+	tabletMap := make(map[string]*topo.TabletInfo)
+	statusMap := make(map[string]*replicationdatapb.Status)
+	addTablet := func(cell string, uid uint32, tabletType topodatapb.TabletType, position string, tags map[string]string) {
+		tablet := &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{Cell: cell, Uid: uid},
+			Type:  tabletType,
+			Tags:  tags,
+		}
+		ti := &topo.TabletInfo{Tablet: tablet}
+		tabletMap[ti.AliasString()] = ti
+		statusMap[ti.AliasString()] = &replicationdatapb.Status{Position: position}
+	}
+	const pos1 = "MySQL56/00000000-0000-0000-0000-000000000001:1-10"
+	const pos2 = "MySQL56/00000000-0000-0000-0000-000000000001:1-11"
+	addTablet("cell1", 100, topodatapb.TabletType_REPLICA, pos1, nil)
+	addTablet("cell2", 200, topodatapb.TabletType_REPLICA, pos1, map[string]string{"region": "east"})
+	addTablet("cell3", 300, topodatapb.TabletType_REPLICA, pos2, nil)
+	addTablet("cell1", 101, topodatapb.TabletType_RDONLY, pos2, nil)
+
+	now := time.Now()
+	tmc := &heartbeatTMClient{
+		heartbeats: map[uint32]time.Time{
+			100: now,
+			200: now.Add(-time.Hour),
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), memorytopo.NewServer("cell1"), tmc)
+
+	testcases := []struct {
+		constraints *EmergencyReparentConstraints
+		want        string
+		wantErr     string
+	}{{
+		// The most advanced replica.
+		want: "cell3-0000000300",
+	}, {
+		constraints: &EmergencyReparentConstraints{ForbiddenCells: []string{"cell3"}},
+		want:        "cell1-0000000100",
+	}, {
+		// The preferred cell wins among the most advanced replicas.
+		constraints: &EmergencyReparentConstraints{ForbiddenCells: []string{"cell3"}, PreferredCells: []string{"cell2"}},
+		want:        "cell2-0000000200",
+	}, {
+		// The preferred cell doesn't win over a more advanced replica.
+		constraints: &EmergencyReparentConstraints{PreferredCells: []string{"cell2"}},
+		want:        "cell3-0000000300",
+	}, {
+		constraints: &EmergencyReparentConstraints{Tags: map[string]string{"region": "east"}},
+		want:        "cell2-0000000200",
+	}, {
+		constraints: &EmergencyReparentConstraints{MaxDataLoss: time.Minute},
+		want:        "cell1-0000000100",
+	}, {
+		constraints: &EmergencyReparentConstraints{MaxDataLoss: time.Minute, ForbiddenCells: []string{"cell1"}},
+		wantErr:     "no tablet can be the new master: cell1-0000000100: cell cell1 is forbidden; cell1-0000000101: type RDONLY cannot be promoted; cell2-0000000200: its last heartbeat is 1h0m0s behind the most advanced tablet; cell3-0000000300: cannot read its last heartbeat: table _vt.heartbeat doesn't exist",
+	}}
+	for _, tcase := range testcases {
+		got, err := wr.chooseEmergencyReparentCandidate(ctx, "ks", "0", tabletMap, statusMap, now, tcase.constraints)
+		if tcase.wantErr != "" {
+			assert.EqualError(t, err, tcase.wantErr, "%+v", tcase.constraints)
+			continue
+		}
+		require.NoError(t, err, "%+v", tcase.constraints)
+		assert.Equal(t, tcase.want, got.AliasString(), "%+v", tcase.constraints)
+	}
+}
+
+// dryRunTMClient adds the semi-sync status of the tablets by uid to
+// precheckTMClient.
+type dryRunTMClient struct {
+	precheckTMClient
+	semiSync map[uint32]bool
+}
+
+func (tmc *dryRunTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	variable := "rpl_semi_sync_slave_status"
+	if tablet.Type == topodatapb.TabletType_MASTER {
+		variable = "rpl_semi_sync_master_status"
+	}
+	if string(query) != fmt.Sprintf("show global status like '%s'", variable) {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	value := "OFF"
+	if tmc.semiSync[tablet.Alias.Uid] {
+		value = "ON"
+	}
+	return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"), variable+"|"+value)), nil
+}
+
+func TestPlannedReparentShardDryRun(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0")
+	for uid, tabletType := range map[uint32]topodatapb.TabletType{
+		200: topodatapb.TabletType_REPLICA,
+		201: topodatapb.TabletType_REPLICA,
+		202: topodatapb.TabletType_REPLICA,
+		203: topodatapb.TabletType_RDONLY,
+	} {
+		require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     tabletType,
+		}))
+	}
+	replicating := func(position string, lag uint32) *replicationdatapb.Status {
+		return &replicationdatapb.Status{
+			Position:            position,
+			SlaveIoRunning:      true,
+			SlaveSqlRunning:     true,
+			SecondsBehindMaster: lag,
+		}
+	}
+	tmc := &dryRunTMClient{
+		precheckTMClient: precheckTMClient{
+			masterPosition: "MySQL56/00000000-0000-0000-0000-000000000001:1-10",
+			status: map[uint32]*replicationdatapb.Status{
+				200: replicating("MySQL56/00000000-0000-0000-0000-000000000001:1-8", 2),
+				201: replicating("MySQL56/00000000-0000-0000-0000-000000000001:1-9", 1),
+				202: replicating("MySQL56/00000000-0000-0000-0000-000000000001:1-5", 60),
+				203: replicating("MySQL56/00000000-0000-0000-0000-000000000001:1-10", 0),
+			},
+		},
+		semiSync: map[uint32]bool{100: true, 200: true},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	// The most advanced replica is chosen.
+	plan, err := wr.PlannedReparentShardDryRun(ctx, "ks", "0", nil, nil, 30*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Problems)
+	assert.Equal(t, "cell1-0000000100", plan.CurrentMaster)
+	assert.True(t, plan.MasterSemiSync)
+	assert.Equal(t, "cell1-0000000201", plan.NewMaster)
+	assert.Equal(t, "1s", plan.CatchUpTime)
+	// The downtime includes the catch up after the demotion.
+	downtime, err := time.ParseDuration(plan.ExpectedDowntime)
+	require.NoError(t, err)
+	assert.True(t, downtime >= time.Second, plan.ExpectedDowntime)
+	require.Len(t, plan.Candidates, 4)
+	assert.True(t, plan.Candidates[0].SemiSync)
+	assert.Empty(t, plan.Candidates[2].Reason)
+	assert.Equal(t, "it lags 60s behind, more than the 30s it can take to catch up", plan.Candidates[2].Health)
+	assert.Equal(t, "type RDONLY cannot be promoted", plan.Candidates[3].Reason)
+
+	// As in PlannedReparentShard, the most advanced replica is chosen
+	// even if it is not replicating, with a warning.
+	tmc.status[202] = &replicationdatapb.Status{Position: "MySQL56/00000000-0000-0000-0000-000000000001:1-10"}
+	plan, err = wr.PlannedReparentShardDryRun(ctx, "ks", "0", nil, nil, 30*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Problems)
+	assert.Equal(t, "cell1-0000000202", plan.NewMaster)
+	assert.Equal(t, []string{"new master cell1-0000000202 may not catch up: it is not replicating"}, plan.Warnings)
+	tmc.status[202] = replicating("MySQL56/00000000-0000-0000-0000-000000000001:1-5", 60)
+
+	// A given master-elect can have any type.
+	plan, err = wr.PlannedReparentShardDryRun(ctx, "ks", "0", &topodatapb.TabletAlias{Cell: "cell1", Uid: 203}, nil, 30*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Problems)
+	assert.Equal(t, "cell1-0000000203", plan.NewMaster)
+	assert.Equal(t, "0s", plan.CatchUpTime)
+
+	plan, err = wr.PlannedReparentShardDryRun(ctx, "ks", "0", &topodatapb.TabletAlias{Cell: "cell1", Uid: 202}, nil, 30*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Problems)
+	assert.Equal(t, []string{"new master cell1-0000000202 may not catch up: it lags 60s behind, more than the 30s it can take to catch up"}, plan.Warnings)
+
+	// The master is not the tablet to avoid.
+	plan, err = wr.PlannedReparentShardDryRun(ctx, "ks", "0", nil, &topodatapb.TabletAlias{Cell: "cell1", Uid: 200}, 30*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Problems)
+	assert.Empty(t, plan.NewMaster)
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ReshardWorkflowOptions are the options of the steps of a resharding
+// workflow. Unlike its shards, they are not saved in the topology, and
+// can be changed when the workflow is resumed.
+type ReshardWorkflowOptions struct {
+	// CopyCheckInterval is how often the copy progress is checked
+	// and logged.
+	CopyCheckInterval time.Duration
+
+	// The parameters of VDiff.
+	SourceCell                  string
+	TargetCell                  string
+	TabletTypes                 string
+	FilteredReplicationWaitTime time.Duration
+	HealthCheckTopologyRefresh  time.Duration
+	HealthCheckRetryDelay       time.Duration
+	HealthCheckTimeout          time.Duration
+
+	// MaxReplicationLag is the largest lag of the streams which
+	// allows switching the traffic.
+	MaxReplicationLag time.Duration
+}
+
+// StartReshardWorkflow runs a resharding workflow up to the point where
+// its traffic can be switched: it creates the missing target shards,
+// starts the vreplication streams, waits for the copy phase to be done
+// and runs VDiff. Its state is saved in the topology after every step,
+// and if the workflow already exists, it is resumed from its last
+// completed step, with the settings it was created with.
+func (wr *Wrangler) StartReshardWorkflow(ctx context.Context, keyspace, workflow string, sources, targets []string, skipSchemaCopy bool, compression string, skipVDiff bool, opts *ReshardWorkflowOptions) error {
+	rwi, err := wr.ts.GetReshardWorkflow(ctx, keyspace, workflow)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		rwi, err = wr.ts.CreateReshardWorkflow(ctx, keyspace, workflow, &topodatapb.ReshardWorkflow{
+			SourceShards:   sources,
+			TargetShards:   targets,
+			SkipSchemaCopy: skipSchemaCopy,
+			Compression:    compression,
+			SkipVdiff:      skipVDiff,
+		})
+		if err != nil {
+			return fmt.Errorf("CreateReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+		}
+	case err != nil:
+		return fmt.Errorf("GetReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	default:
+		if !sameShardNames(rwi.SourceShards, sources) || !sameShardNames(rwi.TargetShards, targets) {
+			return fmt.Errorf("workflow %v.%v already exists with source shards %v and target shards %v", keyspace, workflow, rwi.SourceShards, rwi.TargetShards)
+		}
+		wr.Logger().Infof("Resuming workflow %v.%v from state %v", keyspace, workflow, rwi.State)
+	}
+	return wr.recordReshardWorkflowError(ctx, rwi, wr.runReshardWorkflow(ctx, rwi, opts))
+}
+
+// runReshardWorkflow runs the steps of the workflow from its current
+// state, and saves the state after each of them.
+func (wr *Wrangler) runReshardWorkflow(ctx context.Context, rwi *topo.ReshardWorkflowInfo, opts *ReshardWorkflowOptions) error {
+	keyspace, workflow := rwi.Keyspace(), rwi.Workflow()
+	for {
+		switch rwi.State {
+		case topodatapb.ReshardWorkflow_CREATED:
+			if err := wr.createReshardTargets(ctx, keyspace, rwi.TargetShards); err != nil {
+				return err
+			}
+			// The streams may have been created by a previous run which
+			// could not save the next state.
+			created, err := wr.reshardStreamsCreated(ctx, keyspace, workflow, rwi.TargetShards)
+			if err != nil {
+				return err
+			}
+			if created {
+				wr.Logger().Infof("The streams of workflow %v.%v already exist", keyspace, workflow)
+			} else if err := wr.Reshard(ctx, keyspace, workflow, rwi.SourceShards, rwi.TargetShards, rwi.SkipSchemaCopy, rwi.Compression); err != nil {
+				return err
+			}
+			rwi.State = topodatapb.ReshardWorkflow_COPYING
+		case topodatapb.ReshardWorkflow_COPYING:
+			if err := wr.WaitForWorkflowCopy(ctx, keyspace, workflow, opts.CopyCheckInterval, wr.logCopyProgress); err != nil {
+				return err
+			}
+			rwi.State = topodatapb.ReshardWorkflow_COPIED
+		case topodatapb.ReshardWorkflow_COPIED:
+			if rwi.SkipVdiff {
+				wr.Logger().Printf("Workflow %v.%v is copied and VDiff is skipped, switch the traffic with SwitchReads and then SwitchWrites\n", keyspace, workflow)
+				return nil
+			}
+			reports, err := wr.VDiff(ctx, keyspace, workflow, opts.SourceCell, opts.TargetCell, opts.TabletTypes, opts.FilteredReplicationWaitTime,
+				opts.HealthCheckTopologyRefresh, opts.HealthCheckRetryDelay, opts.HealthCheckTimeout, "" /* format */, 0 /* chunkSize */, "" /* checkpointFile */, false /* resume */)
+			if err != nil {
+				return err
+			}
+			// The report is saved with the error if there are differences.
+			rwi.Validation = tableValidations(reports)
+			if problems := tableValidationProblems(rwi.Validation); len(problems) != 0 {
+				return fmt.Errorf("VDiff found differences between the source and target shards: %v", strings.Join(problems, "; "))
+			}
+			rwi.State = topodatapb.ReshardWorkflow_VERIFIED
+		default:
+			wr.Logger().Printf("Workflow %v.%v is %v, switch the traffic with SwitchReads and then SwitchWrites\n", keyspace, workflow, rwi.State)
+			return nil
+		}
+		rwi.LastError = ""
+		if err := wr.ts.SaveReshardWorkflow(ctx, rwi); err != nil {
+			return fmt.Errorf("SaveReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+		}
+		wr.Logger().Infof("Workflow %v.%v is %v", keyspace, workflow, rwi.State)
+	}
+}
+
+// createReshardTargets creates the target shards which don't exist
+// yet, and checks that they all have a master.
+func (wr *Wrangler) createReshardTargets(ctx context.Context, keyspace string, targets []string) error {
+	var noMaster []string
+	for _, shard := range targets {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if topo.IsErrType(err, topo.NoNode) {
+			wr.Logger().Infof("Creating target shard %v/%v", keyspace, shard)
+			if err := wr.ts.CreateShard(ctx, keyspace, shard); err != nil {
+				return fmt.Errorf("CreateShard(%v, %v) failed: %v", keyspace, shard, err)
+			}
+			noMaster = append(noMaster, shard)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+		}
+		if !si.HasMaster() {
+			noMaster = append(noMaster, shard)
+		}
+	}
+	if len(noMaster) != 0 {
+		return fmt.Errorf("target shards %v of keyspace %v have no master yet: start their tablets, elect their masters and run the command again", strings.Join(noMaster, ","), keyspace)
+	}
+	return nil
+}
+
+// reshardStreamsCreated returns true if the masters of all the target
+// shards already have streams for the workflow, and false if none of
+// them has. Otherwise the workflow was only partly created, and an
+// error is returned.
+func (wr *Wrangler) reshardStreamsCreated(ctx context.Context, keyspace, workflow string, targets []string) (bool, error) {
+	var with, without []string
+	for _, shard := range targets {
+		streams, err := wr.shardWorkflowStreams(ctx, keyspace, shard)
+		if err != nil {
+			return false, fmt.Errorf("cannot read the streams of %v/%v: %v", keyspace, shard, err)
+		}
+		if len(streams[workflow]) != 0 {
+			with = append(with, shard)
+		} else {
+			without = append(without, shard)
+		}
+	}
+	switch {
+	case len(without) == 0:
+		return true, nil
+	case len(with) == 0:
+		return false, nil
+	}
+	return false, fmt.Errorf("workflow %v.%v only has streams on target shards %v and not on %v: delete them with VReplicationExec and run the command again", keyspace, workflow, strings.Join(with, ","), strings.Join(without, ","))
+}
+
+// logCopyProgress logs the rows left to copy on every target shard.
+func (wr *Wrangler) logCopyProgress(progress map[string][]*tabletmanagerdatapb.VReplicationStreamCopyProgress) error {
+	shards := make([]string, 0, len(progress))
+	for shard := range progress {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	for _, shard := range shards {
+		var tables int
+		var rowsCopied, rowsTotal int64
+		for _, stream := range progress[shard] {
+			tables += len(stream.Tables)
+			for _, table := range stream.Tables {
+				rowsCopied += table.RowsCopied
+				rowsTotal += table.RowsTotal
+			}
+		}
+		wr.Logger().Printf("Shard %v: %v tables left to copy, %v/%v rows copied\n", shard, tables, rowsCopied, rowsTotal)
+	}
+	return nil
+}
+
+// ReshardWorkflowSwitchReads switches the rdonly and replica traffic of
+// the workflow to the target shards, once VDiff passed or if the
+// workflow skips it, and if its streams pass checkReshardWorkflowStreams.
+// Each tablet type is recorded in the workflow once switched, so that
+// a failed run can be resumed.
+func (wr *Wrangler) ReshardWorkflowSwitchReads(ctx context.Context, keyspace, workflow string, opts *ReshardWorkflowOptions) error {
+	rwi, err := wr.ts.GetReshardWorkflow(ctx, keyspace, workflow)
+	if err != nil {
+		return fmt.Errorf("GetReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	}
+	switch {
+	case rwi.State == topodatapb.ReshardWorkflow_READS_SWITCHED:
+		wr.Logger().Printf("The reads of workflow %v.%v are already switched\n", keyspace, workflow)
+		return nil
+	case rwi.State == topodatapb.ReshardWorkflow_VERIFIED:
+	case rwi.State == topodatapb.ReshardWorkflow_COPIED && rwi.SkipVdiff:
+	default:
+		return fmt.Errorf("workflow %v.%v is %v, run Start to resume it until VDiff passes", keyspace, workflow, rwi.State)
+	}
+
+	if err := wr.checkReshardWorkflowStreams(ctx, rwi, opts.MaxReplicationLag); err != nil {
+		return wr.recordReshardWorkflowError(ctx, rwi, err)
+	}
+	// The tablet types switched by a previous run are skipped, as well
+	// as the ones switched outside of the workflow.
+	for _, servedType := range []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA} {
+		if topoproto.IsTypeInList(servedType, rwi.ReadsSwitched) {
+			continue
+		}
+		switched, err := wr.reshardReadsSwitched(ctx, keyspace, servedType, rwi.SourceShards, rwi.TargetShards)
+		if err != nil {
+			return wr.recordReshardWorkflowError(ctx, rwi, err)
+		}
+		if switched {
+			wr.Logger().Infof("The %v reads of workflow %v.%v are already switched", servedType, keyspace, workflow)
+		} else if err := wr.SwitchReads(ctx, keyspace, workflow, servedType, nil /* cells */, DirectionForward); err != nil {
+			return wr.recordReshardWorkflowError(ctx, rwi, err)
+		}
+		rwi.ReadsSwitched = append(rwi.ReadsSwitched, servedType)
+		if err := wr.ts.SaveReshardWorkflow(ctx, rwi); err != nil {
+			return fmt.Errorf("SaveReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+		}
+	}
+
+	rwi.State = topodatapb.ReshardWorkflow_READS_SWITCHED
+	rwi.LastError = ""
+	if err := wr.ts.SaveReshardWorkflow(ctx, rwi); err != nil {
+		return fmt.Errorf("SaveReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	}
+	wr.Logger().Printf("The reads of workflow %v.%v are switched, switch the writes with SwitchWrites\n", keyspace, workflow)
+	return nil
+}
+
+// reshardReadsSwitched returns true if the servedType traffic of the
+// keyspace goes to all the target shards and none of the source shards,
+// in all the cells which serve the keyspace.
+func (wr *Wrangler) reshardReadsSwitched(ctx context.Context, keyspace string, servedType topodatapb.TabletType, sources, targets []string) (bool, error) {
+	cells, err := wr.ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return false, fmt.Errorf("GetCellInfoNames failed: %v", err)
+	}
+	served := false
+	for _, cell := range cells {
+		srvKeyspace, err := wr.ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		case err != nil:
+			return false, fmt.Errorf("GetSrvKeyspace(%v, %v) failed: %v", cell, keyspace, err)
+		}
+		partition := topoproto.SrvKeyspaceGetPartition(srvKeyspace, servedType)
+		if partition == nil {
+			return false, nil
+		}
+		shards := make(map[string]bool)
+		for _, ref := range partition.ShardReferences {
+			shards[ref.Name] = true
+		}
+		for _, shard := range targets {
+			if !shards[shard] {
+				return false, nil
+			}
+		}
+		for _, shard := range sources {
+			if shards[shard] {
+				return false, nil
+			}
+		}
+		served = true
+	}
+	return served, nil
+}
+
+// ReshardWorkflowSwitchWrites switches the master traffic of the
+// workflow to the target shards, once its reads are switched, if its
+// last VDiff found no difference and if its streams pass
+// checkReshardWorkflowStreams. The workflow is then
+// complete, and its state is deleted from the topology.
+func (wr *Wrangler) ReshardWorkflowSwitchWrites(ctx context.Context, keyspace, workflow string, opts *ReshardWorkflowOptions) error {
+	rwi, err := wr.ts.GetReshardWorkflow(ctx, keyspace, workflow)
+	if err != nil {
+		return fmt.Errorf("GetReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	}
+	if rwi.State != topodatapb.ReshardWorkflow_READS_SWITCHED {
+		return fmt.Errorf("workflow %v.%v is %v, its reads must be switched with SwitchReads first", keyspace, workflow, rwi.State)
+	}
+
+	if problems := tableValidationProblems(rwi.Validation); len(problems) != 0 {
+		return fmt.Errorf("the last VDiff of workflow %v.%v found differences: %v", keyspace, workflow, strings.Join(problems, "; "))
+	}
+	if err := wr.checkReshardWorkflowStreams(ctx, rwi, opts.MaxReplicationLag); err != nil {
+		return wr.recordReshardWorkflowError(ctx, rwi, err)
+	}
+	if _, err := wr.SwitchWrites(ctx, keyspace, workflow, opts.FilteredReplicationWaitTime, false /* cancelMigrate */, true /* reverseReplication */); err != nil {
+		return wr.recordReshardWorkflowError(ctx, rwi, err)
+	}
+
+	if err := wr.ts.DeleteReshardWorkflow(ctx, rwi); err != nil {
+		return fmt.Errorf("DeleteReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)
+	}
+	wr.Logger().Printf("The writes of workflow %v.%v are switched, the workflow is complete\n", keyspace, workflow)
+	return nil
+}
+
+// checkReshardWorkflowStreams checks that the streams of the workflow
+// run on all the target shards, without error and with a lag of at
+// most maxLag.
+func (wr *Wrangler) checkReshardWorkflowStreams(ctx context.Context, rwi *topo.ReshardWorkflowInfo, maxLag time.Duration) error {
+	if problems := wr.workflowStreamProblems(ctx, rwi.Keyspace(), rwi.Workflow(), rwi.TargetShards, maxLag); len(problems) != 0 {
+		return fmt.Errorf("the streams of workflow %v.%v are not ready to switch the traffic: %v", rwi.Keyspace(), rwi.Workflow(), strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// tableValidations converts the VDiff reports into the validation of
+// a workflow, sorted by table.
+func tableValidations(reports map[string]*DiffReport) []*topodatapb.ReshardWorkflow_TableValidation {
+	tables := make([]string, 0, len(reports))
+	for table := range reports {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	validation := make([]*topodatapb.ReshardWorkflow_TableValidation, 0, len(tables))
+	for _, table := range tables {
+		dr := reports[table]
+		validation = append(validation, &topodatapb.ReshardWorkflow_TableValidation{
+			Table:           table,
+			ProcessedRows:   int64(dr.ProcessedRows),
+			MatchingRows:    int64(dr.MatchingRows),
+			MismatchedRows:  int64(dr.MismatchedRows),
+			ExtraRowsSource: int64(dr.ExtraRowsSource),
+			ExtraRowsTarget: int64(dr.ExtraRowsTarget),
+		})
+	}
+	return validation
+}
+
+// tableValidationProblems returns the differences of the tables
+// of the validation.
+func tableValidationProblems(validation []*topodatapb.ReshardWorkflow_TableValidation) []string {
+	var problems []string
+	for _, tv := range validation {
+		if tv.MismatchedRows != 0 || tv.ExtraRowsSource != 0 || tv.ExtraRowsTarget != 0 {
+			problems = append(problems, fmt.Sprintf("%v: %v mismatched rows, %v extra rows on the source, %v extra rows on the target", tv.Table, tv.MismatchedRows, tv.ExtraRowsSource, tv.ExtraRowsTarget))
+		}
+	}
+	return problems
+}
+
+// reshardValidationProblems returns the differences between the source
+// and target shards of a resharding workflow, which prevent switching
+// its writes. For a workflow run with the Reshard actions, they are the
+// ones found by its last VDiff, unless it skips VDiff. Otherwise, VDiff
+// is run.
+func (wr *Wrangler) reshardValidationProblems(ctx context.Context, keyspace, workflow string, params *SwitchWritesPrecheckParams) []string {
+	rwi, err := wr.ts.GetReshardWorkflow(ctx, keyspace, workflow)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return wr.vdiffProblems(ctx, keyspace, workflow, params)
+	case err != nil:
+		return []string{fmt.Sprintf("GetReshardWorkflow(%v, %v) failed: %v", keyspace, workflow, err)}
+	case rwi.SkipVdiff:
+		return nil
+	}
+	problems := tableValidationProblems(rwi.Validation)
+	if len(problems) == 0 && rwi.State < topodatapb.ReshardWorkflow_VERIFIED {
+		problems = append(problems, fmt.Sprintf("workflow %v.%v is %v and VDiff has not passed yet, run Reshard Start to resume it", keyspace, workflow, rwi.State))
+	}
+	return problems
+}
+
+// recordReshardWorkflowError saves err as the last error of the
+// workflow, and returns it.
+func (wr *Wrangler) recordReshardWorkflowError(ctx context.Context, rwi *topo.ReshardWorkflowInfo, err error) error {
+	if err == nil {
+		return nil
+	}
+	rwi.LastError = err.Error()
+	if saveErr := wr.ts.SaveReshardWorkflow(ctx, rwi); saveErr != nil {
+		wr.Logger().Warningf("Cannot save the last error of workflow %v.%v: %v", rwi.Keyspace(), rwi.Workflow(), saveErr)
+	}
+	return err
+}
+
+// sameShardNames returns true if a and b have the same shards,
+// in any order.
+func sameShardNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestStartReshardWorkflowCreatesTargets(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0")
+	wr := New(logutil.NewMemoryLogger(), ts, &workflowStatusTMClient{})
+	opts := &ReshardWorkflowOptions{CopyCheckInterval: time.Millisecond}
+
+	// The target shards are created, but the workflow cannot go on
+	// without their masters.
+	err := wr.StartReshardWorkflow(ctx, "ks", "wf", []string{"0"}, []string{"-80", "80-"}, false, "", false, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "target shards -80,80- of keyspace ks have no master yet")
+	shards, err := ts.GetShardNames(ctx, "ks")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"0", "-80", "80-"}, shards)
+
+	rwi, err := ts.GetReshardWorkflow(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.ReshardWorkflow_CREATED, rwi.State)
+	assert.Contains(t, rwi.LastError, "have no master yet")
+
+	// The workflow can only be resumed with the same shards.
+	err = wr.StartReshardWorkflow(ctx, "ks", "wf", []string{"0"}, []string{"-40", "40-"}, false, "", false, opts)
+	assert.EqualError(t, err, "workflow ks.wf already exists with source shards [0] and target shards [-80 80-]")
+
+	// The traffic cannot be switched before VDiff passes.
+	err = wr.ReshardWorkflowSwitchReads(ctx, "ks", "wf", opts)
+	assert.EqualError(t, err, "workflow ks.wf is CREATED, run Start to resume it until VDiff passes")
+	err = wr.ReshardWorkflowSwitchWrites(ctx, "ks", "wf", opts)
+	assert.EqualError(t, err, "workflow ks.wf is CREATED, its reads must be switched with SwitchReads first")
+}
+
+func TestReshardWorkflowSwitchReadsPrechecks(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0", "-80", "80-")
+	_, err := ts.CreateReshardWorkflow(ctx, "ks", "wf", &topodatapb.ReshardWorkflow{
+		SourceShards: []string{"0"},
+		TargetShards: []string{"-80", "80-"},
+		State:        topodatapb.ReshardWorkflow_VERIFIED,
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	row := func(id int64, state, message string, transactionTimestamp int64) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarBinary("wf"), sqltypes.NewVarBinary(`keyspace:"ks" shard:"0" `), sqltypes.NewVarBinary("pos"), sqltypes.NewVarBinary(state), sqltypes.NewVarBinary(message), sqltypes.NewInt64(now), sqltypes.NewInt64(transactionTimestamp)}
+	}
+	tmc := &workflowStatusTMClient{
+		rows: map[uint32][][]sqltypes.Value{
+			101: {row(1, "Running", "", now)},
+			102: {row(1, "Running", "", now-100), row(2, "Error", "duplicate key", now)},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	switchErr := wr.ReshardWorkflowSwitchReads(ctx, "ks", "wf", &ReshardWorkflowOptions{MaxReplicationLag: 30 * time.Second})
+	require.Error(t, switchErr)
+	assert.Contains(t, switchErr.Error(), "80-: stream 1 lags 100s behind; 80-: stream 2 is Error")
+
+	// The workflow stays verified, with the failed prechecks.
+	rwi, err := ts.GetReshardWorkflow(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.ReshardWorkflow_VERIFIED, rwi.State)
+	assert.Equal(t, switchErr.Error(), rwi.LastError)
+}
+
+func TestReshardValidationProblems(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0", "-80", "80-")
+	wr := New(logutil.NewMemoryLogger(), ts, &workflowStatusTMClient{})
+
+	validation := tableValidations(map[string]*DiffReport{
+		"t2": {ProcessedRows: 10, MatchingRows: 8, MismatchedRows: 1, ExtraRowsTarget: 1},
+		"t1": {ProcessedRows: 10, MatchingRows: 10},
+	})
+	require.Len(t, validation, 2)
+	assert.Equal(t, "t1", validation[0].Table)
+	assert.Equal(t, int64(8), validation[1].MatchingRows)
+
+	rwi, err := ts.CreateReshardWorkflow(ctx, "ks", "wf", &topodatapb.ReshardWorkflow{
+		SourceShards: []string{"0"},
+		TargetShards: []string{"-80", "80-"},
+		State:        topodatapb.ReshardWorkflow_COPIED,
+	})
+	require.NoError(t, err)
+	params := &SwitchWritesPrecheckParams{}
+	assert.Equal(t, []string{"workflow ks.wf is COPIED and VDiff has not passed yet, run Reshard Start to resume it"}, wr.reshardValidationProblems(ctx, "ks", "wf", params))
+
+	// The differences found by the last VDiff prevent switching the writes.
+	rwi.Validation = validation
+	require.NoError(t, ts.SaveReshardWorkflow(ctx, rwi))
+	assert.Equal(t, []string{"t2: 1 mismatched rows, 0 extra rows on the source, 1 extra rows on the target"}, wr.reshardValidationProblems(ctx, "ks", "wf", params))
+
+	rwi.Validation = validation[:1]
+	rwi.State = topodatapb.ReshardWorkflow_READS_SWITCHED
+	require.NoError(t, ts.SaveReshardWorkflow(ctx, rwi))
+	assert.Empty(t, wr.reshardValidationProblems(ctx, "ks", "wf", params))
+}
+
+func TestStartReshardWorkflowExistingStreams(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0", "-80", "80-")
+	_, err := ts.CreateReshardWorkflow(ctx, "ks", "wf", &topodatapb.ReshardWorkflow{
+		SourceShards: []string{"0"},
+		TargetShards: []string{"-80", "80-"},
+		SkipVdiff:    true,
+	})
+	require.NoError(t, err)
+
+	row := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("wf"), sqltypes.NewVarBinary(`keyspace:"ks" shard:"0" `), sqltypes.NewVarBinary("pos"), sqltypes.NewVarBinary("Running"), sqltypes.NewVarBinary(""), sqltypes.NewInt64(time.Now().Unix()), sqltypes.NewInt64(time.Now().Unix())}
+	tmc := &workflowStatusTMClient{
+		rows: map[uint32][][]sqltypes.Value{
+			101: {row},
+			102: {},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+	opts := &ReshardWorkflowOptions{CopyCheckInterval: time.Millisecond}
+
+	// Only some of the streams were created by the previous run.
+	err = wr.StartReshardWorkflow(ctx, "ks", "wf", []string{"0"}, []string{"-80", "80-"}, false, "", true, opts)
+	assert.EqualError(t, err, "workflow ks.wf only has streams on target shards -80 and not on 80-: delete them with VReplicationExec and run the command again")
+
+	// All the streams exist: Reshard is not run again, and the workflow
+	// goes on with the copy.
+	tmc.rows[102] = [][]sqltypes.Value{row}
+	copied := []*tabletmanagerdatapb.VReplicationStreamCopyProgress{{Id: 1, Workflow: "wf"}}
+	tmc.progress = map[uint32][]*tabletmanagerdatapb.VReplicationStreamCopyProgress{101: copied, 102: copied}
+	err = wr.StartReshardWorkflow(ctx, "ks", "wf", []string{"0"}, []string{"-80", "80-"}, false, "", true, opts)
+	require.NoError(t, err)
+	rwi, err := ts.GetReshardWorkflow(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.ReshardWorkflow_COPIED, rwi.State)
+	assert.Empty(t, rwi.LastError)
+}
+
+func TestReshardWorkflowSwitchReadsResumes(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "0", "-80", "80-")
+	// The replica reads were switched by a previous run, and the rdonly
+	// ones outside of the workflow.
+	_, err := ts.CreateReshardWorkflow(ctx, "ks", "wf", &topodatapb.ReshardWorkflow{
+		SourceShards:  []string{"0"},
+		TargetShards:  []string{"-80", "80-"},
+		State:         topodatapb.ReshardWorkflow_VERIFIED,
+		ReadsSwitched: []topodatapb.TabletType{topodatapb.TabletType_REPLICA},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", "ks", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType: topodatapb.TabletType_RDONLY,
+			ShardReferences: []*topodatapb.ShardReference{
+				{Name: "-80", KeyRange: &topodatapb.KeyRange{End: []byte{0x80}}},
+				{Name: "80-", KeyRange: &topodatapb.KeyRange{Start: []byte{0x80}}},
+			},
+		}},
+	}))
+
+	row := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("wf"), sqltypes.NewVarBinary(`keyspace:"ks" shard:"0" `), sqltypes.NewVarBinary("pos"), sqltypes.NewVarBinary("Running"), sqltypes.NewVarBinary(""), sqltypes.NewInt64(time.Now().Unix()), sqltypes.NewInt64(time.Now().Unix())}
+	tmc := &workflowStatusTMClient{
+		rows: map[uint32][][]sqltypes.Value{
+			101: {row},
+			102: {row},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	// SwitchReads is not called again for any tablet type.
+	require.NoError(t, wr.ReshardWorkflowSwitchReads(ctx, "ks", "wf", &ReshardWorkflowOptions{MaxReplicationLag: 30 * time.Second}))
+	rwi, err := ts.GetReshardWorkflow(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.ReshardWorkflow_READS_SWITCHED, rwi.State)
+	assert.Equal(t, []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY}, rwi.ReadsSwitched)
+}
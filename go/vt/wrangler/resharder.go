@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -47,6 +48,8 @@ type resharder struct {
 	targetMasters map[string]*topo.TabletInfo
 	vschema       *vschemapb.Keyspace
 	refStreams    map[string]*refStream
+	// compression is the gRPC compressor of the new streams.
+	compression string
 }
 
 type refStream struct {
@@ -56,8 +59,12 @@ type refStream struct {
 	tabletTypes string
 }
 
-// Reshard initiates a resharding workflow.
-func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sources, targets []string, skipSchemaCopy bool) error {
+// Reshard initiates a resharding workflow. If compression is set, the
+// source tablets compress the events they stream with it.
+func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sources, targets []string, skipSchemaCopy bool, compression string) error {
+	if err := grpcclient.ValidateCompression(compression); err != nil {
+		return err
+	}
 	if err := wr.validateNewWorkflow(ctx, keyspace, workflow); err != nil {
 		return err
 	}
@@ -66,6 +73,7 @@ func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sour
 	if err != nil {
 		return vterrors.Wrap(err, "buildResharder")
 	}
+	rs.compression = compression
 	if !skipSchemaCopy {
 		if err := rs.copySchema(ctx); err != nil {
 			return vterrors.Wrap(err, "copySchema")
@@ -294,9 +302,10 @@ func (rs *resharder) createStreams(ctx context.Context) error {
 				}),
 			}
 			bls := &binlogdatapb.BinlogSource{
-				Keyspace: rs.keyspace,
-				Shard:    source.ShardName(),
-				Filter:   filter,
+				Keyspace:    rs.keyspace,
+				Shard:       source.ShardName(),
+				Filter:      filter,
+				Compression: rs.compression,
 			}
 			ig.AddRow(rs.workflow, bls, "", "", "")
 		}
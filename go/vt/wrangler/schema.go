@@ -267,6 +267,104 @@ func (wr *Wrangler) ValidateSchemaKeyspace(ctx context.Context, keyspace string,
 	return nil
 }
 
+// SchemaDriftKeyspace compares the table definitions of the masters of
+// all the shards of the keyspace, and returns the tables which differ.
+func (wr *Wrangler) SchemaDriftKeyspace(ctx context.Context, keyspace string, excludeTables []string, includeViews bool) ([]*tmutils.TableDrift, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+
+	var mu sync.Mutex
+	schemas := make(map[string]*tabletmanagerdatapb.SchemaDefinition, len(shards))
+	er := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+		}
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shard)
+		}
+		wg.Add(1)
+		go func(shard string, alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+			sd, err := wr.GetSchema(ctx, alias, nil, excludeTables, includeViews)
+			if err != nil {
+				er.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", alias, excludeTables, includeViews, err))
+				return
+			}
+			mu.Lock()
+			schemas[shard] = sd
+			mu.Unlock()
+		}(shard, si.MasterAlias)
+	}
+	wg.Wait()
+	if er.HasErrors() {
+		return nil, er.Error()
+	}
+	return tmutils.SchemaDrift(schemas), nil
+}
+
+// ApplyDeclarativeSchema changes the schema of every shard of the
+// keyspace into the one created by the CREATE TABLE statements of
+// desired. Tables which are not in desired are dropped only if allowDrop
+// is set.
+//
+// The masters compute the statements which make the changes against
+// their own schema. The changes of all the shards are computed and
+// validated with a dry run before any of them is applied, so that an
+// invalid schema changes nothing. Then the masters apply them, from
+// where they replicate to the slaves. A shard which fails doesn't stop
+// the others: the result of every shard is logged, and the error lists
+// the shards which failed. If dryRun is set, the statements are only
+// logged.
+func (wr *Wrangler) ApplyDeclarativeSchema(ctx context.Context, keyspace string, desired []string, allowDrop, dryRun bool) error {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	sort.Strings(shards)
+
+	masters := make([]*topodatapb.Tablet, 0, len(shards))
+	for _, shard := range shards {
+		master, err := wr.shardMaster(ctx, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		changes, err := wr.tmc.ApplyDeclarativeSchema(ctx, master, desired, allowDrop, true /* dryRun */)
+		if err != nil {
+			return fmt.Errorf("shard %v/%v: %v", keyspace, shard, err)
+		}
+		if len(changes) == 0 {
+			wr.Logger().Printf("%v/%v: schema is up to date\n", keyspace, shard)
+			continue
+		}
+		for _, change := range changes {
+			wr.Logger().Printf("%v/%v: %v\n", keyspace, shard, change)
+		}
+		masters = append(masters, master)
+	}
+	if dryRun {
+		return nil
+	}
+
+	rec := concurrency.AllErrorRecorder{}
+	for _, master := range masters {
+		changes, err := wr.tmc.ApplyDeclarativeSchema(ctx, master, desired, allowDrop, false /* dryRun */)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("%v/%v: %v", keyspace, master.Shard, err))
+			continue
+		}
+		wr.Logger().Printf("%v/%v: applied %v changes\n", keyspace, master.Shard, len(changes))
+	}
+	return rec.Error()
+}
+
 // PreflightSchema will try a schema change on the remote tablet.
 func (wr *Wrangler) PreflightSchema(ctx context.Context, tabletAlias *topodatapb.TabletAlias, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
@@ -451,3 +549,60 @@ func fillStringTemplate(tmpl string, vars interface{}) (string, error) {
 	}
 	return data.String(), nil
 }
+
+// shardMaster returns the master tablet of a shard.
+func (wr *Wrangler) shardMaster(ctx context.Context, keyspace, shard string) (*topodatapb.Tablet, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %v", si.MasterAlias, err)
+	}
+	return ti.Tablet, nil
+}
+
+// SubmitOnlineDDL queues an ALTER TABLE on the master of the shard,
+// to be run by gh-ost or pt-online-schema-change. It returns the uuid
+// of the migration.
+func (wr *Wrangler) SubmitOnlineDDL(ctx context.Context, keyspace, shard, sql, strategy string) (string, error) {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return "", err
+	}
+	return wr.tmc.SubmitOnlineDDL(ctx, master, sql, strategy)
+}
+
+// GetOnlineDDLMigrations returns the online DDL migration of the
+// shard with the given uuid, or all of them if uuid is empty.
+func (wr *Wrangler) GetOnlineDDLMigrations(ctx context.Context, keyspace, shard, uuid string) ([]*tabletmanagerdatapb.OnlineDDLMigration, error) {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	return wr.tmc.GetOnlineDDLMigrations(ctx, master, uuid)
+}
+
+// CancelOnlineDDL cancels a queued or running online DDL migration
+// of the shard.
+func (wr *Wrangler) CancelOnlineDDL(ctx context.Context, keyspace, shard, uuid string) error {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	return wr.tmc.CancelOnlineDDL(ctx, master, uuid)
+}
+
+// RetryOnlineDDL queues a failed or cancelled online DDL migration
+// of the shard again.
+func (wr *Wrangler) RetryOnlineDDL(ctx context.Context, keyspace, shard, uuid string) error {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	return wr.tmc.RetryOnlineDDL(ctx, master, uuid)
+}
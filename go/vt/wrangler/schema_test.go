@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type declarativeSchemaTMClient struct {
+	tmclient.TabletManagerClient
+	schemas map[uint32]*tabletmanagerdatapb.SchemaDefinition
+	fail    map[uint32]bool
+	applied map[uint32][]string
+}
+
+// ApplyDeclarativeSchema computes the changes like the tablets do.
+func (tmc *declarativeSchemaTMClient) ApplyDeclarativeSchema(ctx context.Context, tablet *topodatapb.Tablet, desired []string, allowDrop, dryRun bool) ([]string, error) {
+	changes, err := tmutils.DeclarativeSchemaChanges(tmc.schemas[tablet.Alias.Uid], desired, allowDrop)
+	if err != nil || dryRun {
+		return changes, err
+	}
+	if tmc.fail[tablet.Alias.Uid] {
+		return nil, fmt.Errorf("tablet is down")
+	}
+	tmc.applied[tablet.Alias.Uid] = append(tmc.applied[tablet.Alias.Uid], changes...)
+	return changes, nil
+}
+
+func TestApplyDeclarativeSchema(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-")
+	table := func(schema string) *tabletmanagerdatapb.SchemaDefinition {
+		return &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+				Name:   "t1",
+				Schema: schema,
+				Type:   "BASE TABLE",
+			}},
+		}
+	}
+	tmc := &declarativeSchemaTMClient{
+		schemas: map[uint32]*tabletmanagerdatapb.SchemaDefinition{
+			100: table("CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"),
+			101: table("not a create table"),
+		},
+		fail:    map[uint32]bool{},
+		applied: map[uint32][]string{},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+	desired := []string{"create table t1 (id bigint(20) not null, c int, primary key (id))"}
+
+	// The schema of 80- is invalid: nothing is applied, also not on -80.
+	err := wr.ApplyDeclarativeSchema(ctx, "ks", desired, false, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "shard ks/80-: cannot parse the schema of table t1")
+	assert.Empty(t, tmc.applied)
+
+	// A shard which fails doesn't stop the others.
+	tmc.schemas[101] = tmc.schemas[100]
+	tmc.fail[100] = true
+	err = wr.ApplyDeclarativeSchema(ctx, "ks", desired, false, false)
+	assert.EqualError(t, err, "ks/-80: tablet is down")
+	assert.Equal(t, map[uint32][]string{101: {"alter table t1 add column c int"}}, tmc.applied)
+
+	// A dry run applies nothing.
+	tmc.fail[100] = false
+	tmc.applied = map[uint32][]string{}
+	err = wr.ApplyDeclarativeSchema(ctx, "ks", desired, false, true)
+	require.NoError(t, err)
+	assert.Empty(t, tmc.applied)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The checks run by CheckSwitchWrites.
+const (
+	PrecheckReplicationLag = "ReplicationLag"
+	PrecheckErrantGTIDs    = "ErrantGTIDs"
+	PrecheckVDiff          = "VDiff"
+	PrecheckTargetHealth   = "TargetHealth"
+)
+
+// SwitchWritesPrecheckParams are the parameters of CheckSwitchWrites.
+type SwitchWritesPrecheckParams struct {
+	// MaxReplicationLag is the largest lag of the streams which
+	// allows switching the writes.
+	MaxReplicationLag time.Duration
+
+	// The parameters of VDiff.
+	VDiffTabletTypes            string
+	FilteredReplicationWaitTime time.Duration
+	HealthCheckTopologyRefresh  time.Duration
+	HealthCheckRetryDelay       time.Duration
+	HealthCheckTimeout          time.Duration
+}
+
+// SwitchWritesPrecheck is the result of one of the checks.
+type SwitchWritesPrecheck struct {
+	Name     string
+	Passed   bool
+	Problems []string `json:",omitempty"`
+}
+
+// SwitchWritesPrecheckReport is returned by CheckSwitchWrites.
+type SwitchWritesPrecheckReport struct {
+	Keyspace string
+	Workflow string
+	Checks   []*SwitchWritesPrecheck
+}
+
+// Passed returns true if all the checks passed.
+func (r *SwitchWritesPrecheckReport) Passed() bool {
+	for _, check := range r.Checks {
+		if !check.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *SwitchWritesPrecheckReport) add(name string, problems []string) {
+	r.Checks = append(r.Checks, &SwitchWritesPrecheck{
+		Name:     name,
+		Passed:   len(problems) == 0,
+		Problems: problems,
+	})
+}
+
+// CheckSwitchWrites checks that the writes of a MoveTables workflow can
+// be switched: its streams run without error and lag at most
+// MaxReplicationLag, the replicas of the target shards have no errant
+// GTIDs, VDiff finds no difference and the target shards are healthy.
+// All the checks run, and the report has the problems found by each of
+// them. For resharding workflows, only the differences between the
+// source and target shards are checked, see reshardValidationProblems.
+// Once the streams are frozen by a previous SwitchWrites, the report is
+// empty.
+func (wr *Wrangler) CheckSwitchWrites(ctx context.Context, targetKeyspace, workflow string, params *SwitchWritesPrecheckParams) (*SwitchWritesPrecheckReport, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+	report := &SwitchWritesPrecheckReport{
+		Keyspace: targetKeyspace,
+		Workflow: workflow,
+	}
+	if ts.frozen {
+		return report, nil
+	}
+	if ts.migrationType == binlogdatapb.MigrationType_SHARDS {
+		report.add(PrecheckVDiff, wr.reshardValidationProblems(ctx, targetKeyspace, workflow, params))
+		return report, nil
+	}
+
+	shards := make([]string, 0, len(ts.targets))
+	for shard := range ts.targets {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+
+	report.add(PrecheckReplicationLag, wr.workflowStreamProblems(ctx, targetKeyspace, workflow, shards, params.MaxReplicationLag))
+
+	var healthProblems, errantProblems []string
+	for _, shard := range shards {
+		health, errant := wr.targetShardProblems(ctx, targetKeyspace, shard)
+		healthProblems = append(healthProblems, health...)
+		errantProblems = append(errantProblems, errant...)
+	}
+	report.add(PrecheckErrantGTIDs, errantProblems)
+
+	report.add(PrecheckVDiff, wr.vdiffProblems(ctx, targetKeyspace, workflow, params))
+	report.add(PrecheckTargetHealth, healthProblems)
+	return report, nil
+}
+
+// vdiffProblems runs VDiff on the workflow and returns the differences
+// it found by table.
+func (wr *Wrangler) vdiffProblems(ctx context.Context, targetKeyspace, workflow string, params *SwitchWritesPrecheckParams) []string {
+	reports, err := wr.VDiff(ctx, targetKeyspace, workflow, "" /* sourceCell */, "" /* targetCell */, params.VDiffTabletTypes, params.FilteredReplicationWaitTime,
+		params.HealthCheckTopologyRefresh, params.HealthCheckRetryDelay, params.HealthCheckTimeout, "" /* format */, 0 /* chunkSize */, "" /* checkpointFile */, false /* resume */)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	return tableValidationProblems(tableValidations(reports))
+}
+
+// workflowStreamProblems checks that the streams of the workflow run
+// on the shards, without error and with a lag of at most maxLag, and
+// returns the problems found.
+func (wr *Wrangler) workflowStreamProblems(ctx context.Context, keyspace, workflow string, shards []string, maxLag time.Duration) []string {
+	var problems []string
+	for _, shard := range shards {
+		streams, err := wr.shardWorkflowStreams(ctx, keyspace, shard)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%v: %v", shard, err))
+			continue
+		}
+		if len(streams[workflow]) == 0 {
+			problems = append(problems, fmt.Sprintf("%v: no stream found", shard))
+			continue
+		}
+		for _, stream := range streams[workflow] {
+			switch {
+			case stream.State != "Running":
+				problems = append(problems, fmt.Sprintf("%v: stream %v is %v", shard, stream.ID, stream.State))
+			case stream.Message != "":
+				problems = append(problems, fmt.Sprintf("%v: stream %v has error: %v", shard, stream.ID, stream.Message))
+			case time.Duration(stream.LagSeconds)*time.Second > maxLag:
+				problems = append(problems, fmt.Sprintf("%v: stream %v lags %vs behind", shard, stream.ID, stream.LagSeconds))
+			}
+		}
+	}
+	return problems
+}
+
+// targetShardProblems checks that the master of the shard serves and
+// answers, and that its replicas replicate from it. It returns these
+// problems, and the replicas which have transactions the master
+// doesn't have. The replica positions are read before the master
+// position, so replicas which are up to date are not reported.
+func (wr *Wrangler) targetShardProblems(ctx context.Context, keyspace, shard string) (health, errant []string) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return []string{fmt.Sprintf("%v: %v", shard, err)}, nil
+	}
+	if !si.HasMaster() {
+		return []string{fmt.Sprintf("%v: no master", shard)}, nil
+	}
+	if !si.IsMasterServing {
+		health = append(health, fmt.Sprintf("%v: master is not serving", shard))
+	}
+	tablets, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return append(health, fmt.Sprintf("%v: %v", shard, err)), nil
+	}
+	master, ok := tablets[topoproto.TabletAliasString(si.MasterAlias)]
+	if !ok {
+		return append(health, fmt.Sprintf("%v: master %v not found", shard, topoproto.TabletAliasString(si.MasterAlias))), nil
+	}
+
+	aliases := make([]string, 0, len(tablets))
+	for alias := range tablets {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	replicaPositions := make(map[string]mysql.Position)
+	for _, alias := range aliases {
+		tablet := tablets[alias]
+		if !topo.IsSlaveType(tablet.Type) {
+			continue
+		}
+		status, err := wr.tmc.SlaveStatus(ctx, tablet.Tablet)
+		if err != nil {
+			health = append(health, fmt.Sprintf("%v: SlaveStatus(%v) failed: %v", shard, alias, err))
+			continue
+		}
+		if !status.SlaveIoRunning || !status.SlaveSqlRunning {
+			health = append(health, fmt.Sprintf("%v: replica %v is not replicating", shard, alias))
+		}
+		pos, err := mysql.DecodePosition(status.Position)
+		if err != nil {
+			health = append(health, fmt.Sprintf("%v: cannot decode the position of %v: %v", shard, alias, err))
+			continue
+		}
+		replicaPositions[alias] = pos
+	}
+
+	if err := wr.tmc.Ping(ctx, master.Tablet); err != nil {
+		return append(health, fmt.Sprintf("%v: master %v does not answer: %v", shard, master.AliasString(), err)), nil
+	}
+	masterPosStr, err := wr.tmc.MasterPosition(ctx, master.Tablet)
+	if err != nil {
+		return append(health, fmt.Sprintf("%v: MasterPosition(%v) failed: %v", shard, master.AliasString(), err)), nil
+	}
+	masterPos, err := mysql.DecodePosition(masterPosStr)
+	if err != nil {
+		return append(health, fmt.Sprintf("%v: cannot decode the position of master %v: %v", shard, master.AliasString(), err)), nil
+	}
+	for _, alias := range aliases {
+		pos, ok := replicaPositions[alias]
+		if ok && !masterPos.AtLeast(pos) {
+			errant = append(errant, fmt.Sprintf("%v: replica %v has transactions which master %v doesn't have: %v is not in %v", shard, alias, master.AliasString(), pos, masterPos))
+		}
+	}
+	return health, errant
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// precheckTMClient returns the replication status of the tablets by uid.
+type precheckTMClient struct {
+	tmclient.TabletManagerClient
+	masterPosition string
+	status         map[uint32]*replicationdatapb.Status
+}
+
+func (tmc *precheckTMClient) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return nil
+}
+
+func (tmc *precheckTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return tmc.masterPosition, nil
+}
+
+func (tmc *precheckTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return tmc.status[tablet.Alias.Uid], nil
+}
+
+func TestTargetShardProblems(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80")
+	for _, uid := range []uint32{200, 201} {
+		require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+			Keyspace: "ks",
+			Shard:    "-80",
+			Type:     topodatapb.TabletType_REPLICA,
+		}))
+	}
+	tmc := &precheckTMClient{
+		masterPosition: "MySQL56/00000000-0000-0000-0000-000000000001:1-10",
+		status: map[uint32]*replicationdatapb.Status{
+			200: {
+				Position:        "MySQL56/00000000-0000-0000-0000-000000000001:1-8",
+				SlaveIoRunning:  true,
+				SlaveSqlRunning: true,
+			},
+			201: {
+				Position:        "MySQL56/00000000-0000-0000-0000-000000000001:1-8,00000000-0000-0000-0000-000000000002:1",
+				SlaveIoRunning:  false,
+				SlaveSqlRunning: true,
+			},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	health, errant := wr.targetShardProblems(ctx, "ks", "-80")
+	assert.Equal(t, []string{"-80: replica cell1-0000000201 is not replicating"}, health)
+	require.Len(t, errant, 1)
+	assert.Contains(t, errant[0], "-80: replica cell1-0000000201 has transactions which master cell1-0000000100 doesn't have")
+
+	// Once the replica catches up and replicates, the shard is healthy.
+	tmc.status[201] = tmc.status[200]
+	health, errant = wr.targetShardProblems(ctx, "ks", "-80")
+	assert.Empty(t, health)
+	assert.Empty(t, errant)
+}
+
+func TestSwitchWritesPrecheckReport(t *testing.T) {
+	report := &SwitchWritesPrecheckReport{}
+	report.add(PrecheckReplicationLag, nil)
+	assert.True(t, report.Passed())
+	report.add(PrecheckVDiff, []string{"t1: 1 mismatched rows, 0 extra rows on the source, 0 extra rows on the target"})
+	assert.False(t, report.Passed())
+	assert.Equal(t, &SwitchWritesPrecheck{Name: PrecheckReplicationLag, Passed: true}, report.Checks[0])
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package testlib
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -24,6 +25,8 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -209,7 +212,7 @@ func TestEmergencyReparentShardMasterElectNotBest(t *testing.T) {
 	defer moreAdvancedSlave.StopActionLoop(t)
 
 	// run EmergencyReparentShard
-	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, 10*time.Second); err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") {
+	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, 10*time.Second, nil); err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") {
 		t.Fatalf("EmergencyReparentShard returned the wrong error: %v", err)
 	}
 
@@ -224,3 +227,125 @@ func TestEmergencyReparentShardMasterElectNotBest(t *testing.T) {
 		t.Fatalf("moreAdvancedSlave.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
 	}
 }
+
+// TestEmergencyReparentShardChoosesEligibleTablet lets EmergencyReparentShard
+// choose the new master when the most advanced tablet cannot be promoted:
+// the new master must then catch up with it.
+func TestEmergencyReparentShardChoosesEligibleTablet(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	// The tablets return their last heartbeat of the old master.
+	now := time.Now()
+	heartbeatDB := func(heartbeat time.Time) *fakesqldb.DB {
+		db := fakesqldb.New(t)
+		db.AddQueryPattern("USE .*", &sqltypes.Result{})
+		db.AddQuery("select ts from _vt.heartbeat where keyspaceShard='test_keyspace:0'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("ts", "int64"), fmt.Sprintf("%d", heartbeat.UnixNano())))
+		return db
+	}
+	newMasterDB := heartbeatDB(now.Add(-time.Second))
+	defer newMasterDB.Close()
+	rdonlyDB := heartbeatDB(now)
+	defer rdonlyDB.Close()
+	laggingDB := heartbeatDB(now.Add(-time.Hour))
+	defer laggingDB.Close()
+
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, newMasterDB)
+	rdonly := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_RDONLY, rdonlyDB)
+	laggingSlave := NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_REPLICA, laggingDB)
+	position := func(sequence uint64) mysql.Position {
+		return mysql.Position{
+			GTIDSet: mysql.MariadbGTIDSet{
+				mysql.MariadbGTID{
+					Domain:   2,
+					Server:   123,
+					Sequence: sequence,
+				},
+			},
+		}
+	}
+
+	newMaster.FakeMysqlDaemon.ReadOnly = true
+	newMaster.FakeMysqlDaemon.Replicating = true
+	newMaster.FakeMysqlDaemon.CurrentMasterPosition = position(456)
+	newMaster.FakeMysqlDaemon.PromoteSlaveResult = position(457)
+	newMaster.StartActionLoop(t, wr)
+	defer newMaster.StopActionLoop(t)
+
+	oldMaster.StartActionLoop(t, wr)
+	defer oldMaster.StopActionLoop(t)
+
+	// The rdonly tablet is more advanced, but cannot be promoted.
+	rdonly.FakeMysqlDaemon.ReadOnly = true
+	rdonly.FakeMysqlDaemon.Replicating = true
+	rdonly.FakeMysqlDaemon.CurrentMasterPosition = position(457)
+	rdonly.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(newMaster.Tablet)
+	rdonly.StartActionLoop(t, wr)
+	defer rdonly.StopActionLoop(t)
+
+	// The lagging replica misses too much data.
+	laggingSlave.FakeMysqlDaemon.ReadOnly = true
+	laggingSlave.FakeMysqlDaemon.Replicating = true
+	laggingSlave.FakeMysqlDaemon.CurrentMasterPosition = position(455)
+	laggingSlave.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(newMaster.Tablet)
+	laggingSlave.StartActionLoop(t, wr)
+	defer laggingSlave.StopActionLoop(t)
+
+	expectQueries := func(tablet *FakeTablet, queries ...string) {
+		tablet.FakeMysqlDaemon.Replicating = true
+		tablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = queries
+		tablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	}
+	checkQueries := func() {
+		for _, tablet := range []*FakeTablet{newMaster, oldMaster, rdonly, laggingSlave} {
+			if err := tablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+				t.Errorf("%v: CheckSuperQueryList failed: %v", topoproto.TabletAliasString(tablet.Tablet.Alias), err)
+			}
+		}
+	}
+
+	// Without a data loss constraint, the transactions of the rdonly
+	// tablet cannot be lost.
+	expectQueries(newMaster, "STOP SLAVE")
+	expectQueries(rdonly, "STOP SLAVE")
+	expectQueries(laggingSlave, "STOP SLAVE")
+	err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, nil, 10*time.Second, nil)
+	if err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") || !strings.Contains(err.Error(), "type RDONLY cannot be promoted") {
+		t.Fatalf("EmergencyReparentShard returned the wrong error: %v", err)
+	}
+	checkQueries()
+
+	// With one, the most advanced replica is chosen and catches up with
+	// the rdonly tablet before its promotion.
+	newMaster.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(rdonly.Tablet)
+	newMaster.FakeMysqlDaemon.WaitMasterPosition = position(457)
+	expectQueries(newMaster,
+		"STOP SLAVE",
+		"FAKE SET MASTER",
+		"START SLAVE",
+		"STOP SLAVE",
+		"CREATE DATABASE IF NOT EXISTS _vt",
+		"SUBCREATE TABLE IF NOT EXISTS _vt.reparent_journal",
+		"SUBINSERT INTO _vt.reparent_journal (time_created_ns, action_name, master_alias, replication_position) VALUES",
+	)
+	expectQueries(rdonly, "STOP SLAVE", "FAKE SET MASTER", "START SLAVE")
+	expectQueries(laggingSlave, "STOP SLAVE", "FAKE SET MASTER", "START SLAVE")
+	constraints := &wrangler.EmergencyReparentConstraints{MaxDataLoss: time.Minute}
+	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, nil, 10*time.Second, constraints); err != nil {
+		t.Fatalf("EmergencyReparentShard failed: %v", err)
+	}
+	checkQueries()
+
+	si, err := ts.GetShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard)
+	if err != nil {
+		t.Fatalf("GetShard failed: %v", err)
+	}
+	if !topoproto.TabletAliasEqual(si.MasterAlias, newMaster.Tablet.Alias) {
+		t.Errorf("the new master is %v, want %v", topoproto.TabletAliasString(si.MasterAlias), topoproto.TabletAliasString(newMaster.Tablet.Alias))
+	}
+	if newMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("newMaster.FakeMysqlDaemon.ReadOnly set")
+	}
+}
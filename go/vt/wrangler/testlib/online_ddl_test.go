@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestOnlineDDL(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	if err := vp.Run([]string{"OnlineDDL", "test_keyspace/0", "show"}); err == nil || !strings.Contains(err.Error(), "node doesn't exist") {
+		t.Errorf("OnlineDDL show without a shard: got %v", err)
+	}
+
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	if err := vp.Run([]string{"OnlineDDL", "test_keyspace/0", "show"}); err == nil || !strings.Contains(err.Error(), "no master in shard test_keyspace/0") {
+		t.Errorf("OnlineDDL show without a master: got %v", err)
+	}
+	if _, err := ts.UpdateShardFields(ctx, master.Tablet.Keyspace, master.Tablet.Shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Tablet.Alias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	master.StartActionLoop(t, wr)
+	defer master.StopActionLoop(t)
+
+	out, err := vp.RunAndOutput([]string{"OnlineDDL", "test_keyspace/0", "show"})
+	if err != nil {
+		t.Fatalf("OnlineDDL show failed: %v", err)
+	}
+	if strings.TrimSpace(out) != "[]" {
+		t.Errorf("OnlineDDL show: got %q, want []", out)
+	}
+
+	// The fake tablet has no database to store the migrations in,
+	// so its executor is not open.
+	if err := vp.Run([]string{"OnlineDDL", "-strategy=pt-osc", "test_keyspace/0", "submit", "alter table t1 add column c int"}); err == nil || !strings.Contains(err.Error(), "online DDL executor is not open") {
+		t.Errorf("OnlineDDL submit: got %v", err)
+	}
+	if err := vp.Run([]string{"OnlineDDL", "test_keyspace/0", "cancel", "unknown"}); err == nil || !strings.Contains(err.Error(), "online DDL migration not found: unknown") {
+		t.Errorf("OnlineDDL cancel: got %v", err)
+	}
+	if err := vp.Run([]string{"OnlineDDL", "test_keyspace/0", "retry"}); err == nil || !strings.Contains(err.Error(), "the <uuid> argument is required for OnlineDDL retry") {
+		t.Errorf("OnlineDDL retry without a uuid: got %v", err)
+	}
+	if err := vp.Run([]string{"OnlineDDL", "test_keyspace/0", "pause"}); err == nil || !strings.Contains(err.Error(), "unknown OnlineDDL action pause") {
+		t.Errorf("OnlineDDL pause: got %v", err)
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// topoRepair is an inconsistency found in the topology by
+// RepairTopology, with the change that fixes it.
+type topoRepair struct {
+	// problem describes the inconsistency.
+	problem string
+	// diff describes the change, one line per removed ("-") or
+	// added ("+") value.
+	diff []string
+	// fix makes the change.
+	fix func(ctx context.Context) error
+}
+
+// RepairTopology looks for the following inconsistencies in the
+// topology, and fixes them:
+// - tablet records whose keyspace or shard doesn't exist are deleted.
+// - ShardReplication entries of tablets that don't exist are removed.
+// - shard master aliases and source shards that point to tablets or
+//   shards that don't exist are cleared.
+// - missing SrvKeyspace records, and SrvKeyspace records referencing
+//   shards that don't exist, are rebuilt in the cells with tablets.
+// If dryRun is set, the changes are only logged.
+func (wr *Wrangler) RepairTopology(ctx context.Context, dryRun bool) error {
+	repairs, err := wr.findTopoRepairs(ctx)
+	if err != nil {
+		return err
+	}
+
+	rec := &concurrency.AllErrorRecorder{}
+	for _, r := range repairs {
+		if dryRun {
+			wr.Logger().Printf("Found: %v\n", r.problem)
+			for _, line := range r.diff {
+				wr.Logger().Printf("  %v\n", line)
+			}
+			continue
+		}
+		if err := r.fix(ctx); err != nil {
+			rec.RecordError(vterrors.Wrapf(err, "cannot fix: %v", r.problem))
+			continue
+		}
+		wr.Logger().Printf("Fixed: %v\n", r.problem)
+	}
+	switch {
+	case len(repairs) == 0:
+		wr.Logger().Printf("No repairable inconsistency found\n")
+	case dryRun:
+		wr.Logger().Printf("Dry run: %v change(s) not applied\n", len(repairs))
+	}
+	if rec.HasErrors() {
+		return rec.AggrError(vterrors.Aggregate)
+	}
+	return nil
+}
+
+// findTopoRepairs returns the repairs RepairTopology makes, in the
+// order they are applied.
+func (wr *Wrangler) findTopoRepairs(ctx context.Context) ([]*topoRepair, error) {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	shards := make(map[string]map[string]*topo.ShardInfo, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		shards[keyspace], err = wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+		if err != nil {
+			return nil, fmt.Errorf("FindAllShardsInKeyspace(%v) failed: %v", keyspace, err)
+		}
+	}
+	shardExists := func(keyspace, shard string) bool {
+		_, ok := shards[keyspace][shard]
+		return ok
+	}
+
+	cells, err := wr.ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetCellInfoNames failed: %v", err)
+	}
+
+	var repairs []*topoRepair
+
+	// Orphaned tablet records. Remember the other tablets, and the
+	// cells each keyspace has tablets in.
+	tablets := make(map[string]*topo.TabletInfo)
+	keyspaceCells := make(map[string]map[string]bool)
+	for _, cell := range cells {
+		aliases, err := wr.ts.GetTabletsByCell(ctx, cell)
+		if err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return nil, fmt.Errorf("GetTabletsByCell(%v) failed: %v", cell, err)
+		}
+		// A partial result would make the tablets we couldn't read look
+		// like they don't exist, so we don't repair anything then.
+		tabletMap, err := wr.ts.GetTabletMap(ctx, aliases)
+		if err != nil {
+			return nil, fmt.Errorf("GetTabletMap(%v) failed: %v", cell, err)
+		}
+		for _, alias := range sortedTabletAliases(tabletMap) {
+			ti := tabletMap[alias]
+			if ti.Keyspace == "" || shardExists(ti.Keyspace, ti.Shard) {
+				tablets[alias] = ti
+				if keyspaceCells[ti.Keyspace] == nil {
+					keyspaceCells[ti.Keyspace] = make(map[string]bool)
+				}
+				keyspaceCells[ti.Keyspace][cell] = true
+				continue
+			}
+			tablet := ti.Tablet
+			repairs = append(repairs, &topoRepair{
+				problem: fmt.Sprintf("tablet %v belongs to shard %v/%v, which doesn't exist", alias, ti.Keyspace, ti.Shard),
+				diff:    []string{fmt.Sprintf("- Tablet(%v): %v", alias, topoproto.TabletTypeLString(tablet.Type))},
+				fix: func(ctx context.Context) error {
+					return topotools.DeleteTablet(ctx, wr.ts, tablet)
+				},
+			})
+		}
+	}
+
+	for _, keyspace := range keyspaces {
+		keyspace := keyspace
+		for _, shard := range sortedShardNames(shards[keyspace]) {
+			shard := shard
+			si := shards[keyspace][shard]
+
+			// Dangling ShardReplication entries.
+			for _, cell := range cells {
+				sri, err := wr.ts.GetShardReplication(ctx, cell, keyspace, shard)
+				if err != nil {
+					if topo.IsErrType(err, topo.NoNode) {
+						continue
+					}
+					return nil, fmt.Errorf("GetShardReplication(%v, %v, %v) failed: %v", cell, keyspace, shard, err)
+				}
+				for _, node := range sri.Nodes {
+					alias := topoproto.TabletAliasString(node.TabletAlias)
+					if _, ok := tablets[alias]; ok {
+						continue
+					}
+					cell, tabletAlias := cell, node.TabletAlias
+					repairs = append(repairs, &topoRepair{
+						problem: fmt.Sprintf("ShardReplication of %v/%v in cell %v references tablet %v, which doesn't exist", keyspace, shard, cell, alias),
+						diff:    []string{fmt.Sprintf("- ShardReplication(%v, %v/%v): %v", cell, keyspace, shard, alias)},
+						fix: func(ctx context.Context) error {
+							return topo.RemoveShardReplicationRecord(ctx, wr.ts, cell, keyspace, shard, tabletAlias)
+						},
+					})
+				}
+			}
+
+			// Dangling master alias and source shards.
+			var diff []string
+			var staleMaster *topodatapb.TabletAlias
+			if si.HasMaster() {
+				alias := topoproto.TabletAliasString(si.MasterAlias)
+				if _, ok := tablets[alias]; !ok {
+					staleMaster = si.MasterAlias
+					diff = append(diff, fmt.Sprintf("- Shard(%v/%v) master_alias: %v", keyspace, shard, alias))
+				}
+			}
+			danglingSources := make(map[uint32]*topodatapb.Shard_SourceShard)
+			for _, ss := range si.SourceShards {
+				if !shardExists(ss.Keyspace, ss.Shard) {
+					danglingSources[ss.Uid] = ss
+					diff = append(diff, fmt.Sprintf("- Shard(%v/%v) source_shard %v: %v/%v", keyspace, shard, ss.Uid, ss.Keyspace, ss.Shard))
+				}
+			}
+			if len(diff) > 0 {
+				repairs = append(repairs, &topoRepair{
+					problem: fmt.Sprintf("shard %v/%v references tablets or shards that don't exist", keyspace, shard),
+					diff:    diff,
+					fix: func(ctx context.Context) (err error) {
+						ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, "RepairTopology")
+						if lockErr != nil {
+							return lockErr
+						}
+						defer unlock(&err)
+
+						// The shard may have changed since we looked at it, so
+						// we only remove the values that are still the same.
+						_, err = wr.ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+							if staleMaster != nil && topoproto.TabletAliasEqual(si.MasterAlias, staleMaster) {
+								si.MasterAlias = nil
+								si.MasterTermStartTime = nil
+							}
+							var sourceShards []*topodatapb.Shard_SourceShard
+							for _, ss := range si.SourceShards {
+								if dangling, ok := danglingSources[ss.Uid]; ok && ss.Keyspace == dangling.Keyspace && ss.Shard == dangling.Shard {
+									continue
+								}
+								sourceShards = append(sourceShards, ss)
+							}
+							si.SourceShards = sourceShards
+							return nil
+						})
+						return err
+					},
+				})
+			}
+		}
+
+		// Missing or dangling SrvKeyspace records.
+		var ksCells []string
+		for cell := range keyspaceCells[keyspace] {
+			ksCells = append(ksCells, cell)
+		}
+		sort.Strings(ksCells)
+		for _, cell := range ksCells {
+			var diff []string
+			srvKeyspace, err := wr.ts.GetSrvKeyspace(ctx, cell, keyspace)
+			switch {
+			case topo.IsErrType(err, topo.NoNode):
+				diff = append(diff, fmt.Sprintf("+ SrvKeyspace(%v, %v)", cell, keyspace))
+			case err != nil:
+				return nil, fmt.Errorf("GetSrvKeyspace(%v, %v) failed: %v", cell, keyspace, err)
+			default:
+				for _, partition := range srvKeyspace.Partitions {
+					for _, ref := range partition.ShardReferences {
+						if !shardExists(keyspace, ref.Name) {
+							diff = append(diff, fmt.Sprintf("- SrvKeyspace(%v, %v) %v shard_reference: %v", cell, keyspace, topoproto.TabletTypeLString(partition.ServedType), ref.Name))
+						}
+					}
+				}
+			}
+			if len(diff) == 0 {
+				continue
+			}
+			cell := cell
+			repairs = append(repairs, &topoRepair{
+				problem: fmt.Sprintf("SrvKeyspace of %v in cell %v is missing or references shards that don't exist", keyspace, cell),
+				diff:    diff,
+				fix: func(ctx context.Context) error {
+					return topotools.RebuildKeyspace(ctx, wr.Logger(), wr.ts, keyspace, []string{cell})
+				},
+			})
+		}
+	}
+	return repairs, nil
+}
+
+func sortedTabletAliases(tabletMap map[string]*topo.TabletInfo) []string {
+	aliases := make([]string, 0, len(tabletMap))
+	for alias := range tabletMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+func sortedShardNames(shards map[string]*topo.ShardInfo) []string {
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRepairTopology(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-")
+
+	// A tablet in a shard that doesn't exist.
+	orphan := &topodatapb.TabletAlias{Cell: "cell1", Uid: 200}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    orphan,
+		Keyspace: "ks",
+		Shard:    "c0-",
+		Type:     topodatapb.TabletType_REPLICA,
+	}))
+	// A replication entry for a tablet that doesn't exist.
+	require.NoError(t, topo.UpdateShardReplicationRecord(ctx, ts, "ks", "-80", &topodatapb.TabletAlias{Cell: "cell1", Uid: 300}))
+	// The master of 80- is gone.
+	require.NoError(t, ts.DeleteTablet(ctx, &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}))
+	// No SrvKeyspace was built.
+
+	logger := logutil.NewMemoryLogger()
+	wr := New(logger, ts, nil)
+	require.NoError(t, wr.RepairTopology(ctx, true /* dryRun */))
+	out := logger.String()
+	assert.Contains(t, out, "- Tablet(cell1-0000000200): replica")
+	assert.Contains(t, out, "- ShardReplication(cell1, ks/-80): cell1-0000000300")
+	assert.Contains(t, out, "- ShardReplication(cell1, ks/80-): cell1-0000000101")
+	assert.Contains(t, out, "- Shard(ks/80-) master_alias: cell1-0000000101")
+	assert.Contains(t, out, "+ SrvKeyspace(cell1, ks)")
+	assert.Contains(t, out, "Dry run: 5 change(s) not applied")
+
+	// Nothing changed.
+	_, err := ts.GetTablet(ctx, orphan)
+	require.NoError(t, err)
+	si, err := ts.GetShard(ctx, "ks", "80-")
+	require.NoError(t, err)
+	assert.True(t, si.HasMaster())
+
+	require.NoError(t, wr.RepairTopology(ctx, false /* dryRun */))
+	_, err = ts.GetTablet(ctx, orphan)
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "tablet record was not deleted: %v", err)
+	sri, err := ts.GetShardReplication(ctx, "cell1", "ks", "-80")
+	require.NoError(t, err)
+	assert.Len(t, sri.Nodes, 1)
+	si, err = ts.GetShard(ctx, "ks", "80-")
+	require.NoError(t, err)
+	assert.False(t, si.HasMaster())
+	_, err = ts.GetSrvKeyspace(ctx, "cell1", "ks")
+	require.NoError(t, err)
+
+	logger.Clear()
+	require.NoError(t, wr.RepairTopology(ctx, true /* dryRun */))
+	assert.Contains(t, logger.String(), "No repairable inconsistency found")
+}
+
+func TestRepairTopologyMasterChanged(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-")
+
+	// The master of 80- is gone.
+	require.NoError(t, ts.DeleteTablet(ctx, &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}))
+	wr := New(logutil.NewMemoryLogger(), ts, nil)
+	repairs, err := wr.findTopoRepairs(ctx)
+	require.NoError(t, err)
+
+	// A new master is elected before the repairs are made.
+	newMaster := &topodatapb.TabletAlias{Cell: "cell1", Uid: 102}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    newMaster,
+		Keyspace: "ks",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_MASTER,
+	}))
+	_, err = ts.UpdateShardFields(ctx, "ks", "80-", func(si *topo.ShardInfo) error {
+		si.MasterAlias = newMaster
+		return nil
+	})
+	require.NoError(t, err)
+
+	for _, r := range repairs {
+		require.NoError(t, r.fix(ctx), r.problem)
+	}
+	si, err := ts.GetShard(ctx, "ks", "80-")
+	require.NoError(t, err)
+	assert.True(t, topoproto.TabletAliasEqual(si.MasterAlias, newMaster), "master_alias: %v", si.MasterAlias)
+}
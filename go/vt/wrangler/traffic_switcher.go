@@ -82,6 +82,17 @@ type trafficSwitcher struct {
 	targetKeyspace  string
 	tables          []string
 	sourceKSSchema  *vindexes.KeyspaceSchema
+	// sourceKeyspaces contains the tables and the vschema of each source
+	// keyspace. A table migration can move the tables of several keyspaces
+	// to the target, and then switches their traffic together. In that
+	// case, sourceKeyspace and sourceKSSchema are not set.
+	sourceKeyspaces map[string]*tsSourceKeyspace
+}
+
+// tsSourceKeyspace contains the metadata for each source keyspace.
+type tsSourceKeyspace struct {
+	tables   []string
+	ksSchema *vindexes.KeyspaceSchema
 }
 
 // tsTarget contains the metadata for each migration target.
@@ -118,8 +129,8 @@ func (wr *Wrangler) SwitchReads(ctx context.Context, targetKeyspace, workflow st
 		return err
 	}
 
-	// For reads, locking the source keyspace is sufficient.
-	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, ts.sourceKeyspace, "SwitchReads")
+	// For reads, locking the source keyspaces is sufficient.
+	ctx, unlock, lockErr := wr.lockKeyspaces(ctx, ts.sourceKeyspaceNames(), "SwitchReads")
 	if lockErr != nil {
 		ts.wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
 		return lockErr
@@ -163,21 +174,17 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflow s
 	}
 
 	// Need to lock both source and target keyspaces.
-	ctx, sourceUnlock, lockErr := wr.ts.LockKeyspace(ctx, ts.sourceKeyspace, "SwitchWrites")
+	keyspaces := ts.sourceKeyspaceNames()
+	if _, ok := ts.sourceKeyspaces[ts.targetKeyspace]; !ok {
+		keyspaces = append(keyspaces, ts.targetKeyspace)
+		sort.Strings(keyspaces)
+	}
+	ctx, unlock, lockErr := wr.lockKeyspaces(ctx, keyspaces, "SwitchWrites")
 	if lockErr != nil {
 		ts.wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
 		return 0, lockErr
 	}
-	defer sourceUnlock(&err)
-	if ts.targetKeyspace != ts.sourceKeyspace {
-		tctx, targetUnlock, lockErr := wr.ts.LockKeyspace(ctx, ts.targetKeyspace, "SwitchWrites")
-		if lockErr != nil {
-			ts.wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
-			return 0, lockErr
-		}
-		ctx = tctx
-		defer targetUnlock(&err)
-	}
+	defer unlock(&err)
 
 	// If no journals exist, sourceWorkflows will be initialized by sm.MigrateStreams.
 	journalsExist, sourceWorkflows, err := ts.checkJournals(ctx)
@@ -294,35 +301,25 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 		targets:         targets,
 		sources:         make(map[string]*tsSource),
 		targetKeyspace:  targetKeyspace,
+		sourceKeyspaces: make(map[string]*tsSourceKeyspace),
 	}
 	ts.wr.Logger().Infof("Migration ID for workflow %s: %d", workflow, ts.id)
 
 	// Build the sources
 	for _, target := range targets {
 		for _, bls := range target.sources {
-			if ts.sourceKeyspace == "" {
-				ts.sourceKeyspace = bls.Keyspace
-			} else if ts.sourceKeyspace != bls.Keyspace {
-				return nil, fmt.Errorf("source keyspaces are mismatched across streams: %v vs %v", ts.sourceKeyspace, bls.Keyspace)
+			var tables []string
+			for _, rule := range bls.Filter.Rules {
+				tables = append(tables, rule.Match)
 			}
-
-			if ts.tables == nil {
-				for _, rule := range bls.Filter.Rules {
-					ts.tables = append(ts.tables, rule.Match)
-				}
-				sort.Strings(ts.tables)
-			} else {
-				var tables []string
-				for _, rule := range bls.Filter.Rules {
-					tables = append(tables, rule.Match)
-				}
-				sort.Strings(tables)
-				if !reflect.DeepEqual(ts.tables, tables) {
-					return nil, fmt.Errorf("table lists are mismatched across streams: %v vs %v", ts.tables, tables)
-				}
+			sort.Strings(tables)
+			if sk, ok := ts.sourceKeyspaces[bls.Keyspace]; !ok {
+				ts.sourceKeyspaces[bls.Keyspace] = &tsSourceKeyspace{tables: tables}
+			} else if !reflect.DeepEqual(sk.tables, tables) {
+				return nil, fmt.Errorf("table lists are mismatched across streams: %v vs %v", sk.tables, tables)
 			}
 
-			if _, ok := ts.sources[bls.Shard]; ok {
+			if _, ok := ts.sources[sourceKey(bls.Keyspace, bls.Shard)]; ok {
 				continue
 			}
 			sourcesi, err := ts.wr.ts.GetShard(ctx, bls.Keyspace, bls.Shard)
@@ -333,34 +330,59 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 			if err != nil {
 				return nil, err
 			}
-			ts.sources[bls.Shard] = &tsSource{
+			ts.sources[sourceKey(bls.Keyspace, bls.Shard)] = &tsSource{
 				si:     sourcesi,
 				master: sourceMaster,
 			}
 		}
 	}
+
+	tableKeyspaces := make(map[string]string)
+	for _, keyspace := range ts.sourceKeyspaceNames() {
+		sk := ts.sourceKeyspaces[keyspace]
+		for _, table := range sk.tables {
+			if other, ok := tableKeyspaces[table]; ok {
+				return nil, fmt.Errorf("table %v is moved from more than one source keyspace: %v and %v", table, other, keyspace)
+			}
+			tableKeyspaces[table] = keyspace
+			ts.tables = append(ts.tables, table)
+		}
+		vs, err := ts.wr.ts.GetVSchema(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		sk.ksSchema, err = vindexes.BuildKeyspaceSchema(vs, keyspace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(ts.tables)
+
+	if len(ts.sourceKeyspaces) > 1 {
+		if _, ok := ts.sourceKeyspaces[ts.targetKeyspace]; ok {
+			return nil, fmt.Errorf("streams with several source keyspaces cannot have the target keyspace %v as a source", ts.targetKeyspace)
+		}
+		ts.migrationType = binlogdatapb.MigrationType_TABLES
+		return ts, nil
+	}
+	for keyspace, sk := range ts.sourceKeyspaces {
+		ts.sourceKeyspace = keyspace
+		ts.sourceKSSchema = sk.ksSchema
+	}
 	if ts.sourceKeyspace != ts.targetKeyspace {
 		ts.migrationType = binlogdatapb.MigrationType_TABLES
 	} else {
 		// TODO(sougou): for shard migration, validate that source and target combined
 		// keyranges match.
 		ts.migrationType = binlogdatapb.MigrationType_SHARDS
-		for sourceShard := range ts.sources {
-			if _, ok := ts.targets[sourceShard]; ok {
+		for _, source := range ts.sources {
+			if _, ok := ts.targets[source.si.ShardName()]; ok {
 				// If shards are overlapping, then this is a table migration.
 				ts.migrationType = binlogdatapb.MigrationType_TABLES
 				break
 			}
 		}
 	}
-	vs, err := ts.wr.ts.GetVSchema(ctx, ts.sourceKeyspace)
-	if err != nil {
-		return nil, err
-	}
-	ts.sourceKSSchema, err = vindexes.BuildKeyspaceSchema(vs, ts.sourceKeyspace)
-	if err != nil {
-		return nil, err
-	}
 	return ts, nil
 }
 
@@ -445,8 +467,16 @@ func hashStreams(targetKeyspace string, targets map[string]*tsTarget) int64 {
 func (ts *trafficSwitcher) validate(ctx context.Context, isWrite bool) error {
 	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		// All shards must be present.
-		if err := ts.compareShards(ctx, ts.sourceKeyspace, ts.sourceShards()); err != nil {
-			return err
+		for _, keyspace := range ts.sourceKeyspaceNames() {
+			var sis []*topo.ShardInfo
+			for _, si := range ts.sourceShards() {
+				if si.Keyspace() == keyspace {
+					sis = append(sis, si)
+				}
+			}
+			if err := ts.compareShards(ctx, keyspace, sis); err != nil {
+				return err
+			}
 		}
 		if err := ts.compareShards(ctx, ts.targetKeyspace, ts.targetShards()); err != nil {
 			return err
@@ -544,15 +574,17 @@ func (ts *trafficSwitcher) switchTableReads(ctx context.Context, cells []string,
 	// For forward migration, we add tablet type specific rules to redirect traffic to the target.
 	// For backward, we delete them.
 	tt := strings.ToLower(servedType.String())
-	for _, table := range ts.tables {
-		if direction == DirectionForward {
-			rules[table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
-			rules[ts.targetKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
-			rules[ts.sourceKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
-		} else {
-			delete(rules, table+"@"+tt)
-			delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
-			delete(rules, ts.sourceKeyspace+"."+table+"@"+tt)
+	for sourceKeyspace, sk := range ts.sourceKeyspaces {
+		for _, table := range sk.tables {
+			if direction == DirectionForward {
+				rules[table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
+				rules[ts.targetKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
+				rules[sourceKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
+			} else {
+				delete(rules, table+"@"+tt)
+				delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
+				delete(rules, sourceKeyspace+"."+table+"@"+tt)
+			}
 		}
 	}
 	if err := ts.wr.saveRoutingRules(ctx, rules); err != nil {
@@ -621,15 +653,16 @@ func (ts *trafficSwitcher) stopSourceWrites(ctx context.Context) error {
 	return ts.forAllSources(func(source *tsSource) error {
 		var err error
 		source.position, err = ts.wr.tmc.MasterPosition(ctx, source.master.Tablet)
-		ts.wr.Logger().Infof("Position for source %v:%v: %v", ts.sourceKeyspace, source.si.ShardName(), source.position)
+		ts.wr.Logger().Infof("Position for source %v:%v: %v", source.si.Keyspace(), source.si.ShardName(), source.position)
 		return err
 	})
 }
 
 func (ts *trafficSwitcher) changeTableSourceWrites(ctx context.Context, access accessType) error {
 	return ts.forAllSources(func(source *tsSource) error {
-		if _, err := ts.wr.ts.UpdateShardFields(ctx, ts.sourceKeyspace, source.si.ShardName(), func(si *topo.ShardInfo) error {
-			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, ts.tables)
+		tables := ts.sourceKeyspaces[source.si.Keyspace()].tables
+		if _, err := ts.wr.ts.UpdateShardFields(ctx, source.si.Keyspace(), source.si.ShardName(), func(si *topo.ShardInfo) error {
+			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, tables)
 		}); err != nil {
 			return err
 		}
@@ -644,7 +677,7 @@ func (ts *trafficSwitcher) waitForCatchup(ctx context.Context, filteredReplicati
 	var mu sync.Mutex
 	return ts.forAllUids(func(target *tsTarget, uid uint32) error {
 		bls := target.sources[uid]
-		source := ts.sources[bls.Shard]
+		source := ts.sources[sourceKey(bls.Keyspace, bls.Shard)]
 		ts.wr.Logger().Infof("waiting for keyspace:shard: %v:%v, position %v", ts.targetKeyspace, target.si.ShardName(), source.position)
 		if err := ts.wr.tmc.VReplicationWaitForPos(ctx, target.master.Tablet, int(uid), source.position); err != nil {
 			return err
@@ -699,7 +732,7 @@ func (ts *trafficSwitcher) gatherPositions(ctx context.Context) error {
 	err := ts.forAllSources(func(source *tsSource) error {
 		var err error
 		source.position, err = ts.wr.tmc.MasterPosition(ctx, source.master.Tablet)
-		ts.wr.Logger().Infof("Position for source %v:%v: %v", ts.sourceKeyspace, source.si.ShardName(), source.position)
+		ts.wr.Logger().Infof("Position for source %v:%v: %v", source.si.Keyspace(), source.si.ShardName(), source.position)
 		return err
 	})
 	if err != nil {
@@ -719,7 +752,7 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 	}
 	err := ts.forAllUids(func(target *tsTarget, uid uint32) error {
 		bls := target.sources[uid]
-		source := ts.sources[bls.Shard]
+		source := ts.sources[sourceKey(bls.Keyspace, bls.Shard)]
 		reverseBls := &binlogdatapb.BinlogSource{
 			Keyspace:   ts.targetKeyspace,
 			Shard:      target.si.ShardName(),
@@ -727,6 +760,7 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 			Filter:     &binlogdatapb.Filter{},
 			OnDdl:      bls.OnDdl,
 		}
+		sourceKSSchema := ts.sourceKeyspaces[bls.Keyspace].ksSchema
 		for _, rule := range bls.Filter.Rules {
 			if rule.Filter == "exclude" {
 				reverseBls.Filter.Rules = append(reverseBls.Filter.Rules, rule)
@@ -734,13 +768,13 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 			}
 			var filter string
 			if strings.HasPrefix(rule.Match, "/") {
-				if ts.sourceKSSchema.Keyspace.Sharded {
+				if sourceKSSchema.Keyspace.Sharded {
 					filter = key.KeyRangeString(source.si.KeyRange)
 				}
 			} else {
 				var inKeyrange string
-				if ts.sourceKSSchema.Keyspace.Sharded {
-					vtable, ok := ts.sourceKSSchema.Tables[rule.Match]
+				if sourceKSSchema.Keyspace.Sharded {
+					vtable, ok := sourceKSSchema.Tables[rule.Match]
 					if !ok {
 						return fmt.Errorf("table %s not found in vschema", rule.Match)
 					}
@@ -781,14 +815,22 @@ func (ts *trafficSwitcher) createJournals(ctx context.Context, sourceWorkflows [
 		journal := &binlogdatapb.Journal{
 			Id:              ts.id,
 			MigrationType:   ts.migrationType,
-			Tables:          ts.tables,
+			Tables:          ts.sourceKeyspaces[source.si.Keyspace()].tables,
 			LocalPosition:   source.position,
 			Participants:    participants,
 			SourceWorkflows: sourceWorkflows,
 		}
+		if len(ts.sourceKeyspaces) > 1 {
+			// The journals of all the source keyspaces have the same target
+			// positions. The streams of each keyspace only wait for the
+			// participants of their keyspace.
+			journal.SourceKeyspaces = ts.sourceKeyspaceNames()
+		}
 		for targetShard, target := range ts.targets {
 			for _, tsource := range target.sources {
-				participantMap[tsource.Shard] = true
+				if tsource.Keyspace == source.si.Keyspace() {
+					participantMap[tsource.Shard] = true
+				}
 			}
 			journal.ShardGtids = append(journal.ShardGtids, &binlogdatapb.ShardGtid{
 				Keyspace: ts.targetKeyspace,
@@ -860,19 +902,21 @@ func (ts *trafficSwitcher) changeTableRouting(ctx context.Context) error {
 	// After this step, only the following rules will be left:
 	// table -> targetKeyspace.table
 	// sourceKeyspace.table -> targetKeyspace.table
-	for _, table := range ts.tables {
-		for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
-			tt := strings.ToLower(tabletType.String())
-			delete(rules, table+"@"+tt)
-			delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
-			delete(rules, ts.sourceKeyspace+"."+table+"@"+tt)
-			ts.wr.Logger().Infof("Delete routing: %v %v %v", table+"@"+tt, ts.targetKeyspace+"."+table+"@"+tt, ts.sourceKeyspace+"."+table+"@"+tt)
+	for sourceKeyspace, sk := range ts.sourceKeyspaces {
+		for _, table := range sk.tables {
+			for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+				tt := strings.ToLower(tabletType.String())
+				delete(rules, table+"@"+tt)
+				delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
+				delete(rules, sourceKeyspace+"."+table+"@"+tt)
+				ts.wr.Logger().Infof("Delete routing: %v %v %v", table+"@"+tt, ts.targetKeyspace+"."+table+"@"+tt, sourceKeyspace+"."+table+"@"+tt)
+			}
+			delete(rules, ts.targetKeyspace+"."+table)
+			ts.wr.Logger().Infof("Delete routing: %v", ts.targetKeyspace+"."+table)
+			rules[table] = []string{ts.targetKeyspace + "." + table}
+			rules[sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
+			ts.wr.Logger().Infof("Add routing: %v %v", table, sourceKeyspace+"."+table)
 		}
-		delete(rules, ts.targetKeyspace+"."+table)
-		ts.wr.Logger().Infof("Delete routing: %v", ts.targetKeyspace+"."+table)
-		rules[table] = []string{ts.targetKeyspace + "." + table}
-		rules[ts.sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
-		ts.wr.Logger().Infof("Add routing: %v %v", table, ts.sourceKeyspace+"."+table)
 	}
 	if err := ts.wr.saveRoutingRules(ctx, rules); err != nil {
 		return err
@@ -991,6 +1035,16 @@ func (ts *trafficSwitcher) forAllUids(f func(target *tsTarget, uid uint32) error
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
+// sourceKeyspaceNames returns the sorted names of the source keyspaces.
+func (ts *trafficSwitcher) sourceKeyspaceNames() []string {
+	keyspaces := make([]string, 0, len(ts.sourceKeyspaces))
+	for keyspace := range ts.sourceKeyspaces {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	return keyspaces
+}
+
 func (ts *trafficSwitcher) sourceShards() []*topo.ShardInfo {
 	shards := make([]*topo.ShardInfo, 0, len(ts.sources))
 	for _, source := range ts.sources {
@@ -1030,6 +1084,32 @@ func (wr *Wrangler) saveRoutingRules(ctx context.Context, rules map[string][]str
 	return wr.ts.SaveRoutingRules(ctx, rrs)
 }
 
+// lockKeyspaces locks the keyspaces in the order in which they're given.
+// The returned function unlocks them in the reverse order.
+func (wr *Wrangler) lockKeyspaces(ctx context.Context, keyspaces []string, action string) (context.Context, func(*error), error) {
+	var unlocks []func(*error)
+	unlockAll := func(err *error) {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i](err)
+		}
+	}
+	for _, keyspace := range keyspaces {
+		lctx, unlock, err := wr.ts.LockKeyspace(ctx, keyspace, action)
+		if err != nil {
+			unlockAll(&err)
+			return nil, nil, err
+		}
+		ctx = lctx
+		unlocks = append(unlocks, unlock)
+	}
+	return ctx, unlockAll, nil
+}
+
+// sourceKey returns the key of a source shard in trafficSwitcher.sources.
+func sourceKey(keyspace, shard string) string {
+	return keyspace + "/" + shard
+}
+
 func reverseName(workflow string) string {
 	const reverse = "_reverse"
 	if strings.HasSuffix(workflow, reverse) {
@@ -26,9 +26,12 @@ import (
 
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 )
 
 var (
@@ -437,6 +440,189 @@ func TestTableMigrateMainflow(t *testing.T) {
 	verifyQueries(t, tme.allDBClients)
 }
 
+// TestTableMigrateMultipleSourceKeyspaces tests the switch of a workflow
+// which moves tables from two keyspaces to the target.
+func TestTableMigrateMultipleSourceKeyspaces(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	// Add the unsharded keyspace ks3, whose table t3 is moved to ks2.
+	ks3Master := newFakeTablet(t, tme.wr, "cell1", 90, topodatapb.TabletType_MASTER, nil, TabletKeyspaceShard(t, "ks3", "0"))
+	ks3Master.FakeMysqlDaemon.CurrentMasterPosition = tme.sourceMasters[0].FakeMysqlDaemon.CurrentMasterPosition
+	ks3Master.StartActionLoop(t, tme.wr)
+	defer ks3Master.StopActionLoop(t)
+	ks3Client := newFakeDBClient()
+	ks3Master.Agent.VREngine.Close()
+	ks3Master.Agent.VREngine = vreplication.NewEngine(tme.ts, "", ks3Master.FakeMysqlDaemon, func() binlogplayer.DBClient { return ks3Client }, ks3Client.DBName())
+	if err := ks3Master.Agent.VREngine.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	allDBClients := append(tme.allDBClients, ks3Client)
+	if err := tme.ts.SaveVSchema(ctx, "ks3", &vschemapb.Keyspace{Tables: map[string]*vschemapb.Table{"t3": {}}}); err != nil {
+		t.Fatal(err)
+	}
+	for i, targetShard := range tme.targetShards {
+		var rows []string
+		for j, sourceShard := range tme.sourceShards {
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: "ks1",
+				Shard:    sourceShard,
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "t1",
+						Filter: fmt.Sprintf("select * from t1 where in_keyrange('%s')", targetShard),
+					}, {
+						Match:  "t2",
+						Filter: fmt.Sprintf("select * from t2 where in_keyrange('%s')", targetShard),
+					}},
+				},
+			}
+			rows = append(rows, fmt.Sprintf("%d|%v|", j+1, bls))
+		}
+		bls := &binlogdatapb.BinlogSource{
+			Keyspace: "ks3",
+			Shard:    "0",
+			Filter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t3",
+					Filter: fmt.Sprintf("select * from t3 where in_keyrange('%s')", targetShard),
+				}},
+			},
+		}
+		rows = append(rows, fmt.Sprintf("3|%v|", bls))
+		tme.dbTargetClients[i].addInvariant(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|source|message",
+			"int64|varchar|varchar"),
+			rows...),
+		)
+	}
+	if err := tme.wr.saveRoutingRules(ctx, map[string][]string{
+		"t1":     {"ks1.t1"},
+		"ks2.t1": {"ks1.t1"},
+		"t2":     {"ks1.t2"},
+		"ks2.t2": {"ks1.t2"},
+		"t3":     {"ks3.t3"},
+		"ks2.t3": {"ks3.t3"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tme.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The reads of both keyspaces are switched together.
+	for _, servedType := range []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA} {
+		if err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", servedType, nil, DirectionForward); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":             {"ks1.t1"},
+		"ks2.t1":         {"ks1.t1"},
+		"t2":             {"ks1.t2"},
+		"ks2.t2":         {"ks1.t2"},
+		"t3":             {"ks3.t3"},
+		"ks2.t3":         {"ks3.t3"},
+		"t1@replica":     {"ks2.t1"},
+		"ks2.t1@replica": {"ks2.t1"},
+		"ks1.t1@replica": {"ks2.t1"},
+		"t2@replica":     {"ks2.t2"},
+		"ks2.t2@replica": {"ks2.t2"},
+		"ks1.t2@replica": {"ks2.t2"},
+		"t3@replica":     {"ks2.t3"},
+		"ks2.t3@replica": {"ks2.t3"},
+		"ks3.t3@replica": {"ks2.t3"},
+		"t1@rdonly":      {"ks2.t1"},
+		"ks2.t1@rdonly":  {"ks2.t1"},
+		"ks1.t1@rdonly":  {"ks2.t1"},
+		"t2@rdonly":      {"ks2.t2"},
+		"ks2.t2@rdonly":  {"ks2.t2"},
+		"ks1.t2@rdonly":  {"ks2.t2"},
+		"t3@rdonly":      {"ks2.t3"},
+		"ks2.t3@rdonly":  {"ks2.t3"},
+		"ks3.t3@rdonly":  {"ks2.t3"},
+	})
+
+	ts, err := tme.wr.buildTrafficSwitcher(ctx, tme.targetKeyspace, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	journalQuery := fmt.Sprintf("select val from _vt.resharding_journal where id=%d", ts.id)
+	tme.dbSourceClients[0].addQuery(journalQuery, &sqltypes.Result{}, nil)
+	tme.dbSourceClients[1].addQuery(journalQuery, &sqltypes.Result{}, nil)
+	ks3Client.addQuery(journalQuery, &sqltypes.Result{}, nil)
+
+	state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-892|Running",
+	)
+	for _, dbclient := range tme.dbTargetClients {
+		for id := 1; id <= 3; id++ {
+			dbclient.addQuery(fmt.Sprintf("select pos, state, message from _vt.vreplication where id=%d", id), state, nil)
+			dbclient.addQuery(fmt.Sprintf("select id from _vt.vreplication where id = %d", id), &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(int64(id))}}}, nil)
+			dbclient.addQuery(fmt.Sprintf("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (%d)", id), &sqltypes.Result{}, nil)
+			dbclient.addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", id), stoppedResult(id), nil)
+		}
+	}
+
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[1].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	ks3Client.addQuery("select id from _vt.vreplication where db_name = 'vt_ks3' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t1.*-40.*t2.*-40.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 1}, nil)
+	tme.dbSourceClients[0].addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t1.*-40.*t2.*-40.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 2}, nil)
+	tme.dbSourceClients[1].addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t1.*40-.*t2.*40-.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 1}, nil)
+	tme.dbSourceClients[1].addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t1.*40-.*t2.*40-.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 2}, nil)
+	ks3Client.addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t3.*select \\* from t3.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 1}, nil)
+	ks3Client.addQueryRE("insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t3.*select \\* from t3.*MariaDB/5-456-893.*Stopped", &sqltypes.Result{InsertID: 2}, nil)
+	for _, dbclient := range []*fakeDBClient{tme.dbSourceClients[0], tme.dbSourceClients[1], ks3Client} {
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+	}
+
+	// The journals of each keyspace have its own tables and participants,
+	// and the same target positions.
+	journalPrefix := fmt.Sprintf("insert into _vt.resharding_journal.*%d,", ts.id)
+	tme.dbSourceClients[0].addQueryRE(journalPrefix+".*tables.*t1.*t2.*shard_gtids.*ks2.*MariaDB/5-456-893.*shard_gtids.*ks2.*MariaDB/5-456-893.*participants.*ks1.*-40.*ks1.*40-.*source_keyspaces.*ks1.*ks3", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[1].addQueryRE(journalPrefix+".*tables.*t1.*t2.*shard_gtids.*ks2.*MariaDB/5-456-893.*shard_gtids.*ks2.*MariaDB/5-456-893.*participants.*ks1.*-40.*ks1.*40-.*source_keyspaces.*ks1.*ks3", &sqltypes.Result{}, nil)
+	ks3Client.addQueryRE(journalPrefix+`.*tables.*t3.*shard_gtids.*ks2.*MariaDB/5-456-893.*shard_gtids.*ks2.*MariaDB/5-456-893.*participants.*ks3.*shard:\\"0\\".*source_keyspaces.*ks1.*ks3`, &sqltypes.Result{}, nil)
+
+	resultid123 := &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}, {sqltypes.NewInt64(3)}}}
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid123, nil)
+		dbclient.addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1, 2, 3)", &sqltypes.Result{}, nil)
+		for id := 1; id <= 3; id++ {
+			dbclient.addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", id), stoppedResult(id), nil)
+		}
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid123, nil)
+		dbclient.addQuery("delete from _vt.vreplication where id in (1, 2, 3)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("delete from _vt.copy_state where vrepl_id in (1, 2, 3)", &sqltypes.Result{}, nil)
+	}
+
+	journalID, err := tme.wr.SwitchWrites(ctx, tme.targetKeyspace, "test", 1*time.Second, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if journalID != ts.id {
+		t.Errorf("journal id: %d, want %d", journalID, ts.id)
+	}
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":     {"ks2.t1"},
+		"ks1.t1": {"ks2.t1"},
+		"t2":     {"ks2.t2"},
+		"ks1.t2": {"ks2.t2"},
+		"t3":     {"ks2.t3"},
+		"ks3.t3": {"ks2.t3"},
+	})
+	checkBlacklist(t, tme.ts, "ks1:-40", []string{"t1", "t2"})
+	checkBlacklist(t, tme.ts, "ks1:40-", []string{"t1", "t2"})
+	checkBlacklist(t, tme.ts, "ks3:0", []string{"t3"})
+	checkBlacklist(t, tme.ts, "ks2:-80", nil)
+	checkBlacklist(t, tme.ts, "ks2:80-", nil)
+	verifyQueries(t, allDBClients)
+}
+
 // TestShardMigrate tests table mode migrations.
 // This has to be kept in sync with TestTableMigrate.
 func TestShardMigrateMainflow(t *testing.T) {
@@ -1388,7 +1574,7 @@ func TestMigrateDistinctSources(t *testing.T) {
 	), nil)
 
 	err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, nil, DirectionForward)
-	want := "source keyspaces are mismatched across streams"
+	want := "table t1 is moved from more than one source keyspace: ks1 and ks2"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("SwitchReads: %v, must contain %v", err, want)
 	}
@@ -53,6 +53,14 @@ type DiffReport struct {
 	ExtraRowsTarget int
 }
 
+func (dr *DiffReport) add(other *DiffReport) {
+	dr.ProcessedRows += other.ProcessedRows
+	dr.MatchingRows += other.MatchingRows
+	dr.MismatchedRows += other.MismatchedRows
+	dr.ExtraRowsSource += other.ExtraRowsSource
+	dr.ExtraRowsTarget += other.ExtraRowsTarget
+}
+
 // vdiff contains the metadata for performing vdiff for one workflow.
 type vdiff struct {
 	ts             *trafficSwitcher
@@ -67,6 +75,9 @@ type vdiff struct {
 	// The source and target keyspaces are pulled from ts.
 	sources map[string]*shardStreamer
 	targets map[string]*shardStreamer
+
+	// checkpoint is nil if the progress is not saved.
+	checkpoint *vdiffCheckpoint
 }
 
 // tableDiffer performs a diff for one table in the workflow.
@@ -83,6 +94,10 @@ type tableDiffer struct {
 	// comparePKs is the list of pk columns to compare. The logic
 	// for comparing pk columns is different from compareCols
 	comparePKs []int
+	// pkCols is the list of pk columns, without the weight_string
+	// substitution of comparePKs. They're used to resume a diff
+	// after the last pk of a chunk.
+	pkCols []int
 
 	// source Primitive and targetPrimitive are used for streaming
 	// results from source and target.
@@ -107,9 +122,32 @@ type shardStreamer struct {
 }
 
 // VDiff reports differences between the sources and targets of a vreplication workflow.
+// If chunkSize is not 0, every table is diffed in chunks of at most chunkSize rows per
+// shard, which bounds the rows the tablets have to sort and stream for a snapshot.
+// If checkpointFile is set, the progress is saved to it after every chunk, and the
+// diff can be resumed from it later with resume. A resumed diff uses the chunk size
+// of the checkpoint if chunkSize is 0.
 func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr string,
 	filteredReplicationWaitTime, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout time.Duration,
-	format string) (map[string]*DiffReport, error) {
+	format string, chunkSize int64, checkpointFile string, resume bool) (map[string]*DiffReport, error) {
+	var cp *vdiffCheckpoint
+	switch {
+	case checkpointFile == "" && resume:
+		return nil, fmt.Errorf("a checkpoint file is required to resume a vdiff")
+	case resume:
+		var err error
+		if cp, err = readVDiffCheckpoint(checkpointFile, targetKeyspace, workflow); err != nil {
+			return nil, err
+		}
+		if chunkSize == 0 {
+			chunkSize = cp.ChunkSize
+		}
+		cp.ChunkSize = chunkSize
+	case checkpointFile != "":
+		cp = newVDiffCheckpoint(checkpointFile, targetKeyspace, workflow)
+		cp.ChunkSize = chunkSize
+	}
+
 	// Assign defaults to sourceCell and targetCell if not specified.
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
@@ -140,6 +178,9 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		ts.wr.Logger().Errorf("validate: %v", err)
 		return nil, err
 	}
+	if ts.sourceKeyspace == "" {
+		return nil, fmt.Errorf("vdiff is not supported for workflows with several source keyspaces: %v", ts.sourceKeyspaceNames())
+	}
 
 	// Initialize vdiff.
 	df := &vdiff{
@@ -149,9 +190,10 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		tabletTypesStr: tabletTypesStr,
 		sources:        make(map[string]*shardStreamer),
 		targets:        make(map[string]*shardStreamer),
+		checkpoint:     cp,
 	}
-	for shard, source := range ts.sources {
-		df.sources[shard] = &shardStreamer{
+	for _, source := range ts.sources {
+		df.sources[source.si.ShardName()] = &shardStreamer{
 			master: source.master,
 		}
 	}
@@ -193,30 +235,9 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	diffReports := make(map[string]*DiffReport)
 	jsonOutput := ""
 	for table, td := range df.differs {
-		// Stop the targets and record their source positions.
-		if err := df.stopTargets(ctx); err != nil {
-			return nil, vterrors.Wrap(err, "stopTargets")
-		}
-		// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
-		if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, df.sources, td.sourceExpression, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "startQueryStreams(sources)")
-		}
-		// Fast forward the targets to the newly recorded source positions.
-		if err := df.syncTargets(ctx, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "syncTargets")
-		}
-		// Sources and targets are in sync. Start query streams on the targets.
-		if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, df.targets, td.targetExpression, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "startQueryStreams(targets)")
-		}
-		// Now that queries are running, target vreplication streams can be restarted.
-		if err := df.restartTargets(ctx); err != nil {
-			return nil, vterrors.Wrap(err, "restartTargets")
-		}
-		// Perform the diff of source and target streams.
-		dr, err := td.diff(ctx, df.ts.wr)
+		dr, err := df.diffTable(ctx, td, chunkSize, filteredReplicationWaitTime)
 		if err != nil {
-			return nil, vterrors.Wrap(err, "diff")
+			return nil, err
 		}
 		if format == "json" {
 			json, err := json.MarshalIndent(*dr, "", "")
@@ -238,6 +259,152 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	return diffReports, nil
 }
 
+// diffTable diffs one table, in one pass or in chunks, starting after the last pk
+// of the checkpoint. The progress is recorded in the checkpoint after every chunk.
+func (df *vdiff) diffTable(ctx context.Context, td *tableDiffer, chunkSize int64, filteredReplicationWaitTime time.Duration) (*DiffReport, error) {
+	tc := df.checkpoint.table(td.targetTable)
+	if tc.Done {
+		df.ts.wr.Logger().Infof("Table %v was already diffed, reusing the checkpointed report", td.targetTable)
+		dr := tc.Report
+		return &dr, nil
+	}
+	lastPK := tc.lastPK()
+	if lastPK != nil && chunkSize == 0 {
+		// Diffing the whole table again would count the rows of the
+		// checkpointed chunks twice.
+		return nil, fmt.Errorf("table %v was diffed in chunks, a chunk size is required to resume its diff", td.targetTable)
+	}
+	for {
+		sourceQuery, targetQuery := td.sourceExpression, td.targetExpression
+		if chunkSize != 0 {
+			var err error
+			if sourceQuery, err = td.chunkQuery(sourceQuery, lastPK, chunkSize); err != nil {
+				return nil, err
+			}
+			if targetQuery, err = td.chunkQuery(targetQuery, lastPK, chunkSize); err != nil {
+				return nil, err
+			}
+		}
+		if err := df.startStreams(ctx, sourceQuery, targetQuery, filteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		done := true
+		if chunkSize != 0 {
+			var err error
+			if lastPK, done, err = df.trimChunk(td, chunkSize); err != nil {
+				return nil, vterrors.Wrap(err, "trimChunk")
+			}
+		}
+		// Perform the diff of source and target streams.
+		dr, err := td.diff(ctx, df.ts.wr)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "diff")
+		}
+		tc.Report.add(dr)
+		tc.setLastPK(lastPK)
+		tc.Done = done
+		if err := df.checkpoint.save(); err != nil {
+			return nil, vterrors.Wrap(err, "save checkpoint")
+		}
+		if done {
+			dr := tc.Report
+			return &dr, nil
+		}
+		df.ts.wr.Logger().Infof("Diffed %v up to %v: %+v", td.targetTable, lastPK, tc.Report)
+	}
+}
+
+// startStreams starts the source and target query streams at a consistent snapshot.
+func (df *vdiff) startStreams(ctx context.Context, sourceQuery, targetQuery string, filteredReplicationWaitTime time.Duration) error {
+	// Stop the targets and record their source positions.
+	if err := df.stopTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "stopTargets")
+	}
+	// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
+	if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, df.sources, sourceQuery, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	// Fast forward the targets to the newly recorded source positions.
+	if err := df.syncTargets(ctx, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "syncTargets")
+	}
+	// Sources and targets are in sync. Start query streams on the targets.
+	if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, df.targets, targetQuery, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(targets)")
+	}
+	// Now that queries are running, target vreplication streams can be restarted.
+	if err := df.restartTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "restartTargets")
+	}
+	return nil
+}
+
+// trimChunk reads the rows of a chunk from all the streams. A stream that
+// returned chunkSize rows may have more rows after them, so the chunk is trimmed
+// to the smallest last pk of those streams, and the streams are refilled with
+// the trimmed rows. The rows after the last pk are read again by the next chunk.
+// trimChunk returns the last pk, and whether it was the last chunk.
+func (df *vdiff) trimChunk(td *tableDiffer, chunkSize int64) (lastPK []sqltypes.Value, done bool, err error) {
+	participants := make([]*shardStreamer, 0, len(df.sources)+len(df.targets))
+	for _, participant := range df.sources {
+		participants = append(participants, participant)
+	}
+	for _, participant := range df.targets {
+		participants = append(participants, participant)
+	}
+
+	chunks := make([]*sqltypes.Result, len(participants))
+	var lastRow []sqltypes.Value
+	for i, participant := range participants {
+		if chunks[i], err = participant.readChunk(); err != nil {
+			return nil, false, err
+		}
+		rows := chunks[i].Rows
+		if int64(len(rows)) < chunkSize {
+			continue
+		}
+		row := rows[len(rows)-1]
+		if lastRow != nil {
+			c, err := td.compare(row, lastRow, td.comparePKs)
+			if err != nil {
+				return nil, false, err
+			}
+			if c >= 0 {
+				continue
+			}
+		}
+		lastRow = row
+	}
+	if lastRow == nil {
+		// All the streams were exhausted.
+		for i, participant := range participants {
+			participant.replayChunk(chunks[i])
+		}
+		return nil, true, nil
+	}
+
+	for i, participant := range participants {
+		rows := chunks[i].Rows
+		n := len(rows)
+		for n > 0 {
+			c, err := td.compare(rows[n-1], lastRow, td.comparePKs)
+			if err != nil {
+				return nil, false, err
+			}
+			if c <= 0 {
+				break
+			}
+			n--
+		}
+		chunks[i].Rows = rows[:n]
+		participant.replayChunk(chunks[i])
+	}
+	for _, col := range td.pkCols {
+		lastPK = append(lastPK, lastRow[col])
+	}
+	return lastPK, false, nil
+}
+
 // buildVDiffPlan builds all the differs.
 func (df *vdiff) buildVDiffPlan(ctx context.Context, filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition) error {
 	df.differs = make(map[string]*tableDiffer)
@@ -359,6 +526,7 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 			colname := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
 			if pk == colname {
 				td.comparePKs = append(td.comparePKs, td.compareCols[i])
+				td.pkCols = append(td.pkCols, i)
 				// We'll be comparing pks seperately. So, remove them from compareCols.
 				td.compareCols[i] = -1
 				found = true
@@ -729,9 +897,68 @@ func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[stri
 	return sm.err
 }
 
+func (sm *shardStreamer) readChunk() (*sqltypes.Result, error) {
+	chunk := &sqltypes.Result{}
+	for result := range sm.result {
+		if result.Fields != nil {
+			chunk.Fields = result.Fields
+		}
+		chunk.Rows = append(chunk.Rows, result.Rows...)
+	}
+	return chunk, sm.err
+}
+
+// replayChunk makes StreamExecute serve the chunk instead of the stream.
+func (sm *shardStreamer) replayChunk(chunk *sqltypes.Result) {
+	sm.result = make(chan *sqltypes.Result, 1)
+	sm.result <- chunk
+	close(sm.result)
+}
+
 //-----------------------------------------------------------------
 // tableDiffer
 
+// chunkQuery adds the conditions to select the chunk of at most chunkSize rows
+// after lastPK to a source or target query. The query must select the pk
+// columns of the tableDiffer, and be ordered by them.
+func (td *tableDiffer) chunkQuery(query string, lastPK []sqltypes.Value, chunkSize int64) (string, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return "", fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if lastPK != nil {
+		var cols, vals sqlparser.ValTuple
+		for i, col := range td.pkCols {
+			cols = append(cols, sel.SelectExprs[col].(*sqlparser.AliasedExpr).Expr)
+			val, err := sqlparser.ExprFromValue(lastPK[i])
+			if err != nil {
+				return "", err
+			}
+			vals = append(vals, val)
+		}
+		cond := &sqlparser.ComparisonExpr{
+			Operator: sqlparser.GreaterThanStr,
+			Left:     cols,
+			Right:    vals,
+		}
+		if len(cols) == 1 {
+			cond.Left = cols[0]
+			cond.Right = vals[0]
+		}
+		if sel.Where == nil {
+			sel.Where = &sqlparser.Where{Type: sqlparser.WhereStr, Expr: cond}
+		} else {
+			sel.Where.Expr = &sqlparser.AndExpr{Left: sel.Where.Expr, Right: cond}
+		}
+	}
+	sel.Limit = &sqlparser.Limit{Rowcount: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", chunkSize)))}
+	return sqlparser.String(sel), nil
+}
+
 func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, error) {
 	sourceExecutor := newPrimitiveExecutor(ctx, td.sourcePrimitive)
 	targetExecutor := newPrimitiveExecutor(ctx, td.targetPrimitive)
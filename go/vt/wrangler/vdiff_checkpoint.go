@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// vdiffCheckpoint records the progress of a VDiff in a file, so that
+// an interrupted VDiff can be resumed. Every chunk is diffed with its
+// own snapshot, so no position needs to be recorded.
+type vdiffCheckpoint struct {
+	Keyspace string
+	Workflow string
+	// ChunkSize is the chunk size of the diff, 0 if the tables
+	// are diffed in one pass. A resumed diff uses it by default.
+	ChunkSize int64
+	// Tables uses the target table name for its key.
+	Tables map[string]*tableCheckpoint
+
+	file string
+}
+
+// tableCheckpoint is the progress of the diff of one table.
+type tableCheckpoint struct {
+	// LastPK is the pk of the last row diffed, or nil if
+	// no chunk was diffed yet.
+	LastPK []*querypb.Value
+	Done   bool
+	Report DiffReport
+}
+
+func newVDiffCheckpoint(file, keyspace, workflow string) *vdiffCheckpoint {
+	return &vdiffCheckpoint{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Tables:   make(map[string]*tableCheckpoint),
+		file:     file,
+	}
+}
+
+// readVDiffCheckpoint reads the checkpoint of a previous VDiff of the workflow.
+func readVDiffCheckpoint(file, keyspace, workflow string) (*vdiffCheckpoint, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	cp := newVDiffCheckpoint(file, keyspace, workflow)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("invalid vdiff checkpoint %v: %v", file, err)
+	}
+	if cp.Keyspace != keyspace || cp.Workflow != workflow {
+		return nil, fmt.Errorf("vdiff checkpoint %v is for workflow %v.%v, not %v.%v", file, cp.Keyspace, cp.Workflow, keyspace, workflow)
+	}
+	if cp.Tables == nil {
+		cp.Tables = make(map[string]*tableCheckpoint)
+	}
+	return cp, nil
+}
+
+// table returns the checkpoint of the table. It works on a nil
+// vdiffCheckpoint, in which case nothing is recorded.
+func (cp *vdiffCheckpoint) table(name string) *tableCheckpoint {
+	if cp == nil {
+		return &tableCheckpoint{}
+	}
+	tc, ok := cp.Tables[name]
+	if !ok {
+		tc = &tableCheckpoint{}
+		cp.Tables[name] = tc
+	}
+	return tc
+}
+
+// save writes the checkpoint to a temporary file which is then renamed,
+// so that an interrupted save doesn't lose the previous checkpoint.
+func (cp *vdiffCheckpoint) save() error {
+	if cp == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := cp.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, cp.file)
+}
+
+func (tc *tableCheckpoint) lastPK() []sqltypes.Value {
+	if tc.LastPK == nil {
+		return nil
+	}
+	values := make([]sqltypes.Value, 0, len(tc.LastPK))
+	for _, v := range tc.LastPK {
+		values = append(values, sqltypes.ProtoToValue(v))
+	}
+	return values
+}
+
+func (tc *tableCheckpoint) setLastPK(values []sqltypes.Value) {
+	tc.LastPK = nil
+	for _, v := range values {
+		tc.LastPK = append(tc.LastPK, sqltypes.ValueToProto(v))
+	}
+}
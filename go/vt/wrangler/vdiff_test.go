@@ -17,6 +17,9 @@ limitations under the License.
 package wrangler
 
 import (
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 	"time"
 
@@ -74,6 +77,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -89,6 +93,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -104,6 +109,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -119,6 +125,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, c1 from t1 order by c1 asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{1},
+			pkCols:           []int{1},
 			sourcePrimitive:  newMergeSorter(nil, []int{1}),
 			targetPrimitive:  newMergeSorter(nil, []int{1}),
 		},
@@ -134,6 +141,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -150,6 +158,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{-1, 2},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -166,6 +175,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select textcol, c1, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{2, -1},
 			comparePKs:       []int{1},
+			pkCols:           []int{1},
 			sourcePrimitive:  newMergeSorter(nil, []int{1}),
 			targetPrimitive:  newMergeSorter(nil, []int{1}),
 		},
@@ -182,6 +192,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select textcol, c2, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{2},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{2}),
 			targetPrimitive:  newMergeSorter(nil, []int{2}),
 		},
@@ -198,6 +209,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{2},
+			pkCols:           []int{1},
 			sourcePrimitive:  newMergeSorter(nil, []int{2}),
 			targetPrimitive:  newMergeSorter(nil, []int{2}),
 		},
@@ -214,6 +226,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{2},
+			pkCols:           []int{1},
 			sourcePrimitive:  newMergeSorter(nil, []int{2}),
 			targetPrimitive:  newMergeSorter(nil, []int{2}),
 		},
@@ -228,6 +241,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from multipk order by c1 asc, c2 asc",
 			compareCols:      []int{-1, -1},
 			comparePKs:       []int{0, 1},
+			pkCols:           []int{0, 1},
 			sourcePrimitive:  newMergeSorter(nil, []int{0, 1}),
 			targetPrimitive:  newMergeSorter(nil, []int{0, 1}),
 		},
@@ -244,6 +258,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -261,6 +276,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -278,6 +294,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -295,6 +312,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -312,6 +330,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -328,6 +347,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive:  newMergeSorter(nil, []int{0}),
 			targetPrimitive:  newMergeSorter(nil, []int{0}),
 		},
@@ -344,6 +364,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2, c3, c4 from aggr order by c1 asc",
 			compareCols:      []int{-1, 1, 2, 3},
 			comparePKs:       []int{0},
+			pkCols:           []int{0},
 			sourcePrimitive: &engine.OrderedAggregate{
 				Aggregates: []engine.AggregateParams{{
 					Opcode: engine.AggregateCount,
@@ -557,7 +578,7 @@ func TestVDiffUnsharded(t *testing.T) {
 		env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 		env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, tcase.target)
 
-		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 		require.NoError(t, err)
 		assert.Equal(t, tcase.dr, dr["t1"], tcase.id)
 	}
@@ -619,7 +640,7 @@ func TestVDiffSharded(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 3,
@@ -685,7 +706,7 @@ func TestVDiffAggregates(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 5,
@@ -749,7 +770,7 @@ func TestVDiffPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -813,7 +834,7 @@ func TestVDiffNoPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -851,11 +872,11 @@ func TestVDiffDefaults(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.NoError(t, err)
 }
 
@@ -888,6 +909,124 @@ func TestVDiffReplicationWait(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, "", false)
 	require.EqualError(t, err, "startQueryStreams(sources): WaitForPosition for tablet cell-0000000101: context deadline exceeded")
 }
+
+func TestVDiffChunked(t *testing.T) {
+	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	// Every chunk is trimmed to the smallest last pk of the
+	// streams that returned 2 rows.
+	chunks := []struct {
+		query            string
+		source1, source2 []string
+		target1, target2 []string
+	}{{
+		query:   "select c1, c2 from t1 order by c1 asc limit 2",
+		source1: []string{"1|3", "2|4"},
+		source2: []string{"3|4", "4|4"},
+		target1: []string{"1|3", "3|4"},
+		target2: []string{"2|4", "5|5"},
+	}, {
+		query:   "select c1, c2 from t1 where c1 > 2 order by c1 asc limit 2",
+		source1: []string{"5|5"},
+		source2: []string{"3|4", "4|4"},
+		target1: []string{"3|4", "4|5"},
+		target2: []string{"5|5", "6|6"},
+	}, {
+		query:   "select c1, c2 from t1 where c1 > 4 order by c1 asc limit 2",
+		source1: []string{"5|5"},
+		target2: []string{"5|5", "6|6"},
+	}, {
+		query: "select c1, c2 from t1 where c1 > 6 order by c1 asc limit 2",
+	}}
+	for _, chunk := range chunks {
+		env.tablets[101].setResults(chunk.query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, chunk.source1...))
+		env.tablets[111].setResults(chunk.query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, chunk.source2...))
+		env.tablets[201].setResults(chunk.query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, chunk.target1...))
+		env.tablets[211].setResults(chunk.query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, chunk.target2...))
+	}
+
+	dir, err := ioutil.TempDir("", "vdiff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	checkpointFile := path.Join(dir, "checkpoint")
+
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 2, checkpointFile, false)
+	require.NoError(t, err)
+	wantdr := &DiffReport{
+		ProcessedRows:   6,
+		MatchingRows:    4,
+		MismatchedRows:  1,
+		ExtraRowsTarget: 1,
+	}
+	assert.Equal(t, wantdr, dr["t1"])
+
+	cp, err := readVDiffCheckpoint(checkpointFile, "target", env.workflow)
+	require.NoError(t, err)
+	assert.True(t, cp.Tables["t1"].Done)
+	assert.Equal(t, *wantdr, cp.Tables["t1"].Report)
+	assert.Equal(t, int64(2), cp.ChunkSize)
+
+	// Resume from the second chunk.
+	cp.Tables["t1"] = &tableCheckpoint{
+		Report: DiffReport{ProcessedRows: 2, MatchingRows: 2},
+	}
+	cp.Tables["t1"].setLastPK([]sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, cp.save())
+	dr, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 2, checkpointFile, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantdr, dr["t1"])
+
+	// Resume without a chunk size: the one of the checkpoint is used.
+	cp.Tables["t1"].Done = false
+	require.NoError(t, cp.save())
+	dr, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, checkpointFile, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantdr, dr["t1"])
+
+	// Resume a completed diff: the checkpointed report is reused.
+	env.tablets[101].queries = nil
+	dr, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 2, checkpointFile, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantdr, dr["t1"])
+
+	_, err = env.wr.VDiff(context.Background(), "other", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 2, checkpointFile, true)
+	assert.EqualError(t, err, "vdiff checkpoint "+checkpointFile+" is for workflow target.vdiffTest, not other.vdiffTest")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 2, "", true)
+	assert.EqualError(t, err, "a checkpoint file is required to resume a vdiff")
+
+	// A checkpoint with a last pk but no chunk size can't be resumed
+	// in one pass.
+	cp.ChunkSize = 0
+	require.NoError(t, cp.save())
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, "", 0, checkpointFile, true)
+	assert.EqualError(t, err, "table t1 was diffed in chunks, a chunk size is required to resume its diff")
+}
+
+func TestVDiffChunkQuery(t *testing.T) {
+	td := &tableDiffer{pkCols: []int{0, 1}}
+	got, err := td.chunkQuery("select c1, c2, c3 from t1 where c3 = 1 or c3 = 2 order by c1 asc, c2 asc", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from t1 where c3 = 1 or c3 = 2 order by c1 asc, c2 asc limit 10", got)
+
+	got, err = td.chunkQuery("select c1, c2, c3 from t1 where c3 = 1 or c3 = 2 order by c1 asc, c2 asc", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from t1 where (c3 = 1 or c3 = 2) and (c1, c2) > (1, 'a') order by c1 asc, c2 asc limit 10", got)
+}
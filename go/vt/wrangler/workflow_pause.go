@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// PauseWorkflow pauses the streams of the workflow on the masters of
+// the target keyspace. Each stream stops between two transactions,
+// and its position is logged.
+func (wr *Wrangler) PauseWorkflow(ctx context.Context, targetKeyspace, workflow string) error {
+	return wr.forAllWorkflowStreams(ctx, targetKeyspace, workflow, func(master *topo.TabletInfo, id int) error {
+		pos, err := wr.tmc.VReplicationPause(ctx, master.Tablet, id)
+		if err != nil {
+			return fmt.Errorf("VReplicationPause(%v, %v) failed: %v", master.AliasString(), id, err)
+		}
+		wr.Logger().Printf("Stream %v on %v/%v paused at %v\n", id, master.Keyspace, master.Shard, pos)
+		return nil
+	})
+}
+
+// ResumeWorkflow resumes the streams of the workflow paused by
+// PauseWorkflow.
+func (wr *Wrangler) ResumeWorkflow(ctx context.Context, targetKeyspace, workflow string) error {
+	return wr.forAllWorkflowStreams(ctx, targetKeyspace, workflow, func(master *topo.TabletInfo, id int) error {
+		if err := wr.tmc.VReplicationResume(ctx, master.Tablet, id); err != nil {
+			return fmt.Errorf("VReplicationResume(%v, %v) failed: %v", master.AliasString(), id, err)
+		}
+		wr.Logger().Printf("Stream %v on %v/%v resumed\n", id, master.Keyspace, master.Shard)
+		return nil
+	})
+}
+
+// forAllWorkflowStreams calls f for every stream of the workflow on
+// the masters of the target keyspace, in parallel across the shards.
+func (wr *Wrangler) forAllWorkflowStreams(ctx context.Context, targetKeyspace, workflow string, f func(master *topo.TabletInfo, id int) error) error {
+	shards, err := wr.ts.GetShardNames(ctx, targetKeyspace)
+	if err != nil {
+		return fmt.Errorf("GetShardNames(%v) failed: %v", targetKeyspace, err)
+	}
+
+	var streams sync2.AtomicInt64
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, targetKeyspace, shard)
+		if err != nil {
+			return fmt.Errorf("GetShard(%v, %v) failed: %v", targetKeyspace, shard, err)
+		}
+		if !si.HasMaster() {
+			return fmt.Errorf("no master in shard %v/%v", targetKeyspace, shard)
+		}
+		wg.Add(1)
+		go func(si *topo.ShardInfo) {
+			defer wg.Done()
+			master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			query := fmt.Sprintf("select id from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(workflow))
+			p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			qr := sqltypes.Proto3ToResult(p3qr)
+			for _, row := range qr.Rows {
+				id, err := sqltypes.ToInt64(row[0])
+				if err != nil {
+					allErrors.RecordError(err)
+					return
+				}
+				streams.Add(1)
+				if err := f(master, int(id)); err != nil {
+					allErrors.RecordError(err)
+				}
+			}
+		}(si)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return allErrors.AggrError(vterrors.Aggregate)
+	}
+	if streams.Get() == 0 {
+		return fmt.Errorf("no streams found for workflow %v in keyspace %v", workflow, targetKeyspace)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// pauseTMClient returns the streams of the workflow "wf" by tablet
+// uid, and records the paused and resumed streams.
+type pauseTMClient struct {
+	tmclient.TabletManagerClient
+	streams map[uint32][]int
+
+	mu      sync.Mutex
+	paused  []string
+	resumed []string
+}
+
+func (tmc *pauseTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	want := fmt.Sprintf("select id from _vt.vreplication where db_name='vt_%s' and workflow='wf'", tablet.Keyspace)
+	if query == want {
+		qr := &sqltypes.Result{Fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}}}
+		for _, id := range tmc.streams[tablet.Alias.Uid] {
+			qr.Rows = append(qr.Rows, []sqltypes.Value{sqltypes.NewInt64(int64(id))})
+		}
+		return sqltypes.ResultToProto3(qr), nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func (tmc *pauseTMClient) VReplicationPause(ctx context.Context, tablet *topodatapb.Tablet, id int) (string, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.paused = append(tmc.paused, fmt.Sprintf("%v/%v", tablet.Shard, id))
+	return "MariaDB/0-1-1083", nil
+}
+
+func (tmc *pauseTMClient) VReplicationResume(ctx context.Context, tablet *topodatapb.Tablet, id int) error {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	if id == 3 {
+		return fmt.Errorf("vreplication stream 3 is not paused")
+	}
+	tmc.resumed = append(tmc.resumed, fmt.Sprintf("%v/%v", tablet.Shard, id))
+	return nil
+}
+
+func TestPauseResumeWorkflow(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-")
+	tmc := &pauseTMClient{
+		streams: map[uint32][]int{
+			100: {1, 2},
+			101: {1},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	require.NoError(t, wr.PauseWorkflow(ctx, "ks", "wf"))
+	sort.Strings(tmc.paused)
+	assert.Equal(t, []string{"-80/1", "-80/2", "80-/1"}, tmc.paused)
+
+	require.NoError(t, wr.ResumeWorkflow(ctx, "ks", "wf"))
+	sort.Strings(tmc.resumed)
+	assert.Equal(t, []string{"-80/1", "-80/2", "80-/1"}, tmc.resumed)
+
+	// The other streams are resumed even if one fails.
+	tmc.streams[101] = []int{3}
+	tmc.resumed = nil
+	err := wr.ResumeWorkflow(ctx, "ks", "wf")
+	assert.EqualError(t, err, "VReplicationResume(cell1-0000000101, 3) failed: vreplication stream 3 is not paused")
+	sort.Strings(tmc.resumed)
+	assert.Equal(t, []string{"-80/1", "-80/2"}, tmc.resumed)
+
+	tmc.streams = nil
+	err = wr.PauseWorkflow(ctx, "ks", "wf")
+	assert.EqualError(t, err, "no streams found for workflow wf in keyspace ks")
+}
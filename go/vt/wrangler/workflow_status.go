@@ -0,0 +1,259 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// WorkflowStreamStatus is the status of one vreplication stream of a
+// workflow, as seen on the master of its target shard.
+type WorkflowStreamStatus struct {
+	Shard  string
+	Tablet string
+	ID     int64
+	// Source is the keyspace/shard the stream replicates from.
+	Source   string
+	State    string
+	Position string
+	// Message is the last error of the stream, if any.
+	Message string
+	// TimeUpdated is the last time the stream made progress, in
+	// seconds since the epoch.
+	TimeUpdated int64
+	// TransactionTimestamp is the time the last transaction applied by
+	// the stream was committed on the source, in seconds since the epoch.
+	// It is 0 until the stream applies its first transaction.
+	TransactionTimestamp int64
+	// LagSeconds is how far behind the source the stream was when it
+	// applied its last transaction, TimeUpdated - TransactionTimestamp.
+	// Unlike the time since TimeUpdated, it doesn't grow while the source
+	// is idle: the heartbeats of the source don't update the stream.
+	LagSeconds int64
+	// CopyProgress has the tables which remain to be copied.
+	CopyProgress []*tabletmanagerdatapb.TableCopyProgress
+}
+
+// WorkflowStatus is the status of a workflow, aggregated across the
+// shards of its target keyspace.
+type WorkflowStatus struct {
+	Keyspace string
+	Workflow string
+	// State is the state of the streams if they all have the same,
+	// "Copying" if some tables remain to be copied, and "Mixed" otherwise.
+	State string
+	// MaxLagSeconds is the largest LagSeconds of the streams.
+	MaxLagSeconds int64
+	// Errors is the number of streams which have an error Message.
+	Errors  int
+	Streams []*WorkflowStreamStatus
+}
+
+// WorkflowsStatus is returned by ListAllWorkflows.
+type WorkflowsStatus struct {
+	Workflows []*WorkflowStatus
+	// ShardErrors has the shards whose streams could not be read,
+	// the workflows are then incomplete.
+	ShardErrors []string
+}
+
+// ListAllWorkflows returns the status of the vreplication workflows
+// of the keyspace, or of all keyspaces if keyspace is empty. The
+// masters of all the shards are queried in parallel. The shards which
+// cannot be queried are reported in ShardErrors.
+func (wr *Wrangler) ListAllWorkflows(ctx context.Context, keyspace string) (*WorkflowsStatus, error) {
+	keyspaces := []string{keyspace}
+	if keyspace == "" {
+		var err error
+		keyspaces, err = wr.ts.GetKeyspaces(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
+		}
+	}
+
+	var mu sync.Mutex
+	result := &WorkflowsStatus{}
+	workflows := make(map[string]*WorkflowStatus)
+	recordError := func(keyspace, shard string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.ShardErrors = append(result.ShardErrors, fmt.Sprintf("%v/%v: %v", keyspace, shard, err))
+	}
+
+	wg := sync.WaitGroup{}
+	for _, keyspace := range keyspaces {
+		shards, err := wr.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+		}
+		for _, shard := range shards {
+			wg.Add(1)
+			go func(keyspace, shard string) {
+				defer wg.Done()
+				streams, err := wr.shardWorkflowStreams(ctx, keyspace, shard)
+				if err != nil {
+					recordError(keyspace, shard, err)
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				for workflow, workflowStreams := range streams {
+					key := keyspace + "." + workflow
+					ws, ok := workflows[key]
+					if !ok {
+						ws = &WorkflowStatus{
+							Keyspace: keyspace,
+							Workflow: workflow,
+						}
+						workflows[key] = ws
+					}
+					ws.Streams = append(ws.Streams, workflowStreams...)
+				}
+			}(keyspace, shard)
+		}
+	}
+	wg.Wait()
+
+	for _, ws := range workflows {
+		ws.summarize()
+		result.Workflows = append(result.Workflows, ws)
+	}
+	sort.Slice(result.Workflows, func(i, j int) bool {
+		if result.Workflows[i].Keyspace != result.Workflows[j].Keyspace {
+			return result.Workflows[i].Keyspace < result.Workflows[j].Keyspace
+		}
+		return result.Workflows[i].Workflow < result.Workflows[j].Workflow
+	})
+	sort.Strings(result.ShardErrors)
+	return result, nil
+}
+
+// shardWorkflowStreams returns the streams of the master of the shard,
+// keyed by workflow.
+func (wr *Wrangler) shardWorkflowStreams(ctx context.Context, keyspace, shard string) (map[string][]*WorkflowStreamStatus, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard")
+	}
+	master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("select id, workflow, source, pos, state, message, time_updated, transaction_timestamp from _vt.vreplication where db_name=%s", encodeString(master.DbName()))
+	p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+	if err != nil {
+		return nil, fmt.Errorf("VReplicationExec(%v) failed: %v", master.AliasString(), err)
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+
+	copyProgress, err := wr.tmc.VReplicationCopyProgress(ctx, master.Tablet, "")
+	if err != nil {
+		return nil, fmt.Errorf("VReplicationCopyProgress(%v) failed: %v", master.AliasString(), err)
+	}
+	copyProgressByID := make(map[int64][]*tabletmanagerdatapb.TableCopyProgress)
+	for _, stream := range copyProgress {
+		copyProgressByID[stream.Id] = stream.Tables
+	}
+
+	result := make(map[string][]*WorkflowStreamStatus)
+	for _, row := range qr.Rows {
+		id, err := sqltypes.ToInt64(row[0])
+		if err != nil {
+			return nil, err
+		}
+		timeUpdated, err := sqltypes.ToInt64(row[6])
+		if err != nil {
+			return nil, err
+		}
+		transactionTimestamp, err := sqltypes.ToInt64(row[7])
+		if err != nil {
+			return nil, err
+		}
+		source := row[2].ToString()
+		var bls binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(source, &bls); err == nil {
+			source = bls.Keyspace + "/" + bls.Shard
+		}
+		var lag int64
+		if transactionTimestamp != 0 && timeUpdated > transactionTimestamp {
+			lag = timeUpdated - transactionTimestamp
+		}
+		workflow := row[1].ToString()
+		result[workflow] = append(result[workflow], &WorkflowStreamStatus{
+			Shard:                shard,
+			Tablet:               master.AliasString(),
+			ID:                   id,
+			Source:               source,
+			Position:             row[3].ToString(),
+			State:                row[4].ToString(),
+			Message:              row[5].ToString(),
+			TimeUpdated:          timeUpdated,
+			TransactionTimestamp: transactionTimestamp,
+			LagSeconds:           lag,
+			CopyProgress:         copyProgressByID[id],
+		})
+	}
+	return result, nil
+}
+
+// summarize sorts the streams and computes the aggregated fields.
+func (ws *WorkflowStatus) summarize() {
+	sort.Slice(ws.Streams, func(i, j int) bool {
+		if ws.Streams[i].Shard != ws.Streams[j].Shard {
+			return ws.Streams[i].Shard < ws.Streams[j].Shard
+		}
+		return ws.Streams[i].ID < ws.Streams[j].ID
+	})
+	copying := false
+	for i, stream := range ws.Streams {
+		switch {
+		case i == 0:
+			ws.State = stream.State
+		case ws.State != stream.State:
+			ws.State = "Mixed"
+		}
+		if len(stream.CopyProgress) > 0 {
+			copying = true
+		}
+		if stream.LagSeconds > ws.MaxLagSeconds {
+			ws.MaxLagSeconds = stream.LagSeconds
+		}
+		if stream.Message != "" && stream.Message != frozenStr {
+			ws.Errors++
+		}
+	}
+	if copying {
+		ws.State = "Copying"
+	}
+}
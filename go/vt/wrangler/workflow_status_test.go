@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// workflowStatusTMClient returns the _vt.vreplication rows and the copy
+// progress of the masters by tablet uid.
+type workflowStatusTMClient struct {
+	tmclient.TabletManagerClient
+	rows     map[uint32][][]sqltypes.Value
+	progress map[uint32][]*tabletmanagerdatapb.VReplicationStreamCopyProgress
+}
+
+func (tmc *workflowStatusTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	rows, ok := tmc.rows[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tablet is down")
+	}
+	return sqltypes.ResultToProto3(&sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"id|workflow|source|pos|state|message|time_updated|transaction_timestamp",
+			"int64|varbinary|varbinary|varbinary|varbinary|varbinary|int64|int64"),
+		Rows: rows,
+	}), nil
+}
+
+func (tmc *workflowStatusTMClient) VReplicationCopyProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamCopyProgress, error) {
+	return tmc.progress[tablet.Alias.Uid], nil
+}
+
+func TestListAllWorkflows(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestShardMasters(t, "ks", "-80", "80-", "c0-")
+	now := time.Now().Unix()
+	source := func(shard string) sqltypes.Value {
+		return sqltypes.NewVarBinary(fmt.Sprintf(`keyspace:"src" shard:"%s" filter:<rules:<match:"/.*" > > `, shard))
+	}
+	tmc := &workflowStatusTMClient{
+		rows: map[uint32][][]sqltypes.Value{
+			100: {
+				{sqltypes.NewInt64(1), sqltypes.NewVarBinary("wf"), source("-"), sqltypes.NewVarBinary("pos1"), sqltypes.NewVarBinary("Running"), sqltypes.NewVarBinary(""), sqltypes.NewInt64(now), sqltypes.NewInt64(now)},
+				{sqltypes.NewInt64(2), sqltypes.NewVarBinary("other"), source("-"), sqltypes.NewVarBinary("pos2"), sqltypes.NewVarBinary("Stopped"), sqltypes.NewVarBinary("error: x"), sqltypes.NewInt64(now - 100), sqltypes.NewInt64(now - 200)},
+			},
+			// The source has been idle for an hour: the stream is not
+			// lagging more than when it applied its last transaction.
+			101: {
+				{sqltypes.NewInt64(1), sqltypes.NewVarBinary("wf"), source("-"), sqltypes.NewVarBinary("pos3"), sqltypes.NewVarBinary("Running"), sqltypes.NewVarBinary(""), sqltypes.NewInt64(now - 3600), sqltypes.NewInt64(now - 3610)},
+			},
+		},
+		progress: map[uint32][]*tabletmanagerdatapb.VReplicationStreamCopyProgress{
+			101: {{
+				Id:       1,
+				Workflow: "wf",
+				Tables:   []*tabletmanagerdatapb.TableCopyProgress{{Table: "t1", RowsCopied: 10, RowsTotal: 100}},
+			}},
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+
+	status, err := wr.ListAllWorkflows(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ks/c0-: VReplicationExec(cell1-0000000102) failed: tablet is down"}, status.ShardErrors)
+	require.Len(t, status.Workflows, 2)
+
+	other := status.Workflows[0]
+	assert.Equal(t, "other", other.Workflow)
+	assert.Equal(t, "Stopped", other.State)
+	assert.Equal(t, 1, other.Errors)
+	assert.Equal(t, int64(100), other.MaxLagSeconds)
+
+	wf := status.Workflows[1]
+	assert.Equal(t, "ks", wf.Keyspace)
+	assert.Equal(t, "wf", wf.Workflow)
+	assert.Equal(t, "Copying", wf.State)
+	assert.Equal(t, 0, wf.Errors)
+	assert.Equal(t, int64(10), wf.MaxLagSeconds)
+	require.Len(t, wf.Streams, 2)
+	assert.Equal(t, "-80", wf.Streams[0].Shard)
+	assert.Equal(t, "src/-", wf.Streams[0].Source)
+	assert.Equal(t, "cell1-0000000101", wf.Streams[1].Tablet)
+	assert.Len(t, wf.Streams[1].CopyProgress, 1)
+}